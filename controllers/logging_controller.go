@@ -68,6 +68,7 @@ type LoggingReconciler struct {
 // +kubebuilder:rbac:groups=monitoring.coreos.com,resources=servicemonitors,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=coordination.k8s.io,resources=leases,verbs=*
 // +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=autoscaling,resources=horizontalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile logging resources
 func (r *LoggingReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -103,21 +104,27 @@ func (r *LoggingReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 	}
 
 	if logging.Spec.FluentdSpec != nil {
-		fluentdConfig, secretList, err := r.clusterConfiguration(loggingResources)
-		if err != nil {
-			// TODO: move config generation into Fluentd reconciler
-			reconcilers = append(reconcilers, func() (*reconcile.Result, error) {
-				return &reconcile.Result{}, err
-			})
-		} else {
-			log.V(1).Info("flow configuration", "config", fluentdConfig)
-
-			reconcilers = append(reconcilers, fluentd.New(r.Client, r.Log, &logging, &fluentdConfig, secretList, reconcilerOpts).Reconcile)
+		shards := []string{""}
+		for _, shard := range logging.Spec.FluentdSpec.Shards {
+			shards = append(shards, shard.Name)
+		}
+		for _, shard := range shards {
+			fluentdConfig, secretList, env, err := r.clusterConfiguration(loggingResources.ForShard(shard))
+			if err != nil {
+				// TODO: move config generation into Fluentd reconciler
+				reconcilers = append(reconcilers, func() (*reconcile.Result, error) {
+					return &reconcile.Result{}, err
+				})
+			} else {
+				log.V(1).Info("flow configuration", "shard", shard, "config", fluentdConfig)
+
+				reconcilers = append(reconcilers, fluentd.NewShard(r.Client, r.Log, &logging, shard, &fluentdConfig, secretList, env, reconcilerOpts).Reconcile)
+			}
 		}
 	}
 
 	if logging.Spec.FluentbitSpec != nil {
-		reconcilers = append(reconcilers, fluentbit.New(r.Client, r.Log, &logging, reconcilerOpts).Reconcile)
+		reconcilers = append(reconcilers, fluentbit.New(r.Client, r.Log, &logging, loggingResources.ShardNamespaces, reconcilerOpts).Reconcile)
 	}
 
 	if len(logging.Spec.NodeAgents) > 0 {
@@ -138,9 +145,9 @@ func (r *LoggingReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 	return ctrl.Result{}, nil
 }
 
-func (r *LoggingReconciler) clusterConfiguration(resources model.LoggingResources) (string, *secret.MountSecrets, error) {
+func (r *LoggingReconciler) clusterConfiguration(resources model.LoggingResources) (string, *secret.MountSecrets, []corev1.EnvVar, error) {
 	if cfg := resources.Logging.Spec.FlowConfigOverride; cfg != "" {
-		return cfg, nil, nil
+		return cfg, nil, nil, nil
 	}
 
 	slf := secretLoaderFactory{
@@ -149,7 +156,16 @@ func (r *LoggingReconciler) clusterConfiguration(resources model.LoggingResource
 
 	fluentConfig, err := model.CreateSystem(resources, &slf, r.Log)
 	if err != nil {
-		return "", nil, errors.WrapIfWithDetails(err, "failed to build model", "logging", resources.Logging)
+		return "", nil, nil, errors.WrapIfWithDetails(err, "failed to build model", "logging", resources.Logging)
+	}
+
+	env, err := model.FluentdEnvVars(resources, &slf)
+	if err != nil {
+		return "", nil, nil, errors.WrapIfWithDetails(err, "failed to configure the fluentd environment", "logging", resources.Logging)
+	}
+
+	if err := model.AppendOutputFiles(resources, &slf.Secrets); err != nil {
+		return "", nil, nil, errors.WrapIfWithDetails(err, "failed to render the files of the outputs", "logging", resources.Logging)
 	}
 
 	output := &bytes.Buffer{}
@@ -158,10 +174,10 @@ func (r *LoggingReconciler) clusterConfiguration(resources model.LoggingResource
 		Indent: 2,
 	}
 	if err := renderer.Render(fluentConfig); err != nil {
-		return "", nil, errors.WrapIfWithDetails(err, "failed to render fluentd config", "logging", resources.Logging)
+		return "", nil, nil, errors.WrapIfWithDetails(err, "failed to render fluentd config", "logging", resources.Logging)
 	}
 
-	return output.String(), &slf.Secrets, nil
+	return output.String(), &slf.Secrets, env, nil
 }
 
 type secretLoaderFactory struct {
@@ -206,6 +222,20 @@ func SetupLoggingWithManager(mgr ctrl.Manager, logger logr.Logger) *ctrl.Builder
 				}
 			}
 			return requestList
+		case *corev1.Namespace:
+			// namespace labels may move a namespace between fluentd shards
+			var requestList []reconcile.Request
+			for _, l := range loggingList.Items {
+				if l.Spec.FluentdSpec != nil && len(l.Spec.FluentdSpec.Shards) > 0 {
+					requestList = append(requestList, reconcile.Request{
+						NamespacedName: types.NamespacedName{
+							Namespace: l.Namespace,
+							Name:      l.Name,
+						},
+					})
+				}
+			}
+			return requestList
 		}
 		return nil
 	})
@@ -217,7 +247,8 @@ func SetupLoggingWithManager(mgr ctrl.Manager, logger logr.Logger) *ctrl.Builder
 		Watches(&source.Kind{Type: &loggingv1beta1.ClusterFlow{}}, requestMapper).
 		Watches(&source.Kind{Type: &loggingv1beta1.Output{}}, requestMapper).
 		Watches(&source.Kind{Type: &loggingv1beta1.Flow{}}, requestMapper).
-		Watches(&source.Kind{Type: &corev1.Secret{}}, requestMapper)
+		Watches(&source.Kind{Type: &corev1.Secret{}}, requestMapper).
+		Watches(&source.Kind{Type: &corev1.Namespace{}}, requestMapper)
 
 	fluentd.RegisterWatches(builder)
 	fluentbit.RegisterWatches(builder)
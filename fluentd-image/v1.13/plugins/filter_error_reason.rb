@@ -0,0 +1,57 @@
+#
+# Copyright © 2021 Banzai Cloud
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#    http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+require 'fluent/plugin/filter'
+require 'fluent/root_agent'
+
+module Fluent
+  module Plugin
+    # Adds the error of the events sent to the @ERROR label to the records.
+    #
+    # Fluentd passes the error only to its own log, so the root agent is patched to keep the error
+    # of the event while it is emitted, the filters of the @ERROR label run in the same thread.
+    class ErrorReasonFilter < Filter
+      Fluent::Plugin.register_filter('error_reason', self)
+
+      ERROR_KEY = :logging_operator_error_reason
+
+      module RootAgentErrorReason
+        def emit_error_event(tag, time, record, error)
+          Thread.current[ERROR_KEY] = error
+          super
+        ensure
+          Thread.current[ERROR_KEY] = nil
+        end
+
+        def handle_emits_error(tag, es, error)
+          Thread.current[ERROR_KEY] = error
+          super
+        ensure
+          Thread.current[ERROR_KEY] = nil
+        end
+      end
+      Fluent::RootAgent.prepend(RootAgentErrorReason)
+
+      desc 'The record field to store the error in'
+      config_param :key, :string, default: 'error_reason'
+
+      def filter(tag, time, record)
+        error = Thread.current[ERROR_KEY]
+        record[@key] = "#{error.class}: #{error.message}" if error
+        record
+      end
+    end
+  end
+end
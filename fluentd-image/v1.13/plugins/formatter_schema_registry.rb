@@ -0,0 +1,256 @@
+#
+# Copyright © 2021 Banzai Cloud
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#    http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+require 'json'
+require 'net/http'
+require 'openssl'
+require 'stringio'
+require 'uri'
+require 'fluent/plugin/formatter'
+
+module Fluent
+  module Plugin
+    # Encodes the records as Avro or Protobuf in the wire format of the Confluent schema registry:
+    # a magic byte, the 4 byte id of the schema, the message indexes (Protobuf only) and the binary payload.
+    class SchemaRegistryFormatter < Formatter
+      Fluent::Plugin.register_formatter('schema_registry', self)
+
+      MAGIC_BYTE = [0].pack('C').freeze
+
+      desc 'Encoding of the records'
+      config_param :encoding, :enum, list: [:avro, :protobuf]
+      desc 'The url of the schema registry'
+      config_param :url, :string
+      desc 'Username of the basic authentication of the registry'
+      config_param :username, :string, default: nil
+      desc 'Password of the basic authentication of the registry'
+      config_param :password, :string, default: nil, secret: true
+      desc 'CA certificate verifying the registry'
+      config_param :ca_file, :string, default: nil
+      desc 'Client certificate of the registry'
+      config_param :cert_file, :string, default: nil
+      desc 'Client certificate key of the registry'
+      config_param :key_file, :string, default: nil
+      desc 'The subject name strategy of the schemas'
+      config_param :subject_name_strategy, :enum, list: [:TopicNameStrategy, :RecordNameStrategy, :TopicRecordNameStrategy], default: :TopicNameStrategy
+      desc 'The fully qualified name of the Avro record or the Protobuf message'
+      config_param :record_name, :string, default: nil
+      desc 'The version of the registered schema'
+      config_param :schema_version, :string, default: 'latest'
+      desc 'Avro schema registered under the subject'
+      config_param :schema, :string, default: nil
+      desc 'Compiled Protobuf descriptor set including the imports'
+      config_param :descriptor_set_file, :string, default: nil
+      desc 'Interval of refreshing the schemas looked up in the registry'
+      config_param :cache_ttl, :time, default: 300
+      desc 'The topic of the records without a topic key'
+      config_param :topic, :string, default: nil
+      desc 'The record key of the topic'
+      config_param :topic_key, :string, default: nil
+
+      helpers :timer
+
+      def configure(conf)
+        super
+
+        if @subject_name_strategy != :TopicNameStrategy && @record_name.nil?
+          raise Fluent::ConfigError, "record_name is required for the #{@subject_name_strategy}"
+        end
+
+        case @encoding
+        when :avro
+          require 'avro'
+          @avro_schema = Avro::Schema.parse(@schema) if @schema
+        when :protobuf
+          if @descriptor_set_file.nil? || @record_name.nil?
+            raise Fluent::ConfigError, 'descriptor_set_file and record_name are required for the protobuf encoding'
+          end
+          raise Fluent::ConfigError, 'schema is supported by the avro encoding only' if @schema
+          configure_protobuf
+        end
+
+        @schemas = {}
+        @mutex = Mutex.new
+      end
+
+      # the schema of the subject known in advance is resolved before the first record,
+      # the resolved schemas are refreshed in the background and kept if the registry is unavailable
+      def start
+        super
+
+        subject = default_subject
+        resolve_schema(subject) if subject
+        timer_execute(:schema_registry_refresh, @cache_ttl) { refresh_schemas }
+      end
+
+      def format(tag, time, record)
+        id, schema = schema_for(subject(record))
+        payload = StringIO.new(''.b)
+        payload.write(MAGIC_BYTE)
+        payload.write([id].pack('N'))
+        case @encoding
+        when :avro
+          Avro::IO::DatumWriter.new(schema).write(record, Avro::IO::BinaryEncoder.new(payload))
+        when :protobuf
+          payload.write(@message_indexes)
+          payload.write(@message_class.encode(@message_class.decode_json(record.to_json, ignore_unknown_fields: true)))
+        end
+        payload.string
+      end
+
+      private
+
+      def configure_protobuf
+        require 'google/protobuf'
+        require 'google/protobuf/descriptor_pb'
+
+        set = Google::Protobuf::FileDescriptorSet.decode(File.binread(@descriptor_set_file))
+        pool = Google::Protobuf::DescriptorPool.new
+        set.file.each { |file| pool.add_serialized_file(Google::Protobuf::FileDescriptorProto.encode(file)) }
+        descriptor = pool.lookup(@record_name)
+        raise Fluent::ConfigError, "message #{@record_name} not found in #{@descriptor_set_file}" if descriptor.nil?
+
+        @message_class = descriptor.msgclass
+        @message_indexes = encode_message_indexes(message_indexes(set, @record_name))
+      end
+
+      # message_indexes returns the path of the message in the nested message types of its file
+      def message_indexes(set, name)
+        set.file.each do |file|
+          prefix = file.package.empty? ? '' : "#{file.package}."
+          next unless name.start_with?(prefix)
+
+          path = find_message(file.message_type, name[prefix.length..-1])
+          return path if path
+        end
+        raise Fluent::ConfigError, "message #{name} not found in #{@descriptor_set_file}"
+      end
+
+      def find_message(messages, name)
+        messages.each_with_index do |message, index|
+          return [index] if message.name == name
+          next unless name.start_with?("#{message.name}.")
+
+          path = find_message(message.nested_type, name[(message.name.length + 1)..-1])
+          return [index] + path if path
+        end
+        nil
+      end
+
+      # the first message of the file is encoded as a single zero, the others as the count and the indexes
+      def encode_message_indexes(indexes)
+        return varint(0) if indexes == [0]
+
+        ([indexes.length] + indexes).map { |value| varint(value) }.join.b
+      end
+
+      def varint(value)
+        value = (value << 1) ^ (value >> 63)
+        bytes = []
+        loop do
+          byte = value & 0x7f
+          value >>= 7
+          if value.zero?
+            bytes << byte
+            break
+          end
+          bytes << (byte | 0x80)
+        end
+        bytes.pack('C*')
+      end
+
+      def default_subject
+        return @record_name if @subject_name_strategy == :RecordNameStrategy
+        return nil if @topic.nil?
+
+        topic_subject(@topic)
+      end
+
+      def subject(record)
+        return @record_name if @subject_name_strategy == :RecordNameStrategy
+
+        topic = (@topic_key && record[@topic_key]) || @topic
+        raise 'the topic of the record is unknown' if topic.nil?
+
+        topic_subject(topic)
+      end
+
+      def topic_subject(topic)
+        @subject_name_strategy == :TopicNameStrategy ? "#{topic}-value" : "#{topic}-#{@record_name}"
+      end
+
+      # schema_for returns the resolved schema of the subject, the registry is only requested for the subjects not resolved yet
+      def schema_for(subject)
+        cached = @mutex.synchronize { @schemas[subject] }
+        return cached if cached
+
+        schema = fetch_schema(subject)
+        @mutex.synchronize { @schemas[subject] = schema }
+      end
+
+      def resolve_schema(subject)
+        schema_for(subject)
+      rescue StandardError => e
+        log.warn 'failed to resolve the schema, retrying with the next record', subject: subject, error: e
+      end
+
+      def refresh_schemas
+        subjects = @mutex.synchronize { @schemas.keys }
+        subjects.each do |subject|
+          schema = fetch_schema(subject)
+          @mutex.synchronize { @schemas[subject] = schema }
+        rescue StandardError => e
+          log.warn 'failed to refresh the schema, keeping the last resolved one', subject: subject, error: e
+        end
+      end
+
+      def fetch_schema(subject)
+        path = "subjects/#{URI.encode_www_form_component(subject)}/versions"
+        if @avro_schema
+          response = registry_request(Net::HTTP::Post, path, { schema: @schema })
+          return [response['id'], @avro_schema]
+        end
+
+        response = registry_request(Net::HTTP::Get, "#{path}/#{@schema_version}")
+        schema = @encoding == :avro ? Avro::Schema.parse(response['schema']) : nil
+        [response['id'], schema]
+      end
+
+      def registry_request(method, path, body = nil)
+        uri = URI.join(@url.end_with?('/') ? @url : "#{@url}/", path)
+        http = Net::HTTP.new(uri.host, uri.port)
+        if uri.scheme == 'https'
+          http.use_ssl = true
+          http.ca_file = @ca_file if @ca_file
+          http.cert = OpenSSL::X509::Certificate.new(File.read(@cert_file)) if @cert_file
+          http.key = OpenSSL::PKey.read(File.read(@key_file)) if @key_file
+        end
+
+        request = method.new(uri)
+        request['Accept'] = 'application/vnd.schemaregistry.v1+json'
+        request.basic_auth(@username, @password) if @username
+        if body
+          request['Content-Type'] = 'application/vnd.schemaregistry.v1+json'
+          request.body = body.to_json
+        end
+
+        response = http.request(request)
+        raise "schema registry request #{uri.path} failed: #{response.code} #{response.body}" unless response.is_a?(Net::HTTPSuccess)
+
+        JSON.parse(response.body)
+      end
+    end
+  end
+end
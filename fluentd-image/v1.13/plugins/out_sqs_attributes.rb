@@ -0,0 +1,147 @@
+#
+# Copyright © 2021 Banzai Cloud
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#    http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+require 'fluent/plugin/output'
+require 'aws-sdk-sqs'
+require 'json'
+require 'securerandom'
+
+module Fluent
+  module Plugin
+    # Sends the records to Amazon SQS the same way as the sqs output of fluent-plugin-sqs,
+    # adding message attributes with fixed values and values taken from record fields.
+    class SQSAttributesOutput < Output
+      Fluent::Plugin.register_output('sqs_attributes', self)
+
+      helpers :record_accessor
+
+      # limits of the SendMessageBatch request
+      MAX_BATCH_ENTRIES = 10
+      MAX_BATCH_BYTES = 262_144
+      MAX_ATTRIBUTES = 10
+
+      config_param :sqs_url, :string, default: nil
+      config_param :queue_name, :string, default: nil
+      config_param :aws_key_id, :string, default: nil, secret: true
+      config_param :aws_sec_key, :string, default: nil, secret: true
+      config_param :create_queue, :bool, default: true
+      config_param :region, :string, default: 'ap-northeast-1'
+      config_param :message_group_id, :string, default: nil
+      config_param :delay_seconds, :integer, default: 0
+      config_param :include_tag, :bool, default: true
+      config_param :tag_property_name, :string, default: '__tag'
+      desc 'Message attributes added to every message'
+      config_param :message_attributes, :hash, default: {}
+      desc 'Message attributes taken from record fields, the name of the attribute mapped to the record accessor of the field'
+      config_param :message_attributes_keys, :hash, default: {}
+
+      def configure(conf)
+        super
+        raise Fluent::ConfigError, 'either sqs_url or queue_name is required' if @sqs_url.nil? && @queue_name.nil?
+        if @message_attributes.size + @message_attributes_keys.size > MAX_ATTRIBUTES
+          raise Fluent::ConfigError, "at most #{MAX_ATTRIBUTES} message attributes are allowed"
+        end
+        @attribute_accessors = @message_attributes_keys.map { |name, key| [name, record_accessor_create(key)] }
+      end
+
+      def start
+        super
+        options = { region: @region }
+        options[:credentials] = Aws::Credentials.new(@aws_key_id, @aws_sec_key) if @aws_key_id && @aws_sec_key
+        @client = Aws::SQS::Client.new(options)
+        @queue_url = @sqs_url || queue_url
+      end
+
+      def format(tag, time, record)
+        [tag, time, record].to_msgpack
+      end
+
+      def formatted_to_msgpack_binary?
+        true
+      end
+
+      def write(chunk)
+        entries = []
+        bytes = 0
+        chunk.msgpack_each do |tag, _time, record|
+          entry = message(tag, record)
+          entry_bytes = message_bytes(entry)
+          if !entries.empty? && (entries.size == MAX_BATCH_ENTRIES || bytes + entry_bytes > MAX_BATCH_BYTES)
+            send_batch(entries)
+            entries = []
+            bytes = 0
+          end
+          entries << entry
+          bytes += entry_bytes
+        end
+        send_batch(entries) unless entries.empty?
+      end
+
+      private
+
+      def queue_url
+        if @create_queue
+          @client.create_queue(queue_name: @queue_name).queue_url
+        else
+          @client.get_queue_url(queue_name: @queue_name).queue_url
+        end
+      end
+
+      def message(tag, record)
+        record[@tag_property_name] = tag if @include_tag
+        entry = { id: SecureRandom.uuid, message_body: record.to_json, delay_seconds: @delay_seconds }
+        entry[:message_group_id] = @message_group_id if @message_group_id
+        attributes = message_attributes(record)
+        entry[:message_attributes] = attributes unless attributes.empty?
+        entry
+      end
+
+      # the attributes of the missing and empty fields are left out, SQS rejects empty values
+      def message_attributes(record)
+        attributes = {}
+        @message_attributes.each do |name, value|
+          attributes[name] = { data_type: 'String', string_value: value.to_s }
+        end
+        @attribute_accessors.each do |name, accessor|
+          value = accessor.call(record)
+          value = value.to_json unless value.nil? || value.is_a?(String)
+          next if value.nil? || value.empty?
+
+          attributes[name] = { data_type: 'String', string_value: value }
+        end
+        attributes
+      end
+
+      def message_bytes(entry)
+        attributes = entry.fetch(:message_attributes, {})
+        entry[:message_body].bytesize + attributes.sum do |name, value|
+          name.bytesize + value[:data_type].bytesize + value[:string_value].bytesize
+        end
+      end
+
+      # the messages rejected as invalid are dropped, the chunk is retried on the other failures
+      def send_batch(entries)
+        failed = @client.send_message_batch(queue_url: @queue_url, entries: entries).failed
+        return if failed.empty?
+
+        invalid, retryable = failed.partition(&:sender_fault)
+        invalid.each do |f|
+          log.warn 'dropping message rejected by SQS', code: f.code, message: f.message
+        end
+        raise "failed to send #{retryable.size} messages to SQS: #{retryable.map(&:code).uniq.join(', ')}" unless retryable.empty?
+      end
+    end
+  end
+end
@@ -0,0 +1,25 @@
+#
+# Copyright © 2021 Banzai Cloud
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#    http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# Tests of the plugins of the image, run them with fluentd and the dependencies of the plugins installed:
+#   ruby -Itest -e 'Dir["test/test_*.rb"].each { |f| require File.expand_path(f) }'
+
+$LOAD_PATH.unshift(File.expand_path('../plugins', __dir__))
+
+require 'test/unit'
+require 'fluent/test'
+require 'fluent/test/helpers'
+
+include Fluent::Test::Helpers
@@ -0,0 +1,106 @@
+#
+# Copyright © 2021 Banzai Cloud
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#    http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+require_relative 'helper'
+require 'fluent/test/driver/filter'
+require 'fluent/plugin/filter_record_modifier'
+
+# Tests the record_modifier expression rendered by the Dedup filter of the operator (pkg/sdk/model/filter/dedup.go)
+class DedupFilterTest < Test::Unit::TestCase
+  # the rendering of `stream_keys: [pod]`, `compare_keys: [log]`, `window: 10` and `max_streams: 2`
+  CONFIG = %(
+    prepare_value @dedup_window = 10; @dedup_max_streams = 2; @dedup_state = {}; @dedup_dig = lambda { |r, path| path.reduce(r) { |v, k| v.is_a?(Hash) ? v[k] : nil } }; @dedup_stream_keys = [['pod']]; @dedup_compare_keys = [['log']]
+    <record>
+      __dedup ${stream = @dedup_stream_keys.map { |p| @dedup_dig.call(record, p) }; sig = @dedup_compare_keys.map { |p| @dedup_dig.call(record, p) }.hash; now = time.to_f; last = @dedup_state[stream]; if last && last[0] == sig && now - last[1] < @dedup_window then last[2] += 1; 'drop'; else record['dedup_count'] = last[2] if last && last[2] > 0; @dedup_state.delete(stream); @dedup_state.delete_if { |_, v| now - v[1] > @dedup_window } if @dedup_state.size >= @dedup_max_streams; @dedup_state.shift while @dedup_state.size >= @dedup_max_streams; @dedup_state[stream] = [sig, now, 0]; 'keep' end}
+    </record>
+  ).freeze
+
+  setup do
+    Fluent::Test.setup
+  end
+
+  def create_driver
+    Fluent::Test::Driver::Filter.new(Fluent::Plugin::RecordModifierFilter).configure(CONFIG)
+  end
+
+  def run_filter(d, events)
+    d.run(default_tag: 'test') do
+      events.each { |time, record| d.feed(event_time(time), record) }
+    end
+    d.filtered_records
+  end
+
+  def state(d)
+    d.instance.instance_variable_get(:@dedup_state)
+  end
+
+  test 'drops the repeated records and counts them on the next kept record' do
+    d = create_driver
+    records = run_filter(d, [
+                           ['2021-01-01 00:00:00 UTC', { 'pod' => 'a', 'log' => 'x' }],
+                           ['2021-01-01 00:00:01 UTC', { 'pod' => 'a', 'log' => 'x' }],
+                           ['2021-01-01 00:00:02 UTC', { 'pod' => 'a', 'log' => 'x' }],
+                           ['2021-01-01 00:00:03 UTC', { 'pod' => 'a', 'log' => 'y' }]
+                         ])
+    assert_equal %w[keep drop drop keep], records.map { |r| r['__dedup'] }
+    assert_equal [nil, nil, nil, 2], records.map { |r| r['dedup_count'] }
+  end
+
+  test 'keeps the repeated records after the window' do
+    d = create_driver
+    records = run_filter(d, [
+                           ['2021-01-01 00:00:00 UTC', { 'pod' => 'a', 'log' => 'x' }],
+                           ['2021-01-01 00:00:11 UTC', { 'pod' => 'a', 'log' => 'x' }]
+                         ])
+    assert_equal %w[keep keep], records.map { |r| r['__dedup'] }
+  end
+
+  test 'forgets the streams without records in the window at max_streams' do
+    d = create_driver
+    run_filter(d, [
+                 ['2021-01-01 00:00:00 UTC', { 'pod' => 'a', 'log' => 'x' }],
+                 ['2021-01-01 00:00:20 UTC', { 'pod' => 'b', 'log' => 'x' }],
+                 ['2021-01-01 00:00:21 UTC', { 'pod' => 'c', 'log' => 'x' }]
+               ])
+    assert_equal [['b'], ['c']], state(d).keys
+  end
+
+  test 'forgets the streams kept the longest ago when all streams are in the window' do
+    d = create_driver
+    records = run_filter(d, [
+                           ['2021-01-01 00:00:00 UTC', { 'pod' => 'a', 'log' => 'x' }],
+                           ['2021-01-01 00:00:01 UTC', { 'pod' => 'b', 'log' => 'x' }],
+                           ['2021-01-01 00:00:02 UTC', { 'pod' => 'a', 'log' => 'y' }],
+                           ['2021-01-01 00:00:03 UTC', { 'pod' => 'c', 'log' => 'x' }],
+                           ['2021-01-01 00:00:04 UTC', { 'pod' => 'd', 'log' => 'x' }],
+                           ['2021-01-01 00:00:05 UTC', { 'pod' => 'e', 'log' => 'x' }]
+                         ])
+    assert_equal %w[keep keep keep keep keep keep], records.map { |r| r['__dedup'] }
+    assert_equal [['d'], ['e']], state(d).keys
+  end
+
+  test 'loses the count of a forgotten stream' do
+    d = create_driver
+    records = run_filter(d, [
+                           ['2021-01-01 00:00:00 UTC', { 'pod' => 'a', 'log' => 'x' }],
+                           ['2021-01-01 00:00:01 UTC', { 'pod' => 'a', 'log' => 'x' }],
+                           ['2021-01-01 00:00:02 UTC', { 'pod' => 'b', 'log' => 'x' }],
+                           ['2021-01-01 00:00:03 UTC', { 'pod' => 'c', 'log' => 'x' }],
+                           ['2021-01-01 00:00:04 UTC', { 'pod' => 'a', 'log' => 'x' }]
+                         ])
+    assert_equal %w[keep drop keep keep keep], records.map { |r| r['__dedup'] }
+    assert_nil records.last['dedup_count']
+  end
+end
@@ -0,0 +1,137 @@
+#
+# Copyright © 2021 Banzai Cloud
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#    http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+require_relative 'helper'
+require 'fluent/test/driver/formatter'
+require 'formatter_schema_registry'
+
+class SchemaRegistryFormatterTest < Test::Unit::TestCase
+  CONFIG = %(
+    encoding avro
+    url http://schema-registry:8081
+    topic app-logs
+    topic_key topic
+  ).freeze
+
+  SCHEMA = {
+    type: 'record',
+    name: 'LogRecord',
+    fields: [{ name: 'message', type: 'string' }]
+  }.to_json.freeze
+
+  setup do
+    Fluent::Test.setup
+  end
+
+  def create_driver(conf = CONFIG)
+    Fluent::Test::Driver::Formatter.new(Fluent::Plugin::SchemaRegistryFormatter).configure(conf)
+  end
+
+  # stub_registry answers the requests of the formatter by the block instead of the registry
+  def stub_registry(driver, &block)
+    requests = []
+    driver.instance.define_singleton_method(:registry_request) do |method, path, body = nil|
+      requests << path
+      block.call(method, path, body)
+    end
+    requests
+  end
+
+  def decode(payload)
+    reader = Avro::IO::DatumReader.new(Avro::Schema.parse(SCHEMA))
+    [payload[0].unpack1('C'), payload[1, 4].unpack1('N'), reader.read(Avro::IO::BinaryDecoder.new(StringIO.new(payload[5..-1])))]
+  end
+
+  test 'resolves the schema of the default topic on start' do
+    d = create_driver
+    requests = stub_registry(d) { |_, _, _| { 'id' => 7, 'schema' => SCHEMA } }
+    d.instance_start
+    assert_equal ['subjects/app-logs-value/versions/latest'], requests
+
+    payload = d.instance.format('app', event_time, { 'message' => 'hello' })
+    assert_equal [0, 7, { 'message' => 'hello' }], decode(payload)
+    assert_equal 1, requests.size
+  ensure
+    d.instance_shutdown
+  end
+
+  test 'looks up the subject of the topic of the record' do
+    d = create_driver
+    requests = stub_registry(d) { |_, path, _| { 'id' => path.include?('audit') ? 9 : 7, 'schema' => SCHEMA } }
+    d.instance_start
+
+    payload = d.instance.format('app', event_time, { 'message' => 'hello', 'topic' => 'audit' })
+    assert_equal 9, decode(payload)[1]
+    assert_equal 'subjects/audit-value/versions/latest', requests.last
+  ensure
+    d.instance_shutdown
+  end
+
+  test 'keeps the last resolved schema when the registry is unavailable' do
+    d = create_driver
+    available = true
+    stub_registry(d) do |_, _, _|
+      raise 'schema registry request failed: 503' unless available
+
+      { 'id' => 7, 'schema' => SCHEMA }
+    end
+    d.instance_start
+
+    available = false
+    d.instance.send(:refresh_schemas)
+    payload = d.instance.format('app', event_time, { 'message' => 'hello' })
+    assert_equal 7, decode(payload)[1]
+  ensure
+    d.instance_shutdown
+  end
+
+  test 'retries the resolution of a schema failed on start' do
+    d = create_driver
+    available = false
+    requests = stub_registry(d) do |_, _, _|
+      raise 'schema registry request failed: 503' unless available
+
+      { 'id' => 7, 'schema' => SCHEMA }
+    end
+    d.instance_start
+    assert_raise(RuntimeError) { d.instance.format('app', event_time, { 'message' => 'hello' }) }
+
+    available = true
+    payload = d.instance.format('app', event_time, { 'message' => 'hello' })
+    assert_equal 7, decode(payload)[1]
+    assert_equal 3, requests.size
+  ensure
+    d.instance_shutdown
+  end
+
+  test 'registers the schema of the configuration' do
+    d = create_driver(CONFIG + "schema #{SCHEMA}\n")
+    bodies = []
+    stub_registry(d) do |method, path, body|
+      bodies << [method, path, body]
+      { 'id' => 3 }
+    end
+    d.instance_start
+    assert_equal [[Net::HTTP::Post, 'subjects/app-logs-value/versions', { schema: SCHEMA }]], bodies
+  ensure
+    d.instance_shutdown
+  end
+
+  test 'requires the record name for the record name strategies' do
+    assert_raise(Fluent::ConfigError) do
+      create_driver(CONFIG + "subject_name_strategy RecordNameStrategy\n")
+    end
+  end
+end
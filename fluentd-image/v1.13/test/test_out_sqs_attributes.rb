@@ -0,0 +1,93 @@
+#
+# Copyright © 2021 Banzai Cloud
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#    http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+require_relative 'helper'
+require 'fluent/test/driver/output'
+require 'out_sqs_attributes'
+
+class SQSAttributesOutputTest < Test::Unit::TestCase
+  CONFIG = %(
+    sqs_url https://sqs.eu-west-1.amazonaws.com/123456789012/audit-events
+    region eu-west-1
+    include_tag false
+    message_attributes {"cluster":"production"}
+    message_attributes_keys {"namespace":"$.kubernetes.namespace_name","level":"level"}
+  ).freeze
+
+  setup do
+    Fluent::Test.setup
+    Aws.config[:sqs] = { stub_responses: true }
+  end
+
+  teardown do
+    Aws.config.delete(:sqs)
+  end
+
+  def create_driver(conf = CONFIG)
+    Fluent::Test::Driver::Output.new(Fluent::Plugin::SQSAttributesOutput).configure(conf)
+  end
+
+  def sent_batches(driver)
+    driver.instance.instance_variable_get(:@client).api_requests
+          .select { |r| r[:operation_name] == :send_message_batch }
+          .map { |r| r[:params][:entries] }
+  end
+
+  def string_attribute(value)
+    { data_type: 'String', string_value: value }
+  end
+
+  test 'adds the fixed attributes and the attributes of the record fields' do
+    d = create_driver
+    d.run(default_tag: 'audit') do
+      d.feed(event_time, { 'level' => 'info', 'kubernetes' => { 'namespace_name' => 'default' } })
+      d.feed(event_time, { 'level' => '', 'code' => 1 })
+    end
+    entries = sent_batches(d).flatten
+    assert_equal 2, entries.size
+    assert_equal({
+                   'cluster' => string_attribute('production'),
+                   'namespace' => string_attribute('default'),
+                   'level' => string_attribute('info')
+                 }, entries[0][:message_attributes])
+    # the missing and the empty fields are left out
+    assert_equal({ 'cluster' => string_attribute('production') }, entries[1][:message_attributes])
+    assert_equal({ 'level' => '', 'code' => 1 }, JSON.parse(entries[1][:message_body]))
+  end
+
+  test 'sends the records in batches of up to 10 messages' do
+    d = create_driver
+    d.run(default_tag: 'audit') do
+      11.times { |i| d.feed(event_time, { 'level' => 'info', 'i' => i }) }
+    end
+    assert_equal [10, 1], sent_batches(d).map(&:size)
+  end
+
+  test 'rejects more than 10 attributes' do
+    attributes = (0..10).to_h { |i| ["attribute#{i}", "key#{i}"] }
+    assert_raise(Fluent::ConfigError) do
+      create_driver(%(
+        queue_name audit-events
+        message_attributes_keys #{attributes.to_json}
+      ))
+    end
+  end
+
+  test 'requires the queue' do
+    assert_raise(Fluent::ConfigError) do
+      create_driver('region eu-west-1')
+    end
+  end
+end
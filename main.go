@@ -30,6 +30,7 @@ import (
 	"github.com/banzaicloud/logging-operator/pkg/sdk/api/v1beta1"
 	loggingv1beta1 "github.com/banzaicloud/logging-operator/pkg/sdk/api/v1beta1"
 	"github.com/banzaicloud/logging-operator/pkg/sdk/model/types"
+	"github.com/banzaicloud/logging-operator/pkg/webhook/podhandler"
 	prometheusOperator "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -67,6 +68,8 @@ func main() {
 	var enableprofile bool
 	var namespace string
 	var loggingRef string
+	var tailerImage string
+	var tailerUser int64
 
 	flag.StringVar(&metricsAddr, "metrics-addr", ":8080", "The address the metric endpoint binds to.")
 	flag.BoolVar(&enableLeaderElection, "enable-leader-election", false,
@@ -75,6 +78,8 @@ func main() {
 	flag.BoolVar(&enableprofile, "pprof", false, "enable pprof")
 	flag.StringVar(&namespace, "watch-namespace", "", "namespace to filter the list of watched objects")
 	flag.StringVar(&loggingRef, "watch-logging-name", "", "logging resource name to optionally filter the list of watched objects based on which logging they belong to by checking the app.kubernetes.io/managed-by label")
+	flag.StringVar(&tailerImage, "sidecar-tailer-image", podhandler.DefaultTailerImage, "image of the file tailer sidecar injected by the pod webhook")
+	flag.Int64Var(&tailerUser, "sidecar-tailer-user", podhandler.DefaultTailerUser, "user of the file tailer sidecars if neither the tailed container nor the pod sets one")
 	flag.Parse()
 
 	ctx := context.Background()
@@ -135,6 +140,7 @@ func main() {
 			setupLog.Error(err, "unable to create webhook", "webhook", "v1alpha1.logging")
 			os.Exit(1)
 		}
+		podhandler.SetupPodHandlerWithManager(mgr, tailerImage, tailerUser)
 	}
 
 	// +kubebuilder:scaffold:builder
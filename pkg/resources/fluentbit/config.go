@@ -49,6 +49,17 @@ var fluentBitConfigTemplate = `
     {{- end }}
     {{- end }}
 
+{{- range $tailer := .HostTailers }}
+
+[INPUT]
+    Name         {{ $tailer.Name }}
+    {{- range $key, $value := $tailer.Values }}
+    {{- if $value }}
+    {{ $key }}  {{$value}}
+    {{- end }}
+    {{- end }}
+{{- end }}
+
 {{- if not .DisableKubernetesFilter }}
 [FILTER]
     Name        kubernetes
@@ -59,6 +70,26 @@ var fluentBitConfigTemplate = `
     {{- end }}
 {{- end}}
 
+{{- range $tailer := .HostTailers }}
+
+[FILTER]
+    Name        record_modifier
+    Match       {{ $tailer.Tag }}
+    Record      kubernetes_host ${NODE_NAME}
+    Record      kubernetes_container_name {{ $tailer.ContainerName }}
+{{- end }}
+
+{{- if .HostTailers }}
+
+[FILTER]
+    Name          nest
+    Match         host.*
+    Operation     nest
+    Wildcard      kubernetes_*
+    Nest_under    kubernetes
+    Remove_prefix kubernetes_
+{{- end }}
+
 {{- if .AwsFilter }}
 
 [FILTER]
@@ -70,15 +101,38 @@ var fluentBitConfigTemplate = `
     {{- end }}
 {{- end}}
 
+{{- if not .DisableForward }}
+
 [OUTPUT]
     Name          forward
+    {{- if .DefaultMatchRegex }}
+    Match_Regex   {{ .DefaultMatchRegex }}
+    {{- else }}
     Match         *
+    {{- end }}
     {{- if .Upstream.Enabled }}
     Upstream upstream.conf
     {{- else }}
     Host          {{ .TargetHost }}
     Port          {{ .TargetPort }}
     {{- end }}
+    {{- template "forwardOptions" . }}
+{{- end }}
+{{- range $shard := .FluentdShards }}
+
+[OUTPUT]
+    Name          forward
+    Match_Regex   {{ $shard.MatchRegex }}
+    Host          {{ $shard.Host }}
+    Port          {{ $.TargetPort }}
+    {{- template "forwardOptions" $ }}
+{{- end }}
+{{- template "directOutputs" .DirectOutputs }}
+`
+
+// forwardOptionsTemplate holds the settings shared by the forward outputs of the main fluentd and the shards
+var forwardOptionsTemplate = `
+{{- define "forwardOptions" }}
     {{ if .TLS.Enabled }}
     tls           On
     tls.verify    Off
@@ -110,6 +164,27 @@ var fluentBitConfigTemplate = `
     {{- end }}
     {{- end }}
     {{- end }}
+{{- end }}
+`
+
+// DirectOutputsTemplate renders the outputs bypassing fluentd, shared with the node agents
+var DirectOutputsTemplate = `
+{{- define "directOutputs" }}
+{{- range $output := . }}
+
+[OUTPUT]
+    Name          {{ $output.Name }}
+    Alias         {{ $output.Alias }}
+    {{- if $output.MatchRegex }}
+    Match_Regex   {{ $output.MatchRegex }}
+    {{- else }}
+    Match         {{ $output.Match }}
+    {{- end }}
+    {{- range $param := $output.Params }}
+    {{ $param.Key }}  {{ $param.Value }}
+    {{- end }}
+{{- end }}
+{{- end }}
 `
 
 var upstreamConfigTemplate = `
@@ -16,11 +16,16 @@ package fluentbit
 
 import (
 	"bytes"
+	"context"
 	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
 	"text/template"
 
 	"emperror.dev/errors"
 	"github.com/banzaicloud/logging-operator/pkg/resources/fluentd"
+	"github.com/banzaicloud/logging-operator/pkg/sdk/api/v1beta1"
 	"github.com/banzaicloud/logging-operator/pkg/sdk/model/types"
 	"github.com/banzaicloud/operator-tools/pkg/reconciler"
 	corev1 "k8s.io/api/core/v1"
@@ -33,6 +38,18 @@ type fluentbitInputConfig struct {
 	ParserN []string
 }
 
+type hostTailerInput struct {
+	Name          string
+	Tag           string
+	ContainerName string
+	Values        map[string]string
+}
+
+type fluentdShardOutput struct {
+	MatchRegex string
+	Host       string
+}
+
 type upstreamNode struct {
 	Name string
 	Host string
@@ -63,6 +80,7 @@ type fluentBitConfig struct {
 	TargetHost              string
 	TargetPort              int32
 	Input                   fluentbitInputConfig
+	HostTailers             []hostTailerInput
 	DisableKubernetesFilter bool
 	KubernetesFilter        map[string]string
 	AwsFilter               map[string]string
@@ -77,8 +95,12 @@ type fluentBitConfig struct {
 		KeepaliveMaxRecycle     uint32
 		KeepaliveMaxRecycleSet  bool
 	}
-	ForwardOptions map[string]string
-	Upstream       struct {
+	ForwardOptions    map[string]string
+	DefaultMatchRegex string
+	FluentdShards     []fluentdShardOutput
+	DisableForward    bool
+	DirectOutputs     []DirectOutput
+	Upstream          struct {
 		Enabled bool
 		Config  upstream
 	}
@@ -163,12 +185,20 @@ func (r *Reconciler) configSecret() (runtime.Object, reconciler.DesiredState, er
 		},
 		Monitor:                 monitor,
 		TargetHost:              fmt.Sprintf("%s.%s.svc", r.Logging.QualifiedName(fluentd.ServiceName), r.Logging.Spec.ControlNamespace),
-		TargetPort:              r.Logging.Spec.FluentdSpec.Port,
 		Input:                   fluentbitInput,
 		DisableKubernetesFilter: disableKubernetesFilter,
 		KubernetesFilter:        fluentbitKubernetesFilter,
 		BufferStorage:           fluentbitBufferStorage,
 	}
+	if r.Logging.Spec.FluentdSpec != nil {
+		input.TargetPort = r.Logging.Spec.FluentdSpec.Port
+	} else if r.Logging.Spec.FluentbitSpec.TargetHost == "" {
+		// without fluentd the records are delivered by the direct outputs only
+		input.DisableForward = true
+	}
+	if r.Logging.Spec.HostTailer != nil {
+		input.HostTailers = r.generateHostTailerInputs()
+	}
 	if r.Logging.Spec.FluentbitSpec.FilterAws != nil {
 		awsFilter, err := mapper.StringsMap(r.Logging.Spec.FluentbitSpec.FilterAws)
 		if err != nil {
@@ -212,7 +242,7 @@ func (r *Reconciler) configSecret() (runtime.Object, reconciler.DesiredState, er
 		}
 	}
 
-	if r.Logging.Spec.FluentdSpec.Scaling.Replicas > 1 && r.Logging.Spec.FluentbitSpec.Network == nil {
+	if r.Logging.Spec.FluentdSpec != nil && r.Logging.Spec.FluentdSpec.Scaling.Replicas > 1 && r.Logging.Spec.FluentbitSpec.Network == nil {
 		input.Network.KeepaliveSet = true
 		input.Network.Keepalive = true
 		input.Network.KeepaliveIdleTimeoutSet = true
@@ -222,11 +252,25 @@ func (r *Reconciler) configSecret() (runtime.Object, reconciler.DesiredState, er
 		log.Log.Info("Notice: Because the Fluentd statefulset has been scaled, we've made some changes in the fluentbit network config too. We advice to revise these default configurations.")
 	}
 
-	if r.Logging.Spec.FluentbitSpec.EnableUpstream {
+	if len(r.Logging.Spec.FluentbitSpec.Outputs) > 0 {
+		directOutputs, _, err := DirectOutputs(r.Logging.Spec.FluentbitSpec.Outputs)
+		if err != nil {
+			return nil, reconciler.StatePresent, errors.WrapIf(err, "failed to map direct outputs for fluentbit")
+		}
+		input.DirectOutputs = directOutputs
+	}
+
+	r.configureForwardMatch(&input)
+
+	if r.upstreamEnabled() {
 		input.Upstream.Enabled = true
 		input.Upstream.Config.Name = "fluentd-upstream"
 
-		for i := 0; i < r.Logging.Spec.FluentdSpec.Scaling.Replicas; i++ {
+		indexes, err := fluentd.UpstreamPodIndexes(context.TODO(), r.Client, r.Logging, r.Logging.Spec.FluentbitSpec.UpstreamReadyNodesOnly)
+		if err != nil {
+			return nil, reconciler.StatePresent, errors.WrapIf(err, "failed to determine upstream nodes for fluentbit")
+		}
+		for _, i := range indexes {
 			input.Upstream.Config.Nodes = append(input.Upstream.Config.Nodes, r.generateUpstreamNode(i))
 		}
 	}
@@ -255,12 +299,63 @@ func (r *Reconciler) configSecret() (runtime.Object, reconciler.DesiredState, er
 	}, reconciler.StatePresent, nil
 }
 
+// configureForwardMatch routes the container logs of the sharded namespaces to the fluentd shards,
+// everything else goes to the main fluentd except the records delivered by the direct outputs only
+func (r *Reconciler) configureForwardMatch(input *fluentBitConfig) {
+	bypassed := BypassedTagsRegex(r.Logging.Spec.FluentbitSpec.Outputs)
+	bypassedLookahead := ""
+	if bypassed != "" {
+		bypassedLookahead = fmt.Sprintf("(?!%s)", bypassed)
+	}
+
+	tagPrefix := regexp.QuoteMeta(strings.TrimSuffix(r.Logging.Spec.FluentbitSpec.InputTail.Tag, "*"))
+	// container log file names follow the <pod>_<namespace>_<container>-<id>.log pattern
+	namespacesRegex := func(namespaces []string) string {
+		return fmt.Sprintf("%s.*_(%s)_[^_]+$", tagPrefix, strings.Join(namespaces, "|"))
+	}
+
+	var shardedNamespaces []string
+	if r.Logging.Spec.FluentdSpec != nil && len(r.shardNamespaces) > 0 {
+		for _, shard := range r.Logging.Spec.FluentdSpec.Shards {
+			namespaces := r.shardNamespaces[shard.Name]
+			if len(namespaces) == 0 {
+				continue
+			}
+			shardedNamespaces = append(shardedNamespaces, namespaces...)
+			input.FluentdShards = append(input.FluentdShards, fluentdShardOutput{
+				MatchRegex: "^" + bypassedLookahead + namespacesRegex(namespaces),
+				Host: fmt.Sprintf("%s.%s.svc",
+					fluentd.QualifiedShardName(r.Logging, shard.Name, fluentd.ServiceName),
+					r.Logging.Spec.ControlNamespace),
+			})
+		}
+	}
+	var excluded []string
+	if len(shardedNamespaces) > 0 {
+		excluded = append(excluded, namespacesRegex(shardedNamespaces))
+	}
+	if bypassed != "" {
+		excluded = append(excluded, bypassed)
+	}
+	if len(excluded) > 0 {
+		input.DefaultMatchRegex = fmt.Sprintf("^(?!%s).*$", strings.Join(excluded, "|"))
+	}
+}
+
 func generateConfig(input fluentBitConfig) (string, error) {
 	output := new(bytes.Buffer)
 	tmpl, err := template.New("test").Parse(fluentBitConfigTemplate)
 	if err != nil {
 		return "", err
 	}
+	tmpl, err = tmpl.Parse(forwardOptionsTemplate)
+	if err != nil {
+		return "", err
+	}
+	tmpl, err = tmpl.Parse(DirectOutputsTemplate)
+	if err != nil {
+		return "", err
+	}
 	err = tmpl.Execute(output, input)
 	if err != nil {
 		return "", err
@@ -282,6 +377,57 @@ func generateUpstreamConfig(input fluentBitConfig) (string, error) {
 	return output.String(), nil
 }
 
+func (r *Reconciler) generateHostTailerInputs() (inputs []hostTailerInput) {
+	for _, tailer := range r.Logging.Spec.HostTailer.FileTailers {
+		if tailer.Disabled {
+			continue
+		}
+		values := map[string]string{
+			"Path":            tailer.Path,
+			"Tag":             v1beta1.HostTailerFileTagPrefix + tailer.Name,
+			"DB":              fmt.Sprintf("/tail-db/host-file-%s.db", tailer.Name),
+			"Key":             "message",
+			"Skip_Long_Lines": "Off",
+		}
+		if tailer.SkipLongLines != nil && *tailer.SkipLongLines {
+			values["Skip_Long_Lines"] = "On"
+		}
+		if tailer.BufferMaxSize != "" {
+			values["Buffer_Max_Size"] = tailer.BufferMaxSize
+		}
+		if tailer.BufferChunkSize != "" {
+			values["Buffer_Chunk_Size"] = tailer.BufferChunkSize
+		}
+		if tailer.ReadFromHead {
+			values["Read_from_Head"] = "true"
+		}
+		inputs = append(inputs, hostTailerInput{Name: "tail", Tag: values["Tag"], ContainerName: tailer.Name, Values: values})
+	}
+	for _, tailer := range r.Logging.Spec.HostTailer.SystemdTailers {
+		if tailer.Disabled {
+			continue
+		}
+		values := map[string]string{
+			"Path":              tailer.Path,
+			"Tag":               v1beta1.HostTailerSystemdTagPrefix + tailer.Name,
+			"DB":                fmt.Sprintf("/tail-db/host-systemd-%s.db", tailer.Name),
+			"Max_Entries":       strconv.Itoa(tailer.MaxEntries),
+			"Strip_Underscores": "On",
+		}
+		if tailer.SystemdFilter != "" {
+			values["Systemd_Filter"] = fmt.Sprintf("_SYSTEMD_UNIT=%s", tailer.SystemdFilter)
+		}
+		inputs = append(inputs, hostTailerInput{Name: "systemd", Tag: values["Tag"], ContainerName: tailer.Name, Values: values})
+	}
+	return
+}
+
+// upstreamEnabled tells whether the records are balanced between the fluentd pods by an upstream config,
+// without fluentd there are no pods to list in the upstream.
+func (r *Reconciler) upstreamEnabled() bool {
+	return r.Logging.Spec.FluentbitSpec.EnableUpstream && r.Logging.Spec.FluentdSpec != nil
+}
+
 func (r *Reconciler) generateUpstreamNode(index int) upstreamNode {
 	podName := r.Logging.QualifiedName(fmt.Sprintf("%s-%d", fluentd.ComponentFluentd, index))
 	return upstreamNode{
@@ -0,0 +1,266 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluentbit
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/banzaicloud/logging-operator/pkg/sdk/api/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func testReconciler(t *testing.T, spec v1beta1.LoggingSpec, shardNamespaces map[string][]string) *Reconciler {
+	logging := &v1beta1.Logging{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec:       spec,
+	}
+	if logging.Spec.ControlNamespace == "" {
+		logging.Spec.ControlNamespace = "logging"
+	}
+	if err := logging.SetDefaults(); err != nil {
+		t.Fatal(err)
+	}
+	return &Reconciler{Logging: logging, shardNamespaces: shardNamespaces}
+}
+
+func renderConfig(t *testing.T, r *Reconciler) string {
+	obj, _, err := r.configSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(obj.(*corev1.Secret).Data[BaseConfigName])
+}
+
+func assertContains(t *testing.T, config string, sections ...string) {
+	t.Helper()
+	for _, section := range sections {
+		if !strings.Contains(config, section) {
+			t.Errorf("section not found in the config:\n%s\nconfig:\n%s", section, config)
+		}
+	}
+}
+
+func TestHostTailerMetadata(t *testing.T) {
+	r := testReconciler(t, v1beta1.LoggingSpec{
+		FluentdSpec:   &v1beta1.FluentdSpec{},
+		FluentbitSpec: &v1beta1.FluentbitSpec{},
+		HostTailer: &v1beta1.HostTailerSpec{
+			FileTailers: []v1beta1.FileTailer{
+				{Name: "audit", Path: "/var/log/audit/audit.log"},
+			},
+			SystemdTailers: []v1beta1.SystemdTailer{
+				{Name: "kubelet", SystemdFilter: "kubelet.service"},
+			},
+		},
+	}, nil)
+
+	config := renderConfig(t, r)
+	assertContains(t, config,
+		heredoc.Doc(`
+			[INPUT]
+			    Name         tail
+			    DB  /tail-db/host-file-audit.db
+			    Key  message
+			    Path  /var/log/audit/audit.log
+			    Skip_Long_Lines  On
+			    Tag  host.file.audit`),
+		heredoc.Doc(`
+			[FILTER]
+			    Name        record_modifier
+			    Match       host.file.audit
+			    Record      kubernetes_host ${NODE_NAME}
+			    Record      kubernetes_container_name audit`),
+		heredoc.Doc(`
+			[FILTER]
+			    Name        record_modifier
+			    Match       host.systemd.kubelet
+			    Record      kubernetes_host ${NODE_NAME}
+			    Record      kubernetes_container_name kubelet`),
+		heredoc.Doc(`
+			[FILTER]
+			    Name          nest
+			    Match         host.*
+			    Operation     nest
+			    Wildcard      kubernetes_*
+			    Nest_under    kubernetes
+			    Remove_prefix kubernetes_`),
+	)
+
+	env := r.envVars()
+	if len(env) != 1 || env[0].Name != "NODE_NAME" || env[0].ValueFrom.FieldRef.FieldPath != "spec.nodeName" {
+		t.Errorf("NODE_NAME env var expected, got %v", env)
+	}
+}
+
+func TestHostTailerNameValidation(t *testing.T) {
+	for name, tailers := range map[string]v1beta1.HostTailerSpec{
+		`invalid host tailer name "../etc"`: {
+			FileTailers: []v1beta1.FileTailer{{Name: "../etc", Path: "/var/log/messages"}},
+		},
+		`duplicate host tailer name "node"`: {
+			FileTailers:    []v1beta1.FileTailer{{Name: "node", Path: "/var/log/messages"}},
+			SystemdTailers: []v1beta1.SystemdTailer{{Name: "node"}},
+		},
+	} {
+		tailers := tailers
+		logging := &v1beta1.Logging{Spec: v1beta1.LoggingSpec{
+			FluentbitSpec: &v1beta1.FluentbitSpec{},
+			HostTailer:    &tailers,
+		}}
+		err := logging.SetDefaults()
+		if err == nil || !strings.HasPrefix(err.Error(), name) {
+			t.Errorf("expected %s error, got %v", name, err)
+		}
+	}
+}
+
+func TestFluentdShardsMatch(t *testing.T) {
+	r := testReconciler(t, v1beta1.LoggingSpec{
+		FluentdSpec: &v1beta1.FluentdSpec{
+			Shards: []v1beta1.FluentdShard{
+				{Name: "team-a", Namespaces: []string{"a1", "a2"}},
+				{Name: "empty"},
+				{Name: "team-b", Namespaces: []string{"b1"}},
+			},
+		},
+		FluentbitSpec: &v1beta1.FluentbitSpec{},
+	}, map[string][]string{
+		"team-a": {"a1", "a2"},
+		"empty":  {},
+		"team-b": {"b1"},
+	})
+
+	config := renderConfig(t, r)
+	assertContains(t, config,
+		heredoc.Doc(`
+			[OUTPUT]
+			    Name          forward
+			    Match_Regex   ^(?!kubernetes\..*_(a1|a2|b1)_[^_]+$).*$
+			    Host          test-fluentd.logging.svc
+			    Port          24240`),
+		heredoc.Doc(`
+			[OUTPUT]
+			    Name          forward
+			    Match_Regex   ^kubernetes\..*_(a1|a2)_[^_]+$
+			    Host          test-fluentd-team-a.logging.svc
+			    Port          24240`),
+		heredoc.Doc(`
+			[OUTPUT]
+			    Name          forward
+			    Match_Regex   ^kubernetes\..*_(b1)_[^_]+$
+			    Host          test-fluentd-team-b.logging.svc
+			    Port          24240`),
+	)
+	if strings.Contains(config, "test-fluentd-empty") {
+		t.Error("shards without namespaces should not get a forward output")
+	}
+}
+
+func TestUpstreamWithoutFluentd(t *testing.T) {
+	r := testReconciler(t, v1beta1.LoggingSpec{
+		FluentbitSpec: &v1beta1.FluentbitSpec{
+			EnableUpstream: true,
+			Outputs: []v1beta1.FluentbitOutput{
+				{Name: "direct", Match: "kubernetes.*", HTTP: &v1beta1.FluentbitHTTPOutput{Host: "collector"}},
+			},
+		},
+	}, nil)
+
+	obj, _, err := r.configSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := obj.(*corev1.Secret).Data
+	if _, ok := data[UpstreamConfigName]; ok {
+		t.Errorf("upstream config rendered without fluentd:\n%s", data[UpstreamConfigName])
+	}
+	if config := string(data[BaseConfigName]); strings.Contains(config, "Upstream") {
+		t.Errorf("upstream referenced without fluentd:\n%s", config)
+	}
+	for _, volume := range r.generateVolume() {
+		if volume.Secret == nil {
+			continue
+		}
+		for _, item := range volume.Secret.Items {
+			if item.Key == UpstreamConfigName {
+				t.Errorf("upstream config mounted without fluentd")
+			}
+		}
+	}
+}
+
+func TestForwardMatchRegex(t *testing.T) {
+	tests := []struct {
+		name    string
+		output  v1beta1.FluentbitOutput
+		fluentd string
+		teamA   string
+	}{
+		{
+			name:    "match",
+			output:  v1beta1.FluentbitOutput{Match: "kubernetes.var.log.containers.*_payments_*"},
+			fluentd: `^(?!kubernetes\..*_(a1)_[^_]+$|kubernetes\.var\.log\.containers\..*_payments_.*$).*$`,
+			teamA:   `^(?!kubernetes\.var\.log\.containers\..*_payments_.*$)kubernetes\..*_(a1)_[^_]+$`,
+		},
+		{
+			name:    "anchored regex",
+			output:  v1beta1.FluentbitOutput{MatchRegex: `^kubernetes\.var\.log\.containers\.ingress-.*`},
+			fluentd: `^(?!kubernetes\..*_(a1)_[^_]+$|.*(?:^kubernetes\.var\.log\.containers\.ingress-.*)).*$`,
+			teamA:   `^(?!.*(?:^kubernetes\.var\.log\.containers\.ingress-.*))kubernetes\..*_(a1)_[^_]+$`,
+		},
+		{
+			name:    "unanchored regex",
+			output:  v1beta1.FluentbitOutput{MatchRegex: `_ingress_`},
+			fluentd: `^(?!kubernetes\..*_(a1)_[^_]+$|.*(?:_ingress_)).*$`,
+			teamA:   `^(?!.*(?:_ingress_))kubernetes\..*_(a1)_[^_]+$`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			output := test.output
+			output.Name = "direct"
+			output.HTTP = &v1beta1.FluentbitHTTPOutput{Host: "collector"}
+			r := testReconciler(t, v1beta1.LoggingSpec{
+				FluentdSpec: &v1beta1.FluentdSpec{
+					Shards: []v1beta1.FluentdShard{
+						{Name: "team-a", Namespaces: []string{"a1"}},
+					},
+				},
+				FluentbitSpec: &v1beta1.FluentbitSpec{
+					Outputs: []v1beta1.FluentbitOutput{output},
+				},
+			}, map[string][]string{
+				"team-a": {"a1"},
+			})
+
+			config := renderConfig(t, r)
+			assertContains(t, config,
+				heredoc.Docf(`
+					[OUTPUT]
+					    Name          forward
+					    Match_Regex   %s
+					    Host          test-fluentd.logging.svc`, test.fluentd),
+				heredoc.Docf(`
+					[OUTPUT]
+					    Name          forward
+					    Match_Regex   %s
+					    Host          test-fluentd-team-a.logging.svc`, test.teamA),
+			)
+		})
+	}
+}
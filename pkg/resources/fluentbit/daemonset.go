@@ -17,8 +17,12 @@ package fluentbit
 import (
 	"crypto/sha256"
 	"fmt"
+	"path/filepath"
+	"sort"
 	"strconv"
+	"strings"
 
+	"emperror.dev/errors"
 	"github.com/banzaicloud/logging-operator/pkg/resources/templates"
 	"github.com/banzaicloud/logging-operator/pkg/sdk/api/v1beta1"
 	"github.com/banzaicloud/operator-tools/pkg/reconciler"
@@ -45,6 +49,12 @@ func (r *Reconciler) daemonSet() (runtime.Object, reconciler.DesiredState, error
 		})
 	}
 
+	// the credentials of the direct outputs are passed as environment variables
+	_, outputEnv, err := DirectOutputs(r.Logging.Spec.FluentbitSpec.Outputs)
+	if err != nil {
+		return nil, reconciler.StatePresent, errors.WrapIf(err, "failed to map direct outputs for fluentbit")
+	}
+
 	labels := util.MergeLabels(r.Logging.Spec.FluentbitSpec.Labels, r.getFluentBitLabels())
 	meta := r.FluentbitObjectMeta(fluentbitDaemonSetName)
 	podMeta := metav1.ObjectMeta{
@@ -52,6 +62,7 @@ func (r *Reconciler) daemonSet() (runtime.Object, reconciler.DesiredState, error
 		Annotations: r.Logging.Spec.FluentbitSpec.Annotations,
 	}
 
+	// the upstream config is part of the checksums as well, fluentbit reads the new node list only when restarted
 	if r.configs != nil {
 		for key, config := range r.configs {
 			h := sha256.New()
@@ -96,7 +107,7 @@ func (r *Reconciler) daemonSet() (runtime.Object, reconciler.DesiredState, error
 								Privileged:               r.Logging.Spec.FluentbitSpec.Security.SecurityContext.Privileged,
 								SELinuxOptions:           r.Logging.Spec.FluentbitSpec.Security.SecurityContext.SELinuxOptions,
 							},
-							Env:            r.Logging.Spec.FluentbitSpec.EnvVars,
+							Env:            append(r.envVars(), outputEnv...),
 							LivenessProbe:  r.Logging.Spec.FluentbitSpec.LivenessProbe,
 							ReadinessProbe: r.Logging.Spec.FluentbitSpec.ReadinessProbe,
 						},
@@ -143,13 +154,21 @@ func (r *Reconciler) generateVolumeMounts() (v []corev1.VolumeMount) {
 		})
 	}
 
+	for vCount, path := range r.hostTailerPaths() {
+		v = append(v, corev1.VolumeMount{
+			Name:      "hosttailer" + strconv.Itoa(vCount),
+			ReadOnly:  true,
+			MountPath: path,
+		})
+	}
+
 	if r.Logging.Spec.FluentbitSpec.CustomConfigSecret == "" {
 		v = append(v, corev1.VolumeMount{
 			Name:      "config",
 			MountPath: "/fluent-bit/etc/fluent-bit.conf",
 			SubPath:   BaseConfigName,
 		})
-		if r.Logging.Spec.FluentbitSpec.EnableUpstream {
+		if r.upstreamEnabled() {
 			v = append(v, corev1.VolumeMount{
 				Name:      "config",
 				MountPath: "/fluent-bit/etc/upstream.conf",
@@ -205,6 +224,16 @@ func (r *Reconciler) generateVolume() (v []corev1.Volume) {
 			}})
 	}
 
+	for vCount, path := range r.hostTailerPaths() {
+		v = append(v, corev1.Volume{
+			Name: "hosttailer" + strconv.Itoa(vCount),
+			VolumeSource: corev1.VolumeSource{
+				HostPath: &corev1.HostPathVolumeSource{
+					Path: path,
+				},
+			}})
+	}
+
 	if r.Logging.Spec.FluentbitSpec.CustomConfigSecret == "" {
 		volume := corev1.Volume{
 			Name: "config",
@@ -220,7 +249,7 @@ func (r *Reconciler) generateVolume() (v []corev1.Volume) {
 				},
 			},
 		}
-		if r.Logging.Spec.FluentbitSpec.EnableUpstream {
+		if r.upstreamEnabled() {
 			volume.VolumeSource.Secret.Items = append(volume.VolumeSource.Secret.Items, corev1.KeyToPath{
 				Key:  UpstreamConfigName,
 				Path: UpstreamConfigName,
@@ -250,3 +279,48 @@ func (r *Reconciler) generateVolume() (v []corev1.Volume) {
 	}
 	return
 }
+
+// envVars returns the configured environment variables and the node name used as the host of the host tailer records
+func (r *Reconciler) envVars() []corev1.EnvVar {
+	env := append([]corev1.EnvVar(nil), r.Logging.Spec.FluentbitSpec.EnvVars...)
+	if r.Logging.Spec.HostTailer != nil {
+		env = append(env, corev1.EnvVar{
+			Name: "NODE_NAME",
+			ValueFrom: &corev1.EnvVarSource{
+				FieldRef: &corev1.ObjectFieldSelector{
+					FieldPath: "spec.nodeName",
+				},
+			},
+		})
+	}
+	return env
+}
+
+// hostTailerPaths returns the host directories the host tailers need besides the already mounted /var/log
+func (r *Reconciler) hostTailerPaths() []string {
+	if r.Logging.Spec.HostTailer == nil {
+		return nil
+	}
+	var dirs []string
+	for _, tailer := range r.Logging.Spec.HostTailer.FileTailers {
+		if !tailer.Disabled {
+			dirs = append(dirs, filepath.Dir(tailer.Path))
+		}
+	}
+	for _, tailer := range r.Logging.Spec.HostTailer.SystemdTailers {
+		if !tailer.Disabled {
+			dirs = append(dirs, filepath.Clean(tailer.Path))
+		}
+	}
+	paths := make([]string, 0, len(dirs))
+	seen := make(map[string]bool)
+	for _, dir := range dirs {
+		if dir == "/var/log" || strings.HasPrefix(dir, "/var/log/") || seen[dir] {
+			continue
+		}
+		seen[dir] = true
+		paths = append(paths, dir)
+	}
+	sort.Strings(paths)
+	return paths
+}
@@ -67,14 +67,16 @@ type DesiredObject struct {
 type Reconciler struct {
 	Logging *v1beta1.Logging
 	*reconciler.GenericResourceReconciler
-	configs map[string][]byte
+	configs         map[string][]byte
+	shardNamespaces map[string][]string
 }
 
 // NewReconciler creates a new Fluentbit reconciler
-func New(client client.Client, logger logr.Logger, logging *v1beta1.Logging, opts reconciler.ReconcilerOpts) *Reconciler {
+func New(client client.Client, logger logr.Logger, logging *v1beta1.Logging, shardNamespaces map[string][]string, opts reconciler.ReconcilerOpts) *Reconciler {
 	return &Reconciler{
 		Logging:                   logging,
 		GenericResourceReconciler: reconciler.NewGenericReconciler(client, logger, opts),
+		shardNamespaces:           shardNamespaces,
 	}
 }
 
@@ -0,0 +1,240 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluentbit
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/banzaicloud/logging-operator/pkg/sdk/api/v1beta1"
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/types"
+	"github.com/banzaicloud/operator-tools/pkg/secret"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// DirectOutput is an [OUTPUT] section of fluentbit delivering the records without fluentd
+type DirectOutput struct {
+	Name       string
+	Alias      string
+	Match      string
+	MatchRegex string
+	Params     []DirectOutputParam
+}
+
+// DirectOutputParam is a parameter of a direct output, keys like Header can be repeated
+type DirectOutputParam struct {
+	Key   string
+	Value string
+}
+
+// outputEnv collects the environment variables of the fluentbit container holding the credentials of the direct outputs
+type outputEnv struct {
+	env []corev1.EnvVar
+}
+
+// Load renders the secrets referenced by valueFrom as environment variables expanded by fluentbit
+func (e *outputEnv) Load(s *secret.Secret) (string, error) {
+	if s.Value != "" {
+		return s.Value, nil
+	}
+	if s.ValueFrom == nil || s.ValueFrom.SecretKeyRef == nil {
+		return "", errors.New("the secrets of the fluentbit outputs have to be set by value or valueFrom")
+	}
+	name := secretEnvName(s.ValueFrom.SecretKeyRef)
+	if err := e.add(corev1.EnvVar{Name: name, ValueFrom: &corev1.EnvVarSource{SecretKeyRef: s.ValueFrom.SecretKeyRef}}); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("${%s}", name), nil
+}
+
+// set adds a fixed environment variable, e.g. the AWS credentials of the S3 outputs
+func (e *outputEnv) set(name string, s *secret.Secret) error {
+	if s.Value != "" {
+		return e.add(corev1.EnvVar{Name: name, Value: s.Value})
+	}
+	if s.ValueFrom == nil || s.ValueFrom.SecretKeyRef == nil {
+		return errors.Errorf("%s of the fluentbit outputs has to be set by value or valueFrom", name)
+	}
+	return e.add(corev1.EnvVar{Name: name, ValueFrom: &corev1.EnvVarSource{SecretKeyRef: s.ValueFrom.SecretKeyRef}})
+}
+
+func (e *outputEnv) add(env corev1.EnvVar) error {
+	for _, existing := range e.env {
+		if existing.Name != env.Name {
+			continue
+		}
+		if !reflect.DeepEqual(existing, env) {
+			return errors.Errorf("the fluentbit outputs set %s differently", env.Name)
+		}
+		return nil
+	}
+	e.env = append(e.env, env)
+	return nil
+}
+
+var envNameInvalidChars = regexp.MustCompile(`[^A-Z0-9_]`)
+
+// secretEnvName returns the name of the environment variable of a secret key, e.g. FLUENTBIT_SECRET_ES_CREDENTIALS_PASSWORD
+func secretEnvName(ref *corev1.SecretKeySelector) string {
+	return "FLUENTBIT_SECRET_" + envNameInvalidChars.ReplaceAllString(strings.ToUpper(ref.Name+"_"+ref.Key), "_")
+}
+
+// DirectOutputs maps the typed outputs to fluentbit output sections and returns the environment variables of their credentials
+func DirectOutputs(outputs []v1beta1.FluentbitOutput) ([]DirectOutput, []corev1.EnvVar, error) {
+	env := &outputEnv{}
+	mapper := types.NewStructToStringMapper(env)
+	names := map[string]bool{}
+	var directOutputs []DirectOutput
+	for _, output := range outputs {
+		if output.Name == "" {
+			return nil, nil, errors.New("fluentbit output without name")
+		}
+		if names[output.Name] {
+			return nil, nil, errors.Errorf("duplicate fluentbit output %q", output.Name)
+		}
+		names[output.Name] = true
+		if output.Match != "" && output.MatchRegex != "" {
+			return nil, nil, errors.Errorf("match and matchRegex of the fluentbit output %q cannot be set simultaneously", output.Name)
+		}
+		if output.MatchRegex != "" {
+			if _, err := regexp.Compile(output.MatchRegex); err != nil {
+				return nil, nil, errors.WrapIff(err, "invalid matchRegex of the fluentbit output %q", output.Name)
+			}
+		}
+
+		var plugins []string
+		params := map[string]string{}
+		var repeated []DirectOutputParam
+		add := func(values map[string]string) {
+			for k, v := range values {
+				if v != "" {
+					params[k] = v
+				}
+			}
+		}
+		mapOutput := func(plugin string, config interface{}, tls *v1beta1.FluentbitOutputTLS) error {
+			plugins = append(plugins, plugin)
+			values, err := mapper.StringsMap(config)
+			if err != nil {
+				return errors.WrapIff(err, "failed to map the fluentbit output %q", output.Name)
+			}
+			add(values)
+			if tls != nil {
+				values, err := mapper.StringsMap(tls)
+				if err != nil {
+					return errors.WrapIff(err, "failed to map the tls settings of the fluentbit output %q", output.Name)
+				}
+				add(values)
+			}
+			return nil
+		}
+
+		var err error
+		if output.Elasticsearch != nil {
+			err = errors.Append(err, mapOutput("es", output.Elasticsearch, &output.Elasticsearch.FluentbitOutputTLS))
+		}
+		if output.Loki != nil {
+			err = errors.Append(err, mapOutput("loki", output.Loki, &output.Loki.FluentbitOutputTLS))
+		}
+		if output.Kafka != nil {
+			err = errors.Append(err, mapOutput("kafka", output.Kafka, nil))
+			delete(params, "rdkafka")
+			for k, v := range output.Kafka.Rdkafka {
+				params["rdkafka."+k] = v
+			}
+		}
+		if output.S3 != nil {
+			// the AWS credentials are read from the environment by the S3 plugin
+			s3 := *output.S3
+			s3.AccessKeyID, s3.SecretAccessKey = nil, nil
+			err = errors.Append(err, mapOutput("s3", &s3, nil))
+			if output.S3.AccessKeyID != nil {
+				err = errors.Append(err, env.set("AWS_ACCESS_KEY_ID", output.S3.AccessKeyID))
+			}
+			if output.S3.SecretAccessKey != nil {
+				err = errors.Append(err, env.set("AWS_SECRET_ACCESS_KEY", output.S3.SecretAccessKey))
+			}
+		}
+		if output.HTTP != nil {
+			err = errors.Append(err, mapOutput("http", output.HTTP, &output.HTTP.FluentbitOutputTLS))
+			delete(params, "headers")
+			for _, k := range sortedKeys(output.HTTP.Headers) {
+				repeated = append(repeated, DirectOutputParam{Key: "Header", Value: fmt.Sprintf("%s %s", k, output.HTTP.Headers[k])})
+			}
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		switch len(plugins) {
+		case 0:
+			return nil, nil, errors.Errorf("no output type configured for the fluentbit output %q", output.Name)
+		case 1:
+			// OK
+		default:
+			return nil, nil, errors.Errorf("multiple output types configured for the fluentbit output %q: %s", output.Name, plugins)
+		}
+		add(output.Options)
+
+		directOutput := DirectOutput{
+			Name:       plugins[0],
+			Alias:      output.Name,
+			Match:      output.Match,
+			MatchRegex: output.MatchRegex,
+		}
+		if directOutput.Match == "" && directOutput.MatchRegex == "" {
+			directOutput.Match = "*"
+		}
+		for _, k := range sortedKeys(params) {
+			directOutput.Params = append(directOutput.Params, DirectOutputParam{Key: k, Value: params[k]})
+		}
+		directOutput.Params = append(directOutput.Params, repeated...)
+		directOutputs = append(directOutputs, directOutput)
+	}
+	return directOutputs, env.env, nil
+}
+
+// BypassedTagsRegex returns the regular expression of the tags delivered by the direct outputs only,
+// these are left out of the forward outputs
+func BypassedTagsRegex(outputs []v1beta1.FluentbitOutput) string {
+	var regexes []string
+	for _, output := range outputs {
+		if output.ForwardToAggregator {
+			continue
+		}
+		switch {
+		case output.MatchRegex != "":
+			// the regex is searched anywhere in the tag, anchors and alternations keep their meaning in the group
+			regexes = append(regexes, ".*(?:"+output.MatchRegex+")")
+		case output.Match != "":
+			regexes = append(regexes, strings.ReplaceAll(regexp.QuoteMeta(output.Match), `\*`, ".*")+"$")
+		default:
+			regexes = append(regexes, ".*")
+		}
+	}
+	return strings.Join(regexes, "|")
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,298 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluentbit
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/banzaicloud/logging-operator/pkg/sdk/api/v1beta1"
+	"github.com/banzaicloud/operator-tools/pkg/secret"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func secretKeyRef(name, key string) *corev1.SecretKeySelector {
+	return &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: name}, Key: key}
+}
+
+func valueFrom(name, key string) *secret.Secret {
+	return &secret.Secret{ValueFrom: &secret.ValueFrom{SecretKeyRef: secretKeyRef(name, key)}}
+}
+
+func TestDirectOutputs(t *testing.T) {
+	r := testReconciler(t, v1beta1.LoggingSpec{
+		FluentdSpec: &v1beta1.FluentdSpec{},
+		FluentbitSpec: &v1beta1.FluentbitSpec{
+			Outputs: []v1beta1.FluentbitOutput{
+				{
+					Name:  "payments-es",
+					Match: "kubernetes.var.log.containers.*_payments_*",
+					Elasticsearch: &v1beta1.FluentbitElasticsearchOutput{
+						Host:       "elasticsearch.payments.svc",
+						HTTPUser:   "fluentbit",
+						HTTPPasswd: valueFrom("payments-es", "password"),
+						FluentbitOutputTLS: v1beta1.FluentbitOutputTLS{
+							TLS: "On",
+						},
+					},
+					Options: map[string]string{"Retry_Limit": "False"},
+				},
+				{
+					Name:                "ingress-loki",
+					MatchRegex:          `^kubernetes\.var\.log\.containers\..*_ingress-nginx_.*$`,
+					ForwardToAggregator: true,
+					Loki: &v1beta1.FluentbitLokiOutput{
+						Host:       "loki.loki.svc",
+						HTTPPasswd: &secret.Secret{Value: "plain"},
+					},
+				},
+				{
+					Name: "audit-http",
+					HTTP: &v1beta1.FluentbitHTTPOutput{
+						Host:    "audit.svc",
+						Headers: map[string]string{"X-Tenant": "audit", "X-Cluster": "production"},
+					},
+				},
+				{
+					Name: "kafka",
+					Kafka: &v1beta1.FluentbitKafkaOutput{
+						Brokers: "kafka:9092",
+						Rdkafka: map[string]string{"security.protocol": "SASL_SSL"},
+					},
+				},
+				{
+					Name: "archive",
+					S3: &v1beta1.FluentbitS3Output{
+						Bucket:          "logging-archive",
+						AccessKeyID:     valueFrom("logging-archive-s3", "awsAccessKeyId"),
+						SecretAccessKey: valueFrom("logging-archive-s3", "awsSecretAccessKey"),
+					},
+				},
+			},
+		},
+	}, nil)
+
+	config := renderConfig(t, r)
+	assertContains(t, config,
+		heredoc.Doc(`
+			[OUTPUT]
+			    Name          es
+			    Alias         payments-es
+			    Match         kubernetes.var.log.containers.*_payments_*
+			    HTTP_Passwd  ${FLUENTBIT_SECRET_PAYMENTS_ES_PASSWORD}
+			    HTTP_User  fluentbit
+			    Host  elasticsearch.payments.svc
+			    Retry_Limit  False
+			    tls  On`),
+		heredoc.Doc(`
+			[OUTPUT]
+			    Name          loki
+			    Alias         ingress-loki
+			    Match_Regex   ^kubernetes\.var\.log\.containers\..*_ingress-nginx_.*$
+			    host  loki.loki.svc
+			    http_passwd  plain`),
+		heredoc.Doc(`
+			[OUTPUT]
+			    Name          http
+			    Alias         audit-http
+			    Match         *
+			    Host  audit.svc
+			    Header  X-Cluster production
+			    Header  X-Tenant audit`),
+		heredoc.Doc(`
+			[OUTPUT]
+			    Name          kafka
+			    Alias         kafka
+			    Match         *
+			    Brokers  kafka:9092
+			    rdkafka.security.protocol  SASL_SSL`),
+		heredoc.Doc(`
+			[OUTPUT]
+			    Name          s3
+			    Alias         archive
+			    Match         *
+			    bucket  logging-archive`),
+	)
+	if strings.Contains(config, "aws_key_id") || strings.Contains(config, "aws_sec_key") {
+		t.Errorf("the aws credentials should be passed as environment variables:\n%s", config)
+	}
+
+	obj, _, err := r.daemonSet()
+	if err != nil {
+		t.Fatal(err)
+	}
+	env := obj.(*appsv1.DaemonSet).Spec.Template.Spec.Containers[0].Env
+	expected := []corev1.EnvVar{
+		{Name: "FLUENTBIT_SECRET_PAYMENTS_ES_PASSWORD", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: secretKeyRef("payments-es", "password")}},
+		{Name: "AWS_ACCESS_KEY_ID", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: secretKeyRef("logging-archive-s3", "awsAccessKeyId")}},
+		{Name: "AWS_SECRET_ACCESS_KEY", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: secretKeyRef("logging-archive-s3", "awsSecretAccessKey")}},
+	}
+	if !reflect.DeepEqual(env, expected) {
+		t.Errorf("expected env %v, got %v", expected, env)
+	}
+}
+
+func TestDirectOutputsValidation(t *testing.T) {
+	es := &v1beta1.FluentbitElasticsearchOutput{Host: "es"}
+	tests := []struct {
+		name    string
+		outputs []v1beta1.FluentbitOutput
+		err     string
+	}{
+		{
+			name:    "without name",
+			outputs: []v1beta1.FluentbitOutput{{Elasticsearch: es}},
+			err:     "fluentbit output without name",
+		},
+		{
+			name:    "duplicate",
+			outputs: []v1beta1.FluentbitOutput{{Name: "es", Elasticsearch: es}, {Name: "es", Elasticsearch: es}},
+			err:     `duplicate fluentbit output "es"`,
+		},
+		{
+			name:    "match and matchRegex",
+			outputs: []v1beta1.FluentbitOutput{{Name: "es", Match: "*", MatchRegex: ".*", Elasticsearch: es}},
+			err:     `match and matchRegex of the fluentbit output "es" cannot be set simultaneously`,
+		},
+		{
+			name:    "without type",
+			outputs: []v1beta1.FluentbitOutput{{Name: "es"}},
+			err:     `no output type configured for the fluentbit output "es"`,
+		},
+		{
+			name:    "multiple types",
+			outputs: []v1beta1.FluentbitOutput{{Name: "es", Elasticsearch: es, Loki: &v1beta1.FluentbitLokiOutput{}}},
+			err:     `multiple output types configured for the fluentbit output "es": [es loki]`,
+		},
+		{
+			name: "mounted secret",
+			outputs: []v1beta1.FluentbitOutput{{Name: "es", Elasticsearch: &v1beta1.FluentbitElasticsearchOutput{
+				CloudAuth: &secret.Secret{MountFrom: &secret.ValueFrom{SecretKeyRef: secretKeyRef("es", "auth")}},
+			}}},
+			err: "the secrets of the fluentbit outputs have to be set by value or valueFrom",
+		},
+		{
+			name: "differing s3 credentials",
+			outputs: []v1beta1.FluentbitOutput{
+				{Name: "a", S3: &v1beta1.FluentbitS3Output{Bucket: "a", AccessKeyID: valueFrom("a", "id")}},
+				{Name: "b", S3: &v1beta1.FluentbitS3Output{Bucket: "b", AccessKeyID: valueFrom("b", "id")}},
+			},
+			err: "the fluentbit outputs set AWS_ACCESS_KEY_ID differently",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, _, err := DirectOutputs(test.outputs)
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("expected error %q, got %v", test.err, err)
+			}
+		})
+	}
+}
+
+func TestBypassedTagsRegex(t *testing.T) {
+	tests := []struct {
+		name     string
+		outputs  []v1beta1.FluentbitOutput
+		expected string
+	}{
+		{
+			name:     "match",
+			outputs:  []v1beta1.FluentbitOutput{{Match: "kubernetes.var.log.containers.*_payments_*"}},
+			expected: `kubernetes\.var\.log\.containers\..*_payments_.*$`,
+		},
+		{
+			name:     "anchored regex",
+			outputs:  []v1beta1.FluentbitOutput{{MatchRegex: `^kubernetes\..*_audit_.*$`}},
+			expected: `.*(?:^kubernetes\..*_audit_.*$)`,
+		},
+		{
+			name:     "anchored regex alternation",
+			outputs:  []v1beta1.FluentbitOutput{{MatchRegex: `^host\.|_audit_`}},
+			expected: `.*(?:^host\.|_audit_)`,
+		},
+		{
+			name:     "unanchored regex",
+			outputs:  []v1beta1.FluentbitOutput{{MatchRegex: `_audit_`}},
+			expected: `.*(?:_audit_)`,
+		},
+		{
+			name:     "match all",
+			outputs:  []v1beta1.FluentbitOutput{{}},
+			expected: `.*`,
+		},
+		{
+			name: "forwarded to the aggregator",
+			outputs: []v1beta1.FluentbitOutput{
+				{Match: "kubernetes.*", ForwardToAggregator: true},
+				{Match: "host.*"},
+			},
+			expected: `host\..*$`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if actual := BypassedTagsRegex(test.outputs); actual != test.expected {
+				t.Errorf("expected %s, got %s", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestFluentdShardsMatchBypassed(t *testing.T) {
+	r := testReconciler(t, v1beta1.LoggingSpec{
+		FluentdSpec: &v1beta1.FluentdSpec{
+			Shards: []v1beta1.FluentdShard{
+				{Name: "team-a", Namespaces: []string{"a1"}},
+			},
+		},
+		FluentbitSpec: &v1beta1.FluentbitSpec{
+			Outputs: []v1beta1.FluentbitOutput{
+				{
+					Name:          "payments-es",
+					Match:         "kubernetes.var.log.containers.*_payments_*",
+					Elasticsearch: &v1beta1.FluentbitElasticsearchOutput{Host: "es"},
+				},
+				{
+					Name:                "ingress-loki",
+					MatchRegex:          `_ingress_`,
+					ForwardToAggregator: true,
+					Loki:                &v1beta1.FluentbitLokiOutput{Host: "loki"},
+				},
+			},
+		},
+	}, map[string][]string{
+		"team-a": {"a1"},
+	})
+
+	config := renderConfig(t, r)
+	assertContains(t, config,
+		heredoc.Doc(`
+			[OUTPUT]
+			    Name          forward
+			    Match_Regex   ^(?!kubernetes\..*_(a1)_[^_]+$|kubernetes\.var\.log\.containers\..*_payments_.*$).*$
+			    Host          test-fluentd.logging.svc
+			    Port          24240`),
+		heredoc.Doc(`
+			[OUTPUT]
+			    Name          forward
+			    Match_Regex   ^(?!kubernetes\.var\.log\.containers\..*_payments_.*$)kubernetes\..*_(a1)_[^_]+$
+			    Host          test-fluentd-team-a.logging.svc
+			    Port          24240`),
+	)
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"hash/fnv"
+	"strings"
 
 	"emperror.dev/errors"
 	"github.com/banzaicloud/operator-tools/pkg/reconciler"
@@ -52,6 +53,25 @@ func (r *Reconciler) configHash() (string, error) {
 	return fmt.Sprintf("%x", hasher.Sum32()), nil
 }
 
+// configCheckKey returns the key of the config check result in the logging status.
+// Results of the shards are prefixed with the name of the shard.
+func (r *Reconciler) configCheckKey(hash string) string {
+	if r.shard == "" {
+		return hash
+	}
+	return fmt.Sprintf("%s-%s", r.shard, hash)
+}
+
+// configCheckHash returns the config hash of a config check result key that belongs to the shard of the reconciler
+func (r *Reconciler) configCheckHash(key string) (string, bool) {
+	shard := ""
+	hash := key
+	if i := strings.LastIndex(key, "-"); i != -1 {
+		shard, hash = key[:i], key[i+1:]
+	}
+	return hash, shard == r.shard
+}
+
 func (r *Reconciler) configCheck() (*ConfigCheckResult, error) {
 	hashKey, err := r.configHash()
 	if err != nil {
@@ -140,8 +160,9 @@ func (r *Reconciler) configCheck() (*ConfigCheckResult, error) {
 }
 
 func (r *Reconciler) configCheckCleanup(currentHash string) (removedHashes []string, multierr error) {
-	for configHash := range r.Logging.Status.ConfigCheckResults {
-		if configHash == currentHash {
+	for key := range r.Logging.Status.ConfigCheckResults {
+		configHash, owned := r.configCheckHash(key)
+		if !owned || configHash == currentHash {
 			continue
 		}
 		newSecret, err := r.newCheckSecret(configHash)
@@ -228,7 +249,7 @@ func (r *Reconciler) newCheckPod(hashKey string) *corev1.Pod {
 					Name: "config",
 					VolumeSource: corev1.VolumeSource{
 						Secret: &corev1.SecretVolumeSource{
-							SecretName: r.Logging.QualifiedName(fmt.Sprintf("fluentd-configcheck-%s", hashKey)),
+							SecretName: r.qualifiedName(fmt.Sprintf("fluentd-configcheck-%s", hashKey)),
 						},
 					},
 				},
@@ -236,7 +257,7 @@ func (r *Reconciler) newCheckPod(hashKey string) *corev1.Pod {
 					Name: "output-secret",
 					VolumeSource: corev1.VolumeSource{
 						Secret: &corev1.SecretVolumeSource{
-							SecretName: r.Logging.QualifiedName(fmt.Sprintf("fluentd-configcheck-output-%s", hashKey)),
+							SecretName: r.qualifiedName(fmt.Sprintf("fluentd-configcheck-output-%s", hashKey)),
 						},
 					},
 				},
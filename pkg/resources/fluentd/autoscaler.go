@@ -0,0 +1,77 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluentd
+
+import (
+	"github.com/banzaicloud/operator-tools/pkg/reconciler"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	bufferQueueLengthMetric = "fluentd_output_status_buffer_queue_length"
+	// derived by the metrics adapter from the filesystem metrics of the buffer metrics sidecar
+	bufferVolumeUsagePercentMetric = "fluentd_buffer_volume_usage_percent"
+)
+
+func (r *Reconciler) horizontalPodAutoscaler() (runtime.Object, reconciler.DesiredState, error) {
+	meta := r.FluentdObjectMeta(StatefulSetName, ComponentFluentd)
+	autoscaling := r.Logging.Spec.FluentdSpec.Scaling.Autoscaling
+	if autoscaling == nil {
+		return &autoscalingv2beta2.HorizontalPodAutoscaler{
+			ObjectMeta: meta,
+		}, reconciler.StateAbsent, nil
+	}
+
+	var metrics []autoscalingv2beta2.MetricSpec
+	if autoscaling.TargetBufferQueueLength != nil {
+		metrics = append(metrics, podsMetric(bufferQueueLengthMetric, *autoscaling.TargetBufferQueueLength))
+	}
+	if autoscaling.TargetBufferVolumeUsagePercent != nil {
+		metrics = append(metrics, podsMetric(bufferVolumeUsagePercentMetric, *autoscaling.TargetBufferVolumeUsagePercent))
+	}
+	metrics = append(metrics, autoscaling.Metrics...)
+
+	return &autoscalingv2beta2.HorizontalPodAutoscaler{
+		ObjectMeta: meta,
+		Spec: autoscalingv2beta2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2beta2.CrossVersionObjectReference{
+				APIVersion: "apps/v1",
+				Kind:       "StatefulSet",
+				Name:       r.qualifiedName(StatefulSetName),
+			},
+			MinReplicas: autoscaling.MinReplicas,
+			MaxReplicas: autoscaling.MaxReplicas,
+			Metrics:     metrics,
+			Behavior:    autoscaling.Behavior,
+		},
+	}, reconciler.StatePresent, nil
+}
+
+func podsMetric(name string, target resource.Quantity) autoscalingv2beta2.MetricSpec {
+	return autoscalingv2beta2.MetricSpec{
+		Type: autoscalingv2beta2.PodsMetricSourceType,
+		Pods: &autoscalingv2beta2.PodsMetricSource{
+			Metric: autoscalingv2beta2.MetricIdentifier{
+				Name: name,
+			},
+			Target: autoscalingv2beta2.MetricTarget{
+				Type:         autoscalingv2beta2.AverageValueMetricType,
+				AverageValue: &target,
+			},
+		},
+	}
+}
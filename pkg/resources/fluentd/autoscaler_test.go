@@ -0,0 +1,136 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluentd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/banzaicloud/logging-operator/pkg/sdk/api/v1beta1"
+	"github.com/banzaicloud/operator-tools/pkg/reconciler"
+	util "github.com/banzaicloud/operator-tools/pkg/utils"
+	"github.com/go-logr/logr"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func quantity(value string) *resource.Quantity {
+	q := resource.MustParse(value)
+	return &q
+}
+
+func TestHorizontalPodAutoscaler(t *testing.T) {
+	cpuMetric := autoscalingv2beta2.MetricSpec{
+		Type: autoscalingv2beta2.ResourceMetricSourceType,
+		Resource: &autoscalingv2beta2.ResourceMetricSource{
+			Name: "cpu",
+			Target: autoscalingv2beta2.MetricTarget{
+				Type:               autoscalingv2beta2.UtilizationMetricType,
+				AverageUtilization: util.IntPointer(80),
+			},
+		},
+	}
+	logging := testLogging(t, &v1beta1.FluentdSpec{
+		BufferVolumeMetrics: &v1beta1.Metrics{},
+		Scaling: &v1beta1.FluentdScaling{
+			Replicas: 2,
+			Autoscaling: &v1beta1.FluentdAutoscaling{
+				MaxReplicas:                    5,
+				TargetBufferQueueLength:        quantity("20"),
+				TargetBufferVolumeUsagePercent: quantity("80"),
+				Metrics:                        []autoscalingv2beta2.MetricSpec{cpuMetric},
+			},
+		},
+		Shards: []v1beta1.FluentdShard{{Name: "team-a"}},
+	})
+
+	r := NewShard(nil, logr.Discard(), logging, "team-a", nil, nil, nil, reconciler.ReconcilerOpts{})
+	o, state, err := r.horizontalPodAutoscaler()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state != reconciler.StatePresent {
+		t.Errorf("expected present state, got %v", state)
+	}
+
+	expected := autoscalingv2beta2.HorizontalPodAutoscalerSpec{
+		ScaleTargetRef: autoscalingv2beta2.CrossVersionObjectReference{
+			APIVersion: "apps/v1",
+			Kind:       "StatefulSet",
+			Name:       "test-fluentd-team-a",
+		},
+		MinReplicas: util.IntPointer(2),
+		MaxReplicas: 5,
+		Metrics: []autoscalingv2beta2.MetricSpec{
+			podsMetric("fluentd_output_status_buffer_queue_length", resource.MustParse("20")),
+			podsMetric("fluentd_buffer_volume_usage_percent", resource.MustParse("80")),
+			cpuMetric,
+		},
+	}
+	hpa := o.(*autoscalingv2beta2.HorizontalPodAutoscaler)
+	if !reflect.DeepEqual(hpa.Spec, expected) {
+		t.Errorf("expected %+v, got %+v", expected, hpa.Spec)
+	}
+	if hpa.Name != "test-fluentd-team-a" || hpa.Namespace != "logging" {
+		t.Errorf("unexpected autoscaler name %s/%s", hpa.Namespace, hpa.Name)
+	}
+}
+
+func TestHorizontalPodAutoscalerDefaults(t *testing.T) {
+	logging := testLogging(t, &v1beta1.FluentdSpec{
+		Scaling: &v1beta1.FluentdScaling{
+			Autoscaling: &v1beta1.FluentdAutoscaling{MaxReplicas: 3},
+		},
+	})
+	o, _, err := New(nil, logr.Discard(), logging, nil, nil, reconciler.ReconcilerOpts{}).horizontalPodAutoscaler()
+	if err != nil {
+		t.Fatal(err)
+	}
+	spec := o.(*autoscalingv2beta2.HorizontalPodAutoscaler).Spec
+	if *spec.MinReplicas != 1 {
+		t.Errorf("minReplicas should default to the replicas, got %d", *spec.MinReplicas)
+	}
+	expected := []autoscalingv2beta2.MetricSpec{
+		podsMetric("fluentd_output_status_buffer_queue_length", resource.MustParse("10")),
+	}
+	if !reflect.DeepEqual(spec.Metrics, expected) {
+		t.Errorf("expected the default buffer queue length target, got %+v", spec.Metrics)
+	}
+
+	logging = testLogging(t, &v1beta1.FluentdSpec{})
+	_, state, err := New(nil, logr.Discard(), logging, nil, nil, reconciler.ReconcilerOpts{}).horizontalPodAutoscaler()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state != reconciler.StateAbsent {
+		t.Errorf("the autoscaler should be removed without autoscaling, got %v", state)
+	}
+}
+
+func TestAutoscalingValidation(t *testing.T) {
+	for expected, scaling := range map[string]*v1beta1.FluentdScaling{
+		"`fluentd.scaling.autoscaling.maxReplicas` must not be less than `minReplicas`": {
+			Autoscaling: &v1beta1.FluentdAutoscaling{MinReplicas: util.IntPointer(3), MaxReplicas: 2},
+		},
+		"`fluentd.scaling.autoscaling.targetBufferVolumeUsagePercent` requires `fluentd.bufferVolumeMetrics`": {
+			Autoscaling: &v1beta1.FluentdAutoscaling{MaxReplicas: 2, TargetBufferVolumeUsagePercent: quantity("80")},
+		},
+	} {
+		logging := &v1beta1.Logging{Spec: v1beta1.LoggingSpec{FluentdSpec: &v1beta1.FluentdSpec{Scaling: scaling}}}
+		if err := logging.SetDefaults(); err == nil || err.Error() != expected {
+			t.Errorf("expected error %q, got %v", expected, err)
+		}
+	}
+}
@@ -18,4 +18,9 @@ const (
 	ComponentFluentd     = "fluentd"
 	ComponentConfigCheck = "fluentd-configcheck"
 	ComponentDrainer     = "fluentd-drainer"
+
+	// ShardLabel marks the resources of a fluentd shard
+	ShardLabel = "logging.banzaicloud.io/shard"
+	// SyslogInputLabel marks the service of a syslog input
+	SyslogInputLabel = "logging.banzaicloud.io/syslog-input"
 )
@@ -61,14 +61,16 @@ var fluentdInputTemplate = `
 </source>
 {{ end }}
 `
-var fluentdOutputTemplate = `
+var fluentdErrorTemplate = `
 <label @ERROR>
 <match **>
     @type null
     @id main-fluentd-error
 </match>
 </label>
+`
 
+var fluentdOutputTemplate = `
 <match **>
     @type null
     @id main-no-output
@@ -18,8 +18,10 @@ import (
 	"bytes"
 	"fmt"
 	"html/template"
+	"strings"
 
 	"emperror.dev/errors"
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/types"
 	"github.com/banzaicloud/operator-tools/pkg/reconciler"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -79,14 +81,26 @@ func (r *Reconciler) generateConfigSecret() (map[string][]byte, error) {
 		return nil, err
 	}
 
+	// the error label is rendered into the generated config when the flow of the error output is registered,
+	// otherwise the records emitted to it are discarded
+	outputConfig := fluentdOutputTemplate
+	if !r.errorLabelConfigured() {
+		outputConfig = fluentdErrorTemplate + outputConfig
+	}
+
 	configs := map[string][]byte{
 		"fluent.conf":  []byte(fluentdDefaultTemplate),
 		"input.conf":   []byte(inputConfig),
-		"devnull.conf": []byte(fluentdOutputTemplate),
+		"devnull.conf": []byte(outputConfig),
 	}
 	return configs, nil
 }
 
+// errorLabelConfigured tells whether the generated config routes the records of the error label
+func (r *Reconciler) errorLabelConfigured() bool {
+	return r.config != nil && strings.Contains(*r.config, fmt.Sprintf("<label %s>", types.ErrorLabel))
+}
+
 func (r *Reconciler) secretConfig() (runtime.Object, reconciler.DesiredState, error) {
 	configMap, err := r.generateConfigSecret()
 	if err != nil {
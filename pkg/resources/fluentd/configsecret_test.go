@@ -0,0 +1,81 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluentd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/banzaicloud/logging-operator/pkg/resources/model"
+	"github.com/banzaicloud/logging-operator/pkg/sdk/api/v1beta1"
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/output"
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/render"
+	"github.com/banzaicloud/operator-tools/pkg/reconciler"
+	"github.com/banzaicloud/operator-tools/pkg/secret"
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type testSecretLoaderFactory struct{}
+
+func (testSecretLoaderFactory) OutputSecretLoaderForNamespace(namespace string) secret.SecretLoader {
+	return secret.NewSecretLoader(nil, namespace, "", nil)
+}
+
+func TestErrorLabel(t *testing.T) {
+	for ref, nullLabel := range map[string]bool{
+		"":        true,
+		"errors":  false,
+		"missing": true,
+	} {
+		logging := testLogging(t, &v1beta1.FluentdSpec{})
+		logging.Spec.ErrorOutputRef = ref
+		resources := model.LoggingResources{
+			Logging: *logging,
+			ClusterOutputs: model.ClusterOutputs{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "logging", Name: "errors"},
+					Spec: v1beta1.ClusterOutputSpec{OutputSpec: v1beta1.OutputSpec{
+						NullOutputConfig: output.NewNullOutputConfig(),
+					}},
+				},
+			},
+		}
+		system, err := model.CreateSystem(resources, testSecretLoaderFactory{}, logr.Discard())
+		if err != nil {
+			t.Fatal(err)
+		}
+		out := &bytes.Buffer{}
+		renderer := render.FluentRender{Out: out, Indent: 2}
+		if err := renderer.Render(system); err != nil {
+			t.Fatal(err)
+		}
+		config := out.String()
+
+		r := New(nil, logr.Discard(), logging, &config, nil, reconciler.ReconcilerOpts{})
+		configs, err := r.generateConfigSecret()
+		if err != nil {
+			t.Fatal(err)
+		}
+		errorLabels := strings.Count(config+string(configs["devnull.conf"]), "<label @ERROR>")
+		if errorLabels != 1 {
+			t.Errorf("errorOutputRef %q: expected a single error label, found %d", ref, errorLabels)
+		}
+		if actual := strings.Contains(string(configs["devnull.conf"]), "@id main-fluentd-error"); actual != nullLabel {
+			t.Errorf("errorOutputRef %q: expected the null error label %t, got %t", ref, nullLabel, actual)
+		}
+	}
+}
@@ -27,6 +27,7 @@ import (
 	util "github.com/banzaicloud/operator-tools/pkg/utils"
 	"github.com/go-logr/logr"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
@@ -67,6 +68,10 @@ type Reconciler struct {
 	*reconciler.GenericResourceReconciler
 	config  *string
 	secrets *secret.MountSecrets
+	// env holds the environment variables of the fluentd container required by the outputs
+	env []corev1.EnvVar
+	// shard is the name of the fluentd shard, empty for the main fluentd statefulset
+	shard string
 }
 
 type Desire struct {
@@ -78,6 +83,12 @@ type Desire struct {
 }
 
 func (r *Reconciler) getFluentdLabels(component string) map[string]string {
+	var shardLabels map[string]string
+	if r.shard != "" {
+		// the component differs as well, so that the selectors of the main fluentd don't match the shard's pods
+		component = fmt.Sprintf("%s-%s", component, r.shard)
+		shardLabels = map[string]string{ShardLabel: r.shard}
+	}
 	return util.MergeLabels(
 		r.Logging.Spec.FluentdSpec.Labels,
 		map[string]string{
@@ -85,23 +96,48 @@ func (r *Reconciler) getFluentdLabels(component string) map[string]string {
 			"app.kubernetes.io/component": component,
 		},
 		generateLoggingRefLabels(r.Logging.ObjectMeta.GetName()),
+		shardLabels,
 	)
 }
 
+// qualifiedName returns the name of a resource of the fluentd shard
+func (r *Reconciler) qualifiedName(name string) string {
+	return QualifiedShardName(r.Logging, r.shard, name)
+}
+
+// QualifiedShardName returns the name of a resource of the given fluentd shard
+func QualifiedShardName(logging *v1beta1.Logging, shard, name string) string {
+	if shard == "" {
+		return logging.QualifiedName(name)
+	}
+	return logging.QualifiedName(fmt.Sprintf("%s-%s", name, shard))
+}
+
 func (r *Reconciler) getServiceAccount() string {
 	if r.Logging.Spec.FluentdSpec.Security.ServiceAccount != "" {
 		return r.Logging.Spec.FluentdSpec.Security.ServiceAccount
 	}
-	return r.Logging.QualifiedName(defaultServiceAccountName)
+	return r.qualifiedName(defaultServiceAccountName)
 }
 
 func New(client client.Client, log logr.Logger,
 	logging *v1beta1.Logging, config *string, secrets *secret.MountSecrets, opts reconciler.ReconcilerOpts) *Reconciler {
+	return NewShard(client, log, logging, "", config, secrets, nil, opts)
+}
+
+// NewShard creates a reconciler for a fluentd shard, the empty shard name stands for the main fluentd statefulset
+func NewShard(client client.Client, log logr.Logger,
+	logging *v1beta1.Logging, shard string, config *string, secrets *secret.MountSecrets, env []corev1.EnvVar, opts reconciler.ReconcilerOpts) *Reconciler {
+	if shard != "" {
+		log = log.WithValues("shard", shard)
+	}
 	return &Reconciler{
 		Logging:                   logging,
 		GenericResourceReconciler: reconciler.NewGenericReconciler(client, log, opts),
 		config:                    config,
 		secrets:                   secrets,
+		env:                       env,
+		shard:                     shard,
 	}
 }
 
@@ -140,7 +176,7 @@ func (r *Reconciler) Reconcile() (*reconcile.Result, error) {
 		if err != nil {
 			return nil, err
 		}
-		if result, ok := r.Logging.Status.ConfigCheckResults[hash]; ok {
+		if result, ok := r.Logging.Status.ConfigCheckResults[r.configCheckKey(hash)]; ok {
 			// We already have an existing configcheck result:
 			// - bail out if it was unsuccessful
 			// - cleanup previous results if it's successful
@@ -153,7 +189,7 @@ func (r *Reconciler) Reconcile() (*reconcile.Result, error) {
 			} else {
 				if len(removedHashes) > 0 {
 					for _, removedHash := range removedHashes {
-						delete(r.Logging.Status.ConfigCheckResults, removedHash)
+						delete(r.Logging.Status.ConfigCheckResults, r.configCheckKey(removedHash))
 					}
 					if err := r.Client.Status().Update(ctx, r.Logging); err != nil {
 						return nil, errors.WrapWithDetails(err, "failed to update status", "logging", r.Logging)
@@ -172,7 +208,7 @@ func (r *Reconciler) Reconcile() (*reconcile.Result, error) {
 				return nil, errors.WrapIf(err, "failed to validate config")
 			}
 			if result.Ready {
-				r.Logging.Status.ConfigCheckResults[hash] = result.Valid
+				r.Logging.Status.ConfigCheckResults[r.configCheckKey(hash)] = result.Valid
 				if err := r.Client.Status().Update(ctx, r.Logging); err != nil {
 					return nil, errors.WrapWithDetails(err, "failed to update status", "logging", r.Logging)
 				} else {
@@ -219,6 +255,7 @@ func (r *Reconciler) Reconcile() (*reconcile.Result, error) {
 		r.secretConfig,
 		r.appConfigSecret,
 		r.statefulset,
+		r.horizontalPodAutoscaler,
 		r.service,
 		r.headlessService,
 		r.serviceMetrics,
@@ -242,6 +279,25 @@ func (r *Reconciler) Reconcile() (*reconcile.Result, error) {
 		}
 	}
 
+	for _, o := range r.syslogInputServices() {
+		result, err := r.ReconcileResource(o, reconciler.StatePresent)
+		if err != nil {
+			return nil, errors.WrapIf(err, "failed to reconcile syslog input service")
+		}
+		if result != nil {
+			return result, nil
+		}
+	}
+
+	if r.shard == "" {
+		if err := r.removeStaleShards(ctx); err != nil {
+			return nil, err
+		}
+		if err := r.removeStaleSyslogInputServices(ctx); err != nil {
+			return nil, err
+		}
+	}
+
 	if res, err := r.reconcileDrain(ctx); res != nil || err != nil {
 		return res, err
 	}
@@ -271,7 +327,7 @@ func (r *Reconciler) reconcileDrain(ctx context.Context) (*reconcile.Result, err
 		return nil, errors.WrapIf(err, "listing StatefulSet pods")
 	}
 
-	bufVolName := r.Logging.QualifiedName(r.Logging.Spec.FluentdSpec.BufferStorageVolume.PersistentVolumeClaim.PersistentVolumeSource.ClaimName)
+	bufVolName := r.qualifiedName(r.Logging.Spec.FluentdSpec.BufferStorageVolume.PersistentVolumeClaim.PersistentVolumeSource.ClaimName)
 
 	pvcsInUse := make(map[string]bool)
 	for _, pod := range stsPods.Items {
@@ -279,9 +335,22 @@ func (r *Reconciler) reconcileDrain(ctx context.Context) (*reconcile.Result, err
 			pvcsInUse[bufVol.PersistentVolumeClaim.ClaimName] = true
 		}
 	}
+	replicas := r.Logging.Spec.FluentdSpec.Scaling.Replicas
+	if r.Logging.Spec.FluentdSpec.Scaling.Autoscaling != nil {
+		// the autoscaler owns the replica count
+		replicas = int(*r.Logging.Spec.FluentdSpec.Scaling.Autoscaling.MinReplicas)
+		var sts appsv1.StatefulSet
+		if err := r.Client.Get(ctx, client.ObjectKey{Namespace: r.Logging.Spec.ControlNamespace, Name: r.qualifiedName(StatefulSetName)}, &sts); client.IgnoreNotFound(err) != nil {
+			return nil, errors.WrapIf(err, "getting fluentd statefulset")
+		}
+		if sts.Spec.Replicas != nil {
+			replicas = int(*sts.Spec.Replicas)
+		}
+	}
+
 	// mark PVCs required for upscaling as in-use
-	for i := 0; i < r.Logging.Spec.FluentdSpec.Scaling.Replicas; i++ {
-		pvcsInUse[fmt.Sprintf("%s-%s-%d", bufVolName, r.Logging.QualifiedName(StatefulSetName), i)] = true
+	for i := 0; i < replicas; i++ {
+		pvcsInUse[fmt.Sprintf("%s-%s-%d", bufVolName, r.qualifiedName(StatefulSetName), i)] = true
 	}
 
 	var jobList batchv1.JobList
@@ -392,7 +461,8 @@ func RegisterWatches(builder *builder.Builder) *builder.Builder {
 		Owns(&rbacv1.ClusterRoleBinding{}).
 		Owns(&corev1.ServiceAccount{}).
 		Owns(&batchv1.Job{}).
-		Owns(&corev1.PersistentVolumeClaim{})
+		Owns(&corev1.PersistentVolumeClaim{}).
+		Owns(&autoscalingv2beta2.HorizontalPodAutoscaler{})
 }
 
 var drainableRequirement = requirementMust(labels.NewRequirement("logging.banzaicloud.io/drain", selection.NotEquals, []string{"no"}))
@@ -0,0 +1,87 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluentd
+
+import (
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/input"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+type inputPort struct {
+	name     string
+	protocol corev1.Protocol
+	port     int32
+}
+
+// inputPorts returns the ports of the additional inputs, they are served by the main fluentd statefulset only
+func (r *Reconciler) inputPorts() []inputPort {
+	if r.shard != "" {
+		return nil
+	}
+	var ports []inputPort
+	for _, in := range r.Logging.Spec.FluentdSpec.Inputs {
+		switch {
+		case in.OTLP != nil:
+			grpcPort, httpPort := in.OTLP.Ports()
+			if grpcPort != 0 {
+				ports = append(ports, inputPort{name: "grpc-" + in.Name, protocol: corev1.ProtocolTCP, port: int32(grpcPort)})
+			}
+			if httpPort != 0 {
+				ports = append(ports, inputPort{name: "http-" + in.Name, protocol: corev1.ProtocolTCP, port: int32(httpPort)})
+			}
+		case in.HTTP != nil:
+			ports = append(ports, inputPort{name: "http-" + in.Name, protocol: corev1.ProtocolTCP, port: int32(in.HTTP.GetPort())})
+		}
+	}
+	return ports
+}
+
+func syslogInputPort(name string, syslog *input.SyslogInputConfig) inputPort {
+	protocol := corev1.ProtocolTCP
+	if syslog.GetTransport() == input.SyslogTransportUDP {
+		protocol = corev1.ProtocolUDP
+	}
+	return inputPort{name: name, protocol: protocol, port: int32(syslog.GetPort())}
+}
+
+func (r *Reconciler) inputServicePorts() []corev1.ServicePort {
+	var ports []corev1.ServicePort
+	for _, p := range r.inputPorts() {
+		ports = append(ports, corev1.ServicePort{
+			Name:       p.name,
+			Protocol:   p.protocol,
+			Port:       p.port,
+			TargetPort: intstr.IntOrString{IntVal: p.port},
+		})
+	}
+	return ports
+}
+
+func (r *Reconciler) inputContainerPorts() []corev1.ContainerPort {
+	var ports []corev1.ContainerPort
+	inputPorts := r.inputPorts()
+	for _, in := range r.syslogInputs() {
+		inputPorts = append(inputPorts, syslogInputPort(in.Name, &in.Syslog))
+	}
+	for _, p := range inputPorts {
+		// container port names are limited to 15 characters, so the ports of the inputs are left unnamed
+		ports = append(ports, corev1.ContainerPort{
+			ContainerPort: p.port,
+			Protocol:      p.protocol,
+		})
+	}
+	return ports
+}
@@ -0,0 +1,58 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluentd
+
+import (
+	"testing"
+
+	"github.com/banzaicloud/logging-operator/pkg/sdk/api/v1beta1"
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/input"
+)
+
+func TestInputPortValidation(t *testing.T) {
+	for expected, spec := range map[string]v1beta1.LoggingSpec{
+		`port 24240 of input "webhooks" is already used by the forward input`: {
+			FluentdSpec: &v1beta1.FluentdSpec{
+				Inputs: []v1beta1.FluentdInput{{Name: "webhooks", HTTP: &input.HTTPInputConfig{Port: 24240}}},
+			},
+		},
+		`port 4318 of input "webhooks" is already used by input "otel"`: {
+			FluentdSpec: &v1beta1.FluentdSpec{
+				Inputs: []v1beta1.FluentdInput{
+					{Name: "otel", OTLP: &input.OTLPInputConfig{}},
+					{Name: "webhooks", HTTP: &input.HTTPInputConfig{Port: 4318}},
+				},
+			},
+		},
+		`port 9880 of input "switches" is already used by input "webhooks"`: {
+			FluentdSpec: &v1beta1.FluentdSpec{
+				Inputs: []v1beta1.FluentdInput{{Name: "webhooks", HTTP: &input.HTTPInputConfig{}}},
+			},
+			SyslogInputs: []v1beta1.SyslogInput{{Name: "switches", Syslog: input.SyslogInputConfig{Port: 9880}}},
+		},
+		`port 5140 of input "firewalls" is already used by input "switches"`: {
+			FluentdSpec: &v1beta1.FluentdSpec{},
+			SyslogInputs: []v1beta1.SyslogInput{
+				{Name: "switches"},
+				{Name: "firewalls", Syslog: input.SyslogInputConfig{Transport: input.SyslogTransportTCP}},
+			},
+		},
+	} {
+		logging := &v1beta1.Logging{Spec: spec}
+		if err := logging.SetDefaults(); err == nil || err.Error() != expected {
+			t.Errorf("expected error %q, got %v", expected, err)
+		}
+	}
+}
@@ -22,7 +22,7 @@ import (
 // FluentdObjectMeta creates an objectMeta for resource fluentd
 func (r *Reconciler) FluentdObjectMeta(name, component string) metav1.ObjectMeta {
 	o := metav1.ObjectMeta{
-		Name:      r.Logging.QualifiedName(name),
+		Name:      r.qualifiedName(name),
 		Namespace: r.Logging.Spec.ControlNamespace,
 		Labels:    r.getFluentdLabels(component),
 		OwnerReferences: []metav1.OwnerReference{
@@ -41,7 +41,7 @@ func (r *Reconciler) FluentdObjectMeta(name, component string) metav1.ObjectMeta
 // FluentdObjectMetaClusterScope creates an objectMeta for resource fluentd
 func (r *Reconciler) FluentdObjectMetaClusterScope(name, component string) metav1.ObjectMeta {
 	o := metav1.ObjectMeta{
-		Name:   r.Logging.QualifiedName(name),
+		Name:   r.qualifiedName(name),
 		Labels: r.getFluentdLabels(component),
 		OwnerReferences: []metav1.OwnerReference{
 			{
@@ -37,6 +37,10 @@ func (r *Reconciler) markSecrets(secrets *secret.MountSecrets) ([]runtime.Object
 	annotationKey := fmt.Sprintf("logging.banzaicloud.io/%s", loggingRef)
 	var markedSecrets []runtime.Object
 	for _, secret := range *secrets {
+		// the files rendered by the operator have no source secret to watch
+		if secret.Name == "" {
+			continue
+		}
 		secretItem := &corev1.Secret{}
 		err := r.Client.Get(context.TODO(), types.NamespacedName{
 			Name:      secret.Name,
@@ -58,7 +62,7 @@ func (r *Reconciler) outputSecret(secrets *secret.MountSecrets, mountPath string
 	// Initialise output secret
 	fluentOutputSecret := &corev1.Secret{
 		ObjectMeta: v1.ObjectMeta{
-			Name:      r.Logging.QualifiedName(OutputSecretName),
+			Name:      r.qualifiedName(OutputSecretName),
 			Namespace: r.Logging.Spec.ControlNamespace,
 		},
 	}
@@ -0,0 +1,55 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluentd
+
+import (
+	"testing"
+
+	"github.com/banzaicloud/logging-operator/pkg/sdk/api/v1beta1"
+	"github.com/banzaicloud/operator-tools/pkg/reconciler"
+	"github.com/banzaicloud/operator-tools/pkg/secret"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestOutputSecretFiles(t *testing.T) {
+	c := fake.NewClientBuilder().WithObjects(
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "s3", Namespace: "default"}, Data: map[string][]byte{"key": []byte("secret")}},
+	).Build()
+	secrets := &secret.MountSecrets{
+		{Namespace: "default", Name: "s3", Key: "key", MappedKey: "default-s3-key", Value: []byte("secret")},
+		{MappedKey: "s3-parquet-schema-8e6fa75724fd9051.avsc", Value: []byte(`{"type":"record"}`)},
+	}
+	r := New(c, logr.Discard(), testLogging(t, &v1beta1.FluentdSpec{}), nil, secrets, reconciler.ReconcilerOpts{})
+
+	marked, _, err := r.markSecrets(secrets)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(marked) != 1 {
+		t.Errorf("only the source secrets should be watched, got %d", len(marked))
+	}
+
+	obj, _, err := r.outputSecret(secrets, OutputSecretPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := obj.(*corev1.Secret).Data
+	if string(data["default-s3-key"]) != "secret" || string(data["s3-parquet-schema-8e6fa75724fd9051.avsc"]) != `{"type":"record"}` {
+		t.Errorf("unexpected output secret data %v", data)
+	}
+}
@@ -22,8 +22,11 @@ import (
 
 func (r *Reconciler) placeholderPodFor(pvc corev1.PersistentVolumeClaim) *corev1.Pod {
 	tgps := int64(0)
+	// the placeholder takes the name of the statefulset pod that would use the pvc
+	meta := r.FluentdObjectMeta(StatefulSetName, ComponentDrainer)
+	meta.Name += pvc.Name[strings.LastIndex(pvc.Name, "-"):]
 	return &corev1.Pod{
-		ObjectMeta: r.FluentdObjectMeta(StatefulSetName+pvc.Name[strings.LastIndex(pvc.Name, "-"):], ComponentDrainer),
+		ObjectMeta: meta,
 		Spec: corev1.PodSpec{
 			Containers: []corev1.Container{
 				{
@@ -66,7 +66,7 @@ func (r *Reconciler) pspRole() (runtime.Object, reconciler.DesiredState, error)
 				{
 					APIGroups:     []string{"policy"},
 					Resources:     []string{"podsecuritypolicies"},
-					ResourceNames: []string{r.Logging.QualifiedName(PodSecurityPolicyName)},
+					ResourceNames: []string{r.qualifiedName(PodSecurityPolicyName)},
 					Verbs:         []string{"use"},
 				},
 			},
@@ -84,7 +84,7 @@ func (r *Reconciler) pspRoleBinding() (runtime.Object, reconciler.DesiredState,
 			RoleRef: rbacv1.RoleRef{
 				Kind:     "Role",
 				APIGroup: "rbac.authorization.k8s.io",
-				Name:     r.Logging.QualifiedName(roleName + "-psp"),
+				Name:     r.qualifiedName(roleName + "-psp"),
 			},
 			Subjects: []rbacv1.Subject{
 				{
@@ -48,7 +48,7 @@ func (r *Reconciler) roleBinding() (runtime.Object, reconciler.DesiredState, err
 			RoleRef: rbacv1.RoleRef{
 				Kind:     "Role",
 				APIGroup: "rbac.authorization.k8s.io",
-				Name:     r.Logging.QualifiedName(roleName),
+				Name:     r.qualifiedName(roleName),
 			},
 			Subjects: []rbacv1.Subject{
 				{
@@ -128,7 +128,7 @@ func (r *Reconciler) clusterRoleBinding() (runtime.Object, reconciler.DesiredSta
 			RoleRef: rbacv1.RoleRef{
 				Kind:     "ClusterRole",
 				APIGroup: "rbac.authorization.k8s.io",
-				Name:     r.Logging.QualifiedName(roleName),
+				Name:     r.qualifiedName(roleName),
 			},
 			Subjects: []rbacv1.Subject{
 				{
@@ -46,6 +46,7 @@ func (r *Reconciler) service() (runtime.Object, reconciler.DesiredState, error)
 			Type:     corev1.ServiceTypeClusterIP,
 		},
 	}
+	desired.Spec.Ports = append(desired.Spec.Ports, r.inputServicePorts()...)
 
 	beforeUpdateHook := reconciler.DesiredStateHook(func(current runtime.Object) error {
 		if s, ok := current.(*corev1.Service); ok {
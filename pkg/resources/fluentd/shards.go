@@ -0,0 +1,93 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluentd
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	v1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
+	corev1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/selection"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// staleShardSelector matches the resources of the fluentd shards no longer in the spec
+func (r *Reconciler) staleShardSelector() labels.Selector {
+	selector := labels.SelectorFromSet(generateLoggingRefLabels(r.Logging.ObjectMeta.GetName())).
+		Add(requirementMust(labels.NewRequirement(ShardLabel, selection.Exists, nil)))
+	var shards []string
+	for _, shard := range r.Logging.Spec.FluentdSpec.Shards {
+		shards = append(shards, shard.Name)
+	}
+	if len(shards) > 0 {
+		selector = selector.Add(requirementMust(labels.NewRequirement(ShardLabel, selection.NotIn, shards)))
+	}
+	return selector
+}
+
+// removeStaleShards deletes the resources of the fluentd shards removed from the spec.
+// The buffer volume claims are kept, the same way as on scale down, so that no buffered data is lost.
+func (r *Reconciler) removeStaleShards(ctx context.Context) error {
+	selector := client.MatchingLabelsSelector{Selector: r.staleShardSelector()}
+	nsOpt := client.InNamespace(r.Logging.Spec.ControlNamespace)
+
+	for _, list := range []struct {
+		objects client.ObjectList
+		opts    []client.ListOption
+	}{
+		{&appsv1.StatefulSetList{}, []client.ListOption{nsOpt, selector}},
+		{&autoscalingv2beta2.HorizontalPodAutoscalerList{}, []client.ListOption{nsOpt, selector}},
+		{&corev1.ServiceList{}, []client.ListOption{nsOpt, selector}},
+		{&v1.ServiceMonitorList{}, []client.ListOption{nsOpt, selector}},
+		{&corev1.SecretList{}, []client.ListOption{nsOpt, selector}},
+		{&corev1.ServiceAccountList{}, []client.ListOption{nsOpt, selector}},
+		{&rbacv1.RoleList{}, []client.ListOption{nsOpt, selector}},
+		{&rbacv1.RoleBindingList{}, []client.ListOption{nsOpt, selector}},
+		{&rbacv1.ClusterRoleList{}, []client.ListOption{selector}},
+		{&rbacv1.ClusterRoleBindingList{}, []client.ListOption{selector}},
+		{&policyv1beta1.PodSecurityPolicyList{}, []client.ListOption{selector}},
+	} {
+		if err := r.Client.List(ctx, list.objects, list.opts...); err != nil {
+			if meta.IsNoMatchError(err) || runtime.IsNotRegisteredError(err) {
+				// the optional resource type is not available
+				continue
+			}
+			return errors.WrapIf(err, "listing resources of removed fluentd shards")
+		}
+		items, err := meta.ExtractList(list.objects)
+		if err != nil {
+			return errors.WrapIf(err, "listing resources of removed fluentd shards")
+		}
+		for _, item := range items {
+			o, ok := item.(client.Object)
+			if !ok {
+				continue
+			}
+			r.Log.Info("removing resource of a removed fluentd shard", "shard", o.GetLabels()[ShardLabel], "name", o.GetName())
+			if err := client.IgnoreNotFound(r.Client.Delete(ctx, o)); err != nil {
+				return errors.WrapIfWithDetails(err, "removing resource of a removed fluentd shard", "name", o.GetName())
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluentd
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/banzaicloud/logging-operator/pkg/sdk/api/v1beta1"
+	"github.com/banzaicloud/operator-tools/pkg/reconciler"
+	"github.com/banzaicloud/operator-tools/pkg/volume"
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func testLogging(t *testing.T, fluentdSpec *v1beta1.FluentdSpec) *v1beta1.Logging {
+	logging := &v1beta1.Logging{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec: v1beta1.LoggingSpec{
+			ControlNamespace: "logging",
+			FluentdSpec:      fluentdSpec,
+		},
+	}
+	if err := logging.SetDefaults(); err != nil {
+		t.Fatal(err)
+	}
+	return logging
+}
+
+func TestShardBufferHostPath(t *testing.T) {
+	logging := testLogging(t, &v1beta1.FluentdSpec{
+		DisablePvc: true,
+		BufferStorageVolume: volume.KubernetesVolume{
+			HostPath: &corev1.HostPathVolumeSource{},
+		},
+		Shards: []v1beta1.FluentdShard{{Name: "team-a"}},
+	})
+
+	hostPath := func(shard string) string {
+		r := NewShard(nil, logr.Discard(), logging, shard, new(string), nil, nil, reconciler.ReconcilerOpts{})
+		o, _, err := r.statefulset()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, v := range o.(*appsv1.StatefulSet).Spec.Template.Spec.Volumes {
+			if v.Name == v1beta1.DefaultFluentdBufferStorageVolumeName {
+				return v.HostPath.Path
+			}
+		}
+		t.Fatalf("buffer volume not found for shard %q", shard)
+		return ""
+	}
+
+	if path := hostPath(""); path != "/opt/logging-operator/test/test-fluentd-buffer" {
+		t.Errorf("unexpected host path for the main fluentd: %s", path)
+	}
+	if path := hostPath("team-a"); path != "/opt/logging-operator/test/test-fluentd-buffer-team-a" {
+		t.Errorf("unexpected host path for the shard: %s", path)
+	}
+	if logging.Spec.FluentdSpec.BufferStorageVolume.HostPath.Path != "" {
+		t.Errorf("the shared spec should not be modified")
+	}
+}
+
+func TestRemoveStaleShards(t *testing.T) {
+	logging := testLogging(t, &v1beta1.FluentdSpec{
+		Shards: []v1beta1.FluentdShard{{Name: "team-a"}},
+	})
+
+	var objects []runtime.Object
+	for _, shard := range []string{"", "team-a", "team-b"} {
+		r := NewShard(nil, logr.Discard(), logging, shard, nil, nil, nil, reconciler.ReconcilerOpts{})
+		objects = append(objects,
+			&appsv1.StatefulSet{ObjectMeta: r.FluentdObjectMeta(StatefulSetName, ComponentFluentd)},
+			&corev1.Service{ObjectMeta: r.FluentdObjectMeta(ServiceName, ComponentFluentd)},
+			&corev1.ServiceAccount{ObjectMeta: r.FluentdObjectMeta(defaultServiceAccountName, ComponentFluentd)},
+			&rbacv1.ClusterRole{ObjectMeta: r.FluentdObjectMetaClusterScope(clusterRoleName, ComponentFluentd)},
+			&corev1.PersistentVolumeClaim{ObjectMeta: r.FluentdObjectMeta("buffer", ComponentFluentd)},
+		)
+	}
+	// resources of other loggings are left alone
+	other := NewShard(nil, logr.Discard(), testLogging(t, &v1beta1.FluentdSpec{}), "team-c", nil, nil, nil, reconciler.ReconcilerOpts{})
+	other.Logging.Name = "other"
+	objects = append(objects, &appsv1.StatefulSet{ObjectMeta: other.FluentdObjectMeta(StatefulSetName, ComponentFluentd)})
+
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objects...).Build()
+
+	r := NewShard(c, logr.Discard(), logging, "", nil, nil, nil, reconciler.ReconcilerOpts{})
+	if err := r.removeStaleShards(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	names := func(list client.ObjectList) []string {
+		if err := c.List(context.Background(), list); err != nil {
+			t.Fatal(err)
+		}
+		var res []string
+		switch l := list.(type) {
+		case *appsv1.StatefulSetList:
+			for _, i := range l.Items {
+				res = append(res, i.Name)
+			}
+		case *corev1.ServiceList:
+			for _, i := range l.Items {
+				res = append(res, i.Name)
+			}
+		case *corev1.ServiceAccountList:
+			for _, i := range l.Items {
+				res = append(res, i.Name)
+			}
+		case *rbacv1.ClusterRoleList:
+			for _, i := range l.Items {
+				res = append(res, i.Name)
+			}
+		case *corev1.PersistentVolumeClaimList:
+			for _, i := range l.Items {
+				res = append(res, i.Name)
+			}
+		}
+		sort.Strings(res)
+		return res
+	}
+
+	for list, expected := range map[client.ObjectList][]string{
+		&appsv1.StatefulSetList{}:           {"other-fluentd-team-c", "test-fluentd", "test-fluentd-team-a"},
+		&corev1.ServiceList{}:               {"test-fluentd", "test-fluentd-team-a"},
+		&corev1.ServiceAccountList{}:        {"test-fluentd", "test-fluentd-team-a"},
+		&rbacv1.ClusterRoleList{}:           {"test-fluentd", "test-fluentd-team-a"},
+		&corev1.PersistentVolumeClaimList{}: {"test-buffer", "test-buffer-team-a", "test-buffer-team-b"},
+	} {
+		if result := names(list); !reflect.DeepEqual(result, expected) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	}
+}
@@ -17,6 +17,7 @@ package fluentd
 import (
 	"fmt"
 
+	"emperror.dev/errors"
 	"github.com/banzaicloud/logging-operator/pkg/sdk/api/v1beta1"
 	"github.com/banzaicloud/operator-tools/pkg/reconciler"
 	util "github.com/banzaicloud/operator-tools/pkg/utils"
@@ -30,18 +31,20 @@ import (
 func (r *Reconciler) statefulset() (runtime.Object, reconciler.DesiredState, error) {
 	spec := r.statefulsetSpec()
 
-	r.Logging.Spec.FluentdSpec.BufferStorageVolume.WithDefaultHostPath(
-		fmt.Sprintf(v1beta1.HostPath, r.Logging.Name, r.Logging.QualifiedName(v1beta1.DefaultFluentdBufferStorageVolumeName)),
+	// the shards share the spec, so the default host path is applied on a copy
+	bufferStorageVolume := r.Logging.Spec.FluentdSpec.BufferStorageVolume.DeepCopy()
+	bufferStorageVolume.WithDefaultHostPath(
+		fmt.Sprintf(v1beta1.HostPath, r.Logging.Name, r.qualifiedName(v1beta1.DefaultFluentdBufferStorageVolumeName)),
 	)
 	if !r.Logging.Spec.FluentdSpec.DisablePvc {
-		err := r.Logging.Spec.FluentdSpec.BufferStorageVolume.ApplyPVCForStatefulSet(containerName, bufferPath, spec, func(name string) metav1.ObjectMeta {
+		err := bufferStorageVolume.ApplyPVCForStatefulSet(containerName, bufferPath, spec, func(name string) metav1.ObjectMeta {
 			return r.FluentdObjectMeta(name, ComponentFluentd)
 		})
 		if err != nil {
 			return nil, reconciler.StatePresent, err
 		}
 	} else {
-		err := r.Logging.Spec.FluentdSpec.BufferStorageVolume.ApplyVolumeForPodSpec(v1beta1.DefaultFluentdBufferStorageVolumeName, containerName, bufferPath, &spec.Template.Spec)
+		err := bufferStorageVolume.ApplyVolumeForPodSpec(v1beta1.DefaultFluentdBufferStorageVolumeName, containerName, bufferPath, &spec.Template.Spec)
 		if err != nil {
 			return nil, reconciler.StatePresent, err
 		}
@@ -52,6 +55,20 @@ func (r *Reconciler) statefulset() (runtime.Object, reconciler.DesiredState, err
 		Spec:       *spec,
 	}
 
+	if r.Logging.Spec.FluentdSpec.Scaling.Autoscaling != nil {
+		desired.Spec.Replicas = r.Logging.Spec.FluentdSpec.Scaling.Autoscaling.MinReplicas
+		// leave the replica count to the autoscaler
+		beforeUpdateHook := reconciler.DesiredStateHook(func(current runtime.Object) error {
+			if s, ok := current.(*appsv1.StatefulSet); ok {
+				desired.Spec.Replicas = s.Spec.Replicas
+			} else {
+				return errors.Errorf("failed to cast statefulset object %+v", current)
+			}
+			return nil
+		})
+		return desired, beforeUpdateHook, nil
+	}
+
 	return desired, reconciler.StatePresent, nil
 }
 
@@ -61,8 +78,12 @@ func (r *Reconciler) statefulsetSpec() *appsv1.StatefulSetSpec {
 		initContainers = append(initContainers, *c)
 	}
 
+	fluentd := fluentContainer(r.Logging.Spec.FluentdSpec)
+	fluentd.Ports = append(fluentd.Ports, r.inputContainerPorts()...)
+	fluentd.Env = append(fluentd.Env, r.env...)
+	fluentd.VolumeMounts = append(fluentd.VolumeMounts, r.syslogInputTLSVolumeMounts()...)
 	containers := []corev1.Container{
-		fluentContainer(r.Logging.Spec.FluentdSpec),
+		fluentd,
 		*newConfigMapReloader(r.Logging.Spec.FluentdSpec),
 	}
 	if c := r.bufferMetricsSidecarContainer(); c != nil {
@@ -94,7 +115,7 @@ func (r *Reconciler) statefulsetSpec() *appsv1.StatefulSetSpec {
 					RunAsGroup:   r.Logging.Spec.FluentdSpec.Security.PodSecurityContext.RunAsGroup},
 			},
 		},
-		ServiceName: r.Logging.QualifiedName(ServiceName + "-headless"),
+		ServiceName: r.qualifiedName(ServiceName + "-headless"),
 	}
 }
 
@@ -236,7 +257,7 @@ func (r *Reconciler) generateVolume() (v []corev1.Volume) {
 			Name: "config",
 			VolumeSource: corev1.VolumeSource{
 				Secret: &corev1.SecretVolumeSource{
-					SecretName: r.Logging.QualifiedName(SecretConfigName),
+					SecretName: r.qualifiedName(SecretConfigName),
 				},
 			},
 		},
@@ -244,7 +265,7 @@ func (r *Reconciler) generateVolume() (v []corev1.Volume) {
 			Name: "app-config",
 			VolumeSource: corev1.VolumeSource{
 				Secret: &corev1.SecretVolumeSource{
-					SecretName: r.Logging.QualifiedName(AppSecretConfigName),
+					SecretName: r.qualifiedName(AppSecretConfigName),
 				},
 			},
 		},
@@ -252,7 +273,7 @@ func (r *Reconciler) generateVolume() (v []corev1.Volume) {
 			Name: "output-secret",
 			VolumeSource: corev1.VolumeSource{
 				Secret: &corev1.SecretVolumeSource{
-					SecretName: r.Logging.QualifiedName(OutputSecretName),
+					SecretName: r.qualifiedName(OutputSecretName),
 				},
 			},
 		},
@@ -268,6 +289,7 @@ func (r *Reconciler) generateVolume() (v []corev1.Volume) {
 		}
 		v = append(v, tlsRelatedVolume)
 	}
+	v = append(v, r.syslogInputTLSVolumes()...)
 	return
 }
 
@@ -280,7 +302,7 @@ func (r *Reconciler) volumeMountHackContainer() *corev1.Container {
 			Command:         []string{"sh", "-c", "chmod -R 777 " + bufferPath},
 			VolumeMounts: []corev1.VolumeMount{
 				{
-					Name:      r.Logging.QualifiedName(v1beta1.DefaultFluentdBufferStorageVolumeName),
+					Name:      r.qualifiedName(v1beta1.DefaultFluentdBufferStorageVolumeName),
 					MountPath: bufferPath,
 				},
 			},
@@ -310,7 +332,7 @@ func (r *Reconciler) bufferMetricsSidecarContainer() *corev1.Container {
 			Ports:           generatePortsBufferVolumeMetrics(r.Logging.Spec.FluentdSpec),
 			VolumeMounts: []corev1.VolumeMount{
 				{
-					Name:      r.Logging.QualifiedName(v1beta1.DefaultFluentdBufferStorageVolumeName),
+					Name:      r.qualifiedName(v1beta1.DefaultFluentdBufferStorageVolumeName),
 					MountPath: bufferPath,
 				},
 			},
@@ -0,0 +1,53 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluentd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/banzaicloud/logging-operator/pkg/sdk/api/v1beta1"
+	"github.com/banzaicloud/operator-tools/pkg/reconciler"
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestStatefulSetOutputEnv(t *testing.T) {
+	logging := testLogging(t, &v1beta1.FluentdSpec{
+		EnvVars: []corev1.EnvVar{{Name: "TZ", Value: "UTC"}},
+	})
+	env := []corev1.EnvVar{{Name: "GOOGLE_APPLICATION_CREDENTIALS", Value: "/fluentd/secret/logging-gcl-key.json"}}
+
+	o, _, err := NewShard(nil, logr.Discard(), logging, "", new(string), nil, env, reconciler.ReconcilerOpts{}).statefulset()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range o.(*appsv1.StatefulSet).Spec.Template.Spec.Containers {
+		if c.Name != containerName {
+			continue
+		}
+		expected := []corev1.EnvVar{
+			{Name: "TZ", Value: "UTC"},
+			{Name: "BUFFER_PATH", Value: bufferPath},
+			{Name: "GOOGLE_APPLICATION_CREDENTIALS", Value: "/fluentd/secret/logging-gcl-key.json"},
+		}
+		if !reflect.DeepEqual(c.Env, expected) {
+			t.Errorf("expected %v, got %v", expected, c.Env)
+		}
+		return
+	}
+	t.Fatal("fluentd container not found")
+}
@@ -0,0 +1,151 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluentd
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/banzaicloud/logging-operator/pkg/sdk/api/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// syslogInputs returns the syslog inputs, they are served by the main fluentd statefulset only
+func (r *Reconciler) syslogInputs() []v1beta1.SyslogInput {
+	if r.shard != "" {
+		return nil
+	}
+	return r.Logging.Spec.SyslogInputs
+}
+
+// syslogInputServices returns a dedicated service for each syslog input exposing the listener outside of the cluster
+func (r *Reconciler) syslogInputServices() []runtime.Object {
+	var services []runtime.Object
+	for _, in := range r.syslogInputs() {
+		port := syslogInputPort("syslog", &in.Syslog)
+		servicePort := in.Service.Port
+		if servicePort == 0 {
+			servicePort = port.port
+		}
+		serviceType := in.Service.Type
+		if serviceType == "" {
+			serviceType = corev1.ServiceTypeLoadBalancer
+		}
+		meta := r.FluentdObjectMeta(ServiceName+"-syslog-"+in.Name, ComponentFluentd)
+		meta.Labels[SyslogInputLabel] = in.Name
+		if len(in.Service.Annotations) > 0 {
+			if meta.Annotations == nil {
+				meta.Annotations = make(map[string]string)
+			}
+			for k, v := range in.Service.Annotations {
+				meta.Annotations[k] = v
+			}
+		}
+		services = append(services, &corev1.Service{
+			ObjectMeta: meta,
+			Spec: corev1.ServiceSpec{
+				Ports: []corev1.ServicePort{
+					{
+						Name:       port.name,
+						Protocol:   port.protocol,
+						Port:       servicePort,
+						TargetPort: intstr.IntOrString{IntVal: port.port},
+						NodePort:   in.Service.NodePort,
+					},
+				},
+				Selector:                 r.getFluentdLabels(ComponentFluentd),
+				Type:                     serviceType,
+				LoadBalancerIP:           in.Service.LoadBalancerIP,
+				LoadBalancerSourceRanges: in.Service.LoadBalancerSourceRanges,
+				ExternalTrafficPolicy:    in.Service.ExternalTrafficPolicy,
+			},
+		})
+	}
+	return services
+}
+
+// staleSyslogInputSelector matches the services of the syslog inputs no longer in the spec
+func (r *Reconciler) staleSyslogInputSelector() labels.Selector {
+	selector := labels.SelectorFromSet(generateLoggingRefLabels(r.Logging.ObjectMeta.GetName())).
+		Add(requirementMust(labels.NewRequirement(SyslogInputLabel, selection.Exists, nil)))
+	var names []string
+	for _, in := range r.syslogInputs() {
+		names = append(names, in.Name)
+	}
+	if len(names) > 0 {
+		selector = selector.Add(requirementMust(labels.NewRequirement(SyslogInputLabel, selection.NotIn, names)))
+	}
+	return selector
+}
+
+// removeStaleSyslogInputServices deletes the services of the syslog inputs removed from the spec
+func (r *Reconciler) removeStaleSyslogInputServices(ctx context.Context) error {
+	services := &corev1.ServiceList{}
+	if err := r.Client.List(ctx, services,
+		client.InNamespace(r.Logging.Spec.ControlNamespace),
+		client.MatchingLabelsSelector{Selector: r.staleSyslogInputSelector()}); err != nil {
+		return errors.WrapIf(err, "listing services of removed syslog inputs")
+	}
+	for i := range services.Items {
+		service := &services.Items[i]
+		r.Log.Info("removing service of a removed syslog input", "input", service.Labels[SyslogInputLabel], "name", service.Name)
+		if err := client.IgnoreNotFound(r.Client.Delete(ctx, service)); err != nil {
+			return errors.WrapIfWithDetails(err, "removing service of a removed syslog input", "name", service.Name)
+		}
+	}
+	return nil
+}
+
+func syslogInputTLSVolumeName(in v1beta1.SyslogInput) string {
+	return "syslog-tls-" + in.Name
+}
+
+func (r *Reconciler) syslogInputTLSVolumes() []corev1.Volume {
+	var volumes []corev1.Volume
+	for _, in := range r.syslogInputs() {
+		if in.TLSSecretName == "" {
+			continue
+		}
+		volumes = append(volumes, corev1.Volume{
+			Name: syslogInputTLSVolumeName(in),
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: in.TLSSecretName,
+				},
+			},
+		})
+	}
+	return volumes
+}
+
+func (r *Reconciler) syslogInputTLSVolumeMounts() []corev1.VolumeMount {
+	var mounts []corev1.VolumeMount
+	for _, in := range r.syslogInputs() {
+		if in.TLSSecretName == "" {
+			continue
+		}
+		mounts = append(mounts, corev1.VolumeMount{
+			Name:      syslogInputTLSVolumeName(in),
+			MountPath: v1beta1.SyslogInputTLSPath + in.Name,
+			ReadOnly:  true,
+		})
+	}
+	return mounts
+}
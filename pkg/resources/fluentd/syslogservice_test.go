@@ -0,0 +1,70 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluentd
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/banzaicloud/logging-operator/pkg/sdk/api/v1beta1"
+	"github.com/banzaicloud/operator-tools/pkg/reconciler"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestRemoveStaleSyslogInputServices(t *testing.T) {
+	previous := testLogging(t, &v1beta1.FluentdSpec{})
+	previous.Spec.SyslogInputs = []v1beta1.SyslogInput{{Name: "switches"}, {Name: "firewalls"}}
+	r := New(nil, logr.Discard(), previous, nil, nil, reconciler.ReconcilerOpts{})
+	objects := append([]runtime.Object{
+		&corev1.Service{ObjectMeta: r.FluentdObjectMeta(ServiceName, ComponentFluentd)},
+	}, r.syslogInputServices()...)
+
+	// services of other loggings are left alone
+	other := testLogging(t, &v1beta1.FluentdSpec{})
+	other.Name = "other"
+	other.Spec.SyslogInputs = []v1beta1.SyslogInput{{Name: "routers"}}
+	objects = append(objects, New(nil, logr.Discard(), other, nil, nil, reconciler.ReconcilerOpts{}).syslogInputServices()...)
+
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objects...).Build()
+
+	logging := previous.DeepCopy()
+	logging.Spec.SyslogInputs = logging.Spec.SyslogInputs[:1]
+	if err := New(c, logr.Discard(), logging, nil, nil, reconciler.ReconcilerOpts{}).removeStaleSyslogInputServices(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	services := &corev1.ServiceList{}
+	if err := c.List(context.Background(), services); err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, s := range services.Items {
+		names = append(names, s.Name)
+	}
+	sort.Strings(names)
+	if expected := []string{"other-fluentd-syslog-routers", "test-fluentd", "test-fluentd-syslog-switches"}; !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected %v, got %v", expected, names)
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluentd
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/banzaicloud/logging-operator/pkg/sdk/api/v1beta1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// UpstreamPodIndexes returns the indexes of the fluentd statefulset pods the fluentbit upstream should contain.
+// The replica count is taken from the statefulset, so that scaling by hand or by the autoscaler is followed,
+// the statefulset is owned by the logging resource thus scaling triggers a reconcile as well.
+// With readyOnly the pods that are not ready are left out, unless none of the pods are ready.
+func UpstreamPodIndexes(ctx context.Context, c client.Reader, logging *v1beta1.Logging, readyOnly bool) ([]int, error) {
+	r := &Reconciler{Logging: logging}
+
+	replicas := logging.Spec.FluentdSpec.Scaling.Replicas
+	var sts appsv1.StatefulSet
+	if err := c.Get(ctx, client.ObjectKey{Namespace: logging.Spec.ControlNamespace, Name: r.qualifiedName(StatefulSetName)}, &sts); client.IgnoreNotFound(err) != nil {
+		return nil, errors.WrapIf(err, "getting fluentd statefulset")
+	}
+	if sts.Spec.Replicas != nil {
+		replicas = int(*sts.Spec.Replicas)
+	}
+
+	indexes := make([]int, 0, replicas)
+	for i := 0; i < replicas; i++ {
+		indexes = append(indexes, i)
+	}
+	if !readyOnly {
+		return indexes, nil
+	}
+
+	var pods corev1.PodList
+	if err := c.List(ctx, &pods, client.InNamespace(logging.Spec.ControlNamespace), client.MatchingLabels(r.getFluentdLabels(ComponentFluentd))); err != nil {
+		return nil, errors.WrapIf(err, "listing fluentd pods")
+	}
+	ready := make(map[int]bool)
+	podNamePrefix := r.qualifiedName(StatefulSetName) + "-"
+	for _, pod := range pods.Items {
+		if !strings.HasPrefix(pod.Name, podNamePrefix) || !podReady(pod) {
+			continue
+		}
+		if i, err := strconv.Atoi(strings.TrimPrefix(pod.Name, podNamePrefix)); err == nil {
+			ready[i] = true
+		}
+	}
+
+	var readyIndexes []int
+	for _, i := range indexes {
+		if ready[i] {
+			readyIndexes = append(readyIndexes, i)
+		}
+	}
+	if len(readyIndexes) == 0 {
+		return indexes, nil
+	}
+	return readyIndexes, nil
+}
+
+func podReady(pod corev1.Pod) bool {
+	for _, c := range pod.Status.Conditions {
+		if c.Type == corev1.PodReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
@@ -0,0 +1,118 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluentd
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/banzaicloud/logging-operator/pkg/sdk/api/v1beta1"
+	"github.com/banzaicloud/operator-tools/pkg/utils"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestUpstreamPodIndexes(t *testing.T) {
+	logging := testLogging(t, &v1beta1.FluentdSpec{
+		Scaling: &v1beta1.FluentdScaling{Replicas: 3},
+	})
+	r := &Reconciler{Logging: logging}
+
+	statefulSet := func(replicas int32) runtime.Object {
+		return &appsv1.StatefulSet{
+			ObjectMeta: r.FluentdObjectMeta(StatefulSetName, ComponentFluentd),
+			Spec:       appsv1.StatefulSetSpec{Replicas: utils.IntPointer(replicas)},
+		}
+	}
+	pod := func(index int, ready bool) runtime.Object {
+		status := corev1.ConditionFalse
+		if ready {
+			status = corev1.ConditionTrue
+		}
+		meta := r.FluentdObjectMeta(StatefulSetName, ComponentFluentd)
+		meta.Name += "-" + strconv.Itoa(index)
+		return &corev1.Pod{
+			ObjectMeta: meta,
+			Status: corev1.PodStatus{
+				Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: status}},
+			},
+		}
+	}
+	otherPod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "logging", Name: "test-fluentd-1"}}
+
+	tests := []struct {
+		name      string
+		readyOnly bool
+		objects   []runtime.Object
+		expected  []int
+	}{
+		{
+			name:     "replicas of the spec without statefulset",
+			expected: []int{0, 1, 2},
+		},
+		{
+			name:     "replicas of the statefulset",
+			objects:  []runtime.Object{statefulSet(2), pod(0, false)},
+			expected: []int{0, 1},
+		},
+		{
+			name:      "ready pods",
+			readyOnly: true,
+			objects:   []runtime.Object{statefulSet(4), pod(0, true), pod(1, false), pod(3, true)},
+			expected:  []int{0, 3},
+		},
+		{
+			name:      "ready pods beyond the replicas",
+			readyOnly: true,
+			objects:   []runtime.Object{statefulSet(2), pod(1, true), pod(2, true)},
+			expected:  []int{1},
+		},
+		{
+			name:      "missing pods",
+			readyOnly: true,
+			objects:   []runtime.Object{statefulSet(2)},
+			expected:  []int{0, 1},
+		},
+		{
+			name:      "no ready pods",
+			readyOnly: true,
+			objects:   []runtime.Object{statefulSet(2), pod(0, false), otherPod},
+			expected:  []int{0, 1},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			if err := clientgoscheme.AddToScheme(scheme); err != nil {
+				t.Fatal(err)
+			}
+			c := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(test.objects...).Build()
+
+			indexes, err := UpstreamPodIndexes(context.Background(), c, logging, test.readyOnly)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(indexes, test.expected) {
+				t.Errorf("expected %v, got %v", test.expected, indexes)
+			}
+		})
+	}
+}
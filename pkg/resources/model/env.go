@@ -0,0 +1,68 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"fmt"
+
+	"emperror.dev/errors"
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/output"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// FluentdEnvVars returns the environment variables of the fluentd container required by the outputs of the resources.
+// The Google Cloud Logging plugin reads the Application Default Credentials of the process when it starts,
+// so the outputs have to share the same service account key, its path is set in GOOGLE_APPLICATION_CREDENTIALS.
+func FluentdEnvVars(resources LoggingResources, secrets SecretLoaderFactory) ([]corev1.EnvVar, error) {
+	type googleCloudOutput struct {
+		name      string
+		namespace string
+		spec      *output.GoogleCloudLoggingOutput
+	}
+	var googleCloudOutputs []googleCloudOutput
+	for _, o := range resources.ClusterOutputs {
+		googleCloudOutputs = append(googleCloudOutputs, googleCloudOutput{
+			name:      fmt.Sprintf("clusteroutput %s", o.Name),
+			namespace: o.Namespace,
+			spec:      o.Spec.GoogleCloudLoggingOutput,
+		})
+	}
+	for _, o := range resources.Outputs {
+		googleCloudOutputs = append(googleCloudOutputs, googleCloudOutput{
+			name:      fmt.Sprintf("output %s/%s", o.Namespace, o.Name),
+			namespace: o.Namespace,
+			spec:      o.Spec.GoogleCloudLoggingOutput,
+		})
+	}
+
+	var credentials, credentialsOwner string
+	for _, o := range googleCloudOutputs {
+		if o.spec == nil || o.spec.Credentials == nil || o.spec.Credentials.MountFrom == nil {
+			continue
+		}
+		path, err := secrets.OutputSecretLoaderForNamespace(o.namespace).Load(o.spec.Credentials)
+		if err != nil {
+			return nil, errors.WrapIff(err, "failed to load the google cloud logging credentials of %s", o.name)
+		}
+		if credentials != "" && path != credentials {
+			return nil, errors.Errorf("the google cloud logging outputs must use the same credentials, the credentials of %s and %s differ", credentialsOwner, o.name)
+		}
+		credentials, credentialsOwner = path, o.name
+	}
+	if credentials == "" {
+		return nil, nil
+	}
+	return []corev1.EnvVar{{Name: "GOOGLE_APPLICATION_CREDENTIALS", Value: credentials}}, nil
+}
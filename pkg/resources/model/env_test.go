@@ -0,0 +1,97 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/banzaicloud/logging-operator/pkg/sdk/api/v1beta1"
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/output"
+	"github.com/banzaicloud/operator-tools/pkg/secret"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+type mountSecretLoaderFactory struct {
+	client  client.Client
+	secrets secret.MountSecrets
+}
+
+func (f *mountSecretLoaderFactory) OutputSecretLoaderForNamespace(namespace string) secret.SecretLoader {
+	return secret.NewSecretLoader(f.client, namespace, "/fluentd/secret", &f.secrets)
+}
+
+func googleCloudCredentials(name string) *output.GoogleCloudLoggingOutput {
+	return &output.GoogleCloudLoggingOutput{
+		Credentials: &secret.Secret{
+			MountFrom: &secret.ValueFrom{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: name},
+					Key:                  "key.json",
+				},
+			},
+		},
+	}
+}
+
+func TestFluentdEnvVars(t *testing.T) {
+	c := fake.NewClientBuilder().WithObjects(
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "gcl", Namespace: "logging"}, Data: map[string][]byte{"key.json": []byte("{}")}},
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "logging"}, Data: map[string][]byte{"key.json": []byte("{}")}},
+	).Build()
+	clusterOutput := func(name string, spec *output.GoogleCloudLoggingOutput) v1beta1.ClusterOutput {
+		return v1beta1.ClusterOutput{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "logging"},
+			Spec:       v1beta1.ClusterOutputSpec{OutputSpec: v1beta1.OutputSpec{GoogleCloudLoggingOutput: spec}},
+		}
+	}
+
+	secrets := &mountSecretLoaderFactory{client: c}
+	env, err := FluentdEnvVars(LoggingResources{
+		ClusterOutputs: ClusterOutputs{
+			clusterOutput("gcl-a", googleCloudCredentials("gcl")),
+			clusterOutput("gcl-b", googleCloudCredentials("gcl")),
+			clusterOutput("gcl-adc", &output.GoogleCloudLoggingOutput{}),
+		},
+	}, secrets)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []corev1.EnvVar{{Name: "GOOGLE_APPLICATION_CREDENTIALS", Value: "/fluentd/secret/logging-gcl-key.json"}}
+	if !reflect.DeepEqual(env, expected) {
+		t.Errorf("expected %v, got %v", expected, env)
+	}
+	if len(secrets.secrets) == 0 || secrets.secrets[0].MappedKey != "logging-gcl-key.json" {
+		t.Errorf("the credentials should be mounted, got %v", secrets.secrets)
+	}
+
+	env, err = FluentdEnvVars(LoggingResources{}, &mountSecretLoaderFactory{client: c})
+	if err != nil || env != nil {
+		t.Errorf("expected no environment variables without credentials, got %v, %v", env, err)
+	}
+
+	_, err = FluentdEnvVars(LoggingResources{
+		ClusterOutputs: ClusterOutputs{
+			clusterOutput("gcl-a", googleCloudCredentials("gcl")),
+			clusterOutput("gcl-b", googleCloudCredentials("other")),
+		},
+	}, &mountSecretLoaderFactory{client: c})
+	if expected := "the google cloud logging outputs must use the same credentials, the credentials of clusteroutput gcl-a and clusteroutput gcl-b differ"; err == nil || err.Error() != expected {
+		t.Errorf("expected error %q, got %v", expected, err)
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"fmt"
+
+	"emperror.dev/errors"
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/output"
+	"github.com/banzaicloud/operator-tools/pkg/secret"
+)
+
+// AppendOutputFiles appends the files rendered from the outputs of the resources to the secrets mounted to fluentd.
+// The files have no source secret, they are only added to the output secret of fluentd and of the config check,
+// e.g. the parquet schemas of the S3 outputs defined in place are read by columnify from a file.
+func AppendOutputFiles(resources LoggingResources, secrets *secret.MountSecrets) error {
+	type s3Output struct {
+		name string
+		spec *output.S3OutputConfig
+	}
+	var s3Outputs []s3Output
+	for _, o := range resources.ClusterOutputs {
+		s3Outputs = append(s3Outputs, s3Output{name: fmt.Sprintf("clusteroutput %s", o.Name), spec: o.Spec.S3OutputConfig})
+	}
+	for _, o := range resources.Outputs {
+		s3Outputs = append(s3Outputs, s3Output{name: fmt.Sprintf("output %s/%s", o.Namespace, o.Name), spec: o.Spec.S3OutputConfig})
+	}
+
+	files := map[string]bool{}
+	for _, o := range s3Outputs {
+		if o.spec == nil || o.spec.Parquet == nil || o.spec.Parquet.Schema == nil {
+			continue
+		}
+		name, schema, err := o.spec.Parquet.Schema.SchemaFile()
+		if err != nil {
+			return errors.WrapIff(err, "failed to render the parquet schema of %s", o.name)
+		}
+		// the name is the hash of the schema, outputs with the same schema share the file
+		if files[name] {
+			continue
+		}
+		files[name] = true
+		*secrets = append(*secrets, secret.MountSecret{
+			MappedKey: name,
+			Value:     []byte(schema),
+		})
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/banzaicloud/logging-operator/pkg/resources/fluentd"
+	"github.com/banzaicloud/logging-operator/pkg/sdk/api/v1beta1"
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/output"
+	"github.com/banzaicloud/operator-tools/pkg/secret"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAppendOutputFiles(t *testing.T) {
+	if output.S3ParquetSchemaDir != fluentd.OutputSecretPath {
+		t.Fatalf("the parquet schemas are rendered to %s instead of the output secret %s", output.S3ParquetSchemaDir, fluentd.OutputSecretPath)
+	}
+	parquet := func(fields ...string) *output.S3OutputConfig {
+		schema := &output.S3ParquetSchema{}
+		for _, name := range fields {
+			schema.Fields = append(schema.Fields, output.S3ParquetField{Name: name, Type: "string"})
+		}
+		return &output.S3OutputConfig{Parquet: &output.S3Parquet{Schema: schema}}
+	}
+	resources := LoggingResources{
+		ClusterOutputs: ClusterOutputs{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "archive", Namespace: "logging"},
+				Spec:       v1beta1.ClusterOutputSpec{OutputSpec: v1beta1.OutputSpec{S3OutputConfig: parquet("message")}},
+			},
+		},
+		Outputs: Outputs{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "archive", Namespace: "default"},
+				Spec:       v1beta1.OutputSpec{S3OutputConfig: parquet("message")},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "audit", Namespace: "default"},
+				Spec:       v1beta1.OutputSpec{S3OutputConfig: parquet("message", "user")},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "gzip", Namespace: "default"},
+				Spec:       v1beta1.OutputSpec{S3OutputConfig: &output.S3OutputConfig{}},
+			},
+		},
+	}
+
+	var secrets secret.MountSecrets
+	if err := AppendOutputFiles(resources, &secrets); err != nil {
+		t.Fatal(err)
+	}
+	if len(secrets) != 2 {
+		t.Fatalf("expected a file for each distinct schema, got %v", secrets)
+	}
+	name, schema, err := parquet("message", "user").Parquet.Schema.SchemaFile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if secrets[1].MappedKey != name || string(secrets[1].Value) != schema || secrets[1].Name != "" {
+		t.Errorf("unexpected schema file %v", secrets[1])
+	}
+
+	resources.Outputs[2].Spec.S3OutputConfig = parquet()
+	if err := AppendOutputFiles(resources, &secret.MountSecrets{}); err == nil {
+		t.Error("expected an error for a schema without fields")
+	}
+}
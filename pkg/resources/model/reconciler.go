@@ -51,9 +51,13 @@ func NewValidationReconciler(
 
 			output.Status.Active = utils.BoolPointer(false)
 			output.Status.Problems = nil
+			output.Status.Warnings = outputWarnings(output.Spec.OutputSpec)
 
 			output.Status.Problems = append(output.Status.Problems,
 				validateOutputSpec(output.Spec.OutputSpec, secrets.OutputSecretLoaderForNamespace(output.Namespace))...)
+			if ref := output.Spec.SecondaryOutputRef; ref != "" && resources.ClusterOutputs.FindByName(ref) == nil {
+				output.Status.Problems = append(output.Status.Problems, fmt.Sprintf("dangling secondary output reference: %s", ref))
+			}
 			output.Status.ProblemsCount = len(output.Status.Problems)
 		}
 
@@ -63,18 +67,25 @@ func NewValidationReconciler(
 
 			output.Status.Active = utils.BoolPointer(false)
 			output.Status.Problems = nil
+			output.Status.Warnings = outputWarnings(output.Spec)
 
 			output.Status.Problems = append(output.Status.Problems,
 				validateOutputSpec(output.Spec, secrets.OutputSecretLoaderForNamespace(output.Namespace))...)
+			if ref := output.Spec.SecondaryOutputRef; ref != "" && resources.Outputs.FindByNamespacedName(output.Namespace, ref) == nil {
+				output.Status.Problems = append(output.Status.Problems, fmt.Sprintf("dangling secondary output reference: %s", ref))
+			}
 			output.Status.ProblemsCount = len(output.Status.Problems)
 		}
 
+		enforcedFilters := filterNames(resources.Logging.Spec.EnforcedOutputFilters)
+
 		for i := range resources.ClusterFlows {
 			flow := &resources.ClusterFlows[i]
 			registerForPatching(flow)
 
 			flow.Status.Active = utils.BoolPointer(false)
 			flow.Status.Problems = nil
+			flow.Status.EnforcedFilters = enforcedFilters
 
 			if len(flow.Spec.GlobalOutputRefs) == 0 && len(flow.Spec.OutputRefs) > 0 {
 				flow.Status.Problems = append(flow.Status.Problems, "\"outputRefs\" field is deprecated, use \"globalOutputRefs\" instead")
@@ -88,15 +99,32 @@ func NewValidationReconciler(
 					flow.Status.Problems = append(flow.Status.Problems, fmt.Sprintf("dangling global output reference: %s", ref))
 				}
 			}
+			for _, name := range flow.Spec.Inputs {
+				if !hasFluentdInput(resources.Logging, name) {
+					flow.Status.Problems = append(flow.Status.Problems, fmt.Sprintf("dangling input reference: %s", name))
+				}
+			}
 			flow.Status.ProblemsCount = len(flow.Status.Problems)
 		}
 
+		registerForPatching(&resources.Logging)
+		resources.Logging.Status.Problems = nil
+		if ref := resources.Logging.Spec.ErrorOutputRef; ref != "" {
+			if output := resources.ClusterOutputs.FindByName(ref); output != nil {
+				output.Status.Active = utils.BoolPointer(true)
+			} else {
+				resources.Logging.Status.Problems = append(resources.Logging.Status.Problems, fmt.Sprintf("dangling error output reference: %s", ref))
+			}
+		}
+		resources.Logging.Status.ProblemsCount = len(resources.Logging.Status.Problems)
+
 		for i := range resources.Flows {
 			flow := &resources.Flows[i]
 			registerForPatching(flow)
 
 			flow.Status.Active = utils.BoolPointer(false)
 			flow.Status.Problems = nil
+			flow.Status.EnforcedFilters = enforcedFilters
 
 			if len(flow.Spec.LocalOutputRefs)+len(flow.Spec.GlobalOutputRefs) == 0 && len(flow.Spec.OutputRefs) > 0 {
 				flow.Status.Problems = append(flow.Status.Problems, "\"outputRefs\" field is deprecated, use \"globalOutputRefs\" and \"localOutputRefs\" instead")
@@ -122,6 +150,24 @@ func NewValidationReconciler(
 			flow.Status.ProblemsCount = len(flow.Status.Problems)
 		}
 
+		// secondary outputs are active when their primary output is
+		for i := range resources.ClusterOutputs {
+			output := &resources.ClusterOutputs[i]
+			if utils.PointerToBool(output.Status.Active) && output.Spec.SecondaryOutputRef != "" {
+				if secondary := resources.ClusterOutputs.FindByName(output.Spec.SecondaryOutputRef); secondary != nil {
+					secondary.Status.Active = utils.BoolPointer(true)
+				}
+			}
+		}
+		for i := range resources.Outputs {
+			output := &resources.Outputs[i]
+			if utils.PointerToBool(output.Status.Active) && output.Spec.SecondaryOutputRef != "" {
+				if secondary := resources.Outputs.FindByNamespacedName(output.Namespace, output.Spec.SecondaryOutputRef); secondary != nil {
+					secondary.Status.Active = utils.BoolPointer(true)
+				}
+			}
+		}
+
 		var errs error
 		for _, req := range patchRequests {
 			if req.IsEmptyPatch() {
@@ -172,6 +218,14 @@ func validateOutputSpec(spec v1beta1.OutputSpec, secrets secret.SecretLoader) (p
 	return
 }
 
+// outputWarnings lists the settings of the output that are not applied as configured
+func outputWarnings(spec v1beta1.OutputSpec) (warnings []string) {
+	if spec.LokiOutput != nil {
+		warnings = append(warnings, spec.LokiOutput.CardinalityWarnings()...)
+	}
+	return
+}
+
 type patchRequest struct {
 	Obj   client.Object
 	Patch client.Patch
@@ -182,6 +236,19 @@ func (r patchRequest) IsEmptyPatch() bool {
 	return err == nil && string(data) == "{}"
 }
 
+// filterNames returns the names of the configured filter types
+func filterNames(filters []v1beta1.Filter) (names []string) {
+	for _, filter := range filters {
+		it := mirror.StructRange(filter)
+		for it.Next() {
+			if it.Field().Type.Kind() == reflect.Ptr && !it.Value().IsNil() {
+				names = append(names, jsonFieldName(it.Field()))
+			}
+		}
+	}
+	return
+}
+
 func jsonFieldName(f reflect.StructField) string {
 	t := f.Tag.Get("json")
 	n := strings.Split(t, ",")[0]
@@ -190,3 +257,20 @@ func jsonFieldName(f reflect.StructField) string {
 	}
 	return f.Name
 }
+
+func hasFluentdInput(logging v1beta1.Logging, name string) bool {
+	for _, input := range logging.Spec.SyslogInputs {
+		if input.Name == name {
+			return true
+		}
+	}
+	if logging.Spec.FluentdSpec == nil {
+		return false
+	}
+	for _, input := range logging.Spec.FluentdSpec.Inputs {
+		if input.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,39 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/banzaicloud/logging-operator/pkg/sdk/api/v1beta1"
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/output"
+)
+
+func TestOutputWarnings(t *testing.T) {
+	spec := v1beta1.OutputSpec{
+		LokiOutput: &output.LokiOutput{
+			Url:        "http://loki:3100",
+			Labels:     output.Label{"pod": "$.kubernetes.pod_name"},
+			AutoLabels: &output.LokiAutoLabels{},
+		},
+	}
+	if problems := validateOutputSpec(spec, testSecretLoaderFactory{}.OutputSecretLoaderForNamespace("default")); len(problems) != 0 {
+		t.Errorf("the refused loki labels are reported as problems: %q", problems)
+	}
+	expected := `loki label "pod" has high cardinality and is refused, allow it with allow_high_cardinality`
+	if warnings := outputWarnings(spec); len(warnings) != 1 || warnings[0] != expected {
+		t.Errorf("expected the warning %q, got %q", expected, warnings)
+	}
+}
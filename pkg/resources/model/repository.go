@@ -21,6 +21,8 @@ import (
 	"emperror.dev/errors"
 	"github.com/banzaicloud/logging-operator/pkg/sdk/api/v1beta1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -45,20 +47,24 @@ func (r LoggingResourceRepository) LoggingResourcesFor(ctx context.Context, logg
 	res.ClusterOutputs, err = r.ClusterOutputsFor(ctx, logging)
 	errs = errors.Append(errs, err)
 
+	var nsList corev1.NamespaceList
 	watchNamespaces := logging.Spec.WatchNamespaces
-	if len(watchNamespaces) == 0 {
-		var nsList corev1.NamespaceList
+	if len(watchNamespaces) == 0 || (logging.Spec.FluentdSpec != nil && len(logging.Spec.FluentdSpec.Shards) > 0) {
 		if err := r.Client.List(ctx, &nsList); err != nil {
 			errs = errors.Append(errs, errors.WrapIf(err, "listing namespaces"))
 			return
 		}
-
+	}
+	if len(watchNamespaces) == 0 {
 		for _, i := range nsList.Items {
 			watchNamespaces = append(watchNamespaces, i.Name)
 		}
 	}
 	sort.Strings(watchNamespaces)
 
+	res.ShardNamespaces, err = shardNamespaces(logging, nsList.Items)
+	errs = errors.Append(errs, err)
+
 	for _, ns := range watchNamespaces {
 		flows, err := r.FlowsInNamespaceFor(ctx, ns, logging)
 		res.Flows = append(res.Flows, flows...)
@@ -148,6 +154,43 @@ func (r LoggingResourceRepository) OutputsInNamespaceFor(ctx context.Context, na
 	return res, nil
 }
 
+// shardNamespaces assigns namespaces to fluentd shards, a namespace belongs to the first shard it matches
+func shardNamespaces(logging v1beta1.Logging, namespaces []corev1.Namespace) (map[string][]string, error) {
+	if logging.Spec.FluentdSpec == nil || len(logging.Spec.FluentdSpec.Shards) == 0 {
+		return nil, nil
+	}
+	assigned := make(map[string]bool)
+	res := make(map[string][]string)
+	for _, shard := range logging.Spec.FluentdSpec.Shards {
+		var selector labels.Selector
+		if shard.NamespaceSelector != nil {
+			var err error
+			selector, err = metav1.LabelSelectorAsSelector(shard.NamespaceSelector)
+			if err != nil {
+				return nil, errors.WrapIff(err, "invalid namespace selector for fluentd shard %s", shard.Name)
+			}
+		}
+		shardNamespaces := []string{}
+		for _, ns := range shard.Namespaces {
+			if !assigned[ns] {
+				assigned[ns] = true
+				shardNamespaces = append(shardNamespaces, ns)
+			}
+		}
+		if selector != nil {
+			for _, ns := range namespaces {
+				if !assigned[ns.Name] && selector.Matches(labels.Set(ns.Labels)) {
+					assigned[ns.Name] = true
+					shardNamespaces = append(shardNamespaces, ns.Name)
+				}
+			}
+		}
+		sort.Strings(shardNamespaces)
+		res[shard.Name] = shardNamespaces
+	}
+	return res, nil
+}
+
 func clusterResourceListOpts(logging v1beta1.Logging) []client.ListOption {
 	var opts []client.ListOption
 	if !logging.Spec.AllowClusterResourcesFromAllNamespaces {
@@ -24,6 +24,45 @@ type LoggingResources struct {
 	Flows          []v1beta1.Flow
 	ClusterOutputs ClusterOutputs
 	ClusterFlows   []v1beta1.ClusterFlow
+	// ShardNamespaces holds the namespaces assigned to each fluentd shard
+	ShardNamespaces map[string][]string
+	// Shard is the name of the fluentd shard the resources belong to, empty for the main fluentd
+	Shard string
+}
+
+// ForShard returns the resources handled by the given fluentd shard.
+// Cluster scoped resources are part of every shard, namespaced ones only belong to the shard of their namespace.
+// The empty shard name stands for the main fluentd that handles every namespace not assigned to a shard.
+func (r LoggingResources) ForShard(shard string) LoggingResources {
+	if len(r.ShardNamespaces) == 0 {
+		r.Shard = shard
+		return r
+	}
+	namespaceShard := make(map[string]string)
+	for name, namespaces := range r.ShardNamespaces {
+		for _, ns := range namespaces {
+			namespaceShard[ns] = name
+		}
+	}
+
+	res := LoggingResources{
+		Logging:         r.Logging,
+		ClusterOutputs:  r.ClusterOutputs,
+		ClusterFlows:    r.ClusterFlows,
+		ShardNamespaces: r.ShardNamespaces,
+		Shard:           shard,
+	}
+	for _, flow := range r.Flows {
+		if namespaceShard[flow.Namespace] == shard {
+			res.Flows = append(res.Flows, flow)
+		}
+	}
+	for _, output := range r.Outputs {
+		if namespaceShard[output.Namespace] == shard {
+			res.Outputs = append(res.Outputs, output)
+		}
+	}
+	return res
 }
 
 type ClusterOutputs []v1beta1.ClusterOutput
@@ -0,0 +1,136 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/banzaicloud/logging-operator/pkg/sdk/api/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func namespace(name string, labels map[string]string) corev1.Namespace {
+	return corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+}
+
+func TestShardNamespaces(t *testing.T) {
+	logging := v1beta1.Logging{Spec: v1beta1.LoggingSpec{FluentdSpec: &v1beta1.FluentdSpec{
+		Shards: []v1beta1.FluentdShard{
+			{
+				Name:       "team-a",
+				Namespaces: []string{"a2", "a1"},
+			},
+			{
+				Name:       "team-b",
+				Namespaces: []string{"a1"},
+				NamespaceSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"team": "b"},
+				},
+			},
+			{
+				Name: "empty",
+				NamespaceSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"team": "none"},
+				},
+			},
+		},
+	}}}
+	namespaces := []corev1.Namespace{
+		namespace("a1", map[string]string{"team": "b"}),
+		namespace("a2", nil),
+		namespace("b1", map[string]string{"team": "b"}),
+		namespace("b2", map[string]string{"team": "b"}),
+		namespace("default", nil),
+	}
+
+	result, err := shardNamespaces(logging, namespaces)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// a namespace belongs to the first shard it matches
+	expected := map[string][]string{
+		"team-a": {"a1", "a2"},
+		"team-b": {"b1", "b2"},
+		"empty":  {},
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+
+	result, err = shardNamespaces(v1beta1.Logging{Spec: v1beta1.LoggingSpec{FluentdSpec: &v1beta1.FluentdSpec{}}}, namespaces)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result != nil {
+		t.Errorf("no shard assignment expected without shards, got %v", result)
+	}
+
+	logging.Spec.FluentdSpec.Shards[1].NamespaceSelector.MatchExpressions = []metav1.LabelSelectorRequirement{
+		{Key: "team", Operator: "Invalid"},
+	}
+	if _, err := shardNamespaces(logging, namespaces); err == nil {
+		t.Error("invalid namespace selector should fail")
+	}
+}
+
+func TestForShard(t *testing.T) {
+	flow := func(namespace, name string) v1beta1.Flow {
+		return v1beta1.Flow{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}}
+	}
+	output := func(namespace, name string) v1beta1.Output {
+		return v1beta1.Output{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}}
+	}
+	resources := LoggingResources{
+		ClusterFlows:   []v1beta1.ClusterFlow{{ObjectMeta: metav1.ObjectMeta{Name: "all"}}},
+		ClusterOutputs: []v1beta1.ClusterOutput{{ObjectMeta: metav1.ObjectMeta{Name: "all"}}},
+		Flows:          []v1beta1.Flow{flow("a1", "flow"), flow("b1", "flow"), flow("default", "flow")},
+		Outputs:        Outputs{output("a1", "output"), output("default", "output")},
+		ShardNamespaces: map[string][]string{
+			"team-a": {"a1"},
+			"team-b": {"b1"},
+		},
+	}
+
+	for shard, expected := range map[string]struct {
+		flows   []v1beta1.Flow
+		outputs Outputs
+	}{
+		"":       {flows: []v1beta1.Flow{flow("default", "flow")}, outputs: Outputs{output("default", "output")}},
+		"team-a": {flows: []v1beta1.Flow{flow("a1", "flow")}, outputs: Outputs{output("a1", "output")}},
+		"team-b": {flows: []v1beta1.Flow{flow("b1", "flow")}},
+	} {
+		res := resources.ForShard(shard)
+		if res.Shard != shard {
+			t.Errorf("expected shard %q, got %q", shard, res.Shard)
+		}
+		if !reflect.DeepEqual(res.Flows, expected.flows) {
+			t.Errorf("shard %q: expected flows %v, got %v", shard, expected.flows, res.Flows)
+		}
+		if !reflect.DeepEqual(res.Outputs, expected.outputs) {
+			t.Errorf("shard %q: expected outputs %v, got %v", shard, expected.outputs, res.Outputs)
+		}
+		if len(res.ClusterFlows) != 1 || len(res.ClusterOutputs) != 1 {
+			t.Errorf("shard %q: cluster resources should be part of every shard", shard)
+		}
+	}
+
+	// without shards every resource belongs to the main fluentd
+	resources.ShardNamespaces = nil
+	if res := resources.ForShard(""); !reflect.DeepEqual(res.Flows, resources.Flows) || !reflect.DeepEqual(res.Outputs, resources.Outputs) {
+		t.Errorf("every resource should belong to the main fluentd without shards")
+	}
+}
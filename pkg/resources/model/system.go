@@ -16,6 +16,7 @@ package model
 
 import (
 	"fmt"
+	"reflect"
 	"strconv"
 
 	"emperror.dev/errors"
@@ -25,6 +26,7 @@ import (
 
 	"github.com/banzaicloud/logging-operator/pkg/sdk/api/v1beta1"
 	"github.com/banzaicloud/logging-operator/pkg/sdk/model/common"
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/filter"
 	"github.com/banzaicloud/logging-operator/pkg/sdk/model/input"
 	"github.com/banzaicloud/logging-operator/pkg/sdk/model/types"
 	"github.com/banzaicloud/logging-operator/pkg/sdk/plugins"
@@ -76,8 +78,31 @@ func CreateSystem(resources LoggingResources, secrets SecretLoaderFactory, logge
 
 	builder := types.NewSystemBuilder(rootInput, globalFilters, router)
 
+	// additional inputs belong to the main fluentd only
+	mainFluentd := resources.Shard == ""
+	if mainFluentd {
+		for _, inputSpec := range logging.Spec.FluentdSpec.Inputs {
+			input, err := plugins.CreateInput(inputSpec, secrets.OutputSecretLoaderForNamespace(logging.Spec.ControlNamespace))
+			if err != nil {
+				return nil, errors.WrapIff(err, "creating input %q", inputSpec.Name)
+			}
+			if err := builder.RegisterInput(inputSpec.Name, input); err != nil {
+				return nil, err
+			}
+		}
+		for _, syslogInput := range logging.Spec.SyslogInputs {
+			input, err := syslogInputDirective(syslogInput, secrets.OutputSecretLoaderForNamespace(logging.Spec.ControlNamespace))
+			if err != nil {
+				return nil, errors.WrapIff(err, "creating syslog input %q", syslogInput.Name)
+			}
+			if err := builder.RegisterInput(syslogInput.Name, input); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	for _, flowCr := range resources.Flows {
-		flow, err := FlowForFlow(flowCr, resources.ClusterOutputs, resources.Outputs, secrets)
+		flow, err := FlowForFlow(flowCr, resources.Logging, resources.ClusterOutputs, resources.Outputs, secrets)
 		if err != nil {
 			// TODO set flow status to error?
 			return nil, err
@@ -88,12 +113,19 @@ func CreateSystem(resources LoggingResources, secrets SecretLoaderFactory, logge
 		}
 	}
 	for _, flowCr := range resources.ClusterFlows {
-		flow, err := FlowForClusterFlow(flowCr, resources.ClusterOutputs, secrets)
+		if len(flowCr.Spec.Inputs) > 0 && !mainFluentd {
+			continue
+		}
+		flow, err := FlowForClusterFlow(flowCr, resources.Logging, resources.ClusterOutputs, secrets)
 		if err != nil {
 			// TODO set flow status to error?
 			return nil, err
 		}
-		err = builder.RegisterFlow(flow)
+		if len(flowCr.Spec.Inputs) > 0 {
+			err = builder.RegisterInputFlow(flow, flowCr.Spec.Inputs)
+		} else {
+			err = builder.RegisterFlow(flow)
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -110,9 +142,24 @@ func CreateSystem(resources LoggingResources, secrets SecretLoaderFactory, logge
 		}
 	}
 
+	// a dangling reference is reported in the status of the logging by the validation
+	if ref := resources.Logging.Spec.ErrorOutputRef; ref != "" && resources.ClusterOutputs.FindByName(ref) != nil {
+		flow, err := FlowForErrorOutput(resources.Logging, resources.ClusterOutputs, secrets)
+		if err != nil {
+			return nil, err
+		}
+		err = builder.RegisterErrorFlow(flow)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	system, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
 
-	if system != nil && len(system.Flows) == 0 {
+	if len(system.Flows) == 0 {
 		logger.Info("no flows found, generating empty model")
 	}
 
@@ -125,7 +172,7 @@ func CreateSystem(resources LoggingResources, secrets SecretLoaderFactory, logge
 		}
 	}
 
-	return system, err
+	return system, nil
 }
 
 func unsetBufferPath(directive types.Directive) {
@@ -159,7 +206,7 @@ func filtersForFilters(flowID string, flowName string, secretLoader secret.Secre
 	return result, errs
 }
 
-func FlowForFlow(flow v1beta1.Flow, clusterOutputs ClusterOutputs, outputs Outputs, secrets SecretLoaderFactory) (*types.Flow, error) {
+func FlowForFlow(flow v1beta1.Flow, logging v1beta1.Logging, clusterOutputs ClusterOutputs, outputs Outputs, secrets SecretLoaderFactory) (*types.Flow, error) {
 	if flow.Spec.Match != nil && flow.Spec.Selectors != nil {
 		return nil, errors.Errorf("match and selectors cannot be defined simultaneously for flow %s",
 			utils.ObjectKeyFromObjectMeta(&flow).String())
@@ -215,7 +262,7 @@ func FlowForFlow(flow v1beta1.Flow, clusterOutputs ClusterOutputs, outputs Outpu
 	for _, outputRef := range flow.Spec.GlobalOutputRefs {
 		if clusterOutput := clusterOutputs.FindByName(outputRef); clusterOutput != nil {
 			outputID := fmt.Sprintf("%s:clusteroutput:%s:%s", flowID, clusterOutput.Namespace, clusterOutput.Name)
-			plugin, err := plugins.CreateOutput(clusterOutput.Spec.OutputSpec, outputID, secrets.OutputSecretLoaderForNamespace(clusterOutput.Namespace))
+			plugin, err := clusterOutputPlugin(clusterOutput, outputID, clusterOutputs, secrets)
 			if err != nil {
 				errs = errors.Append(errs, errors.WrapIff(err, "failed to create configured output %q", outputRef))
 				continue
@@ -228,7 +275,7 @@ func FlowForFlow(flow v1beta1.Flow, clusterOutputs ClusterOutputs, outputs Outpu
 	for _, outputRef := range flow.Spec.LocalOutputRefs {
 		if output := outputs.FindByNamespacedName(flow.Namespace, outputRef); output != nil {
 			outputID := fmt.Sprintf("%s:output:%s:%s", flowID, output.Namespace, output.Name)
-			plugin, err := plugins.CreateOutput(output.Spec, outputID, secrets.OutputSecretLoaderForNamespace(output.Namespace))
+			plugin, err := outputPlugin(output, outputID, outputs, secrets)
 			if err != nil {
 				errs = errors.Append(errs, errors.WrapIff(err, "failed to create configured output %q", outputRef))
 				continue
@@ -244,10 +291,12 @@ func FlowForFlow(flow v1beta1.Flow, clusterOutputs ClusterOutputs, outputs Outpu
 	errs = errors.Append(errs, err)
 	result.WithFilters(filters...)
 
+	errs = errors.Append(errs, withEnforcedOutputFilters(result, flow.Name, logging, secrets))
+
 	return result, errs
 }
 
-func FlowForClusterFlow(flow v1beta1.ClusterFlow, clusterOutputs ClusterOutputs, secrets SecretLoaderFactory) (*types.Flow, error) {
+func FlowForClusterFlow(flow v1beta1.ClusterFlow, logging v1beta1.Logging, clusterOutputs ClusterOutputs, secrets SecretLoaderFactory) (*types.Flow, error) {
 	if flow.Spec.Match != nil && flow.Spec.Selectors != nil {
 		return nil, errors.Errorf("match and selectors cannot be defined simultaneously for clusterflow %s",
 			utils.ObjectKeyFromObjectMeta(&flow).String())
@@ -303,7 +352,7 @@ func FlowForClusterFlow(flow v1beta1.ClusterFlow, clusterOutputs ClusterOutputs,
 	for _, outputRef := range flow.Spec.GlobalOutputRefs {
 		if clusterOutput := clusterOutputs.FindByName(outputRef); clusterOutput != nil {
 			outputID := fmt.Sprintf("%s:clusteroutput:%s:%s", flowID, clusterOutput.Namespace, clusterOutput.Name)
-			plugin, err := plugins.CreateOutput(clusterOutput.Spec.OutputSpec, outputID, secrets.OutputSecretLoaderForNamespace(clusterOutput.Namespace))
+			plugin, err := clusterOutputPlugin(clusterOutput, outputID, clusterOutputs, secrets)
 			if err != nil {
 				errs = errors.Append(errs, errors.WrapIff(err, "failed to create configured output %q", outputRef))
 				continue
@@ -319,6 +368,8 @@ func FlowForClusterFlow(flow v1beta1.ClusterFlow, clusterOutputs ClusterOutputs,
 	errs = errors.Append(errs, err)
 	result.WithFilters(filters...)
 
+	errs = errors.Append(errs, withEnforcedOutputFilters(result, flow.Name, logging, secrets))
+
 	return result, errs
 }
 
@@ -340,7 +391,7 @@ func FlowForDefaultFlow(logging v1beta1.Logging, clusterOutputs ClusterOutputs,
 	for _, outputRef := range logging.Spec.DefaultFlowSpec.GlobalOutputRefs {
 		if clusterOutput := clusterOutputs.FindByName(outputRef); clusterOutput != nil {
 			outputID := fmt.Sprintf("%s:clusteroutput:%s:%s", flowID, clusterOutput.Namespace, clusterOutput.Name)
-			plugin, err := plugins.CreateOutput(clusterOutput.Spec.OutputSpec, outputID, secrets.OutputSecretLoaderForNamespace(clusterOutput.Namespace))
+			plugin, err := clusterOutputPlugin(clusterOutput, outputID, clusterOutputs, secrets)
 			if err != nil {
 				errs = errors.Append(errs, errors.WrapIff(err, "failed to create configured output %q", outputRef))
 				continue
@@ -356,5 +407,204 @@ func FlowForDefaultFlow(logging v1beta1.Logging, clusterOutputs ClusterOutputs,
 	errs = errors.Append(errs, err)
 	result.WithFilters(filters...)
 
+	errs = errors.Append(errs, withEnforcedOutputFilters(result, logging.Name, logging, secrets))
+
 	return result, errs
 }
+
+func FlowForErrorOutput(logging v1beta1.Logging, clusterOutputs ClusterOutputs, secrets SecretLoaderFactory) (*types.Flow, error) {
+	flowID := fmt.Sprintf("logging:%s:%s:error", logging.Namespace, logging.Name)
+
+	result := types.NewErrorFlow(flowID)
+
+	outputRef := logging.Spec.ErrorOutputRef
+	clusterOutput := clusterOutputs.FindByName(outputRef)
+	if clusterOutput == nil {
+		return nil, errors.Errorf("referenced error clusteroutput not found: %s", outputRef)
+	}
+
+	tagger := &filter.RecordTransformer{
+		Records: []filter.Record{
+			{"error_tag": "${tag}"},
+		},
+	}
+	taggerFilter, err := tagger.ToDirective(secrets.OutputSecretLoaderForNamespace(logging.Namespace), flowID+":error_tag")
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to create error tag filter")
+	}
+	result.WithFilters(taggerFilter, &types.GenericDirective{
+		PluginMeta: types.PluginMeta{
+			Type:      "error_reason",
+			Directive: "filter",
+			Tag:       "**",
+			Id:        flowID + ":error_reason",
+		},
+		Params: types.Params{
+			"key": "error_reason",
+		},
+	})
+
+	outputID := fmt.Sprintf("%s:clusteroutput:%s:%s", flowID, clusterOutput.Namespace, clusterOutput.Name)
+	plugin, err := clusterOutputPlugin(clusterOutput, outputID, clusterOutputs, secrets)
+	if err != nil {
+		return nil, errors.WrapIff(err, "failed to create configured error output %q", outputRef)
+	}
+	result.WithOutputs(plugin)
+
+	if err := withEnforcedOutputFilters(result, logging.Name, logging, secrets); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// enforcedOutputFilters creates the filters every flow applies right before its outputs
+func enforcedOutputFilters(id string, flowName string, logging v1beta1.Logging, secrets SecretLoaderFactory) ([]types.Filter, error) {
+	return filtersForFilters(
+		id+":enforced",
+		flowName,
+		secrets.OutputSecretLoaderForNamespace(logging.Spec.ControlNamespace),
+		logging.Spec.EnforcedOutputFilters)
+}
+
+// withEnforcedOutputFilters adds the enforced filters to the flow after its filters.
+// The filters of the outputs follow the filters of the flow, so when an output has its own filters,
+// the enforced filters are moved into the label of every output of the flow, after the filters of the output.
+func withEnforcedOutputFilters(flow *types.Flow, flowName string, logging v1beta1.Logging, secrets SecretLoaderFactory) error {
+	if len(logging.Spec.EnforcedOutputFilters) == 0 {
+		return nil
+	}
+
+	var filteredOutputs bool
+	for _, output := range flow.Outputs {
+		if _, ok := output.(*types.FilteredOutput); ok {
+			filteredOutputs = true
+		}
+	}
+	if !filteredOutputs {
+		filters, err := enforcedOutputFilters(flow.FlowID, flowName, logging, secrets)
+		flow.WithFilters(filters...)
+		return err
+	}
+
+	var errs error
+	for i, output := range flow.Outputs {
+		if filtered, ok := output.(*types.FilteredOutput); ok {
+			filters, err := enforcedOutputFilters(filtered.Flow.FlowID, flowName, logging, secrets)
+			errs = errors.Append(errs, err)
+			filtered.Flow.WithFilters(filters...)
+			continue
+		}
+		outputID := output.GetPluginMeta().Id
+		filters, err := enforcedOutputFilters(outputID, flowName, logging, secrets)
+		errs = errors.Append(errs, err)
+		flow.Outputs[i] = types.NewFilteredOutput(outputID, filters, output)
+	}
+	return errs
+}
+
+func clusterOutputPlugin(clusterOutput *v1beta1.ClusterOutput, outputID string, clusterOutputs ClusterOutputs, secrets SecretLoaderFactory) (types.Directive, error) {
+	secretLoader := secrets.OutputSecretLoaderForNamespace(clusterOutput.Namespace)
+	plugin, err := plugins.CreateOutput(clusterOutput.Spec.OutputSpec, outputID, secretLoader)
+	if err != nil {
+		return nil, err
+	}
+
+	if secondaryRef := clusterOutput.Spec.SecondaryOutputRef; secondaryRef != "" {
+		secondaryOutput := clusterOutputs.FindByName(secondaryRef)
+		if secondaryOutput == nil {
+			return nil, errors.Errorf("referenced secondary clusteroutput not found: %s", secondaryRef)
+		}
+		if err := validateSecondaryOutputSpec(clusterOutput.Name, secondaryOutput.Spec.OutputSpec); err != nil {
+			return nil, err
+		}
+		secondary, err := plugins.CreateOutput(secondaryOutput.Spec.OutputSpec, fmt.Sprintf("%s:secondary:%s", outputID, secondaryRef), secrets.OutputSecretLoaderForNamespace(secondaryOutput.Namespace))
+		if err != nil {
+			return nil, errors.WrapIff(err, "failed to create secondary output %q", secondaryRef)
+		}
+		if plugin, err = types.NewSecondaryDirective(plugin, secondary); err != nil {
+			return nil, err
+		}
+	}
+
+	return withOutputFilters(plugin, outputID, clusterOutput.Name, secretLoader, clusterOutput.Spec.Filters)
+}
+
+func outputPlugin(output *v1beta1.Output, outputID string, outputs Outputs, secrets SecretLoaderFactory) (types.Directive, error) {
+	secretLoader := secrets.OutputSecretLoaderForNamespace(output.Namespace)
+	plugin, err := plugins.CreateOutput(output.Spec, outputID, secretLoader)
+	if err != nil {
+		return nil, err
+	}
+
+	if secondaryRef := output.Spec.SecondaryOutputRef; secondaryRef != "" {
+		secondaryOutput := outputs.FindByNamespacedName(output.Namespace, secondaryRef)
+		if secondaryOutput == nil {
+			return nil, errors.Errorf("referenced secondary output not found: %s", secondaryRef)
+		}
+		if err := validateSecondaryOutputSpec(output.Name, secondaryOutput.Spec); err != nil {
+			return nil, err
+		}
+		secondary, err := plugins.CreateOutput(secondaryOutput.Spec, fmt.Sprintf("%s:secondary:%s", outputID, secondaryRef), secrets.OutputSecretLoaderForNamespace(secondaryOutput.Namespace))
+		if err != nil {
+			return nil, errors.WrapIff(err, "failed to create secondary output %q", secondaryRef)
+		}
+		if plugin, err = types.NewSecondaryDirective(plugin, secondary); err != nil {
+			return nil, err
+		}
+	}
+
+	return withOutputFilters(plugin, outputID, output.Name, secretLoader, output.Spec.Filters)
+}
+
+func withOutputFilters(plugin types.Directive, outputID string, outputName string, secretLoader secret.SecretLoader, filters []v1beta1.Filter) (types.Directive, error) {
+	if len(filters) == 0 {
+		return plugin, nil
+	}
+	outputFilters, err := filtersForFilters(outputID, outputName, secretLoader, filters)
+	if err != nil {
+		return nil, err
+	}
+	return types.NewFilteredOutput(outputID, outputFilters, plugin), nil
+}
+
+func validateSecondaryOutputSpec(name string, secondary v1beta1.OutputSpec) error {
+	if secondary.SecondaryOutputRef != "" {
+		return errors.Errorf("secondary output of %s cannot have a secondary output itself", name)
+	}
+	if secondary.NullOutputConfig != nil {
+		return errors.Errorf("secondary output of %s cannot be a null output", name)
+	}
+	// the secondary output writes the chunks of the primary output's buffer
+	v := reflect.ValueOf(secondary)
+	for i := 0; i < v.NumField(); i++ {
+		if v.Field(i).Kind() != reflect.Ptr || v.Field(i).IsNil() || v.Field(i).Elem().Kind() != reflect.Struct {
+			continue
+		}
+		if buffer := v.Field(i).Elem().FieldByName("Buffer"); buffer.IsValid() && buffer.Kind() == reflect.Ptr && !buffer.IsNil() {
+			return errors.Errorf("secondary output of %s cannot have a buffer, it uses the buffer of %s", name, name)
+		}
+	}
+	return nil
+}
+
+// syslogInputDirective renders the listener of a syslog input, the tls transport defaults to the certificates mounted from the TLS secret
+func syslogInputDirective(syslogInput v1beta1.SyslogInput, secretLoader secret.SecretLoader) (types.Directive, error) {
+	config := syslogInput.Syslog.DeepCopy()
+	if config.GetTransport() == input.SyslogTransportTLS && syslogInput.TLSSecretName != "" {
+		if config.TLS == nil {
+			config.TLS = &input.SyslogInputTLS{}
+		}
+		tlsPath := v1beta1.SyslogInputTLSPath + syslogInput.Name + "/"
+		if config.TLS.CertPath == "" {
+			config.TLS.CertPath = tlsPath + "tls.crt"
+		}
+		if config.TLS.PrivateKeyPath == "" {
+			config.TLS.PrivateKeyPath = tlsPath + "tls.key"
+		}
+		if config.TLS.CAPath == "" && config.TLS.ClientCertAuth {
+			config.TLS.CAPath = tlsPath + "ca.crt"
+		}
+	}
+	return config.ToDirective(secretLoader, syslogInput.Name)
+}
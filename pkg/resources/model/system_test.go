@@ -0,0 +1,435 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/andreyvit/diff"
+	"github.com/banzaicloud/logging-operator/pkg/sdk/api/v1beta1"
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/filter"
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/output"
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/render"
+	"github.com/banzaicloud/operator-tools/pkg/secret"
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+type testSecretLoaderFactory struct{}
+
+func (testSecretLoaderFactory) OutputSecretLoaderForNamespace(namespace string) secret.SecretLoader {
+	return secret.NewSecretLoader(nil, namespace, "", nil)
+}
+
+func testResources(t *testing.T, spec v1beta1.LoggingSpec) LoggingResources {
+	logging := v1beta1.Logging{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec:       spec,
+	}
+	logging.Spec.ControlNamespace = "logging"
+	if logging.Spec.FluentdSpec == nil {
+		logging.Spec.FluentdSpec = &v1beta1.FluentdSpec{}
+	}
+	if err := logging.SetDefaults(); err != nil {
+		t.Fatal(err)
+	}
+	return LoggingResources{
+		Logging: logging,
+		ClusterOutputs: ClusterOutputs{
+			{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "logging", Name: "errors"},
+				Spec: v1beta1.ClusterOutputSpec{OutputSpec: v1beta1.OutputSpec{
+					NullOutputConfig: output.NewNullOutputConfig(),
+				}},
+			},
+		},
+	}
+}
+
+func renderSystem(t *testing.T, resources LoggingResources) string {
+	system, err := CreateSystem(resources, testSecretLoaderFactory{}, logr.Discard())
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := &bytes.Buffer{}
+	renderer := render.FluentRender{Out: out, Indent: 2}
+	if err := renderer.Render(system); err != nil {
+		t.Fatal(err)
+	}
+	return out.String()
+}
+
+func assertConfig(t *testing.T, expected, config string) {
+	t.Helper()
+	if a, e := diff.TrimLinesInString(config), diff.TrimLinesInString(expected); a != e {
+		t.Errorf("result does not match the expected config:\n%v", diff.LineDiff(e, a))
+	}
+}
+
+const mainConfig = `
+		<source>
+		  @type forward
+		  @id main_forward
+		  bind 0.0.0.0
+		  port 24240
+		</source>
+		<match **>
+		  @type label_router
+		  @id main
+		  metrics false
+		</match>`
+
+func TestErrorOutput(t *testing.T) {
+	config := renderSystem(t, testResources(t, v1beta1.LoggingSpec{ErrorOutputRef: "errors"}))
+	assertConfig(t, heredoc.Doc(mainConfig+`
+		<label @ERROR>
+		  <filter **>
+		    @type record_transformer
+		    @id logging::test:error:error_tag
+		    <record>
+		      error_tag ${tag}
+		    </record>
+		  </filter>
+		  <filter **>
+		    @type error_reason
+		    @id logging::test:error:error_reason
+		    key error_reason
+		  </filter>
+		  <match **>
+		    @type null
+		    @id logging::test:error:clusteroutput:logging:errors
+		  </match>
+		</label>`), config)
+}
+
+func TestDanglingErrorOutput(t *testing.T) {
+	resources := testResources(t, v1beta1.LoggingSpec{ErrorOutputRef: "missing"})
+
+	// the error flow is skipped instead of failing the whole configuration
+	config := renderSystem(t, resources)
+	assertConfig(t, heredoc.Doc(mainConfig), config)
+
+	scheme := runtime.NewScheme()
+	if err := v1beta1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(resources.Logging.DeepCopy(), resources.ClusterOutputs[0].DeepCopy()).
+		Build()
+	if _, err := NewValidationReconciler(context.Background(), c, resources, testSecretLoaderFactory{})(); err != nil {
+		t.Fatal(err)
+	}
+
+	var logging v1beta1.Logging
+	if err := c.Get(context.Background(), client.ObjectKey{Name: "test"}, &logging); err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"dangling error output reference: missing"}
+	if !reflect.DeepEqual(logging.Status.Problems, expected) || logging.Status.ProblemsCount != 1 {
+		t.Errorf("expected problems %v, got %v", expected, logging.Status.Problems)
+	}
+}
+
+func TestEnforcedOutputFilters(t *testing.T) {
+	resources := testResources(t, v1beta1.LoggingSpec{
+		ErrorOutputRef: "errors",
+		EnforcedOutputFilters: []v1beta1.Filter{
+			{RecordTransformer: &filter.RecordTransformer{Records: []filter.Record{{"cluster": "production"}}}},
+		},
+		DefaultFlowSpec: &v1beta1.DefaultFlowSpec{
+			Filters:          []v1beta1.Filter{{StdOut: &filter.StdOutFilterConfig{}}},
+			GlobalOutputRefs: []string{"errors"},
+		},
+	})
+	resources.ClusterFlows = []v1beta1.ClusterFlow{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "logging", Name: "all"},
+			Spec: v1beta1.ClusterFlowSpec{
+				Filters:          []v1beta1.Filter{{StdOut: &filter.StdOutFilterConfig{}}},
+				GlobalOutputRefs: []string{"errors"},
+			},
+		},
+	}
+	resources.Flows = []v1beta1.Flow{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "app"},
+			Spec: v1beta1.FlowSpec{
+				Filters:         []v1beta1.Filter{{StdOut: &filter.StdOutFilterConfig{}}},
+				LocalOutputRefs: []string{"null"},
+			},
+		},
+	}
+	resources.Outputs = Outputs{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "null"},
+			Spec:       v1beta1.OutputSpec{NullOutputConfig: output.NewNullOutputConfig()},
+		},
+	}
+
+	// the enforced filters follow the filters of every flow, including the error flow
+	config := renderSystem(t, resources)
+	assertConfig(t, heredoc.Doc(`
+		<source>
+		  @type forward
+		  @id main_forward
+		  bind 0.0.0.0
+		  port 24240
+		</source>
+		<match **>
+		  @type label_router
+		  @id main
+		  default_route @098f6bcd4621d373cade4e832627b4f6
+		  metrics false
+		  <route>
+		    @label @8610f7407c2029283d4547a67252d4c7
+		    metrics_labels {"id":"flow:default:app"}
+		    <match>
+		      namespaces default
+		      negate false
+		    </match>
+		  </route>
+		  <route>
+		    @label @a10bf4e982aa3afa26babff99b20c91b
+		    metrics_labels {"id":"clusterflow:logging:all"}
+		    <match>
+		      namespaces 
+		      negate false
+		    </match>
+		  </route>
+		</match>
+		<label @8610f7407c2029283d4547a67252d4c7>
+		  <filter **>
+		    @type stdout
+		    @id flow:default:app:0
+		  </filter>
+		  <filter **>
+		    @type record_transformer
+		    @id flow:default:app:enforced:0
+		    <record>
+		      cluster production
+		    </record>
+		  </filter>
+		  <match **>
+		    @type null
+		    @id flow:default:app:output:default:null
+		  </match>
+		</label>
+		<label @a10bf4e982aa3afa26babff99b20c91b>
+		  <filter **>
+		    @type stdout
+		    @id clusterflow:logging:all:0
+		  </filter>
+		  <filter **>
+		    @type record_transformer
+		    @id clusterflow:logging:all:enforced:0
+		    <record>
+		      cluster production
+		    </record>
+		  </filter>
+		  <match **>
+		    @type null
+		    @id clusterflow:logging:all:clusteroutput:logging:errors
+		  </match>
+		</label>
+		<label @098f6bcd4621d373cade4e832627b4f6>
+		  <filter **>
+		    @type stdout
+		    @id logging::test:0
+		  </filter>
+		  <filter **>
+		    @type record_transformer
+		    @id logging::test:enforced:0
+		    <record>
+		      cluster production
+		    </record>
+		  </filter>
+		  <match **>
+		    @type null
+		    @id logging::test:clusteroutput:logging:errors
+		  </match>
+		</label>
+		<label @ERROR>
+		  <filter **>
+		    @type record_transformer
+		    @id logging::test:error:error_tag
+		    <record>
+		      error_tag ${tag}
+		    </record>
+		  </filter>
+		  <filter **>
+		    @type error_reason
+		    @id logging::test:error:error_reason
+		    key error_reason
+		  </filter>
+		  <filter **>
+		    @type record_transformer
+		    @id logging::test:error:enforced:0
+		    <record>
+		      cluster production
+		    </record>
+		  </filter>
+		  <match **>
+		    @type null
+		    @id logging::test:error:clusteroutput:logging:errors
+		  </match>
+		</label>`), config)
+}
+
+func TestEnforcedOutputFiltersAfterOutputFilters(t *testing.T) {
+	resources := testResources(t, v1beta1.LoggingSpec{
+		EnforcedOutputFilters: []v1beta1.Filter{
+			{Redact: &filter.Redact{Keys: []string{"token"}, CustomPatterns: []string{`.+`}, Action: "drop"}},
+		},
+	})
+	resources.Flows = []v1beta1.Flow{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "app"},
+			Spec: v1beta1.FlowSpec{
+				LocalOutputRefs: []string{"leaky", "null"},
+			},
+		},
+	}
+	resources.Outputs = Outputs{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "leaky"},
+			Spec: v1beta1.OutputSpec{
+				Filters: []v1beta1.Filter{
+					{RecordTransformer: &filter.RecordTransformer{Records: []filter.Record{{"token": "restored"}}}},
+				},
+				NullOutputConfig: output.NewNullOutputConfig(),
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "null"},
+			Spec:       v1beta1.OutputSpec{NullOutputConfig: output.NewNullOutputConfig()},
+		},
+	}
+
+	// the key re-added by the filter of the output is redacted, the output without filters gets a label of its own
+	config := renderSystem(t, resources)
+	assertConfig(t, heredoc.Doc(`
+		<source>
+		  @type forward
+		  @id main_forward
+		  bind 0.0.0.0
+		  port 24240
+		</source>
+		<match **>
+		  @type label_router
+		  @id main
+		  metrics false
+		  <route>
+		    @label @8610f7407c2029283d4547a67252d4c7
+		    metrics_labels {"id":"flow:default:app"}
+		    <match>
+		      namespaces default
+		      negate false
+		    </match>
+		  </route>
+		</match>
+		<label @8610f7407c2029283d4547a67252d4c7>
+		  <match **>
+		    @type copy
+		    <store>
+		      @type relabel
+		      @label @b1bfa8f74f50e8c0e9d7e29105b9a10c
+		    </store>
+		    <store>
+		      @type relabel
+		      @label @44c5340a10268f71c2257ccd15f70a50
+		    </store>
+		  </match>
+		</label>
+		<label @b1bfa8f74f50e8c0e9d7e29105b9a10c>
+		  <filter **>
+		    @type record_transformer
+		    @id flow:default:app:output:default:leaky:0
+		    <record>
+		      token restored
+		    </record>
+		  </filter>
+		  <filter **>
+		    @type record_modifier
+		    @id flow:default:app:output:default:leaky:enforced:0
+		    prepare_value @redact_keys = ['token']; @redact_pattern = Regexp.new('(?:.+)')
+		    remove_keys __redact
+		    <record>
+		      __redact ${@redact_keys.each { |k| next unless record[k].is_a?(String); record.delete(k) if record[k] =~ @redact_pattern }; nil}
+		    </record>
+		  </filter>
+		  <match **>
+		    @type null
+		    @id flow:default:app:output:default:leaky
+		  </match>
+		</label>
+		<label @44c5340a10268f71c2257ccd15f70a50>
+		  <filter **>
+		    @type record_modifier
+		    @id flow:default:app:output:default:null:enforced:0
+		    prepare_value @redact_keys = ['token']; @redact_pattern = Regexp.new('(?:.+)')
+		    remove_keys __redact
+		    <record>
+		      __redact ${@redact_keys.each { |k| next unless record[k].is_a?(String); record.delete(k) if record[k] =~ @redact_pattern }; nil}
+		    </record>
+		  </filter>
+		  <match **>
+		    @type null
+		    @id flow:default:app:output:default:null
+		  </match>
+		</label>`), config)
+}
+
+func TestSecondaryOutputBuffer(t *testing.T) {
+	resources := testResources(t, v1beta1.LoggingSpec{})
+	resources.Flows = []v1beta1.Flow{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "app"},
+			Spec:       v1beta1.FlowSpec{LocalOutputRefs: []string{"primary"}},
+		},
+	}
+	resources.Outputs = Outputs{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "primary"},
+			Spec: v1beta1.OutputSpec{
+				FileOutput:         &output.FileOutputConfig{Path: "/tmp/primary"},
+				SecondaryOutputRef: "backup",
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "backup"},
+			Spec: v1beta1.OutputSpec{
+				FileOutput: &output.FileOutputConfig{Path: "/tmp/backup", Buffer: &output.Buffer{ChunkLimitSize: "1MB"}},
+			},
+		},
+	}
+
+	_, err := CreateSystem(resources, testSecretLoaderFactory{}, logr.Discard())
+	if expected := "secondary output of primary cannot have a buffer, it uses the buffer of primary"; err == nil || !strings.Contains(err.Error(), expected) {
+		t.Errorf("expected error %q, got %v", expected, err)
+	}
+
+	resources.Outputs[1].Spec.FileOutput.Buffer = nil
+	if _, err := CreateSystem(resources, testSecretLoaderFactory{}, logr.Discard()); err != nil {
+		t.Fatal(err)
+	}
+}
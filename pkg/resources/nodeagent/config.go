@@ -65,9 +65,15 @@ var fluentBitConfigTemplate = `
     {{- end }}
 {{- end}}
 
+{{- if not .DisableForward }}
+
 [OUTPUT]
     Name          forward
+    {{- if .DefaultMatchRegex }}
+    Match_Regex   {{ .DefaultMatchRegex }}
+    {{- else }}
     Match         *
+    {{- end }}
     {{- if .Upstream.Enabled }}
     Upstream /fluent-bit/conf_upstream/upstream.conf
     {{- else }}
@@ -105,6 +111,8 @@ var fluentBitConfigTemplate = `
     {{- end }}
     {{- end }}
     {{- end }}
+{{- end }}
+{{- template "directOutputs" .DirectOutputs }}
 `
 
 var upstreamConfigTemplate = `
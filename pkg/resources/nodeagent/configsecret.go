@@ -16,13 +16,16 @@ package nodeagent
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"text/template"
 
 	"emperror.dev/errors"
+	"github.com/banzaicloud/logging-operator/pkg/resources/fluentbit"
 	"github.com/banzaicloud/logging-operator/pkg/resources/fluentd"
 	"github.com/banzaicloud/logging-operator/pkg/sdk/model/types"
 	"github.com/banzaicloud/operator-tools/pkg/reconciler"
+	util "github.com/banzaicloud/operator-tools/pkg/utils"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -77,8 +80,11 @@ type fluentBitConfig struct {
 		KeepaliveMaxRecycle     uint32
 		KeepaliveMaxRecycleSet  bool
 	}
-	ForwardOptions map[string]string
-	Upstream       struct {
+	ForwardOptions    map[string]string
+	DefaultMatchRegex string
+	DisableForward    bool
+	DirectOutputs     []fluentbit.DirectOutput
+	Upstream          struct {
 		Enabled bool
 		Config  upstream
 	}
@@ -163,12 +169,17 @@ func (n *nodeAgentInstance) configSecret() (runtime.Object, reconciler.DesiredSt
 		},
 		Monitor:                 monitor,
 		TargetHost:              fmt.Sprintf("%s.%s.svc.cluster.local", n.FluentdQualifiedName(fluentd.ServiceName), n.logging.Spec.ControlNamespace),
-		TargetPort:              n.logging.Spec.FluentdSpec.Port,
 		Input:                   fluentbitInput,
 		DisableKubernetesFilter: disableKubernetesFilter,
 		KubernetesFilter:        fluentbitKubernetesFilter,
 		BufferStorage:           fluentbitBufferStorage,
 	}
+	if n.logging.Spec.FluentdSpec != nil {
+		input.TargetPort = n.logging.Spec.FluentdSpec.Port
+	} else if n.nodeAgent.FluentbitSpec.TargetHost == "" {
+		// without fluentd the records are delivered by the direct outputs only
+		input.DisableForward = true
+	}
 	if n.nodeAgent.FluentbitSpec.FilterAws != nil {
 		awsFilter, err := mapper.StringsMap(n.nodeAgent.FluentbitSpec.FilterAws)
 		if err != nil {
@@ -212,7 +223,7 @@ func (n *nodeAgentInstance) configSecret() (runtime.Object, reconciler.DesiredSt
 		}
 	}
 
-	if n.logging.Spec.FluentdSpec.Scaling.Replicas > 1 && n.nodeAgent.FluentbitSpec.Network == nil {
+	if n.logging.Spec.FluentdSpec != nil && n.logging.Spec.FluentdSpec.Scaling.Replicas > 1 && n.nodeAgent.FluentbitSpec.Network == nil {
 		input.Network.KeepaliveSet = true
 		input.Network.Keepalive = true
 		input.Network.KeepaliveIdleTimeoutSet = true
@@ -222,11 +233,26 @@ func (n *nodeAgentInstance) configSecret() (runtime.Object, reconciler.DesiredSt
 		log.Log.Info("Notice: Because the Fluentd statefulset has been scaled, we've made some changes in the fluentbit network config too. We advice to revise these default configurations.")
 	}
 
-	if n.nodeAgent.FluentbitSpec.EnableUpstream != nil && *n.nodeAgent.FluentbitSpec.EnableUpstream {
+	if len(n.nodeAgent.FluentbitSpec.Outputs) > 0 {
+		directOutputs, _, err := fluentbit.DirectOutputs(n.nodeAgent.FluentbitSpec.Outputs)
+		if err != nil {
+			return nil, reconciler.StatePresent, errors.WrapIf(err, "failed to map direct outputs for fluentbit")
+		}
+		input.DirectOutputs = directOutputs
+		if bypassed := fluentbit.BypassedTagsRegex(n.nodeAgent.FluentbitSpec.Outputs); bypassed != "" {
+			input.DefaultMatchRegex = fmt.Sprintf("^(?!%s).*$", bypassed)
+		}
+	}
+
+	if util.PointerToBool(n.nodeAgent.FluentbitSpec.EnableUpstream) && n.logging.Spec.FluentdSpec != nil {
 		input.Upstream.Enabled = true
 		input.Upstream.Config.Name = "fluentd-upstream"
 
-		for i := 0; i < n.logging.Spec.FluentdSpec.Scaling.Replicas; i++ {
+		indexes, err := fluentd.UpstreamPodIndexes(context.TODO(), n.reconciler.Client, n.logging, util.PointerToBool(n.nodeAgent.FluentbitSpec.UpstreamReadyNodesOnly))
+		if err != nil {
+			return nil, reconciler.StatePresent, errors.WrapIf(err, "failed to determine upstream nodes for fluentbit")
+		}
+		for _, i := range indexes {
 			input.Upstream.Config.Nodes = append(input.Upstream.Config.Nodes, n.generateUpstreamNode(i))
 		}
 	}
@@ -261,6 +287,10 @@ func generateConfig(input fluentBitConfig) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	tmpl, err = tmpl.Parse(fluentbit.DirectOutputsTemplate)
+	if err != nil {
+		return "", err
+	}
 	err = tmpl.Execute(output, input)
 	if err != nil {
 		return "", err
@@ -20,6 +20,7 @@ import (
 	"strconv"
 
 	"emperror.dev/errors"
+	"github.com/banzaicloud/logging-operator/pkg/resources/fluentbit"
 	"github.com/banzaicloud/logging-operator/pkg/resources/templates"
 	"github.com/banzaicloud/logging-operator/pkg/sdk/api/v1beta1"
 	"github.com/banzaicloud/operator-tools/pkg/merge"
@@ -46,6 +47,12 @@ func (n *nodeAgentInstance) daemonSet() (runtime.Object, reconciler.DesiredState
 		})
 	}
 
+	// the credentials of the direct outputs are passed as environment variables
+	_, outputEnv, err := fluentbit.DirectOutputs(n.nodeAgent.FluentbitSpec.Outputs)
+	if err != nil {
+		return nil, reconciler.StatePresent, errors.WrapIf(err, "failed to map direct outputs for fluentbit")
+	}
+
 	meta := n.NodeAgentObjectMeta(fluentbitDaemonSetName)
 	podMeta := metav1.ObjectMeta{
 		Labels:      n.getFluentBitLabels(),
@@ -80,6 +87,7 @@ func (n *nodeAgentInstance) daemonSet() (runtime.Object, reconciler.DesiredState
 							Name:         containerName,
 							Ports:        containerPorts,
 							VolumeMounts: n.generateVolumeMounts(),
+							Env:          outputEnv,
 							SecurityContext: &corev1.SecurityContext{
 								RunAsUser:                n.nodeAgent.FluentbitSpec.Security.SecurityContext.RunAsUser,
 								RunAsNonRoot:             n.nodeAgent.FluentbitSpec.Security.SecurityContext.RunAsNonRoot,
@@ -107,7 +115,7 @@ func (n *nodeAgentInstance) daemonSet() (runtime.Object, reconciler.DesiredState
 		return desired, reconciler.StatePresent, err
 	}
 
-	err := merge.Merge(desired, n.nodeAgent.FluentbitSpec.DaemonSetOverrides)
+	err = merge.Merge(desired, n.nodeAgent.FluentbitSpec.DaemonSetOverrides)
 	if err != nil {
 		return desired, reconciler.StatePresent, errors.WrapIf(err, "unable to merge overrides to base object")
 	}
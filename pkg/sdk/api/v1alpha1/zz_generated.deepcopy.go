@@ -19,6 +19,7 @@
 package v1alpha1
 
 import (
+	"github.com/banzaicloud/logging-operator/pkg/sdk/api/v1beta1"
 	"github.com/banzaicloud/logging-operator/pkg/sdk/model/output"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -436,6 +437,43 @@ func (in *OutputSpec) DeepCopyInto(out *OutputSpec) {
 		*out = new(output.GELFOutputConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OTLPOutput != nil {
+		in, out := &in.OTLPOutput, &out.OTLPOutput
+		*out = new(output.OTLPOutput)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OpenSearchOutput != nil {
+		in, out := &in.OpenSearchOutput, &out.OpenSearchOutput
+		*out = new(output.OpenSearchOutput)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GoogleCloudLoggingOutput != nil {
+		in, out := &in.GoogleCloudLoggingOutput, &out.GoogleCloudLoggingOutput
+		*out = new(output.GoogleCloudLoggingOutput)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AzureLogAnalyticsOutput != nil {
+		in, out := &in.AzureLogAnalyticsOutput, &out.AzureLogAnalyticsOutput
+		*out = new(output.AzureLogAnalyticsOutput)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SQSOutputConfig != nil {
+		in, out := &in.SQSOutputConfig, &out.SQSOutputConfig
+		*out = new(output.SQSOutputConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SNSOutputConfig != nil {
+		in, out := &in.SNSOutputConfig, &out.SNSOutputConfig
+		*out = new(output.SNSOutputConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Filters != nil {
+		in, out := &in.Filters, &out.Filters
+		*out = make([]v1beta1.Filter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutputSpec.
@@ -461,6 +499,11 @@ func (in *OutputStatus) DeepCopyInto(out *OutputStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Warnings != nil {
+		in, out := &in.Warnings, &out.Warnings
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutputStatus.
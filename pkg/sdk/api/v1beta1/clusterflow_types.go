@@ -73,6 +73,8 @@ type ClusterFlowSpec struct {
 	// Deprecated
 	OutputRefs       []string `json:"outputRefs,omitempty"`
 	GlobalOutputRefs []string `json:"globalOutputRefs,omitempty"`
+	// Names of the additional fluentd inputs whose records the flow receives instead of the container logs
+	Inputs []string `json:"inputs,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -74,6 +74,10 @@ type Filter struct {
 	Throttle          *filter.Throttle           `json:"throttle,omitempty"`
 	SumoLogic         *filter.SumoLogic          `json:"sumologic,omitempty"`
 	EnhanceK8s        *filter.EnhanceK8s         `json:"enhanceK8s,omitempty"`
+	Redact            *filter.Redact             `json:"redact,omitempty"`
+	Sample            *filter.Sample             `json:"sample,omitempty"`
+	Dedup             *filter.Dedup              `json:"dedup,omitempty"`
+	TraceContext      *filter.TraceContext       `json:"traceContext,omitempty"`
 }
 
 // FlowStatus defines the observed state of Flow
@@ -81,6 +85,8 @@ type FlowStatus struct {
 	Active        *bool    `json:"active,omitempty"`
 	Problems      []string `json:"problems,omitempty"`
 	ProblemsCount int      `json:"problemsCount,omitempty"`
+	// Filters enforced by the logging resource on the flow
+	EnforcedFilters []string `json:"enforcedFilters,omitempty"`
 }
 
 // +kubebuilder:object:root=true
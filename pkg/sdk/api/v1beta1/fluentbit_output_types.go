@@ -0,0 +1,232 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"github.com/banzaicloud/operator-tools/pkg/secret"
+)
+
+// +name:"FluentbitOutput"
+// +weight:"200"
+type _hugoFluentbitOutput interface{}
+
+// +name:"FluentbitOutput"
+// +version:"v1beta1"
+// +description:"FluentbitOutput defines an output of fluentbit bypassing the fluentd aggregator"
+type _metaFluentbitOutput interface{}
+
+// +kubebuilder:object:generate=true
+
+// FluentbitOutput defines an output of fluentbit delivering the matching records directly, bypassing the fluentd aggregator.
+// The matching records are not forwarded to fluentd unless forwardToAggregator is set.
+// The credentials are secrets of the control namespace, they are passed to fluentbit as environment variables.
+type FluentbitOutput struct {
+	// Name of the output, used as the alias of the output in the fluentbit metrics
+	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9_]*[a-z0-9])?$
+	Name string `json:"name"`
+	// Tag pattern of the delivered records, e.g. kubernetes.var.log.containers.*_payments_* (default: *)
+	Match string `json:"match,omitempty"`
+	// Regular expression matching the tag of the delivered records, mutually exclusive with match
+	MatchRegex string `json:"matchRegex,omitempty"`
+	// Forward the matching records to fluentd as well (default: false)
+	ForwardToAggregator bool `json:"forwardToAggregator,omitempty"`
+	// Extra options of the output plugin not covered by the typed fields, e.g. Retry_Limit
+	Options map[string]string `json:"options,omitempty"`
+	// Elasticsearch output, see https://docs.fluentbit.io/manual/pipeline/outputs/elasticsearch
+	Elasticsearch *FluentbitElasticsearchOutput `json:"es,omitempty"`
+	// Loki output, see https://docs.fluentbit.io/manual/pipeline/outputs/loki
+	Loki *FluentbitLokiOutput `json:"loki,omitempty"`
+	// Kafka output, see https://docs.fluentbit.io/manual/pipeline/outputs/kafka
+	Kafka *FluentbitKafkaOutput `json:"kafka,omitempty"`
+	// Amazon S3 output, see https://docs.fluentbit.io/manual/pipeline/outputs/s3
+	S3 *FluentbitS3Output `json:"s3,omitempty"`
+	// HTTP output, see https://docs.fluentbit.io/manual/pipeline/outputs/http
+	HTTP *FluentbitHTTPOutput `json:"http,omitempty"`
+}
+
+// FluentbitOutputTLS defines the TLS settings of the fluentbit outputs
+type FluentbitOutputTLS struct {
+	// Enable TLS (default:Off)
+	TLS string `json:"tls,omitempty"`
+	// Verify the certificate of the server (default:On)
+	TLSVerify string `json:"tls.verify,omitempty"`
+	// CA certificate file mounted into the fluentbit pods
+	TLSCAFile string `json:"tls.ca_file,omitempty"`
+	// Client certificate file mounted into the fluentbit pods
+	TLSCrtFile string `json:"tls.crt_file,omitempty"`
+	// Client certificate key file mounted into the fluentbit pods
+	TLSKeyFile string `json:"tls.key_file,omitempty"`
+}
+
+// FluentbitElasticsearchOutput sends the records to Elasticsearch
+type FluentbitElasticsearchOutput struct {
+	// IP address or hostname of the Elasticsearch instance (default:127.0.0.1)
+	Host string `json:"Host,omitempty"`
+	// TCP port of the Elasticsearch instance (default:9200)
+	Port string `json:"Port,omitempty"`
+	// Elasticsearch accepts new data on HTTP query path "/_bulk", this option defines the prefix of the path
+	Path string `json:"Path,omitempty"`
+	// Index name (default:fluent-bit)
+	Index string `json:"Index,omitempty"`
+	// Username of the basic authentication
+	HTTPUser string `json:"HTTP_User,omitempty"`
+	// Password of the basic authentication
+	// +docLink:"Secret,../secret/"
+	HTTPPasswd *secret.Secret `json:"HTTP_Passwd,omitempty"`
+	// Enable the logstash format compatibility, the index name is composed by the prefix and the date (default:Off)
+	LogstashFormat string `json:"Logstash_Format,omitempty"`
+	// Prefix of the index name in logstash format (default:logstash)
+	LogstashPrefix string `json:"Logstash_Prefix,omitempty"`
+	// Time format of the index name in logstash format (default:%Y.%m.%d)
+	LogstashDateFormat string `json:"Logstash_DateFormat,omitempty"`
+	// Key of the timestamp added to the records (default:@timestamp)
+	TimeKey string `json:"Time_Key,omitempty"`
+	// Append the tag to the records (default:Off)
+	IncludeTagKey string `json:"Include_Tag_Key,omitempty"`
+	// Key of the tag appended to the records (default:_flb-key)
+	TagKey string `json:"Tag_Key,omitempty"`
+	// Generate the _id of the records to avoid duplicates (default:Off)
+	GenerateID string `json:"Generate_ID,omitempty"`
+	// Replace the dots of the field names with underscores (default:Off)
+	ReplaceDots string `json:"Replace_Dots,omitempty"`
+	// Print the Elasticsearch API errors (default:Off)
+	TraceError string `json:"Trace_Error,omitempty"`
+	// Omit the _type of the records, required by Elasticsearch 8 (default:Off)
+	SuppressTypeName string `json:"Suppress_Type_Name,omitempty"`
+	// Size of the buffer reading the responses (default:4KB)
+	BufferSize string `json:"Buffer_Size,omitempty"`
+	// Ingest pipeline of the records
+	Pipeline string `json:"Pipeline,omitempty"`
+	// Sign the requests with AWS SigV4 for Amazon Elasticsearch Service (default:Off)
+	AWSAuth string `json:"AWS_Auth,omitempty"`
+	// Region of the Amazon Elasticsearch Service
+	AWSRegion string `json:"AWS_Region,omitempty"`
+	// Role assumed to sign the requests
+	AWSRoleARN string `json:"AWS_Role_ARN,omitempty"`
+	// Elastic cloud id of the cluster
+	CloudID string `json:"Cloud_ID,omitempty"`
+	// Elastic cloud credentials in user:password format
+	// +docLink:"Secret,../secret/"
+	CloudAuth          *secret.Secret `json:"Cloud_Auth,omitempty"`
+	FluentbitOutputTLS `json:",inline"`
+}
+
+// FluentbitLokiOutput sends the records to Grafana Loki
+type FluentbitLokiOutput struct {
+	// Hostname or IP address of the Loki server (default:127.0.0.1)
+	Host string `json:"host,omitempty"`
+	// TCP port of the Loki server (default:3100)
+	Port string `json:"port,omitempty"`
+	// URI of the push endpoint (default:/loki/api/v1/push)
+	URI string `json:"uri,omitempty"`
+	// Tenant of the streams
+	TenantID string `json:"tenant_id,omitempty"`
+	// Labels of the streams, e.g. job=fluent-bit, $kubernetes['namespace_name']
+	Labels string `json:"labels,omitempty"`
+	// Record keys added as labels, e.g. $kubernetes['pod_name']
+	LabelKeys string `json:"label_keys,omitempty"`
+	// Record keys removed before sending the records
+	RemoveKeys string `json:"remove_keys,omitempty"`
+	// Format of the log lines: json or key_value (default:json)
+	LineFormat string `json:"line_format,omitempty"`
+	// Add the kubernetes labels as stream labels (default:off)
+	AutoKubernetesLabels string `json:"auto_kubernetes_labels,omitempty"`
+	// Username of the basic authentication
+	HTTPUser string `json:"http_user,omitempty"`
+	// Password of the basic authentication
+	// +docLink:"Secret,../secret/"
+	HTTPPasswd         *secret.Secret `json:"http_passwd,omitempty"`
+	FluentbitOutputTLS `json:",inline"`
+}
+
+// FluentbitKafkaOutput sends the records to Kafka
+type FluentbitKafkaOutput struct {
+	// Comma separated list of the brokers, e.g. 192.168.1.3:9092,192.168.1.4:9092
+	Brokers string `json:"Brokers"`
+	// Comma separated list of the topics (default:fluent-bit)
+	Topics string `json:"Topics,omitempty"`
+	// Record key holding the topic of the record, it must be listed in topics
+	TopicKey string `json:"Topic_Key,omitempty"`
+	// Format of the messages: json, msgpack or gelf (default:json)
+	Format string `json:"Format,omitempty"`
+	// Key of the messages
+	MessageKey string `json:"Message_Key,omitempty"`
+	// Record key holding the key of the messages
+	MessageKeyField string `json:"Message_Key_Field,omitempty"`
+	// Key of the timestamp added to the records (default:@timestamp)
+	TimestampKey string `json:"Timestamp_Key,omitempty"`
+	// Format of the timestamp: double or iso8601 (default:double)
+	TimestampFormat string `json:"Timestamp_Format,omitempty"`
+	// Librdkafka properties, e.g. security.protocol: SASL_SSL
+	Rdkafka map[string]string `json:"rdkafka,omitempty"`
+}
+
+// FluentbitS3Output uploads the records to Amazon S3
+type FluentbitS3Output struct {
+	// Name of the bucket
+	Bucket string `json:"bucket"`
+	// Region of the bucket
+	Region string `json:"region,omitempty"`
+	// Size of the uploaded files (default:100M)
+	TotalFileSize string `json:"total_file_size,omitempty"`
+	// Time after the buffered records are uploaded (default:10m)
+	UploadTimeout string `json:"upload_timeout,omitempty"`
+	// Format of the object keys, e.g. /$TAG[4]/%Y/%m/%d/$UUID.gz (default:/fluent-bit-logs/$TAG/%Y/%m/%d/%H/%M/%S)
+	S3KeyFormat string `json:"s3_key_format,omitempty"`
+	// Use PutObject instead of multipart uploads (default:Off)
+	UsePutObject string `json:"use_put_object,omitempty"`
+	// Compression of the objects: gzip (default:none)
+	Compression string `json:"compression,omitempty"`
+	// Role assumed to upload the objects
+	RoleARN string `json:"role_arn,omitempty"`
+	// AWS access key id, passed to fluentbit as AWS_ACCESS_KEY_ID, the S3 outputs of a fluentbit have to share it
+	// +docLink:"Secret,../secret/"
+	AccessKeyID *secret.Secret `json:"aws_key_id,omitempty"`
+	// AWS secret access key, passed to fluentbit as AWS_SECRET_ACCESS_KEY, the S3 outputs of a fluentbit have to share it
+	// +docLink:"Secret,../secret/"
+	SecretAccessKey *secret.Secret `json:"aws_sec_key,omitempty"`
+	// Custom S3 endpoint (like minio)
+	Endpoint string `json:"endpoint,omitempty"`
+	// Directory of the buffered records, it has to be on the buffer storage volume to survive restarts (default:/tmp/fluent-bit/s3)
+	StoreDir string `json:"store_dir,omitempty"`
+	// Storage class of the objects
+	StorageClass string `json:"storage_class,omitempty"`
+}
+
+// FluentbitHTTPOutput sends the records to an HTTP endpoint
+type FluentbitHTTPOutput struct {
+	// IP address or hostname of the HTTP server (default:127.0.0.1)
+	Host string `json:"Host,omitempty"`
+	// TCP port of the HTTP server (default:80)
+	Port string `json:"Port,omitempty"`
+	// URI of the endpoint (default:/)
+	URI string `json:"URI,omitempty"`
+	// Format of the request body: msgpack, json, json_stream, json_lines or gelf (default:msgpack)
+	Format string `json:"Format,omitempty"`
+	// Compression of the request body: gzip
+	Compress string `json:"compress,omitempty"`
+	// Username of the basic authentication
+	HTTPUser string `json:"http_User,omitempty"`
+	// Password of the basic authentication
+	// +docLink:"Secret,../secret/"
+	HTTPPasswd *secret.Secret `json:"http_Passwd,omitempty"`
+	// Key of the date in the json formats (default:date)
+	JSONDateKey string `json:"json_date_key,omitempty"`
+	// Format of the date in the json formats: double, iso8601 or epoch (default:double)
+	JSONDateFormat string `json:"json_date_format,omitempty"`
+	// Headers of the requests
+	Headers            map[string]string `json:"headers,omitempty"`
+	FluentbitOutputTLS `json:",inline"`
+}
@@ -73,16 +73,23 @@ type FluentbitSpec struct {
 	DisableKubernetesFilter *bool         `json:"disableKubernetesFilter,omitempty"`
 	BufferStorage           BufferStorage `json:"bufferStorage,omitempty"`
 	// +docLink:"volume.KubernetesVolume,https://github.com/banzaicloud/operator-tools/tree/master/docs/types"
-	BufferStorageVolume     volume.KubernetesVolume      `json:"bufferStorageVolume,omitempty"`
-	CustomConfigSecret      string                       `json:"customConfigSecret,omitempty"`
-	PodPriorityClassName    string                       `json:"podPriorityClassName,omitempty"`
-	LivenessProbe           *corev1.Probe                `json:"livenessProbe,omitempty"`
-	LivenessDefaultCheck    bool                         `json:"livenessDefaultCheck,omitempty"`
-	ReadinessProbe          *corev1.Probe                `json:"readinessProbe,omitempty"`
-	Network                 *FluentbitNetwork            `json:"network,omitempty"`
-	ForwardOptions          *ForwardOptions              `json:"forwardOptions,omitempty"`
+	BufferStorageVolume  volume.KubernetesVolume `json:"bufferStorageVolume,omitempty"`
+	CustomConfigSecret   string                  `json:"customConfigSecret,omitempty"`
+	PodPriorityClassName string                  `json:"podPriorityClassName,omitempty"`
+	LivenessProbe        *corev1.Probe           `json:"livenessProbe,omitempty"`
+	LivenessDefaultCheck bool                    `json:"livenessDefaultCheck,omitempty"`
+	ReadinessProbe       *corev1.Probe           `json:"readinessProbe,omitempty"`
+	Network              *FluentbitNetwork       `json:"network,omitempty"`
+	ForwardOptions       *ForwardOptions         `json:"forwardOptions,omitempty"`
+	// Balance the records between the fluentd pods with an upstream config listing the replicas of the fluentd statefulset.
+	// Fluentbit reads the upstream config on startup only, so scaling fluentd restarts the fluentbit pods.
 	EnableUpstream          bool                         `json:"enableUpstream,omitempty"`
 	ServiceAccountOverrides *typeoverride.ServiceAccount `json:"serviceAccount,omitempty"`
+	// Leave the fluentd pods that are not ready out of the upstream config. Every change of the node list restarts the fluentbit pods.
+	UpstreamReadyNodesOnly bool `json:"upstreamReadyNodesOnly,omitempty"`
+	// Outputs delivering the matching records directly, bypassing fluentd. Without fluentd only these outputs are rendered.
+	// +docLink:"FluentbitOutput,../fluentbit_output_types/"
+	Outputs []FluentbitOutput `json:"outputs,omitempty"`
 }
 
 // +kubebuilder:object:generate=true
@@ -18,7 +18,10 @@ import (
 	"github.com/banzaicloud/logging-operator/pkg/sdk/model/input"
 	"github.com/banzaicloud/operator-tools/pkg/typeoverride"
 	"github.com/banzaicloud/operator-tools/pkg/volume"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // +name:"FluentdSpec"
@@ -82,6 +85,39 @@ type FluentdSpec struct {
 	FluentOutLogrotate      *FluentOutLogrotate          `json:"fluentOutLogrotate,omitempty"`
 	ForwardInputConfig      *input.ForwardInputConfig    `json:"forwardInputConfig,omitempty"`
 	ServiceAccountOverrides *typeoverride.ServiceAccount `json:"serviceAccount,omitempty"`
+	// Dedicated fluentd statefulsets for groups of namespaces. Namespaces not assigned to any shard are handled by the main statefulset.
+	Shards []FluentdShard `json:"shards,omitempty"`
+	// Additional inputs of the main fluentd statefulset exposed through the fluentd service, e.g. for applications and appliances outside of the cluster.
+	// ClusterFlows select the records of the inputs by the name of the input.
+	Inputs []FluentdInput `json:"inputs,omitempty"`
+}
+
+// +kubebuilder:object:generate=true
+
+// FluentdInput defines an additional input of fluentd, exactly one of the input configs has to be set.
+// Syslog listeners are configured by the syslogInputs of the logging.
+type FluentdInput struct {
+	// Name of the input, referenced by the inputs of the ClusterFlows
+	Name string                 `json:"name"`
+	OTLP *input.OTLPInputConfig `json:"otlp,omitempty"`
+	HTTP *input.HTTPInputConfig `json:"http,omitempty"`
+}
+
+// Ports returns the ports the input listens on
+func (i FluentdInput) Ports() []int {
+	var ports []int
+	if i.OTLP != nil {
+		grpcPort, httpPort := i.OTLP.Ports()
+		for _, port := range []int{grpcPort, httpPort} {
+			if port != 0 {
+				ports = append(ports, port)
+			}
+		}
+	}
+	if i.HTTP != nil {
+		ports = append(ports, i.HTTP.GetPort())
+	}
+	return ports
 }
 
 // +kubebuilder:object:generate=true
@@ -100,6 +136,44 @@ type FluentdScaling struct {
 	Replicas            int                `json:"replicas,omitempty"`
 	PodManagementPolicy string             `json:"podManagementPolicy,omitempty"`
 	Drain               FluentdDrainConfig `json:"drain,omitempty"`
+	// Horizontal pod autoscaling of the fluentd statefulset, overrides replicas
+	Autoscaling *FluentdAutoscaling `json:"autoscaling,omitempty"`
+}
+
+// +kubebuilder:object:generate=true
+
+// FluentdAutoscaling configures a HorizontalPodAutoscaler for the fluentd statefulset.
+// The metrics are served by the custom metrics API, e.g. prometheus-adapter exposing the fluentd and buffer volume metrics,
+// see docs/fluentd-autoscaling.md for the adapter rules.
+// Buffers of the removed replicas are handled by the drain configuration when scaling down.
+type FluentdAutoscaling struct {
+	// Lower limit for the number of replicas (default: replicas)
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	// Upper limit for the number of replicas
+	MaxReplicas int32 `json:"maxReplicas"`
+	// Target of the average buffer queue length per pod (default: 10 if no metrics are given)
+	// The fluentd_output_status_buffer_queue_length metric has to be exposed as a pods metric.
+	TargetBufferQueueLength *resource.Quantity `json:"targetBufferQueueLength,omitempty"`
+	// Target of the average buffer volume usage per pod in percents
+	// The fluentd_buffer_volume_usage_percent pods metric is derived by the metrics adapter from the filesystem metrics
+	// of the buffer metrics sidecar, so it requires bufferVolumeMetrics.
+	TargetBufferVolumeUsagePercent *resource.Quantity `json:"targetBufferVolumeUsagePercent,omitempty"`
+	// Additional metrics to scale on
+	Metrics []autoscalingv2beta2.MetricSpec `json:"metrics,omitempty"`
+	// Scaling behavior in the up and down directions
+	Behavior *autoscalingv2beta2.HorizontalPodAutoscalerBehavior `json:"behavior,omitempty"`
+}
+
+// +kubebuilder:object:generate=true
+
+// FluentdShard defines a dedicated fluentd statefulset for a group of namespaces
+type FluentdShard struct {
+	// Name of the shard, used as a suffix for the names of the shard's resources
+	Name string `json:"name"`
+	// Namespaces handled by the shard
+	Namespaces []string `json:"namespaces,omitempty"`
+	// Namespaces with matching labels are handled by the shard
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
 }
 
 // +kubebuilder:object:generate=true
@@ -0,0 +1,86 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import "regexp"
+
+// +name:"HostTailerSpec"
+// +weight:"200"
+type _hugoHostTailerSpec interface{}
+
+// +name:"HostTailer"
+// +version:"v1beta1"
+// +description:"HostTailer defines file and systemd tailers running on the hosts"
+type _metaHostTailerSpec interface{}
+
+const (
+	// HostTailerFileTagPrefix is the tag prefix of records read by file tailers
+	HostTailerFileTagPrefix = "host.file."
+	// HostTailerSystemdTagPrefix is the tag prefix of records read by systemd tailers
+	HostTailerSystemdTagPrefix = "host.systemd."
+)
+
+var hostTailerNameRegexp = regexp.MustCompile(`^[a-z0-9]([-a-z0-9_]*[a-z0-9])?$`)
+
+// +kubebuilder:object:generate=true
+
+// HostTailerSpec defines the tailers reading host level log sources.
+// The tailers are rendered into the fluentbit daemonset configuration and ship records to fluentd like the container logs.
+// The records get the `kubernetes.host` (node name) and `kubernetes.container_name` (tailer name) metadata,
+// so ClusterFlows can select them with the `hosts` and `container_names` selectors.
+type HostTailerSpec struct {
+	// List of file tailers
+	FileTailers []FileTailer `json:"fileTailers,omitempty"`
+	// List of systemd tailers
+	SystemdTailers []SystemdTailer `json:"systemdTailers,omitempty"`
+}
+
+// +kubebuilder:object:generate=true
+
+// FileTailer configuration options
+type FileTailer struct {
+	// Name for the tailer, unique among the tailers, records are tagged with host.file.<name>
+	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9_]*[a-z0-9])?$
+	Name string `json:"name"`
+	// Path to the loggable file on the host
+	Path string `json:"path"`
+	// Disable tailing the file
+	Disabled bool `json:"disabled,omitempty"`
+	// Set the limit of the buffer size per monitored file (default:32k)
+	BufferMaxSize string `json:"bufferMaxSize,omitempty"`
+	// Set the initial buffer size to read files data (default:32k)
+	BufferChunkSize string `json:"bufferChunkSize,omitempty"`
+	// Skip long lines instead of stopping the monitoring of the file (default:true)
+	SkipLongLines *bool `json:"skipLongLines,omitempty"`
+	// Start reading from the head of new log files
+	ReadFromHead bool `json:"readFromHead,omitempty"`
+}
+
+// +kubebuilder:object:generate=true
+
+// SystemdTailer configuration options
+type SystemdTailer struct {
+	// Name for the tailer, unique among the tailers, records are tagged with host.systemd.<name>
+	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9_]*[a-z0-9])?$
+	Name string `json:"name"`
+	// Disable the systemd tailer
+	Disabled bool `json:"disabled,omitempty"`
+	// Override systemd journal path (default:/var/log/journal)
+	Path string `json:"path,omitempty"`
+	// Filter to select systemd unit, example: kubelet.service
+	SystemdFilter string `json:"systemdFilter,omitempty"`
+	// Maximum entries to read from the journal in one round (default:1000)
+	MaxEntries int `json:"maxEntries,omitempty"`
+}
@@ -55,6 +55,14 @@ type LoggingSpec struct {
 	DefaultFlowSpec *DefaultFlowSpec `json:"defaultFlow,omitempty"`
 	// Global filters to apply on logs before any match or filter mechanism.
 	GlobalFilters []Filter `json:"globalFilters,omitempty"`
+	// Filters every flow applies right before its outputs, after the filters of the flow and of the outputs.
+	// Flows cannot bypass them, the enforced filters are listed in the status of the flows.
+	EnforcedOutputFilters []Filter `json:"enforcedOutputFilters,omitempty"`
+	// Reference to a ClusterOutput receiving the records fluentd emits to its @ERROR label, e.g. records the parser or an output failed to process.
+	// The original tag of the record is added as the `error_tag` field, the error as the `error_reason` field
+	// by the `error_reason` filter plugin of the fluentd image, installed from the v1.13.1-alpine-1 image on.
+	// Without a reference, or with a reference to a missing ClusterOutput, these records are discarded.
+	ErrorOutputRef string `json:"errorOutputRef,omitempty"`
 	// Limit namespaces to watch Flow and Output custom reasources.
 	WatchNamespaces []string `json:"watchNamespaces,omitempty"`
 	// Namespace for cluster wide configuration resources like CLusterFlow and ClusterOutput.
@@ -65,16 +73,22 @@ type LoggingSpec struct {
 	AllowClusterResourcesFromAllNamespaces bool `json:"allowClusterResourcesFromAllNamespaces,omitempty"`
 	// NodeAgent Configuration
 	NodeAgents []*NodeAgent `json:"nodeAgents,omitempty"`
+	// File and systemd tailers reading logs from the hosts. Requires the fluentbit daemonset.
+	HostTailer *HostTailerSpec `json:"hostTailer,omitempty"`
 	// EnableRecreateWorkloadOnImmutableFieldChange enables the operator to recreate the
 	// fluentbit daemonset and the fluentd statefulset (and possibly other resource in the future)
 	// in case there is a change in an immutable field
 	// that otherwise couldn't be managed with a simple update.
 	EnableRecreateWorkloadOnImmutableFieldChange bool `json:"enableRecreateWorkloadOnImmutableFieldChange,omitempty"`
+	// Syslog listeners of the main fluentd statefulset exposed by dedicated services. Requires the fluentd statefulset.
+	SyslogInputs []SyslogInput `json:"syslogInputs,omitempty"`
 }
 
 // LoggingStatus defines the observed state of Logging
 type LoggingStatus struct {
 	ConfigCheckResults map[string]bool `json:"configCheckResults,omitempty"`
+	Problems           []string        `json:"problems,omitempty"`
+	ProblemsCount      int             `json:"problemsCount,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -114,7 +128,7 @@ const (
 	DefaultFluentbitImageRepository         = "fluent/fluent-bit"
 	DefaultFluentbitImageTag                = "1.7.9"
 	DefaultFluentdImageRepository           = "ghcr.io/banzaicloud/fluentd"
-	DefaultFluentdImageTag                  = "v1.12.4-alpine-3"
+	DefaultFluentdImageTag                  = "v1.13.1-alpine-1"
 	DefaultFluentdBufferStorageVolumeName   = "fluentd-buffer"
 	DefaultFluentdDrainWatchImageRepository = "ghcr.io/banzaicloud/fluentd-drain-watch"
 	DefaultFluentdDrainWatchImageTag        = "v0.0.1"
@@ -252,6 +266,23 @@ func (l *Logging) SetDefaults() error {
 		if l.Spec.FluentdSpec.Scaling.PodManagementPolicy == "" {
 			l.Spec.FluentdSpec.Scaling.PodManagementPolicy = "OrderedReady"
 		}
+		if l.Spec.FluentdSpec.Scaling.Autoscaling != nil {
+			if l.Spec.FluentdSpec.Scaling.Autoscaling.MinReplicas == nil {
+				l.Spec.FluentdSpec.Scaling.Autoscaling.MinReplicas = util.IntPointer(cast.ToInt32(l.Spec.FluentdSpec.Scaling.Replicas))
+			}
+			if l.Spec.FluentdSpec.Scaling.Autoscaling.MaxReplicas < *l.Spec.FluentdSpec.Scaling.Autoscaling.MinReplicas {
+				return errors.New("`fluentd.scaling.autoscaling.maxReplicas` must not be less than `minReplicas`")
+			}
+			if l.Spec.FluentdSpec.Scaling.Autoscaling.TargetBufferVolumeUsagePercent != nil && l.Spec.FluentdSpec.BufferVolumeMetrics == nil {
+				return errors.New("`fluentd.scaling.autoscaling.targetBufferVolumeUsagePercent` requires `fluentd.bufferVolumeMetrics`")
+			}
+			if l.Spec.FluentdSpec.Scaling.Autoscaling.TargetBufferQueueLength == nil &&
+				l.Spec.FluentdSpec.Scaling.Autoscaling.TargetBufferVolumeUsagePercent == nil &&
+				len(l.Spec.FluentdSpec.Scaling.Autoscaling.Metrics) == 0 {
+				queueLength := resource.MustParse("10")
+				l.Spec.FluentdSpec.Scaling.Autoscaling.TargetBufferQueueLength = &queueLength
+			}
+		}
 		if l.Spec.FluentdSpec.Scaling.Drain.Image.Repository == "" {
 			l.Spec.FluentdSpec.Scaling.Drain.Image.Repository = DefaultFluentdDrainWatchImageRepository
 		}
@@ -261,6 +292,37 @@ func (l *Logging) SetDefaults() error {
 		if l.Spec.FluentdSpec.Scaling.Drain.Image.PullPolicy == "" {
 			l.Spec.FluentdSpec.Scaling.Drain.Image.PullPolicy = "IfNotPresent"
 		}
+		shardNames := make(map[string]bool)
+		for _, shard := range l.Spec.FluentdSpec.Shards {
+			if shard.Name == "" {
+				return errors.New("`fluentd.shards` require `name`")
+			}
+			if shardNames[shard.Name] {
+				return fmt.Errorf("duplicate fluentd shard name %q", shard.Name)
+			}
+			shardNames[shard.Name] = true
+		}
+		// the additional inputs listen in the pod of the main fluentd statefulset next to the forward input
+		ports := map[int]string{int(l.Spec.FluentdSpec.Port): "the forward input"}
+		checkPort := func(port int, name string) error {
+			if owner, ok := ports[port]; ok {
+				return fmt.Errorf("port %d of input %q is already used by %s", port, name, owner)
+			}
+			ports[port] = fmt.Sprintf("input %q", name)
+			return nil
+		}
+		for _, in := range l.Spec.FluentdSpec.Inputs {
+			for _, port := range in.Ports() {
+				if err := checkPort(port, in.Name); err != nil {
+					return err
+				}
+			}
+		}
+		for _, in := range l.Spec.SyslogInputs {
+			if err := checkPort(in.Syslog.GetPort(), in.Name); err != nil {
+				return err
+			}
+		}
 		if l.Spec.FluentdSpec.FluentLogDestination == "" {
 			l.Spec.FluentdSpec.FluentLogDestination = "null"
 		}
@@ -457,6 +519,48 @@ func (l *Logging) SetDefaults() error {
 			l.Spec.FluentbitSpec.TLS.Enabled = util.BoolPointer(false)
 		}
 	}
+	if l.Spec.HostTailer != nil {
+		if l.Spec.FluentbitSpec == nil {
+			return errors.New("`hostTailer` requires `fluentbit` to be configured")
+		}
+		// the names end up in tags, container names and position db file names
+		names := make(map[string]bool)
+		checkName := func(name string) error {
+			if !hostTailerNameRegexp.MatchString(name) {
+				return fmt.Errorf("invalid host tailer name %q, it must consist of lower case alphanumeric characters, '-' or '_'", name)
+			}
+			if names[name] {
+				return fmt.Errorf("duplicate host tailer name %q", name)
+			}
+			names[name] = true
+			return nil
+		}
+		for i := range l.Spec.HostTailer.FileTailers {
+			if l.Spec.HostTailer.FileTailers[i].Name == "" || l.Spec.HostTailer.FileTailers[i].Path == "" {
+				return errors.New("`hostTailer.fileTailers` require `name` and `path`")
+			}
+			if err := checkName(l.Spec.HostTailer.FileTailers[i].Name); err != nil {
+				return err
+			}
+			if l.Spec.HostTailer.FileTailers[i].SkipLongLines == nil {
+				l.Spec.HostTailer.FileTailers[i].SkipLongLines = util.BoolPointer(true)
+			}
+		}
+		for i := range l.Spec.HostTailer.SystemdTailers {
+			if l.Spec.HostTailer.SystemdTailers[i].Name == "" {
+				return errors.New("`hostTailer.systemdTailers` require `name`")
+			}
+			if err := checkName(l.Spec.HostTailer.SystemdTailers[i].Name); err != nil {
+				return err
+			}
+			if l.Spec.HostTailer.SystemdTailers[i].Path == "" {
+				l.Spec.HostTailer.SystemdTailers[i].Path = "/var/log/journal"
+			}
+			if l.Spec.HostTailer.SystemdTailers[i].MaxEntries == 0 {
+				l.Spec.HostTailer.SystemdTailers[i].MaxEntries = 1000
+			}
+		}
+	}
 	return nil
 }
 
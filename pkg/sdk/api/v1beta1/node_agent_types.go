@@ -69,5 +69,12 @@ type NodeAgentFluentbit struct {
 	LivenessDefaultCheck *bool                   `json:"livenessDefaultCheck,omitempty" plugin:"default:true"`
 	Network              *FluentbitNetwork       `json:"network,omitempty"`
 	ForwardOptions       *ForwardOptions         `json:"forwardOptions,omitempty"`
-	EnableUpstream       *bool                   `json:"enableUpstream,omitempty"`
+	// Balance the records between the fluentd pods with an upstream config listing the replicas of the fluentd statefulset.
+	// Fluentbit reads the upstream config on startup only, so scaling fluentd restarts the fluentbit pods.
+	EnableUpstream *bool `json:"enableUpstream,omitempty"`
+	// Leave the fluentd pods that are not ready out of the upstream config. Every change of the node list restarts the fluentbit pods.
+	UpstreamReadyNodesOnly *bool `json:"upstreamReadyNodesOnly,omitempty"`
+	// Outputs delivering the matching records directly, bypassing fluentd
+	// +docLink:"FluentbitOutput,../fluentbit_output_types/"
+	Outputs []FluentbitOutput `json:"outputs,omitempty"`
 }
@@ -54,6 +54,19 @@ type OutputSpec struct {
 	RedisOutputConfig            *output.RedisOutputConfig            `json:"redis,omitempty"`
 	SyslogOutputConfig           *output.SyslogOutputConfig           `json:"syslog,omitempty"`
 	GELFOutputConfig             *output.GELFOutputConfig             `json:"gelf,omitempty"`
+	OTLPOutput                   *output.OTLPOutput                   `json:"otlp,omitempty"`
+	OpenSearchOutput             *output.OpenSearchOutput             `json:"opensearch,omitempty"`
+	GoogleCloudLoggingOutput     *output.GoogleCloudLoggingOutput     `json:"googleCloudLogging,omitempty"`
+	AzureLogAnalyticsOutput      *output.AzureLogAnalyticsOutput      `json:"azureLogAnalytics,omitempty"`
+	SQSOutputConfig              *output.SQSOutputConfig              `json:"sqs,omitempty"`
+	SNSOutputConfig              *output.SNSOutputConfig              `json:"sns,omitempty"`
+	// Name of an output rendered as the secondary of this output, used once the retries of the buffer are exhausted or the retry secondary threshold is reached.
+	// Outputs can reference outputs in the same namespace, ClusterOutputs can reference ClusterOutputs.
+	// The secondary output writes the buffer chunks of this output, so it cannot have a buffer of its own
+	// and its placeholders, e.g. ${tag} or %Y in the path, can only refer to the chunk keys of this output's buffer.
+	SecondaryOutputRef string `json:"secondaryOutputRef,omitempty"`
+	// Filters applied only to the records sent to this output, the other outputs of the flow receive the records unmodified.
+	Filters []Filter `json:"filters,omitempty"`
 }
 
 // OutputStatus defines the observed state of Output
@@ -61,6 +74,8 @@ type OutputStatus struct {
 	Active        *bool    `json:"active,omitempty"`
 	Problems      []string `json:"problems,omitempty"`
 	ProblemsCount int      `json:"problemsCount,omitempty"`
+	// Settings of the output that are accepted but not applied as configured, they are not counted as problems
+	Warnings []string `json:"warnings,omitempty"`
 }
 
 // +kubebuilder:object:root=true
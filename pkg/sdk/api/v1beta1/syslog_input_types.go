@@ -0,0 +1,69 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/input"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// +name:"SyslogInput"
+// +weight:"200"
+type _hugoSyslogInput interface{}
+
+// +name:"SyslogInput"
+// +version:"v1beta1"
+// +description:"SyslogInput defines a syslog listener of fluentd exposed for network appliances"
+type _metaSyslogInput interface{}
+
+// SyslogInputTLSPath is the directory the TLS secrets of the syslog inputs are mounted into, each input in its own subdirectory
+const SyslogInputTLSPath = "/fluentd/syslog-tls/"
+
+// +kubebuilder:object:generate=true
+
+// SyslogInput defines a syslog listener of the main fluentd statefulset exposed by a dedicated service,
+// e.g. for firewalls, switches and other appliances outside of the cluster.
+// ClusterFlows select the records of the listener by the name of the input.
+type SyslogInput struct {
+	// Name of the input, referenced by the inputs of the ClusterFlows, used as the default tag and in the name of the service
+	Name string `json:"name"`
+	// Listener configuration, the tls transport uses the certificates of the TLS secret unless the paths are set
+	// +docLink:"SyslogInputConfig,../../plugins/inputs/syslog/"
+	Syslog input.SyslogInputConfig `json:"syslog,omitempty"`
+	// Name of the secret holding tls.crt, tls.key and optionally ca.crt (verifying client certificates) for the tls transport
+	TLSSecretName string `json:"tlsSecretName,omitempty"`
+	// Service exposing the listener
+	Service SyslogInputService `json:"service,omitempty"`
+}
+
+// +kubebuilder:object:generate=true
+
+// SyslogInputService configures the service of a syslog input
+type SyslogInputService struct {
+	// Type of the service: LoadBalancer or NodePort (default: LoadBalancer)
+	Type corev1.ServiceType `json:"type,omitempty"`
+	// Port of the service (default: the port of the listener)
+	Port int32 `json:"port,omitempty"`
+	// Node port of the service, allocated by Kubernetes if not set
+	NodePort int32 `json:"nodePort,omitempty"`
+	// Load balancer IP requested from the cloud provider
+	LoadBalancerIP string `json:"loadBalancerIP,omitempty"`
+	// Source ranges allowed to reach the load balancer
+	LoadBalancerSourceRanges []string `json:"loadBalancerSourceRanges,omitempty"`
+	// Set to Local to preserve the address of the senders
+	ExternalTrafficPolicy corev1.ServiceExternalTrafficPolicyType `json:"externalTrafficPolicy,omitempty"`
+	// Annotations of the service, e.g. to configure the load balancer of the cloud provider
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
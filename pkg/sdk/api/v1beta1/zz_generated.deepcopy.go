@@ -22,10 +22,13 @@ import (
 	"github.com/banzaicloud/logging-operator/pkg/sdk/model/filter"
 	"github.com/banzaicloud/logging-operator/pkg/sdk/model/input"
 	"github.com/banzaicloud/logging-operator/pkg/sdk/model/output"
+	"github.com/banzaicloud/operator-tools/pkg/secret"
 	"github.com/banzaicloud/operator-tools/pkg/typeoverride"
 	"github.com/banzaicloud/operator-tools/pkg/volume"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"k8s.io/api/autoscaling/v2beta2"
 	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -174,6 +177,11 @@ func (in *ClusterFlowSpec) DeepCopyInto(out *ClusterFlowSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Inputs != nil {
+		in, out := &in.Inputs, &out.Inputs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterFlowSpec.
@@ -392,6 +400,26 @@ func (in *Exclude) DeepCopy() *Exclude {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileTailer) DeepCopyInto(out *FileTailer) {
+	*out = *in
+	if in.SkipLongLines != nil {
+		in, out := &in.SkipLongLines, &out.SkipLongLines
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileTailer.
+func (in *FileTailer) DeepCopy() *FileTailer {
+	if in == nil {
+		return nil
+	}
+	out := new(FileTailer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Filter) DeepCopyInto(out *Filter) {
 	*out = *in
@@ -465,6 +493,26 @@ func (in *Filter) DeepCopyInto(out *Filter) {
 		*out = new(filter.EnhanceK8s)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Redact != nil {
+		in, out := &in.Redact, &out.Redact
+		*out = new(filter.Redact)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Sample != nil {
+		in, out := &in.Sample, &out.Sample
+		*out = new(filter.Sample)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Dedup != nil {
+		in, out := &in.Dedup, &out.Dedup
+		*out = new(filter.Dedup)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TraceContext != nil {
+		in, out := &in.TraceContext, &out.TraceContext
+		*out = new(filter.TraceContext)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Filter.
@@ -670,6 +718,11 @@ func (in *FlowStatus) DeepCopyInto(out *FlowStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.EnforcedFilters != nil {
+		in, out := &in.EnforcedFilters, &out.EnforcedFilters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlowStatus.
@@ -697,6 +750,103 @@ func (in *FluentOutLogrotate) DeepCopy() *FluentOutLogrotate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FluentbitElasticsearchOutput) DeepCopyInto(out *FluentbitElasticsearchOutput) {
+	*out = *in
+	if in.HTTPPasswd != nil {
+		in, out := &in.HTTPPasswd, &out.HTTPPasswd
+		*out = new(secret.Secret)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CloudAuth != nil {
+		in, out := &in.CloudAuth, &out.CloudAuth
+		*out = new(secret.Secret)
+		(*in).DeepCopyInto(*out)
+	}
+	out.FluentbitOutputTLS = in.FluentbitOutputTLS
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FluentbitElasticsearchOutput.
+func (in *FluentbitElasticsearchOutput) DeepCopy() *FluentbitElasticsearchOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(FluentbitElasticsearchOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FluentbitHTTPOutput) DeepCopyInto(out *FluentbitHTTPOutput) {
+	*out = *in
+	if in.HTTPPasswd != nil {
+		in, out := &in.HTTPPasswd, &out.HTTPPasswd
+		*out = new(secret.Secret)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	out.FluentbitOutputTLS = in.FluentbitOutputTLS
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FluentbitHTTPOutput.
+func (in *FluentbitHTTPOutput) DeepCopy() *FluentbitHTTPOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(FluentbitHTTPOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FluentbitKafkaOutput) DeepCopyInto(out *FluentbitKafkaOutput) {
+	*out = *in
+	if in.Rdkafka != nil {
+		in, out := &in.Rdkafka, &out.Rdkafka
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FluentbitKafkaOutput.
+func (in *FluentbitKafkaOutput) DeepCopy() *FluentbitKafkaOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(FluentbitKafkaOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FluentbitLokiOutput) DeepCopyInto(out *FluentbitLokiOutput) {
+	*out = *in
+	if in.HTTPPasswd != nil {
+		in, out := &in.HTTPPasswd, &out.HTTPPasswd
+		*out = new(secret.Secret)
+		(*in).DeepCopyInto(*out)
+	}
+	out.FluentbitOutputTLS = in.FluentbitOutputTLS
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FluentbitLokiOutput.
+func (in *FluentbitLokiOutput) DeepCopy() *FluentbitLokiOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(FluentbitLokiOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FluentbitNetwork) DeepCopyInto(out *FluentbitNetwork) {
 	*out = *in
@@ -732,6 +882,93 @@ func (in *FluentbitNetwork) DeepCopy() *FluentbitNetwork {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FluentbitOutput) DeepCopyInto(out *FluentbitOutput) {
+	*out = *in
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Elasticsearch != nil {
+		in, out := &in.Elasticsearch, &out.Elasticsearch
+		*out = new(FluentbitElasticsearchOutput)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Loki != nil {
+		in, out := &in.Loki, &out.Loki
+		*out = new(FluentbitLokiOutput)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Kafka != nil {
+		in, out := &in.Kafka, &out.Kafka
+		*out = new(FluentbitKafkaOutput)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(FluentbitS3Output)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(FluentbitHTTPOutput)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FluentbitOutput.
+func (in *FluentbitOutput) DeepCopy() *FluentbitOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(FluentbitOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FluentbitOutputTLS) DeepCopyInto(out *FluentbitOutputTLS) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FluentbitOutputTLS.
+func (in *FluentbitOutputTLS) DeepCopy() *FluentbitOutputTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(FluentbitOutputTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FluentbitS3Output) DeepCopyInto(out *FluentbitS3Output) {
+	*out = *in
+	if in.AccessKeyID != nil {
+		in, out := &in.AccessKeyID, &out.AccessKeyID
+		*out = new(secret.Secret)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretAccessKey != nil {
+		in, out := &in.SecretAccessKey, &out.SecretAccessKey
+		*out = new(secret.Secret)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FluentbitS3Output.
+func (in *FluentbitS3Output) DeepCopy() *FluentbitS3Output {
+	if in == nil {
+		return nil
+	}
+	out := new(FluentbitS3Output)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FluentbitSpec) DeepCopyInto(out *FluentbitSpec) {
 	*out = *in
@@ -848,6 +1085,13 @@ func (in *FluentbitSpec) DeepCopyInto(out *FluentbitSpec) {
 		*out = new(typeoverride.ServiceAccount)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = make([]FluentbitOutput, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FluentbitSpec.
@@ -880,6 +1124,48 @@ func (in *FluentbitTLS) DeepCopy() *FluentbitTLS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FluentdAutoscaling) DeepCopyInto(out *FluentdAutoscaling) {
+	*out = *in
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TargetBufferQueueLength != nil {
+		in, out := &in.TargetBufferQueueLength, &out.TargetBufferQueueLength
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.TargetBufferVolumeUsagePercent != nil {
+		in, out := &in.TargetBufferVolumeUsagePercent, &out.TargetBufferVolumeUsagePercent
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]v2beta2.MetricSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Behavior != nil {
+		in, out := &in.Behavior, &out.Behavior
+		*out = new(v2beta2.HorizontalPodAutoscalerBehavior)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FluentdAutoscaling.
+func (in *FluentdAutoscaling) DeepCopy() *FluentdAutoscaling {
+	if in == nil {
+		return nil
+	}
+	out := new(FluentdAutoscaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FluentdDrainConfig) DeepCopyInto(out *FluentdDrainConfig) {
 	*out = *in
@@ -896,10 +1182,40 @@ func (in *FluentdDrainConfig) DeepCopy() *FluentdDrainConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FluentdInput) DeepCopyInto(out *FluentdInput) {
+	*out = *in
+	if in.OTLP != nil {
+		in, out := &in.OTLP, &out.OTLP
+		*out = new(input.OTLPInputConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(input.HTTPInputConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FluentdInput.
+func (in *FluentdInput) DeepCopy() *FluentdInput {
+	if in == nil {
+		return nil
+	}
+	out := new(FluentdInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FluentdScaling) DeepCopyInto(out *FluentdScaling) {
 	*out = *in
 	in.Drain.DeepCopyInto(&out.Drain)
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(FluentdAutoscaling)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FluentdScaling.
@@ -912,6 +1228,31 @@ func (in *FluentdScaling) DeepCopy() *FluentdScaling {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FluentdShard) DeepCopyInto(out *FluentdShard) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FluentdShard.
+func (in *FluentdShard) DeepCopy() *FluentdShard {
+	if in == nil {
+		return nil
+	}
+	out := new(FluentdShard)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FluentdSpec) DeepCopyInto(out *FluentdSpec) {
 	*out = *in
@@ -1026,6 +1367,20 @@ func (in *FluentdSpec) DeepCopyInto(out *FluentdSpec) {
 		*out = new(typeoverride.ServiceAccount)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Shards != nil {
+		in, out := &in.Shards, &out.Shards
+		*out = make([]FluentdShard, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Inputs != nil {
+		in, out := &in.Inputs, &out.Inputs
+		*out = make([]FluentdInput, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FluentdSpec.
@@ -1068,6 +1423,33 @@ func (in *ForwardOptions) DeepCopy() *ForwardOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostTailerSpec) DeepCopyInto(out *HostTailerSpec) {
+	*out = *in
+	if in.FileTailers != nil {
+		in, out := &in.FileTailers, &out.FileTailers
+		*out = make([]FileTailer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SystemdTailers != nil {
+		in, out := &in.SystemdTailers, &out.SystemdTailers
+		*out = make([]SystemdTailer, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostTailerSpec.
+func (in *HostTailerSpec) DeepCopy() *HostTailerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HostTailerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImageSpec) DeepCopyInto(out *ImageSpec) {
 	*out = *in
@@ -1197,6 +1579,13 @@ func (in *LoggingSpec) DeepCopyInto(out *LoggingSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.EnforcedOutputFilters != nil {
+		in, out := &in.EnforcedOutputFilters, &out.EnforcedOutputFilters
+		*out = make([]Filter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.WatchNamespaces != nil {
 		in, out := &in.WatchNamespaces, &out.WatchNamespaces
 		*out = make([]string, len(*in))
@@ -1213,6 +1602,18 @@ func (in *LoggingSpec) DeepCopyInto(out *LoggingSpec) {
 			}
 		}
 	}
+	if in.HostTailer != nil {
+		in, out := &in.HostTailer, &out.HostTailer
+		*out = new(HostTailerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SyslogInputs != nil {
+		in, out := &in.SyslogInputs, &out.SyslogInputs
+		*out = make([]SyslogInput, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingSpec.
@@ -1235,6 +1636,11 @@ func (in *LoggingStatus) DeepCopyInto(out *LoggingStatus) {
 			(*out)[key] = val
 		}
 	}
+	if in.Problems != nil {
+		in, out := &in.Problems, &out.Problems
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingStatus.
@@ -1393,6 +1799,18 @@ func (in *NodeAgentFluentbit) DeepCopyInto(out *NodeAgentFluentbit) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.UpstreamReadyNodesOnly != nil {
+		in, out := &in.UpstreamReadyNodesOnly, &out.UpstreamReadyNodesOnly
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = make([]FluentbitOutput, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeAgentFluentbit.
@@ -1582,6 +2000,43 @@ func (in *OutputSpec) DeepCopyInto(out *OutputSpec) {
 		*out = new(output.GELFOutputConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OTLPOutput != nil {
+		in, out := &in.OTLPOutput, &out.OTLPOutput
+		*out = new(output.OTLPOutput)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OpenSearchOutput != nil {
+		in, out := &in.OpenSearchOutput, &out.OpenSearchOutput
+		*out = new(output.OpenSearchOutput)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GoogleCloudLoggingOutput != nil {
+		in, out := &in.GoogleCloudLoggingOutput, &out.GoogleCloudLoggingOutput
+		*out = new(output.GoogleCloudLoggingOutput)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AzureLogAnalyticsOutput != nil {
+		in, out := &in.AzureLogAnalyticsOutput, &out.AzureLogAnalyticsOutput
+		*out = new(output.AzureLogAnalyticsOutput)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SQSOutputConfig != nil {
+		in, out := &in.SQSOutputConfig, &out.SQSOutputConfig
+		*out = new(output.SQSOutputConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SNSOutputConfig != nil {
+		in, out := &in.SNSOutputConfig, &out.SNSOutputConfig
+		*out = new(output.SNSOutputConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Filters != nil {
+		in, out := &in.Filters, &out.Filters
+		*out = make([]Filter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutputSpec.
@@ -1607,6 +2062,11 @@ func (in *OutputStatus) DeepCopyInto(out *OutputStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Warnings != nil {
+		in, out := &in.Warnings, &out.Warnings
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutputStatus.
@@ -1725,6 +2185,65 @@ func (in *ServiceMonitorConfig) DeepCopy() *ServiceMonitorConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyslogInput) DeepCopyInto(out *SyslogInput) {
+	*out = *in
+	in.Syslog.DeepCopyInto(&out.Syslog)
+	in.Service.DeepCopyInto(&out.Service)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyslogInput.
+func (in *SyslogInput) DeepCopy() *SyslogInput {
+	if in == nil {
+		return nil
+	}
+	out := new(SyslogInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyslogInputService) DeepCopyInto(out *SyslogInputService) {
+	*out = *in
+	if in.LoadBalancerSourceRanges != nil {
+		in, out := &in.LoadBalancerSourceRanges, &out.LoadBalancerSourceRanges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyslogInputService.
+func (in *SyslogInputService) DeepCopy() *SyslogInputService {
+	if in == nil {
+		return nil
+	}
+	out := new(SyslogInputService)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemdTailer) DeepCopyInto(out *SystemdTailer) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemdTailer.
+func (in *SystemdTailer) DeepCopy() *SystemdTailer {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemdTailer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeMount) DeepCopyInto(out *VolumeMount) {
 	*out = *in
@@ -0,0 +1,172 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/types"
+	"github.com/banzaicloud/operator-tools/pkg/secret"
+)
+
+// +name:"Dedup"
+// +weight:"200"
+type _hugoDedup interface{}
+
+// +kubebuilder:object:generate=true
+// +docName:"Dedup Filter"
+// Drop identical consecutive records of a stream (e.g. a container) within a time window.
+// The first record of a run is kept, the identical records arriving within the window are dropped,
+// and the number of the dropped records is added to the next kept record of the stream.
+// The count is lost when no record is kept after the dropped ones, for example when the burst ends the stream,
+// or when the stream is forgotten because more than max_streams streams are tracked.
+// The filter is rendered into a [record_modifier](https://github.com/repeatedly/fluent-plugin-record-modifier) and a [grep](https://docs.fluentd.org/filter/grep) filter.
+type _docDedup interface{}
+
+// +name:"Dedup"
+// +url:"https://github.com/repeatedly/fluent-plugin-record-modifier"
+// +version:"2.1.0"
+// +description:"Drop identical consecutive records within a time window"
+// +status:"Testing"
+type _metaDedup interface{}
+
+const (
+	dedupTempKey           = "__dedup"
+	dedupDefaultWindow     = 10
+	dedupDefaultCountKey   = "dedup_count"
+	dedupDefaultMaxStreams = 10000
+)
+
+var dedupDefaultStreamKeys = []string{"kubernetes.namespace_name", "kubernetes.pod_name", "kubernetes.container_name"}
+
+// +kubebuilder:object:generate=true
+type Dedup struct {
+	// Record keys identifying a stream, nested keys are separated by dots (default: kubernetes.namespace_name, kubernetes.pod_name, kubernetes.container_name)
+	StreamKeys []string `json:"stream_keys,omitempty"`
+	// Record keys compared to detect identical records, nested keys are separated by dots (default: the log key of the container runtime, `message` or `log`)
+	CompareKeys []string `json:"compare_keys,omitempty"`
+	// Time window in seconds from the kept record in which the identical records are dropped (default: 10)
+	Window int `json:"window,omitempty"`
+	// Record key of the number of records dropped before the record (default: dedup_count)
+	CountKey string `json:"count_key,omitempty"`
+	// Maximum number of tracked streams, when it is reached the streams without records in the window are forgotten, then the streams kept the longest ago (default: 10000)
+	MaxStreams int `json:"max_streams,omitempty"`
+}
+
+// #### Example `Dedup` filter configurations
+// ```yaml
+//apiVersion: logging.banzaicloud.io/v1beta1
+//kind: Flow
+//metadata:
+//  name: demo-flow
+//spec:
+//  filters:
+//    - dedup:
+//        window: 30
+//  selectors: {}
+//  localOutputRefs:
+//    - demo-output
+// ```
+//
+// #### Fluentd Config Result
+// ```yaml
+//<filter **>
+//  @type record_modifier
+//  @id test_dedup
+//  prepare_value @dedup_window = 30; @dedup_max_streams = 10000; @dedup_state = {}; @dedup_dig = lambda { |r, path| path.reduce(r) { |v, k| v.is_a?(Hash) ? v[k] : nil } }; @dedup_stream_keys = [['kubernetes', 'namespace_name'], ['kubernetes', 'pod_name'], ['kubernetes', 'container_name']]; @dedup_compare_keys = [['message']]
+//  <record>
+//    __dedup ${stream = @dedup_stream_keys.map { |p| @dedup_dig.call(record, p) }; sig = @dedup_compare_keys.map { |p| @dedup_dig.call(record, p) }.hash; now = time.to_f; last = @dedup_state[stream]; if last && last[0] == sig && now - last[1] < @dedup_window then last[2] += 1; 'drop'; else record['dedup_count'] = last[2] if last && last[2] > 0; @dedup_state.delete(stream); @dedup_state.delete_if { |_, v| now - v[1] > @dedup_window } if @dedup_state.size >= @dedup_max_streams; @dedup_state.shift while @dedup_state.size >= @dedup_max_streams; @dedup_state[stream] = [sig, now, 0]; 'keep' end}
+//  </record>
+//</filter>
+//<filter **>
+//  @type grep
+//  @id test_dedup_grep
+//  <exclude>
+//    key __dedup
+//    pattern /^drop$/
+//  </exclude>
+//</filter>
+//<filter **>
+//  @type record_modifier
+//  @id test_dedup_cleanup
+//  remove_keys __dedup
+//</filter>
+// ```
+type _expDedup interface{}
+
+func (d *Dedup) ToDirective(secretLoader secret.SecretLoader, id string) (types.Directive, error) {
+	window := d.Window
+	if window <= 0 {
+		window = dedupDefaultWindow
+	}
+	maxStreams := d.MaxStreams
+	if maxStreams <= 0 {
+		maxStreams = dedupDefaultMaxStreams
+	}
+	countKey := d.CountKey
+	if countKey == "" {
+		countKey = dedupDefaultCountKey
+	}
+	streamKeys := d.StreamKeys
+	if len(streamKeys) == 0 {
+		streamKeys = dedupDefaultStreamKeys
+	}
+	compareKeys := d.CompareKeys
+	if len(compareKeys) == 0 {
+		compareKeys = []string{types.GetLogKey()}
+	}
+
+	prepare := []string{
+		fmt.Sprintf("@dedup_window = %d", window),
+		fmt.Sprintf("@dedup_max_streams = %d", maxStreams),
+		"@dedup_state = {}",
+		"@dedup_dig = " + types.RubyDigLambda,
+		fmt.Sprintf("@dedup_stream_keys = %s", rubyKeyPaths(streamKeys)),
+		fmt.Sprintf("@dedup_compare_keys = %s", rubyKeyPaths(compareKeys)),
+	}
+	expression := strings.Join([]string{
+		"stream = @dedup_stream_keys.map { |p| @dedup_dig.call(record, p) }",
+		"sig = @dedup_compare_keys.map { |p| @dedup_dig.call(record, p) }.hash",
+		"now = time.to_f",
+		"last = @dedup_state[stream]",
+		"if last && last[0] == sig && now - last[1] < @dedup_window then last[2] += 1",
+		"'drop'",
+		fmt.Sprintf("else record[%s] = last[2] if last && last[2] > 0", types.RubyString(countKey)),
+		"@dedup_state.delete(stream)",
+		"@dedup_state.delete_if { |_, v| now - v[1] > @dedup_window } if @dedup_state.size >= @dedup_max_streams",
+		"@dedup_state.shift while @dedup_state.size >= @dedup_max_streams",
+		"@dedup_state[stream] = [sig, now, 0]",
+		"'keep' end",
+	}, "; ")
+
+	return chainWithTempKeyFilter(types.NewRecordModifier(id,
+		types.Params{
+			"prepare_value": strings.Join(prepare, "; "),
+		},
+		types.Params{
+			dedupTempKey: "${" + expression + "}",
+		},
+	), id, dedupTempKey), nil
+}
+
+// rubyKeyPaths renders the dot separated nested keys as a Ruby array of key paths
+func rubyKeyPaths(keys []string) string {
+	var paths []string
+	for _, key := range keys {
+		paths = append(paths, types.RubyStringArray(strings.Split(key, ".")))
+	}
+	return "[" + strings.Join(paths, ", ") + "]"
+}
@@ -0,0 +1,61 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter_test
+
+import (
+	"testing"
+
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/filter"
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/render"
+	"github.com/ghodss/yaml"
+)
+
+func TestDedup(t *testing.T) {
+	CONFIG := []byte(`
+stream_keys:
+  - kubernetes.pod_name
+compare_keys:
+  - log
+window: 30
+count_key: repeated
+`)
+	expected := `
+<filter **>
+  @type record_modifier
+  @id test
+  prepare_value @dedup_window = 30; @dedup_max_streams = 10000; @dedup_state = {}; @dedup_dig = lambda { |r, path| path.reduce(r) { |v, k| v.is_a?(Hash) ? v[k] : nil } }; @dedup_stream_keys = [['kubernetes', 'pod_name']]; @dedup_compare_keys = [['log']]
+  <record>
+    __dedup ${stream = @dedup_stream_keys.map { |p| @dedup_dig.call(record, p) }; sig = @dedup_compare_keys.map { |p| @dedup_dig.call(record, p) }.hash; now = time.to_f; last = @dedup_state[stream]; if last && last[0] == sig && now - last[1] < @dedup_window then last[2] += 1; 'drop'; else record['repeated'] = last[2] if last && last[2] > 0; @dedup_state.delete(stream); @dedup_state.delete_if { |_, v| now - v[1] > @dedup_window } if @dedup_state.size >= @dedup_max_streams; @dedup_state.shift while @dedup_state.size >= @dedup_max_streams; @dedup_state[stream] = [sig, now, 0]; 'keep' end}
+  </record>
+</filter>
+<filter **>
+  @type grep
+  @id test_grep
+  <exclude>
+    key __dedup
+    pattern /^drop$/
+  </exclude>
+</filter>
+<filter **>
+  @type record_modifier
+  @id test_cleanup
+  remove_keys __dedup
+</filter>
+`
+	dedup := &filter.Dedup{}
+	yaml.Unmarshal(CONFIG, dedup)
+	test := render.NewOutputPluginTest(t, dedup)
+	test.DiffResult(expected)
+}
@@ -0,0 +1,182 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"fmt"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/types"
+	"github.com/banzaicloud/operator-tools/pkg/secret"
+)
+
+// +name:"Redact"
+// +weight:"200"
+type _hugoRedact interface{}
+
+// +kubebuilder:object:generate=true
+// +docName:"Redact Filter"
+// Mask, hash or drop sensitive data like email addresses, credit card numbers, IBANs, JWTs and IP addresses.
+// The filter is rendered into a [record_modifier](https://github.com/repeatedly/fluent-plugin-record-modifier) filter.
+type _docRedact interface{}
+
+// +name:"Redact"
+// +url:"https://github.com/repeatedly/fluent-plugin-record-modifier"
+// +version:"2.1.0"
+// +description:"Mask, hash or drop sensitive data in the records"
+// +status:"Testing"
+type _metaRedact interface{}
+
+const (
+	RedactActionMask = "mask"
+	RedactActionHash = "hash"
+	RedactActionDrop = "drop"
+
+	redactDefaultReplacement = "[REDACTED]"
+	redactTempKey            = "__redact"
+)
+
+// RedactPatterns are the built-in patterns of the redact filter in Ruby regular expression syntax
+var RedactPatterns = map[string]string{
+	"email":       `[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`,
+	"credit_card": `\b(?:\d[ -]?){12,18}\d\b`,
+	"iban":        `\b[A-Z]{2}\d{2}(?: ?[A-Z0-9]){11,30}\b`,
+	"jwt":         `\beyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`,
+	"ipv4":        `\b(?:(?:25[0-5]|2[0-4]\d|1?\d?\d)\.){3}(?:25[0-5]|2[0-4]\d|1?\d?\d)\b`,
+	"ipv6":        `\b(?:[0-9A-Fa-f]{1,4}:){7}[0-9A-Fa-f]{1,4}\b|\b(?:[0-9A-Fa-f]{1,4}:){1,6}:(?:[0-9A-Fa-f]{1,4}(?::[0-9A-Fa-f]{1,4}){0,5})?\b`,
+}
+
+// +kubebuilder:object:generate=true
+type Redact struct {
+	// Top level record keys to scrub (default: the log key of the container runtime, `message` or `log`)
+	Keys []string `json:"keys,omitempty"`
+	// Built-in patterns to scrub: email, credit_card, iban, jwt, ipv4, ipv6
+	Patterns []string `json:"patterns,omitempty"`
+	// Custom patterns to scrub in Ruby regular expression syntax
+	CustomPatterns []string `json:"custom_patterns,omitempty"`
+	// What to do with the matches: `mask` replaces them with the replacement, `hash` replaces them with their salted SHA256 hash,
+	// `drop` removes the whole key from the record (default: mask)
+	Action string `json:"action,omitempty"`
+	// Replacement of the matches for the mask action (default: [REDACTED])
+	Replacement string `json:"replacement,omitempty"`
+	// Salt prepended to the matches before hashing for the hash action.
+	// A salt taken from a secret by valueFrom or mountFrom is mounted into fluentd and read on startup, only a salt set by value is rendered into the config.
+	// +docLink:"Secret,../secret/"
+	Salt *secret.Secret `json:"salt,omitempty"`
+}
+
+// #### Example `Redact` filter configurations
+// ```yaml
+//apiVersion: logging.banzaicloud.io/v1beta1
+//kind: Flow
+//metadata:
+//  name: demo-flow
+//spec:
+//  filters:
+//    - redact:
+//        keys:
+//          - message
+//        patterns:
+//          - email
+//        action: mask
+//  selectors: {}
+//  localOutputRefs:
+//    - demo-output
+// ```
+//
+// #### Fluentd Config Result
+// ```yaml
+//<filter **>
+//  @type record_modifier
+//  @id test_redact
+//  prepare_value @redact_keys = ['message']; @redact_pattern = Regexp.new('[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\\.[A-Za-z]{2,}'); @redact_replacement = '[REDACTED]'
+//  remove_keys __redact
+//  <record>
+//    __redact ${@redact_keys.each { |k| next unless record[k].is_a?(String); record[k] = record[k].gsub(@redact_pattern) { @redact_replacement } }; nil}
+//  </record>
+//</filter>
+// ```
+type _expRedact interface{}
+
+func (r *Redact) ToDirective(secretLoader secret.SecretLoader, id string) (types.Directive, error) {
+	var patterns []string
+	for _, name := range r.Patterns {
+		pattern, ok := RedactPatterns[name]
+		if !ok {
+			return nil, errors.Errorf("unknown redact pattern %q", name)
+		}
+		patterns = append(patterns, pattern)
+	}
+	patterns = append(patterns, r.CustomPatterns...)
+	if len(patterns) == 0 {
+		return nil, errors.New("at least one pattern is required for the redact filter")
+	}
+	for i := range patterns {
+		patterns[i] = "(?:" + patterns[i] + ")"
+	}
+
+	keys := r.Keys
+	if len(keys) == 0 {
+		keys = []string{types.GetLogKey()}
+	}
+	var quotedKeys []string
+	for _, key := range keys {
+		quotedKeys = append(quotedKeys, types.RubyString(key))
+	}
+
+	prepare := []string{
+		fmt.Sprintf("@redact_keys = [%s]", strings.Join(quotedKeys, ", ")),
+		fmt.Sprintf("@redact_pattern = Regexp.new(%s)", types.RubyString(strings.Join(patterns, "|"))),
+	}
+	var action string
+	switch r.Action {
+	case "", RedactActionMask:
+		replacement := r.Replacement
+		if replacement == "" {
+			replacement = redactDefaultReplacement
+		}
+		prepare = append(prepare, fmt.Sprintf("@redact_replacement = %s", types.RubyString(replacement)))
+		// the block form keeps backreferences like \1 of the replacement literal
+		action = "record[k] = record[k].gsub(@redact_pattern) { @redact_replacement }"
+	case RedactActionHash:
+		salt := "''"
+		if r.Salt != nil {
+			saltSecret := r.Salt
+			if saltSecret.ValueFrom != nil {
+				// the salt of a secret is mounted instead of rendered into the config
+				saltSecret = &secret.Secret{MountFrom: saltSecret.ValueFrom}
+			}
+			value, err := secretLoader.Load(saltSecret)
+			if err != nil {
+				return nil, errors.WrapIf(err, "failed to load salt for the redact filter")
+			}
+			salt = types.RubyString(value)
+			if saltSecret.MountFrom != nil {
+				salt = fmt.Sprintf("File.read(%s).strip", salt)
+			}
+		}
+		prepare = append([]string{`require "digest"`}, prepare...)
+		prepare = append(prepare, fmt.Sprintf("@redact_salt = %s", salt))
+		action = "record[k] = record[k].gsub(@redact_pattern) { |m| Digest::SHA256.hexdigest(@redact_salt + m) }"
+	case RedactActionDrop:
+		action = "record.delete(k) if record[k] =~ @redact_pattern"
+	default:
+		return nil, errors.Errorf("unknown redact action %q", r.Action)
+	}
+
+	return types.NewTempKeyRecordModifier(id, prepare, redactTempKey,
+		fmt.Sprintf("@redact_keys.each { |k| next unless record[k].is_a?(String); %s }", action)), nil
+}
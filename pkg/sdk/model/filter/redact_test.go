@@ -0,0 +1,137 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/filter"
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/render"
+	"github.com/banzaicloud/operator-tools/pkg/secret"
+	"github.com/ghodss/yaml"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestRedactMask(t *testing.T) {
+	CONFIG := []byte(`
+keys:
+  - message
+patterns:
+  - email
+custom_patterns:
+  - 'secret=\S+'
+replacement: '\1 redacted'
+`)
+	expected := `
+<filter **>
+  @type record_modifier
+  @id test
+  prepare_value @redact_keys = ['message']; @redact_pattern = Regexp.new('(?:[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\\.[A-Za-z]{2,})|(?:secret=\\S+)'); @redact_replacement = '\\1 redacted'
+  remove_keys __redact
+  <record>
+    __redact ${@redact_keys.each { |k| next unless record[k].is_a?(String); record[k] = record[k].gsub(@redact_pattern) { @redact_replacement } }; nil}
+  </record>
+</filter>
+`
+	redact := &filter.Redact{}
+	yaml.Unmarshal(CONFIG, redact)
+	test := render.NewOutputPluginTest(t, redact)
+	test.DiffResult(expected)
+}
+
+func TestRedactHash(t *testing.T) {
+	CONFIG := []byte(`
+keys:
+  - message
+  - client
+patterns:
+  - ipv4
+action: hash
+salt:
+  value: "pepper's"
+`)
+	expected := `
+<filter **>
+  @type record_modifier
+  @id test
+  prepare_value require "digest"; @redact_keys = ['message', 'client']; @redact_pattern = Regexp.new('(?:\\b(?:(?:25[0-5]|2[0-4]\\d|1?\\d?\\d)\\.){3}(?:25[0-5]|2[0-4]\\d|1?\\d?\\d)\\b)'); @redact_salt = 'pepper\'s'
+  remove_keys __redact
+  <record>
+    __redact ${@redact_keys.each { |k| next unless record[k].is_a?(String); record[k] = record[k].gsub(@redact_pattern) { |m| Digest::SHA256.hexdigest(@redact_salt + m) } }; nil}
+  </record>
+</filter>
+`
+	redact := &filter.Redact{}
+	yaml.Unmarshal(CONFIG, redact)
+	test := render.NewOutputPluginTest(t, redact)
+	test.DiffResult(expected)
+}
+
+func TestRedactHashSaltFromSecret(t *testing.T) {
+	redact := &filter.Redact{
+		Patterns: []string{"email"},
+		Action:   filter.RedactActionHash,
+		Salt: &secret.Secret{ValueFrom: &secret.ValueFrom{SecretKeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "redact"},
+			Key:                  "salt",
+		}}},
+	}
+	c := fake.NewClientBuilder().WithObjects(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "redact"},
+		Data:       map[string][]byte{"salt": []byte("pepper")},
+	}).Build()
+	secrets := &secret.MountSecrets{}
+	directive, err := redact.ToDirective(secret.NewSecretLoader(c, "default", "/fluentd/secret", secrets), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the salt is mounted instead of rendered into the config
+	prepare := directive.GetParams()["prepare_value"]
+	if expected := "@redact_salt = File.read('/fluentd/secret/default-redact-salt').strip"; !strings.HasSuffix(prepare, expected) || strings.Contains(prepare, "pepper") {
+		t.Errorf("expected prepare_value ending with %q, got %q", expected, prepare)
+	}
+	if len(*secrets) != 1 || string((*secrets)[0].Value) != "pepper" {
+		t.Errorf("expected the salt to be mounted, got %+v", *secrets)
+	}
+}
+
+func TestRedactDrop(t *testing.T) {
+	CONFIG := []byte(`
+keys:
+  - token
+patterns:
+  - jwt
+action: drop
+`)
+	expected := `
+<filter **>
+  @type record_modifier
+  @id test
+  prepare_value @redact_keys = ['token']; @redact_pattern = Regexp.new('(?:\\beyJ[A-Za-z0-9_-]+\\.[A-Za-z0-9_-]+\\.[A-Za-z0-9_-]*)')
+  remove_keys __redact
+  <record>
+    __redact ${@redact_keys.each { |k| next unless record[k].is_a?(String); record.delete(k) if record[k] =~ @redact_pattern }; nil}
+  </record>
+</filter>
+`
+	redact := &filter.Redact{}
+	yaml.Unmarshal(CONFIG, redact)
+	test := render.NewOutputPluginTest(t, redact)
+	test.DiffResult(expected)
+}
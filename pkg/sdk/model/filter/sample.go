@@ -0,0 +1,208 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/types"
+	"github.com/banzaicloud/operator-tools/pkg/secret"
+)
+
+// +name:"Sample"
+// +weight:"200"
+type _hugoSample interface{}
+
+// +kubebuilder:object:generate=true
+// +docName:"Sample Filter"
+// Keep only a portion of the records, optionally with different rates by the value of a record key.
+// With a hash key the decision is made by the hash of its value, so related records (e.g. with the same trace id) are kept or dropped together.
+// The filter is rendered into a [record_modifier](https://github.com/repeatedly/fluent-plugin-record-modifier) and a [grep](https://docs.fluentd.org/filter/grep) filter.
+type _docSample interface{}
+
+// +name:"Sample"
+// +url:"https://docs.fluentd.org/filter/grep"
+// +version:"more info"
+// +description:"Keep only a portion of the records"
+// +status:"Testing"
+type _metaSample interface{}
+
+const sampleTempKey = "__sample"
+
+// +kubebuilder:object:generate=true
+type Sample struct {
+	// Keep every interval-th record, counted per fluentd worker. With a hash key one value out of every interval values is kept.
+	// Mutually exclusive with percentage and rates.
+	Interval int `json:"interval,omitempty"`
+	// Percentage of the records to keep, between 0 and 100 (default: 100 when rates are set)
+	Percentage *int `json:"percentage,omitempty"`
+	// Record key selecting the percentage from rates, e.g. level
+	RateKey string `json:"rate_key,omitempty"`
+	// Percentage of the records to keep by the value of the rate key, between 0 and 100, other values use percentage
+	Rates map[string]int `json:"rates,omitempty"`
+	// Record key to hash for the decision, records with the same value are kept or dropped together. Records are sampled randomly without it.
+	HashKey string `json:"hash_key,omitempty"`
+}
+
+// #### Example `Sample` filter configurations
+// ```yaml
+//apiVersion: logging.banzaicloud.io/v1beta1
+//kind: Flow
+//metadata:
+//  name: demo-flow
+//spec:
+//  filters:
+//    - sample:
+//        rate_key: level
+//        rates:
+//          debug: 1
+//          info: 20
+//        hash_key: trace_id
+//  selectors: {}
+//  localOutputRefs:
+//    - demo-output
+// ```
+//
+// #### Fluentd Config Result
+// ```yaml
+//<filter **>
+//  @type record_modifier
+//  @id test_sample
+//  prepare_value require "zlib"; @sample_modulus = 100; @sample_default = 100; @sample_rates = {'debug' => 1, 'info' => 20}
+//  <record>
+//    __sample ${(Zlib.crc32(record['trace_id'].to_s) % @sample_modulus) < @sample_rates.fetch(record['level'].to_s, @sample_default) ? 'keep' : 'drop'}
+//  </record>
+//</filter>
+//<filter **>
+//  @type grep
+//  @id test_sample_grep
+//  <exclude>
+//    key __sample
+//    pattern /^drop$/
+//  </exclude>
+//</filter>
+//<filter **>
+//  @type record_modifier
+//  @id test_sample_cleanup
+//  remove_keys __sample
+//</filter>
+// ```
+type _expSample interface{}
+
+func (s *Sample) ToDirective(secretLoader secret.SecretLoader, id string) (types.Directive, error) {
+	modulus, threshold := 100, 100
+	switch {
+	case s.Interval < 0:
+		return nil, errors.New("interval must be positive")
+	case s.Interval > 0:
+		if s.Percentage != nil || len(s.Rates) > 0 {
+			return nil, errors.New("interval is mutually exclusive with percentage and rates")
+		}
+		modulus, threshold = s.Interval, 1
+	case s.Percentage != nil:
+		if *s.Percentage < 0 || *s.Percentage > 100 {
+			return nil, errors.Errorf("percentage must be between 0 and 100, got %d", *s.Percentage)
+		}
+		threshold = *s.Percentage
+	case len(s.Rates) == 0:
+		return nil, errors.New("one of interval, percentage or rates is required")
+	}
+	if len(s.Rates) > 0 && s.RateKey == "" {
+		return nil, errors.New("rate_key is required for rates")
+	}
+
+	prepare := []string{
+		fmt.Sprintf("@sample_modulus = %d", modulus),
+		fmt.Sprintf("@sample_default = %d", threshold),
+	}
+	rate := "@sample_default"
+	if len(s.Rates) > 0 {
+		values := make([]string, 0, len(s.Rates))
+		for value := range s.Rates {
+			values = append(values, value)
+		}
+		sort.Strings(values)
+		var rates []string
+		for _, value := range values {
+			if s.Rates[value] < 0 || s.Rates[value] > 100 {
+				return nil, errors.Errorf("rate of %q must be between 0 and 100, got %d", value, s.Rates[value])
+			}
+			rates = append(rates, fmt.Sprintf("%s => %d", types.RubyString(value), s.Rates[value]))
+		}
+		prepare = append(prepare, fmt.Sprintf("@sample_rates = {%s}", strings.Join(rates, ", ")))
+		rate = fmt.Sprintf("@sample_rates.fetch(record[%s].to_s, @sample_default)", types.RubyString(s.RateKey))
+	}
+	bucket := "rand(@sample_modulus)"
+	switch {
+	case s.HashKey != "":
+		prepare = append([]string{`require "zlib"`}, prepare...)
+		bucket = fmt.Sprintf("(Zlib.crc32(record[%s].to_s) %% @sample_modulus)", types.RubyString(s.HashKey))
+	case s.Interval > 0:
+		// a counter instead of random numbers, so that exactly one record is kept out of every interval
+		prepare = append(prepare, "@sample_count = 0")
+		bucket = "((@sample_count += 1) % @sample_modulus)"
+	}
+
+	return chainWithTempKeyFilter(types.NewRecordModifier(id,
+		types.Params{
+			"prepare_value": strings.Join(prepare, "; "),
+		},
+		types.Params{
+			sampleTempKey: fmt.Sprintf("${%s < %s ? 'keep' : 'drop'}", bucket, rate),
+		},
+	), id, sampleTempKey), nil
+}
+
+// chainWithTempKeyFilter drops the records the filter marked with drop in the temporary key and removes the key afterwards
+func chainWithTempKeyFilter(filter types.Filter, id string, tempKey string) *types.FilterChain {
+	return &types.FilterChain{
+		Filters: []types.Filter{
+			filter,
+			&types.GenericDirective{
+				PluginMeta: types.PluginMeta{
+					Type:      "grep",
+					Directive: "filter",
+					Tag:       "**",
+					Id:        id + "_grep",
+				},
+				SubDirectives: []types.Directive{
+					&types.GenericDirective{
+						PluginMeta: types.PluginMeta{
+							Directive: "exclude",
+						},
+						Params: types.Params{
+							"key":     tempKey,
+							"pattern": "/^drop$/",
+						},
+					},
+				},
+			},
+			&types.GenericDirective{
+				PluginMeta: types.PluginMeta{
+					Type:      "record_modifier",
+					Directive: "filter",
+					Tag:       "**",
+					Id:        id + "_cleanup",
+				},
+				Params: types.Params{
+					"remove_keys": tempKey,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter_test
+
+import (
+	"testing"
+
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/filter"
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/render"
+	"github.com/banzaicloud/operator-tools/pkg/secret"
+	"github.com/ghodss/yaml"
+)
+
+func TestSampleInterval(t *testing.T) {
+	CONFIG := []byte(`
+interval: 10
+`)
+	expected := `
+<filter **>
+  @type record_modifier
+  @id test
+  prepare_value @sample_modulus = 10; @sample_default = 1; @sample_count = 0
+  <record>
+    __sample ${((@sample_count += 1) % @sample_modulus) < @sample_default ? 'keep' : 'drop'}
+  </record>
+</filter>
+<filter **>
+  @type grep
+  @id test_grep
+  <exclude>
+    key __sample
+    pattern /^drop$/
+  </exclude>
+</filter>
+<filter **>
+  @type record_modifier
+  @id test_cleanup
+  remove_keys __sample
+</filter>
+`
+	sample := &filter.Sample{}
+	yaml.Unmarshal(CONFIG, sample)
+	test := render.NewOutputPluginTest(t, sample)
+	test.DiffResult(expected)
+}
+
+func TestSampleRates(t *testing.T) {
+	CONFIG := []byte(`
+percentage: 50
+rate_key: level
+rates:
+  info: 20
+  debug: 1
+hash_key: trace_id
+`)
+	expected := `
+<filter **>
+  @type record_modifier
+  @id test
+  prepare_value require "zlib"; @sample_modulus = 100; @sample_default = 50; @sample_rates = {'debug' => 1, 'info' => 20}
+  <record>
+    __sample ${(Zlib.crc32(record['trace_id'].to_s) % @sample_modulus) < @sample_rates.fetch(record['level'].to_s, @sample_default) ? 'keep' : 'drop'}
+  </record>
+</filter>
+<filter **>
+  @type grep
+  @id test_grep
+  <exclude>
+    key __sample
+    pattern /^drop$/
+  </exclude>
+</filter>
+<filter **>
+  @type record_modifier
+  @id test_cleanup
+  remove_keys __sample
+</filter>
+`
+	sample := &filter.Sample{}
+	yaml.Unmarshal(CONFIG, sample)
+	test := render.NewOutputPluginTest(t, sample)
+	test.DiffResult(expected)
+}
+
+func TestSampleValidation(t *testing.T) {
+	for config, expected := range map[string]string{
+		"interval: -1":                          "interval must be positive",
+		"interval: 10\npercentage: 10":          "interval is mutually exclusive with percentage and rates",
+		"percentage: 101":                       "percentage must be between 0 and 100, got 101",
+		"percentage: -1":                        "percentage must be between 0 and 100, got -1",
+		"rate_key: level\nrates:\n  debug: 200": `rate of "debug" must be between 0 and 100, got 200`,
+		"rates:\n  debug: 1":                    "rate_key is required for rates",
+		"hash_key: trace_id":                    "one of interval, percentage or rates is required",
+	} {
+		sample := &filter.Sample{}
+		if err := yaml.Unmarshal([]byte(config), sample); err != nil {
+			t.Fatal(err)
+		}
+		_, err := sample.ToDirective(secret.NewSecretLoader(nil, "", "", nil), "test")
+		if err == nil || err.Error() != expected {
+			t.Errorf("%s: expected error %q, got %v", config, expected, err)
+		}
+	}
+}
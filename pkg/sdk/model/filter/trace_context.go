@@ -0,0 +1,158 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/types"
+	"github.com/banzaicloud/operator-tools/pkg/secret"
+)
+
+// +name:"Trace Context"
+// +weight:"200"
+type _hugoTraceContext interface{}
+
+// +kubebuilder:object:generate=true
+// +docName:"Trace Context Filter"
+// Extract the W3C `traceparent`, B3 and `trace_id`/`span_id` formatted trace context from the records
+// and add it as top level `trace_id`, `span_id` and `trace_flags` fields following the OpenTelemetry log data model.
+// Trace ids are lowercased and 64 bit trace ids are padded to 128 bits.
+// The filter is rendered into a [record_modifier](https://github.com/repeatedly/fluent-plugin-record-modifier) filter.
+//
+// Elasticsearch like outputs receive the fields as they are, to map them differently for a single output use the filters of the output,
+// e.g. a Loki output can add the trace id to the line with a `record_transformer` filter instead of a high cardinality label.
+type _docTraceContext interface{}
+
+// +name:"Trace Context"
+// +url:"https://github.com/repeatedly/fluent-plugin-record-modifier"
+// +version:"2.1.0"
+// +description:"Extract trace context into OpenTelemetry fields"
+// +status:"Testing"
+type _metaTraceContext interface{}
+
+const (
+	traceContextTempKey              = "__trace_context"
+	traceContextDefaultTraceIDKey    = "trace_id"
+	traceContextDefaultSpanIDKey     = "span_id"
+	traceContextDefaultTraceFlagsKey = "trace_flags"
+
+	traceContextW3CPattern       = `\b[0-9a-f]{2}-([0-9a-f]{32})-([0-9a-f]{16})-([0-9a-f]{2})\b`
+	traceContextB3Pattern        = `\b([0-9a-f]{32}|[0-9a-f]{16})-([0-9a-f]{16})(?:-[01d](?:-[0-9a-f]{16})?)?\b`
+	traceContextTraceIDPattern   = `\b(?:x-b3-)?trace_?id["'=: ]+([0-9a-f]{32}|[0-9a-f]{16})\b`
+	traceContextSpanIDPattern    = `\b(?:x-b3-)?span_?id["'=: ]+([0-9a-f]{16})\b`
+	traceContextRegexpIgnoreCase = "Regexp::IGNORECASE"
+)
+
+var (
+	traceContextDefaultSourceKeys  = []string{"traceparent", "b3"}
+	traceContextDefaultTraceIDKeys = []string{"trace_id", "traceId", "traceID", "traceid"}
+	traceContextDefaultSpanIDKeys  = []string{"span_id", "spanId", "spanID", "spanid"}
+)
+
+// +kubebuilder:object:generate=true
+type TraceContext struct {
+	// Record keys parsed for W3C traceparent, B3 and trace_id=... span_id=... formatted trace context (default: the log key of the container runtime, traceparent, b3)
+	SourceKeys []string `json:"source_keys,omitempty"`
+	// Record keys already holding the trace id, e.g. set by a JSON parser (default: trace_id, traceId, traceID, traceid)
+	TraceIDKeys []string `json:"trace_id_keys,omitempty"`
+	// Record keys already holding the span id (default: span_id, spanId, spanID, spanid)
+	SpanIDKeys []string `json:"span_id_keys,omitempty"`
+	// Record key of the extracted trace id (default: trace_id)
+	TraceIDKey string `json:"trace_id_key,omitempty"`
+	// Record key of the extracted span id (default: span_id)
+	SpanIDKey string `json:"span_id_key,omitempty"`
+	// Record key of the extracted W3C trace flags (default: trace_flags)
+	TraceFlagsKey string `json:"trace_flags_key,omitempty"`
+}
+
+// #### Example `TraceContext` filter configurations
+// ```yaml
+//apiVersion: logging.banzaicloud.io/v1beta1
+//kind: Flow
+//metadata:
+//  name: demo-flow
+//spec:
+//  filters:
+//    - parser:
+//        remove_key_name_field: false
+//        reserve_data: true
+//        parse:
+//          type: json
+//    - traceContext: {}
+//  selectors: {}
+//  localOutputRefs:
+//    - demo-output
+// ```
+type _expTraceContext interface{}
+
+func (t *TraceContext) ToDirective(secretLoader secret.SecretLoader, id string) (types.Directive, error) {
+	sourceKeys := t.SourceKeys
+	if len(sourceKeys) == 0 {
+		sourceKeys = append([]string{types.GetLogKey()}, traceContextDefaultSourceKeys...)
+	}
+	traceIDKeys := t.TraceIDKeys
+	if len(traceIDKeys) == 0 {
+		traceIDKeys = traceContextDefaultTraceIDKeys
+	}
+	spanIDKeys := t.SpanIDKeys
+	if len(spanIDKeys) == 0 {
+		spanIDKeys = traceContextDefaultSpanIDKeys
+	}
+	traceIDKey := t.TraceIDKey
+	if traceIDKey == "" {
+		traceIDKey = traceContextDefaultTraceIDKey
+	}
+	spanIDKey := t.SpanIDKey
+	if spanIDKey == "" {
+		spanIDKey = traceContextDefaultSpanIDKey
+	}
+	traceFlagsKey := t.TraceFlagsKey
+	if traceFlagsKey == "" {
+		traceFlagsKey = traceContextDefaultTraceFlagsKey
+	}
+
+	extract := strings.Join([]string{
+		"tid = @trace_id_keys.map { |k| record[k] }.find { |v| v.is_a?(String) && !v.empty? }",
+		"sid = @span_id_keys.map { |k| record[k] }.find { |v| v.is_a?(String) && !v.empty? }",
+		"flags = nil",
+		"@trace_source_keys.each { |k| break if tid; v = record[k]; next unless v.is_a?(String)",
+		"if (m = @trace_w3c.match(v)) then tid, sid, flags = m[1], m[2], m[3]",
+		"elsif (m = @trace_b3.match(v)) then tid, sid = m[1], m[2]",
+		"elsif (m = @trace_plain_trace_id.match(v)) then tid = m[1]; s = @trace_plain_span_id.match(v); sid ||= s && s[1]",
+		"end }",
+		"return if tid.nil? || tid =~ /\\A0+\\z/",
+		"record[@trace_id_key] = tid.downcase.rjust(32, '0')",
+		"record[@span_id_key] = sid.downcase if sid",
+		"record[@trace_flags_key] = flags if flags",
+	}, "; ")
+
+	prepare := []string{
+		fmt.Sprintf("@trace_source_keys = %s", types.RubyStringArray(sourceKeys)),
+		fmt.Sprintf("@trace_id_keys = %s", types.RubyStringArray(traceIDKeys)),
+		fmt.Sprintf("@span_id_keys = %s", types.RubyStringArray(spanIDKeys)),
+		fmt.Sprintf("@trace_id_key = %s", types.RubyString(traceIDKey)),
+		fmt.Sprintf("@span_id_key = %s", types.RubyString(spanIDKey)),
+		fmt.Sprintf("@trace_flags_key = %s", types.RubyString(traceFlagsKey)),
+		fmt.Sprintf("@trace_w3c = Regexp.new(%s, %s)", types.RubyString(traceContextW3CPattern), traceContextRegexpIgnoreCase),
+		fmt.Sprintf("@trace_b3 = Regexp.new(%s, %s)", types.RubyString(traceContextB3Pattern), traceContextRegexpIgnoreCase),
+		fmt.Sprintf("@trace_plain_trace_id = Regexp.new(%s, %s)", types.RubyString(traceContextTraceIDPattern), traceContextRegexpIgnoreCase),
+		fmt.Sprintf("@trace_plain_span_id = Regexp.new(%s, %s)", types.RubyString(traceContextSpanIDPattern), traceContextRegexpIgnoreCase),
+		fmt.Sprintf("@trace_extract = lambda { |record| %s }", extract),
+	}
+
+	return types.NewTempKeyRecordModifier(id, prepare, traceContextTempKey, "@trace_extract.call(record)"), nil
+}
@@ -0,0 +1,50 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter_test
+
+import (
+	"testing"
+
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/filter"
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/render"
+	"github.com/ghodss/yaml"
+)
+
+func TestTraceContext(t *testing.T) {
+	CONFIG := []byte(`
+source_keys:
+  - message
+trace_id_keys:
+  - traceId
+span_id_keys:
+  - spanId
+trace_id_key: trace.id
+`)
+	expected := `
+<filter **>
+  @type record_modifier
+  @id test
+  prepare_value @trace_source_keys = ['message']; @trace_id_keys = ['traceId']; @span_id_keys = ['spanId']; @trace_id_key = 'trace.id'; @span_id_key = 'span_id'; @trace_flags_key = 'trace_flags'; @trace_w3c = Regexp.new('\\b[0-9a-f]{2}-([0-9a-f]{32})-([0-9a-f]{16})-([0-9a-f]{2})\\b', Regexp::IGNORECASE); @trace_b3 = Regexp.new('\\b([0-9a-f]{32}|[0-9a-f]{16})-([0-9a-f]{16})(?:-[01d](?:-[0-9a-f]{16})?)?\\b', Regexp::IGNORECASE); @trace_plain_trace_id = Regexp.new('\\b(?:x-b3-)?trace_?id["\'=: ]+([0-9a-f]{32}|[0-9a-f]{16})\\b', Regexp::IGNORECASE); @trace_plain_span_id = Regexp.new('\\b(?:x-b3-)?span_?id["\'=: ]+([0-9a-f]{16})\\b', Regexp::IGNORECASE); @trace_extract = lambda { |record| tid = @trace_id_keys.map { |k| record[k] }.find { |v| v.is_a?(String) && !v.empty? }; sid = @span_id_keys.map { |k| record[k] }.find { |v| v.is_a?(String) && !v.empty? }; flags = nil; @trace_source_keys.each { |k| break if tid; v = record[k]; next unless v.is_a?(String); if (m = @trace_w3c.match(v)) then tid, sid, flags = m[1], m[2], m[3]; elsif (m = @trace_b3.match(v)) then tid, sid = m[1], m[2]; elsif (m = @trace_plain_trace_id.match(v)) then tid = m[1]; s = @trace_plain_span_id.match(v); sid ||= s && s[1]; end }; return if tid.nil? || tid =~ /\A0+\z/; record[@trace_id_key] = tid.downcase.rjust(32, '0'); record[@span_id_key] = sid.downcase if sid; record[@trace_flags_key] = flags if flags }
+  remove_keys __trace_context
+  <record>
+    __trace_context ${@trace_extract.call(record); nil}
+  </record>
+</filter>
+`
+	traceContext := &filter.TraceContext{}
+	yaml.Unmarshal(CONFIG, traceContext)
+	test := render.NewOutputPluginTest(t, traceContext)
+	test.DiffResult(expected)
+}
@@ -18,7 +18,9 @@
 
 package filter
 
-import ()
+import (
+	"github.com/banzaicloud/operator-tools/pkg/secret"
+)
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AndSection) DeepCopyInto(out *AndSection) {
@@ -75,6 +77,31 @@ func (in *DedotFilterConfig) DeepCopy() *DedotFilterConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Dedup) DeepCopyInto(out *Dedup) {
+	*out = *in
+	if in.StreamKeys != nil {
+		in, out := &in.StreamKeys, &out.StreamKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CompareKeys != nil {
+		in, out := &in.CompareKeys, &out.CompareKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Dedup.
+func (in *Dedup) DeepCopy() *Dedup {
+	if in == nil {
+		return nil
+	}
+	out := new(Dedup)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DetectExceptions) DeepCopyInto(out *DetectExceptions) {
 	*out = *in
@@ -415,6 +442,41 @@ func (in *RecordTransformer) DeepCopy() *RecordTransformer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Redact) DeepCopyInto(out *Redact) {
+	*out = *in
+	if in.Keys != nil {
+		in, out := &in.Keys, &out.Keys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Patterns != nil {
+		in, out := &in.Patterns, &out.Patterns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CustomPatterns != nil {
+		in, out := &in.CustomPatterns, &out.CustomPatterns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Salt != nil {
+		in, out := &in.Salt, &out.Salt
+		*out = new(secret.Secret)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Redact.
+func (in *Redact) DeepCopy() *Redact {
+	if in == nil {
+		return nil
+	}
+	out := new(Redact)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RegexpSection) DeepCopyInto(out *RegexpSection) {
 	*out = *in
@@ -445,6 +507,33 @@ func (in *Replace) DeepCopy() *Replace {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Sample) DeepCopyInto(out *Sample) {
+	*out = *in
+	if in.Percentage != nil {
+		in, out := &in.Percentage, &out.Percentage
+		*out = new(int)
+		**out = **in
+	}
+	if in.Rates != nil {
+		in, out := &in.Rates, &out.Rates
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Sample.
+func (in *Sample) DeepCopy() *Sample {
+	if in == nil {
+		return nil
+	}
+	out := new(Sample)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SingleParseSection) DeepCopyInto(out *SingleParseSection) {
 	*out = *in
@@ -509,3 +598,33 @@ func (in *Throttle) DeepCopy() *Throttle {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TraceContext) DeepCopyInto(out *TraceContext) {
+	*out = *in
+	if in.SourceKeys != nil {
+		in, out := &in.SourceKeys, &out.SourceKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TraceIDKeys != nil {
+		in, out := &in.TraceIDKeys, &out.TraceIDKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SpanIDKeys != nil {
+		in, out := &in.SpanIDKeys, &out.SpanIDKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TraceContext.
+func (in *TraceContext) DeepCopy() *TraceContext {
+	if in == nil {
+		return nil
+	}
+	out := new(TraceContext)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,81 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package input
+
+import (
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/filter"
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/types"
+	"github.com/banzaicloud/operator-tools/pkg/secret"
+)
+
+const HTTPInputDefaultPort = 9880
+
+// +kubebuilder:object:generate=true
+
+// HTTPInputConfig receives records posted to an HTTP endpoint, the records are tagged by the path of the request.
+// More info at https://docs.fluentd.org/input/http
+type HTTPInputConfig struct {
+	// The port to listen to (default: 9880)
+	Port int `json:"port,omitempty" plugin:"default:9880"`
+	// The bind address to listen to (default: 0.0.0.0)
+	Bind string `json:"bind,omitempty" plugin:"default:0.0.0.0"`
+	// The size limit of the POSTed element (default: 32MB)
+	BodySizeLimit string `json:"body_size_limit,omitempty"`
+	// The timeout limit for keeping the connection alive (default: 10s)
+	KeepaliveTimeout string `json:"keepalive_timeout,omitempty"`
+	// Add HTTP_ prefixed headers to the record
+	AddHTTPHeaders bool `json:"add_http_headers,omitempty"`
+	// Add REMOTE_ADDR field to the record
+	AddRemoteAddr bool `json:"add_remote_addr,omitempty"`
+	// Allowed origins for CORS
+	CORSAllowOrigins []string `json:"cors_allow_origins,omitempty"`
+	// Respond with 204 No Content instead of 200 OK
+	Use204Response bool `json:"use_204_response,omitempty"`
+	// Parser of the request body, json and msgpack bodies are parsed by default
+	// +docLink:"Parse Section,../../filters/parser/#parse-section"
+	Parse *filter.ParseSection `json:"parse,omitempty"`
+}
+
+// GetPort returns the port of the input
+func (h *HTTPInputConfig) GetPort() int {
+	if h.Port == 0 {
+		return HTTPInputDefaultPort
+	}
+	return h.Port
+}
+
+func (h *HTTPInputConfig) ToDirective(secretLoader secret.SecretLoader, id string) (types.Directive, error) {
+	const pluginType = "http"
+	http := &types.GenericDirective{
+		PluginMeta: types.PluginMeta{
+			Type:      pluginType,
+			Directive: "source",
+			Id:        id + "_" + pluginType,
+		},
+	}
+	if params, err := types.NewStructToStringMapper(secretLoader).StringsMap(h); err != nil {
+		return nil, err
+	} else {
+		http.Params = params
+	}
+	if h.Parse != nil {
+		if parse, err := h.Parse.ToDirective(secretLoader, ""); err != nil {
+			return nil, err
+		} else {
+			http.SubDirectives = append(http.SubDirectives, parse)
+		}
+	}
+	return http, nil
+}
@@ -0,0 +1,111 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package input
+
+import (
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/types"
+	"github.com/banzaicloud/operator-tools/pkg/secret"
+)
+
+const (
+	OTLPInputDefaultGRPCPort = 4317
+	OTLPInputDefaultHTTPPort = 4318
+)
+
+// +kubebuilder:object:generate=true
+
+// OTLPInputConfig receives OpenTelemetry logs over OTLP gRPC and HTTP.
+// The records hold the received OTLP requests: the type key is opentelemetry_logs and the message key is the request in JSON,
+// the OTLP output sends them as they are.
+// More info at https://github.com/fluent-plugins-nursery/fluent-plugin-opentelemetry
+type OTLPInputConfig struct {
+	// The tag of the records (default: the name of the input)
+	Tag string `json:"tag,omitempty"`
+	// The gRPC receiver (default: enabled on port 4317 if no receivers are configured)
+	GRPC *OTLPReceiver `json:"grpc,omitempty"`
+	// The HTTP receiver (default: enabled on port 4318 if no receivers are configured)
+	HTTP *OTLPReceiver `json:"http,omitempty"`
+}
+
+// +kubebuilder:object:generate=true
+
+type OTLPReceiver struct {
+	// The port to listen to (default: 4317 for gRPC, 4318 for HTTP)
+	Port int `json:"port,omitempty"`
+	// The bind address to listen to (default: 0.0.0.0)
+	Bind string `json:"bind,omitempty" plugin:"default:0.0.0.0"`
+}
+
+// Ports returns the ports of the enabled receivers, zero stands for a disabled receiver
+func (o *OTLPInputConfig) Ports() (grpcPort int, httpPort int) {
+	if o.GRPC == nil && o.HTTP == nil {
+		return OTLPInputDefaultGRPCPort, OTLPInputDefaultHTTPPort
+	}
+	if o.GRPC != nil {
+		grpcPort = o.GRPC.Port
+		if grpcPort == 0 {
+			grpcPort = OTLPInputDefaultGRPCPort
+		}
+	}
+	if o.HTTP != nil {
+		httpPort = o.HTTP.Port
+		if httpPort == 0 {
+			httpPort = OTLPInputDefaultHTTPPort
+		}
+	}
+	return grpcPort, httpPort
+}
+
+func (o *OTLPInputConfig) ToDirective(secretLoader secret.SecretLoader, id string) (types.Directive, error) {
+	const pluginType = "opentelemetry"
+	tag := o.Tag
+	if tag == "" {
+		tag = id
+	}
+	otlp := &types.GenericDirective{
+		PluginMeta: types.PluginMeta{
+			Type:      pluginType,
+			Directive: "source",
+			Id:        id + "_" + pluginType,
+		},
+		Params: types.Params{
+			"tag": tag,
+		},
+	}
+	grpcPort, httpPort := o.Ports()
+	for _, receiver := range []struct {
+		directive string
+		config    *OTLPReceiver
+		port      int
+	}{
+		{directive: "grpc", config: o.GRPC, port: grpcPort},
+		{directive: "http", config: o.HTTP, port: httpPort},
+	} {
+		if receiver.port == 0 {
+			continue
+		}
+		config := &OTLPReceiver{}
+		if receiver.config != nil {
+			config = receiver.config.DeepCopy()
+		}
+		config.Port = receiver.port
+		if section, err := types.NewFlatDirective(types.PluginMeta{Directive: receiver.directive}, config, secretLoader); err != nil {
+			return nil, err
+		} else {
+			otlp.SubDirectives = append(otlp.SubDirectives, section)
+		}
+	}
+	return otlp, nil
+}
@@ -0,0 +1,163 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package input
+
+import (
+	"emperror.dev/errors"
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/filter"
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/types"
+	"github.com/banzaicloud/operator-tools/pkg/secret"
+)
+
+const (
+	SyslogInputDefaultPort = 5140
+
+	SyslogTransportUDP = "udp"
+	SyslogTransportTCP = "tcp"
+	SyslogTransportTLS = "tls"
+)
+
+// +kubebuilder:object:generate=true
+
+// SyslogInputConfig receives syslog messages, the records are tagged by the tag, the facility and the priority of the message.
+// More info at https://docs.fluentd.org/input/syslog
+type SyslogInputConfig struct {
+	// The port to listen to (default: 5140)
+	Port int `json:"port,omitempty" plugin:"default:5140"`
+	// The bind address to listen to (default: 0.0.0.0)
+	Bind string `json:"bind,omitempty" plugin:"default:0.0.0.0"`
+	// The prefix of the tag (default: the name of the input)
+	Tag string `json:"tag,omitempty"`
+	// The transport protocol: udp, tcp or tls (default: udp)
+	Transport string `json:"transport,omitempty"`
+	// The TLS configuration of the tls transport
+	TLS *SyslogInputTLS `json:"tls,omitempty"`
+	// The format of the messages: rfc3164, rfc5424 or auto (default: rfc3164)
+	MessageFormat string `json:"message_format,omitempty"`
+	// Parser of the messages instead of the syslog parser, e.g. for appliances sending non-standard messages. Mutually exclusive with message_format.
+	// +docLink:"Parse Section,../../filters/parser/#parse-section"
+	Parse *filter.ParseSection `json:"parse,omitempty"`
+	// The max bytes of a message (default: 2048)
+	MessageLengthLimit string `json:"message_length_limit,omitempty"`
+	// The framing of the tcp messages: traditional or octet_count (default: traditional)
+	FrameType string `json:"frame_type,omitempty"`
+	// The field name of the hostname of the sender
+	SourceHostnameKey string `json:"source_hostname_key,omitempty"`
+	// The field name of the address of the sender
+	SourceAddressKey string `json:"source_address_key,omitempty"`
+	// The field name of the severity
+	SeverityKey string `json:"severity_key,omitempty"`
+	// The field name of the facility
+	FacilityKey string `json:"facility_key,omitempty"`
+}
+
+// +kubebuilder:object:generate=true
+
+type SyslogInputTLS struct {
+	// Path of the server certificate
+	CertPath string `json:"cert_path,omitempty"`
+	// Path of the private key of the server certificate
+	PrivateKeyPath string `json:"private_key_path,omitempty"`
+	// Path of the CA certificate verifying the client certificates
+	CAPath string `json:"ca_path,omitempty"`
+	// Require and verify client certificates
+	ClientCertAuth bool `json:"client_cert_auth,omitempty"`
+	// The TLS version: TLSv1_1, TLSv1_2 or TLSv1_3 (default: TLSv1_2)
+	Version string `json:"version,omitempty"`
+}
+
+// GetPort returns the port of the listener
+func (s *SyslogInputConfig) GetPort() int {
+	if s.Port == 0 {
+		return SyslogInputDefaultPort
+	}
+	return s.Port
+}
+
+// GetTransport returns the transport protocol of the input
+func (s *SyslogInputConfig) GetTransport() string {
+	if s.Transport == "" {
+		return SyslogTransportUDP
+	}
+	return s.Transport
+}
+
+func (s *SyslogInputConfig) ToDirective(secretLoader secret.SecretLoader, id string) (types.Directive, error) {
+	const pluginType = "syslog"
+	transport := s.GetTransport()
+	switch transport {
+	case SyslogTransportUDP, SyslogTransportTCP:
+	case SyslogTransportTLS:
+		if s.TLS == nil || s.TLS.CertPath == "" || s.TLS.PrivateKeyPath == "" {
+			return nil, errors.New("certificate and private key are required for the tls syslog transport")
+		}
+	default:
+		return nil, errors.Errorf("unknown syslog transport %q", s.Transport)
+	}
+	if s.MessageFormat != "" && s.Parse != nil {
+		return nil, errors.New("message_format and parse are mutually exclusive")
+	}
+	syslog := &types.GenericDirective{
+		PluginMeta: types.PluginMeta{
+			Type:      pluginType,
+			Directive: "source",
+			Id:        id + "_" + pluginType,
+		},
+	}
+	if params, err := types.NewStructToStringMapper(secretLoader).StringsMap(s); err != nil {
+		return nil, err
+	} else {
+		syslog.Params = params
+	}
+	// transport and message format are rendered into their own sections
+	delete(syslog.Params, "transport")
+	delete(syslog.Params, "message_format")
+	if syslog.Params["tag"] == "" {
+		syslog.Params["tag"] = id
+	}
+	transportSection := &types.GenericDirective{
+		PluginMeta: types.PluginMeta{
+			Directive: "transport",
+			Tag:       transport,
+		},
+	}
+	if transport == SyslogTransportTLS {
+		if params, err := types.NewStructToStringMapper(secretLoader).StringsMap(s.TLS); err != nil {
+			return nil, err
+		} else {
+			transportSection.Params = params
+		}
+	}
+	syslog.SubDirectives = append(syslog.SubDirectives, transportSection)
+	if s.Parse != nil {
+		if parse, err := s.Parse.ToDirective(secretLoader, ""); err != nil {
+			return nil, err
+		} else {
+			syslog.SubDirectives = append(syslog.SubDirectives, parse)
+		}
+	}
+	if s.MessageFormat != "" {
+		syslog.SubDirectives = append(syslog.SubDirectives, &types.GenericDirective{
+			PluginMeta: types.PluginMeta{
+				Type:      "syslog",
+				Directive: "parse",
+			},
+			Params: types.Params{
+				"message_format": s.MessageFormat,
+			},
+		})
+	}
+	return syslog, nil
+}
@@ -20,6 +20,7 @@ package input
 
 import (
 	"github.com/banzaicloud/logging-operator/pkg/sdk/model/common"
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/filter"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -67,6 +68,111 @@ func (in *ForwardInputConfig) DeepCopy() *ForwardInputConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPInputConfig) DeepCopyInto(out *HTTPInputConfig) {
+	*out = *in
+	if in.CORSAllowOrigins != nil {
+		in, out := &in.CORSAllowOrigins, &out.CORSAllowOrigins
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Parse != nil {
+		in, out := &in.Parse, &out.Parse
+		*out = new(filter.ParseSection)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPInputConfig.
+func (in *HTTPInputConfig) DeepCopy() *HTTPInputConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPInputConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OTLPInputConfig) DeepCopyInto(out *OTLPInputConfig) {
+	*out = *in
+	if in.GRPC != nil {
+		in, out := &in.GRPC, &out.GRPC
+		*out = new(OTLPReceiver)
+		**out = **in
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(OTLPReceiver)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OTLPInputConfig.
+func (in *OTLPInputConfig) DeepCopy() *OTLPInputConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OTLPInputConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OTLPReceiver) DeepCopyInto(out *OTLPReceiver) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OTLPReceiver.
+func (in *OTLPReceiver) DeepCopy() *OTLPReceiver {
+	if in == nil {
+		return nil
+	}
+	out := new(OTLPReceiver)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyslogInputConfig) DeepCopyInto(out *SyslogInputConfig) {
+	*out = *in
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(SyslogInputTLS)
+		**out = **in
+	}
+	if in.Parse != nil {
+		in, out := &in.Parse, &out.Parse
+		*out = new(filter.ParseSection)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyslogInputConfig.
+func (in *SyslogInputConfig) DeepCopy() *SyslogInputConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SyslogInputConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyslogInputTLS) DeepCopyInto(out *SyslogInputTLS) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyslogInputTLS.
+func (in *SyslogInputTLS) DeepCopy() *SyslogInputTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(SyslogInputTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TailInputConfig) DeepCopyInto(out *TailInputConfig) {
 	*out = *in
@@ -0,0 +1,176 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package output
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/banzaicloud/operator-tools/pkg/secret"
+
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/types"
+)
+
+// +name:"Azure Log Analytics"
+// +weight:"200"
+type _hugoAzureLogAnalytics interface{}
+
+// +docName:"Azure Log Analytics output plugin for Fluentd"
+// Sends logs to a custom log table of an Azure Monitor Log Analytics workspace through the HTTP Data Collector API.
+// Log Analytics stores nested objects as JSON strings, so the Kubernetes metadata is mapped to top level fields before sending,
+// e.g. `kubernetes.namespace_name` becomes the `kubernetes_namespace_name` field (the `kubernetes_namespace_name_s` column of the table).
+//
+// More info at https://github.com/yokawasa/fluent-plugin-azure-loganalytics
+// Requires a fluentd image of v1.13.1-alpine-1 or later, the older images lack the plugin.
+//
+// #### Example output configurations
+// ```
+// spec:
+//   azureLogAnalytics:
+//     customer_id:
+//       valueFrom:
+//         secretKeyRef:
+//           name: log-analytics
+//           key: workspaceId
+//     shared_key:
+//       valueFrom:
+//         secretKeyRef:
+//           name: log-analytics
+//           key: sharedKey
+//     log_type: KubernetesLogs
+//     time_generated_field: time
+//     buffer:
+//       timekey: 1m
+//       timekey_wait: 30s
+//       timekey_use_utc: true
+// ```
+type _docAzureLogAnalytics interface{}
+
+// +name:"Azure Log Analytics"
+// +url:"https://github.com/yokawasa/fluent-plugin-azure-loganalytics/releases/tag/v0.7.0"
+// +version:"0.7.0"
+// +description:"Send your logs to Azure Monitor Log Analytics"
+// +status:"Testing"
+type _metaAzureLogAnalytics interface{}
+
+var azureLogAnalyticsDefaultKubernetesFields = map[string]string{
+	"kubernetes_namespace_name":  "kubernetes.namespace_name",
+	"kubernetes_pod_name":        "kubernetes.pod_name",
+	"kubernetes_container_name":  "kubernetes.container_name",
+	"kubernetes_container_image": "kubernetes.container_image",
+	"kubernetes_host":            "kubernetes.host",
+}
+
+// +kubebuilder:object:generate=true
+// +docName:"Output Config"
+type AzureLogAnalyticsOutput struct {
+	// Your Operations Management Suite workspace ID
+	// +docLink:"Secret,../secret/"
+	CustomerID *secret.Secret `json:"customer_id"`
+	// The primary or the secondary connected sources client authentication key
+	// +docLink:"Secret,../secret/"
+	SharedKey *secret.Secret `json:"shared_key"`
+	// The name of the event type that is being submitted to Log Analytics. log_type only alpha characters (default: ApacheAccessLog)
+	LogType string `json:"log_type"`
+	// The service endpoint, e.g. ods.opinsights.azure.us for the government cloud (default: ods.opinsights.azure.com)
+	Endpoint string `json:"endpoint,omitempty"`
+	// The name of the time generated field. Be careful that the value of the field should strictly follow the ISO 8601 format (YYYY-MM-DDThh:mm:ssZ)
+	TimeGeneratedField string `json:"time_generated_field,omitempty"`
+	// The resource ID of the Azure resource the data should be associated with, e.g. the AKS cluster
+	AzureResourceID string `json:"azure_resource_id,omitempty"`
+	// Add the time field to the record (default: true)
+	// +kubebuilder:validation:Optional
+	AddTimeField *bool `json:"add_time_field,omitempty" plugin:"default:true"`
+	// The name of the time field added to the record (default: time)
+	TimeFieldName string `json:"time_field_name,omitempty"`
+	// The format of the time field, e.g. %Y-%m-%dT%H:%M:%S%z (default: %s)
+	TimeFormat string `json:"time_format,omitempty"`
+	// Use local time instead of UTC in the time field (default: false)
+	Localtime bool `json:"localtime,omitempty"`
+	// Add the tag to the record (default: false)
+	AddTagField bool `json:"add_tag_field,omitempty"`
+	// The name of the tag field added to the record (default: tag)
+	TagFieldName string `json:"tag_field_name,omitempty"`
+	// Top level fields set from record keys, nested keys are separated by dots
+	// (default: the kubernetes_namespace_name, kubernetes_pod_name, kubernetes_container_name, kubernetes_container_image and kubernetes_host fields from the kubernetes metadata)
+	KubernetesFieldMapping map[string]string `json:"kubernetes_field_mapping,omitempty"`
+	// Remove the nested kubernetes metadata after the mapping (default: false)
+	RemoveKubernetesMetadata bool `json:"remove_kubernetes_metadata,omitempty"`
+	// +docLink:"Buffer,../buffer/"
+	Buffer *Buffer `json:"buffer,omitempty"`
+}
+
+func (a *AzureLogAnalyticsOutput) ToDirective(secretLoader secret.SecretLoader, id string) (types.Directive, error) {
+	const pluginType = "azure-loganalytics"
+	if a.LogType == "" {
+		return nil, errors.New("log_type is required for the azure log analytics output")
+	}
+	logAnalytics := &types.OutputPlugin{
+		PluginMeta: types.PluginMeta{
+			Type:      pluginType,
+			Directive: "match",
+			Tag:       "**",
+			Id:        id,
+		},
+	}
+	if params, err := types.NewStructToStringMapper(secretLoader).StringsMap(a); err != nil {
+		return nil, err
+	} else {
+		logAnalytics.Params = params
+	}
+	// the field mapping is rendered into a filter instead of the output
+	delete(logAnalytics.Params, "kubernetes_field_mapping")
+	delete(logAnalytics.Params, "remove_kubernetes_metadata")
+	if a.Buffer == nil {
+		a.Buffer = &Buffer{}
+	}
+	if buffer, err := a.Buffer.ToDirective(secretLoader, id); err != nil {
+		return nil, err
+	} else {
+		logAnalytics.SubDirectives = append(logAnalytics.SubDirectives, buffer)
+	}
+
+	return types.NewFilteredOutput(id+":azure_loganalytics", []types.Filter{a.mappingFilter(id)}, logAnalytics), nil
+}
+
+// mappingFilter renders a record_modifier filter copying the kubernetes metadata to top level fields
+func (a *AzureLogAnalyticsOutput) mappingFilter(id string) types.Filter {
+	mapping := a.KubernetesFieldMapping
+	if len(mapping) == 0 {
+		mapping = azureLogAnalyticsDefaultKubernetesFields
+	}
+	names := make([]string, 0, len(mapping))
+	for name := range mapping {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	record := types.Params{}
+	for _, name := range names {
+		var path []string
+		for _, k := range strings.Split(mapping[name], ".") {
+			path = append(path, types.RubyString(k))
+		}
+		record[name] = fmt.Sprintf("${record.dig(%s)}", strings.Join(path, ", "))
+	}
+	params := types.Params{}
+	if a.RemoveKubernetesMetadata {
+		params["remove_keys"] = "kubernetes"
+	}
+
+	return types.NewRecordModifier(id+"_azure_loganalytics", params, record)
+}
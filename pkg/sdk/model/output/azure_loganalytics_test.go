@@ -0,0 +1,83 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package output_test
+
+import (
+	"testing"
+
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/output"
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/render"
+	"github.com/ghodss/yaml"
+)
+
+func TestAzureLogAnalytics(t *testing.T) {
+	CONFIG := []byte(`
+customer_id:
+  value: 00000000-0000-0000-0000-000000000000
+shared_key:
+  value: c2hhcmVkLWtleQ==
+log_type: KubernetesLogs
+time_generated_field: time
+time_format: "%Y-%m-%dT%H:%M:%S%z"
+kubernetes_field_mapping:
+  namespace: kubernetes.namespace_name
+  app: kubernetes.labels.app
+remove_kubernetes_metadata: true
+buffer:
+  timekey: 1m
+  timekey_wait: 30s
+  timekey_use_utc: true
+`)
+	expected := `
+  <match **>
+    @type relabel
+    @label @586e6b5f03c251d252310e5508538b47
+  </match>
+</label>
+<label @586e6b5f03c251d252310e5508538b47>
+  <filter **>
+    @type record_modifier
+    @id test_azure_loganalytics
+    remove_keys kubernetes
+    <record>
+      app ${record.dig('kubernetes', 'labels', 'app')}
+      namespace ${record.dig('kubernetes', 'namespace_name')}
+    </record>
+  </filter>
+  <match **>
+    @type azure-loganalytics
+    @id test
+    add_time_field true
+    customer_id 00000000-0000-0000-0000-000000000000
+    log_type KubernetesLogs
+    shared_key c2hhcmVkLWtleQ==
+    time_format %Y-%m-%dT%H:%M:%S%z
+    time_generated_field time
+    <buffer tag,time>
+      @type file
+      chunk_limit_size 8MB
+      path /buffers/test.*.buffer
+      retry_forever true
+      timekey 1m
+      timekey_use_utc true
+      timekey_wait 30s
+    </buffer>
+  </match>
+`
+	la := &output.AzureLogAnalyticsOutput{}
+	yaml.Unmarshal(CONFIG, la)
+	test := render.NewOutputPluginTest(t, la)
+	test.DiffResult(expected)
+}
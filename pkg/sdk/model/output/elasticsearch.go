@@ -15,6 +15,10 @@
 package output
 
 import (
+	"bytes"
+	"encoding/json"
+
+	"emperror.dev/errors"
 	"github.com/banzaicloud/logging-operator/pkg/sdk/model/types"
 	"github.com/banzaicloud/operator-tools/pkg/secret"
 )
@@ -41,11 +45,34 @@ type _hugoElasticsearch interface{}
 //       timekey_wait: 30s
 //       timekey_use_utc: true
 // ```
+//
+// #### Example data stream configuration with an inline ILM policy
+// ```
+// spec:
+//   elasticsearch:
+//     host: elasticsearch-elasticsearch-cluster.default.svc.cluster.local
+//     port: 9200
+//     data_stream_enable: true
+//     data_stream_name: logs-kubernetes-default
+//     data_stream_ilm_name: logs-kubernetes
+//     data_stream_ilm_policy_overwrite: true
+//     data_stream_ilm_policy: |
+//       {
+//         "policy": {
+//           "phases": {
+//             "hot": {"actions": {"rollover": {"max_age": "1d", "max_primary_shard_size": "50gb"}}},
+//             "delete": {"min_age": "7d", "actions": {"delete": {}}}
+//           }
+//         }
+//       }
+// ```
+// The policy is sent to Elasticsearch when fluentd starts or reloads its configuration, a changed policy is applied on the reload after the change.
+// It requires fluent-plugin-elasticsearch 5.0.3, the version installed in the fluentd image.
 type _docElasticsearch interface{}
 
 // +name:"Elasticsearch"
-// +url:"https://github.com/uken/fluent-plugin-elasticsearch/releases/tag/v4.3.3"
-// +version:"4.3.3"
+// +url:"https://github.com/uken/fluent-plugin-elasticsearch/releases/tag/v5.0.3"
+// +version:"5.0.3"
 // +description:"Send your logs to Elasticsearch"
 // +status:"GA"
 type _metaElasticsearch interface{}
@@ -240,11 +267,25 @@ type ElasticsearchOutput struct {
 	DataStreamEnable *bool `json:"data_stream_enable,omitempty"`
 	// You can specify Elasticsearch data stream name by this parameter. This parameter is mandatory for elasticsearch_data_stream. There are some limitations about naming rule. For more details https://www.elastic.co/guide/en/elasticsearch/reference/master/indices-create-data-stream.html#indices-create-data-stream-api-path-params
 	DataStreamName string `json:"data_stream_name,omitempty"`
+	// Specify an existing index template for the data stream. If not present, a new template is created and named after the data stream.
+	DataStreamTemplateName string `json:"data_stream_template_name,omitempty"`
+	// Specify an existing ILM policy to be applied to the data stream. If not present, either the specified template's or a new ILM default policy is applied. (default: the data stream name with the _policy suffix)
+	DataStreamILMName string `json:"data_stream_ilm_name,omitempty"`
+	// Specify the ILM policy contents as Hash, e.g. inline JSON in the resource. The policy is created by the plugin when fluentd starts or reloads its configuration, so a changed policy takes effect only on the next fluentd reload and only with data_stream_ilm_policy_overwrite.
+	DataStreamILMPolicy string `json:"data_stream_ilm_policy,omitempty"`
+	// Specify whether the data stream ILM policy should be overwritten, so the policy of the resource is kept up to date in Elasticsearch.
+	DataStreamILMPolicyOverwrite bool `json:"data_stream_ilm_policy_overwrite,omitempty"`
+	// Specify whether the data stream template uses the index patterns of the data stream name. (default: true)
+	// +kubebuilder:validation:Optional
+	DataStreamTemplateUseIndexPatterns *bool `json:"data_stream_template_use_index_patterns,omitempty"`
 }
 
 func (e *ElasticsearchOutput) ToDirective(secretLoader secret.SecretLoader, id string) (types.Directive, error) {
 	pluginType := "elasticsearch"
 	if e.DataStreamEnable != nil && *e.DataStreamEnable {
+		if e.DataStreamName == "" {
+			return nil, errors.New("data_stream_name is required for the elasticsearch data stream")
+		}
 		pluginType = "elasticsearch_data_stream"
 	}
 	elasticsearch := &types.OutputPlugin{
@@ -260,6 +301,14 @@ func (e *ElasticsearchOutput) ToDirective(secretLoader secret.SecretLoader, id s
 	} else {
 		elasticsearch.Params = params
 	}
+	// the data stream policy written as multi-line JSON in the resource is compacted to fit into a single parameter line
+	if policy, ok := elasticsearch.Params["data_stream_ilm_policy"]; ok {
+		compacted := &bytes.Buffer{}
+		if err := json.Compact(compacted, []byte(policy)); err != nil {
+			return nil, errors.WrapIf(err, "invalid data_stream_ilm_policy")
+		}
+		elasticsearch.Params["data_stream_ilm_policy"] = compacted.String()
+	}
 	if e.Buffer == nil {
 		e.Buffer = &Buffer{}
 	}
@@ -64,3 +64,96 @@ buffer:
 	test := render.NewOutputPluginTest(t, es)
 	test.DiffResult(expected)
 }
+
+func TestElasticSearchDataStream(t *testing.T) {
+	CONFIG := []byte(`
+host: elasticsearch-elasticsearch-cluster.default.svc.cluster.local
+port: 9200
+data_stream_enable: true
+data_stream_name: logs-kubernetes-default
+data_stream_ilm_name: logs-kubernetes
+data_stream_ilm_policy_overwrite: true
+data_stream_ilm_policy: |
+  {
+    "policy": {
+      "phases": {
+        "hot": {"actions": {"rollover": {"max_age": "1d"}}},
+        "delete": {"min_age": "7d", "actions": {"delete": {}}}
+      }
+    }
+  }
+buffer:
+  timekey: 1m
+  timekey_wait: 30s
+  timekey_use_utc: true
+`)
+	expected := `
+  <match **>
+    @type elasticsearch_data_stream
+    @id test
+    data_stream_enable true
+    data_stream_ilm_name logs-kubernetes
+    data_stream_ilm_policy {"policy":{"phases":{"hot":{"actions":{"rollover":{"max_age":"1d"}}},"delete":{"min_age":"7d","actions":{"delete":{}}}}}}
+    data_stream_ilm_policy_overwrite true
+    data_stream_name logs-kubernetes-default
+    exception_backup true
+    fail_on_putting_template_retry_exceed true
+    host elasticsearch-elasticsearch-cluster.default.svc.cluster.local
+    port 9200
+    reload_connections true
+    ssl_verify true
+    utc_index true
+    verify_es_version_at_startup true
+    <buffer tag,time>
+      @type file
+      chunk_limit_size 8MB
+      path /buffers/test.*.buffer
+      retry_forever true
+      timekey 1m
+      timekey_use_utc true
+      timekey_wait 30s
+    </buffer>
+  </match>
+`
+	es := &output.ElasticsearchOutput{}
+	yaml.Unmarshal(CONFIG, es)
+	test := render.NewOutputPluginTest(t, es)
+	test.DiffResult(expected)
+}
+
+func TestElasticSearchILMPolicy(t *testing.T) {
+	CONFIG := []byte(`
+host: elasticsearch-elasticsearch-cluster.default.svc.cluster.local
+enable_ilm: true
+ilm_policy_id: logs
+ilm_policy: 'policy:{}'
+`)
+	expected := `
+  <match **>
+    @type elasticsearch
+    @id test
+    enable_ilm true
+    exception_backup true
+    fail_on_putting_template_retry_exceed true
+    host elasticsearch-elasticsearch-cluster.default.svc.cluster.local
+    ilm_policy policy:{}
+    ilm_policy_id logs
+    reload_connections true
+    ssl_verify true
+    utc_index true
+    verify_es_version_at_startup true
+    <buffer tag,time>
+      @type file
+      chunk_limit_size 8MB
+      path /buffers/test.*.buffer
+      retry_forever true
+      timekey 10m
+      timekey_wait 10m
+    </buffer>
+  </match>
+`
+	es := &output.ElasticsearchOutput{}
+	yaml.Unmarshal(CONFIG, es)
+	test := render.NewOutputPluginTest(t, es)
+	test.DiffResult(expected)
+}
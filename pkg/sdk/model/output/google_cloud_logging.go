@@ -0,0 +1,195 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/banzaicloud/operator-tools/pkg/secret"
+
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/types"
+)
+
+// +name:"Google Cloud Logging"
+// +weight:"200"
+type _hugoGoogleCloudLogging interface{}
+
+// +docName:"Google Cloud Logging output plugin for Fluentd"
+// Sends logs to Google Cloud Logging (formerly Stackdriver).
+// The records are prepared before sending:
+// - the Kubernetes metadata is mapped to the `k8s_container` monitored resource of the namespace, pod and container,
+// - the value of the severity key is mapped to the Cloud Logging severity.
+//
+// The plugin uses the Application Default Credentials, so on GKE it authenticates with workload identity or the service account of the node.
+// A service account key mounted from a secret is set in the GOOGLE_APPLICATION_CREDENTIALS environment variable of the fluentd statefulset,
+// so the Cloud Logging outputs of a fluentd must use the same key, outputs with differing keys are rejected.
+//
+// More info at https://github.com/GoogleCloudPlatform/fluent-plugin-google-cloud
+// fluent-plugin-google-cloud ships with the fluentd image v1.13.1-alpine-1 and later.
+//
+// #### Example output configurations
+// ```
+// spec:
+//   googleCloudLogging:
+//     project_id: my-project
+//     k8s_cluster_name: production
+//     k8s_cluster_location: europe-west1
+//     credentials:
+//       mountFrom:
+//         secretKeyRef:
+//           name: cloud-logging-sa
+//           key: key.json
+//     severity_map:
+//       fatal: CRITICAL
+//     buffer:
+//       timekey: 1m
+//       timekey_wait: 30s
+//       timekey_use_utc: true
+// ```
+type _docGoogleCloudLogging interface{}
+
+// +name:"Google Cloud Logging"
+// +url:"https://github.com/GoogleCloudPlatform/fluent-plugin-google-cloud"
+// +version:"0.12.11"
+// +description:"Send logs to Google Cloud Logging"
+// +status:"Testing"
+type _metaGoogleCloudLogging interface{}
+
+const googleCloudLoggingDefaultSeverityKey = "level"
+
+// +kubebuilder:object:generate=true
+// +docName:"Output Config"
+type GoogleCloudLoggingOutput struct {
+	// Project of the logs (default: the project of the metadata server or the credentials)
+	ProjectID string `json:"project_id,omitempty"`
+	// Name of the cluster in the k8s_container monitored resource (default: the cluster-name attribute of the metadata server)
+	K8sClusterName string `json:"k8s_cluster_name,omitempty"`
+	// Location of the cluster in the k8s_container monitored resource (default: the cluster-location attribute of the metadata server)
+	K8sClusterLocation string `json:"k8s_cluster_location,omitempty"`
+	// Service account key used instead of the Application Default Credentials, it must be mounted from a secret.
+	// It is set for the whole fluentd, so every Cloud Logging output has to use the same secret.
+	// +docLink:"Secret,../secret/"
+	Credentials *secret.Secret `json:"credentials,omitempty"`
+	// Use the metadata server of the node, disable it outside of Google Cloud (default: true)
+	// +kubebuilder:validation:Optional
+	UseMetadataService *bool `json:"use_metadata_service,omitempty"`
+	// Use the gRPC API instead of the REST API (default: false)
+	UseGrpc bool `json:"use_grpc,omitempty"`
+	// Write the valid entries of a request even if some of them are invalid (default: true)
+	// +kubebuilder:validation:Optional
+	PartialSuccess *bool `json:"partial_success,omitempty"`
+	// Send JSON formatted messages as structured payload (default: false)
+	DetectJson bool `json:"detect_json,omitempty"`
+	// Labels added to every entry
+	Labels map[string]string `json:"labels,omitempty"`
+	// Record keys mapped to entry labels, e.g. {"namespace": "k8s-pod/namespace"}
+	LabelMap map[string]string `json:"label_map,omitempty"`
+	// Record key of the severity (default: level)
+	SeverityKey string `json:"severity_key,omitempty"`
+	// Lowercase severities mapped to Cloud Logging severities, e.g. fatal: CRITICAL, other values are uppercased and recognized by the plugin (DEBUG, INFO, WARNING, ERROR, ...)
+	SeverityMap map[string]string `json:"severity_map,omitempty"`
+	// +docLink:"Buffer,../buffer/"
+	Buffer *Buffer `json:"buffer,omitempty"`
+}
+
+func (g *GoogleCloudLoggingOutput) ToDirective(secretLoader secret.SecretLoader, id string) (types.Directive, error) {
+	const pluginType = "google_cloud"
+
+	params := types.Params{}
+	if g.ProjectID != "" {
+		params["project_id"] = g.ProjectID
+	}
+	if g.K8sClusterName != "" {
+		params["k8s_cluster_name"] = g.K8sClusterName
+	}
+	if g.K8sClusterLocation != "" {
+		params["k8s_cluster_location"] = g.K8sClusterLocation
+	}
+	if g.UseMetadataService != nil {
+		params["use_metadata_service"] = fmt.Sprint(*g.UseMetadataService)
+	}
+	if g.UseGrpc {
+		params["use_grpc"] = "true"
+	}
+	if g.PartialSuccess != nil {
+		params["partial_success"] = fmt.Sprint(*g.PartialSuccess)
+	}
+	if g.DetectJson {
+		params["detect_json"] = "true"
+	}
+	for key, values := range map[string]map[string]string{"labels": g.Labels, "label_map": g.LabelMap} {
+		if len(values) > 0 {
+			b, err := json.Marshal(values)
+			if err != nil {
+				return nil, errors.WrapIff(err, "failed to marshal %s", key)
+			}
+			params[key] = string(b)
+		}
+	}
+
+	// the key is set in the environment of the fluentd statefulset, the plugin reads it as the Application Default Credentials
+	if g.Credentials != nil && g.Credentials.MountFrom == nil {
+		return nil, errors.New("the credentials of the google cloud logging output must be mounted from a secret")
+	}
+
+	googleCloud := &types.OutputPlugin{
+		PluginMeta: types.PluginMeta{
+			Type:      pluginType,
+			Directive: "match",
+			Tag:       "**",
+			Id:        id,
+		},
+		Params: params,
+	}
+	if g.Buffer == nil {
+		g.Buffer = &Buffer{}
+	}
+	if buffer, err := g.Buffer.ToDirective(secretLoader, id); err != nil {
+		return nil, err
+	} else {
+		googleCloud.SubDirectives = append(googleCloud.SubDirectives, buffer)
+	}
+
+	// the monitored resource and the severity are set in a dedicated label before the output
+	return types.NewFilteredOutput(id+":google_cloud", []types.Filter{g.conversionFilter(id)}, googleCloud), nil
+}
+
+// conversionFilter renders a record_modifier filter adding the local resource id and the severity of the record
+func (g *GoogleCloudLoggingOutput) conversionFilter(id string) types.Filter {
+	severityKey := g.SeverityKey
+	if severityKey == "" {
+		severityKey = googleCloudLoggingDefaultSeverityKey
+	}
+	severityMap := make(map[string]string, len(g.SeverityMap))
+	for k, v := range g.SeverityMap {
+		severityMap[strings.ToLower(k)] = v
+	}
+
+	prepare := []string{
+		fmt.Sprintf("@gcl_severity_key = %s", types.RubyString(severityKey)),
+		fmt.Sprintf("@gcl_severity_map = %s", types.RubyHash(severityMap)),
+		"@gcl_convert = lambda { |record| " + strings.Join([]string{
+			"k = record['kubernetes']",
+			"record['logging.googleapis.com/local_resource_id'] = ['k8s_container', k['namespace_name'], k['pod_name'], k['container_name']].join('.') if k.is_a?(Hash) && k['namespace_name'] && k['pod_name'] && k['container_name']",
+			"s = record[@gcl_severity_key]",
+			"record['severity'] = @gcl_severity_map.fetch(s.to_s.downcase, s.to_s.upcase) unless s.nil?",
+		}, "; ") + " }",
+	}
+
+	return types.NewTempKeyRecordModifier(id+"_google_cloud", prepare, "__google_cloud", "@gcl_convert.call(record)")
+}
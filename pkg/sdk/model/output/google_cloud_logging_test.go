@@ -0,0 +1,79 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package output_test
+
+import (
+	"testing"
+
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/output"
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/render"
+	"github.com/ghodss/yaml"
+)
+
+func TestGoogleCloudLogging(t *testing.T) {
+	CONFIG := []byte(`
+project_id: my-project
+k8s_cluster_name: production
+k8s_cluster_location: europe-west1
+use_metadata_service: false
+labels:
+  environment: production
+severity_map:
+  Fatal: CRITICAL
+buffer:
+  timekey: 1m
+  timekey_wait: 30s
+  timekey_use_utc: true
+`)
+	expected := `
+  <match **>
+    @type relabel
+    @label @5ddba311dc68ee38664cda9e76657082
+  </match>
+</label>
+<label @5ddba311dc68ee38664cda9e76657082>
+  <filter **>
+    @type record_modifier
+    @id test_google_cloud
+    prepare_value @gcl_severity_key = 'level'; @gcl_severity_map = {'fatal' => 'CRITICAL'}; @gcl_convert = lambda { |record| k = record['kubernetes']; record['logging.googleapis.com/local_resource_id'] = ['k8s_container', k['namespace_name'], k['pod_name'], k['container_name']].join('.') if k.is_a?(Hash) && k['namespace_name'] && k['pod_name'] && k['container_name']; s = record[@gcl_severity_key]; record['severity'] = @gcl_severity_map.fetch(s.to_s.downcase, s.to_s.upcase) unless s.nil? }
+    remove_keys __google_cloud
+    <record>
+      __google_cloud ${@gcl_convert.call(record); nil}
+    </record>
+  </filter>
+  <match **>
+    @type google_cloud
+    @id test
+    k8s_cluster_location europe-west1
+    k8s_cluster_name production
+    labels {"environment":"production"}
+    project_id my-project
+    use_metadata_service false
+    <buffer tag,time>
+      @type file
+      chunk_limit_size 8MB
+      path /buffers/test.*.buffer
+      retry_forever true
+      timekey 1m
+      timekey_use_utc true
+      timekey_wait 30s
+    </buffer>
+  </match>
+`
+	gcl := &output.GoogleCloudLoggingOutput{}
+	yaml.Unmarshal(CONFIG, gcl)
+	test := render.NewOutputPluginTest(t, gcl)
+	test.DiffResult(expected)
+}
@@ -15,6 +15,7 @@
 package output
 
 import (
+	"emperror.dev/errors"
 	"github.com/banzaicloud/logging-operator/pkg/sdk/model/types"
 	"github.com/banzaicloud/operator-tools/pkg/secret"
 )
@@ -124,7 +125,11 @@ type KafkaOutputConfig struct {
 	// Verify certificate hostname
 	SSLVerifyHostname *bool `json:"ssl_verify_hostname,omitempty"`
 	// +docLink:"Format,../format/"
+	// +kubebuilder:validation:Optional
 	Format *Format `json:"format"`
+	// Encode the records as Avro or Protobuf with the schemas of a schema registry instead of the format
+	// +docLink:"Schema Registry,../schema_registry/"
+	SchemaRegistry *SchemaRegistry `json:"schema_registry,omitempty"`
 	// +docLink:"Buffer,../buffer/"
 	Buffer *Buffer `json:"buffer,omitempty"`
 }
@@ -153,6 +158,25 @@ func (e *KafkaOutputConfig) ToDirective(secretLoader secret.SecretLoader, id str
 		kafka.SubDirectives = append(kafka.SubDirectives, buffer)
 	}
 
+	if e.SchemaRegistry != nil {
+		if e.Format != nil {
+			return nil, errors.New("format and schema_registry are mutually exclusive")
+		}
+		if format, err := e.SchemaRegistry.ToFormatDirective(secretLoader); err != nil {
+			return nil, err
+		} else {
+			// the subject of the schemas depends on the topic of the record
+			topicKey := e.TopicKey
+			if topicKey == "" {
+				topicKey = "topic"
+			}
+			format.GetParams()["topic_key"] = topicKey
+			if e.DefaultTopic != "" {
+				format.GetParams()["topic"] = e.DefaultTopic
+			}
+			kafka.SubDirectives = append(kafka.SubDirectives, format)
+		}
+	}
 	if e.Format != nil {
 		if format, err := e.Format.ToDirective(secretLoader, ""); err != nil {
 			return nil, err
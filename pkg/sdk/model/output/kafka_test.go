@@ -19,6 +19,7 @@ import (
 
 	"github.com/banzaicloud/logging-operator/pkg/sdk/model/output"
 	"github.com/banzaicloud/logging-operator/pkg/sdk/model/render"
+	"github.com/banzaicloud/operator-tools/pkg/secret"
 	"github.com/ghodss/yaml"
 )
 
@@ -62,3 +63,93 @@ buffer:
 	test := render.NewOutputPluginTest(t, kafka)
 	test.DiffResult(expected)
 }
+
+func TestKafkaAvroSchemaRegistry(t *testing.T) {
+	CONFIG := []byte(`
+brokers: kafka-headless.kafka.svc.cluster.local:29092
+default_topic: app-logs
+schema_registry:
+  encoding: avro
+  url: https://schema-registry.kafka.svc:8081
+  username:
+    value: logging
+  password:
+    value: secret
+  subject_name_strategy: TopicRecordNameStrategy
+  record_name: com.example.LogRecord
+buffer:
+  timekey: 1m
+  timekey_wait: 30s
+  timekey_use_utc: true
+`)
+	expected := `
+  <match **>
+    @type kafka2
+    @id test
+    brokers kafka-headless.kafka.svc.cluster.local:29092
+    default_topic app-logs
+    sasl_over_ssl false
+    <buffer tag,time>
+      @type file
+      chunk_limit_size 8MB
+      path /buffers/test.*.buffer
+      retry_forever true
+      timekey 1m
+      timekey_use_utc true
+      timekey_wait 30s
+    </buffer>
+    <format>
+      @type schema_registry
+      encoding avro
+      password secret
+      record_name com.example.LogRecord
+      subject_name_strategy TopicRecordNameStrategy
+      topic app-logs
+      topic_key topic
+      url https://schema-registry.kafka.svc:8081
+      username logging
+    </format>
+  </match>
+`
+	kafka := &output.KafkaOutputConfig{}
+	yaml.Unmarshal(CONFIG, kafka)
+	test := render.NewOutputPluginTest(t, kafka)
+	test.DiffResult(expected)
+}
+
+func TestKafkaProtobufRequiresDescriptorSet(t *testing.T) {
+	CONFIG := []byte(`
+brokers: kafka-headless.kafka.svc.cluster.local:29092
+schema_registry:
+  encoding: protobuf
+  url: https://schema-registry.kafka.svc:8081
+  record_name: com.example.LogRecord
+`)
+	kafka := &output.KafkaOutputConfig{}
+	if err := yaml.Unmarshal(CONFIG, kafka); err != nil {
+		t.Fatal(err)
+	}
+	_, err := kafka.ToDirective(secret.NewSecretLoader(nil, "", "", nil), "test")
+	if err == nil || err.Error() != "descriptor_set_file and record_name are required for the protobuf encoding" {
+		t.Errorf("expected missing descriptor set error, got %v", err)
+	}
+}
+
+func TestKafkaSchemaRegistryWithFormat(t *testing.T) {
+	CONFIG := []byte(`
+brokers: kafka-headless.kafka.svc.cluster.local:29092
+format:
+  type: json
+schema_registry:
+  encoding: avro
+  url: https://schema-registry.kafka.svc:8081
+`)
+	kafka := &output.KafkaOutputConfig{}
+	if err := yaml.Unmarshal(CONFIG, kafka); err != nil {
+		t.Fatal(err)
+	}
+	_, err := kafka.ToDirective(secret.NewSecretLoader(nil, "", "", nil), "test")
+	if err == nil || err.Error() != "format and schema_registry are mutually exclusive" {
+		t.Errorf("expected mutually exclusive error, got %v", err)
+	}
+}
@@ -39,6 +39,20 @@ type _hugoLoki interface{}
 //       timekey_wait: 30s
 //       timekey_use_utc: true
 // ```
+//
+// #### Example output configuration with automatic labels
+// The namespace and the allowed pod labels become Loki labels, the pod name, the pod template hash and similar high cardinality keys are refused.
+// ```
+// spec:
+//   loki:
+//     url: http://loki:3100
+//     auto_labels:
+//       allow:
+//         - app.kubernetes.io/*
+//         - team
+//       deny:
+//         - app.kubernetes.io/version
+// ```
 type _docLoki interface{}
 
 // +name:"Grafana Loki"
@@ -88,6 +102,9 @@ type LokiOutput struct {
 	ConfigureKubernetesLabels *bool `json:"configure_kubernetes_labels,omitempty"`
 	// +docLink:"Buffer,../buffer/"
 	Buffer *Buffer `json:"buffer,omitempty"`
+	// Derive the labels from the namespace and the pod labels, high cardinality keys are refused by a cardinality guard
+	// +docLink:"Auto Labels,#auto-labels"
+	AutoLabels *LokiAutoLabels `json:"auto_labels,omitempty"`
 }
 
 type Label map[string]string
@@ -145,13 +162,28 @@ func (l *LokiOutput) ToDirective(secretLoader secret.SecretLoader, id string) (t
 		// Prevent meta configuration from marshalling
 		l.ConfigureKubernetesLabels = nil
 	}
+	labels := l.Labels
+	if l.AutoLabels != nil {
+		// the refused labels are left out of a copy, the configured labels of the spec are kept
+		labels = Label{}
+		labels.merge(l.Labels)
+		if l.AutoLabels.Namespace == nil || *l.AutoLabels.Namespace {
+			labels.merge(Label{
+				"namespace": `$.kubernetes.namespace_name`,
+			})
+		}
+		for _, name := range l.AutoLabels.guardedLabels(labels) {
+			delete(labels, name)
+		}
+		l.ExtractKubernetesLabels = util.BoolPointer(true)
+	}
 	if params, err := types.NewStructToStringMapper(secretLoader).StringsMap(l); err != nil {
 		return nil, err
 	} else {
 		loki.Params = params
 	}
-	if l.Labels != nil {
-		if meta, err := l.Labels.ToDirective(secretLoader, ""); err != nil {
+	if labels != nil {
+		if meta, err := labels.ToDirective(secretLoader, ""); err != nil {
 			return nil, err
 		} else {
 			loki.SubDirectives = append(loki.SubDirectives, meta)
@@ -166,5 +198,9 @@ func (l *LokiOutput) ToDirective(secretLoader secret.SecretLoader, id string) (t
 		loki.SubDirectives = append(loki.SubDirectives, buffer)
 	}
 
+	if l.AutoLabels != nil {
+		// the pod labels not allowed as loki labels are removed in a dedicated label before the output
+		return types.NewFilteredOutput(id+":loki", []types.Filter{l.AutoLabels.podLabelFilter(id)}, loki), nil
+	}
 	return loki, nil
 }
@@ -0,0 +1,175 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package output
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/types"
+)
+
+// LokiHighCardinalityLabels are glob patterns of the keys refused as Loki labels by the cardinality guard of the automatic labels
+var LokiHighCardinalityLabels = []string{
+	"pod",
+	"pod_name",
+	"pod_id",
+	"pod-name",
+	"container_id",
+	"docker_id",
+	"pod-template-hash",
+	"controller-revision-hash",
+	"statefulset.kubernetes.io/pod-name",
+	"*request_id*",
+	"*request-id*",
+	"*requestid*",
+	"*trace_id*",
+	"*traceid*",
+	"*span_id*",
+	"uid",
+	"*_uid",
+	"*-uid",
+	"*/uid",
+}
+
+var lokiDefaultAutoLabels = []string{"app", "app.kubernetes.io/name", "app.kubernetes.io/component"}
+
+// +kubebuilder:object:generate=true
+// +docName:"Auto Labels"
+// The namespace and the pod labels matching the allow and not matching the deny glob patterns become Loki labels.
+// The pod labels are also removed from the record if they are not allowed.
+// The cardinality guard refuses the keys of LokiHighCardinalityLabels (e.g. pod, pod-template-hash, *request_id*) both as pod labels and as configured labels.
+// Refused configured labels stay in the spec but are left out of the rendered configuration, the output status lists them as warnings.
+// Add them to allow_high_cardinality to keep them as Loki labels.
+type LokiAutoLabels struct {
+	// Add the namespace label (default: true)
+	// +kubebuilder:validation:Optional
+	Namespace *bool `json:"namespace,omitempty"`
+	// Glob patterns of the pod labels added as Loki labels, e.g. app.kubernetes.io/* (default: app, app.kubernetes.io/name, app.kubernetes.io/component)
+	Allow []string `json:"allow,omitempty"`
+	// Glob patterns of the pod labels never added as Loki labels
+	Deny []string `json:"deny,omitempty"`
+	// High cardinality keys added as Loki labels despite the cardinality guard
+	AllowHighCardinality []string `json:"allow_high_cardinality,omitempty"`
+}
+
+// CardinalityWarnings lists the configured labels and allow patterns refused by the cardinality guard
+func (l *LokiOutput) CardinalityWarnings() []string {
+	if l.AutoLabels == nil {
+		return nil
+	}
+	var warnings []string
+	for _, name := range l.AutoLabels.guardedLabels(l.Labels) {
+		warnings = append(warnings, fmt.Sprintf("loki label %q has high cardinality and is refused, allow it with allow_high_cardinality", name))
+	}
+	for _, pattern := range l.AutoLabels.allowPatterns() {
+		for _, guard := range LokiHighCardinalityLabels {
+			if globRegexp(pattern).MatchString(guard) || globRegexp(guard).MatchString(pattern) {
+				warnings = append(warnings, fmt.Sprintf("loki auto label pattern %q allows high cardinality pod labels, they are refused", pattern))
+				break
+			}
+		}
+	}
+	return warnings
+}
+
+// guardedLabels returns the names of the labels refused by the cardinality guard
+func (a *LokiAutoLabels) guardedLabels(labels Label) []string {
+	var names []string
+	for name, value := range labels {
+		key := name
+		// record accessors like $.kubernetes.pod_name are checked by the accessed key as well
+		if strings.HasPrefix(value, "$.") {
+			path := strings.Split(value, ".")
+			key = path[len(path)-1]
+		}
+		if a.highCardinality(name) || a.highCardinality(key) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (a *LokiAutoLabels) allowPatterns() []string {
+	if len(a.Allow) == 0 {
+		return lokiDefaultAutoLabels
+	}
+	return a.Allow
+}
+
+func (a *LokiAutoLabels) highCardinality(key string) bool {
+	for _, allowed := range a.AllowHighCardinality {
+		if allowed == key {
+			return false
+		}
+	}
+	for _, guard := range LokiHighCardinalityLabels {
+		if globRegexp(guard).MatchString(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// podLabelFilter renders a record_modifier filter keeping the allowed pod labels of the record only
+func (a *LokiAutoLabels) podLabelFilter(id string) types.Filter {
+	prepare := []string{
+		fmt.Sprintf("@loki_allow = %s", rubyRegexpArray(a.allowPatterns())),
+		fmt.Sprintf("@loki_deny = %s", rubyRegexpArray(a.Deny)),
+		fmt.Sprintf("@loki_guard = %s", rubyRegexpArray(LokiHighCardinalityLabels)),
+		fmt.Sprintf("@loki_allow_high_cardinality = %s", types.RubyStringArray(a.AllowHighCardinality)),
+		"@loki_labels = lambda { |record| " + strings.Join([]string{
+			"k = record['kubernetes']",
+			"return unless k.is_a?(Hash) && k['labels'].is_a?(Hash)",
+			"k['labels'] = k['labels'].select { |name, _| @loki_allow.any? { |r| r.match?(name) } && @loki_deny.none? { |r| r.match?(name) } && (@loki_allow_high_cardinality.include?(name) || @loki_guard.none? { |r| r.match?(name) }) }",
+		}, "; ") + " }",
+	}
+
+	return types.NewTempKeyRecordModifier(id+"_loki", prepare, "__loki_labels", "@loki_labels.call(record)")
+}
+
+// globRegexpString converts a glob pattern to a case insensitive regular expression valid in both Go and Ruby
+func globRegexpString(pattern string) string {
+	var b strings.Builder
+	b.WriteString(`(?i)\A`)
+	for _, c := range pattern {
+		switch c {
+		case '*':
+			b.WriteString(".*")
+		case '?':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	b.WriteString(`\z`)
+	return b.String()
+}
+
+func globRegexp(pattern string) *regexp.Regexp {
+	return regexp.MustCompile(globRegexpString(pattern))
+}
+
+// rubyRegexpArray renders the glob patterns as a Ruby array of regular expressions
+func rubyRegexpArray(patterns []string) string {
+	var regexps []string
+	for _, pattern := range patterns {
+		regexps = append(regexps, fmt.Sprintf("Regexp.new(%s)", types.RubyString(globRegexpString(pattern))))
+	}
+	return "[" + strings.Join(regexps, ", ") + "]"
+}
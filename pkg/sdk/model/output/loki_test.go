@@ -69,3 +69,98 @@ buffer:
 	test := render.NewOutputPluginTest(t, loki)
 	test.DiffResult(expected)
 }
+
+func TestLokiAutoLabels(t *testing.T) {
+	CONFIG := []byte(`
+url: http://loki:3100
+labels:
+  pod: $.kubernetes.pod_name
+  host: $.kubernetes.host
+auto_labels:
+  allow:
+    - app.kubernetes.io/*
+    - team
+  deny:
+    - app.kubernetes.io/version
+buffer:
+  timekey: 1m
+  timekey_wait: 30s
+  timekey_use_utc: true
+`)
+	expected := `
+  <match **>
+    @type relabel
+    @label @d12c3c3661053ebfcd611ae586825591
+  </match>
+</label>
+<label @d12c3c3661053ebfcd611ae586825591>
+  <filter **>
+    @type record_modifier
+    @id test_loki
+    prepare_value @loki_allow = [Regexp.new('(?i)\\Aapp\\.kubernetes\\.io/.*\\z'), Regexp.new('(?i)\\Ateam\\z')]; @loki_deny = [Regexp.new('(?i)\\Aapp\\.kubernetes\\.io/version\\z')]; @loki_guard = [Regexp.new('(?i)\\Apod\\z'), Regexp.new('(?i)\\Apod_name\\z'), Regexp.new('(?i)\\Apod_id\\z'), Regexp.new('(?i)\\Apod-name\\z'), Regexp.new('(?i)\\Acontainer_id\\z'), Regexp.new('(?i)\\Adocker_id\\z'), Regexp.new('(?i)\\Apod-template-hash\\z'), Regexp.new('(?i)\\Acontroller-revision-hash\\z'), Regexp.new('(?i)\\Astatefulset\\.kubernetes\\.io/pod-name\\z'), Regexp.new('(?i)\\A.*request_id.*\\z'), Regexp.new('(?i)\\A.*request-id.*\\z'), Regexp.new('(?i)\\A.*requestid.*\\z'), Regexp.new('(?i)\\A.*trace_id.*\\z'), Regexp.new('(?i)\\A.*traceid.*\\z'), Regexp.new('(?i)\\A.*span_id.*\\z'), Regexp.new('(?i)\\Auid\\z'), Regexp.new('(?i)\\A.*_uid\\z'), Regexp.new('(?i)\\A.*-uid\\z'), Regexp.new('(?i)\\A.*/uid\\z')]; @loki_allow_high_cardinality = []; @loki_labels = lambda { |record| k = record['kubernetes']; return unless k.is_a?(Hash) && k['labels'].is_a?(Hash); k['labels'] = k['labels'].select { |name, _| @loki_allow.any? { |r| r.match?(name) } && @loki_deny.none? { |r| r.match?(name) } && (@loki_allow_high_cardinality.include?(name) || @loki_guard.none? { |r| r.match?(name) }) } }
+    remove_keys __loki_labels
+    <record>
+      __loki_labels ${@loki_labels.call(record); nil}
+    </record>
+  </filter>
+  <match **>
+    @type loki
+    @id test
+    extract_kubernetes_labels true
+    line_format json
+    url http://loki:3100
+    <label>
+      host $.kubernetes.host
+      namespace $.kubernetes.namespace_name
+    </label>
+    <buffer tag,time>
+      @type file
+      chunk_limit_size 8MB
+      path /buffers/test.*.buffer
+      retry_forever true
+      timekey 1m
+      timekey_use_utc true
+      timekey_wait 30s
+    </buffer>
+  </match>
+`
+	loki := &output.LokiOutput{}
+	yaml.Unmarshal(CONFIG, loki)
+	test := render.NewOutputPluginTest(t, loki)
+	test.DiffResult(expected)
+	if len(loki.Labels) != 2 || loki.Labels["pod"] != "$.kubernetes.pod_name" {
+		t.Errorf("the configured labels of the spec are modified: %v", loki.Labels)
+	}
+}
+
+func TestLokiCardinalityWarnings(t *testing.T) {
+	CONFIG := []byte(`
+url: http://loki:3100
+labels:
+  pod: $.kubernetes.pod_name
+  request: $.request_id
+  container: $.kubernetes.container_name
+auto_labels:
+  allow:
+    - "*"
+  allow_high_cardinality:
+    - request_id
+`)
+	loki := &output.LokiOutput{}
+	if err := yaml.Unmarshal(CONFIG, loki); err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{
+		`loki label "pod" has high cardinality and is refused, allow it with allow_high_cardinality`,
+		`loki auto label pattern "*" allows high cardinality pod labels, they are refused`,
+	}
+	warnings := loki.CardinalityWarnings()
+	if len(warnings) != len(expected) {
+		t.Fatalf("expected %d warnings, got %q", len(expected), warnings)
+	}
+	for i := range expected {
+		if warnings[i] != expected[i] {
+			t.Errorf("expected warning %q, got %q", expected[i], warnings[i])
+		}
+	}
+}
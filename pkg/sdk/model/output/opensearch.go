@@ -0,0 +1,329 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/types"
+	"github.com/banzaicloud/operator-tools/pkg/secret"
+)
+
+// +name:"OpenSearch"
+// +weight:"200"
+type _hugoOpenSearch interface{}
+
+// +docName:"OpenSearch output plugin for Fluentd"
+// More info at https://github.com/fluent/fluent-plugin-opensearch
+// The plugin requires the v1.13.1-alpine-1 or a later fluentd image.
+//
+// The plugin does not sniff the version of the cluster, so it works with OpenSearch and Amazon OpenSearch Service clusters, including the serverless collections.
+//
+// #### Example output configurations
+// ```
+// spec:
+//   opensearch:
+//     host: opensearch-cluster.default.svc.cluster.local
+//     port: 9200
+//     scheme: https
+//     ssl_verify: false
+//     data_stream_enable: true
+//     data_stream_name: logs-kubernetes
+//     buffer:
+//       timekey: 1m
+//       timekey_wait: 30s
+//       timekey_use_utc: true
+// ```
+type _docOpenSearch interface{}
+
+// +name:"OpenSearch"
+// +url:"https://github.com/fluent/fluent-plugin-opensearch/releases/tag/v1.0.2"
+// +version:"1.0.2"
+// +description:"Send your logs to OpenSearch"
+// +status:"Testing"
+type _metaOpenSearch interface{}
+
+const openSearchISMPolicyIDPlaceholder = "<<ism_policy_id>>"
+
+// +kubebuilder:object:generate=true
+// +docName:"OpenSearch"
+// Send your logs to OpenSearch
+type OpenSearchOutput struct {
+	// You can specify OpenSearch host by this parameter. (default:localhost)
+	Host string `json:"host,omitempty"`
+	// You can specify OpenSearch port by this parameter.(default: 9200)
+	Port int `json:"port,omitempty"`
+	// You can specify multiple OpenSearch hosts with separator ",". If you specify hosts option, host and port options are ignored.
+	Hosts string `json:"hosts,omitempty"`
+	// User for HTTP Basic authentication. This plugin will escape required URL encoded characters within %{} placeholders. e.g. %{demo+}
+	User string `json:"user,omitempty"`
+	// Password for HTTP Basic authentication.
+	// +docLink:"Secret,../secret/"
+	Password *secret.Secret `json:"password,omitempty"`
+	// Path for HTTP Basic authentication.
+	Path string `json:"path,omitempty"`
+	// Connection scheme (default: http)
+	Scheme string `json:"scheme,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Skip ssl verification (default: true)
+	SslVerify *bool `json:"ssl_verify,omitempty" plugin:"default:true"`
+	// If you want to configure SSL/TLS version, you can specify ssl_version parameter. [SSLv23, TLSv1, TLSv1_1, TLSv1_2]
+	SslVersion string `json:"ssl_version,omitempty"`
+	// CA certificate
+	SSLCACert *secret.Secret `json:"ca_file,omitempty"`
+	// Client certificate
+	SSLClientCert *secret.Secret `json:"client_cert,omitempty"`
+	// Client certificate key
+	SSLClientCertKey *secret.Secret `json:"client_key,omitempty"`
+	// Client key password
+	SSLClientCertKeyPass *secret.Secret `json:"client_key_pass,omitempty"`
+	// Enable Logstash log format.(default: false)
+	LogstashFormat bool `json:"logstash_format,omitempty"`
+	// Adds a @timestamp field to the log, following all settings logstash_format does, except without the restrictions on index_name. This allows one to log to an alias in OpenSearch and utilize the rollover API.(default: false)
+	IncludeTimestamp bool `json:"include_timestamp,omitempty"`
+	// Set the Logstash prefix.(default: logstash)
+	LogstashPrefix string `json:"logstash_prefix,omitempty"`
+	// Set the Logstash prefix separator.(default: -)
+	LogstashPrefixSeparator string `json:"logstash_prefix_separator,omitempty"`
+	// Set the Logstash date format.(default: %Y.%m.%d)
+	LogstashDateformat string `json:"logstash_dateformat,omitempty"`
+	// The index name to write events to (default: fluentd)
+	IndexName string `json:"index_name,omitempty"`
+	// This param is to set a pipeline id of your OpenSearch to be added into the request, you can configure ingest node.
+	Pipeline string `json:"pipeline,omitempty"`
+	// The format of the time stamp field (@timestamp or what you specify with time_key). This parameter only has an effect when logstash_format is true as it only affects the name of the index we write to.
+	TimeKeyFormat string `json:"time_key_format,omitempty"`
+	// Should the record not include a time_key, define the degree of sub-second time precision to preserve from the time portion of the routed event.
+	TimePrecision string `json:"time_precision,omitempty"`
+	// By default, when inserting records in Logstash format, @timestamp is dynamically created with the time at log ingestion. If you'd like to use a custom time, include an @timestamp with your record.
+	TimeKey string `json:"time_key,omitempty"`
+	// By default, the records inserted into index logstash-YYMMDD with UTC (Coordinated Universal Time). This option allows to use local time if you describe utc_index to false.(default: true)
+	// +kubebuilder:validation:Optional
+	UtcIndex *bool `json:"utc_index,omitempty" plugin:"default:true"`
+	// Tell this plugin to find the index name to write to in the record under this key in preference to other mechanisms. Key can be specified as path to nested record using dot ('.') as a separator.
+	TargetIndexKey string `json:"target_index_key,omitempty"`
+	// The name of the template to define. If a template by the name given is already present, it will be left unchanged, unless template_overwrite is set, in which case the template will be updated.
+	TemplateName string `json:"template_name,omitempty"`
+	// The path to the file containing the template to install.
+	// +docLink:"Secret,../secret/"
+	TemplateFile *secret.Secret `json:"template_file,omitempty"`
+	// Specify index templates in form of hash. Can contain multiple templates.
+	Templates string `json:"templates,omitempty"`
+	// Specify the string and its value to be replaced in form of hash. Can contain multiple key value pair that would be replaced in the specified template_file.
+	CustomizeTemplate string `json:"customize_template,omitempty"`
+	// Always update the template, even if it already exists.(default: false)
+	TemplateOverwrite bool `json:"template_overwrite,omitempty"`
+	// You can specify times of retry putting template.(default: 10)
+	MaxRetryPuttingTemplate string `json:"max_retry_putting_template,omitempty"`
+	// Indicates whether to fail when max_retry_putting_template is exceeded. If you have multiple output plugin, you could use this property to do not fail on fluentd statup.(default: true)
+	// +kubebuilder:validation:Optional
+	FailOnPuttingTemplateRetryExceed *bool `json:"fail_on_putting_template_retry_exceed,omitempty" plugin:"default:true"`
+	// Id of the Index State Management (ISM) policy attached to the indices created by the template: the `<<ism_policy_id>>` placeholder of the template file is replaced with the id,
+	// e.g. in the `plugins.index_state_management.policy_id` setting of the index template. Requires template_file.
+	// Not supported with data streams: their template is created by the plugin without the template file, use the `ism_template` of the policy instead.
+	ISMPolicyID string `json:"ism_policy_id,omitempty"`
+	// You can specify HTTP request timeout.(default: 5s)
+	RequestTimeout string `json:"request_timeout,omitempty"`
+	// You can tune how the OpenSearch transport host reloading feature works.(default: true)
+	// +kubebuilder:validation:Optional
+	ReloadConnections *bool `json:"reload_connections,omitempty" plugin:"default:true"`
+	// Indicates that the OpenSearch transport will try to reload the nodes addresses if there is a failure while making the request, this can be useful to quickly remove a dead node from the list of addresses.(default: false)
+	ReloadOnFailure bool `json:"reload_on_failure,omitempty"`
+	// When reload_connections true, this is the integer number of operations after which the plugin will reload the connections. The default value is 10000.
+	ReloadAfter string `json:"reload_after,omitempty"`
+	// You can set in the OpenSearch transport how often dead connections from the transport's pool will be resurrected.(default: 60s)
+	ResurrectAfter string `json:"resurrect_after,omitempty"`
+	// This will add the Fluentd tag in the JSON record.(default: false)
+	IncludeTagKey bool `json:"include_tag_key,omitempty"`
+	// This will add the Fluentd tag in the JSON record.(default: tag)
+	TagKey string `json:"tag_key,omitempty"`
+	// https://github.com/fluent/fluent-plugin-opensearch#id_key
+	IdKey string `json:"id_key,omitempty"`
+	// Similar to parent_key config, will add _routing into OpenSearch command if routing_key is set and the field does exist in input event.
+	RoutingKey string `json:"routing_key,omitempty"`
+	// Remove keys on update will not update the configured keys in OpenSearch when a record is being updated. This setting only has any effect if the write operation is update or upsert.
+	RemoveKeysOnUpdate string `json:"remove_keys_on_update,omitempty"`
+	// This setting allows remove_keys_on_update to be configured with a key in each record, in much the same way as target_index_key works.
+	RemoveKeysOnUpdateKey string `json:"remove_keys_on_update_key,omitempty"`
+	// This setting allows custom routing of messages in response to bulk request failures. The default behavior is to emit failed records using the same tag that was provided.
+	RetryTag string `json:"retry_tag,omitempty"`
+	// The write_operation can be any of: (index,create,update,upsert)(default: index)
+	WriteOperation string `json:"write_operation,omitempty"`
+	// Indicates that the plugin should reset connection on any error (reconnect on next send). By default it will reconnect only on "host unreachable exceptions".(default: false)
+	ReconnectOnError bool `json:"reconnect_on_error,omitempty"`
+	// This is debugging purpose option to enable to obtain transporter layer log. (default: false)
+	WithTransporterLog bool `json:"with_transporter_log,omitempty"`
+	// With content_type application/x-ndjson, OpenSearch plugin adds application/x-ndjson as Content-Profile in payload. (default: application/json)
+	ContentType string `json:"content_type,omitempty"`
+	// With this option set to true, Fluentd manifests the index name in the request URL (rather than in the request body). You can use this option to enforce an URL-based access control.
+	IncludeIndexInUrl bool `json:"include_index_in_url,omitempty"`
+	// With logstash_format true, OpenSearch plugin parses timestamp field for generating index name. If the record has invalid timestamp value, this plugin emits an error event to @ERROR label with time_parse_error_tag configured tag.
+	TimeParseErrorTag string `json:"time_parse_error_tag,omitempty"`
+	// With http_backend typhoeus, OpenSearch plugin uses typhoeus faraday http backend. Typhoeus can handle HTTP keepalive. (default: excon)
+	HttpBackend string `json:"http_backend,omitempty"`
+	// With default behavior, OpenSearch client uses Yajl as JSON encoder/decoder. Oj is the alternative high performance JSON encoder/decoder. When this parameter sets as true, OpenSearch client uses Oj as JSON encoder/decoder. (default: false)
+	PreferOjSerializer bool `json:"prefer_oj_serializer,omitempty"`
+	// OpenSearch will complain if you send object and concrete values to the same field, enable hash flattening to combat this.
+	FlattenHashes bool `json:"flatten_hashes,omitempty"`
+	// Flatten separator
+	FlattenHashesSeparator string `json:"flatten_hashes_separator,omitempty"`
+	// Default unrecoverable_error_types parameter is set up strictly. Because rejected_execution_exception is caused by exceeding OpenSearch's thread pool capacity. Advanced users can increase its capacity, but normal users should follow default behavior.
+	UnrecoverableErrorTypes string `json:"unrecoverable_error_types,omitempty"`
+	// If you want to disable to verify the OpenSearch version at start up, set it as false, e.g. for serverless collections. (default: true)
+	// +kubebuilder:validation:Optional
+	VerifyOsVersionAtStartup *bool `json:"verify_os_version_at_startup,omitempty" plugin:"default:true"`
+	// This parameter changes the OpenSearch version the plugin assumes when the version is not verified.(default: 1)
+	DefaultOpensearchVersion string `json:"default_opensearch_version,omitempty"`
+	// This parameter adds additional headers to request. Example: {"token":"secret"} (default: {})
+	CustomHeaders string `json:"custom_headers,omitempty"`
+	// By default, the error logger won't record the reason for a 400 error from the OpenSearch API unless you set log_level to debug. You can set this true to capture the 400 error reasons without all the other debug logs. (default: false)
+	LogOs400Reason bool `json:"log_os_400_reason,omitempty"`
+	// By default, record body is wrapped by 'doc'. This behavior can not handle update script requests. You can set this to suppress doc wrapping and allow record body to be untouched. (default: false)
+	SuppressDocWrap bool `json:"suppress_doc_wrap,omitempty"`
+	// A list of exception that will be ignored - when the exception occurs the chunk will be discarded and the buffer retry mechanism won't be called.
+	IgnoreExceptions string `json:"ignore_exceptions,omitempty"`
+	// Indicates whether to backup chunk when ignore exception occurs. (default: true)
+	// +kubebuilder:validation:Optional
+	ExceptionBackup *bool `json:"exception_backup,omitempty" plugin:"default:true"`
+	// Configure bulk_message request splitting threshold size.
+	// If you specify this size as negative number, bulk_message request splitting feature will be disabled. (default: 20MB)
+	BulkMessageRequestThreshold string `json:"bulk_message_request_threshold,omitempty"`
+	// The parameter sniffer_class_name gives you the ability to provide your own Sniffer class to implement whatever connection reload logic you require.
+	SnifferClassName string `json:"sniffer_class_name,omitempty"`
+	// Use @type opensearch_data_stream
+	DataStreamEnable *bool `json:"data_stream_enable,omitempty"`
+	// You can specify OpenSearch data stream name by this parameter. This parameter is mandatory for opensearch_data_stream.
+	DataStreamName string `json:"data_stream_name,omitempty"`
+	// Specify an existing index template for the data stream. If not present, a new template is created and named after the data stream.
+	DataStreamTemplateName string `json:"data_stream_template_name,omitempty"`
+	// AWS SigV4 authentication of Amazon OpenSearch Service domains and serverless collections
+	// +docLink:"OpenSearch Endpoint Credentials,#opensearch-endpoint-credentials"
+	Endpoint *OpenSearchEndpointCredentials `json:"endpoint,omitempty"`
+	// +docLink:"Buffer,../buffer/"
+	Buffer *Buffer `json:"buffer,omitempty"`
+}
+
+// +kubebuilder:object:generate=true
+// +docName:"OpenSearch Endpoint Credentials"
+// endpoint
+type OpenSearchEndpointCredentials struct {
+	// AWS region. It should be in form like us-east-1, us-west-2. Default nil, which means try to find from environment variable AWS_REGION.
+	Region string `json:"region,omitempty"`
+	// AWS connection url.
+	Url string `json:"url,omitempty"`
+	// AWS access key id. This parameter is required when your agent is not running on EC2 instance with an IAM Role.
+	// +docLink:"Secret,../secret/"
+	AccessKeyId *secret.Secret `json:"access_key_id,omitempty"`
+	// AWS secret key. This parameter is required when your agent is not running on EC2 instance with an IAM Role.
+	// +docLink:"Secret,../secret/"
+	SecretAccessKey *secret.Secret `json:"secret_access_key,omitempty"`
+	// Typically, you can use AssumeRole for cross-account access or federation.
+	AssumeRoleArn *secret.Secret `json:"assume_role_arn,omitempty"`
+	// Set with AWS_CONTAINER_CREDENTIALS_RELATIVE_URI environment variable value
+	EcsContainerCredentialsRelativeUri *secret.Secret `json:"ecs_container_credentials_relative_uri,omitempty"`
+	// AssumeRoleWithWebIdentity https://docs.aws.amazon.com/STS/latest/APIReference/API_AssumeRoleWithWebIdentity.html
+	AssumeRoleSessionName *secret.Secret `json:"assume_role_session_name,omitempty"`
+	// AssumeRoleWithWebIdentity https://docs.aws.amazon.com/STS/latest/APIReference/API_AssumeRoleWithWebIdentity.html
+	AssumeRoleWebIdentityTokenFile *secret.Secret `json:"assume_role_web_identity_token_file,omitempty"`
+	// Regional AWS STS endpoint used instead of the global one. https://docs.aws.amazon.com/IAM/latest/UserGuide/id_credentials_temp_enable-regions.html
+	StsCredentialsRegion *secret.Secret `json:"sts_credentials_region,omitempty"`
+	// The AWS service name signing the requests: es for OpenSearch Service domains, aoss for serverless collections (default: es)
+	AwsServiceName string `json:"aws_service_name,omitempty"`
+	// Interval of refreshing the credentials (default: 5h)
+	RefreshCredentialsInterval string `json:"refresh_credentials_interval,omitempty"`
+}
+
+func (o *OpenSearchEndpointCredentials) ToDirective(secretLoader secret.SecretLoader, id string) (types.Directive, error) {
+	return types.NewFlatDirective(types.PluginMeta{
+		Directive: "endpoint",
+	}, o, secretLoader)
+}
+
+func (e *OpenSearchOutput) ToDirective(secretLoader secret.SecretLoader, id string) (types.Directive, error) {
+	pluginType := "opensearch"
+	if e.DataStreamEnable != nil && *e.DataStreamEnable {
+		if e.DataStreamName == "" {
+			return nil, errors.New("data_stream_name is required for the opensearch data stream")
+		}
+		if e.ISMPolicyID != "" {
+			return nil, errors.New("ism_policy_id is not supported with data_stream_enable, attach the policy to the data stream by the ism_template of the policy")
+		}
+		pluginType = "opensearch_data_stream"
+	}
+	opensearch := &types.OutputPlugin{
+		PluginMeta: types.PluginMeta{
+			Type:      pluginType,
+			Directive: "match",
+			Tag:       "**",
+			Id:        id,
+		},
+	}
+	if params, err := types.NewStructToStringMapper(secretLoader).StringsMap(e); err != nil {
+		return nil, err
+	} else {
+		opensearch.Params = params
+	}
+	// the data stream is selected by the type of the plugin
+	delete(opensearch.Params, "data_stream_enable")
+	if e.ISMPolicyID != "" {
+		customizeTemplate, err := e.ismCustomizeTemplate()
+		if err != nil {
+			return nil, err
+		}
+		opensearch.Params["customize_template"] = customizeTemplate
+		delete(opensearch.Params, "ism_policy_id")
+	}
+	if e.Endpoint != nil {
+		if endpoint, err := e.Endpoint.ToDirective(secretLoader, id); err != nil {
+			return nil, err
+		} else {
+			opensearch.SubDirectives = append(opensearch.SubDirectives, endpoint)
+		}
+	}
+	if e.Buffer == nil {
+		e.Buffer = &Buffer{}
+	}
+	if buffer, err := e.Buffer.ToDirective(secretLoader, id); err != nil {
+		return nil, err
+	} else {
+		opensearch.SubDirectives = append(opensearch.SubDirectives, buffer)
+	}
+	return opensearch, nil
+}
+
+// ismCustomizeTemplate adds the ISM policy id to the replacements of the template file
+func (e *OpenSearchOutput) ismCustomizeTemplate() (string, error) {
+	if e.TemplateFile == nil {
+		return "", errors.New("template_file is required to attach the ism policy")
+	}
+	replacements := make(map[string]string)
+	if e.CustomizeTemplate != "" {
+		if err := json.Unmarshal([]byte(e.CustomizeTemplate), &replacements); err != nil {
+			return "", errors.WrapIf(err, "failed to parse customize_template")
+		}
+	}
+	replacements[openSearchISMPolicyIDPlaceholder] = e.ISMPolicyID
+	// the placeholders are kept as they are instead of escaping the angle brackets
+	b := &bytes.Buffer{}
+	encoder := json.NewEncoder(b)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(replacements); err != nil {
+		return "", errors.WrapIf(err, "failed to marshal customize_template")
+	}
+	return strings.TrimSpace(b.String()), nil
+}
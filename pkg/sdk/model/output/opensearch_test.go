@@ -0,0 +1,133 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package output_test
+
+import (
+	"testing"
+
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/output"
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/render"
+	"github.com/banzaicloud/operator-tools/pkg/secret"
+	"github.com/ghodss/yaml"
+)
+
+func TestOpenSearch(t *testing.T) {
+	CONFIG := []byte(`
+host: opensearch-cluster.default.svc.cluster.local
+port: 9200
+scheme: https
+ssl_verify: false
+template_name: logs-kubernetes
+template_file:
+  value: /fluentd/templates/logs.json
+customize_template: '{"<<shards>>": "3"}'
+ism_policy_id: logs-rollover
+endpoint:
+  url: https://search-logs.eu-west-1.es.amazonaws.com
+  region: eu-west-1
+  aws_service_name: es
+buffer:
+  timekey: 1m
+  timekey_wait: 30s
+  timekey_use_utc: true
+`)
+	expected := `
+  <match **>
+    @type opensearch
+    @id test
+    customize_template {"<<ism_policy_id>>":"logs-rollover","<<shards>>":"3"}
+    exception_backup true
+    fail_on_putting_template_retry_exceed true
+    host opensearch-cluster.default.svc.cluster.local
+    port 9200
+    reload_connections true
+    scheme https
+    ssl_verify false
+    template_file /fluentd/templates/logs.json
+    template_name logs-kubernetes
+    utc_index true
+    verify_os_version_at_startup true
+    <endpoint>
+      aws_service_name es
+      region eu-west-1
+      url https://search-logs.eu-west-1.es.amazonaws.com
+    </endpoint>
+    <buffer tag,time>
+      @type file
+      chunk_limit_size 8MB
+      path /buffers/test.*.buffer
+      retry_forever true
+      timekey 1m
+      timekey_use_utc true
+      timekey_wait 30s
+    </buffer>
+  </match>
+`
+	os := &output.OpenSearchOutput{}
+	yaml.Unmarshal(CONFIG, os)
+	test := render.NewOutputPluginTest(t, os)
+	test.DiffResult(expected)
+}
+
+func TestOpenSearchDataStream(t *testing.T) {
+	CONFIG := []byte(`
+host: opensearch-cluster.default.svc.cluster.local
+data_stream_enable: true
+data_stream_name: logs-kubernetes
+`)
+	expected := `
+  <match **>
+    @type opensearch_data_stream
+    @id test
+    data_stream_name logs-kubernetes
+    exception_backup true
+    fail_on_putting_template_retry_exceed true
+    host opensearch-cluster.default.svc.cluster.local
+    reload_connections true
+    ssl_verify true
+    utc_index true
+    verify_os_version_at_startup true
+    <buffer tag,time>
+      @type file
+      chunk_limit_size 8MB
+      path /buffers/test.*.buffer
+      retry_forever true
+      timekey 10m
+      timekey_wait 10m
+    </buffer>
+  </match>
+`
+	os := &output.OpenSearchOutput{}
+	yaml.Unmarshal(CONFIG, os)
+	test := render.NewOutputPluginTest(t, os)
+	test.DiffResult(expected)
+}
+
+func TestOpenSearchDataStreamISMPolicy(t *testing.T) {
+	CONFIG := []byte(`
+data_stream_enable: true
+data_stream_name: logs-kubernetes
+template_file:
+  value: /fluentd/templates/logs.json
+ism_policy_id: logs-rollover
+`)
+	os := &output.OpenSearchOutput{}
+	yaml.Unmarshal(CONFIG, os)
+	_, err := os.ToDirective(secret.NewSecretLoader(nil, "", "", nil), "test")
+	expected := "ism_policy_id is not supported with data_stream_enable, attach the policy to the data stream by the ism_template of the policy"
+	if err == nil || err.Error() != expected {
+		t.Fatalf("expected error %q, got %v", expected, err)
+	}
+}
@@ -0,0 +1,272 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/banzaicloud/operator-tools/pkg/secret"
+
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/types"
+)
+
+// +name:"OpenTelemetry"
+// +weight:"200"
+type _hugoOTLP interface{}
+
+// +docName:"OpenTelemetry (OTLP) output plugin for Fluentd"
+// Sends logs to an OpenTelemetry collector or any backend accepting OTLP over gRPC or HTTP.
+// The records are converted to OTLP log records before sending:
+// - the Kubernetes metadata is mapped to resource attributes following the OpenTelemetry semantic conventions,
+// - the log key of the container runtime (`message` or `log`) becomes the body,
+// - the `level` key becomes the severity text,
+// - the `trace_id`, `span_id` and `trace_flags` keys, e.g. extracted by the [trace context filter](../../filters/trace_context/), become the trace context,
+// - the rest of the top level keys become log attributes.
+// Records received by the OTLP input of fluentd are sent as they are.
+//
+// More info at https://github.com/fluent-plugins-nursery/fluent-plugin-opentelemetry
+// fluent-plugin-opentelemetry is installed in the fluentd images from v1.13.1-alpine-1 on.
+//
+// #### Example output configurations
+// ```
+// spec:
+//   otlp:
+//     endpoint: otel-collector.observability.svc:4317
+//     headers:
+//       - name: authorization
+//         value:
+//           valueFrom:
+//             secretKeyRef:
+//               name: otlp-token
+//               key: authorization
+//     buffer:
+//       timekey: 1m
+//       timekey_wait: 30s
+//       timekey_use_utc: true
+// ```
+type _docOTLP interface{}
+
+// +name:"OpenTelemetry"
+// +url:"https://github.com/fluent-plugins-nursery/fluent-plugin-opentelemetry"
+// +version:"0.1.0"
+// +description:"Send logs to OpenTelemetry collectors and backends over OTLP"
+// +status:"Testing"
+type _metaOTLP interface{}
+
+const (
+	OTLPProtocolGRPC = "grpc"
+	OTLPProtocolHTTP = "http"
+
+	otlpDefaultSeverityKey = "level"
+)
+
+var otlpDefaultResourceAttributes = map[string]string{
+	"k8s.namespace.name":   "kubernetes.namespace_name",
+	"k8s.pod.name":         "kubernetes.pod_name",
+	"k8s.pod.uid":          "kubernetes.pod_id",
+	"k8s.container.name":   "kubernetes.container_name",
+	"k8s.node.name":        "kubernetes.host",
+	"container.image.name": "kubernetes.container_image",
+}
+
+// +kubebuilder:object:generate=true
+// +docName:"Output Config"
+type OTLPOutput struct {
+	// Protocol used to send the logs: grpc or http (default: grpc)
+	Protocol string `json:"protocol,omitempty"`
+	// Endpoint of the OTLP receiver, e.g. otel-collector:4317 for grpc or http://otel-collector:4318 for http
+	Endpoint string `json:"endpoint"`
+	// Compression of the requests: text or gzip (default: text)
+	Compress string `json:"compress,omitempty"`
+	// Headers sent with every request, e.g. the authorization header of the backend
+	Headers []OTLPHeader `json:"headers,omitempty"`
+	// +docLink:"OTLP TLS,#otlp-tls"
+	TLS *OTLPTLS `json:"tls,omitempty"`
+	// Resource attributes mapped from record keys, nested keys are separated by dots
+	// (default: the k8s.namespace.name, k8s.pod.name, k8s.pod.uid, k8s.container.name, k8s.node.name and container.image.name attributes from the kubernetes metadata)
+	ResourceAttributes map[string]string `json:"resource_attributes,omitempty"`
+	// Record key of the severity text (default: level)
+	SeverityKey string `json:"severity_key,omitempty"`
+	// +docLink:"Buffer,../buffer/"
+	Buffer *Buffer `json:"buffer,omitempty"`
+}
+
+// +kubebuilder:object:generate=true
+// +docName:"OTLP header"
+type OTLPHeader struct {
+	// Name of the header
+	Name string `json:"name"`
+	// Value of the header
+	// +docLink:"Secret,../secret/"
+	Value *secret.Secret `json:"value"`
+}
+
+// +kubebuilder:object:generate=true
+// +docName:"OTLP TLS"
+type OTLPTLS struct {
+	// The CA certificate path for TLS.
+	// +docLink:"Secret,../secret/"
+	CACertPath *secret.Secret `json:"ca_path,omitempty"`
+	// The client certificate path for TLS.
+	// +docLink:"Secret,../secret/"
+	CertPath *secret.Secret `json:"cert_path,omitempty"`
+	// The client private key path for TLS.
+	// +docLink:"Secret,../secret/"
+	PrivateKeyPath *secret.Secret `json:"private_key_path,omitempty"`
+	// Skip the verification of the server certificate (default: false)
+	Insecure bool `json:"insecure,omitempty"`
+}
+
+func (t *OTLPTLS) ToDirective(secretLoader secret.SecretLoader, id string) (types.Directive, error) {
+	transport, err := types.NewFlatDirective(types.PluginMeta{
+		Directive: "transport",
+	}, t, secretLoader)
+	if err != nil {
+		return nil, err
+	}
+	transport.GetParams()["protocol"] = "tls"
+	return transport, nil
+}
+
+func (o *OTLPOutput) ToDirective(secretLoader secret.SecretLoader, id string) (types.Directive, error) {
+	const pluginType = "opentelemetry"
+
+	protocol := o.Protocol
+	switch protocol {
+	case "":
+		protocol = OTLPProtocolGRPC
+	case OTLPProtocolGRPC, OTLPProtocolHTTP:
+	default:
+		return nil, errors.Errorf("unknown otlp protocol %q", o.Protocol)
+	}
+	if o.Endpoint == "" {
+		return nil, errors.New("endpoint is required for the otlp output")
+	}
+
+	endpoint := &types.GenericDirective{
+		PluginMeta: types.PluginMeta{
+			Directive: protocol,
+		},
+		Params: types.Params{
+			"endpoint": o.Endpoint,
+		},
+	}
+	if o.Compress != "" {
+		endpoint.Params["compress"] = o.Compress
+	}
+	if len(o.Headers) > 0 {
+		headers := make(map[string]string, len(o.Headers))
+		for _, header := range o.Headers {
+			if header.Value != nil && header.Value.MountFrom != nil {
+				return nil, errors.Errorf("the value of the otlp header %q cannot be mounted from a secret", header.Name)
+			}
+			value, err := secretLoader.Load(header.Value)
+			if err != nil {
+				return nil, errors.WrapIff(err, "failed to load the value of the otlp header %q", header.Name)
+			}
+			headers[header.Name] = value
+		}
+		b, err := json.Marshal(headers)
+		if err != nil {
+			return nil, errors.WrapIf(err, "failed to marshal the otlp headers")
+		}
+		endpoint.Params["headers"] = string(b)
+	}
+
+	otlp := &types.OutputPlugin{
+		PluginMeta: types.PluginMeta{
+			Type:      pluginType,
+			Directive: "match",
+			Tag:       "**",
+			Id:        id,
+		},
+		Params: types.Params{},
+	}
+	otlp.SubDirectives = append(otlp.SubDirectives, endpoint)
+	if o.TLS != nil {
+		if transport, err := o.TLS.ToDirective(secretLoader, ""); err != nil {
+			return nil, err
+		} else {
+			otlp.SubDirectives = append(otlp.SubDirectives, transport)
+		}
+	}
+	if o.Buffer == nil {
+		o.Buffer = &Buffer{}
+	}
+	if buffer, err := o.Buffer.ToDirective(secretLoader, id); err != nil {
+		return nil, err
+	} else {
+		otlp.SubDirectives = append(otlp.SubDirectives, buffer)
+	}
+
+	// the plugin sends OTLP requests prepared in the message key, so the records are converted in a dedicated label before the output
+	return types.NewFilteredOutput(id+":otlp", []types.Filter{o.conversionFilter(id)}, otlp), nil
+}
+
+// conversionFilter renders a record_modifier filter replacing the records with the OTLP logs request of the record
+func (o *OTLPOutput) conversionFilter(id string) types.Filter {
+	resourceAttributes := o.ResourceAttributes
+	if len(resourceAttributes) == 0 {
+		resourceAttributes = otlpDefaultResourceAttributes
+	}
+	names := make([]string, 0, len(resourceAttributes))
+	for name := range resourceAttributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var attributes []string
+	for _, name := range names {
+		attributes = append(attributes, fmt.Sprintf("%s => %s", types.RubyString(name), types.RubyStringArray(strings.Split(resourceAttributes[name], "."))))
+	}
+	severityKey := o.SeverityKey
+	if severityKey == "" {
+		severityKey = otlpDefaultSeverityKey
+	}
+
+	prepare := []string{
+		`require "json"`,
+		fmt.Sprintf("@otlp_resource_attributes = {%s}", strings.Join(attributes, ", ")),
+		fmt.Sprintf("@otlp_body_key = %s", types.RubyString(types.GetLogKey())),
+		fmt.Sprintf("@otlp_severity_key = %s", types.RubyString(severityKey)),
+		"@otlp_excluded_keys = ['kubernetes', 'trace_id', 'span_id', 'trace_flags', @otlp_body_key, @otlp_severity_key]",
+		"@otlp_dig = " + types.RubyDigLambda,
+		"@otlp_value = lambda { |v| v.is_a?(String) ? {'stringValue' => v} : v.is_a?(Integer) ? {'intValue' => v.to_s} : v.is_a?(Float) ? {'doubleValue' => v} : v == true || v == false ? {'boolValue' => v} : {'stringValue' => v.to_json} }",
+		"@otlp_message = lambda { |record, time| " + strings.Join([]string{
+			"return record['message'] if record['type'] == 'opentelemetry_logs' && record['message'].is_a?(String)",
+			"log = {'timeUnixNano' => (time.to_r * 1000000000).to_i.to_s, 'body' => @otlp_value.call(record.fetch(@otlp_body_key, '')), 'attributes' => record.reject { |k, _| @otlp_excluded_keys.include?(k) }.map { |k, v| {'key' => k, 'value' => @otlp_value.call(v)} }}",
+			"log['severityText'] = record[@otlp_severity_key].to_s if record[@otlp_severity_key]",
+			"log['traceId'] = record['trace_id'] if record['trace_id']",
+			"log['spanId'] = record['span_id'] if record['span_id']",
+			"log['flags'] = record['trace_flags'].to_i(16) if record['trace_flags']",
+			"resource = @otlp_resource_attributes.map { |k, path| v = @otlp_dig.call(record, path); {'key' => k, 'value' => @otlp_value.call(v)} unless v.nil? }.compact",
+			"{'resourceLogs' => [{'resource' => {'attributes' => resource}, 'scopeLogs' => [{'logRecords' => [log]}]}]}.to_json }",
+		}, "; "),
+	}
+
+	return types.NewRecordModifier(id+"_otlp",
+		types.Params{
+			"prepare_value":  strings.Join(prepare, "; "),
+			"whitelist_keys": "type,message",
+		},
+		types.Params{
+			"message": "${@otlp_message.call(record, time)}",
+			"type":    "opentelemetry_logs",
+		},
+	)
+}
@@ -0,0 +1,88 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package output_test
+
+import (
+	"testing"
+
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/output"
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/render"
+	"github.com/ghodss/yaml"
+)
+
+func TestOTLP(t *testing.T) {
+	CONFIG := []byte(`
+protocol: http
+endpoint: https://otel-collector:4318
+compress: gzip
+headers:
+  - name: authorization
+    value:
+      value: Bearer token
+resource_attributes:
+  k8s.namespace.name: kubernetes.namespace_name
+  service.name: kubernetes.labels.app
+tls:
+  insecure: true
+buffer:
+  timekey: 1m
+  timekey_wait: 30s
+  timekey_use_utc: true
+`)
+	expected := `
+  <match **>
+    @type relabel
+    @label @305acc9ae11140bf80fb054452f8ce8c
+  </match>
+</label>
+<label @305acc9ae11140bf80fb054452f8ce8c>
+  <filter **>
+    @type record_modifier
+    @id test_otlp
+    prepare_value require "json"; @otlp_resource_attributes = {'k8s.namespace.name' => ['kubernetes', 'namespace_name'], 'service.name' => ['kubernetes', 'labels', 'app']}; @otlp_body_key = 'message'; @otlp_severity_key = 'level'; @otlp_excluded_keys = ['kubernetes', 'trace_id', 'span_id', 'trace_flags', @otlp_body_key, @otlp_severity_key]; @otlp_dig = lambda { |r, path| path.reduce(r) { |v, k| v.is_a?(Hash) ? v[k] : nil } }; @otlp_value = lambda { |v| v.is_a?(String) ? {'stringValue' => v} : v.is_a?(Integer) ? {'intValue' => v.to_s} : v.is_a?(Float) ? {'doubleValue' => v} : v == true || v == false ? {'boolValue' => v} : {'stringValue' => v.to_json} }; @otlp_message = lambda { |record, time| return record['message'] if record['type'] == 'opentelemetry_logs' && record['message'].is_a?(String); log = {'timeUnixNano' => (time.to_r * 1000000000).to_i.to_s, 'body' => @otlp_value.call(record.fetch(@otlp_body_key, '')), 'attributes' => record.reject { |k, _| @otlp_excluded_keys.include?(k) }.map { |k, v| {'key' => k, 'value' => @otlp_value.call(v)} }}; log['severityText'] = record[@otlp_severity_key].to_s if record[@otlp_severity_key]; log['traceId'] = record['trace_id'] if record['trace_id']; log['spanId'] = record['span_id'] if record['span_id']; log['flags'] = record['trace_flags'].to_i(16) if record['trace_flags']; resource = @otlp_resource_attributes.map { |k, path| v = @otlp_dig.call(record, path); {'key' => k, 'value' => @otlp_value.call(v)} unless v.nil? }.compact; {'resourceLogs' => [{'resource' => {'attributes' => resource}, 'scopeLogs' => [{'logRecords' => [log]}]}]}.to_json }
+    whitelist_keys type,message
+    <record>
+      message ${@otlp_message.call(record, time)}
+      type opentelemetry_logs
+    </record>
+  </filter>
+  <match **>
+    @type opentelemetry
+    @id test
+    <http>
+      compress gzip
+      endpoint https://otel-collector:4318
+      headers {"authorization":"Bearer token"}
+    </http>
+    <transport>
+      insecure true
+      protocol tls
+    </transport>
+    <buffer tag,time>
+      @type file
+      chunk_limit_size 8MB
+      path /buffers/test.*.buffer
+      retry_forever true
+      timekey 1m
+      timekey_use_utc true
+      timekey_wait 30s
+    </buffer>
+  </match>
+`
+	otlp := &output.OTLPOutput{}
+	yaml.Unmarshal(CONFIG, otlp)
+	test := render.NewOutputPluginTest(t, otlp)
+	test.DiffResult(expected)
+}
@@ -52,11 +52,39 @@ type _hugoS3 interface{}
 //      timekey_wait: 30s
 //      timekey_use_utc: true*/
 // ```
+//
+// #### Example parquet output configuration
+// Objects partitioned by namespace and date (`logs/namespace=default/dt=2021-06-01/`) in parquet files, queryable by Athena.
+// ```
+// spec:
+//  s3:
+//    s3_bucket: logging-amazon-s3
+//    s3_region: eu-central-1
+//    path: logs/
+//    partitions:
+//      namespace: true
+//    parquet:
+//      parquet_compression_codec: snappy
+//      schema:
+//        fields:
+//          - name: time
+//            type: string
+//          - name: message
+//            type: string
+//          - name: container_name
+//            type: string
+//          - name: labels
+//            type: map
+//    buffer:
+//      timekey: 10m
+//      timekey_wait: 30s
+//      timekey_use_utc: true
+// ```
 type _docS3 interface{}
 
 // +name:"Amazon S3"
-// +url:"https://github.com/fluent/fluent-plugin-s3/releases/tag/v1.4.0"
-// +version:"1.4.0"
+// +url:"https://github.com/fluent/fluent-plugin-s3/releases/tag/v1.6.0"
+// +version:"1.6.0"
 // +description:"Store logs in Amazon S3"
 // +status:"GA"
 type _metaS3 interface{}
@@ -138,7 +166,7 @@ type S3OutputConfig struct {
 	S3ObjectKeyFormat string `json:"s3_object_key_format,omitempty" plugin:"default:%{path}%{time_slice}_%{uuid_hash}_%{index}.%{file_extension}"`
 	// S3 bucket name
 	S3Bucket string `json:"s3_bucket"`
-	// Archive format on S3
+	// Archive format on S3: gzip, lzo, json, txt, zstd, gzip_command, lzma2 or parquet
 	StoreAs string `json:"store_as,omitempty"`
 	// The type of storage to use for the object(STANDARD,REDUCED_REDUNDANCY,STANDARD_IA)
 	StorageClass string `json:"storage_class,omitempty"`
@@ -158,6 +186,12 @@ type S3OutputConfig struct {
 	OneEyeFormat bool `json:"oneeye_format,omitempty"`
 	// Custom cluster name (default:one-eye)
 	ClusterName string `json:"clustername,omitempty"`
+	// Columnar store of the objects, store_as defaults to parquet
+	// +docLink:"Parquet,#parquet"
+	Parquet *S3Parquet `json:"parquet,omitempty"`
+	// Hive style partitions of the object keys by namespace and date
+	// +docLink:"Partitions,#partitions"
+	Partitions *S3Partitions `json:"partitions,omitempty"`
 }
 
 // +kubebuilder:object:generate=true
@@ -225,6 +259,36 @@ func (c *S3OutputConfig) ToDirective(secretLoader secret.SecretLoader, id string
 		c.Path = fmt.Sprintf(OneEyePathTemplate, clusterName)
 		c.S3ObjectKeyFormat = OneEyeObjectKeyFormat
 	}
+	if c.Partitions != nil {
+		if c.OneEyeFormat {
+			return nil, errors.New("partitions and oneeye_format cannot be set simultaneously")
+		}
+		if c.Buffer == nil {
+			c.Buffer = new(Buffer)
+		}
+		c.Buffer.Tags = c.Partitions.chunkKeys(c.Buffer.Tags)
+		c.Path = c.Partitions.path(c.Path)
+	}
+	if c.StoreAs == S3StoreAsORC {
+		return nil, errors.New("orc is not supported by the s3 plugin, use the parquet store")
+	}
+	if c.Parquet != nil {
+		if c.StoreAs == "" {
+			c.StoreAs = S3StoreAsParquet
+		}
+		if c.StoreAs != S3StoreAsParquet {
+			return nil, fmt.Errorf("parquet cannot be set with store_as %s", c.StoreAs)
+		}
+		// the chunks are converted from json lines
+		if c.Format == nil {
+			c.Format = &Format{}
+		}
+		if c.Format.Type != "" && c.Format.Type != "json" {
+			return nil, fmt.Errorf("format %s cannot be converted to parquet, use json", c.Format.Type)
+		}
+	} else if c.StoreAs == S3StoreAsParquet {
+		return nil, errors.New("the parquet store requires the parquet settings")
+	}
 	params, err := types.NewStructToStringMapper(secretLoader).StringsMap(c)
 	if err != nil {
 		return nil, err
@@ -250,6 +314,13 @@ func (c *S3OutputConfig) ToDirective(secretLoader secret.SecretLoader, id string
 			s3.SubDirectives = append(s3.SubDirectives, format)
 		}
 	}
+	if c.Parquet != nil {
+		if compress, err := c.Parquet.ToDirective(secretLoader, id); err != nil {
+			return nil, err
+		} else {
+			s3.SubDirectives = append(s3.SubDirectives, compress)
+		}
+	}
 	if err := c.validateAndSetCredentials(s3, secretLoader); err != nil {
 		return nil, err
 	}
@@ -0,0 +1,252 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package output
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/types"
+	"github.com/banzaicloud/operator-tools/pkg/secret"
+)
+
+const (
+	S3StoreAsParquet = "parquet"
+	S3StoreAsORC     = "orc"
+
+	// S3ParquetSchemaDir is the directory of the parquet schemas defined in place,
+	// the operator renders them into the output secret of fluentd mounted there
+	S3ParquetSchemaDir = "/fluentd/secret"
+
+	s3ParquetSchemaFile          = "s3-parquet-schema-%x.avsc"
+	s3PartitionNamespaceKey      = "$.kubernetes.namespace_name"
+	s3PartitionDefaultDateFormat = "%Y-%m-%d"
+)
+
+var (
+	s3ParquetFieldTypes = map[string]interface{}{
+		"string":  "string",
+		"boolean": "boolean",
+		"int":     "int",
+		"long":    "long",
+		"float":   "float",
+		"double":  "double",
+		"bytes":   "bytes",
+		"map":     map[string]string{"type": "map", "values": "string"},
+	}
+	avroNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+)
+
+// +kubebuilder:object:generate=true
+// +docName:"Parquet"
+// Columnar store of the objects, the chunks are converted by [columnify](https://github.com/reproio/columnify) shipped in the fluentd image from v1.13.1-alpine-1 on.
+// The records are written as json lines, the keys not defined by the schema are dropped.
+// ORC is out of scope: the S3 plugin does not support it and the parquet store is the only columnar store of the output.
+type S3Parquet struct {
+	// Compression codec of the parquet files: uncompressed, snappy, gzip, lzo, brotli, lz4, zstd (default: snappy)
+	// +kubebuilder:validation:Enum=uncompressed;snappy;gzip;lzo;brotli;lz4;zstd
+	CompressionCodec string `json:"parquet_compression_codec,omitempty"`
+	// Page size of the parquet files (default: 8k)
+	PageSize string `json:"parquet_page_size,omitempty"`
+	// Row group size of the parquet files (default: 128m)
+	RowGroupSize string `json:"parquet_row_group_size,omitempty"`
+	// Type of the schema file: avro or bigquery (default: avro)
+	// +kubebuilder:validation:Enum=avro;bigquery
+	SchemaType string `json:"schema_type,omitempty"`
+	// Schema file mounted from a secret, mutually exclusive with schema
+	// +docLink:"Secret,../secret/"
+	SchemaFile *secret.Secret `json:"schema_file,omitempty"`
+	// Schema of the records defined in place, mutually exclusive with schema_file
+	// +docLink:"Parquet Schema,#parquet-schema"
+	Schema *S3ParquetSchema `json:"schema,omitempty"`
+}
+
+// +kubebuilder:object:generate=true
+// +docName:"Parquet Schema"
+// Avro record schema of the parquet files. Nested keys have to be flattened before the output, e.g. with a record_transformer filter.
+type S3ParquetSchema struct {
+	// Name of the avro record (default: log)
+	Name string `json:"name,omitempty"`
+	// Fields of the record
+	Fields []S3ParquetField `json:"fields"`
+}
+
+// +kubebuilder:object:generate=true
+// +docName:"Parquet Field"
+type S3ParquetField struct {
+	// Name of the field, the record key of the value
+	Name string `json:"name"`
+	// Type of the field: string, boolean, int, long, float, double, bytes or map (of strings)
+	// +kubebuilder:validation:Enum=string;boolean;int;long;float;double;bytes;map
+	Type string `json:"type"`
+	// Records without the field are refused by the conversion, other fields are nullable (default: false)
+	Required bool `json:"required,omitempty"`
+}
+
+// +kubebuilder:object:generate=true
+// +docName:"Partitions"
+// Hive style partitions appended to the path, e.g. `logs/namespace=default/dt=2021-06-01/`, the namespace is added to the chunk keys of the buffer.
+type S3Partitions struct {
+	// Partition the objects by the namespace of the records (default: true)
+	Namespace *bool `json:"namespace,omitempty"`
+	// Strftime format of the date partition, the date partition is omitted if set to "-" (default: %Y-%m-%d)
+	DateFormat string `json:"date_format,omitempty"`
+}
+
+// Validate checks the schema of the records
+func (s *S3ParquetSchema) Validate() error {
+	if s.Name != "" && !avroNameRegexp.MatchString(s.Name) {
+		return fmt.Errorf("invalid parquet schema name %q", s.Name)
+	}
+	if len(s.Fields) == 0 {
+		return errors.New("parquet schema without fields")
+	}
+	names := map[string]bool{}
+	for _, field := range s.Fields {
+		if !avroNameRegexp.MatchString(field.Name) {
+			return fmt.Errorf("invalid parquet field name %q", field.Name)
+		}
+		if names[field.Name] {
+			return fmt.Errorf("duplicate parquet field %q", field.Name)
+		}
+		names[field.Name] = true
+		if _, ok := s3ParquetFieldTypes[field.Type]; !ok {
+			return fmt.Errorf("unknown type %q of the parquet field %q", field.Type, field.Name)
+		}
+	}
+	return nil
+}
+
+// AvroSchema returns the avro record schema of the fields, the optional fields are nullable
+func (s *S3ParquetSchema) AvroSchema() (string, error) {
+	if err := s.Validate(); err != nil {
+		return "", err
+	}
+	type requiredField struct {
+		Name string      `json:"name"`
+		Type interface{} `json:"type"`
+	}
+	type nullableField struct {
+		Name    string        `json:"name"`
+		Type    []interface{} `json:"type"`
+		Default interface{}   `json:"default"`
+	}
+	name := s.Name
+	if name == "" {
+		name = "log"
+	}
+	var fields []interface{}
+	for _, field := range s.Fields {
+		fieldType := s3ParquetFieldTypes[field.Type]
+		if field.Required {
+			fields = append(fields, requiredField{Name: field.Name, Type: fieldType})
+		} else {
+			fields = append(fields, nullableField{Name: field.Name, Type: []interface{}{"null", fieldType}})
+		}
+	}
+	schema := struct {
+		Type   string        `json:"type"`
+		Name   string        `json:"name"`
+		Fields []interface{} `json:"fields"`
+	}{Type: "record", Name: name, Fields: fields}
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(schema); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// SchemaFile returns the name of the file of the avro schema in the output secret of fluentd and its content
+func (s *S3ParquetSchema) SchemaFile() (string, string, error) {
+	schema, err := s.AvroSchema()
+	if err != nil {
+		return "", "", err
+	}
+	sum := sha256.Sum256([]byte(schema))
+	return fmt.Sprintf(s3ParquetSchemaFile, sum[:8]), schema, nil
+}
+
+// ToDirective renders the compress section of the parquet store
+func (p *S3Parquet) ToDirective(secretLoader secret.SecretLoader, id string) (types.Directive, error) {
+	if (p.SchemaFile == nil) == (p.Schema == nil) {
+		return nil, errors.New("either schema or schema_file is required for the parquet store")
+	}
+	if p.SchemaFile != nil && p.SchemaFile.MountFrom == nil {
+		return nil, errors.New("the parquet schema_file must be mounted from a secret")
+	}
+	if p.Schema != nil && p.SchemaType != "" && p.SchemaType != "avro" {
+		return nil, errors.New("the parquet schema defined in place is an avro schema")
+	}
+	params, err := types.NewStructToStringMapper(secretLoader).StringsMap(p)
+	if err != nil {
+		return nil, err
+	}
+	params["record_type"] = "jsonl"
+	if p.Schema != nil {
+		// columnify reads the schema from a file, it is mounted from the output secret
+		name, _, err := p.Schema.SchemaFile()
+		if err != nil {
+			return nil, err
+		}
+		params["schema_file"] = S3ParquetSchemaDir + "/" + name
+	}
+	return &types.GenericDirective{
+		PluginMeta: types.PluginMeta{
+			Directive: "compress",
+		},
+		Params: params,
+	}, nil
+}
+
+// path returns the hive style partitions appended to the prefix
+func (p *S3Partitions) path(prefix string) string {
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+	if p.Namespace == nil || *p.Namespace {
+		prefix += "namespace=${" + s3PartitionNamespaceKey + "}/"
+	}
+	switch p.DateFormat {
+	case "-":
+	case "":
+		prefix += "dt=" + s3PartitionDefaultDateFormat + "/"
+	default:
+		prefix += "dt=" + p.DateFormat + "/"
+	}
+	return prefix
+}
+
+// chunkKeys returns the buffer chunk keys including the partition keys
+func (p *S3Partitions) chunkKeys(tags string) string {
+	if tags == "" {
+		tags = "tag,time"
+	}
+	if p.Namespace != nil && !*p.Namespace {
+		return tags
+	}
+	for _, key := range strings.Split(tags, ",") {
+		if strings.TrimSpace(key) == s3PartitionNamespaceKey {
+			return tags
+		}
+	}
+	return tags + "," + s3PartitionNamespaceKey
+}
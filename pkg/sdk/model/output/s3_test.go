@@ -19,6 +19,7 @@ import (
 
 	"github.com/banzaicloud/logging-operator/pkg/sdk/model/output"
 	"github.com/banzaicloud/logging-operator/pkg/sdk/model/render"
+	"github.com/banzaicloud/operator-tools/pkg/secret"
 	"github.com/ghodss/yaml"
 )
 
@@ -61,3 +62,80 @@ buffer:
 	test := render.NewOutputPluginTest(t, s3)
 	test.DiffResult(expected)
 }
+
+func TestS3Parquet(t *testing.T) {
+	CONFIG := []byte(`
+s3_bucket: logging-amazon-s3
+s3_region: eu-central-1
+path: logs
+partitions:
+  date_format: "%Y/%m/%d"
+parquet:
+  parquet_compression_codec: zstd
+  schema:
+    name: container_log
+    fields:
+      - name: time
+        type: string
+        required: true
+      - name: message
+        type: string
+      - name: labels
+        type: map
+buffer:
+  timekey: 1h
+  timekey_wait: 1m
+  timekey_use_utc: true
+`)
+	expected := `
+  <match **>
+    @type s3
+    @id test
+    path logs/namespace=${$.kubernetes.namespace_name}/dt=%Y/%m/%d/
+    s3_bucket logging-amazon-s3
+    s3_object_key_format %{path}%{time_slice}_%{uuid_hash}_%{index}.%{file_extension}
+    s3_region eu-central-1
+    store_as parquet
+    <buffer tag,time,$.kubernetes.namespace_name>
+      @type file
+      chunk_limit_size 8MB
+      path /buffers/test.*.buffer
+      retry_forever true
+      timekey 1h
+      timekey_use_utc true
+      timekey_wait 1m
+    </buffer>
+    <format>
+      @type json
+    </format>
+    <compress>
+      parquet_compression_codec zstd
+      record_type jsonl
+      schema_file /fluentd/secret/s3-parquet-schema-8e6fa75724fd9051.avsc
+    </compress>
+  </match>
+`
+	s3 := &output.S3OutputConfig{}
+	yaml.Unmarshal(CONFIG, s3)
+	test := render.NewOutputPluginTest(t, s3)
+	test.DiffResult(expected)
+}
+
+func TestS3ParquetSchemaValidation(t *testing.T) {
+	CONFIG := []byte(`
+s3_bucket: logging-amazon-s3
+parquet:
+  schema:
+    fields:
+      - name: kubernetes.namespace_name
+        type: string
+`)
+	s3 := &output.S3OutputConfig{}
+	if err := yaml.Unmarshal(CONFIG, s3); err != nil {
+		t.Fatal(err)
+	}
+	_, err := s3.ToDirective(secret.NewSecretLoader(nil, "", "", nil), "test")
+	if err == nil || err.Error() != `invalid parquet field name "kubernetes.namespace_name"` {
+		t.Errorf("expected invalid field name error, got %v", err)
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package output
+
+import (
+	"emperror.dev/errors"
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/types"
+	"github.com/banzaicloud/operator-tools/pkg/secret"
+)
+
+// +name:"Schema Registry"
+// +weight:"200"
+type _hugoSchemaRegistry interface{}
+
+// +docName:"Schema Registry"
+// The schema registry of the Kafka output encodes the records as Avro or Protobuf in the wire format of the Confluent schema registry:
+// a magic byte, the id of the schema in the registry and the binary payload. It replaces the format of the Kafka output.
+// The schema is looked up in the registry by the subject of the record, the avro schema of the configuration is registered under the subject if set.
+// The protobuf messages are encoded with the descriptors of a compiled descriptor set (`protoc --include_imports --descriptor_set_out`),
+// the message of the record_name is filled from the record as JSON, unknown fields are ignored.
+//
+// The schema of the default topic or of the record name is resolved when fluentd starts, the schemas of the other topics with their first record.
+// The resolved schemas are refreshed every cache_ttl, the last resolved schema is kept while the registry is unavailable.
+// The Kafka output drops the records it cannot encode, e.g. the records of a topic whose schema could not be resolved yet, and logs a warning for each.
+//
+// The encoding is implemented by the `schema_registry` formatter plugin of the fluentd image, available from v1.13.1-alpine-1 on.
+//
+// #### Example schema registry configuration
+// ```
+// spec:
+//   kafka:
+//     brokers: kafka-headless.kafka.svc.cluster.local:29092
+//     default_topic: app-logs
+//     schema_registry:
+//       encoding: avro
+//       url: https://schema-registry.kafka.svc:8081
+//       username:
+//         valueFrom:
+//           secretKeyRef:
+//             name: schema-registry
+//             key: username
+//       password:
+//         valueFrom:
+//           secretKeyRef:
+//             name: schema-registry
+//             key: password
+//       subject_name_strategy: TopicNameStrategy
+// ```
+type _docSchemaRegistry interface{}
+
+// +name:"Schema Registry"
+// +url:"https://docs.confluent.io/platform/current/schema-registry/serdes-develop/index.html"
+// +version:"more info"
+// +description:"Encode records as Avro or Protobuf registered in a schema registry"
+// +status:"Testing"
+type _metaSchemaRegistry interface{}
+
+const (
+	SchemaRegistryEncodingAvro     = "avro"
+	SchemaRegistryEncodingProtobuf = "protobuf"
+
+	SubjectNameStrategyTopicName       = "TopicNameStrategy"
+	SubjectNameStrategyRecordName      = "RecordNameStrategy"
+	SubjectNameStrategyTopicRecordName = "TopicRecordNameStrategy"
+)
+
+// +kubebuilder:object:generate=true
+// +docName:"Schema Registry"
+type SchemaRegistry struct {
+	// Encoding of the records: avro or protobuf
+	// +kubebuilder:validation:Enum=avro;protobuf
+	Encoding string `json:"encoding"`
+	// The url of the Confluent compatible schema registry
+	URL string `json:"url"`
+	// Username of the basic authentication of the registry
+	// +docLink:"Secret,../secret/"
+	Username *secret.Secret `json:"username,omitempty"`
+	// Password of the basic authentication of the registry
+	// +docLink:"Secret,../secret/"
+	Password *secret.Secret `json:"password,omitempty"`
+	// CA certificate verifying the registry
+	// +docLink:"Secret,../secret/"
+	CAFile *secret.Secret `json:"ca_file,omitempty"`
+	// Client certificate of the registry
+	// +docLink:"Secret,../secret/"
+	CertFile *secret.Secret `json:"cert_file,omitempty"`
+	// Client certificate key of the registry
+	// +docLink:"Secret,../secret/"
+	KeyFile *secret.Secret `json:"key_file,omitempty"`
+	// The subject of the schema: TopicNameStrategy (<topic>-value), RecordNameStrategy (<record_name>) or TopicRecordNameStrategy (<topic>-<record_name>) (default: TopicNameStrategy)
+	// The topic is the topic of the record or the default topic of the Kafka output.
+	// +kubebuilder:validation:Enum=TopicNameStrategy;RecordNameStrategy;TopicRecordNameStrategy
+	SubjectNameStrategy string `json:"subject_name_strategy,omitempty"`
+	// The fully qualified name of the avro record or the protobuf message, required by the record name strategies and the protobuf format
+	RecordName string `json:"record_name,omitempty"`
+	// The version of the registered schema used to encode the records (default: latest)
+	SchemaVersion string `json:"schema_version,omitempty"`
+	// Avro schema registered under the subject and used to encode the records instead of looking up the registered one
+	Schema string `json:"schema,omitempty"`
+	// Compiled protobuf descriptor set including the imports, it must be mounted from a secret
+	// +docLink:"Secret,../secret/"
+	DescriptorSetFile *secret.Secret `json:"descriptor_set_file,omitempty"`
+	// Interval of refreshing the schemas looked up in the registry (default: 5m)
+	CacheTTL string `json:"cache_ttl,omitempty"`
+}
+
+// ToFormatDirective renders the format section of the schema registry formatter
+func (s *SchemaRegistry) ToFormatDirective(secretLoader secret.SecretLoader) (types.Directive, error) {
+	if s.URL == "" {
+		return nil, errors.New("url is required for the schema registry")
+	}
+	switch s.SubjectNameStrategy {
+	case "", SubjectNameStrategyTopicName:
+	case SubjectNameStrategyRecordName, SubjectNameStrategyTopicRecordName:
+		if s.RecordName == "" {
+			return nil, errors.Errorf("record_name is required for the %s", s.SubjectNameStrategy)
+		}
+	default:
+		return nil, errors.Errorf("unknown subject name strategy %q", s.SubjectNameStrategy)
+	}
+	switch s.Encoding {
+	case SchemaRegistryEncodingAvro:
+		if s.DescriptorSetFile != nil {
+			return nil, errors.New("descriptor_set_file is supported by the protobuf encoding only")
+		}
+	case SchemaRegistryEncodingProtobuf:
+		if s.DescriptorSetFile == nil || s.RecordName == "" {
+			return nil, errors.New("descriptor_set_file and record_name are required for the protobuf encoding")
+		}
+		if s.Schema != "" {
+			return nil, errors.New("schema is supported by the avro encoding only")
+		}
+	default:
+		return nil, errors.Errorf("unknown schema registry encoding %q", s.Encoding)
+	}
+	files := []struct {
+		name string
+		file *secret.Secret
+	}{
+		{"ca_file", s.CAFile},
+		{"cert_file", s.CertFile},
+		{"key_file", s.KeyFile},
+		{"descriptor_set_file", s.DescriptorSetFile},
+	}
+	for _, f := range files {
+		if f.file != nil && f.file.MountFrom == nil {
+			return nil, errors.Errorf("the %s of the schema registry must be mounted from a secret", f.name)
+		}
+	}
+	format, err := types.NewFlatDirective(types.PluginMeta{
+		Type:      "schema_registry",
+		Directive: "format",
+	}, s, secretLoader)
+	if err != nil {
+		return nil, err
+	}
+	return format, nil
+}
@@ -0,0 +1,121 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package output
+
+import (
+	"emperror.dev/errors"
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/types"
+	"github.com/banzaicloud/operator-tools/pkg/secret"
+)
+
+// +name:"Amazon SNS"
+// +weight:"200"
+type _hugoSNS interface{}
+
+// +docName:"SNS output plugin for Fluentd"
+//  More info at https://github.com/miyagawa/fluent-plugin-sns
+//  The plugin is available in the fluentd image from v1.13.1-alpine-1 on.
+//
+// Without aws_key_id and aws_sec_key the default credential chain of the AWS SDK is used,
+// e.g. the IAM role of the node or the role of the fluentd service account (IRSA).
+// Message attributes can be taken from record fields, so subscriptions (e.g. SQS queues) can filter the messages of specific log streams.
+//
+// #### Example output configurations
+// ```
+// spec:
+//   sns:
+//     sns_topic_name: application-events
+//     aws_region: eu-west-1
+//     sns_subject: application event
+//     sns_message_attributes:
+//       source: kubernetes
+//     sns_message_attributes_keys:
+//       namespace: namespace
+//       app: app
+//     buffer:
+//       flush_interval: 10s
+// ```
+type _docSNS interface{}
+
+// +name:"Amazon SNS"
+// +url:"https://github.com/miyagawa/fluent-plugin-sns"
+// +version:"3.2.0"
+// +description:"Fluent plugin for Amazon SNS"
+// +status:"Testing"
+type _metaSNS interface{}
+
+// +kubebuilder:object:generate=true
+// +docName:"Output Config"
+type SNSOutputConfig struct {
+	// AWS access key id
+	// +docLink:"Secret,../secret/"
+	AWSKeyId *secret.Secret `json:"aws_key_id,omitempty"`
+	// AWS secret key
+	// +docLink:"Secret,../secret/"
+	AWSSecKey *secret.Secret `json:"aws_sec_key,omitempty"`
+	// AWS region of the topic
+	AWSRegion string `json:"aws_region,omitempty"`
+	// Name of the SNS topic
+	SNSTopicName string `json:"sns_topic_name"`
+	// Fixed subject of the messages
+	SNSSubject string `json:"sns_subject,omitempty"`
+	// Record key of the subject
+	SNSSubjectKey string `json:"sns_subject_key,omitempty"`
+	// ERB template of the subject, the record is available as record
+	SNSSubjectTemplate string `json:"sns_subject_template,omitempty"`
+	// Record key of the message body (default: the record as JSON)
+	SNSBodyKey string `json:"sns_body_key,omitempty"`
+	// ERB template of the message body, the record is available as record
+	SNSBodyTemplate string `json:"sns_body_template,omitempty"`
+	// Fixed message attributes added to every message
+	SNSMessageAttributes map[string]string `json:"sns_message_attributes,omitempty"`
+	// Message attributes taken from record fields: the name of the attribute mapped to the record key
+	SNSMessageAttributesKeys map[string]string `json:"sns_message_attributes_keys,omitempty"`
+	// Endpoint of the SNS API, e.g. for VPC endpoints
+	SNSEndpoint string `json:"sns_endpoint,omitempty"`
+	// HTTP proxy of the requests
+	Proxy string `json:"proxy,omitempty"`
+	// +docLink:"Buffer,../buffer/"
+	Buffer *Buffer `json:"buffer,omitempty"`
+}
+
+func (s *SNSOutputConfig) ToDirective(secretLoader secret.SecretLoader, id string) (types.Directive, error) {
+	const pluginType = "sns"
+	if s.SNSTopicName == "" {
+		return nil, errors.New("sns_topic_name is required for the sns output")
+	}
+	sns := &types.OutputPlugin{
+		PluginMeta: types.PluginMeta{
+			Type:      pluginType,
+			Directive: "match",
+			Tag:       "**",
+			Id:        id,
+		},
+	}
+	if params, err := types.NewStructToStringMapper(secretLoader).StringsMap(s); err != nil {
+		return nil, err
+	} else {
+		sns.Params = params
+	}
+	if s.Buffer == nil {
+		s.Buffer = &Buffer{}
+	}
+	if buffer, err := s.Buffer.ToDirective(secretLoader, id); err != nil {
+		return nil, err
+	} else {
+		sns.SubDirectives = append(sns.SubDirectives, buffer)
+	}
+	return sns, nil
+}
@@ -0,0 +1,61 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package output_test
+
+import (
+	"testing"
+
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/output"
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/render"
+	"github.com/ghodss/yaml"
+)
+
+func TestSNS(t *testing.T) {
+	CONFIG := []byte(`
+sns_topic_name: application-events
+aws_region: eu-west-1
+sns_subject: application event
+sns_message_attributes:
+  source: kubernetes
+sns_message_attributes_keys:
+  namespace: namespace
+buffer:
+  flush_interval: 10s
+`)
+	expected := `
+  <match **>
+    @type sns
+    @id test
+    aws_region eu-west-1
+    sns_message_attributes {"source":"kubernetes"}
+    sns_message_attributes_keys {"namespace":"namespace"}
+    sns_subject application event
+    sns_topic_name application-events
+    <buffer tag,time>
+      @type file
+      chunk_limit_size 8MB
+      flush_interval 10s
+      path /buffers/test.*.buffer
+      retry_forever true
+      timekey 10m
+      timekey_wait 10m
+    </buffer>
+  </match>
+`
+	sns := &output.SNSOutputConfig{}
+	yaml.Unmarshal(CONFIG, sns)
+	test := render.NewOutputPluginTest(t, sns)
+	test.DiffResult(expected)
+}
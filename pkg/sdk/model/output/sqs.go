@@ -0,0 +1,133 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package output
+
+import (
+	"emperror.dev/errors"
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/types"
+	"github.com/banzaicloud/operator-tools/pkg/secret"
+)
+
+// +name:"Amazon SQS"
+// +weight:"200"
+type _hugoSQS interface{}
+
+// +docName:"SQS output plugin for Fluentd"
+//  More info at https://github.com/ixixi/fluent-plugin-sqs
+//
+// Without aws_key_id and aws_sec_key the default credential chain of the AWS SDK is used,
+// e.g. the IAM role of the node or the role of the fluentd service account (IRSA).
+// The records of a buffer chunk are sent in batches of up to 10 messages.
+// Message attributes, e.g. for routing the messages by their consumers, can be fixed or taken from record fields.
+// They are sent by the `sqs_attributes` output plugin of the fluentd image, the other options work the same way.
+// Both plugins are installed in the fluentd image from v1.13.1-alpine-1 on.
+//
+// #### Example output configurations
+// ```
+// spec:
+//   sqs:
+//     queue_name: audit-events
+//     region: eu-west-1
+//     message_attributes:
+//       cluster: production
+//     message_attributes_keys:
+//       namespace: $.kubernetes.namespace_name
+//       level: level
+//     buffer:
+//       flush_interval: 10s
+// ```
+type _docSQS interface{}
+
+// +name:"Amazon SQS"
+// +url:"https://github.com/ixixi/fluent-plugin-sqs/releases/tag/v3.0.0"
+// +version:"3.0.0"
+// +description:"Fluent plugin for Amazon SQS"
+// +status:"Testing"
+type _metaSQS interface{}
+
+// SQS allows at most 10 attributes per message
+const sqsMaxMessageAttributes = 10
+
+// +kubebuilder:object:generate=true
+// +docName:"Output Config"
+type SQSOutputConfig struct {
+	// SQS queue url e.g. https://sqs.us-west-2.amazonaws.com/123456789012/myqueue
+	SQSUrl string `json:"sqs_url,omitempty"`
+	// SQS queue name - required if sqs_url is not set
+	QueueName string `json:"queue_name,omitempty"`
+	// AWS access key id
+	// +docLink:"Secret,../secret/"
+	AWSKeyId *secret.Secret `json:"aws_key_id,omitempty"`
+	// AWS secret key
+	// +docLink:"Secret,../secret/"
+	AWSSecKey *secret.Secret `json:"aws_sec_key,omitempty"`
+	// Create SQS queue (default: true)
+	// +kubebuilder:validation:Optional
+	CreateQueue *bool `json:"create_queue,omitempty" plugin:"default:true"`
+	// AWS region (default: ap-northeast-1)
+	Region string `json:"region,omitempty"`
+	// Message group id for FIFO queue
+	MessageGroupId string `json:"message_group_id,omitempty"`
+	// Delivery delay seconds (default: 0)
+	DelaySeconds int `json:"delay_seconds,omitempty"`
+	// Include tag (default: true)
+	// +kubebuilder:validation:Optional
+	IncludeTag *bool `json:"include_tag,omitempty" plugin:"default:true"`
+	// Tags property name in json (default: '__tag')
+	TagPropertyName string `json:"tag_property_name,omitempty"`
+	// String message attributes added to every message
+	MessageAttributes map[string]string `json:"message_attributes,omitempty"`
+	// String message attributes taken from record fields: the name of the attribute mapped to the record key, e.g. level or $.kubernetes.namespace_name.
+	// Messages of records without the field are sent without the attribute, other than string values are sent as JSON.
+	MessageAttributesKeys map[string]string `json:"message_attributes_keys,omitempty"`
+	// +docLink:"Buffer,../buffer/"
+	Buffer *Buffer `json:"buffer,omitempty"`
+}
+
+func (s *SQSOutputConfig) ToDirective(secretLoader secret.SecretLoader, id string) (types.Directive, error) {
+	pluginType := "sqs"
+	if s.SQSUrl == "" && s.QueueName == "" {
+		return nil, errors.New("either sqs_url or queue_name is required for the sqs output")
+	}
+	if attributes := len(s.MessageAttributes) + len(s.MessageAttributesKeys); attributes > 0 {
+		if attributes > sqsMaxMessageAttributes {
+			return nil, errors.Errorf("at most %d message attributes are allowed for the sqs output, got %d", sqsMaxMessageAttributes, attributes)
+		}
+		// fluent-plugin-sqs sends the messages without attributes
+		pluginType = "sqs_attributes"
+	}
+	sqs := &types.OutputPlugin{
+		PluginMeta: types.PluginMeta{
+			Type:      pluginType,
+			Directive: "match",
+			Tag:       "**",
+			Id:        id,
+		},
+	}
+	if params, err := types.NewStructToStringMapper(secretLoader).StringsMap(s); err != nil {
+		return nil, err
+	} else {
+		sqs.Params = params
+	}
+	if s.Buffer == nil {
+		s.Buffer = &Buffer{}
+	}
+	if buffer, err := s.Buffer.ToDirective(secretLoader, id); err != nil {
+		return nil, err
+	} else {
+		sqs.SubDirectives = append(sqs.SubDirectives, buffer)
+	}
+	return sqs, nil
+}
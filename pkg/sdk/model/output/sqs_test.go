@@ -0,0 +1,114 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package output_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/output"
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/render"
+	"github.com/banzaicloud/operator-tools/pkg/secret"
+	"github.com/ghodss/yaml"
+)
+
+func TestSQS(t *testing.T) {
+	CONFIG := []byte(`
+sqs_url: https://sqs.eu-west-1.amazonaws.com/123456789012/audit-events.fifo
+region: eu-west-1
+create_queue: false
+message_group_id: audit
+aws_key_id:
+  value: AKIAEXAMPLE
+aws_sec_key:
+  value: secret
+buffer:
+  flush_interval: 10s
+`)
+	expected := `
+  <match **>
+    @type sqs
+    @id test
+    aws_key_id AKIAEXAMPLE
+    aws_sec_key secret
+    create_queue false
+    include_tag true
+    message_group_id audit
+    region eu-west-1
+    sqs_url https://sqs.eu-west-1.amazonaws.com/123456789012/audit-events.fifo
+    <buffer tag,time>
+      @type file
+      chunk_limit_size 8MB
+      flush_interval 10s
+      path /buffers/test.*.buffer
+      retry_forever true
+      timekey 10m
+      timekey_wait 10m
+    </buffer>
+  </match>
+`
+	sqs := &output.SQSOutputConfig{}
+	yaml.Unmarshal(CONFIG, sqs)
+	test := render.NewOutputPluginTest(t, sqs)
+	test.DiffResult(expected)
+}
+
+func TestSQSMessageAttributes(t *testing.T) {
+	CONFIG := []byte(`
+queue_name: audit-events
+region: eu-west-1
+message_attributes:
+  cluster: production
+message_attributes_keys:
+  namespace: $.kubernetes.namespace_name
+  level: level
+`)
+	expected := `
+  <match **>
+    @type sqs_attributes
+    @id test
+    create_queue true
+    include_tag true
+    message_attributes {"cluster":"production"}
+    message_attributes_keys {"level":"level","namespace":"$.kubernetes.namespace_name"}
+    queue_name audit-events
+    region eu-west-1
+    <buffer tag,time>
+      @type file
+      chunk_limit_size 8MB
+      path /buffers/test.*.buffer
+      retry_forever true
+      timekey 10m
+      timekey_wait 10m
+    </buffer>
+  </match>
+`
+	sqs := &output.SQSOutputConfig{}
+	yaml.Unmarshal(CONFIG, sqs)
+	test := render.NewOutputPluginTest(t, sqs)
+	test.DiffResult(expected)
+}
+
+func TestSQSMessageAttributesLimit(t *testing.T) {
+	sqs := &output.SQSOutputConfig{QueueName: "audit-events", MessageAttributesKeys: map[string]string{}}
+	for i := 0; i < 11; i++ {
+		sqs.MessageAttributesKeys[fmt.Sprintf("attribute%d", i)] = fmt.Sprintf("key%d", i)
+	}
+	_, err := sqs.ToDirective(secret.NewSecretLoader(nil, "", "", nil), "test")
+	expected := "at most 10 message attributes are allowed for the sqs output, got 11"
+	if err == nil || err.Error() != expected {
+		t.Fatalf("expected error %q, got %v", expected, err)
+	}
+}
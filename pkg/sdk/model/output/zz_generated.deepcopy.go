@@ -53,6 +53,48 @@ func (in *AwsElasticsearchOutputConfig) DeepCopy() *AwsElasticsearchOutputConfig
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureLogAnalyticsOutput) DeepCopyInto(out *AzureLogAnalyticsOutput) {
+	*out = *in
+	if in.CustomerID != nil {
+		in, out := &in.CustomerID, &out.CustomerID
+		*out = new(secret.Secret)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SharedKey != nil {
+		in, out := &in.SharedKey, &out.SharedKey
+		*out = new(secret.Secret)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AddTimeField != nil {
+		in, out := &in.AddTimeField, &out.AddTimeField
+		*out = new(bool)
+		**out = **in
+	}
+	if in.KubernetesFieldMapping != nil {
+		in, out := &in.KubernetesFieldMapping, &out.KubernetesFieldMapping
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Buffer != nil {
+		in, out := &in.Buffer, &out.Buffer
+		*out = new(Buffer)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureLogAnalyticsOutput.
+func (in *AzureLogAnalyticsOutput) DeepCopy() *AzureLogAnalyticsOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureLogAnalyticsOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AzureStorage) DeepCopyInto(out *AzureStorage) {
 	*out = *in
@@ -261,6 +303,11 @@ func (in *ElasticsearchOutput) DeepCopyInto(out *ElasticsearchOutput) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.DataStreamTemplateUseIndexPatterns != nil {
+		in, out := &in.DataStreamTemplateUseIndexPatterns, &out.DataStreamTemplateUseIndexPatterns
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticsearchOutput.
@@ -562,6 +609,62 @@ func (in *GELFOutputConfig) DeepCopy() *GELFOutputConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudLoggingOutput) DeepCopyInto(out *GoogleCloudLoggingOutput) {
+	*out = *in
+	if in.Credentials != nil {
+		in, out := &in.Credentials, &out.Credentials
+		*out = new(secret.Secret)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UseMetadataService != nil {
+		in, out := &in.UseMetadataService, &out.UseMetadataService
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PartialSuccess != nil {
+		in, out := &in.PartialSuccess, &out.PartialSuccess
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.LabelMap != nil {
+		in, out := &in.LabelMap, &out.LabelMap
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SeverityMap != nil {
+		in, out := &in.SeverityMap, &out.SeverityMap
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Buffer != nil {
+		in, out := &in.Buffer, &out.Buffer
+		*out = new(Buffer)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudLoggingOutput.
+func (in *GoogleCloudLoggingOutput) DeepCopy() *GoogleCloudLoggingOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudLoggingOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPAuth) DeepCopyInto(out *HTTPAuth) {
 	*out = *in
@@ -716,6 +819,11 @@ func (in *KafkaOutputConfig) DeepCopyInto(out *KafkaOutputConfig) {
 		*out = new(Format)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SchemaRegistry != nil {
+		in, out := &in.SchemaRegistry, &out.SchemaRegistry
+		*out = new(SchemaRegistry)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Buffer != nil {
 		in, out := &in.Buffer, &out.Buffer
 		*out = new(Buffer)
@@ -943,6 +1051,41 @@ func (in *LogZOutput) DeepCopy() *LogZOutput {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LokiAutoLabels) DeepCopyInto(out *LokiAutoLabels) {
+	*out = *in
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Allow != nil {
+		in, out := &in.Allow, &out.Allow
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Deny != nil {
+		in, out := &in.Deny, &out.Deny
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowHighCardinality != nil {
+		in, out := &in.AllowHighCardinality, &out.AllowHighCardinality
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LokiAutoLabels.
+func (in *LokiAutoLabels) DeepCopy() *LokiAutoLabels {
+	if in == nil {
+		return nil
+	}
+	out := new(LokiAutoLabels)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LokiOutput) DeepCopyInto(out *LokiOutput) {
 	*out = *in
@@ -1015,6 +1158,11 @@ func (in *LokiOutput) DeepCopyInto(out *LokiOutput) {
 		*out = new(Buffer)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AutoLabels != nil {
+		in, out := &in.AutoLabels, &out.AutoLabels
+		*out = new(LokiAutoLabels)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LokiOutput.
@@ -1102,6 +1250,235 @@ func (in *OSSOutput) DeepCopy() *OSSOutput {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OTLPHeader) DeepCopyInto(out *OTLPHeader) {
+	*out = *in
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(secret.Secret)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OTLPHeader.
+func (in *OTLPHeader) DeepCopy() *OTLPHeader {
+	if in == nil {
+		return nil
+	}
+	out := new(OTLPHeader)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OTLPOutput) DeepCopyInto(out *OTLPOutput) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]OTLPHeader, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(OTLPTLS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceAttributes != nil {
+		in, out := &in.ResourceAttributes, &out.ResourceAttributes
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Buffer != nil {
+		in, out := &in.Buffer, &out.Buffer
+		*out = new(Buffer)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OTLPOutput.
+func (in *OTLPOutput) DeepCopy() *OTLPOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(OTLPOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OTLPTLS) DeepCopyInto(out *OTLPTLS) {
+	*out = *in
+	if in.CACertPath != nil {
+		in, out := &in.CACertPath, &out.CACertPath
+		*out = new(secret.Secret)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CertPath != nil {
+		in, out := &in.CertPath, &out.CertPath
+		*out = new(secret.Secret)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrivateKeyPath != nil {
+		in, out := &in.PrivateKeyPath, &out.PrivateKeyPath
+		*out = new(secret.Secret)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OTLPTLS.
+func (in *OTLPTLS) DeepCopy() *OTLPTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(OTLPTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchEndpointCredentials) DeepCopyInto(out *OpenSearchEndpointCredentials) {
+	*out = *in
+	if in.AccessKeyId != nil {
+		in, out := &in.AccessKeyId, &out.AccessKeyId
+		*out = new(secret.Secret)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretAccessKey != nil {
+		in, out := &in.SecretAccessKey, &out.SecretAccessKey
+		*out = new(secret.Secret)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AssumeRoleArn != nil {
+		in, out := &in.AssumeRoleArn, &out.AssumeRoleArn
+		*out = new(secret.Secret)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EcsContainerCredentialsRelativeUri != nil {
+		in, out := &in.EcsContainerCredentialsRelativeUri, &out.EcsContainerCredentialsRelativeUri
+		*out = new(secret.Secret)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AssumeRoleSessionName != nil {
+		in, out := &in.AssumeRoleSessionName, &out.AssumeRoleSessionName
+		*out = new(secret.Secret)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AssumeRoleWebIdentityTokenFile != nil {
+		in, out := &in.AssumeRoleWebIdentityTokenFile, &out.AssumeRoleWebIdentityTokenFile
+		*out = new(secret.Secret)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StsCredentialsRegion != nil {
+		in, out := &in.StsCredentialsRegion, &out.StsCredentialsRegion
+		*out = new(secret.Secret)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchEndpointCredentials.
+func (in *OpenSearchEndpointCredentials) DeepCopy() *OpenSearchEndpointCredentials {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchEndpointCredentials)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchOutput) DeepCopyInto(out *OpenSearchOutput) {
+	*out = *in
+	if in.Password != nil {
+		in, out := &in.Password, &out.Password
+		*out = new(secret.Secret)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SslVerify != nil {
+		in, out := &in.SslVerify, &out.SslVerify
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SSLCACert != nil {
+		in, out := &in.SSLCACert, &out.SSLCACert
+		*out = new(secret.Secret)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SSLClientCert != nil {
+		in, out := &in.SSLClientCert, &out.SSLClientCert
+		*out = new(secret.Secret)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SSLClientCertKey != nil {
+		in, out := &in.SSLClientCertKey, &out.SSLClientCertKey
+		*out = new(secret.Secret)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SSLClientCertKeyPass != nil {
+		in, out := &in.SSLClientCertKeyPass, &out.SSLClientCertKeyPass
+		*out = new(secret.Secret)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UtcIndex != nil {
+		in, out := &in.UtcIndex, &out.UtcIndex
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TemplateFile != nil {
+		in, out := &in.TemplateFile, &out.TemplateFile
+		*out = new(secret.Secret)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FailOnPuttingTemplateRetryExceed != nil {
+		in, out := &in.FailOnPuttingTemplateRetryExceed, &out.FailOnPuttingTemplateRetryExceed
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ReloadConnections != nil {
+		in, out := &in.ReloadConnections, &out.ReloadConnections
+		*out = new(bool)
+		**out = **in
+	}
+	if in.VerifyOsVersionAtStartup != nil {
+		in, out := &in.VerifyOsVersionAtStartup, &out.VerifyOsVersionAtStartup
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ExceptionBackup != nil {
+		in, out := &in.ExceptionBackup, &out.ExceptionBackup
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DataStreamEnable != nil {
+		in, out := &in.DataStreamEnable, &out.DataStreamEnable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(OpenSearchEndpointCredentials)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Buffer != nil {
+		in, out := &in.Buffer, &out.Buffer
+		*out = new(Buffer)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchOutput.
+func (in *OpenSearchOutput) DeepCopy() *OpenSearchOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RedisOutputConfig) DeepCopyInto(out *RedisOutputConfig) {
 	*out = *in
@@ -1200,6 +1577,16 @@ func (in *S3OutputConfig) DeepCopyInto(out *S3OutputConfig) {
 		*out = new(S3SharedCredentials)
 		**out = **in
 	}
+	if in.Parquet != nil {
+		in, out := &in.Parquet, &out.Parquet
+		*out = new(S3Parquet)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Partitions != nil {
+		in, out := &in.Partitions, &out.Partitions
+		*out = new(S3Partitions)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3OutputConfig.
@@ -1212,6 +1599,86 @@ func (in *S3OutputConfig) DeepCopy() *S3OutputConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *S3Parquet) DeepCopyInto(out *S3Parquet) {
+	*out = *in
+	if in.SchemaFile != nil {
+		in, out := &in.SchemaFile, &out.SchemaFile
+		*out = new(secret.Secret)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(S3ParquetSchema)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3Parquet.
+func (in *S3Parquet) DeepCopy() *S3Parquet {
+	if in == nil {
+		return nil
+	}
+	out := new(S3Parquet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *S3ParquetField) DeepCopyInto(out *S3ParquetField) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3ParquetField.
+func (in *S3ParquetField) DeepCopy() *S3ParquetField {
+	if in == nil {
+		return nil
+	}
+	out := new(S3ParquetField)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *S3ParquetSchema) DeepCopyInto(out *S3ParquetSchema) {
+	*out = *in
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]S3ParquetField, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3ParquetSchema.
+func (in *S3ParquetSchema) DeepCopy() *S3ParquetSchema {
+	if in == nil {
+		return nil
+	}
+	out := new(S3ParquetSchema)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *S3Partitions) DeepCopyInto(out *S3Partitions) {
+	*out = *in
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3Partitions.
+func (in *S3Partitions) DeepCopy() *S3Partitions {
+	if in == nil {
+		return nil
+	}
+	out := new(S3Partitions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *S3SharedCredentials) DeepCopyInto(out *S3SharedCredentials) {
 	*out = *in
@@ -1227,6 +1694,149 @@ func (in *S3SharedCredentials) DeepCopy() *S3SharedCredentials {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SNSOutputConfig) DeepCopyInto(out *SNSOutputConfig) {
+	*out = *in
+	if in.AWSKeyId != nil {
+		in, out := &in.AWSKeyId, &out.AWSKeyId
+		*out = new(secret.Secret)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AWSSecKey != nil {
+		in, out := &in.AWSSecKey, &out.AWSSecKey
+		*out = new(secret.Secret)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SNSMessageAttributes != nil {
+		in, out := &in.SNSMessageAttributes, &out.SNSMessageAttributes
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SNSMessageAttributesKeys != nil {
+		in, out := &in.SNSMessageAttributesKeys, &out.SNSMessageAttributesKeys
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Buffer != nil {
+		in, out := &in.Buffer, &out.Buffer
+		*out = new(Buffer)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SNSOutputConfig.
+func (in *SNSOutputConfig) DeepCopy() *SNSOutputConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SNSOutputConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SQSOutputConfig) DeepCopyInto(out *SQSOutputConfig) {
+	*out = *in
+	if in.AWSKeyId != nil {
+		in, out := &in.AWSKeyId, &out.AWSKeyId
+		*out = new(secret.Secret)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AWSSecKey != nil {
+		in, out := &in.AWSSecKey, &out.AWSSecKey
+		*out = new(secret.Secret)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CreateQueue != nil {
+		in, out := &in.CreateQueue, &out.CreateQueue
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IncludeTag != nil {
+		in, out := &in.IncludeTag, &out.IncludeTag
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MessageAttributes != nil {
+		in, out := &in.MessageAttributes, &out.MessageAttributes
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.MessageAttributesKeys != nil {
+		in, out := &in.MessageAttributesKeys, &out.MessageAttributesKeys
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Buffer != nil {
+		in, out := &in.Buffer, &out.Buffer
+		*out = new(Buffer)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SQSOutputConfig.
+func (in *SQSOutputConfig) DeepCopy() *SQSOutputConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SQSOutputConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchemaRegistry) DeepCopyInto(out *SchemaRegistry) {
+	*out = *in
+	if in.Username != nil {
+		in, out := &in.Username, &out.Username
+		*out = new(secret.Secret)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Password != nil {
+		in, out := &in.Password, &out.Password
+		*out = new(secret.Secret)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CAFile != nil {
+		in, out := &in.CAFile, &out.CAFile
+		*out = new(secret.Secret)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CertFile != nil {
+		in, out := &in.CertFile, &out.CertFile
+		*out = new(secret.Secret)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KeyFile != nil {
+		in, out := &in.KeyFile, &out.KeyFile
+		*out = new(secret.Secret)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DescriptorSetFile != nil {
+		in, out := &in.DescriptorSetFile, &out.DescriptorSetFile
+		*out = new(secret.Secret)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchemaRegistry.
+func (in *SchemaRegistry) DeepCopy() *SchemaRegistry {
+	if in == nil {
+		return nil
+	}
+	out := new(SchemaRegistry)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SplunkHecOutput) DeepCopyInto(out *SplunkHecOutput) {
 	*out = *in
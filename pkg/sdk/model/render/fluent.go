@@ -37,6 +37,12 @@ func (f *FluentRender) Render(config types.FluentConfig) error {
 
 func (f *FluentRender) RenderDirectives(directives []types.Directive, indent int) error {
 	for _, d := range directives {
+		if chain, ok := d.(*types.FilterChain); ok {
+			if err := f.RenderDirectives(chain.GetSections(), indent); err != nil {
+				return err
+			}
+			continue
+		}
 		meta := d.GetPluginMeta()
 		if meta.Directive == "" {
 			return fmt.Errorf("Directive must have a name %s", meta)
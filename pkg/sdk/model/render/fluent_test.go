@@ -383,6 +383,511 @@ func TestMultipleOutput(t *testing.T) {
 	}
 }
 
+func TestSecondaryOutput(t *testing.T) {
+	system := types.NewSystemBuilder(toDirective(t, input.NewTailInputConfig("input.log")), nil, types.NewRouter("test", nil))
+
+	flowObj, err := types.NewFlow(
+		[]types.FlowMatch{
+			{Labels: map[string]string{
+				"key1": "val1",
+				"key2": "val2"},
+				Namespaces: []string{"ns-test"}},
+		}, "", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	primary := toDirective(t, &output.FileOutputConfig{Path: "/tmp/primary"})
+	secondary, err := (&output.FileOutputConfig{Path: "/tmp/secondary"}).ToDirective(secret.NewSecretLoader(nil, "", "", nil), "test:secondary")
+	if err != nil {
+		t.Fatal(err)
+	}
+	outputWithSecondary, err := types.NewSecondaryDirective(primary, secondary)
+	if err != nil {
+		t.Fatal(err)
+	}
+	flowObj.WithOutputs(outputWithSecondary)
+
+	err = system.RegisterFlow(flowObj)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fluentConfig, err := system.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := &bytes.Buffer{}
+	renderer := render.FluentRender{
+		Out:    b,
+		Indent: 2,
+	}
+	err = renderer.Render(fluentConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `
+		<source>
+          @type tail
+          @id test
+          path input.log
+        </source>
+        <match **>
+          @type label_router
+          @id test
+          <route>
+            @label @901f778f9602a78e8fd702c1973d8d8d
+            <match>
+              labels key1:val1,key2:val2
+              namespaces ns-test
+              negate false
+            </match>
+          </route>
+        </match>
+        <label @901f778f9602a78e8fd702c1973d8d8d>
+          <match **>
+            @type file
+            @id test
+            add_path_suffix true
+            path /tmp/primary
+            <buffer tag,time>
+              @type file
+              chunk_limit_size 8MB
+              path /buffers/test.*.buffer
+              retry_forever true
+              timekey 10m
+              timekey_wait 10m
+            </buffer>
+            <secondary>
+              @type file
+              @id test:secondary
+              add_path_suffix true
+              path /tmp/secondary
+            </secondary>
+          </match>
+        </label>`
+
+	if a, e := diff.TrimLinesInString(b.String()), diff.TrimLinesInString(expected); a != e {
+		t.Errorf("Result does not match (-actual vs +expected):\n%v\nActual: %s", diff.LineDiff(a, e), b.String())
+	}
+}
+
+func TestSecondaryOutputRequiresBuffer(t *testing.T) {
+	primary := toDirective(t, output.NewNullOutputConfig())
+	secondary := toDirective(t, &output.FileOutputConfig{Path: "/tmp/secondary"})
+	if _, err := types.NewSecondaryDirective(primary, secondary); err == nil {
+		t.Error("expected error for unbuffered primary output")
+	}
+}
+
+func TestSecondaryOutputPlaceholders(t *testing.T) {
+	tests := map[string]struct {
+		primary   *output.FileOutputConfig
+		secondary string
+		err       string
+	}{
+		"tag and time": {
+			primary:   &output.FileOutputConfig{Path: "/tmp/primary"},
+			secondary: "/tmp/secondary/${tag}/%Y%m%d",
+		},
+		"tag part": {
+			primary:   &output.FileOutputConfig{Path: "/tmp/primary"},
+			secondary: "/tmp/secondary/${tag[1]}",
+		},
+		"record key": {
+			primary:   &output.FileOutputConfig{Path: "/tmp/primary", Buffer: &output.Buffer{Tags: "tag,time,namespace"}},
+			secondary: "/tmp/secondary/${namespace}",
+		},
+		"missing record key": {
+			primary:   &output.FileOutputConfig{Path: "/tmp/primary"},
+			secondary: "/tmp/secondary/${namespace}",
+			err:       `secondary output refers to chunk key namespace in path, the buffer of the primary output has the chunk keys "tag,time"`,
+		},
+		"missing time": {
+			primary:   &output.FileOutputConfig{Path: "/tmp/primary", Buffer: &output.Buffer{Tags: "tag"}},
+			secondary: "/tmp/secondary/%Y",
+			err:       `secondary output refers to the time in path, the buffer of the primary output has the chunk keys "tag"`,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			primary := toDirective(t, test.primary)
+			secondary, err := (&output.FileOutputConfig{Path: test.secondary}).ToDirective(secret.NewSecretLoader(nil, "", "", nil), "test:secondary")
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = types.NewSecondaryDirective(primary, secondary)
+			if test.err == "" && err != nil {
+				t.Errorf("unexpected error %v", err)
+			}
+			if test.err != "" && (err == nil || err.Error() != test.err) {
+				t.Errorf("expected error %q, got %v", test.err, err)
+			}
+		})
+	}
+}
+
+func TestFilteredOutput(t *testing.T) {
+	system := types.NewSystemBuilder(toDirective(t, input.NewTailInputConfig("input.log")), nil, types.NewRouter("test", nil))
+
+	flowObj, err := types.NewFlow(
+		[]types.FlowMatch{
+			{Labels: map[string]string{
+				"key1": "val1",
+				"key2": "val2"},
+				Namespaces: []string{"ns-test"}},
+		}, "", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	filteredOutput := types.NewFilteredOutput("filtered",
+		[]types.Filter{toDirective(t, filter.NewStdOutFilterConfig())},
+		toDirective(t, output.NewNullOutputConfig()))
+	flowObj.
+		WithOutputs(toDirective(t, output.NewNullOutputConfig())).
+		WithOutputs(filteredOutput)
+
+	err = system.RegisterFlow(flowObj)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fluentConfig, err := system.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := &bytes.Buffer{}
+	renderer := render.FluentRender{
+		Out:    b,
+		Indent: 2,
+	}
+	err = renderer.Render(fluentConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `
+		<source>
+          @type tail
+          @id test
+          path input.log
+        </source>
+        <match **>
+          @type label_router
+          @id test
+          <route>
+            @label @901f778f9602a78e8fd702c1973d8d8d
+            <match>
+              labels key1:val1,key2:val2
+              namespaces ns-test
+              negate false
+            </match>
+          </route>
+        </match>
+        <label @901f778f9602a78e8fd702c1973d8d8d>
+          <match **>
+            @type copy
+            <store>
+              @type null
+              @id test
+            </store>
+            <store>
+              @type relabel
+              @label @dce4a640a8b606a2290c35b8d8d1e9d6
+            </store>
+          </match>
+        </label>
+        <label @dce4a640a8b606a2290c35b8d8d1e9d6>
+          <filter **>
+            @type stdout
+            @id test
+          </filter>
+          <match **>
+            @type null
+            @id test
+          </match>
+        </label>`
+
+	if a, e := diff.TrimLinesInString(b.String()), diff.TrimLinesInString(expected); a != e {
+		t.Errorf("Result does not match (-actual vs +expected):\n%v\nActual: %s", diff.LineDiff(a, e), b.String())
+	}
+}
+
+func TestNestedFilteredOutput(t *testing.T) {
+	system := types.NewSystemBuilder(toDirective(t, input.NewTailInputConfig("input.log")), nil, types.NewRouter("test", nil))
+
+	flowObj, err := types.NewFlow(
+		[]types.FlowMatch{
+			{Namespaces: []string{"ns-test"}},
+		}, "", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	innerOutput := types.NewFilteredOutput("inner",
+		[]types.Filter{toDirective(t, filter.NewStdOutFilterConfig())},
+		toDirective(t, output.NewNullOutputConfig()))
+	flowObj.WithOutputs(types.NewFilteredOutput("outer",
+		[]types.Filter{toDirective(t, filter.NewStdOutFilterConfig())},
+		innerOutput))
+
+	err = system.RegisterFlow(flowObj)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fluentConfig, err := system.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := &bytes.Buffer{}
+	renderer := render.FluentRender{
+		Out:    b,
+		Indent: 2,
+	}
+	err = renderer.Render(fluentConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `
+		<source>
+          @type tail
+          @id test
+          path input.log
+        </source>
+        <match **>
+          @type label_router
+          @id test
+          <route>
+            @label @a42fd8d29c181fcf9887280c4a51bd1e
+            <match>
+              namespaces ns-test
+              negate false
+            </match>
+          </route>
+        </match>
+        <label @a42fd8d29c181fcf9887280c4a51bd1e>
+          <match **>
+            @type relabel
+            @label @7cfcbd1c1575dd7eae454f18b9267188
+          </match>
+        </label>
+        <label @7cfcbd1c1575dd7eae454f18b9267188>
+          <filter **>
+            @type stdout
+            @id test
+          </filter>
+          <match **>
+            @type relabel
+            @label @ea97586b4aa0c141e4456912f3325f7f
+          </match>
+        </label>
+        <label @ea97586b4aa0c141e4456912f3325f7f>
+          <filter **>
+            @type stdout
+            @id test
+          </filter>
+          <match **>
+            @type null
+            @id test
+          </match>
+        </label>`
+
+	if a, e := diff.TrimLinesInString(b.String()), diff.TrimLinesInString(expected); a != e {
+		t.Errorf("Result does not match (-actual vs +expected):\n%v\nActual: %s", diff.LineDiff(a, e), b.String())
+	}
+}
+
+func TestAdditionalInputs(t *testing.T) {
+	system := types.NewSystemBuilder(toDirective(t, input.NewTailInputConfig("input.log")), nil, types.NewRouter("test", nil))
+
+	if err := system.RegisterInput("appliances", toDirective(t, &input.SyslogInputConfig{Transport: input.SyslogTransportTCP, MessageFormat: "rfc5424"})); err != nil {
+		t.Fatal(err)
+	}
+	if err := system.RegisterInput("webhooks", toDirective(t, &input.HTTPInputConfig{})); err != nil {
+		t.Fatal(err)
+	}
+
+	flowObj, err := types.NewFlow(nil, "", "appliances", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	flowObj.WithOutputs(toDirective(t, output.NewNullOutputConfig()))
+
+	err = system.RegisterInputFlow(flowObj, []string{"appliances"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fluentConfig, err := system.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := &bytes.Buffer{}
+	renderer := render.FluentRender{
+		Out:    b,
+		Indent: 2,
+	}
+	err = renderer.Render(fluentConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `
+		<source>
+          @type tail
+          @id test
+          path input.log
+        </source>
+        <source>
+          @type syslog
+          @id test_syslog
+          @label @98152497039843dd23d5c42aab3b1bc2
+          bind 0.0.0.0
+          port 5140
+          tag test
+          <transport tcp>
+          </transport>
+          <parse>
+            @type syslog
+            message_format rfc5424
+          </parse>
+        </source>
+        <source>
+          @type http
+          @id test_http
+          @label @381725590a77709b01a825319a9ebda8
+          bind 0.0.0.0
+          port 9880
+        </source>
+        <match **>
+          @type label_router
+          @id test
+        </match>
+        <label @fea3a660827437aacee0391d5419bb39>
+          <match **>
+            @type null
+            @id test
+          </match>
+        </label>
+        <label @98152497039843dd23d5c42aab3b1bc2>
+          <match **>
+            @type relabel
+            @label @fea3a660827437aacee0391d5419bb39
+          </match>
+        </label>
+        <label @381725590a77709b01a825319a9ebda8>
+          <match **>
+            @type null
+          </match>
+        </label>`
+
+	if a, e := diff.TrimLinesInString(b.String()), diff.TrimLinesInString(expected); a != e {
+		t.Errorf("Result does not match (-actual vs +expected):\n%v\nActual: %s", diff.LineDiff(a, e), b.String())
+	}
+}
+
+func TestAdditionalInputNotFound(t *testing.T) {
+	system := types.NewSystemBuilder(toDirective(t, input.NewTailInputConfig("input.log")), nil, types.NewRouter("test", nil))
+
+	flowObj, err := types.NewFlow(nil, "", "appliances", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	flowObj.WithOutputs(toDirective(t, output.NewNullOutputConfig()))
+
+	err = system.RegisterInputFlow(flowObj, []string{"appliances"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = system.Build()
+	if err == nil || err.Error() != "referenced input not found: appliances" {
+		t.Errorf("expected missing input error, got %v", err)
+	}
+}
+
+func TestSyslogInputTLS(t *testing.T) {
+	system := types.NewSystemBuilder(toDirective(t, input.NewTailInputConfig("input.log")), nil, types.NewRouter("test", nil))
+
+	syslog := &input.SyslogInputConfig{
+		Port:      6514,
+		Transport: input.SyslogTransportTLS,
+		TLS: &input.SyslogInputTLS{
+			CertPath:       "/fluentd/syslog-tls/firewalls/tls.crt",
+			PrivateKeyPath: "/fluentd/syslog-tls/firewalls/tls.key",
+			CAPath:         "/fluentd/syslog-tls/firewalls/ca.crt",
+			ClientCertAuth: true,
+		},
+		Parse: &filter.ParseSection{
+			Type:       "regexp",
+			Expression: "/^(?<host>[^ ]*) (?<message>.*)$/",
+		},
+		SourceAddressKey: "source_address",
+	}
+	if err := system.RegisterInput("firewalls", toDirective(t, syslog)); err != nil {
+		t.Fatal(err)
+	}
+
+	fluentConfig, err := system.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := &bytes.Buffer{}
+	renderer := render.FluentRender{
+		Out:    b,
+		Indent: 2,
+	}
+	err = renderer.Render(fluentConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `
+		<source>
+          @type tail
+          @id test
+          path input.log
+        </source>
+        <source>
+          @type syslog
+          @id test_syslog
+          @label @d06317209d204701ccf784588f553b59
+          bind 0.0.0.0
+          port 6514
+          source_address_key source_address
+          tag test
+          <transport tls>
+            ca_path /fluentd/syslog-tls/firewalls/ca.crt
+            cert_path /fluentd/syslog-tls/firewalls/tls.crt
+            client_cert_auth true
+            private_key_path /fluentd/syslog-tls/firewalls/tls.key
+          </transport>
+          <parse>
+            @type regexp
+            expression /^(?<host>[^ ]*) (?<message>.*)$/
+          </parse>
+        </source>
+        <match **>
+          @type label_router
+          @id test
+        </match>
+        <label @d06317209d204701ccf784588f553b59>
+          <match **>
+            @type null
+          </match>
+        </label>`
+
+	if a, e := diff.TrimLinesInString(b.String()), diff.TrimLinesInString(expected); a != e {
+		t.Errorf("Result does not match (-actual vs +expected):\n%v\nActual: %s", diff.LineDiff(a, e), b.String())
+	}
+}
+
 func TestRenderFullFluentConfig(t *testing.T) {
 	system := types.NewSystemBuilder(toDirective(t, input.NewTailInputConfig("input.log")), nil, types.NewRouter("test", nil))
 
@@ -23,6 +23,13 @@ type SystemBuilder struct {
 	globalFilters []Filter
 	flows         []*Flow
 	router        *Router
+	inputs        []namedInput
+	inputFlows    map[string][]*Flow
+}
+
+type namedInput struct {
+	name  string
+	input Input
 }
 
 func NewSystemBuilder(input Input, globalFilers []Filter, router *Router) *SystemBuilder {
@@ -40,6 +47,7 @@ func (s *SystemBuilder) RegisterFlow(f *Flow) error {
 		}
 	}
 	s.flows = append(s.flows, f)
+	s.registerFilteredOutputs(f)
 	s.router.AddRoute(f)
 	return nil
 }
@@ -51,15 +59,82 @@ func (s *SystemBuilder) RegisterDefaultFlow(f *Flow) error {
 		}
 	}
 	s.flows = append(s.flows, f)
+	s.registerFilteredOutputs(f)
 	s.router.Params["default_route"] = f.FlowLabel
 	return nil
 }
 
+func (s *SystemBuilder) RegisterErrorFlow(f *Flow) error {
+	for _, e := range s.flows {
+		if e.FlowLabel == f.FlowLabel {
+			return errors.New("Error flow already exists")
+		}
+	}
+	s.flows = append(s.flows, f)
+	s.registerFilteredOutputs(f)
+	return nil
+}
+
+func (s *SystemBuilder) registerFilteredOutputs(f *Flow) {
+	for _, o := range f.Outputs {
+		if filtered, ok := o.(*FilteredOutput); ok {
+			s.flows = append(s.flows, filtered.Flow)
+			// the output of a filtered output can be a filtered output itself, e.g. an output converting the records
+			s.registerFilteredOutputs(filtered.Flow)
+		}
+	}
+}
+
+// RegisterInput adds an additional input, its records are sent to the flows registered for the input by name
+// instead of the router, so the global filters are not applied to them.
+func (s *SystemBuilder) RegisterInput(name string, input Input) error {
+	for _, e := range s.inputs {
+		if e.name == name {
+			return errors.Errorf("Input %s already exists", name)
+		}
+	}
+	input.GetPluginMeta().Label = InputLabel(name)
+	s.inputs = append(s.inputs, namedInput{name: name, input: input})
+	return nil
+}
+
+// RegisterInputFlow adds a flow receiving the records of the named additional inputs instead of the records of the router
+func (s *SystemBuilder) RegisterInputFlow(f *Flow, inputs []string) error {
+	for _, e := range s.flows {
+		if e.FlowLabel == f.FlowLabel {
+			return errors.New("Flow already exists")
+		}
+	}
+	if s.inputFlows == nil {
+		s.inputFlows = make(map[string][]*Flow)
+	}
+	for _, input := range inputs {
+		s.inputFlows[input] = append(s.inputFlows[input], f)
+	}
+	s.flows = append(s.flows, f)
+	s.registerFilteredOutputs(f)
+	return nil
+}
+
 func (s *SystemBuilder) Build() (*System, error) {
+	flows := s.flows
+	var inputs []Input
+	registered := make(map[string]bool)
+	for _, e := range s.inputs {
+		registered[e.name] = true
+		inputs = append(inputs, e.input)
+		flows = append(flows, newInputFlow(e.name, s.inputFlows[e.name]))
+	}
+	for name := range s.inputFlows {
+		if !registered[name] {
+			return nil, errors.Errorf("referenced input not found: %s", name)
+		}
+	}
 	return &System{
 		Input:         s.input,
+		Inputs:        inputs,
 		GlobalFilters: s.globalFilters,
 		Router:        s.router,
-		Flows:         s.flows,
+		Flows:         flows,
 	}, nil
 }
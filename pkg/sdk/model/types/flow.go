@@ -28,7 +28,9 @@ type FluentConfig interface {
 }
 
 type System struct {
-	Input         Input    `json:"input"`
+	Input Input `json:"input"`
+	// Additional inputs emitting their records into their own labels
+	Inputs        []Input  `json:"inputs,omitempty"`
 	GlobalFilters []Filter `json:"globalFilters"`
 	Router        *Router  `json:"router"`
 	Flows         []*Flow  `json:"flows"`
@@ -39,6 +41,9 @@ func (s *System) GetDirectives() []Directive {
 	directives := []Directive{
 		s.Input,
 	}
+	for _, input := range s.Inputs {
+		directives = append(directives, input)
+	}
 	// Add GlobalFilters between input and router
 	for _, filter := range s.GlobalFilters {
 		directives = append(directives, filter)
@@ -120,6 +125,94 @@ func NewFlow(matches []FlowMatch, id, name, namespace string) (*Flow, error) {
 	}, nil
 }
 
+// FilteredOutput relabels the records to a dedicated label holding the filters and the output,
+// so that the filters only apply to the records of this output.
+type FilteredOutput struct {
+	GenericDirective
+
+	// Flow rendering the filters and the output
+	Flow *Flow `json:"-"`
+}
+
+func NewFilteredOutput(id string, filters []Filter, output Output) *FilteredOutput {
+	label := fmt.Sprintf("@%x", md5.Sum([]byte(id)))
+	return &FilteredOutput{
+		GenericDirective: GenericDirective{
+			PluginMeta: PluginMeta{
+				Type:      "relabel",
+				Directive: "match",
+				Tag:       "**",
+				Label:     label,
+			},
+		},
+		Flow: &Flow{
+			PluginMeta: PluginMeta{
+				Directive: "label",
+				Tag:       label,
+			},
+			FlowID:    id,
+			Filters:   filters,
+			Outputs:   []Output{output},
+			FlowLabel: label,
+		},
+	}
+}
+
+// InputLabel returns the label receiving the records of the named additional input
+func InputLabel(name string) string {
+	return fmt.Sprintf("@%x", md5.Sum([]byte("input:"+name)))
+}
+
+// newInputFlow relabels the records of an additional input to the flows registered for the input,
+// the records are dropped if there are no such flows.
+func newInputFlow(name string, flows []*Flow) *Flow {
+	label := InputLabel(name)
+	var outputs []Output
+	for _, f := range flows {
+		outputs = append(outputs, &GenericDirective{
+			PluginMeta: PluginMeta{
+				Type:      "relabel",
+				Directive: "match",
+				Tag:       "**",
+				Label:     f.FlowLabel,
+			},
+		})
+	}
+	if len(outputs) == 0 {
+		outputs = append(outputs, &GenericDirective{
+			PluginMeta: PluginMeta{
+				Type:      "null",
+				Directive: "match",
+				Tag:       "**",
+			},
+		})
+	}
+	return &Flow{
+		PluginMeta: PluginMeta{
+			Directive: "label",
+			Tag:       label,
+		},
+		FlowID:    "input:" + name,
+		Outputs:   outputs,
+		FlowLabel: label,
+	}
+}
+
+// ErrorLabel is the builtin fluentd label receiving the error events
+const ErrorLabel = "@ERROR"
+
+// NewErrorFlow creates a flow for the records emitted to the fluentd error label
+func NewErrorFlow(id string) *Flow {
+	return &Flow{
+		PluginMeta: PluginMeta{
+			Directive: "label",
+			Tag:       ErrorLabel,
+		},
+		FlowID:    id,
+		FlowLabel: ErrorLabel,
+	}
+}
+
 func calculateFlowLabel(matches []FlowMatch, name, namespace string) (string, error) {
 	b := md5.New()
 	_, err := io.WriteString(b, name)
@@ -0,0 +1,87 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// RubyString quotes the value as a single quoted Ruby string literal
+func RubyString(value string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(value) + "'"
+}
+
+// RubyStringArray quotes the values as a Ruby array of string literals
+func RubyStringArray(values []string) string {
+	var quoted []string
+	for _, value := range values {
+		quoted = append(quoted, RubyString(value))
+	}
+	return "[" + strings.Join(quoted, ", ") + "]"
+}
+
+// RubyHash quotes the map as a Ruby hash of string literals with sorted keys
+func RubyHash(values map[string]string) string {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var pairs []string
+	for _, k := range keys {
+		pairs = append(pairs, fmt.Sprintf("%s => %s", RubyString(k), RubyString(values[k])))
+	}
+	return "{" + strings.Join(pairs, ", ") + "}"
+}
+
+// RubyDigLambda is a Ruby lambda returning the value at the key path of the record, or nil if any part of the path is missing
+const RubyDigLambda = "lambda { |r, path| path.reduce(r) { |v, k| v.is_a?(Hash) ? v[k] : nil } }"
+
+// NewRecordModifier renders a record_modifier filter with the given parameters setting the fields of the record
+func NewRecordModifier(id string, params Params, record Params) *GenericDirective {
+	return &GenericDirective{
+		PluginMeta: PluginMeta{
+			Type:      "record_modifier",
+			Directive: "filter",
+			Tag:       "**",
+			Id:        id,
+		},
+		Params: params,
+		SubDirectives: []Directive{
+			&GenericDirective{
+				PluginMeta: PluginMeta{
+					Directive: "record",
+				},
+				Params: record,
+			},
+		},
+	}
+}
+
+// NewTempKeyRecordModifier renders a record_modifier filter evaluating the Ruby expression on every record.
+// The expression modifies the record in place, it is evaluated as the value of a temporary key removed afterwards.
+func NewTempKeyRecordModifier(id string, prepare []string, tempKey string, expression string) *GenericDirective {
+	return NewRecordModifier(id,
+		Params{
+			"prepare_value": strings.Join(prepare, "; "),
+			"remove_keys":   tempKey,
+		},
+		Params{
+			tempKey: fmt.Sprintf("${%s; nil}", expression),
+		},
+	)
+}
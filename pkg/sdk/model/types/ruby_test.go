@@ -0,0 +1,36 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/banzaicloud/logging-operator/pkg/sdk/model/types"
+)
+
+func TestRubyLiterals(t *testing.T) {
+	if got, expected := types.RubyString(`it's a \ test`), `'it\'s a \\ test'`; got != expected {
+		t.Fatalf("string literal `%s` does not match expected `%s`", got, expected)
+	}
+	if got, expected := types.RubyStringArray([]string{"a", "b'c"}), `['a', 'b\'c']`; got != expected {
+		t.Fatalf("array literal `%s` does not match expected `%s`", got, expected)
+	}
+	if got, expected := types.RubyStringArray(nil), `[]`; got != expected {
+		t.Fatalf("array literal `%s` does not match expected `%s`", got, expected)
+	}
+	if got, expected := types.RubyHash(map[string]string{"warn": "WARNING", "err": "ERROR"}), `{'err' => 'ERROR', 'warn' => 'WARNING'}`; got != expected {
+		t.Fatalf("hash literal `%s` does not match expected `%s`", got, expected)
+	}
+}
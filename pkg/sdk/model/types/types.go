@@ -16,6 +16,8 @@ package types
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 
 	"emperror.dev/errors"
 	"github.com/banzaicloud/operator-tools/pkg/secret"
@@ -87,6 +89,27 @@ func (d *GenericDirective) GetSections() []Directive {
 	return d.SubDirectives
 }
 
+// FilterChain is a filter implemented by a sequence of filter directives, the directives are rendered in place of the chain
+type FilterChain struct {
+	Filters []Filter `json:"filters"`
+}
+
+func (c *FilterChain) GetPluginMeta() *PluginMeta {
+	return &PluginMeta{}
+}
+
+func (c *FilterChain) GetParams() Params {
+	return nil
+}
+
+func (c *FilterChain) GetSections() []Directive {
+	var sections []Directive
+	for _, filter := range c.Filters {
+		sections = append(sections, filter)
+	}
+	return sections
+}
+
 type PluginParam struct {
 	Description string
 	Default     string
@@ -144,6 +167,69 @@ func NewFlatDirective(meta PluginMeta, config interface{}, secretLoader secret.S
 	return directive, nil
 }
 
+// NewSecondaryDirective renders the secondary output into the primary output as a <secondary> section.
+// The secondary output uses the buffer of the primary one, so its own buffer is omitted.
+func NewSecondaryDirective(primary Output, secondary Output) (Directive, error) {
+	if _, ok := secondary.(*FilteredOutput); ok {
+		return nil, errors.New("secondary output cannot filter the records")
+	}
+	// the secondary belongs to the output of a filtered output instead of the relabel directive
+	if filtered, ok := primary.(*FilteredOutput); ok && len(filtered.Flow.Outputs) == 1 {
+		directive, err := NewSecondaryDirective(filtered.Flow.Outputs[0], secondary)
+		if err != nil {
+			return nil, err
+		}
+		return &FilteredOutput{
+			GenericDirective: filtered.GenericDirective,
+			Flow: &Flow{
+				PluginMeta: filtered.Flow.PluginMeta,
+				FlowID:     filtered.Flow.FlowID,
+				Filters:    filtered.Flow.Filters,
+				Outputs:    []Output{directive},
+				FlowLabel:  filtered.Flow.FlowLabel,
+			},
+		}, nil
+	}
+
+	var buffer Directive
+	for _, s := range primary.GetSections() {
+		if s.GetPluginMeta().Directive == "buffer" {
+			buffer = s
+		}
+	}
+	if buffer == nil {
+		return nil, errors.Errorf("secondary output requires a buffered primary output, %s has no buffer", primary.GetPluginMeta().Type)
+	}
+	if err := validateSecondaryPlaceholders(buffer.GetPluginMeta().Tag, secondary.GetParams()); err != nil {
+		return nil, err
+	}
+
+	secondarySection := &GenericDirective{
+		PluginMeta: PluginMeta{
+			Type:      secondary.GetPluginMeta().Type,
+			Id:        secondary.GetPluginMeta().Id,
+			LogLevel:  secondary.GetPluginMeta().LogLevel,
+			Directive: "secondary",
+		},
+		Params: secondary.GetParams(),
+	}
+	for _, s := range secondary.GetSections() {
+		switch s.GetPluginMeta().Directive {
+		case "buffer", "secondary":
+			continue
+		}
+		secondarySection.SubDirectives = append(secondarySection.SubDirectives, s)
+	}
+
+	directive := &GenericDirective{
+		PluginMeta: *primary.GetPluginMeta(),
+		Params:     primary.GetParams(),
+	}
+	directive.SubDirectives = append(directive.SubDirectives, primary.GetSections()...)
+	directive.SubDirectives = append(directive.SubDirectives, secondarySection)
+	return directive, nil
+}
+
 func NewCopyDirective(directives []Output) Directive {
 	directive := &GenericDirective{
 		PluginMeta: PluginMeta{
@@ -158,6 +244,7 @@ func NewCopyDirective(directives []Output) Directive {
 				Type:      d.GetPluginMeta().Type,
 				Id:        d.GetPluginMeta().Id,
 				LogLevel:  d.GetPluginMeta().LogLevel,
+				Label:     d.GetPluginMeta().Label,
 				Directive: "store",
 			},
 			Params:        d.GetParams(),
@@ -167,3 +254,36 @@ func NewCopyDirective(directives []Output) Directive {
 	}
 	return directive
 }
+
+var (
+	chunkKeyPlaceholder = regexp.MustCompile(`\$\{([^}\[]+)(?:\[-?\d+\])?\}`)
+	timePlaceholder     = regexp.MustCompile(`%[a-zA-Z]`)
+)
+
+// secondaryPlaceholderParams are the parameters of the outputs resolving the placeholders of the buffer chunk keys
+var secondaryPlaceholderParams = []string{"path", "index_name", "logstash_prefix", "s3_object_key_format"}
+
+// validateSecondaryPlaceholders checks that the chunks of the primary output carry the keys the placeholders of the secondary output refer to,
+// the secondary output writes the chunks of the primary one, so it cannot resolve the placeholders of other chunk keys
+func validateSecondaryPlaceholders(chunkKeys string, secondary Params) error {
+	keys := make(map[string]bool)
+	for _, key := range strings.Split(chunkKeys, ",") {
+		keys[strings.TrimSpace(key)] = true
+	}
+	for _, param := range secondaryPlaceholderParams {
+		value := secondary[param]
+		for _, match := range chunkKeyPlaceholder.FindAllStringSubmatch(value, -1) {
+			key := match[1]
+			if key == "tag_parts" {
+				key = "tag"
+			}
+			if !keys[key] {
+				return errors.Errorf("secondary output refers to chunk key %s in %s, the buffer of the primary output has the chunk keys %q", key, param, chunkKeys)
+			}
+		}
+		if timePlaceholder.MatchString(value) && !keys["time"] {
+			return errors.Errorf("secondary output refers to the time in %s, the buffer of the primary output has the chunk keys %q", param, chunkKeys)
+		}
+	}
+	return nil
+}
@@ -47,6 +47,26 @@ func CreateOutput(outputSpec v1beta1.OutputSpec, outputName string, secretLoader
 	}
 }
 
+func CreateInput(inputSpec v1beta1.FluentdInput, secretLoader secret.SecretLoader) (types.Directive, error) {
+	v := reflect.ValueOf(inputSpec)
+	var converters []DirectiveConverter
+	for i := 0; i < v.NumField(); i++ {
+		if v.Field(i).Kind() == reflect.Ptr && !v.Field(i).IsNil() {
+			if converter, ok := v.Field(i).Interface().(DirectiveConverter); ok {
+				converters = append(converters, converter)
+			}
+		}
+	}
+	switch len(converters) {
+	case 0:
+		return nil, errors.Errorf("no plugin config available for input %s", inputSpec.Name)
+	case 1:
+		return converters[0].ToDirective(secretLoader, inputSpec.Name)
+	default:
+		return nil, errors.Errorf("more then one plugin config is not allowed for input %s", inputSpec.Name)
+	}
+}
+
 func CreateFilter(filter v1beta1.Filter, id string, secretLoader secret.SecretLoader) (types.Directive, error) {
 	v := reflect.ValueOf(filter)
 	var converters []DirectiveConverter
@@ -24,37 +24,37 @@ var Root = func() http.FileSystem {
 		"/logging.banzaicloud.io_clusterflows.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "logging.banzaicloud.io_clusterflows.yaml",
 			modTime:          time.Time{},
-			uncompressedSize: 59396,
+			uncompressedSize: 68004,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\x4d\x8f\xe3\xb8\xd1\xbe\xfb\x57\x08\x7b\xef\x7e\x77\xdf\x20\xc0\xc2\x97\x60\x31\xc9\x02\x8b\x09\x26\x83\x49\x30\x97\x20\x20\x68\xaa\x2c\x73\x4d\x91\x5a\x92\xf2\xb4\x27\xc8\x7f\x0f\x24\xf9\xb3\x5b\x12\x8b\x12\xdd\xeb\x9e\x54\xdd\x2c\xd1\x0f\x4b\x64\xb1\x8a\x45\x52\x7a\x16\x0f\x0f\x0f\x0b\x5e\xc9\xcf\x60\x9d\x34\x7a\x99\xf1\x4a\xc2\x93\x07\xdd\xfc\x72\x8f\xdb\x1f\xdd\xa3\x34\xff\xb7\xfb\x61\xb1\x95\x3a\x5f\x66\xef\x6a\xe7\x4d\xf9\x09\x9c\xa9\xad\x80\x3f\xc3\x5a\x6a\xe9\xa5\xd1\x8b\x12\x3c\xcf\xb9\xe7\xcb\x45\x96\x71\xad\x8d\xe7\xcd\x65\xd7\xfc\xcc\x32\x61\xb4\xb7\x46\x29\xb0\x0f\x05\xe8\xc7\x6d\xbd\x82\x55\x2d\x55\x0e\xb6\x05\x3f\x56\xbd\xfb\xfe\xf1\x8f\x8f\xdf\x2f\xb2\x4c\x58\x68\xff\xfe\x0f\x59\x82\xf3\xbc\xac\x96\x99\xae\x95\x5a\x64\x99\xe6\x25\x2c\x33\xa1\x6a\xe7\xc1\xae\x95\xf9\xe2\x1e\x95\x29\x0a\xa9\x8b\xc7\x15\xd7\x5f\xb9\x14\xca\xd4\xf9\xa3\x34\x0b\x57\x81\x68\x6a\x2f\xac\xa9\xab\x65\x36\x50\xaa\x43\x3c\xaa\xc9\x3d\x14\xc6\xca\xe3\xef\x87\xe3\xbf\x1e\x78\x5b\x79\x96\x1d\x1a\xa1\xab\xfe\x67\x65\xbe\xb4\x57\x95\x74\xfe\xfd\xf3\x3b\x7f\x95\xce\xb7\x77\x2b\x55\x5b\xae\xae\x95\x6e\x6f\x38\xa9\x8b\x5a\x71\x7b\x75\x6b\x91\x65\x4e\x98\x0a\x96\xd9\x87\x46\xb1\x8a\x0b\xc8\x17\x59\x76\x68\xa3\x56\xb1\x87\x8c\xe7\x79\xdb\xea\x5c\x7d\xb4\x52\x7b\xb0\xef\x8c\xaa\x4b\x7d\x52\x3b\x07\x27\xac\xac\x7c\xdb\xaa\xbf\xb8\xcc\x6f\x20\x6b\xc0\x33\x2e\xbc\xdc\xc1\x9f\xda\x62\x59\xf6\xab\x33\xfa\x23\xf7\x9b\x65\xf6\xe8\x3c\xf7\xb5\x7b\xec\xee\x1f\x6e\x77\x6d\xfd\xd3\xe5\x25\xbf\x6f\x34\x5b\x19\xa3\x80\xeb\xbe\xca\x3e\xd4\xe5\x0a\x6c\x66\xd6\x59\x65\xcd\x4a\x41\xe9\x06\xeb\x3a\x16\x78\x67\x6a\xed\xaf\xaa\xfc\x78\xfd\xd7\xae\xd2\xe6\x39\x0b\xb0\x8b\x73\xb1\xdd\x0f\x5c\x55\x1b\xfe\x43\xd7\x98\x62\x03\x25\x5f\x1e\xfe\x62\x2a\xd0\x3f\x7d\xfc\xe5\xf3\x1f\xfe\x7e\x75\x39\x6b\xb4\xaa\xc0\xfa\x53\x17\x77\x72\x61\xff\x17\x57\x8f\x35\x3b\x6f\xa5\x2e\x2e\x6e\xb4\x56\x80\x29\x78\x39\x28\x9e\x17\x36\xab\x5f\x41\xf8\x8b\x1b\x47\x83\x3d\x4b\xbf\xb2\x8d\xac\xa5\xf2\x60\x5f\x5c\xce\x32\xe9\xa1\xec\xb9\x3c\x86\xd5\x89\x30\x5a\x70\xdf\x7f\x2f\xfc\xef\x03\x82\x97\xba\x36\xb5\x63\x4a\x6a\x60\x16\x0a\x78\xaa\x86\xcb\x0f\xb6\xda\xb5\xac\x55\xed\x36\xac\xb5\xf2\x1d\x57\x61\xb8\x4b\x3b\xe9\x93\x2d\x40\xc5\x2a\x6e\xbd\xe4\x8a\x6d\x61\x1f\x46\xbc\x34\xf7\x20\x62\x7f\x97\xf7\xc1\x06\x9e\x1b\xa5\x5a\x00\xa3\xac\x95\x97\x6d\x67\x80\xce\x53\x75\xc8\x19\xd4\x79\x6e\x7d\x2a\x58\xdd\x5a\xcd\x88\x7d\x61\x3b\x38\xaa\x6f\x03\x4a\x1d\xb1\x76\x5c\xd5\x30\x1b\xcd\x41\xc5\x2d\xf7\xc6\xce\x47\xf2\x16\x78\xc9\x64\x0e\xda\x4b\xbf\x4f\xf2\xac\x5e\x96\x60\x6a\xcf\x14\x5f\x01\x62\x9c\x05\xd0\x6a\x07\x6c\x2d\xad\xf3\xcc\x9f\x82\xf8\xec\x91\xd6\x80\x26\x1e\x68\x03\xce\xf8\x2c\x39\xe4\x66\x96\x5f\xcc\x81\xe5\xc6\x33\x0d\xce\x43\x3e\xbf\x0d\x0e\x70\xa9\x6c\x09\xf1\xfc\x1e\x84\xff\xcb\x93\x80\xea\x62\x46\xf7\x52\x30\x4d\xa1\xb8\x2e\x6a\x5e\x8c\x8f\xf2\xc1\x10\x16\xf5\x5c\xe7\x62\xdc\x5a\xbe\x1f\xf6\x66\xfc\x89\xad\xf6\x3e\x85\xe3\x69\xa0\x12\xf9\xb0\x12\x9c\xe3\xc5\x7c\x8f\x73\xf6\xd5\xb1\x61\x34\x00\x6c\xa1\x34\x3b\x60\x9e\x17\xac\xb2\xb0\x96\x4f\x89\x5c\xda\xad\xad\x19\xf4\x86\x6b\x01\xef\x7f\x9c\x65\xc7\xbc\x92\xac\xcd\x2b\xee\xc8\x90\x57\xc0\x2d\x58\xe6\xcd\x16\x34\x5b\x4b\x35\xdf\x78\x04\x0f\xe2\x60\x1a\xab\x91\xb2\x99\xe3\xff\x6c\xcd\x68\xff\xc6\x00\x66\x6d\x38\x15\x16\xfc\x7b\xd8\x7f\x82\x75\xb8\x74\x1c\x76\x16\x9e\x81\x5d\x0a\xb2\x1f\xcf\xd2\x66\x30\xb7\x02\x37\x55\x97\x1c\xc6\x55\x10\x0a\x3c\x67\xb1\xf0\x5b\x2d\xed\x78\x30\x3b\xca\x43\xd3\x8c\x88\x72\xc1\x91\x3b\xa1\x68\x70\xd6\x96\xc5\xb4\x6e\x8b\x46\x36\x7c\x16\xb2\xe1\x5e\x1d\x52\xda\x30\xaa\x98\xe0\x62\xd3\xa4\xdb\x6b\x0b\x6e\x33\x7f\xee\x71\x05\xc7\x76\xdc\xca\x76\x2d\x2e\x15\xb0\x93\x5f\x11\xa1\x09\x87\xe5\x7d\x82\x05\x01\xa1\x24\x68\xcf\x04\xd8\xc1\x69\x7e\x46\xa1\xee\x24\xe4\x26\x86\x84\x42\xdd\xa8\x90\x0d\x0f\x08\xd9\x30\xba\xd8\xc1\x57\x07\xba\x9a\x5c\x75\x27\x64\xe6\x43\x42\xae\x7a\x54\xc8\x86\x07\x84\x6c\x18\x5d\x4c\x18\x0b\x8c\x57\x92\x5d\x6e\x5d\x0f\xc9\xeb\x2e\x95\xe5\xdc\x73\xd6\x16\x9c\xbb\x44\x26\x35\xd3\xc7\x6d\x7a\x56\x71\x3f\x9a\x7e\xbd\xee\x43\x4a\xcd\x2a\x93\xdf\x99\x52\xdb\x7a\x05\x56\x83\x07\xc7\x6a\x3b\x7f\x15\xba\xf3\x53\x2c\x97\x09\x76\xd4\x9c\x3a\x6d\x2d\x89\x0d\x97\x88\x7c\x37\x34\xac\x77\x60\xe5\x7a\xcf\x9c\x43\x3c\xe8\x38\x56\x70\xc0\x15\x60\xe4\xe0\xfe\x1a\xc6\x3b\xaf\xb8\xd8\x82\xce\x99\x92\x2b\xcb\xed\xfc\xcd\xc4\x56\x21\xf6\xff\xac\x19\x6a\x2b\xee\xe6\x8f\xb4\x0e\x30\x31\x9c\x32\x66\x5b\x57\xcd\x74\x12\xb1\x67\x13\xdc\x11\x11\xc6\xe6\x73\xbd\xdc\xe5\xc9\x1e\x6c\x4c\x45\x47\xb1\x28\xf7\x3e\x3e\x90\xdd\x56\x56\xac\x51\x56\x17\x4c\xd7\x4a\xb1\xee\xf1\x6f\x6f\xe8\x16\x66\xd9\x39\x7f\x7e\x72\xe7\x5a\x10\x3d\x84\x9f\xeb\xc0\x93\x50\x75\x8e\x98\x8c\x20\x6a\x8d\xad\xbb\x93\x5b\xce\xb3\x2a\xee\x3d\xd8\x51\x37\x39\x03\xff\x16\x13\xa1\x87\xa3\xce\x69\x27\x4d\xb8\x01\xd3\x49\xf8\xac\x4c\x27\x64\x11\x2f\xe4\x9b\xb4\x88\x84\x1e\x19\xe1\x6d\x92\x7a\x37\x94\x25\x45\xf4\x31\xda\x7a\xd0\x98\x38\x8b\x09\xdb\x0a\xce\x4a\x12\x76\xe5\xf8\xa1\x1e\x8a\x51\xe4\x91\x2e\xd4\xa5\x18\x45\x16\x71\xad\xee\xfd\xc6\xa8\xb0\x69\x51\x88\xea\x91\xbb\x0b\x51\x41\xa0\x8a\x5b\x07\x83\x51\x0c\xd3\x83\x50\x4a\xcf\xa4\xde\x71\x25\xf3\x43\x72\xc9\xbc\x61\x60\x2d\xe6\xc8\x6b\x68\x69\x66\xc3\xdb\xa3\x0d\xaa\x06\xb6\x96\xa0\x10\x79\x6b\x70\x21\xb0\x69\x0b\xb6\x85\x7d\xaa\xf3\x90\x0d\x54\x68\x2d\x1b\x7b\x86\x7d\x7c\xc1\x04\x3b\xa0\x72\x50\xb2\x94\x7e\xb8\x5f\xa3\xd4\xba\x42\x64\xc8\xa1\x85\x46\x06\xe7\x65\xc9\x3d\x30\x51\x5b\x0b\xda\x33\xd8\x81\x1e\x3d\xdf\x91\x45\x2d\xd7\xc3\x53\x65\xc1\xbd\x7c\x63\x68\x86\xca\x6b\x63\xcb\xe1\x37\x70\x26\xc2\x75\x67\xf0\x95\xd4\xe9\x76\x9c\xda\xd7\x5d\xbc\x2c\x21\xb4\x0d\x9b\x45\xb5\x68\xfb\xda\x01\x4b\x6f\x63\xca\x08\xae\x5a\x7d\xd3\xe9\x7a\x3a\x56\x1d\x82\x44\xce\x91\x22\x62\x10\x36\x6a\xb7\x8b\x72\x50\x56\x7e\xcf\x3a\xdc\x74\x4f\xdf\x42\x77\xce\x33\xf5\xb0\x3d\xe0\x05\x9b\x0c\xd9\xae\x71\x33\xcf\x69\x3e\xa3\x93\xd8\x8d\x3e\xbc\xff\xb8\xc4\x47\x4f\x44\x71\xbe\x64\x12\x74\xd4\xf0\xbf\xac\x01\xdf\x38\xf8\x21\x3b\x0d\x3f\x7a\x6c\xcc\xa8\x26\x6a\x9c\x5c\xd6\x83\xee\x90\xb6\x2f\x6e\xd8\xe1\x53\xfa\x3a\x0e\x3c\xb4\x15\x3b\x0b\xfd\xab\x09\xbb\xe9\x69\xe0\x37\xd3\x7a\x5f\x61\x53\xe5\x48\xe4\xda\x8b\x5b\x98\x7a\xf4\x09\x03\x44\xd2\x89\x37\x6a\xfc\xf6\x57\xd4\x9c\x05\x0b\x88\x31\x83\x28\x44\x8c\xc1\xc6\x6d\xf9\xa5\x04\x4b\xb7\x1b\x89\x30\x46\xac\x19\xa2\x0c\xb0\xcb\x49\xe7\x6e\xd0\xe2\xa7\x14\xe8\xa9\x6c\xd4\x30\x8e\x4e\x99\xa2\xd0\xa7\x4e\x81\x62\xfc\x45\xcc\xd4\x27\x42\x75\x6c\x04\x8c\x86\xc4\xa7\x50\x51\xe0\x91\xf3\xa8\x98\x16\x8e\x4c\xa5\xa2\xd4\x8e\x99\x9b\xc5\xe8\x8c\x4e\xa9\x22\x16\x9e\xa3\xa2\x23\x7e\x81\x7c\xc2\xf4\x31\xa6\x25\xa6\x4c\x1b\xe3\x17\x31\x11\x0d\x78\xb3\x05\xfe\x39\x89\x56\xfc\x34\x3c\x3e\xd9\x9a\xb0\xaf\x10\x33\xff\x9e\x00\x3f\x21\xe9\x9a\xd2\x50\xb1\x89\xd7\x94\x3a\x26\x26\x5f\x93\xab\x9a\x90\x80\x4d\xe8\xa0\xe8\x24\x6c\x6a\x1d\xb7\xdc\x1c\x8b\x4c\xc6\x26\xd6\x80\x4f\xc8\xa6\x54\x70\x53\xed\xf1\x89\xd9\x04\x74\x74\x72\x16\x3f\x14\x6e\xb4\x53\x1c\x65\xf4\x31\x6b\x9c\x91\xb3\x22\x3c\x28\xce\x3c\x22\x51\x71\x06\x1d\xbb\xc6\x9b\x1a\x30\xed\x32\x34\xca\x58\xf1\x66\x9a\x74\x8f\xb9\xfd\x32\xc9\x71\xfb\x0c\xbb\xbf\x17\xd2\xd2\x42\xa5\xb8\x80\xd3\x96\xa4\x83\xdf\x6a\xd0\x02\xb1\x3d\x17\x46\x76\x60\x77\xc0\x70\x1f\x33\xc2\xa2\x85\x82\x78\x92\xd3\xb8\x95\x35\x25\xf8\x0d\xd4\x33\xbf\x0a\xb4\x02\x35\x6a\x9e\xf1\xa7\xa2\x91\xa6\x8c\xb2\xbb\x12\xbc\x95\xe2\xf5\x16\x0e\x56\xb5\xd8\x82\x4f\x3b\x5e\x73\x70\xe8\x01\x8b\x4c\x5f\xd3\xba\xe7\xb0\x11\x74\x32\xe5\x80\x7c\xa4\x2a\x51\xf1\x12\xf7\xba\xd9\xef\xe7\xfc\xb1\x27\x4c\x1a\x03\x09\x14\x69\x1e\x35\x50\xa4\x51\xeb\x75\x1c\x7d\x10\xe8\x70\x66\xa4\x34\xb9\x5c\xcb\x79\x67\x51\xc4\x86\x5b\x06\x5a\x98\x3c\x90\xae\xe0\xce\x63\x58\xa8\xb8\x85\x44\xdf\x14\xfc\xdf\x7a\xed\xe4\x1c\xdc\x53\xbc\xb0\xd3\x46\xf4\xd7\xf3\xeb\x37\x5a\xf8\x4c\xed\x89\x0f\xed\xf2\x3b\xf8\xa0\x73\x03\x05\x0a\x86\x8f\xc3\x1d\x1e\xe2\xb5\xec\xf2\xcb\x46\x7a\x50\xd2\xf9\x14\xa6\x89\x75\x6d\xde\x72\xed\x9a\xf4\x6b\x9e\x77\xe3\xb5\x37\x6d\x62\x24\xb8\x1b\xcd\xe3\x70\x13\x50\xd0\x7c\xa5\x80\xd9\x7a\x95\xea\xd3\xba\xf4\x7a\x5e\x4f\xb1\xd7\xf4\x93\x1a\xbe\x24\x7a\xbf\xef\x88\x86\xc9\xf0\x53\x8c\x14\xe7\x73\x53\xcf\xfa\x7a\xab\xa9\x7d\x55\xfb\x14\x6f\x8b\x87\x95\xad\x4b\xa3\x4c\x21\x07\x27\xea\xb8\xaf\x70\x2b\x05\xc2\x1b\xcb\x92\x1d\x20\x3d\x43\xa6\x99\xb2\x1c\xde\x39\x61\xc2\x68\xcf\xa5\x06\xdb\x7d\x42\x3a\x19\xee\x9a\x0b\xa9\xa4\xdf\x27\x86\xdd\x18\xe7\x13\x43\x9e\xbf\x1d\x90\x16\xb7\x32\x79\x6a\x44\x2b\x8d\x4d\xdf\xa6\xb5\x96\xa9\xda\x54\x99\x02\xb1\x16\x89\x82\xea\xc8\x2d\xd8\x81\x0e\x62\xfe\xcb\xf0\xcf\xf0\xd2\x8d\xcc\xe7\xc0\xa9\x3e\xc5\xfb\x0c\xf6\xb8\xf6\x95\x73\xcc\xf7\x05\x71\xe0\xcd\x68\x4a\x89\x95\xbc\x51\x53\x63\xa5\x53\xd0\x5b\x2e\xa4\x2e\xd8\x99\x66\x25\x55\xc7\x1f\x91\xcf\x9e\x39\xa9\xc2\xd8\xe1\x19\x9a\x43\x1c\xf1\x92\xd8\xd0\x11\xac\x3b\x34\x91\xb8\x21\x4f\x0e\x3e\x19\x62\x65\xe6\xbf\xac\x72\x81\xc5\xe4\x5c\xb8\xe0\xb4\xc6\xf3\x82\xe9\xa6\xe7\x95\x9c\xf9\x4e\x50\x12\xf7\x1e\xd6\x77\x63\x8d\xf7\xc3\xdf\xbd\xc6\x68\xda\x7e\x1c\x9c\x75\x0b\xb9\xac\x3d\x86\x13\xd6\x3a\xf4\x9d\xd2\x2b\xcc\x0a\xac\x34\x39\x4b\xf0\xa9\xf9\x0e\x36\xb7\xa6\x62\xca\x14\x08\xc0\xd0\xe8\xec\x00\x53\x30\x52\x74\x48\x16\x1c\x78\x66\xb9\x87\x74\x8f\xfb\x85\x5b\xdd\x8c\x80\x1c\x14\xdf\xcf\x87\x0d\xd8\xd4\xe8\xed\xe1\x74\xae\x50\x66\xc5\xd5\xdf\xda\x04\xe4\x13\xac\x63\xd8\x7e\x46\x9b\x77\xb8\xc6\x03\xdd\x55\xef\x57\xdf\x46\x20\x4b\xee\x45\xcf\xc4\x60\x32\x17\x51\xe0\xa5\x74\x2c\x19\xd1\x39\x82\xdd\xd1\xb7\xc7\x9a\x98\x75\x47\xea\xdc\xfb\xde\xdb\x29\x80\xde\x4d\x9b\x85\xd3\x78\x68\xb2\x65\x32\xde\xdb\xab\x43\xc6\xdb\x57\x6c\x86\xf1\x4e\x0c\x54\xe6\x15\x43\x54\x37\xba\x4c\xdf\x39\x7e\x6c\x67\x23\x6a\xee\x69\x82\x81\x1b\x1d\x99\xe1\x75\x35\xc3\x63\xbc\xe3\x57\x1c\x0a\xae\xfd\x33\xab\x23\x4f\xe2\xed\xdb\xf6\x8a\x91\x71\x48\xc9\xbe\x99\x50\x6f\xdb\xbc\xb8\xd8\x1e\x4c\xc9\x97\x99\xb7\x75\xb7\x31\xe3\xbc\xb1\xbc\x80\x65\xb6\xe6\xca\x1d\x2e\xd5\x2b\x7b\x20\x17\x3d\x3d\xd9\xa1\x89\xb3\x7f\xff\x87\xc8\x2f\x2f\xc8\x2f\x57\xe0\x89\xfb\xf2\x52\x88\xfb\x92\xb8\x2f\xcf\x42\xdc\x97\x33\x95\x22\xee\xcb\xa9\x68\xc4\x7d\x79\x06\x20\xee\xcb\x83\x10\xf7\x25\x16\x8a\xb8\x2f\x27\xc1\x04\xad\x99\xb8\x2f\xf1\xf5\x12\xf7\x25\x31\x74\xf4\xca\xdb\x60\xe8\x20\x96\x19\xb2\xe1\x61\x79\x1b\x36\x8c\x2a\x46\xdc\x97\xf3\xa0\x88\xfb\x92\xdc\xc4\x90\xbc\x0d\x37\x41\xa1\x8e\x6c\x78\x58\xde\x86\x0d\xe3\x42\x1d\x71\x5f\x92\x99\x0f\xc8\xdb\x30\x73\x72\xd5\x64\xc3\xc3\xf2\x36\x6c\x18\xe7\xaa\x89\xfb\xf2\x79\x61\xe2\xbe\x24\xee\xcb\x49\x58\xc1\x01\x47\xdc\x97\x93\xe0\x88\xfb\x72\xb8\x18\x71\x5f\x4e\xaf\xa5\x13\xe2\x15\x9b\x8e\xff\x4d\xb2\x48\x11\xaf\xd8\x0c\xfc\x6f\xd2\x22\x12\x7a\x64\xe2\xbe\x7c\x2e\x6f\x94\x58\x8c\xb8\x2f\x87\x84\x3c\x52\x8f\xba\x14\xa3\xc8\x22\xae\xd5\xbd\xdf\x18\x45\xdc\x97\xcf\xe4\x8d\x86\xa8\x20\x10\x71\x5f\xce\x46\x24\xee\xcb\x39\xc8\xc4\x7d\x49\xdc\x97\x07\x5c\xe2\xbe\x6c\x71\xd3\x3d\x3d\x71\x5f\xbe\x94\xd8\x8d\x3e\xe2\xbe\x1c\x11\xe2\xbe\x8c\xe9\x10\xe2\xbe\x1c\x45\x27\xee\xcb\xb3\x10\xf7\x25\x71\x5f\xce\x04\x4b\xb7\x1b\x49\xdc\x97\xcf\xf4\x47\x0d\x63\xe2\xbe\x1c\x10\xe2\xbe\x3c\x09\x71\x5f\x5e\xd6\x8f\x8c\x8e\xf8\x05\x72\xe2\xbe\x7c\x0e\x49\xdc\x97\xc4\x7d\x49\xdc\x97\xc4\x7d\x89\xaa\x81\xb8\x2f\xfb\x85\xb8\x2f\xaf\x70\x89\xfb\x32\x2d\x60\xda\x65\x68\xe2\xbe\x24\xee\x4b\xe2\xbe\x3c\x09\x71\x5f\xce\xc5\x23\xee\xcb\xcb\xa2\xe9\x00\x89\xfb\x92\xb8\x2f\xbf\x9d\xd7\x4e\x88\xfb\xf2\xa5\x10\xf7\x65\x4f\x09\xe2\xbe\x6c\x85\xb8\x2f\x8f\x42\x7e\x72\xaa\x6a\xc4\x7d\x49\xdc\x97\xc4\x7d\x49\xdc\x97\xc4\x7d\x99\x08\x8f\xb8\x2f\x89\xfb\x72\x0e\x28\x71\x5f\x12\xf7\x65\x2a\x2c\xe2\xbe\x7c\x59\x80\xb8\x2f\x47\x01\x89\xfb\x72\xa0\x0c\x71\x5f\x22\xb5\x23\xee\xcb\xbb\x51\xe7\xde\xf7\xde\xde\x1c\x7d\x20\x71\x5f\x92\xf1\x1e\xe5\xcd\x19\xef\xc4\x40\x45\xdc\x97\xd7\xd5\x10\xf7\x65\xef\xc5\x61\xee\xcb\xf3\x95\x71\xea\xcb\x73\x63\x73\x21\xa0\xf2\x90\x7f\x38\x3b\xc8\x96\x95\x31\xfb\xee\xbb\xf6\x47\xa5\x6a\xcb\xd5\xe1\xa7\x30\xba\xb3\x0c\xb7\xcc\xfe\xf9\xaf\x45\x57\x31\xe4\x9f\x8f\x1f\x26\x6d\x2e\xfe\x37\x00\x00\xff\xff\xc2\xb8\xd2\xb7\x04\xe8\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\x5f\x6f\xe3\x38\x92\x7f\xf7\xa7\xd0\x17\x48\x6e\x7b\x17\x07\x0c\xfc\xb2\x18\xf4\xed\x00\x83\x39\xcc\x36\x66\x0f\xfd\x4a\xd0\x54\xd9\xe6\x84\x22\x35\x24\x95\xc4\x7d\xb8\xef\x7e\xa0\x24\xc7\x4e\xda\x36\xab\xc4\x72\xe2\xdd\x55\x9c\x97\x58\xca\x8f\xc5\xe2\x8f\xc5\x2a\xfe\xab\xc5\xdd\xdd\xdd\x42\xb6\xfa\x2b\xf8\xa0\x9d\x5d\x56\xb2\xd5\xf0\x1c\xc1\xa6\xbf\xc2\xfd\xc3\x0f\xe1\x5e\xbb\xff\x78\xfc\xb4\x78\xd0\xb6\x5e\x56\x9f\xbb\x10\x5d\xf3\x1b\x04\xd7\x79\x05\xff\x05\x6b\x6d\x75\xd4\xce\x2e\x1a\x88\xb2\x96\x51\x2e\x17\x55\x25\xad\x75\x51\xa6\xaf\x43\xfa\xb3\xaa\x94\xb3\xd1\x3b\x63\xc0\xdf\x6d\xc0\xde\x3f\x74\x2b\x58\x75\xda\xd4\xe0\x7b\xf0\x7d\xd1\x8f\x7f\xba\xff\xcf\xfb\x3f\x2d\xaa\x4a\x79\xe8\xff\xfd\x7f\x74\x03\x21\xca\xa6\x5d\x56\xb6\x33\x66\x51\x55\x56\x36\xb0\xac\x94\xe9\x42\x04\xbf\x36\xee\x29\xdc\x1b\xb7\xd9\x68\xbb\xb9\x5f\x49\xfb\x4d\x6a\x65\x5c\x57\xdf\x6b\xb7\x08\x2d\xa8\x54\xfa\xc6\xbb\xae\x5d\x56\x67\xde\x1a\x10\xf7\x62\xca\x08\x1b\xe7\xf5\xfe\xef\xbb\xfd\x7f\xdd\xc9\xbe\xf0\xaa\x1a\x95\x30\x14\xff\x93\x71\x4f\xfd\xb7\x46\x87\xf8\xcb\xdb\x27\xff\xad\x43\xec\x9f\xb6\xa6\xf3\xd2\xbc\x16\xba\x7f\x10\xb4\xdd\x74\x46\xfa\x57\x8f\x16\x55\x15\x94\x6b\x61\x59\xfd\x2a\x1b\x08\xad\x54\x50\x2f\xaa\x6a\xd4\x51\x2f\xd8\x5d\x25\xeb\xba\xd7\xba\x34\x5f\xbc\xb6\x11\xfc\x67\x67\xba\x66\xaf\xed\xbb\xaa\x86\xa0\xbc\x6e\xd3\x2b\xcb\xea\xe7\x50\xc5\x2d\x54\x49\x59\x95\x54\x51\x3f\xc2\x5f\xfb\xe2\xab\xea\xf7\xe0\xec\x17\x19\xb7\xcb\xea\x3e\x44\x19\xbb\x70\x3f\x3c\x1f\x1f\x27\xcd\x2c\xab\x1f\x8f\xbf\x8a\xbb\x24\xd9\xca\x39\x03\xd2\x9e\x2a\xec\xd7\xae\x59\x81\xaf\xdc\xba\x6a\xbd\x5b\x19\x68\xc2\xd9\xb2\xf6\x2f\x7c\x76\x9d\x8d\xe3\x5b\x43\x91\x5f\x5e\xff\xeb\x50\x68\xaa\xe7\x06\xfc\xe2\xf0\xda\xe3\x27\x69\xda\xad\xfc\xd4\x7f\x15\xd4\x16\x9a\x9e\x7d\xe9\x2f\xd7\x82\xfd\xf1\xcb\xcf\x5f\xff\xf2\x8f\x57\x5f\x57\x49\xaa\x16\x7c\x7c\x69\xe2\xe1\xf7\x88\xff\x47\xdf\xee\x4b\x0e\xd1\x6b\xbb\x39\x7a\xd0\xb3\x00\xf3\xe2\x71\xa7\x38\xfc\x0c\xa8\x6e\xf5\x3b\xa8\x7d\xbd\xd3\x67\x4f\xd8\xaa\xba\x2c\x6c\xfa\xac\xb5\x89\xe0\xbf\xfb\xba\xaa\x74\x84\xe6\xc4\xd7\x97\xb0\x86\x8f\x72\x56\xc9\x78\xfa\x59\xfe\xbf\xf7\x9d\x5c\xdb\xce\x75\x41\x18\x6d\x41\x78\xd8\xc0\x73\x7b\xfe\xfd\xb3\x5a\x7b\xfd\x59\x9b\x2e\x6c\x45\x6a\x7d\xff\x28\x4d\x1e\xee\x98\x27\xa7\x7e\x1e\x00\x5a\xd1\x4a\x1f\xb5\x34\xe2\x01\x76\x79\xc4\x63\xba\x67\x11\x4f\x37\xf9\x84\x7a\xa3\x44\xcb\x60\x34\x9d\x89\xba\x6f\x0c\xb0\x35\x57\x83\x1c\x40\x43\x94\x3e\x72\xc1\xda\x9e\x35\x21\x8f\x93\x6b\x60\x52\xdb\x66\x84\xda\x63\x3d\x4a\xd3\x41\x31\x5a\x80\x56\x7a\x19\x9d\x2f\x47\x8a\x1e\x64\x23\x74\x0d\x36\xea\xb8\x63\xa9\x6b\xd4\x0d\xb8\x2e\x0a\x23\x57\x60\x8a\xd1\xba\x00\x62\xad\x7d\x88\x22\xbe\x0c\xe2\xc5\x3d\x2d\x81\x32\x77\xb4\x33\xc6\xf8\xf0\xa9\xa1\x76\x45\x76\xb1\x06\x51\xbb\x28\x2c\x84\x08\x6f\x86\x8d\x29\x3a\x18\xe1\xb8\xb8\x84\xa9\x7f\xd7\x96\xd4\x5f\xb9\xa6\x95\x1e\x12\x47\x2f\xbc\x75\x61\xe8\x22\xd5\xe7\xf0\x9a\xf4\x5e\xee\xce\xbe\xa5\x92\xd7\xc1\xd2\x6f\x1a\xf9\x2c\x86\xfe\xc8\x60\xbc\xc6\x8e\x7d\x5b\xba\x7a\xd2\xb6\x76\x4f\xa5\x95\x43\x30\x2d\x82\x8a\x7f\x7b\x56\xd0\x1e\xc5\x0e\xd3\x48\x67\xa4\xdd\x74\x72\x03\x37\xa4\xc5\xc4\x93\xd5\x2e\x42\x28\x55\xe4\x00\xc5\x34\x5a\x36\x10\x82\xdc\x00\xa3\x57\x40\x75\xd8\x32\xc0\x1e\x1a\xf7\x08\x22\xca\x8d\x68\x3d\xac\xf5\x73\x31\xe2\xd0\xc7\xae\x6d\x37\xc1\x6e\xa5\x55\xf0\xcb\x0f\x45\x3c\x96\xad\x16\x7d\x04\x7b\x43\x44\x5e\x81\xf4\xe0\x45\x74\x0f\x60\xc5\x5a\x9b\x72\xf2\x28\x99\xc5\xc1\x28\x2b\x7d\x9a\x64\xd7\x7f\xf2\xee\x62\xfb\x52\x00\xd3\x27\x80\xf2\x10\x7f\x81\xdd\x6f\xb0\xce\xbf\x4d\xc3\x46\xf8\xfa\x64\x7d\x1e\x7f\xfa\x90\xfa\x5a\xe0\xae\xb7\xd4\x97\x7b\x39\xdd\xc5\x39\xfc\x78\xf8\xa3\xd3\xfe\xb2\xdb\xb4\xff\xb9\xab\x1e\x60\xb7\xc8\xbc\x84\xe9\xb9\x13\x5e\xcd\xc6\x07\x24\xed\xf6\x68\x33\x87\x67\x0e\xbf\x23\x87\x51\xaf\x29\xa9\xb6\x69\x62\x67\xed\x21\x6c\xcb\x7d\x8f\x57\x70\xe2\x51\x7a\xdd\xcf\xfa\x72\x01\x07\xfd\x0d\xb8\xb0\x62\x34\x0c\x50\x46\x83\x8d\x42\x81\x3f\x1b\x50\xce\x43\xdd\x3c\xd4\xcd\x43\xdd\x3c\xd4\xcd\x43\xdd\x47\x0f\x75\x83\xad\xce\x34\xf5\x6c\xaa\x67\x53\x3d\x9b\xea\xd9\x54\xcf\xa6\xfa\x23\x4d\xb5\xf3\x20\xd2\x44\xd9\xf1\x26\x89\xdb\x98\x2a\x4b\x0b\x54\xa2\x7f\x71\x51\x58\x9e\xb6\xc2\xee\x37\x84\x88\x36\x6d\xa4\xb8\x99\x4a\x6a\x2b\x5a\x57\xdf\x98\x50\x69\x8f\x91\xb7\x10\x21\x88\xce\x5f\xec\x45\xa8\x42\x87\xd9\x13\x51\x6b\x86\xb5\xdb\x60\x5e\x16\x31\xd5\x56\xea\x37\x7b\x4e\xa6\x74\xeb\x47\xf0\x7a\xbd\x13\x21\x98\x52\xac\x6c\x87\xdb\x80\xd3\x45\xcb\x81\x2b\xa9\x1e\xd2\x6e\x04\xa3\x57\x5e\xfa\x5d\xb1\x3a\x7b\x81\xc4\x9f\x45\xea\x6a\x2b\x19\xca\x7b\xda\x00\xc8\x0c\x67\x9c\x7b\xe8\xda\xec\x1a\x28\x0a\xd1\x83\x72\xbe\x0e\x85\x7d\xed\x78\x0f\x19\x76\x4c\x45\x89\x87\xa2\x11\xbe\x23\x87\x07\xdd\x8a\x24\xac\xdd\x88\xb4\x09\x50\x0c\xd5\xbf\x3e\xd1\x3d\x14\xf1\x5c\xbe\xdd\x23\x46\x6e\x21\x4c\x29\xe3\x5a\xd3\xb3\x32\x5d\x7d\x91\xab\xe8\x52\x6f\xcf\xcf\x6a\x65\x8c\xe0\x2f\x9a\xc9\x02\xfc\x6b\x38\x42\x77\x7b\x99\x11\xef\x22\xbb\x0a\xbe\xc3\xec\xab\x95\xdb\x95\x35\x33\xe2\xdf\x89\x11\x48\x50\x0c\x1c\xc2\xda\x20\x58\x85\xe7\x13\x8a\x49\x84\x36\x46\xb3\x07\x8d\x89\x63\x4c\x9e\x2b\x38\x96\x30\x36\xa5\xf3\xef\xd6\x8a\x08\xd6\xa0\x4b\x9d\x2d\xd2\xbf\x80\x45\x9a\xc7\xa8\x79\x8c\xba\xda\x18\x95\xa7\x16\x82\x54\x78\x3a\xa1\x88\x44\x68\x62\x34\x79\xd0\x98\x38\xc2\xe4\xa9\x82\x23\x09\x5b\x4b\x66\x81\x5a\xe9\x03\x9c\x1d\xc5\x30\x2d\x08\x8d\x8e\x42\xdb\x47\x69\x74\x3d\x06\x97\x22\x3a\x01\xde\x3b\x5f\x1a\x65\x56\xd5\x56\x86\xad\xe8\xa7\xce\xc5\x5a\x83\xb9\xa8\x7f\x54\x63\x6a\x9b\x74\x91\x66\x12\xb8\xf6\x43\x26\xa8\xdc\x5c\x36\x0a\xa8\x6f\x8b\xe5\xa2\xbc\x43\xd5\x60\x74\xa3\xe3\xf9\x76\x25\x89\xf5\x0a\x51\x20\xbb\x16\x1a\x19\x42\xd4\x8d\x8c\x20\x54\xe7\x7d\x5a\x32\x84\x47\xb0\x11\x07\x9f\x23\x4f\xfa\xc0\x73\xeb\x21\x7c\x7f\x36\xad\x40\xe4\xb5\xf3\xcd\xf9\xb3\x5e\x13\xe1\x86\xd3\x1e\x69\x57\x32\x1b\x70\x7f\xb0\x2a\x1d\x1f\xc9\x2d\xc3\xd2\x34\xda\x1f\x70\x11\xfc\x1c\x33\x4e\x49\xd3\x1f\x77\xe1\x93\xf5\x65\x5b\xf5\x72\xc1\xe2\x23\xa1\x2b\x83\x31\xce\xe3\x32\x58\x9a\x94\x83\xa6\x8d\xbb\x74\x0c\x42\xdb\x0d\x5f\xed\xfb\xf9\xbe\xc1\x78\x72\x77\xdb\x11\x2f\x30\xe9\x15\x6b\xd9\x4a\x6c\x06\x55\x7b\x54\xfb\x41\xd4\x20\xc5\x96\x4c\x82\x26\x75\xff\x69\xca\xc1\x77\xd9\x69\xf8\xe4\xbe\x51\x50\x0c\xa9\x9f\x4c\x6a\x90\xa4\x27\x71\xc5\x06\x9f\xd2\xd6\x34\xf0\xdc\x52\x6c\x11\xfa\x37\x67\xaf\x04\x7e\x35\xa9\x77\x2d\x84\xab\x20\x77\xf1\xcd\x59\x76\x1e\xaa\x23\xe3\x0a\xca\xf0\x45\x20\x35\x5a\x07\x58\x22\xd3\x00\x31\x34\x20\x21\x62\x08\x8b\x07\x64\x95\x0e\x43\x4c\x34\x1a\x82\x8c\x58\x1a\xa2\x08\xd8\xc7\x41\xa7\x6e\x67\x20\xb9\x15\x78\x97\x02\xed\xca\x12\x74\x36\x21\x64\x22\xa1\x4f\x75\x81\x28\xf6\x82\xe2\xfa\x10\x44\xc7\x8e\x80\x64\x48\x7c\x08\x45\x02\x27\xfa\x51\x14\x0d\x13\x43\x29\x92\xd8\x14\xdf\x8c\x22\x33\x3a\xa4\x42\x3b\xff\xa4\x6a\xe1\x47\xa6\x49\xee\x23\x45\x13\x53\xdc\x46\x42\x4d\x47\xcc\xc0\xa8\x67\x5a\x98\x55\x16\x68\xd1\x74\x49\xb7\x38\x64\x7d\xd2\xac\xcf\x44\xf8\x09\x41\xd7\x14\x45\x51\x03\xaf\x29\x65\x4c\x0c\xbe\x26\x17\x35\x21\x00\x9b\xd0\x40\xe4\x20\x6c\x6a\x19\xe4\xf6\xa7\x16\x80\xf1\x18\x8b\x4a\xc0\x07\x64\x53\x0a\xb8\xaa\xf4\xf8\xc0\x6c\x02\x3a\x3a\x38\xa3\x77\x05\x42\x80\x46\x19\x08\x49\xa4\x27\xe8\x03\x4f\x74\x2a\x28\x8e\x1e\x44\x54\x1c\xa1\x29\xa0\xec\x52\xe2\x88\x4b\x40\x44\x91\x15\x4f\x53\x24\x41\x31\xd4\x1c\x6f\x26\xd9\x2f\x9f\x61\xd7\xf7\x72\x52\x7a\x68\x4d\xda\xe4\xbf\x5f\x92\x0c\xf0\x47\x07\x56\x01\x07\x72\x00\xff\x08\x02\x77\x6d\x16\x16\x2d\x37\x88\x63\xd0\xb2\xad\xd2\x7a\xd7\x40\xdc\x42\x77\x96\x5c\x18\xd7\xb0\x0f\x57\x2e\x3c\x9f\xb2\x2b\x1a\x49\x65\x14\xef\x1a\x88\x5e\xab\x8b\x05\x22\x5c\x65\xbc\x93\xbc\xea\xd4\x03\xc4\xec\x6b\xe8\x4a\xa6\xdf\x74\xf7\x28\x2b\x20\xb7\x79\xce\x93\x60\x2a\x15\xc8\xa2\x20\x69\x41\x39\x6e\xf6\x71\xc6\x1f\xbb\xc3\x24\x11\x24\xf3\x4a\xaa\x6a\xe6\x95\x54\xcf\x05\x83\x66\xf3\x86\x3e\x0b\x34\xee\x19\x69\x5c\xad\xd7\x1a\x7c\x89\x81\x52\x5b\xe9\x05\x58\xe5\xea\x4c\xb8\x82\x6a\x95\xd6\xa7\x4b\x02\x81\xe9\xf6\xca\x7f\xaf\x63\x27\x87\xc1\x3d\x30\x68\xae\x1f\xd1\x4b\x55\x87\xb7\xeb\x57\x9a\xf8\xe4\xb6\xc4\xa3\x5e\x3e\xc0\x06\x1d\x14\x54\xbc\x1d\x6e\xac\xc4\x7b\xf1\xf2\x69\xab\x23\xa4\x0b\xc7\x39\xa8\x89\x35\x6d\xd1\x4b\x1b\xd2\xc4\x53\x99\x75\x93\x5d\x74\xfd\x69\x58\x25\x43\x2c\x75\x19\xd3\x0d\x7b\x72\x65\x40\xf8\x6e\xb5\x2b\x07\xeb\xe7\xbd\xe6\xe3\x79\xe4\xe3\x79\xbc\x76\xd2\xc2\x13\xd3\xf9\xbe\x3d\x1a\x26\xc2\xe7\xe9\x29\xb5\x54\xb1\xa8\x77\x28\xdc\xe5\x57\x19\x25\xaa\x3e\x43\xc3\x7e\x1a\xb2\x94\x86\xa8\x22\x71\x4c\xc9\x51\xe4\x7d\xa5\xb9\x3d\xfd\x8c\x03\x49\x93\x59\xa0\x40\x95\x18\xa4\xb9\x08\x32\xdf\x5b\x33\xdf\x5b\x33\xdf\x5b\x33\xdf\x5b\x33\xdf\x5b\xf3\x61\xf7\xd6\x64\x5f\x09\xb2\x69\xcf\xdf\x89\x8c\x21\x54\x7f\xf6\x24\xc3\x24\x54\x03\xf3\x65\x5f\x69\xc1\x2b\xb0\x11\x75\xe3\x78\x0e\xcb\xa7\x53\x17\x1c\xd5\x4b\x40\x17\xfb\x25\xdd\x19\xc7\xd5\x00\xc1\x02\xc4\x2b\x21\xd6\xae\x8b\x25\x44\x71\x5d\x6c\xbb\x98\x5d\xb3\x42\x28\x33\x2f\x6c\xd7\x38\xe3\x36\x5a\x95\xc8\xab\x52\x2e\x31\x15\x9d\x17\x6c\x67\x97\x0e\x90\x3c\xb3\x65\xe3\x71\x67\x91\xb2\x22\x49\x6d\xc1\x0f\x79\x72\xd8\x70\xd7\x52\x69\x93\x52\xbf\xf0\xc2\x6e\x5d\x88\xcc\x90\x87\x6b\xab\x78\x71\xd3\x9d\x53\xcc\x88\x5e\x3b\xcf\xaf\xd3\xce\x6a\x2e\x9d\x1a\xb7\x41\x2c\x83\xa3\xa0\x86\x0c\x7e\x62\xcc\x79\xb7\xe3\xc6\xe3\xeb\x99\x6f\x81\xb9\xb2\x40\xbc\x81\x1d\xc3\x2f\x51\xcb\xb0\xe5\x02\x4f\xbd\x89\x13\x8b\x5d\xa9\xdc\x58\x7c\x02\x46\x2f\x95\xb6\x1b\x71\xc8\x25\xc9\xd5\xf0\x7b\xe4\x83\x65\x66\x15\x18\xdb\x3d\x73\x2e\xef\x1e\x8f\x85\x43\x7b\xb0\x7e\xed\x93\x5b\x91\x2f\x06\x9e\x0d\xb1\x75\x35\x27\x96\xd0\xf5\xb5\xdd\x9a\x94\x9e\xc6\xa6\x96\x37\xba\xf0\x38\x3a\x8b\x79\xcf\xcb\xbb\xf5\x2e\xc6\xb2\xf0\xa2\xcf\x4b\x23\x86\x3d\x04\xa2\xdf\x01\x9e\x97\x3a\xe7\x11\xbf\xc2\x6c\xc1\x6b\x57\x8b\xc0\x05\x5b\x7b\xd7\x0a\xe3\x36\xa1\xbc\x77\x0e\x72\x72\x04\x1f\x03\x52\xda\x4c\x13\x45\x0a\x44\xf8\xaa\xfb\x24\xbd\x4d\x3d\xa0\x06\x23\x77\xe5\xb0\x79\x4e\x79\xa9\xe0\xb3\xb3\x11\x9e\x63\x09\xaf\xc6\xf1\xe4\xb6\xe6\x8b\x43\x2b\xad\xd0\x35\x4b\x93\x1f\x61\xdd\x50\x0d\x93\xc1\x4c\xd9\xbb\xe4\x26\xb0\xd4\x72\xc0\xd3\x35\x3b\xd8\xcd\xe8\x2c\xd3\x23\x2e\x3e\x3e\x8f\xbe\x31\x6e\x25\xcd\xdf\xfb\x90\xfc\x37\x58\x9f\xa8\xcc\xd9\x3a\x5e\xac\xd9\xf9\x12\xb5\x6d\xbb\xf8\x0e\xe5\x8c\xd9\xb4\x4f\x4e\x99\x5e\x80\x6c\x64\x54\x5b\x82\x74\x39\x1b\x33\xc6\x65\x25\x26\xea\xb5\xef\x78\xe1\xc5\x0b\x62\xa2\xea\x8e\x53\xec\xe1\x27\x79\x8b\x37\x24\xce\xad\x6f\xb8\x7c\x71\x5d\x6f\x46\x67\x59\xb1\x03\xa4\xa9\xaf\x99\xbc\x33\x79\xff\xe9\xc8\x7b\xf1\xf1\x79\x74\xf7\x8e\x43\xe1\xd0\xbb\xdc\xa9\xc3\xdb\xd8\xc6\x46\x94\x7c\x42\x05\x67\x1e\x84\x28\xe3\xdb\x3d\xef\xe7\xfb\xb8\x54\x51\x3f\x9e\x88\xe9\x2e\xc5\x34\x60\xd7\xce\x2b\xa8\x7f\xd2\x26\x82\x7f\x07\x15\xb7\xde\xad\x0c\x34\xef\x58\xd2\xe7\x94\x13\x74\xb9\xc0\x07\x3d\x27\x1b\xe3\xbb\x2f\xfb\xe3\x0f\xf5\xb2\x8a\xbe\x83\xe1\x8b\xe8\x7c\x5a\xd9\xaa\xd6\xd2\x84\xf1\xab\x6e\xe5\x61\x08\x68\x5e\x6a\x36\xb6\x69\xf5\xbf\xff\xb7\x48\x6b\x94\xc7\xbc\x4a\xc2\xf8\xcf\xce\x74\xcd\x7e\x67\xca\xb0\x5f\xda\xeb\x3e\x49\xe1\xb2\xfa\x39\x54\x71\x0b\xd5\xda\xb8\xa7\xb1\xb5\xff\x3a\xa2\xfe\x1e\x9c\xfd\x92\x52\x30\x54\xf7\x43\x01\xf7\xc3\xf3\xf1\x71\x32\x16\xcb\xea\xc7\xe3\xaf\xbe\x67\xc5\x9b\xc2\x7e\xed\x9a\x15\xf8\xca\xad\x5f\x34\x79\xb6\xac\x57\xaa\x1e\xdf\x1a\x8a\xfc\xf2\xfa\x5f\xbf\x57\xfa\xf0\xda\xe3\xa7\x15\x44\xf9\xa9\xff\xd7\xa0\xb6\xd0\xbc\x9c\x50\x71\x2d\xd8\x1f\xbf\xfc\xfc\xf5\x2f\xff\x78\xf5\xf5\xb9\x7e\x20\x5b\xfd\x75\xc8\x03\x72\xfc\xed\x59\x0e\x3d\x68\x5b\xa3\x5e\x3c\x9d\x6f\xfe\x24\x53\x52\xb4\x08\x0a\xdb\x69\xd7\xe4\x8e\x77\x1e\xeb\x65\x80\x57\xe7\xa7\x90\xb0\x2e\x82\xb6\x9d\xeb\x82\x48\xd7\x11\x88\xfc\x75\x9a\x67\xb4\xf6\xfa\x43\x4d\x0d\x7d\xaa\x6f\x1e\xff\xf4\x9b\x36\xf7\xb9\x34\x50\x61\xe6\x31\xdb\xb3\x88\xa7\x9b\x7c\x42\xbd\x39\x22\xe0\x97\x6b\x0b\x44\xca\x9e\xc1\xd4\x20\x07\xd0\x10\xa5\x8f\x5c\xb0\x96\x2b\x3b\x39\xa9\x6d\x33\x42\xed\xb1\x78\xd6\x77\x43\x3a\x5a\x21\xa3\xf3\xe5\x48\x7d\x3a\x72\xa1\x6b\xb0\x31\x2d\x3e\x72\xd4\x35\xed\x7a\x75\x5d\x14\xbd\x0b\x5b\x8c\xd6\x05\x18\xae\x26\xe9\x2f\x06\x08\x51\x36\x6d\x79\x4f\x4b\xa0\xcc\x1d\xed\x8c\x31\x3e\x7c\x6a\xa8\x5d\x91\x5d\xac\x41\xd4\x2e\x0a\x0b\x21\x42\x5d\xae\x83\x11\x8e\x8b\x4b\x98\xfa\x77\x6d\x49\xfd\x95\x6b\xfa\x13\x45\xb7\x35\x73\xa9\x92\x7f\xc7\xd2\x6f\x1a\xf9\x9c\xee\x8a\x00\xd9\x30\x18\xaf\xb1\x63\xdf\x96\xae\x9e\xb4\xad\xdd\x53\x69\xe5\x10\x4c\x8b\xa0\xe2\xdf\x9e\x15\xf4\xbe\x64\x28\x21\x9d\x91\x76\xd3\xc9\x0d\xdc\x90\x16\x13\x4f\x56\xbb\x08\x0c\x2c\x49\x50\x4c\xa3\x65\x03\x21\xa0\x76\xd6\xa1\xbd\x02\xaa\xc3\x96\x01\x1e\x0f\x8b\xa4\xc5\x52\xa6\x35\xe8\xa1\x8f\x5d\xdb\x6e\x82\xdd\x4a\xab\xe0\x97\x1f\x8a\x78\x9c\x52\x14\xf6\x2b\x73\x37\x44\xe4\x15\x48\x0f\x5e\x44\xf7\x00\x56\xac\xf5\xf9\x05\x61\x74\xb9\x4a\x66\x71\xe6\xd3\x07\xf3\xe9\x83\xf9\xf4\xc1\x7c\xfa\x60\x3e\x7d\xf0\x61\xa7\x0f\xaa\x4a\x49\xb5\x4d\x5b\x72\xd7\x1e\xc2\xb6\xdc\xf7\x78\x05\x27\x1e\xa5\xd7\x12\x77\x9c\x11\x07\x1c\xf4\x37\xe0\xc2\x8a\xd1\x30\x40\x0d\xf9\xe1\x15\xf8\xb3\x01\xe5\x3c\xd4\xcd\x43\xdd\x3c\xd4\xcd\x43\xdd\x3c\xd4\x7d\xf4\x50\x37\xd8\xea\x4c\x53\xcf\xa6\x7a\x36\xd5\xb3\xa9\x9e\x4d\xf5\x6c\xaa\x3f\xd2\x54\x3b\x0f\x22\x4d\x94\x3d\x0e\x6b\xf8\x37\x34\x55\x96\x16\xa8\x38\x8e\xc8\xa6\xfd\xb6\x87\x33\x33\xe9\xa2\x96\xed\xed\x54\x52\xdb\xfe\xc0\xcc\x6d\x09\xf5\xd0\xad\xc0\x5b\x88\x10\x44\xe7\x2f\xf6\x22\x54\xa1\x83\x9d\x12\xb5\x66\x58\xbb\x0d\xe6\x65\x11\x53\x6d\xa5\x46\xc4\xbb\xb9\x6e\xfd\x08\x5e\xaf\x77\x22\x04\x53\x8a\x95\xed\x70\x1b\x70\xba\x68\x39\x70\x25\xd5\x43\xda\x8d\x60\xf4\xca\x4b\xbf\x2b\x56\x67\x2f\x90\xf8\x73\x7f\x41\xed\x4a\x06\x60\x02\x64\x86\x33\xce\x3d\x74\xf3\x65\x61\x13\x2e\x0b\x0b\x0f\xba\x15\x69\xbf\x9b\xdd\x88\xfe\xca\x7c\x9e\xbb\xbe\xf2\x44\xf7\x50\xc4\x73\xf9\x76\x8f\x18\xb9\x85\x30\xa5\xa0\x4e\x27\x90\x4a\xbd\x3d\x3f\x6b\xbc\x76\xeb\x4a\xf8\xff\x92\x59\xb1\xe7\x3c\xe9\x73\x9e\xf4\xab\xe5\x49\x47\x58\x1b\x04\xab\xf0\x7c\x42\x31\x89\xd0\xc6\x68\xf6\xa0\x31\x71\x8c\xc9\x73\x05\xc7\x12\xc6\xa6\x74\xfe\xdd\x5a\x71\x1e\xa3\xe6\x31\x6a\x1e\xa3\xe6\x31\xea\x7d\xc6\xa8\x3c\xb5\x10\xa4\xc2\xd3\x09\x45\x24\x42\x13\xa3\xc9\x83\xc6\xc4\x11\x26\x4f\x15\x1c\x49\xd8\x5a\x32\x0b\x34\xe4\x4d\x5d\x2e\xa6\xb7\x20\x34\x3a\xbe\x24\xce\xd9\x5f\x5e\xee\x04\x78\xef\x7c\x69\x94\x39\xde\xa7\xd8\x4f\x9d\x63\x73\xfd\x64\x1a\x53\xdb\xa4\x8b\x34\x93\xc0\xb5\x1f\x92\xed\xfe\xa5\xbe\x2d\x96\x8b\xf2\x0e\x85\x4e\x0e\x8a\x12\x6b\x52\x62\x5a\x34\xf2\xb4\x74\x91\x38\xf2\xd0\xf2\x32\xa0\x45\xce\x5f\x17\x34\x01\x0e\x9f\x88\x16\x0d\x4c\xca\x2b\x89\xd7\x28\x31\x01\x2d\x5a\x5c\x7c\x7e\x4a\xbc\xac\x2f\xdb\xaa\x97\x0b\x16\x1f\x09\x5d\x19\x8c\x71\x9e\x98\x32\x13\x5f\x7b\x7a\x8a\x4c\x74\xfd\x46\xbc\xc0\xa4\x57\xac\x65\x2b\xb1\x19\x54\xed\x51\xed\x07\x51\x83\x14\x5b\x32\x09\x7a\x42\x5a\x59\xaa\x72\xa8\x29\x65\xa9\xf8\x13\xd3\xc9\x4e\x2a\x66\x42\x2a\x59\x62\x83\x90\xd3\xc8\x4e\xc1\x27\xb6\x35\x0d\x3c\xb7\x14\x5b\x84\x8e\x4f\x1d\x4b\x05\xbf\x9a\xd4\xf8\x94\xb1\x44\x64\x74\xba\x58\x1a\xd5\x91\x71\x05\x65\xf8\x22\x90\x1a\xad\x03\x2c\x91\x69\x80\x18\x1a\x90\x10\x31\x84\xc5\x03\xb2\x4a\x87\x21\x26\x1a\x0d\x41\x46\x2c\x0d\x51\x04\xec\xe3\xa0\x53\xb7\x33\x90\xdc\x0a\xbc\x4b\x81\x76\x65\x09\x3a\x9b\x10\x32\x91\xd0\xa7\xba\x40\x14\x7b\x41\x71\x7d\x08\xa2\x63\x47\x40\x32\x24\x3e\x84\x22\x81\x13\xfd\x28\x8a\x86\x89\xa1\x14\x49\x6c\x8a\x6f\x46\x91\x19\x1d\x52\xa1\x9d\x7f\x52\xb5\xf0\x23\xd3\x24\xf7\x91\xa2\x89\x29\x6e\x23\xa1\xa6\x23\x66\x60\xd4\x33\x2d\xcc\x2a\x0b\xb4\x68\xba\xa4\x5b\x1c\xb2\x3e\x69\xd6\x67\x22\xfc\x84\xa0\x6b\x8a\xa2\xa8\x81\xd7\x94\x32\x26\x06\x5f\x93\x8b\x9a\x10\x80\x4d\x68\x20\x72\x10\x36\xb5\x0c\x72\xfb\x53\x0b\xc0\x78\x8c\x45\x25\xe0\x03\xb2\x29\x05\x5c\x55\x7a\x7c\x60\x36\x01\x1d\x1d\x9c\xd1\xbb\x02\x21\x40\xa3\x0c\x84\x24\xd2\x13\xf4\x81\x27\x3a\x15\x14\x47\x0f\x22\x2a\x8e\xd0\x14\x50\x76\x29\x71\xc4\x25\x20\xa2\xc8\x8a\xa7\x29\x92\xa0\xb4\x34\xb6\xfd\xd6\x7c\xec\xfa\x5e\x4e\xca\x7d\x52\x99\xfd\x92\x64\x80\x3f\x3a\xb0\x0a\x38\x90\xfb\xeb\x27\x05\xee\xda\x2c\x2c\x5a\x6e\x10\xc7\xa0\x65\x5b\xa5\xf5\xae\x81\xb8\x85\xb7\xf7\x9a\xd2\x5c\xc3\x5b\xbf\x9d\xb7\x81\xe8\xb5\xba\x58\x20\xc2\x55\xc6\x3b\xc9\x43\xce\x8c\xec\x6b\xe8\x4a\xa6\xdf\x74\x1b\x28\x2b\x20\xb7\x79\xce\x93\x60\x2a\x15\xc8\xa2\x20\x69\x41\x39\x6e\xf6\x71\xc6\x1f\xbb\xc3\x24\x11\x24\xf3\x4a\xaa\x6a\xe6\x95\x54\xcf\x05\x83\x66\xf3\x86\x1e\x9b\xf0\xbe\x71\xb5\x5e\x6b\xf0\x25\x06\x4a\x6d\xa5\x17\x60\x95\xab\x33\xe1\x0a\xaa\x55\x5a\x9f\x2e\x09\x04\xa6\xdb\x2b\xe7\x1c\xf5\xd3\x73\xd4\xf7\x23\x7a\xa9\xea\xf0\x76\xfd\x4a\x13\x9f\xdc\x96\x78\xd4\xcb\x07\xd8\xa0\x83\x82\x8a\xb7\xc3\x8d\x95\x78\x2f\x5e\x3e\x6d\x75\x04\xa3\x87\xbc\x7e\x17\x89\x80\x50\x1a\xd6\xb4\x45\x2f\x6d\x48\x13\x4f\x65\xd6\x4d\x76\xd1\xf5\xa7\x61\x95\x0c\xb1\xd4\x65\x4c\x37\xec\xc9\x95\x01\xe1\xbb\xd5\xae\x1c\xac\x9f\xf7\x9a\x8f\xe7\x91\x8f\xe7\xf1\xda\x49\x0b\x4f\x4c\xe7\xfb\xf6\x68\x98\x08\x9f\xa7\xa7\xd4\xb2\x2c\xc5\x4a\xba\x7a\xdf\xd9\x42\x39\xab\x4a\x75\x21\xba\x66\x3f\x0d\x59\x4a\x43\x54\x91\x38\xa6\xe4\x28\xf2\xbe\xd2\xdc\x9e\x7e\xc6\x81\xa4\xc9\x2c\x50\xa0\x4a\x0c\xd2\x5c\x04\x99\xef\xad\x99\xef\xad\x99\xef\xad\x99\xef\xad\x99\xef\xad\xf9\xb0\x7b\x6b\xb2\xaf\x04\xd9\xb4\xe7\xef\x44\xc6\x10\xaa\x3f\x7b\x92\x61\x12\xaa\x81\xf9\xb2\xaf\xb4\xe0\x15\xd8\x88\xba\x71\x3c\x87\xd5\x67\xbe\xe5\xa8\x5e\x02\xba\xd8\x2f\xe9\xce\x38\xae\x06\x08\x16\x20\x5e\x09\xb1\x76\x5d\x2c\x21\xca\x90\x2d\x2d\xbb\x66\x85\x50\x66\x5e\xd8\xae\x71\xc6\x6d\xb4\x2a\x91\x57\x39\x33\xa4\x5c\xe3\xcb\x1d\x7f\x80\xe4\x99\x2d\x1b\x8f\x3b\x1f\x65\x8c\xef\x8f\x24\xb2\xe1\xae\xa5\xd2\x26\xa5\x7e\xe1\x85\x4d\xb9\x40\x99\x21\x0f\xd7\x56\xf1\xe2\xa6\x3b\xa7\x98\x11\xbd\x76\x9e\x5f\xa7\x9d\xd5\x5c\x3a\x35\x6e\x83\x58\x06\x47\x41\x8d\x19\xb3\x95\x8c\xb0\x71\x7e\xc7\x8d\xc7\xd7\x33\xdf\x02\x73\x65\x81\x78\x03\x3b\x86\x5f\xa2\x96\x61\xcb\x05\x9e\x7a\x13\x27\x16\xbb\x52\xb9\xb1\xf8\x04\x4c\x99\xc0\x53\x22\x7a\x69\xad\x8b\xfd\xb5\xe0\x5c\x0d\xbf\x47\x7e\x9d\xd2\x96\x0d\x16\xdb\x3d\x73\x2e\xef\x1e\x8f\x85\x43\x7b\xb0\x7e\xed\x93\x5b\x91\x2f\x06\x9e\x0d\xb1\x75\x35\x27\x96\xd0\xf5\xb5\xdd\x9a\x94\x9e\xc6\xa6\x96\x37\xba\xf0\x38\x3a\x8b\x79\xcf\xcb\xbb\xf5\x2e\xc6\xb2\xf0\xa2\xcf\x4b\x23\x86\x3d\x04\xa2\xdf\x01\x9e\x97\x3a\xe7\x11\xbf\xc2\x6c\xc1\x6b\x57\x8b\xc0\x05\x5b\x7b\xd7\x0a\xe3\x36\xa1\xbc\x77\x0e\x72\x72\x04\x1f\x03\x52\xda\x4c\x13\x45\x0a\x44\xf8\xaa\xfb\x24\xbd\x4d\x3d\xa0\x06\x23\x77\xe5\xb0\x79\x4e\x79\xa9\xe0\xb3\xb3\x11\x9e\x63\x09\xaf\xc6\xf1\xe4\xb6\xe6\x8b\x43\x2b\xad\xd0\x35\x4b\x93\x1f\x61\xdd\x50\x0d\x93\xc1\x04\xb1\x36\x72\x13\x58\x6a\x39\xe0\xe9\x9a\x1d\xec\x66\x74\x96\xe9\x11\x17\x1f\x9f\x47\xdf\x18\xb7\x92\xe6\xef\xef\x98\xc0\x5c\xdb\xb6\x8b\xf8\x72\xfe\x5f\x64\xdb\x93\x93\x9f\x9e\x9e\x99\x97\x8e\x75\xc8\x14\x8f\x91\xb9\x89\x25\xc9\x19\x24\xb8\x8e\x50\x19\x03\xed\x97\x51\x52\x44\xa1\xb6\x1d\xf1\x28\xc4\xe3\x4c\xa2\xfc\x4e\x5c\xc0\x22\x00\xa8\xb5\x38\x88\x9c\x33\xd8\x17\x5c\xc2\x9b\xae\x83\x26\xcc\x08\x3a\xbb\x38\x15\x34\xf4\x35\x9a\x78\x47\x13\xef\x90\x4b\xbc\x78\xa5\x71\x9b\x9e\x4f\xc7\xaa\x10\x92\xbb\xf2\xb1\x6d\xde\x26\x36\xb2\x89\xb0\x19\x4b\x10\xe0\x90\x28\x2e\x49\x2c\x41\x5f\xf3\x8e\x3b\x8f\x83\x96\x90\x94\x61\xe9\xd3\xe1\xeb\xd3\xa4\xe6\xa5\xe5\x17\x25\xa7\xa6\xb8\x65\xe6\x94\xa4\x16\xd1\x21\x88\x0b\x8a\xf2\x93\x72\x52\x73\xe9\x68\x93\x33\xe8\xae\x67\x2b\x2e\xe2\x3b\x3d\x58\x23\x03\x43\x10\xbc\xfd\x21\xc5\x4a\xa1\xa4\xa8\x34\x15\x22\x50\x92\x5f\x04\x9a\xd9\x42\x12\x29\x4d\x2a\x4a\x85\xf4\x67\xe0\x1e\x83\x46\xa9\x42\x75\x2d\x17\x22\x76\x13\x93\x41\xb7\x1e\xa7\xa6\xf8\x21\x4a\xe4\xec\xcc\xbc\x14\x2b\x05\x25\x25\xb0\xb6\x82\x9c\xd2\xa2\xc4\x1c\x28\x37\x39\x3f\x0f\x92\x14\x8b\xad\x14\xa2\x63\xb9\x40\xb7\x46\xe7\x17\xa5\xa6\x84\xc1\xae\xbf\x50\x88\x8e\xe5\x02\x0c\x00\x4c\x3d\x0a\x89\xa4\x09\x01\x00"),
 		},
 		"/logging.banzaicloud.io_clusteroutputs.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "logging.banzaicloud.io_clusteroutputs.yaml",
 			modTime:          time.Time{},
-			uncompressedSize: 351612,
+			uncompressedSize: 553032,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xbd\x5d\x8f\xec\x38\x72\x36\x78\x7f\x7e\x85\xe0\xfb\xea\x9d\x41\x7b\xec\xc1\xb9\x31\x06\xb3\x5e\x78\xe0\xc5\x6c\xc3\xb3\x98\x9b\x17\x2f\x08\x96\x14\x99\xc9\x4e\x26\xa9\x21\xa9\xfa\x68\xc3\xff\xfd\x05\x25\x65\x96\xb2\x52\xfc\x10\x59\x75\x3a\xd1\xe7\x89\xbb\xaa\xa4\x1e\x7e\x28\x18\x8a\x08\x46\x04\xbf\x3c\x3c\x3c\x7c\xe1\xbd\xf8\x3b\x19\x2b\xb4\xfa\xda\xf0\x5e\xd0\x8b\x23\xe5\xff\xb2\x3f\x1c\xff\x68\x7f\x10\xfa\xff\x7a\xfa\xfd\x97\xa3\x50\xdd\xd7\xe6\xcf\x83\x75\xfa\xf4\x5f\x64\xf5\x60\x5a\xfa\xbf\x69\x27\x94\x70\x42\xab\x2f\x27\x72\xbc\xe3\x8e\x7f\xfd\xd2\x34\x5c\x29\xed\xb8\xff\xb7\xf5\x7f\x36\x4d\xab\x95\x33\x5a\x4a\x32\x0f\x7b\x52\x3f\x1c\x87\x47\x7a\x1c\x84\xec\xc8\x8c\xe0\xe7\xae\x9f\x7e\xf7\xc3\x1f\x7e\xf8\xdd\x97\xa6\x69\x0d\x8d\x8f\xff\xff\xe2\x44\xd6\xf1\x53\xff\xb5\x51\x83\x94\x5f\x9a\x46\xf1\x13\x7d\x6d\x5a\x39\x58\x47\x46\x0f\xae\x1f\x9c\xfd\x41\xea\xfd\x5e\xa8\xfd\x0f\x8f\x5c\xfd\xc2\x45\x2b\xf5\xd0\xfd\x20\xf4\x17\xdb\x53\xeb\xfb\xdf\x1b\x3d\xf4\x5f\x9b\x40\xab\x09\xf3\x3c\x50\xee\x68\xaf\x8d\x38\xff\xfd\x70\x7e\xea\x81\x8f\xdd\x37\xcd\xbc\x0c\xd3\x00\xfe\xbf\x71\x00\xe3\xff\xa5\xb0\xee\x3f\x6f\x7f\xfb\x7f\x85\x9d\x7e\xef\xe5\x60\xb8\x7c\x3f\xf4\xf1\x27\x2b\xd4\x7e\x90\xdc\xbc\xfb\xf1\x4b\xd3\xd8\x56\xf7\xf4\xb5\xf9\xab\x1f\x60\xcf\x5b\xea\xbe\x34\xcd\xbc\x5a\xe3\x00\x1f\x1a\xde\x75\xe3\xfa\x73\xf9\x93\x11\xca\x91\xf9\xb3\x96\xc3\x49\x5d\x86\xdf\x91\x6d\x8d\xe8\xdd\xb8\xbe\x7f\xb1\x8d\x3b\x50\x33\xc1\x37\xbc\x75\xe2\x89\xfe\x6d\x6c\xd8\x34\x3f\x5b\xad\x7e\xe2\xee\xf0\xb5\xf9\xc1\x3a\xee\x06\xfb\xc3\xf4\xfb\xfc\xf3\xb4\xee\x7f\x5a\xfe\xcb\xbd\xfa\xb1\x3d\x6a\x2d\x89\xab\xb5\xee\xfe\x3a\x9c\x1e\xc9\x34\x7a\xd7\xf4\x46\x3f\x4a\x3a\xd9\x60\x5f\xe7\x06\x7f\xd6\x83\x72\x57\x5d\xfe\x74\xfd\xe8\xd4\xa9\x9f\xe9\x9e\xcc\x97\xb7\x66\x4f\xbf\xe7\xb2\x3f\xf0\xdf\x4f\x0b\xda\x1e\xe8\xc4\xbf\xce\x8f\xe8\x9e\xd4\x9f\x7e\xfa\xcb\xdf\x7f\xfc\xdb\xd5\xbf\x1b\x3f\xaa\x9e\x8c\xbb\xbc\xec\x89\x16\x7b\x61\xf1\xdf\x73\xcf\xd6\x19\xa1\xf6\x8b\x1f\x46\x7e\xc8\x69\xb8\xdc\x20\xef\x1b\xeb\xc7\x9f\xa9\x75\x8b\x1f\xce\xac\xfb\x46\xeb\x83\x1d\x07\xfc\x6c\xff\x5d\x72\xeb\x44\x6b\x89\x9b\xf6\xf0\xfe\xf7\xd8\xb3\x9e\x1e\x87\xdd\x8e\xcc\xda\x2f\xa9\x27\x3d\xb5\x87\x41\x1d\xd9\x6e\x90\x92\xb9\x83\x21\x7b\xd0\xb2\x0b\xb5\x0d\xae\xcd\x2d\xa0\x14\x27\xe1\x98\xa1\x56\x9b\x2e\xd8\xf7\x1a\x3b\xc4\x01\xad\xf8\x85\xea\x46\xa7\x4f\xbd\x21\x9b\x1c\x52\x14\xa4\x23\xc9\x5f\xa9\x63\xad\x3e\xf9\x41\x39\x71\x22\x3d\xb8\x3a\x48\x61\xf9\xa3\x24\x36\x4d\xf6\x91\xb7\xc7\xa1\x4f\x01\x2e\xb7\xee\x2d\xed\xe4\x60\x0f\x8c\x3b\x66\x0f\x83\xeb\xf4\xb3\xfa\x08\xb8\x51\x44\x3d\x71\x59\x35\xd7\x09\xea\xa4\xbb\xba\x57\x39\xc1\x78\xa6\xe5\x1d\x7b\x1c\x8c\x75\x1f\x39\xbc\x19\xb7\xf5\x02\xad\x8e\x7f\xaf\xf0\x3e\x64\x84\xfa\x89\xcc\x4e\xea\x67\xe6\x45\xfc\x7b\x39\xb7\x11\xab\xf7\x72\xbc\x06\xe0\x1f\x03\x0d\x34\x6f\x4f\x49\x6a\x9f\x86\x8b\x2f\xd7\x88\xd7\x4d\x1b\xc1\x6e\xd8\xf6\x71\x54\x43\xce\xbc\x32\x7a\xe9\xb5\x22\xe5\x04\x97\xe3\x1e\xd3\xbb\x1d\x7b\xe4\xb6\x8e\x0f\x27\xe8\x9d\x36\xf4\x14\x12\xc3\x4d\xe6\x26\x9b\xa0\x4e\xfc\xe5\x63\xf8\xe4\x0d\xce\x8b\xa8\x4a\x31\x3c\x81\x19\xae\x3a\x7d\xca\x78\x1d\x39\x13\xb5\xd4\x6a\xd5\x71\xf3\xfa\x41\x9f\x9e\x09\xf5\x23\xc4\xf1\x8c\xe4\x1b\xd6\xc3\x3c\x73\x51\x37\x1a\xc7\xf7\x75\x1f\x2c\xbf\x24\x47\x7a\xfd\x08\x0c\x36\x58\x62\x83\x6b\xeb\xde\xff\x19\xac\x7e\x69\x66\xa0\x5f\xb4\xaa\x7b\x55\x4e\x3b\x2e\x3f\x4a\xcb\xa8\x64\x9c\x80\x52\x79\x26\x52\x5d\xaf\x45\xe8\xb3\x94\x56\xf9\x78\xdb\x92\xb5\xcc\x2f\x9b\x88\x6c\xb8\x34\x90\xa7\x93\xff\x40\xfe\x3f\x46\x9f\x62\x8d\x72\xc1\x3c\x59\x6a\x0d\xb9\xff\xa4\xd7\xff\xa2\x5d\xaa\xed\x16\x5c\x4f\xd1\x3d\xb0\xa4\x8c\x77\xbc\xa4\xd1\x86\xf9\x0c\x60\xdd\x4f\xc6\xe1\x16\xf0\xf8\xce\x7b\x23\x43\xff\x18\x84\xa1\x08\x07\x9c\xe9\xc1\x2f\x5c\xb2\x55\x82\x6b\x37\x37\x7c\xe2\x72\x48\xac\x6a\xe6\x6a\x8e\x48\xe0\x51\xf0\xe8\x07\xf3\x68\x46\x23\x6e\xed\x70\x22\x66\xb4\x24\xc6\x4d\x44\x55\x87\xb4\x05\x27\x4f\x04\x69\xfb\x8e\xc0\xa3\x0b\xfa\x7e\x79\x74\xa3\xb4\xb5\x64\xad\xd0\x8a\xc5\xdf\x04\xc4\x2e\x58\x7a\x22\x88\xdd\x77\x04\x1e\x5d\xd0\xf7\xcb\xa3\x1b\xc5\xee\x33\x3d\x32\xd1\x91\x72\xc2\xbd\x32\xa7\x8f\xa4\xd8\x4e\x48\x48\xe0\x55\x02\x77\x2f\x09\x12\xf8\x1d\x81\x47\x17\xf4\xfd\xf2\x68\x46\x23\x6a\x2d\x6b\xb5\x72\x5c\x28\x32\xac\x35\xd4\x4d\xa7\x5c\x96\x19\x92\xdc\x89\x27\x62\x83\x11\x10\xc2\x6b\x04\x06\x5f\x12\x84\xf0\x3b\x02\x8f\x2e\xe8\xfb\xe5\xd1\x8c\x46\x86\xf6\xb5\xd1\x18\x13\xb3\xb1\xb7\x13\x3a\x88\xec\x35\xc2\x76\x58\x12\x44\xf6\x3b\x02\x8f\x2e\xe8\xfb\xe5\xd1\x8c\x46\xd6\xd9\x77\xda\x72\x5c\x84\x43\xe8\x82\xa1\x27\x82\xd0\x7d\x47\xe0\xd1\x05\x7d\xbf\x3c\x9a\xd1\x68\x30\x35\x61\xad\x89\x0e\x72\xe2\xd4\x13\x7d\xec\xb4\x39\xf1\xf2\xe8\xba\xae\x63\x8a\x9e\xa5\x48\x07\x24\xc6\xdf\xf8\x89\xac\xe5\x7b\x8a\x5b\x01\xd5\xc1\x88\xa4\x86\x88\x08\x78\x68\xf4\xe0\x46\x07\x7e\xa4\xc9\xcf\x56\x87\xb9\xf6\xa1\x91\xce\x3e\x45\x7e\x6e\xa3\xbf\x9e\xec\xbe\xe7\xed\x31\xd2\xe2\xc0\xed\x21\xf2\xb3\x15\x6a\x2f\x89\x8d\xe2\xee\xd3\x98\x4e\xa8\x56\x0e\x1d\x31\xc7\xf7\xe1\xd7\x95\x7a\xe7\x52\xef\xad\xe3\xf6\xc0\x62\xfc\x97\x0d\xd2\x1b\xda\x89\x97\x22\xfe\xcf\x98\x45\xe0\xd9\xc8\x32\xf1\x5f\x06\x43\xd6\x69\xc3\xf7\x2b\xdc\x18\xdf\x56\x7c\x70\x9a\x8d\x39\x85\xf4\xe6\xe5\x2c\x5b\x9f\x71\x18\x79\x20\xc1\xf5\x99\x30\xc4\xa9\xb3\x8c\xf7\x82\x3d\xad\x65\x9b\x6d\x82\x9a\x16\x6c\x0c\xc8\x4d\xbf\xfb\x04\xd6\xbc\xc4\x49\x17\x42\x5a\x90\x65\x68\xb1\x79\x5f\xf1\x7c\xcd\x20\x5f\x2b\xc8\xd2\x08\x36\x7c\xb4\xf3\x34\x81\x0d\x80\xf9\x1a\x40\xfe\xd7\x3f\xef\xcb\x9f\xfe\xea\x7f\xd4\x87\x3c\xa1\x8d\x66\xac\x56\x86\x16\x0a\x1e\x0b\xd2\x6f\x9f\xc7\x12\x0d\x6e\x44\x5e\x38\x5b\x0f\xf2\xee\x8a\xc0\x8b\x05\x8d\x20\xef\xc0\x63\xf1\x1e\xbf\xa9\xbc\xb3\xdc\x4e\x11\x56\x90\x78\xe0\xc6\xb5\x1e\x21\xf1\xc0\x63\xb7\x74\x5f\x3c\x96\x68\x80\xe2\x26\x5b\x46\x87\xe2\x26\x35\x70\x28\x6e\xb2\x06\x87\xe2\x26\x28\x6e\x82\xe2\x26\xdb\x27\x8a\xe2\x26\x99\xc7\x53\x28\x6e\x82\xe2\x26\x6f\x54\x71\x00\x12\x96\xf6\xd1\x07\xc3\xfa\xf0\xc3\xfb\xe3\xa2\x60\x8b\x77\x2e\xc8\x9b\x76\x91\x49\x8f\x85\x2c\x9f\xb9\xdb\x5e\xf8\x6f\x79\x2c\x66\x9d\x21\x1e\xb0\xb1\x92\x67\x62\xcf\x96\x09\x65\x1d\x57\x2d\xb1\xde\xe8\x9d\xf0\xba\xda\x55\x54\x94\x33\x41\x2d\x3b\x25\x5e\x3d\x7a\xac\xd4\x0c\xdc\x14\x57\x04\x13\xb2\xa0\x11\xdc\x14\xe0\xb1\x78\x8f\x9f\xed\x98\x7d\xb6\x5e\xe1\xc3\x71\x3b\x38\x30\xd4\x23\xa4\x1c\x78\xec\x96\xee\x8b\xc7\x72\xa4\x9c\xb3\x89\x6a\x56\xa9\x30\xa5\x19\x24\x5d\x9f\x25\x03\xc8\xdb\x6b\xd6\x45\x35\xd3\xf0\x5b\x80\x6f\x79\xcb\xe8\xe0\x5b\xae\x81\x83\x6f\x79\x0d\x0e\xbe\x65\xf8\x96\xe1\x5b\xde\x3e\x51\xf8\x96\xe1\x5b\x86\x6f\x39\xd4\x20\xa8\xbf\xb6\x5a\xb5\x83\x31\xa4\xda\x68\x72\x43\x78\x3b\xc7\x2b\x6f\x27\x86\x87\xb4\xa2\x0b\x21\xad\x68\xd9\x20\xc8\xaf\x07\xe7\x7a\xd6\x1b\xfd\x52\x92\x8b\xb3\xc8\x4a\x12\xa7\xc8\xeb\x4e\xf1\x8c\x7f\x0d\xec\xc0\x55\x27\x0b\xd3\x65\xa4\x6e\xb9\xf4\x63\x28\xeb\x5f\xea\x3d\x1b\x6f\x00\x63\xde\xe0\x0b\x4b\xf4\xe4\x28\xde\xc3\x14\xe6\x38\x2d\xa1\x8a\x6d\xd7\x6b\x88\xaa\x91\x18\xf2\xdc\x43\x1d\x33\xf4\x8f\x81\x6c\x99\x6c\xf2\x40\xd3\x11\x52\xdd\x9c\x16\x18\xc5\x93\xf2\x6a\x17\x3d\x91\x72\x96\xf5\x64\xd8\xe3\xfa\xd1\x58\x93\x21\xae\x3d\xd2\x59\xdc\xc5\x34\xec\x24\xce\x9b\xc8\x2c\x63\xbe\x7e\x70\xcc\x2f\xcf\x3c\xad\xb3\xcd\x3a\x69\x39\xe3\xa7\xb3\x6c\x6f\xbc\xc3\xcd\xc4\x0b\x4f\x74\x15\x2f\xac\x6a\x24\x66\x1d\x2b\xa6\x90\x7c\xf4\xa4\x9f\x88\x7d\xe0\xa6\xbd\x41\xac\xe2\xd1\x05\xda\x47\xb0\xfc\x0c\x67\xc8\x79\x1b\x4b\x2b\x26\x14\xeb\x78\x21\xb3\x7d\x12\x4a\xf1\xe4\xbc\xde\xeb\xf8\x9e\x71\x3b\xad\x7c\x19\xab\x2f\x50\xca\xcf\xba\x63\x47\xfd\x13\xb7\xde\xfc\x10\xf9\x44\x77\xdc\xf1\x4e\xef\x37\x1f\xdb\xf7\x02\xe7\x44\xf0\xe1\x87\x7a\xc4\x39\x11\x78\xec\x96\xee\x8b\xc7\x12\x0d\x70\xb0\xb2\x65\x74\x38\x58\xa9\x81\xc3\xc1\xca\x1a\x1c\x0e\x56\x70\xb0\x82\x83\x95\xed\x13\xc5\xc1\x0a\x0e\x56\x70\xb0\x12\x6a\x10\x39\x58\x99\x74\x18\xa1\x15\x93\xf4\x44\x65\x75\xd7\xba\x8e\x1d\xb4\x75\xc5\x0e\xc8\xae\x63\x56\x0f\xa6\xad\x7c\xba\xe5\x8e\xf6\xda\x94\x39\x3b\xba\xae\xdc\x45\xed\x27\x5f\xe7\xe8\xaf\x29\x3f\xe6\x85\xf2\xfc\x05\x2a\x76\xdb\x66\x24\x0a\x04\x9f\x57\x9a\x59\x2b\xd9\x13\x97\xa2\xe3\x61\x25\x22\xe9\x12\xd5\xa6\x6c\x11\x2d\x99\x27\x51\xc8\x3b\x7e\xe0\xc5\x1d\x97\x97\x5b\x9b\x84\x8d\x75\xfc\xd4\x57\x79\xe7\x16\xdb\xb7\xdc\x39\x77\x70\xae\xc2\xb5\xf7\xb3\xad\xe9\xdb\xda\xa8\xc4\x29\x71\x07\xce\x5e\xba\x9b\x5f\x22\x92\x90\x24\xb7\x4e\xb4\x96\xb8\x29\x48\xe6\x81\x57\x10\x1e\x9b\x70\x8f\xf0\x0a\x82\xc7\x6e\xe9\xbe\x78\x2c\xd1\x80\xf7\xbd\x14\xed\xf8\x69\x2f\x3f\x64\x86\x6b\x71\xcb\xe8\xe0\x5a\xac\x81\x83\x6b\x71\x0d\x0e\xae\x45\xb8\x16\xe1\x5a\xdc\x3e\x51\xb8\x16\xe1\x5a\x84\x6b\x31\xd4\x20\x72\x96\x2c\x8f\x97\x20\xbe\x39\xc4\x31\xb5\x83\x12\x7d\xb6\x3c\x72\x25\x31\x4c\xed\x2b\x82\x19\x54\xd0\x08\xa6\x36\x78\x2c\xde\xe3\x27\x9b\xda\xad\x14\xa4\x1c\x6b\x29\xe4\x92\x86\x94\xbb\x22\x70\x60\x41\x23\x48\x39\xf0\x58\xbc\xc7\x6f\x23\xe5\x70\x6a\x02\x06\x0c\xf4\x08\x21\x07\x1e\xbb\xa5\xfb\xe2\xb1\x6c\x21\xc7\x7a\x1e\x72\xe5\x43\xd2\x5d\x11\xb8\xb0\xa0\x11\x24\x1d\x78\x2c\xde\xe3\x67\x4b\x3a\xad\x9c\x17\x75\x61\x7f\x62\xca\xaf\x37\x58\xa7\x4f\xec\x40\xbc\x23\x53\x16\x84\x36\x41\x88\x5f\x88\x39\x3a\xf5\x92\xbb\xc2\x58\x42\xee\xf8\x39\x31\x94\x14\x7f\x0c\x39\x1b\x53\x6f\x72\x89\x53\x1e\x16\x49\x3b\x3e\x48\xc7\xae\xc2\x93\xaa\x6e\xad\xec\x68\x27\xa9\x75\xda\x30\x2e\x05\x2f\x5b\xe9\x69\x59\x98\x90\x85\x45\x8b\xe9\xa5\xa5\x71\xc7\x44\xcf\x8f\x53\x28\x3b\x2e\x24\xd3\x8a\xf5\x83\x73\x42\xed\x2f\x6f\x9d\x9d\x8f\xd0\x5a\x0a\xed\x9e\x24\xb4\xe4\xce\x91\x62\x07\x6e\x0f\xf1\x90\xc8\x5c\x0c\x66\xa9\xe7\x86\x3b\x5d\x56\x87\xa1\x38\xbc\xd4\x3f\x58\x18\xd1\xea\x5c\x3f\xbe\x1f\x52\x65\xae\x7a\xd1\x15\x07\x36\x8a\xbd\xd2\x86\xd8\x85\x4f\xca\x66\x20\xe4\x89\xf5\x5a\x8a\x78\xc5\x98\x8c\xc7\x83\xf5\xaf\xf3\x11\xf4\x13\x99\x67\x23\xe2\x32\x29\xcc\x4c\xe7\x28\x61\xa1\x3a\x7a\x61\x42\xb1\xe0\x3d\xdc\xb9\x48\x55\xf1\xc6\xcb\xea\x24\x63\x08\x6b\x29\x8c\x9f\x4d\xe7\xf7\x6c\xef\x37\x4b\x61\x55\xca\x09\xa6\x58\xc6\x4e\x8f\x57\xdc\xb9\x3c\x56\x5d\xb0\xec\x9f\x7f\xf7\x3b\x66\x88\x17\x07\xc3\x5e\xae\x7f\xf6\x0b\x52\x51\x4a\xff\x6e\xee\xa2\x7e\x87\x51\x29\x02\xcf\x31\xea\xaf\x6c\x4f\xce\xaf\x77\xcd\x57\xf0\x0d\xec\xfd\xd7\xa3\x08\xce\x5b\x77\xcf\xda\xa0\x4a\x3e\xb4\xef\xd5\x1e\x61\xe1\x81\xc7\x6e\xe9\xbe\x78\x2c\xd1\xa0\xf0\x82\x96\xa6\xe9\x45\x4f\xe1\xca\x77\xa9\x87\x13\x89\x39\x91\x72\x48\x86\x76\x64\x98\xfe\x99\x59\x32\x82\x4b\xf1\x4b\xbc\x04\x5a\xf8\x85\x19\x6a\xb5\x52\xd4\x3a\x6f\x6c\x90\x31\xf1\xef\x57\x0c\x47\x6a\xde\x31\xbe\x73\x85\xc5\xd8\x66\x80\x79\x34\x29\xb5\x38\x39\x10\xad\x98\x37\xa1\x06\x53\xa8\x93\xce\x85\x8f\x8e\xf4\x6a\x3d\xd6\xd0\x77\xa5\x9f\xcf\x55\xa4\x8a\x82\x4c\x73\xa0\x51\x2c\xbc\x2e\x89\x61\x07\x63\xfc\x3b\xaf\x79\x5d\x63\x68\x1e\x5f\x29\x32\x94\xf3\xb4\x96\xd2\x1b\x0d\x93\xca\x5f\xf8\x86\xf4\x30\xea\x36\xa5\x2b\x69\xdb\x03\x15\x2a\xd5\x56\x89\x9d\xdf\x7f\xad\xe4\xd6\x96\xeb\xe6\xd6\xca\x31\x2c\xb4\x46\xd7\x1b\x31\x84\xaa\xc6\x78\x22\x23\x76\x85\x06\xd3\xfc\x7c\x79\xff\x43\x3f\x66\x03\xb0\x4e\xb7\xec\xd9\xf0\x42\x83\xeb\x02\xe3\x1b\x26\xdf\x4a\x18\xa7\x2a\xed\x91\x1b\xaf\xc0\x4f\x56\x57\x25\xc8\x38\x8d\x62\x8c\xb3\xbf\x08\x01\x85\xd0\xaa\x42\x3d\x42\x73\x07\x8f\xdd\xd2\x7d\xf1\x58\xa2\xc1\x45\xce\x15\x7f\x85\x2f\x08\x95\x5e\xcc\x33\x4e\x99\x3b\x37\xa7\x14\x72\xf2\xe1\x0c\xa7\x58\x1c\xa3\xe7\xc6\xd2\x64\x06\x14\xeb\x76\x13\x90\xa1\x56\x14\x2b\x04\x59\x1f\xf0\x70\xe1\x00\xe5\x8d\x9a\x27\x32\xe3\x39\xce\x3c\x99\xd7\xbe\xf0\xc5\x0c\xb6\x50\x43\x1e\x5c\x5b\xa3\xde\xce\xe5\x26\x88\xcd\x71\x16\x19\x0a\x56\x04\x6c\xd4\xee\x16\x7e\xc5\x31\x23\xd1\x71\xe3\x4a\xcf\xa7\x9e\x85\x3b\x30\x67\xb8\xb2\xde\x8a\x25\xc3\xe4\x5a\xbd\xd1\x2c\x24\xbf\xe5\x98\x97\xc8\xc9\xe2\x1a\x81\xb5\x8e\x88\x88\xe9\x30\xaf\xfb\x2b\x3f\x91\xed\x79\xbb\xc6\x03\xc2\xd1\x69\x95\x35\x32\xfa\xe4\xc6\xf0\xf7\x42\x70\x5d\xe1\x4a\xd4\x58\xe8\x3a\xd6\x73\x77\x60\x76\xd8\x25\x1c\xd2\x91\xdb\x52\xfb\x3e\x71\x9a\x85\x1b\xa7\x90\xbd\xfc\x0e\x10\xd9\xcb\xc8\x5e\x46\xf6\x72\x1e\x2a\xb2\x97\x91\xbd\xbc\x1d\x29\x18\x34\xb6\x09\x06\xd9\xcb\x41\x30\x64\x2f\x87\x1b\x04\xed\x66\xdc\xf9\x74\x21\xdc\xf9\xb4\x6c\xf0\x09\x67\xa4\x79\x86\x4d\xf8\x78\xe0\xf5\x24\x85\x3a\xb2\xd4\x00\x4a\x8a\xe9\x79\xcc\x2d\xf6\xe4\x4e\x9b\x67\xbe\x16\x0a\x93\xb0\xef\xda\x23\x33\x64\x7b\xad\x2c\xe5\x1c\xdd\x85\xbf\xa2\x30\xd4\xb6\x8c\x0e\x86\x5a\x0d\x1c\x0c\xb5\x35\x38\x18\x6a\x30\xd4\x60\xa8\x6d\x9f\x28\x0c\x35\x18\x6a\x30\xd4\x42\x0d\x62\xc9\x67\x63\x78\x5e\x95\xd6\xd8\x29\xcb\x8c\x1e\x54\xc7\x8c\x7e\x14\x85\x87\x48\xf4\xd2\x0b\x43\xcc\x63\xb5\xbc\x3d\x44\x0f\xe7\xc2\x43\x39\x70\xd3\xd5\x4d\xe6\x40\xdc\xb8\x47\xe2\x29\x0d\x20\x1f\x27\xfc\x06\x13\x6f\x6f\x4e\xe5\x51\xe4\x9e\xb5\x39\x4e\x67\x8d\xb6\xfa\x6c\xed\x48\xd4\x73\x29\x9e\x0a\x0f\xa2\x2f\x8f\xd7\x2d\x73\x7f\x10\xe7\x10\x4a\xd6\x91\x1b\xd3\xea\xca\x06\xe4\x91\xb2\x0a\xa3\x85\x07\x33\x9f\xe8\x26\xaf\xfc\x8c\x21\x8c\x56\x20\x5b\x9a\x62\x65\xd3\xb1\xd4\x0e\x46\xb8\xe2\xda\x1e\x5c\x8e\xda\x9c\xd2\xea\xf5\xa4\x07\x1b\xbd\x1a\x22\x67\x3c\xd3\x98\xe4\x2e\x71\x47\x45\x93\x27\xcd\xec\x81\x1b\x8a\x24\xb7\x65\xc2\x0c\x96\x0c\xe3\x43\x5a\x3f\x8c\xcd\x2b\x1e\x89\xf2\x70\x3d\xeb\x50\x9b\xcb\x7c\x4a\xa4\xaf\x25\x55\x29\xad\x2c\x99\xa7\x60\xd2\x6f\xf0\xec\xb9\xc9\x0c\x53\x0a\xa7\x4e\x2e\x47\x97\x08\x25\x4a\x45\x25\x65\x81\xc4\xb3\x84\xe6\x36\x99\x81\x57\x19\x71\x87\xdb\x00\x9b\x4d\xf1\x61\xdb\xb1\x9b\xdc\x58\xb1\x89\xb2\x16\x74\x49\x79\x71\x63\x85\xe0\xf9\x31\x64\xcb\x0e\x72\x22\xc9\x26\xca\x8b\x27\x9b\x28\x1d\x55\xb6\x1c\x43\x22\xb6\x6c\x63\xd3\x44\x2c\xe3\x12\x2d\x63\x75\x33\xe2\x1a\x27\x02\x0f\x7f\x00\x38\x78\x38\xbb\x59\x38\xeb\x68\x09\x12\x77\x0f\xe4\xa9\x09\x10\xf8\x67\xc2\x66\x09\x11\x04\x7e\x94\xc0\xc3\x01\x02\x0f\x67\x37\xb3\x8e\xab\xee\x31\xfa\x9e\xf3\x56\xc7\xdb\x74\xa9\x97\x0a\x89\x3f\x11\x76\x4b\x88\x20\xf1\xa3\x04\x1e\x0e\x10\x78\x38\xbb\xd9\x33\x89\xfd\xa1\x5a\xc9\x4f\x2d\xc8\xc3\xe8\x7d\x0a\xfc\x98\x1c\xe7\xd4\x60\x2d\x81\x62\xfc\x55\x5a\x36\xf9\x49\x47\x1f\x9f\x15\x7b\x45\x5d\xa4\x6c\x7c\xea\x65\x7b\x3c\xff\x34\x93\x7a\x2f\x5a\x2e\x99\x75\xa3\xe3\xbe\x38\x69\xec\x8c\x17\x3e\x51\x47\x7a\xed\x15\x21\xf5\xb1\xa0\x11\xd2\x6b\xc1\x63\xf1\x1e\x3f\x3b\xbd\xf6\x2c\xe7\xdc\x61\x38\x3d\xf6\x46\x84\xe2\xa3\x72\xe5\xe5\x60\x89\x91\x72\x64\x7a\x23\x2c\x4d\x62\xb8\x42\xa4\x8b\xfe\x50\x5a\x51\x75\x7c\xfe\xed\x3a\x12\x48\x72\xec\xb2\x60\x8f\x90\xe4\xe0\xb1\x5b\xba\x2f\x1e\xcb\x91\xe4\x93\xb8\xeb\x8d\x78\x9a\x6b\x50\x8d\xa5\xfb\xfb\x83\x09\x86\x45\x42\xf6\x5d\x11\xf8\xb2\xa0\x11\x64\x1f\x78\x2c\xde\xe3\xaf\x25\xfb\xa0\xf1\x81\x23\xd7\x7b\x84\xd4\x03\x8f\xdd\xd2\x7d\xf1\x58\x86\xd4\x13\x6a\x8c\x56\xa5\x48\x02\x56\x8e\x9d\x3d\xd7\x0f\x8a\x07\x98\x66\x02\x15\x57\x85\x9a\x07\xf1\x56\x21\xb6\x32\xd0\x3a\x96\x17\x3a\x47\x6d\xde\xfc\x12\x59\xf2\x7d\xbb\xb2\x67\x52\x69\xa1\xd1\xd0\xf5\xe0\x4a\xf0\xc1\x69\xd6\x1a\xf2\x1f\xb2\xc7\xa1\x3d\x52\xa1\x57\x3c\xfd\x6c\x70\x08\xc8\x43\xdd\x32\x3a\xe4\xa1\xd6\xc0\x21\x0f\x75\x0d\x0e\x79\xa8\xc8\x43\x45\x1e\xea\xf6\x89\x22\x0f\x35\x0f\x06\x79\xa8\xc8\x43\x5d\xd0\xec\x3f\xf1\xcc\x15\x54\xec\x52\x3b\x7a\xc6\xa8\xca\xa7\x6a\x0d\x75\x93\x30\xb5\xec\xe7\xe0\x75\x54\x70\xe0\x5c\x11\x8c\xeb\x82\x46\x70\xe0\x80\xc7\xe2\x3d\x7e\xb2\x03\x87\x54\x6b\x5e\xa7\xab\x44\x4b\x2b\x83\xa3\xcc\xdb\x85\x50\xe6\x6d\xd9\x20\xc8\x74\x07\x7a\x99\xf5\xed\xa8\x61\x95\xfa\x4c\x1f\xe9\x35\x7c\xf3\x48\x62\x8c\xd3\xd8\x6a\x0b\xda\xcf\x28\x27\x72\xbc\xe3\x8e\xaf\x63\x54\x27\x60\x27\xa4\x68\x96\xb8\x4b\x46\xa5\x67\x29\xfd\xa9\x60\xdd\xb0\x48\x7b\x68\x62\x2c\x55\x19\xc9\x5b\x79\xab\x42\x79\xd5\x41\xa3\xfd\x88\x8b\x9e\xb5\x4e\x1b\x2f\xae\xc6\xfb\x9d\x8a\x11\x88\x15\xde\x40\x3d\x96\xd5\x6f\x75\x27\x54\x51\x49\xfd\x98\x5f\x7b\xf2\xf8\xae\xfc\x30\x2f\xd7\xcd\x2f\x31\x87\x37\xc9\x15\x65\x24\xbe\x69\xe0\x36\xde\x32\x3a\xb8\x8d\x6b\xe0\xe0\x36\x5e\x83\x83\xdb\x18\x6e\x63\xb8\x8d\xb7\x4f\x14\x6e\x63\xb8\x8d\xe1\x36\x0e\x35\x08\x9b\x93\xc1\x02\x55\x29\xfd\xbd\xe2\x72\x64\xed\x74\xab\xcb\x42\x2b\x9c\x2c\xbc\x77\xd8\x49\xcb\x26\x8f\x55\x00\x80\x77\x9d\x98\x1c\x5a\x3f\x25\x15\xdd\xba\x55\x8f\x19\x00\xab\x29\x9b\x0f\xe3\x6a\x6f\xd1\xfd\x0f\xce\xad\xa8\x7c\x89\x68\x97\x60\x35\xb8\xb4\xe6\x9f\xae\x2e\x96\xe7\xf8\xcc\x2c\x3b\x90\xef\x45\xdd\x96\xae\xbd\x2d\x59\x3b\x3b\x55\x7b\x83\x53\xb5\xd9\x94\xa6\xbd\x11\x78\x5b\x8a\x76\xbe\xd7\xb6\xd9\x94\x9e\x9d\x97\x9c\x9d\xe5\xc1\xdd\xd0\x30\xa3\xb4\x40\xe6\x6a\x66\x96\x15\x00\x8f\x16\x01\x7f\xbf\x3c\x9a\xd1\x28\x5d\xe1\x05\x62\x16\x2c\x3c\x11\xc4\xec\x3b\x02\x8f\x2e\xe8\xfb\xe5\xd1\x64\xa3\x54\x55\xe3\xb3\xae\x1b\xf8\xf9\x2c\xa3\x4b\xac\x31\xb8\xdf\xb7\x8c\x0e\xee\xf7\x1a\x38\xb8\xdf\xd7\xe0\xe0\x7e\x87\xfb\x1d\xee\xf7\xed\x13\x85\xfb\x1d\xee\x77\xb8\xdf\x43\x0d\x62\xb7\x07\xb9\x31\xe4\x3a\xd8\x4b\xa2\x07\x52\x5d\xaf\x4b\xab\x3e\x8d\xf7\xe1\xbc\x5d\x7c\xc9\x2d\x1b\xd4\x7c\x93\x8b\xd7\x60\xca\x1c\xed\x08\x69\xbc\x10\x42\x1a\x97\x0d\x22\x21\x8d\xbc\x0b\x56\x09\xfb\x76\xc7\x32\xd3\xa9\x09\x3b\x91\x3b\xe8\xe8\x35\x48\xc1\x0e\xfc\xab\x64\x63\xa0\x5d\xd9\xd6\xd1\x3d\xa9\xca\x4b\xa1\x8c\x7e\x29\x8b\x44\x1e\x55\xda\xaa\xbe\xc7\x2f\xe4\x68\xf9\x5c\x24\x4a\xab\xbb\xd0\xdb\x8a\x46\x76\xa6\x95\x98\x78\x50\xa3\xb5\xb2\x6e\x2e\x63\x45\x12\x8e\xc2\x73\x9b\xfc\x4a\xc8\x30\x28\x68\x84\x2c\x16\xf0\x58\xbc\xc7\x6f\x51\x7c\x09\x75\x3a\xc1\xca\xab\x74\x5f\xac\x0c\x71\xd9\x80\xc7\x12\x3d\x7e\x03\x71\x89\x02\x9d\xd7\x04\x86\x8c\xf5\x08\xa1\x07\x1e\xbb\xa5\xfb\xe2\xb1\xcd\x42\x0f\x3a\x1e\x58\x71\xbd\x47\x88\x3b\xf0\xd8\x2d\xdd\x17\x8f\x65\x88\xbb\xb9\x9a\x65\xaa\x2e\x67\xd4\x2c\x2e\xaf\xa6\x19\x4b\x11\x38\x1f\xf9\xdc\xfc\x14\x99\xd5\x91\xef\x8e\x2b\x29\xf6\xa9\xf2\x97\xc7\x3a\x2f\xea\xa3\xd1\xc7\x52\xb7\x02\x42\xa1\xb6\x8c\x0e\xa1\x50\x35\x70\x08\x85\x5a\x83\x43\x28\x14\x42\xa1\x10\x0a\xb5\x7d\xa2\x08\x85\x42\x28\x14\x42\xa1\x42\x0d\x52\x05\x2c\x45\x59\xe8\xc5\x59\x03\x12\x5a\x8d\x51\x07\x81\x37\x99\x40\xe9\x68\xc7\x07\xe9\x58\x32\x78\x28\x13\xa7\xe7\xc6\x89\xaa\x02\x71\x67\x24\xa7\x7b\x51\x38\x27\x61\x5b\x6e\x3a\x36\x9a\x00\xac\x23\x29\x9e\xc8\x4b\x76\x2e\x64\xc8\x1c\x4b\xf1\x3a\xbd\xb4\x72\xe8\x68\x9a\x5e\x7a\x72\x69\xa0\x71\x76\xe5\x30\x08\x39\xbb\x10\x42\xce\x96\x0d\x82\xc2\x66\x4f\x6e\xde\x10\xb3\xd8\x91\xba\xa8\x96\xd7\x3d\x05\xaf\x4d\x03\x61\x3b\xa3\x4f\xb3\x89\xfa\xeb\x0f\x4a\x74\x74\xea\xb5\xa3\x78\x80\x6a\x78\x75\xa7\x77\xc4\xf7\xfb\x51\xdf\x7b\x7c\x75\xa5\xa5\x8d\xaf\x81\xe6\x9d\x5a\x88\xe5\x11\x2c\xa9\xae\xae\xd8\xf2\x42\x5a\x14\x7f\x1d\xea\xbf\x2f\x57\x08\x15\x28\xb1\xf2\x0f\x38\x13\xb8\x22\xf8\x6b\x0b\x1a\xe1\x4c\x00\x3c\x16\xef\xf1\x93\xcf\x04\xce\x83\x65\xbc\x3d\x16\x4a\x7c\xcb\xad\x64\xfa\x89\x0c\xb3\x36\x5a\xfd\x28\xbc\x78\xb6\x35\xfc\xc4\x4e\xd4\x1e\xb8\x12\x36\xc0\xca\x89\xd7\x6a\xad\x3c\xc7\x36\x43\x5e\x63\x2f\xad\xf5\x08\x79\x0d\x1e\xbb\xa5\xfb\xe2\xb1\x44\x83\x85\x94\x9b\x6d\x22\xfb\x6a\x1d\x45\x45\x66\x44\xee\x7a\xb4\xb7\x20\x67\xc8\x4d\xf0\xf4\x5a\x8f\x90\x9b\xe0\xb1\x5b\xba\x2f\x1e\xcb\x91\x9b\x8b\x74\x8e\xf6\xc0\x05\x2e\x71\x02\x2f\xae\xf6\x08\x79\x07\x1e\xbb\xa5\xfb\xe2\xb1\x8d\xf2\x2e\xf8\xc6\x20\xed\xae\x08\x9c\x58\xd0\x08\xd2\x0e\x3c\x16\xef\xf1\x1b\x48\xbb\x39\xb2\xf9\xa0\xad\x0b\x2f\x70\x6a\xfe\x59\x27\xf6\xc1\x77\x32\x58\x62\xe7\xc8\x86\x9d\x36\x6c\x50\x47\xa5\x9f\x55\x3a\xca\x21\x3c\xa0\x78\x69\x5c\x08\xef\x2b\xc2\xc6\x2a\x68\x04\xe1\x0d\x1e\x8b\xf7\xf8\xa9\xc2\x3b\x7a\x6d\xdc\x94\x68\xb1\xf2\xcb\x14\x1a\x75\xf3\x43\xa4\xa7\xa3\x50\x64\x85\xfd\x9b\x33\xc4\x57\x18\x35\x91\x32\x62\xed\x70\x22\x66\xb4\x24\xb6\xb8\x09\xba\x54\x28\x77\x83\xe1\x63\x70\xc2\x14\x53\x5b\x17\x2e\x4a\x2f\xce\x7f\x22\x64\x30\xc4\x30\x13\xa7\xd7\x52\xb4\x75\x11\x5e\xe3\xfa\x70\x53\x17\xe3\x3e\x82\xd8\x39\xe6\x31\xbe\xdb\x92\x68\xa9\x9a\xc7\xe7\x01\xc7\x7e\x5e\x0e\xa5\x44\x37\xe1\xcf\x96\x09\x7e\xaa\x8b\xaa\xf1\x20\x47\x7a\x0d\xbe\x63\x28\x02\x57\x04\x21\x5d\xd0\x08\x8a\x00\x78\x2c\xde\xe3\x27\x5b\x71\x5e\xc8\x59\x8a\x98\x5f\x90\x72\x57\x04\x0e\x2c\x68\x04\x29\x07\x1e\x8b\xf7\xf8\x4d\xa4\x9c\x65\x4e\x1f\x09\x27\x90\xe0\xc1\xd5\x1e\x21\xe7\xc0\x63\xb7\x74\x5f\x3c\x96\x68\xf0\xc8\x5d\x7b\x60\x7e\xc8\x64\xdd\x98\x9e\x12\x49\xcf\x4f\xd9\xbf\xb7\x60\xe1\xb4\xcf\x24\x16\x4a\x70\x6c\x18\x1d\x4a\x70\xd4\xc0\xa1\x04\xc7\x1a\x1c\x4a\x70\xa0\x04\x07\x4a\x70\x6c\x9f\x28\x4a\x70\xe4\xc1\xa0\x04\x07\x4a\x70\x2c\x08\x75\x14\x2e\x84\x3a\x0a\xcb\x06\x41\x8e\xf9\x80\x04\x74\xa3\x5b\xb2\xf6\x23\x4e\x8b\x67\xa8\x4f\x3c\x06\x9d\x7b\x28\x59\x29\x43\xfb\xb2\x52\x90\xfe\x51\x4b\xee\xa2\x56\x88\x1d\xb3\x43\x1b\x9e\x68\x6a\x87\xcd\xc7\xab\x4c\x2b\x76\x65\x2c\x96\xd9\x88\x76\x0c\x13\x88\x1c\x3f\x47\xe7\x16\x8b\x69\x58\x20\xdf\xfc\x1a\x59\x6b\xa9\xf7\x9d\xda\x5e\xea\xb2\x17\xc5\x1c\xcc\xfb\x80\x41\x95\x78\x0e\xe6\xf5\x96\xd1\xc1\xbc\xae\x81\x83\x79\xbd\x06\x07\xf3\x1a\xe6\x35\xcc\xeb\xed\x13\x85\x79\x0d\xf3\x1a\xe6\x75\xa8\x41\xb8\x42\x5b\x46\xc6\x45\x10\x5d\xa8\x3d\x59\x47\x86\x75\xfa\x14\xcc\xc8\xcd\xc5\xa8\xba\x35\xf8\x7c\xb8\x94\x51\x1a\x3e\x88\x11\xde\x19\xc5\xf6\xc2\xac\xc2\xaf\xfc\x72\x5e\xf7\x8d\x66\xc4\x5e\xa8\xfd\xea\x41\x6c\x64\x88\x52\xef\x7f\xd9\x6a\x7b\xc0\x0e\xd8\x32\x3a\xd8\x01\x35\x70\xb0\x03\xd6\xe0\x60\x07\xc0\x0e\x80\x1d\xb0\x7d\xa2\xb0\x03\x60\x07\xc0\x0e\x08\x35\x08\xda\x01\x71\xed\x3b\xe3\x9c\x43\x87\x2a\x90\x35\x99\xb2\x20\x12\x52\x9b\x37\x84\x26\x2f\xb4\x36\x1f\xac\xd9\x14\xfe\xb8\x0d\xb7\xc9\x0d\x83\x6c\xf2\xb8\x63\x49\x79\xe1\x90\x05\xc0\xf9\x61\x91\x4d\xe6\x9e\x7d\xa3\xbc\xf0\xc8\x26\x2b\x44\xb2\x49\xf3\xfb\xe6\x86\x89\x90\xdc\x26\x7f\x35\x33\x42\x73\x1b\xf0\x68\x29\xf0\xf7\xcb\xa3\x19\x8d\x06\x53\xa3\xfc\x25\x3a\xd8\xff\x22\xa2\x87\x8e\x91\xfb\x0a\x9c\xeb\x99\xe8\x24\xd5\x5d\xed\xa7\x07\xd7\x0f\xde\x60\x9b\x2f\xf0\x48\xf8\x73\xc2\xe3\x79\x0f\x24\x4a\x2b\x92\x4c\xba\x5a\x45\xe8\xf4\xac\xd3\x4a\xa2\xe8\xd2\x86\x00\x3e\xfa\xca\x46\xa9\x8f\x02\xae\xa4\x30\x20\x5c\x49\x0b\x40\xb8\x92\xe0\x4a\x82\x2b\x09\xae\x24\xb8\x92\xae\x50\xe1\x4a\xba\x6d\x02\x57\xd2\x6f\xc8\x95\x84\x7b\x3c\xf2\x2d\x56\x64\xae\x16\x34\x42\x76\x34\x78\x2c\xde\xe3\x27\x67\x47\x43\xbc\x81\xf5\x42\x3d\x42\xbc\x81\xc7\x6e\xe9\xbe\x78\x2c\x25\xde\xb4\xda\x89\xfd\x60\x88\x1d\x87\x47\x32\x8a\x1c\x59\x26\xf9\x23\x85\xd2\xa0\x52\xeb\xd0\x19\xdd\xb3\x39\xf7\xab\xe2\x5e\x66\x67\x78\x74\x18\xdf\xf2\x12\xd9\x71\x34\xad\xfb\xa8\x15\x12\xca\x52\xeb\x57\xdc\x95\x22\xa0\xee\x1a\xc4\x45\xa0\x47\x7c\x92\xc0\x63\xb7\x74\x5f\x3c\x96\x68\x70\x2f\x62\x5f\x0a\x45\x2c\x96\x92\x9e\xe8\x00\x97\x52\x63\x1b\xc5\x7a\x84\xa8\x06\x8f\xdd\xd2\x7d\xf1\x58\xa2\x81\xa1\x93\x7e\x1a\xf5\xfc\xc0\xcb\x14\x8e\x4e\xb5\x02\x9a\x1b\xc3\xd7\xe6\xea\x48\xf1\xc2\x14\xa2\x60\x6c\x4c\xea\x39\x5c\xf2\x82\xed\x16\xe9\x11\x22\x1d\x3c\x76\x4b\xf7\xc5\x63\xd1\x06\x91\x1f\x15\x3d\x1b\x92\x6b\xd7\x63\x55\x94\x36\x81\xc8\xbc\x22\xb0\x73\x41\x23\x88\x4c\xf0\x58\xbc\xc7\x4f\x2f\xa0\x6c\x89\x0d\x66\x25\x5a\xb6\x49\xaf\xa4\x14\x2d\x29\x1b\xf1\x95\x43\x44\x5e\x11\xd8\xb7\xa0\x11\x44\x24\x78\x2c\xde\xe3\xaf\xa6\x55\x0e\x52\xae\x46\x48\x46\x9e\xd1\x6b\x31\xf3\x09\x25\x94\x8f\x15\x0b\xc7\xab\xd9\x26\x0e\x84\xb0\xc5\x46\x58\xeb\x11\xc2\x16\x3c\x76\x4b\xf7\xc5\x63\x89\x06\x0b\x59\x87\x6b\x28\xc1\x83\xeb\x3d\x42\xce\x81\xc7\x6e\xe9\xbe\x78\x2c\x25\xe7\x06\xa7\x59\x6b\x88\x3b\x62\x8f\x43\x7b\x0c\x29\x75\xa9\xe9\xa7\x9f\x0d\xbe\x0f\x24\xbc\x6e\x19\x1d\x12\x5e\x6b\xe0\x90\xf0\xba\x06\x87\x84\x57\x24\xbc\x22\xe1\x75\xfb\x44\x91\xf0\x9a\x07\x83\x84\x57\x24\xbc\x2e\xa8\x3d\x50\x7b\xac\xd2\x36\x27\x84\xa9\x83\x32\x04\xaf\x50\x48\xed\x3f\x90\xa6\x65\xa4\xbc\xee\x52\x06\x54\x55\x86\x19\x97\x35\x5d\x08\x97\x35\x2d\x1b\x84\xeb\x8f\xd3\xcb\xfc\x0d\x8b\x2a\x2b\xa9\x0f\xa2\x50\x1d\xbd\xd4\x04\x66\x7b\x99\x55\xf1\xb8\xee\x49\x55\x16\xaf\xb2\x96\xd9\xee\xc8\xa4\xde\xb3\x4e\x04\xf4\x96\xd4\x28\x9e\xc8\x3c\x1b\xe1\x0a\xb7\x7e\x58\xf5\x4c\xf4\x3b\x6a\xd2\x55\xb3\xb7\x4e\x1b\x62\xbc\xa4\xfe\x7a\xd3\x0c\xfd\x87\x48\xbe\x67\x6e\x94\xe7\x01\x36\x9a\x93\x05\x23\x89\x56\x82\xbf\x39\x6c\x5a\x6b\xb4\x74\xd2\xae\xfc\x3e\x7d\x62\x56\x7e\x28\xa9\xe5\x65\xa8\x13\xdb\xcf\xcc\xa4\x37\x73\xba\xa1\x97\xa2\xe5\xae\x22\x9b\x0f\x4e\x92\x2d\xa3\x83\x93\xa4\x06\x0e\x4e\x92\x35\x38\x38\x49\xe0\x24\x81\x93\x64\xfb\x44\xe1\x24\x81\x93\x04\x4e\x92\x50\x83\xa0\xa1\xd7\x3d\x32\x35\x9c\x1e\x43\xc2\x26\xb5\x99\xe1\x59\xb8\x10\x3c\x0b\xcb\x06\xd1\x9b\xcd\x8a\xcc\x49\xa1\x2c\x19\x37\x5a\x61\xbd\xa1\x9d\x78\x29\x42\x41\xa6\x37\xe2\x20\x62\x3d\x22\xd6\x06\x3c\x76\x4b\xf7\xc5\x63\x89\x06\xe1\x3b\x5f\xd2\xee\x3e\xb3\xf3\x4a\x50\x8d\xcb\xd5\xb9\x68\xbe\x76\xa8\xf3\xc8\x9c\xec\x8f\x9b\xdd\x61\x6d\x59\xce\x38\xb7\x76\x38\x11\x33\x5a\x12\x6b\x0d\x75\x93\x75\x17\xd8\x12\xe9\x2d\xd3\x0d\x86\x7b\x5e\x9c\x6d\x93\x3a\xb5\x9b\x5e\x1c\x19\xc5\x65\x30\x4e\x34\x13\xa7\xd7\x52\xb4\x75\x5a\xd2\xb8\x3e\xdc\xd4\xf9\x0a\x46\x10\x4b\xd6\xfa\x05\x8a\x0b\x81\x24\x5a\x7c\x7b\x3e\x5c\x06\x1c\xfb\x79\x39\x94\x92\x5d\xb7\x2d\xc2\x2d\xcc\x82\xcf\x96\x09\x7e\x62\xde\xda\x0b\xb2\x56\x06\x06\x22\x8a\xf1\x05\x0a\xf7\x08\x2d\x07\x3c\x76\x4b\xf7\xc5\x63\x29\x79\xfb\x6c\xfd\x77\x15\xc9\xb8\xe0\xc0\x50\x8f\x90\x72\xe0\xb1\x5b\xba\x2f\x1e\x4b\x34\xc0\x79\xfc\x96\xd1\xe1\x3c\xbe\x06\x0e\xe7\xf1\x6b\x70\x38\x8f\xc7\x79\x3c\xce\xe3\xb7\x4f\x14\xe7\xf1\x38\x8f\xc7\x79\x7c\xa8\x41\x22\x69\x81\xf7\xc2\x4f\x5c\x2b\x66\x1d\x8f\x7b\xf3\x83\x5d\xe5\xa6\x3f\x24\x00\xd2\xd9\x0f\x61\x00\x39\x58\x17\xab\xc2\x9a\x7a\x5e\x9f\xfa\xc1\x79\xbd\x89\xda\xa3\x1d\x42\xd5\x69\x13\x28\x53\xec\x31\x73\x86\x2b\xbb\x23\xc3\x78\xdb\x92\xa4\xc9\x33\x5f\x04\xb8\xd3\xa6\x25\xe6\xbf\xad\xcc\xba\xd7\x78\x58\x33\x52\x32\x1a\x04\x4e\x4c\x54\x2b\x19\xf6\x86\x2b\x37\x19\x73\xad\x56\xce\xe8\xb2\x33\xae\x09\xc6\xeb\xab\x95\x8f\x33\xde\x46\x2f\xbf\x4e\x40\x8c\x09\x11\xc5\x18\x9b\xf2\x53\x22\x21\x25\x95\xe9\x29\x42\x59\xc7\x95\x97\x06\x46\x7b\x06\xfe\x88\x53\xc3\xf1\xd6\xf5\x74\xe2\x4a\xc6\xe8\x2e\x68\xe9\x44\x90\x4c\x34\xd1\x33\xde\x75\xd5\x36\x76\xf8\x84\x3a\x13\x20\x7a\x3a\x96\x85\x91\xd8\x6c\x5a\x11\xbd\xe6\x9c\x83\x47\x6e\xab\xcf\xc9\xfa\x89\xc4\x29\x15\xf2\x74\x6f\xf4\xcb\x6b\x71\x89\x4b\xfb\x63\x8d\xc6\x60\x7f\x64\x55\x79\x8a\xf6\x47\x76\x22\xc7\x3b\xee\x78\xe9\xf3\xd3\x1b\x65\x95\x79\x63\xf6\x47\x66\x68\x5f\xaa\x20\xd8\x03\x37\xde\xca\xad\x97\x05\xd5\x06\xfc\x59\x2e\x55\x1e\xb0\x27\x76\x8b\x15\x7b\xc5\xdd\x60\x88\x3d\x91\xb1\xc5\xcb\x66\x89\xb5\x83\x75\xfa\xe4\xb5\x34\xb9\xd7\x46\xb8\x43\x40\x6f\xd8\x02\x95\x48\x86\xca\x06\x61\xa7\xee\x0f\xa5\x40\xc7\x53\xfc\x48\x3e\x89\x20\xfd\xd2\x8a\xdd\x2b\xeb\x29\x1e\xab\x1b\xc6\x70\xda\x78\x55\xaf\x95\x3c\x24\xbe\x33\x10\xca\xb3\x01\x2d\xb1\xc7\x41\x75\xd2\xef\x8c\xe0\x45\x9d\x19\x20\x96\xcc\x13\x19\x66\x45\x47\x8c\x54\x6b\x5e\xfb\x62\x4d\xfe\x53\x53\x0b\x2f\xa2\xf4\xe6\xb7\x58\xac\x53\x2f\x07\x75\xfc\x0f\xda\x5c\xba\x1d\x07\x05\x5b\x46\x87\x83\x82\x1a\x38\x1c\x14\xac\xc1\xe1\xa0\x00\x07\x05\x38\x28\xd8\x3e\x51\x1c\x14\xe0\xa0\x00\x07\x05\xa1\x06\xe1\x83\x02\x3e\xba\x4c\x4b\x35\x3e\xc4\xbe\xd5\x00\xfe\xf6\xe3\x92\x10\xfb\xd6\x80\xc7\x12\x3d\x7e\xf6\x7d\xf7\x9c\x85\xf5\x52\x48\xb8\x2b\x02\xf7\x15\x34\x82\x84\x03\x8f\xc5\x7b\xfc\x6c\x09\x27\x05\x29\x17\x71\x87\x42\xca\x5d\x11\x38\xb0\xa0\x11\xa4\x1c\x78\x2c\xde\xe3\xb7\x91\x72\x48\xd4\x02\x03\x06\x7a\x84\x90\x03\x8f\xdd\xd2\x7d\xf1\x58\x4a\xc8\x69\x32\x2d\x31\xa7\xd9\xe0\x76\x7f\x8c\x1d\x25\x47\x8a\x85\x73\xc7\x23\xee\xe6\x54\x44\xa9\x20\x19\x3a\x65\xe5\x5d\x27\xa6\x17\xf4\x53\x92\xe7\x6a\x1d\x93\x88\x6c\xbd\x10\x22\x5b\x97\x0d\x22\xc5\xc6\x5b\x56\x5c\x16\xcc\x3f\x5c\x5e\xf1\xc6\x3f\xed\xf4\x91\x02\xc7\xa7\x50\x4d\xae\x08\x9f\x8d\x82\x46\x50\x4d\xc0\x63\xf1\x1e\x3f\x59\x35\x29\x17\xad\xda\x46\xcc\xb6\xc4\xc3\xa2\x93\x54\x77\xf9\xc0\x18\x9d\x5f\xd6\xf7\x18\xd7\x5f\x3c\x72\x65\xa9\x1d\x0c\x31\x6b\xa3\x29\x16\x61\x46\x39\x12\xf5\xbe\xfb\x68\xa4\x64\xf8\xf1\x13\x39\x23\xda\x31\x66\xb7\x78\x12\x33\xc6\x28\x3a\x2a\x41\x2c\xdb\x19\x7d\x62\xf4\x44\xf1\xb0\xee\xf0\x84\x94\x56\xa3\x5a\xcc\x0c\xf5\x92\xb7\x74\x22\xe5\xd8\xd4\x6b\xd1\xb8\xea\xaf\xf5\xe8\x8d\x76\xba\x2d\x4c\xa1\xf9\x80\x7b\x35\xf4\x60\xda\x32\x35\x7f\x7a\xb4\x3c\xa8\x79\x7c\xbc\xd8\xc8\x78\x7b\xbc\x22\xac\x5a\xb2\x56\xf4\x07\x32\x25\x81\xc4\xb1\x88\xdb\xb3\x1e\x19\xf8\x69\xd4\xf3\x6e\x7e\x8b\x08\x4f\x3b\x9c\xb4\xd4\x7b\xb1\x39\x18\xd7\x1b\x31\x63\xb0\x95\xe3\xa7\x68\x7e\x13\x2e\xe2\x40\x3c\xef\x3b\x40\xc4\xf3\x22\x9e\x17\xf1\xbc\x88\xe7\x45\x3c\xef\x15\x2a\xe2\x79\x6f\x9b\x20\x9e\xf7\xb7\x14\xcf\x1b\xd5\x61\x52\xcb\x7f\x7e\x9a\x91\x6a\x75\x57\x6a\x57\x4d\xd9\x8e\xac\x13\x27\x52\x56\x68\x55\x96\xe9\x37\xa3\xc4\xce\x23\x84\xa3\x50\x35\x8f\xec\x95\xe4\xc6\xf0\x35\xef\x4a\xdd\x29\x4a\x47\x23\xb7\x14\xe6\x58\x5e\x14\x3e\xad\x8f\x89\xea\xcd\xa5\x57\xbd\xc2\x25\x7d\x45\x70\x17\x16\x34\x82\x4b\x1a\x3c\x16\xef\xf1\x93\x5d\xd2\x52\xef\x6b\xca\x33\xf8\xc7\x2b\x1d\xa3\xe3\x57\xe2\x57\xbd\x59\xb8\xb2\x50\xc7\xe4\x06\x6c\xb9\xa3\xbd\x36\x35\xbe\xc0\xf2\xa3\xd7\xf9\xf9\xe2\xfa\x5e\x8b\xe7\xcb\xbd\x89\xc3\x49\xb3\x29\xc2\xac\xe8\xf9\x8b\x9b\xae\x78\x04\x73\x5d\x86\xc2\xc3\x82\x98\x3f\x33\x78\x7d\xf0\xc3\x72\xe9\x6e\x7e\x8d\x79\x34\x5f\xad\xd4\x2b\xba\x21\x6a\x0b\xc0\x17\xb9\x02\x08\x5f\x24\x7c\x91\xf0\x45\xc2\x17\x09\x5f\xe4\x15\x2a\x7c\x91\xb7\x4d\xe0\x8b\xfc\x0d\xf9\x22\x2b\x43\x78\xfb\x7e\xd2\xe9\x47\x0f\x60\xd5\x34\xbd\x69\xf2\x41\x50\xa3\xc9\x59\x8d\x72\x8e\x2a\x16\xdd\x07\x80\xf5\x46\xb7\x1f\x83\x64\x76\xed\xbf\xfc\xe1\x8f\xff\xca\xce\xc3\xcb\xe1\xaf\xf8\xfe\xb1\xce\x0c\xad\x1b\x0c\x75\xb3\xa9\x5c\x3d\xc6\x38\xcb\x22\x90\xfa\x03\xf6\x6d\xd5\xdd\xca\xed\x60\xa2\x66\x7c\x98\x5f\xca\xc3\xaf\xc7\xa2\xdc\xa9\xc7\xc3\xb6\xbb\x19\xac\xf3\x9a\x1c\x0a\x05\xc0\x97\x19\xee\x11\xfe\x72\xf0\xd8\x2d\xdd\x17\x8f\x45\x1b\x44\x63\x1e\xd7\xe2\x1d\x83\x68\x81\x1f\xac\xe3\x6e\x78\xc7\x26\x61\xf6\xe1\xad\x13\x4f\x2b\x2f\x38\xb6\xf6\xbd\xd1\x8f\x72\xf5\xcc\x37\x78\x14\x1c\xe5\x8f\xf0\x11\xf0\xb9\xa7\x3f\xaf\xfb\x55\xc2\x5f\xa4\xd5\xb5\xb9\x5d\xfa\x87\xc6\xf6\xd4\x7e\x09\x3e\x35\x96\xa7\xed\xbe\xfa\x8f\xd3\xf4\xa1\x9f\xeb\xee\x7e\x6d\x76\x5c\xda\xf9\x5f\xc3\xa3\xa1\xc9\x85\x7b\x99\xfa\xfc\x0e\x9a\xff\xfe\x9f\x2f\xbe\x93\x65\xc6\xde\x68\xaa\xff\x59\xcb\xe1\x74\x3e\x91\x7f\x68\x3a\xb2\xad\x11\x53\xe9\xdb\xe6\x2f\xb6\x71\x07\xf2\x3a\x52\x3f\xb8\xf9\xfd\xfc\xdb\x8c\xeb\xb5\xa2\x9f\xfc\xd7\xb1\xf9\x61\xea\xe2\x87\xe9\xf7\xf9\xe7\x71\xaf\x36\x7f\x5a\xfe\xeb\xf6\x3d\xbe\xeb\xee\xaf\xc3\xe9\x91\x4c\xa3\x77\x97\xc5\x0e\xf6\x75\xf5\x36\xae\xba\xfc\xe9\xfa\xd1\xdb\xf7\x32\x35\x7b\xfa\xfd\x23\x39\xfe\xfb\x69\xd9\xda\x03\x9d\x2e\x95\xc1\x75\x4f\xea\x4f\x3f\xfd\xe5\xef\x3f\xfe\xed\xea\xdf\x21\xce\xe5\xbd\xf8\xfb\x5a\x71\xea\x00\x9b\x1d\x85\xea\xb2\x1a\xae\x17\x2c\x0f\x6d\xb4\xfe\x7d\x65\xdf\xc8\x36\x7b\xb6\xff\x2e\xb9\x75\xa2\xb5\xc4\x4d\xbb\xa2\xde\xc0\x6b\x0f\xaf\xfd\x0a\x20\xbc\xf6\xf0\xda\xc3\x6b\x0f\xaf\x3d\xbc\xf6\x57\xa8\xf0\xda\xdf\x36\x81\xd7\xfe\x37\xe4\xb5\xaf\x8d\x1b\xe5\x6d\x4b\x36\x7e\x4d\x49\x1e\x50\x93\xe7\x54\xcb\x07\x6b\x36\x39\x3e\xb6\xe1\x36\xb9\x0e\x90\x66\x9b\xcf\xa2\xc9\x76\x84\x14\x00\xe7\x3b\x44\x9a\x4d\x4e\x91\x26\xdb\x31\xd2\x64\x39\x47\x9a\x5c\x07\xc9\x86\x86\x09\x67\x5c\x93\xbf\x9a\x19\x4e\xb9\x06\x3c\x5a\x0a\xfc\xfd\xf2\x68\x46\x23\x6e\xed\x70\x22\x66\xb4\x24\xc6\x4d\x44\x55\x87\xb4\x05\x27\x4f\x04\x69\xfb\x8e\xc0\xa3\x0b\xfa\x7e\x79\x74\xa3\xb4\xb5\x64\xad\xd0\x2a\x71\x23\x22\xc4\x2e\x58\x7a\x22\x88\xdd\x77\x04\x1e\x5d\xd0\xf7\xcb\xa3\x1b\xc5\xee\x33\x3d\x32\x31\x5e\x87\xeb\x5e\xa7\x4a\x3c\x91\xeb\x8b\x1a\x48\x60\x70\xf7\x85\x20\x81\xdf\x11\x78\x74\x41\xdf\x2f\x8f\x66\x34\xa2\xd6\xb2\x56\x2b\xc7\x85\x22\xb3\xbc\x90\x9c\x19\x92\xdc\x89\x27\x0a\x27\xa0\x36\x10\xc2\x60\xf0\x0b\x41\x08\xbf\x23\xf0\xe8\x82\xbe\x5f\x1e\xcd\x68\x64\x68\x5f\x1b\x8d\x31\x31\x1b\x7b\x3b\xa1\x83\xc8\x5e\x23\x6c\x87\x25\x41\x64\xbf\x23\xf0\xe8\x82\xbe\x5f\x1e\xcd\x68\x64\x9d\x7d\xa7\x2d\xc7\x45\x38\x84\x2e\x18\x7a\x22\x08\xdd\x77\x04\x1e\x5d\xd0\xf7\xcb\xa3\x19\x8d\x06\x53\x13\xd6\x9a\xe8\x20\x27\x4e\x3d\xd1\x07\x2e\xb6\xba\x10\xf2\xb1\x97\x0d\x82\x4c\x27\x54\x2b\x87\x8e\x98\xe3\xc9\x7a\x75\xe1\x77\x2e\xf5\xde\x3a\x6e\x0f\x19\xd5\xea\x32\x40\x7a\x43\x3b\x51\x76\xab\x4a\xc6\x2c\xa2\xd9\x7a\xab\xcb\xc4\x7f\x19\x0c\x9d\x53\xe4\x6e\x1e\x4c\x5c\xb3\x30\x38\xed\xd5\x34\xee\xe8\xcd\xcb\x59\xb6\x3e\xe3\x30\xf2\x40\x82\xeb\x33\x61\x88\x53\x67\x19\xef\x05\x7b\x5a\xcb\x36\xdb\x04\x35\x2d\xd8\x18\x90\x5b\x51\xa9\x70\xc2\x9a\x97\x38\xe9\x42\x40\xba\xfc\x15\x21\x95\xb9\xa0\x11\xd2\xe5\xc1\x63\xf1\x1e\x3f\xb9\xbc\xec\x8d\xc8\x0b\x67\xeb\x41\xde\x5d\x11\x78\xb1\xa0\x11\xe4\x1d\x78\x2c\xde\xe3\x37\x95\x77\x96\x5b\xdc\x69\x0b\x6e\x0c\xf7\x08\x89\x07\x1e\xbb\xa5\xfb\xe2\xb1\x44\x03\x14\x37\xd9\x32\x3a\x14\x37\xa9\x81\x43\x71\x93\x35\x38\x14\x37\x41\x71\x13\x14\x37\xd9\x3e\x51\x14\x37\xc9\x3c\x9e\x42\x71\x13\x14\x37\x79\xa3\x8a\x03\x90\xb0\xb4\x8f\x3e\x18\xab\xba\xf9\xee\xb8\x28\xd8\xe2\x9d\x0b\xf2\xa6\x5d\x64\xd2\xad\xd4\x43\xf7\xcc\xdd\xf6\xc2\x7f\xcb\x63\x31\xeb\x0c\xf1\x80\x8d\x95\x3c\x13\x7b\xb6\x4c\x28\xeb\xb8\x6a\x89\xf5\x46\xef\x84\xd7\xd5\xae\xa2\xa2\x9c\x49\xdc\x72\x18\x16\xaf\x1e\x3d\x56\x6a\x06\x6e\x8a\x2b\x82\x09\x59\xd0\x08\x6e\x0a\xf0\x58\xbc\xc7\xcf\x76\xcc\x3e\x5b\xaf\xf0\xe1\xb8\x1d\x1c\x18\xea\x11\x52\x0e\x3c\x76\x4b\xf7\xc5\x63\x39\x52\xce\xd9\x44\x35\xab\x54\x98\xd2\x0c\x92\xae\xcf\x92\x01\xe4\xed\x35\xeb\x0a\xef\xdf\x86\x6f\x79\xcb\xe8\xe0\x5b\xae\x81\x83\x6f\x79\x0d\x0e\xbe\x65\xf8\x96\xe1\x5b\xde\x3e\x51\xf8\x96\xe1\x5b\x86\x6f\x39\xd4\x20\xa8\xbf\xb6\x5a\xb5\x83\x31\xa4\xda\x68\x72\x43\x78\x3b\xc7\x2b\x6f\x27\x86\x87\xb4\xa2\x0b\x21\xad\x68\xd9\x20\x7c\xcd\xa3\x73\x3d\xeb\x8d\x7e\x29\xc9\xc5\x59\x64\x25\x89\x53\xe4\x75\xa7\x78\xc6\xbf\x06\x76\xe0\xaa\x93\x85\xe9\x32\x52\xb7\x5c\xfa\x31\x94\xf5\x2f\xf5\x9e\xed\x8d\x1e\x7a\xe6\x0d\xbe\xb0\x44\x4f\x8e\xe2\x3d\x4c\x61\x8e\xd3\x12\xaa\xd8\x76\xbd\x86\xa8\x1a\x89\x21\xcf\x3d\xd4\x31\x43\xff\x18\xa8\xf0\x5e\x50\x0f\x34\x1d\x21\xd5\xcd\x69\x81\x51\x3c\x29\xaf\x76\xd1\x13\x29\x67\x59\x4f\x86\x3d\xae\x1f\x8d\x35\x19\xe2\xda\x23\x9d\xc5\x5d\x4c\xc3\x4e\xe2\xbc\x89\xcc\x32\xe6\xeb\x07\xc7\xfc\xf2\xcc\xd3\x3a\xdb\xac\x93\x96\x33\x7e\x3a\xcb\xf6\xc6\x3b\xdc\x4c\xbc\xf0\x44\x57\xf1\xc2\xaa\x46\x62\xd6\xb1\x62\x0a\xc9\x47\x4f\xfa\x89\xd8\x07\x6e\xda\x1b\xc4\x2a\x1e\x5d\xa0\x7d\x04\xcb\xcf\x70\x86\x9c\xb7\xb1\xb4\x62\x42\xb1\x8e\x17\x32\xdb\x27\xa1\x14\x4f\xce\xeb\xbd\x8e\xef\x19\xb7\xd3\xca\x97\xb1\xfa\x02\xa5\xfc\xac\x3b\x76\xd4\x3f\x71\xeb\xcd\x0f\x91\x4f\x74\xc7\x1d\xef\xf4\x7e\xf3\xb1\x7d\x2f\x70\x4e\x04\x1f\x7e\xa8\x47\x9c\x13\x81\xc7\x6e\xe9\xbe\x78\x2c\xd1\x00\x07\x2b\x5b\x46\x87\x83\x95\x1a\x38\x1c\xac\xac\xc1\xe1\x60\x05\x07\x2b\x38\x58\xd9\x3e\x51\x1c\xac\xe0\x60\x05\x07\x2b\xa1\x06\x91\x83\x95\x49\x87\x11\x5a\x31\x49\x4f\x54\x56\x77\xad\xeb\xd8\x41\x5b\x57\xec\x80\xec\x3a\x66\xf5\x60\xda\xca\xa7\x5b\xee\x68\xaf\x4d\x99\xb3\xa3\xeb\xca\x5d\xd4\x7e\xf2\x75\x8e\xfe\x9a\xf2\x63\x5e\x28\xcf\x5f\xa0\x62\xb7\x6d\x46\xa2\x40\xf0\x79\xa5\x99\xb5\x92\x3d\x71\x29\x3a\x1e\x56\x22\x92\x2e\x51\x6d\xca\x16\xd1\x92\x79\x12\x85\xbc\xe3\x07\x5e\xdc\x71\x79\xb9\xb5\x49\xd8\x58\xc7\x4f\x7d\x95\x77\x6e\xb1\x7d\xcb\x9d\x73\x07\xe7\x2a\x5c\x7b\x3f\xdb\x9a\xbe\xad\x8d\x4a\x9c\x12\x77\xe0\xec\xa5\xbb\xf9\x25\x22\x09\x49\x72\xeb\x44\x6b\x89\x9b\x82\x64\x1e\x78\x05\xe1\xb1\x09\xf7\x08\xaf\x20\x78\xec\x96\xee\x8b\xc7\x12\x0d\x78\xdf\x4b\xd1\x8e\x9f\xf6\xf2\x43\x66\xb8\x16\xb7\x8c\x0e\xae\xc5\x1a\x38\xb8\x16\xd7\xe0\xe0\x5a\x84\x6b\x11\xae\xc5\xed\x13\x85\x6b\x11\xae\x45\xb8\x16\x43\x0d\x22\x67\xc9\xf2\x78\x09\xe2\x9b\x43\x1c\x53\x3b\x28\xd1\x67\xcb\x23\x57\x12\xc3\xd4\xbe\x22\x98\x41\x05\x8d\x60\x6a\x83\xc7\xe2\x3d\x7e\xb2\xa9\xdd\x4a\x41\xca\xb1\x96\x42\x2e\x69\x48\xb9\x2b\x02\x07\x16\x34\x82\x94\x03\x8f\xc5\x7b\xfc\x36\x52\x0e\xa7\x26\x60\xc0\x40\x8f\x10\x72\xe0\xb1\x5b\xba\x2f\x1e\xcb\x16\x72\xac\xe7\x21\x57\x3e\x24\xdd\x15\x81\x0b\x0b\x1a\x41\xd2\x81\xc7\xe2\x3d\x7e\xb6\xa4\xd3\xca\x79\x51\x17\xf6\x27\xa6\xfc\x7a\x83\x75\xfa\xc4\x0e\xc4\x3b\x32\x65\x41\x68\x13\x84\xf8\x85\x98\xa3\x53\x2f\xb9\x2b\x8c\x25\xe4\x8e\x9f\x13\x43\x49\xf1\xc7\x90\xb3\x31\xf5\x26\x97\x38\xe5\x61\x91\xb4\xe3\x83\x74\xec\x2a\x3c\xa9\xea\xd6\xca\x8e\x76\x92\x5a\xa7\x0d\xe3\x52\xf0\xb2\x95\x9e\x96\x85\x09\x59\x58\xb4\x98\x5e\x5a\x1a\x77\x4c\xf4\xfc\x38\x85\xb2\xe3\x42\x32\xad\x58\x3f\x38\x27\xd4\xfe\xf2\xd6\xd9\xf9\x08\xad\xa5\xd0\xee\x49\x42\x4b\xee\x1c\x29\x76\xe0\xf6\x10\x0f\x89\xcc\xc5\x60\x96\x7a\x6e\xb8\xd3\x65\x75\x18\x8a\xc3\x4b\xfd\x83\x85\x11\xad\xce\xf5\xe3\xfb\x21\x55\xe6\xaa\x17\x5d\x71\x60\xa3\xd8\x2b\x6d\x88\x5d\xf8\xa4\x6c\x06\x42\x9e\x58\xaf\xa5\x88\x57\x8c\xc9\x78\x3c\x58\xff\x3a\x1f\x41\x3f\x91\x79\x36\x22\x2e\x93\xc2\xcc\x74\x8e\x12\x16\xaa\xa3\x17\x26\x14\x0b\xde\xc3\x9d\x8b\x54\x15\x6f\xbc\xac\x4e\x32\x86\xb0\x96\xc2\xf8\xd9\x74\x7e\xcf\xf6\x7e\xb3\x14\x56\xa5\x9c\x60\x8a\x65\xec\xf4\x78\xc5\x9d\xcb\x63\xd5\x05\xcb\xfe\xf9\x77\xbf\x63\x86\x78\x71\x30\xec\xe5\xfa\x67\xbf\x20\x15\xa5\xf4\xef\xe6\x2e\xea\x77\x18\x95\x22\xf0\x1c\xa3\xfe\xca\xf6\xe4\xfc\x7a\xd7\x7c\x05\xdf\xc0\xde\x7f\x3d\x8a\xe0\xbc\x75\xf7\xac\x0d\xaa\xe4\x43\xfb\x5e\xed\x11\x16\x1e\x78\xec\x96\xee\x8b\xc7\x12\x0d\x0a\x2f\x68\x69\x9a\x5e\xf4\x14\xae\x7c\x97\x7a\x38\x91\x98\x13\x29\x87\x64\x68\x47\x86\xe9\x9f\x99\x25\x23\xb8\x14\xbf\xc4\x4b\xa0\x85\x5f\x98\xa1\x56\x2b\x45\xad\xf3\xc6\x06\x19\x13\xff\x7e\xc5\x70\xa4\xe6\x1d\xe3\x3b\x57\x58\x8c\x6d\x06\x98\x47\x93\x52\x8b\x93\x03\xd1\x8a\x79\x13\x6a\x30\x85\x3a\xe9\x5c\xf8\xe8\x48\xaf\xd6\x63\x0d\x7d\x57\xfa\xf9\x5c\x45\xaa\x28\xc8\x34\x07\x1a\xc5\xc2\xeb\x92\x18\x76\x30\xc6\xbf\xf3\x9a\xd7\x35\x86\xe6\xf1\x95\x22\x43\x39\x4f\x6b\x29\xbd\xd1\x30\xa9\xfc\x85\x6f\x48\x0f\xa3\x6e\x53\xba\x92\xb6\x3d\x50\xa1\x52\x6d\x95\xd8\xf9\xfd\xd7\x4a\x6e\x6d\xb9\x6e\x6e\xad\x1c\xc3\x42\x6b\x74\xbd\x11\x43\xa8\x6a\x8c\x27\x32\x62\x57\x68\x30\xcd\xcf\x97\xf7\x3f\xf4\x63\x36\x00\xeb\x74\xcb\x9e\x0d\x2f\x34\xb8\x2e\x30\xbe\x61\xf2\xad\x84\x71\xaa\xd2\x1e\xb9\xf1\x0a\xfc\x64\x75\x55\x82\x8c\xd3\x28\xc6\x38\xfb\x8b\x10\x50\x08\xad\x2a\xd4\x23\x34\x77\xf0\xd8\x2d\xdd\x17\x8f\x25\x1a\x5c\xe4\x5c\xf1\x57\xf8\x82\x50\xe9\xc5\x3c\xe3\x94\xb9\x73\x73\x4a\x21\x27\x1f\xce\x70\x8a\xc5\x31\x7a\x6e\x2c\x4d\x66\x40\xb1\x6e\x37\x01\x19\x6a\x45\xb1\x42\x90\xf5\x01\x0f\x17\x0e\x50\xde\xa8\x79\x22\x33\x9e\xe3\xcc\x93\x79\xed\x0b\x5f\xcc\x60\x0b\x35\xe4\xc1\xb5\x35\xea\xed\x5c\x6e\x82\xd8\x1c\x67\x91\xa1\x60\x45\xc0\x46\xed\x6e\xe1\x57\x1c\x33\x12\x1d\x37\xae\xf4\x7c\xea\x59\xb8\x03\x73\x86\x2b\xeb\xad\x58\x32\x4c\xae\xd5\x1b\xcd\x42\xf2\x5b\x8e\x79\x89\x9c\x2c\xae\x11\x58\xeb\x88\x88\x98\x0e\xf3\xba\xbf\xf2\x13\xd9\x9e\xb7\x6b\x3c\x20\x1c\x9d\x56\x59\x23\xa3\x4f\x6e\x0c\x7f\x2f\x04\xd7\x15\xae\x44\x8d\x85\xae\x63\x3d\x77\x07\x66\x87\x5d\xc2\x21\x1d\xb9\x2d\xb5\xef\x13\xa7\x59\xb8\x71\x0a\xd9\xcb\xef\x00\x91\xbd\x8c\xec\x65\x64\x2f\xe7\xa1\x22\x7b\x19\xd9\xcb\xdb\x91\x82\x41\x63\x9b\x60\x90\xbd\x1c\x04\x43\xf6\x72\xb8\x41\xd0\x6e\xc6\x9d\x4f\x17\xc2\x9d\x4f\xcb\x06\x9f\x70\x46\x9a\x67\xd8\x84\x8f\x07\x5e\x4f\x52\xa8\x23\x4b\x0d\xa0\xa4\x98\x9e\xc7\xdc\x62\x4f\xee\xb4\x79\xe6\x6b\xa1\x30\x09\xfb\xae\x3d\x32\x43\xb6\xd7\xca\x52\xce\xd1\x5d\xf8\x2b\x0a\x43\x6d\xcb\xe8\x60\xa8\xd5\xc0\xc1\x50\x5b\x83\x83\xa1\x06\x43\x0d\x86\xda\xf6\x89\xc2\x50\x83\xa1\x06\x43\x2d\xd4\x20\x96\x7c\x36\x86\xe7\x55\x69\x8d\x9d\xb2\xcc\xe8\x41\x75\xcc\xe8\x47\x51\x78\x88\x44\x2f\xbd\x30\xc4\x3c\x56\xcb\xdb\x43\xf4\x70\x2e\x3c\x94\x03\x37\x5d\xdd\x64\x0e\xc4\x8d\x7b\x24\x9e\xd2\x00\xf2\x71\xc2\x6f\x30\xf1\xf6\xe6\x54\x1e\x45\xee\x59\x9b\xe3\x74\xd6\x68\xab\xcf\xd6\x8e\x44\x3d\x97\xe2\xa9\xf0\x20\xfa\xf2\x78\xdd\x32\xf7\x07\x71\x0e\xa1\x64\x1d\xb9\x31\xad\xae\x6c\x40\x1e\x29\xab\x30\x5a\x78\x30\xf3\x89\x6e\xf2\xca\xcf\x18\xc2\x68\x05\xb2\xa5\x29\x56\x36\x1d\x4b\xed\x60\x84\x2b\xae\xed\xc1\xe5\xa8\xcd\x29\xad\x5e\x4f\x7a\xb0\xd1\xab\x21\x72\xc6\x33\x8d\x49\xee\x12\x77\x54\x34\x79\xd2\xcc\x1e\xb8\xa1\x48\x72\x5b\x26\xcc\x60\xc9\x30\x3e\xa4\xf5\xc3\xd8\xbc\xe2\x91\x28\x0f\xd7\xb3\x0e\xb5\xb9\xcc\xa7\x44\xfa\x5a\x52\x95\xd2\xca\x92\x79\x0a\x26\xfd\x06\xcf\x9e\x9b\xcc\x30\xa5\x70\xea\xe4\x72\x74\x89\x50\xa2\x54\x54\x52\x16\x48\x3c\x4b\x68\x6e\x93\x19\x78\x95\x11\x77\xb8\x0d\xb0\xd9\x14\x1f\xb6\x1d\xbb\xc9\x8d\x15\x9b\x28\x6b\x41\x97\x94\x17\x37\x56\x08\x9e\x1f\x43\xb6\xec\x20\x27\x92\x6c\xa2\xbc\x78\xb2\x89\xd2\x51\x65\xcb\x31\x24\x62\xcb\x36\x36\x4d\xc4\x32\x2e\xd1\x32\x56\x37\x23\xae\x71\x22\xf0\xf0\x07\x80\x83\x87\xb3\x9b\x85\xb3\x8e\x96\x20\x71\xf7\x40\x9e\x9a\x00\x81\x7f\x26\x6c\x96\x10\x41\xe0\x47\x09\x3c\x1c\x20\xf0\x70\x76\x33\xeb\xb8\xea\x1e\xa3\xef\x39\x6f\x75\xbc\x4d\x97\x7a\xa9\x90\xf8\x13\x61\xb7\x84\x08\x12\x3f\x4a\xe0\xe1\x00\x81\x87\xb3\x9b\x3d\x93\xd8\x1f\xaa\x95\xfc\xd4\x82\x3c\x8c\xde\xa7\xc0\x8f\xc9\x71\x4e\x0d\xd6\x12\x28\xc6\x5f\xa5\x65\x93\x9f\x74\xf4\xf1\x59\xb1\x57\xd4\x45\xca\xc6\xa7\x5e\xb6\xc7\xf3\x4f\x33\xa9\xf7\xa2\xe5\x92\x59\x37\x3a\xee\x8b\x93\xc6\xce\x78\xe1\x13\x75\xa4\xd7\x5e\x11\x52\x1f\x0b\x1a\x21\xbd\x16\x3c\x16\xef\xf1\xb3\xd3\x6b\xcf\x72\xce\x1d\x86\xd3\x63\x6f\x44\x28\x3e\x2a\x57\x5e\x0e\x96\x18\x29\x47\xa6\x37\xc2\xd2\x24\x86\x2b\x44\xba\xe8\x0f\xa5\x15\x55\xc7\xe7\xdf\xae\x23\x81\x24\xc7\x2e\x0b\xf6\x08\x49\x0e\x1e\xbb\xa5\xfb\xe2\xb1\x1c\x49\x3e\x89\xbb\xde\x88\xa7\xb9\x06\xd5\x58\xba\xbf\x3f\x98\x60\x58\x24\x64\xdf\x15\x81\x2f\x0b\x1a\x41\xf6\x81\xc7\xe2\x3d\xfe\x5a\xb2\x0f\x1a\x1f\x38\x72\xbd\x47\x48\x3d\xf0\xd8\x2d\xdd\x17\x8f\x65\x48\x3d\xa1\xc6\x68\x55\x8a\x24\x60\xe5\xd8\xd9\x73\xfd\xa0\x78\x80\x69\x26\x50\x71\x55\xa8\x79\x10\x6f\x15\x62\x2b\x03\xad\x63\x79\xa1\x73\xd4\xe6\xcd\x2f\x91\x25\xdf\xb7\x2b\x7b\x26\x95\x16\x1a\x0d\x5d\x0f\xae\x04\x1f\x9c\x66\xad\x21\xff\x21\x7b\x1c\xda\x23\x15\x7a\xc5\xd3\xcf\x06\x87\x80\x3c\xd4\x2d\xa3\x43\x1e\x6a\x0d\x1c\xf2\x50\xd7\xe0\x90\x87\x8a\x3c\x54\xe4\xa1\x6e\x9f\x28\xf2\x50\xf3\x60\x90\x87\x8a\x3c\xd4\x05\xcd\xfe\x13\xcf\x5c\x41\xc5\x2e\xb5\xa3\x67\x8c\xaa\x7c\xaa\xd6\x50\x37\x09\x53\xcb\x7e\x0e\x5e\x47\x05\x07\xce\x15\xc1\xb8\x2e\x68\x04\x07\x0e\x78\x2c\xde\xe3\x27\x3b\x70\x48\xb5\xe6\x75\xba\x4a\xb4\xb4\x32\x38\xca\xbc\x5d\x08\x65\xde\x96\x0d\x82\x4c\x77\xa0\x97\x59\xdf\x8e\x1a\x56\xa9\xcf\xf4\x91\x5e\xc3\x37\x8f\x24\xc6\x38\x8d\xad\xb6\xa0\xfd\x8c\x72\x22\xc7\x3b\xee\xf8\x3a\x46\x75\x02\x76\x42\x8a\x66\x89\xbb\x64\x54\x7a\x96\xd2\x9f\x0a\xd6\x0d\x8b\xb4\x87\x26\xc6\x52\x95\x91\xbc\x95\xb7\x2a\x94\x57\x1d\x34\xda\x8f\xb8\xe8\x59\xeb\xb4\xf1\xe2\x6a\xbc\xdf\xa9\x18\x81\x58\xe1\x0d\xd4\x63\x59\xfd\x56\x77\x42\x15\x95\xd4\x8f\xf9\xb5\x27\x8f\xef\xca\x0f\xf3\x72\xdd\xfc\x12\x73\x78\x93\x5c\x51\x46\xe2\x9b\x06\x6e\xe3\x2d\xa3\x83\xdb\xb8\x06\x0e\x6e\xe3\x35\x38\xb8\x8d\xe1\x36\x86\xdb\x78\xfb\x44\xe1\x36\x86\xdb\x18\x6e\xe3\x50\x83\xb0\x39\x19\x2c\x50\x95\xd2\xdf\x2b\x2e\x47\xd6\x4e\xb7\xba\x2c\xb4\xc2\xc9\xc2\x7b\x87\x9d\xb4\x6c\xf2\x58\x05\x00\x78\xd7\x89\xc9\xa1\xf5\x53\x52\xd1\xad\x5b\xf5\x98\x01\xb0\x9a\xb2\xf9\x30\xae\xf6\x16\xdd\xff\xe0\xdc\x8a\xca\x97\x88\x76\x09\x56\x83\x4b\x6b\xfe\xe9\xea\x62\x79\x8e\xcf\xcc\xb2\x03\xf9\x5e\xd4\x6d\xe9\xda\xdb\x92\xb5\xb3\x53\xb5\x37\x38\x55\x9b\x4d\x69\xda\x1b\x81\xb7\xa5\x68\xe7\x7b\x6d\x9b\x4d\xe9\xd9\x79\xc9\xd9\x59\x1e\xdc\x0d\x0d\x33\x4a\x0b\x64\xae\x66\x66\x59\x01\xf0\x68\x11\xf0\xf7\xcb\xa3\x19\x8d\xd2\x15\x5e\x20\x66\xc1\xc2\x13\x41\xcc\xbe\x23\xf0\xe8\x82\xbe\x5f\x1e\x4d\x36\x4a\x55\x35\x3e\xeb\xba\x81\x9f\xcf\x32\xba\xc4\x1a\x83\xfb\x7d\xcb\xe8\xe0\x7e\xaf\x81\x83\xfb\x7d\x0d\x0e\xee\x77\xb8\xdf\xe1\x7e\xdf\x3e\x51\xb8\xdf\xe1\x7e\x87\xfb\x3d\xd4\x20\x76\x7b\x90\x1b\x43\xae\x83\xbd\x24\x7a\x20\xd5\xf5\xba\xb4\xea\xd3\x78\x1f\xce\xdb\xc5\x97\xdc\xb2\x41\xcd\x37\xb9\x78\x0d\xa6\xcc\xd1\x8e\x90\xc6\x0b\x21\xa4\x71\xd9\x20\x12\xd2\xc8\xbb\x60\x95\xb0\x6f\x77\x2c\x33\x9d\x9a\xb0\x13\xb9\x83\x8e\x5e\x83\x14\xec\xc0\xbf\x4a\x36\x06\xda\x95\x6d\x1d\xdd\x93\xaa\xbc\x14\xca\xe8\x97\xb2\x48\xe4\x51\xa5\xad\xea\x7b\xfc\x42\x8e\x96\xcf\x45\xa2\xb4\xba\x0b\xbd\xad\x68\x64\x67\x5a\x89\x89\x07\x35\x5a\x2b\xeb\xe6\x32\x56\x24\xe1\x28\x3c\xb7\xc9\xaf\x84\x0c\x83\x82\x46\xc8\x62\x01\x8f\xc5\x7b\xfc\x16\xc5\x97\x50\xa7\x13\xac\xbc\x4a\xf7\xc5\xca\x10\x97\x0d\x78\x2c\xd1\xe3\x37\x10\x97\x28\xd0\x79\x4d\x60\xc8\x58\x8f\x10\x7a\xe0\xb1\x5b\xba\x2f\x1e\xdb\x2c\xf4\xa0\xe3\x81\x15\xd7\x7b\x84\xb8\x03\x8f\xdd\xd2\x7d\xf1\x58\x86\xb8\x9b\xab\x59\xa6\xea\x72\x46\xcd\xe2\xf2\x6a\x9a\xb1\x14\x81\xf3\x91\xcf\xcd\x4f\x91\x59\x1d\xf9\xee\xb8\x92\x62\x9f\x2a\x7f\x79\xac\xf3\xa2\x3e\x1a\x7d\x2c\x75\x2b\x20\x14\x6a\xcb\xe8\x10\x0a\x55\x03\x87\x50\xa8\x35\x38\x84\x42\x21\x14\x0a\xa1\x50\xdb\x27\x8a\x50\x28\x84\x42\x21\x14\x2a\xd4\x20\x55\xc0\x52\x94\x85\x5e\x9c\x35\x20\xa1\xd5\x18\x75\x10\x78\x93\x09\x94\x8e\x76\x7c\x90\x8e\x25\x83\x87\x32\x71\x7a\x6e\x9c\xa8\x2a\x10\x77\x46\x72\xba\x17\x85\x73\x12\xb6\xe5\xa6\x63\xa3\x09\xc0\x3a\x92\xe2\x89\xbc\x64\xe7\x42\x86\xcc\xb1\x14\xaf\xd3\x4b\x2b\x87\x8e\xa6\xe9\xa5\x27\x97\x06\x1a\x67\x57\x0e\x83\x90\xb3\x0b\x21\xe4\x6c\xd9\x20\x28\x6c\xf6\xe4\xe6\x0d\x31\x8b\x1d\xa9\x8b\x6a\x79\xdd\x53\xf0\xda\x34\x10\xb6\x33\xfa\x34\x9b\xa8\xbf\xfe\xa0\x44\x47\xa7\x5e\x3b\x8a\x07\xa8\x86\x57\x77\x7a\x47\x7c\xbf\x1f\xf5\xbd\xc7\x57\x57\x5a\xda\xf8\x1a\x68\xde\xa9\x85\x58\x1e\xc1\x92\xea\xea\x8a\x2d\x2f\xa4\x45\xf1\xd7\xa1\xfe\xfb\x72\x85\x50\x81\x12\x2b\xff\x80\x33\x81\x2b\x82\xbf\xb6\xa0\x11\xce\x04\xc0\x63\xf1\x1e\x3f\xf9\x4c\xe0\x3c\x58\xc6\xdb\x63\xa1\xc4\xb7\xdc\x4a\xa6\x9f\xc8\x30\x6b\xa3\xd5\x8f\xc2\x8b\x67\x5b\xc3\x4f\xec\x44\xed\x81\x2b\x61\x03\xac\x9c\x78\xad\xd6\xca\x73\x6c\x33\xe4\x35\xf6\xd2\x5a\x8f\x90\xd7\xe0\xb1\x5b\xba\x2f\x1e\x4b\x34\x58\x48\xb9\xd9\x26\xb2\xaf\xd6\x51\x54\x64\x46\xe4\xae\x47\x7b\x0b\x72\x86\xdc\x04\x4f\xaf\xf5\x08\xb9\x09\x1e\xbb\xa5\xfb\xe2\xb1\x1c\xb9\xb9\x48\xe7\x68\x0f\x5c\xe0\x12\x27\xf0\xe2\x6a\x8f\x90\x77\xe0\xb1\x5b\xba\x2f\x1e\xdb\x28\xef\x82\x6f\x0c\xd2\xee\x8a\xc0\x89\x05\x8d\x20\xed\xc0\x63\xf1\x1e\xbf\x81\xb4\x9b\x23\x9b\x0f\xda\xba\xf0\x02\xa7\xe6\x9f\x75\x62\x1f\x7c\x27\x83\x25\x76\x8e\x6c\xd8\x69\xc3\x06\x75\x54\xfa\x59\xa5\xa3\x1c\xc2\x03\x8a\x97\xc6\x85\xf0\xbe\x22\x6c\xac\x82\x46\x10\xde\xe0\xb1\x78\x8f\x9f\x2a\xbc\xa3\xd7\xc6\x4d\x89\x16\x2b\xbf\x4c\xa1\x51\x37\x3f\x44\x7a\x3a\x0a\x45\x56\xd8\xbf\x39\x43\x7c\x85\x51\x13\x29\x23\xd6\x0e\x27\x62\x46\x4b\x62\x8b\x9b\xa0\x4b\x85\x72\x37\x18\x3e\x06\x27\x4c\x31\xb5\x75\xe1\xa2\xf4\xe2\xfc\x27\x42\x06\x43\x0c\x33\x71\x7a\x2d\x45\x5b\x17\xe1\x35\xae\x0f\x37\x75\x31\xee\x23\x88\x9d\x63\x1e\xe3\xbb\x2d\x89\x96\xaa\x79\x7c\x1e\x70\xec\xe7\xe5\x50\x4a\x74\x13\xfe\x6c\x99\xe0\xa7\xba\xa8\x1a\x0f\x72\xa4\xd7\xe0\x3b\x86\x22\x70\x45\x10\xd2\x05\x8d\xa0\x08\x80\xc7\xe2\x3d\x7e\xb2\x15\xe7\x85\x9c\xa5\x88\xf9\x05\x29\x77\x45\xe0\xc0\x82\x46\x90\x72\xe0\xb1\x78\x8f\xdf\x44\xca\x59\xe6\xf4\x91\x70\x02\x09\x1e\x5c\xed\x11\x72\x0e\x3c\x76\x4b\xf7\xc5\x63\x89\x06\x8f\xdc\xb5\x07\xe6\x87\x4c\xd6\x8d\xe9\x29\x91\xf4\xfc\x94\xfd\x7b\x0b\x16\x4e\xfb\x4c\x62\xa1\x04\xc7\x86\xd1\xa1\x04\x47\x0d\x1c\x4a\x70\xac\xc1\xa1\x04\x07\x4a\x70\xa0\x04\xc7\xf6\x89\xa2\x04\x47\x1e\x0c\x4a\x70\xa0\x04\xc7\x82\x50\x47\xe1\x42\xa8\xa3\xb0\x6c\x10\xe4\x98\x0f\x48\x40\x37\xba\x25\x6b\x3f\xe2\xb4\x78\x86\xfa\xc4\x63\xd0\xb9\x87\x92\x95\x32\xb4\x2f\x2b\x05\xe9\x1f\xb5\xe4\x2e\x6a\x85\xd8\x31\x3b\xb4\xe1\x89\xa6\x76\xd8\x7c\xbc\xca\xb4\x62\x57\xc6\x62\x99\x8d\x68\xc7\x30\x81\xc8\xf1\x73\x74\x6e\xb1\x98\x86\x05\xf2\xcd\xaf\x91\xb5\x96\x7a\xdf\xa9\xed\xa5\x2e\x7b\x51\xcc\xc1\xbc\x0f\x18\x54\x89\xe7\x60\x5e\x6f\x19\x1d\xcc\xeb\x1a\x38\x98\xd7\x6b\x70\x30\xaf\x61\x5e\xc3\xbc\xde\x3e\x51\x98\xd7\x30\xaf\x61\x5e\x87\x1a\x84\x2b\xb4\x65\x64\x5c\x04\xd1\x85\xda\x93\x75\x64\x58\xa7\x4f\xc1\x8c\xdc\x5c\x8c\xaa\x5b\x83\xcf\x87\x4b\x19\xa5\xe1\x83\x18\xe1\x9d\x51\x6c\x2f\xcc\x2a\xfc\xca\x2f\xe7\x75\xdf\x68\x46\xec\x85\xda\xaf\x1e\xc4\x46\x86\x28\xf5\xfe\x97\xad\xb6\x07\xec\x80\x2d\xa3\x83\x1d\x50\x03\x07\x3b\x60\x0d\x0e\x76\x00\xec\x00\xd8\x01\xdb\x27\x0a\x3b\x00\x76\x00\xec\x80\x50\x83\xa0\x1d\x10\xd7\xbe\x33\xce\x39\x74\xa8\x02\x59\x93\x29\x0b\x22\x21\xb5\x79\x43\x68\xf2\x42\x6b\xf3\xc1\x9a\x4d\xe1\x8f\xdb\x70\x9b\xdc\x30\xc8\x26\x8f\x3b\x96\x94\x17\x0e\x59\x00\x9c\x1f\x16\xd9\x64\xee\xd9\x37\xca\x0b\x8f\x6c\xb2\x42\x24\x9b\x34\xbf\x6f\x6e\x98\x08\xc9\x6d\xf2\x57\x33\x23\x34\xb7\x01\x8f\x96\x02\x7f\xbf\x3c\x9a\xd1\x68\x30\x35\xca\x5f\xa2\x83\xfd\x2f\x22\x7a\xe8\x18\xb9\xaf\xc0\xb9\x9e\x89\x4e\x52\xdd\xd5\x7e\x7a\x70\xfd\xe0\x0d\xb6\xf9\x02\x8f\x84\x3f\x27\x3c\x9e\xf7\x40\xa2\xb4\x22\xc9\xa4\xab\x55\x84\x4e\xcf\x3a\xad\x24\x8a\x2e\x6d\x08\xe0\xa3\xaf\x6c\x94\xfa\x28\xe0\x4a\x0a\x03\xc2\x95\xb4\x00\x84\x2b\x09\xae\x24\xb8\x92\xe0\x4a\x82\x2b\xe9\x0a\x15\xae\xa4\xdb\x26\x70\x25\xfd\x86\x5c\x49\xb8\xc7\x23\xdf\x62\x45\xe6\x6a\x41\x23\x64\x47\x83\xc7\xe2\x3d\x7e\x72\x76\x34\xc4\x1b\x58\x2f\xd4\x23\xc4\x1b\x78\xec\x96\xee\x8b\xc7\x52\xe2\x4d\xab\x9d\xd8\x0f\x86\xd8\x71\x78\x24\xa3\xc8\x91\x65\x92\x3f\x52\x28\x0d\x2a\xb5\x0e\x9d\xd1\x3d\x9b\x73\xbf\x2a\xee\x65\x76\x86\x47\x87\xf1\x2d\x2f\x91\x1d\x47\xd3\xba\x8f\x5a\x21\xa1\x2c\xb5\x7e\xc5\x5d\x29\x02\xea\xae\x41\x5c\x04\x7a\xc4\x27\x09\x3c\x76\x4b\xf7\xc5\x63\x89\x06\xf7\x22\xf6\xa5\x50\xc4\x62\x29\xe9\x89\x0e\x70\x29\x35\xb6\x51\xac\x47\x88\x6a\xf0\xd8\x2d\xdd\x17\x8f\x25\x1a\x18\x3a\xe9\xa7\x51\xcf\x0f\xbc\x4c\xe1\xe8\x54\x2b\xa0\xb9\x31\x7c\x6d\xae\x8e\x14\x2f\x4c\x21\x0a\xc6\xc6\xa4\x9e\xc3\x25\x2f\xd8\x6e\x91\x1e\x21\xd2\xc1\x63\xb7\x74\x5f\x3c\x16\x6d\x10\xf9\x51\xd1\xb3\x21\xb9\x76\x3d\x56\x45\x69\x13\x88\xcc\x2b\x02\x3b\x17\x34\x82\xc8\x04\x8f\xc5\x7b\xfc\xf4\x02\xca\x96\xd8\x60\x56\xa2\x65\x9b\xf4\x4a\x4a\xd1\x92\xb2\x11\x5f\x39\x44\xe4\x15\x81\x7d\x0b\x1a\x41\x44\x82\xc7\xe2\x3d\xfe\x6a\x5a\xe5\x20\xe5\x6a\x84\x64\xe4\x19\xbd\x16\x33\x9f\x50\x42\xf9\x58\xb1\x70\xbc\x9a\x6d\xe2\x40\x08\x5b\x6c\x84\xb5\x1e\x21\x6c\xc1\x63\xb7\x74\x5f\x3c\x96\x68\xb0\x90\x75\xb8\x86\x12\x3c\xb8\xde\x23\xe4\x1c\x78\xec\x96\xee\x8b\xc7\x52\x72\x6e\x70\x9a\xb5\x86\xb8\x23\xf6\x38\xb4\xc7\x90\x52\x97\x9a\x7e\xfa\xd9\xe0\xfb\x40\xc2\xeb\x96\xd1\x21\xe1\xb5\x06\x0e\x09\xaf\x6b\x70\x48\x78\x45\xc2\x2b\x12\x5e\xb7\x4f\x14\x09\xaf\x79\x30\x48\x78\x45\xc2\xeb\x82\xda\x03\xb5\xc7\x2a\x6d\x73\x42\x98\x3a\x28\x43\xf0\x0a\x85\xd4\xfe\x03\x69\x5a\x46\xca\xeb\x2e\x65\x40\x55\x65\x98\x71\x59\xd3\x85\x70\x59\xd3\xb2\x41\xb8\xfe\x38\xbd\xcc\xdf\xb0\xa8\xb2\x92\xfa\x20\x0a\xd5\xd1\x4b\x4d\x60\xb6\x97\x59\x15\x8f\xeb\x9e\x54\x65\xf1\x2a\x6b\x99\xed\x8e\x4c\xea\x3d\xeb\x44\x40\x6f\x49\x8d\xe2\x89\xcc\xb3\x11\xae\x70\xeb\x87\x55\xcf\x44\xbf\xa3\x26\x5d\x35\x7b\xeb\xb4\x21\xc6\x4b\xea\xaf\x37\xcd\xd0\x7f\x88\xe4\x7b\xe6\x46\x79\x1e\x60\xa3\x39\x59\x30\x92\x68\x25\xf8\x9b\xc3\xa6\xb5\x46\x4b\x27\xed\xca\xef\xd3\x27\x66\xe5\x87\x92\x5a\x5e\x86\x3a\xb1\xfd\xcc\x4c\x7a\x33\xa7\x1b\x7a\x29\x5a\xee\x2a\xb2\xf9\xe0\x24\xd9\x32\x3a\x38\x49\x6a\xe0\xe0\x24\x59\x83\x83\x93\x04\x4e\x12\x38\x49\xb6\x4f\x14\x4e\x12\x38\x49\xe0\x24\x09\x35\x08\x1a\x7a\xdd\x23\x53\xc3\xe9\x31\x24\x6c\x52\x9b\x19\x9e\x85\x0b\xc1\xb3\xb0\x6c\x10\xbd\xd9\xac\xc8\x9c\x14\xca\x92\x71\xa3\x15\xd6\x1b\xda\x89\x97\x22\x14\x64\x7a\x23\x0e\x22\xd6\x23\x62\x6d\xc0\x63\xb7\x74\x5f\x3c\x96\x68\x10\xbe\xf3\x25\xed\xee\x33\x3b\xaf\x04\xd5\xb8\x5c\x9d\x8b\xe6\x6b\x87\x3a\x8f\xcc\xc9\xfe\xb8\xd9\x1d\xd6\x96\xe5\x8c\x73\x6b\x87\x13\x31\xa3\x25\xb1\xd6\x50\x37\x59\x77\x81\x2d\x91\xde\x32\xdd\x60\xb8\xe7\xc5\xd9\x36\xa9\x53\xbb\xe9\xc5\x91\x51\x5c\x06\xe3\x44\x33\x71\x7a\x2d\x45\x5b\xa7\x25\x8d\xeb\xc3\x4d\x9d\xaf\x60\x04\xb1\x64\xad\x5f\xa0\xb8\x10\x48\xa2\xc5\xb7\xe7\xc3\x65\xc0\xb1\x9f\x97\x43\x29\xd9\x75\xdb\x22\xdc\xc2\x2c\xf8\x6c\x99\xe0\x27\xe6\xad\xbd\x20\x6b\x65\x60\x20\xa2\x18\x5f\xa0\x70\x8f\xd0\x72\xc0\x63\xb7\x74\x5f\x3c\x96\x92\xb7\xcf\xd6\x7f\x57\x91\x8c\x0b\x0e\x0c\xf5\x08\x29\x07\x1e\xbb\xa5\xfb\xe2\xb1\x44\x03\x9c\xc7\x6f\x19\x1d\xce\xe3\x6b\xe0\x70\x1e\xbf\x06\x87\xf3\x78\x9c\xc7\xe3\x3c\x7e\xfb\x44\x71\x1e\x8f\xf3\x78\x9c\xc7\x87\x1a\x24\x92\x16\x78\x2f\xfc\xc4\xb5\x62\xd6\xf1\xb8\x37\x3f\xd8\x55\x6e\xfa\x43\x02\x20\x9d\xfd\x10\x06\x90\x83\x75\xb1\x2a\xac\xa9\xe7\xf5\xa9\x1f\x9c\xd7\x9b\xa8\x3d\xda\x21\x54\x9d\x36\x81\x32\xc5\x1e\x33\x67\xb8\xb2\x3b\x32\x8c\xb7\x2d\x49\x9a\x3c\xf3\x45\x80\x3b\x6d\x5a\x62\xfe\xdb\xca\xac\x7b\x8d\x87\x35\x23\x25\xa3\x41\xe0\xc4\x44\xb5\x92\x61\x6f\xb8\x72\x93\x31\xd7\x6a\xe5\x8c\x2e\x3b\xe3\x9a\x60\xbc\xbe\x5a\xf9\x38\xe3\x6d\xf4\xf2\xeb\x04\xc4\x98\x10\x51\x8c\xb1\x29\x3f\x25\x12\x52\x52\x99\x9e\x22\x94\x75\x5c\x79\x69\x60\xb4\x67\xe0\x8f\x38\x35\x1c\x6f\x5d\x4f\x27\xae\x64\x8c\xee\x82\x96\x4e\x04\xc9\x44\x13\x3d\xe3\x5d\x57\x6d\x63\x87\x4f\xa8\x33\x01\xa2\xa7\x63\x59\x18\x89\xcd\xa6\x15\xd1\x6b\xce\x39\x78\xe4\xb6\xfa\x9c\xac\x9f\x48\x9c\x52\x21\x4f\xf7\x46\xbf\xbc\x16\x97\xb8\xb4\x3f\xd6\x68\x0c\xf6\x47\x56\x95\xa7\x68\x7f\x64\x27\x72\xbc\xe3\x8e\x97\x3e\x3f\xbd\x51\x56\x99\x37\x66\x7f\x64\x86\xf6\xa5\x0a\x82\x3d\x70\xe3\xad\xdc\x7a\x59\x50\x6d\xc0\x9f\xe5\x52\xe5\x01\x7b\x62\xb7\x58\xb1\x57\xdc\x0d\x86\xd8\x13\x19\x5b\xbc\x6c\x96\x58\x3b\x58\xa7\x4f\x5e\x4b\x93\x7b\x6d\x84\x3b\x04\xf4\x86\x2d\x50\x89\x64\xa8\x6c\x10\x76\xea\xfe\x50\x0a\x74\x3c\xc5\x8f\xe4\x93\x08\xd2\x2f\xad\xd8\xbd\xb2\x9e\xe2\xb1\xba\x61\x0c\xa7\x8d\x57\xf5\x5a\xc9\x43\xe2\x3b\x03\xa1\x3c\x1b\xd0\x12\x7b\x1c\x54\x27\xfd\xce\x08\x5e\xd4\x99\x01\x62\xc9\x3c\x91\x61\x56\x74\xc4\x48\xb5\xe6\xb5\x2f\xd6\xe4\x3f\x35\xb5\xf0\x22\x4a\x6f\x7e\x8b\xc5\x3a\xf5\x72\x50\xc7\xff\xa0\xcd\xa5\xdb\x71\x50\xb0\x65\x74\x38\x28\xa8\x81\xc3\x41\xc1\x1a\x1c\x0e\x0a\x70\x50\x80\x83\x82\xed\x13\xc5\x41\x01\x0e\x0a\x70\x50\x10\x6a\x10\x3e\x28\xe0\xa3\xcb\xb4\x54\xe3\x43\xec\x5b\x0d\xe0\x6f\x3f\x2e\x09\xb1\x6f\x0d\x78\x2c\xd1\xe3\x67\xdf\x77\xcf\x59\x58\x2f\x85\x84\xbb\x22\x70\x5f\x41\x23\x48\x38\xf0\x58\xbc\xc7\xcf\x96\x70\x52\x90\x72\x11\x77\x28\xa4\xdc\x15\x81\x03\x0b\x1a\x41\xca\x81\xc7\xe2\x3d\x7e\x1b\x29\x87\x44\x2d\x30\x60\xa0\x47\x08\x39\xf0\xd8\x2d\xdd\x17\x8f\xa5\x84\x9c\x26\xd3\x12\x73\x9a\x0d\x6e\xf7\xc7\xd8\x51\x72\xa4\x58\x38\x77\x3c\xe2\x6e\x4e\x45\x94\x0a\x92\xa1\x53\x56\xde\x75\x62\x7a\x41\x3f\x25\x79\xae\xd6\x31\x89\xc8\xd6\x0b\x21\xb2\x75\xd9\x20\x52\x6c\xbc\x65\xc5\x65\xc1\xfc\xc3\xe5\x15\x6f\xfc\xd3\x4e\x1f\x29\x70\x7c\x0a\xd5\xe4\x8a\xf0\xd9\x28\x68\x04\xd5\x04\x3c\x16\xef\xf1\x93\x55\x93\x72\xd1\xaa\x6d\xc4\x6c\x4b\x3c\x2c\x3a\x49\x75\x97\x0f\x8c\xd1\xf9\x65\x7d\x8f\x71\xfd\xc5\x23\x57\x96\xda\xc1\x10\xb3\x36\x9a\x62\x11\x66\x94\x23\x51\xef\xbb\x8f\x46\x4a\x86\x1f\x3f\x91\x33\xa2\x1d\x63\x76\x8b\x27\x31\x63\x8c\xa2\xa3\x12\xc4\xb2\x9d\xd1\x27\x46\x4f\x14\x0f\xeb\x0e\x4f\x48\x69\x35\xaa\xc5\xcc\x50\x2f\x79\x4b\x27\x52\x8e\x4d\xbd\x16\x8d\xab\xfe\x5a\x8f\xde\x68\xa7\xdb\xc2\x14\x9a\x0f\xb8\x57\x43\x0f\xa6\x2d\x53\xf3\xa7\x47\xcb\x83\x9a\xc7\xc7\x8b\x8d\x8c\xb7\xc7\x2b\xc2\xaa\x25\x6b\x45\x7f\x20\x53\x12\x48\x1c\x8b\xb8\x3d\xeb\x91\x81\x9f\x46\x3d\xef\xe6\xb7\x88\xf0\xb4\xc3\x49\x4b\xbd\x17\x9b\x83\x71\xbd\x11\x33\x06\x5b\x39\x7e\x8a\xe6\x37\xe1\x22\x0e\xc4\xf3\xbe\x03\x44\x3c\x2f\xe2\x79\x11\xcf\x8b\x78\x5e\xc4\xf3\x5e\xa1\x22\x9e\xf7\xb6\x09\xe2\x79\x7f\x4b\xf1\xbc\x51\x1d\x26\xb5\xfc\xe7\xa7\x19\xa9\x56\x77\xa5\x76\xd5\x94\xed\xc8\x3a\x71\x22\x65\x85\x56\x65\x99\x7e\x33\x4a\xec\x3c\x42\x38\x0a\x55\xf3\xc8\x5e\x49\x6e\x0c\x5f\xf3\xae\xd4\x9d\xa2\x74\x34\x72\x4b\x61\x8e\xe5\x45\xe1\xd3\xfa\x98\xa8\xde\x5c\x7a\xd5\x2b\x5c\xd2\x57\x04\x77\x61\x41\x23\xb8\xa4\xc1\x63\xf1\x1e\x3f\xd9\x25\x2d\xf5\xbe\xa6\x3c\x83\x7f\xbc\xd2\x31\x3a\x7e\x25\x7e\xd5\x9b\x85\x2b\x0b\x75\x4c\x6e\xc0\x96\x3b\xda\x6b\x53\xe3\x0b\x2c\x3f\x7a\x9d\x9f\x2f\xae\xef\xb5\x78\xbe\xdc\x9b\x38\x9c\x34\x9b\x22\xcc\x8a\x9e\xbf\xb8\xe9\x8a\x47\x30\xd7\x65\x28\x3c\x2c\x88\xf9\x33\x83\xd7\x07\x3f\x2c\x97\xee\xe6\xd7\x98\x47\xf3\xd5\x4a\xbd\xa2\x1b\xa2\xb6\x00\x7c\x91\x2b\x80\xf0\x45\xc2\x17\x09\x5f\x24\x7c\x91\xf0\x45\x5e\xa1\xc2\x17\x79\xdb\x04\xbe\xc8\xdf\x90\x2f\xb2\x32\x84\xb7\xef\x27\x9d\x7e\xf4\x00\x56\x4d\xd3\x9b\x26\x1f\x04\x35\x9a\x9c\xd5\x28\xe7\xa8\x62\xd1\x7d\x00\x58\x6f\x74\xfb\x31\x48\x66\xd7\xfe\xcb\x1f\xfe\xf8\xaf\xec\x3c\xbc\x1c\xfe\x8a\xef\x1f\xeb\xcc\xd0\xba\xc1\x50\x37\x9b\xca\xd5\x63\x8c\xb3\x2c\x02\xa9\x3f\x60\xdf\x56\xdd\xad\xdc\x0e\x26\x6a\xc6\x87\xf9\xa5\x3c\xfc\x7a\x2c\xca\x9d\x7a\x3c\x6c\xbb\x9b\xc1\x3a\xaf\xc9\xa1\x50\x00\x7c\x99\xe1\x1e\xe1\x2f\x07\x8f\xdd\xd2\x7d\xf1\x58\xb4\x41\x34\xe6\x71\x2d\xde\x31\x88\x16\xf8\xc1\x3a\xee\x86\x77\x6c\x12\x66\x1f\xde\x3a\xf1\xb4\xf2\x82\x63\x6b\xdf\x1b\xfd\x28\x57\xcf\x7c\x83\x47\xc1\x51\xfe\x08\x1f\x01\x9f\x7b\xfa\xf3\xba\x5f\x25\xfc\x45\x5a\x5d\x9b\xdb\xa5\x7f\x68\x6c\x4f\xed\x97\xe0\x53\x63\x79\xda\xee\xab\xff\x38\x4d\x1f\xfa\xb9\xee\xee\xf2\x3f\xc3\xa3\xa1\xc9\x83\x7b\x99\xf9\xfc\x0a\x9a\xff\xfe\x9f\x2f\x6f\x6f\x83\xb7\x2d\xf5\x8e\xba\xbf\xf2\x8b\x71\x7d\x14\xaa\xfb\xda\xfc\xd3\x3f\x8d\x7f\xf4\x72\x30\x5c\xce\x7f\x7a\x4b\x77\x4c\xf2\xb3\x5f\x9b\xff\xf5\xbf\xbf\xcc\xe5\x7a\xbb\xbf\x4f\x15\x99\xa7\x7f\xfe\x9f\x00\x00\x00\xff\xff\x61\x44\xe3\xc8\x7c\x5d\x05\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\x5f\x8f\xe3\xb6\x11\x7f\xf7\xa7\xd0\x17\xf0\xf6\x82\xb6\x40\xe0\x97\x22\x48\x53\x20\x48\x70\x3d\xa4\x45\x5e\x89\x31\x35\x96\x79\x4b\x91\x0a\x49\xd9\xeb\xfb\xf4\xc5\x48\xf2\xda\xe7\xb3\x44\x89\x74\x80\xbb\xed\x9c\xee\x65\x2d\xf1\x27\x72\x38\xfc\x91\x9c\x3f\xd4\x6a\xbd\x5e\xaf\xa0\x51\xbf\xa3\xf3\xca\x9a\x4d\x01\x8d\xc2\x97\x80\x86\xfe\xf2\x4f\xcf\xdf\xfb\x27\x65\xff\x72\xf8\x6e\xf5\xac\x4c\xb9\x29\x7e\x6c\x7d\xb0\xf5\x6f\xe8\x6d\xeb\x24\xfe\x13\x77\xca\xa8\xa0\xac\x59\xd5\x18\xa0\x84\x00\x9b\x55\x51\x80\x31\x36\x00\xfd\xec\xe9\xcf\xa2\x90\xd6\x04\x67\xb5\x46\xb7\xae\xd0\x3c\x3d\xb7\x5b\xdc\xb6\x4a\x97\xe8\x3a\xf0\xf3\xab\x0f\xef\x9e\xfe\xfe\xf4\x6e\x55\x14\xd2\x61\x57\xfc\xbf\xaa\x46\x1f\xa0\x6e\x36\x85\x69\xb5\x5e\x15\x85\x81\x1a\x37\x85\xd4\xad\x0f\xe8\x6c\x1b\x9a\x36\xf8\x27\x6d\xab\x4a\x99\xea\x69\x0b\xe6\x13\x28\xa9\x6d\x5b\x3e\x29\xbb\xf2\x0d\x4a\x7a\x7f\xe5\x6c\xdb\x6c\x8a\x91\xa7\x7a\xcc\x73\x45\x21\x60\x65\x9d\x3a\xff\xbd\x3e\x97\x5a\x43\xf7\xfa\xa2\x18\xc4\xd0\x57\xe0\xdf\x5d\x05\xba\xdf\xb5\xf2\xe1\x97\x2f\xef\xfd\xaa\x7c\x7f\xbf\xd1\xad\x03\x7d\x5b\xf5\xee\x96\x57\xa6\x6a\x35\xb8\x9b\x9b\xab\xa2\xf0\xd2\x36\xb8\x29\xde\x43\x8d\xbe\x01\x89\xe5\xaa\x28\x06\x69\x75\x15\x5c\x17\x50\x96\x9d\xfc\x41\x7f\x70\xca\x04\x74\x3f\x5a\xdd\xd6\x67\xb9\xaf\x8b\x12\xbd\x74\xaa\xa1\x47\x36\xc5\xcf\xbe\x08\x7b\x2c\x7a\xb1\x15\x20\x83\x3a\xe0\x3f\xba\x2a\x14\xc5\x47\x6f\xcd\x07\x08\xfb\x4d\xf1\xe4\x03\x84\xd6\x3f\xf5\xf7\x87\xdb\x24\xa3\x4d\xf1\xc3\xf5\x4f\xe1\x44\x75\xdb\x5a\xab\x11\xcc\xbd\xd7\xbd\x6f\xeb\x2d\xba\xc2\xee\x8a\xc6\xd9\xad\xc6\xda\x8f\xbe\xeb\xfc\xc0\x8f\xb6\x35\x61\x78\xaa\x7f\xe5\x87\xcf\x8b\xf6\x2f\xa5\x96\x56\xe8\x56\x97\xc7\x0e\xdf\x81\x6e\xf6\xf0\x5d\xf7\x93\x97\x7b\xac\x3b\x4d\xa4\xbf\x6c\x83\xe6\x87\x0f\x3f\xff\xfe\xd7\xff\x7c\xf6\x73\x41\xb5\x6a\xd0\x85\xd7\xce\xee\xff\x5f\x8d\x85\xab\x5f\xcf\x6f\xf6\xc1\x29\x53\x5d\xdd\xe8\xf4\x61\xce\x83\xd7\x03\xe4\xf2\xaf\x47\xb5\xdb\x8f\x28\xcf\xed\xa6\xeb\xac\xba\x45\x31\x5d\x59\xba\xe0\xe8\x7f\xd2\xe0\x83\x92\x1e\xc1\xc9\xfd\xed\xfd\xa9\xb2\x74\x6d\xdb\xdd\x0e\xdd\xbd\x3b\xb1\x92\x74\xc9\x7d\x6b\x9e\xc5\xae\xd5\x5a\x84\xbd\x43\xbf\xb7\xfa\x46\x1e\x33\x64\x73\x7d\xf5\x80\x5a\xd5\x2a\x08\x87\xd2\xba\xd2\xc7\xf0\xae\xd5\x61\x1a\xd0\xab\x4f\x98\x57\x3b\x5b\x37\x0e\xbd\xcf\x02\x29\x51\xc3\x09\x4b\x21\x6d\x4d\x95\x0a\xaa\x46\xdb\x86\x3c\x48\xe5\x61\xab\x51\xf4\x8d\xdd\x82\x7c\x6e\x9b\x18\xe0\xf5\xd0\xfd\xf2\xdf\x4e\xb7\x7e\x2f\x20\x08\xbf\x6f\x43\x69\x8f\x37\xc3\x21\x0d\x8e\x7a\xca\x1d\x40\x67\xb5\xb5\x87\xaa\x6d\x99\xd7\x95\x3d\x0c\x29\x2d\x94\x62\xdb\x3a\x1f\x1e\x59\xbd\x01\x57\x12\xa1\xe5\xe9\xef\x67\x78\x0f\xa9\xa1\x3d\xa0\xdb\x69\x7b\x14\x44\xf1\xb7\x3c\xb7\x10\xab\x21\x1e\xcf\x01\xf8\xa3\xc5\x16\x87\xe1\xa9\xd1\x54\x61\x9f\x27\xae\x0e\xaf\xec\x07\x82\x5f\x30\xec\xa7\x51\x1d\x06\x77\x12\xf8\xd2\x58\x83\x26\x28\xd0\xdd\x18\xb3\xbb\x9d\xd8\x82\xcf\xd3\xc3\x1e\x7a\x67\x1d\x1e\xd0\xc5\x90\xa6\x07\x59\x0f\x55\xc3\xcb\x63\x34\xf9\x02\x47\x14\x95\x49\xc3\x3d\x98\x03\x53\xda\x7a\x46\x77\xcc\x69\xa8\x47\x69\x4d\x09\xee\xf4\xa0\xa9\xa7\x47\x7d\x04\x1d\x0f\x48\xf4\x60\x3e\xcc\x11\x54\x5e\x6d\x02\x54\x79\x13\x16\x89\xe4\x19\x4f\x8f\xc0\x10\xad\x47\xd1\x86\x9b\xd5\xcd\xd2\xfe\x3f\x83\xe5\x8b\x66\x00\xfa\x64\x4d\x5e\x57\x05\x1b\x40\x2f\xa0\x9b\x69\xb0\x3c\xc5\x19\x59\x54\x9e\x2f\x34\x65\x63\x95\x09\xa9\x4b\x3e\x90\x12\xbd\x17\x24\x7f\x35\x31\xe0\xe2\x40\x74\xd5\x34\x41\xfe\xcb\xd9\x7a\xea\xa1\xb9\x60\x74\x79\x94\x0e\xc3\x2f\x78\xfa\x0d\x77\xb1\x67\x97\xe0\xd2\x35\x39\x06\x16\x75\xd1\xe7\x57\xb7\xd5\xf9\x33\x80\x6d\xb7\xf3\x9b\x9a\x06\x96\x8e\xbc\xcb\x3f\x87\x7f\xb4\xca\x61\xb9\x89\x3e\xb9\x2e\x9e\xf1\x14\x7d\x2a\xa2\xb5\x8b\x1f\x3c\x80\x6e\x23\x52\x9d\x29\xcd\x0e\x89\x75\x94\x75\xf4\xc1\x3a\x3a\xe3\x21\xf0\xbe\xad\x51\x38\xab\x51\x80\x9b\x58\xaa\x33\xdb\x32\xdb\x32\xdb\x32\xdb\x32\xdb\x3e\x88\x6d\x3d\x7a\x32\x02\x8b\xe9\x9e\x60\xda\x65\xda\x65\xda\x65\xda\x65\xda\x7d\x10\xed\x1e\x71\x2b\x54\x49\x36\xd6\x70\x12\xc1\x3e\xa3\x11\x3b\xa5\x27\x3a\x85\x19\x98\x19\x98\x19\x98\x19\x98\x19\x38\x83\x81\x51\x7a\x41\x41\x3a\xa0\x0c\x3a\x21\x1d\x76\x0c\x0c\xda\x0b\x87\x1a\x28\xe8\x43\xb4\x4e\x6d\x56\x79\xba\xc3\x24\xcc\x24\xcc\x24\xcc\x24\xcc\x24\x7c\x97\x84\x1d\x56\xb9\xd1\x18\xbd\x63\x41\x5c\x3c\x74\x9b\x55\x9e\xa6\x31\x65\x33\x65\x33\x65\x33\x65\x33\x65\xdf\xa5\x6c\x1f\xfc\xcd\x6a\x79\x9a\xc2\x99\x74\x99\x74\x99\x74\x99\x74\x99\x74\x33\x48\xb7\x75\x13\x72\x89\x0a\x3a\xf2\x82\x39\x71\xea\x91\x77\xec\xac\xab\x21\x3d\xba\xae\x2c\x85\xc1\xa3\x56\xf1\x80\xc4\xe9\x1e\xaf\xd1\x7b\xa8\x70\x7a\x17\x10\x15\xd7\xf0\xc8\x28\x00\x9a\x76\x82\x02\xd6\x94\x6c\xd4\x19\xf0\x27\x1e\xa1\xd4\xa3\x89\xdb\x3a\xf8\xc3\xc4\x6d\x39\x79\xb7\xf6\x55\x03\xf2\x79\xe2\x89\x3d\xf8\xfd\xc4\x6d\x4a\xce\xd2\x28\x3a\xba\x4b\x97\x62\x44\xe9\x94\x91\xba\x2d\x51\x04\xa8\xc6\xbb\x2b\xd6\xe7\xda\x56\x3e\x80\xdf\x8b\x29\xfd\x9b\x0d\xd2\x38\xdc\xa9\x97\xcd\x2a\xa1\xb9\x33\x5a\x31\x52\x76\x42\x4c\xf0\xa9\x75\xf8\xab\xad\x7e\x30\xa0\x4f\x41\xc9\x3b\xe3\x67\x7a\x6c\x41\x59\x76\xf2\xdd\x29\xd4\x65\x9a\x70\x3a\x08\x55\x63\x16\x06\xb5\x43\xb8\x21\x81\x72\x34\x80\x36\x22\x62\xce\xd9\xe2\x9c\x2d\xce\xd9\xe2\x9c\x2d\xce\xd9\xe2\x9c\x2d\xce\xd9\xe2\x9c\x2d\xce\xd9\x7a\xe3\x39\x5b\xb2\x3b\x77\x03\xdd\xe8\x82\x31\xbe\xea\x9b\x61\x55\x8c\x83\x2c\xb3\xd4\xcc\xc3\x9b\x6d\xa1\x99\xd1\x0b\xcb\x2c\x33\x0b\x00\xe7\x5b\x64\xe6\x8c\x85\x25\x96\x98\xb8\x15\x26\xa2\x3b\xb3\x1f\x8a\x58\x07\x67\x48\x6b\x86\x55\x90\x75\xec\xff\x58\xc7\xb2\x32\x53\x23\x92\xa4\x53\x85\x9c\xc1\x80\xbe\xdf\x9f\x8b\x1a\x9a\x46\x99\xea\x3e\xd8\xf5\xc1\x39\x31\x05\x8a\x76\x61\xa4\x59\xda\x4a\xd0\x34\xfd\x6d\x56\x29\x9d\xa9\x6d\x35\xb1\x2c\x89\x54\xce\x61\x6d\x0f\x28\xae\x84\x73\xff\x20\x9a\xb9\x95\xf1\x7b\x70\x58\x8e\xdb\x77\x78\x1e\xe2\x79\x88\xe7\x21\x9e\x87\xbe\xe9\x79\xe8\xd5\x4e\x3c\x91\xfd\x15\x91\xe7\xc5\x4e\x9c\x8b\x11\xb5\xe6\x4f\x97\xaf\xd0\xa0\x83\x80\x65\xdc\x6a\x3d\x02\x34\xde\x75\xeb\xeb\x7d\xc9\x9d\xbb\xe7\x99\xeb\xce\xad\xcb\x3c\xb2\x5a\xd0\x39\x9d\x17\xc0\x07\xeb\xa0\xba\x23\xd2\xe9\x21\x08\x6d\xb0\x14\xac\x01\x01\x2f\xb1\xce\x53\xe2\x18\x57\xeb\xae\x1a\xf3\x40\x46\x3b\xa7\xc7\x50\x75\xe9\x05\x34\x4a\x0c\xc7\xfa\x65\x40\xf5\xda\x4c\x12\xcd\xd1\x99\xbe\x5a\x83\x88\xa3\x81\x84\x3c\xdb\xf3\x6c\xcf\xb3\x3d\xcf\xf6\xdf\xf4\x6c\xff\x05\xe5\x8d\xfb\x7f\x98\xef\x98\xef\x98\xef\x98\xef\xde\x10\xdf\x79\xf0\x7d\x9e\xf5\x66\x95\xd6\xf1\xcc\x78\xcc\x78\xcc\x78\xcc\x78\x5f\x31\xe3\x71\xb8\x1c\x87\xcb\x71\xb8\x1c\x87\xcb\x71\xb8\x1c\x87\xcb\x71\xb8\x1c\x87\xcb\x71\xb8\xdc\x1b\x0f\x97\xcb\x70\x80\x8c\xb3\xfd\x64\xc1\xf1\xf5\xf0\xfa\xd6\x5d\x34\xfa\xc4\x8d\x09\x72\xb5\xa0\xd1\xdd\xe7\xac\x8e\x10\xe4\x3e\xc7\x2d\xe6\x83\x43\x18\xd9\x63\xc5\x74\x16\x8e\x5e\x28\xe3\x03\x18\x89\xa2\x71\x96\x92\xbe\x6e\x72\xa3\xc3\xe5\xc3\x5a\x4b\xe9\x15\x8e\xd3\x07\xce\xb3\x99\x82\xcd\x14\x6c\xa6\x60\x33\xc5\x37\x6d\xa6\x20\x92\xf3\x28\xd9\xdd\xce\xee\x76\x76\xb7\xb3\xbb\xfd\xad\xba\xdb\x89\xe5\x82\x8f\x7c\xd3\x22\x22\xd1\x33\x48\xfc\x94\xf6\x19\x40\xf4\x49\x2a\x1f\x46\x54\x2b\xd6\x0b\x6c\x5b\x66\xdb\x32\xdb\x96\xd9\xb6\xcc\xb6\x65\xb6\x2d\xb3\x6d\x99\x6d\xcb\x6c\x5b\x7e\xe3\xb6\x65\x69\x8d\x6c\x9d\x43\x23\x47\x7a\x34\x36\x9c\xb3\xb2\x1c\xa7\x2c\xdb\x7c\xb8\x18\x1f\x2e\xf6\xe5\xe1\x62\xfb\x10\x1a\x32\xc8\xbf\x4c\xaa\xeb\x28\xfe\xeb\xd9\x64\xaa\x9e\xe8\xee\x98\xce\x50\x37\x88\x3d\x98\x52\xa3\x4b\xaa\xc6\x03\x72\x68\x2b\x67\xdb\x46\xd0\xce\x71\x9c\xd1\xa3\xb5\xb8\x85\x89\x89\x64\x06\x54\xf2\xde\xf5\x73\x88\xac\x9a\x38\x24\xb6\xc3\x52\x90\x29\x01\x7d\x1a\x37\x51\x7d\x7a\x17\x52\xfa\x7e\xfc\x06\x23\xb9\x51\xb4\xec\xc2\x03\x9a\xe0\x45\x83\x4e\x6c\xef\xbb\xc6\xe6\xd0\x35\x21\x9d\xe9\x6e\x6a\x85\x1d\xc5\xb9\x50\x66\x9a\xf2\x35\x6d\x10\x24\x9e\xa1\x59\xe7\x3d\x6b\xbf\x58\xea\xa6\xce\xb4\xb1\x71\x83\x3b\x13\x6f\xbc\xa1\x77\xf1\xc6\x97\x1a\x91\x56\x4f\x1d\xa9\x1c\x2d\xda\xe5\xbe\x3f\x70\xd0\x7e\x81\x98\xa5\xa3\x57\x68\x8f\x50\xf9\x01\xce\x61\xa0\x3d\x96\x35\x42\x19\x51\x42\xa2\xb2\xfd\x49\x28\xc9\x8d\x23\x1b\x1d\xa5\xe5\x82\xef\x25\x9f\xa6\xea\x57\x28\xe9\xbe\xee\x71\x6b\xeb\x7a\xd0\xd6\xd5\x82\x29\x9a\x8e\x62\x28\xed\x9d\x53\x2a\xa6\x57\x73\x94\x33\x3a\x2a\x4b\xf6\x13\xb1\x9f\x88\xfd\x44\xec\x27\xfa\xa6\xfd\x44\xec\x58\x61\xc7\x0a\x3b\x56\xd8\xb1\xc2\x8e\x15\x76\xac\xb0\x63\x85\x1d\x2b\xec\x58\x79\xf3\x8e\x95\x7e\x0d\x43\xe6\x02\x8d\x07\x1c\x21\x89\xc8\x6b\xca\x52\xec\xad\x0f\xe3\xeb\xf1\x78\xf9\xfe\xdb\x0c\x79\xa5\x25\x04\xac\xac\x3b\xa5\xa2\x24\x9b\xa8\xa9\xf1\x79\x86\xfe\x9c\x8f\x90\x10\x29\x0f\x33\x50\x52\x25\xa8\xfc\x8c\x44\x81\xd1\xf2\xc6\x0a\xef\x35\x7d\xae\x45\x95\x30\xbe\x88\x88\x35\xa3\xb1\x2e\x4d\x88\x1e\xdd\x41\x25\xea\x0e\x55\x3c\xf9\xc5\xe9\x1f\x5d\xe9\x59\xcb\x07\xa8\x9b\x64\x04\xb2\xab\x5d\x0d\xdf\x34\xa1\x13\x08\x39\xab\xd2\x4b\x7f\xf4\x39\xef\xf6\x5e\xa7\x14\x1e\xdf\x54\xaf\xcf\x56\xba\xd5\x02\x26\x44\x0d\x9e\xbe\x6f\x83\xe0\xe4\x9e\xad\x82\x6c\x15\x64\xab\x20\x5b\x05\xd9\x2a\x78\xb6\x0a\x42\xd3\x68\x25\x21\x64\x05\x7d\xb3\x69\x91\x4d\x8b\x6c\x5a\x64\xd3\x22\x9b\x16\xd9\xb4\xc8\xa6\x45\x36\x2d\xb2\x69\xf1\x8d\x9b\x16\xb7\xad\x7e\x7e\x0d\xe2\x1b\x42\x1c\x63\x23\x28\xf2\x4e\x09\x5d\xe8\x71\xea\x22\x92\xb7\xda\xbc\xd5\xe6\xad\x36\x6f\xb5\xbf\xe2\xad\xb6\xd4\x0a\x4d\x10\x12\xc7\x4c\xd2\xcc\x72\xcc\x72\xcc\x72\xcc\x72\x6f\x81\xe5\x46\x3b\x8a\x49\x8e\x49\x8e\x49\x8e\x49\xee\x8d\x90\x9c\x68\x60\xcc\x94\xcf\x4c\xc7\x4c\xc7\x4c\xc7\x4c\xf7\x6d\x33\x9d\x35\x94\x72\x38\x61\x88\x8e\x48\xb3\xff\xa2\xa5\xd8\x23\x94\xe8\x7c\x06\x84\xfa\x84\x22\x60\xdd\x68\x08\x69\x35\xa1\xcc\xc0\x73\x2e\x34\x1a\xd8\x8e\x19\x1b\x63\x3d\x79\x8d\xa3\x74\x46\x6e\xf6\x2d\x50\x63\xb5\x92\xa7\x07\x42\x09\x72\xd3\x1d\x9d\x0a\x0f\x68\xe9\x43\x5a\x79\xee\xbf\x07\xa3\x51\xb4\x99\x32\x25\xbe\x88\x06\x42\x40\x67\x7c\x62\x7b\x71\x07\xad\x0e\xe2\xb3\x98\xb1\xac\x4f\x89\x96\xb8\xd3\x28\x83\x75\x02\xb4\x82\x34\xf5\xef\x75\x95\xba\x35\xad\x55\xf8\x22\xb1\x0b\x73\x99\x74\xea\xc7\x50\x76\xa0\xb4\xb0\x46\x34\x6d\x08\xca\x54\x17\xe1\x9f\xfd\x9a\x12\xb1\x4c\x84\xd6\xd4\x6b\x46\xd0\xe9\x1e\xe8\x1f\x81\x21\x3c\x36\xe0\x20\x58\x97\x24\xf1\xe4\x98\x5f\x2a\x98\xd6\xc9\x14\xa8\xd9\xf5\x0f\x9a\x32\x09\x40\x4d\x7c\x44\x3e\x56\xb4\x32\xd6\xa1\x78\xd5\x93\xb4\x16\x64\x32\xd8\x15\x6b\xa9\x32\x17\x21\x93\xf7\xce\xa1\xdb\x3d\xa7\x28\x23\x5a\xa7\xf3\x90\xb2\x82\xc0\x5f\x41\xce\x71\xc5\xa9\x30\xd4\x9a\x92\xc6\xec\x40\x93\x69\x62\xee\x84\x92\x4c\xe0\x03\x4f\x3b\xdc\xa9\x97\x24\x00\x3a\x7e\x02\xbd\xf8\xdb\xbb\x77\xc2\x21\x24\x47\x28\x6b\x5b\xf9\x00\x7e\xdf\x09\x24\xe3\xfb\x06\xaf\x38\x71\x8c\x19\x95\xc9\x93\xcb\x35\x46\x26\x05\x9e\x13\x07\x4e\xa2\xc2\x20\xd0\x67\xcd\x82\x17\xb0\xdb\xd9\x23\x09\x8e\xb6\xdc\x47\xeb\x46\x58\x82\xb7\xdd\xbc\xed\xe6\x6d\x37\x6f\xbb\xbf\xe9\x6d\xf7\x78\x4c\x64\x44\x8a\x8d\x6a\x70\xfc\x38\xc2\x58\xe1\x48\xb6\xd4\x78\x78\x1e\xcd\x39\xe8\x84\xfd\x28\x3c\x3a\x05\x5a\x7d\x1a\x0b\x44\x8c\x75\x98\x43\x69\x8d\x41\x19\x68\xb3\x81\xce\xd9\x64\x1c\x6d\xa1\x14\xb0\x0b\xe8\x92\x84\x31\x00\x0c\xb5\x89\x2d\x8b\xa3\x15\xb1\x46\xd0\x16\xaa\x75\x98\x0a\xd3\x9d\x46\xf5\x8c\x27\x4f\x92\x69\x9b\x32\x75\xfa\xbc\x8b\x94\xbc\x79\x78\x8d\xfe\x9a\x8a\x79\x8c\x62\x78\x3a\x01\x54\x86\xac\xee\xa2\xf5\x45\x80\x2a\xad\xb4\xd5\x9a\x36\x0d\xa2\x5b\x9e\x26\xf6\x90\x6d\xbb\xb5\x4d\xaa\x24\xbd\xdc\x63\x8d\x69\x45\x8d\xa2\x0c\x0c\x21\x35\x78\x9f\x6e\x5c\xa1\x8c\x49\x5a\xab\xe5\xac\xf5\x3a\x0c\x65\xb2\x31\x0e\xe8\xd4\xee\x94\xd6\x13\x43\xf9\xf4\xf7\xb7\x4d\x97\x7a\x29\x4a\x2b\xc5\xd1\x41\xe2\x86\xeb\x15\x86\x5e\x17\xed\x95\x71\x9c\xac\x5c\x54\x70\xb4\x80\xef\xd4\x3a\x17\x84\x9e\x4a\xc7\x38\xdb\x8b\x38\xca\x93\xa3\x3c\x39\xca\x93\xa3\x3c\xdf\x68\x94\xe7\x2b\xcf\x8d\x8b\x76\x2e\x53\x66\x5a\x31\xcf\x38\x3e\xad\x16\x33\xce\xa7\x8e\x16\x16\x19\x86\x35\x4a\xd0\x10\x0d\x38\x8f\xfd\x36\x20\x79\x6d\xd7\x03\x39\x94\x2a\x79\x41\x30\x6b\x02\x1f\x2d\xdd\x1a\xda\xd4\x1c\xd0\x75\x7e\x9c\xa1\x31\xa7\x26\xb1\x63\x5a\x9f\xb8\x42\x6e\x83\xcc\x59\xde\x0e\x67\x80\xa0\x18\x82\x5f\x66\x2c\xb0\x26\xc0\xba\xd5\xdd\x95\x5d\xb1\x4b\x13\x0d\xe0\x42\xaa\x7f\xea\xa8\xc2\x5e\x04\x07\xc6\xd3\x99\x1f\xe8\xe8\x18\xe0\x44\x24\x72\x98\x0a\x5a\x8a\x44\x4f\x3c\x19\x91\xf5\x04\x45\xf4\xce\xbc\xf2\x3d\xd4\xe8\x1b\x90\xf7\x74\x40\x05\xac\xef\xaa\xc6\x8c\x77\x82\x73\x70\x4b\x82\xf7\x17\x5c\xd3\x73\x0e\x7d\xd8\x80\x6c\x10\xc2\xb7\xbb\x88\x41\x7a\x5c\x90\xd0\x34\x11\x6f\xd6\x78\x59\x4e\x29\xe7\x94\x72\x4e\x29\xe7\x94\x72\x4e\x29\xe7\x94\x72\x4e\x29\xe7\x94\x72\x4e\x29\x7f\xe3\x29\xe5\x53\x3b\x45\xfe\x10\x17\x7f\x88\xeb\xcb\x0f\x71\xa5\xfb\x48\xe7\x6d\x6c\x46\xcb\xfb\x53\xad\x95\x79\x16\xb1\x0a\x8c\x69\xda\xb8\x3d\x6b\xdd\xd5\x6d\xb5\x40\x10\x3b\xa5\x03\xba\x25\xbb\xc8\xd8\x60\x92\xd6\xc8\xb1\x81\x38\x67\x28\x76\x08\x41\x99\xd6\xb6\x5e\x90\x2b\x5a\x38\xac\xf0\x65\x62\xe1\x1f\x15\xf8\xb2\xc5\xfa\xbc\x99\x9f\x0e\xa7\xc3\x86\x0c\x4c\xdd\xc2\x69\x72\xbc\xc7\x3a\xf4\x2e\x62\x8d\x01\x28\x5a\x39\xbb\xdd\xb3\xaa\x16\xc1\xa8\x5b\x1d\x54\xd7\x19\x68\xca\x47\x75\xc8\x05\xb4\xb3\xdc\x3c\x0a\xd6\x74\x5a\xe3\xe3\x38\xb1\x0e\x5e\xd4\xb7\x91\x4a\x9d\xb1\x22\xfe\x85\x99\x68\x91\xd8\xbc\x25\x48\x43\xcc\x7f\x49\x1b\x80\x70\x7a\x48\x5b\x87\xb5\xa6\xd0\xb0\x45\x9d\x8d\x46\xc1\xf9\x3b\xe5\x7c\x88\xc5\xaf\x2e\x19\x69\x04\xfa\xe0\x81\x16\x99\x6d\xc8\x40\x52\xda\x2c\x5e\x2c\x51\x94\x36\x08\x83\x3e\x60\x99\x2f\x83\x01\xee\x51\xba\x34\xa7\xfd\x6d\x93\xd3\x7e\x3a\xd1\x18\xdc\xd4\x17\xfb\x22\x53\xd7\xa2\xf6\x4c\x1b\x45\x2f\xff\x3a\x63\xc8\x43\xc6\x0d\xed\x49\xfb\x44\x97\x07\x90\xd7\x30\xb0\xbf\x2e\x59\x1d\x95\x29\xed\x31\xb7\x71\x33\x34\x2d\xa0\x0c\x3f\x45\xf2\x11\xe6\x29\x9d\x06\x53\xb5\x50\xe1\x57\x24\x45\xd2\x93\xed\x69\xd4\x31\x37\x5f\x90\x3d\xd4\x83\x66\xcb\x61\xe7\xb3\x59\x65\xca\xe0\xb2\x2a\x58\xba\x60\x8b\x00\x0f\x71\x6b\x14\x0d\x33\x15\x26\xbf\x00\x71\xea\x9b\x85\x8f\xe3\x4d\x34\x7b\x30\x12\x7f\xf9\x3e\x4b\x8f\xe9\x28\xf2\xee\x4b\x8d\x5f\x91\x22\x6f\x11\x1c\x3a\x11\xec\x33\x9a\x89\xb8\x9e\x05\xef\x9d\x3c\x05\x6e\xbe\xb0\x66\xc6\x0a\x2d\x03\x5c\x16\xd3\xb1\x1c\x7b\x76\x7c\xc7\x02\x79\x5e\x5f\xe3\xfe\xeb\x07\x80\xcf\x8f\xfb\x58\xb2\xc4\x99\xb3\x67\x4e\x89\x04\x99\x39\x72\x97\x3f\xfa\x3f\xf6\xae\x66\xb9\x95\x15\x07\xef\xf3\x14\x7e\x81\x6c\x66\x35\x95\xed\xdd\xce\x62\xde\x80\x22\x34\xee\x66\xd2\x86\x1e\xa0\xe3\xf8\xed\x6f\x41\xb7\x93\x9c\x5b\x01\x89\x9f\x1c\xbb\x72\x54\xe7\xec\xdc\xf9\x10\x42\x08\x24\xf4\x03\xc5\x1f\x15\x72\x17\x11\x8b\x44\x32\x4c\x32\xdc\x55\x86\x51\x9f\x09\x2e\xa6\x90\xfa\x7b\x0c\x2f\x1c\xed\x77\x8f\x5f\xe0\xd8\x2b\xb7\x2a\x13\x7a\x51\x0e\x9c\x77\x7d\x97\x61\x79\x3f\x77\x80\x82\x4a\x01\xd2\x51\x47\x47\x1d\x1d\x75\x74\xd4\xd1\x51\x77\xfb\xa3\x0e\x28\x68\x48\xaa\x9a\x54\x35\xa9\x6a\x52\xd5\xa4\xaa\x6f\xaf\xaa\x43\x19\x9b\xe0\x28\xdb\x83\xca\xef\xc8\x55\x16\x1e\xa8\x80\xd0\x2b\xe4\x78\x6a\x6b\xd4\x15\xa3\xc7\x33\x21\x08\xb7\x98\xa4\xd2\x6c\x31\xc3\x9d\x11\xf5\xb2\x3e\x4b\xab\xa5\x97\x2e\x5d\xc6\xa7\x60\xd0\xcd\x7b\xc2\x06\xd5\xe1\xed\xd6\xcd\xef\x8f\x98\x62\xe2\x0a\x61\xef\x42\xdb\x7a\xcb\x9a\x4d\xb7\xde\xc4\x63\x81\x1b\x6e\x94\x46\x35\x3d\x07\xee\xd5\xae\xd8\xac\x9e\x2d\xb7\x17\x98\x60\x80\x9d\x91\x20\xf6\xaf\x50\xd0\x87\xe7\xe3\x5f\x8b\x00\x3b\xc3\xcd\xc6\xbc\xac\x0b\xf8\x06\x8a\x42\x04\x33\x11\x50\x7b\x8d\x0f\x83\xda\x8e\x98\xff\xa2\xcf\x54\x14\x79\x28\x31\xc2\x6f\x64\xf7\xa2\x16\x16\x88\xd5\x23\xd3\xeb\x3c\xef\x89\x18\xdf\x2f\xe8\x56\x36\xc9\x39\xd7\x43\xe3\x0a\x61\x46\xd9\xdf\x9a\xde\x62\xa9\x30\xe8\x33\xd4\xa8\xf7\x77\xcf\xca\x96\x2d\x6b\xc6\xff\x8e\x8b\xd0\xe3\x95\x66\xc4\xb7\xc8\xad\x82\xdf\x30\xd7\x69\x41\x51\x59\x24\x11\x7f\x92\x44\x20\x41\x31\x70\x08\x6d\x83\x90\x2a\xbc\x3c\xa1\x24\xa9\x60\x8d\xd1\xd2\x83\xc6\xc4\x49\x0c\x2c\x2b\x38\x29\xe9\xb8\x94\xc6\xfe\xb6\x55\x44\x48\x0d\x7a\x54\xd2\x48\x3f\x40\x23\xd1\x19\x45\x67\xd4\xb7\x9d\x51\xb0\x68\x21\x84\x0a\x2f\x4e\x28\x41\x2a\x58\x62\xb4\xf0\xa0\x31\x71\x02\x03\x8b\x0a\x4e\x48\xba\xad\x24\x08\x14\x4b\x85\x24\x4f\x31\xcc\x0a\xca\x90\x26\xa7\x74\x2c\x74\xb1\x1b\x97\xcc\x9b\x5c\x11\x42\xbc\x95\x79\x88\x29\x53\x2c\xba\xce\xd9\x51\xc9\x79\x80\x11\x81\xc5\x54\x3a\xf0\x22\x78\x12\x7a\xc5\x43\x06\x28\xc8\x97\x8d\x02\x8a\x6b\xf1\xf4\xd0\xbe\xa1\x06\x19\x93\x17\x73\x29\xc8\x05\x64\xfd\x82\x98\x2f\x82\x5d\x81\x2c\x9d\x57\xa7\x50\xb7\x47\x84\x62\x86\xda\x33\xf9\x2a\xb5\xc7\xc1\x43\xc2\x13\xfe\xc9\xb7\x58\xe4\x41\x99\x7e\x24\xe7\x92\x2e\xab\xe1\xb6\x6c\x8f\x7c\x2a\x66\x21\x70\x4c\xac\xba\x16\x15\xea\xc7\xd1\x98\xe0\xc2\xfa\xcb\xd8\x6c\x04\x9f\x63\xba\x4b\x3f\x5a\xdf\xc3\xaa\x9f\x1e\xba\xdc\x91\xd0\x93\xc1\x28\xe7\xfd\x19\x2c\x38\xe5\xe4\x69\xf1\x97\xd0\xa1\x43\xe9\xf1\x29\xfb\x79\xc9\xec\xa3\xbf\x6f\x53\x9e\xbd\xb7\xed\x8e\xe7\x3a\xf1\x15\xab\xd9\x5a\x74\x46\x29\xf7\x4a\xf5\x47\x21\x07\x4b\x74\x49\x15\x74\xd1\xf6\xaf\x63\x0e\x7e\xcb\xd6\xe1\x17\xef\x8d\x86\x61\x8a\xf6\x49\xd5\x82\x04\x3e\x65\x6b\xbb\xf5\xc0\x2f\x5c\xeb\x32\x70\xe8\x29\xb6\x09\x3d\x5f\xb7\xa1\x05\xfc\xdb\xa8\x4e\x97\xa3\x6b\x44\xce\x56\xd5\xa8\x17\x75\xa4\x5d\x51\x72\x7c\x15\x08\x35\x9a\x07\x58\x41\x2e\x03\xc4\x88\x41\x11\x22\x46\x60\xf1\x80\x5d\xa9\xc3\x08\x26\x1a\x0d\x21\x8c\x58\x31\x44\x09\x60\xb4\x83\x52\x5d\xef\xd0\xd7\x0a\xfc\x95\x02\x7d\x95\x2d\xe0\x59\x85\xc9\x54\x84\x5e\x7b\x05\x2a\xd1\x17\x25\x57\x9f\x02\xd2\xb1\x27\x60\x31\x24\xde\x84\x2a\x02\x2f\xbc\x47\x95\x70\xb8\xd0\x94\x2a\x22\xbb\xe4\x6e\x56\x42\x33\xda\xa4\x42\x5f\xfe\x8b\xa6\x85\x3f\x99\xaa\xae\x8f\x25\x9c\xa8\xb9\x36\x16\xcc\x74\xc7\x74\x1d\xf9\x5c\x66\x66\xb5\x19\x5a\x65\xbc\x2c\xd7\x38\xc5\xfc\x2c\xd3\x3e\x95\xf0\x15\x46\x57\x0d\xa3\x4a\x0d\xaf\x9a\x31\x2a\x8d\xaf\xea\xa1\x2a\x0c\xb0\x8a\x05\x2a\x36\xc2\x6a\xc7\x28\x5e\xff\xd2\x01\x30\x37\xc6\xa6\x11\xf0\x06\x59\xcd\x00\xdf\x4a\x3d\xde\x30\xab\x40\x47\x1b\x67\xe5\x5b\xa1\xc0\x40\x2b\x39\x08\x8b\x84\xbe\x80\x1f\x78\x41\x2f\x05\xc5\x89\x47\x21\x2a\x4e\xa0\x4b\x40\xbb\x53\x89\x13\xdc\x02\x44\x94\xb0\xe2\xc5\x14\x29\xa0\x18\xd1\xfc\xe8\xa8\x15\x43\xf3\xb1\xef\x7b\x10\x95\x56\x2e\x73\x08\xf2\xbf\x3e\x49\xba\xd0\x6a\x4b\x0b\xd9\x03\xd9\x49\xfb\x2a\x19\xae\x6c\x16\x16\x0d\x3a\xc4\x31\x68\xe0\xaa\x2c\xd6\x9c\xa4\x9f\xe4\x9a\x14\x2e\xcc\xd5\x30\x9a\x2b\x99\xdf\x6b\xa2\xa2\x91\xa2\x8c\x92\xbb\x93\xf4\x56\x89\xec\x80\x88\xab\x32\xfe\x92\xfc\xbc\x8a\x17\x99\x6a\x5d\x5d\x31\xc9\xf0\x7f\x90\x4e\x74\x05\xec\xad\x9e\x61\x21\xa8\x15\x85\x62\x52\x90\x62\x51\x92\x6e\x76\x3b\xe5\x8f\x8d\x30\x09\x02\x02\x7c\x12\xa6\x0a\x7c\x12\xe6\xf9\xd0\x81\xb3\xb0\xa2\x07\x81\xf6\x98\x91\x93\x19\xd4\x51\x49\xdb\xa2\xa0\xc4\xc4\x2d\x93\x5a\x98\x01\x30\x57\x50\xab\xb2\xd8\x50\x24\x70\x2f\xc8\x4b\x69\x27\x45\x69\x27\x1f\x87\xbb\xeb\xc0\xb9\x78\xa2\xb7\xb2\x0e\xaf\xd7\xbf\xc9\xf1\xd9\x5b\x13\xef\x7c\xb9\x81\x0e\xfa\x60\x50\x73\x38\xdc\x3e\x89\xdf\x25\x97\xe7\x49\x79\x39\x2b\xe7\x7b\x88\x26\x56\xb5\xc5\x06\x4b\xc1\xf1\xd4\xa6\xdd\xf8\xea\x4d\xcc\x86\x15\xdc\xf9\xd6\x2b\xe3\xb5\xa9\x12\xb3\xeb\xf3\xa5\x1d\x2c\xfa\xbd\x28\x3d\xaf\x38\x3d\xaf\xaf\x9e\xd4\xf2\xdc\x29\xbf\xef\x8a\x86\xb1\xf0\xfb\xec\x94\x81\x0b\xdf\xb4\x3b\x80\x06\x38\x28\x3a\x0f\x07\xb1\x3a\x6f\x4e\x57\x37\x64\xab\x18\xa2\x86\xc4\x49\x0a\x24\x22\xbf\x97\x9a\xfb\xe3\xcf\x7e\x90\x9c\x80\x07\x0a\xd4\x88\x8e\xcf\x59\x10\xaa\x5b\x43\x75\x6b\xa8\x6e\x0d\xd5\xad\xa1\xba\x35\x37\xab\x5b\x03\x7e\xe2\xf8\x69\x49\xd7\x44\xc6\x08\x54\xcc\x3d\x01\x24\x09\xb5\xc0\xfd\xba\xaf\x2c\xd2\x0a\xa9\x3d\xaa\xe2\x38\x84\x65\x43\xd6\x45\x8f\xe9\xd9\x74\xbb\xe4\xda\xcb\x38\x6e\x06\x08\x29\x40\x7c\xe2\xfc\x90\xe9\x11\x87\x11\x14\xb3\xfa\x65\xf5\xe0\x9b\x15\x82\x99\x30\xb1\xeb\xc9\xcc\x66\x54\xa2\x85\x5e\x61\xe6\x59\x0a\x6f\xec\xfb\xe3\x4b\x23\xd9\x9f\x21\xfb\x78\xcb\xf6\x74\x67\x16\xba\x22\x71\xa5\xa5\xdd\xfa\xe4\x74\xc3\x3d\x72\xa1\x66\xe5\x2f\x9d\x61\x27\xe3\x7c\x67\xc8\x8f\xb2\x55\x7d\x71\x43\xcd\xa9\xce\x88\x56\x19\xdb\x9f\xa7\xab\x56\xbd\x78\x3a\x9b\x11\xf1\x0c\x8e\x82\x72\x66\xb5\x42\x32\xc1\xbd\x1c\x8d\xbd\xf4\xc6\xeb\xb7\x33\xff\x09\xdc\xab\x0b\xc4\x3f\x60\x77\xf3\x8b\x0d\xdc\x4d\xbd\xc0\xc3\x6e\xea\x89\xd5\x9d\xa9\xbd\xb1\xfa\x11\xe8\x2d\x17\x4a\x8f\x8c\x6b\x6d\x7c\x2c\x0b\xde\x6b\xe1\xaf\xc8\x1f\x9a\xb9\x2b\xc1\xd8\xed\x09\x5d\x79\xaf\x78\x5d\x64\xe8\x0a\x16\xdf\x3e\x7b\x33\xf2\x5d\xc1\x77\x43\x5c\xcc\xd0\x13\x8b\xa9\xe1\xbb\xaf\x35\xa1\x3d\x8d\x0e\x2b\x3f\xab\xc6\x74\xf4\x2e\xea\x1d\xa6\x77\xb2\xc6\xfb\x36\xf3\x22\xf6\xa5\x61\x5b\x0c\xc1\xd6\xdc\x1a\xa6\x1a\xba\x11\xff\x82\xb9\x48\xab\xcc\xc0\x5c\x2f\xd8\xc1\x9a\x85\xcd\x66\x44\x00\x42\xbb\x73\xa3\xb3\x87\xf1\xb1\x21\x85\x60\x1a\xcf\x82\x21\xd2\x6f\xba\x67\x6e\x75\xd8\x01\xb1\xc5\x7f\x3b\x2c\x2c\x53\x96\x0b\xf9\x97\xd1\x5e\xbe\xf9\x16\xb9\xda\xcf\x93\xfb\xf2\x17\xbb\x85\x6b\xa6\x86\x2e\x4b\xfe\x09\xeb\x8e\x66\x18\x14\x66\xe8\xde\xc5\x47\xd7\x65\x96\x1b\x9e\x1a\xba\x83\xdd\x0d\xcf\x80\x1d\x91\xfd\x39\x8d\x7e\x34\xf6\xcc\xbf\x7a\x00\xcb\xef\x1d\x2e\x5e\x98\x95\x6e\x31\xda\xc9\x7c\xdf\x78\x68\xb3\x3f\xaf\xc7\x63\xea\x0c\x83\xf7\xaf\x98\x56\xfd\xc2\x8e\x21\xf6\xbe\x4f\x27\xfc\x0d\x30\x9e\x2f\xfb\xdb\xa0\x83\xf0\xf2\x8a\xec\x33\x60\xbe\x47\x0c\x86\x3a\x73\x8a\xa1\x04\x4d\x20\x51\x43\xcb\x81\x09\x73\x0a\x44\x65\x57\x0f\x0b\xa9\x5c\x7c\x1d\xdf\x26\x1b\x8a\xee\xae\x0b\x04\x98\x3f\xf4\xb6\xbe\x7e\xdc\x33\x37\xad\x7e\x30\x67\xdd\x03\x0e\xf6\x2c\x22\xe6\xba\x51\x76\x32\x43\xdb\x52\x6e\x30\x41\x68\xf9\xc0\x9e\xd7\xd0\xbb\xb6\x23\x79\x3b\x6e\xec\x3b\xda\x26\xbf\xbf\xe0\x75\xa1\xd0\xbc\x4a\x7b\x9c\xcd\x99\x41\x6f\xd0\x08\xac\x7c\x19\x70\x04\xc0\xff\x57\xb9\xca\x7d\x7b\xce\x52\x8f\x7e\x6a\x63\x57\xc4\x1b\xb6\x8d\xe0\x0a\xb6\x7d\x1e\xd5\x4a\x6f\x2f\x4c\xbe\x2d\x46\x87\x1e\xcc\x7c\x8e\x7b\xcc\x1c\x8f\x2c\x5f\x35\x1a\xc1\x80\x0d\xfa\x68\xac\x7c\x95\x16\x42\xca\x6f\xb2\x0d\x2a\xb4\x0b\xed\x22\x27\x1f\x70\x41\x45\x35\xaa\xe1\x0d\xcc\x72\x3d\x98\x13\x62\x39\x30\x13\x75\x52\x18\x3d\x70\x7b\xe9\x74\xf4\x6c\xa8\x3d\xd4\xf1\x8e\x94\x75\xb0\xa3\x61\xce\x5c\xf9\x26\x18\xcf\x47\xd7\x06\xa0\x4e\x32\x7b\xad\xc3\x63\xb0\xd0\xd4\x3b\x9b\xe4\x81\x59\xff\x2b\x58\x3b\x6b\x76\xa0\x7c\xaa\x0d\x06\xc8\x78\x3e\x17\xa8\x9b\x3c\x58\x9b\xe0\x00\x17\x55\x61\xb4\x96\x02\xb8\x77\x40\x5b\x7a\xd0\x8e\x59\xb3\xea\x81\x59\xf3\x9c\x6a\x6a\x00\x2d\xa5\x7c\x5b\x94\x95\x2c\x60\xc5\xbe\x7b\x75\xa4\x4c\xdc\x0e\x6d\x93\x99\x24\xb7\xfe\x59\x72\xe8\x06\x80\xc7\x49\xaf\x20\xb0\x7a\x6a\xd4\xa1\xbf\x89\x96\xfe\x6c\xec\xcb\x56\x96\xd0\xc5\x6b\x98\xe7\xd6\xaf\x4b\x1d\xa3\x43\xb8\x23\x9f\xd5\xab\x6c\xfc\xf3\x36\x36\x2f\x93\x62\x47\xae\xe6\x35\xac\x79\xec\xfe\x6d\x6c\x1d\x41\x01\x09\xd0\xf9\x10\x31\xc1\xb6\x78\x95\x36\xa3\x42\x60\x84\x18\x9b\xc0\x3e\x9b\x62\x75\xd3\x71\x52\xac\xe1\x25\xaa\xd6\x08\xe3\x73\xbc\xcd\x69\xa3\x2f\x27\xb3\x3a\xb6\xb9\x54\x52\x5f\xc3\xf4\x84\x7f\x4e\xce\xc7\xe8\x8d\xce\xbb\xcb\x41\x65\x74\x38\xb8\x89\x5b\x09\xf8\x05\x10\x30\xab\x93\x96\xf1\xd5\x4f\x2d\xf3\xca\xc7\x93\x3c\xfe\x3a\xeb\xd4\x37\xef\xf3\xa9\xd1\xbe\x4e\xea\x46\x6d\x15\xf3\xf0\x52\xf5\x66\xb2\x0e\x11\x58\x92\x0e\x87\x2e\x0f\x10\x5d\x9e\x58\x16\xee\xdc\x19\x88\x0d\xa6\xc8\x46\x8a\x6c\xa4\xc8\x46\x8a\x6c\xa4\xc8\xc6\x9b\x45\x36\x1e\x0e\x8b\xc9\x77\xb8\x86\x0e\x34\xfc\x35\x81\x14\x3e\x29\x7c\x52\xf8\xa4\xf0\x49\xe1\xdf\x54\xe1\x3b\xcf\xf5\xd0\x23\x0d\x33\xd8\x74\xd0\xa2\x92\xc6\x27\x8d\x4f\x1a\x9f\x34\x3e\x69\xfc\x1b\x6a\xfc\xb3\x54\xe3\xd4\x7c\xc9\x87\x18\xf2\x18\xbd\x4f\x0f\x95\x74\xe6\x23\x78\xfc\xec\xd8\xe6\x27\x8d\x3e\x3e\xa7\x46\x1d\x42\x30\x64\xca\x74\x81\x16\x3b\xe0\x09\x69\x7d\x88\x30\x54\x82\xcf\xcc\xf9\xe8\xb8\x4f\x0a\x2c\x20\x9e\xef\x78\xe9\x17\x75\x78\x63\x22\xce\x40\xdc\xee\xc6\xeb\x0c\x1c\x1e\x5a\x4f\x14\x6c\x62\x9c\x6e\x28\x00\xc4\xeb\x03\xbc\x26\xc0\xe9\x00\x78\xf7\xa3\x76\x29\xe2\x23\xe0\xbc\x42\x70\x0b\x71\x46\x91\x8c\xfd\xc1\x32\x06\x7c\xf0\xae\xe7\xfc\xb4\x9e\x9e\x17\xab\x52\xf1\x51\x58\x7d\x19\x02\x0a\x64\x08\x77\x59\xac\x72\x72\x53\xc3\x4f\x0f\x35\x1c\x8d\xa4\xa9\x65\x92\xd6\xd5\x93\x34\x2b\xa9\x3d\x69\x72\xd2\xe4\xa4\xc9\x49\x93\xff\x7c\x4d\xbe\xa9\xbb\xc5\xaa\xd7\x3d\x3d\x9f\x85\x67\xdb\x65\xb2\xc9\xb0\x48\xd2\x7d\xa4\xfb\x48\xf7\x91\xee\xfb\x99\xba\x8f\x6e\x7c\x74\xe3\xa3\x1b\x1f\xdd\xf8\x7e\xec\x8d\x4f\xe9\x18\xad\x2a\x33\x09\x58\xd0\xec\x83\x9d\xfd\x2a\xad\x3a\x5e\x80\x00\x53\x24\x50\xba\xf2\x31\xb0\xb8\x3b\x11\x7b\x40\x7c\x28\x3a\xd1\x16\x68\x9d\x5e\xcc\x10\x59\x1a\xa3\x36\x1f\x0a\x58\x3e\x7e\xd5\x1b\x20\xbf\x9f\xb8\x98\xab\x38\x11\x6b\xf5\x0a\x2b\xc3\x41\xb6\xa5\xe3\xd7\xcc\xff\x70\x80\xff\x36\x49\x02\xe5\xa1\x52\x1e\x2a\xe5\xa1\x52\x1e\x2a\xe5\xa1\x52\x1e\x2a\xe5\xa1\x52\x1e\x2a\xe5\xa1\xfe\xf4\x3c\xd4\xcd\x7f\x12\x64\x34\x79\xb1\x83\x76\xf4\x8e\x91\xdd\x2b\x20\x86\x95\xc3\xa6\x4c\x1d\xfb\x9f\x33\xba\xf6\x0a\x4a\x0e\x1c\x72\xe0\x90\x03\x87\x1c\x38\x77\xec\xc0\x91\x5a\xd8\x4b\x8c\x40\x49\xe7\xd9\x00\xfc\xcc\x15\x2c\x84\x45\x85\x0f\x03\xd3\xf2\x9c\xef\xc7\x8e\x61\xfe\x49\x3a\xc7\x47\xa0\x20\x78\xf3\x11\x27\xf5\x9a\xd9\x69\x8f\x07\xb3\x7a\x76\x54\x73\xba\x35\xdd\xe3\x21\x9c\x28\x99\x9f\x67\xef\x5e\x33\x3f\x8b\xec\xaf\x27\x37\x2e\x5c\xbc\x64\xbe\x08\x35\xe1\x33\x3f\x3b\xa5\xc7\x79\xef\x18\x57\xcf\x45\x40\xe8\x26\xf9\xb6\xdf\xb7\xb3\x86\x15\x74\x4c\xbf\xc8\x4b\x60\xf5\xd3\x43\x05\x8d\x1b\x6d\x41\x58\xb2\xf5\x94\x71\x28\x27\xe9\x79\xba\xa7\x6a\x73\x02\x76\x56\xa2\x41\x1a\x51\x07\x0d\x0a\x05\x52\x6c\x39\x95\xf6\x78\xc8\x89\x14\x20\x2f\x50\x24\x6f\x70\x1b\x9c\xad\xf2\x59\x51\x48\x6b\x8f\xb4\xa7\x00\x60\xca\x62\x4d\xa0\xb8\xea\x6f\x43\x54\x5a\x50\x57\x62\xe6\xce\x55\x23\x48\xc6\xeb\xfe\x38\x76\x74\xcb\xb5\x99\xcc\x33\x2d\x2d\x0a\x8f\xbb\xc7\xf7\x8b\x1f\x76\x76\x3d\x14\xac\xfe\x28\xe7\x2f\x2e\x23\xf9\x4d\x43\x6e\x63\x72\x1b\x93\xdb\x98\xdc\xc6\xe4\x36\x26\xb7\x31\xb9\x8d\xc9\x6d\x4c\x6e\xe3\x1f\xee\x36\x4e\x17\xa8\x02\x90\xd3\x65\x4a\x20\x05\xb0\x58\xe3\x8d\x30\x73\xd5\xb0\x7e\x4e\x48\x2f\x24\x29\x21\xba\x65\xf3\x58\x25\x00\x4a\xfa\x94\x01\x44\x02\x5c\xcf\x19\x00\x5f\xa6\x6c\x3e\xc6\xa2\x30\x0f\x05\x83\x8c\xc6\x8c\xb3\xfc\x6b\x36\xeb\xf0\x1f\x33\x8e\x5f\x5a\x2a\x64\x09\x90\x25\x40\x96\x00\x59\x02\x64\x09\x90\x25\x40\x96\x00\x59\x02\x64\x09\xfc\xd1\x96\xc0\xa7\xc0\x8d\xda\x5b\x1f\xc5\x6c\x50\xcc\x06\xc5\x6c\x50\xcc\xc6\x1d\xc7\x6c\x6c\x95\xf2\x33\x91\x69\xd0\xbc\x5f\xfe\xed\x98\x98\x57\xe7\xa5\x65\xb3\x11\x3c\x7d\x55\x06\x96\xe5\x33\x50\x7a\x9d\x01\x90\xad\x27\xe9\x89\x2f\xb7\xf6\x69\xec\xa4\xb8\xdb\xd3\xb1\x70\x1b\x6f\xe2\x6e\x15\x22\x69\x92\x43\xab\xbc\x3f\xb7\x26\x9b\x9f\x02\x44\xba\xd0\x7d\x28\x34\xc3\x4e\x2a\x04\x2c\xc0\x5d\x2c\x6d\x28\x0a\x33\xda\x45\xd4\xf1\x32\xfc\xf5\x35\xca\x83\x85\xc4\x2d\x25\x64\x0d\x52\x86\xc8\xc9\xfb\x2f\xd8\x94\xd7\xdb\xe9\xe6\x07\xb0\xbe\x87\x8b\xe9\xc3\x18\xc8\x0b\x13\x1e\xac\xec\x50\x2b\xc3\x45\x1f\x6e\x28\x71\xab\x39\xe4\x2a\x80\xf1\x87\x1d\x2c\x7c\x75\x87\x1e\xee\xe0\x03\x84\xbb\xea\x43\x30\x66\x09\xcd\x4d\xc4\x85\x8b\x64\x94\x64\xb4\x58\x46\x11\x1f\xc1\x05\x8d\x49\xcd\x92\x9a\x25\x35\x4b\x6a\x96\xd4\x6c\xb5\x9a\xcd\x93\xff\xf8\x7e\xd7\x4d\xfc\x7c\xd5\xd1\x0f\x15\x83\xd3\x1b\x33\xbd\x31\xd3\x1b\x33\xbd\x31\xd3\x1b\x33\xbd\x31\xd3\x1b\x33\xbd\x31\xd3\x1b\xf3\x4f\x7f\x63\x36\xda\x87\x2a\x05\xe9\x51\x80\x11\xa4\x1e\x16\x53\x5b\xe4\x3c\xb6\x7f\x7e\x6f\x2e\xcc\xb8\x63\xab\xde\x1b\x17\xf3\xe7\x7c\x2e\x64\x5a\x26\x28\x83\x97\x32\x78\x4b\x33\x78\xf9\x20\xed\x1d\xbc\x94\x85\x57\x13\x76\x92\x7e\x32\x89\xc3\x0b\x18\x20\x2c\x25\x8b\x79\xa5\x4f\x0f\x35\x72\x6b\x16\xa9\xf3\x67\x1e\x74\xba\x2f\xd6\xbc\x5d\xaa\x68\x8f\x57\xda\xa6\xb1\xe3\x41\x1b\xf4\xc6\x87\x46\x11\x66\x90\xae\x22\x91\x19\x1a\x0a\xca\xe1\x75\x6e\x6e\xe3\x63\x08\x8e\x17\x9c\xfa\x2c\x50\x9f\x05\xea\xb3\x40\x7d\x16\x7e\x7e\x9f\x05\x6a\x4b\x43\x6d\x69\xa8\x2d\x0d\xb5\xa5\xa1\xb6\x34\x98\xb6\x34\xd4\x8f\x86\xfa\xd1\x7c\x5b\x3f\x9a\xbf\xd9\x3b\xa3\xe4\xc6\x51\x20\x0c\xbf\xfb\x14\xbe\x40\x2e\xe0\x43\x6c\x6d\xed\xec\x3b\x85\xa5\xb6\x4c\x19\x09\x15\xa0\x64\x32\xa7\xdf\x42\x96\x33\xc9\xac\x81\x06\x65\x6a\x6c\xe7\x2f\x3f\x4a\x6a\xa3\xa6\xf9\xa0\xa1\xd5\xfd\x76\x01\xd0\x03\xf4\x6e\x16\x7a\x58\xe3\x61\x8d\x87\x35\x1e\xd6\x78\x0f\xbb\xc6\x5b\x8a\xb7\xc4\x43\x1b\x32\x0a\x5d\x57\x3c\x26\xae\xae\xa7\xb7\x23\x9f\x4d\xc1\x5b\x9d\xe4\xe1\x74\x25\xa3\x64\xda\x68\x65\x73\x5a\xb7\x8b\xba\xb7\xe6\x54\xbb\xad\x80\x50\x28\x84\x42\x21\x14\x0a\xa1\x50\x08\x85\x42\x28\x14\x42\xa1\x10\x0a\x85\x50\xa8\x47\x0f\x85\x3a\x9f\x21\x55\x7e\x60\x7c\x59\x01\x85\xda\x03\x21\xea\xa0\xa9\x92\xd2\xd2\x41\x4e\xda\x8b\x6c\xf0\x10\x53\xce\xfc\xe9\xf5\xaa\x7a\x08\x17\x49\xde\x8c\xaa\xf2\x9d\x94\x6b\xa4\x6d\xc5\xec\x02\x88\x96\xb4\x7a\x26\xfb\x2a\x0e\x52\xe9\x98\x3b\x96\xb3\x75\xfa\xde\xe8\xa9\xa5\xf3\xeb\xe5\x5f\x2e\x2f\x68\x7e\xbb\x7a\x31\x08\x39\x43\xc8\x59\x59\xc8\x59\x47\x7e\x19\x10\x0b\x76\xb4\xa9\x4a\x5d\x7f\x4b\xc1\x6b\xe7\x86\x88\x83\x35\xfd\xe2\xa2\xfe\xf9\x46\xa9\x96\xfa\xd1\x84\x10\xd7\x3a\xed\x9e\xfb\x48\x76\xdd\xbc\x7c\xdc\xbf\xfa\x58\x53\x73\x6b\xbd\x8f\x82\x96\x91\x5a\x29\x2b\x48\x70\x34\xb4\xeb\x6a\x8b\xbd\xa3\x45\x8e\x7c\x51\xfd\xaf\x9f\x5f\x3e\x48\x58\x21\x25\x95\xfe\x01\x67\x02\x38\x13\xc0\x99\x00\xce\x04\xee\xfa\x4c\xe0\xd2\x58\x21\x9b\x53\x25\xf1\x9d\x74\x5a\x84\x5d\x2e\xe1\x5c\x44\x91\x39\xe5\xb9\xe6\x48\xbd\x14\x96\x3a\xe5\xbc\x8d\xd8\x4c\xde\xec\x1a\x29\xe2\xc5\xae\xf8\x96\xcb\xc0\x36\x5f\x58\xd9\xd0\x2a\x93\xcb\x1e\x62\x85\xa3\x82\x3f\xd4\x2a\x04\xf3\x87\x1c\xc7\x72\xae\x5b\x73\x5e\x76\x7e\xf8\x31\x06\x4f\xf1\x8d\x19\xdc\x17\x68\x93\x81\x7d\xd8\x28\x6c\xb4\xd8\x46\x19\x37\x35\xb2\x39\x92\xf0\x3e\xa1\x1d\x86\xba\xe7\xa0\x6a\xd0\x1a\xb4\x06\xad\x41\x6b\xd0\xfa\xb7\xd1\xba\x25\xd7\x58\x35\x7a\x63\x85\x23\x10\x17\xc4\x05\x71\x41\x5c\x10\xf7\x37\x12\x97\x86\x54\xcd\x60\xce\x59\x97\x7c\xb6\x26\x71\x79\x2e\xac\xb6\x9f\x0e\x9b\x15\xbd\x19\xb6\xc5\x31\x17\x60\x2e\xc0\x5c\x80\xb9\x00\x73\xc1\x6f\x9b\x0b\xd2\x27\x88\xc0\x2c\x30\x0b\xcc\x02\xb3\xc0\xec\x4a\xcc\x9e\xe3\x82\x12\xe5\x75\x98\x0a\x3f\x9f\x43\x7e\x82\x88\xf4\x37\x52\x5c\x51\xd3\xac\x98\xf9\xb5\x84\xf3\x56\x7a\xea\x5e\xeb\xbd\x8a\x7f\x43\x34\xe2\x5f\xb2\xa7\x6f\x8b\xa8\xc4\xbd\xff\xcc\x0a\x65\xde\x3c\x0b\x2e\x78\x82\xf1\xea\x93\xd5\xbb\x55\xcf\xa3\xa2\x00\x2a\x0a\xa0\xa2\x00\x2a\x0a\xa0\xa2\xc0\x9f\xab\x28\x70\xd9\x08\x8b\x5c\x9e\xac\xde\x54\xfc\xaf\x6b\xac\xec\x45\x4f\xcd\x51\x0e\xca\x45\x8c\x3e\xd3\xc9\x21\x91\xe2\x92\x07\x71\xb7\xa9\x33\x6a\xc6\xcc\xc0\x1b\x19\xfc\xd1\xc6\x93\xc7\x1e\x65\x05\x03\x81\x37\xba\x0a\x04\xf2\x47\x15\x7f\x44\xf1\x46\x53\x7e\x24\x65\xed\x9e\x79\x53\x86\xf0\x0c\x6d\x31\xc8\x0e\x1b\xfb\xc2\x36\x96\xb9\xe1\x1d\xe5\x96\xef\x27\xdc\xab\xf3\x14\xb1\xa6\x9c\x12\x66\x69\x3f\x13\x22\xee\x36\x75\xe6\x03\x6e\x82\x9b\xe0\x26\xb8\x79\xeb\xdc\x7c\x97\xfa\xb5\x39\x4a\x15\xd9\xc9\x01\xef\xc0\x3b\xf0\x0e\xbc\x7b\x28\xde\x45\x7b\x0c\xb4\x03\xed\x40\x3b\xd0\xee\xee\x69\xb7\x64\x41\x3c\x1a\xe7\xe3\x0a\xce\xbd\x3f\x2b\xbb\x47\xb4\x4f\x26\x47\xe2\x92\x05\xe5\x60\xac\x98\x86\xd3\x60\x5e\x86\x7c\x46\x94\x78\x83\xd2\x65\xb4\x01\x6f\xc0\x1b\xf0\x06\xbc\xef\x18\xde\xf1\xa6\x3e\x5d\x92\xb2\x6e\x0a\x04\x9e\xd4\x40\x4e\xb9\x6f\xde\x92\xbc\x62\x8f\x69\xbb\x91\xce\x4d\x3d\x09\x6b\x42\x1e\x50\x4b\xed\x39\x51\x61\xc4\xc4\xf2\x26\xd8\x4e\x56\x86\xbe\x5d\xd2\xec\x45\xef\x63\x99\x0b\x7d\xf7\x61\x26\xd0\xd1\xac\x63\x4c\x39\xa3\xd1\xaa\x79\x5d\x25\x62\xd6\x8f\xb4\xeb\x02\x61\x66\x21\x6e\x49\x83\x96\x1e\x54\x59\x69\x69\x73\x7f\x7a\x6b\x70\xea\xf2\xfb\xa6\x94\x5b\xf1\x76\x2b\x5f\x9c\x50\xb2\x5f\x97\x68\x27\x08\x09\x51\xfc\xaa\xad\xb5\x39\xcc\xf7\x98\xef\x31\xdf\x63\xbe\xbf\xd9\xf9\xfe\x4c\x4a\x47\x09\x2f\x0b\x94\x03\xe5\x40\x39\x50\xee\x01\x28\xe7\x84\x37\x27\xc2\x41\x23\x0e\x1a\x71\xd0\x88\x83\xc6\x47\x3c\x68\xdc\x4b\xdf\x1c\x45\x40\x33\x39\x3f\xa7\xbe\x4d\x54\xec\xc8\xf9\xbf\xff\x17\x16\xcf\x04\x9f\x95\x85\xaa\x3c\xa8\xca\x83\xaa\x3c\xa8\xca\x83\xaa\x3c\xa8\xca\x83\xaa\x3c\xa8\xca\x83\xaa\x3c\x8f\x5d\x95\x07\xa5\x55\x50\x5a\xa5\xac\xb4\xca\x27\xd4\xa4\xb0\xa6\x21\xe7\x3e\xe3\xb4\x78\x11\x15\xbb\x9c\x6d\x4a\xce\x6f\x7c\xba\xfc\x43\x8d\xa6\x42\x16\x77\x33\x54\xa9\xc8\x52\xc8\x51\x79\x59\x56\xa8\x83\x70\x53\x13\x7f\xd1\xdc\x08\x5b\x8e\x57\x85\x19\xc4\x07\x67\x71\xb7\xa9\x99\xc0\xdd\x1c\x26\x90\xc8\x31\x91\x7c\xb7\xb8\xbe\x9f\xde\x4b\xde\x14\xe8\x5a\x9b\xae\x1d\xca\xab\xdf\x8e\xaa\xda\x82\xe5\x38\x56\x3d\x87\xa2\xb7\x28\x7a\x8b\xa2\xb7\x28\x7a\x8b\xa2\xb7\x28\x7a\x8b\xa2\xb7\x28\x7a\x8b\xa2\xb7\x0f\x5e\xf4\x96\xf3\x61\x45\x54\xba\x1a\x3a\x72\x9e\xac\x68\x4d\x1f\xfd\xf0\x96\x2b\x83\x86\x76\x34\x6a\xf0\x55\x52\x2e\x87\x4b\xc9\xf1\x9a\x91\x11\x1f\x19\xd5\xfe\xc2\xb2\x84\xbf\x72\xe5\xa2\xf7\x4d\x41\x7f\x69\xd3\x75\x6a\xe8\xae\x1e\xc4\x26\x9a\xa8\x4d\xf7\x63\xb7\x29\x5b\xcd\xc3\x0f\x80\x1f\x00\x3f\x00\x7e\x00\xfc\x00\xf8\x01\xf0\x03\xe0\x07\xc0\x0f\x78\x70\x3f\x20\xbd\xfa\xce\x2f\xf9\x46\x63\x7d\xae\x75\x69\x16\x24\x42\x6a\x79\x4d\x60\x86\xd6\xf2\x85\x95\x85\x3f\x96\xc9\x65\x87\x41\xb2\x3a\xf7\xe3\x2f\xee\xcf\xad\x14\xcc\x0f\x8b\xe4\x8e\x59\x8e\x1b\x55\x1e\x22\xc9\xb0\xf7\xe2\x1b\x33\x21\xb9\x05\xda\x64\x84\xe6\xc2\x46\x61\xa3\xc5\x36\xca\xb8\x69\x5d\xb2\xf6\xcc\x1f\x74\x3f\x54\xc4\xbd\xcc\x69\xf9\xe8\xfd\x28\x54\xab\x29\xbd\xce\xca\xcd\x22\x66\xf2\xe3\x14\xe2\x22\x1b\x3d\xb5\x24\x72\xfb\x39\xf1\xf6\xfc\x2a\x48\xf5\x54\x27\xe8\xbc\xe4\x4b\xf8\x7b\xb9\x57\x5a\xd6\xb4\x9a\x68\xac\x11\x10\x37\xd8\x90\xf3\xf9\x3c\xe3\x6f\x0a\xba\x59\x9b\x93\xda\x6d\xca\x88\x22\x27\x6f\x84\x96\x7b\xaa\x0f\xa2\x90\x5a\x9b\x97\xd8\xc5\xed\x56\x79\xea\xa3\xcf\xb2\x2c\xfb\xe7\x4d\xd2\x5a\x19\x1b\xb8\x73\x33\xc4\x51\x75\x47\xd1\x48\xdb\xaa\x41\x6a\xe5\x5f\xff\x7c\xbb\x5a\x1a\x6e\xa0\x15\x81\xe8\x6e\x94\x4d\x02\xeb\xb9\xe1\x92\xb4\x3d\x6c\x4b\x62\x5b\x12\xdb\x92\xd8\x96\xc4\xb6\x24\xb6\x25\xb1\x2d\x89\x6d\x49\x6c\x4b\x7e\x81\x6d\x49\x94\x7e\x41\xe9\x17\x94\x7e\x41\xe9\x97\xc7\x2d\xfd\x02\xbc\x01\x6f\xc0\x1b\xf0\xf6\xa8\x78\x33\xc3\x41\x75\x93\x25\x71\x9a\xf6\x64\x07\xf2\xe4\x92\xbb\xc1\x39\x3d\xb4\xd6\x8c\x62\xf9\x8e\x30\xda\xfd\x39\x21\xf4\xdd\x5b\x99\x6c\x86\x6c\xdb\xf9\x1b\x42\xa9\xff\xce\x5a\x63\xd6\x1e\x32\x3a\x9a\x5b\xd3\xf8\xcf\xd2\x90\x1a\x1c\x35\x41\xe3\xbe\x56\x42\x54\xaf\x98\x92\x30\x25\x61\x4a\xc2\x94\x74\xd7\x53\xd2\xad\x60\x5f\xab\x81\x44\x2a\xbd\x41\xe6\x0f\x46\xe9\xdc\x8b\xb1\x11\xa5\x03\xd5\x40\x35\x50\x0d\x54\xdf\x35\xaa\x2d\xf5\xe6\x99\x42\x16\x84\x48\x67\x26\x83\x1b\xb2\x9a\x4e\x07\x35\x78\x1a\x64\x3a\x6e\x27\x2a\x3a\x1a\x67\x95\x7b\x0e\x75\x81\x50\x17\x08\x75\x81\x50\x17\xe8\x51\xeb\x02\x25\x2e\x0e\xf4\x62\x49\x5f\xab\xa8\xb6\x22\x4d\x0e\x90\x09\x64\x02\x99\x40\xe6\x1d\x23\x73\xbb\x0d\x49\x5b\xc5\x64\xd5\x2e\xf1\x70\x54\x93\x5a\x35\x34\xb8\xc4\x5e\x39\x10\x09\x44\x02\x91\x40\xe4\x1d\x23\x32\x71\x71\x98\xb4\xbe\x1a\x21\x99\x78\xc6\x8c\x81\x98\xd2\x36\x57\xc2\x7b\x91\x36\x05\x69\x53\x90\x36\x05\x69\x53\x90\x36\x05\x69\x53\x90\x36\x05\x69\x53\x90\x36\xe5\x4b\xa7\x4d\xd9\x4f\xfa\x24\x2e\xb5\x01\xde\x92\x10\xa6\x47\x50\xe6\x3f\x1b\x39\xa7\xf8\xaf\x5d\x44\xc2\x59\x87\xb3\x0e\x67\x1d\xce\xfa\xcd\x3a\xeb\xdb\x6d\xa3\x15\x0d\x1e\x1f\x76\xe1\xc3\x2e\x7c\xd8\x85\x0f\xbb\x1e\xf6\xc3\xae\x33\xe5\xa2\x1d\x05\xc8\x01\x72\x80\x1c\x20\xf7\x20\x90\x13\x21\x1c\x7d\xb7\xa9\xeb\x70\x90\x0e\xa4\x03\xe9\x40\xba\x5b\x26\x9d\x19\x7c\x40\x5d\x7c\x3f\x31\xa3\xcd\x66\x72\xde\xf4\xe2\x48\xb2\x25\xeb\x56\x88\x50\x3f\x48\x78\xea\x47\x2d\x7d\x5d\x4b\x5a\xe9\xa5\x58\x4a\x19\xd2\x20\xf7\xb1\xcd\xc6\x5c\x4f\xbe\x97\x13\x37\xb8\x82\xc6\x5c\xde\x6a\x85\x34\x3a\xc8\x49\x7b\xf1\xf3\x08\x5f\x3c\x93\x75\xd1\x93\xb9\x8c\xb8\xb5\x59\xb1\xe5\x5c\x13\x33\xc4\x5e\x09\x95\x30\x73\x1e\x27\x18\x93\x24\x5f\x58\x19\xc8\xca\xe4\xb2\x81\xc6\xe8\x81\x5f\x7f\x71\xcb\x58\x29\x98\x0f\x38\xce\xd0\xa8\x01\x1d\x0f\x76\x0c\x54\x15\xdf\x98\x99\x5c\x0b\xb4\xc9\x98\x64\x61\xa3\xb0\xd1\x62\x1b\x65\xdc\x24\x9d\x9b\x7a\x12\xd6\x68\x12\xd2\x26\x22\x31\x40\x5b\xd0\x16\xb4\x05\x6d\x41\xdb\x4f\xa2\xad\x23\x17\x56\xd8\x89\x55\x3b\xb0\x0b\xec\x02\xbb\xf7\x81\xdd\xff\xd8\xbb\xba\xdc\xd6\x75\x23\xfc\xee\x55\x74\x03\x01\x2e\x70\x5a\xa0\xc8\x0a\xfa\xd6\xee\x80\x60\xa8\xb1\xcd\x1b\x89\xd4\xe5\x50\x71\xdc\xd5\x17\x94\x6c\x9f\xdc\x53\xf3\x47\xa3\x9c\x8b\x24\xe7\x43\xf2\x66\x71\x44\x52\xc3\x8f\x33\x9c\xe1\x37\x80\xdd\x4f\x02\xbb\x27\x7a\x52\xb6\x4b\x29\xb4\xf1\xac\xe6\xea\x59\x85\xc4\x31\x20\x30\x10\x18\x08\x0c\x04\x06\x02\x6f\x45\xe0\x13\x2b\xa6\xf0\x62\x4d\xe9\x98\xba\x71\xd6\xc9\xb0\x32\xde\x45\x6d\x1d\x05\x65\x02\xcd\x70\xae\x7b\x56\x81\x7a\x1d\xed\x4b\xe1\xde\x2f\x10\x1d\x88\x0e\x44\x07\xa2\x03\xd1\x37\x22\x7a\xa0\x7d\xba\xaf\xf1\x27\xf8\x7d\xa7\x3b\x5f\x87\xad\x57\x02\x97\xf0\x87\xfa\x1e\x47\x7c\xdc\x6d\x53\x61\xec\x05\xd8\x0b\xb0\x17\x60\x2f\xc0\x5e\x70\x77\x2f\xe0\xc8\x3f\x98\xe1\x65\x08\x07\xe8\x02\x74\x01\xba\x00\x5d\x80\xee\x06\xd0\xfd\xa9\x55\xc9\xe9\xd5\xd0\x9c\x36\x53\x64\x72\xa9\xcd\xf8\x5e\xdb\x5e\x79\xa7\xc6\x29\x46\xeb\x0e\xb7\xfc\x4b\x75\x25\xb3\x30\x44\x9d\x50\x74\xaf\x63\x24\xa7\x8e\x9a\x8f\xc4\xef\x21\x43\x31\x8d\x3a\xe8\xe8\x33\x14\x18\x95\x29\x3d\x7a\x8e\xe2\x86\x2c\x6b\x99\x0a\xc0\xa7\xef\x43\xae\x13\x09\xb0\x5d\xde\x39\xaa\x35\x3d\x38\x1f\x48\xdd\xf4\x44\x36\x82\x6b\xad\x78\xeb\x3a\x7a\x55\xd6\xa9\x0a\x05\x74\xfe\x5b\xde\xaa\xce\xeb\x43\x6d\x4c\x0d\x42\xec\x40\x1c\xf5\x20\x54\xfb\x65\x34\x79\x54\xac\x4d\x0a\x0f\x6a\xf4\xbd\x35\xf9\xf4\xd7\x8a\x84\xde\x1f\x94\x67\xf5\xf7\xdf\x7e\x53\x81\x34\x7b\x27\x1b\x47\xef\x0f\x1c\x35\x1f\x55\xa7\x23\x6d\x28\xb9\x70\x93\x53\x97\xd1\xd0\x99\x31\xd0\xde\xbe\x6e\xeb\xc8\x22\x63\xe3\x8a\x4f\xb4\x34\x0b\x90\xfd\x88\x6f\x28\x4c\x81\xc2\x14\x28\x4c\x81\xc2\x14\x28\x4c\xf1\xe7\xc2\x14\x79\xaa\xb6\xca\x2c\x8e\x76\xa4\x54\xf8\x47\xd6\xd8\xe7\x18\x33\x6a\xac\x61\x69\x9b\xa0\xa0\xfc\xef\x29\x76\x69\x75\x6f\xff\x9b\xe3\x47\xab\x7d\xb0\x40\xc6\x3b\x47\x26\x26\x73\x98\x42\xf0\x62\x39\xbd\xd7\x9d\xd2\xfb\x48\x41\x34\x19\x17\x01\x97\xde\xd4\x0c\xb7\x6a\x47\xbc\x53\xc9\xc8\x9f\x02\x49\xc5\xdc\x4a\x95\xa4\x99\x99\xc6\x4e\xba\x7d\xde\x95\x24\x36\x6f\x6f\xa4\x54\x25\x2a\xb6\xaa\x0c\x9e\x42\x48\xdf\x7c\xcb\xe7\x4a\xf6\x45\xd4\x07\x59\x6b\x3f\xcd\x7e\x97\x74\x16\xd8\x1c\x69\x20\x59\x53\x67\x13\xa9\xab\x32\xbd\x66\x96\xdb\xc2\xcc\x7d\xba\x8a\x66\xf7\x67\x99\x7e\x5d\xda\x8b\xaf\xb2\xf1\x34\xce\x6c\xab\xaa\xf3\x46\x9d\x82\x16\x7a\x04\x0d\x5e\x49\xb6\x0b\x51\x87\x03\xc5\x8b\x8b\x24\x16\x72\xf5\xbc\x41\x92\x06\x92\x34\x90\xa4\x81\x24\xed\x8b\x92\xa4\xdd\x4e\x18\xf3\x53\x5b\x99\xce\x9b\x84\xc4\x51\x7c\x0a\xb6\x6c\x8e\xe4\x27\xf0\x2a\x87\x65\xbd\xb0\x43\xb5\x50\x73\xb5\xf1\x96\x32\x99\x89\xdf\x54\x8d\x3a\x30\x2d\xe6\xaa\xd8\x06\x59\x04\x05\x32\x56\xbc\x09\x4f\x2e\x99\xcf\x2f\x14\xd2\xa5\xfb\x6b\x77\xce\xa3\x70\x6a\x27\x16\xda\x62\x53\x34\xcb\x2e\x2c\x53\x88\xc5\x8c\x49\xe7\x72\x17\x83\x64\xa6\x36\x8f\x3a\x44\xe9\xf1\xfa\xc9\xc6\xa3\x8a\x41\x3b\x1e\x7d\x88\x14\x54\xef\x0f\x42\x49\x49\xcf\xd3\xf5\xff\xa0\xf3\x7c\xdc\xc5\xe9\x29\xac\x4b\x7f\x8f\xe1\xa6\x0c\xba\xfa\x0d\x05\xc0\x72\x54\xf0\xb8\x93\x41\x37\x6c\x16\xd8\x2c\xb0\x59\x60\xb3\x7c\x60\x9b\xa5\x81\xee\x04\x38\x07\x9c\x03\xce\x01\xe7\x3e\x37\xce\x4d\xd1\xa7\x34\xc1\x74\x0c\xf5\x34\x99\xe7\x9c\x51\x57\x1b\x7e\xbd\x6d\xf6\x7b\xa0\xd2\x14\x2a\x4d\xa1\xd2\x14\x2a\x4d\xa1\xd2\x14\x2a\x4d\xa1\xd2\x14\x2a\x4d\xa1\xd2\xd4\x17\xaf\x34\x65\x8e\x64\x9e\x37\x59\x9b\x8b\x84\xe5\x05\x32\x09\xc9\xa0\x98\x93\x25\x4c\x30\x9b\x98\x63\xcb\xbc\xaa\x95\xa9\x2a\xc5\x02\xea\xa6\xaf\xee\x3a\xe5\xe8\x94\xcf\xc1\x69\xe9\x7f\xfa\xbb\x56\xfd\xda\xbc\xc4\x8a\x6a\x43\x6e\x2a\x38\xa9\x0f\x7f\xf3\x53\x9c\x59\x5f\x0a\x8f\xfc\xce\x3e\x37\x86\xe4\x51\xf5\x91\x5f\x0a\x3f\x9b\xe2\xaf\x03\x1f\x46\x6d\x9e\x0b\x4f\xa4\x94\xf4\xc2\xcf\x6c\xdd\xa1\x27\x35\x3b\xe4\xf2\x59\xac\xac\x9d\x23\xbd\x5e\xf6\xb0\xa2\xb1\x52\xdb\x10\xe7\x90\xc9\x96\x50\xd4\xc6\x48\x56\x22\x35\x2e\x6f\x74\xb5\x11\x78\x66\xc5\xdd\x73\x0a\xaf\xa8\xce\x06\x59\x2f\xb6\x45\x13\xc5\x99\x73\xb3\x25\xbd\x69\xf4\x1c\x53\xa2\xbf\x66\xd1\xeb\xa7\xf1\x5d\x90\xef\xa4\x83\x4b\x2a\xa4\x66\x77\x52\xd0\x93\xfc\x41\xc9\xc3\x9d\x60\xd3\xbd\x87\xde\x1e\xd2\xde\xf9\x7d\xd9\x62\xee\xfc\x70\x05\xed\xdd\x8a\xd5\xe7\x63\x7f\xc7\xa3\x45\x11\x6d\x14\xd1\x46\x11\x6d\x14\xd1\x46\x11\x6d\x14\xd1\x46\x11\x6d\x14\xd1\x46\x11\xed\x5f\xba\x88\x76\xd9\x86\xa9\x48\xbf\x5a\xa5\xa2\xc6\xc5\x62\x3c\x36\xd2\x90\xf9\xa9\xc5\x10\xad\x07\x5e\x2b\x9d\x6b\x0a\x76\xb7\xf5\xa4\x31\xaf\x62\x9d\xc0\x75\xf1\xef\xf5\xb2\x9b\x63\xe1\x2b\x66\x73\xcd\xe7\xd9\x24\xbc\x3d\x46\xde\x0e\x32\x6d\x6e\xa0\x24\x6a\xde\xb4\x4c\x45\x8f\x56\xd5\x77\xd5\xec\x36\xe4\x6d\x40\x87\xa1\xc3\xef\xaa\xc3\x0d\x8f\xd5\x06\xf2\x30\x6f\x05\xd9\x1f\x4b\x87\x9f\xd5\xb7\x2f\x0f\xe8\x10\xf4\xbd\xe9\x19\x83\x8f\xde\xf8\xcc\x27\xac\x68\x44\x20\xf6\x53\x30\xa4\x74\x8c\xc1\x3e\x4d\xd9\x2b\x00\xba\xeb\xec\x02\x77\xff\xa9\xae\x8d\xaa\x16\x56\x46\xcc\x94\xd2\xcf\xe3\x59\x7e\xa7\xa0\xcf\xf4\xad\xbe\xae\x8d\x56\x65\xf7\xb2\x0d\x1a\x1a\x77\xe2\x36\x61\xeb\x11\xac\x5d\xee\x2a\xf4\xaa\x7e\x58\x29\x72\xad\x14\xbc\x0e\xb5\xd6\x61\x56\x3b\x62\xb5\xe1\x55\x75\x75\xaf\x7c\xb0\x61\xb7\x6d\x9c\xcd\xc6\x9d\x16\x3a\x0a\x1d\x5d\xa5\xa3\x0d\x0f\x19\x0a\x11\x38\x0b\x9c\x05\xce\x02\x67\x81\xb3\x3f\x0f\x67\xad\x63\x32\x59\xbe\x93\xd6\x09\x19\x83\x7d\xb9\xf0\x93\x00\xb4\x01\xda\x00\x6d\x80\x36\x40\xfb\x67\x81\x76\xf1\x81\xfc\xd8\x64\xb9\x3b\x81\x3a\x7b\x47\xc3\xca\xda\xa7\xfb\xde\x9f\x54\x37\x8d\xbd\x35\x0d\xb4\x55\xf9\xef\x86\x2c\x20\x64\x01\x21\x0b\x08\x59\x40\xc8\x02\x42\x16\x10\xb2\x80\x90\x05\x84\x2c\xa0\x2f\x9e\x05\xd4\x3d\x29\x37\x0d\x4f\x39\xb0\xa9\x2d\xe6\xd2\xbd\x0a\xdc\x0a\xc2\xad\xa0\x3b\xb7\x82\xa4\x75\x2e\xd2\xc1\x59\x88\xe9\x13\x5f\xe8\xf7\xc1\x92\x0f\x96\x7c\xb0\xe4\x83\x25\x1f\x2c\xf9\x3f\xb0\xe4\x8b\xf9\xea\x39\x86\x7d\xb2\xa6\xb6\x5c\x97\x8c\xb1\x97\xbc\xbc\x30\x26\xfe\xf6\xb8\x5b\xa7\xaf\xda\xf4\xa2\xbe\x6b\xe6\x69\x20\x15\x7c\x4f\x6f\xeb\x11\x3e\xee\x64\x4b\xa6\x9b\x16\x3a\xcc\x8b\x6f\x92\x7d\xae\xda\xaf\xf4\x4f\xaf\x91\x82\xd3\x7d\x96\xe3\xad\x51\xce\x52\x19\x68\x93\x88\x79\x7e\x74\xd8\x76\x56\x30\x0b\x61\xe2\xc4\xea\x5a\xe0\x77\x6f\x92\x56\x5e\x9e\x0f\xb7\x0e\x97\x7e\x7e\xdb\x15\xc9\xaa\x5b\xc7\x4e\x95\x1d\x8c\x3e\xb1\xb2\x7a\x98\x2b\x9c\x65\x55\xab\x41\x06\xd8\x00\xc1\x06\x08\x36\x40\xb0\x01\x7e\x5d\x36\xc0\x13\xa7\x7d\x35\xef\xf2\x03\xe5\x80\x72\x40\x39\xa0\xdc\xa7\x46\x39\xc4\xe3\x11\x8f\x47\x3c\x1e\xf1\x78\xc4\xe3\x11\x8f\x47\x3c\x1e\xf1\x78\xc4\xe3\xbf\x78\x3c\x7e\xa1\x0b\xd5\xa3\x4d\x33\x98\x8e\x8e\x53\x81\xa7\xc7\x9d\xe0\x55\xad\xd4\xa5\x15\x01\x75\xe6\xd2\xbc\x80\x7e\xe2\xf9\xd0\x7a\x20\x59\x7b\x3f\x8c\x53\x24\x35\x8f\x84\xa7\x81\x45\x52\x16\xc6\xd4\xa5\xce\x55\xaa\x28\x9a\xd8\xfa\x7a\x79\xa1\xaa\x39\xe7\xc1\xd0\x7c\xeb\x42\x71\x3c\xf7\x24\x15\x82\xc4\x09\x24\x4e\xac\x48\x9c\x38\x04\xed\xe2\xe2\xcc\x19\xef\x62\x10\xd2\x0b\x2c\x62\x92\xbd\xba\xb1\xb9\xd2\x66\xdc\x20\x62\x2e\x8d\x28\x96\xb1\x8a\x5b\x36\x2b\x65\x33\xb5\xac\x75\x1c\xb5\x4b\x68\x10\xfc\xde\xbe\x4f\xd4\xf0\x18\xe3\xa8\xea\xa4\xb3\x0d\xbd\xbb\x49\xab\x93\xb8\x36\x4a\xb3\xa3\xd2\x5d\xb7\xd9\xc7\xce\x57\x54\x6f\x14\x50\x8c\x8e\xbd\xc7\x62\xf3\x8e\xe8\xdc\x12\x07\xcf\xc3\x6b\x53\xfd\xcf\x6c\x0f\x47\x1d\xfe\x98\x28\x4a\x95\xe8\xd2\x5c\x5d\x8f\x44\x52\x64\xd3\xf8\x8e\xcc\x86\x29\xfb\x2e\x75\x4c\x5b\xc6\x66\x43\xea\x2a\x2d\xf8\x93\x3a\x04\x3f\x8d\xdb\x45\xce\x05\xc7\x75\x5e\x44\x7d\xe2\xd2\xdf\xde\x52\x5f\x3a\xd8\xaa\xd2\x94\xad\x7b\xdd\x9a\xb3\xd3\xa6\x59\x58\x7b\xd6\xd9\xa6\xcf\x6b\x36\x7c\x51\x77\x5b\x3b\x5b\xe4\xf5\xb9\xfe\x3d\xcc\x13\x55\x79\xa8\x02\x02\xad\x74\x3f\xed\x9f\xb0\x69\x36\xea\xf3\xf0\x70\x51\xd1\xdd\x86\x81\x2d\x4b\xa5\x50\xcf\xbd\x5d\x81\x1b\xe2\x68\xeb\x56\x43\x7b\xac\x63\x9d\xdc\xe6\x98\x47\xf3\xb7\x5a\xf3\xf1\x85\x82\xdb\x63\x20\xeb\x57\x71\xeb\x92\x6b\x89\x87\x34\xea\xdd\xaa\x07\x2b\xf1\xb7\x15\xb3\xd9\x10\x87\x83\x8e\x42\x47\x57\xeb\x68\x3b\xd2\x96\x77\xcc\xea\x84\x57\x5e\x34\xea\x10\x67\x36\xb8\x8c\x82\xd5\x15\xb0\xd3\xb1\x6c\xf0\x36\x75\x73\x51\x32\x1e\xb5\xa9\x0e\xb6\xa4\x00\xd5\xd1\x0a\x3d\xbe\x31\xf8\xd7\xb3\x9a\x82\x15\xb5\xe6\x6f\x5b\xce\xd3\xf8\x9b\xba\x5e\x08\x97\xb6\x1f\x28\xea\x4e\x47\x2d\x6d\xbf\x4c\xe8\xd6\xda\xfe\xfc\x4d\x05\x3a\x48\x8f\xcf\xf8\xa8\x03\x75\xef\xe1\x29\x6f\x0e\x6f\x5d\xbd\xf6\x32\x2e\x6e\x5d\x9a\x6c\x0f\x4e\xc7\x29\xd0\xb5\x70\xff\xe3\x4e\xf0\x1a\x66\x52\x66\xe2\xe8\x87\x74\x86\xd9\x1f\x7c\xb0\xf1\x38\x6c\x17\x95\xdd\x6b\x56\x0a\x51\x43\xf7\x0f\xa9\xa0\xe7\xa1\x9c\xb0\x5a\x95\xd0\xa7\xa9\xb5\xfb\xb3\x1a\x89\x82\x4c\x46\xf4\x21\x79\xb5\xa6\xd7\xcc\x62\x09\xf2\x3a\x37\x9c\xb2\x86\x5d\xd7\xa7\x95\x41\xc2\x13\xff\x89\x53\x1a\x73\x78\xa1\xa0\xd8\x76\xa4\xc8\x99\x70\x1e\xc5\xe7\xdc\x3f\xb5\x68\xce\x0d\x4a\x77\x2b\x56\xd3\x2d\xa6\xf8\xef\x29\x8e\x53\xbc\x6b\x79\x15\x7a\xc4\xee\xce\xc7\x29\xc3\x0c\x52\xa9\x91\x4a\x8d\x54\x6a\xa4\x52\x7f\xf9\x54\xea\x0d\x06\x1d\x32\xb1\x91\x89\x8d\x4c\x6c\x64\x62\x23\x13\x1b\x99\xd8\xc8\xc4\x46\x26\x36\x32\xb1\x91\x89\x8d\x4c\x6c\x64\x62\x23\x13\x1b\x99\xd8\x9f\x38\x13\x7b\x0e\x15\x3d\xee\x04\xa2\xd9\xb1\x7a\xf2\x9d\xbc\x58\xd2\x4d\x40\xa4\x61\xec\xb5\x30\x85\x2c\x49\xd9\x16\x70\x72\xac\xae\x09\xc2\x1f\xaa\x18\xd5\xdd\x7e\xa5\xd9\xfe\x20\x9d\xe3\x49\x9e\x34\xff\xa6\xfd\x26\xfd\xb9\xca\xd8\xac\x42\xd1\x8f\xd6\x14\x42\x64\x45\x11\x79\x17\xef\xe1\x07\xe1\xbb\x15\xf3\xcc\x63\x3f\xb9\xe7\x7f\xdd\xcb\x66\x2c\xbb\x62\x70\xe2\xe0\xc4\xc1\x89\x83\x13\x07\x27\x0e\x4e\x1c\x9c\x38\x38\x71\x70\xe2\xbe\xb8\x13\x67\x4a\x19\xee\x88\x4b\x22\x2e\x89\xb8\x24\xe2\x92\x9f\x3a\x2e\x59\xac\x8e\x0d\x84\x03\xc2\x01\xe1\x80\x70\x9f\x1b\xe1\x7a\x4b\x2e\x16\xd2\xa2\x81\x72\x40\x39\xa0\x1c\x50\xee\x2b\xa0\x5c\xf6\x43\x01\xe4\x00\x72\x00\x39\x80\xdc\xe7\x06\x39\x4f\xc1\x90\x8a\x5e\x4d\x71\xff\xcf\xc7\x9d\x64\xe8\xe9\xfe\x6b\xe1\xb8\xb9\xf2\x39\x4a\xdc\x2e\x7f\x65\x9a\x40\xe9\xfa\x2d\xf8\xdf\xc0\xff\xf6\xff\xfc\x6f\x47\x32\x4a\x5c\x3c\x2f\x35\xce\xb3\x6e\xd5\x62\x5a\xa9\x75\xf4\xcf\xe4\xa4\xfa\x0a\xd3\x04\xa6\x09\x4c\x13\x98\x26\x1f\xd8\x34\x91\x43\xab\xe7\x82\xdb\x56\x69\x6c\xbb\x9e\xca\x51\xf8\x1a\x36\xcf\x1c\x96\xb2\x77\xa7\x96\xf2\x9e\x3b\x26\x93\x48\x2d\x98\x33\x4a\x53\x53\x94\x67\xa2\xb1\x90\xb4\x59\x6b\x3e\x24\x0e\xc8\x25\x31\x51\x3c\x88\x8b\x8c\x19\x3a\x36\x0a\x61\xb5\x0f\x7e\x50\xf4\x42\x2e\xca\x06\xe4\xbc\x9b\xcd\x62\x15\x68\xec\xb5\xa1\x21\x9d\x07\x2c\x6f\x15\xf5\xab\xce\x21\x5a\xd3\xad\x31\xf8\xe8\x8d\x90\x68\xb6\x4e\x3a\x5a\x7b\x3d\xfb\x29\x18\x12\xbd\x7c\x69\x2a\xfe\xa4\x4b\x73\xb1\x93\xf1\xbd\xb9\xbc\x07\xdc\x2b\x63\xc7\x23\x05\x16\xb4\xcf\x23\xef\xc3\xcd\x8e\xcc\xfc\x34\xdb\x79\xbb\x15\xe0\xc9\x7f\xdc\xe9\x21\x58\x35\xc0\xaa\x01\x56\x0d\xb0\x6a\xfc\xe2\xac\x1a\xa0\xc5\x00\x2d\x06\x68\x31\x40\x8b\x51\xa5\xc5\xf8\x1f\x7b\xd7\xb2\xe3\x38\x6e\x45\xf7\xfa\x0a\xff\x40\x0d\x30\x08\xf2\x80\x37\x41\x63\x90\xc5\x00\x41\xd0\x40\x80\xd9\x12\x34\x75\xcb\x66\x9a\x16\x15\x92\xb2\xbb\x3a\xc8\xbf\x07\xd4\xc3\xed\x72\x89\x0f\x51\xee\x4c\x97\xfb\x6c\x6d\xf1\x8a\x22\x2f\x0f\x79\xcf\x7d\xf0\xdd\xa2\x1c\x32\xaa\x90\x51\x85\x8c\x2a\x64\x54\x21\xa3\x0a\x19\x55\xc8\xa8\x42\x46\x15\x32\xaa\x1e\x3d\xa3\xca\x90\x2f\x14\xdf\x03\xe5\xb6\x2a\x99\x82\xfe\x00\x33\x2e\xbf\x80\x66\xa5\x20\x41\x36\x42\x75\x35\x31\xc7\xf7\x65\x7d\x78\x5b\x3e\xe2\xf7\x0f\x09\xf9\x6e\x4b\x5a\x4c\x1d\x1b\x2e\x69\x0a\x91\xb6\x89\xb7\xf4\x0a\x53\x5a\x46\x62\xb3\x59\x51\x9f\xd4\xfe\xdb\xb2\xce\xa8\xa2\xb6\x8e\xef\xd9\x68\xc6\xbc\x94\x76\x3e\x32\xba\xb6\x3b\x6a\xa5\xf7\x72\x06\xd0\xe2\xc6\x93\x0f\x00\xf2\x30\x66\x1d\x3f\xb6\x65\x4b\x00\x96\x1b\x2c\x37\x58\x6e\xb0\xdc\x60\xb9\xc1\x72\x83\xe5\x06\xcb\x0d\x96\xdb\xa3\x5b\x6e\xd1\x33\x4c\x6a\xf8\xa7\xd6\xfe\x56\x19\x5d\x97\xc6\x24\x0d\xd7\x0e\xb1\x5a\x1e\xa9\xb1\xe1\xdb\xca\xf2\xa4\xc4\x62\xf9\xa3\xf7\xb3\x26\xc4\xa7\xee\xfb\x5c\x97\x81\x50\x53\xaf\x2d\x64\xca\x5a\x4f\x07\x3e\xad\x3f\x49\x2a\x9c\xcb\x78\x55\x48\x44\xdf\x20\xfa\x06\xd1\x37\x88\xbe\x79\xd7\xd1\x37\x4a\xef\xd7\x5c\x71\xe8\x9b\x07\x27\x39\x2f\xa8\xb8\xdf\x25\x56\x74\xe1\x2e\xe1\xbb\x6b\x2e\xbb\xec\x83\x58\x99\xe0\x8e\xf6\xda\xbc\xac\x91\x51\x1c\x5b\x3f\xb6\x0f\x2f\x8f\xfc\xf6\xe5\x91\xb8\xdd\x51\xb3\x21\x3b\xbb\xa8\xfd\x85\xa6\x2b\xee\xc1\x78\xb7\x61\x61\xa0\x7d\x78\xd9\x3e\x5d\x0e\x02\x33\x7f\x5d\x0d\x5d\xb5\x60\xed\xd9\x17\xab\xf4\xcc\xd9\x30\x8e\xad\xe0\x22\xc1\x45\x82\x8b\x04\x17\x09\x2e\x12\x5c\x24\xb8\x48\x70\x91\xe0\x22\x1f\x9c\x8b\x8c\xd9\x45\xe9\x03\x1f\x6f\xdb\xfe\x60\x3a\x30\x80\x2b\x7a\x39\x64\xef\xde\x49\x94\xb7\x19\xd7\x4b\x99\x82\x2d\x64\x7d\x07\x61\xad\xd1\xe2\x3e\x92\xcc\xb3\xf8\xd3\x1f\xff\xf2\xe7\xcb\xbd\x2b\x76\x35\x7e\x5a\x67\x3a\xe1\xaf\xc5\xaf\x47\x53\x79\x75\x1f\x51\x84\xe4\xdb\x17\x21\x29\xb5\xe4\xa7\x74\xf1\x6d\x55\xa2\x2f\xe5\xa5\x4b\x9c\xe1\x8d\x4d\x35\x0f\xf6\xda\x99\xce\x3a\x7f\x25\x3f\x8a\xec\xa2\xc8\x2e\x8a\xec\xa2\xc8\xee\x83\x16\xd9\x0d\x77\xf5\x69\x33\x5b\x2b\x20\x28\x2d\xf0\x87\x75\xdc\x75\x37\x6a\x12\x56\x1f\x2e\x9c\x3c\xcd\x4c\x70\x6c\xec\x5b\xa3\x77\x6a\xd6\xe7\x1b\x74\x05\x47\xf5\x23\xec\x02\x9e\xde\xf4\xcb\x3c\xaf\x12\xdb\x91\xce\xdc\x34\xb2\xd9\x7f\xf3\x4e\xce\xce\xc2\xdb\x49\x7e\xda\xd8\x96\x44\x15\x6c\x65\xc9\x9c\xa8\xde\x6e\x9c\x19\x8f\x14\xd6\x69\xc3\xf7\xb4\xdd\x3c\x73\x65\xc7\x9f\xba\x9d\xa1\x81\x2c\xbe\xf4\x7f\x9c\xed\xcd\x7f\xfe\x5b\xf9\x97\x5c\xc7\x2a\xfb\x71\x31\xbf\x68\xd5\x1d\x27\xdf\xff\xd3\xa6\x26\x2b\x8c\xec\x33\x29\xb7\x9b\x5f\xed\xc6\x1d\xc8\x97\x84\x6b\x3b\x37\x6a\xc2\x5f\x47\xb9\xbe\x08\xdc\x47\x5f\xec\x7e\xf3\xd3\xf0\x8a\x9f\x86\xff\xc7\xbf\xfd\x39\x7e\xbb\xf9\x70\xfd\xd3\x5b\x8d\xb9\x79\xdd\x3f\xba\xe3\x8e\xcc\x46\x3f\x5f\xa6\x35\xf8\xae\x57\xf3\x3e\x3e\x35\xbc\xf2\xe3\xeb\xa6\x6f\x35\x60\x78\xec\xf4\xf3\x8e\x1c\xff\xb9\x6f\x6a\xc5\x81\x8e\x7c\x1a\x30\xef\xeb\xf9\xf0\xf1\xd7\xdf\xfe\xf0\xcf\x57\x3f\x87\xd6\x08\x6f\xe5\x6f\x64\xec\x1b\xde\x2c\xa0\x2b\x9f\x64\x53\x67\x3d\x78\x24\xc7\xfd\x59\x7c\x9b\x56\x26\x7f\x09\x1f\x89\xec\x05\x7d\xb6\x7f\x53\xdc\x3a\x29\x2c\x71\x23\x66\x0e\x52\xf0\x0f\xc0\x3f\x00\xff\x00\xfc\x03\xf0\x0f\xc0\x3f\x00\xff\x00\xfc\x03\xf0\x0f\xfc\xd0\xfe\x81\xb5\x11\xaa\x5c\x08\xb2\xf1\x22\x73\x79\x82\x32\xe9\xbb\x7c\x61\xcb\x28\x96\x65\x72\xb3\xa9\x96\xac\x29\x2a\xa1\x5c\x0a\x04\xe7\x53\x2f\xb9\x2b\x6f\xb3\x09\x9b\xbc\xe5\x34\x4c\x86\xd6\x2e\x7e\x30\x41\xfb\x2d\x18\xcd\x0c\xfa\x0f\x3a\x0a\x1d\x5d\xac\xa3\x19\x0f\x71\x6b\xbb\x23\x31\xa3\x15\x31\x6e\x22\x47\x75\xa0\x2d\xd0\x16\x68\x0b\xb4\x05\xda\xde\x09\x6d\x2d\x59\x4f\x02\x47\x0a\x53\x00\x76\x01\xbb\x80\x5d\xc0\x2e\x60\xf7\x8e\xb0\x7b\xa6\x1d\x93\xb5\xe7\x58\xdd\xcb\x50\x2f\xbf\xbf\xbd\x6a\x5b\xad\x53\x1c\x20\x30\x10\x18\x08\x0c\x04\x06\x02\xcf\x22\x30\x09\xcb\x84\x6e\x1c\x97\x0d\x19\x26\x0c\xf5\x08\xcc\x95\x65\x86\x14\xf7\x31\x1f\xe1\x54\x57\x80\x30\x40\x18\x20\x0c\x10\x06\x08\xaf\x04\xe1\x58\x19\xce\xcc\xb1\x1e\x1c\x0b\xec\xab\x87\x6e\x5b\xad\xd3\x34\x40\x36\x20\x1b\x90\x0d\xc8\x06\x64\xcf\x42\xb6\x75\xf6\xe6\xb4\x1c\x87\x70\x80\x2e\x40\x17\xa0\x0b\xd0\x05\xe8\xae\x00\xdd\x60\xbd\xf9\xac\x81\x4e\xbc\x20\x27\x4e\x3d\xf1\x8e\x95\xf9\xf7\x75\xcd\x1a\x3a\x2b\x99\x0e\x48\x8c\xcf\xf8\x94\x4d\x1e\x55\xfe\xe4\x70\x8d\x8f\x20\xf3\xfb\x5b\x66\x7e\x5f\x5d\xb9\x11\x9e\xae\xd4\x9c\x2b\xbd\xb7\x8e\xdb\x43\x46\x69\xbe\x0c\x21\xad\xa1\x67\xf9\x79\x5b\x15\x7c\x6e\xc6\x57\x04\xda\x46\x86\x89\x7f\xe9\x0c\xfd\x5d\xef\x3f\x34\x5c\xbd\xf8\x5c\xa7\x6d\xb5\x6c\x6d\xf5\xb7\x3a\xf0\x68\x15\x89\xd4\xe0\x4c\x17\x43\xac\x92\xe1\xbf\x83\x4d\x59\x7d\xc1\x00\xda\xc4\x10\x23\x67\x0b\x39\x5b\xc8\xd9\x42\xce\x16\x72\xb6\x90\xb3\x85\x9c\x2d\xe4\x6c\x21\x67\xeb\xc1\x73\xb6\x86\xbb\x1d\xc8\x04\x0f\x8c\xe9\x53\x5f\x06\xab\x98\x16\xb2\x8c\xa9\xc9\x93\x97\xcd\xd0\x64\xcc\xc2\x32\x66\x66\x81\xc0\x7c\x46\x26\x67\x2d\x2c\x61\x62\xd2\x2c\x4c\x42\x77\xb2\x1f\x4a\xb0\x83\x19\xa3\x95\xc1\x0a\x42\xc7\x7e\x60\x1d\x5b\x95\x99\x9a\x18\xc9\x4f\xdd\x8e\x4c\x43\x8e\xec\x60\x9f\xb3\x23\x6f\xdb\xe0\x5d\x3a\xff\xcf\x3b\x3e\x95\x16\x5c\xf9\xed\x6f\x5b\x95\x4c\xa6\xaf\x7d\x19\xde\x5d\x12\x9d\x33\x74\xd4\x27\x62\x57\x83\x33\x5f\x88\x26\xb7\x33\xf6\xc0\x7d\x55\xc9\xe0\x5a\xc2\x3e\x84\x7d\x08\xfb\x10\xf6\xa1\x77\xbd\x0f\x5d\x78\xe2\xd2\x6b\x89\x07\x6b\xe7\x3e\x32\x92\x6c\x7e\xbc\xfd\x9e\x1a\x32\xdc\x17\x5a\x4d\xb2\xd6\x01\x41\xe1\xa9\x7b\x1a\x6f\x8b\xeb\xed\x92\x99\x7f\xa7\x9d\x6b\xe6\xaf\xaf\xfb\x48\xb5\x60\x72\x7a\x2f\xc0\x54\x28\xaf\x5a\xb6\x04\x79\xe7\x34\x1b\x6f\x58\xbf\xc4\x3a\xc7\x86\x23\xac\xd6\x7d\x37\xf2\x84\x04\x27\x67\x90\x21\x8f\xb5\x65\xbc\x95\xec\x34\x57\x73\x6e\x91\xa8\x41\x9b\xfd\x88\xae\xd1\x99\xa1\x5b\xe3\x10\x27\x03\x09\xb1\xdb\x63\xb7\xc7\x6e\x8f\xdd\xfe\x5d\xef\xf6\x6f\x20\x2f\xec\xff\x01\xde\x01\xef\x80\x77\xc0\xbb\x07\xc2\x3b\xcb\xed\x90\x67\xbd\xad\xca\x26\x1e\x88\x07\xc4\x03\xe2\x01\xf1\xbe\x63\xc4\x43\xb8\x1c\xc2\xe5\x10\x2e\x87\x70\x39\x84\xcb\x21\x5c\x0e\xe1\x72\x08\x97\x43\xb8\xdc\x83\x87\xcb\xad\x70\x80\x84\xd1\x3e\xda\x30\x7c\x1e\x7e\xba\x75\x17\x05\x9f\xb8\xa1\x20\xab\x05\x1f\x2d\x94\xee\xea\x33\x77\xe2\xb0\xc6\x2d\x66\x9d\x21\x1e\xb0\xb1\x52\x3a\xcb\xcf\x96\xc9\xc6\x3a\xde\x08\x62\xad\xd1\xbe\x6a\xdb\x4d\x6e\xb4\x33\xc1\x53\x76\x0a\x5e\xf9\x39\x5e\x70\x1e\x34\x05\x68\x0a\xd0\x14\xa0\x29\xde\x35\x4d\xe1\x41\xce\x92\x80\xbb\x1d\xee\x76\xb8\xdb\xe1\x6e\x7f\x54\x77\xbb\x47\x39\x67\x13\x77\x5a\x24\x46\x74\x12\x92\xae\xd2\x9e\x21\xa8\xb3\xfe\xe8\x1b\x50\xad\xd4\x2c\x80\x5b\x06\xb7\x0c\x6e\x19\xdc\x32\xb8\x65\x70\xcb\xe0\x96\xc1\x2d\x83\x5b\x7e\x70\x6e\x59\xe8\x46\x74\xc6\x50\x23\x02\x33\x9a\x5a\xce\xab\xb2\x1c\x63\xcc\x76\xfa\xb8\x89\xe2\x62\x3f\x5e\x71\xb1\x83\x73\xad\x27\xe4\x3f\x47\xd5\x35\x28\xff\x52\x9b\x4c\x1e\x23\xd3\x9d\xd2\x19\x3f\x0d\xec\xc0\x9b\x5a\x91\x29\xea\xc6\x1d\x72\x68\xf7\x46\x77\x2d\xf3\x96\x63\x18\xd1\x93\xbd\xb8\x15\x93\x1a\x92\x0c\x51\xc5\xb6\xeb\x6b\x11\xab\x7a\x62\xc8\xa3\x1d\xd5\xcc\x53\x09\x64\xcb\xb0\xc9\xf7\x67\x70\x21\x95\xdb\xe3\x37\x32\x8a\x3f\xca\x1f\xbb\xe8\x44\x8d\xb3\xac\x25\xc3\x76\xf3\xae\xb1\x1c\xb8\xf6\x92\x26\xb8\x8b\x9d\xb0\x93\x72\xbe\x42\x66\x99\xf2\xb5\x9d\x63\x7e\x78\xc6\xcf\x9a\x6c\xd6\xe1\xb0\xd4\x6f\x9d\x65\x6b\xe3\x46\x6e\xa6\xbc\xf0\x87\xce\xca\x0b\x1f\x35\x12\x5f\x1d\x2b\xa9\x9c\x6c\xda\xe7\xbe\xdf\x71\xd1\xbe\x91\xb8\x4a\x47\xaf\xa4\xdd\x43\xe5\x47\x71\x86\x9c\xb7\xb1\x74\xc3\x64\xc3\x6a\x5e\xa8\x6c\xdf\x48\x4a\xf1\xc7\x79\x8e\xce\xa7\xe5\x72\x3b\x8c\x7c\x99\xaa\x5f\x49\x29\xf7\x75\x87\xd9\xd6\xa7\x51\x5b\xab\x05\x5b\xb4\x2f\xc5\x50\xeb\x99\x2a\x15\xf1\xd3\x9c\xcf\x19\x0d\x8e\x25\xfc\x44\xf0\x13\xc1\x4f\x04\x3f\xd1\xbb\xf6\x13\xc1\xb1\x02\xc7\x0a\x1c\x2b\x70\xac\xc0\xb1\x02\xc7\x0a\x1c\x2b\x70\xac\xc0\xb1\xf2\xf0\x8e\x95\xe1\x0c\xe3\xe9\x02\x45\x27\x0a\x80\x44\xe2\x35\x75\xcd\x0e\xda\xba\xf0\x79\x3c\xdd\x7e\xb8\x9b\x61\x5d\x6b\xc1\x1d\xed\xb5\x79\x29\x95\x52\x4c\x51\xfb\x8f\x5f\x47\xf4\xaf\xb9\x84\xc4\x83\xf2\xb8\x03\x15\x75\xc2\xb7\xcf\x48\x14\x08\xb6\x6f\x34\xb3\x56\xb1\x13\x57\xb2\xe6\xe1\x43\x44\xea\x33\x5a\x6d\xca\x06\xd1\x92\x39\xc9\x42\xdd\xf1\x1d\x2f\x7e\x71\xf9\xa5\x2b\x03\x6a\x59\xc7\x8f\x6d\xb1\x04\xcf\xab\x5d\x2d\xdf\xb2\x41\xf7\x42\xbc\xb3\xaa\xbc\xf5\xbf\xec\x9a\x77\x5b\xab\x4a\x1a\x87\x8d\xea\xa7\x89\xa5\xab\x16\x20\x21\x29\x6e\xfd\xfd\x36\xc4\x8d\x38\x80\x15\x04\x2b\x08\x56\x10\xac\x20\x58\xc1\x89\x15\xe4\x6d\xab\xa4\xe0\x6e\x55\xd0\x37\xa8\x45\x50\x8b\xa0\x16\x41\x2d\x82\x5a\x04\xb5\x08\x6a\x11\xd4\x22\xa8\xc5\x07\xa7\x16\x77\x9d\xfa\x74\x09\xe2\x1b\x43\x1c\x53\x2b\x28\xf1\x4e\xc1\xfb\xd0\xe3\xd2\x43\x24\x4c\x6d\x98\xda\x30\xb5\x61\x6a\x7f\xc7\xa6\xb6\x50\x92\x1a\xc7\x04\x85\x28\x69\xa0\x1c\x50\x0e\x28\x07\x94\x7b\x04\x94\x0b\x4e\x14\x40\x0e\x20\x07\x90\x03\xc8\x3d\x08\xc8\xb1\x96\x87\xa8\x7c\x20\x1d\x90\x0e\x48\x07\xa4\x7b\xdf\x48\xa7\x1b\x9f\x72\x18\x21\xa2\x13\xa3\x39\xdc\x68\xc9\x0e\xc4\x6b\x32\x76\x85\x08\xf9\x85\x98\xa3\x63\xab\xb8\x2b\xeb\x89\xcf\x0c\x9c\x72\xa1\xa9\xe1\xbb\x10\xd9\x98\x9a\xc9\x6b\x39\x52\xad\xc8\xcd\xbe\x15\xd4\x6a\x25\xc5\xcb\x1d\x45\x31\xef\xa6\x3b\x1b\xe9\xee\xf0\xa5\x77\xf9\xca\x69\xfe\xee\x2c\xcd\x47\x9b\xc9\xa6\xa6\xcf\xac\xe5\xce\x91\x69\x6c\xe1\xf7\xd2\x33\xef\x94\x63\xaf\x62\xc6\x56\x5d\x25\x5a\xd3\xb3\x22\xe1\xb4\x61\x5c\x49\x5e\xa6\xfe\x83\xae\xfa\x69\x2d\xfb\x2a\xfa\x2c\xa8\x0f\x73\x89\x3a\xf5\x53\x52\x9e\xb9\x54\x4c\x37\xac\xed\x9c\x93\xcd\xfe\xeb\xe0\x4f\x7e\x4d\x41\x54\x17\x8a\x56\x7e\xd6\x1a\xe6\xab\x7b\x90\xbd\x87\x0c\x66\xa9\xe5\x86\x3b\x6d\x8a\x46\xbc\x38\xe6\xd7\x37\x2c\x9b\x64\x1f\xa8\xd9\xcf\x0f\x35\x75\x91\x00\x19\xb9\x44\x3e\xd5\x74\xdf\x68\x43\xec\xa2\x27\xf1\x2f\xf8\x1f\x7b\xd7\xb7\x1b\xb9\x6e\xf3\xef\xf3\x14\xf3\x02\x01\x0e\x3e\x7c\x17\xc5\xde\x1e\xf4\xaa\x45\xd1\x37\x10\x14\x9b\xe3\xd1\x89\x47\x72\x25\x39\x93\xe9\xd3\x17\x94\x3d\x49\x76\x1b\x89\xd4\x9f\xdd\xa4\x39\xda\xec\xdd\xd8\x3f\x53\x14\x45\x91\x14\x49\x45\x51\xea\x34\xd8\x1b\xad\xa5\xc6\x5a\x84\x4a\xbd\x77\x4b\xdd\xde\x74\x8a\xd2\x62\xb5\x73\x1d\x52\x55\x12\xf8\x0b\xc8\x2d\xaf\xb8\x14\x06\x47\x33\xe2\x9a\xdd\xd5\x64\x19\x9b\x03\x53\x8a\x15\xf8\xae\xa7\x2d\x1c\xd5\x73\x11\x00\xb6\x9f\x00\x27\xfe\xff\xb7\xdf\x84\x05\x59\x9c\xa1\x3c\x9b\xc9\x79\xe9\x4e\x81\x21\x15\xf7\x1b\xbc\xe0\xd0\x18\x0c\x62\xea\xf8\xf2\x16\xa3\x52\x05\xde\x0a\x07\xae\x62\x02\x2f\xc0\x55\xed\x82\xaf\x60\x3f\xee\x1e\x45\x70\xe8\x72\x5f\x8c\x8d\x68\x89\xee\x76\x77\xb7\xbb\xbb\xdd\xdd\xed\xfe\x9f\x76\xbb\xe3\x39\x91\x04\x17\x17\xb5\x40\xbc\x1d\x21\xf5\x32\x51\x2d\x15\x4f\xcf\xc3\x3d\x07\xac\x30\x7f\x08\x07\x56\xc9\x59\xfd\x3b\x96\x88\x48\x4d\x98\x85\xc1\x68\x0d\x83\x47\x67\x03\xac\x35\xc5\x38\xb3\x91\xa3\x90\x47\x0f\xb6\x88\x19\x3b\xc0\x4e\x0d\x65\x16\x93\x84\x18\x2d\xd0\x85\x5a\x2d\x94\xc2\x84\x6e\x54\x8f\x70\x75\xc8\x99\x75\x19\x4b\xb7\xcf\x77\x91\x8a\x9d\x87\x97\xec\xaf\x54\xce\x23\x89\xe1\xb0\x03\xe8\xe0\xab\xa6\x0b\xed\x0b\x2f\xa7\xb2\xb7\xcd\x3c\xa3\xd3\x20\x82\x79\x5a\x38\x43\x66\x0d\xb6\x4d\x29\x27\xdd\x70\x82\x33\x94\xbd\xaa\x15\x56\x60\x88\x61\x96\xce\x95\x07\x57\xb0\x62\x12\x6d\xb5\x1a\x5b\x2f\x60\x28\x5d\x8d\xf1\x04\x56\x1d\xaf\x65\x33\xb1\xbf\x5f\xfe\xfd\x75\x09\xa5\x97\x62\x34\x83\xb8\x58\x59\xe8\x70\xbd\xc0\xe0\xe7\xc8\x59\x89\xe3\x54\xd5\xa2\x4a\x8b\x06\x7c\x10\xeb\x5a\x10\x7c\xaa\x1c\xe3\x16\x2f\xea\x59\x9e\x3d\xcb\xb3\x67\x79\xf6\x2c\xcf\x2f\x9a\xe5\xf9\xa2\xe7\xe2\xac\xe5\x6a\xca\xca\x28\xe6\x0d\xc7\x95\x51\xc1\xe8\x4f\x4d\xbe\x2c\x2a\x02\x6b\x58\xa0\x21\x16\x69\x1d\x6c\x6e\x40\xb1\x6d\xb7\x01\x59\x18\x54\xb1\x41\xc0\xda\xc0\xa3\x6f\xaf\x1a\x9d\x9a\x27\xb0\xe1\x1c\x67\x1f\xcc\x75\x29\x9c\x98\xd5\x15\x5a\xc8\xab\x1f\x6a\xcc\xdb\xbd\x07\x08\x88\x3d\xf9\x85\x61\x60\x25\xc0\x82\x75\xf7\x26\xae\x18\xca\x44\xbd\xb4\xbe\xf4\x7c\xea\xa2\xfc\x49\x78\x2b\xb5\xc3\x9e\x1f\x60\xb1\x0d\x70\x21\x12\x1e\x98\x0a\x34\x45\xc8\x8e\x27\x11\x5e\x27\x54\xc4\x76\x98\x37\xfe\x43\x9e\xc1\x2d\x72\x78\x4f\x06\x94\x87\xf3\xbb\xa2\xc1\xf8\xa6\xb4\x56\xfe\xa8\x04\xdf\x37\xb8\xd2\x7b\x0e\x5e\x6c\x80\x31\x08\xe1\xd6\x23\x11\x90\x8e\x33\x52\x2e\x0b\x71\x9a\x15\x7f\xb7\x97\x94\xf7\x92\xf2\x5e\x52\xde\x4b\xca\x7b\x49\x79\x2f\x29\xef\x25\xe5\xbd\xa4\xbc\x97\x94\x7f\xf1\x92\xf2\x94\xa7\xd8\x2f\xe2\xea\x17\x71\xfd\xf7\x45\x5c\xe5\x67\xa4\x3c\xc7\x26\xfa\xbe\xbb\x9e\x67\xa5\x1f\x05\x45\x40\x4c\xd2\xe2\xf1\xac\xfb\x40\xdb\x5d\x06\x23\x8e\x6a\xf6\x60\x73\xbc\x48\x6a\x31\x0d\x46\x0f\xb1\x85\xc8\x59\x8a\x01\xc1\x2b\xbd\x9a\xd5\x09\x3c\x8a\x16\x16\x26\x78\x4e\x18\xfe\x24\xc3\xf3\x8c\x75\xde\xce\x8f\xcd\xe9\x60\xc1\x00\x53\x30\x9c\x92\xeb\x9d\x9a\xd0\x77\x11\xcf\xe0\x25\x66\x2b\x57\x8f\x9b\x45\x1a\x81\x71\x5e\x67\xaf\xc2\x64\x80\x1e\x5b\x4d\xc8\x2b\x68\x88\xdc\xb4\x82\xd5\x41\x6a\x1c\x8d\x43\x4d\x70\xd6\xdc\x12\x44\xdd\xb0\x88\xf3\x05\x26\x1a\x91\x9b\x97\x83\xb4\xe7\xfc\x8f\xe8\x00\xf8\x6b\x93\xb1\xee\xb6\xa6\x98\xe5\x03\xcc\xd5\x68\x98\x9c\x7f\x54\xd6\x79\x2a\x7f\x35\x67\xa5\x21\x68\xe3\x85\x46\xec\x36\x18\x20\x19\x4d\x95\x5e\x1c\x41\x8c\xc6\x0b\x0d\xce\xc3\x58\xcf\x83\x1d\xae\x95\x2c\x71\xc6\xbf\x2e\x35\xe3\xc7\x8e\xc6\xd2\xa6\x6e\xec\x23\xb6\xae\xac\xf1\xa4\x83\xa2\xaf\xff\x42\x30\xa4\xc9\xba\x41\x9f\x74\x2b\x74\x69\xa0\xbc\xf6\x85\xfd\xb9\x78\x75\x51\x7a\x34\x97\xda\xc1\x31\x24\xcd\xc3\xe0\xff\x4a\xd4\x23\xf0\x84\x6e\x96\x7a\x5a\xe5\x04\x9f\x88\x8b\x28\x27\x0f\xd7\xe8\xc1\x1c\x9f\x91\x1b\x54\xa3\xdd\x72\xf7\x7c\xbe\xdd\x55\xf2\xe0\xd5\x2a\xc8\x35\xd8\x08\xe0\x3d\x6f\x0d\xb3\x61\x52\x69\xf2\x19\x88\xa9\x3b\x0b\xdb\xe9\x4d\xd0\x27\xa9\x07\xf8\xdb\x5f\xaa\xe4\x18\x5b\x91\x87\x9b\x1a\x3f\x91\x20\x3f\x80\xb4\x60\x85\x37\x8f\xa0\x13\x79\x3d\x19\xdf\x4d\x76\x81\xe3\x33\x8b\x99\x2b\x94\x07\x98\x97\xd3\x91\x8f\xcd\xce\xef\xc8\xe0\xe7\xdb\xbf\xf8\xf9\x75\x03\x70\x7e\xde\x47\x8e\x89\xc3\xf1\x99\x4b\x32\x41\x98\x2b\xb7\xe0\x51\xd2\x3f\xc8\xe2\x2e\x23\x17\xa9\xcb\x70\x97\xe1\xa6\x32\xcc\x7a\x6c\x90\xc3\x09\x4b\x7f\x8f\x78\xc2\x51\x6f\x7b\x7c\x07\x27\x9e\xa4\x55\x89\xd4\x8b\x7c\xe0\x74\xe8\x3b\x0f\xcb\xfb\xb9\x01\x14\xd5\x0a\xb0\x6f\x75\x7d\xab\xeb\x5b\x5d\xdf\xea\xfa\x56\xf7\xf1\x5b\x1d\xd1\xd0\xb0\xab\xea\xae\xaa\xbb\xaa\xee\xaa\xba\xab\xea\x8f\x57\xd5\xd8\xc6\x06\x03\x65\x7b\x52\xf9\x27\x0a\x95\xe1\x01\x15\x91\x7a\xc5\xfc\x9e\xda\x2e\xea\x0a\xd9\xe3\x89\x14\x84\x8f\x18\xa4\xd2\x62\x31\xe3\x27\x23\xea\x71\x7d\x00\xab\xc1\x83\x8b\xb7\xf1\xc9\xf8\xe8\x16\x3d\x11\xa3\x6a\x70\x76\xeb\xe6\x97\x43\xcc\xe1\x24\x15\xc3\xdf\xa5\x96\xf5\x56\x35\x1b\xbf\x7a\x93\x8f\x45\x2e\xb8\x09\x8c\xaa\x3a\x0e\xdc\xbb\x5d\x89\x59\x3d\x58\x69\xaf\x34\xc1\x04\x3b\x03\x41\xe2\xff\xb0\xa1\x8f\x4c\xe7\xbf\x66\x01\x36\x86\x9b\x8d\x79\x5c\x17\xf2\x0c\x94\x85\x48\x56\x22\xb0\xd6\x9a\x1c\x47\xb5\x6d\x31\xff\x64\xef\xa9\x2c\xf2\x58\x62\xc4\x5f\xc8\xee\x51\x2d\x02\x89\xd5\x93\xd0\xeb\x3c\xef\x85\x18\x3f\x5f\xd0\x2d\x54\xc9\xb9\xd4\x63\xe5\x0c\x71\xbe\xb2\x9f\x35\x3d\x87\x56\x61\xd4\x63\xac\xaf\x7e\x3e\x3b\x2b\xd9\xb6\xac\x1a\xff\x67\x18\x42\xf7\x37\x9a\x19\xcf\x32\x97\x0a\x7f\xc1\xdc\x86\x45\x65\x65\x75\x89\xf8\x33\x49\x04\x13\x94\x03\xc7\xd0\x36\x0c\xa9\xe2\xcb\x13\x4b\x92\x32\xe6\x98\x2d\x3d\x6c\x4c\x9e\xc4\xd0\xb2\xc2\x93\x92\x86\x53\x69\xec\x2f\x9b\x45\x86\xd4\xb0\xbf\xda\x35\xd2\x17\xd0\x48\x7d\x8f\xea\x7b\xd4\x4f\xdb\xa3\x68\xd1\x62\x08\x15\x5f\x9c\x58\x82\x94\x31\xc5\x6c\xe1\x61\x63\xf2\x04\x86\x16\x15\x9e\x90\x34\x9b\x49\x12\x28\xb4\x0a\x89\xee\x62\x9c\x19\x04\x2c\x93\x53\x3a\x34\xba\xd8\x9d\x4b\xe1\x4d\xaa\x09\x21\xdf\xcb\x3c\x84\x92\x29\x11\x42\xe7\xe2\xa8\x60\x1e\x69\x44\x62\x32\x95\x46\x5e\x60\x24\xa1\x55\x3e\x24\x42\x51\xb1\x6c\x16\x50\x98\x8b\x6f\x77\xf5\x0b\x6a\x84\x50\xbc\x98\x2a\x41\xce\x20\xeb\x3b\xc4\x74\x13\xec\x02\x64\x70\x5e\x9d\xb1\x6f\xcf\x80\xcd\x0c\xb5\x17\xf0\x04\xda\xf3\xe0\x29\xe1\xc1\x3f\x78\x0e\x4d\x1e\x94\x69\x47\x72\xaa\xe8\xb2\x18\x6e\xab\xf6\x48\x97\x62\x66\x02\x87\xc2\xaa\x5b\x53\xa1\x76\x1c\x0d\x05\x2e\xa2\xbd\x8c\xcd\x66\x90\x73\x28\x77\x69\x47\xeb\x4b\x5a\xf5\xb7\xbb\x26\x36\x12\x7b\x30\x1c\xe5\xbc\x1f\x83\x61\x50\x0e\xce\x8b\xbf\xe2\x0d\x1d\x4a\x4f\xdf\x92\x8f\xe7\x8c\x3e\xc4\xfb\x36\xe5\xd9\x7a\xd9\xee\x78\xae\x11\x5f\xb9\x9a\xad\x46\x67\xe4\x72\x2f\x57\x7f\x64\x72\x30\x47\x97\x14\x41\x67\x2d\xff\x32\xe6\xf0\x97\x6c\x19\x7e\xf6\xda\xa8\xf8\x4c\xd6\x3a\x29\x9a\x10\xe4\x53\xb2\xb7\x5b\x0b\xfc\xcc\xb9\xce\x03\xa7\x8e\x62\xab\xd0\xd3\x7d\x1b\x6a\xc0\x7f\x1a\xd5\xf1\x76\x74\x95\xc8\xc9\xae\x1a\xe5\xa2\xce\xf4\x2b\x72\xb6\xaf\x0c\xa1\x66\xf3\x80\x2b\xc8\x79\x80\x1c\x31\xc8\x42\xe4\x08\x2c\x1f\xb0\x29\x75\x1c\xc1\x64\xa3\x31\x84\x91\x2b\x86\x2c\x01\x0c\x7e\x50\xec\xd6\x3b\xb6\x59\xc1\x37\x29\xd8\xa6\x6c\x06\xcf\x0a\x5c\xa6\x2c\xf4\x52\x13\x28\x47\x5f\xe4\x98\x3e\x19\xa4\x73\x77\xc0\x6c\x48\xbe\x0b\x95\x05\x9e\x69\x47\xe5\x70\x38\xd3\x95\xca\x22\x3b\xc7\x36\xcb\xa1\x99\xed\x52\xb1\x8d\xff\xac\x61\xf1\x77\xa6\x22\xf3\x31\x87\x13\x25\x66\x63\xc6\x48\x77\x4c\xd7\x90\xcf\x79\x6e\x56\x9d\xa3\x95\xc7\xcb\x7c\x8d\x93\xcd\xcf\x3c\xed\x53\x08\x5f\xe0\x74\x95\x30\x2a\xd7\xf1\x2a\xf9\x46\xa1\xf3\x55\xfc\xa9\x02\x07\xac\x60\x82\xb2\x9d\xb0\xd2\x6f\x64\xcf\x7f\xee\x07\x38\x16\x63\xd5\x17\xf8\x0e\x59\xc9\x07\x7e\x2a\xf5\x7c\xc7\xac\x00\x9d\xed\x9c\xe5\x2f\x85\x0c\x07\x2d\x67\x23\xcc\x12\xfa\x0c\x7e\xf0\x05\x3d\x17\x94\x27\x1e\x99\xa8\x3c\x81\xce\x01\x6d\x4e\x25\x4f\x70\x33\x10\x59\xc2\xca\x17\x53\xa6\x80\x72\x44\xf3\xf5\x46\xad\x90\x9a\xcf\x3d\xdf\xa3\xa8\xb4\xb0\xcc\x98\xe4\x7f\x3b\x92\x74\x78\xd5\x96\x1e\xa0\x05\xb2\x03\xfb\x04\x82\xd7\x36\x8b\x8b\x46\x6d\xe2\x1c\x34\x72\x56\x16\x6b\xce\xe0\x4f\xb0\x46\x85\x8b\x63\x1a\x06\x77\x25\xf1\x7b\x49\x56\x34\x53\x94\x59\x72\x77\x06\x6f\xd5\x90\xfc\x20\xc3\x54\xe6\x1b\xc9\x0f\xeb\xf0\x08\xb1\xab\xab\x0b\x06\x89\xff\x47\x70\x43\x53\xc0\xd6\xea\x99\x16\x82\x52\x51\xc8\x26\x85\x29\x16\x39\xe5\x66\x1f\xa7\xfc\xb9\x19\x26\x28\x20\xc4\x23\x38\x54\xe2\x11\x1c\xe7\x5d\x03\xce\xd2\x8a\x9e\x04\xda\x73\x46\xce\x66\x54\x47\x05\xb6\x46\x41\x0d\x27\x69\x05\xe8\xc1\x8c\x84\xbb\xc2\x9a\x95\xc5\x62\x93\xc0\xbd\x21\x6f\x2f\x3b\xc9\x2a\x3b\x79\xdd\xdc\x5d\x03\xce\x85\x1d\xbd\x96\x75\x7c\xbd\xfe\x93\x02\x9f\xad\x35\xf1\xce\x97\x0f\xd0\x41\xaf\x0c\xaa\x4e\x87\xdb\x07\xf1\xab\xe4\xf2\x72\x52\x1e\x66\xe5\x7c\x0b\xd1\xe4\xaa\xb6\x70\xc1\x12\x06\x9e\xea\xb4\x9b\x5c\xbd\x09\xd5\xb0\x83\x74\xbe\xd6\x64\xbc\x5d\xaa\x24\xec\xfa\x70\xad\x07\x0b\x71\xaf\x5e\x9e\x97\x5d\x9e\xd7\x56\x4f\x6a\xb8\x34\xaa\xef\xbb\xa1\x71\x3c\xfc\x36\x2b\x65\x94\x83\xaf\x5a\x1d\xc4\x05\x38\x2c\x3a\x0f\x87\x61\x75\xde\x9c\x6f\x61\xc8\x5a\x31\x64\x7d\x92\x27\x29\x94\x88\xfc\x5a\x6a\x3e\x1f\x7f\xf6\x8d\xe4\x4c\x1c\x50\xb0\xbe\xe8\xe4\x9c\x04\xe9\x7d\x6b\x7a\xdf\x9a\xde\xb7\xa6\xf7\xad\xe9\x7d\x6b\x3e\xac\x6f\x0d\xf9\x88\x93\xe7\x25\xde\x13\x99\x23\x50\xa1\xf6\x84\x90\x24\xd6\x04\xb7\xbb\x7d\x65\x01\x3b\x80\xf6\xac\x8e\xe3\x14\x96\xc5\xaa\x8b\x16\xc3\xb3\xf1\xeb\x92\x4b\x8d\x71\xde\x08\x18\x52\xc0\x78\xc4\xf9\x31\x71\x47\x1c\x47\x50\xcc\xea\x97\xd5\x93\x67\x56\x0c\x66\xd2\xc4\xae\x67\x33\x9b\x49\x0d\x35\xf4\x0e\x66\x9e\x61\xf0\xc6\xbe\x1c\xbe\x54\x92\xfd\x16\xb2\x4d\xb4\x6c\x2f\x77\x16\x78\x2b\x92\x54\x1a\xec\x76\x4f\x4e\x33\xdc\xa3\x1c\xd4\xac\xfc\xb5\x31\xec\xc9\x38\xdf\x18\xf2\xb5\x6d\x55\x5b\x5c\xec\x39\xd5\x18\xd1\x2a\x63\xdb\xf3\x74\xd5\xaa\x15\x4f\x67\x33\x31\x8e\xc1\x59\x50\xce\xac\x76\x00\x31\x48\x0f\x93\xb1\xd7\xd6\x78\xed\x56\xe6\x8f\xc0\xad\x6e\x81\xf8\x01\x76\x77\xbf\xc4\x28\xdd\xa9\x15\x38\xae\xa6\x96\x58\xcd\x99\xda\x1a\xab\x1d\x81\xde\xca\x41\xe9\x49\x48\xad\x8d\x0f\x6d\xc1\x5b\x4d\xfc\x0d\xf9\x55\x33\x37\x25\x98\xbb\x3c\x29\x93\xf7\x86\xd7\x44\x86\x6e\x60\xe1\xec\xb3\x35\x23\x5f\x14\x7c\x33\xc4\xc5\x8c\x2d\xb1\x84\x1a\x7f\xb6\x59\x83\xd7\xd3\x68\x9c\xf9\x59\x55\x96\xa3\x37\x51\xef\x34\xbd\x27\x6b\xbc\xaf\x73\x2f\xc2\xbd\x34\x62\xcb\x21\xd8\x2e\xb7\xa6\xa9\xa6\x2c\xe2\xef\x30\x17\xb0\xca\x8c\xc2\xb5\x82\x1d\xad\x59\xc4\x6c\x26\x06\x20\xb5\x3a\x37\x3a\x5b\x38\x1f\x1b\x12\x26\xd3\x78\x81\x8e\x48\xbb\xe1\x5e\xa4\xd5\xb8\x02\xc2\x15\xff\xf5\xb0\xb4\x4c\x59\x39\xc0\xef\x46\x7b\x78\xf6\x35\x72\xb5\xef\x27\x9f\x2b\x5e\xec\x16\xa9\x85\x1a\x9b\x4c\xf9\x1b\xac\x4f\x34\x42\x54\x98\x78\x7b\x97\x9c\x5c\x93\x51\x6e\x78\x6a\x6c\x0e\xf6\x69\x78\x46\xac\x88\xe4\xcf\x71\xf4\xa3\xb1\x17\xf9\xde\x01\x58\x7a\xed\xc8\xe1\x51\x58\x70\x8b\xd1\x0e\xd2\xf7\xc6\x53\x8b\xfd\x61\x3d\x1e\x63\x7b\x18\xbd\x7e\x87\xd3\xaa\x1f\xc5\x11\x73\xef\xdb\xdc\x84\xbf\x01\x86\xfd\x65\x3f\x1b\x74\x14\x5e\x5a\x91\xbd\x05\x4c\xdf\x11\xc3\xa1\xce\x9c\x43\x2a\x41\x15\x48\xd0\xd0\x30\x8a\xc1\x9c\x91\xa8\xe4\xec\x71\x21\x95\x0b\xa7\xe3\xdb\x60\xb1\xe9\xee\xba\x50\x80\xe9\x4d\x6f\xbb\xd7\x4f\x7a\xe1\x4e\xab\x1f\xcd\x45\xb7\x80\xa3\x23\x8b\x8c\xb1\x6e\x94\x9d\xcd\x58\x37\x95\x1b\x0c\x0a\xad\x1c\xc5\xc3\x8a\x77\xd7\x36\x24\x6f\xc7\x0d\xf7\x8e\xd6\xc9\xef\x77\x78\x4d\x28\x34\x4f\x60\x8f\xb3\xb9\x08\xea\x0c\x9a\x81\x95\x6e\x03\xce\x00\xf8\xd7\x0a\x2b\xec\xcb\x73\x06\x3d\xf9\x53\x1d\xbb\x02\xde\xb8\x2d\x04\x97\xb1\xec\xd3\xa8\x16\xbc\xbd\x0a\x78\x5e\x8c\xc6\x3b\x98\xe5\x1c\xd6\x98\x39\x1e\x45\xba\x6b\x34\x83\x01\x1b\xf4\xd1\x58\x78\x02\x4b\x21\xa5\x17\xd9\x06\x85\xd7\x85\x36\x91\x93\x57\x38\x54\x51\x95\x6a\x78\x03\xb3\x52\x8f\xe6\xcc\x98\x0e\xce\x40\x1d\x0c\x46\x8f\xd2\x5e\x1b\x6d\x3d\x1b\x6a\x0b\x75\xbc\x23\x25\x03\xec\x6c\x98\x8b\x54\xbe\x0a\xc6\xcb\xc9\xd5\x01\xa8\x33\x24\xcd\x3a\x3e\x86\xc0\x4b\xbd\x93\x45\x1e\x9c\xf9\xbf\x81\xd5\xb3\x66\x07\x4a\x97\xda\x70\x80\x8c\x97\x73\x86\xba\x49\x83\xd5\x09\x0e\x61\xa8\x0e\x46\x6b\x18\x08\xbb\x83\x5a\xd2\xa3\x76\xc2\x9a\x55\x8f\xc2\x9a\x87\xd8\xa5\x06\xd4\x54\xc2\xf3\xa2\x2c\x08\xc4\x0a\xf7\xee\x95\x91\x72\x92\x76\xac\x1b\xcc\x09\xa4\xf5\x0f\x20\x29\x0b\x80\x8f\x13\x9f\x41\x62\xf6\xd4\xa4\xf1\x7e\x13\x0d\xfe\x62\xec\xe3\xd6\x96\xd0\x05\x33\xcc\x4b\xeb\xd7\xa5\x8c\xd1\x98\xee\x28\x67\xf5\x04\x95\xaf\xd7\xb1\x79\x39\x29\x71\x94\x6a\x5e\x71\xce\xc3\xed\xdf\xc6\x96\x11\x84\x48\x84\xce\xa7\x88\x41\xdf\xe2\x09\x6c\x42\x85\xd0\x08\x21\x37\x41\xbc\x75\xc5\xca\x86\xe3\x60\x58\xf1\x24\xaa\xd4\x09\x93\x73\xb0\xe6\xb4\xd1\xd7\xb3\x59\x9d\xd8\x42\x2a\xb1\xa7\x69\x7a\xf0\xcf\xc1\x7c\x0c\xd1\xe8\x74\xb8\x9c\x54\x46\x87\x83\x3b\x49\x0b\x44\x5c\x80\x01\xb3\x3a\xb0\x42\xae\xfe\x54\x33\xae\x74\x3e\xc9\xfd\xf7\xa3\x8e\x3d\xf3\x32\x9e\x12\xed\xeb\x40\x57\x6a\xab\x50\x87\x17\xeb\x37\x93\x0c\x88\xd0\x92\x74\x38\x34\x39\x80\x68\x72\xc4\xb2\x48\xe7\x2e\x44\x6e\x70\xcf\x6c\xec\x99\x8d\x3d\xb3\xb1\x67\x36\xf6\xcc\xc6\x0f\xcb\x6c\x3c\x1c\x16\x93\xbe\xe1\x9a\xda\xd0\xf8\x66\x42\x57\xf8\x5d\xe1\x77\x85\xdf\x15\x7e\x57\xf8\x1f\xaa\xf0\x9d\x97\x7a\x6c\x51\x86\x89\x3e\x1d\x35\xa9\x5d\xe3\x77\x8d\xdf\x35\x7e\xd7\xf8\x5d\xe3\x7f\xa0\xc6\xbf\x80\x9a\x4e\xd5\x46\x3e\xc5\x90\xfb\x10\x7d\xba\x2b\xa4\x33\x9d\xc1\xe3\x67\x27\xb6\x38\x69\x88\xf1\x39\x35\x69\x4c\xc1\x80\x98\xeb\x42\x4d\x36\xe2\x0d\x60\x3d\x66\x18\xaa\x41\xce\xc2\xf9\x10\xb8\x8f\x0a\x2c\x21\x9e\x2f\x78\xf1\x13\x75\x7a\x61\x32\xf6\x40\xde\xea\xe6\xeb\x0c\x1e\x1e\x5b\x4f\x64\x2c\x62\x9e\x6e\xc8\x00\xe4\xeb\x03\xbe\x26\xe0\xe9\x00\x7a\xf5\xb3\x56\x29\xe3\x21\x62\xbf\x62\x70\x8b\xb1\x47\x75\x19\xfb\x13\xcb\x18\xf1\xc0\x8b\x9e\xf3\xa7\xf5\xfc\xb0\x58\x15\xcb\x8f\xe2\xea\x4b\x4c\x28\x00\x4c\x77\x59\xac\x72\xb0\xa9\xe1\x6f\x77\x25\x1c\x0d\xa4\xa9\xe5\x04\xd6\x95\x93\x34\x2b\xd0\xbe\x6b\xf2\xae\xc9\xbb\x26\xef\x9a\xfc\xeb\x6b\xf2\x4d\xdd\x2d\x56\x3d\xed\xe5\xf9\x02\x8f\x6d\x97\x93\x8d\xa6\x45\x76\xdd\xd7\x75\x5f\xd7\x7d\x5d\xf7\x7d\x4d\xdd\xd7\x2d\xbe\x6e\xf1\x75\x8b\xaf\x5b\x7c\x5f\xd6\xe2\x53\x3a\x64\xab\x42\xa2\x00\x8b\x1a\x3d\xfa\xd9\x4f\x60\xd5\xf1\x4a\x24\x98\x32\x81\xe2\x9d\x8f\x89\xc9\xdd\x89\xd8\x13\xe2\xb1\xe9\x44\x5d\xa2\x75\x7c\x32\x31\xb3\x34\x64\x6d\xde\x65\xb0\x7c\x7a\xef\x6e\x80\xf4\x7a\x92\xc3\x5c\xc4\x89\xd0\xab\x77\xb0\x80\x1b\xd9\x56\x8e\x5f\x32\xfe\xc3\x81\x7e\x37\x4a\x42\xaf\x43\xed\x75\xa8\xbd\x0e\xb5\xd7\xa1\xf6\x3a\xd4\x5e\x87\xda\xeb\x50\x7b\x1d\x6a\xaf\x43\xfd\xea\x75\xa8\x5b\xfc\x04\x65\x34\x6a\xd8\x51\x2b\x7a\xc7\x48\xae\x15\x12\xc3\xc2\xb8\x29\x53\x27\xfe\x70\x46\x97\x9a\xa0\x3d\x80\xd3\x03\x38\x3d\x80\xd3\x03\x38\x9f\x38\x80\x03\x7a\xb0\xd7\x90\x81\x12\xaf\xb3\x21\xf8\x99\x6a\x58\x48\x8b\x8a\x1c\x47\xa1\xe1\x92\xbe\x8f\x9d\xc3\xfc\x33\x38\x27\x27\xa2\x21\x78\xf5\x16\x07\x7a\x4d\xac\xb4\xfb\x83\x59\xbd\x38\xaa\x39\x7e\x35\xdd\xfd\x01\x77\x94\xc4\xcf\xb3\x77\x4f\x89\x9f\x87\xe4\xaf\x67\x37\x2d\x72\x78\x4c\x3c\x81\x3d\xe1\x13\x3f\x3b\xa5\xa7\x79\xbf\x31\xae\x9c\x8b\x84\xd0\x9d\xe0\x79\xb7\xb7\x93\x8e\x15\xb5\x4d\x3f\xc2\x15\x59\xfd\xed\xae\x80\xc6\x8d\x36\x14\x96\x64\x3f\x65\x1e\xca\x19\xbc\x8c\xdf\xa9\x5a\x5d\x80\x9d\x94\x68\x92\x46\xd6\x46\xc3\x42\xa1\x14\x5b\x4a\xa5\xdd\x1f\x52\x22\x45\xc8\x0b\x95\xc9\x8b\x61\x83\x8b\x55\x3e\x29\x0a\x71\xed\x11\x8f\x14\x10\x4c\x59\xac\x41\x8a\x8b\xde\xc5\xac\x34\x54\x57\xc3\x2c\x9d\x2b\x46\x00\x21\xcb\x5e\x0e\x37\xba\xa5\xae\x99\x4c\x33\x2d\x2e\x0a\xf7\x7b\xc4\xf7\x9d\x1f\x76\x76\xdd\x65\xcc\xfe\x04\xf3\x3b\xc6\x48\x7a\xd1\xf4\xb0\x71\x0f\x1b\xf7\xb0\x71\x0f\x1b\xf7\xb0\x71\x0f\x1b\xf7\xb0\x71\x0f\x1b\xf7\xb0\xf1\x17\x0f\x1b\xc7\x1b\x54\x11\xc8\xf1\x36\x25\x94\x02\x58\xac\xf1\x66\x30\x73\xd1\x67\xfd\x1c\x91\x5e\x4a\x52\x30\xbb\x65\x8b\x58\x45\x00\x72\xee\x29\x23\x88\x24\xb8\x9e\x72\x00\xde\x2d\xd9\xbc\x0f\x4d\x61\xee\x32\x3e\x32\x19\x33\xcd\xf0\xfb\x6c\xd6\xf1\xef\x66\x9a\xde\xf5\x54\xba\x27\xd0\x3d\x81\xee\x09\xfc\x02\x4f\xe0\x3f\xec\x5d\x51\x72\xe4\x2c\x0e\x7e\xef\x53\xe4\x02\x79\xdf\xca\x21\xb6\xb6\x76\xf6\x9d\x22\x58\x71\x53\xed\x36\x2e\xc0\x93\x64\x4e\xbf\x85\xdb\x9d\x49\xfe\x09\x48\xe0\x4c\xa5\xd3\xf9\x2a\x8f\xed\xc8\x58\x88\x4f\x08\x09\x7d\x7f\xca\x45\x24\x80\x48\x00\x91\x00\x22\x01\x44\x02\x88\x04\x2e\x25\x12\x78\x55\xb8\xd1\xba\xeb\x43\xcd\x06\x6a\x36\x50\xb3\x81\x9a\x8d\x0b\xae\xd9\x38\x75\xca\x2f\x54\xa6\x71\xdf\x7d\xf8\x57\x50\x66\x98\x43\x24\xaf\x06\x67\x74\x7e\xab\xcc\x4c\xcb\x6b\x41\xf9\x79\x66\x84\x9c\x38\x49\x8f\x7a\xfa\xec\x33\x8d\x75\x28\xe1\xf3\xc7\x31\x69\xbf\x9c\xc9\x87\xd9\x98\x6c\x48\xce\xcd\xf2\x9a\x6e\xcd\x92\x9f\x32\x83\x0c\x69\xd3\x9e\xc8\xb0\xb3\x80\x20\x15\x70\x11\x53\x9b\x36\x67\xbd\x9f\x4c\x9b\x2e\xd3\x7f\x9f\xab\x3c\x54\xba\xb8\x65\x0d\xb5\x48\x2a\x0c\x72\x1f\xe3\x3b\x6a\x2a\xe3\x76\x9e\xfc\x80\xc7\x7b\xbe\x99\x3e\x2f\x43\xb8\x61\x92\x0b\xab\x73\x6a\x75\x72\xc5\xce\x4d\x64\x6e\x2d\x4e\xae\x41\xb0\xdc\xd9\xf1\xc6\xd7\xe6\xf4\x64\x8e\x8f\x31\xee\xa6\x07\xd9\x9a\x25\xb1\x36\x05\x1b\x2e\xd8\x28\x6c\xb4\xda\x46\x05\x0f\xf1\x0d\x8d\x01\xb3\x80\x59\xc0\x2c\x60\x16\x30\xdb\x0c\xb3\xe5\xe1\xdf\xbe\xec\x75\x33\x3f\x9f\x31\x7a\xd7\xf0\x72\xe4\x98\x91\x63\x46\x8e\x19\x39\x66\xe4\x98\x91\x63\x46\x8e\x19\x39\x66\xe4\x98\xaf\x3d\xc7\xec\xc6\x98\xba\x14\xe4\xdf\xc2\xbc\x81\xc6\x6e\x72\xad\x4d\xce\x17\xfa\xe7\x17\x72\x61\xa5\x83\x9a\xc7\x95\xb8\x58\xdf\x97\xef\x42\xe6\x6d\x02\x37\x78\x71\x83\xb7\xf6\x06\xaf\xee\xc8\x5f\x40\xa6\x2c\x65\x4d\xd4\x91\xe2\xde\x65\x9c\x17\xf3\x82\x34\x95\x6a\xb9\x57\x7a\xb7\x6b\xb1\x5b\x37\xd1\x58\xf6\x79\x9c\x77\x9f\xbc\x7b\x7a\x6e\x1a\xfb\xb2\xa5\xdd\xf4\xee\xc5\xd1\x26\xdc\xf8\x8d\x28\xc6\x75\x14\x1a\x2e\x32\x73\xaf\xe2\xee\xf0\x86\x30\x6c\xd3\x63\x2a\x8e\x37\x1a\x3c\x0b\xe0\x59\x00\xcf\x02\x78\x16\xae\x9f\x67\x01\xb4\x34\xa0\xa5\x01\x2d\x0d\x68\x69\x40\x4b\x23\xa1\xa5\x01\x1f\x0d\xf8\x68\xc0\x47\x03\x3e\x9a\x6f\xc5\x47\x03\x22\x1a\x10\xd1\x80\x88\x06\x44\x34\xdf\x84\x88\x66\x25\x6f\xc9\x97\x36\x30\x0a\xdd\x46\x1e\x93\x57\xd7\xed\x4b\xca\x67\x57\xf1\x55\x07\xfd\x70\x78\xa7\xa3\x64\xd9\x68\xb5\x39\x6c\x3b\x45\xbd\xf7\xee\xd0\x7a\xac\x80\x52\x28\x94\x42\xa1\x14\x0a\xa5\x50\x28\x85\x42\x29\x14\x4a\xa1\x50\x0a\x85\x52\xa8\x6b\x2f\x85\x3a\xe5\x90\x1a\x2f\x18\x9f\x77\x40\x89\x7b\x20\x55\x1d\x98\x26\x29\x1d\x3d\xe8\x79\x88\x8a\x2d\x1e\x12\xca\x59\xae\x5e\x6f\xe2\x43\x38\x4b\x8a\x6e\xb2\x8d\xdf\x64\x83\xd1\xbe\x53\x4b\x08\xa0\x3a\x1a\xec\x4f\x4a\x8d\x94\xb4\x1d\x72\xe1\x18\x67\xeb\xf4\x64\x86\xb9\xa3\xd3\xe7\xf1\x1f\xc7\x0b\x5a\xbe\xae\x5d\x0c\x4a\xce\x50\x72\x56\x57\x72\xd6\x53\x5c\x17\xc4\x0a\x3b\x83\x6b\x6a\x5d\x7f\x49\xc5\x6b\xa7\x81\xa8\x07\xef\x8e\x6b\x88\xfa\xf9\x83\xb2\x1d\x1d\x27\x97\x4a\x5c\xdb\xb4\x7b\x9a\x23\xdd\xf7\xcb\xf6\xf1\xfe\x39\xe6\x86\xca\xed\xf5\xde\x0a\x5a\x57\x6a\xa3\xac\x24\x21\xd0\xd8\x6d\xe3\x16\x7b\x85\x16\x1c\xf2\x65\xf5\xbf\xdd\xbf\xbc\x91\xb0\x41\x4a\xa9\xfd\x03\x72\x02\xc8\x09\x20\x27\x80\x9c\xc0\x97\xce\x09\x9c\x07\xab\xb4\x39\x34\x22\x7e\xd0\x61\x50\xe9\x94\x4b\x85\x90\x51\x24\xa7\xbc\x60\xf6\x74\xd4\xca\x53\x6f\x43\xf4\x19\x9b\xe1\xcd\xce\x68\x95\x27\xbb\x92\x5b\xae\x00\xb6\xe5\xc2\xea\x96\x56\x9d\x5c\xf1\x12\xab\x5c\x15\xf2\xa5\xd6\x20\x58\xbe\xe4\x24\x96\xf3\xbe\x35\xf3\xb2\xf9\xe5\x27\x58\x3c\xd5\x0f\x32\x70\x5f\xa1\x4d\x01\xec\xc3\x46\x61\xa3\xd5\x36\x2a\x78\xc8\x68\xb3\x27\x15\x63\x41\x3b\x02\x75\x2f\x45\xd5\x40\x6b\xa0\x35\xd0\x1a\x68\x0d\xb4\xfe\x6b\x68\xdd\x51\x30\xde\x4e\xd1\x79\x15\x08\x88\x0b\xc4\x05\xe2\x02\x71\x81\xb8\x7f\x11\x71\x69\x2c\x71\x06\x4b\x72\x5d\xfa\xa7\x77\x85\x9f\x17\x62\xb5\xfb\xf9\x61\xb7\x61\x36\xd3\xb1\x38\x7c\x01\x7c\x01\x7c\x01\x7c\x01\x7c\xc1\x5f\xf3\x05\xe5\x0c\x22\x60\x16\x30\x0b\x98\x05\xcc\x02\x66\x37\xc2\xec\xa9\x2e\xa8\x40\xaf\x23\x54\xf8\x29\x0f\xf9\x01\x22\xca\x77\xa4\xa4\xa2\xe6\x45\x31\xcb\x67\xa9\x10\xbd\x8e\xd4\x3f\xb7\x47\x15\xff\x4b\xd5\x88\xff\xd6\x47\xfa\xb1\x8a\x2a\x3c\xfb\xdf\x45\xa1\xc2\x87\x17\xc1\x15\xff\x21\xf8\xf4\xd9\x0f\x77\x9b\xfe\x1f\x8c\x02\x60\x14\x00\xa3\x00\x18\x05\xc0\x28\xf0\x79\x8c\x02\xe7\x83\xb0\xcc\xcf\xb3\x1f\x76\x0d\xef\x0d\xc6\xeb\xa3\x3a\x92\xd9\xeb\xd1\x86\x8c\xd1\x33\x93\x9c\x1a\x29\xae\x7d\x10\xef\x76\x6d\x46\x2d\xf0\x0c\xb2\x95\x21\x5f\x6d\x32\x79\xe2\x55\x56\xb1\x10\x64\xab\xab\x42\xa0\x7c\x55\xc9\x57\x94\x6c\x35\xf1\x2b\x89\xb5\x7b\xe1\x43\x0c\xc2\x0b\xb4\x25\x40\x76\xd8\xd8\x37\xb6\x31\xe6\x81\x57\x28\xb7\xde\x9f\x08\xcf\x21\x52\xc6\x9a\x38\x25\x2c\xd2\x7e\x37\x44\xbc\xdb\xb5\x99\x0f\x70\x13\xb8\x09\xdc\x04\x6e\x5e\x3a\x6e\xbe\x6a\xfd\x6a\xf6\xda\x66\x4e\x72\x80\x77\xc0\x3b\xe0\x1d\xf0\xee\xaa\xf0\x2e\x3b\x63\x40\x3b\xa0\x1d\xd0\x0e\x68\xf7\xe5\xd1\x6e\xed\x82\xb8\x77\x21\xe6\x15\xcc\x7d\xbf\xa8\xbb\x47\x76\x4e\xe6\x40\xea\xdc\x05\xe5\xc1\x79\x35\x8f\x87\xd1\x3d\x8e\x7c\x47\x94\xfc\x80\xca\x34\xda\x00\x6f\x80\x37\xc0\x1b\xe0\xfd\x85\xc1\x3b\x3f\xd4\xdb\x73\x53\xd6\x5d\x85\xc0\x83\x1d\x29\xd8\xf0\x23\x7a\xd2\xef\xd8\x63\xd9\x6e\x74\x08\xf3\x91\x94\x77\xa9\x0f\xa8\xa7\xee\xd4\xa8\x30\x63\x62\xbc\x09\x76\xb3\xd7\x69\x6e\xd7\x36\x7b\xd9\xe7\x44\xe6\x42\x4f\x31\x79\x82\x21\xdb\x75\x4c\x28\x67\x72\x83\x35\xcf\x9b\x44\x2c\xfa\xd1\x7e\x5b\x21\xcc\x22\x24\xac\x6d\xd0\xca\x8b\x8a\x95\x56\x36\xf7\xdb\x97\x01\x97\x7e\x7e\x3d\x94\x7a\x2b\xbe\xb9\xd1\x8f\x41\x59\x7d\xdc\xd6\x68\x27\x09\x49\x55\xfc\xb6\x6b\xb5\x39\xf8\x7b\xf8\x7b\xf8\x7b\xf8\xfb\x8b\xf5\xf7\x27\xa4\x0c\x54\x88\xb2\x80\x72\x40\x39\xa0\x1c\x50\xee\x0a\x50\x2e\xa8\xe8\x0e\x84\x44\x23\x12\x8d\x48\x34\x22\xd1\x78\x8d\x89\xc6\x7b\x1d\xcd\x5e\x25\x68\xa6\x10\x97\xd6\xb7\x05\xc6\x0e\x2e\xfe\xfd\x53\x58\xbe\x13\x3c\x2b\x0b\xac\x3c\x60\xe5\x01\x2b\x0f\x58\x79\xc0\xca\x03\x56\x1e\xb0\xf2\x80\x95\x07\xac\x3c\xd7\xcd\xca\x03\x6a\x15\x50\xab\xd4\x51\xab\x7c\x00\x27\x85\x77\x86\x42\xf8\x88\x6c\xf1\x2a\x2a\xf7\x33\x3b\x14\x2e\x6e\xbc\x3d\xbf\xa1\x45\x53\xa9\x8b\xbb\x1b\x9b\x54\xe4\x29\xf5\xa8\x3c\x6f\x2b\xec\x83\x0a\xb3\xc9\x7f\x28\xb7\xc2\xd6\xf4\xaa\x72\xa3\x7a\x13\x2c\xde\xed\x5a\x1c\x78\x58\xca\x04\x0a\x3d\x26\x8a\xdf\x96\xd7\xf7\xed\x6b\xc9\xbb\x0a\x5d\x0f\xae\xef\xc6\x7a\xf6\xdb\xc9\x36\x5b\xb0\x9e\xa6\xa6\xff\x03\xe9\x2d\x48\x6f\x41\x7a\x0b\xd2\x5b\x90\xde\x82\xf4\x16\xa4\xb7\x20\xbd\x05\xe9\xed\x95\x93\xde\x4a\x2e\x56\x64\xa5\xdb\xb1\xa7\x10\xc9\xab\xce\x1d\xb3\x17\x6f\xa5\x32\x68\xec\x26\x67\xc7\xd8\x24\xe5\x9c\x5c\x2a\xae\x57\x46\x46\x7e\x65\x34\xc7\x0b\xeb\x16\xfe\x9d\x5f\xce\x7a\xdf\x55\xcc\xd7\xe0\xfa\xde\x8e\xfd\xbb\x89\xd8\xc2\x10\x07\xd7\xff\xba\xdb\xd5\xed\xe6\x11\x07\x20\x0e\x40\x1c\x80\x38\x00\x71\x00\xe2\x00\xc4\x01\x88\x03\x10\x07\x5c\x79\x1c\x50\xde\x7d\xf3\x5b\xbe\xc9\xf9\xc8\x8d\xae\x8c\x05\x85\x92\x5a\xd9\x10\x84\xa5\xb5\x72\x61\x75\xe5\x8f\x75\x72\xc5\x65\x90\xa2\xc9\x7d\xfb\x97\x8f\xe7\x36\x0a\x96\x97\x45\x4a\xd7\xac\x24\x8c\xaa\x2f\x91\x14\xd8\x7b\xf5\x83\x4c\x49\x6e\x85\x36\x05\xa5\xb9\xb0\x51\xd8\x68\xb5\x8d\x0a\x1e\xda\xd6\xac\x9d\x79\x41\xff\xcb\x66\xc2\x4b\x4e\xcb\xfb\x18\x27\x65\xbb\x81\xca\xfb\x2c\xce\x8b\xb8\x39\x4e\x73\xaa\x8b\x34\xc3\xdc\x91\xe2\xce\x73\xf2\xe3\xf9\xa7\x20\x7b\xa4\x36\x41\xa7\x2d\x5f\x21\xde\xe3\x3e\x69\xdd\xd3\x0e\x44\x53\x8b\x80\xbc\xc1\xa6\x9e\xcf\x27\x8f\xbf\xab\x98\xe6\xc1\x1d\xec\xdd\xae\x0e\x51\xf4\x1c\x9d\x1a\xf4\x3d\xb5\x17\x51\xe8\x61\x70\x8f\xb9\x1f\x6f\x6e\x6c\xa4\x63\xf6\x7f\x45\x96\xfd\xfb\x21\xed\xbd\xce\x2d\xdc\x65\x18\x6a\x6f\xfb\xbd\x32\xda\x77\x76\xd4\x83\x8d\xcf\x9f\x3f\xae\x8e\xc6\x0b\x18\x45\x42\xf4\x30\x69\x53\x80\x75\x6e\xb9\x14\x6d\x0f\xc7\x92\x38\x96\xc4\xb1\x24\x8e\x25\x71\x2c\x89\x63\x49\x1c\x4b\xe2\x58\x12\xc7\x92\xdf\xe0\x58\x12\xd4\x2f\xa0\x7e\x01\xf5\x0b\xa8\x5f\xae\x97\xfa\x05\xf0\x06\x78\x03\xbc\x01\xde\xae\x15\xde\xdc\xf8\x60\xfb\xd9\x93\x3a\xcc\xf7\xe4\x47\x8a\x14\x8a\xa7\xc1\x9c\x1e\x3a\xef\x26\xb5\xde\x23\xcc\x4e\x3f\x27\x84\x9e\xa2\xd7\xc5\x61\xe8\xae\x5b\xee\x10\xea\xe1\x3f\xac\x35\xb2\xf6\xc0\xe8\x68\x19\x8d\x89\x1f\xa5\x21\x3b\x06\x32\x49\xe3\xb1\x55\x42\x56\xaf\x70\x49\x70\x49\x70\x49\x70\x49\x5f\xda\x25\x5d\x0a\xec\x0f\x76\x24\x55\x6a\x6f\xc0\xbc\x60\xd2\x21\x3c\x3a\x9f\x51\x3a\xa0\x1a\x50\x0d\xa8\x06\x54\x7f\x69\xa8\xf6\x74\x74\x3f\x29\x75\x41\xc8\x4c\x66\xb1\xb8\x81\xd5\x74\xb9\xa8\x21\xd2\xa8\xcb\x75\x3b\x59\xd1\xd9\x3a\x2b\xee\xff\xc0\x0b\x04\x5e\x20\xf0\x02\x81\x17\xe8\x5a\x79\x81\x0a\x3f\x8e\xf4\xe8\x69\x78\x8f\x51\x6d\x43\x9b\x1c\x40\x26\x20\xf3\x4f\xc8\xfc\x3f\x7b\x57\xb7\x1c\xb9\xaa\x73\xef\xfb\x29\xe6\x05\x52\xb5\xab\xe6\xfb\x6e\xf2\x12\xe7\xbc\x01\x45\xb0\xd2\xcd\x0e\x36\x3e\x08\xa7\x93\xfd\xf4\xa7\xb0\xbb\x7b\x66\x4f\x35\x3f\x16\x99\x3a\x33\xd9\xab\x92\xbb\xb6\x65\x10\x62\x21\x90\x58\xda\xf7\x10\x20\x13\x90\xf9\x3f\x84\xcc\x2f\x5f\x12\x69\xab\x5a\x82\x7d\x2c\xbc\x9c\xd5\xa4\xb3\x86\x26\x2e\x9c\x95\x03\x22\x01\x91\x80\x48\x40\xe4\x6f\x0c\x91\x85\x1f\xa7\xc5\xb9\xbb\x19\x92\x85\x77\xfc\x9c\x10\x53\x07\x73\x27\xbd\x17\xb4\x29\xa0\x4d\x01\x6d\x0a\x68\x53\x40\x9b\x02\xda\x14\xd0\xa6\x80\x36\x05\xb4\x29\xff\x68\xda\x94\xa7\xc5\xbd\xa8\x6b\x6d\x80\x1b\x09\x61\x79\x06\x55\xbe\x69\xf4\x4a\xf1\x2f\x75\x22\xb1\x59\xc7\x66\x1d\x9b\x75\x6c\xd6\x7f\xd9\xcd\xfa\x97\x2f\xc6\x59\x9a\x22\x2e\x76\xe1\x62\x17\x2e\x76\xe1\x62\xd7\xa7\xbd\xd8\xb5\xa1\x5c\x76\xa0\x00\x72\x00\x39\x80\x1c\x40\xee\x93\x80\x9c\x4a\xe9\xe8\x8f\x07\xd9\x80\x03\xe9\x80\x74\x40\x3a\x20\xdd\xaf\x8c\x74\x7e\x8a\x09\xea\xf2\xe7\x89\x15\x6d\x9a\x85\xa3\x1f\xd5\x89\xf4\x40\x81\x3b\x44\xd8\xbf\x48\x45\x1a\x67\xa7\xa3\xac\x25\x83\x8e\x5a\x5d\x4a\x19\xd2\xa4\x9f\x72\x87\x8d\xb5\x91\xfc\x5e\x4e\xde\xe0\x76\x34\xe6\xda\xab\x0e\x69\xf4\xac\x17\x17\xd5\xb7\x10\xbe\x7a\xa5\xc0\xd9\xc8\x5c\x45\x5c\x2f\x2b\xb6\x5e\x6b\x62\xa6\xdc\x2b\x65\x0b\x66\xde\x86\x13\x0d\x8b\x64\xbb\xb0\x7d\x40\xb6\x4f\x6e\x33\xa0\x35\x8c\xc0\x8f\x7f\x79\xcb\xe8\x14\xdc\x0e\x70\x2d\x53\x43\x02\x74\x6d\x60\xd7\x00\x55\xbb\x1f\xac\x2c\xae\x3b\xb4\xd9\xb0\xc8\xc2\x46\x61\xa3\xbb\x6d\xb4\xe1\x21\xcd\xbc\x8c\xa4\x82\x77\xa4\x74\x28\x64\x62\x00\x6d\x81\xb6\x40\x5b\xa0\x2d\xd0\xf6\x83\xd0\x96\x89\x93\x87\x5d\xf0\xda\x01\xbb\x80\x5d\xc0\x2e\x60\x17\xb0\xfb\x81\xb0\x7b\xa6\x27\x65\x87\x94\x42\x1b\xdf\xd5\x5a\x3d\xab\x90\x38\x06\x04\x06\x02\x03\x81\x81\xc0\x40\xe0\x5e\x04\x3e\xb3\x62\x0a\xaf\xd6\x94\x8e\xa9\x1b\xb5\x4e\x86\x95\xf1\x53\xd4\x76\xa2\xa0\x4c\xa0\x15\xce\xb5\x63\x15\xc8\xe9\x68\x5f\x0b\xf7\x7e\x81\xe8\x40\x74\x20\x3a\x10\x1d\x88\xde\x89\xe8\x81\x9e\xd3\x7d\x8d\xbf\xc1\xef\x07\xdd\xf9\x3a\xf6\x5e\x09\xdc\xc2\x1f\xea\x5b\x1c\xf1\xf1\xd0\x67\xc2\x58\x0b\xb0\x16\x60\x2d\xc0\x5a\x80\xb5\xe0\xee\x5a\xc0\x91\x7f\x70\xc3\xcb\x10\x0e\xd0\x05\xe8\x02\x74\x01\xba\x00\xdd\x0e\xd0\xfd\xa9\x55\xc9\xe9\xcd\xd0\x9a\x36\x53\x64\x72\xa9\x69\xfc\x59\x5b\xa7\xfc\xa4\xe6\x25\x46\x3b\x1d\x6f\xf9\x97\xea\x4a\x66\x61\x88\x06\xa1\x68\xa7\x63\xa4\x49\x9d\x34\x9f\x88\x3f\x42\x86\x62\x9a\x75\xd0\xd1\x67\x28\x30\x2a\x2a\x3d\x79\x8e\xe2\x17\x59\xf6\x66\x2a\x00\x9f\xc6\x87\xa6\x41\x24\xc0\x0e\xf9\xcd\x51\xed\xd5\xe3\xe4\x03\xa9\x9b\x9d\xc8\x7a\x70\xad\x15\x6f\xa7\x81\xde\x94\x9d\x54\x85\x02\x3a\x3f\x96\xb7\xaa\xf3\xfa\x58\xeb\x53\x83\x10\x3b\x12\x47\x3d\x0a\xcd\x7e\xeb\x4d\x1e\x15\x6b\x4a\xe1\x51\xcd\xde\x59\x93\x4f\x7f\xad\x48\x70\xfe\xa8\x3c\xab\xff\xfb\xe3\x0f\x15\x48\xb3\x9f\x64\xfd\x70\xfe\xc8\x51\xf3\x49\x0d\x3a\x52\x47\xc9\x85\x9b\x9c\xba\x8c\x86\xc6\xcc\x81\x9e\xed\x5b\x5f\x43\x36\x19\x9d\x33\x3e\xd1\xd2\x6c\x40\xf6\x23\xbe\xa1\x30\x05\x0a\x53\xa0\x30\x05\x0a\x53\xa0\x30\xc5\xdf\x0b\x53\xe4\xa9\xda\x2a\x5a\x9c\xed\x4c\xa9\xf0\x8f\xec\x65\x9f\x63\xcc\xa8\xb1\x86\xa5\x65\x82\x82\xf2\x7f\xa6\xd8\xa5\xd5\xce\xfe\x95\xe3\x47\xab\x0d\x58\x20\xe3\xa7\x89\x4c\x4c\xee\x30\x85\xe0\xc5\x72\x9c\xd7\x83\xd2\xcf\x91\x82\x48\x19\x17\x01\x97\xd6\xd4\x1c\xb7\x6a\x43\xfc\xa4\x92\x93\xbf\x04\x92\x8a\xb9\x95\x2a\x49\x9a\x59\xe6\x41\xba\x7c\xde\x95\x24\x76\x6f\x6f\xa4\x54\x25\x2a\xb6\xaa\x0c\x5e\x42\x48\x63\xde\x33\x5c\xc9\xbf\x88\xfa\x28\x7b\xdb\x2f\xeb\xbe\x4b\xaa\x05\x36\x27\x1a\x49\xf6\xea\x64\x13\xa9\xab\x32\x4e\x33\xcb\x7d\x61\x66\x97\xae\xa2\xd9\xe7\x77\x99\x7d\x5d\xde\x17\x5f\x65\xe3\x65\x5e\xd9\x56\xd5\xe0\x8d\x3a\x07\x2d\xdc\x11\x34\xec\x4a\xb2\x4d\x88\x3a\x1c\x29\x5e\xb6\x48\x62\x21\xd7\x9d\x37\x48\xd2\x40\x92\x06\x92\x34\x90\xa4\x7d\x52\x92\xb4\xdb\x09\x63\x5e\xb5\x15\x75\xde\x24\x24\x8e\xe2\x73\xb0\x65\x77\x24\xaf\xc0\xab\x1c\x96\xb5\xc2\x8e\xd5\x42\xcd\xd5\x97\x7b\xca\x64\x26\x7e\x53\x35\xeb\xc0\xb4\xb9\xab\x62\x1f\x64\x13\x14\xc8\x58\xf1\x22\xbc\x4c\xc9\x7d\x7e\xa5\x90\x2e\xdd\x5f\x9b\xf3\x3e\x0b\x55\xbb\xb0\xd0\x17\x5b\xa2\xd9\x56\x61\x99\x41\x6c\x6e\x4c\x3a\x97\xbb\x38\x24\x2b\xb5\x79\xd4\x21\x4a\x8f\xd7\xcf\x36\x9e\x54\x0c\x7a\xe2\xd9\x87\x48\x41\x39\x7f\x14\x4a\x4a\x76\x9e\xae\xff\x07\x9d\xe7\xe3\x2e\xaa\xa7\x30\x2f\xfd\x3d\x86\x9b\x32\xe8\xea\xef\x28\x00\xb6\xa3\x82\xc7\x83\x0c\xba\xe1\xb3\xc0\x67\x81\xcf\x02\x9f\xe5\x17\xf6\x59\x1a\xe8\x4e\x80\x73\xc0\x39\xe0\x1c\x70\xee\xf7\xc6\xb9\x25\xfa\x94\x26\x98\x8e\xa1\x9e\x16\xf3\x92\x73\xea\x6a\xdd\xaf\xbf\x9b\x1d\x0f\x54\x9a\x42\xa5\x29\x54\x9a\x42\xa5\x29\x54\x9a\x42\xa5\x29\x54\x9a\x42\xa5\x29\x54\x9a\xfa\xe4\x95\xa6\xcc\x89\xcc\x4b\x97\xb7\xb9\x49\xd8\x3e\x20\x93\x90\x1c\x8a\x35\x59\xc2\x04\xd3\xc5\x1c\x5b\xe6\x55\xad\xa8\xaa\x14\x0b\xa8\xbb\xbe\x7a\x18\xd4\x44\xe7\x7c\x0e\x4e\x4b\xfb\xd3\xdf\xb5\xea\x57\xf7\x14\x2b\x9a\x0d\x4d\x4b\x61\x93\xfa\xf0\xc5\x2f\x71\x65\x7d\x29\x3c\xf2\x27\xfb\x5c\x1f\xd2\x8e\xca\x45\x7e\x2d\xfc\x6c\x8a\xbf\x8e\x7c\x9c\xb5\x79\x29\x3c\x91\x52\xd2\x0b\x3f\xb3\x9d\x8e\x8e\xd4\xba\x21\x97\x6b\xb1\x32\x77\x4e\xf4\x76\x59\xc3\x8a\xce\x4a\x6d\x41\x5c\x43\x26\x3d\xa1\xa8\xce\x48\x56\x22\x35\x2e\x2f\x74\xb5\x1e\x78\x66\xc5\xc3\x4b\x0a\xaf\xa8\xc1\x06\x59\x2b\xfa\xa2\x89\xe2\xcc\xb9\xd5\x93\xee\xea\x3d\xc7\x94\xe8\xaf\x59\xf4\xf9\x65\xfe\x10\xe4\x3b\xeb\x30\x25\x13\x52\xeb\x76\x52\xd0\x92\xfc\x41\xc9\xc3\x9d\x60\xd3\xbd\x87\xbe\x3f\xa4\xbd\xf3\xfb\xb6\xc4\xdc\xf9\xe1\x0a\xda\x87\x1d\xb3\xcf\x47\x77\x67\x47\x8b\x22\xda\x28\xa2\x8d\x22\xda\x28\xa2\x8d\x22\xda\x28\xa2\x8d\x22\xda\x28\xa2\x8d\x22\xda\xff\xe8\x22\xda\x65\x1f\xa6\x22\xfd\xea\x95\x8a\x5e\x2e\x16\xe3\xb1\x91\xc6\xcc\x4f\x2d\x8e\x68\x3d\xf0\x5a\x69\x5c\x53\xb0\xbb\xad\x25\x8d\x79\x15\xfb\x04\xee\x8b\x7f\xef\x97\xdd\x1c\x0b\xdf\xa1\xcd\x3d\xc3\xd3\x25\xbc\x3d\x46\xde\x0e\x32\x6d\xdb\x40\x49\xd4\xbc\x69\x9a\x8a\x1e\xad\x9a\xef\x2e\xed\x36\xe4\x6d\xc0\x86\x61\xc3\x1f\x6a\xc3\x0d\x8f\xd5\x3a\xf2\xb0\x2e\x05\xd9\x1f\x4b\x87\x9f\xd5\xaf\x6f\x0f\xe8\x10\xf4\x3d\xf5\xcc\xc1\x47\x6f\x7c\x66\x08\x2b\x16\x11\x88\xfd\x12\x0c\x29\x1d\x63\xb0\x4f\x4b\xf6\x0a\x80\x1e\x06\xbb\xc1\xdd\xbf\xab\x73\xa3\x6a\x85\x95\x1e\x33\xa5\xf4\xf3\xf8\x2e\xbf\x53\xe0\x32\x6d\xab\xcf\x6b\xa3\x55\x79\x7b\xd9\x06\x0d\x8d\x2b\x71\x9b\xb0\xfd\x08\xd6\x2e\x77\x17\x7a\x55\x07\x56\x8a\x5c\x3b\x05\xef\x43\xad\x7d\x98\xd5\x8e\x58\x6d\x78\x55\x9d\xdd\x3b\x1f\x6c\x58\x6d\x1b\xb5\xd9\xb8\xd2\xc2\x46\x61\xa3\xbb\x6c\xb4\xe1\x21\x43\x21\x02\x67\x81\xb3\xc0\x59\xe0\x2c\x70\xf6\xe7\xe1\xac\x9d\x98\x4c\x96\xef\xa4\x55\x21\x73\xb0\xaf\x17\x7e\x12\x80\x36\x40\x1b\xa0\x0d\xd0\x06\x68\xff\x2c\xd0\x2e\x3e\x90\xef\x9b\x2c\x77\x27\xd0\x60\xef\x58\x58\xd9\xfa\xb4\x73\xfe\xac\x86\x65\x76\xd6\x34\xd0\x56\xe5\xc7\x0d\x59\x40\xc8\x02\x42\x16\x10\xb2\x80\x90\x05\x84\x2c\x20\x64\x01\x21\x0b\x08\x59\x40\x9f\x3c\x0b\x68\x78\x52\xd3\x32\x3e\xe5\xc0\xa6\x36\x99\x4b\xf7\x2a\x70\x2b\x08\xb7\x82\xee\xdc\x0a\x92\xd6\xb9\x48\x07\x67\x21\xa6\x21\xbe\xd0\xef\x83\x25\x1f\x2c\xf9\x60\xc9\x07\x4b\x3e\x58\xf2\x7f\x60\xc9\x17\xf3\xd5\x73\x0c\xcf\xc9\x9b\xea\xb9\x2e\x19\xa3\x93\x7c\xbc\xd0\x27\xfe\xfa\x78\xd8\x67\xaf\xda\x38\x51\xdb\x35\xf3\x32\x92\x0a\xde\xd1\xf7\xf5\x08\x1f\x0f\xb2\x29\x33\x2c\x1b\x1d\xe6\x65\x6f\x92\x7d\xae\xda\xae\xf4\x4f\x6f\x91\xc2\xa4\x5d\x96\xe3\xad\x51\xce\x56\x19\xa8\x4b\xc4\xaa\x1f\x1d\xfa\xce\x0a\x56\x21\x4c\x9c\x58\x5d\x0b\xfc\xee\x4d\xd2\xca\xd3\xf3\xe1\xd6\xe0\xd2\xcf\xdf\x37\x45\x32\xeb\xf6\xb1\x53\x65\x3b\xa3\xcf\xac\xac\x1e\xd7\x0a\x67\x59\xd3\x6a\x90\x01\x36\x40\xb0\x01\x82\x0d\x10\x6c\x80\x9f\x97\x0d\xf0\xcc\x69\x5d\xcd\x6f\xf9\x81\x72\x40\x39\xa0\x1c\x50\xee\xb7\x46\x39\xc4\xe3\x11\x8f\x47\x3c\x1e\xf1\x78\xc4\xe3\x11\x8f\x47\x3c\x1e\xf1\x78\xc4\xe3\x3f\x79\x3c\x7e\xa3\x0b\xd5\xb3\x4d\x1a\x4c\x47\xc7\xa9\xc0\xd3\xe3\x41\xf0\xa9\x56\xea\xd2\x8a\x80\x3a\x73\x69\x5e\x80\x5b\x78\x3d\xb4\x1e\x49\xf6\xbe\x1f\xe7\x25\x92\x5a\x7b\xc2\xcb\xc8\x22\x29\x1b\x63\xea\x56\xe7\x2a\x55\x14\x4d\x6c\x7d\x4e\x5e\xa8\x6a\xcd\x79\x30\xb4\xde\xba\x50\x1c\xdf\x1d\x49\x85\x20\x71\x02\x89\x13\x3b\x12\x27\x8e\x41\x4f\x71\xdb\xcc\x19\x3f\xc5\x20\xa4\x17\xd8\xc4\x24\x7f\xb5\xf3\x75\xa5\xcd\xdc\x21\x62\x2d\x8d\x28\x96\xb1\x8b\x5b\x36\x2b\xa5\x9b\x5a\xd6\x4e\x1c\xf5\x94\xd0\x20\xf8\x67\xfb\x31\x51\xc3\x53\x8c\xb3\xaa\x93\xce\x36\xb4\xee\x26\xad\x4e\xe2\xda\x28\xcd\xce\x4a\x0f\x43\xf7\x1e\x3b\x5f\x51\xbd\x51\x40\x31\x3a\xf6\x11\x93\xcd\x4f\x44\xef\x2d\x71\xf0\x3c\xbc\x36\xd5\xff\xcc\xb6\x70\xd6\xe1\x3f\x0b\x45\xa9\x11\x5d\x5e\x57\xd7\x23\x91\x14\xd9\x34\x7e\x20\xd3\xa1\xb2\x6f\x52\xe7\xb4\x64\x74\x3b\x52\x57\x69\xc1\x9f\xd5\x31\xf8\x65\xee\x17\xb9\x16\x1c\xd7\x79\x11\x75\xc5\xa5\xbf\x67\x4b\xae\x74\xb0\x55\xa5\x29\xdb\xf7\xb9\x3d\x67\xa7\x4d\x5a\xd8\x7b\xd6\xd9\x66\xcf\x7b\x16\x7c\x51\x73\x5b\x1b\x5b\xe4\xf5\xb9\xfe\x3d\xac\x8a\xaa\x3c\x54\x01\x81\x56\xba\x9f\xf6\x21\x6c\xd2\x46\x5d\x0f\x0f\x17\x13\x3d\x74\x74\x6c\x9b\x2a\x85\x7a\xee\xed\x06\xdc\x10\x47\xdb\x37\x1b\xda\x63\x1d\xfb\xe4\x36\xc7\x3c\x9a\xc7\x6a\xcf\xe0\x0b\x05\xb7\xc7\x40\xf6\xcf\xe2\xd6\x29\xd7\x12\x0f\x69\xb4\xbb\x5d\x0f\x56\xe2\x6f\x3b\xb4\xd9\x10\x87\x83\x8d\xc2\x46\x77\xdb\x68\x3b\xd2\x96\x57\xcc\xaa\xc2\x2b\x1f\x9a\x75\x88\x2b\x1b\x5c\xc6\xc0\xea\x06\x38\xe8\x58\x76\x78\x9b\x9a\xb9\x19\x19\xcf\xda\x54\x3b\x5b\x32\x80\x6a\x6f\x85\x3b\xbe\x39\xf8\xb7\x77\xb5\x04\x2b\x7a\x9b\xbf\xf6\x9c\xa7\xf1\x57\x75\xbd\x10\x2e\x7d\x7f\xa4\xa8\x07\x1d\xb5\xf4\xfd\x4d\xa1\xbd\xb5\xfd\xf9\xab\x0a\x74\x94\x1e\x9f\xf1\x49\x07\x1a\x3e\x62\xa7\xdc\x1d\xde\xba\xee\xda\xcb\xb8\xd8\x3b\x35\xd9\x1e\x27\x1d\x97\x40\xd7\xc2\xfd\x8f\x07\xc1\x67\x98\x49\x99\x85\xa3\x1f\xd3\x19\xa6\x3b\xfa\x60\xe3\x69\xec\x17\x95\x5d\x6b\x76\x0a\x51\xe3\xf0\xff\x52\x41\x2f\x63\x39\x61\xb5\x2a\xc1\x25\xd5\xda\xe7\x77\x35\x13\x05\x99\x8c\xe8\x43\xda\xd5\x1a\xa7\x99\xc5\x12\xe4\x75\x6e\x38\x65\x0d\x4f\x83\x4b\x33\x83\x84\x27\xfe\x0b\xa7\x34\xe6\xf0\x4a\x41\xb1\x1d\x48\xd1\x64\xc2\xfb\x2c\x3e\xe7\xfe\xa9\x45\x73\x6e\x50\x7a\xd8\x31\x9b\x6e\x31\xc5\x7f\x2d\x71\x5e\xe2\x5d\xcf\xab\xd0\x22\x9e\xee\x0c\x4e\x19\x66\x90\x4a\x8d\x54\x6a\xa4\x52\x23\x95\xfa\xd3\xa7\x52\x77\x38\x74\xc8\xc4\x46\x26\x36\x32\xb1\x91\x89\x8d\x4c\x6c\x64\x62\x23\x13\x1b\x99\xd8\xc8\xc4\x46\x26\x36\x32\xb1\x91\x89\x8d\x4c\x6c\x64\x62\xff\xc6\x99\xd8\x6b\xa8\xe8\xf1\x20\x10\xcd\x13\xab\x27\x3f\xc8\x8b\x25\xdd\x04\x44\x1a\x67\xa7\x85\x29\x64\x49\x4a\x5f\xc0\x69\x62\x75\x4d\x10\xee\x2f\x46\xf5\x5f\xf6\xae\xa6\xb7\x6d\x18\x86\xde\xfd\x2b\x8c\xde\x73\xdc\x56\xe4\xba\xcb\x4e\x3b\xee\x52\x14\x86\x2b\xb3\xa9\x11\xc7\x52\x45\xb9\x43\x31\xec\xbf\x0f\xf2\x47\x5a\xb4\x96\x94\xd2\xe9\xd6\x64\xef\xd8\x06\x94\x19\x4b\x7e\xe1\x23\xe9\xc7\x83\x2f\x9b\xdc\x99\x79\xbf\xfc\xdd\xfe\x20\xce\x71\x27\x6f\x9a\x7f\x66\xbf\xe8\xfc\x4c\x6b\x2c\x3e\x42\x4e\x9b\x5a\x45\x4a\x64\xd1\x25\xc2\x14\x6f\xf5\x62\xf1\xec\x0d\xf7\x99\x4d\xd3\xb5\xdb\x6f\x73\xdd\x8c\x71\x2a\x06\x12\x07\x12\x07\x12\x07\x12\x07\x12\x07\x12\x07\x12\x07\x12\x07\x12\x77\xe6\x24\x4e\xc5\x3a\xdc\x51\x97\x44\x5d\x12\x75\x49\xd4\x25\x4f\xba\x2e\x19\x9d\x8e\x0d\x84\x03\xc2\x01\xe1\x80\x70\xa7\x8d\x70\x4d\x4d\xad\x8b\xb4\x45\x03\xe5\x80\x72\x40\x39\xa0\xdc\x39\xa0\x5c\x70\xa3\x00\x72\x00\x39\x80\x1c\x40\xee\xb4\x41\x4e\x93\x55\x54\x38\x5d\x74\xee\xf6\x72\x9d\x49\xbe\xba\x7f\xff\x35\x92\x6e\x4e\x6c\x47\x4c\xdb\xe5\x6f\xb6\x09\xc4\x5e\xbf\x85\xfe\x1b\xf4\xdf\x5e\xeb\xbf\xdd\x91\x2a\xc4\xc3\xf3\xbc\x71\x58\x75\x2b\x55\xd3\xf2\xd6\x4e\x6f\xa9\x95\x9e\x57\x84\x26\x08\x4d\x10\x9a\x20\x34\xf9\xc0\xa1\x89\x1c\x5a\x35\x47\x68\x5b\xc2\xb8\xae\x1a\x8a\x57\xe1\x53\xd8\xdc\x6b\x58\xca\xae\xed\x2d\xe5\x9e\xb7\x4c\xca\x8b\x5a\x30\x07\x0e\x4d\xea\xa0\x6c\x89\x4c\xa4\x69\x33\x65\xbe\xf3\x1a\x90\x43\x63\xa2\xf8\x4b\x8c\x6b\xf4\xd0\xb1\x70\x11\x2e\x6e\xad\xde\x15\xf4\x40\xad\x93\x7d\xa1\x56\xb7\x7d\x58\x5c\x58\x32\x4d\xa9\x68\xe7\xf3\x01\xc3\x55\x45\x7e\xa5\x35\x44\x53\x67\xcb\x58\xed\xb4\x12\x0a\xcd\xa6\x45\x47\x53\x97\x67\xdd\x59\x45\xa2\x8b\x0f\xa6\xe2\x2d\x1d\xcc\xc5\x24\xe3\xc9\x5c\xee\x01\x37\x85\xaa\xcd\x1d\x59\x16\xd8\x87\x91\x77\xb5\x8f\x23\x03\x1f\xf5\x71\x5e\xf6\x06\xf0\xe4\xfb\x19\x0f\xa1\xaa\x01\x55\x0d\xa8\x6a\x40\x55\xe3\x3f\x57\xd5\x80\x2c\x06\x64\x31\x20\x8b\x01\x59\x0c\xc8\x62\x40\x16\x03\xb2\x18\x90\xc5\x80\x2c\x06\x64\x31\x20\x8b\x01\x59\x0c\xc8\x62\x40\x16\xe3\x44\x65\x31\x94\x25\x2f\x14\xdf\x03\xe5\x3a\x93\x6c\x41\x1f\xc0\x8c\x8f\x5f\xe0\x64\xa5\x20\xa1\x6e\x55\xd3\x55\x54\xb8\x72\x23\xf3\xe1\xb5\x7c\xc4\xbf\x6f\x09\xf9\xb0\x92\x16\x93\x63\xc3\x90\xa6\x50\xd2\x36\x71\x95\xfe\xc0\x48\x65\x24\xf2\x7c\x81\x3e\x29\xdf\x73\xd1\xd9\x46\x64\xeb\xca\x4d\x31\xd2\x98\x47\xa9\xf3\x91\xbb\xcb\xdd\x4e\x37\x7a\x53\xcf\x00\x5a\x9c\x3c\xf9\x06\x20\x0f\x63\xec\xca\x9d\x91\x3d\x02\x60\x6e\x60\x6e\x60\x6e\x60\x6e\x60\x6e\x60\x6e\x60\x6e\x60\x6e\x60\x6e\xe7\xce\xdc\xa2\x31\x4c\xea\xf6\x4f\xd6\x7e\xaa\x8c\xae\xa4\x3d\x49\xc3\xd8\xa1\xa2\xaa\x77\xd4\x72\x78\x5a\xd9\x61\xab\xc4\x7a\xf9\xa3\xf3\x59\x13\xcb\xa7\xe6\x7d\x2e\x7b\x03\xa1\xa2\xfe\xb4\x90\x95\x59\x4f\x01\x9f\xd6\xdb\x9a\x84\x7b\x19\x57\x85\x44\x5d\x1a\x75\x69\xd4\xa5\x51\x97\x3e\xe9\xba\x74\xa3\x37\x4b\x46\x1c\x7a\xf3\xe0\x26\x1f\xd6\x54\xdc\xff\x4a\x2c\x70\xe1\x28\xed\xbb\x4b\x86\x5d\xf6\x4d\xac\x85\x2a\x1d\x6d\xb4\x7d\x5c\xb2\x86\xb8\xb7\x7e\xb4\x0f\x3f\x1e\x87\xdb\x8b\xb7\xd3\xe7\xe8\x8a\xe1\xed\x6c\x91\xfd\x3e\x4d\x27\xf6\x60\x9c\x6d\x28\x6c\xb4\x0f\x3f\xb6\xab\x7d\x20\x30\xf3\xd1\xb3\x5b\x97\xbd\xe1\xd9\xe3\x47\x6e\xf4\x4c\x6c\x18\xc7\x56\xe4\x22\x91\x8b\x44\x2e\x12\xb9\x48\xe4\x22\x91\x8b\x44\x2e\x12\xb9\x48\xe4\x22\xcf\x3c\x17\x19\xe3\x45\xe9\x80\xaf\x34\xa6\x0f\x4c\x87\x0c\xe0\x02\x2f\x87\xb7\x77\x8f\xb4\x94\xe7\x8c\xcb\x57\x99\x9a\x2d\xea\xea\x08\x8b\x19\xab\xd5\x71\x56\xb2\xb7\xea\xf3\xa7\xcb\x2f\xfb\xb9\x2b\xbc\x18\x3f\xd9\xd9\x4e\xf9\xb1\xf8\xd5\x48\x95\x17\xfb\x08\x11\x92\xf7\x17\x21\x91\x32\xf9\xe9\x75\xf1\x75\x26\x39\x2f\x72\xe9\x12\x67\xcb\x96\x53\xe6\x41\xaf\x9d\xed\xd8\xf9\x91\xfc\x10\xd9\x85\xc8\x2e\x44\x76\x21\xb2\x7b\xa6\x22\xbb\x61\x57\x57\xf9\xac\x56\x40\x70\xb5\xc0\x07\xec\x4a\xd7\xbd\x38\x26\xe1\xe3\x53\x2a\x57\x3f\xcc\x6c\x70\xec\xde\x1b\xab\x6f\x9a\xd9\x9a\x6f\xb0\x14\x1c\x3d\x1f\xe1\x12\xf0\x74\xa5\xaf\xf3\x79\x95\xd8\x2f\xd2\xcf\xd2\xb6\x75\xbb\x79\x77\x27\x67\x77\xe1\xf5\x26\xaf\x72\x36\xa4\xb2\xa0\x15\x93\x7d\xa0\x6a\x9d\x3b\x3b\x86\x14\xec\xb4\x2d\x37\xf4\xfc\x3f\xdd\x8d\xa5\x21\x57\xbc\x77\x7f\xdc\xec\xfc\xd7\xef\xec\x69\xdf\x4b\xa5\xc8\x38\xaa\xbe\x97\x7b\x1a\xbf\xad\xdb\x6a\x9d\x5f\x5c\xf4\x7f\x98\xa6\xb3\x65\x33\xfe\xe9\x39\x75\xdf\xde\xcc\xeb\xfc\xea\x3a\xf3\xc1\xa2\xb6\x54\xfd\x20\xcb\xb5\x6e\x79\x9d\x5f\x5d\x67\x7f\x06\x00\x86\xad\xad\x01\x48\x70\x08\x00"),
 		},
 		"/logging.banzaicloud.io_flows.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "logging.banzaicloud.io_flows.yaml",
 			modTime:          time.Time{},
-			uncompressedSize: 58995,
+			uncompressedSize: 67395,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\x5f\x8f\xe4\xa8\x11\x7f\xef\x4f\x61\xdd\xfb\x4c\x6e\x13\x45\x3a\xf5\x4b\x74\xda\xe4\xa4\xd3\x46\x9b\xd5\x26\xda\x97\x28\x42\x34\xae\x76\x73\x8d\xc1\x07\xb8\x67\x7a\xa3\x7c\xf7\xc8\x76\xff\x9d\xb1\x4d\x61\xd3\x73\x33\x7b\x55\x6f\xe3\x66\x7e\x94\xa1\x28\xaa\x00\xf3\x5b\xdc\xdd\xdd\x2d\x78\x25\xbf\x80\x75\xd2\xe8\x65\xc6\x2b\x09\x8f\x1e\x74\xf3\x97\xbb\xdf\xfe\xe0\xee\xa5\xf9\xc3\xee\xdd\x62\x2b\x75\xbe\xcc\xde\xd7\xce\x9b\xf2\x33\x38\x53\x5b\x01\x7f\x85\xb5\xd4\xd2\x4b\xa3\x17\x25\x78\x9e\x73\xcf\x97\x8b\x2c\xe3\x5a\x1b\xcf\x9b\xc7\xae\xf9\x33\xcb\x84\xd1\xde\x1a\xa5\xc0\xde\x15\xa0\xef\xb7\xf5\x0a\x56\xb5\x54\x39\xd8\x16\xfc\x58\xf5\xee\xfb\xfb\x3f\xdf\x7f\xbf\xc8\x32\x61\xa1\xfd\xf7\x7f\xc9\x12\x9c\xe7\x65\xb5\xcc\x74\xad\xd4\x22\xcb\x34\x2f\x61\x99\xad\x95\x79\x70\xf7\xca\x14\x85\xd4\xc5\xfd\x8a\xeb\xaf\x5c\x0a\x65\xea\xfc\x5e\x9a\x85\xab\x40\x34\xd5\x16\xd6\xd4\xd5\x32\x1b\x28\xd5\x41\x1d\xf5\xe3\x1e\x0a\x63\xe5\xf1\xef\xbb\xe3\x7f\xdd\xf1\xb6\xd6\x2c\xeb\xde\xfe\x27\x65\x1e\xda\x3f\x95\x74\xfe\xc3\xe9\xd1\xdf\xa5\xf3\xed\xe3\x4a\xd5\x96\xab\x83\x7e\xed\x13\x27\x75\x51\x2b\x6e\xbb\x67\x8b\x2c\x73\xc2\x54\xb0\xcc\x3e\x36\x95\x57\x5c\x40\xbe\xc8\xb2\x43\x03\xb4\x95\xdf\x65\x3c\xcf\xdb\x26\xe5\xea\x93\x95\xda\x83\x7d\x6f\x54\x5d\xea\x93\x6a\x39\x38\x61\x65\xe5\xdb\x26\xfb\xd9\x65\x7e\x03\x2d\x78\xc6\x85\x97\x3b\xf8\x4b\x5b\x2c\xcb\x7e\x71\x46\x7f\xe2\x7e\xb3\xcc\xee\x9d\xe7\xbe\x76\xf7\xdd\xef\x87\x9f\xbb\x86\xfc\xf1\xf2\x91\xdf\x37\x9a\xad\x8c\x51\xc0\x75\x5f\x65\x1f\xeb\x72\x05\x36\x33\xeb\xac\xb2\x66\xa5\xa0\x74\x83\x75\x1d\x0b\xbc\x37\xb5\xf6\x57\x55\x7e\xba\xfe\xd7\xae\xd2\xe6\x3d\x0b\xb0\x8b\x73\xb1\xdd\x3b\xae\xaa\x0d\x7f\xd7\xb5\xa2\xd8\x40\xc9\x97\x87\x7f\x31\x15\xe8\x1f\x3f\xfd\xfc\xe5\x4f\xff\xbc\x7a\x9c\x35\x5a\x55\x60\xfd\xa9\x1b\x3b\xb9\x30\xee\x8b\xa7\xc7\x9a\x9d\xb7\x52\x17\x17\x3f\xb4\x3d\x8d\x29\x78\x69\xf1\x4f\x0b\x9b\xd5\x2f\x20\xfc\xc5\x0f\x47\xa3\x3c\x4b\xbf\xb2\x8d\xac\xa5\xf2\x60\x9f\x3d\xce\x32\xe9\xa1\xec\x79\x3c\x86\xd5\x89\x30\x5a\x70\xdf\xff\x5b\xf8\xbf\x0f\x08\x5e\xea\xda\xd4\x8e\x29\xa9\x81\x59\x28\xe0\xb1\x1a\x2e\x3f\xd8\x6a\xd7\xb2\x56\xb5\xdb\xb0\xd6\xca\x77\x5c\x85\xe1\x2e\xed\xa4\x4f\xb6\x00\x15\xab\xb8\xf5\x92\x2b\xb6\x85\x7d\x18\xf1\xd2\xdc\x83\x88\xfd\x5d\xde\x07\x1b\x78\x6f\x94\x6a\x01\x8c\xb2\x56\x5e\xb6\x9d\x01\x3a\x4f\xd5\x21\x67\x50\xe7\xb9\xf5\xa9\x60\x75\x6b\x35\x23\xf6\x85\xed\xe0\xa8\xbe\x0d\x28\x75\xc4\xda\x71\x55\xc3\x6c\x34\x07\x15\xb7\xdc\x1b\x3b\x1f\xc9\x5b\xe0\x25\x93\x39\x68\x2f\xfd\x3e\xc9\xbb\x7a\x59\x82\xa9\x3d\x53\x7c\x05\x88\x71\x16\x40\xab\x1d\xb0\xb5\xb4\xce\x33\x7f\x9a\xa1\x67\x8f\xb4\x06\x34\xf1\x40\x1b\x70\xc6\x67\xc9\x21\x37\xb3\xfc\x62\x0e\x2c\x37\x9e\x69\x70\x1e\xf2\xf9\x6d\x70\x80\x4b\x65\x4b\x88\xf7\xf7\x20\xfc\xdf\x1e\x05\x54\x17\xe1\xda\x73\xc1\x34\x85\xe2\xba\xa8\x79\x31\x3e\xca\x07\xa7\xb0\xa8\xf7\x3a\x17\xe3\xd6\xf2\xfd\xb0\x37\xe3\x8f\x6c\xb5\xf7\x29\x1c\x4f\x03\x95\xc8\x87\x95\xe0\x1c\x2f\xe6\x7b\x9c\xb3\xaf\x8e\x9d\x46\x03\xc0\x16\x4a\xb3\x03\xe6\x79\xc1\x2a\x0b\x6b\xf9\x98\xc8\xa5\xdd\xda\x9a\x41\x6f\xb8\x16\xf0\xe1\x87\x59\x76\xcc\x2b\xc9\xda\xdc\xe1\x15\x19\xf2\x0a\xb8\x05\xcb\xbc\xd9\x82\x66\x6b\xa9\xe6\x1b\x8f\xe0\x41\x1c\x4c\x63\x35\x52\x36\x31\xfe\x4f\xd6\x8c\xf6\x6f\x0c\x60\xd6\x4e\xa7\xc2\x82\xff\x00\xfb\xcf\xb0\x0e\x97\x8e\xc3\xce\xc2\x11\xd8\xa5\x20\xfb\xf1\x2c\x6d\x06\x73\x2b\x70\x53\x75\xc9\x61\x5c\x05\xa1\x89\xe7\x2c\x16\x7e\xad\xa5\x1d\x9f\xcc\x8e\x72\xd7\x34\x23\xa2\x5c\x70\xe4\x4e\x28\x1a\x8c\xda\xb2\x98\xd6\x6d\xd1\xc8\x86\xcf\x42\x36\xdc\xab\x43\x4a\x1b\x46\x15\x13\x5c\x6c\x9a\x74\x7b\x6d\xc1\x6d\xe6\xc7\x1e\x57\x70\x6c\xc7\xad\x6c\x17\xda\x52\x01\x3b\xf9\x15\x31\x35\xe1\xb0\xbc\x4f\xb0\x20\x20\x94\x04\xed\x99\x00\x3b\x18\xe6\x67\x34\xd5\x9d\x84\xdc\xc4\x90\xd0\x54\x37\x2a\x64\xc3\x03\x42\x36\x8c\x2e\x76\xf0\xd5\x81\xae\x26\x57\xdd\x09\x99\xf9\x90\x90\xab\x1e\x15\xb2\xe1\x01\x21\x1b\x46\x17\x13\xc6\x02\xe3\x95\x64\x97\x5b\xd7\x43\xf2\xb2\x4b\x65\x39\xf7\x9c\xb5\x05\xe7\x2e\x91\x49\xcd\xf4\x71\x9b\x9e\x55\xdc\x8f\xa6\x5f\x2f\xfb\x92\x52\xb3\xca\xe4\xaf\x4c\xa9\x6d\xbd\x02\xab\xc1\x83\x63\xb5\x9d\xbf\x0a\xdd\xf9\x29\x96\xcb\x04\x3b\x6a\x4e\x9d\xb6\x96\xc4\x86\x4b\x44\xbe\x1b\x1a\xd6\x3b\xb0\x72\xbd\x67\xce\x21\x5e\x74\x1c\x2b\x38\xe0\x0a\x30\x72\x70\x7f\x0d\xe3\x9d\x57\x5c\x6c\x41\xe7\x4c\xc9\x95\xe5\x76\xfe\x66\x62\xab\x10\xfb\x23\x6b\x86\xda\x8a\xbb\xf9\x23\xad\x03\x4c\x0c\xa7\x8c\xd9\xd6\x55\x13\x4e\x22\xf6\x6c\x82\x3b\x22\xc2\xd8\x7c\xae\x97\xbb\x3c\xd9\x83\x9d\x53\xd1\xb3\x58\x94\x7b\x1f\x1f\xc8\x6e\x2b\x2b\xd6\x28\xab\x0b\xa6\x6b\xa5\x58\xf7\xfa\xb7\x37\x74\x0b\xb3\xec\x9c\x3f\x3d\xb9\x73\x2d\x88\x1e\xc2\xc7\x3a\xf0\x28\x54\x9d\x23\x82\x11\x44\xad\xb1\x75\x77\x72\xcb\x38\xab\xe2\xde\x83\x1d\x75\x93\x33\xf0\x6f\x11\x08\xdd\x1d\x75\x4e\x1b\x34\xe1\x06\x4c\x27\xe1\xb3\x32\x9d\x90\x45\x3c\x93\x6f\xd2\x22\x12\x7a\x64\x84\xb7\x49\xea\xdd\x50\x96\x14\xd1\xc7\x68\xeb\x41\x63\xe2\x2c\x26\x6c\x2b\x38\x2b\x49\xd8\x95\xe3\x87\x7a\x68\x8e\x22\x8f\x74\xa1\x2e\xcd\x51\x64\x11\xd7\xea\xbe\xde\x39\x2a\x6c\x5a\x34\x45\xf5\xc8\xab\x9b\xa2\x82\x40\x15\xb7\x0e\x06\x67\x31\x4c\x0f\x42\x29\x3d\x93\x7a\xc7\x95\xcc\x0f\xc9\x25\xf3\x86\x81\xb5\x98\x23\xaf\xa1\xa5\x99\x0d\x6f\x8f\x36\xa8\x1a\xd8\x5a\x82\x42\xe4\xad\xc1\x85\xc0\xa6\x2d\xd8\x16\xf6\xa9\xce\x43\x36\x50\xa1\xb5\x6c\xec\x19\xf6\xf1\x05\x13\xec\x80\xca\x41\xc9\x52\xfa\xe1\x7e\x8d\x52\xeb\x0a\x91\x21\x87\x16\x1a\x19\x9c\x97\x25\xf7\xc0\x44\x6d\x2d\x68\xcf\x60\x07\x7a\xf4\x7c\x47\x16\xb5\x5c\x0f\x8f\x95\x05\xf7\xfc\x8b\xa1\x19\x2a\xaf\x8d\x2d\x87\xbf\xc0\x99\x08\xd7\x9d\xc1\x57\x52\xa7\xdb\x71\x6a\x3f\x77\xf1\xb2\x84\xd0\x36\x6c\x16\xd5\xa2\xed\x67\x07\x2c\xbd\x8d\x29\x23\xb8\x6a\xf5\x4d\xa7\xeb\xe9\x58\x75\x08\x12\x19\x23\x45\xcc\x41\xd8\x59\xbb\x5d\x94\x83\xb2\xf2\x7b\xd6\xe1\xa6\x7b\xfb\x16\xba\x73\x9e\xa9\x87\xed\x01\x2f\xd8\x64\xc8\x76\x8d\x8b\x3c\xa7\xf9\x8c\x4e\x62\x37\xfa\xf0\xfe\xe3\x12\x1f\x1d\x88\xe2\x7c\xc9\x24\xe8\xa8\xe1\x7f\x59\x03\xbe\x71\xf0\x43\x76\x1a\x7e\xf4\xd8\x98\x51\x4d\xd4\x38\xb9\xac\x07\xdd\x21\x6d\x5f\xdc\xb0\xc3\xa7\xf4\x75\x1c\x78\x68\x2b\x76\x16\xfa\x57\x13\x76\xd3\xd3\xc0\x6f\xa6\xf5\xbe\xc2\xa6\xca\x91\xc8\xb5\x17\xb7\x30\xf5\xe8\x13\x06\x88\xa4\x13\x6f\xd4\xf8\xed\xaf\xa8\x98\x05\x0b\x88\x31\x83\x28\x44\x8c\xc1\xc6\x6d\xf9\xa5\x04\x4b\xb7\x1b\x89\x30\x46\xac\x19\xa2\x0c\xb0\xcb\x49\xe7\x6e\xd0\xe2\x43\x0a\x74\x28\x1b\x35\x8c\xa3\x53\xa6\x28\xf4\xa9\x21\x50\x8c\xbf\x88\x09\x7d\x22\x54\xc7\xce\x80\xd1\x90\xf8\x14\x2a\x0a\x3c\x32\x8e\x8a\x69\xe1\xc8\x54\x2a\x4a\xed\x98\xd8\x2c\x46\x67\x74\x4a\x15\xb1\xf0\x1c\x35\x3b\xe2\x17\xc8\x27\x84\x8f\x31\x2d\x31\x25\x6c\x8c\x5f\xc4\x44\x34\xe0\xcd\x16\xf8\xe7\x24\x5a\xf1\x61\x78\x7c\xb2\x35\x61\x5f\x21\x26\xfe\x9e\x00\x3f\x21\xe9\x9a\xd2\x50\xb1\x89\xd7\x94\x3a\x26\x26\x5f\x93\xab\x9a\x90\x80\x4d\xe8\xa0\xe8\x24\x6c\x6a\x1d\xb7\xdc\x1c\x8b\x4c\xc6\x26\xd6\x80\x4f\xc8\xa6\x54\x70\x53\xed\xf1\x89\xd9\x04\x74\x74\x72\x16\x3f\x14\x6e\xb4\x53\x1c\x65\xf4\x31\x6b\x9c\x91\x51\x11\x1e\x14\x67\x1e\x91\xa8\x38\x83\x8e\x5d\xe3\x4d\x0d\x98\x76\x19\x1a\x65\xac\x78\x33\x4d\xba\xc7\xdc\xde\x4c\x72\xdc\x3e\xc3\xee\xef\x85\xb4\xb4\x50\x29\x2e\xe0\xb4\x25\xe9\xe0\xd7\x1a\xb4\x40\x6c\xcf\x85\x91\x1d\xd8\x1d\x30\xdc\x65\x46\x58\xb4\xd0\x24\x9e\xe4\x34\x6e\x65\x4d\x09\x7e\x03\xf5\xcc\x5b\x81\x56\xa0\x46\xcd\x33\xfe\x54\x34\xd2\x94\x51\x76\x57\x82\xb7\x52\xbc\xdc\xc2\xc1\xaa\x16\x5b\xf0\x69\xc7\x6b\x0e\x0e\x3d\x60\x91\xe9\x6b\x5a\xf7\x1c\x36\x82\x4e\xa6\x1c\x90\x8f\x54\x25\x6a\xbe\xc4\x7d\x6e\xf6\xdb\x39\x7f\xec\x09\x93\xc6\x40\x02\x45\x9a\x57\x0d\x14\x69\xd4\x7a\x19\x47\x1f\x04\x3a\x9c\x19\x29\x4d\x2e\xd7\x72\xde\x59\x14\xb1\xe1\x96\x81\x16\x26\x0f\xa4\x2b\xb8\xf3\x18\x16\x2a\x6e\x21\xd1\x9d\x82\xbf\xaf\xcf\x4e\xce\x93\x7b\x8a\x0f\x76\xda\x19\xfd\xe5\xfc\xfa\x8d\x16\x3e\x53\x7b\xe2\x43\xbb\xfc\x06\x3e\xe8\xdc\x40\x81\x82\xe1\xe3\x70\x87\x97\x78\x29\xbb\x7c\xd8\x48\x0f\x4a\x3a\x9f\xc2\x34\xb1\xae\xcd\x5b\xae\x5d\x93\x7e\xcd\xf3\x6e\xbc\xf6\xa6\x4d\x8c\x04\x77\xa3\x79\x1c\x2e\x00\x05\xcd\x57\x0a\x98\xad\x57\xa9\xae\xd6\xa5\xcf\xf3\x7a\x8a\xbd\xa4\x9f\xd4\xf0\x90\xe8\xfb\xbe\x23\x1a\x26\xc3\x4f\x31\x52\x9c\xcf\x4d\x3d\xeb\xf6\x56\x53\xfb\xaa\xf6\x29\xbe\x16\x0f\x2b\x5b\x97\x46\x99\x42\x0e\x06\xea\xb8\x5b\xb8\x95\x02\xe1\x8d\x65\xc9\x0e\x90\x9e\x21\xd3\x84\x2c\x87\x6f\x4e\x98\x30\xda\x73\xa9\xc1\x76\x57\x48\x27\xc3\x5d\x73\x21\x95\xf4\xfb\xc4\xb0\x1b\xe3\x7c\x62\xc8\xf3\xdd\x01\x69\x71\x2b\x93\xa7\x46\xb4\xd2\xd8\xf4\x6d\x5a\x6b\x99\xaa\x4d\x95\x29\x10\x6b\x91\x28\xa8\x8e\xb9\x82\x1d\x28\x1f\xe6\x7f\x0c\xff\x04\x2f\xdd\xc8\x7c\x0a\x9c\xea\x2a\xde\x27\xb0\xc7\xb5\xaf\x9c\x63\xee\x17\xc4\x81\x37\xa3\x29\x25\x56\xf2\x46\x4d\x8d\x95\x4e\x41\x6f\xb9\x90\xba\x60\x67\x0e\x95\x54\x1d\x7f\x44\x3e\x7b\xe6\xa4\x0a\x63\x87\x67\x28\x86\x38\xe2\x25\xb1\xa1\x23\x58\x77\x68\x22\x71\x43\x9e\x1c\x7c\x32\xc4\xca\xcc\xff\x58\xe5\x02\x8b\xc9\xb9\x70\xc1\xb0\xc6\xf3\x82\xe9\xa6\xe7\x95\x9c\xf9\x4d\x50\x12\xf7\x1e\xd6\x77\x63\x8d\xf7\xc3\xf7\x5e\x63\x34\x6d\x2f\x07\x67\xdd\x42\x2e\x6b\x8f\xe1\x84\xb5\x0e\xdd\x53\x7a\x85\x59\x81\x95\x26\x67\x09\xae\x9a\xef\x60\x73\x6b\x2a\xa6\x4c\x81\x00\x0c\x8d\xce\x0e\x30\x05\x23\x45\x87\x64\xc1\x81\x67\x96\x7b\x48\xf7\xba\x0f\xdc\xea\x66\x04\xe4\xa0\xf8\x7e\x3e\x6c\xc0\xa6\x46\x7f\x1e\x4e\xe7\x0a\x65\x56\x5c\xfd\xa3\x4d\x40\x3e\xc3\x3a\x86\xed\x67\xb4\x79\x87\x6b\x6c\xcf\x7f\xbc\x6c\x85\x2d\x87\x56\xef\x35\x73\x23\x90\x25\xf7\xa2\x27\x12\x99\x4c\x7e\x14\xf8\x0a\x1e\xcb\x7e\x74\x9e\x32\x5f\xd1\x65\x67\xcd\x24\xf9\x8a\xd4\x79\xdd\x9b\x7d\xe1\x1c\x1d\x9a\x54\x98\x0c\xe5\xf6\xea\xbc\x69\x43\x99\xe8\xf1\xcd\x0b\xba\xde\xce\x92\x4d\xdf\x81\x78\x6c\xc3\x22\x6a\xee\x69\x82\x81\x1f\x3a\x56\xc0\xeb\x6a\x86\xc7\x53\x47\x54\x38\x34\x69\xf4\x87\x28\x47\xc2\xc1\xdb\xb7\xed\x15\xb5\xe1\x90\x92\x7d\x21\x45\x6f\xdb\x3c\x7b\xd8\x9e\xf0\xc8\x97\x99\xb7\x75\xb7\xc3\xe1\xbc\xb1\xbc\x80\x65\xb6\xe6\xca\x1d\x1e\xd5\x2b\x7b\xa0\xe0\x3c\xbd\xd9\xa1\x89\xb3\xff\xfe\x8f\x58\x24\x2f\x58\x24\x57\xe0\x89\x44\xf2\x52\x88\x44\x92\x48\x24\xcf\x42\x24\x92\x33\x95\x22\x12\xc9\xa9\x68\x44\x22\x79\x06\x20\x12\xc9\x83\x10\x89\x24\x16\x8a\x48\x24\x27\xc1\x04\xad\x99\x48\x24\xf1\xf5\x12\x89\x24\x51\x5d\xf4\xca\xdb\xa0\xba\x20\xba\x16\xb2\xe1\x61\x79\x1b\x36\x8c\x2a\x46\x24\x92\xf3\xa0\x88\x44\x92\xdc\xc4\x90\xbc\x0d\x37\x41\x53\x1d\xd9\xf0\xb0\xbc\x0d\x1b\xc6\x4d\x75\x44\x22\x49\x66\x3e\x20\x6f\xc3\xcc\xc9\x55\x93\x0d\x0f\xcb\xdb\xb0\x61\x9c\xab\x26\x12\xc9\xa7\x85\x89\x44\x92\x48\x24\x27\x61\x05\x07\x1c\x91\x48\x4e\x82\x23\x12\xc9\xe1\x62\x44\x22\x39\xbd\x96\x4e\x88\xa0\x6b\x3a\xfe\x37\x49\xc7\x44\x04\x5d\x33\xf0\xbf\x49\x8b\x48\xe8\x91\x89\x44\xf2\xa9\xbc\x51\x86\x2e\x22\x91\x1c\x12\xf2\x48\x3d\xea\xd2\x1c\x45\x16\x71\xad\xee\xeb\x9d\xa3\x88\x44\xf2\x89\xbc\xd1\x29\x2a\x08\x44\x24\x92\xb3\x11\x89\x44\x72\x0e\x32\x91\x48\x12\x89\xe4\x01\x97\x48\x24\x5b\xdc\x74\x6f\x4f\x24\x92\xcf\x25\x76\xa3\x8f\x48\x24\x47\x84\x48\x24\x63\x3a\x84\x48\x24\x47\xd1\x89\x44\xf2\x2c\x44\x22\x49\x24\x92\x33\xc1\xd2\xed\x46\x12\x89\xe4\x13\xfd\x51\xc3\x98\x48\x24\x07\x84\x48\x24\x4f\x42\x24\x92\x97\xf5\x23\x67\x47\xfc\x02\x39\x91\x48\x3e\x85\x24\x12\x49\x22\x91\x24\x12\x49\x22\x91\x44\xd5\x40\x24\x92\xfd\x42\x24\x92\x57\xb8\x44\x22\x99\x16\x30\xed\x32\x34\x91\x48\x12\x89\x24\x91\x48\x9e\x84\x48\x24\xe7\xe2\x11\x89\xe4\x65\xd1\x74\x80\x44\x22\x49\x24\x92\xdf\xce\x67\x27\x44\x22\xf9\x5c\x88\x44\xb2\xa7\x04\x91\x48\xb6\x42\x24\x92\x47\x21\x3f\x39\x55\x35\x22\x91\x24\x12\x49\x22\x91\x24\x12\x49\x22\x91\x4c\x84\x47\x24\x92\x44\x22\x39\x07\x94\x48\x24\x89\x44\x32\x15\x16\x91\x48\x3e\x2f\x40\x24\x92\xa3\x80\x44\x22\x39\x50\x86\x48\x24\x27\x54\x48\x24\x92\x7d\x12\xb5\x7d\x45\xdc\x80\xc9\x36\xfb\xc2\x39\x3a\x91\x48\xbe\x90\x3a\x6f\xda\x50\x26\x7a\x7c\x22\x91\xbc\xae\x86\x48\x24\x7b\x1f\x0e\x93\x48\x9e\x9f\x8c\x73\x48\x9e\x1b\x9b\x0b\x01\x95\x87\xfc\xe3\xd9\x19\xb5\xf4\x86\xd9\x77\xdf\xb5\x7f\x54\xaa\xb6\x5c\x1d\xfe\x14\x46\x77\x96\xe1\x96\xd9\xbf\xff\xb3\xe8\x2a\x86\xfc\xcb\xf1\x86\xcf\xe6\xe1\xff\x03\x00\x00\xff\xff\x91\xef\x6b\xf3\x73\xe6\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\x5f\x6f\xe3\x38\x92\x7f\xf7\xa7\xd0\x17\x48\x6e\x7b\x17\x07\x0c\xfc\xb2\x18\xf4\xed\x00\x83\x39\xcc\x36\x66\x0f\xfd\x4a\xd0\x54\x59\xe6\x98\x22\x35\x24\xe5\xc4\x7d\xb8\xef\x7e\x20\x25\xc7\x4e\xda\x36\x8b\x62\x39\x31\x76\x14\xe7\x25\x32\xf3\x63\xb1\xf8\x63\xb1\x4a\xfc\x53\x8b\x87\x87\x87\x05\xef\xe4\x57\xb0\x4e\x1a\xbd\xac\x78\x27\xe1\xd9\x83\x0e\x7f\xb9\xc7\xed\x0f\xee\x51\x9a\xff\xd8\x7d\x5a\x6c\xa5\xae\x97\xd5\xe7\xde\x79\xd3\xfe\x06\xce\xf4\x56\xc0\x7f\xc1\x5a\x6a\xe9\xa5\xd1\x8b\x16\x3c\xaf\xb9\xe7\xcb\x45\x55\x71\xad\x8d\xe7\xe1\xb1\x0b\x7f\x56\x95\x30\xda\x5b\xa3\x14\xd8\x87\x06\xf4\xe3\xb6\x5f\xc1\xaa\x97\xaa\x06\x1b\xc1\x0f\x55\xef\xfe\xf2\xf8\x9f\x8f\x7f\x59\x54\x95\xb0\x10\xff\xfd\x7f\x64\x0b\xce\xf3\xb6\x5b\x56\xba\x57\x6a\x51\x55\x9a\xb7\xb0\xac\xd6\xca\x3c\xb9\x47\x65\x9a\x46\xea\xe6\x71\xc5\xf5\x37\x2e\x85\x32\x7d\xfd\x28\xcd\xc2\x75\x20\x42\xb5\x8d\x35\x7d\xb7\xac\x2e\x94\x1a\xa0\x0e\xf2\x71\x0f\x8d\xb1\xf2\xf0\xf7\xc3\xe1\xbf\x1e\x78\xac\xb5\xaa\x86\xd6\xff\xa4\xcc\x53\xfc\x53\x49\xe7\x7f\x79\x79\xf4\xdf\xd2\xf9\xf8\xb8\x53\xbd\xe5\x6a\x94\x2f\x3e\x71\x52\x37\xbd\xe2\x76\x78\xb6\xa8\x2a\x27\x4c\x07\xcb\xea\x57\xde\x82\xeb\xb8\x80\x7a\x51\x55\xa3\x02\x62\xe5\x0f\x15\xaf\xeb\xa8\x52\xae\xbe\x58\xa9\x3d\xd8\xcf\x46\xf5\xed\x41\x95\x0f\x55\x0d\x4e\x58\xd9\x85\x22\xcb\xea\x67\x57\xf9\x0d\x44\xf0\x8a\x0b\x2f\x77\xf0\xf7\x58\x6f\x55\xfd\xee\x8c\xfe\xc2\xfd\x66\x59\x3d\x3a\xcf\x7d\xef\x1e\x87\xef\xc7\xaf\x43\xeb\x97\xd5\x8f\xa7\x8f\xfc\x3e\x48\xb6\x32\x46\x01\xd7\xe7\x2a\xfb\xb5\x6f\x57\x60\x2b\xb3\xae\x3a\x6b\x56\x0a\x5a\x77\xb1\xae\x43\x81\xcf\xa6\xd7\x7e\x2c\x35\x54\xf9\xe5\xf5\xbf\x0e\x95\x86\x76\x36\x60\x17\xc7\x62\xbb\x4f\x5c\x75\x1b\xfe\x29\x3e\x72\x62\x03\x6d\xa4\x56\xf8\xcb\x74\xa0\x7f\xfc\xf2\xf3\xd7\xbf\xfd\xeb\xd5\xe3\x2a\x48\xd5\x81\xf5\x2f\xdd\x38\xfc\x9e\x90\xfb\xe4\xe9\xa1\x66\xe7\xad\xd4\xcd\xc9\x17\xb1\xa7\x31\x05\x4f\x19\x7f\xfc\x19\x50\xcd\xea\x77\x10\x87\x76\x87\xcf\x81\x94\x55\x75\x5d\xd8\xf0\x59\x4b\xe5\xc1\x7e\xf7\xb8\xaa\xa4\x87\xf6\xcc\xe3\x6b\x58\xc3\x47\x18\x2d\xb8\x3f\xff\x5d\xfa\xbf\x0f\x23\x58\xea\xde\xf4\x8e\x29\xa9\x81\x59\x68\xe0\xb9\xbb\x5c\xfe\xa2\xd6\x5e\x7f\xd6\xaa\x77\x1b\x16\x7a\xdf\xee\xb8\x4a\xc3\x9d\xf2\xe4\xdc\xcf\x16\xa0\x63\x1d\xb7\x5e\x72\xc5\xb6\xb0\x4f\x23\x9e\xd2\x3d\x89\x78\xbe\xcb\x27\xb4\x1b\x25\x5a\x02\xa3\xed\x95\x97\xb1\x33\x40\xd7\x54\x1d\x72\x04\x75\x9e\x5b\x4f\x05\xab\x23\x6b\x5c\x1a\x27\xd5\xc1\x59\x7d\x9b\x10\xea\x80\xb5\xe3\xaa\x87\x62\x34\x07\x1d\xb7\xdc\x1b\x5b\x8e\xe4\x2d\xf0\x96\xc9\x1a\xb4\x97\x7e\x4f\xd2\x56\x2f\x5b\x30\xbd\x67\x8a\xaf\x40\x15\xa3\xf5\x0e\xd8\x5a\x5a\xe7\x99\x7f\x99\xa1\x8b\x47\x5a\x00\x25\x1e\x68\x17\x8c\xf1\xf1\x53\x43\x6d\x8a\xec\x62\x0d\xac\x36\x9e\x69\x70\x1e\xde\x4c\x1b\x53\x74\x30\xc2\x51\x71\x09\xd3\xfe\xbe\x2b\x69\xbf\x30\x6d\xc7\x2d\x04\x8e\x5e\x29\x75\x65\xea\xca\x6a\xcf\xb1\x18\xb7\x96\xef\x2f\x96\x12\xc1\xeb\x20\x19\x37\x2d\x7f\x66\xc3\x78\x24\x30\x5e\xe3\xc0\xbe\x2f\x5d\x3d\x49\x5d\x9b\xa7\xd2\xc6\x21\x98\xe6\x41\xf8\x7f\x3c\x0b\xe8\x4e\x02\x83\x69\xa4\x53\x5c\x37\x3d\x6f\xe0\x8e\xb4\x18\x78\xb2\xda\x7b\x70\xa5\x8a\x1c\xa0\x88\x66\xcb\x16\x9c\xe3\x0d\x10\x7a\x05\xb9\x0e\x5b\x02\xd8\x42\x6b\x76\xc0\x3c\x6f\x58\x67\x61\x2d\x9f\x8b\x11\x87\x31\x76\x6b\xbb\x09\x7a\xc3\xb5\x80\x5f\x7e\x28\xe2\x31\xef\x24\x8b\x51\xea\x1d\x11\x79\x05\xdc\x82\x65\xde\x6c\x41\xb3\xb5\x54\xe5\xe4\x11\x3c\x89\x83\x51\x56\xf8\xb4\xc1\xae\xff\x64\xcd\xd5\xfe\xcd\x01\x0c\x1f\x07\xc2\x82\xff\x05\xf6\xbf\xc1\x3a\x5d\x3a\x0f\x1b\xe1\xeb\x67\xeb\xf3\xf4\x13\x43\xea\x5b\x81\x9b\x68\xa9\xaf\x8f\xf2\x7c\x17\xe7\xf8\x63\xe1\x8f\x5e\xda\xeb\x6e\xd3\xe1\xe7\xa1\xda\xc2\x7e\x91\x28\x84\x19\xb9\x13\x8a\x26\xe3\x83\x2c\xed\x46\xb4\x99\xc3\x33\x87\xdf\x91\xc3\xa8\x62\x82\x8b\x4d\x78\xb1\xb3\xb6\xe0\x36\xe5\xbe\xc7\x2b\x38\xb6\xe3\x56\xc6\x57\xba\x54\xc0\x4e\x7e\x03\x2a\x2c\xef\x15\x01\x94\x92\xa0\x3d\x13\x60\x2f\x06\x94\xf3\x54\x37\x4f\x75\xf3\x54\x37\x4f\x75\xf3\x54\xf7\xd1\x53\xdd\x60\xab\x13\x5d\x3d\x9b\xea\xd9\x54\xcf\xa6\x7a\x36\xd5\xb3\xa9\xfe\x48\x53\x6d\x2c\xb0\xf0\xa2\xec\x74\x93\xc4\x7d\xbc\x2a\x0b\x0b\x54\x2c\x16\x5c\x14\xd6\x27\x35\xd3\x87\x0d\x21\xac\x0b\x1b\x29\xee\xa6\x91\x52\xb3\xce\xd4\x77\x26\x54\xd8\x40\x64\x35\x78\x70\xac\xb7\x57\x47\x11\xaa\xd2\xe1\xed\x09\xab\x25\xc1\xda\xad\x53\x2f\x8b\x98\x62\xc3\xe5\x9b\x3d\x27\x53\x86\xf5\x0e\xac\x5c\xef\x99\x73\xaa\x14\x2b\x39\xe0\x1a\x30\xb2\x68\x39\x70\xc5\xc5\x36\xec\x46\x50\x72\x65\xb9\xdd\x17\xab\x33\x0a\xc4\xfe\xca\xc2\x50\x5b\x71\x57\x3e\xd2\x06\x40\x62\x38\x65\xcc\xb6\xef\x92\x6b\xa0\x28\x44\x0b\xc2\xd8\xda\x15\x8e\xb5\xd3\x3d\x64\xd8\x39\x15\x25\x1e\x8a\x46\xf8\x81\xec\xb6\xb2\x63\x41\x58\xdd\xb0\xb0\xc3\x8f\x0d\xcd\xbf\x3d\xd1\x2d\x14\xf1\x9c\xbf\xdd\x23\x96\xdd\x43\x98\x5a\xc6\xb5\xa6\x67\xa1\xfa\xfa\x2a\x57\xd1\xb5\xde\x9f\x9f\xd5\x71\xef\xc1\x5e\x35\x93\x05\xf8\xb7\x70\x84\x1e\x0e\x32\x23\xca\x22\x87\x0a\x7e\xc0\x1c\x9a\x95\xda\x95\x35\x33\xe2\xcf\xc4\x08\x24\x28\x06\x0e\x61\x6d\x10\xac\xc2\xf3\x09\xc5\xa4\x8c\x3e\x46\xb3\x07\x8d\x89\x63\x4c\x9a\x2b\x38\x96\x10\x76\xa5\xb1\xef\xd6\x8b\x08\xd6\xa0\x6b\x9d\x2d\xd2\xbf\x81\x45\x9a\xe7\xa8\x79\x8e\xba\xd9\x1c\x95\xa6\x16\x82\x54\x78\x3a\xa1\x88\x94\xd1\xc5\x68\xf2\xa0\x31\x71\x84\x49\x53\x05\x47\x12\xb2\x9e\x4c\x02\x75\xdc\x3a\xb8\x38\x8b\x61\x7a\x10\x5a\xe9\x99\xd4\x3b\xae\x64\x3d\x06\x97\xcc\x1b\x06\xd6\x1a\x5b\x1a\x65\x56\xd5\x86\xbb\x0d\x8b\xaf\xce\xd9\x5a\x82\xba\xaa\x7f\x54\x67\x4a\x1d\x74\x11\xde\x24\x50\xed\x87\x0c\x50\xa9\x77\xd9\x28\xa0\xd8\x17\xcb\x45\xf9\x80\xaa\x41\xc9\x56\xfa\xcb\xfd\x9a\x25\xd6\x2b\x44\x86\x1c\x5a\x68\x64\x70\x5e\xb6\xdc\x03\x13\xbd\xb5\x61\xc9\x10\x76\xa0\x3d\x0e\x3e\x45\x9e\xf0\x81\xe7\xce\x82\xfb\xfe\x6c\x5a\x81\xc8\x6b\x63\xdb\xcb\x67\xbd\x26\xc2\x0d\xa7\x3d\xc2\xae\x64\x32\xe0\x78\xb0\x2a\x1c\x1f\x49\x2d\xc3\xe6\x69\x34\x1e\x70\x61\xf4\x1c\x53\x46\x70\x15\x8f\xbb\xd0\xc9\xfa\xb2\xad\x7a\xb9\x20\xf1\x91\xd0\x8d\xc1\x18\xe7\x71\x19\x2c\xbc\x94\x83\xb6\xf3\xfb\x70\x0c\x42\xea\x86\xae\xf5\xf1\x7d\xdf\x60\x3c\xa9\x87\xed\x88\xe7\x88\xf4\x8a\xb5\x6c\x25\x36\x23\x57\x7b\xb9\xf6\x23\x53\x83\x39\xb6\x64\x12\x74\xd6\xf0\x9f\xa6\x1c\xfc\x90\x9d\x86\x9f\x3d\x36\x0a\xaa\xc9\x1a\x27\x93\x3a\x24\xe8\x89\xdd\xb0\xc3\xa7\xf4\x75\x1e\x78\x6a\x29\xb6\x08\xfd\x9b\xd1\x37\x02\xbf\x99\xd4\xfb\x0e\xdc\x4d\x90\x7b\xff\xe6\x2c\x3b\x0d\xd5\x91\x71\x45\xce\xf4\x95\x41\x6a\xb4\x0e\xb0\x44\xce\x03\xc4\xd0\x20\x0b\x11\x43\x58\x3c\x20\xa9\x74\x18\x62\xa2\xd1\x10\x64\xc4\xd2\x10\x45\xc0\x18\x07\x9d\xbb\x9d\x21\xcb\xad\xc0\xbb\x14\x68\x57\x36\x43\x67\x13\x42\xa6\x2c\xf4\xa9\x2e\x50\x8e\xbd\xc8\x71\x7d\x32\x44\xc7\xce\x80\xd9\x90\xf8\x10\x2a\x0b\x3c\xd3\x8f\xca\xd1\x70\x66\x28\x95\x25\x76\x8e\x6f\x96\x23\x33\x3a\xa4\x42\x3b\xff\x59\xcd\xc2\xcf\x4c\x93\xdc\xc7\x1c\x4d\x4c\x71\x1b\x33\x5a\x3a\x62\x3a\x42\x3d\xe7\x85\x59\x65\x81\x56\x9e\x2e\xf3\x2d\x4e\xb6\x3e\xf3\xac\xcf\x44\xf8\x09\x41\xd7\x14\x45\xe5\x06\x5e\x53\xea\x98\x18\x7c\x4d\xae\x6a\x42\x00\x36\xa1\x83\xb2\x83\xb0\xa9\x75\x64\xf7\x7f\x6e\x05\x18\x8f\xb1\xa8\x06\x7c\x40\x36\xa5\x82\x9b\x4a\x8f\x0f\xcc\x26\xa0\xa3\x83\xb3\xfc\xa1\x90\x11\xa0\xe5\x4c\x84\x59\xa4\xcf\xd0\x07\x9e\xe8\xb9\xa0\x38\x7a\x64\xa2\xe2\x08\x9d\x03\x4a\x2e\x25\x8e\xb8\x19\x88\x28\xb2\xe2\x69\x8a\x24\x28\x86\x9a\xe3\xcd\x24\x87\xe5\x33\xec\xfa\x5e\x4a\x4a\x0b\x9d\x0a\x9b\xfc\x0f\x4b\x92\x0e\xfe\xe8\x41\x0b\xa0\x40\x76\x60\x77\xc0\x70\xd7\x66\x61\xd1\x52\x93\x38\x06\x2d\xd9\x2b\x9d\x35\x2d\xf8\x0d\xf4\x17\xc9\x85\x71\x0d\x63\xb8\x72\xe5\xfb\x29\xbb\xa2\x91\x54\x46\xf1\xae\x05\x6f\xa5\xb8\x5a\x21\xc2\x55\xc6\x3b\xc9\xab\x5e\x6c\xc1\x27\x8b\xa1\x1b\x19\x7e\xc3\xdd\xa3\xa4\x80\xd4\xe6\x39\x4d\x82\xa9\x54\xc8\x16\x05\x49\x8b\x9c\xe3\x66\x1f\x67\xfc\xb1\x3b\x4c\x02\x41\x12\x45\x42\x53\x13\x45\x42\x3b\x17\x04\x9a\x4d\x1b\xfa\x24\xd0\xb8\x67\xa4\x35\xb5\x5c\x4b\xb0\x25\x06\x4a\x6c\xb8\x65\xa0\x85\xa9\x13\xe1\x0a\xaa\x57\x3a\x1b\x2e\x09\x04\xa2\xdb\x2b\xff\x5c\xc7\x4e\x8e\x93\xbb\x23\xd0\x5c\x9c\xd1\x4b\x55\x87\xb7\xeb\x37\x7a\xf1\x49\x6d\x89\x47\xbd\x7c\x80\x0d\x3a\x2a\xa8\x78\x3b\xdc\xd8\x88\xf7\xe2\xe5\xd3\x46\x7a\x08\x77\x8b\x53\x50\x13\x6b\xda\xbc\xe5\xda\x85\x17\x4f\x65\xd6\x8d\xf7\xde\xc4\xd3\xb0\x82\x3b\x5f\xea\x32\x86\x1b\xf6\xf8\x4a\x01\xb3\xfd\x6a\x5f\x0e\x16\xdf\x7b\xcd\xc7\xf3\xb2\x8f\xe7\xd1\xda\x49\x0d\x4f\x44\xe7\xfb\x0e\x68\x98\x08\x9f\x66\xa4\xd4\x5c\xf8\xa2\xd1\x21\x70\x97\x5f\x25\x94\x28\x62\xfa\x85\xc3\x6b\xc8\x52\x1a\xa2\xaa\xc4\x31\x25\x45\x91\xf7\x95\xe6\xfe\xf4\x33\x4e\x24\x6d\x62\x81\x02\x55\xa3\xe3\xea\x2a\xc8\x7c\x6f\xcd\x7c\x6f\xcd\x7c\x6f\xcd\x7c\x6f\xcd\x7c\x6f\xcd\x87\xdd\x5b\x93\x2c\xe2\x78\xdb\x5d\xbe\x13\x19\x43\xa8\x78\xf6\x24\xc1\x24\x54\x07\xd3\x65\x5f\xe9\xc0\x0a\xd0\x1e\x75\xe3\x78\x0a\xcb\x86\x53\x17\x14\xcd\x0b\x40\x57\xc7\x65\xbe\x33\x8e\x6b\x01\x82\x05\x88\x22\xce\xd7\xa6\xf7\x25\x44\x31\xbd\xef\x7a\x9f\x5c\xb3\x42\x28\x33\x2d\x6c\xdf\x1a\x65\x1a\x29\x4a\xe4\x15\x21\x51\x98\xf0\xc6\x32\xb2\xb3\x4b\x47\x48\x9a\xb7\x65\xe3\x71\x67\x16\xb2\x22\x71\xa9\xc1\x0e\x79\x72\xc8\x70\xd7\x5c\x48\x15\x52\xbf\xd0\xc2\x6e\x8c\xf3\xc4\x90\xc7\x6b\xab\x68\x71\xc3\x9d\x53\xc4\x88\x56\x1a\x4b\xaf\xd3\x5e\x4b\x2a\x9d\x2a\xd3\x20\x96\xc1\x51\x50\x43\x7a\x3e\x36\xe6\xb5\xdb\x53\xe3\xd1\x8d\xcc\xb7\xc0\x54\x59\x20\xde\xc0\x8e\xe1\x17\xab\xb9\xdb\x50\x81\x87\xd1\x44\x89\x45\xae\x54\x6a\x2c\x3a\x01\xbd\xe5\x42\xea\x86\x1d\x13\x45\x52\x75\xfc\x01\xf9\x68\x99\x49\x05\xc6\x0e\xcf\x94\xcb\x7b\xc0\x23\xe1\xd0\x01\x2c\xae\x7d\x52\x2b\xf2\xc5\xc0\x93\x21\x76\xa6\xa6\xc4\x62\xb2\xbe\xb5\x5b\x13\xd2\xd3\xe8\xd0\xf3\x4a\x16\x1e\x47\x27\x31\xef\x69\x79\x37\xd6\x78\x5f\x16\x5e\xc4\xbc\x34\x6c\xd8\x43\xc0\xe2\x0e\xf0\xb4\xd4\x29\x8f\xf8\x15\x66\x07\x56\x9a\x9a\x39\x2a\xd8\xda\x9a\x8e\x29\xd3\xb8\xf2\xd1\x39\xc8\x49\x11\x7c\x0c\x48\x61\x33\x8d\x67\x21\x10\xa1\x6b\xee\x13\xb7\x3a\x8c\x80\x1a\x14\xdf\x97\xc3\xa6\x39\x65\xb9\x80\xcf\x46\x7b\x78\xf6\x25\xbc\x1a\xe7\x93\xfb\x7a\x5f\xec\x3a\xae\x99\xac\x49\xba\xfc\x04\xeb\x8e\x5a\x18\x0c\x66\xc8\xde\xc5\x1b\x47\xd2\xca\x01\x4f\xd6\xe4\x60\x77\xa3\xb3\xc4\x88\xb8\xfa\xf5\x65\xf4\x46\x99\x15\x57\xff\x8c\x21\xf9\x6f\xb0\x3e\xd3\x98\x8b\x6d\xbc\xda\xb2\xcb\x35\xc6\xcd\xf8\xef\x5b\x61\x4c\xb8\x7d\xf6\xdd\xe9\x15\xc8\x96\x7b\xb1\xc9\x90\x2e\x65\x6c\xc6\x00\xad\xc4\x56\xbd\x76\x22\xaf\x14\xbc\x22\x26\xaa\xed\x38\xc5\x1e\x7f\x82\xdb\x78\x47\xe2\xdc\xf7\xce\xcb\x64\x11\x07\xe1\x7d\xd3\x4c\x94\x99\x28\x57\x89\x72\xf5\xeb\xcb\xad\x37\xef\x68\x7a\x07\x26\x9b\x73\xa7\x93\xb1\x8a\x45\xd4\x7c\x46\x05\x17\xbe\x70\x9e\xfb\xb7\x9b\xba\x2f\x8f\x27\x2e\xbc\xdc\x9d\x09\x5a\xae\x39\xed\xa0\xd7\xc6\x0a\xa8\x7f\xca\xce\x99\x3f\x51\xc5\x9d\x35\x2b\x05\xed\x3b\xd6\xf4\x39\x24\xbd\x5c\x2e\xf0\x5e\xfd\xd9\xce\xf8\xee\x61\xdc\xdf\x5f\x2f\x2b\x6f\x7b\x18\x1e\x78\x63\xc3\xd2\x4d\xb5\xe6\xca\x8d\x8f\xfa\x95\x85\xc1\x63\x7f\x69\xd9\xd8\xa7\xd5\xff\xfe\xdf\x22\x2c\xc2\x9d\xf2\x2a\x08\x63\x3f\x1b\xd5\xb7\x87\xad\x17\xc3\x86\x60\x2b\x63\x16\xbe\x65\xf5\xb3\xab\xfc\x06\xaa\xb5\x32\x4f\x63\x6f\xff\x7d\x44\xfd\xdd\x19\xfd\x25\xe4\x18\xa8\x1e\x87\x0a\x1e\x87\xef\xc7\xaf\x83\x59\x5d\x56\x3f\x9e\x3e\xfa\x9e\x15\x6f\x2a\xfb\xb5\x6f\x57\x60\x2b\xb3\x7e\xd1\xe4\xc5\xba\x5e\xa9\x7a\x2c\x35\x54\xf9\xe5\xf5\xbf\x7e\xaf\xf4\xa1\xd8\xee\xd3\x0a\x3c\xff\x14\xff\xd5\x89\x0d\xb4\x2f\x47\x30\x4c\x07\xfa\xc7\x2f\x3f\x7f\xfd\xdb\xbf\x5e\x3d\xbe\x34\x0e\x78\x27\xbf\x0e\x89\x2e\x4e\x9f\x5e\xe4\xd0\x56\xea\x1a\x55\xf0\x7c\x42\xf5\xb3\x4c\x09\xe1\x10\x08\xec\xa0\x5d\x67\x0f\xbc\xcb\x58\x2f\x93\xa9\xb8\xfc\x8e\x04\x3b\x1d\x4b\xdd\x9b\xde\xb1\x70\xde\x9e\xa5\xef\x8b\xbc\xa0\xb5\xd7\x9f\xdc\xdc\xc7\xe7\xc6\xe6\xe9\x4f\xdc\x95\x78\x48\x16\x81\x8a\xa3\x4e\xd9\x9e\x44\x24\xca\xa1\x9f\xdc\xae\x80\xc2\x78\x39\x97\xcf\x42\x7a\x08\xa2\x0e\x39\x82\x3a\xcf\xad\xa7\x82\xd5\x54\xe9\xb7\xb3\xfa\x36\x21\xd4\x01\x8b\x66\x01\xd3\x85\xb3\x03\xdc\x1b\x5b\x8e\x14\xf3\x6d\x33\x59\x83\xf6\x61\x75\x8d\xa2\xad\x61\x5b\xa7\xe9\x3d\x8b\xee\x62\x31\x5a\xef\x60\xb8\x7b\x23\x9e\x7c\x77\x9e\xb7\x5d\xf9\x48\x0b\xa0\xc4\x03\xed\x82\x31\x3e\x7e\x6a\xa8\x4d\x91\x5d\xac\x81\xd5\xc6\x33\x0d\xce\x43\x5d\xae\x83\x11\x8e\x8a\x4b\x98\xf6\xf7\x5d\x49\xfb\x85\x69\xe3\x91\x99\xfb\x7a\x35\x27\x82\x7f\x47\x32\x6e\x5a\xfe\x1c\x2e\x43\x00\xde\x12\x18\xaf\x71\x60\xdf\x97\xae\x9e\xa4\xae\xcd\x53\x69\xe3\x10\x4c\xf3\x20\xfc\x3f\x9e\x05\x44\x5f\xd2\x95\x90\x4e\x71\xdd\xf4\xbc\x81\x3b\xd2\x62\xe0\xc9\x6a\xef\x81\x80\x25\x01\x8a\x68\xb6\x6c\xc1\x39\xd4\xd6\x31\xb4\x57\x90\xeb\xb0\x25\x80\xc7\xd3\x10\x61\x35\x90\x68\x91\x75\x18\x63\xb7\xb6\x9b\xa0\x37\x5c\x0b\xf8\xe5\x87\x22\x1e\x87\x1c\x7c\x71\xe9\xe9\x8e\x88\xbc\x02\x6e\xc1\x32\x6f\xb6\xa0\xd9\x5a\x5e\x5e\xf1\x44\xd7\x2b\x78\x12\x67\xde\x5e\x3f\x6f\xaf\x9f\xb7\xd7\xcf\xdb\xeb\xe7\xed\xf5\x1f\xb6\xbd\xbe\xaa\x04\x17\x9b\xb0\xe7\x74\x6d\xc1\x6d\xca\x7d\x8f\x57\x70\x6c\xc7\xad\xe4\xb8\xf3\x7a\x38\x60\x27\xbf\x01\x15\x96\xf7\x8a\x00\x6a\x48\x80\x2e\xc0\x5e\x0c\x28\xe7\xa9\x6e\x9e\xea\xe6\xa9\x6e\x9e\xea\xe6\xa9\xee\xa3\xa7\xba\xc1\x56\x27\xba\x7a\x36\xd5\xb3\xa9\x9e\x4d\xf5\x6c\xaa\x67\x53\xfd\x91\xa6\xda\x58\x60\xe1\x45\xd9\x6e\x58\xc3\xbf\xa3\x57\x65\x61\x81\x8a\xe2\x0c\x68\x55\x49\x7d\x3c\x14\x12\x6e\x22\xd9\xdc\x4f\x23\xa5\x8e\x27\x42\xee\x4b\xa8\x6d\xbf\x02\xab\xc1\x83\x63\xbd\xbd\x3a\x8a\x50\x95\x0e\x76\x8a\xd5\x92\x60\xed\xd6\xa9\x97\x45\x4c\xb1\xe1\x12\x11\xef\xa6\x86\xf5\x0e\xac\x5c\xef\x99\x73\xaa\x14\x2b\x39\xe0\x1a\x30\xb2\x68\x39\x70\xc5\xc5\x36\xec\x46\x50\x72\x65\xb9\xdd\x17\xab\x33\x0a\xc4\xfe\x1a\x6f\x60\x5d\x71\x07\x44\x80\xc4\x70\xca\x98\x6d\x3f\xdf\x86\x35\xe1\x36\x2c\xb7\x95\x1d\x0b\xfb\xdd\x74\xc3\xe2\x9d\xf0\x34\x97\x59\xa5\x89\x6e\xa1\x88\xe7\xfc\xed\x1e\xb1\xec\x1e\xc2\xd4\x82\xda\x75\x9f\x55\xeb\xfd\xf9\x59\xe3\xbd\x52\x37\xc2\xff\xb7\x4c\xfb\x3c\x27\x02\x9f\x13\x81\xdf\x2c\x11\x38\xc2\xda\x20\x58\x85\xe7\x13\x8a\x49\x19\x7d\x8c\x66\x0f\x1a\x13\xc7\x98\x34\x57\x70\x2c\x21\xec\x4a\x63\xdf\xad\x17\xe7\x39\x6a\x9e\xa3\xe6\x39\x6a\x9e\xa3\xde\x67\x8e\x4a\x53\x0b\x41\x2a\x3c\x9d\x50\x44\xca\xe8\x62\x34\x79\xd0\x98\x38\xc2\xa4\xa9\x82\x23\x09\x59\x4f\x26\x81\x86\xc4\xa0\xcb\xc5\xf4\x1e\x84\x56\xfa\x97\xcc\x30\x87\xdb\xb9\x0d\x03\x6b\x8d\x2d\x8d\x32\xc7\x0b\x03\xe3\xab\x73\x6c\x32\x9b\x44\x67\x4a\x1d\x74\x11\xde\x24\x50\xed\x87\x24\xbb\x60\x28\xf6\xc5\x72\x51\x3e\xa0\xd0\xd9\x2f\x51\x62\x4d\xca\xbc\x8a\x46\x9e\x96\x0f\x11\x47\x9e\xbc\xc4\x03\x68\x91\xd3\xf7\xe1\x4c\x80\xc3\x67\x5a\x45\x03\x67\x25\x4e\xc4\x6b\x34\x33\xc3\x2a\x5a\x5c\x7c\x02\x46\xbc\xac\x2f\xdb\xaa\x97\x0b\x12\x1f\x09\xdd\x18\x8c\x71\x9e\x98\x13\x12\xdf\xfa\xfc\x1c\x90\xe8\xf6\x8d\x78\x8e\x48\xaf\x58\xcb\x56\x62\x33\x72\xb5\x97\x6b\x3f\x32\x35\x98\x63\x4b\x26\x41\x4f\xc8\x9b\x9a\xab\x9c\xdc\x9c\xa9\xb9\xf8\x13\xf3\xa5\x4e\xaa\x66\x42\xae\xd4\xcc\x0e\xc9\xce\x93\x3a\x05\x3f\xb3\xaf\xf3\xc0\x53\x4b\xb1\x45\xe8\xf8\xdc\xa8\xb9\xe0\x37\x93\x1a\x9f\x13\x35\x13\x19\x9d\x0f\x35\x8f\xea\xc8\xb8\x22\x67\xfa\xca\x20\x35\x5a\x07\x58\x22\xe7\x01\x62\x68\x90\x85\x88\x21\x2c\x1e\x90\x54\x3a\x0c\x31\xd1\x68\x08\x32\x62\x69\x88\x22\x60\x8c\x83\xce\xdd\xce\x90\xe5\x56\xe0\x5d\x0a\xb4\x2b\x9b\xa1\xb3\x09\x21\x53\x16\xfa\x54\x17\x28\xc7\x5e\xe4\xb8\x3e\x19\xa2\x63\x67\xc0\x6c\x48\x7c\x08\x95\x05\x9e\xe9\x47\xe5\x68\x38\x33\x94\xca\x12\x3b\xc7\x37\xcb\x91\x19\x1d\x52\xa1\x9d\xff\xac\x66\xe1\x67\xa6\x49\xee\x63\x8e\x26\xa6\xb8\x8d\x19\x2d\x1d\x31\x1d\xa1\x9e\xf3\xc2\xac\xb2\x40\x2b\x4f\x97\xf9\x16\x27\x5b\x9f\x79\xd6\x67\x22\xfc\x84\xa0\x6b\x8a\xa2\x72\x03\xaf\x29\x75\x4c\x0c\xbe\x26\x57\x35\x21\x00\x9b\xd0\x41\xd9\x41\xd8\xd4\x3a\xb2\xfb\x3f\xb7\x02\x8c\xc7\x58\x54\x03\x3e\x20\x9b\x52\xc1\x4d\xa5\xc7\x07\x66\x13\xd0\xd1\xc1\x59\xfe\x50\xc8\x08\xd0\x72\x26\xc2\x2c\xd2\x67\xe8\x03\x4f\xf4\x5c\x50\x1c\x3d\x32\x51\x71\x84\xce\x01\x25\x97\x12\x47\xdc\x0c\x44\x14\x59\xf1\x34\x45\x12\x34\x2f\x4f\x6b\xdc\x9a\x8f\x5d\xdf\x4b\x49\x79\xc8\x9a\x72\x58\x92\x74\xf0\x47\x0f\x5a\x00\x05\x72\xbc\x7e\x92\xe1\xae\xcd\xc2\xa2\xa5\x26\x71\x0c\x5a\xb2\x57\x3a\x6b\x5a\xf0\x1b\x78\x7b\xaf\x69\x9e\x6b\x78\xdf\x37\xe1\x56\x55\x0b\xde\x4a\x71\xb5\x42\x84\xab\x8c\x77\x92\x87\xa4\x10\xc9\x62\xe8\x46\x86\xdf\x70\x1b\x28\x29\x20\xb5\x79\x4e\x93\x60\x2a\x15\xb2\x45\x41\xd2\x22\xe7\xb8\xd9\xc7\x19\x7f\xec\x0e\x93\x40\x90\x44\x91\xd0\xd4\x44\x91\xd0\xce\x05\x81\x66\xd3\x86\x1e\x9b\xd1\xbd\x35\xb5\x5c\x4b\xb0\x25\x06\x4a\x6c\xb8\x65\xa0\x85\xa9\x13\xe1\x0a\xaa\x57\x3a\x1b\x2e\x09\x04\xa2\xdb\x2b\xe7\x24\xec\xd3\x93\xb0\xc7\x19\xbd\x54\x75\x78\xbb\x7e\xa3\x17\x9f\xd4\x96\x78\xd4\xcb\x07\xd8\xa0\xa3\x82\x8a\xb7\xc3\x8d\x8d\x78\x2f\x5e\x3e\x6d\xa4\x07\x25\x87\xc4\x75\x57\x89\x80\x50\x1a\xd6\xb4\x79\xcb\xb5\x0b\x2f\x9e\xca\xac\x1b\xef\xbd\x89\xa7\x61\x05\x77\xbe\xd4\x65\x0c\x37\xec\xf1\x95\x02\x66\xfb\xd5\xbe\x1c\x2c\xbe\xf7\x9a\x8f\xe7\x65\x1f\xcf\xa3\xb5\x93\x1a\x9e\x88\xce\xf7\x1d\xd0\x30\x11\x3e\xcd\x48\xa9\x79\x59\x3a\x93\x70\xf5\xbe\xd1\x85\x72\x56\x95\xe8\x9d\x37\xed\xe1\x35\x64\x29\x0d\x51\x55\xe2\x98\x92\xa2\xc8\xfb\x4a\x73\x7f\xfa\x19\x27\x92\x36\xb1\x40\x81\xaa\xd1\x71\x75\x15\x64\xbe\xb7\x66\xbe\xb7\x66\xbe\xb7\x66\xbe\xb7\x66\xbe\xb7\xe6\xc3\xee\xad\x49\x16\x71\xbc\xed\x2e\xdf\x89\x8c\x21\x54\x3c\x7b\x92\x60\x12\xaa\x83\xe9\xb2\xaf\x74\x60\x05\x68\x8f\xba\x71\x3c\x85\x15\x53\xbb\x52\x34\x2f\x00\x5d\x1d\x97\xf9\xce\x38\xae\x05\x08\x16\x20\x8a\x38\x5f\x9b\xde\x97\x10\x65\xc8\x96\x96\x5c\xb3\x42\x28\x33\x2d\x6c\xdf\x1a\x65\x1a\x29\x4a\xe4\x15\x46\x0d\x29\xd7\xe8\x92\xa3\x1f\x21\x69\xde\x96\x8d\xc7\x9d\x4f\x52\xa2\xc7\x23\x89\x64\xb8\x6b\x2e\xa4\x0a\xa9\x5f\x68\x61\x43\x8e\x4b\x62\xc8\xe3\xb5\x55\xb4\xb8\xe1\xce\x29\x62\x44\x2b\x8d\xa5\xd7\x69\xaf\x25\x95\x4e\x95\xc1\x67\xc3\x4f\x40\x8d\x29\xa1\x05\xf7\xd0\x18\xbb\xa7\xc6\xa3\x1b\x99\x6f\x81\xa9\xb2\x40\xbc\x81\x1d\xc3\x2f\x56\x73\xb7\xa1\x02\x0f\xa3\x89\x12\x8b\x5c\xa9\xd4\x58\x74\x02\x86\x54\xd7\x21\xd3\x3a\xd7\xda\xf8\x78\x2d\x38\x55\xc7\x1f\x90\x5f\xa7\x8f\x25\x83\xc5\x0e\xcf\x94\xcb\x7b\xc0\x23\xe1\xd0\x01\x2c\xae\x7d\x52\x2b\xf2\xc5\xc0\x93\x21\x76\xa6\xa6\xc4\x62\xb2\xbe\xb5\x5b\x13\xd2\xd3\xe8\xd0\xf3\x4a\x16\x1e\x47\x27\x31\xef\x69\x79\x37\xd6\x78\x5f\x16\x5e\xc4\xbc\x34\x6c\xd8\x43\xc0\xe2\x0e\xf0\xb4\xd4\x29\x8f\xf8\x15\x66\x07\x56\x9a\x9a\x39\x2a\xd8\xda\x9a\x8e\x29\xd3\xb8\xf2\xd1\x39\xc8\x49\x11\x7c\x0c\x48\x61\x33\x8d\x67\x21\x10\xa1\x6b\xee\x13\xb7\x3a\x8c\x80\x1a\x14\xdf\x97\xc3\xa6\x39\x65\xb9\x80\xcf\x46\x7b\x78\xf6\x25\xbc\x1a\xe7\x93\xfb\x7a\x5f\xec\x3a\xae\x99\xac\x49\xba\xfc\x04\xeb\x8e\x5a\x18\x0c\x26\xb0\xb5\xe2\x8d\x23\x69\xe5\x80\x27\x6b\x72\xb0\xbb\xd1\x59\x62\x44\x5c\xfd\xfa\x32\x7a\xa3\xcc\x8a\xab\x7f\xbe\x63\x02\xf3\xb8\x19\xff\x7d\x2b\x6c\x1a\xa9\x9b\xb3\xef\x4e\xaf\x40\xb6\xdc\x8b\x4d\x86\x74\x29\x63\x33\x06\x68\x25\xb6\xea\xb5\x13\x79\xa5\xe0\x2b\x31\xff\x5f\x30\x21\x12\xfc\x4e\x5c\xc0\x22\x00\xa8\xd9\x38\x88\x9c\x33\xb8\x57\x5e\x12\x54\x02\xb9\xe2\x7f\x34\xa1\x8c\x26\x14\xbc\x09\x05\xaf\x34\x6e\xdf\xe7\xd3\xb1\xe8\x85\xa4\xe4\x7c\x6c\xbb\x93\x89\x0d\x58\x22\x6c\xc6\x12\x04\x38\x24\x8a\x4b\x12\x4b\xd0\x17\x75\xe3\xce\x4f\xa0\x35\x12\x65\x58\x3a\x2d\xf8\x1a\xed\xa9\x79\x69\xf9\x45\xc9\xa9\x29\x6e\x24\xdf\x99\x4f\x66\x10\x17\x14\xe5\x27\xe5\xa4\xe6\xd2\xd1\x26\x67\xd0\x65\xc6\x56\x5c\xc4\xb7\xea\xb1\x46\x06\x86\x20\x78\x7d\x7f\x8a\x95\x42\x49\x51\x69\x2a\x44\xa0\x24\xbf\x08\x34\x75\x83\x24\x52\x9a\x54\x94\x0a\x69\xb0\xc3\x3d\x06\x8d\x52\x85\xea\x5a\x2e\x44\xec\x26\x26\x83\xae\xf5\x4d\x4d\xf1\x43\x94\x7e\xd9\x99\x79\x29\x56\x0a\x4a\x4a\x60\x6d\x05\x39\xa5\x45\x89\x39\x50\x6e\x72\x7e\x1e\x24\x29\x16\x5b\x29\x44\xc7\x72\x81\xae\x45\xce\x2f\x4a\x4d\x09\x83\xdd\xef\xa0\x10\x1d\xcb\x05\x18\x00\x66\x78\x4c\x55\x43\x07\x01\x00"),
 		},
 		"/logging.banzaicloud.io_loggings.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "logging.banzaicloud.io_loggings.yaml",
 			modTime:          time.Time{},
-			uncompressedSize: 833367,
+			uncompressedSize: 1053223,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xbd\x5b\x77\xdb\xb8\x92\x30\xfa\xde\xbf\x82\x0f\xf3\x90\x4c\x2f\x67\x66\xf6\xcc\x99\xb5\xa7\x5f\x7a\xb9\x9d\xb8\x77\xbe\xc4\x89\x8f\x95\x64\x3f\xf4\xee\xe1\x82\xc8\x92\x84\x23\x10\xe0\x06\x40\xd9\xea\xd3\xe7\xbf\x9f\x45\x52\x37\xdb\x12\x51\xb8\x50\x94\x1c\xd4\x53\xc7\x4d\x15\x6e\x85\x42\xdd\xeb\x87\x8b\x8b\x8b\x1f\x48\x49\xbf\x81\x54\x54\xf0\x9f\x12\x52\x52\x78\xd0\xc0\xeb\x7f\xa9\x37\xf3\xbf\xaa\x37\x54\xfc\xdb\xe2\x3f\x7e\x98\x53\x9e\xff\x94\x5c\x55\x4a\x8b\xe2\x0e\x94\xa8\x64\x06\x6f\x61\x42\x39\xd5\x54\xf0\x1f\x0a\xd0\x24\x27\x9a\xfc\xf4\x43\x92\x10\xce\x85\x26\xf5\x9f\x55\xfd\xcf\x24\xc9\x04\xd7\x52\x30\x06\xf2\x62\x0a\xfc\xcd\xbc\x1a\xc3\xb8\xa2\x2c\x07\xd9\x20\x5f\x0f\xbd\xf8\xf7\x37\xff\xd7\x9b\x7f\xff\x21\x49\x32\x09\xcd\xcf\xbf\xd0\x02\x94\x26\x45\xf9\x53\xc2\x2b\xc6\x7e\x48\x12\x4e\x0a\xf8\x29\x61\x62\x3a\xa5\x7c\xaa\xde\xac\xfe\xe3\xcd\x98\xf0\x3f\x08\xcd\x98\xa8\xf2\x37\x54\xfc\xa0\x4a\xc8\xea\x91\xa7\x52\x54\xe5\xe6\xf3\xa7\x5f\xb5\xd8\xd6\x53\x24\x1a\xa6\x42\xd2\xf5\xbf\x2f\xd6\xbf\xba\x20\xcd\xc0\x49\xd2\x6e\xc0\xc7\xf6\xaf\xcd\x5f\x18\x55\xfa\xc3\xee\x5f\x3f\x52\xa5\x9b\xff\x53\xb2\x4a\x12\xb6\x9d\x68\xf3\x47\x45\xf9\xb4\x62\x44\x6e\xfe\xfc\x43\x92\xa8\x4c\x94\xf0\x53\x72\xc5\x2a\xa5\x41\xfe\x90\x24\xab\xcd\x68\x66\x71\xb1\x5a\xee\xe2\x3f\x08\x2b\x67\xe4\x3f\x5a\x2c\xd9\x0c\x0a\xd2\x4e\x32\x49\x44\x09\xfc\xf2\xf6\xfd\xb7\xff\x1c\x3d\xfa\x73\x92\x94\x52\x94\x20\xf5\x66\x3d\x2d\xec\x1c\xf4\xce\x5f\x93\x44\x2f\xeb\x59\x28\x2d\xd7\x4b\x6b\xa1\x59\x32\xe6\xc3\xdd\xd3\x7f\xfa\xb1\x18\xff\x3f\x90\xe9\x9d\xff\xb1\x3e\x9d\x2d\xec\x9f\x6c\x33\x61\xc6\xc4\xfd\x6a\x77\xd6\x54\xa7\xae\xa5\x28\x2e\x19\xfb\x54\x9f\x5e\x49\xb2\xe7\xbf\x5a\x0f\x3d\x16\x82\x01\xe1\x4f\xfe\xef\x8a\x1a\x37\x3f\x3f\xf4\xeb\x67\xab\xac\x21\x87\x09\xa9\x98\xbe\x66\xe2\xfe\xf9\xef\x0e\xaf\xa3\x86\x09\x65\x1a\xe4\xde\xff\x95\x24\x54\x43\x71\xe0\x7f\x99\xf0\x6e\x56\x95\x11\x7d\xf8\xff\xe3\xb0\xac\x30\x69\xca\x2b\x51\xa9\x94\x51\x0e\xa9\x84\x29\x3c\x94\xdd\xbf\xe9\xdc\xb3\xc7\x30\x61\x95\x9a\xa5\x94\x6b\x90\x0b\xc2\x70\x68\xeb\xaf\xa7\xcd\xfd\x38\x0c\x73\x80\x32\x2d\x89\xd4\x94\xb0\x74\x0e\x4b\x1c\xe6\xfd\x24\xd2\x81\x79\x3f\xa9\x1f\x42\x8f\xd8\x0f\xf4\x54\x11\xb8\x8a\x8a\x69\xda\x1c\x1a\xf0\x3c\xf4\xc1\x6d\x91\x2b\x4d\xa4\x0e\x8d\x9e\x37\xd4\x66\xa0\x4d\x1b\x82\xb0\xa6\x05\xc4\x24\xd7\x38\x17\x84\x55\x7b\x18\x87\x23\x56\x05\x25\x91\x44\x0b\x19\x0e\xa3\x96\x40\x8a\x94\xe6\xc0\x35\xd5\xcb\xa0\x7b\xa0\x69\x01\xa2\xd2\x29\x23\x63\x40\xde\x5f\x04\xd6\x4a\x41\x3a\xa1\x52\xe9\x54\x6f\x5e\xfd\x60\x37\xb8\x46\xde\xd3\x05\x3e\xf0\xc8\x3d\x86\x1c\x72\x11\x84\x37\xe7\x90\xe6\x42\xa7\x1c\x94\x86\x3c\xdc\xfe\xac\xd0\x86\xa6\x43\xe4\xde\x68\xc8\xf4\xbb\x87\x0c\xca\x1d\x91\x71\x3f\x60\xb7\x89\x11\x3e\xad\xc8\xd4\xcc\x4d\x3a\x9f\xdd\xa7\x0b\x41\x50\xf1\xfa\x53\x22\x25\x59\x76\x73\x53\xf2\x90\x8e\x97\x3a\x24\xc3\xab\x51\x06\xe6\xa1\x05\x28\x45\xa6\xe1\x38\xdd\xf6\x0d\x71\x11\x03\x10\x03\x48\x28\xc4\x02\x52\x4d\xa6\x69\x29\x61\x42\x1f\x02\xb3\xd4\x63\xde\x0c\xe0\x33\xc2\x33\xf8\xf0\xd7\x20\x77\x82\x94\x34\x6d\x14\xa2\x13\xbe\x14\x63\x20\x12\x64\xaa\xc5\x1c\x78\x3a\xa1\x2c\x1c\xe1\x65\x04\x85\x0f\xbb\x99\x35\x14\xa2\xe2\xba\xd6\x45\xcc\x9f\xda\x21\x4e\x1a\x91\x20\x93\xa0\x3f\xc0\xf2\x0e\x26\xb8\x5f\xd8\x8f\x91\xe0\xa4\xcf\x5d\xb0\x38\xf3\x2d\x34\x5a\x6c\xdf\x83\x88\xe6\xf5\x30\x73\x92\xe7\x03\x61\x1e\xc8\x2d\x48\xf8\x67\x45\xa5\xf9\xf1\x5d\xc3\x45\xbd\xc5\xc8\x6f\x51\x5c\xc1\xf1\x73\x94\xc4\x9a\xd8\xee\x7e\x83\x35\xde\x01\x33\xc4\x3b\x30\xf8\x1d\x40\x7f\x9a\x91\x6c\x06\xa9\x84\x89\x04\x35\x0b\x27\x4b\x3d\x42\x9b\x2e\x88\xa4\x8d\x91\x33\xf4\x00\x8a\xfe\x81\x7c\x36\xf1\x38\xb5\x0e\x68\xa8\xc9\x18\x05\xae\xd3\x0c\x64\xa7\x4a\x94\xc4\xe7\xd8\x08\x91\x15\x9d\x27\x2b\x8a\xcf\x71\x07\xc4\x3b\xf0\x5d\xdc\x01\xfc\x73\xdc\xbe\x17\x08\xb2\x88\xcf\x45\x37\xc4\xab\x72\x9e\x57\x25\x3e\x17\x1d\x10\xef\xc0\x77\x71\x07\xf0\xcf\x85\x90\x90\x92\x92\xa6\xbb\xa1\x14\x5d\x30\x9c\xb9\x33\x27\x9a\xa4\xcd\xc7\xa1\xcc\x9c\x94\xa7\x7c\x1d\xd7\x90\x96\x44\x1b\xd5\xd7\xe1\x16\x4f\x79\x5a\x8a\xfc\xc4\x27\x39\xaf\xc6\x20\x39\x68\x50\x69\x25\xc3\x79\x29\x5a\xde\x98\xe6\x34\xa0\xc7\x57\xb1\x8d\x8b\x33\x9b\x11\x8a\xb4\x2b\x60\x58\xc7\x02\x24\x9d\x2c\x53\xa5\x90\x1b\x60\xc6\x89\xba\xcc\x53\x10\xb4\xd3\x07\x8c\x7d\x2d\xc6\x24\x9b\x03\xcf\x53\x46\xc7\x92\xc8\x70\x8e\xf0\x66\x82\xe9\x5f\xd2\xfa\x1a\x8f\x89\x0a\x77\x8b\x5b\xc4\x3d\xa1\x65\x42\xcc\xab\xb2\x16\xa7\x91\x7e\x42\x94\xd7\x2d\x13\x32\x0f\xc5\x69\x49\x9e\xd3\xf6\x29\xbc\xb5\x92\x07\xac\x5e\x5d\xeb\xa7\xc7\xcc\x2c\xd4\x9c\x96\x69\x3d\x79\x3e\x4d\x79\xc5\x58\xda\x6e\xcb\x71\x2f\x8d\x84\x20\x77\x86\x3c\x8d\xfc\x7b\x0e\xc8\xd3\xb4\x93\xe9\xe0\x21\x63\x55\x8e\x14\xb6\x90\x33\x70\x99\x47\x0b\xc7\x90\x2d\x4b\xa2\x35\x48\x23\xbb\xf6\x1c\xa7\x4f\xa1\xef\x62\xbd\x86\x7e\x84\x44\xfc\x05\x6c\x01\x17\x97\xd6\x42\xa4\xa0\x48\x41\x1e\xc8\xb1\x68\x91\x5c\xad\x17\x8e\x8a\xa6\x40\x4b\x9a\xb0\xa2\x3a\x2b\xdc\x78\x4a\xc3\xd1\x18\x9e\xba\x7a\x38\x7a\x73\x30\x5d\x7c\x47\x9f\x42\xe4\x82\x28\x88\xef\xe8\x41\x88\x14\x84\x82\x73\x7b\x47\x71\x24\x19\x9f\xd1\x06\x5e\xd0\x33\x8a\x42\x58\x12\xa9\xa0\xf3\xb5\xc5\x9e\x38\x14\x54\xa7\x94\x2f\x08\xa3\xf9\x4a\x89\x4f\xb5\x48\x41\x4a\x6c\x68\x3c\xc6\xac\x36\x23\x4d\x18\x10\xab\x20\x9d\x50\x60\x48\x3b\x01\xca\x08\x5c\xef\x53\x3a\x87\x65\xe8\x18\xe8\x1a\x25\xc6\x17\x62\x93\x4f\x63\x36\x70\xd9\x5c\xd4\x1c\x18\x2d\xa8\xee\xa6\x03\xeb\x69\x3e\xc2\x9c\x5a\x5c\x59\xab\x11\x40\x69\x5a\x10\x0d\x69\x56\x49\x09\x5c\xa7\xb0\x00\x6e\x8c\x91\x4a\xac\xdd\x40\xf0\x50\x4a\x50\xcf\xb3\x40\x03\x2c\x61\x22\x64\xd1\x9d\x85\xe8\x81\xb6\xcd\x0f\x62\x94\x87\xf7\x84\x36\x29\x7e\x9a\x16\x80\x09\x33\x48\xac\x77\xbc\x49\x95\x4a\xfb\xa3\x4d\x26\x32\xc2\x9a\xf9\x87\x9f\xfb\x26\x65\x03\x83\xda\x42\x0e\xb4\x7c\x27\x6d\x24\x8f\xc6\xf8\x0a\x45\xa9\x97\x69\x8b\x3f\xfc\xae\x34\x43\xb4\xcc\xbb\x2f\x76\xb0\xc2\x8b\xda\x4e\x8b\x7d\xb7\x97\xbe\xdd\xf9\x52\x0b\x2e\x4e\x6a\x3b\x1e\xb5\x3b\x8e\x95\x30\x8e\xe7\x57\xce\x43\x58\xb3\x96\xdd\x91\xec\x36\xcd\x8e\x0d\xb8\x8f\xe3\x74\xbf\x3c\x87\xb3\xbe\x6b\xbb\xe3\x59\x1d\x58\x73\x56\x47\x20\x0c\x57\x9a\xb0\x1f\x04\x13\x62\xe0\x3d\xca\x1f\x02\xf7\x44\xb8\x0f\xd2\xfb\x2a\x96\xa5\x8d\x49\xc2\x61\x84\x4a\x67\x7d\x5e\x15\xa7\xe8\x1b\xa4\x32\x6f\x77\x29\xec\x5c\xb1\xd6\x72\x97\x0d\x62\x2c\xd9\x58\x63\xc6\x12\xbc\xbd\x5b\xba\x0f\xa4\xe1\x3d\xe8\x48\x62\xb6\x21\x63\x34\x01\xb7\xba\x7e\xa8\x80\x03\x3b\x91\xc8\x4a\x8c\xb7\x66\x13\x4e\x6a\xa6\xf5\x28\x3e\x22\x9d\x2d\x5f\xb2\x15\xe5\x2c\x97\x62\xf3\x52\x3b\xa1\xb6\x53\x3b\xad\x07\x71\x90\x0f\x6d\x4f\xc0\x41\xfd\xb4\x5e\x86\xad\xec\x69\xbb\x06\x2b\x35\xd4\xd2\x21\x61\xfd\x92\xdb\x39\x52\x1c\xc5\x65\xdb\x1d\x72\x15\x93\xdd\x0c\xd6\xc8\xcd\xed\xdd\x31\xe4\xab\x9c\xba\xa9\x24\x6e\x0a\xaa\xa3\x5f\xca\x56\x17\x71\x1c\xc6\x51\x51\x75\xdd\x40\x17\x65\xd5\x75\x2c\x0f\x85\xd5\x6b\x48\x47\xa5\xd5\xf1\x00\x9d\x14\x57\x9f\xb1\x8e\xe1\x94\x75\x50\x60\x3d\x46\xb2\x53\x62\x5d\x07\x3a\xca\x6a\xec\x94\x59\xc7\x51\xac\x14\x5a\xb7\xab\xd4\x73\x94\x83\xf5\xa5\xb1\xb5\x5b\x3b\x48\x76\x76\xc8\xf1\xe4\xe4\x80\x1d\x7f\x21\x5c\xec\xf9\x7d\x21\xee\xc7\x05\x81\x26\x76\x3b\x32\xef\x25\x4e\xa2\xa9\x82\xb5\x76\xd7\xda\xf8\x97\x31\xb3\x96\x50\x32\x92\xc1\xc6\x45\xae\xe0\x9f\x15\xf0\x7d\x95\x54\xdd\x47\x50\x20\x17\x90\xe2\x0b\xf5\xd9\x60\xc5\x08\x1d\x41\xa3\xf2\x4b\x29\x0a\xd0\x33\xa8\x02\x55\xb7\x1b\x03\x33\x92\xb8\x5b\x16\x85\xc5\x95\x40\xd3\x6d\x01\x5a\xd2\x6c\x18\x63\xcd\xb8\xca\xe6\xa0\xfb\xe1\x07\x39\x28\x2b\x86\x60\x61\x12\xe8\xe7\xb9\xc0\x11\x4e\x0b\xae\x49\x38\x0e\xd3\xb2\x7e\xe3\xf1\xe9\xb8\xa7\xf1\x28\xd9\x44\x62\xd5\x44\x85\xf8\xac\xde\x02\xc4\x67\xf5\x34\x8f\xff\x00\xa1\x10\xae\xe2\xaa\x0a\x91\xd3\x09\x0d\x13\xb7\x95\xcd\x88\x4c\x81\x67\x22\x47\xa8\x78\xf8\x18\x25\x09\x25\x91\x10\xb8\xe6\x6f\x4c\x99\x4b\x1e\x09\x2a\x21\x93\x11\x1b\xe9\x64\x98\xf7\xa6\x67\x63\x77\x5f\x2f\xc3\x6a\xcf\x06\xe6\x7d\xdb\xcd\x43\x7c\x8c\x0b\x59\x5d\x2d\x6c\x08\xda\xbe\x9f\x51\x0d\x8c\x2a\x1d\x92\xbc\x6d\x58\xab\x96\x84\xab\x5a\xb5\x0d\xc3\x5d\x49\xa5\x45\xa3\x6c\x66\x44\x19\x75\x65\xbc\x50\x0e\x9c\x8c\x19\xa4\xb2\x1a\x87\x2e\xd9\x1f\x53\x9c\xcf\x84\x5f\x73\xb8\x0f\x9c\x2b\xbd\xc6\x8a\xb5\xbe\x84\xbc\x7d\x4a\xe7\xa2\x0a\x52\xed\x5d\x54\xba\xac\x74\xc8\x0a\x21\xb8\x05\x54\x85\x60\x62\x4a\x3b\x95\x1b\x7c\x37\x11\xc6\x20\xd3\x42\xa6\xc1\x83\xc7\xb7\xa8\xc3\x8a\x66\xab\xdc\xb9\x34\x13\x5c\x13\xca\x41\xb6\xad\x2e\x82\xe3\x9f\x90\x8c\x32\xaa\x97\x3d\xa1\x9f\x09\xa5\x7b\x42\xbd\xad\x31\xd3\x0f\xfe\x52\xe4\x7d\x61\x96\x54\xc8\xfe\xf6\xbc\xe2\x34\xf4\x9e\x33\x31\x45\xda\xa7\xd1\x28\xdb\x3e\x4a\xe9\xaa\xe7\x55\xb8\x82\x28\x4f\xf0\x86\xbf\xf1\x4f\x07\x08\x5d\xe2\xff\x09\xfa\xb5\x9d\x33\x27\xd8\x3a\xc0\xf8\x41\xea\xdb\xd9\x07\xce\xde\x36\xbd\x2f\x9c\xe1\x27\xac\x25\xc9\x28\x9f\xa6\xdb\x3e\x74\xa1\x09\x65\x3d\xc2\xf6\x85\xe8\x65\x01\x36\xd7\x1e\x23\x13\xad\xf1\x06\xa5\xbd\x35\xd2\x36\xc8\xa8\xa7\x8d\xe6\x87\x9b\xb5\x79\x62\x2e\x45\xb8\xc4\xbb\x1d\x9c\x29\x0d\x85\x16\x25\xb2\x69\x32\x4d\x79\x4d\x2d\x8c\x06\xca\x81\x0c\xfa\xe4\xe0\xd6\x30\x93\x42\xeb\xee\x1e\x1d\xd8\xd9\x37\x8d\x4e\xd2\xd6\xf8\x9f\x36\x61\x6f\xb8\x95\x60\xea\x97\x3f\xc2\x5d\x82\xa4\x22\x4f\x03\xb6\xdc\x69\xd1\xe7\x52\x94\x29\x13\x53\x24\x62\xcc\xed\x6f\x11\x87\xec\x04\xd6\x62\x94\xa0\x40\xa7\x92\x68\x08\xbf\x0d\xf7\x44\xf2\xfa\x46\xe5\xc0\xc8\x32\x1c\x7a\x04\x3d\x1a\x3f\xe9\x56\x99\xa7\x4c\x8c\x09\xfb\xdc\x28\x70\x77\x30\x71\xe9\xfa\x68\x3c\x86\xee\x19\x88\xc1\xc6\xee\xd8\xba\xd6\xd8\x73\x07\x4d\x87\x57\xf8\xbb\x90\x73\x26\x48\xfe\x99\xbf\x2f\x8a\x4a\xd7\xff\xeb\x9a\x02\xcb\xaf\x66\x84\xef\x6b\x3a\xd5\x45\xeb\x13\x26\xee\xaf\x04\x9f\xd0\xe9\xd5\x0c\xb2\xf9\x5b\xaa\x6a\x74\x7b\x98\x30\x0e\xc9\xe7\x05\x48\x49\xf7\x15\x50\xe9\xd8\x9a\x09\xab\x80\xeb\xf1\x3e\x66\xd3\xcd\xb8\xc8\xa4\x69\xa4\x7b\xe0\x66\x9a\x99\x1e\x17\x39\x5c\x76\xe2\xc0\xe1\x69\xbf\x82\x09\x48\x09\xf9\xdb\xaa\x5e\xe2\x28\x9b\x41\x5e\x31\xca\xa7\xef\xa7\x5c\x6c\xfe\xfc\xee\x01\xb2\xca\xdc\xbe\x02\x65\x0e\xb3\xb1\xb0\xb7\xb3\xc3\x04\x1b\xd8\x62\x4e\x9a\x0e\x6a\x3a\x9b\xbd\xdb\x58\xa1\xd1\x0e\x4f\xab\x28\x57\xb7\x38\x57\xeb\x12\x28\x8e\x11\x5d\x4d\x4f\x61\x54\x13\xc0\x20\x83\x35\x96\x1b\xab\x6d\xb0\xde\x6d\xaf\xf9\xd9\x86\x93\xb5\x60\x5b\xe3\xc5\xae\xca\x4b\xfd\xf5\xfa\x90\xd0\x3f\xb1\x0e\xa3\xb3\x5f\x79\x73\x7b\x1a\x06\x1e\x2f\x4e\xef\x83\xc5\x8b\xd3\xc2\xf9\x5f\x1c\xab\x01\xee\x81\x4e\x67\xa8\x48\xd5\x95\x02\x57\x4b\xc3\xff\xf9\x17\xf4\x34\x30\xa2\x39\xfe\x98\x2e\x76\xde\x6b\xe3\xa7\xed\xd2\x50\x12\x3e\x62\xb3\x70\x67\xb0\x5e\x48\x08\x59\x07\xcf\x9c\x6a\x71\x6d\x04\xad\xef\xe2\x0b\x48\xcc\x9d\x44\x5f\xdd\x28\xf2\x44\xce\xed\x37\xbf\xc8\xb9\xa3\xc8\x83\x85\x78\x71\xf6\xfc\x30\x5e\x9c\xa0\x03\xe0\x63\x53\x30\x1b\x79\xf1\xfc\xf5\x45\xc8\x11\x26\xd3\xb5\xf9\xa3\x52\xe4\xdf\x89\x85\x66\xbb\xd0\x7a\x77\xfb\x90\x59\x1a\x57\xd3\xfa\x08\xb1\xf7\xc6\x85\x91\xba\x0a\x47\x89\x1b\xe7\x71\xe3\xf5\x89\x0b\xbf\x4f\x7c\xd8\x9c\x2b\xdf\xf7\x1c\xd4\x85\xff\x27\xae\x6f\x40\xe2\x37\x57\xb7\xb7\x20\x71\x7a\x0f\x12\xeb\x37\x21\x71\x7b\x17\x12\xb7\xb7\x21\x71\xdc\x8d\xe6\xf6\x7d\xb4\xc8\x59\x69\xc1\x27\x73\x65\x77\xb2\xd6\xe7\xee\xfc\x6c\x5a\xfc\x64\xe3\x08\x8f\xec\x6f\x07\x22\xfb\xeb\x80\xc8\xfe\xf6\xff\x22\xb2\xbf\xce\xc9\x9e\x36\xfb\xeb\x4b\xdd\x76\x58\xbb\xed\xd9\x6a\x51\x0a\x26\xa6\xcb\x0f\x78\xa6\x65\x9d\xcd\x83\xbf\x43\x17\xbb\xf3\xf9\xee\xad\xd5\x8f\x75\x97\x17\x6f\xb2\x0e\xae\xfc\x59\x6b\x66\xd1\x5c\x1d\xad\x6e\x7e\xf3\x8b\x56\x37\x4b\x73\xb5\x9d\x48\xe1\x27\x4e\x38\x3f\xa7\xfd\xb4\x51\x77\xd2\x9e\x22\x8b\x8a\x2c\xca\x6f\x7e\x91\x45\x45\x16\x65\xcd\xa2\x42\xf7\x7d\xb0\x5c\xa7\xcd\xb9\x59\x6a\x34\x16\x33\xc1\x8b\xee\x78\x2d\x26\xa8\x3c\x8e\xf4\xfc\x70\x4d\xa3\xf7\x27\x00\xfa\x24\x7a\x7f\xf6\x40\x34\x7f\x76\x40\x34\x7f\xee\xff\x45\x34\x7f\x76\x4e\xf6\xb4\xcd\x9f\x91\xfd\xed\x40\x64\x7f\x1d\x10\xd9\xdf\xfe\x5f\x44\xf6\xd7\x39\xd9\xd3\x66\x7f\xd1\xfb\xd3\x01\xd1\xfb\xf3\x08\xa2\xf7\xe7\x10\x44\xef\x4f\xa0\x69\xb5\x10\x4d\xab\x2b\x88\xa6\xd5\x68\x5a\xdd\x99\xe0\xe9\x99\x56\x23\x8b\xc2\x42\x64\x51\xfe\xf3\x8b\x2c\x2a\xb2\xa8\xe8\xfd\x71\x99\xc9\xf9\x7b\x7f\x0c\x1f\x6c\x2b\xd4\x1d\x38\x46\x1b\xe2\x36\x6e\xac\x61\x32\xe3\x6a\x32\x01\x39\xd2\x42\x92\x7d\xc5\x77\x12\xd4\xab\xa3\xda\x9f\xbf\x19\x93\x6c\xce\xc4\xf4\x4d\x01\x85\xa9\xfe\x16\x82\x20\xd6\x58\xb3\x19\x64\x73\x55\x75\xb8\x95\x2c\x90\x95\x44\x77\x54\x75\xb4\x40\xa4\x96\xbc\xa3\x54\x6e\xd0\x63\xf9\x26\x58\x75\xa8\xd0\xa0\xf9\x70\x9a\x0e\x78\x6f\x69\xc7\xb3\x8c\x13\x2b\x0a\xc8\x69\xd7\x21\x24\xf8\x6b\xae\xe8\x1f\xf0\xd1\x5c\x9f\x8d\xf0\xe5\xe7\x49\xf7\x27\x17\x16\x56\x81\x0b\x3c\x17\x5a\x37\xef\x4b\xfe\xf7\xd5\x3f\x7e\xfc\xf3\xe2\xf5\xcf\xaf\x5e\xfd\xf6\xef\x17\xff\xf3\xfb\x8f\xaf\xfe\xf1\xa6\xf9\x8f\x7f\x7d\xfd\xf3\xeb\x3f\xd7\xff\xf8\xf1\xf5\xeb\x57\xaf\x7e\xfb\x70\xf3\xeb\x97\xdb\x77\xbf\xd3\xd7\x7f\xfe\xc6\xab\x62\xde\xfe\xeb\xcf\x57\xbf\xc1\xbb\xdf\x91\x48\x5e\xbf\xfe\xf9\x5f\x3a\xa7\xf5\x70\x31\xaf\xc6\x20\x39\x68\x50\x17\x94\xeb\x0b\x21\x2f\x56\xad\x0d\x13\x2d\xab\xc3\xd5\x16\x10\xfc\xaf\x29\x23\xda\x7d\x3b\x90\xae\xea\x4e\x1c\x09\x9e\x4a\x9a\xcf\xfc\x11\x99\xdf\x93\x8b\x66\xce\xbe\x9b\x77\x1b\xf7\xee\xd0\x28\xdd\x91\x12\x8b\x0e\x3e\x8a\xdb\xb6\xb6\xb0\x6c\xf7\x7a\xf1\xba\x53\xc6\x08\x2d\x3e\xa1\xba\x0d\x59\x89\x35\x24\xff\xcc\x19\x42\x5a\x6a\x91\xe2\xca\xdd\xe3\x92\x9f\x37\x2b\x42\x50\x84\x51\x48\x52\x25\x18\x1a\x60\xe1\xb7\x9a\x64\x19\x28\x75\x23\x72\x8c\xe8\x8b\x16\x7c\xad\xc4\x5e\xbc\xd0\x9b\x13\x4d\x46\x08\x4a\x4b\xac\x35\x75\x52\xd2\x5f\xa5\xa8\xca\x5e\xa4\xfa\x39\xe5\x48\x7d\xd2\x12\x71\x4f\x0d\xb9\x6c\x7a\xc7\xd4\x6b\x43\x7c\x86\xea\x9b\x65\xa1\xb2\x49\x68\x39\x0e\xaa\x74\xa1\x0d\x21\x34\x42\x33\x52\xb9\xf3\x51\x81\x11\x92\xd5\x16\x6c\x64\xac\xe7\xbf\xb2\xd2\xb2\x4f\x54\xee\xda\x82\xb3\x04\xb6\x0b\x96\xb6\x84\xfa\x42\x80\x8a\x64\x11\xc9\xc2\xed\x63\x85\x36\x7c\xdb\x31\x2b\x37\xa3\xb7\x95\x11\xd6\xc5\xe0\x7d\x8c\xfe\xe4\x2e\xa6\x6e\xa7\x81\xec\xcd\xdc\x0e\x46\x6e\xaf\xe0\x0f\x1b\x03\xb7\xad\x79\xdb\xc6\xb8\x6d\x6d\xda\xb6\x36\x6c\xdb\xad\xd7\xda\xa8\x7d\xba\xbd\x57\x6d\x78\x4d\x6b\xb1\xba\x62\x44\xa9\xe0\x2a\xd4\xa2\xb1\x83\xd5\xda\x42\x0f\x68\x03\xcf\x16\xb5\x67\xbe\x96\xe4\x4c\x48\x31\xd2\x24\x9b\x8f\xe8\x1f\x07\x26\x6f\x0e\xb2\x31\xbd\xe2\x59\xa5\xb4\x28\xda\x02\xe9\x23\xc8\x24\x1c\xb0\xda\x19\xb6\x26\x6f\x6b\xb3\x7f\xd8\xbc\x93\xd7\x94\xe9\x43\x5d\x2b\x4c\x2a\x70\x5b\x51\xfe\x6b\xa9\xb4\x04\x72\xc0\x26\x69\xc6\xb1\xf8\x46\xe4\x81\x2b\xd6\xc9\x45\x31\xaf\x92\x49\x39\x42\x11\x92\xb1\xc3\x19\x1e\xcb\xb5\x14\x9d\xb6\x5b\x7c\x67\xb7\x9a\x0e\x6e\x48\xf9\x01\x96\x77\x60\x14\x16\x6d\x1e\x70\xe4\xc3\x6d\xc5\xeb\xb0\x3a\xaa\x15\x52\x51\xb6\x7c\x1a\x8f\x18\x63\xcc\xb1\xf1\x7c\x99\x9f\x45\x34\xcf\x9e\x50\x60\x79\xe0\x93\x24\x25\xfd\x06\x12\xdb\x0f\xd7\x6a\xef\x9b\xe9\x76\x9b\x5a\x9d\x30\xe3\xf7\x7e\x33\x83\x50\x27\xb0\xb6\x26\x5c\xf7\x70\x12\x9b\x5e\x55\xb8\xe7\xcd\xf2\x30\x72\xba\xa0\x0a\x27\x05\xa3\x55\x4b\x7b\xb5\xd2\x5a\xa5\x3c\x69\x75\xd2\x5b\x95\x5c\x93\xd3\x60\x17\x64\x3d\x81\x50\xf7\x43\x35\x42\x47\x7c\x6f\x5e\xc2\x7b\x83\xf8\xcc\x34\xab\x4e\x73\x2e\x52\xa2\x3e\xa4\xc4\xc1\x83\x96\xe4\xdb\x4a\xcd\xa8\xf8\x21\x9b\x9b\xb7\x74\x98\x83\xd2\x94\x13\x53\xb4\xf6\x96\x51\xfd\xdb\x9b\x1f\xbb\x98\x07\xd2\x99\x67\xf6\x40\xe1\xc8\xc7\xcc\x60\x42\xce\xdc\x4c\x10\x3b\xdb\x79\xf0\x9b\x4e\x9e\xe4\x49\x36\x93\x46\x95\xb9\xbc\x3f\x70\xe2\x66\x8a\xb8\x21\x3a\xf3\x0b\x4b\x21\x59\x56\x13\x6c\x67\x07\x42\xcc\xe1\x92\x82\xfa\xe3\xf8\xc3\xef\xf7\x90\xfd\x25\xa5\x5c\x69\xc2\x33\xf0\x9e\xcc\x23\x64\xdd\x6e\x71\x0c\xba\x99\x50\xba\x9b\xc9\x63\xb0\xd0\x22\x57\xe9\xc2\x24\x22\x23\x8e\xbd\x94\x74\x41\x34\xa4\xb4\xc3\x77\x88\x99\xd0\xa2\xcc\x3c\x77\xda\x70\x85\xda\x2b\xb2\xd5\xfe\x5d\x6f\xca\xa5\x29\x78\x2e\xc1\x6d\xdc\x2f\x4d\x80\x55\x7a\xd8\x70\x82\xc4\xf3\xf6\xd3\x28\xbd\x03\x2d\x3b\xd9\x3d\x12\xcf\xdf\x09\xd5\xe9\x17\x6a\x26\xae\x6e\x4c\x55\x51\x2c\xd3\x1b\xd0\xc4\x0b\xcd\x87\xbf\x8e\x2e\x3e\x8a\xe9\x94\xf2\xe9\x9b\x77\x6d\xa3\xeb\x60\xf8\x6e\x89\xec\x6c\x58\x8a\x41\x07\x50\xa6\x1f\xc5\xd4\x0f\x49\x35\x86\xf4\xea\x32\xbd\xa6\x5d\x0d\x48\x2d\x10\x75\x2b\xa6\x58\x44\x5f\xc8\x34\xbd\x35\xb4\xd4\x45\xe3\x12\x73\xe0\x81\x16\xf8\xf5\xee\xa3\x3f\x92\x02\x34\x49\x4b\x09\x4c\x90\x3c\xcd\x48\x36\x83\x34\xef\x0a\x4e\x44\xe2\x65\xa0\xd3\x5b\x21\xfd\x22\x4e\x4d\xe6\x7a\x04\x0a\xff\x97\xfc\x06\xe4\x14\xbc\x49\x7b\x83\x25\xed\x8c\xd0\xb6\xc2\xf4\x45\x52\xbf\x20\xdc\x16\x55\x80\xdb\x7f\x07\x53\x78\x08\x81\xe8\xab\x82\xf4\xff\x88\x4a\x76\x6a\x57\x48\x3c\x2b\x32\xf4\xc2\xa3\x99\x7a\x93\xc3\xb8\xf2\x3b\xfc\x1a\xcb\x02\x24\x9d\xf8\x9c\xbc\xe9\x41\x67\xd5\xa1\xfe\xf8\xfe\x3e\x87\x89\x90\xf7\x44\xe6\x9f\xcb\x8e\x87\xde\x2c\x2b\xdc\xb5\x8a\x43\x4a\xb2\x79\x2a\x41\x95\x82\x2b\x4f\xb9\xad\x7e\xe9\x97\xa9\x21\x74\x19\x71\x40\x23\xe0\x79\x2a\xba\x56\x87\x9d\xd1\x17\xe2\x47\x2a\xb5\xb8\x91\x12\x95\xbe\x6f\xcf\xa2\x47\x09\x70\x2a\x0f\x76\x72\xf7\x27\x18\x5a\x78\xa4\x2f\x34\x3f\xbe\xad\x18\x6b\xdd\x5b\x1d\x27\x62\x74\xae\x63\xed\x4f\x18\x3b\x91\x4d\xa8\x2f\xce\xd3\xd8\xe5\xbb\x2e\x2b\xc6\x6e\x05\xa3\x99\xdf\x6b\x21\xa1\x14\x8a\x6a\x21\xfd\xd0\x68\x2f\xb2\x36\x6c\x09\xe5\x65\xa5\xbf\x10\x7a\x80\xe5\x9b\xcf\x70\xa5\x3c\x5c\xcd\x2a\x3e\xf7\x57\x21\x56\xd8\x6e\xc8\x43\x00\x75\xe4\x17\xcf\x9f\xa7\x23\xbf\xbc\x96\x24\x79\x2b\xb2\x79\xbd\x9c\x4e\x37\xbd\x1d\x9e\xf4\xfa\xf0\x63\x83\xc4\xb6\x52\x61\xfc\x25\xf4\xb6\xe6\x42\xfa\x99\xe5\xde\x1a\x8c\xaf\x5c\x56\xa4\xbf\x54\x93\x00\xaf\xd1\x4d\xc5\x34\x65\x94\xfb\x9d\xd7\x06\x4b\x80\xd3\x0a\x20\xd9\xb5\x28\xd2\x6b\x2a\x95\xf6\x5e\xdb\x0a\xd9\x27\x8f\x97\x01\xc5\xcd\xcd\x6c\xda\x9b\x7e\x6b\x04\xde\x3a\xc1\x1d\x4c\x24\xa8\x59\x23\x35\xc8\x85\xa7\xec\x7c\x27\x34\xd1\xd0\x58\x3f\xfc\x84\xaa\x39\x2d\xd3\x8f\x82\x4f\xd3\x8f\x94\x7b\x9a\x63\xbc\xc5\x2a\x32\x4d\x1b\x25\xc5\x0b\xcb\x3a\xd7\x10\x63\xb6\x74\x7f\x0e\x59\x87\xd6\x7b\xcc\x54\x54\x46\x17\xc0\x41\xa9\xb7\x30\x21\x15\xd3\x57\x33\xc8\xe6\x6e\xa1\x3d\x6b\x4c\xb7\x52\x8c\xdd\xf3\x26\x1f\xba\xf2\x5c\x70\x82\x5e\x26\x8a\x82\x98\xd2\x2f\x50\x11\x9b\x96\x81\x67\xde\xf5\x6b\x27\x84\xb2\x4a\xc2\x97\x59\x7d\xcf\x05\xeb\x58\x02\xae\x8c\x13\xc6\xa9\x3f\xd3\xba\xfc\xb5\x4b\x81\xc6\x6d\xfa\x4c\x28\x43\x62\x29\x72\x33\xeb\xf9\xfc\x0d\x48\x0e\x87\xa2\xc4\xd6\x10\xbc\xa6\x52\x2f\x6e\x64\x63\x34\x99\x03\x56\xbc\x0b\x19\x91\x83\x73\xd1\x4e\x11\x75\x13\x82\xd5\xd6\x0a\x96\x72\x59\x76\x5a\x1f\x93\x41\xf3\x99\x3d\x82\x4a\x54\x36\x03\x13\x31\x22\xdd\xcf\x88\x5c\x52\x21\xbd\xfa\xad\x52\x4e\x35\x25\xec\x2d\x30\xb2\x1c\x41\x26\x78\x57\x87\xe6\x70\x8c\xab\x04\x49\x45\x7e\xc4\x01\x55\xd5\x24\x4e\x1e\x95\x3b\xeb\xac\x1c\xd5\x2a\xd9\xe9\xf0\xe7\x17\x79\xe3\x8e\x71\x4d\x34\xc8\x62\x15\x2f\xf1\xab\x24\x19\xdc\x5a\xd3\xef\x7f\xff\x97\x1f\x2d\xd1\x02\x44\xa5\x8f\x76\x63\x4c\x92\xa7\x98\x7e\x84\x05\x1c\x50\x63\x0c\x67\x5e\x80\x96\x34\x73\x36\x52\xd3\x10\x2a\x94\x77\x55\x93\xee\xab\x14\x90\x53\x4a\x51\x80\x9e\x41\xa5\x2c\x1c\xf9\xdd\x16\x70\x05\x72\x41\x33\xb8\x11\x9c\x76\x66\x64\xd9\xe3\x6a\x73\x1e\x7c\xd9\xdd\x56\x85\xc2\x64\x03\xb9\x64\x01\x59\xea\x06\x46\xd1\x69\x26\xb8\x90\x98\xc9\x62\x83\x0d\xdb\x2b\x72\x07\x8d\xa6\x49\xf9\xf4\xd8\xe2\x34\xc9\xcc\x75\x51\x5b\xb0\x12\xa8\x0b\x91\x57\xac\x42\x25\x69\xe1\x58\xe7\xd3\x79\x60\x63\xa0\x65\xb7\xa5\xe1\x29\x5a\x74\x22\x7e\xc9\x48\x06\x05\x70\x54\x39\x5f\x2b\xdc\x0a\x4a\x82\xce\xa0\xb4\xc3\xdc\xc4\xf9\xe1\x13\xef\x4e\xa4\x9c\x1a\x91\x53\xd0\xcd\xac\x03\xef\x48\x60\x75\x49\xc6\x4b\x1c\x2f\xf1\x0a\xe2\x25\x7e\xf2\xed\x79\x5c\x62\x8c\x82\xd0\xca\xe7\xfd\xd9\x9c\x0b\x51\xf1\x8e\x8a\x59\x06\xf4\x1c\xf4\xbd\x90\x07\x8c\xc3\x66\x9e\x92\x09\xce\x21\xd3\x5f\x4c\x8b\x0c\x27\xf6\xce\x01\x4a\xc2\xe8\xc2\x33\xfc\x64\x83\xe6\x7d\xce\x60\x88\xe9\xdf\x90\x87\x3b\xc8\x96\x59\x57\x34\xe1\x91\x74\x36\x2e\x72\x43\x49\xe7\x63\xfa\x2e\xca\x0e\x87\xa5\x01\x77\x29\xf2\x5b\x49\x85\xa4\x7a\x69\x48\x9b\x37\x22\x52\xcd\x7a\xd3\x7c\xec\xec\xf3\x88\xb5\x22\x1f\x7f\x1b\x6b\x45\xee\x83\x58\x2b\xb2\x7b\x94\x58\x2b\x72\x2f\xc4\x5a\x91\xb1\x56\xe4\x53\x88\xb5\x22\x63\xad\x48\x77\xc4\xb1\x56\x24\x6e\x53\x63\x51\xc0\xef\xa2\x28\x60\xac\x15\x19\xc9\xc2\xef\xe3\x58\x2b\xb2\x0b\x1c\xc8\x31\xd6\x8a\xdc\xf9\x59\xac\x15\xb9\x1f\x62\xad\xc8\x58\x2b\xb2\xef\x5a\x91\x6b\xeb\x60\x34\x0e\xee\x85\x68\x1c\x8c\xc6\xc1\x13\x30\x70\x45\xe3\x60\xe7\x28\xd1\x38\x18\x8d\x83\x7b\x21\x1a\x07\x1b\x88\xc6\xc1\x1d\x88\xc6\xc1\x68\x05\x3a\x31\xb9\x6b\x0b\xd1\x38\xb8\x81\x48\x16\x5b\x88\xc6\xc1\x68\x1c\xec\x65\xa0\x68\x1c\x8c\xc6\x41\xf4\xd4\xa2\x71\xd0\x03\xed\x39\x1a\x07\x6b\x9d\x92\xc6\x8a\x09\xb1\x62\x42\xf7\x64\x62\xc5\x04\x7f\xac\xb1\x62\xc2\x16\xd1\x4b\xcc\xdf\x8e\x15\x13\x30\xe7\x11\x2b\x26\xb4\x10\x2b\x26\x6c\x20\x56\x4c\x38\xa3\x8a\x09\x06\xc3\xa5\x99\xac\x4d\x06\x4a\x5b\x25\xc6\x48\xb7\x78\xaa\x45\xd3\xec\x49\x5a\x93\xfa\x74\xed\x9a\x0d\x88\xf1\xd8\x4e\xee\xd8\x0c\x1f\x28\xc8\x2a\x49\xf5\x01\x53\x97\xf9\x22\x97\x0d\x1b\x6c\x50\x5c\x09\xae\xe1\xc1\xfb\xb5\x9b\x28\x84\x0f\x0b\x9f\x8b\x8d\xa5\x9f\xd5\xb0\x57\x33\xc2\xa7\x60\xaa\x84\x9c\xe0\xdf\x5c\x59\xf1\xcb\x61\x56\xd4\x8c\xfc\x49\xf0\x3b\x21\x50\xe2\x83\xd9\x53\xdc\x60\xfc\xda\x59\x9a\xb5\x9f\xa5\x28\xf8\x48\x79\xf5\xd0\x59\x10\x7e\x0d\x78\xb5\x91\x1d\x2e\xfa\xf3\x7c\x92\x38\xed\x4e\x74\xa5\xc6\x3a\x20\x34\xc7\x5f\x58\x22\xac\x8c\xa7\x67\x85\x10\xeb\xeb\x87\x2c\x13\x45\x79\x2b\xc5\xa4\xb3\x15\x49\x62\x77\x7e\x22\x23\xac\x09\x99\xc1\xe0\x4d\x06\xdd\x79\x6c\x88\x45\x8d\x30\xc8\x8e\x57\x65\xc9\x9a\xba\x0f\x84\x35\xdc\x27\x84\x45\xc6\xa6\x02\x06\x5e\x49\xc0\x99\x1d\xd4\x52\x65\xda\x64\x03\x8f\x76\xa5\xf3\xb5\x2b\xdd\x53\x9e\x8b\x7b\x15\x98\xc7\x4f\x0b\x45\xae\x24\xe4\xc0\x35\x25\x6c\x64\x8c\x39\x4a\xec\xb6\xfd\x39\xfa\xf0\x01\x5f\xeb\x47\xf7\x24\x3d\x0b\x8f\x24\xc0\x56\x6c\xba\x92\x40\xb4\x67\xa9\x8b\xfa\x21\xfd\x85\x28\xc8\x2f\x1b\xbb\x4d\x2d\x5a\x4a\xc1\x42\x60\x56\x61\xa5\x55\xc2\x98\xb8\xbf\x95\x74\x41\x19\x4c\xe1\x9d\xca\x08\x33\xf6\xf5\xc4\x0b\x5c\x19\x29\xc9\x98\x32\x6a\xa6\x74\x8b\x58\xb9\x1c\xe1\x37\x1d\x3c\x46\x4e\x0a\x44\x14\xdb\xb0\xb3\x44\xf2\xbf\x72\x4d\x1c\x86\x6d\xc7\xd2\x44\x29\x45\xd6\x74\xa7\x0d\xa3\x9e\xac\xc2\x49\x6b\x25\xe1\x9a\x32\x50\x4b\xa5\x01\x15\x9d\x8f\x54\x17\xa2\xea\xe3\xb7\x94\xa8\xfa\x84\x42\x18\x55\x9f\xef\x43\xf5\x89\x82\xe4\x7e\x38\x75\x41\x72\x55\x7d\xf7\xb2\x6d\x65\x6d\x92\xf2\xdc\x8b\x75\x61\xc6\x31\xd3\x05\xa9\xb4\x68\xca\xd8\x8d\x1e\xa1\x6b\x1a\xae\xfa\x49\xa8\xb1\x1f\x5d\x5b\x33\x98\xe4\xa4\xab\x89\x31\x52\x3e\xc7\xd4\x99\x4e\x4e\xa4\xf2\x72\x57\x2b\x9e\xd3\x99\x27\xea\x2a\x1f\x8b\x72\x49\x49\xbf\x99\x1a\xa9\xef\x4e\x1b\xb1\x09\x13\x0a\x2c\xef\xce\xc2\xb3\xc4\x88\x49\x9d\x41\x23\x0b\x78\x57\x5b\x64\xaa\x3c\xd8\x99\xd3\x01\xe3\xda\x35\x1c\xfa\x54\xaa\xae\xfe\xf4\x56\xb8\x82\x70\x28\x03\x92\xb6\x16\xec\xdf\x0e\x06\x6e\x18\xa6\xda\xfe\xfc\x70\x43\x6b\xff\x8e\xa9\x07\x4d\xa9\x88\x38\x4f\x4e\xc6\xac\x4b\x9c\x43\x9a\x61\x24\xe8\x6e\x49\x04\x71\x9e\x6a\x46\x24\xe4\x7e\xfd\xec\xba\xe5\xd3\x8b\xf5\x7a\x9d\xc8\x40\x30\x90\x5d\xaf\x4f\x27\x1f\xc4\xf0\x40\x98\x4c\x20\xeb\xd4\x71\x51\xb7\xc2\x90\xec\x80\xc2\x81\x49\x66\x40\x21\xda\xee\x9a\x31\xde\x05\xaf\x71\xe3\xf4\x6d\xa3\x69\x1e\xb1\x00\xa4\x4b\x7e\x3f\x6f\xe9\xf8\xf1\x84\x55\xc0\xf5\x1e\x32\xed\xa6\x13\x32\x99\x50\xee\xe1\xe1\xe7\x22\x87\xcb\x4e\x1c\x38\x3c\xed\x57\x30\x01\x29\x21\x7f\x5b\xd5\x1b\x38\xca\x66\x90\x57\x8c\xf2\x69\xdb\x56\x75\xf5\xe7\x77\x0f\x90\x55\x66\x23\x6a\x70\x67\x4f\x3b\x3b\xe0\x98\xc4\x5c\xfb\x9c\x22\xb7\x34\x27\xeb\x44\x1c\x97\x54\x27\xeb\x64\x27\xe7\x5c\x1f\x97\x84\x27\xe7\xc1\xec\x93\x9e\x9c\xd2\x9e\x9c\xe7\xe7\x92\xfa\x64\x9f\xfc\x64\x97\xfe\xe4\x90\x00\xe5\x90\x02\x65\xbf\xf2\xe6\xf6\x5c\xd7\x92\x79\xbc\x38\xbd\x0f\x16\x2f\x4e\x0b\xe7\x7f\x71\xac\x06\xb8\x07\x3a\x9d\xa1\xfa\x84\xe0\xc2\x8c\x9f\x4e\x03\x97\xe1\x8d\x8f\x64\xd8\xbe\xd7\xc6\x4f\xdb\xa5\xa1\xf4\xc7\x80\xbd\x72\xda\x85\x84\x90\x75\xf0\xcc\x69\xb7\x31\xc2\x17\x90\x98\x3b\x89\xbe\xba\x51\xe4\x89\x9c\xdb\x6f\x7e\x91\x73\x47\x91\x07\x0b\xf1\xe2\xec\xf9\x61\xbc\x38\x41\x07\xc0\xa2\xc6\xfa\xb0\x9f\xbd\xbe\x08\x39\x22\x48\x08\xde\x77\x62\xa1\xd9\x2e\xb4\xde\xdd\x3e\x64\x96\xc6\x07\xd7\xdd\x59\xca\x7f\x90\xc4\x43\x38\x4a\xdc\x38\x8f\x1b\xaf\x4f\x5c\xf8\x7d\xe2\xc3\xe6\x5c\xf9\xbe\xe7\xa0\x2e\xfc\x3f\x71\x7d\x03\x12\xbf\xb9\xba\xbd\x05\x89\xd3\x7b\x90\x58\xbf\x09\x89\xdb\xbb\x90\xb8\xbd\x0d\x89\xe3\x6e\x58\x97\xd5\x69\xc1\xa7\xb8\xce\xee\x64\x1d\x8b\x24\x39\x3c\x9b\x16\x3f\xd9\x38\x83\x23\xfb\xdb\x81\xc8\xfe\x3a\x20\xb2\xbf\xfd\xbf\x88\xec\xaf\x73\xb2\xa7\xcd\xfe\xfa\x52\xb7\x1d\xd6\x6e\x7b\xb6\x5a\x94\x82\x89\xe9\xb2\x33\x22\xc2\x6b\x56\x36\x77\xe8\x62\x77\x3e\xdf\xbd\xb5\xfa\xb1\xee\xf2\xe2\x4d\xd6\xc1\x95\x3f\x6b\xcd\x2c\x9a\xab\xa3\xd5\xcd\x6f\x7e\xd1\xea\x66\x69\xae\xb6\x13\x29\xfc\xc4\x09\xe7\xe7\x14\xbd\x0d\x56\x9f\x3b\x69\x4f\x91\x45\x45\x16\xe5\x37\xbf\xc8\xa2\x22\x8b\xb2\x66\x51\xa8\x25\x58\x50\xb1\xe5\x3a\x6d\xce\xcd\x52\xa3\xb1\xc9\x87\x43\x8b\xee\x78\x2d\x26\xa8\x3c\x8e\xf4\xfc\x70\x4d\xa3\xf7\x27\x00\xfa\x24\x7a\x7f\xf6\x40\x34\x7f\x76\x40\x34\x7f\xee\xff\x45\x34\x7f\x76\x4e\xf6\xb4\xcd\x9f\x91\xfd\xed\x40\x64\x7f\x1d\x10\xd9\xdf\xfe\x5f\x44\xf6\xd7\x39\xd9\xd3\x66\x7f\xd1\xfb\xd3\x01\xd1\xfb\xf3\x08\xa2\xf7\xe7\x10\x44\xef\x4f\xa0\x69\xb5\x10\x4d\xab\x2b\x88\xa6\xd5\x68\x5a\xdd\x99\xe0\xe9\x99\x56\x23\x8b\xc2\x42\x64\x51\xfe\xf3\x8b\x2c\x2a\xb2\xa8\xe8\xfd\x71\x99\xc9\xf9\x7b\x7f\x0c\x1f\x18\x2b\xdf\xd9\x10\xb7\x71\x63\x0d\x93\x19\x57\x93\x09\xc8\x51\xdb\xd5\xf5\x5b\xd3\x28\x75\xff\x50\x88\xf2\x53\x45\xa9\x97\x6f\x69\x07\xff\xc7\xbd\x5f\x05\xe4\xb4\x42\x55\x3a\x36\xd2\x93\xa2\x7f\xc0\x47\x5a\xd0\x93\x6d\xae\x76\x92\x3d\x8f\xfa\x6d\x56\x35\x13\x4a\xa7\xdd\xbd\x2a\x91\x3e\xd1\x50\xfd\x2e\x9b\xcf\xfc\x11\xa1\xfa\xdd\x11\x3d\xf3\xdd\xbc\xee\x0a\x88\x71\xef\x0e\xba\xe4\x17\xde\x5d\x90\xdb\x0a\x8a\xdd\xeb\xc5\x0b\xe9\x19\x23\xb4\x08\x5f\x4d\x78\x55\x38\x1e\x8b\xd4\x5c\x62\x1d\x9f\x65\xbb\x59\x11\x82\x22\xcc\x05\xba\x8d\xa5\x9c\x2d\x7a\x2b\x34\xdd\x2a\x6e\x44\x8e\x91\xb1\x06\xef\xb1\x40\x34\x19\x21\x28\x2d\xb1\x56\x09\x49\x49\x11\x65\xff\x1f\xcf\x18\x2d\x3e\x62\x8a\xa9\x3a\x21\xc6\xf6\xfa\xb1\x44\x8c\x57\xb6\x2e\x9a\xb5\x21\x3e\x43\xb4\xf0\xb1\xd2\x0d\x0c\xed\x3c\x77\xc1\x8e\x10\x4c\x6d\x3e\x77\xc1\x47\xd7\x42\x48\x56\x5b\xb0\x91\xb1\x9e\xff\xca\x4a\x9d\x3b\x51\xb9\x6b\x0b\x1e\x7d\xae\xb7\x60\xa9\xb4\x9a\xdb\x88\xee\x42\x24\x8b\xef\x82\x2c\x2c\x3e\x56\x68\x0b\xab\x1d\xb3\x72\xb3\xae\x5a\x59\xfb\x5c\x2c\xab\x96\x76\x55\x27\x43\xa2\x8b\x4d\xd5\x69\x20\x7b\x7b\xaa\x83\x35\xd5\x2b\xca\xc0\xc6\x92\x6a\x6b\x47\xb5\xb1\xa2\x5a\xdb\x50\xad\x2d\xa8\x76\xeb\xb5\xb6\x9e\xfa\x30\x6e\xeb\x13\xec\x8d\xd7\xb4\x16\xab\x2b\x46\x94\x0a\xae\x42\x2d\x1a\x3b\x58\xad\x2d\xf4\x80\xf6\x24\x9b\xbc\xa0\xac\x84\xad\x79\xf0\x52\x4e\x0f\x90\x4b\x27\x47\x40\x1a\x2a\x0f\x51\xfd\xee\x0c\xde\x17\x64\xea\x6c\xa5\x8c\x4d\x5c\xea\x99\x57\x8c\x99\x5a\x69\x23\x66\x24\xa1\x14\x8a\x6a\x21\xfd\xd0\x68\x32\xf5\xf8\xbd\x05\xe9\xde\x80\x96\x34\x3b\x70\x5a\x08\xd2\xe1\x1a\xe4\x82\x74\xb4\x67\x43\x2c\xb6\xdb\xf2\x86\x41\x70\xb0\xf9\x44\x82\x0e\xaa\xc2\x08\xd4\xa5\x14\x05\xe8\x19\x54\xea\x12\xd3\xaa\x07\x63\x4c\x5a\x35\x78\xba\x11\x9c\x76\xca\x34\xf6\xb8\xae\x04\x9f\xd0\x0e\x2a\x42\xf6\x24\xda\xbc\x8c\x98\xf7\x74\xf8\x86\x3f\x49\x32\x13\x5c\x48\xcc\x64\xb1\xb6\xbe\xa2\xb9\x22\x77\xd0\x44\x75\x51\x7e\x88\xd5\xaf\x21\x78\x34\x19\xc9\xcc\x21\x6c\x2d\x58\x89\x22\x85\xc8\x2b\x56\xa1\xc4\x1c\x9b\x5e\xda\x89\x65\x58\x62\xcd\x32\xa7\xf0\x10\x7c\x79\x12\x4a\x46\xb2\xa6\xab\x78\x70\xdc\x0a\x4a\x82\xd6\x41\xec\x30\x37\xb6\x2d\xbc\xe8\x7a\x22\x9e\xef\xa6\x05\x50\x33\xeb\xc0\x3b\x12\x3c\x6a\x34\x5e\xe2\x78\x89\x5b\x88\x97\xf8\xc9\xb7\xe7\x71\x89\x11\xc8\x34\x2d\x40\x54\x3d\x76\xe5\xcc\x1a\xd1\xea\x6a\x06\xd9\xdc\x28\x08\x1e\x33\x72\x65\x67\x5e\x77\xdd\x6e\x12\x33\xeb\x32\xb9\x43\x6c\x45\x3d\xa3\x7d\x1b\x6f\xd3\x46\xdb\xb1\x4f\xd2\x76\xdd\x67\x20\x89\xd9\x5d\x11\x8f\xed\xe4\x8e\x0d\x75\xa9\xef\x80\x09\x92\x83\x8c\x86\x9e\x68\xe8\x79\xfa\x01\x92\x74\xe2\x93\xf0\xfd\xf1\x96\xf8\x24\x9c\xe5\xb1\x19\x3e\xc8\xa9\x22\x63\x06\xb7\x87\xa2\xf7\x4c\x96\x2d\xe0\x8b\x6f\x44\xba\xb8\x2c\x30\x6c\xdf\xc4\xf2\x51\x47\x1e\xa2\xb9\xe9\x0a\xcb\xb5\x14\x9d\xa1\xd3\xd8\xa7\xac\xe5\xa6\x37\xa4\xfc\x00\xcb\x3b\x30\xc6\x6a\xd8\x58\x07\x90\x7e\x73\x2b\xed\x0f\x1b\x22\x66\x85\x54\x94\x2d\xa7\xc0\x23\xc6\xc4\x52\xda\x64\x38\x98\xbd\xd2\x68\x75\xb3\x69\x9e\x1e\xf8\x24\xf1\x3d\xde\x13\xdb\xbd\x47\xf7\x7a\xb7\xc4\x8c\xdf\xfb\xcd\x0c\x42\x9d\xc0\x3a\x98\xef\xba\x87\x93\xc8\x04\xd7\x84\x72\x90\x38\xef\xb2\xe5\x61\xe4\x74\x41\x15\xce\x76\x84\x8e\xec\xb2\x8f\xea\xb2\x8e\xe8\x3a\xc9\x77\x74\x0d\xde\x91\x5c\x6b\x72\x1a\xec\x82\xac\x27\x10\xea\x7e\xb4\x9d\xe7\xe3\x7b\xf3\x12\xde\x1b\xa4\xac\xde\x35\xab\xce\x68\x6a\xa4\x50\x79\x48\x35\x6e\x3b\xb6\x7f\x14\xd3\xb7\xa0\x34\xe5\xe4\xb0\xbb\xc2\x70\x80\x2d\xa2\xcf\x55\x8d\x4b\x0a\x4d\xb4\xb3\xb9\xe2\xa0\xa5\x03\x31\x8b\xa4\x11\x73\x6b\x29\xb9\xe3\x8c\x31\x14\xe3\x1d\x9c\xa0\xe8\x1f\x3e\xcb\xe8\xa6\x89\x8b\xf5\x22\x5d\xd4\x88\x55\x93\xfe\xdb\x45\x36\x3a\x98\x57\x12\x73\x1c\xd1\x4f\x72\xcc\x71\x8c\x39\x8e\x7b\x21\xe6\x38\x1e\xfa\x28\xe6\x38\x76\x83\x95\x90\x1a\x73\x1c\x9f\x41\xcc\x71\x6c\x20\xe6\x38\xee\x40\xcc\x71\xc4\x6d\x6a\x4c\x66\xfb\x2e\x92\xd9\x62\x8e\x63\x24\x0b\xbf\x8f\x63\x8e\x63\x17\x38\x90\x63\xcc\x71\xdc\xf9\x59\xcc\x71\xdc\x0f\x31\xc7\x31\xe6\x38\xf6\x9d\xe3\x38\x11\xf2\x9e\xc8\xfc\x3d\x2f\x2b\xdd\x95\x4e\x84\x30\xe5\xe6\x79\xaa\xc9\x34\x2d\x25\x4c\x68\x47\x9c\x38\x62\xfd\xe3\x4e\x7d\x03\x81\x20\x9b\x55\x7c\x9e\x2a\xfa\x07\xa4\xac\xdb\x48\x68\x87\xec\x9e\x48\x1e\x00\x63\x0e\x7c\x99\xce\x01\x4a\xc2\xe8\xc2\x68\x39\xee\x56\xe3\x19\xe5\x53\x90\x29\x32\x20\xda\x90\xfc\xd6\x99\x64\x87\x8a\x98\x53\x82\x2d\x20\x9d\x09\xa5\xbb\x75\x3b\x5c\xb2\x5b\x56\xc9\x00\x6d\xa4\x08\x63\xe2\x3e\x25\x5c\xf0\x65\x21\x2a\x95\x62\x6c\x3d\x58\x0b\x8a\x02\x36\x41\x2c\x37\xb1\xb0\x6c\xcf\x88\x84\x3c\x35\xca\x20\x48\x74\x95\x02\x99\x92\x0a\x67\x14\x34\xad\x17\x63\xcc\x7b\xb4\x23\x5d\xdf\x6d\xd6\xe9\x63\xfa\x53\xc0\xf3\xed\x55\x4a\x4b\x92\xcd\xc1\x48\xc3\x06\xb2\x9b\xd3\x32\xa5\x7c\x41\x18\xcd\x53\x58\x74\x26\xa6\xa0\xf0\x35\xe4\x96\x92\x3c\xaf\xc5\xdc\xee\x83\xc5\x38\x9b\x1a\x74\x7f\x5b\x6d\x70\x67\x25\xd2\xde\x83\x53\x93\x44\x4b\xc2\x55\x37\xdf\xc0\x5d\xd2\x8c\xa4\x19\x48\x93\xff\x00\x39\xab\x15\xc2\xa0\xb8\x24\x5d\x10\x0d\xf5\xf1\xa5\x25\x51\xaa\x9c\x49\xa2\xc2\xdc\xf8\x67\xd8\x43\xcd\x3a\xec\x7e\xd2\x72\x06\x87\x22\x0a\x6d\x71\x31\x0a\x5c\xb7\x07\x1e\x8a\x37\x25\x09\xe5\xcd\x8b\x11\x88\xb3\xf7\x78\xe0\xbd\x9c\x76\x29\x85\x16\x99\x30\xc4\x51\x20\x91\x2d\x30\x01\x6e\x28\x5c\xbe\x02\x2a\x6d\xba\x7d\xdc\x41\x09\x44\x43\xfe\x51\x4c\xdf\x77\xd6\x23\x30\xcc\xa9\xc5\x36\x22\x05\x78\x63\x8a\x59\x1a\x31\x4b\xe3\xc9\x07\x07\xb7\x84\x75\x28\xf1\xc7\xcc\x1e\xac\x65\x24\x0e\x4a\xbd\x85\x09\xa9\x98\x6e\xd2\x08\xbb\xa8\xff\x30\x97\x5c\x63\xba\x95\x62\xec\x5e\x3b\xfb\xa1\xcb\x0f\x88\x94\x1b\x44\x51\x10\x93\x7b\x0a\x65\xd1\xb2\x54\xcc\xbd\x73\x58\x27\x84\xb2\x4a\xc2\x97\x99\x04\x35\x13\xac\x63\x09\xe1\xca\x9b\xcc\xb4\x2e\x7f\xed\x12\x90\x71\x9b\x5e\x8b\xf7\x41\xde\x99\x7a\x3e\x7f\x03\x92\x1b\x05\x8b\xe0\xd9\xfa\xbd\xc4\x32\x1a\x53\x1a\x1c\xb0\xe2\xe3\x18\x11\x3e\xca\x8b\x76\x8a\xa8\x9b\x10\xac\x24\x43\x38\x11\xa7\x53\xcf\x48\x06\x8d\xf7\xf2\xf0\xdf\xa8\x6c\x06\x41\x8c\x07\xa8\x58\x1b\x21\x3d\xac\x89\xb5\x90\x4d\x35\x25\xec\x2d\x30\xb2\x1c\x41\x26\x78\xde\x71\xd9\x02\xd6\x65\x02\x49\x45\x7e\xc4\x01\x55\xd5\x04\x96\x1c\x95\x3b\xeb\xac\x1c\x89\x6e\x03\xc6\x91\xf9\xf3\x8b\xbc\x71\xc7\xb8\x26\x1a\x64\xb1\x0a\xb1\xfe\x55\x92\x0c\x6e\xad\xe9\xb7\xa3\x82\x0b\x8a\x96\x5a\x83\xf0\xd1\x6e\x8c\x49\xf2\x14\xd3\x8f\xb0\x38\x54\x77\xc4\x70\xe6\x45\xac\x60\xb7\x3b\x4c\xac\x60\xf7\x04\x62\x05\xbb\x58\xc1\x2e\x16\xbf\x7a\x02\xb1\xf8\xd5\x93\x6f\xcf\xa3\xf8\x55\xac\x60\x17\x2f\xf1\x16\xe2\x25\x7e\xf2\xed\x79\x5c\x62\x8c\x82\xd0\x77\x05\x3b\x2e\x72\x43\xf7\xdf\x63\x5a\x9e\x4b\x91\xdf\x4a\x2a\x24\xd5\x4b\x43\x64\x97\x61\xa4\xc3\x62\xb4\x59\x84\x36\x71\x0b\x09\x24\xa7\xd1\xaa\x1d\xad\xda\xdd\x93\x89\x56\x6d\x7f\xac\xd1\xaa\xbd\x45\xf4\x12\x6d\x6c\xd1\xaa\x1d\xad\xda\xeb\x6f\xa2\x55\x3b\x5a\xb5\x5f\xa6\x55\xdb\x90\xc5\x19\xeb\x6d\xbe\xc0\xc2\x8d\xb1\xde\xe6\x59\x1e\x9b\xe9\x26\x0b\xa1\x0f\x56\xb8\x31\xec\xa8\xca\x08\xab\x67\xe8\xc8\x03\x72\x49\x68\x87\xd1\x0d\xf7\x36\x1a\x0b\x21\x25\x36\x81\xb4\x87\x63\x1b\xed\x66\x95\x58\xc5\x3a\x6e\x7e\x81\x35\x35\xd9\xe6\x76\xe2\xcb\x0d\x38\x1a\xb1\xac\xb2\xe1\x30\x16\x2f\x4c\x8c\xa4\xc3\x8c\x31\x31\x93\x0e\x68\x3b\x63\x28\xad\xf1\x59\x84\x99\x76\x57\x87\x11\xf9\x0d\xe1\x64\xda\x58\x68\xc3\x04\x9c\x32\x9a\x11\xa3\x4f\xd5\x43\xae\xe8\xce\x7c\x32\x93\x7d\xd9\x08\x65\x0d\x8a\x2b\xc1\x35\x3c\x78\xcb\xde\x13\x85\x28\x2f\x82\xb7\xde\x63\x5f\xb3\xd5\xb0\x57\x33\xc2\xa7\x80\xb9\x08\x48\xea\x92\x15\xbf\x1c\x66\x45\xcd\xc8\x9f\x04\xbf\x13\x02\xa5\xcc\x98\xb9\x75\x83\xf1\xab\x02\x83\x8f\x20\xfc\x52\x14\x7c\xa4\xbc\x7a\xf8\x5c\x1a\x42\x0c\x12\x2b\x56\xcd\x0e\x87\x89\x3c\x9f\x24\x8e\xdb\x09\x16\x36\xdd\xb7\xf9\x34\x24\xc2\xca\x78\x7a\x56\x08\x91\x8f\x91\x82\x2c\x13\x45\x79\x2b\xc5\x84\x9a\x76\xc8\xe2\xfc\x44\x46\x58\x53\xcd\x0c\x83\x37\x19\x74\xe7\xb1\xd5\xaf\x6a\x84\x41\x76\xbc\x2a\x4b\xd6\xbc\x43\x84\x35\xdc\x27\x84\x7d\xd8\xc6\x67\x8a\x37\x59\xe0\x64\x14\xb5\x54\x99\x36\x39\x10\xa3\x95\xfb\x7c\xad\xdc\xf7\x94\xe7\xe2\x5e\x05\xe6\xf1\xd3\x42\x91\x2b\x09\x39\x70\x4d\x09\x3b\x5c\x9a\xf4\xf9\x7c\x51\xdb\xfe\x1c\x7d\xf8\x5a\x7c\xeb\x47\xf7\x24\x8b\x3e\x3c\x92\x00\x5b\xb1\xe9\x4a\xc2\xc1\x3a\xbd\x09\x52\xda\xa8\x1f\xd2\x5f\x88\x82\xfc\xb2\xb1\x22\xd7\xa2\xa5\x14\x2c\x04\x66\x15\x56\x5a\x6d\x32\xfe\x6f\x25\x5d\x50\x06\x53\x78\x57\x2b\xe8\x1d\xd5\x8e\x6d\x26\x9a\x34\xf9\xba\x25\x19\x53\x46\xcd\x94\x6e\x51\xc6\x30\x47\x94\xb4\x19\xbc\x7c\xa1\x14\x88\x02\x83\xc3\xce\x12\xc9\xff\xca\x35\x71\x04\x32\x99\x94\x52\x64\x37\xa2\x32\x85\x00\x61\xd5\x93\x55\xa5\xcf\x5a\x49\xb8\xa6\x0c\xd4\x52\x69\x40\x15\x4e\x46\xaa\x0b\x51\xf5\xf1\x5b\x4a\x54\x7d\x42\x21\x8c\xaa\xcf\xf7\xa1\xfa\x44\x41\x72\x3f\x9c\xba\x20\xb9\xca\xd7\xb8\xcc\xb2\xee\xd7\xcd\x37\x40\x10\x33\x0e\xa2\x08\x59\xa5\x45\x51\x23\x18\x3d\x42\xf7\x45\xcc\xa1\x43\xf8\xc3\x3c\x37\xb1\x64\x43\x9b\x65\x42\x72\xa2\x89\xb7\x7c\x8e\xc9\x4c\x4a\x4e\x24\x57\xa7\xab\x78\xc3\xe9\xcc\x13\x75\x95\x8f\x45\xb9\xf8\x16\x69\xe8\x4d\x40\xb7\x46\x43\x63\xc4\x54\x35\x47\x23\x0b\x78\x57\x5b\x64\xaa\x24\xe6\x2a\xf1\x16\xaf\x7d\x1b\xa8\x12\xfa\x54\x2a\x1a\x6a\x0b\x83\x70\x28\x03\x92\x83\x96\x4a\x44\x50\x77\x88\x46\x43\xed\x05\xec\x7e\xe8\x11\xdb\xd5\x56\xce\xf3\xab\xfc\xd6\x63\xcf\x21\x2d\x18\xc8\x2e\xe6\xee\xdd\x7e\x14\x26\x13\xc8\x3a\x55\x48\x14\xd1\x19\x4a\x2c\xa2\x70\x60\xca\x38\xa3\x10\x6d\x77\xcd\x18\xdc\x86\x57\x68\x71\xea\x6c\x88\x46\xac\xc8\xf8\x9b\x43\x57\x77\x53\x97\x37\x36\x3f\x8f\x65\xb5\x9e\x7e\x70\x70\x4b\xd6\x54\x53\x71\x7d\x35\x2b\xc4\x01\x66\x66\xe2\xcc\xf7\x42\xce\x0f\x66\x6a\xf8\x26\x15\x75\xac\x60\xca\xc4\x98\xb0\x6b\xca\xf4\xde\xd1\x0f\x92\xa8\x89\x34\x33\xc1\x33\x72\x90\x3b\x62\x08\x3b\x13\x5c\x53\x5e\x89\x4a\xa5\x8c\x72\x48\x9b\xbc\xc9\x4e\x73\x21\x8a\xc4\x27\xac\x52\xb3\xd4\x5c\x8d\x01\xcb\xb7\xe6\x00\x65\x5a\x12\x59\xeb\xe6\xa6\x7a\xb9\x38\x8b\xe2\x23\x8c\x66\x65\xe7\x88\xef\x4c\x51\x31\x4d\x9b\xc3\x00\x9e\x87\x3a\x90\x2d\x52\xa5\x89\xd4\xa1\xd0\xf2\x86\x6a\x3a\x19\x27\xee\x80\xad\xce\xd6\x30\xa9\x35\xae\x30\x5d\xc7\x51\x79\xb9\x38\x4c\x5a\x02\x29\x52\xda\xd8\x98\xf4\x32\xc8\x5a\x57\x51\xf2\x29\x33\xe5\xc9\xa2\xb0\x55\x0a\xd2\x09\x95\x4a\x37\x45\xc6\x95\x26\x05\x82\x44\x4c\x37\xad\x46\x1a\xf8\xa2\x19\xdf\xcf\x1c\xf2\xc3\x8e\x07\x0c\x5f\xcc\x21\xcd\x85\x4e\x39\x28\xdd\x6d\x38\xc5\xed\xc1\x0a\x5d\x28\x5a\x42\xac\x5f\x43\xa6\xdf\x3d\x64\xd0\x6d\x84\xc5\x6c\x05\x23\x7c\x5a\x91\x69\xf7\x2d\x47\x78\x02\xad\x64\xa3\x6e\xef\x5f\x41\x1e\xd2\xf1\x52\x87\x60\x3c\x35\xaa\x40\x3c\xac\x00\xa5\x0c\xd1\xd4\x96\xbc\xda\xf6\x19\x35\xa6\xd7\x15\x62\x01\xa8\xd6\x0d\x56\x2c\xad\x6f\x6a\x06\x3e\x23\x3c\x83\x0f\x7f\xf5\xa2\x63\x52\xd2\x74\x6a\x8c\xc4\x3a\x2e\x21\x8f\x81\x48\x90\xa9\x16\x73\xe0\xa9\xc9\x8f\x84\x1a\x37\x23\x46\x3c\x58\x85\xa7\xb1\xae\x5f\x4b\x61\xf0\x46\xdb\x05\x74\xd9\x34\x41\xb7\xc5\x9d\xd8\x35\x74\xb2\x0a\x4a\x48\xfa\x4d\x2a\xb0\x6b\x8e\x9e\x58\x38\xce\xd7\x60\xd3\x4e\x09\xdb\x4a\x09\x1d\x81\x66\xf1\x29\x2a\x38\x0f\xbd\xbb\x0d\xb6\x48\xc3\x5b\x88\x34\xbc\x77\x0e\x21\x69\x18\xf5\x59\x46\xb2\x59\xad\x6e\x4f\x24\xa8\x4e\x47\x07\x4e\xf6\x78\x84\x2e\x5d\x10\x49\x8d\x51\x70\x36\x88\xbb\xdb\xde\xdb\xe1\xd2\x3a\x80\x41\x60\xa7\xad\x44\x7c\xea\xcc\x10\xd9\xc4\x21\x88\x4f\x5d\x27\x44\x1a\x3e\x00\x91\x86\xd1\x9f\xad\x78\xb5\xe1\xa8\x23\xab\x6e\x21\x92\xf9\x21\x88\xac\xba\x13\x22\x0d\x1f\x80\x48\xc3\xe8\xcf\x32\x21\x21\x25\x25\x4d\x57\x5d\xc1\x4e\xc8\x54\x96\x13\x4d\x52\x53\x64\x34\x6a\x3c\xca\xd3\x4d\xd8\x95\xb1\x29\xdb\x71\x17\x49\x79\x5a\x8a\xfc\xc4\x26\xb5\xad\xd3\x92\x56\xd2\xdf\x0a\xdd\xf2\xa9\x34\x3f\x54\x82\xc5\x06\x95\x62\x1b\xd7\x52\x36\xeb\xac\xad\x82\xbd\xd6\x0b\x90\x74\xb2\x4c\x95\x42\x2c\xb4\x1b\x97\xf1\xc2\x4d\x41\xd0\x83\xfe\x35\x0c\x77\x1e\x93\x6c\x0e\x3c\x4f\x19\x1d\x4b\xd2\x5d\xe0\x03\xb5\x9d\xcd\x84\xd2\xbf\xa4\xf5\x55\x1b\x1b\x3a\x20\x5a\x20\x0c\x8c\x8e\x09\x31\xaf\xca\x5a\x9c\x44\xf8\x6c\x8c\x1e\x91\x4c\xc8\xee\x00\x2c\xc4\x5d\x1b\x3e\x24\x18\x77\x91\x9b\x76\xb7\xf5\x64\xf9\x34\xe5\x15\x63\x69\xbb\xfc\xfe\x09\x5d\x82\x17\x9d\x1b\xca\xe3\x22\x4e\x08\x2f\xeb\xc0\x43\xc6\x2a\x4c\xcf\xf8\xde\x4a\x17\xf5\x29\x67\xad\x2b\x81\xf5\x83\xbf\x0f\x41\xe8\x62\x3d\xe7\xb0\x42\x13\xee\xc2\xb4\x60\x8e\x95\x69\x21\x52\xc4\x33\x78\x91\x14\x11\x90\x23\x23\xb8\x4d\x50\xee\x86\xa2\x24\x8b\x33\x46\x53\x0f\x1a\x27\x36\x59\xd1\x44\x2b\x38\x2a\x09\x78\x94\xdd\x41\x3d\xf1\x8d\x8a\x1c\x69\x67\xba\xf1\x8d\x8a\x14\xf1\x78\xba\xa7\xfb\x46\x99\x49\x2b\x3e\x51\x7b\xe0\xe4\x9e\x28\x23\xa2\x92\xc8\x8e\x0a\x0d\xa8\x6c\xad\x82\xea\x94\xf2\x05\x61\x34\x5f\x29\x97\xa9\x16\x29\x48\x89\x09\x79\x35\x99\x66\x66\xa4\x09\x6d\x60\x15\xa4\x4d\x8a\x68\x00\x43\x60\xbd\x17\xe9\x1c\x96\xa1\xe2\x21\x6b\x54\x26\x5b\x36\x36\x86\xbd\xdb\x60\x82\xbd\x50\x39\x34\x85\xc7\xcd\x95\x37\xd0\xe4\xbf\xc1\x98\x22\xaf\x16\x1a\x33\x28\x4d\x0b\xa2\x21\xcd\x2a\x29\x81\xeb\x14\x16\x88\x4e\x5b\x78\x73\x3d\x3c\x94\x12\x54\xd8\x5c\xe5\x36\x69\x28\x2c\xba\x36\x06\x9f\x51\x1e\xce\xe3\xd4\xa4\xbb\x68\x5a\x80\xc9\x0d\x9b\x58\xed\x68\x93\x76\x90\x86\xa7\xb1\xa6\xbc\x4a\x33\xdf\x70\x73\xdd\x84\x55\x9b\x50\x22\x65\x24\x8b\x37\x08\xfb\x6a\x37\x46\x39\x28\x4a\xbd\x4c\x57\xa5\xd0\x83\xad\xbe\x41\xdd\x32\xcf\xd0\xd7\x76\x85\xcf\x5c\x1e\x1c\xb7\xaf\x76\x92\xa7\x1b\xcf\x68\xc1\xd6\xd1\x87\xe7\x1f\xbb\xf8\xd1\x82\x28\x8e\x97\x38\xa1\xb6\xba\xfe\xbb\x23\xe0\x37\x07\x7f\x65\xdd\xf0\x5b\xdf\x0d\x8f\x61\xac\xee\xc9\xee\x38\xf8\x22\xf2\xf5\x59\xf4\x78\xe0\x2e\x67\x6d\x87\xdc\xe4\x8a\xf5\xc2\xfe\x87\x30\xb3\x69\x37\xe4\xbd\xcd\x7a\x59\x62\x55\x65\x4b\xcc\x95\x46\x54\xba\x4a\xac\x49\xdd\x3a\xc2\x00\xa1\x74\xe2\x89\x1a\xef\xfe\xb2\x92\x59\xb0\x08\x31\x64\x60\x85\x11\x43\xb0\x76\x2e\xbf\x90\xc8\xc2\x79\x23\x11\xc4\x88\x25\x43\x14\x01\xb6\x3a\xa9\xaf\x83\x16\x2f\x52\xa0\x45\x59\xab\x6b\x6c\xad\x32\x59\x61\x77\x15\x81\x6c\xf8\x85\x8d\xe8\x63\x31\x75\xec\x0b\x68\x8d\x12\xaf\x42\x59\x21\xb7\x94\xa3\x6c\x76\xd8\x52\x95\xb2\x9a\xb6\x8d\x6c\x66\x33\x67\xb4\x4a\x35\x74\xa9\x60\x27\xf1\xd1\x66\x27\x5c\xc4\x46\x7b\x23\xe6\x90\x1d\x95\x7c\x14\x2d\x7b\x31\xdc\x5e\xd9\x72\xf0\x2b\xd8\xc8\xdf\x0e\xe8\x1d\x94\x2e\x97\x8d\xb2\x55\xbc\x5c\xc6\x70\x54\xbe\x9c\x87\x72\x50\xc0\x1c\x0e\xc8\x5a\x09\x73\x1d\xa3\x4f\xe7\x98\xa5\x32\xe6\x38\x02\x5e\x21\x73\x19\xa0\xd7\xd9\xe3\x15\x33\x07\xec\x68\xe5\xcc\xfe\x2a\xf4\xe4\x29\xb6\x22\x7a\x1b\x1b\xa7\xa5\x54\x84\x47\x8a\x23\x0f\x4b\xac\x38\x82\xb6\xb5\xf1\x86\x46\x18\xd6\x0c\x8d\x22\x56\x3c\x99\x06\xf5\x31\x37\x95\x49\xd6\xee\x33\xac\x7f\xcf\x34\x4b\x09\x25\x23\x19\x6c\x5c\x92\x0a\xfe\x59\x01\xef\x2e\xb6\x8a\xc5\xac\x40\x2e\x20\xc5\x15\x33\xc2\x62\x33\x3d\xe2\x41\xa2\x71\x4b\x29\x0a\xd0\x33\xa8\x3c\xab\x02\x99\x4a\x25\xdb\x47\x45\x23\x49\x19\x45\x77\x05\x68\x49\xb3\xe3\x19\x0e\xc6\x55\x36\x47\xb5\x3c\xb5\x32\x1b\xa8\xb0\x45\xee\x43\xb3\x67\x4c\xbd\xec\xc4\x31\x40\xde\x72\x2a\x56\xef\x25\x2e\xdd\x6c\x38\xe6\x8f\x8d\x30\xa9\x09\xc4\xf0\x89\xb1\xdb\x58\xb8\xbe\x0f\x01\x42\x50\x56\x31\x23\x85\xc8\xe9\x84\xfa\xc5\xa2\x64\x33\x22\x53\xe0\x99\xc8\x0d\xea\x0a\x2e\x1e\x43\x42\x49\x24\x04\xaa\x29\xf8\x7d\xa5\x9d\x6c\x1f\xf7\x10\x09\x3b\xcd\x8b\x7e\x3c\xbe\xde\x93\xe1\x33\x34\x27\x5e\xed\xcb\x00\x3c\x68\xbb\x41\x86\x0f\xcd\xe1\x70\xab\x45\x1c\x8b\x2e\xef\x67\x54\x03\xa3\x4a\x87\x20\x4d\x2c\x6b\xd3\x92\x70\x55\xab\x5f\x7e\xdc\x8d\x54\x5a\x34\x8a\x51\x46\x14\xa2\x3c\xba\x49\x00\x6d\x6b\xc0\xa7\xb2\x1a\x87\x2a\xad\x1b\xd3\xf3\xf6\x7c\x76\x4c\x3e\xc9\xe1\x3e\x50\x7e\xdf\x1a\x1b\x46\xc3\x0f\x71\x53\x94\xce\x45\xe5\x55\xbd\x55\x54\xba\xac\x74\x88\x6c\x71\xf3\x64\xab\x42\x30\x31\xa5\x07\x05\x75\x5c\x15\x6e\xc6\x20\xd3\x42\xa6\xc1\x02\x48\xb7\x28\xc3\x88\x2c\xab\x9c\x93\x34\x13\x5c\x13\xca\x41\xb6\x25\xa4\x83\xe1\x9d\x90\x8c\x32\xaa\x97\x81\xd1\xce\x84\xd2\x81\x51\x6e\x6b\x07\x84\xc5\x5b\x8a\x3c\x34\x46\x49\x85\x0c\xbf\xa7\x15\xa7\xa1\xf6\x94\x89\x29\xc2\x16\x89\x42\xd5\x76\xbd\x49\x33\xa2\x61\xda\xd9\xca\xc0\x0d\x5f\xb8\x9b\xf9\x14\x71\xa8\x52\xbc\x4f\xd0\xae\x6d\x5f\x39\xc1\xd4\x17\xc4\x21\xaf\x6f\x53\x48\x5c\xc1\x37\x35\x34\xae\x70\x13\xd4\x92\x64\x94\x4f\xd3\x6d\x3f\xb4\x50\x07\xbf\xc6\xbc\xe5\xcc\x41\x27\x8c\xbd\x9e\x26\x19\x62\x8d\x2f\x08\x0d\xad\x91\xb5\x41\x13\x81\x37\x12\xd5\x93\xcb\x0a\x63\x79\xa8\x61\x89\x1b\xae\xb4\xbb\x15\x57\x08\xb1\x46\x93\x69\xca\xeb\x93\x67\xd4\x33\x27\x28\x08\x7b\x37\xcf\x77\x26\x85\xd6\x87\xeb\x5e\x63\x66\xda\x14\x07\x4f\x5b\x43\x6e\xda\x84\xe1\x98\x67\x6d\xaa\x53\xfa\x08\x67\x09\x92\x8a\x3c\x0d\x50\x6a\xbe\x45\x9b\x4b\x51\xa6\x4c\x4c\x11\x08\x4d\xb7\xb3\x45\x18\xa2\x23\x45\x8b\x49\x82\x02\x9d\x4a\xa2\x21\xdc\x72\xef\x89\xe4\xf5\x0d\xc8\x81\x91\xa5\x3f\x5a\x03\x4d\x75\xfe\xef\xc3\xea\x1c\x13\xd3\x29\xe5\xd3\xbd\x35\xd8\x3a\xf6\x8f\x8b\x1c\x2e\xa7\xc0\xf7\x79\x10\x9c\xdb\x03\x99\xba\x6d\xe0\x6a\xaf\xa0\xfa\x77\x0e\xeb\xec\x39\x6d\x67\x94\xf1\x93\xae\xf7\xda\x38\x8d\x0d\xe5\x5c\xb3\x0a\xb8\x1e\x1f\xe6\x5a\xa8\x92\x52\xd5\x64\x02\x72\xa4\x85\x34\x74\xb2\xc0\x5a\x50\x55\x8b\xea\xcd\x98\x64\x73\x26\xa6\x6f\x0a\x28\xcc\xbc\x35\xb1\xb1\xc0\xac\x47\xc8\x66\x90\xcd\x55\x65\xac\xa9\x68\x8d\xd8\x54\x8d\xcd\x09\xa9\x5a\x72\x64\x24\x75\x98\x2b\xf2\xe8\x60\xbf\x35\xdd\xd4\x42\x1c\x6f\x13\x13\xf6\xb6\xbb\x94\x9b\x0d\xbe\xa4\x61\x5b\x39\x35\x1f\x63\x62\xeb\x1f\x54\xf4\x0f\xf8\x88\xa1\xbc\xa4\x61\x7b\xcb\xcf\xa8\x2a\x9a\x17\xc8\x27\xec\xf9\x2f\xd0\x51\x46\xeb\x30\xb8\xe4\x7f\x5f\xfd\xe3\xc7\x3f\x2f\x5e\xff\xfc\xea\xd5\x6f\xff\x7e\xf1\x3f\xbf\xff\xf8\xea\x1f\x6f\x9a\xff\xf8\xd7\xd7\x3f\xbf\xfe\x73\xfd\x8f\x1f\x5f\xbf\x7e\xf5\xea\xb7\x0f\x37\xbf\x7e\xb9\x7d\xf7\x3b\x7d\xfd\xe7\x6f\xbc\x2a\xe6\xed\xbf\xfe\x7c\xf5\x1b\xbc\xfb\x1d\x89\xe4\xf5\xeb\x9f\xff\x05\x31\xb9\x87\x8b\x6d\x79\xc0\x0b\xca\xf5\x85\x90\x17\xab\x10\xc1\x44\xcb\xaa\xdb\x98\x6f\xe1\xb4\x6d\xd4\x45\xcc\x4d\xb4\xa1\x34\x0c\xbe\xc4\xc9\x0f\x1d\x16\x29\xb6\x44\x45\x53\x51\x60\x16\x72\xcb\x31\x6d\x98\xe3\x8e\x87\xda\xf1\x72\x61\x7c\x13\xac\x2a\x02\x37\x86\x0c\xcc\xce\xd8\x06\x83\x67\x8c\xd0\xa2\xbb\xbb\xf1\x63\xb0\x0e\xac\x94\x40\xf2\xcf\x9c\xf5\x16\x37\x6d\x57\xf5\x65\xb3\x5e\x34\x95\x21\xe3\x36\x55\x09\xa8\x00\x52\xdb\x03\x22\x59\x06\x4a\xdd\x88\x1c\x1f\xfb\x8a\xce\x1c\x68\xc1\xfa\x44\x6d\x82\x54\x5b\xa8\x75\x97\x11\x9a\x86\x13\x87\x6d\x4a\xda\xbe\x60\xbf\xd6\xfa\x25\xfe\x17\x4e\x8b\xc7\x75\xa1\xf7\x1e\xc4\xa6\x92\xb6\xe3\x20\x36\x57\x27\x69\x5d\xff\x94\xef\xef\x2f\xbe\xef\x63\x63\xc4\xd2\x16\xac\xae\x5a\xb2\xd3\x1c\x1f\x4d\x1e\x2e\x04\xd5\xa8\x37\x16\xdf\xbb\xc7\xc7\xed\x60\xc0\xca\xab\x5b\xb0\x97\x5c\x9f\xff\xd6\x92\x38\x93\x53\x97\x66\xb7\xe0\x29\xd7\xee\x82\x35\x95\x26\xab\x4b\x06\x2a\x92\xd1\x21\x88\x64\xd4\xc7\x4f\x14\xb4\x41\x03\x7d\xb2\xc7\x82\xe8\x6c\xf6\x6e\x13\x35\x66\x45\xa1\x96\x32\x8a\xeb\x14\x5b\xb0\xc8\xda\xda\x82\x33\x41\x27\x49\x3d\x4f\x53\x73\xe0\xe0\x83\x36\xd1\x21\xd6\x5b\xe3\x74\x12\x2d\x78\xcc\xd5\x5e\x82\x5c\x83\xad\xc8\xd2\x02\xb6\xda\xe3\xee\x2f\xd6\x87\x68\xf5\x33\xa7\x17\xc2\x6d\x37\x9a\xdb\xf7\x11\x19\xb7\xbf\x05\xff\xa7\xc5\xf1\xdc\x8f\xc2\xf5\x5a\xab\xe8\x15\x23\x4a\xf5\xaa\xd4\x2e\x1a\xbb\x6b\xad\x95\xf5\x3c\x44\x8f\xab\xb0\xd8\x5d\xab\xd8\x48\x63\x67\x9a\x55\x74\x83\x32\x99\xa4\x90\xe1\x71\x52\x8c\x34\xc9\xe6\x23\x43\x67\xcb\x95\xfb\xba\x16\x6f\xfe\xf3\x2f\xc6\x51\x8d\x6d\x2b\x2b\xa5\x45\x71\x25\xf8\x84\x4e\x47\x4d\x1f\x14\xef\x95\xe4\x04\x0a\xc1\x47\xdd\x98\xd0\xcd\xd5\x10\xfd\xf9\x6d\xf0\x25\x78\x0f\xe2\xe6\x73\x67\x4e\xd3\x1f\x21\xe3\x33\x9d\x4e\x73\xfe\xe8\x4f\x31\xe6\x28\x2b\x57\x0e\xe5\x77\x40\xf2\xe5\x08\x32\xc1\xbb\x03\xb9\xd7\x80\xbd\x70\x6b\xb0\xd3\x3e\x24\x2c\x68\x2d\x75\xfe\x8d\xd6\x4c\x7f\x89\xf6\x07\xf5\x3b\x2b\x1b\xb1\xdb\x56\x9e\x75\x15\xb7\x2d\x05\x3c\x37\x31\xdb\x5a\xc4\x76\x94\x22\xdc\x44\x6b\xc7\xc1\x5c\x44\x6a\x27\x71\xda\x59\x94\x76\x11\x1c\xed\x45\x68\x3b\xf1\xd9\x41\x74\x76\x96\x0d\xf1\x2b\x77\x10\x97\xfd\x44\x65\x67\xb3\x3a\x7a\x1b\xac\x3e\xd7\x50\x94\x8c\xe8\x5e\x9c\x48\x58\x61\xc3\x15\x7f\x62\x2f\x7c\x6c\x7e\x76\x46\xea\x8e\x8d\x70\xb2\x86\x73\x5a\x9f\xbd\x3a\x87\xf4\xa9\x25\xae\x34\x95\x69\xba\x80\xb7\x40\x72\x46\x39\x58\x88\x36\x6b\xd8\x11\x26\xfe\xfb\xbf\xac\x37\xcf\xd6\xd0\x4a\x26\x13\xca\xa9\xb6\x7a\x68\x5d\x8d\x66\x4d\x30\x9a\xc3\x78\x3e\x63\xb6\xbf\x85\x09\x48\x09\xf9\xdb\xaa\x26\xc8\x51\x36\x83\xbc\x62\x94\x4f\xdf\x4f\xb9\xd8\xfc\xf9\xdd\x03\x64\x95\xb6\xa8\x28\xb6\x0b\xce\x06\x2f\xbf\x95\xad\x31\xd4\xeb\x33\x95\x2e\xe9\x7b\x16\x89\xa7\xf5\xf6\x39\x78\x6c\xea\x16\xc2\x2c\xac\x05\x27\x9b\xef\x73\xf0\x32\x72\x3e\x05\x77\xbb\x70\xcf\x13\x73\xb5\x1d\x3f\x87\x20\x74\xd0\x42\xd0\x15\xba\x5b\x9c\x9f\x83\x9b\x0d\xfa\x39\xd8\x5b\xa5\xf7\xe1\x70\xb2\x53\x3f\x07\x47\xcb\xf5\x21\x44\xbe\xfb\xdc\x70\xa7\x6b\x0a\xcc\xee\x41\x7e\x0e\x91\x31\x21\x20\x32\x26\x1b\x88\x8c\x09\x85\xe3\x25\x32\xa6\x00\x93\xb9\x07\x3a\x9d\xa1\xcb\x94\x3e\x07\x5b\x03\xe6\x7e\x70\x0f\xf5\x58\x83\x1f\xb1\x5d\xec\x48\xc2\x8e\x08\xda\x8d\x74\xfa\xb1\xe7\x31\xfa\x50\xd2\x7a\xdb\xfa\xd3\x6f\x7c\x1f\xab\x5a\xf5\x1b\xad\xec\xd8\x5f\x40\xba\x73\x4d\x4f\x96\x1b\xd5\x1c\x04\x44\x69\xc2\x02\xa2\x34\x61\x03\x51\x9a\x30\x23\x8a\x6a\xce\x21\x88\x8c\xc9\x02\x22\x63\xb2\x81\xc8\x98\xcc\x88\x06\x57\x73\xfc\xa6\xe1\x73\xc4\x17\xcf\x25\x58\x67\x19\xdf\x69\x0b\x9c\x7f\x5a\x8a\x3c\xfa\x5c\x9e\xcf\xce\xdb\xe7\xb2\xdd\xd6\x9a\x1a\x86\xd6\x48\x1a\x27\xef\xc8\x32\x3e\xbf\xcf\x09\x25\xc1\xd5\xa4\x24\xe4\x4b\x14\x52\x2a\x49\xc2\x49\x26\x49\xf8\x27\x32\xac\x84\x92\xf4\x31\xc1\x70\x92\x4a\x12\x56\x5a\x49\xfa\x58\x6d\x48\xa9\x25\x09\x28\xb9\x24\x81\xa4\x97\x24\xa4\x04\x93\x84\x94\x62\x92\xa0\x7b\xef\x98\x2b\xb1\x1f\xfc\x43\x6e\xf6\x43\x50\xea\x0d\x2c\x4e\x7a\x23\xda\x94\xe2\x8b\x0f\x1f\x1a\xe2\xc3\xe7\x0e\xf1\xe1\x0b\x82\x32\x3e\x7c\x48\x88\x0f\x9f\x3b\x7c\x1f\x0f\xdf\x29\x18\x78\x83\xed\x74\x18\x0a\xd5\xa2\x14\x4c\x4c\x97\x1f\x7c\x1f\xa6\x20\xeb\xf2\xe7\x51\x17\xbb\x2b\x8a\x31\x06\x27\x10\x63\xf0\xd8\xf2\x13\x03\x0d\x5e\x8e\x51\x2f\x90\x15\x2d\x06\x19\x20\x20\xfa\xf2\x2c\x20\xfa\xf2\x6c\x20\xfa\xf2\xcc\x88\x82\x04\x19\x84\x50\x02\xfa\x50\x00\x02\x8b\xa4\x9e\x9b\x1e\x00\x49\x40\x4b\x57\x7c\x9c\x10\x10\x1f\x27\x0b\x88\x8f\x93\x0d\xc4\xc7\xc9\x8c\x28\x3e\x4e\x78\x44\x27\xf4\x38\x79\x6c\x8d\xf7\xcd\x0f\xb2\xab\xfe\xd4\x17\xc4\xfa\xe4\xbd\x16\x5f\x13\x87\xaf\xc5\x69\x30\x0b\x85\x57\x94\x14\xd7\x34\x46\x4a\x3d\x9f\x5d\x8c\x94\xea\x77\x42\x49\x74\x18\x3b\x42\x74\x18\xfb\x41\x74\x18\xbb\x43\x74\x18\xe3\x21\x3a\x8c\x87\x46\x14\x23\xa5\xec\x21\x3e\x7c\xee\x10\x1f\xbe\x20\x28\xe3\xc3\x87\x84\xf8\xf0\xb9\xc3\xf7\xf1\xf0\x9d\x82\x87\x22\x46\x4a\x75\x40\x8c\x94\xda\x40\x8c\x94\xda\xc5\x12\x23\xa5\xf6\x43\x8c\x94\x5a\x43\x74\x46\x63\x20\x3a\xa3\xed\x20\x3a\xa3\xf1\x10\x9d\xd1\x87\x21\x3a\xa3\x8f\x89\x24\x46\x4a\x99\x21\x3e\x4e\x03\x4d\x2c\x3e\x4e\x36\x10\x1f\x27\x33\xa2\xf8\x38\xe1\x11\x9d\xd0\xe3\x14\x23\xa5\x62\xa4\x54\x03\xe7\x16\x29\xe5\xf4\x33\x52\x69\x51\x88\x8a\xeb\x11\xc8\x05\xcd\xe0\x32\xcb\xea\x7f\x7d\x11\x73\xb0\xb2\x7c\xb4\x83\x8f\x85\x60\x40\x38\xfa\x77\xdb\xde\x90\x36\x63\x1d\xb5\x97\x30\x91\x53\x87\x2b\x7d\x5e\x4d\x76\xeb\x83\x28\x0a\xc2\x1d\xae\xda\xb9\x2d\x14\xf8\xe2\x88\x8b\xf4\x2e\xcd\x6c\xd1\x0d\xf6\x29\x78\xf3\xdf\x46\x22\x1e\x76\xf4\x6b\x29\x9c\x83\x12\x43\x68\x52\x59\xd3\xef\xf5\x86\x94\x1f\x60\x79\x07\x96\xfd\xf5\xc3\x4f\x27\x09\xa3\xd4\x05\x93\xdd\x7c\xc8\x33\xf8\x64\x44\xd9\xca\xb8\x61\x26\x64\xfb\x92\x3d\x87\x10\x2a\x92\xaf\x7a\x14\x44\xb8\x9e\x50\x60\xf9\x89\x50\x3f\x29\xe9\x37\x90\xca\xd1\x2d\xb3\x0b\xc1\xe8\xae\xd9\x1e\x53\x6b\xeb\x23\xce\x28\x0c\xdd\x6d\x56\x35\x34\xf5\x49\x50\xa2\x92\x19\x5c\x9f\x10\x15\x6e\xc4\x56\x9b\x6e\xed\x87\x20\x18\x21\xe6\x74\x41\x95\xbf\x65\x8d\xf0\xe5\x67\xaf\x5d\x4e\x5a\xed\xcb\xdb\xc5\xfd\x1c\x5b\x10\x43\x58\x49\xb4\x06\xc9\x7f\x4a\xfe\xf7\xd5\x3f\x7e\xfc\xf3\xe2\xf5\xcf\xaf\x5e\xfd\xf6\xef\x17\xff\xf3\xfb\x8f\xaf\xfe\xf1\xa6\xf9\x8f\x7f\x7d\xfd\xf3\xeb\x3f\xd7\xff\xf8\xf1\xf5\xeb\x57\xaf\x7e\xfb\x70\xf3\xeb\x97\xdb\x77\xbf\xd3\xd7\x7f\xfe\xc6\xab\x62\xde\xfe\xeb\xcf\x57\xbf\xc1\xbb\xdf\x91\x48\x5e\xbf\xfe\xf9\x5f\x3c\x27\xfe\x70\x31\xaf\xc6\x20\x39\x68\x50\x17\x94\xeb\x0b\x21\x2f\xda\x1d\xf9\x29\xd1\xb2\x72\x6b\xab\xb2\x86\xf5\x35\x3b\x11\x6a\x0e\xc3\xc4\xd6\x8b\x1a\x9a\x87\x29\xc8\x24\xe8\x28\x43\xee\x83\x28\x43\x76\xc0\x0b\x91\x21\x3d\x51\x78\x16\x87\x27\x85\x0b\x03\xf0\x9a\xb3\x97\x7d\xc2\x4d\xe9\x1d\xcc\x46\xb1\xd1\x91\x3d\x98\x5b\x08\xc6\xe6\xcb\x48\x82\x30\x91\x10\x0c\x24\x04\xf3\xf0\xbe\xb4\xa5\x84\x09\x7d\x18\xcc\xfa\xd3\xbe\x98\x91\xa2\x5e\x0c\x45\x0d\xc4\x4e\x69\x41\xa6\x0e\x67\xe8\x71\x72\xcd\x88\xb7\x15\x63\xb7\x82\xd1\xcc\x41\xce\xf2\x18\x9b\xd1\x09\x64\xcb\x8c\x39\xac\xd8\xef\xbe\x94\x42\xe9\x91\x26\xd2\x31\xb0\xda\xff\xb2\xc2\x03\x64\x43\x47\x13\x39\xfb\x50\x76\xe1\xe5\x25\x33\x04\x90\x1f\x67\x5a\x97\xbf\x82\x47\xd4\x7e\x98\x03\x9e\x09\xe5\x31\x87\x24\xdc\xc1\xd4\xfb\xf1\x37\x20\xb9\xa5\xdf\xf4\x39\x9c\x5c\xc8\x5a\x08\x6d\x30\xe9\x25\x34\xec\xc4\x66\x15\x2e\x00\xcb\x51\x3f\x7a\x8c\xa4\xd9\xa2\x20\x5c\xeb\x64\xc2\xaf\x4a\x6f\x93\x7e\xa0\xe3\x2e\x85\xeb\xbb\xba\x86\x00\x26\xdd\x90\x06\xdd\x80\xe6\xdc\x1e\x6d\xa2\x2a\x9b\x81\x2f\x33\x3a\x99\xec\xbb\x9a\x86\x86\x7c\xc1\x75\x56\x8e\x44\x36\x8f\x6f\xf8\x06\xe2\xad\x3e\x0c\xbd\xdd\xea\xf3\xbf\x4a\x9e\x08\x4a\x09\x23\x2d\xca\xa8\xa6\x45\x35\x6d\x2f\x8e\xa8\xa6\x25\x51\x4d\x33\x43\x54\xd3\x90\x10\xd5\x34\x13\xa2\xa8\xa6\x6d\x20\x0a\x74\x0e\x10\xd5\xb4\x35\x44\x35\x6d\x17\xe2\xad\xee\xc6\x75\xca\xb7\xfa\xfc\xaf\x52\x00\x77\xa7\xd3\x8f\x19\x5d\x00\x07\xa5\x6e\xa5\x18\x1f\xdd\x0b\xe8\xae\xdd\xf9\x33\x0d\x6f\xad\xee\x65\xe4\x6e\x7a\x12\xee\x84\x50\x56\x49\xf8\x32\x93\xa0\x66\x82\x39\x6e\xa7\x7f\x8d\x2c\x5f\x76\xe7\xa5\x89\xfa\x13\xa3\xdf\xeb\x15\x80\x90\x82\x68\x9e\xde\x37\x22\x94\xc6\x79\x52\x21\xa2\x41\xb4\xcc\x93\x8a\x92\xf6\xd4\x2c\xfd\xb5\xca\x80\x25\x00\x7c\xb4\x49\x3f\x4d\x32\xc0\x91\xfa\xc9\x9a\x9e\x72\x66\x28\x19\x33\x90\x7c\xd9\x93\x6c\xe9\xab\x2d\x0e\x5e\xd4\xc0\x59\xac\xf5\xbc\x64\x94\x53\x4d\x09\x7b\x0b\x8c\x2c\x47\x90\x09\x9e\x3b\x32\xf5\xe1\x85\x83\x12\x24\x15\xf9\x99\x2f\x42\x55\x59\x06\x4a\x9d\xbd\xa4\xe6\x69\x71\x78\x01\xb2\x5a\xe4\xfa\x5b\xe8\x85\xeb\x9f\x2b\xcb\xd5\x20\x0b\xca\x89\xa6\x82\xff\x2a\x49\x06\xb7\x41\xf9\xd6\x7f\xff\xd7\x30\xf7\x9d\x16\x20\x2a\x7d\xd6\xdc\xd7\xe3\x5c\xdd\x54\x19\x8f\xbb\x55\x13\xef\x31\xeb\xd7\x04\xc8\x26\x6a\x53\xab\x6f\x3d\xd8\x62\x88\x22\xdd\xfe\x7c\xb1\x7e\x59\xde\xdf\x0e\x96\x42\x53\x0f\x7f\xfe\x9b\x38\x68\x05\x9c\x52\x0a\x2d\x32\xe1\x9c\x7b\x93\xc3\x84\x54\x4c\xff\x94\x7c\xb9\xba\x1d\x66\x0d\x7e\xa9\x93\x8f\x2e\xa3\x0b\xb3\x1f\x26\xe9\xe7\x89\x1c\xc1\xa8\xd2\x17\x05\x29\x2f\xe6\xb0\x74\xe0\x4a\xbe\xdb\x70\xb1\x21\xa3\x00\xcb\x68\x37\xa5\x20\xa5\x25\x2e\x09\x24\xa7\xd1\x31\xe0\x02\xd1\x31\x10\x1d\x03\x2d\xbc\x00\x65\x33\x3a\x06\x9e\x42\x74\x0c\x1c\x80\xe8\x18\x58\x43\x74\x0c\xbc\x74\x13\x51\x74\x0c\x44\xc7\x40\x74\x0c\xec\xc0\xf0\xeb\x88\x8e\x81\xc8\xf5\xb7\x10\x1d\x03\xbb\x3f\x8f\x8e\x81\xfd\x30\x34\xd7\xf2\x38\xd7\x75\xa9\x42\x87\x95\xfb\xb1\x3a\x46\x0b\xea\xe2\x21\x48\x02\x37\x16\xf1\xe2\x57\x61\xb8\x55\x10\x5e\x75\x76\xe5\x45\x7b\x61\xad\x01\x2a\xfe\x81\x8a\x64\xf9\x08\x4b\x24\xcb\x61\xc9\xd2\xe3\xc7\x0a\xb2\x4a\x52\xbd\xbc\x12\x5c\xc3\x83\x83\x54\xe7\xc7\xe3\x09\x63\xe2\xfe\x56\xd2\x05\x65\x30\x85\x77\x2a\x23\x8c\xb8\xb6\xb6\xf5\xaf\x26\x97\x91\x92\x8c\x29\xa3\xee\xd7\xd2\x5f\xb8\x27\xf9\x77\xef\x11\x48\x92\x5c\xba\x56\x02\x48\x5e\xcc\x2e\x78\xd7\x53\x58\x5d\x2a\x47\x72\xf2\xbd\x4b\xa5\x14\xd9\x8d\xa8\xb8\xa3\xa2\xe8\xed\x5d\x26\xf9\x67\xce\x96\x77\x42\xe8\x6b\xca\x40\x2d\x95\x06\xc7\x86\x2d\xbe\x3b\x21\x2b\x7e\xa9\x7e\x95\xa2\x72\x24\xe9\xe1\x35\xa0\x66\x05\x9f\x04\xaf\x77\x73\xc0\x3d\xfc\xaa\xc0\xb1\x87\xc0\xf0\x5b\xa8\xe0\x23\xe5\xd5\xc3\xe7\xa6\x66\xea\x70\x0d\xd8\x61\x01\x1e\xf5\x5a\x03\xf0\x45\x29\x5c\x6a\x80\x06\x9c\x40\x83\x62\xc8\x09\x54\xce\x54\x1c\x64\x02\x9e\xcf\x8a\x82\x2c\x13\x45\x79\x2b\xc5\x84\xba\x9e\x64\x00\x3a\x16\x19\x61\x4d\x18\x99\xcf\x3c\x92\x17\x41\x51\xde\xcd\x28\x97\xe5\x20\x9a\xf4\x3d\xe5\xb9\xb8\x57\x03\x73\xc4\x69\xa1\xc8\x95\x84\x1c\xb8\xa6\x84\x8d\x4a\x9f\x2a\x50\x01\x68\xe9\xf9\x74\xfc\x1a\xf9\x84\xe0\xd8\xeb\xa7\x77\xe0\x99\x0c\xa6\x21\x6b\x22\x75\x55\xc6\xf0\x34\x5b\x88\x6a\x58\x0c\x4f\x6b\xe1\x05\xb8\x3c\x63\x78\xda\x53\x88\xe1\x69\x07\x20\x86\xa7\xad\x21\x86\xa7\xbd\xf4\x40\x85\x18\x9e\x16\xc3\xd3\x62\x78\xda\x0e\x0c\xbf\x8e\x18\x9e\x16\xb9\xfe\x16\x62\x78\xda\xee\xcf\x63\x78\xda\x7e\x18\x9a\x6b\x79\x99\x67\x72\xea\x10\x2e\xe0\xe3\x90\x6a\x86\xfc\xcc\x5d\x5a\x12\xfb\x0c\xbb\x43\xbd\x37\xa0\x14\x99\x82\x5b\x6f\x77\x0f\xbe\xb2\x67\x0a\xc7\xef\x25\xa7\xb5\xf3\x78\x6e\x1b\xbf\x10\xac\x2a\xe0\x2d\x2c\xa8\x53\x14\xe4\x60\x15\x0b\xf2\x66\xc6\x6e\x44\xd2\x82\xf7\x13\x34\x60\x92\xbc\x5f\x82\xf9\x76\xef\x9c\x7e\x7e\x5e\xad\x7d\x5b\x02\x6f\xa2\x35\xce\x89\xbe\x8b\x7a\xc2\x83\x92\x77\x3b\x03\x29\x4a\x32\xf5\x08\x5b\x3b\xef\x7b\xb6\x0d\xb6\xf1\x9b\x80\x4f\x13\x58\x55\x8d\x07\xa5\x83\xd5\xf8\xef\x1e\x4a\x67\xd7\xfe\xa0\xdc\x6e\x73\x93\xbe\x03\x66\x77\x2f\xe4\x9c\xf2\xe9\x5b\xea\x70\x54\xce\x87\xe4\x76\x3c\x0e\x5b\xeb\xb8\xa9\x2e\xdb\x99\x73\x75\xd5\x74\x58\xb7\x59\x94\x2b\xcf\xaf\x37\x42\x81\x5c\x38\xf8\x5f\x1c\xdf\x27\x1f\x09\xd5\x91\x38\x85\x5b\x2c\x84\xe3\x02\xfd\x9e\x5f\xf7\x17\xc7\x93\xd3\x79\xb8\x8b\xbc\x46\xf6\x60\x57\xae\xf4\xa0\x80\xc8\x6c\x66\x7f\x3e\xe7\x42\xf1\x4e\x5b\x9a\x73\x65\xaf\x74\x3a\x2d\x0d\x38\x19\x33\x18\x81\xac\x55\x81\x8f\x94\xcf\xad\xf6\xd4\x4d\xaa\x81\x72\x06\x05\x48\xc2\xae\xd6\xa5\x9d\xac\x06\x75\x38\x79\x77\x36\x40\xe4\xf4\x98\xea\x42\x80\xdb\xeb\x22\x2f\x38\xc7\xc1\x9c\xdb\x42\x81\x2f\xce\x48\xf9\x1b\x54\xe3\xf1\x0a\x59\x08\x33\xfa\xb5\x14\x8e\xf9\x0c\x61\xc2\x48\xb2\x46\xf4\xbb\x21\xe5\x07\x58\xde\x81\x57\x1b\xa1\x50\x51\x2d\x73\x70\x56\x41\xd7\x10\x2c\xa8\xe5\xa4\x22\x6c\x5a\xb9\x92\x78\x44\xfe\xef\x4e\xc8\x47\x51\x6f\x21\x4c\x94\xcd\x1c\x7c\xba\xf3\x05\x09\x91\x99\x50\x60\xf9\x89\x50\x3f\x29\xe9\x37\x90\xca\xc3\x0a\xb5\x86\x60\x74\xd7\x6c\x8f\x8f\x61\x26\xf0\x8c\xc2\xd0\xdd\x66\x55\x43\x53\xdf\xba\x32\xc3\xf5\x09\x51\xe1\xa6\x22\xa9\x5f\xb4\x78\x0b\xc1\x08\x31\xa7\x0b\xaa\x84\x47\xda\x4d\x0b\x01\x1a\xe6\x85\x6d\x99\x17\xb4\x69\xde\x19\xa6\xe2\xaf\xa1\xc7\x2e\x9e\xdb\x6b\x76\x22\xd4\x1c\x86\x89\xad\x17\x35\x34\x0f\x53\x90\x49\xd0\x51\x86\xdc\x07\x51\x86\xec\x80\x17\x22\x43\x06\x28\x47\xe3\xee\xf6\x39\x2f\xc7\x0d\xf0\x85\x9b\xd2\x3b\x64\xcb\x88\x56\x47\xf6\x60\x6e\x21\x18\x9b\x2f\x23\x09\xc2\x44\x42\x30\x90\x10\xcc\xc3\xfb\xd2\x96\x12\x26\xf4\x61\x38\x5f\x73\xf3\x62\x46\x8a\x7a\x31\x14\x35\x10\x3b\xa5\x05\x99\x1e\xb7\xe3\x4f\x33\xe2\x6d\xc5\xd8\x00\x41\x83\x8c\x4e\x20\x5b\x66\x2e\xa5\x02\xfc\xee\x4b\x29\x94\x1e\x69\xe2\x1a\x91\xee\x7f\x59\xdd\x73\x88\xc3\x8c\x9f\x84\xc8\x25\x4e\x42\x64\x4f\x26\x21\xe5\x61\xff\xec\xb8\x20\xf2\xa3\x57\x5e\x6e\x12\xec\x80\xfd\x72\x3e\x92\x70\x07\x13\x24\x4f\x37\x09\x45\x6d\xa1\xb4\xd2\x24\x90\x36\x98\x84\xbc\x01\x49\xa8\xdc\xdd\xc0\xb3\x0a\xa1\x19\x26\x21\xf2\x78\x93\x20\xb9\xbc\x49\x28\x53\x4b\x12\x88\x6b\xf9\xe6\xf5\x26\xe1\x8e\xdb\x2f\xd3\x2b\x09\x63\xd2\x0d\x69\xd0\x0d\x68\xce\xed\xd1\x26\xea\x9b\xf3\x9b\x84\xa2\x00\xff\xab\xee\x91\x8c\x96\x04\xb2\x00\xf9\xe5\x6b\x26\x2f\xef\x0d\x8f\xb7\xfa\x30\xf4\x76\xab\xcf\xff\x2a\x79\x22\x28\x25\x8c\xb4\x6b\xd9\xd9\xa8\xa6\x6d\x20\xaa\x69\x7b\x20\xaa\x69\x4f\x26\x12\xd5\x34\x03\x44\x35\x0d\x09\x51\x4d\x3b\x04\x51\x4d\xdb\x85\xef\x4d\xa0\x8b\x6a\xda\x16\xa2\x9a\xb6\x0b\xf1\x56\x77\xe3\x3a\xe5\x5b\x7d\xfe\x57\x69\xa8\x3a\xbe\x8c\x2e\x20\xf6\x99\x77\x81\x58\xc8\x37\x16\xf2\x6d\xe1\x05\x14\x87\x8b\x85\x7c\x9f\x42\x2c\xe4\x7b\x00\x62\x21\xdf\x35\xc4\x42\xbe\x2f\xbd\xa4\x63\x2c\xe4\x1b\x0b\xf9\xc6\x42\xbe\x3b\x30\xfc\x3a\x62\x21\xdf\xc8\xf5\xb7\x10\x0b\xf9\xee\xfe\x3c\x16\xf2\xdd\x0f\x43\x73\x2d\x8f\x73\x75\x53\x65\x3c\xee\x56\x4d\xbc\xe7\x54\xee\x72\x93\x5a\x7d\xeb\xc1\x16\xfd\x09\xc4\x9f\xd0\xdb\x97\xe5\xfd\xed\x60\x29\x34\x4d\xe7\xc0\xb3\xdf\xc4\x41\x2b\xe0\x94\x52\x68\x91\x09\xe7\xdc\x9b\x1c\x26\xa4\x62\xfa\xa7\xe4\xcb\xd5\xed\x30\x6b\xf0\x4b\x9d\x7c\x74\x19\x5d\x98\xfd\x30\x49\x3f\x12\x48\x4e\xa3\x15\xdc\x05\xa2\x15\x3c\x5a\xc1\x5b\x78\x01\x9a\x55\xb4\x82\x3f\x85\x68\x05\x3f\x00\xd1\x0a\xbe\x86\x68\x05\x7f\xe9\xf6\x90\x68\x05\x8f\x56\xf0\x68\x05\xdf\x81\xe1\xd7\x11\xad\xe0\x91\xeb\x6f\x21\x5a\xc1\x77\x7f\x1e\xad\xe0\xfb\x61\x68\xae\xe5\x71\xae\xeb\xba\x7c\x47\xef\xe7\xc0\x68\x41\x5d\xcc\xe1\x35\x90\x3c\xa7\x6d\x29\x9e\x5b\x6f\x76\xeb\xc5\xaf\xc2\x70\xab\x20\xbc\xea\xec\x6a\x69\xf6\xc2\x5a\x03\x94\xb7\x03\x15\xc9\xf2\x11\x96\x48\x96\xc3\x92\xa5\xc7\x8f\x15\x64\x95\xa4\x7a\x79\x25\xb8\x86\x07\x07\xa9\xce\x8f\xc7\x13\xc6\xc4\xfd\xad\xa4\x0b\xca\x60\x0a\xef\x54\x46\x98\x47\xdb\x3a\xdf\xd2\x69\x19\x29\xc9\x98\x32\xea\x7e\x2d\xfd\x85\x7b\x92\x7f\xf7\x1e\x81\x24\xc9\xa5\x6b\xda\x7b\xf2\x62\x76\xc1\xbb\x78\xc0\xea\x52\x39\x92\x93\xef\x5d\x2a\xa5\xc8\x9a\xfe\x9d\x3e\xc3\x7b\xb8\x52\xdb\x06\x90\x77\x42\xe8\x6b\xca\x40\x2d\x95\x06\xc7\xee\x24\xbe\x3b\x21\x2b\x7e\xa9\x7e\x95\xa2\x72\x24\xe9\xe1\x35\xa0\x66\x05\x9f\x04\xaf\x77\x73\xc0\x3d\xfc\xaa\xc0\xb1\x60\xfe\xf0\x5b\xa8\xe0\x23\xe5\xd5\xc3\x67\xb7\x7e\x7a\x2d\xf8\x3f\x2e\x0c\x16\xe0\x51\x9c\x34\x00\x5f\x94\xc2\xa5\xe0\x65\xc0\x09\x34\x28\x86\x9c\x40\xe5\x4c\xc5\x41\x26\xe0\xf9\xac\x28\xc8\x32\x51\x94\xb7\x52\x4c\xa8\xeb\x49\x06\xa0\x63\x91\x11\xd6\xc4\x4c\xf9\xcc\x23\x79\x11\x14\xe5\x6b\x2b\xac\x27\x30\x04\x25\xdd\x53\x9e\x8b\x7b\x35\x30\x47\x9c\x16\x8a\x5c\x49\xc8\x81\x6b\x4a\xd8\xa8\xf4\x29\x79\x14\x80\x96\x9e\x4f\xc7\xaf\x6b\x4d\x08\x8e\xbd\x7e\x7a\x07\x9e\xc9\x60\x1a\xb2\x26\x52\x57\x65\x0c\x4f\xb3\x85\xa8\x86\xc5\xf0\xb4\x16\x5e\x80\xcb\x33\x86\xa7\x3d\x85\x18\x9e\x76\x00\x62\x78\xda\x1a\x62\x78\xda\x4b\x0f\x54\x88\xe1\x69\x31\x3c\x2d\x86\xa7\xed\xc0\xf0\xeb\x88\xe1\x69\x91\xeb\x6f\x21\x86\xa7\xed\xfe\x3c\x86\xa7\xed\x87\xa1\xb9\x96\x97\x79\x26\xa7\x0e\xe1\x02\x3e\x0e\xa9\x66\xc8\xcf\xdc\xa5\xff\xae\xcf\xb0\x9a\xc8\x29\xe8\x2b\xbf\x76\xd6\x1e\x4c\x65\xe7\xf6\xdc\x80\x52\x64\x0a\x6e\x8d\xd4\xc3\x4e\xe1\xf8\x8d\xdb\xb4\x76\x1e\xcf\xed\xe0\x17\x82\x55\x05\xbc\x85\x05\x75\x8a\xc2\x1c\xac\x3c\x40\xde\xcc\xd8\xa7\xdb\xbe\xf7\x13\x38\x60\x46\xba\x5f\x36\xf7\x76\xef\x9c\x7e\x7e\x5e\x7d\x74\x5b\x02\x6f\xa2\x45\xce\x89\xbe\x8b\x7a\xc2\x83\x92\x77\x3b\x03\x29\x4a\x32\xf5\x08\x9b\x3b\xef\x7b\xb6\x0d\xf6\xf1\x9b\x80\x4f\xc7\x55\x55\x8d\x07\xa5\x83\xd5\xf8\xef\x1e\x4a\xe7\xd0\x82\x41\xb9\xdd\xe6\x26\x7d\x07\xcc\xee\x5e\xc8\x39\xe5\xd3\xb7\xd4\xe1\xa8\x9c\x0f\xc9\xed\x78\x1c\xb6\xd6\x71\x53\x5d\xb6\x73\x26\x94\xbe\x64\x94\x28\x3b\xfe\xed\xf0\x5c\xb8\x3f\x14\xf5\x1c\xeb\x3d\x3c\xe6\xb3\xe6\x75\x93\xdd\xe9\x9a\x3a\x84\x37\x3a\x4f\xf5\xc8\x64\xf6\xfe\xf6\xca\x66\x71\x6e\x0f\x4a\x3d\xd0\x27\xd0\x35\x7f\x38\xce\x60\xb7\xef\xdf\x1e\x67\x20\x5b\xe9\xc0\x89\x2c\x36\xbd\xbc\x47\x4d\x3b\xfa\xd3\xe5\x09\x47\x2e\x2b\x77\xc4\xab\x42\x39\xdd\xda\x27\x4e\xf7\x00\x88\x9c\x7e\x07\xfc\xd8\x39\x7e\xe7\xdc\x16\x0a\x7c\x71\x46\x4a\xe3\xa0\x9a\x92\x57\xa8\x45\x98\xd1\xaf\xa5\x70\xcc\xc3\x08\x13\xfe\x92\x09\x3e\xa1\xd3\x1b\x52\x7e\x80\xe5\x1d\x78\xf5\xfa\x09\x15\x8d\x33\x07\x67\xd5\x75\x0d\xc1\x82\x71\x4e\x2a\x32\x48\x94\x6d\xb2\x6c\x98\x09\xf9\x28\xf8\x2d\x84\x89\x0e\x9a\x83\x4f\x0b\xbd\x20\xa1\x3d\x13\x0a\x2c\x3f\x11\xea\x27\x25\xfd\x06\x52\x79\x58\xaf\xd6\x10\x8c\xee\x9a\xed\xf1\x31\xe8\x04\x9e\x51\x18\xba\xdb\xac\x6a\x68\xea\x5b\x57\x94\xb8\x3e\x21\x2a\xcc\xfc\xdc\x6a\x8f\x21\x18\x21\xe6\x74\x41\x95\xf0\x48\x17\x6a\x21\x40\x57\xbb\xb0\x7d\xed\x82\x76\xb6\x3b\xc3\x12\x02\x6b\xe8\xb1\xd5\xe6\xf6\x9a\x9d\x08\x35\x87\x61\x62\xeb\x45\x0d\xcd\xc3\x54\x63\x64\x88\x32\xe4\x3e\x88\x32\x64\x07\xbc\x10\x19\x32\x40\x19\x1d\x77\x77\xd1\x79\x39\x7c\x80\x2f\xdc\x94\xde\x21\xfb\x3a\xb4\x3a\xb2\x07\x73\x0b\xc1\xd8\x7c\x19\x49\x10\x26\x12\x82\x81\x84\x60\x1e\xde\x97\xb6\x94\x30\xa1\x0f\xc3\xf9\xa8\x9b\x17\x33\x52\xd4\x8b\xa1\xa8\x81\xd8\x69\xe3\xe7\x39\x6a\xc0\xdf\xc6\xb3\x34\x40\xb0\x21\xa3\x13\xc8\x96\x99\x4b\x89\x03\xbf\xfb\x52\x0a\xa5\x47\x9a\xb8\x46\xd2\xfb\x5f\x56\xf7\xdc\xe7\x30\xe3\x27\x21\x72\xa0\x93\x10\x59\x9f\x49\x48\x79\xd8\x3f\xab\x2f\x88\xfc\xe8\x95\x4f\x9c\x04\x3b\x60\xbf\x5c\x95\x24\xdc\xc1\x04\xc9\x2f\x4e\x42\x51\x5b\x28\xad\x34\x09\xa4\x0d\x26\x21\x6f\x40\x12\x2a\xe7\x38\xf0\xac\x42\x68\x86\x49\x88\xfc\xe3\x24\x48\x0e\x72\x12\xca\xd4\x92\x04\xe2\x5a\xbe\xf9\xc8\x49\xb8\xe3\xf6\xcb\x50\x4b\xc2\x98\x74\x43\x1a\x74\x03\x9a\x73\x7b\xb4\x89\xfa\xe6\x2a\x27\xa1\x28\xc0\xff\xaa\x7b\x24\xd1\x25\x81\x2c\x40\x7e\x79\xa6\xc9\xcb\x7b\xc3\xe3\xad\x3e\x0c\xbd\xdd\xea\xf3\xbf\x4a\x9e\x08\x4a\x09\x23\xed\x5a\x2e\x37\xaa\x69\x1b\x88\x6a\xda\x1e\x88\x6a\xda\x93\x89\x44\x35\xcd\x00\x51\x4d\x43\x42\x54\xd3\x0e\x41\x54\xd3\x76\xe1\x7b\x13\xe8\xa2\x9a\xb6\x85\xa8\xa6\xed\x42\xbc\xd5\xdd\xb8\x4e\xf9\x56\x9f\xff\x55\x0a\xe0\xee\x74\xfa\x31\xa3\x0b\x88\xfd\xf1\x5d\x20\x16\x20\x8e\x05\x88\x5b\x78\x01\x45\xed\x62\x01\xe2\xa7\x10\xec\xd1\x8b\x05\x88\x9f\x21\x88\x05\x88\x63\x29\xca\x67\x78\x4e\xb1\x14\x65\x2c\x40\x1c\x0b\x10\xc7\x02\xc4\x3b\x30\xfc\x3a\x62\x01\xe2\xc8\xf5\xb7\x10\x0b\x10\xef\xfe\x3c\x16\x20\xde\x0f\x43\x73\x2d\x8f\x73\x3d\x72\x39\x9f\x96\xbb\x9c\x53\x99\xcc\x4d\x6a\xf5\xad\x07\x5b\xf4\x27\x10\x7f\x42\x5f\xd7\x02\x1b\x2c\x85\xa6\x29\xda\x75\xf6\x9b\x38\x68\x05\x9c\x52\x0a\x2d\x32\xe1\x9c\x7b\x93\xc3\x84\x54\x4c\xff\x94\x7c\xb9\xba\x1d\x66\x0d\x7e\xa9\x93\x8f\x2e\xa3\x0b\xb3\x1f\x26\xe9\xe7\x89\x1c\xc1\xa8\xd2\x17\x05\x29\x2f\xe6\xb0\x74\xe0\x4a\xbe\xdb\x70\xb1\x21\xa3\x00\xcb\x68\x37\xa5\x20\xa5\x25\x2e\x09\x24\xa7\xd1\x31\xe0\x02\xd1\x31\x10\x1d\x03\x2d\xbc\x00\x65\x33\x3a\x06\x9e\x42\x74\x0c\x1c\x80\xe8\x18\x58\x43\x74\x0c\xbc\x74\x13\x51\x74\x0c\x44\xc7\x40\x74\x0c\xec\xc0\xf0\xeb\x88\x8e\x81\xc8\xf5\xb7\x10\x1d\x03\xbb\x3f\x8f\x8e\x81\xfd\x30\x34\xd7\xf2\x38\xd7\x75\xa9\x42\x87\x95\xfb\xb1\x3a\x46\x0b\xea\xe2\x21\xa8\x81\xe4\x39\x6d\xab\x13\xdd\x7a\xb3\x5b\x2f\x7e\x15\x86\x5b\x05\xe1\x55\x67\x57\x5e\xb4\x17\xd6\x1a\xa0\xe2\x1f\xa8\x48\x96\x8f\xb0\x44\xb2\x1c\x96\x2c\x3d\x7e\xac\x20\xab\x24\xd5\xcb\x2b\xc1\x35\x3c\x38\x48\x75\x7e\x3c\x9e\x30\x26\xee\x6f\x25\x5d\x50\x06\x53\x78\xa7\x32\xc2\x3c\x3a\x00\xfa\x56\x93\xcb\x48\x49\xc6\x94\x51\xf7\x6b\xe9\x2f\xdc\x93\xfc\xbb\xf7\x08\x24\x49\x2e\x5d\x2b\x01\x24\x2f\x66\x17\xbc\xeb\x29\xac\x2e\x95\x23\x39\xf9\xde\xa5\x52\x8a\xac\x69\x85\xea\x33\xbc\x87\x77\xb9\xed\xa5\x79\x27\x84\xbe\xa6\x0c\xd4\x52\x69\x70\x6c\xd8\xe2\xbb\x13\xb2\xe2\x97\xea\x57\x29\x2a\x47\x92\x1e\x5e\x03\x6a\x56\xf0\x49\xf0\x7a\x37\x07\xdc\xc3\xaf\x0a\x1c\x7b\x08\x0c\xbf\x85\x0a\x3e\x52\x5e\x3d\x7c\x6e\x6a\xa6\x0e\xf6\xb8\x30\x58\x80\x47\xbd\xd6\x00\x7c\x51\x0a\x97\x1a\xa0\x01\x27\xd0\xa0\x18\x72\x02\x95\x33\x15\x07\x99\x80\xe7\xb3\xa2\x20\xcb\x44\x51\xde\x4a\x31\xa1\xae\x27\x19\x80\x8e\x45\x46\x58\x13\x46\xe6\x33\x8f\xe4\x45\x50\x94\xaf\xad\xb0\x9e\xc0\x10\x94\x74\x4f\x79\x2e\xee\xd5\xc0\x1c\x71\x5a\x28\x72\x25\x21\x07\xae\x29\x61\xa3\xd2\xa7\x0a\x54\x00\x5a\x7a\x3e\x1d\xbf\x46\x3e\x21\x38\xf6\xfa\xe9\x1d\x78\x26\x83\x69\xc8\x9a\x48\x5d\x95\x31\x3c\xcd\x16\xa2\x1a\x16\xc3\xd3\x5a\x78\x01\x2e\xcf\x18\x9e\xf6\x14\x62\x78\xda\x01\x88\xe1\x69\x6b\x88\xe1\x69\x2f\x3d\x50\x21\x86\xa7\xc5\xf0\xb4\x18\x9e\xb6\x03\xc3\xaf\x23\x86\xa7\x45\xae\xbf\x85\x18\x9e\xb6\xfb\xf3\x18\x9e\xb6\x1f\x86\xe6\x5a\x5e\xe6\x99\x9c\x3a\x84\x0b\xf8\x38\xa4\x9a\x21\x3f\x73\x97\x96\xc4\x3e\xc3\xee\x50\xef\x0d\x28\x45\xa6\xe0\xd6\xdb\xdd\x83\xaf\xec\x99\xc2\xf1\x7b\xc9\x69\xed\x3c\x9e\xdb\xc6\x2f\x04\xab\x0a\x78\x0b\x0b\xea\x14\x05\x39\x58\xc5\x82\xbc\x99\xb1\x1b\x91\xb4\xe0\xfd\x04\x0d\x98\x24\xef\x97\x60\xbe\xdd\x3b\xa7\x9f\x9f\x57\x6b\xdf\x96\xc0\x9b\x68\x8d\x73\xa2\xef\xa2\x9e\xf0\xa0\xe4\xdd\xce\x40\x8a\x92\x4c\x3d\xc2\xd6\xce\xfb\x9e\x6d\x83\x6d\xfc\x26\xe0\xd3\x04\x56\x55\xe3\x41\xe9\x60\x35\xfe\xbb\x87\xd2\xd9\xb5\x3f\x28\xb7\xdb\xdc\xa4\xef\x80\xd9\xdd\x0b\x39\xa7\x7c\xfa\x96\x3a\x1c\x95\xf3\x21\xb9\x1d\x8f\xc3\xd6\x3a\x6e\xaa\xcb\x76\x72\x91\x83\xad\x4f\xd8\x69\x03\xeb\x81\x46\xc0\x20\xd3\xc2\xea\xcc\xfc\xa3\xed\x1d\xcf\xdb\xe9\x10\xc4\x02\xe4\x0c\x88\x15\x89\xf8\xaf\xd0\xc1\xda\xe1\x63\xe1\xf0\xb0\x6a\x9c\x49\x9e\x40\x40\x83\x8b\x13\x19\x95\x12\xa0\x68\x62\x6a\xec\x55\x33\xa7\xb3\x29\x25\x15\x92\xda\xe9\x64\xae\xf6\x06\x37\xc2\x5b\xcf\xf0\x8a\x11\xa5\x8e\xc2\xb1\x36\x45\x8d\x7e\x25\xda\xee\x46\x3a\x08\xd3\xee\x62\x74\x26\x78\xcb\x3f\xbe\x38\x85\xb0\x1d\xfd\x35\x7c\x34\xdf\x53\x7d\x16\x25\x34\x11\x43\x47\xba\x7d\xb2\xe2\x9a\x16\x70\x3c\xd2\x56\xd9\x0c\xf2\x8a\xd9\x87\x83\xb9\x8d\xe6\x9e\x9e\xe4\x7a\x2d\x26\x6e\xb1\xfa\x3e\x86\x60\xf7\xf7\x74\x35\xd9\xab\x19\xe1\xae\xb6\x38\xf7\x5b\xec\x9c\xd7\x30\xcc\x5e\xf9\x64\x31\xb8\xab\xa8\xce\x99\x0b\xc3\x6c\x92\x5f\x9e\x82\x8f\x45\xc7\x39\x37\xc1\x4b\x73\x76\xcd\x47\xf0\x1a\xd4\x35\x62\xdc\x6b\x50\xd7\xbc\x03\x1f\x4b\xbd\xab\x86\xef\x97\x65\xe0\x45\x85\x01\x32\x0b\xce\x8d\x36\x5c\x6d\x47\x4e\xb9\x03\xee\x34\x51\x95\x25\x83\x02\xb8\x26\xac\x79\x75\xac\x4f\xd7\xd1\x50\xed\xe7\xe2\xf5\x71\x8c\xba\x1a\xb9\xd4\x52\x65\x9a\x1d\x6b\x7f\xfc\xcc\xf8\xee\x96\x6b\x4f\x8b\xa9\x47\xd8\xa9\xdf\x8b\xe3\x6c\xa9\x75\xb4\xb4\xba\x86\x93\x7a\xd8\x67\x5d\x09\xd7\x2f\x27\xc8\x87\x10\xc3\xe4\x01\x79\x11\x46\xb8\xdc\x1f\x3f\xfa\xf4\xcd\xf7\x39\xbe\xc0\xe0\xf4\x33\x05\x72\x41\x33\xb8\xcc\x32\x51\x71\x7d\x24\x6d\x56\xff\x4d\x28\x5d\xdf\xe2\x4b\x75\xfd\x7f\xbf\xfd\x64\x3f\xa2\xad\x0a\xa2\x66\x44\xc2\xad\x14\x19\xb4\xf6\x01\x55\x12\xbb\xb8\x15\xc7\x61\xab\x71\x2e\x0a\x62\x17\x99\xe3\xb4\xa7\xc1\x62\xba\x5c\x9f\x79\xb7\x07\x5e\x0b\x06\x92\x58\xb3\xb9\xa3\x9a\x07\x61\x32\x81\xcc\x21\x92\xd2\x83\x01\xcc\xe1\xb8\xb1\x44\xf5\xd6\x10\x4b\xb7\x92\xf7\xa0\xdb\xb3\x77\x0e\xda\x1b\x4e\x26\x75\x14\x9b\x9c\xb7\xeb\x88\x96\x5b\x2d\x4a\xc1\xc4\x74\x39\x2a\x25\x90\xfc\x4a\x70\xa5\x25\xa1\x96\x31\x31\x47\xbd\xa1\x8c\x8c\x81\xb9\xb8\x46\x7d\x07\xae\xa1\x20\x3a\x6b\x42\x1e\x40\x29\xf7\x14\x6e\xaf\x9c\xb8\x10\xf9\x70\x4e\x2c\x67\x0b\x41\xa2\xb4\xdd\xd9\x50\xe0\x89\x34\xb7\xdb\x2b\xb9\xd0\x3b\xc7\x31\x58\x2e\x9f\x7f\xfe\x9a\x6f\x36\xe0\x45\x4d\x5c\xce\xbf\x5d\x13\x85\x23\x02\xef\x1c\x40\xbf\xfd\x6b\xb8\xc3\xc7\x9a\x3f\x9d\x40\xad\xc4\xb3\x2d\x3e\x50\x90\x87\xd1\x1c\xee\xbd\x04\x04\x87\x88\x7e\x2f\xa3\xd5\xea\x15\xfd\x70\x64\x51\xee\x7e\x06\xfc\x2b\x57\x44\x53\x35\xa1\x64\xec\x62\xba\x3d\xba\x43\x7b\x75\xbc\x96\xbf\xda\xd9\x61\xcb\x5f\x3e\xdb\xa3\x53\x15\xc5\x42\x35\xca\x72\xdd\x2c\xbf\xad\x0a\xd1\x1d\xab\x8d\xc9\x3e\x5d\xd1\x93\xdc\xab\x77\x8c\x28\x4d\xb3\x5f\x98\xc8\xe6\x23\x2d\xe4\xd1\xcb\xa3\x4c\x94\x5b\xe0\x4a\xe2\xff\x24\x94\x44\x6a\xea\x1e\xf2\x3d\x74\xc2\x95\x6f\xd0\xb6\x6f\xc8\x76\x4b\xdd\xef\xdf\x9e\x99\xfd\x7d\x3d\xed\x63\x8a\x00\xe4\x8f\x4a\xc2\x5b\xaa\xe6\xc7\xbe\x5d\x19\xc9\x66\x94\x4f\x6f\x44\x3e\xcc\x15\xcb\xa9\x9a\xbb\xd7\x9c\x0a\x30\xf8\xd7\xbb\xf7\x83\x8c\x3d\x20\x5b\x9b\x53\xd7\x8a\x4f\xde\xf9\x0b\xc3\xb1\x23\x1f\x8e\xb0\x26\x52\xc7\x9f\x7e\xbd\x7b\x7f\x74\x5e\x72\xed\x14\xd6\xe0\xc7\x4b\x86\x7d\x6d\x14\x64\x12\xac\x5d\x3c\x4f\xc7\x77\xa6\xed\xc6\x07\x33\xd0\xe8\x3e\xb4\xbd\xdd\x36\x97\x1f\xaf\x17\x7d\x4c\xfa\xce\xa0\x9c\x4d\x8e\x1e\xc2\x50\x08\x4e\xb5\x70\x2d\x0c\xe6\x65\x30\x0b\x64\xd8\x70\x35\xf2\xb8\x17\x78\x3a\xe3\xe7\x62\xcd\x4f\xdc\xf8\x68\x12\x80\x9f\x34\xc3\xdf\x81\x63\x95\x12\x7f\xa3\xb9\x5f\xf9\xb8\x61\x8d\x71\x3e\x05\x98\x07\xe3\xc4\x6b\x0e\x73\x54\x5e\x4a\x79\xee\xb2\x51\x67\xab\xd2\x9f\x02\x4f\x89\x97\xda\xf1\x52\x47\x83\x02\x1a\x32\xc1\x27\x74\x7a\x43\x1c\xda\x51\xf8\x51\x59\x0e\x13\x52\x31\xed\x6e\x50\x18\xde\x6a\xe6\x21\xac\x45\x47\x77\x23\x2a\xbb\x9e\x7d\x0b\xfe\x14\xd0\x42\x98\x1a\x6d\x7e\xf5\x4d\x83\x6c\xe9\xb0\x2e\xea\xd2\xad\x64\x44\x32\xbc\x7b\x7a\xb0\xb0\x73\x51\xb6\x4e\xed\x21\xa4\x0c\x9f\x47\x43\xd1\xa3\x3f\x17\x92\x2e\x06\x91\xd4\x07\x95\x42\xb9\xc8\xe1\xb6\x1a\x33\xaa\x66\xa3\x28\x12\xfa\xb5\xea\x1c\xde\xc3\x75\xa9\xb5\xa4\xe3\xca\xb2\x08\xc0\x16\x5e\x4e\x10\x8c\x97\x91\xbd\xe1\x04\xc7\x64\x77\xb9\xb8\xe7\xf7\x44\xe6\x97\xb7\x0e\x2e\xa0\x28\x25\x9f\xb1\x94\x3c\xa1\xc0\x72\x67\x9e\x1b\x6e\x1e\x35\x90\x92\x7e\x03\xa9\x3c\x0a\xcb\xad\x21\x50\x48\xe5\x6a\x7b\x7c\x6a\xad\x05\x9e\x51\x98\x86\x0d\x9b\x55\x79\x0b\xf3\x9e\x3d\x17\xa2\x7e\xf4\x7c\x16\x9e\x34\xb2\xee\x5f\x7f\x7d\x42\x17\x3b\x13\x5c\x13\xca\x7d\x7b\x6a\xb5\x10\xec\x6e\xe7\x74\x41\x95\x5f\x10\x7a\xe2\x5f\x40\xbd\x85\x50\x65\xd4\x1f\x63\x0b\xb0\x49\x67\x53\x88\xec\x39\xf4\xd4\x01\xa4\x85\xf5\x35\x3b\x11\x6a\x0e\xf3\x2e\xac\x17\x35\xec\xb3\xe0\x6f\xe3\x39\x57\x3b\x8d\xc7\xe0\x50\x94\x7a\xe9\x54\xe4\xd3\x33\x18\x00\x72\x5a\x79\x75\x9a\x76\xf7\x0b\xd3\x3f\xe0\x23\x2d\xa8\x63\xf3\x0b\x0f\xbe\x1d\x82\x5b\x07\xe0\xd1\x67\xc6\x99\x83\xf3\x63\x9f\xeb\x52\xce\xa0\x00\xe9\x62\x97\xf4\xbb\x2f\xad\x9d\xe4\x8a\x11\x5a\x7c\x81\xa2\x64\x44\x0f\xd6\x55\xb8\x00\x4d\x72\xa2\x89\xaf\xc9\xcb\x8b\xd9\x2b\xaf\x16\xb4\x61\x04\x54\xd2\x74\x42\xba\x11\xb9\xaf\x9c\x1b\x20\x19\x30\xa0\x7c\xeb\x9f\x10\x58\x43\x4d\x21\xa3\x00\xa2\x4e\x28\x5d\xa2\x31\x13\x38\x95\xe1\x7b\x0e\x01\xa5\x64\xf7\x48\xe2\x9e\x26\x14\xa2\xe7\x65\xc0\x09\x85\x10\x50\x1b\xf7\x1d\xe5\xb9\x27\x0a\xcf\x76\x95\x81\x4c\x1f\x5b\x15\xc2\xf3\x42\x84\xbb\x58\xac\x16\xa6\xbc\xb1\x84\xb5\xe2\x3f\xc2\x1b\x42\xd3\x4e\x82\x6b\xdb\x49\x68\x8d\x3b\x39\x3f\xd9\xee\x31\xf4\xaa\x79\x27\xe1\x6e\x60\xb2\x62\x4c\xa0\x22\xd9\x7b\x61\x8c\x64\x9f\x9c\x15\xd9\x07\x42\xa4\x9c\x4b\xb1\xec\x42\xb8\x07\x2c\x4c\x89\x96\x5d\x08\x22\xd1\x27\x41\x17\xd9\x82\x67\x74\xdb\x2e\x04\xbe\xc2\x61\xca\xbb\xec\x42\xf0\x09\xfa\x97\x7d\xd9\x85\x60\x34\xd2\x42\xf0\xd5\x86\xd2\x04\xd7\x10\x46\x94\x6f\xc1\x27\x1e\xef\x31\x1e\xcf\xf2\x31\xbb\x10\x50\xba\x08\xb9\xf7\xde\x25\x66\x76\xa1\x2f\x61\x25\x28\xf5\x9e\xdc\x7b\xa7\x85\x24\x53\xb7\xee\x13\x87\x26\xe5\xbd\x55\xeb\x6e\x86\x7e\x9e\xf4\xc0\xd3\x39\x91\xdd\xf1\x3e\x77\xdf\xd6\xcf\xaa\x84\xcc\xc3\x37\x72\xfc\x0a\x4c\x13\x07\x6b\xec\xd9\xa6\x40\xb1\x2a\xd6\x33\x71\x8a\xf6\xd4\x44\x4e\x41\xff\xfd\xef\x9f\xbe\xc3\x24\xe0\xfb\x7b\xea\xda\x77\xfc\x7c\x97\xed\xc3\x51\x18\x3c\x7c\x6b\x1e\x85\x18\x51\x7f\x8c\xa1\x85\x5b\x35\xf9\x16\x5e\x4e\x00\xf6\xd0\x2c\x32\x26\xb8\x7e\x37\xd1\xf3\x13\x26\xb2\xf9\xf1\x73\xc7\x73\xa2\x89\x1a\xb0\xce\xcb\x6a\xfc\xaf\x5f\x07\x49\x43\xf6\x38\xaf\x69\x53\x36\x5f\x51\xa5\x81\xeb\x21\x4a\x8d\xc5\x42\x7e\xee\x82\x6f\x99\x0f\x46\xf0\xe7\x5a\xb4\xab\xdd\xb2\xa3\xde\x30\xaa\xef\xa0\x14\x47\xe7\x88\x54\x36\xfe\x08\xaf\x13\xf2\x20\x8f\x52\x28\x3a\xe0\xf0\x0b\xea\x9e\x3b\x33\x58\x49\x88\xed\xae\x1d\x95\x40\x59\xa5\x34\xc8\xe3\x17\xcf\x02\x9e\x97\xc2\xb6\xc9\xc2\x16\xbc\xb9\xff\x77\x58\xc0\xca\x87\x3a\x37\xc7\xe5\xc2\x76\x5d\xa2\xd0\x3d\x68\xba\x69\xe3\xe8\x74\xc0\x7e\x24\x3d\x18\x51\xe9\x61\x84\x28\xaf\xa7\xf8\xc8\x34\x41\xd5\x00\x95\x0c\xb2\x19\x29\x2f\x2b\x3d\x7b\x4b\x55\x26\x16\xe0\xf7\x1e\xba\x6b\xe0\xeb\x69\x8c\xda\x68\x80\x61\x26\x31\xa0\xa8\x4f\x39\xd5\x94\x68\xe1\x91\x75\xe7\x3b\x83\x7f\x0e\x21\x8d\xac\xa8\xfe\x3d\xaf\x1f\x78\xcb\xf6\x6f\xc1\xa6\x70\xc6\xae\x85\x52\x48\x4d\x5c\x7d\xcc\xe7\x6b\xe2\x8e\x06\xc3\x33\x36\x18\xae\x5d\x52\xb7\x0d\xed\x9e\x99\x50\x40\xff\x69\x4f\x2f\x17\x35\x87\x71\xf8\xd5\xee\x36\x1d\x53\x10\x71\x23\x2e\x8f\x23\xe1\xc7\xd7\xed\xbe\x4b\xed\xaa\xed\x2c\x7b\x76\x25\x66\x9d\x04\xf1\x8b\xd5\x5a\x8f\x79\x71\xca\x8d\xa5\xfa\xdb\x36\x75\xf0\xe8\x12\x7d\x3d\x68\xb4\xbb\x5a\xc1\xc5\x76\xd7\x8e\x4a\x2f\x33\xa1\x05\xff\x7e\xfd\x1b\xf9\xd9\x15\xc5\xad\xa7\x7c\x54\x0a\x11\x52\xdf\x0b\x39\x50\x50\xc6\x77\x5b\xf1\x3a\x96\x6c\x46\x43\x29\x45\xfd\x2b\x97\x09\x7f\xf7\xc5\xe8\xbc\x72\x4a\x07\x2f\x47\xe7\x51\xac\x3b\xec\x44\x92\x50\x89\x1d\x81\xb2\x43\xc2\xe6\x0f\x05\xcb\x1e\x0a\x9c\xac\xe2\x5b\x0f\x6e\x0b\xa1\x2a\xc3\x6d\x21\x74\xee\xa4\x6f\xb5\xb8\xa7\x33\x0b\x74\x08\xe1\xb2\x7b\xc2\xe4\xf6\x78\x55\x73\xda\x42\xc0\x9c\x9e\x50\x19\x3d\x21\x2a\x34\x04\x3b\x7a\xbf\x3a\xdd\x4f\x27\xe4\x2e\x25\x3d\xc6\xe3\x79\x5e\x5e\x65\x6d\xb7\x10\xdf\x13\x13\x84\xa8\x22\xdb\xd7\xdc\x92\xa0\x95\x65\xb7\xd0\x43\x9e\x66\xb0\x6a\xb3\x5b\x08\x3e\xcb\x90\xd9\x9f\xa1\x6a\xd1\x6e\x21\x68\xea\x66\x94\x47\x7c\x66\x16\x8c\xe6\x42\x56\xb4\xdd\x42\x78\x26\x13\xb4\xca\xed\x16\x7a\xe0\x33\x81\x2a\xdf\x6e\x21\x58\x89\x92\x16\xc2\x17\x2a\x79\x8c\x37\xe8\x66\x9e\x79\xd1\x92\x16\x7a\x2f\x5d\xd2\x42\xa8\xaa\xb9\x5b\x38\xf9\xf7\x2d\x40\x4d\xdd\x2d\x04\x7d\xde\x42\x6a\x7a\x2f\x53\x47\x0b\x32\xa1\x36\xae\x23\xea\x1f\x8f\x21\xda\xb3\xec\x20\xca\x8f\x8e\x10\xed\x59\x38\x54\xd1\x9e\xd5\x31\xa1\x13\xb1\x67\x29\x90\x0b\x9a\xc1\x65\x96\x89\x8a\xeb\x2f\x62\x0e\x5e\xf6\x8c\x60\x7d\x7b\xaa\x9c\x02\x3f\x99\x5e\x08\x49\x02\x0f\x25\x95\xa4\x26\x80\x11\x64\x82\xbb\x96\x6b\xd8\xc2\x0e\xf7\xfd\xef\xff\x0a\xb2\xca\x10\x7c\x37\x04\xc7\x0d\xb4\xe7\x61\xfa\x4f\x78\x72\xc6\x00\x77\xcc\x1b\xc5\x40\x45\x36\xfe\x59\x89\xf1\xd2\xa5\x94\xbc\x1f\x0f\x98\xba\xd7\xe1\x3e\xeb\x58\x0e\x09\x53\xaa\xf4\x40\x89\x9e\x1a\x38\xe1\x8e\x0a\x85\xe7\xd0\x95\x1a\xa8\x9e\xca\xc2\x31\x56\xc9\x7b\x68\xbf\xd4\xd6\x96\x4a\x9c\xa3\x6e\x8e\xc9\x41\xe4\xf8\xe8\xd1\x36\x43\xe6\x46\x15\x64\x3a\xcc\xc8\x73\x58\x36\x66\xb5\x21\xc6\x2e\x04\xa7\x5a\xc8\xef\x30\xb5\xa7\x14\x62\x88\xac\x90\xa1\xdf\xa9\x98\x53\xe4\x25\xce\x0d\xf4\xdc\x79\xe5\x12\xd5\x8c\xcd\xe1\x77\x6b\xd6\x70\xcc\x27\x47\x65\x84\xc1\xfb\xcf\xdf\xd1\xb3\x33\x25\x1a\xee\xc9\x30\x62\x63\x29\x85\x86\xac\x56\x8a\xdf\x8a\x82\xd0\x61\x32\x73\x23\x3b\x3c\x63\x76\xa8\x14\x7b\xc7\xc9\x98\xb9\xaa\xf9\xde\xc7\xd7\x96\x5f\x76\x8f\xce\xf6\xdc\xc0\xd5\xf8\xb7\x43\xc9\x12\x6a\xa9\x34\x0c\xd3\x6b\xd1\xb7\xaa\xf3\x60\xef\xe1\x8a\xe3\x3a\xfc\x72\xc3\x2c\x5c\x7e\xdb\x9c\xd4\x51\xdf\x52\x47\x2f\xe3\x77\x9f\x2f\x71\xce\xcd\xfb\x3d\xdd\x9e\x41\x6c\xbf\xb1\x85\xfb\xf3\x59\x78\x6d\xa9\x7f\xfb\x63\x77\x37\xe5\xf9\xb6\x4e\xf6\xf5\xf8\x85\x11\x2d\x07\x7a\x20\x7d\xde\x8d\x56\xa6\x11\x47\x2f\x96\xf0\xdd\xe6\x81\x46\x25\xc4\x8b\x4d\x0c\x2a\x89\xee\x0e\xaf\xca\x61\x6a\x4b\x79\xec\xdf\x42\x95\x33\x90\xf0\xdd\x25\x7e\x6f\x14\x37\x46\xb3\xe5\x20\x19\xd8\x4f\xe6\x30\x30\xf9\xba\xa7\x98\x0c\x9c\x86\xee\x94\x27\xe2\x7c\x61\xdc\xe6\xeb\xd0\x60\xd8\x71\x86\xf6\xf2\x92\xe5\x40\x56\x9f\x57\x65\x4e\x34\x8c\xb4\x24\x1a\xa6\xa8\xb7\xd5\x96\x9f\x48\xc1\x18\xe5\xd3\xaf\xcd\x40\xd8\x43\x71\x61\x5a\x05\x79\x18\x55\xd2\xce\x41\x68\x9d\x02\xe1\x9e\xe4\xe0\x9c\xc6\x10\x2c\xca\xbf\x20\x0f\x5f\x39\x59\x10\xca\xc8\x98\xc5\x6d\xda\x0f\xd6\xb7\x5a\x5b\xbc\x8f\x96\x4b\xb3\x98\x0b\xf2\x53\xd4\x67\x39\x55\x35\x81\x7c\xd8\x6c\xe7\x35\x65\xba\xdb\xa7\x86\x13\xaf\xa1\xb1\x43\x7f\x2d\x95\x96\x40\x3a\x4d\xa2\x36\xf8\x3a\x39\x3d\x12\xd1\x83\x96\xe4\xdb\xaa\x25\x5f\x65\xa8\xf3\x8d\x30\x20\xe1\xd9\x57\x0e\x4a\x53\x4e\x70\xbd\x1d\xb6\x29\x4b\xff\xf6\xe6\x47\x73\x1a\x91\x05\xb5\xe1\x95\x2b\x1b\x45\x0a\x9b\x36\xd4\xd7\xba\x70\xa2\xc0\xc5\xee\x21\x18\xbe\x44\x64\x05\x59\xdd\xc4\x6e\x31\x60\xd2\xdc\xbb\xcb\xfb\x4e\x2a\xc2\xd2\xda\x0d\xd1\x99\x51\x7e\x44\x6f\x2d\x69\xa3\x99\x53\x6a\x94\xb3\xf0\x04\x43\x0a\x1a\x16\xdf\x1f\xe1\x70\x41\xf6\x97\x94\x72\xa5\x09\xcf\x20\xe8\x24\x1f\x21\xc6\xbc\x25\x78\xd4\x33\xa1\x34\xc6\x6c\x80\xc7\x48\x8b\x5c\xa5\x0b\x5c\x4e\x3e\x9a\x94\x4a\x49\x17\x44\x43\x4a\x8d\x31\xa9\xf8\x89\x2e\xca\x2c\xe0\x29\xa1\xae\x74\x7b\x59\xb7\x8f\x66\x88\x3b\x7b\xc9\xb9\xd0\x04\xd5\x19\x0e\xbd\xdd\xbf\x54\x93\x09\xc8\x74\x44\xff\x40\x12\x06\x02\xe7\xdb\x4f\xa3\xf4\x0e\xb4\x44\x3c\x78\x56\x38\xff\x4e\xa8\x4e\xbf\x50\x2c\x09\x63\xb0\x56\x45\xb1\x4c\x6f\x40\x93\x60\x28\x3f\xfc\x75\x74\xf1\x51\x4c\xa7\x94\x4f\xdf\xbc\x7b\xc8\x58\x65\x76\xad\x38\xe1\xbe\x25\x12\x11\xd9\x84\x47\x0d\x50\xa6\x1f\x85\x31\xa6\x11\x8f\xb0\x1a\x43\x7a\x75\x99\x5e\x53\xb3\x72\x61\x8d\x14\x63\xfc\xb0\x43\xfa\x85\x4c\xd3\x5b\x09\x13\xfa\x10\x18\xaf\x98\x03\xef\x61\x13\xbe\xde\x7d\x0c\x8b\xb0\x00\x4d\xd2\x52\x02\x13\x24\x4f\x33\x92\xcd\x20\xcd\x69\x40\xfa\xaa\xc6\xc0\x40\xa7\xb7\x42\x1a\x3d\xdc\x68\xa4\xb8\x46\xea\x68\x74\x61\x65\xa2\x1b\x90\x53\x08\x7a\xa5\x36\x18\xd3\x0f\x66\xa7\xb1\x03\xd6\x2f\xd2\x5c\x95\xd8\x12\x6d\x60\x2e\x75\x07\x53\x78\x08\x8d\xf4\xab\x82\xf4\xff\x88\x4a\x22\xfc\x8e\x56\x38\x57\x24\x1f\x0c\xa7\x66\xea\x4d\x0e\xe3\x2a\x1c\x41\xd5\x18\x17\x20\xe9\x24\x14\x35\xe1\x44\x23\x56\xa9\xce\x6b\x86\x0d\x27\xc0\x19\x95\x26\x42\xde\x13\x99\x7f\x36\xb7\xd4\xc5\xca\x61\x77\xad\x22\x99\x92\x6c\x9e\x4a\x50\xa5\xe0\x2a\xa0\x54\x5d\x4b\x4e\xcb\xf4\x23\x2d\x68\x38\xd2\x19\x01\xcf\x53\x64\x53\x61\xfc\x4c\xbf\x90\x70\xa4\x58\x8b\x75\x29\x51\xe9\xfb\xf6\x34\x8f\x2a\xab\x4f\xa5\xc1\x0b\x18\x96\x20\x29\x2f\x2b\xfd\x85\xd0\x4e\x6e\x83\xa5\xc5\x95\xfc\x7e\x35\xab\xf8\x3c\xac\x14\xbf\xc2\x7c\x43\x1e\x02\x6b\x07\xbf\x04\x44\x95\x8e\x96\x3c\x0b\x87\xaf\xe9\x01\x9c\x62\x42\xe1\x5c\x70\xa6\xd7\x26\xce\x67\x85\x79\xa5\x5d\x84\x15\x86\xdf\x4f\xb9\x90\x90\x7e\x66\x79\x50\xe5\x22\xa4\xb8\x52\xa4\xbf\x54\x93\xc0\x0c\xf2\xa6\x62\x9a\x32\xca\xc3\x9d\xfb\x06\x63\xe0\x53\x0f\x2c\xfc\xb4\xe8\xd2\x6b\x2a\x95\x0e\xba\xfe\x15\xe2\x4f\x26\x84\xc8\x00\x4c\x0b\xeb\x35\xd6\xa5\x1a\xf4\xde\xd4\xc8\x82\x8a\xe5\x77\x30\x91\xa0\x66\xcd\x83\x28\x17\x01\x45\xd3\x3b\xa1\x89\x86\xc6\x98\x12\x4e\xbe\x98\xd3\x32\xfd\x28\xf8\x34\xfd\x48\x79\x40\xab\x4f\x50\x09\x83\x4c\xd3\x46\x7f\x08\x86\x71\x15\x0e\xf2\x06\x6f\xa7\x0d\x23\x40\x33\xba\x00\x0e\x4a\xbd\x6d\x23\xb7\xaf\x66\x90\x75\xb6\x07\xc1\x09\x4b\x4c\x4c\x3f\xc2\x02\x3a\x09\x0d\xb5\x8a\x02\xb4\xa4\x59\x10\x49\x9b\x86\x26\x7e\x4c\x6c\x30\x1e\x19\xc2\x96\x61\x13\x1a\x8d\x77\x92\x97\x52\x14\xa0\x67\x50\x29\x6b\x9b\x30\x46\xb0\x5f\xd5\xa8\xb9\x69\xf3\x09\xfb\xc2\x7b\xd5\xf4\x09\x30\x61\xb7\x89\xfd\x20\x79\x4e\xdb\x00\x62\x9c\x65\xe8\xf1\x6f\x6e\x2d\xa3\x4c\xfa\x73\xdf\x27\xc9\x4c\x70\x21\xf1\x8b\xb0\x8b\x67\x6d\x2f\xe8\x1d\xb0\x1a\x3f\xe5\x53\xd4\x10\x16\x89\x12\xf6\xe1\x3a\x24\xc3\xb9\xbc\xd7\xe0\x10\x14\x52\x88\xbc\x62\x95\x45\x00\x91\x5b\xbd\x20\x97\x40\x17\x89\x79\x95\x9e\x0e\x61\xb5\x78\x09\x25\x23\x19\x14\x60\x53\x73\xc4\x61\x1c\x05\x25\x91\x04\xc1\x33\xfc\x46\x69\xdc\xee\xf8\xdb\xd1\x82\x75\xa6\x8f\x53\xe4\x91\x43\x3c\x5f\xd3\xcd\xb1\x59\x4d\x8f\xbb\xe6\x14\x35\x88\x5b\x86\x8c\x8c\xa4\x13\x22\x23\x89\x8c\x24\x32\x12\xe3\x32\xd0\x88\x35\x2d\x40\x54\xa1\x94\x57\xd4\xb0\x2b\x95\x66\xd4\xca\xaf\x21\x34\x9b\x02\x34\xc9\x89\x39\xee\xc0\x4a\xfc\xc5\xeb\x02\xc9\xc9\x4a\xbe\xec\xac\x25\x77\xf4\xa7\xaa\x04\xa3\xdd\xda\xea\xec\x19\x13\x19\xd1\xf0\x51\x90\xfc\x17\xc2\x08\xcf\x40\x7e\x12\x39\xdc\x0a\xd9\x1d\xbf\xfa\x78\xe6\x58\x15\x22\x63\x95\xd2\x20\xdf\xdf\xe2\x51\x23\xb7\x7b\x83\x39\xb4\x30\xe1\x74\xe8\x58\xfe\xfb\x28\xdc\x9c\x51\xa5\x2f\x56\xbf\xd7\xa2\xa0\x99\x11\x01\x3c\x68\x90\x9c\xb0\xb3\x5a\xf5\x7a\xd2\xd8\x44\x28\xab\xa9\xac\x91\x7f\x91\x64\x32\xa1\x59\x9b\x72\x15\x7c\x94\x19\x10\xa6\x67\x8d\x01\x6d\x7d\x59\x30\x63\xd8\xe6\xbb\xdb\xc9\x72\x8d\xd1\xab\xf7\xa5\xd3\xf2\x9a\x14\x94\x21\x79\xe6\x69\x50\x9c\xf7\x3d\x5b\x2d\x7a\xd9\xd3\x9e\xb2\x1d\xe6\x7b\xc5\x88\xb2\x60\xbc\x0e\x23\xf4\xc0\x7c\x77\xd1\x8f\x1a\xe1\xf8\x8e\xf0\xe9\x39\x91\x48\x89\x7d\xef\xfa\x55\x43\xcb\xf2\x56\x0a\x2d\x32\x9b\x2a\x43\x0e\x6a\x82\x5d\x1a\xb8\xcb\x00\x16\x5c\xb1\x05\xb7\x5a\x20\x2e\xda\x2e\xc6\xfc\x3e\xc8\xbc\xac\x4f\x7e\x55\x82\xe7\xa7\xe4\xcb\xd5\x6d\x9f\xa7\xd9\x6a\x88\x76\xe7\x69\x99\xec\xe7\x9a\xea\xe7\x98\xe8\x17\x24\xcd\xcf\x2e\x47\xf8\xa2\x21\xbc\xa1\x8d\x6e\xfb\x9e\xc2\x82\x94\x17\x73\x58\xa2\xb8\x14\x7a\x15\x17\x1b\x7a\xf6\x78\x9e\x0b\x52\x9a\x39\x77\x35\x66\x54\xcd\x3e\x09\x7d\x07\x24\x5f\x5e\xe6\xb9\x04\xa5\x70\x3c\xd7\x4e\x79\x51\xc0\x20\x43\xda\x96\x4e\x51\xc9\xac\x57\xa0\x14\x15\xfc\x72\x32\xa1\x9c\xea\xf0\x82\xcc\x13\xfc\x38\x4f\x5d\xe2\xf0\x54\x66\x8c\x02\xd7\x38\x49\xc6\x05\x7f\xb2\xb5\x17\x39\xb4\x91\x70\xad\x6b\xe5\xc2\x02\x9d\x78\x05\xf2\x73\x2d\x4a\xc1\xc4\x74\xf9\x01\xc9\x1c\x4e\x43\x94\xc3\x84\x55\x58\x4e\x01\xb9\x6b\xa8\xcf\x38\xe8\x7b\x21\x3b\x03\x2f\xb0\xd4\x9a\x09\xce\x21\xd3\x5f\x70\x86\xcd\x7e\x42\x0a\xe6\x00\x25\x61\x74\x11\x30\x92\x79\x83\xf2\x7d\xce\xe0\x34\x16\x77\x43\x1e\xee\x20\x5b\x66\xe6\x74\x9c\x3e\x26\x82\xa2\xab\x52\xe4\xb7\x92\x0a\x59\xf3\xdd\x5a\x87\x34\x99\x57\x50\xe4\x5f\x0a\xd5\x3c\x63\xf9\x38\x04\xbd\x42\x51\xea\xe5\x5b\x73\x76\x90\x0d\xb7\x2e\x20\xa7\x15\xaa\x30\xaa\xdd\x4b\x46\xff\x00\x54\xa8\x68\x62\x23\xeb\xda\x4b\xb9\xd6\xf2\xed\x49\x37\x24\xf5\x14\xbb\xd1\x8f\xd7\x4c\x28\x9d\x62\x62\xb6\x6c\x28\x0d\x5b\x1f\xd2\xea\xc0\x7a\x78\xac\xb0\x6a\x09\xaa\xe0\xa3\xd5\x96\x63\x22\x63\xe3\x8e\x87\xda\xf1\x72\x11\xd4\x13\x84\xef\xce\x6b\x2f\xac\x13\x5a\xd8\x94\x3d\xb3\x56\xe9\x6d\xab\x3a\xda\xe9\x7d\x76\x9a\xfe\xc5\x76\xbd\xc1\xd5\x37\x84\xf3\x2f\x71\x38\x20\x92\x65\xa0\xd4\x8d\xc8\xf1\xea\x91\x65\x30\x83\x8b\xd1\xc9\x32\x90\x21\x27\x9a\x8c\xac\x3a\x4c\xbb\x28\x85\xa4\xa4\xbf\xda\x36\x3e\x73\xb2\x50\xcd\x29\xb7\x2a\x3e\xe7\x34\x88\x6d\x39\x50\xa7\xc8\x1f\xbb\x42\x7a\x17\xcd\xca\xd1\x1f\x5b\xd4\xdb\xb3\xd4\x94\xb7\x1d\xcb\xd1\xe4\xe1\x42\x50\xac\x16\x32\xad\xac\x0b\xee\xa6\xa5\x0d\x06\xfb\x96\xfd\x3e\x6d\xf9\x3d\x5a\xef\x9f\xb4\x34\xbb\x85\x80\x2d\xf4\xad\xa9\x34\x59\x5d\x32\x50\x91\x8c\x0e\x41\x24\xa3\x3e\x7e\x62\x63\x0f\x4f\x9c\xcb\x71\xea\x6c\xf6\xee\xa1\x94\xad\x65\xd9\x8a\x42\x1d\x7a\x34\xb8\x97\x39\x76\xea\xc7\xe0\x4c\xd0\x49\x52\xcf\xd3\x2e\xd0\x35\xc0\xa0\x0b\xc2\x2a\x97\x0a\xd0\xce\xdd\x32\x3c\xe6\xea\x12\x0a\xdb\x82\x6b\xed\x5f\xdb\xde\x0b\x17\x9b\x43\xb4\xfa\x99\xd3\x0b\xe1\xb6\x1b\xcd\xed\xb3\x8d\x73\x0e\xf1\xb4\x38\x9e\xfb\x51\xb8\x5e\x9b\xbd\x89\xb2\xb1\x3e\x1f\xc8\x62\x49\x8b\x55\x25\x51\x7c\x8f\x15\xc7\x21\x7a\x5c\x45\xf8\x10\x58\xd4\x67\x0a\xb2\x4a\x1a\x1c\x9c\x58\x56\x5f\x8a\x7c\xb4\x42\x77\x25\xb8\x86\x07\xa3\x49\xd8\xe6\x11\x99\x28\xb4\x3a\x69\x9b\x0f\x62\x27\x69\xad\x26\x72\x35\x23\x7c\x5d\xa9\x3e\xb8\xe9\x4d\x56\xfc\xf2\x54\xd6\xdb\xcc\xe5\x93\xe0\x77\x42\xa0\x6c\xfc\x76\x26\xa3\x06\xfb\x57\x64\x0b\xd1\x7e\x17\xaa\xe0\x23\xe5\xd5\x03\xa2\x8e\xd1\x1a\x6c\x85\x20\x66\x4a\xff\x7e\x3e\x79\x1b\xdb\x9e\xc0\x57\x19\x77\xcb\x4a\xe9\x0b\xb9\x4d\x0b\xd9\x7e\xa3\x3e\xb2\x4c\x14\xe5\xad\x14\x13\x44\x3d\xc1\xc4\x85\x02\x44\x46\x58\xe3\x01\xc0\x8f\x91\x9c\xd8\x79\xd9\xd9\x78\x6b\xe4\xc1\xcf\xa9\x2a\x4b\xd6\xe4\xc8\x11\xd6\x70\xc9\xd0\x41\x17\xf6\x19\x85\xb6\xda\xba\x8d\x90\xa9\x96\x2a\xd3\x38\xc1\xb2\xd7\xa0\xdb\xde\x83\x61\x1b\xad\xa9\xc7\x11\x6c\xe3\x10\xd1\x36\xd3\x8b\x76\xea\x16\x94\xd2\x4b\xcc\xe2\x3d\xe5\xb9\xb8\x57\x3d\xbe\x60\xd3\x42\x91\x2b\x09\x39\x70\x4d\x09\x1b\x21\xdd\x2c\x89\xcb\x61\x3d\x1f\xaa\x5f\xcf\xd8\x5a\x10\x39\x2b\x21\xff\x91\xd4\xdd\x0a\xa3\x57\x12\x10\x9d\x5f\xf0\x52\x5a\x2d\x56\xfc\x42\x14\xe4\x97\x8d\xf3\xab\x16\xed\xa5\x60\xa1\x47\x51\xfd\x69\x0e\x84\x31\x71\x7f\x2b\xe9\x82\x32\x98\xc2\x3b\x95\x11\x86\x6c\x00\x61\x9d\xb4\x47\x4a\x32\xa6\x8c\x62\xef\x93\xb5\xfb\x31\x47\x9b\x5a\x4e\xd0\xed\x28\x05\xda\x19\x78\x6a\xb3\xb7\xe2\xd9\xe5\x9a\xd4\x50\x87\x65\x47\x61\xa5\x14\x59\xd3\x32\x25\xbc\xca\xb9\x8a\x0c\xa8\xd5\xbc\x6b\xca\x00\xdf\x47\xdb\x41\xe1\x8b\xaa\xed\x53\x88\xaa\x6d\x17\x44\xd5\xf6\xe0\xe7\x51\xb5\x0d\x88\x7c\x78\xd5\x36\x8a\xf0\xee\xc3\x9d\xab\x08\xbf\xaa\xda\x77\xd9\xf6\x78\xc2\xc9\xd4\x61\xca\xae\xe0\x47\xc6\x52\x19\xa9\xb4\x28\x6a\x64\xa3\x47\xa8\x9b\x2e\x1d\xe1\xb4\x05\x5a\x90\x29\xdc\x56\x8c\x8d\x9a\x3e\xd1\x46\xca\x47\xca\x93\x76\x57\x09\x6f\x0e\xb1\x0f\xbb\xb5\xa1\x30\xb3\x10\x1b\x6b\xe1\x1c\x80\xef\xa5\x16\xce\x80\xb7\x84\x94\xf4\x1b\xae\x83\x59\x0b\x96\xdb\x35\xa1\xc0\x72\x7c\xab\x66\x4b\xec\xf8\xd8\x52\x4b\xc4\x3d\xf1\x8e\x16\xb1\x45\xd3\x75\x6b\xf9\xa8\x0d\xf4\xec\xf3\x44\x2b\x73\x23\x39\x07\xbc\xc1\xb9\x2a\x0a\x61\x5b\x0f\xe0\x6f\x42\x75\xbe\xad\xa8\x85\xe0\x4a\x0b\x84\x6d\xa4\x61\x70\x3a\xa0\xb3\xd9\xcc\x7d\x63\x13\x5b\x63\xa1\x04\x8d\x91\xf2\xd0\x34\xa2\x66\x44\x42\x1e\xae\xbe\x3c\x46\x93\xb8\x58\xef\x8c\x2f\x99\x2d\x88\xfc\x28\xa6\xca\xc4\x06\x11\x53\x37\x0e\x57\x76\x2b\x77\x86\x21\x3a\xd1\x1f\xbe\x77\xf7\x44\x67\xb3\x4f\x6b\xce\xb6\x87\xda\x0e\xbe\x5c\x9d\xf3\x39\x34\xe0\xfe\xb3\xbb\x48\xb2\xd6\xf4\xbd\x99\xc8\x0f\x88\xa5\x29\x4d\xf4\xd3\x5a\xa6\x87\xef\x4d\xd6\xd4\x01\x68\xca\x65\xdd\x81\xaa\xd8\xbe\x37\x1b\x2b\x89\x74\x5f\xa7\x83\x27\xb1\xf7\x7f\x3c\xfb\x63\xad\x38\x40\xbe\x13\x6c\xbb\x0a\xf6\xfa\x29\x99\x10\xa6\x56\x7f\xaa\xc6\xcf\x12\x03\x56\xdb\x91\xfc\xbf\xff\xdf\x0f\x6b\x07\xdb\x4f\xc9\xe2\x3f\xc6\xa0\xc9\x7f\xb4\x3f\xca\x66\x50\x6c\x04\x55\x51\x02\xbf\xbc\x7d\xff\xed\x3f\x47\x8f\xfe\x7c\x68\x0b\x0f\xc9\x1a\x07\xa8\xe0\xf9\xeb\x7e\xe0\xc3\xfd\xf2\xf3\xa1\x13\x7f\xa6\x51\x1f\x3e\xef\xc6\x35\x71\xd5\x16\xe4\xbb\x5b\xef\xd5\xb5\x14\xc5\x25\x63\x5d\xf4\xde\x75\xb6\x4f\xa9\xf4\xd0\xaf\xf7\x5e\x8a\x55\xa1\x9c\x6b\x26\xee\x9f\xff\xae\x9b\xdf\xb7\x0d\x59\x0f\x3c\x05\x9d\xa2\x25\xe6\x1d\xc9\x04\xcf\x48\x10\x0d\xb5\xde\x1f\xca\x2b\x51\xa9\xb4\xe9\x1e\xd3\xd4\xf4\x45\xf6\xc2\x45\x3c\x24\x4d\xf3\xb5\xd4\xae\xaf\x02\x36\xc3\x3e\x2d\x89\xd4\x94\xb0\x14\x11\x43\x8d\x7f\x4d\x1f\x61\xc6\xaa\x8a\xe8\xfd\x40\x4f\x15\x81\xab\xd8\xb4\xfc\x01\x9e\x87\x3e\xb8\x2d\x72\xa5\x89\xd4\xa1\xd1\xf3\x86\xda\x90\x3d\x24\x50\x2d\x2a\x6c\x69\x01\xd5\xbd\xa3\xc5\x89\x0a\xc4\xc0\xcb\x56\xd8\x5a\xd3\x78\x8c\x5a\x02\x29\x52\xda\x58\x16\xf5\x32\xe8\x1e\xac\xaa\xd9\xa4\x0c\x53\xe7\x19\x8d\xb5\x52\x90\x4e\xa8\x54\x3a\xad\xf1\x2b\x4d\x8a\x80\xed\xb7\x6b\xe4\x3d\x5d\x60\x94\xfc\x99\x43\xde\xed\x40\xc3\xf2\xe6\x1c\xd2\x5c\xe8\x94\x83\xd2\x21\xf5\x85\x15\xda\xd0\x74\x88\xdc\x1b\x0d\x99\x7e\xf7\x90\x41\xb8\xfe\x98\x8c\xf0\x69\x45\x10\x65\x23\x87\xeb\xfd\x55\x90\x87\x74\xbc\x34\xf4\x65\x4f\xac\x18\x5e\x8d\x32\x30\x0f\x2d\x40\xa9\x5a\x6a\x0d\xff\x86\xb8\x88\x01\x88\x01\x24\x14\x62\x01\xa9\x26\xd3\xb4\x0c\xdb\x4c\xbb\x65\xa9\xc7\xbc\x19\xc0\x67\x84\x67\xf0\xe1\xaf\x41\xee\x04\x29\x69\x3a\x45\xc5\x82\x0e\x77\x29\xc6\x40\x24\xc8\x54\x37\x2d\xcb\x31\x3e\x52\xf4\x1c\x32\x82\xc2\x67\x55\x2f\x48\x54\x5c\xd7\xba\x48\x1f\x4e\xc8\xd6\x82\xf3\x01\x96\x77\x80\x4e\x8c\x75\x49\x39\xb4\x4c\x36\x74\x08\x24\x3a\x4a\x6d\x80\x5a\x13\x6e\x75\x7e\xfb\x81\xf0\xc5\x3b\x12\xb7\x2a\x04\xe8\x64\x3e\xa7\xc8\x57\xe4\xe7\xe8\xd0\x61\xab\xdd\x6f\xb0\xc6\x3b\x60\x86\x78\x07\x06\xbf\x03\xe8\x4f\x33\x92\xcd\x20\x95\x6d\xfb\xd3\x70\xb2\xd4\x23\xb4\xe9\x82\x48\x8a\x8a\xb2\xb5\x1d\x40\xa1\x5b\x65\xe3\x71\x6a\x1d\xd0\x50\xd3\x56\x5c\x4d\x33\x30\x17\x81\x8e\xcf\x71\x37\x44\x56\x74\x9e\xac\x28\x3e\xc7\x1d\x10\xef\xc0\x77\x71\x07\xf0\xcf\x71\xfb\x5e\x20\xc8\x22\x3e\x17\xdd\x10\xaf\xca\x79\x5e\x95\xf8\x5c\x74\x40\xbc\x03\xdf\xc5\x1d\xc0\x3f\x17\x42\x42\x4a\x4a\x9a\x2e\xda\x48\x87\x13\x36\x77\xe6\x44\x93\x14\x93\xad\x81\x1e\x9b\xf2\x74\x13\xe0\x88\x2a\x5b\x3d\xdc\xe2\x29\x4f\x4b\x91\x9f\xf8\x24\xb7\x55\xf4\xd2\x4a\x86\xf3\x52\xb4\xbc\x31\xcd\xcd\x05\xec\xf1\x28\x15\xdb\xb8\x38\xb3\x19\xa1\x01\x93\x09\x16\x20\xe9\x64\x99\x2a\x85\xdc\x00\x33\x4e\xd4\x65\x9e\x82\xa0\x9d\x3e\x60\xec\x6b\x31\x26\xd9\x1c\x78\x9e\x32\x3a\x96\x44\x86\x73\x84\x37\x13\x4c\xff\x92\xd6\xd7\x78\x4c\x54\xb8\x5b\xdc\x22\xee\x09\x2d\x13\x62\x5e\x95\x29\xa6\x4d\x93\x85\xd7\x2d\x13\xd2\xdc\xd9\x06\x79\x8f\x5d\x53\x09\x86\x8d\x77\x4e\x12\x35\xa7\x65\x5a\x4f\x9e\x4f\x53\x5e\x31\x96\xb6\xdb\x72\xdc\x4b\x23\x21\xc8\x9d\x21\xe6\xb8\xfe\x5e\xb2\x21\xe0\x21\x63\x15\xb6\x4e\x9d\x55\x16\xfc\xa9\xca\x96\xeb\x8a\xb2\xfd\x8e\xd3\xa7\xd0\x77\xb1\x5e\x43\x3f\x42\x22\xfe\x02\xb6\x80\x8b\x4b\x6b\x21\x52\x50\xa4\x20\x0f\xe4\x58\xb4\x48\xae\xd6\x0b\x47\x45\x53\xa0\x25\x4d\x58\x51\x9d\x15\x6e\x3c\xa5\xe1\x68\x0c\x4f\x5d\x3d\x1c\xbd\x39\x98\x2e\xbe\xa3\x4f\x21\x72\x41\x14\xc4\x77\xf4\x20\x44\x0a\x42\xc1\xb9\xbd\xa3\x38\x92\x8c\xcf\x68\x03\x2f\xe8\x19\x45\x21\x2c\x89\x34\xd4\x12\xc2\x77\x97\xa4\x3a\xa5\x7c\x41\x18\xcd\x57\x4a\x7c\xaa\x45\x0a\x52\x62\x43\xe3\x31\x66\xb5\x19\x69\xc2\x80\x58\x05\x69\x93\xa4\x1f\xd0\x08\x5c\xef\x53\x3a\x87\x65\xe8\x18\xe8\x1a\x25\xc6\x17\x62\x93\x4f\x63\x36\x70\xd9\x5c\xd4\x1c\x9a\x36\x48\xb8\x9a\x52\x56\xd7\x69\x83\x39\xb5\xb8\xb2\x56\x23\x80\xd2\xb4\x20\x1a\xd2\xac\x92\x12\xb8\x4e\x61\x01\x36\x85\xec\xb0\x6e\x20\xd8\x34\x41\x09\xbe\x84\x55\x7a\x7d\x3f\x68\xdb\xfc\x20\x46\x79\x78\x4f\x68\x93\xe2\xa7\x69\x01\x98\x30\x83\xc4\x7a\xc7\x9b\x54\xa9\xb4\x3f\xda\x6c\x4a\x8b\x35\xf3\x0f\x3f\xf7\x4d\xca\x06\x06\xf5\x69\xf4\xed\x6e\x8c\xaf\x4d\x8b\xe0\x74\xd5\xf4\x28\xf8\xae\x34\x43\xb4\xcc\xbb\x2f\x76\xb0\xc2\x3b\x7c\x59\x6b\x77\xbe\xd4\x82\x8b\x93\xda\x8e\x47\xed\x8e\x63\x25\x8c\xe3\xf9\x95\xf3\x10\xd6\xac\x65\x77\x24\xbb\x4d\xb3\x63\x03\xee\xe3\x38\xdd\x2f\xcf\xe1\xac\xef\xda\xee\x78\x76\x95\x6f\xeb\xb3\x3a\x02\x61\xb8\xd2\x84\xfd\x20\x98\x10\x03\xef\x51\xfe\x10\xb8\x27\xc2\x7d\x90\xde\x57\xb1\x2c\x6d\x4c\x12\x0e\x23\x54\x1a\x5d\x4b\xd2\xe5\xaa\x38\x45\xdf\x20\x95\x79\xbb\x4b\x61\xe7\x8a\xb5\x96\xbb\x6c\x10\x63\xc9\xc6\x1a\x33\x96\xe0\xed\xdd\xd2\x7d\x20\x0d\xef\x41\x47\x12\xb3\x0d\x19\xa3\x09\xb8\xd5\xf5\x87\x29\xd8\x67\x25\xc6\x5b\xb3\x09\x27\x35\xd3\x7a\x14\x1f\x91\xce\x96\x2f\xd9\x8a\x72\x96\x4b\xb1\x79\xa9\x9d\x50\xdb\xa9\x9d\xd6\x83\x38\xc8\x87\xb6\x27\xe0\xa0\x7e\x5a\x2f\xc3\x56\xf6\xb4\x5d\x83\x95\x1a\x6a\xe9\x90\xb0\x7e\xc9\xed\x1c\x29\x8e\xe2\xb2\xed\x0e\xb9\x8a\xc9\x6e\x06\x6b\xe4\xe6\xf6\xee\x18\xf2\x55\x4e\xdd\x54\x12\x37\x05\xd5\xd1\x2f\x65\xab\x8b\x38\x0e\xe3\xa8\xa8\xba\x6e\xa0\x8b\xb2\xea\x3a\x96\x87\xc2\xea\x35\xa4\xa3\xd2\xea\x78\x80\x4e\x8a\xab\xcf\x58\xc7\x70\xca\x3a\x28\xb0\x1e\x23\xd9\x29\xb1\xae\x03\x1d\x65\x35\x76\xca\xac\xe3\x28\x56\x0a\xad\xdb\x55\xea\x39\xca\xc1\xfa\xd2\xd8\xda\xad\x1d\x24\x3b\x3b\xe4\x78\x72\x72\xc0\x8e\xbf\x10\x2e\xf6\xfc\xbe\x10\xf7\xe3\x82\x40\x13\xbb\x1d\x99\xf7\x12\x27\xd1\x54\xc1\x5a\xbb\x6b\x6d\xfc\xcb\xa8\x8e\x77\x50\x32\x92\xc1\xc6\x45\xae\xe0\x9f\x15\x70\x73\x49\x75\x9b\x11\x9a\x02\xba\x29\xbe\x50\x9f\x0d\x56\x8c\xd0\x11\x34\x2a\xbf\x94\xa2\x00\x3d\x83\xa7\x85\x8e\x9f\x7d\x85\xac\x6e\x87\x69\xca\xe0\x96\x45\x61\x71\x25\xd0\x74\x5b\x80\x96\x34\x1b\xc6\x58\x33\xae\xb2\x39\xa2\xb3\x43\x0b\xd6\xa6\x1a\x65\xc5\x10\x2c\x4c\x02\xfd\x3c\x17\xf8\x6e\x1e\x3e\xfd\x3c\x9c\xf5\x69\xf4\x1b\xdf\x5b\x73\x88\x9e\x1e\x25\x9b\x48\xac\x9a\xa8\x10\x9f\xa1\x3a\xef\x22\x3b\x70\x0d\x13\xae\xb5\x8a\xab\x2a\x44\x4e\x27\x34\x4c\xdc\x56\x36\x23\x32\x05\x9e\x89\x1c\xa1\xe2\xe1\x63\x94\x24\x94\x44\x42\xe0\x9a\xbf\x31\x65\x2e\x79\x24\xa8\x84\x4c\x46\x6c\xa4\x93\x61\xde\x9b\x9e\x8d\xdd\x7d\xbd\x0c\xab\x3d\x1b\x98\xf7\x6d\x37\x0f\xf1\x31\x2e\x64\x75\xb5\xb0\x21\x68\xfb\x7e\x46\x35\x30\xaa\x74\x48\xf2\xb6\x61\xad\x5a\x12\xae\x6a\xd5\x36\x0c\x77\x25\x95\x16\x8d\xb2\x99\x91\xee\x76\x3d\x89\x95\x50\xde\xb6\x78\x49\x65\x35\x0e\x5d\xb2\x3f\xa6\x38\x9f\x09\xbf\xe6\x70\x1f\x38\x57\x7a\x8d\x15\x6b\x7d\x09\x79\xfb\x94\xce\x45\x15\xa4\xda\xbb\xa8\x74\x59\xe9\x90\x15\x42\x70\x0b\xa8\x0a\xc1\xc4\x94\x76\x2a\x37\xf8\x6e\x22\x8c\x41\xa6\x85\x4c\x83\x07\x8f\x6f\x51\x87\x15\xcd\x56\xb9\x73\x69\x26\xb8\x26\x94\x83\x6c\x5b\x5d\x04\xc7\x3f\x21\x19\x65\x54\x2f\x7b\x42\x3f\x13\x4a\xf7\x84\x7a\x5b\x63\xa6\x1f\xfc\xa5\xc8\xfb\xc2\x2c\xa9\x90\xfd\xed\x79\xc5\x69\xe8\x3d\x67\x62\x8a\xb4\x4f\xa3\x51\xb6\x7d\x94\xd2\x8c\x68\x98\x8a\x80\x05\x51\x9e\xe0\x0d\x7f\xe3\x9f\x0e\x10\xba\xc4\xff\x13\xf4\x6b\x3b\x67\x4e\xb0\x75\x80\xf1\x83\xcc\x0c\x3d\x0f\x5d\x71\xf6\xb6\xe9\x7d\xe1\x0c\x3f\x61\x2d\x49\x46\xf9\x34\xdd\x76\xe0\x0d\x4d\x28\xeb\x11\xb6\x2f\x44\x2f\x0b\xb0\xb9\xf6\x18\x99\x68\x8d\x37\x28\xed\xad\x91\xb6\x41\x46\x3d\x6d\x34\xba\x6b\xab\x35\xe6\x52\x84\x4b\xbc\xdb\xc1\x99\x9a\x9b\xb4\x86\x14\xd9\x34\x99\xa6\xbc\xa6\x16\x46\x03\xe5\x40\x06\x7d\x72\x70\x6b\x98\x49\xa1\x75\x77\x8f\x0e\xec\xec\x9b\x46\x27\x69\x6b\xfc\x4f\x9b\xb0\x37\xdc\x4a\x30\xf5\xcb\x1f\xe1\x2e\x41\x52\x91\xa7\x01\x5b\xee\xb4\xe8\x73\x29\xca\x94\x89\x29\x12\x31\xe6\xf6\xb7\x88\x43\x76\x02\x6b\x31\x4a\x50\xa0\x53\x49\x34\x84\xdf\x86\x7b\x22\x79\x7d\xa3\x72\x60\x64\x19\x0e\x3d\x82\x1e\x8d\x9f\x74\xab\xcc\x53\x26\xc6\x84\x7d\x6e\x14\xb8\x3b\x98\xb8\x74\x7d\x34\x1e\x43\xf7\x0c\xc4\x60\x63\x77\x6c\x5d\x6b\xec\xb9\x83\x4c\x02\xd1\xf0\x77\x21\xe7\x4c\x90\xfc\x33\x7f\x5f\x14\x95\xae\xff\xd7\x35\x05\x96\x5f\xcd\x08\xdf\xd7\x74\xaa\x8b\xd6\x27\x4c\xdc\x5f\x6d\xbb\xc3\xbe\xa5\xea\x40\x4f\x65\x1c\x92\xcf\x0b\x90\x92\xee\x2b\xa0\xd2\xb1\x35\x13\x56\x01\xd7\xe3\x7d\xcc\xa6\x9b\x71\x91\xc9\x84\x72\xaa\x0f\xdc\x4c\x33\xd3\xe3\x22\x87\xcb\x4e\x1c\x38\x3c\xed\x57\x30\x01\x29\x21\x7f\x5b\xd5\x4b\x1c\x65\x33\xc8\x2b\x46\xf9\xf4\xfd\x94\x8b\xcd\x9f\xdf\x3d\x40\x56\x99\xdb\x57\xa0\xcc\x61\x36\x16\xf6\x76\x76\x98\x60\x03\x5b\xcc\x49\xd3\x41\x4d\x67\xb3\x77\x1b\x2b\x34\xda\xe1\x69\x15\xe5\xea\x16\xe7\x6a\x5d\x02\xc5\x31\xa2\xab\xe9\x29\x8c\x6a\x02\x18\x64\xb0\xc6\x72\x63\xb5\x0d\xd6\xbb\xed\x35\x3f\xdb\x70\xb2\x16\x6c\x6b\xbc\xd8\x55\x79\xa9\xbf\x5e\x1f\x12\xfa\x27\xd6\x61\x74\xf6\x2b\x6f\x6e\x4f\xc3\xc0\xe3\xc5\xe9\x7d\xb0\x78\x71\x5a\x38\xff\x8b\x63\x35\xc0\x3d\xd0\xe9\x0c\x15\xa9\xba\x52\xe0\x6a\x69\xf8\x3f\xff\x82\x9e\x06\x46\x34\xc7\x1f\xd3\xc5\xce\x7b\x6d\xfc\xb4\x5d\x1a\x4a\xc2\x47\x6c\x16\xee\x0c\xd6\x0b\x09\x21\xeb\xe0\x99\x53\x2d\xae\x8d\xa0\xf5\x5d\x7c\x01\x89\xb9\x93\xe8\xab\x1b\x45\x9e\xc8\xb9\xfd\xe6\x17\x39\x77\x14\x79\xb0\x10\x2f\xce\x9e\x1f\xc6\x8b\x13\x74\x00\x7c\x6c\x0a\x66\x23\x2f\x9e\xbf\xbe\x08\x39\xc2\x64\xba\x36\x7f\x54\x8a\xfc\x3b\xb1\xd0\x6c\x17\x5a\xef\x6e\x1f\x32\x4b\xe3\x6a\x5a\x1f\x21\xf6\xde\xb8\x30\x52\x57\xe1\x28\x71\xe3\x3c\x6e\xbc\x3e\x71\xe1\xf7\x89\x0f\x9b\x73\xe5\xfb\x9e\x83\xba\xf0\xff\xc4\xf5\x0d\x48\xfc\xe6\xea\xf6\x16\x24\x4e\xef\x41\x62\xfd\x26\x24\x6e\xef\x42\xe2\xf6\x36\x24\x8e\xbb\xd1\xdc\xbe\x8f\x16\x39\x2b\x2d\xf8\x64\xae\xec\x4e\xd6\xfa\xdc\x9d\x9f\x4d\x8b\x9f\x6c\x1c\xe1\x91\xfd\xed\x40\x64\x7f\x1d\x10\xd9\xdf\xfe\x5f\x44\xf6\xd7\x39\xd9\xd3\x66\x7f\x7d\xa9\xdb\x0e\x6b\xb7\x3d\x5b\x2d\x4a\xc1\xc4\x74\xf9\x01\xcf\xb4\xac\xb3\x79\xf0\x77\xe8\x62\x77\x3e\xdf\xbd\xb5\xfa\xb1\xee\xf2\xe2\x4d\xd6\xc1\x95\x3f\x6b\xcd\x2c\x9a\xab\xa3\xd5\xcd\x6f\x7e\xd1\xea\x66\x69\xae\xb6\x13\x29\xfc\xc4\x09\xe7\xe7\xb4\x9f\x36\xea\x4e\xda\x53\x64\x51\x91\x45\xf9\xcd\x2f\xb2\xa8\xc8\xa2\xac\x59\x54\xe8\xbe\x0f\x96\xeb\xb4\x39\x37\x4b\x8d\xc6\x62\x26\x78\xd1\x1d\xaf\xc5\x04\x95\xc7\x91\x9e\x1f\xae\x69\xf4\xfe\x04\x40\x9f\x44\xef\xcf\x1e\x88\xe6\xcf\x0e\x88\xe6\xcf\xfd\xbf\x88\xe6\xcf\xce\xc9\x9e\xb6\xf9\x33\xb2\xbf\x1d\x88\xec\xaf\x03\x22\xfb\xdb\xff\x8b\xc8\xfe\x3a\x27\x7b\xda\xec\x2f\x7a\x7f\x3a\x20\x7a\x7f\x1e\x41\xf4\xfe\x1c\x82\xe8\xfd\x09\x34\xad\x16\xa2\x69\x75\x05\xd1\xb4\x1a\x4d\xab\x3b\x13\x3c\x3d\xd3\x6a\x64\x51\x58\x88\x2c\xca\x7f\x7e\x91\x45\x45\x16\x15\xbd\x3f\x2e\x33\x39\x7f\xef\x8f\xe1\x83\x6d\x85\xba\x03\xc7\x68\x43\xdc\xc6\x8d\x35\x4c\x66\x5c\x4d\x26\x20\x47\x5a\x48\xb2\xaf\xf8\x4e\x82\x7a\x75\x54\xfb\xf3\x37\x63\x92\xcd\x99\x98\xbe\x29\xa0\x30\xd5\xdf\x42\x10\xc4\x1a\x6b\x36\x83\x6c\xae\xaa\x0e\xb7\x92\x05\xb2\x92\xe8\x8e\xaa\x8e\x16\x88\xd4\x92\x77\x94\xca\x0d\x7a\x2c\xdf\x04\xab\x0e\x15\x1a\x34\x1f\x4e\xd3\x01\xef\x2d\xed\x78\x96\x71\x62\x45\x01\x39\xed\x3a\x84\x04\x7f\xcd\x15\xfd\x03\x3e\x9a\xeb\xb3\x11\xbe\xfc\x3c\xe9\xfe\xe4\xc2\xc2\x2a\x70\x81\xe7\x42\xeb\xe6\x7d\xc9\xff\xbe\xfa\xc7\x8f\x7f\x5e\xbc\xfe\xf9\xd5\xab\xdf\xfe\xfd\xe2\x7f\x7e\xff\xf1\xd5\x3f\xde\x34\xff\xf1\xaf\xaf\x7f\x7e\xfd\xe7\xfa\x1f\x3f\xbe\x7e\xfd\xea\xd5\x6f\x1f\x6e\x7e\xfd\x72\xfb\xee\x77\xfa\xfa\xcf\xdf\x78\x55\xcc\xdb\x7f\xfd\xf9\xea\x37\x78\xf7\x3b\x12\xc9\xeb\xd7\x3f\xff\x4b\xe7\xb4\x1e\x2e\xe6\xd5\x18\x24\x07\x0d\xea\x82\x72\x7d\x21\xe4\xc5\xaa\xb5\x61\xa2\x65\x75\xb8\xda\x02\x82\xff\x35\x65\x44\xbb\x6f\x07\xd2\x55\xdd\x89\x23\xc1\x53\x49\xf3\x99\x3f\x22\xf3\x7b\x72\xd1\xcc\xd9\x77\xf3\x6e\xe3\xde\x1d\x1a\xa5\x3b\x52\x62\xd1\xc1\x47\x71\xdb\xd6\x16\x96\xed\x5e\x2f\x5e\x77\xca\x18\xa1\xc5\x27\x54\xb7\x21\x2b\xb1\x86\xe4\x9f\x39\x43\x48\x4b\x2d\x52\x5c\xb9\x7b\x5c\xf2\xf3\x66\x45\x08\x8a\x30\x0a\x49\xaa\x04\x43\x03\x2c\xfc\x56\x93\x2c\x03\xa5\x6e\x44\x8e\x11\x7d\xd1\x82\xaf\x95\xd8\x8b\x17\x7a\x73\xa2\xc9\x08\x41\x69\x89\xb5\xa6\x4e\x4a\xfa\xab\x14\x55\xd9\x8b\x54\x3f\xa7\x1c\xa9\x4f\x5a\x22\xee\xa9\x21\x97\x4d\xef\x98\x7a\x6d\x88\xcf\x50\x7d\xb3\x2c\x54\x36\x09\x2d\xc7\x41\x95\x2e\xb4\x21\x84\x46\x68\x46\x2a\x77\x3e\x2a\x30\x42\xb2\xda\x82\x8d\x8c\xf5\xfc\x57\x56\x5a\xf6\x89\xca\x5d\x5b\x70\x96\xc0\x76\xc1\xd2\x96\x50\x5f\x08\x50\x91\x2c\x22\x59\xb8\x7d\xac\xd0\x86\x6f\x3b\x66\xe5\x66\xf4\xb6\x32\xc2\xba\x18\xbc\x8f\xd1\x9f\xdc\xc5\xd4\xed\x34\x90\xbd\x99\xdb\xc1\xc8\xed\x15\xfc\x61\x63\xe0\xb6\x35\x6f\xdb\x18\xb7\xad\x4d\xdb\xd6\x86\x6d\xbb\xf5\x5a\x1b\xb5\x4f\xb7\xf7\xaa\x0d\xaf\x69\x2d\x56\x57\x8c\x28\x15\x5c\x85\x5a\x34\x76\xb0\x5a\x5b\xe8\x01\x6d\xe0\xd9\xa2\xf6\xcc\xd7\x92\x9c\x09\x29\x46\x9a\x64\xf3\x11\xfd\xe3\xc0\xe4\xcd\x41\x36\xa6\x57\x3c\xab\x94\x16\x45\x5b\x20\x7d\x04\x99\x84\x03\x56\x3b\xc3\xd6\xe4\x6d\x6d\xf6\x0f\x9b\x77\xf2\x9a\x32\x7d\xa8\x6b\x85\x49\x05\x6e\x2b\xca\x7f\x2d\x95\x96\x40\x0e\xd8\x24\xcd\x38\x16\xdf\x88\x3c\x70\xc5\x3a\xb9\x28\xe6\x55\x32\x29\x47\x28\x42\x32\x76\x38\xc3\x63\xb9\x96\xa2\xd3\x76\x8b\xef\xec\x56\xd3\xc1\x0d\x29\x3f\xc0\xf2\x0e\x8c\xc2\xa2\xcd\x03\x8e\x7c\xb8\xad\x78\x1d\x56\x47\xb5\x42\x2a\xca\x96\x4f\xe3\x11\x63\x8c\x39\x36\x9e\x2f\xf3\xb3\x88\xe6\xd9\x13\x0a\x2c\x0f\x7c\x92\xa4\xa4\xdf\x40\x62\xfb\xe1\x5a\xed\x7d\x33\xdd\x6e\x53\xab\x13\x66\xfc\xde\x6f\x66\x10\xea\x04\xd6\xd6\x84\xeb\x1e\x4e\x62\xd3\xab\x0a\xf7\xbc\x59\x1e\x46\x4e\x17\x54\xe1\xa4\x60\xb4\x6a\x69\xaf\x56\x5a\xab\x94\x27\xad\x4e\x7a\xab\x92\x6b\x72\x1a\xec\x82\xac\x27\x10\xea\x7e\xa8\x46\xe8\x88\xef\xcd\x4b\x78\x6f\x10\x9f\x99\x66\xd5\x69\xce\x45\x4a\xd4\x87\x94\x38\x78\xd0\x92\x7c\x5b\xa9\x19\x15\x3f\x64\x73\xf3\x96\x0e\x73\x50\x9a\x72\x62\x8a\xd6\xde\x32\xaa\x7f\x7b\xf3\x63\x17\xf3\x40\x3a\xf3\xcc\x1e\x28\x1c\xf9\x98\x19\x4c\xc8\x99\x9b\x09\x62\x67\x3b\x0f\x7e\xd3\xc9\x93\x3c\xc9\x66\xd2\xa8\x32\x97\xf7\x07\x4e\xdc\x4c\x11\x37\x44\x67\x7e\x61\x29\x24\xcb\x6a\x82\xed\xec\x40\x88\x39\x5c\x52\x50\x7f\x1c\x7f\xf8\xfd\x1e\xb2\xbf\xa4\x94\x2b\x4d\x78\x06\xde\x93\x79\x84\xac\xdb\x2d\x8e\x41\x37\x13\x4a\x77\x33\x79\x0c\x16\x5a\xe4\x2a\x5d\x98\x44\x64\xc4\xb1\x97\x92\x2e\x88\x86\x94\x76\xf8\x0e\x31\x13\x5a\x94\x99\xe7\x4e\x1b\xae\x50\x7b\x45\xb6\xda\xbf\xeb\x4d\xb9\x34\x05\xcf\x25\xb8\x8d\xfb\xa5\x09\xb0\x4a\x0f\x1b\x4e\x90\x78\xde\x7e\x1a\xa5\x77\xa0\x65\x27\xbb\x47\xe2\xf9\x3b\xa1\x3a\xfd\x42\xcd\xc4\xd5\x8d\xa9\x2a\x8a\x65\x7a\x03\x9a\x78\xa1\xf9\xf0\xd7\xd1\xc5\x47\x31\x9d\x52\x3e\x7d\xf3\xae\x6d\x74\x1d\x0c\xdf\x2d\x91\x9d\x0d\x4b\x31\xe8\x00\xca\xf4\xa3\x98\xfa\x21\xa9\xc6\x90\x5e\x5d\xa6\xd7\xb4\xab\x01\xa9\x05\xa2\x6e\xc5\x14\x8b\xe8\x0b\x99\xa6\xb7\x86\x96\xba\x68\x5c\x62\x0e\x3c\xd0\x02\xbf\xde\x7d\xf4\x47\x52\x80\x26\x69\x29\x81\x09\x92\xa7\x19\xc9\x66\x90\xe6\x5d\xc1\x89\x48\xbc\x0c\x74\x7a\x2b\xa4\x5f\xc4\xa9\xc9\x5c\x8f\x40\xe1\xff\x92\xdf\x80\x9c\x82\x37\x69\x6f\xb0\xa4\x9d\x11\xda\x56\x98\xbe\x48\xea\x17\x84\xdb\xa2\x0a\x70\xfb\xef\x60\x0a\x0f\x21\x10\x7d\x55\x90\xfe\x1f\x51\xc9\x4e\xed\x0a\x89\x67\x45\x86\x5e\x78\x34\x53\x6f\x72\x18\x57\x7e\x87\x5f\x63\x59\x80\xa4\x13\x9f\x93\x37\x3d\xe8\xac\x3a\xd4\x1f\xdf\xdf\xe7\x30\x11\xf2\x9e\xc8\xfc\x73\xd9\xf1\xd0\x9b\x65\x85\xbb\x56\x71\x48\x49\x36\x4f\x25\xa8\x52\x70\xe5\x29\xb7\xd5\x2f\xfd\x32\x35\x84\x2e\x23\x0e\x68\x04\x3c\x4f\x45\xd7\xea\xb0\x33\xfa\x42\xfc\x48\xa5\x16\x37\x52\xa2\xd2\xf7\xed\x59\xf4\x28\x01\x4e\xe5\xc1\x4e\xee\xfe\x04\x43\x0b\x8f\xf4\x85\xe6\xc7\xb7\x15\x63\xad\x7b\xab\xe3\x44\x8c\xce\x75\xac\xfd\x09\x63\x27\xb2\x09\xf5\xc5\x79\x1a\xbb\x7c\xd7\x65\xc5\xd8\xad\x60\x34\xf3\x7b\x2d\x24\x94\x42\x51\x2d\xa4\x1f\x1a\xed\x45\xd6\x86\x2d\xa1\xbc\xac\xf4\x17\x42\x0f\xb0\x7c\xf3\x19\xae\x94\x87\xab\x59\xc5\xe7\xfe\x2a\xc4\x0a\xdb\x0d\x79\x08\xa0\x8e\xfc\xe2\xf9\xf3\x74\xe4\x97\xd7\x92\x24\x6f\x45\x36\xaf\x97\xd3\xe9\xa6\xb7\xc3\x93\x5e\x1f\x7e\x6c\x90\xd8\x56\x2a\x8c\xbf\x84\xde\xd6\x5c\x48\x3f\xb3\xdc\x5b\x83\xf1\x95\xcb\x8a\xf4\x97\x6a\x12\xe0\x35\xba\xa9\x98\xa6\x8c\x72\xbf\xf3\xda\x60\x09\x70\x5a\x01\x24\xbb\x16\x45\x7a\x4d\xa5\xd2\xde\x6b\x5b\x21\xfb\xe4\xf1\x32\xa0\xb8\xb9\x99\x4d\x7b\xd3\x6f\x8d\xc0\x5b\x27\xb8\x83\x89\x04\x35\x6b\xa4\x06\xb9\xf0\x94\x9d\xef\x84\x26\x1a\x1a\xeb\x87\x9f\x50\x35\xa7\x65\xfa\x51\xf0\x69\xfa\x91\x72\x4f\x73\x8c\xb7\x58\x45\xa6\x69\xa3\xa4\x78\x61\x59\xe7\x1a\x62\xcc\x96\xee\xcf\x21\xeb\xd0\x7a\x8f\x99\x8a\xca\xe8\x02\x38\x28\xf5\x16\x26\xa4\x62\xfa\x6a\x06\xd9\xdc\x2d\xb4\x67\x8d\xe9\x56\x8a\xb1\x7b\xde\xe4\x43\x57\x9e\x0b\x4e\xd0\xcb\x44\x51\x10\x53\xfa\x05\x2a\x62\xd3\x32\xf0\xcc\xbb\x7e\xed\x84\x50\x56\x49\xf8\x32\xab\xef\xb9\x60\x1d\x4b\xc0\x95\x71\xc2\x38\xf5\x67\x5a\x97\xbf\x76\x29\xd0\xb8\x4d\x9f\x09\x65\x48\x2c\x45\x6e\x66\x3d\x9f\xbf\x01\xc9\xe1\x50\x94\xd8\x1a\x82\xd7\x54\xea\xc5\x8d\x6c\x8c\x26\x73\xc0\x8a\x77\x21\x23\x72\x70\x2e\xda\x29\xa2\x6e\x42\xb0\xda\x5a\xc1\x52\x2e\xcb\x4e\xeb\x63\x32\x68\x3e\xb3\x47\x50\x89\xca\x66\x60\x22\x46\xa4\xfb\x19\x91\x4b\x2a\xa4\x57\xbf\x55\xca\xa9\xa6\x84\xbd\x05\x46\x96\x23\xc8\x04\xef\xea\xd0\x1c\x8e\x71\x95\x20\xa9\xc8\x8f\x38\xa0\xaa\x9a\xc4\xc9\xa3\x72\x67\x9d\x95\xa3\x5a\x25\x3b\x1d\xfe\xfc\x22\x6f\xdc\x31\xae\x89\x06\x59\xac\xe2\x25\x7e\x95\x24\x83\x5b\x6b\xfa\xfd\xef\xff\xf2\xa3\x25\x5a\x80\xa8\xf4\xd1\x6e\x8c\x49\xf2\x14\xd3\x8f\xb0\x80\x03\x6a\x8c\xe1\xcc\x0b\xd0\x92\x66\xce\x46\x6a\x1a\x42\x85\xf2\xae\x6a\xd2\x7d\x95\x02\x72\x4a\x29\x0a\xd0\x33\xa8\x94\x85\x23\xbf\xdb\x02\xae\x40\x2e\x68\x06\x37\x82\xd3\xce\x8c\x2c\x7b\x5c\x6d\xce\x83\x2f\xbb\xdb\xaa\x50\x98\x6c\x20\x97\x2c\x20\x4b\xdd\xc0\x28\x3a\xcd\x04\x17\x12\x33\x59\x6c\xb0\x61\x7b\x45\xee\xa0\xd1\x34\x29\x9f\x1e\x5b\x9c\x26\x99\xb9\x2e\x6a\x0b\x56\x02\x75\x21\xf2\x8a\x55\xa8\x24\x2d\x1c\xeb\x7c\x3a\x0f\x6c\x0c\xb4\xec\xb6\x34\x3c\x45\x8b\x4e\xc4\x2f\x19\xc9\xa0\x00\x8e\x2a\xe7\x6b\x85\x5b\x41\x49\xd0\x19\x94\x76\x98\x9b\x38\x3f\x7c\xe2\xdd\x89\x94\x53\x23\x72\x0a\xba\x99\x75\xe0\x1d\x09\xac\x2e\xc9\x78\x89\xe3\x25\x5e\x41\xbc\xc4\x4f\xbe\x3d\x8f\x4b\x8c\x51\x10\x5a\xf9\xbc\x3f\x9b\x73\x21\x2a\xde\x51\x31\xcb\x80\x9e\x83\xbe\x17\xf2\x80\x71\xd8\xcc\x53\x32\xc1\x39\x64\xfa\x8b\x69\x91\xe1\xc4\xde\x39\x40\x49\x18\x5d\x78\x86\x9f\x6c\xd0\xbc\xcf\x19\x0c\x31\xfd\x1b\xf2\x70\x07\xd9\x32\xeb\x8a\x26\x3c\x92\xce\xc6\x45\x6e\x28\xe9\x7c\x4c\xdf\x45\xd9\xe1\xb0\x34\xe0\x2e\x45\x7e\x2b\xa9\x90\x54\x2f\x0d\x69\xf3\x46\x44\xaa\x59\x6f\x9a\x8f\x9d\x7d\x1e\xb1\x56\xe4\xe3\x6f\x63\xad\xc8\x7d\x10\x6b\x45\x76\x8f\x12\x6b\x45\xee\x85\x58\x2b\x32\xd6\x8a\x7c\x0a\xb1\x56\x64\xac\x15\xe9\x8e\x38\xd6\x8a\xc4\x6d\x6a\x2c\x0a\xf8\x5d\x14\x05\x8c\xb5\x22\x23\x59\xf8\x7d\x1c\x6b\x45\x76\x81\x03\x39\xc6\x5a\x91\x3b\x3f\x8b\xb5\x22\xf7\x43\xac\x15\x19\x6b\x45\xf6\x5d\x2b\x72\x6d\x1d\x8c\xc6\xc1\xbd\x10\x8d\x83\xd1\x38\x78\x02\x06\xae\x68\x1c\xec\x1c\x25\x1a\x07\xa3\x71\x70\x2f\x44\xe3\x60\x03\xd1\x38\xb8\x03\xd1\x38\x18\xad\x40\x27\x26\x77\x6d\x21\x1a\x07\x37\x10\xc9\x62\x0b\xd1\x38\x18\x8d\x83\xbd\x0c\x14\x8d\x83\xd1\x38\x88\x9e\x5a\x34\x0e\x7a\xa0\x3d\x47\xe3\x60\xad\x53\xd2\x58\x31\x21\x56\x4c\xe8\x9e\x4c\xac\x98\xe0\x8f\x35\x56\x4c\xd8\x22\x7a\x89\xf9\xdb\xb1\x62\x02\xe6\x3c\x62\xc5\x84\x16\x62\xc5\x84\x0d\xc4\x8a\x09\x67\x54\x31\xc1\x60\xb8\x34\x93\xb5\xc9\x40\x69\xab\xc4\x18\xe9\x16\x4f\xb5\x68\x9a\x3d\x49\x6b\x52\x9f\xae\x5d\xb3\x01\x31\x1e\xdb\xc9\x1d\x9b\xe1\x03\x05\x59\x25\xa9\x3e\x60\xea\x32\x5f\xe4\xb2\x61\x83\x0d\x8a\x2b\xc1\x35\x3c\x78\xbf\x76\x13\x85\xf0\x61\xe1\x73\xb1\xb1\xf4\xb3\x1a\xf6\x6a\x46\xf8\x14\x4c\x95\x90\x13\xfc\x9b\x2b\x2b\x7e\x39\xcc\x8a\x9a\x91\x3f\x09\x7e\x27\x04\x4a\x7c\x30\x7b\x8a\x1b\x8c\x5f\x3b\x4b\xb3\xf6\xb3\x14\x05\x1f\x29\xaf\x1e\x3a\x0b\xc2\xaf\x01\xaf\x36\xb2\xc3\x45\x7f\x9e\x4f\x12\xa7\xdd\x89\xae\xd4\x58\x07\x84\xe6\xf8\x0b\x4b\x84\x95\xf1\xf4\xac\x10\x62\x7d\xfd\x90\x65\xa2\x28\x6f\xa5\x98\x74\xb6\x22\x49\xec\xce\x4f\x64\x84\x35\x21\x33\x18\xbc\xc9\xa0\x3b\x8f\x0d\xb1\xa8\x11\x06\xd9\xf1\xaa\x2c\x59\x53\xf7\x81\xb0\x86\xfb\x84\xb0\xc8\xd8\x54\xc0\xc0\x2b\x09\x38\xb3\x83\x5a\xaa\x4c\x9b\x6c\xe0\xd1\xae\x74\xbe\x76\xa5\x7b\xca\x73\x71\xaf\x02\xf3\xf8\x69\xa1\xc8\x95\x84\x1c\xb8\xa6\x84\x8d\x8c\x31\x47\x89\xdd\xb6\x3f\x47\x1f\x3e\xe0\x6b\xfd\xe8\x9e\xa4\x67\xe1\x91\x04\xd8\x8a\x4d\x57\x12\x88\xf6\x2c\x75\x51\x3f\xa4\xbf\x10\x05\xf9\x65\x63\xb7\xa9\x45\x4b\x29\x58\x08\xcc\x2a\xac\xb4\x4a\x18\x13\xf7\xb7\x92\x2e\x28\x83\x29\xbc\x53\x19\x61\xc6\xbe\x9e\x78\x81\x2b\x23\x25\x19\x53\x46\xcd\x94\x6e\x11\x2b\x97\x23\xfc\xa6\x83\xc7\xc8\x49\x81\x88\x62\x1b\x76\x96\x48\xfe\x57\xae\x89\xc3\xb0\xed\x58\x9a\x28\xa5\xc8\x9a\xee\xb4\x61\xd4\x93\x55\x38\x69\xad\x24\x5c\x53\x06\x6a\xa9\x34\xa0\xa2\xf3\x91\xea\x42\x54\x7d\xfc\x96\x12\x55\x9f\x50\x08\xa3\xea\xf3\x7d\xa8\x3e\x51\x90\xdc\x0f\xa7\x2e\x48\xae\xaa\xef\x5e\xb6\xad\xac\x4d\x52\x9e\x7b\xb1\x2e\xcc\x38\x66\xba\x20\x95\x16\x4d\x19\xbb\xd1\x23\x74\x4d\xc3\x55\x3f\x09\x35\xf6\xa3\x6b\x6b\x06\x93\x9c\x74\x35\x31\x46\xca\xe7\x98\x3a\xd3\xc9\x89\x54\x5e\xee\x6a\xc5\x73\x3a\xf3\x44\x5d\xe5\x63\x51\x2e\x29\xe9\x37\x53\x23\xf5\xdd\x69\x23\x36\x61\x42\x81\xe5\xdd\x59\x78\x96\x18\x31\xa9\x33\x68\x64\x01\xef\x6a\x8b\x4c\x95\x07\x3b\x73\x3a\x60\x5c\xbb\x86\x43\x9f\x4a\xd5\xd5\x9f\xde\x0a\x57\x10\x0e\x65\x40\xd2\xd6\x82\xfd\xdb\xc1\xc0\x0d\xc3\x54\xdb\x9f\x1f\x6e\x68\xed\xdf\x31\xf5\xa0\x29\x15\x11\xe7\xc9\xc9\x98\x75\x89\x73\x48\x33\x8c\x04\xdd\x2d\x89\x20\xce\x53\xcd\x88\x84\xdc\xaf\x9f\x5d\xb7\x7c\x7a\xb1\x5e\xaf\x13\x19\x08\x06\xb2\xeb\xf5\xe9\xe4\x83\x18\x1e\x08\x93\x09\x64\x9d\x3a\x2e\xea\x56\x18\x92\x1d\x50\x38\x30\xc9\x0c\x28\x44\xdb\x5d\x33\xc6\xbb\xe0\x35\x6e\x9c\xbe\x6d\x34\xcd\x23\x16\x80\x74\xc9\xef\xe7\x2d\x1d\x3f\x9e\xb0\x0a\xb8\xde\x43\xa6\xdd\x74\x42\x26\x13\xca\x3d\x3c\xfc\x5c\xe4\x70\xd9\x89\x03\x87\xa7\xfd\x0a\x26\x20\x25\xe4\xff\x3f\x7b\x6f\xf7\xdc\x36\xae\x24\x8a\xbf\xef\x5f\xc1\xda\xda\x87\x64\xa7\x3c\xbf\xad\xfd\x6d\x6d\xed\xce\xcb\xa9\x8c\x13\xcf\xc9\x4d\x9c\xf8\x5a\xc9\x9c\x87\x39\xb3\x2a\x98\x6c\x49\x28\x83\x04\x0f\x00\xca\xd6\xdc\xb9\xff\xfb\x2d\x92\xfa\xf2\x87\xc8\x46\xa3\x25\x4a\x36\xfa\x69\x9c\x11\x1b\x5f\x8d\x46\x7f\xf7\xfb\xaa\xde\xc0\x51\x3a\x83\xac\x52\xb2\x98\xb6\x6d\x55\x97\xff\xfc\xe1\x1e\xd2\xaa\xdf\x88\xca\xee\xec\x69\x67\x07\x05\x26\x31\xd7\x3f\xa7\x88\x96\xe6\xe4\x9d\x88\x43\x49\x75\xf2\x4e\x76\x22\xe7\xfa\x50\x12\x9e\xc8\x83\xf9\x27\x3d\x91\xd2\x9e\xc8\xf3\xa3\xa4\x3e\xf9\x27\x3f\xf9\xa5\x3f\x11\x12\xa0\x08\x29\x50\xfe\x2b\x6f\x6e\xcf\x45\x2d\x99\xc7\x8b\xb3\xf7\xc1\xe2\xc5\x69\xe1\xf4\x2f\x8e\xd7\x00\x77\x20\xa7\x33\x54\x9f\x10\x5c\x98\xf1\xe3\x69\xe0\x32\xbc\xf1\x91\x0c\x9b\xf7\xba\xf7\xa7\xed\xd2\x50\xfa\x23\x63\xaf\x9c\x76\x21\x1c\xb2\x0e\x9e\x39\x6d\x37\x46\xf8\x06\x06\x73\x27\xd1\x57\x37\x8a\x3c\x91\x73\x87\xcd\x2f\x72\xee\x28\xf2\x60\x21\x5e\x9c\x67\x3e\x8c\x17\x87\x75\x00\x2c\x6a\xac\x0f\xfb\xc9\xeb\x8b\x90\x23\x58\x42\xf0\x5e\x89\x85\x66\xb3\xd0\x7a\x77\xf7\x21\xb3\x34\x3e\xb8\xee\xce\x52\xe1\x83\x24\x01\xc2\x51\x42\xe3\x3c\x34\x5e\x9f\x50\xf8\x7d\x12\xc2\xe6\xa8\x7c\x3f\x70\x50\x0a\xff\x4f\xa8\x6f\x40\x12\x36\x57\xda\x5b\x90\x90\xde\x83\xc4\xfb\x4d\x48\x68\xef\x42\x42\x7b\x1b\x12\xe2\x6e\x78\x97\xd5\x69\x21\xa4\xb8\xce\xf6\x64\x89\x45\x92\x08\xcf\xa6\xc7\x27\x6b\x67\x70\x64\x7f\x5b\x10\xd9\x5f\x07\x44\xf6\xf7\xfc\x17\x91\xfd\x75\x4e\xf6\xb8\xd9\xdf\xbe\xd4\x6d\xc2\xda\x7d\xcf\xd6\xe9\x52\x2b\x3d\x5d\x74\x46\x44\x04\xcd\xca\xe7\x0e\x9d\x6d\xcf\xe7\xd5\x5b\xab\x1f\xea\x2e\x2f\xde\x64\xcd\xae\xfc\x79\x6b\x66\xd1\x5c\x1d\xad\x6e\x61\xf3\x8b\x56\x37\x4f\x73\xb5\x9f\x48\x11\x26\x4e\x90\x9f\x53\xf4\x36\x78\xfd\x9c\xa4\x3d\x45\x16\x15\x59\x54\xd8\xfc\x22\x8b\x8a\x2c\xca\x9b\x45\xa1\x96\xe0\x41\xc5\x9e\xeb\xf4\x39\x37\x4f\x8d\xc6\x27\x1f\x0e\x2d\xba\xe3\xb5\x18\x56\x79\x1c\xe9\xf9\x29\x9c\x8c\xde\x1f\x06\xf4\x49\xf4\xfe\x3c\x03\xd1\xfc\xd9\x01\xd1\xfc\xf9\xfc\x17\xd1\xfc\xd9\x39\xd9\xe3\x36\x7f\x46\xf6\xb7\x05\x91\xfd\x75\x40\x64\x7f\xcf\x7f\x11\xd9\x5f\xe7\x64\x8f\x9b\xfd\x45\xef\x4f\x07\x44\xef\xcf\x03\x88\xde\x9f\x5d\x10\xbd\x3f\x4c\xd3\x6a\x21\x9a\x56\x97\x10\x4d\xab\xd1\xb4\xba\x35\xc1\xe3\x33\xad\x46\x16\x85\x85\xc8\xa2\xc2\xe7\x17\x59\x54\x64\x51\xd1\xfb\x43\x99\xc9\xe9\x7b\x7f\x7a\x7e\xd0\x5b\xf9\xce\x87\xb8\x7b\x37\xb6\x67\x32\x37\xd5\x64\x02\x66\xd4\x76\x75\xfd\xb5\x69\x94\xfa\xfc\x50\x88\xf2\x53\x79\xe9\x16\xef\x65\x07\xff\xc7\xbd\x5f\x39\x64\xb2\x42\x55\x3a\xee\xa5\x27\x2b\xff\x80\xcf\x32\x97\x47\xdb\x5c\xed\x28\x7b\x1e\xed\xb7\x59\xd5\x4c\x5b\x37\xee\xee\x55\x89\xf4\x89\x72\xf5\xbb\x6c\x7e\x16\x8e\x08\xd5\xef\x4e\xb8\x59\xe8\xe6\x75\x57\x40\x8c\x7b\xb7\xd3\x25\x3f\x0f\xee\x82\xdc\x56\x50\xec\x5e\x2f\x5e\x48\x4f\x95\x90\x39\x7f\x35\xe1\x65\xe1\x78\x2c\xd2\xfe\x12\xeb\xf8\x2c\xdb\xf5\x8a\x10\x14\xd1\x5f\xa0\xbb\xb7\x94\xb3\x47\x6f\x85\xa6\x5b\xc5\xa5\xce\x30\x32\xd6\xe0\x3d\x16\x84\x13\x23\x04\xa5\x25\xde\x2a\xa1\x28\x25\xa2\xec\xff\xc3\x19\xa3\xc5\x47\x4c\x31\x55\x12\x62\x6c\xaf\x1f\x4f\xc4\x78\x65\xeb\xac\x59\x1b\xe2\x67\x88\x16\x3e\x5e\xba\x41\x4f\x3b\xcf\x6d\xf0\x23\x84\xbe\x36\x9f\xdb\x10\xa2\x6b\x21\x24\xab\x0d\xf8\xc8\x58\x4f\xbf\xf2\x52\xe7\x8e\x54\xee\xda\x40\x40\x9f\xeb\x0d\x78\x2a\xad\xfd\x6d\x44\xb7\x21\x92\xc5\xab\x20\x0b\x8f\x1f\x5b\xb4\x85\xd5\x8f\x59\xd1\xac\xab\x5e\xd6\x3e\x8a\x65\xd5\xd3\xae\x4a\x32\x24\x52\x6c\xaa\xa4\x81\xfc\xed\xa9\x04\x6b\x6a\x50\x94\x81\x8f\x25\xd5\xd7\x8e\xea\x63\x45\xf5\xb6\xa1\x7a\x5b\x50\xfd\xd6\xeb\x6d\x3d\x0d\x61\xdc\xde\x27\xb8\x37\x5e\xd3\x5a\xac\xce\x95\xb0\x96\x5d\x85\x9a\x37\x76\xb0\x5a\x5b\xd8\x03\xda\xa3\x6c\xf2\x82\xb2\x12\xb6\xe6\xc1\x77\x66\xba\x83\x5c\x3a\x39\x02\xd2\x50\xb9\x8b\xea\xb7\x67\xf0\x31\x17\x53\xb2\x95\x32\x36\x71\xa9\x67\x5e\x29\xd5\xd7\x4a\x1b\x31\x23\x03\xa5\xb6\xd2\x69\x13\x86\xc6\x89\x69\xc0\xf7\x1e\xa4\x7b\x09\xce\xc8\x74\xc7\x69\x21\x48\xa7\x70\x60\xe6\xa2\xa3\x3d\x1b\x62\xb1\xdd\x96\x37\x0c\x82\x9d\xcd\x27\x12\x74\x50\x15\x46\xa0\x2e\x8d\xce\xc1\xcd\xa0\xb2\xef\x30\xad\x7a\x30\xc6\xa4\x65\x83\xa7\x4b\x5d\xc8\x4e\x99\xc6\x1f\xd7\xb9\x2e\x26\xb2\x83\x8a\x90\x3d\x89\xd6\x2f\x23\xe6\x3d\x1d\xbe\xe1\x4f\x92\xcc\x74\xa1\x0d\x66\xb2\x58\x5b\x5f\xde\x5c\x91\x6b\x68\xa2\xba\x64\xb1\x8b\xd5\xaf\x80\x3d\x9a\x4c\xa4\xfd\x21\x6c\x2d\x78\x89\x22\xb9\xce\x2a\x55\xa1\xc4\x1c\x9f\x5e\xda\x89\x67\x58\x62\xcd\x32\xa7\x70\xcf\xbe\x3c\x03\xa5\x12\x69\xd3\x55\x9c\x1d\xb7\x85\x52\xa0\x75\x10\x3f\xcc\x8d\x6d\x0b\x2f\xba\x1e\x89\xe7\xbb\x69\x01\xd4\xcc\x9a\x79\x47\xd8\xa3\x46\xe3\x25\x8e\x97\xb8\x85\x78\x89\x1f\xfd\xf6\x34\x2e\x31\x02\x99\x93\x39\xe8\x6a\x8f\x5d\x39\xd3\x46\xb4\x3a\x9f\x41\x7a\xdb\x2b\x08\x1e\x32\x72\x65\x6b\x5e\xd7\xdd\x6e\x92\x7e\xd6\xd5\xe7\x0e\xf1\x15\xf5\x7a\xed\xdb\x78\x9b\x36\xda\x8e\x7d\x94\xb6\xeb\x7d\x06\x92\xf4\xbb\x2b\xe2\xb1\x1d\xdd\xb1\xa1\x2e\xf5\x35\x28\x2d\x32\x30\xd1\xd0\x13\x0d\x3d\x8f\x7f\x80\x24\x9d\xf8\x24\xbc\x3e\xde\x12\x9f\x84\x93\x3c\xb6\x9e\x1f\x64\xd2\x8a\x1b\x05\x57\xbb\xa2\xf7\xfa\x2c\x5b\x50\xcc\x7f\x15\x86\xe2\xb2\xc0\xb0\xfd\x3e\x96\x8f\x3a\x72\x8e\xe6\xa6\x4b\x2c\x17\x46\x77\x86\x4e\x63\x9f\xb2\x96\x9b\x5e\x8a\xf2\x13\x2c\xae\xa1\x37\x56\xc3\xc7\x3a\x80\xf4\x9b\x7b\x69\x7f\xd8\x10\x31\x2f\xa4\xba\x6c\x39\x05\x1e\x31\x26\x96\xd2\x27\xc3\xa1\xdf\x2b\x8d\x56\x37\x9b\xe6\xe9\xcc\x27\x89\xef\xf1\x9e\xf8\xee\x3d\xba\xd7\xbb\x27\x66\xfc\xde\xaf\x67\xc0\x75\x02\xab\x60\xbe\x8b\x3d\x9c\x44\xaa\x0b\x27\x64\x01\x06\xe7\x5d\xf6\x3c\x8c\x4c\xce\xa5\xc5\xd9\x8e\xd0\x91\x5d\xfe\x51\x5d\xde\x11\x5d\x47\xf9\x8e\xae\x20\x38\x92\x6b\x45\x4e\x83\x5d\x90\xd5\x04\xb8\xee\x47\xdb\x79\x3e\xbe\x37\x2f\xe1\xbd\x41\xca\xea\x5d\xb3\xea\x8c\xa6\x46\x0a\x95\xbb\x54\xe3\xb6\x63\xfb\x67\x3d\x7d\x0f\xd6\xc9\x42\xec\x76\x57\xf4\x1c\x60\x8b\xe8\x6b\x55\xe3\x32\xda\x09\x47\x36\x57\xec\xb4\x74\x20\x66\x91\x34\x62\x6e\x2d\x25\x77\x9c\x31\x86\x62\x82\x83\x13\xac\xfc\x23\x64\x19\xdd\x34\x71\xb6\x5a\x24\x45\x8d\x58\x36\xe9\xbf\x9a\xa7\xa3\x9d\x79\x25\x31\xc7\x11\xfd\x24\xc7\x1c\xc7\x98\xe3\xf8\x2c\xc4\x1c\xc7\x5d\x3f\x8a\x39\x8e\xdd\xe0\x25\xa4\xc6\x1c\xc7\x27\x10\x73\x1c\x1b\x88\x39\x8e\x5b\x10\x73\x1c\x71\x9b\x1a\x93\xd9\x5e\x45\x32\x5b\xcc\x71\x8c\x64\x11\xf6\xe3\x98\xe3\xd8\x05\x04\x72\x8c\x39\x8e\x5b\x9f\xc5\x1c\xc7\xe7\x21\xe6\x38\xc6\x1c\xc7\x7d\xe7\x38\x4e\xb4\xb9\x13\x26\xfb\x58\x94\x95\xeb\x4a\x27\x42\x98\x72\xb3\x6c\xec\xc4\x74\x5c\x1a\x98\xc8\x8e\x38\x71\xc4\xfa\x6f\x3a\xf5\x0d\x04\x82\x74\x56\x15\xb7\x63\x2b\xff\x80\xb1\xea\x36\x12\xfa\x21\xbb\x13\xa6\x60\xc0\x98\x41\xb1\x18\xdf\x02\x94\x42\xc9\x79\xaf\xe5\xb8\x5b\x8d\x57\xb2\x98\x82\x19\x23\x03\xa2\x7b\x92\xdf\x3a\x93\xec\x50\x11\x73\x56\xab\x39\x8c\x67\xda\xba\x6e\xdd\x0e\x97\xec\x96\x56\x86\xa1\x8d\x94\x50\x4a\xdf\x8d\x45\xa1\x8b\x45\xae\x2b\x3b\xc6\xd8\x7a\xb0\x16\x14\x0b\x6a\x82\x58\x6e\xe2\x61\xd9\x9e\x09\x03\xd9\xb8\x57\x06\x41\xa2\xab\x2c\x98\xb1\xa8\x70\x46\xc1\xbe\xf5\x62\x8c\x79\x0f\x76\xa4\xeb\x77\xeb\x75\x86\x98\xfe\x2c\x14\xd9\xe6\x2a\x8d\x4b\x91\xde\x42\x2f\x0d\xf7\x90\xdd\xad\x2c\xc7\xb2\x98\x0b\x25\xb3\x31\xcc\x3b\x13\x53\x50\xf8\x1a\x72\x1b\x8b\x2c\xab\xc5\xdc\xee\x83\xc5\x38\x9b\x1a\x74\x7f\x5d\x6e\x70\x67\x25\xd2\xbd\x07\xa7\x26\x89\x33\xa2\xb0\xdd\x7c\x03\x77\x49\x53\x31\x4e\xc1\xf4\xf9\x0f\x90\xb3\x5a\x22\x64\xc5\x65\xe4\x5c\x38\xa8\x8f\x6f\x5c\x0a\x6b\xcb\x99\x11\x96\xe7\xc6\x3f\xc1\xce\x35\x6b\xde\xfd\x94\xe5\x0c\x76\x45\x14\xfa\xe2\x52\x12\x0a\xd7\x1e\x38\x17\x6f\x4a\x12\x59\x34\x2f\x06\x13\x67\xdf\xe3\x81\xef\xe5\xb4\x4b\xa3\x9d\x4e\x75\x4f\x1c\x05\x12\xd9\x1c\x13\xe0\x86\xc2\x15\x2a\xa0\xca\xa6\xdb\xc7\x35\x94\x20\x1c\x64\x9f\xf5\xf4\x63\x67\x3d\x82\x9e\x39\xb5\xd8\x46\x22\x87\x60\x4c\x31\x4b\x23\x66\x69\x3c\xfa\xc1\xce\x2d\x51\x1d\x4a\xfc\x21\xb3\x07\x6b\x19\xa9\x00\x6b\xdf\xc3\x44\x54\xca\x35\x69\x84\x5d\xd4\xbf\x9b\x4b\xae\x30\x5d\x19\x7d\x43\xaf\x9d\x7d\xdf\xe5\x07\x44\xca\x0d\x3a\xcf\x45\x9f\x7b\x0a\x65\xd1\xf2\x54\xcc\x83\x73\x58\x27\x42\xaa\xca\xc0\xb7\x99\x01\x3b\xd3\xaa\x63\x09\x7c\xe5\x4d\x66\xce\x95\xbf\x74\x09\xc8\xb8\x4d\xaf\xc5\x7b\x96\x77\xa6\x9e\xcf\x5f\x41\x64\xbd\x82\x05\x7b\xb6\xfe\x5e\x62\x19\x7b\x53\x1a\x08\x58\xf1\x71\x8c\x08\x1f\xe5\x59\x3b\x45\xd4\x4d\x60\x2b\xc9\xc0\x27\xe2\x74\xea\x19\xc9\xa0\xf1\x5e\x01\xfe\x1b\x9b\xce\x80\xc5\x78\x80\x8a\xb5\xd1\x26\xc0\x9a\x58\x0b\xd9\xd2\x49\xa1\xde\x83\x12\x8b\x11\xa4\xba\xc8\x3a\x2e\x1b\x63\x5d\x26\x30\x52\x67\x07\x1c\xd0\x56\x4d\x60\xc9\x41\xb9\xb3\x4b\xcb\x91\xee\x36\x60\x1c\x98\x3f\xbf\xc8\x1b\x77\x88\x6b\xe2\xc0\xe4\xcb\x10\xeb\x5f\x8c\x48\xe1\xca\x9b\x7e\x3b\x2a\xb8\xa0\x68\xa9\x35\x08\x1f\xec\xc6\xf4\x49\x9e\x7a\xfa\x19\xe6\xbb\xea\x8e\xf4\x9c\x79\x1e\x2b\xd8\x6d\x0f\x13\x2b\xd8\x3d\x82\x58\xc1\x2e\x56\xb0\x8b\xc5\xaf\x1e\x41\x2c\x7e\xf5\xe8\xb7\xa7\x51\xfc\x2a\x56\xb0\x8b\x97\x78\x03\xf1\x12\x3f\xfa\xed\x69\x5c\x62\x8c\x82\xb0\xef\x0a\x76\x85\xce\x7a\xba\xff\x1e\xd2\xf2\x5c\xea\xec\xca\x48\x6d\xa4\x5b\xf4\x44\x76\xf5\x8c\xb4\x5b\x8c\xee\x17\xa1\xfb\xb8\x85\x01\x91\xc9\x68\xd5\x8e\x56\xed\xee\xc9\x44\xab\x76\x38\xd6\x68\xd5\xde\x20\x7a\x89\x36\xb6\x68\xd5\x8e\x56\xed\xd5\x6f\xa2\x55\x3b\x5a\xb5\x5f\xa6\x55\xbb\x27\x8b\x33\xd6\xdb\x7c\x81\x85\x1b\x63\xbd\xcd\x93\x3c\xb6\xbe\x9b\xac\xb5\xdb\x59\xe1\xa6\x67\x47\x6d\x2a\x54\x3d\x43\x22\x0f\xc8\x8c\x90\x1d\x46\x37\xdc\xdb\xd8\x5b\x08\x29\xf1\x09\xa4\xdd\x1d\xdb\xe8\x37\xab\xc4\x2b\xd6\x71\xfd\x05\xd6\xd4\xe4\x9b\xdb\x89\x2f\x37\x40\x34\x62\x79\x65\xc3\x61\x2c\x5e\x98\x18\x49\xc2\x8c\x31\x31\x93\x04\xb4\x9d\x31\x94\xde\xf8\x3c\xc2\x4c\xbb\xab\xc3\xe8\xec\x52\x14\x62\xda\x58\x68\x79\x02\x4e\x95\x4c\x45\xaf\x4f\x35\x40\xae\xe8\xce\x7c\xea\x27\xfb\xb2\x11\xca\x1a\x14\xe7\xba\x70\x70\x1f\x2c\x7b\x4f\x2c\xa2\xbc\x08\xde\x7a\x8f\x7d\xcd\x96\xc3\x9e\xcf\x44\x31\x05\xcc\x45\x40\x52\x97\xa9\x8a\x77\xc3\xac\xa8\x19\xf9\x8b\x2e\xae\xb5\x46\x29\x33\xfd\xdc\xba\xc1\xf8\xdd\x42\x8f\x8f\x80\x7f\x29\x16\x3e\xcb\xa2\xba\xff\x5a\xf6\x84\x18\x24\x5e\xac\x5a\xed\x0e\x13\x79\x3a\x49\x1c\xb7\xd3\x8a\x37\xdd\xb7\xf9\x29\x27\xc2\xaa\xf7\xf4\xbc\x10\x22\x1f\x23\x0b\x69\xaa\xf3\xf2\xca\xe8\x89\xec\xdb\x21\x8f\xf3\xd3\xa9\x50\x4d\x35\x33\x0c\xde\x64\xd0\x9d\xc7\x56\xbf\xaa\x11\xb2\xec\x78\x55\x96\xaa\x79\x87\x84\x6a\xb8\x0f\x87\x7d\xd8\xc7\x67\x8a\x37\x59\xe0\x64\x14\xbb\xb0\xa9\xeb\x73\x20\x46\x2b\xf7\xe9\x5a\xb9\xef\x64\x91\xe9\x3b\xcb\xcc\xe3\xa7\xb9\x15\xe7\x06\x32\x28\x9c\x14\x6a\x77\x69\xd2\xa7\xf3\x45\x6d\xfb\x53\xf4\xfc\xb5\xf8\x56\x8f\xee\x51\x16\x7d\x78\x20\x01\xb6\x62\xd3\xb9\x81\x9d\x75\x7a\x13\xa4\xb4\x51\x3f\xa4\x3f\x0b\x0b\xd9\xbb\xc6\x8a\x5c\x8b\x96\x46\x2b\x0e\xcc\x96\x57\x5a\x6d\x32\xfe\xaf\x8c\x9c\x4b\x05\x53\xf8\x50\x2b\xe8\x1d\xd5\x8e\x7d\x26\x9a\x34\xf9\xba\xa5\xb8\x91\x4a\xf6\x53\xba\x47\x19\xc3\x0c\x51\xd2\x66\xf0\xf2\x85\x46\x23\x0a\x0c\x0e\x3b\x4b\x24\xff\x2b\x57\xc4\xc1\x64\x32\x29\x8d\x4e\x2f\x75\xd5\x17\x02\x84\x55\x4f\x96\x95\x3e\x6b\x25\xe1\x42\x2a\xb0\x0b\xeb\x00\x55\x38\x19\xa9\x2e\x44\xd5\x27\x6c\x29\x51\xf5\xe1\x42\x18\x55\x9f\xd7\xa1\xfa\x44\x41\xf2\x79\x38\x76\x41\x72\x99\xaf\xf1\x2e\x4d\xbb\x5f\xb7\xd0\x00\x41\xcc\x38\x88\x22\x64\x95\xd3\x79\x8d\x60\xf4\x00\xdd\x37\x7d\x0b\x1d\xc2\x1f\xe6\xb9\x89\x25\x1b\xda\x2c\x13\x91\x09\x27\x82\xe5\x73\x4c\x66\x52\x72\x24\xb9\x3a\x5d\xc5\x1b\x8e\x67\x9e\xa8\xab\x7c\x28\xca\xc5\xb7\x48\x43\x6f\x02\xba\x35\x1a\x1a\x23\xa6\xaa\x39\x1a\x19\xe3\x5d\x6d\x91\xd9\x52\xf4\x57\x89\xf7\x78\xed\xdb\x40\x15\xee\x53\xa9\x24\xd7\x16\xb2\x70\xa8\x1e\x24\x3b\x2d\x95\x88\xa0\x6e\x8e\x46\x43\xed\x05\xec\x7e\xe8\x11\xdb\xd5\x56\xce\x0b\xab\xfc\xb6\xc7\x9e\x43\x4e\x2b\x30\x5d\xcc\x3d\xb8\xfd\x28\x4c\x26\x90\x76\xaa\x90\x28\xa2\xeb\x29\xb1\x88\xc2\x81\x29\xe3\x8c\x42\xb4\xd9\xb5\xde\xe0\x36\xbc\x42\x8b\x53\x67\x39\x1a\xb1\x22\xe3\x6f\x76\x5d\xdd\x75\x5d\xde\xd8\xfc\x3c\x96\xd5\x7a\xfc\x83\x9d\x5b\xb2\xa2\x9a\xaa\x70\xe7\xb3\x5c\xef\x60\x66\x7d\x9c\xf9\x4e\x9b\xdb\x9d\x99\x1a\xa1\x49\x45\x1d\x2b\x98\x2a\x7d\x23\xd4\x85\x54\xee\xd9\xd1\x77\x92\x68\x1f\x69\xa6\xba\x48\xc5\x4e\xee\x88\x21\xec\x54\x17\x4e\x16\x95\xae\xec\x58\xc9\x02\xc6\x4d\xde\x64\xa7\xb9\x10\x45\xe2\x13\x55\xd9\xd9\xb8\xbf\x1a\x03\x96\x6f\xdd\x02\x94\xe3\x52\x98\x5a\x37\xef\xab\x97\x8b\xb3\x28\x3e\xc0\xd8\xaf\xec\x1c\xf0\x9d\xc9\x2b\xe5\x64\x73\x18\x50\x64\x5c\x07\xb2\x41\x6a\x9d\x30\x8e\x0b\x6d\xd1\x50\x4d\x27\xe3\xc4\x1d\xb0\xd7\xd9\xf6\x4c\x6a\x85\x8b\xa7\xeb\x38\x2a\x2f\x17\x87\xc9\x19\x10\xf9\x58\x36\x36\x26\xb7\x60\x59\xeb\x32\x4a\x7e\xac\xfa\xf2\x64\x51\xd8\x2a\x0b\xe3\x89\x34\xd6\x35\x45\xc6\xad\x13\x39\x82\x44\xfa\x6e\x5a\x8d\x94\xf9\xa2\xf5\xbe\x9f\x19\x64\xbb\x1d\x0f\x18\xbe\x98\xc1\x38\xd3\x6e\x5c\x80\x75\xdd\x86\x53\xdc\x1e\x2c\xd1\x71\xd1\x12\x62\xfd\x0e\x52\xf7\xe1\x3e\x85\x6e\x23\x2c\x66\x2b\x94\x28\xa6\x95\x98\x76\xdf\x72\x84\x27\xd0\x4b\x36\xea\xf6\xfe\xe5\xe2\x7e\x7c\xb3\x70\x1c\x8c\xa7\x46\xc5\xc4\xc3\x72\xb0\xb6\x27\x9a\xda\x93\x57\xfb\x3e\xa3\xbd\xe9\x75\xb9\x9e\x03\xaa\x75\x83\x17\x4b\xdb\x37\x35\x43\x31\x13\x45\x0a\x9f\xfe\x2b\x88\x8e\x45\x29\xc7\xd3\xde\x48\xac\xc3\x12\xf2\x0d\x08\x03\x66\xec\xf4\x2d\x14\xe3\x3e\x3f\x12\x6a\xdc\x54\xf4\xe2\xc1\x2a\x3c\x8d\x75\xfd\xc2\xe8\x1e\x6f\xb4\x5f\x40\x97\x4f\x13\x74\x5f\xdc\x89\x5f\x43\x27\xaf\xa0\x84\x64\xbf\x49\x05\x7e\xcd\xd1\x13\x0f\xc7\xf9\x0a\x7c\xda\x29\x61\x5b\x29\xa1\x23\xd0\x3c\x7e\x8a\x0a\xce\x43\xef\x6e\x83\x2d\xd2\xf0\x06\x22\x0d\x3f\x3b\x07\x4e\x1a\x46\xfd\x2c\x15\xe9\xac\x56\xb7\x27\x06\x6c\xa7\xa3\x03\x27\x7b\x3c\x40\x37\x9e\x0b\x23\x7b\xa3\xe0\x7c\x10\x77\xb7\xbd\xf7\xc3\xe5\x1c\x83\x41\x60\xab\xad\x44\x7c\xea\xfa\x21\xb2\x89\x5d\x10\x9f\xba\x4e\x88\x34\xbc\x03\x22\x0d\xa3\x7f\xb6\xe4\xd5\x3d\x47\x1d\x59\x75\x0b\x91\xcc\x77\x41\x64\xd5\x9d\x10\x69\x78\x07\x44\x1a\x46\xff\x2c\xd5\x06\xc6\xa2\x94\xe3\x65\x57\xb0\x23\x32\x95\x65\xc2\x89\x71\x5f\x64\x34\x6a\x3c\x59\x8c\xd7\x61\x57\xbd\x4d\xd9\x0e\xbb\x48\x59\x8c\x4b\x9d\x1d\xd9\xa4\x36\x75\x5a\xc6\x95\x09\xb7\x42\xb7\x7c\x6a\x9c\xed\x2a\xc1\xe2\x83\xca\xaa\xb5\x6b\x29\x9d\x75\xd6\x56\xc1\x5e\xeb\x39\x18\x39\x59\x8c\xad\x45\x2c\xb4\x1b\x57\xef\x85\x9b\x82\x96\x3b\xfd\x6b\x18\xee\x7c\x23\xd2\x5b\x28\xb2\xb1\x92\x37\x46\x74\x17\xf8\x40\x6d\x67\x33\xa1\xf1\xbf\x8f\xeb\xab\x76\xd3\xd3\x01\xd1\x03\x21\x33\x3a\xa5\xf5\x6d\x55\xd6\xe2\x24\xc2\x67\xd3\xeb\x11\x49\xb5\xe9\x0e\xc0\x42\xdc\xb5\xe1\x43\x82\x71\x17\xb9\x69\x77\x5b\x4f\xb6\x98\x8e\x8b\x4a\xa9\x71\xbb\xfc\xfd\x13\xba\x81\x20\x3a\xef\x29\x8f\x8b\x38\x21\xbc\xac\x03\xf7\xa9\xaa\x30\x3d\xe3\xf7\x56\xba\x68\x9f\x72\xd6\xaa\x12\xd8\x7e\xf0\xef\x43\x10\x3a\x5b\xcd\x99\x57\x68\xc2\x5d\x98\x16\xfa\x63\x65\x5a\x88\x14\xf1\x04\x5e\x24\x45\x30\x72\x64\x04\xb7\x61\xe5\x6e\x28\x4a\xf2\x38\x63\x34\xf5\xa0\x71\x62\x93\x15\xfb\x68\x05\x47\x25\x8c\x47\xd9\x1d\xd4\x13\xdf\xa8\xc8\x91\xb6\xa6\x1b\xdf\xa8\x48\x11\x0f\xa7\x7b\xbc\x6f\x54\x3f\x69\xc5\x27\xea\x19\x38\xba\x27\xaa\x17\x51\x29\x4c\x47\x85\x06\x54\xb6\x56\x2e\xdd\x58\x16\x73\xa1\x64\xb6\x54\x2e\xc7\x4e\x8f\xc1\x18\x4c\xc8\x6b\x9f\x69\x66\x26\x9a\xd0\x06\x55\xc1\xb8\x49\x11\x65\x30\x04\xd6\x7b\x31\xbe\x85\x05\x57\x3c\x64\x8d\xaa\xcf\x96\x8d\x8d\x61\xef\x36\x98\x60\x2f\x54\x06\x4d\xe1\xf1\xfe\xca\x1b\x68\xf2\x5f\x63\x1c\x23\xaf\x16\x1a\x33\x58\x27\x73\xe1\x60\x9c\x56\xc6\x40\xe1\xc6\x30\x47\x74\xda\xc2\x9b\xeb\xe1\xbe\x34\x60\x79\x73\x95\xdb\xa4\x21\x5e\x74\x6d\x0c\xbe\x92\x05\x9f\xc7\xa9\x49\x77\x71\x32\x87\x3e\x37\x6c\xe2\xb5\xa3\x4d\xda\xc1\x98\x9f\xc6\x9a\xf2\x2a\xcd\x7c\xf9\xe6\xba\x0e\xab\xee\x43\x89\x94\x91\x3c\xde\x20\xec\xab\xdd\x18\xe5\x20\x2f\xdd\x62\xbc\x2c\x85\xce\xb6\xfa\x06\x75\xcb\x3c\xb9\xaf\xed\x12\x5f\x7f\x79\x70\xdc\xbe\xfa\x49\x9e\x34\x9e\xd1\x82\xaf\xa3\x0f\xcf\x3f\xb6\xf1\xa3\x05\x51\x1c\x2f\x21\xa1\xf6\xba\xfe\xdb\x23\xe0\x37\x07\x7f\x65\x69\xf8\xbd\xef\x46\xc0\x30\x5e\xf7\x64\x7b\x1c\x7c\x11\xf9\xfa\x2c\xf6\x78\xe0\x94\xb3\xf6\x43\xde\xe7\x8a\x0d\xc2\xfe\x87\xee\x67\xd3\x34\xe4\x7b\x9b\xf5\xa2\xc4\xaa\xca\x9e\x98\x2b\x87\xa8\x74\x95\x78\x93\xba\x77\x84\x01\x42\xe9\xc4\x13\x35\xde\xfd\xe5\x25\xb3\x60\x11\x62\xc8\xc0\x0b\x23\x86\x60\xfd\x5c\x7e\x9c\xc8\xf8\xbc\x91\x08\x62\xc4\x92\x21\x8a\x00\x5b\x9d\x34\xd4\x41\x8b\x17\x29\xd0\xa2\xac\xd7\x35\xf6\x56\x99\xbc\xb0\x53\x45\x20\x1f\x7e\xe1\x23\xfa\x78\x4c\x1d\xfb\x02\x7a\xa3\xc4\xab\x50\x5e\xc8\x3d\xe5\x28\x9f\x1d\xf6\x54\xa5\xbc\xa6\xed\x23\x9b\xf9\xcc\x19\xad\x52\x0d\x5d\x2a\x98\x24\x3e\xfa\xec\x04\x45\x6c\xf4\x37\x62\x0e\xd9\x51\x29\x44\xd1\xf2\x17\xc3\xfd\x95\x2d\x82\x5f\xc1\x47\xfe\x26\xa0\x27\x28\x5d\x94\x8d\xf2\x55\xbc\x28\x63\x10\x95\x2f\xf2\x50\x04\x05\x8c\x70\x40\xde\x4a\x18\x75\x8c\x7d\x3a\xc7\x3c\x95\x31\xe2\x08\x78\x85\x8c\x32\xc0\x5e\x67\x8f\x57\xcc\x08\xd8\xd1\xca\x99\xff\x55\xd8\x93\xa7\xd8\x8b\xe8\x7d\x6c\x9c\x9e\x52\x11\x1e\x29\x8e\x3c\x3c\xb1\xe2\x08\xda\xd7\xc6\xcb\x8d\x90\xd7\x0c\x8d\x22\x56\x3c\x99\xb2\xfa\x98\x9b\xca\x24\x2b\xf7\x19\xd6\xbf\xd7\x37\x4b\x03\xa5\x12\x29\xac\x5d\x92\x16\xfe\x51\x41\xd1\x5d\x6c\x15\x8b\xd9\x82\x99\xc3\x18\x57\xcc\x08\x8b\xad\xef\x11\x67\x89\xc6\x2d\x8d\xce\xc1\xcd\xa0\x0a\xac\x0a\xd4\x57\x2a\xd9\x3f\x2a\x1a\x49\xca\x28\xba\xcb\xc1\x19\x99\x1e\xce\x70\x70\x53\xa5\xb7\xa8\x96\xa7\x5e\x66\x03\xcb\x5b\xe4\x9e\x9b\x3d\x63\xea\x65\x27\xc4\x00\x79\xcf\xa9\x78\xbd\x97\xb8\x74\xb3\xe1\x98\x3f\x36\xc2\xa4\x26\x90\x9e\x9f\xf4\x76\x1b\xe3\xeb\xfb\xc0\x10\x82\xb2\x8c\x19\xc9\x75\x26\x27\x32\x2c\x16\x25\x9d\x09\x33\x86\x22\xd5\x59\x8f\xba\x82\x8b\xc7\x30\x50\x0a\x03\x4c\x35\x05\x5f\x57\xda\xc9\xe6\x71\xe7\x48\xd8\x69\x5e\xf4\xc3\xf1\xf5\x3d\x19\x3e\xb9\x39\xf1\x72\x5f\x06\xe0\x41\x9b\x0d\xea\xf9\x61\x7f\x38\xdc\x72\x11\x87\xa2\xcb\xbb\x99\x74\xa0\xa4\x75\x1c\xa4\x89\x65\x6d\xce\x88\xc2\xd6\xea\x57\x18\x77\x13\x95\xd3\x8d\x62\x94\x0a\x8b\x28\x8f\xde\x27\x80\xb6\x35\xe0\xc7\xa6\xba\xe1\x2a\xad\x1b\xd3\xf3\x9e\xf9\xd9\x21\xf9\x64\x01\x77\x4c\xf9\x7d\x2b\x6c\x18\x0d\x9f\xe3\xa6\x58\x97\xe9\x2a\xa8\x7a\xab\xae\x5c\x59\x39\x8e\x6c\xf1\xfe\xc9\x56\xb9\x56\x7a\x2a\x77\x0a\xea\xb8\x2a\xdc\x4a\x41\xea\xb4\x19\xb3\x05\x90\x6e\x50\xf2\x88\x2c\xcb\x9c\x93\x71\xaa\x0b\x27\x64\x01\xa6\x2d\x21\xcd\x86\x77\x22\x52\xa9\xa4\x5b\x30\xa3\x9d\x69\xeb\x98\x51\x6e\x6a\x07\xf0\xe2\x2d\x75\xc6\x8d\xd1\x48\x6d\xf8\xf7\xb4\x2a\x24\xd7\x9e\x2a\x3d\x45\xd8\x22\x51\xa8\xda\xae\x37\xe3\x54\x38\x98\x76\xb6\x32\xa0\xe1\xe3\xbb\x99\x8f\x11\x73\x95\xe2\x7d\x84\x76\x65\xfb\xca\x04\xa6\xbe\x20\x0e\x79\x7d\x9b\x38\x71\xb1\x6f\x2a\x37\x2e\xbe\x09\x3a\x23\x52\x59\x4c\xc7\x9b\x7e\x68\x5c\x07\xbf\xc2\xbc\xe1\xcc\xac\x13\xc6\x5e\xcf\x3e\x19\x62\x85\x8f\x85\x86\x56\xc8\xda\xa0\x09\xe6\x8d\x44\xf5\xe4\xf2\xc2\x58\xee\x6a\x58\x42\xc3\x35\xee\x6e\xc5\xc5\x21\xd6\x38\x31\x1d\x17\xf5\xc9\x2b\x19\x98\x13\xc4\xc2\xde\xfb\xe7\x3b\x33\xda\xb9\xdd\x75\xaf\x31\x33\x6d\x8a\x83\x8f\x5b\x43\xee\xb8\x09\xc3\xe9\x9f\x75\x5f\x9d\xd2\x07\x38\x4b\x30\x52\x67\x63\x86\x52\xf3\x2d\xda\xcc\xe8\x72\xac\xf4\x14\x81\xb0\xef\x76\xb6\x08\x39\x3a\x52\xb4\x98\x0c\x58\x70\x63\x23\x1c\xf0\x2d\xf7\x4e\x98\xa2\xbe\x01\x19\x28\xb1\x08\x47\xdb\x43\x53\x9d\xff\x7b\xb7\x3a\xa7\xf4\x74\x2a\x8b\xe9\xb3\x35\xd8\x3a\xf6\xaf\xd0\x19\xbc\x9b\x42\xf1\x9c\x07\x81\xdc\x1e\xa8\xaf\xdb\x06\xae\xf6\x0a\xaa\x7f\xe7\xb0\xce\x9e\xe3\x76\x46\xf5\xfe\xa4\xeb\xbd\xee\x9d\xc6\x9a\x72\x2e\x54\x05\x85\xbb\xd9\xcd\xb5\x50\x25\xa5\xaa\xc9\x04\xcc\xc8\x69\xd3\xd3\xc9\x02\x6b\x41\xb5\x2d\xaa\x1f\x6f\x44\x7a\xab\xf4\xf4\xc7\x1c\xf2\x7e\xde\x9a\xf8\x58\x60\x56\x23\xa4\x33\x48\x6f\x6d\xd5\x5b\x53\xd1\x1b\x71\x5f\x35\x36\x12\x52\xbb\x28\x90\x91\xd4\x3c\x57\xe4\xc1\xc1\xfe\xda\x74\x53\xe3\x38\xde\x26\x26\xec\x7d\x77\x29\x37\x1f\x7c\x49\xc3\xb6\x32\xd9\x7f\x8c\x89\xaf\x7f\xd0\xca\x3f\xe0\x33\x86\xf2\x92\x86\xed\x2d\xbe\xa2\xaa\x68\x9e\x21\x9f\xb0\xa7\x5f\xa0\xa3\x8c\x56\x61\x70\xc9\xff\xbc\xf9\xfb\x0f\x7f\x9e\xbd\xfd\xcb\x9b\x37\xbf\xfd\xdb\xd9\x7f\xff\xfe\xc3\x9b\xbf\xff\xd8\xfc\xc7\xbf\xbe\xfd\xcb\xdb\x3f\x57\x7f\xfc\xf0\xf6\xed\x9b\x37\xbf\x7d\xba\xfc\xe5\xdb\xd5\x87\xdf\xe5\xdb\x3f\x7f\x2b\xaa\xfc\xb6\xfd\xeb\xcf\x37\xbf\xc1\x87\xdf\x91\x48\xde\xbe\xfd\xcb\xbf\x20\x26\x77\x7f\xb6\x29\x0f\x78\x26\x0b\x77\xa6\xcd\xd9\x32\x44\x30\x71\xa6\xea\x36\xe6\x7b\x38\x6d\x1b\x75\x11\x73\x13\x7d\x28\x0d\x83\x2f\x21\xf9\xa1\x79\x91\x62\x4b\x54\x34\x15\x05\x66\x9c\x5b\x8e\x69\xc3\x1c\x77\x9c\x6b\xc7\xcb\x79\xef\x9b\xe0\x55\x11\xb8\x31\x64\x60\x76\xc6\x37\x18\x3c\x55\x42\xe6\xdd\xdd\x8d\x1f\x82\x77\x60\xa5\x01\x91\x7d\x2d\xd4\xde\xe2\xa6\xfd\xaa\xbe\xac\xd7\x8b\xa6\x32\x64\xdc\xa6\x2d\x01\x15\x40\xea\x7b\x40\x22\x4d\xc1\xda\x4b\x9d\xe1\x63\x5f\xd1\x99\x03\x2d\x78\x9f\xa8\x4f\x90\x6a\x0b\xb5\xee\x32\x42\xd3\x70\x42\xd8\xa6\xa4\xed\x0b\xf6\x4b\xad\x5f\xe2\xbf\x20\x2d\x1e\xd7\x85\x3e\x78\x10\x9f\x4a\xda\xc4\x41\x7c\xae\x4e\xd2\xba\xfe\x65\xf1\x7c\x7f\xf1\xe7\x7e\xdc\x1b\xb1\xb4\x01\xaf\xab\x96\x6c\x35\xc7\x47\x93\x07\x85\xa0\x1a\xf5\xc6\xe3\xf7\xf4\xf8\xb8\x2d\x0c\x58\x79\x75\x03\xfe\x92\xeb\xd3\x6f\x3d\x89\x33\x39\x76\x69\x76\x03\x81\x72\xed\x36\x78\x53\x69\xb2\xbc\x64\x60\x23\x19\xed\x82\x48\x46\xfb\xf8\xc4\x42\x1b\x34\xb0\x4f\xf6\x98\x0b\x97\xce\x3e\xac\xa3\xc6\xbc\x28\xd4\x53\x46\xa1\x4e\xb1\x05\x8f\xac\xad\x0d\x90\x09\x3a\x49\xea\x79\xf6\x35\x07\x66\x1f\xb4\x89\x0e\xf1\xde\x1a\xd2\x49\xb4\x10\x30\x57\x7f\x09\x72\x05\xbe\x22\x4b\x0b\xd8\x6a\x8f\xdb\x5f\xac\x0e\xd1\xeb\x33\xd2\x0b\x41\xdb\x8d\xe6\xf6\x7d\x46\xc6\xed\x6f\x20\xfc\x69\x21\x9e\xfb\x41\xb8\x5e\x6b\x15\x3d\x57\xc2\xda\xbd\x2a\xb5\xf3\xc6\xee\x5a\x6b\x65\x7b\x1e\x62\x8f\xab\xf0\xd8\x5d\xaf\xd8\xc8\xde\xce\x34\xcb\xe8\x06\xdb\x67\x92\x42\x86\xc7\x19\x3d\x72\x22\xbd\x1d\xf5\x74\xb6\x5c\xba\xaf\x6b\xf1\xe6\xff\xff\xf7\xde\x51\x7b\xdb\x56\x56\xd6\xe9\xfc\x5c\x17\x13\x39\x1d\x35\x7d\x50\x82\x57\x92\x09\xc8\x75\x31\xea\xc6\x84\x6e\xae\x86\xe8\xcf\xef\x83\x2f\xc1\x7b\x10\xd7\x3f\x27\x73\x9a\xfd\x11\x32\x3e\xd3\xe9\x38\xe7\x8f\xfe\x29\xc6\x1c\xe5\xe5\xca\x91\xc5\x35\x88\x6c\x31\x82\x54\x17\xdd\x81\xdc\x2b\xc0\x5e\xb8\x15\xf8\x69\x1f\x06\xe6\xb2\x96\x3a\xff\x2a\x6b\xa6\xbf\x40\xfb\x83\xf6\x3b\x2b\x1f\xb1\xdb\x57\x9e\xa5\x8a\xdb\x9e\x02\x1e\x4d\xcc\xf6\x16\xb1\x89\x52\x04\x4d\xb4\x26\x0e\x46\x11\xa9\x49\xe2\x34\x59\x94\xa6\x08\x8e\xfe\x22\xb4\x9f\xf8\x4c\x10\x9d\xc9\xb2\x21\x7e\xe5\x04\x71\x39\x4c\x54\x26\x9b\xd5\xd1\xdb\xe0\xf5\x73\x07\x79\xa9\x84\xdb\x8b\x13\x09\x2b\x6c\x50\xf1\x27\xfe\xc2\xc7\xfa\xb3\x13\x52\x77\x7c\x84\x93\x15\x9c\xd2\xfa\xfc\xd5\x39\xa4\x4f\x2d\xa1\xd2\x54\xea\xe4\x1c\xde\x83\xc8\x94\x2c\xc0\x43\xb4\x59\xc1\x96\x30\xf1\x9f\xff\xe1\xbd\x79\xbe\x86\x56\x31\x99\xc8\x42\x3a\xaf\x87\x96\x6a\x34\x6b\x82\xd1\x08\xe3\x85\x8c\xd9\x7e\x0b\x13\x30\x06\xb2\xf7\x55\x4d\x90\xa3\x74\x06\x59\xa5\x64\x31\xfd\x38\x2d\xf4\xfa\x9f\x3f\xdc\x43\x5a\x39\x8f\x8a\x62\xdb\x40\x36\x78\x85\xad\x6c\x85\xa1\x5e\x5f\x5f\xe9\x92\x7d\xcf\x22\x09\xb4\xde\x3e\x85\x80\x4d\xdd\x00\xcf\xc2\x5a\x20\xd9\x7c\x9f\x42\x90\x91\xf3\x31\xd0\xed\xc2\x7b\x9e\x18\xd5\x76\xfc\x14\x58\xe8\xa0\x05\xd6\x15\xd2\x2d\xce\x4f\x81\x66\x83\x7e\x0a\xfe\x56\xe9\xe7\x70\x90\xec\xd4\x4f\x81\x68\xb9\xde\x85\x28\x74\x9f\x1b\xee\x74\x21\x41\xf9\x3d\xc8\x4f\x21\x32\x26\x04\x44\xc6\xe4\x03\x91\x31\xa1\x70\xbc\x44\xc6\xc4\x30\x99\x3b\x90\xd3\x19\xba\x4c\xe9\x53\xf0\x35\x60\x3e\x0f\xf4\x50\x8f\x15\x84\x11\xdb\xd9\x96\x24\x4c\x44\xd0\x6e\x24\xe9\xe3\xc0\x63\x0c\xa1\xa4\xd5\xb6\xed\x4f\xbf\x09\x7d\xac\x6a\xd5\x6f\xb4\xb4\x63\x7f\x03\x43\xe7\x9a\x81\x2c\x37\xaa\x39\x08\x88\xd2\x84\x07\x44\x69\xc2\x07\xa2\x34\xd1\x8f\x28\xaa\x39\xbb\x20\x32\x26\x0f\x88\x8c\xc9\x07\x22\x63\xea\x47\x34\xb8\x9a\x13\x36\x8d\x90\x23\x3e\x7b\x2a\xc1\x92\x65\x7c\xd2\x16\x90\x3f\x2d\x75\x16\x7d\x2e\x4f\x67\x17\xec\x73\xd9\x6c\x6b\x4d\x0d\x43\x6b\x24\x8d\x93\x77\xe4\x19\x9f\xbf\xcf\x09\x25\xec\x6a\x52\xc2\xf9\x12\x71\x4a\x25\x09\x9f\x64\x92\xf0\x3f\x91\xbc\x12\x4a\xb2\x8f\x09\xf2\x49\x2a\x09\xaf\xb4\x92\xec\x63\xb5\x9c\x52\x4b\xc2\x28\xb9\x24\x4c\xd2\x4b\xc2\x29\xc1\x24\x9c\x52\x4c\xc2\xba\xf7\xc4\x5c\x89\xe7\x21\x3c\xe4\xe6\x79\x60\xa5\x5e\x66\x71\x32\x18\xd1\xba\x14\x5f\x7c\xf8\xd0\x10\x1f\x3e\x3a\xc4\x87\x8f\x05\x65\x7c\xf8\x90\x10\x1f\x3e\x3a\xbc\x8e\x87\xef\x18\x0c\xbc\x6c\x3b\xcd\x43\xa1\x4e\x97\x5a\xe9\xe9\xe2\x53\xe8\xc3\xc4\xb2\xae\x70\x1e\x75\xb6\xbd\xa2\x18\x63\x70\x04\x31\x06\x0f\x2d\x3f\x31\xd0\xe0\xe5\x18\xf5\x98\xac\x68\x31\xc8\x00\x01\xd1\x97\xe7\x01\xd1\x97\xe7\x03\xd1\x97\xd7\x8f\x88\x25\xc8\x80\x43\x09\xd8\x87\x02\xc0\x2c\x92\x06\x6e\x3a\x03\x12\x46\x4b\x57\x7c\x9c\x10\x10\x1f\x27\x0f\x88\x8f\x93\x0f\xc4\xc7\xa9\x1f\x51\x7c\x9c\xf0\x88\x8e\xe8\x71\x0a\xd8\x9a\xe0\x9b\xcf\xb2\xab\xe1\xd4\xc7\x62\x7d\x0a\x5e\x4b\xa8\x89\x23\xd4\xe2\x34\x98\x85\x22\x28\x4a\xaa\x70\x32\x46\x4a\x3d\x9d\x5d\x8c\x94\xda\xef\x84\x92\xe8\x30\x26\x42\x74\x18\x87\x41\x74\x18\xd3\x21\x3a\x8c\xf1\x10\x1d\xc6\x43\x23\x8a\x91\x52\xfe\x10\x1f\x3e\x3a\xc4\x87\x8f\x05\x65\x7c\xf8\x90\x10\x1f\x3e\x3a\xbc\x8e\x87\xef\x18\x3c\x14\x31\x52\xaa\x03\x62\xa4\xd4\x1a\x62\xa4\xd4\x36\x96\x18\x29\xf5\x3c\xc4\x48\xa9\x15\x44\x67\x34\x06\xa2\x33\xda\x0f\xa2\x33\x1a\x0f\xd1\x19\xbd\x1b\xa2\x33\xfa\x90\x48\x62\xa4\x54\x3f\xc4\xc7\x69\xa0\x89\xc5\xc7\xc9\x07\xe2\xe3\xd4\x8f\x28\x3e\x4e\x78\x44\x47\xf4\x38\xc5\x48\xa9\x18\x29\xd5\xc0\xa9\x45\x4a\x91\x3e\x13\x95\xd3\xb9\xae\x0a\x37\x02\x33\x97\x29\xbc\x4b\xd3\xfa\xaf\x6f\xfa\x16\xbc\x2c\x1f\xed\xe0\x37\x5a\x2b\x10\x05\xfa\xbb\x4d\x6f\x48\x9f\xb1\x0e\xda\x4b\x58\x98\x29\xe1\x4a\x9f\x56\x93\xdd\xfa\x20\xf2\x5c\x14\x84\xab\x76\x6a\x0b\x85\x62\x7e\xc0\x45\x06\x97\x66\xf6\xe8\x06\xfb\x18\x82\xf9\x6f\x23\x11\x0f\x3b\xfa\x85\xd1\xe4\xa0\x44\x0e\x4d\x2a\x6d\xfa\xbd\x5e\x8a\xf2\x13\x2c\xae\xc1\xb3\xbf\x3e\xff\x74\x12\x1e\xa5\x8e\x4d\x76\x0b\x21\x4f\xf6\xc9\xe8\xb2\x95\x71\x79\x26\xe4\xfb\x92\x3d\x05\x0e\x15\x29\x54\x3d\x62\x11\xae\x27\x12\x54\x76\x24\xd4\x2f\x4a\xf9\x2b\x18\x4b\x74\xcb\x6c\x03\x1b\xdd\x35\xdb\xd3\xd7\xda\xfa\x80\x33\xe2\xa1\xbb\xf5\xaa\x86\xa6\x3e\x03\x56\x57\x26\x85\x8b\x23\xa2\xc2\xb5\xd8\xea\xd3\xad\x7d\x17\xb0\x11\x62\x26\xe7\xd2\x86\x5b\xd6\x44\xb1\xf8\x1a\xb4\xcb\x49\xab\x7d\x05\xbb\xb8\x9f\x62\x63\x31\x84\x95\xc2\x39\x30\xc5\x4f\xc9\xff\xbc\xf9\xfb\x0f\x7f\x9e\xbd\xfd\xcb\x9b\x37\xbf\xfd\xdb\xd9\x7f\xff\xfe\xc3\x9b\xbf\xff\xd8\xfc\xc7\xbf\xbe\xfd\xcb\xdb\x3f\x57\x7f\xfc\xf0\xf6\xed\x9b\x37\xbf\x7d\xba\xfc\xe5\xdb\xd5\x87\xdf\xe5\xdb\x3f\x7f\x2b\xaa\xfc\xb6\xfd\xeb\xcf\x37\xbf\xc1\x87\xdf\x91\x48\xde\xbe\xfd\xcb\xbf\x04\x4e\xfc\xfe\xec\xb6\xba\x01\x53\x80\x03\x7b\x26\x0b\x77\xa6\xcd\x59\xbb\x23\x3f\x25\xce\x54\xb4\xb6\x2a\x2b\x58\x5d\xb3\x23\xa1\x66\x1e\x26\xb6\x5a\xd4\xd0\x3c\xcc\x42\x6a\xc0\x45\x19\xf2\x39\x88\x32\x64\x07\xbc\x10\x19\x32\x10\x45\x60\x71\x78\x91\x53\x18\x40\xd0\x9c\x83\xec\x13\x34\xa5\x77\x30\x1b\xc5\x5a\x47\x0e\x60\x6e\x1c\x8c\x2d\x94\x91\xb0\x30\x11\x0e\x06\xc2\xc1\x3c\x82\x2f\x6d\x69\x60\x22\xef\x07\xb3\xfe\xb4\x2f\x66\xa4\xa8\x17\x43\x51\x03\xb1\x53\x99\x8b\x29\xe1\x0c\x03\x4e\xae\x19\xf1\xaa\x52\xea\x4a\x2b\x99\x12\xe4\xac\x80\xb1\x95\x9c\x40\xba\x48\x15\x61\xc5\x61\xf7\xa5\xd4\xd6\x8d\x9c\x30\xc4\xc0\xea\xf0\xcb\x0a\xf7\x90\x0e\x1d\x4d\x44\xf6\xa1\x6c\xc3\xcb\x4b\x66\x60\x90\x1f\x67\xce\x95\xbf\x40\x40\xd4\x3e\xcf\x01\xcf\xb4\x0d\x98\x43\xc2\x77\x30\xf5\x7e\xfc\x15\x44\xe6\xe9\x37\x7d\x0a\x47\x17\xb2\xc6\xa1\x0d\x26\x7b\x09\x0d\x3b\xb2\x59\xf1\x05\x60\x11\xf5\xa3\x87\x48\x9a\x2d\x62\xe1\x5a\x47\x13\x7e\x55\x06\x9b\xf4\x99\x8e\xbb\xd4\xd4\x77\x75\x05\x0c\x26\x5d\x4e\x83\x2e\xa3\x39\x77\x8f\x36\x51\x9b\xce\x20\x94\x19\x1d\x4d\xf6\x5d\x4d\x43\x43\xbe\xe0\x2e\x2d\x47\x3a\xbd\x8d\x6f\xf8\x1a\xe2\xad\xde\x0d\x7b\xbb\xd5\xa7\x7f\x95\x02\x11\x94\x06\x46\x4e\x97\x51\x4d\x8b\x6a\xda\xb3\x38\xa2\x9a\x96\x44\x35\xad\x1f\xa2\x9a\x86\x84\xa8\xa6\xf5\x21\x8a\x6a\xda\x1a\xa2\x40\x47\x80\xa8\xa6\xad\x20\xaa\x69\xdb\x10\x6f\x75\x37\xae\x63\xbe\xd5\xa7\x7f\x95\x18\xdc\x9d\xa4\x8f\x95\x9c\x43\x01\xd6\x5e\x19\x7d\x73\x70\x2f\x20\x5d\xbb\x0b\x67\x1a\xc1\x5a\xdd\xcb\xc8\xdd\x0c\x24\xdc\x89\x90\xaa\x32\xf0\x6d\x66\xc0\xce\xb4\x22\x6e\x67\x78\x8d\xac\x50\x76\x17\xa4\x89\x86\x13\x63\xd8\xeb\xc5\x40\x48\x2c\x9a\x67\xf0\x8d\xe0\xd2\x38\x8f\x2a\x44\x94\x45\xcb\x3c\xaa\x28\xe9\x40\xcd\x32\x5c\xab\x64\x2c\x01\x10\xa2\x4d\x86\x69\x92\x0c\x47\x1a\x26\x6b\x06\xca\x99\x5c\x32\x26\x93\x7c\xb9\x27\xd9\x32\x54\x5b\x1c\xbc\xa8\x01\x59\xac\x0d\xbc\x64\xb2\x90\x4e\x0a\xf5\x1e\x94\x58\x8c\x20\xd5\x45\x46\x64\xea\xc3\x0b\x07\x25\x18\xa9\xb3\x13\x5f\x84\xad\xd2\x14\xac\x3d\x79\x49\x2d\xd0\xe2\xf0\x02\x64\xb5\xc8\xf5\x37\xb0\x17\xae\x7f\xaa\x2c\xd7\x81\xc9\x65\x21\x9c\xd4\xc5\x2f\x46\xa4\x70\xc5\xca\xb7\xfe\xf3\x3f\x86\xb9\xef\x32\x07\x5d\xb9\x93\xe6\xbe\x01\xe7\x4a\x53\x65\x02\xee\x56\x4d\xbc\x87\xac\x5f\xc3\x90\x4d\xd4\xa6\x56\x5f\x05\xb0\x45\x8e\x22\xdd\xe1\x7c\xb1\x7e\x59\x3e\x5e\x0d\x96\x42\x53\x0f\x7f\xfa\x9b\x38\x68\x05\x9c\xd2\x68\xa7\x53\x4d\xce\xbd\xc9\x60\x22\x2a\xe5\x7e\x4a\xbe\x9d\x5f\x0d\xb3\x86\xb0\xd4\xc9\x07\x97\x91\xc2\xec\x87\x49\xfa\x79\x24\x47\x28\x69\xdd\x59\x2e\xca\xb3\x5b\x58\x10\xb8\x52\xe8\x36\x9c\xad\xc9\x88\x61\x19\xed\xa6\xe4\xa2\xf4\xc4\x65\x40\x64\x32\x3a\x06\x28\x10\x1d\x03\xd1\x31\xd0\xc2\x0b\x50\x36\xa3\x63\xe0\x31\x44\xc7\xc0\x0e\x88\x8e\x81\x15\x44\xc7\xc0\x4b\x37\x11\x45\xc7\x40\x74\x0c\x44\xc7\xc0\x16\x0c\xbf\x8e\xe8\x18\x88\x5c\x7f\x03\xd1\x31\xb0\xfd\x79\x74\x0c\x3c\x0f\x43\x73\xad\x80\x73\x5d\x95\x2a\x24\xac\x3c\x8c\xd5\x29\x99\x4b\x8a\x87\x20\x61\x6e\x2c\x12\xc4\xaf\x78\xb8\x15\x0b\xaf\x3a\xb9\xf2\xa2\x7b\x61\xad\x0c\x15\xff\xc0\x46\xb2\x7c\x80\x25\x92\xe5\xb0\x64\x19\xf0\xb1\x85\xb4\x32\xd2\x2d\xce\x75\xe1\xe0\x9e\x20\xd5\x85\xf1\x78\xa1\x94\xbe\xbb\x32\x72\x2e\x15\x4c\xe1\x83\x4d\x85\x12\xd4\xd6\xb6\xe1\xd5\xe4\x52\x51\x8a\x1b\xa9\x24\xfd\x5a\x86\x0b\xf7\x22\x7b\xf5\x1e\x81\x24\xc9\x0c\xb5\x12\x40\xf2\x62\x76\x21\xb8\x9e\xc2\xf2\x52\x11\xc9\x29\xf4\x2e\x95\x46\xa7\x97\xba\x2a\x88\x8a\x62\xb0\x77\x59\x64\x5f\x0b\xb5\xb8\xd6\xda\x5d\x48\x05\x76\x61\x1d\x10\x1b\xb6\x84\xee\x84\xa9\x8a\x77\xf6\x17\xa3\x2b\x22\x49\x0f\xaf\x01\x35\x2b\xf8\xa2\x8b\x7a\x37\x07\xdc\xc3\xef\x16\x88\x3d\x04\x86\xdf\x42\x0b\x9f\x65\x51\xdd\x7f\x6d\x6a\xa6\x0e\xd7\x80\x1d\xe6\x10\x50\xaf\x95\x81\x2f\x1a\x4d\xa9\x01\xca\x38\x81\x06\xc5\x90\x13\xa8\xc8\x54\xcc\x32\x81\xc0\x67\xc5\x42\x9a\xea\xbc\xbc\x32\x7a\x22\xa9\x27\xc9\x40\xc7\x3a\x15\xaa\x09\x23\x0b\x99\x47\xf2\x22\x28\x2a\xb8\x19\xe5\xa2\x1c\x44\x93\xbe\x93\x45\xa6\xef\xec\xc0\x1c\x71\x9a\x5b\x71\x6e\x20\x83\xc2\x49\xa1\x46\x65\x48\x15\x28\x06\x5a\x7a\x3a\x9d\xb0\x46\x3e\x1c\x1c\x7b\xf5\xf4\x0e\x3c\x93\xc1\x34\x64\x27\x8c\xab\xca\x18\x9e\xe6\x0b\x51\x0d\x8b\xe1\x69\x2d\xbc\x00\x97\x67\x0c\x4f\x7b\x0c\x31\x3c\x6d\x07\xc4\xf0\xb4\x15\xc4\xf0\xb4\x97\x1e\xa8\x10\xc3\xd3\x62\x78\x5a\x0c\x4f\xdb\x82\xe1\xd7\x11\xc3\xd3\x22\xd7\xdf\x40\x0c\x4f\xdb\xfe\x3c\x86\xa7\x3d\x0f\x43\x73\xad\x20\xf3\x4c\x26\x09\xe1\x02\x21\x0e\xa9\x66\xc8\xaf\x05\xa5\x25\x71\xc8\xb0\x5b\xd4\x7b\x09\xd6\x8a\x29\xd0\x7a\xbb\x07\xf0\x95\x67\xa6\x70\xf8\x5e\x72\xce\x91\xc7\xa3\x6d\xfc\x5c\xab\x2a\x87\xf7\x30\x97\xa4\x28\xc8\xc1\x2a\x16\x64\xcd\x8c\x69\x44\xd2\x42\xf0\x13\x34\x60\x92\x7c\x58\x82\xf9\x66\xef\x48\x9f\x9f\x56\x6b\xdf\x96\xc0\x9b\x68\x8d\x53\xa2\xef\xbc\x9e\xf0\xa0\xe4\xdd\xce\xc0\xe8\x52\x4c\x03\xc2\xd6\x4e\xfb\x9e\x6d\x82\x6d\xc2\x26\x10\xd2\x04\xd6\x56\x37\x83\xd2\xc1\x72\xfc\x0f\xf7\x25\xd9\xb5\x3f\x28\xb7\x5b\xdf\xa4\x57\xc0\xec\xee\xb4\xb9\x95\xc5\xf4\xbd\x24\x1c\x15\xf9\x90\x68\xc7\x43\xd8\x5a\xe2\xa6\x52\xb6\x33\x2b\xec\x79\xd3\x61\xdd\x67\x51\x54\x9e\x5f\x6f\x84\x05\x33\x27\xf8\x5f\x88\xef\x53\x88\x84\x4a\x24\x4e\x4d\x8b\x85\x20\x2e\x30\xec\xf9\xa5\xbf\x38\x81\x9c\x2e\xc0\x5d\x14\x34\x72\x00\xbb\xa2\xd2\x83\x05\x61\xd2\x99\xff\xf9\x9c\x0a\xc5\x93\xb6\x34\x2b\xac\xbf\xd2\x49\x5a\x1a\x14\xe2\x46\xc1\x08\x4c\xad\x0a\x7c\x96\xc5\xad\xd7\x9e\xd2\xa4\x1a\x28\x67\x90\x83\x11\xea\x7c\x55\xda\xc9\x6b\x50\xc2\xc9\xd3\xd9\x80\x30\xd3\x43\xaa\x0b\x0c\xb7\x97\x22\x2f\x90\xe3\x60\x4e\x6d\xa1\x50\xcc\x4f\x48\xf9\x1b\x54\xe3\x09\x0a\x59\xe0\x19\xfd\xc2\x68\x62\x3e\x03\x4f\x18\x49\xda\x88\x7e\x97\xa2\xfc\x04\x8b\x6b\x08\x6a\x23\xc4\x15\xd5\x72\x0b\x64\x15\x74\x05\x6c\x41\x2d\x47\x15\x61\xd3\xca\x95\x22\x20\xf2\x7f\x7b\x42\x21\x8a\x7a\x0b\x3c\x51\x36\xb7\x10\xd2\x9d\x8f\x25\x44\x66\x22\x41\x65\x47\x42\xfd\xa2\x94\xbf\x82\xb1\x01\x56\xa8\x15\xb0\xd1\x5d\xb3\x3d\x21\x86\x19\xe6\x19\xf1\xd0\xdd\x7a\x55\x43\x53\xdf\xaa\x32\xc3\xc5\x11\x51\xe1\xba\x22\x69\x58\xb4\x78\x0b\x6c\x84\x98\xc9\xb9\xb4\x3a\x20\xed\xa6\x05\x86\x86\x79\xbc\x2d\xf3\x58\x9b\xe6\x9d\x60\x2a\xfe\x0a\xf6\xd8\xc5\x73\x73\xcd\x8e\x84\x9a\x79\x98\xd8\x6a\x51\x43\xf3\x30\x0b\xa9\x01\x17\x65\xc8\xe7\x20\xca\x90\x1d\xf0\x42\x64\x48\x86\x72\x34\x74\xb7\xcf\x69\x39\x6e\xa0\x98\xd3\x94\xde\x21\x5b\x46\xb4\x3a\x72\x00\x73\xe3\x60\x6c\xa1\x8c\x84\x85\x89\x70\x30\x10\x0e\xe6\x11\x7c\x69\x4b\x03\x13\x79\x3f\x9c\xaf\xb9\x79\x31\x23\x45\xbd\x18\x8a\x1a\x88\x9d\xca\x5c\x4c\x0f\xdb\xf1\xa7\x19\xf1\xaa\x52\x6a\x80\xa0\x41\x25\x27\x90\x2e\x52\x4a\xa9\x80\xb0\xfb\x52\x6a\xeb\x46\x4e\x50\x23\xd2\xc3\x2f\x2b\x3d\x87\x98\x67\xfc\x84\x23\x97\x38\xe1\xc8\x9e\x4c\x38\xe5\xe1\xf0\xec\x38\x16\xf9\x31\x28\x2f\x37\x61\x3b\xe0\xb0\x9c\x8f\x84\xef\x60\x58\xf2\x74\x13\x2e\x6a\xe3\xd2\x4a\x13\x26\x6d\x30\xe1\xbc\x01\x09\x57\xee\x2e\xf3\xac\x38\x34\xc3\x84\x23\x8f\x37\x61\xc9\xe5\x4d\xb8\x4c\x2d\x09\x13\xd7\x0a\xcd\xeb\x4d\xf8\x8e\x3b\x2c\xd3\x2b\xe1\x31\xe9\x72\x1a\x74\x19\xcd\xb9\x7b\xb4\x89\x86\xe6\xfc\x26\x5c\x14\x10\x7e\xd5\x03\x92\xd1\x12\x26\x0b\x50\x58\xbe\x66\xf2\xf2\xde\xf0\x78\xab\x77\xc3\xde\x6e\xf5\xe9\x5f\xa5\x40\x04\xa5\x81\x91\xa3\x96\x9d\x8d\x6a\xda\x1a\xa2\x9a\xf6\x0c\x44\x35\xed\xd1\x44\xa2\x9a\xd6\x03\x51\x4d\x43\x42\x54\xd3\x76\x41\x54\xd3\xb6\xe1\xb5\x09\x74\x51\x4d\xdb\x40\x54\xd3\xb6\x21\xde\xea\x6e\x5c\xc7\x7c\xab\x4f\xff\x2a\x0d\x55\xc7\x57\xc9\x39\xc4\x3e\xf3\x14\x88\x85\x7c\x63\x21\xdf\x16\x5e\x40\x71\xb8\x58\xc8\xf7\x31\xc4\x42\xbe\x3b\x20\x16\xf2\x5d\x41\x2c\xe4\xfb\xd2\x4b\x3a\xc6\x42\xbe\xb1\x90\x6f\x2c\xe4\xbb\x05\xc3\xaf\x23\x16\xf2\x8d\x5c\x7f\x03\xb1\x90\xef\xf6\xe7\xb1\x90\xef\xf3\x30\x34\xd7\x0a\x38\x57\x9a\x2a\x13\x70\xb7\x6a\xe2\x3d\xa5\x72\x97\xeb\xd4\xea\xab\x00\xb6\x18\x4e\x20\xe1\x84\xde\xbe\x2c\x1f\xaf\x06\x4b\xa1\x69\x3a\x07\x9e\xfc\x26\x0e\x5a\x01\xa7\x34\xda\xe9\x54\x93\x73\x6f\x32\x98\x88\x4a\xb9\x9f\x92\x6f\xe7\x57\xc3\xac\x21\x2c\x75\xf2\xc1\x65\xa4\x30\xfb\x61\x92\x7e\x0c\x88\x4c\x46\x2b\x38\x05\xa2\x15\x3c\x5a\xc1\x5b\x78\x01\x9a\x55\xb4\x82\x3f\x86\x68\x05\xdf\x01\xd1\x0a\xbe\x82\x68\x05\x7f\xe9\xf6\x90\x68\x05\x8f\x56\xf0\x68\x05\xdf\x82\xe1\xd7\x11\xad\xe0\x91\xeb\x6f\x20\x5a\xc1\xb7\x3f\x8f\x56\xf0\xe7\x61\x68\xae\x15\x70\xae\xab\xba\x7c\x07\xef\xe7\xa0\x64\x2e\x29\xe6\xf0\x1a\x44\x96\xc9\xb6\x14\xcf\x55\x30\xbb\x0d\xe2\x57\x3c\xdc\x8a\x85\x57\x9d\x5c\x2d\xcd\xbd\xb0\x56\x86\xf2\x76\x60\x23\x59\x3e\xc0\x12\xc9\x72\x58\xb2\x0c\xf8\xd8\x42\x5a\x19\xe9\x16\xe7\xba\x70\x70\x4f\x90\xea\xc2\x78\xbc\x50\x4a\xdf\x5d\x19\x39\x97\x0a\xa6\xf0\xc1\xa6\x42\x05\xb4\xad\x0b\x2d\x9d\x96\x8a\x52\xdc\x48\x25\xe9\xd7\x32\x5c\xb8\x17\xd9\xab\xf7\x08\x24\x49\x66\xa8\x69\xef\xc9\x8b\xd9\x85\xe0\xe2\x01\xcb\x4b\x45\x24\xa7\xd0\xbb\x54\x1a\x9d\x36\xfd\x3b\x43\x86\x0f\x70\xa5\xb6\x0d\x20\xaf\xb5\x76\x17\x52\x81\x5d\x58\x07\xc4\xee\x24\xa1\x3b\x61\xaa\xe2\x9d\xfd\xc5\xe8\x8a\x48\xd2\xc3\x6b\x40\xcd\x0a\xbe\xe8\xa2\xde\xcd\x01\xf7\xf0\xbb\x05\x62\xc1\xfc\xe1\xb7\xd0\xc2\x67\x59\x54\xf7\x5f\x69\xfd\xf4\x5a\x08\x7f\x5c\x14\xcc\x21\xa0\x38\x29\x03\x5f\x34\x9a\x52\xf0\x92\x71\x02\x0d\x8a\x21\x27\x50\x91\xa9\x98\x65\x02\x81\xcf\x8a\x85\x34\xd5\x79\x79\x65\xf4\x44\x52\x4f\x92\x81\x8e\x75\x2a\x54\x13\x33\x15\x32\x8f\xe4\x45\x50\x54\xa8\xad\xb0\x9e\xc0\x10\x94\x74\x27\x8b\x4c\xdf\xd9\x81\x39\xe2\x34\xb7\xe2\xdc\x40\x06\x85\x93\x42\x8d\xca\x90\x92\x47\x0c\xb4\xf4\x74\x3a\x61\x5d\x6b\x38\x38\xf6\xea\xe9\x1d\x78\x26\x83\x69\xc8\x4e\x18\x57\x95\x31\x3c\xcd\x17\xa2\x1a\x16\xc3\xd3\x5a\x78\x01\x2e\xcf\x18\x9e\xf6\x18\x62\x78\xda\x0e\x88\xe1\x69\x2b\x88\xe1\x69\x2f\x3d\x50\x21\x86\xa7\xc5\xf0\xb4\x18\x9e\xb6\x05\xc3\xaf\x23\x86\xa7\x45\xae\xbf\x81\x18\x9e\xb6\xfd\x79\x0c\x4f\x7b\x1e\x86\xe6\x5a\x41\xe6\x99\x4c\x12\xc2\x05\x42\x1c\x52\xcd\x90\x5f\x0b\x4a\xff\xdd\x90\x61\x9d\x30\x53\x70\xe7\x61\xed\xac\x03\x98\xca\xd6\xed\xb9\x04\x6b\xc5\x14\x68\x8d\xd4\x79\xa7\x70\xf8\xc6\x6d\xce\x91\xc7\xa3\x1d\xfc\x5c\xab\x2a\x87\xf7\x30\x97\xa4\x28\xcc\xc1\xca\x03\x64\xcd\x8c\x43\xba\xed\x07\x3f\x81\x03\x66\xa4\x87\x65\x73\x6f\xf6\x8e\xf4\xf9\x69\xf5\xd1\x6d\x09\xbc\x89\x16\x39\x25\xfa\xce\xeb\x09\x0f\x4a\xde\xed\x0c\x8c\x2e\xc5\x34\x20\x6c\xee\xb4\xef\xd9\x26\xd8\x27\x6c\x02\x21\x1d\x57\x6d\x75\x33\x28\x1d\x2c\xc7\xff\x70\x5f\x92\x43\x0b\x06\xe5\x76\xeb\x9b\xf4\x0a\x98\xdd\x9d\x36\xb7\xb2\x98\xbe\x97\x84\xa3\x22\x1f\x12\xed\x78\x08\x5b\x4b\xdc\x54\xca\x76\xce\xb4\x75\xef\x94\x14\xd6\x8f\x7f\x13\x9e\x0b\xfa\x43\x51\xcf\xb1\xde\xc3\x43\x3e\x6b\x41\x37\x99\x4e\xd7\x92\x10\xde\x48\x9e\xea\x81\xc9\xec\xe3\xd5\xb9\xcf\xe2\x68\x0f\x4a\x3d\xd0\x17\x70\x35\x7f\x38\xcc\x60\x57\x1f\xdf\x1f\x66\x20\x5f\xe9\x80\x44\x16\xeb\x5e\xde\xa3\xa6\x1d\xfd\xf1\xf2\x84\x03\x97\x95\x3b\xe0\x55\x91\x85\xdc\xd8\x27\x8e\xf7\x00\x84\x99\xbe\x02\x7e\x4c\x8e\xdf\x39\xb5\x85\x42\x31\x3f\x21\xa5\x71\x50\x4d\x29\x28\xd4\x82\x67\xf4\x0b\xa3\x89\x79\x18\x3c\xe1\x2f\xa9\x2e\x26\x72\x7a\x29\xca\x4f\xb0\xb8\x86\xa0\x5e\x3f\x5c\xd1\x38\xb7\x40\x56\x5d\x57\xc0\x16\x8c\x73\x54\x91\x41\xba\x6c\x93\x65\x79\x26\x14\xa2\xe0\xb7\xc0\x13\x1d\x74\x0b\x21\x2d\xf4\x58\x42\x7b\x26\x12\x54\x76\x24\xd4\x2f\x4a\xf9\x2b\x18\x1b\x60\xbd\x5a\x01\x1b\xdd\x35\xdb\x13\x62\xd0\x61\x9e\x11\x0f\xdd\xad\x57\x35\x34\xf5\xad\x2a\x4a\x5c\x1c\x11\x15\xa6\x61\x6e\xb5\x87\xc0\x46\x88\x99\x9c\x4b\xab\x03\xd2\x85\x5a\x60\xe8\x6a\xc7\xdb\xd7\x8e\xb5\xb3\xdd\x09\x96\x10\x58\xc1\x1e\x5b\x6d\x6e\xae\xd9\x91\x50\x33\x0f\x13\x5b\x2d\x6a\x68\x1e\x66\x1b\x23\x43\x94\x21\x9f\x83\x28\x43\x76\xc0\x0b\x91\x21\x19\xca\xe8\xd0\xdd\x45\xa7\xe5\xf0\x81\x62\x4e\x53\x7a\x87\xec\xeb\xd0\xea\xc8\x01\xcc\x8d\x83\xb1\x85\x32\x12\x16\x26\xc2\xc1\x40\x38\x98\x47\xf0\xa5\x2d\x0d\x4c\xe4\xfd\x70\x3e\xea\xe6\xc5\x8c\x14\xf5\x62\x28\x6a\x20\x76\xda\xf8\x79\x0e\x1a\xf0\xb7\xf6\x2c\x0d\x10\x6c\xa8\xe4\x04\xd2\x45\x4a\x29\x71\x10\x76\x5f\x4a\x6d\xdd\xc8\x09\x6a\x24\x7d\xf8\x65\xa5\xe7\x3e\xf3\x8c\x9f\x70\xe4\x40\x27\x1c\x59\x9f\x09\xa7\x3c\x1c\x9e\xd5\xc7\x22\x3f\x06\xe5\x13\x27\x6c\x07\x1c\x96\xab\x92\xf0\x1d\x0c\x4b\x7e\x71\xc2\x45\x6d\x5c\x5a\x69\xc2\xa4\x0d\x26\x9c\x37\x20\xe1\xca\x39\x66\x9e\x15\x87\x66\x98\x70\xe4\x1f\x27\x2c\x39\xc8\x09\x97\xa9\x25\x61\xe2\x5a\xa1\xf9\xc8\x09\xdf\x71\x87\x65\xa8\x25\x3c\x26\x5d\x4e\x83\x2e\xa3\x39\x77\x8f\x36\xd1\xd0\x5c\xe5\x84\x8b\x02\xc2\xaf\x7a\x40\x12\x5d\xc2\x64\x01\x0a\xcb\x33\x4d\x5e\xde\x1b\x1e\x6f\xf5\x6e\xd8\xdb\xad\x3e\xfd\xab\x14\x88\xa0\x34\x30\x72\xd4\x72\xb9\x51\x4d\x5b\x43\x54\xd3\x9e\x81\xa8\xa6\x3d\x9a\x48\x54\xd3\x7a\x20\xaa\x69\x48\x88\x6a\xda\x2e\x88\x6a\xda\x36\xbc\x36\x81\x2e\xaa\x69\x1b\x88\x6a\xda\x36\xc4\x5b\xdd\x8d\xeb\x98\x6f\xf5\xe9\x5f\x25\x06\x77\x27\xe9\x63\x25\xe7\x10\xfb\xe3\x53\x20\x16\x20\x8e\x05\x88\x5b\x78\x01\x45\xed\x62\x01\xe2\xc7\xc0\xf6\xe8\xc5\x02\xc4\x4f\x10\xc4\x02\xc4\xb1\x14\xe5\x13\x3c\xc7\x58\x8a\x32\x16\x20\x8e\x05\x88\x63\x01\xe2\x2d\x18\x7e\x1d\xb1\x00\x71\xe4\xfa\x1b\x88\x05\x88\xb7\x3f\x8f\x05\x88\x9f\x87\xa1\xb9\x56\xc0\xb9\x1e\xb8\x9c\x4f\xcb\x5d\x4e\xa9\x4c\xe6\x3a\xb5\xfa\x2a\x80\x2d\x86\x13\x48\x38\xa1\xaf\x6a\x81\x0d\x96\x42\xd3\x14\xed\x3a\xf9\x4d\x1c\xb4\x02\x4e\x69\xb4\xd3\xa9\x26\xe7\xde\x64\x30\x11\x95\x72\x3f\x25\xdf\xce\xaf\x86\x59\x43\x58\xea\xe4\x83\xcb\x48\x61\xf6\xc3\x24\xfd\x3c\x92\x23\x94\xb4\xee\x2c\x17\xe5\xd9\x2d\x2c\x08\x5c\x29\x74\x1b\xce\xd6\x64\xc4\xb0\x8c\x76\x53\x72\x51\x7a\xe2\x32\x20\x32\x19\x1d\x03\x14\x88\x8e\x81\xe8\x18\x68\xe1\x05\x28\x9b\xd1\x31\xf0\x18\xa2\x63\x60\x07\x44\xc7\xc0\x0a\xa2\x63\xe0\xa5\x9b\x88\xa2\x63\x20\x3a\x06\xa2\x63\x60\x0b\x86\x5f\x47\x74\x0c\x44\xae\xbf\x81\xe8\x18\xd8\xfe\x3c\x3a\x06\x9e\x87\xa1\xb9\x56\xc0\xb9\xae\x4a\x15\x12\x56\x1e\xc6\xea\x94\xcc\x25\xc5\x43\x50\x83\xc8\x32\xd9\x56\x27\xba\x0a\x66\xb7\x41\xfc\x8a\x87\x5b\xb1\xf0\xaa\x93\x2b\x2f\xba\x17\xd6\xca\x50\xf1\x0f\x6c\x24\xcb\x07\x58\x22\x59\x0e\x4b\x96\x01\x1f\x5b\x48\x2b\x23\xdd\xe2\x5c\x17\x0e\xee\x09\x52\x5d\x18\x8f\x17\x4a\xe9\xbb\x2b\x23\xe7\x52\xc1\x14\x3e\xd8\x54\xa8\x80\x0e\x80\xa1\xd5\xe4\x52\x51\x8a\x1b\xa9\x24\xfd\x5a\x86\x0b\xf7\x22\x7b\xf5\x1e\x81\x24\xc9\x0c\xb5\x12\x40\xf2\x62\x76\x21\xb8\x9e\xc2\xf2\x52\x11\xc9\x29\xf4\x2e\x95\x46\xa7\x4d\x2b\xd4\x90\xe1\x03\xbc\xcb\x6d\x2f\xcd\x6b\xad\xdd\x85\x54\x60\x17\xd6\x01\xb1\x61\x4b\xe8\x4e\x98\xaa\x78\x67\x7f\x31\xba\x22\x92\xf4\xf0\x1a\x50\xb3\x82\x2f\xba\xa8\x77\x73\xc0\x3d\xfc\x6e\x81\xd8\x43\x60\xf8\x2d\xb4\xf0\x59\x16\xd5\xfd\xd7\xa6\x66\xea\x60\x8f\x8b\x82\x39\x04\xd4\x6b\x65\xe0\x8b\x46\x53\x6a\x80\x32\x4e\xa0\x41\x31\xe4\x04\x2a\x32\x15\xb3\x4c\x20\xf0\x59\xb1\x90\xa6\x3a\x2f\xaf\x8c\x9e\x48\xea\x49\x32\xd0\xb1\x4e\x85\x6a\xc2\xc8\x42\xe6\x91\xbc\x08\x8a\x0a\xb5\x15\xd6\x13\x18\x82\x92\xee\x64\x91\xe9\x3b\x3b\x30\x47\x9c\xe6\x56\x9c\x1b\xc8\xa0\x70\x52\xa8\x51\x19\x52\x05\x8a\x81\x96\x9e\x4e\x27\xac\x91\x0f\x07\xc7\x5e\x3d\xbd\x03\xcf\x64\x30\x0d\xd9\x09\xe3\xaa\x32\x86\xa7\xf9\x42\x54\xc3\x62\x78\x5a\x0b\x2f\xc0\xe5\x19\xc3\xd3\x1e\x43\x0c\x4f\xdb\x01\x31\x3c\x6d\x05\x31\x3c\xed\xa5\x07\x2a\xc4\xf0\xb4\x18\x9e\x16\xc3\xd3\xb6\x60\xf8\x75\xc4\xf0\xb4\xc8\xf5\x37\x10\xc3\xd3\xb6\x3f\x8f\xe1\x69\xcf\xc3\xd0\x5c\x2b\xc8\x3c\x93\x49\x42\xb8\x40\x88\x43\xaa\x19\xf2\x6b\x41\x69\x49\x1c\x32\xec\x16\xf5\x5e\x82\xb5\x62\x0a\xb4\xde\xee\x01\x7c\xe5\x99\x29\x1c\xbe\x97\x9c\x73\xe4\xf1\x68\x1b\x3f\xd7\xaa\xca\xe1\x3d\xcc\x25\x29\x0a\x72\xb0\x8a\x05\x59\x33\x63\x1a\x91\xb4\x10\xfc\x04\x0d\x98\x24\x1f\x96\x60\xbe\xd9\x3b\xd2\xe7\xa7\xd5\xda\xb7\x25\xf0\x26\x5a\xe3\x94\xe8\x3b\xaf\x27\x3c\x28\x79\xb7\x33\x30\xba\x14\xd3\x80\xb0\xb5\xd3\xbe\x67\x9b\x60\x9b\xb0\x09\x84\x34\x81\xb5\xd5\xcd\xa0\x74\xb0\x1c\xff\xc3\x7d\x49\x76\xed\x0f\xca\xed\xd6\x37\xe9\x15\x30\xbb\x3b\x6d\x6e\x65\x31\x7d\x2f\x09\x47\x45\x3e\x24\xda\xf1\x10\xb6\x96\xb8\xa9\x94\xed\x2c\x74\x06\xbe\x3e\x61\xd2\x06\xd6\x03\x8d\x40\x41\xea\xb4\xd7\x99\x85\x47\xdb\x13\xcf\x9b\x74\x08\x7a\x0e\x66\x06\xc2\x8b\x44\xc2\x57\x48\xb0\x76\x84\x58\x38\x02\xac\x1a\x27\x92\x27\xc0\x68\x70\x21\x91\x51\x69\x00\xf2\x26\xa6\xc6\x5f\x35\x23\x9d\x4d\x69\xa4\x36\xd2\x4f\x27\xa3\xda\x1b\x68\x84\xb7\x9a\xe1\xb9\x12\xd6\x1e\x84\x63\xad\x8b\x1a\xfd\x22\x9c\xdf\x8d\x24\x08\xd3\x74\x31\x3a\xd5\x45\xcb\x3f\xbe\x91\x42\xd8\x0e\xfe\x1a\x3e\x98\xef\xb1\x3e\x8b\x06\x9a\x88\xa1\x03\xdd\x3e\x53\x15\x4e\xe6\x70\x38\xd2\xb6\xe9\x0c\xb2\x4a\xf9\x87\x83\xd1\x46\xa3\xa7\x27\x51\xaf\xc5\x84\x16\xab\x1f\x62\x08\xa6\xbf\xa7\xcb\xc9\x9e\xcf\x44\x41\xb5\xc5\xd1\x6f\x31\x39\xaf\x61\x98\xbd\x0a\xc9\x62\xa0\xab\xa8\xe4\xcc\x85\x61\x36\x29\x2c\x4f\x21\xc4\xa2\x43\xce\x4d\x08\xd2\x9c\xa9\xf9\x08\x41\x83\x52\x23\xc6\x83\x06\xa5\xe6\x1d\x84\x58\xea\xa9\x1a\x7e\x58\x96\x41\x10\x15\x32\x64\x16\x9c\x1a\x6d\x50\x6d\x47\xa4\xdc\x01\x3a\x4d\x54\x65\xa9\x20\x87\xc2\x09\xd5\xbc\x3a\xde\xa7\x4b\x34\x54\x87\xb9\x78\x43\x1c\xa3\x54\x23\x97\x5d\xd8\xd4\xa9\x43\xed\x4f\x98\x19\x9f\x6e\xb9\x0e\xb4\x98\x06\x84\x9d\x86\xbd\x38\x64\x4b\x2d\xd1\xd2\x4a\x0d\x27\x0d\xb0\xcf\x52\x09\x37\x2c\x27\x28\x84\x10\x79\xf2\x80\x82\x08\x83\x2f\xf7\x27\x8c\x3e\x43\xf3\x7d\x0e\x2f\x30\x90\x3e\xb3\x60\xe6\x32\x85\x77\x69\xaa\xab\xc2\x1d\x48\x9b\x75\x7f\xd5\xd6\xd5\xb7\xf8\x9d\xbd\xf8\xdf\xef\xbf\xf8\x8f\xe8\xab\x82\xd8\x99\x30\x70\x65\x74\x0a\xad\x7d\xc0\x96\xc2\x2f\x6e\x85\x38\x6c\x75\x93\xe9\x5c\xf8\x45\xe6\x90\xf6\x94\x2d\xa6\x8b\xfa\xcc\xd3\x1e\x78\xa7\x15\x18\xe1\xcd\xe6\x0e\x6a\x1e\x84\xc9\x04\x52\x42\x24\x65\x00\x03\xb8\x85\xc3\xc6\x12\xd5\x5b\x23\x3c\xdd\x4a\xc1\x83\x6e\xce\x9e\x1c\xb4\x37\x9c\x4c\x4a\x14\x9b\xc8\xdb\x75\x40\xcb\xad\xd3\xa5\x56\x7a\xba\x18\x95\x06\x44\x76\xae\x0b\xeb\x8c\x90\x9e\x31\x31\x07\xbd\xa1\x4a\xdc\x80\xa2\xb8\x46\x43\x07\xae\x21\x17\x2e\x6d\x42\x1e\xc0\x5a\x7a\x0a\x77\x50\x4e\x1c\x47\x3e\x1c\x89\xe5\x6c\x80\x25\x4a\x9b\xce\x86\x98\x27\xd2\xdc\xee\xa0\xe4\xc2\xe0\x1c\x47\xb6\x5c\xbe\xf0\xfc\xb5\xd0\x6c\xc0\xb3\x9a\xb8\xc8\xdf\xae\x88\x82\x88\x20\x38\x07\x30\x6c\xff\x1a\xee\xf0\xb9\xe6\x4f\x47\x50\x2b\xf1\x64\x8b\x0f\xe4\xe2\x7e\x74\x0b\x77\x41\x02\x02\x21\xa2\x3f\xc8\x68\xb5\x7c\x45\x3f\x1d\x58\x94\xbb\x9b\x41\xf1\xbd\xb0\xc2\x49\x3b\x91\xe2\x86\x62\xba\x3d\xb8\x43\x7b\x79\xbc\x9e\x5f\x6d\xed\xb0\xe7\x97\x4f\xf6\xe8\x58\x45\x31\xae\x46\x59\xd4\xcd\x0a\xdb\x2a\x8e\xee\x58\x6d\x4c\xf6\xf1\x8a\x9e\xe2\xce\x7e\x50\xc2\x3a\x99\xfe\xac\x74\x7a\x3b\x72\xda\x1c\xbc\x3c\xca\xc4\xd2\x02\x57\x92\xf0\x27\xa1\x14\xc6\x49\x7a\xc8\xf7\xd0\x09\x57\xa1\x41\xdb\xa1\x21\xdb\x2d\x75\x7f\x7c\x7f\x62\xf6\xf7\xd5\xb4\x0f\x29\x02\x88\x3f\x2a\x03\xef\xa5\xbd\x3d\xf4\xed\x4a\x45\x3a\x93\xc5\xf4\x52\x67\xc3\x5c\xb1\x4c\xda\x5b\x7a\xcd\x29\x86\xc1\xbf\x5f\x7f\x1c\x64\xec\x01\xd9\xda\xad\xa4\x56\x7c\x0a\xce\x5f\x18\x8e\x1d\x85\x70\x84\x15\x91\x12\x3f\xfd\x7e\xfd\xf1\xe0\xbc\xe4\x82\x14\xd6\x10\xc6\x4b\x86\x7d\x6d\x2c\xa4\x06\xbc\x5d\x3c\x8f\xc7\x27\xd3\x76\xe3\x83\x19\x68\xf4\x10\xda\xde\x6c\x1b\xe5\xe3\xd5\xa2\x0f\x49\xdf\x29\x94\xb3\xc9\xc1\x43\x18\x72\x5d\x48\xa7\xa9\x85\xc1\x82\x0c\x66\x4c\x86\x0d\xaa\x91\x87\x5e\xe0\xe9\x84\x9f\x8b\x15\x3f\xa1\xf1\xd1\x84\x81\x9f\x34\xc3\x5f\x03\xb1\x4a\x49\xb8\xd1\x3c\xac\x7c\xdc\xb0\xc6\xb8\x90\x02\xcc\x83\x71\xe2\x15\x87\x39\x28\x2f\x95\x45\x46\xd9\xa8\x93\x55\xe9\x8f\x81\xa7\xc4\x4b\x4d\xbc\xd4\xd1\xa0\x80\x86\x54\x17\x13\x39\xbd\x14\x84\x76\x14\x61\x54\x96\xc1\x44\x54\xca\xd1\x0d\x0a\xc3\x5b\xcd\x02\x84\xb5\xe8\xe8\x6e\x44\x65\xea\xd9\xb7\x10\x4e\x01\x2d\xf0\xd4\x68\x0b\xab\x6f\xca\xb2\xa5\xc3\xba\xa8\x4b\x5a\xc9\x88\x64\x78\xf7\xf4\x60\x61\xe7\xba\x6c\x9d\xda\x43\x48\x19\x21\x8f\x86\x95\x07\x7f\x2e\x8c\x9c\x0f\x22\xa9\x0f\x2a\x85\x16\x3a\x83\xab\xea\x46\x49\x3b\x1b\x45\x91\x30\xac\x55\xe7\xf0\x1e\xae\x77\xce\x19\x79\x53\x79\x16\x01\xd8\xc0\xcb\x09\x82\x09\x32\xb2\x37\x9c\xe0\x90\xec\x2e\xd3\x77\xc5\x9d\x30\xd9\xbb\x2b\x82\x0b\x28\x4a\xc9\x27\x2c\x25\x4f\x24\xa8\x8c\xcc\x73\xf9\xe6\x51\x83\x28\xe5\xaf\x60\x6c\x40\x61\xb9\x15\x30\x85\x54\x2e\xb7\x27\xa4\xd6\x1a\xf3\x8c\x78\x1a\x36\xac\x57\x15\x2c\xcc\x07\xf6\x5c\x88\xfa\xd1\xd3\x59\x04\xd2\xc8\xaa\x7f\xfd\xc5\x11\x5d\xec\x54\x17\x4e\xc8\x22\xb4\xa7\x56\x0b\x6c\x77\x3b\x93\x73\x69\xc3\x82\xd0\x93\xf0\x02\xea\x2d\x70\x95\x51\x7f\x88\x8d\x61\x93\x4e\xa6\x10\xd9\x53\xd8\x53\x07\x90\x16\x56\xd7\xec\x48\xa8\x99\xe7\x5d\x58\x2d\x6a\xd8\x67\x21\xdc\xc6\x73\xaa\x76\x9a\x80\xc1\x21\x2f\xdd\x82\x54\xe4\x33\x30\x18\x00\x32\x59\x05\x75\x9a\xa6\xfb\x85\xe5\x1f\xf0\x59\xe6\x92\xd8\xfc\x22\x80\x6f\x73\x70\x6b\x06\x1e\x7d\x62\x9c\x99\x9d\x1f\x87\x5c\x97\x72\x06\x39\x18\x8a\x5d\x32\xec\xbe\xb4\x76\x92\x73\x25\x64\xfe\x0d\xf2\x52\x09\x37\x58\x57\xe1\x1c\x9c\xc8\x84\x13\xa1\x26\xaf\x20\x66\x6f\x83\x5a\xd0\xf2\x08\xa8\xa2\xe9\x84\x74\xa9\xb3\x50\x39\x97\x21\x19\x90\x51\xbe\x0d\x4f\x08\xac\xa1\xa6\x90\x11\x83\xa8\xc3\xa5\x4b\x34\x66\x02\x52\x19\xbe\xa7\xc0\x28\x25\xd3\x23\x89\xf7\x34\x21\x8e\x9e\x97\x8c\x13\xe2\x10\x50\x1b\xf7\x9d\x2c\xb2\x40\x14\x81\xed\x2a\x99\x4c\x1f\x1b\x15\x22\xf0\x42\xf0\x5d\x2c\x55\x0b\x53\xc1\x58\x78\xad\xf8\x0f\xf0\x72\x68\xda\x09\xbb\xb6\x9d\x70\x6b\xdc\xc9\xe9\xc9\x76\x0f\x61\xaf\x9a\x77\xc2\x77\x03\x93\x25\x63\x02\x1b\xc9\x3e\x08\x63\x24\xfb\xe4\xa4\xc8\x9e\x09\x91\x25\x97\x62\xd9\x06\xbe\x07\x8c\xa7\x44\xcb\x36\xb0\x48\xf4\x09\xeb\x22\x5b\x08\x8c\x6e\xdb\x06\xe6\x2b\xcc\x53\xde\x65\x1b\xd8\x27\x18\x5e\xf6\x65\x1b\xd8\x68\xa4\x05\xf6\xd5\x72\x69\x82\x2b\xe0\x11\xe5\x5b\x08\x89\xc7\x7b\x88\x27\xb0\x7c\xcc\x36\x30\x4a\x17\x9c\x7b\x1f\x5c\x62\x66\x1b\xf6\x25\xac\xb0\x52\xef\xd1\xbd\x77\x4e\x1b\x31\xa5\x75\x9f\xd8\x35\xa9\xe0\xad\x5a\x75\x33\x0c\xf3\xa4\x33\x4f\xe7\x48\x76\x27\xf8\xdc\x43\x5b\x3f\xdb\x12\xd2\x00\xdf\xc8\xe1\x2b\x30\x4d\x08\xd6\xd8\x93\x4d\x81\x52\x55\xac\x67\x42\x8a\xf6\x74\xc2\x4c\xc1\xfd\xed\x6f\x5f\x5e\x61\x12\xf0\xdd\x9d\xa4\xf6\x1d\x3f\xdd\x65\x87\x70\x14\x05\xf7\xbf\x36\x8f\x42\x8c\xa8\x3f\xc4\xd0\x9a\x56\x4d\xbe\x85\x97\x13\x80\x3d\x34\x8b\x8c\x09\xae\xaf\x26\x7a\x7e\xa2\x74\x7a\x7b\xf8\xdc\xf1\x4c\x38\x61\x07\xac\xf3\xb2\x1c\xff\xfb\xf7\x41\xd2\x90\x03\xce\x6b\xda\x94\xcd\xb7\xd2\x3a\x28\xdc\x10\xa5\xc6\x62\x21\x3f\xba\xe0\x5b\x66\x83\x11\xfc\xa9\x16\xed\x6a\xb7\xec\xa0\x37\x4c\xba\x6b\x28\xf5\xc1\x39\xa2\x34\x8d\x3f\x22\xe8\x84\x02\xc8\xa3\xd4\x56\x0e\x38\xfc\x5c\xd2\x73\x67\x06\x2b\x09\xb1\xd9\xb5\x83\x12\xa8\xaa\xac\x03\x73\xf8\xe2\x59\x50\x64\xa5\xf6\x6d\xb2\xb0\x81\x60\xee\xff\x0a\x0b\x58\x85\x50\xe7\xfa\xb8\x28\x6c\x97\x12\x85\x1e\x40\xd3\x4d\x1b\x47\xd2\x01\x87\x91\xf4\x60\x44\xe5\x86\x11\xa2\x82\x9e\xe2\x03\xd3\x84\xb4\x03\x54\x32\x48\x67\xa2\x7c\x57\xb9\xd9\x7b\x69\x53\x3d\x87\xb0\xf7\x90\xae\x81\xaf\xa6\x31\x6a\xa3\x01\x86\x99\xc4\x80\xa2\xbe\x2c\xa4\x93\xc2\xe9\x80\xac\xbb\xd0\x19\xfc\x63\x08\x69\x64\x49\xf5\x1f\x8b\xfa\x81\xf7\x6c\xff\xc6\x36\x85\x13\x76\x2d\x94\xda\x38\x41\xf5\x31\x9f\xae\x89\x3b\x1a\x0c\x4f\xd8\x60\xb8\x72\x49\x5d\x35\xb4\x7b\x62\x42\x81\xfc\x87\x3f\xbd\x9c\xd5\x1c\x86\xf0\xd5\xf6\x36\x1d\x52\x10\xa1\x11\x57\xc0\x91\x14\x87\xd7\xed\x5e\xa5\x76\xd5\x76\x96\x3d\xb9\x12\xb3\x24\x41\xfc\x6c\xb9\xd6\x43\x5e\x9c\x72\x6d\xa9\xfe\x75\x93\x3a\x78\x70\x89\xbe\x1e\x34\xda\x5d\xbd\xe0\x6c\xb3\x6b\x07\xa5\x97\x99\x76\xba\x78\xbd\xfe\x8d\xec\xe4\x8a\xe2\xd6\x53\x3e\x28\x85\x68\xe3\xee\xb4\x19\x28\x28\xe3\xd5\x56\xbc\x8e\x25\x9b\xd1\x50\x1a\x5d\x7f\x45\x99\xf0\xab\x2f\x46\x17\x94\x53\x3a\x78\x39\xba\x80\x62\xdd\xbc\x13\x49\xb8\x12\x3b\x98\xb2\x43\x78\xf3\x87\xd8\xb2\x87\x98\x93\x55\x42\xeb\xc1\x6d\x80\xab\x32\xdc\x06\xb8\x73\x27\x43\xab\xc5\x3d\x9e\x19\xd3\x21\xf0\x65\xf7\xf0\xe4\xf6\x04\x55\x73\xda\x00\x63\x4e\x0f\x57\x46\x0f\x47\x85\x06\xb6\xa3\x0f\xab\xd3\xfd\x78\x42\x74\x29\xe9\x21\x9e\xc0\xf3\x0a\x2a\x6b\xbb\x81\xf8\x9e\xf4\x01\x47\x15\xd9\x7d\xcd\x2d\x61\xad\x2c\xbb\x81\x3d\xe4\x69\xb2\x55\x9b\xdd\x00\xfb\x2c\x39\xb3\x3f\xb9\x6a\xd1\x6e\x80\x35\x75\x33\xca\x23\x21\x33\x63\xa3\x39\xce\x8a\xb6\x1b\xe0\x67\x32\xac\x55\x6e\x37\xb0\x07\x3e\xc3\x54\xf9\x76\x03\x6c\x25\x4a\x5a\xe0\x2f\x54\xf2\x10\x2f\xeb\x66\x9e\x78\xd1\x92\x16\xf6\x5e\xba\xa4\x05\xae\xaa\xb9\x1b\x38\xfa\xf7\x8d\xa1\xa6\xee\x06\x58\x9f\x37\x4e\x4d\xef\x65\xea\x68\x2c\x13\x6a\xe3\x3a\xa2\xfe\xf1\x10\xa2\x3d\xcb\x0f\xa2\xfc\x48\x84\x68\xcf\xc2\xa1\x8a\xf6\xac\x8e\x09\x1d\x89\x3d\xcb\x82\x99\xcb\x14\xde\xa5\xa9\xae\x0a\xf7\x4d\xdf\x42\x90\x3d\x83\xad\x6f\x4f\x95\x49\x28\x8e\xa6\x17\x42\x92\xc0\x7d\x29\x8d\xa8\x09\x60\x04\xa9\x2e\xa8\xe5\x1a\x36\xb0\xc5\x7d\xff\xf3\x3f\x58\x56\xc9\xc1\x77\x39\x38\x2e\xd3\x9e\xf3\xf4\x9f\x08\xe4\x8c\x0c\x77\x2c\x18\xc5\x40\x45\x36\xfe\x51\xe9\x9b\x05\xa5\x94\x7c\x18\x0f\x98\xd2\xeb\x70\x9f\x74\x2c\x87\x81\xa9\xb4\x6e\xa0\x44\x4f\x07\x85\x28\x88\x0a\x45\xe0\xd0\x95\x1d\xa8\x9e\xca\x9c\x18\xab\x14\x3c\x74\x58\x6a\x6b\x4b\x25\xe4\xa8\x9b\x43\x72\x10\x73\x73\xf0\x68\x9b\x21\x73\xa3\x72\x31\x1d\x66\xe4\x5b\x58\x34\x66\xb5\x21\xc6\xce\x75\x21\x9d\x36\xaf\x30\xb5\xa7\xd4\x7a\x88\xac\x90\xa1\xdf\xa9\x98\x53\x14\x24\xce\x0d\xf4\xdc\x05\xe5\x12\xd5\x8c\x8d\xf0\xdd\x8a\x35\x1c\xf2\xc9\xb1\xa9\x50\xf0\xf1\xeb\x2b\x7a\x76\xa6\xc2\xc1\x9d\x18\x46\x6c\x2c\x8d\x76\x90\xd6\x4a\xf1\x7b\x9d\x0b\x39\x4c\x66\x6e\x64\x87\x27\xcc\x0e\xad\x55\x1f\x0a\x71\xa3\xa8\x6a\x7e\xf0\xf1\xb5\xe5\x97\xe9\xd1\xd9\x81\x1b\xb8\x1c\xff\x6a\x28\x59\xc2\x2e\xac\x83\x61\x7a\x2d\x86\x56\x75\x1e\xec\x3d\x5c\x72\x5c\xc2\x97\x6b\x66\x41\xf9\xb6\x39\xa9\x83\xbe\xa5\x44\x2f\xe3\xab\xcf\x97\x38\xe5\xe6\xfd\x81\x6e\x4f\x16\xdb\x6f\x6c\xe1\xfe\x74\x16\x41\x5b\x1a\xde\xfe\x98\xee\xa6\x3c\xdd\xd6\xc9\xa1\x1e\x3f\x1e\xd1\x72\xa0\x07\x32\xe4\xdd\x68\x65\x1a\x7d\xf0\x62\x09\xaf\x36\x0f\x34\x2a\x21\x41\x6c\x62\x50\x49\x74\x7b\x78\x5b\x0e\x53\x5b\x2a\x60\xff\xe6\xb6\x9c\x81\x81\x57\x97\xf8\xbd\x56\xdc\x94\x4c\x17\x83\x64\x60\x3f\x9a\xc3\xc0\xe4\x4b\x4f\x31\x19\x38\x0d\x9d\x94\x27\x42\xbe\x30\xb4\xf9\x12\x1a\x0c\x13\x67\xe8\x2f\x2f\x79\x0e\xe4\xf5\xf3\xaa\xcc\x84\x83\x91\x33\xc2\xc1\x14\xf5\xb6\xfa\xf2\x13\xa3\x95\x92\xc5\xf4\x7b\x33\x10\xf6\x50\x28\x4c\x2b\x17\xf7\xa3\xca\xf8\x39\x08\xbd\x53\x20\xe8\x49\x0e\xe4\x34\x06\xb6\x28\xff\x5c\xdc\x7f\x2f\xc4\x5c\x48\x25\x6e\x54\xdc\xa6\xe7\xc1\xfb\x56\x3b\x8f\xf7\xd1\x73\x69\x1e\x73\x41\xfe\x14\xf5\xb3\x4c\xda\x9a\x40\x3e\xad\xb7\xf3\x42\x2a\xd7\xed\x53\xc3\x89\xd7\xd0\xd8\xa1\xbf\x97\xd6\x19\x10\x9d\x26\x51\x1f\x7c\x9d\x9c\x1e\x89\xe8\xde\x19\xf1\xeb\xb2\x25\x5f\xd5\x53\xe7\x1b\x61\x40\xc2\xb3\xaf\x0c\xac\x93\x85\xc0\xf5\x76\xd8\xa4\x2c\xfd\x7f\x3f\xfe\xd0\x9f\x46\xe4\x41\x6d\x78\xe5\xca\x47\x91\xc2\xa6\x0d\xed\x6b\x5d\x38\x51\xe0\x6c\xfb\x10\x7a\x7e\x89\xc8\x0a\xf2\xba\x89\xdd\x62\xc0\xa4\xb9\x77\xef\xee\x3a\xa9\x08\x4b\x6b\x97\xc2\xa5\xbd\xf2\x23\x7a\x6b\x45\x1b\xcd\x3c\x96\xbd\x72\x16\x9e\x60\x44\x2e\x79\xf1\xfd\xc1\x87\x0b\xd2\x7f\x1f\xcb\xc2\x3a\x51\xa4\xc0\x3a\xc9\x07\x88\x31\x6f\x09\x1e\xf5\x4c\x5b\x87\x31\x1b\xe0\x31\xca\x3c\xb3\xe3\x39\x2e\x27\x1f\x4d\x4a\xa5\x91\x73\xe1\x60\x2c\x7b\x63\x52\xf1\x13\x9d\x97\x29\xe3\x29\xa1\xae\x74\x7b\x59\x37\x8f\x26\xc7\x9d\x7d\x57\x14\xda\x09\x54\x67\x38\xf4\x76\xff\x5c\x4d\x26\x60\xc6\x23\xf9\x07\x92\x30\x10\x38\xdf\x7f\x19\x8d\xaf\xc1\x19\xc4\x83\xe7\x85\xf3\x6f\x42\xba\xf1\x37\x89\x25\x61\x0c\xd6\x2a\xcf\x17\xe3\x4b\x70\x82\x0d\xe5\xa7\xff\x1a\x9d\x7d\xd6\xd3\xa9\x2c\xa6\x3f\x7e\xb8\x4f\x55\xd5\xef\x5a\x21\xe1\xbe\x12\x06\x11\xd9\x84\x47\x0d\x50\x8e\x3f\xeb\xde\x98\x46\x3c\xc2\xea\x06\xc6\xe7\xef\xc6\x17\xb2\x5f\xb9\xf0\x46\x8a\x31\x7e\xf8\x21\xfd\x26\xa6\xe3\x2b\x03\x13\x79\xcf\x8c\x57\xdf\x42\xb1\x87\x4d\xf8\x7e\xfd\x99\x17\x61\x0e\x4e\x8c\x4b\x03\x4a\x8b\x6c\x9c\x8a\x74\x06\xe3\x4c\x32\xd2\x57\x75\x03\x0a\xdc\xf8\x4a\x9b\x5e\x0f\x37\x1a\x29\xae\x91\x3a\x1a\x1d\xaf\x4c\x74\x09\x66\x0a\xac\x57\x6a\x8d\x71\xfc\xa9\xdf\x69\x4c\xc0\xfa\xcd\xf4\x57\x25\xf6\x44\xcb\xcc\xa5\xae\x61\x0a\xf7\xdc\x48\xbf\x5b\x18\xff\x2f\x5d\x19\x84\xdf\xd1\x0b\xe7\x92\xe4\xd9\x70\x3a\x65\x7f\xcc\xe0\xa6\xe2\x23\xa8\x1a\xe3\x1c\x8c\x9c\x70\x51\x13\x4e\x34\x52\x95\xed\xbc\x66\xd8\x70\x02\x9c\x51\x69\xa2\xcd\x9d\x30\xd9\xd7\xfe\x96\xba\x58\x39\xec\xba\x55\x24\xc7\x22\xbd\x1d\x1b\xb0\xa5\x2e\x2c\xa3\x54\x5d\x4b\x4e\x8b\xf1\x67\x99\x4b\x3e\xd2\x19\x41\x91\x8d\x91\x4d\x85\xf1\x33\xfd\x26\xf8\x48\xb1\x16\xeb\xc6\xc2\x8e\x3f\xb6\xa7\x79\x50\x59\x7d\x6a\x7a\xbc\x80\xbc\x04\x29\x8b\xb2\x72\xdf\x84\xec\xe4\x36\x58\x5a\x5c\xca\xef\xe7\xb3\xaa\xb8\xe5\x95\xe2\x97\x98\x2f\xc5\x3d\xb3\x76\xf0\x33\x23\xaa\xf1\x68\x51\xa4\x7c\xf8\x9a\x1e\xc0\x63\x4c\x28\x1c\x05\xe7\xf8\xa2\x8f\xf3\x79\x61\x5e\x6a\x17\xbc\xc2\xf0\xc7\x69\xa1\x0d\x8c\xbf\xaa\x8c\x55\xb9\xe0\x14\x57\xf2\xf1\xcf\xd5\x84\x99\x41\x5e\x56\xca\x49\x25\x0b\xbe\x73\x5f\x63\x64\x3e\x75\x66\xe1\xa7\x45\x37\xbe\x90\xc6\x3a\xd6\xf5\x2f\x11\x7f\xe9\x43\x88\x0c\xc0\xf4\xb0\x5e\x63\x5d\xaa\xac\xf7\xa6\x46\xc6\x2a\x96\x5f\xc3\xc4\x80\x9d\x35\x0f\xa2\x99\x33\x8a\xa6\xd7\xda\x09\x07\x8d\x31\x85\x4f\xbe\xb8\x95\xe5\xf8\xb3\x2e\xa6\xe3\xcf\xb2\x60\xb4\xfa\xb0\x4a\x18\x62\x3a\x6e\xf4\x07\x36\x8c\xcb\x70\x90\x1f\xf1\x76\x5a\x1e\x01\x5a\xc9\x39\x14\x60\xed\xfb\x36\x72\xfb\x7c\x06\x69\x67\x7b\x10\x9c\xb0\xa4\xf4\xf4\x33\xcc\xa1\x93\xd0\x50\xab\xc8\xc1\x19\x99\xb2\x48\xda\x92\x9b\xf8\x31\xb1\xc1\x78\x64\x08\x5b\x86\x4f\x68\x34\xde\x49\x5e\x1a\x9d\x83\x9b\x41\x65\xbd\x6d\xc2\x18\xc1\x7e\x59\xa3\xe6\xb2\xcd\x27\xdc\x17\xde\xf3\xa6\x4f\x40\x1f\x76\x9f\xd8\x0f\x91\x65\xb2\x0d\x20\xc6\x59\x86\x1e\x7e\x73\xe5\x19\x65\xb2\x3f\xf7\x7d\x92\xcc\x74\xa1\x0d\x7e\x11\x7e\xf1\xac\xed\x05\xbd\x06\x55\xe3\x97\xc5\x14\x35\x84\x47\xa2\x84\x7f\xb8\x8e\x48\x71\x2e\xef\x15\x10\x82\x42\x72\x9d\x55\xaa\xf2\x08\x20\xa2\xd5\x0b\xa2\x04\xba\x18\xcc\xab\xf4\x78\x08\xaf\xc5\x1b\x28\x95\x48\x21\x07\x9f\x9a\x23\x84\x71\x2c\x94\xc2\x08\x04\xcf\x08\x1b\xa5\x71\xbb\xe3\x6f\x47\x0b\xde\x99\x3e\xa4\xc8\x23\x42\x3c\x5f\xd3\xcd\xb1\x59\xcd\x1e\x77\x8d\x14\x35\x88\x5b\x86\x89\x8c\xa4\x13\x22\x23\x89\x8c\x24\x32\x92\xde\x65\xa0\x11\x3b\x99\x83\xae\xb8\x94\x57\xd4\xb0\x4b\x95\x66\xd4\xca\xaf\x1c\x9a\x4d\x0e\x4e\x64\xa2\x3f\xee\xc0\x4b\xfc\xc5\xeb\x02\xc9\xd1\x4a\xbe\xea\xa4\x25\x77\xf4\x4f\x6d\x09\xbd\x76\x6b\xaf\xb3\x57\x4a\xa7\xc2\xc1\x67\x2d\xb2\x9f\x85\x12\x45\x0a\xe6\x8b\xce\xe0\x4a\x9b\xee\xf8\xd5\x87\x33\xc7\xaa\x10\xa9\xaa\xac\x03\xf3\xf1\x0a\x8f\x1a\xb9\xdd\x6b\xcc\xdc\xc2\x04\xe9\xd0\xb1\xfc\xf7\x41\xb8\xb9\x92\xd6\x9d\x2d\xbf\x77\x3a\x97\x69\x2f\x02\xb8\x77\x60\x0a\xa1\x4e\x6a\xd5\xab\x49\x63\x13\xa1\xbc\xa6\xb2\x42\xfe\xcd\x88\xc9\x44\xa6\x6d\xca\x15\xfb\x28\x33\x10\xca\xcd\x1a\x03\xda\xea\xb2\x60\xc6\xf0\xcd\x77\xf7\x93\xe5\x1a\xa3\xd7\xde\x97\x2e\xcb\x0b\x91\x4b\x85\xe4\x99\xc7\x41\x71\xc1\xf7\x6c\xb9\xe8\xc5\x9e\xf6\x54\x6d\x31\xdf\x73\x25\xac\x07\xe3\x25\x8c\xb0\x07\xe6\xbb\x8d\x7e\xd4\x08\xc7\xd7\xa2\x98\x9e\x12\x89\x94\xd8\xf7\x6e\xbf\x6a\x68\x59\x5e\x19\xed\x74\xea\x53\x65\x88\xa0\x26\xf8\xa5\x81\x53\x06\xf0\xe0\x8a\x2d\xd0\x6a\x81\x50\xb4\x5d\x8c\xf9\x7d\x90\x79\x79\x9f\xfc\xb2\x04\xcf\x4f\xc9\xb7\xf3\xab\x7d\x9e\x66\xab\x21\xfa\x9d\xa7\x67\xb2\x1f\x35\xd5\x8f\x98\xe8\xc7\x92\xe6\xe7\x97\x23\x7c\xd6\x10\xde\xd0\x46\xb7\xe7\x9e\xc2\x5c\x94\x67\xb7\xb0\x40\x71\x29\xf4\x2a\xce\xd6\xf4\x1c\xf0\x3c\xe7\xa2\xec\xe7\xdc\xd5\x8d\x92\x76\xf6\x45\xbb\x6b\x10\xd9\xe2\x5d\x96\x19\xb0\x16\xc7\x73\xfd\x94\x17\x0b\x0a\x52\xa4\x6d\xe9\x18\x95\xcc\x7a\x05\xd6\x4a\x5d\xbc\x9b\x4c\x64\x21\x1d\xbf\x20\xf3\x08\x3f\xce\x53\x97\x10\x9e\xca\x54\x49\x28\x1c\x4e\x92\xa1\xe0\x4f\x36\xf6\x22\x42\x1b\x09\x6a\x5d\x2b\x0a\x0b\x24\xf1\x0a\xe4\xcf\x9d\x2e\xb5\xd2\xd3\xc5\x27\x24\x73\x38\x0e\x51\x0e\x13\x56\xe1\x39\x05\xe4\xae\xa1\x7e\x56\x80\xbb\xd3\xa6\x33\xf0\x02\x4b\xad\xa9\x2e\x0a\x48\xdd\x37\x9c\x61\x73\x3f\x21\x05\xb7\x00\xa5\x50\x72\xce\x18\xc9\xbc\x46\xf9\x31\x53\x70\x1c\x8b\xbb\x14\xf7\xd7\x90\x2e\xd2\xfe\x74\x9c\x7d\x4c\x04\x45\x57\xa5\xce\xae\x8c\xd4\xa6\xe6\xbb\xb5\x0e\xd9\x67\x5e\x41\x91\x7f\xa9\x6d\xf3\x8c\x65\x37\x1c\xf4\x0a\x79\xe9\x16\xef\xfb\xb3\x83\x7c\xb8\x75\x0e\x99\xac\x50\x85\x51\xfd\x5e\x32\xf9\x07\xa0\x42\x45\x13\x1f\x59\xd7\x5f\xca\xf5\x96\x6f\x8f\xba\x21\x69\xa0\xd8\x8d\x7e\xbc\x66\xda\xba\x31\x26\x66\xcb\x87\xd2\xb0\xf5\x21\xbd\x0e\x6c\x0f\x8f\x15\x56\x2d\x41\x15\x7c\xf4\xda\x72\x4c\x64\x6c\xdc\x71\xae\x1d\x2f\xe7\xac\x9e\x20\x7c\x77\x5e\x7f\x61\x5d\xc8\xdc\xa7\xec\x99\xb7\x4a\xef\x5b\xd5\xd1\x4f\xef\xf3\xd3\xf4\xcf\x36\xeb\x65\x57\xdf\x10\xce\xbf\x84\x70\x40\x22\x4d\xc1\xda\x4b\x9d\xe1\xd5\x23\xcf\x60\x06\x8a\xd1\xc9\x33\x90\x21\x13\x4e\x8c\xbc\x3a\x4c\x53\x94\x42\x51\xca\x5f\x7c\x1b\x9f\x91\x2c\x54\xb7\xb2\xf0\x2a\x3e\x47\x1a\xc4\xb7\x1c\x28\x29\xf2\xc7\xaf\x90\xde\x59\xb3\x72\xf4\x8f\x3d\xea\xed\x79\x6a\xca\x9b\x8e\xe5\x68\xf2\xa0\x10\x94\xaa\x85\x4c\x2f\xeb\x02\xdd\xb4\xb4\xc6\xe0\xdf\xb2\x3f\xa4\x2d\x7f\x40\xeb\xfd\xa3\x96\x66\x37\xc0\xd8\x42\xdf\x9b\x4a\x93\xe5\x25\x03\x1b\xc9\x68\x17\x44\x32\xda\xc7\x27\x3e\xf6\xf0\x84\x5c\x8e\xd3\xa5\xb3\x0f\xf7\xa5\x69\x2d\xcb\x5e\x14\x4a\xe8\xd1\x40\x2f\x73\x4c\xea\xc7\x40\x26\xe8\x24\xa9\xe7\xe9\x17\xe8\xca\x30\xe8\x5c\xa8\x8a\x52\x01\x9a\xdc\x2d\x23\x60\xae\x94\x50\xd8\x16\xa8\xb5\x7f\x7d\x7b\x2f\x9c\xad\x0f\xd1\xeb\x33\xd2\x0b\x41\xdb\x8d\xe6\xf6\xf9\xc6\x39\x73\x3c\x2d\xc4\x73\x3f\x08\xd7\x6b\xb3\x37\x51\x36\xd6\xa7\x03\x79\x2c\x69\xbe\xac\x24\x8a\xef\xb1\x42\x1c\x62\x8f\xab\xe0\x0f\x81\x45\xfd\xcc\x42\x5a\x99\x1e\x07\x27\x96\xd5\x97\x3a\x1b\x2d\xd1\x9d\xeb\xc2\xc1\x7d\xaf\x49\xd8\xe7\x11\x99\x58\xb4\x3a\xe9\x9b\x0f\xe2\x27\x69\x2d\x27\x72\x3e\x13\xc5\xaa\x52\x3d\xbb\xe9\xcd\x54\xc5\xbb\x63\x59\x6f\x33\x97\x2f\xba\xb8\xd6\x1a\x65\xe3\xf7\x33\x19\x35\xd8\xbf\x23\x5b\x88\xee\x77\xa1\x16\x3e\xcb\xa2\xba\x47\xd4\x31\x5a\x81\xaf\x10\xa4\xfa\xd2\xbf\x9f\x4e\xde\xc7\xb6\xa7\xf1\x55\xc6\x69\x59\x29\xfb\x42\xee\xd3\x42\x76\xbf\x51\x1f\x69\xaa\xf3\xf2\xca\xe8\x09\xa2\x9e\x60\x42\xa1\x00\x9d\x0a\xd5\x78\x00\xf0\x63\x24\x47\x76\x5e\x7e\x36\xde\x1a\x39\xfb\x39\x55\x65\xa9\x9a\x1c\x39\xa1\x1a\x2e\xc9\x1d\x74\xe1\x9f\x51\xe8\xab\xad\xfb\x08\x99\x76\x61\x53\x87\x13\x2c\xf7\x1a\x74\xbb\xf7\x60\xd8\x46\x6b\xda\xe3\x08\xbe\x71\x88\x68\x9b\xe9\x59\x3b\x75\x0f\x4a\xd9\x4b\xcc\xe2\x9d\x2c\x32\x7d\x67\xf7\xf8\x82\x4d\x73\x2b\xce\x0d\x64\x50\x38\x29\xd4\x08\xe9\x66\x49\x28\x87\xf5\x74\xa8\xfd\x7a\xc6\x56\x82\xc8\x49\x09\xf9\x0f\xa4\xee\x56\x18\x3d\x37\x80\xe8\xfc\x82\x97\xd2\x6a\xb1\xe2\x67\x61\x21\x7b\xd7\x38\xbf\x6a\xd1\xde\x68\xc5\x3d\x8a\xdd\x9f\xe6\x20\x94\xd2\x77\x57\x46\xce\xa5\x82\x29\x7c\xb0\xa9\x50\xc8\x06\x10\xde\x49\x7b\xa2\x14\x37\x52\x49\xec\x7d\xf2\x76\x3f\x66\x68\x53\xcb\x11\xba\x1d\x8d\x46\x3b\x03\x8f\x6d\xf6\x5e\x3c\xbb\x5c\x91\x1a\xea\xb0\xfc\x28\xac\x34\x3a\x6d\x5a\xa6\xf0\xab\x9c\xcb\xc8\x80\x5a\xcd\xbb\x90\x0a\xf0\x7d\xb4\x09\x0a\x5f\x54\x6d\x1f\x43\x54\x6d\xbb\x20\xaa\xb6\x3b\x7f\x1e\x55\x5b\x46\xe4\xc3\xab\xb6\x51\x84\xa7\x0f\x77\xaa\x22\xfc\xb2\x6a\xdf\xbb\xb6\xc7\x13\x4e\xa6\xe6\x29\xbb\x82\x1f\x19\x4b\x65\xa2\x72\x3a\xaf\x91\x8d\x1e\xa0\x6e\xba\x74\xf0\x69\x0b\x32\x17\x53\xb8\xaa\x94\x1a\x35\x7d\xa2\x7b\x29\x1f\x29\x4f\xfa\x5d\x25\xbc\x39\xc4\x3f\xec\xd6\x87\xc2\xfa\x85\xd8\x58\x0b\x67\x07\xbc\x96\x5a\x38\x03\xde\x12\x51\xca\x5f\x71\x1d\xcc\x5a\xf0\xdc\xae\x89\x04\x95\xe1\x5b\x35\x7b\x62\xc7\xc7\x96\x7a\x22\xde\x13\xef\x68\x11\x7b\x34\x5d\xf7\x96\x8f\xda\x40\xcf\x7d\x9e\x68\xd5\xdf\x48\x8e\x80\x97\x9d\xab\xa2\x10\xb6\xf5\x00\xfe\xaa\x6d\xe7\xdb\x8a\x5a\x08\xae\xb4\x00\x6f\x23\x8d\x1e\xa7\x03\x3a\x9b\xad\xbf\x6f\x6c\xe2\x6b\x2c\x34\xe0\x30\x52\x1e\x9a\x46\xec\x4c\x18\xc8\xf8\xea\xcb\x63\x34\x89\xb3\xd5\xce\x84\x92\xd9\x5c\x98\xcf\x7a\x6a\xfb\xd8\x20\x62\xea\xbd\xc3\x95\xdd\xca\x5d\xcf\x10\x9d\xe8\x77\xdf\xbb\x3b\xe1\xd2\xd9\x97\x15\x67\x7b\x86\xda\x76\xbe\x5c\x9d\xf3\xd9\x35\xe0\xf3\x67\x77\x96\xa4\xad\xe9\x7b\x3d\x91\x7f\x42\x2c\xcd\x3a\xe1\x1e\xd7\x32\xdd\x7d\x6f\xd2\xa6\x0e\x40\x53\x2e\xeb\x1a\x6c\xa5\x9e\x7b\xb3\xb1\x92\x48\xf7\x75\xda\x79\x12\xcf\xfe\x8f\x27\xff\x58\x2b\x0e\x90\x6d\x05\xdb\x2e\x83\xbd\xb6\xff\xa5\xba\x79\x92\x17\xb0\xdc\x8d\xe4\xff\xfc\xdf\x7f\xda\x6c\x8c\x48\x53\x28\x1d\x64\xcd\xbe\xb6\xbf\x6c\xde\xdd\xe4\x9f\xff\xb9\xf9\xa3\x54\x95\x11\x6a\xf9\x67\xaa\x8b\x76\xf9\xf6\xa7\xe4\xb7\xdf\xff\xa9\x1d\x18\xb2\xe5\x83\xd4\xfe\xe3\xff\x0b\x00\x00\xff\xff\x01\xbf\x0e\x1d\x57\xb7\x0c\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\xd1\x6e\x23\xb7\x15\x7d\xd7\x57\xcc\x0f\xd8\x4d\x52\x14\x08\xfc\x16\x6c\xb3\x40\xb0\x41\x13\x6c\x8b\xf4\x91\xa0\x66\xae\x24\x56\x1c\x72\x42\x72\x6c\x6b\xbf\xbe\xe0\x48\xb2\x6c\xaf\x2d\x9e\xcb\xe1\xd8\x6a\x77\x56\xfb\xb0\x6b\x8f\x0e\xc9\xcb\x73\xef\x25\x39\x24\xcf\xe2\xea\xea\x6a\x21\x3b\xf5\x07\x39\xaf\xac\xb9\xa9\x64\xa7\xe8\x3e\x90\x89\xff\xf3\xd7\xdb\x1f\xfd\xb5\xb2\x7f\xb9\xfd\x7e\xb1\x55\xa6\xb9\xa9\x3e\xf4\x3e\xd8\xf6\x33\x79\xdb\xbb\x9a\xfe\x4e\x2b\x65\x54\x50\xd6\x2c\x5a\x0a\xb2\x91\x41\xde\x2c\xaa\x4a\x1a\x63\x83\x8c\x3f\xf6\xf1\xbf\x55\x55\x5b\x13\x9c\xd5\x9a\xdc\xd5\x9a\xcc\xf5\xb6\x5f\xd2\xb2\x57\xba\x21\x37\x80\x1f\x8b\xbe\xfd\xee\xfa\x6f\xd7\xdf\x2d\xaa\xaa\x76\x34\x7c\xfd\x5f\xaa\x25\x1f\x64\xdb\xdd\x54\xa6\xd7\x7a\x51\x55\x46\xb6\x74\x53\x69\xbb\x5e\x2b\xb3\xf6\xd7\x87\x7f\x5c\x2f\xa5\xf9\x22\x55\xad\x6d\xdf\x5c\x2b\xbb\xf0\x1d\xd5\xb1\xe4\xb5\xb3\x7d\x77\x53\xbd\xf2\xd4\x1e\xed\x58\x45\x19\x68\x6d\x9d\x3a\xfe\xff\xea\xf8\xad\x2b\x39\x14\x5c\x55\x7b\x03\xfc\xba\xff\xe9\xf0\x13\xad\x7c\xf8\xf4\xf8\xa7\xbf\x2a\x1f\x86\xdf\x74\xba\x77\x52\x9f\x2a\x3a\xfc\xd0\x2b\xb3\xee\xb5\x74\x0f\x3f\x5e\x54\x95\xaf\x6d\x47\x37\xd5\x07\xdd\xfb\x40\x6e\x51\x55\x07\x63\x0c\xb5\xb8\x3a\x34\xf7\xf6\x7b\xa9\xbb\x8d\xfc\x7e\x8f\x52\x6f\xa8\x1d\xcc\x1c\xff\x67\x3b\x32\x3f\xfd\xfe\xcb\x1f\x7f\xfd\xe7\x93\x1f\x57\x55\xe7\x6c\x47\x2e\x3c\xb4\x67\xff\xf7\x51\x47\x3f\xfa\x69\x55\x85\x5d\xac\x85\x0f\xee\xd8\xb4\xfd\x67\x68\x32\xf2\xe0\xe3\xde\x3f\xfd\xd9\xa3\xda\xe5\x7f\xa8\x0e\x8f\x50\x8f\xbd\x53\x55\xe7\x2b\x1b\x3f\x52\x6b\x7b\x77\xb0\xce\x91\x75\xfe\xa3\xb3\xed\x4f\x5a\xff\x43\xb6\xe4\x3b\x59\x7f\xfd\xad\x63\xd1\x4b\x6b\x35\x49\xf3\xec\xb7\x07\x36\x3e\x7c\xfd\xb5\x6f\x7f\xd5\xca\xf8\xb7\xa1\x95\xec\x75\xf8\xa8\xed\xdd\xd7\xdf\x7b\xbd\x1d\xf1\xb3\x52\x3a\x90\x7b\xf1\x57\x55\xa5\x02\xb5\xaf\xfc\x2a\x85\xfb\xd0\xaa\x5a\x86\xd7\x7f\x8f\xa1\x1c\xed\xa3\x4c\x6f\x7b\x2f\xb4\x32\x24\x1c\xad\xe9\xbe\x3b\xff\x9d\xb3\x36\x7b\xfa\x59\xe9\xde\x6f\x84\x32\x81\xdc\xad\xd4\x18\x6c\x7c\x7a\x3d\xf8\xc7\xeb\x9f\x2d\x51\x27\x3a\xe9\x82\x92\x5a\x6c\x69\x87\x21\xbf\x4c\x91\x33\xc8\x2f\x53\x7d\x84\x3d\xe0\xaa\x02\x58\x6d\xaf\x83\x1a\x3a\x8d\x4c\x53\xba\xe3\x4e\xe0\x3e\x48\x17\x4a\xc3\x9b\x81\x6d\x1e\xc3\x43\x08\xc1\xe6\x02\x50\xc9\x23\xe6\xad\xd4\x3d\x15\x43\xf5\xd4\x49\x27\x83\x75\xe5\x10\x83\x23\xd9\x0a\xd5\x90\x09\x2a\xec\x8a\xda\x20\xa8\x96\x6c\x1f\x84\x96\x4b\xd2\xc5\x50\x7b\x4f\x62\xa5\x9c\x0f\x22\x3c\x64\xfd\x62\x1e\x1c\xc1\x27\x72\xe0\x57\x92\xdc\xd3\x4f\x43\x8d\x2d\x12\x9b\x1b\x12\x8d\x0d\xc2\x90\x0f\xf4\x2c\x35\x8f\xb1\xcf\x01\xb6\x34\x0f\x51\xdb\xf4\x5d\x09\xdb\xd4\xb6\xed\xa4\xa3\xc8\xf5\xc4\x93\x89\x74\xcb\x6e\xe7\xe9\x51\xe9\x9c\xdc\x9d\x7d\xb2\xb6\xbd\x09\x45\xfd\xb1\x95\xf7\x62\xef\xef\x05\x83\xe7\x21\x80\x5c\xb6\x2d\xef\x94\x69\x5e\x1a\x87\xe5\x35\x1a\x64\x6b\xa0\x3a\xfc\x7c\x5f\x53\xf7\x68\x82\x33\x8e\xb8\x5a\x9a\x75\x2f\xd7\x74\xc1\x96\x8e\x1c\x5b\xee\x02\xf9\x52\xc6\xde\x43\x16\xce\xf8\x2d\x79\x2f\xd7\x34\xc1\x88\x27\x67\xd0\x0a\x14\xe0\xa8\xb5\xb7\x24\x82\x5c\x8b\xce\xd1\x4a\xdd\x17\x43\xde\xfb\xef\x5b\xc6\x71\x32\x1b\x69\x6a\xfa\xf4\x63\x11\x9f\x90\x9d\x12\xc3\xf4\xfd\x82\x9d\x62\x49\xd2\x91\x13\xc1\x6e\xc9\x88\x95\xd2\xe5\x88\x57\x4b\x08\x0f\x35\x66\xfc\xb4\x31\xef\xc4\x99\x73\xfa\x51\x1e\x70\xfc\x78\xaa\x1d\x85\x4f\xb4\xfb\x4c\x2b\xec\x1b\xfc\x32\xc0\xb9\x52\x96\xbd\x1f\x7f\x86\x35\x97\xa9\x0b\xb1\x43\xf6\x48\x47\x92\xbc\xe1\xdc\xe9\x8f\xa3\x3f\x7b\xe5\xd2\x43\xc5\xe3\x9f\xab\x6a\x4b\xbb\x05\xf0\x20\x1a\x15\x32\x1f\x87\xe6\x57\x6c\xeb\x0f\xa8\xb3\x0f\xcc\x3e\xf0\x3f\xe0\x03\xf0\xa3\xb5\xac\x37\x71\x91\x6e\xe5\xc8\x6f\xca\x8d\xa5\x9e\xc0\x8a\x5b\xe9\xd4\xb0\x24\x5f\xba\x00\xaf\xbe\x50\x69\xcc\x10\x74\x41\x48\xad\xc8\x04\x51\x93\x3b\x3b\x81\x9f\xd3\xf1\x9c\x8e\xe7\x74\x3c\xa7\xe3\x39\x1d\x7f\xeb\xe9\x78\x9f\x2f\x00\x5a\xcc\xe9\x62\x4e\x17\x73\xba\x98\xd3\xc5\x9c\x2e\xbe\xe5\x74\x61\x1d\x89\xb8\xd8\xf9\x78\xe3\xcf\x65\x2e\x77\xc6\x97\xa6\x62\x78\x78\x51\xa8\x6c\x65\x84\x39\xee\xc2\x11\x9d\x0c\x9b\xcb\x6d\xbc\x32\xa2\xb3\xcd\x85\x57\x32\x6e\xac\x73\x86\x02\x79\xd1\xbb\xa4\x67\xc2\x15\xd8\xaf\x6c\x89\x46\x15\xdc\x9f\xe0\xf5\xc3\x0b\xf9\x7a\x23\xd5\xb3\xfd\x68\x63\x42\xc7\x2d\x39\xb5\xda\x09\xef\x75\x29\x4c\xc8\x99\xd7\x64\x55\x91\xd7\xd8\x4b\x59\x6f\xe3\xee\x1d\xad\x96\x4e\xba\x5d\x31\x93\x0f\x15\x14\x3f\x88\xe8\xc6\x4b\xe9\xcb\x79\xf1\x1e\x78\x22\x58\x6d\xed\xb6\xef\xa0\x77\xfa\x30\xb2\xa3\xda\xba\xc6\x17\xf2\x63\xd9\x34\xc3\x16\x58\xa9\x7f\x67\x8d\x07\xe0\xea\xc2\x14\xe4\x05\x0b\xbf\x55\x9d\x88\x95\x37\x6b\x11\x77\xd7\x8a\xbd\x59\xde\xd6\x69\x1c\x15\xf1\x19\xf9\x7c\x9f\x6a\x76\x6f\xa2\x25\x1e\xde\x79\xde\xd7\xba\x6f\x92\x9c\x67\xd5\xe0\xb2\xc7\x96\x9d\x0c\x81\x5c\x32\x5c\x8f\x2c\x67\xca\x41\xdf\xd5\xb1\x0d\xe0\xf3\x0c\xd7\xe3\x39\xe0\xb1\xa9\xc8\x2e\xca\x99\x41\x33\x83\x5e\x66\x10\x03\x1c\x85\x05\xa3\x1a\xc8\x46\x1e\x0f\x61\x06\x32\x39\xc1\x62\x1d\x0b\x1b\x67\x1a\xc6\x31\x9c\x5d\x13\x74\xbd\x75\xef\xd2\xeb\x20\xe3\x58\x35\x98\xa3\xe0\x37\x14\x05\xe7\x3c\x3a\xe7\xd1\x8b\xcb\xa3\x18\x25\x41\x32\xf2\x68\x08\x13\x90\x49\x09\x16\xe9\x58\xd8\x38\xd1\x30\x8a\xe1\xe4\x2a\xde\xf3\x10\x60\x27\x9d\xa7\xb3\xd9\x16\xed\x71\x6a\x55\x10\xca\xdc\x4a\xad\x9a\xc3\x24\x5e\x04\x2b\xc8\x39\xeb\x4a\xcd\xe6\xab\x6a\x23\xfd\x46\x0c\xaf\x60\xc4\x4a\x91\x4e\xf6\x13\xdc\xf9\xca\x44\x3b\xc5\x15\x9e\xd2\x7b\xa0\x23\x24\xf2\x2e\x04\x06\x1c\xfa\xec\x66\x51\xce\x51\x1b\xd2\xaa\x55\xe1\x3c\x0f\xd8\xd5\x7c\x82\x2c\x18\x2e\xcb\x2a\x81\x7c\x50\xad\x0c\x24\xea\xde\xb9\xf8\xca\x9b\x6e\xc9\x04\xbc\x18\x84\x74\xf1\x43\xf7\x9d\x23\xff\xf5\x99\xe5\x02\x4d\x58\x59\xd7\x9e\x3f\x33\x3b\x02\x76\x7f\x9a\x2d\x9e\x70\x28\x5e\xc0\x70\x20\x35\x1e\x93\x43\xb6\x19\xf0\x2d\x3e\x1c\xec\x13\xd3\x71\x53\xdb\x5a\xea\xe1\x98\x5f\xf9\xba\x3f\x1c\xd9\xb8\x59\x14\x1d\x07\xb2\x1a\x88\x26\x8a\xc3\xeb\xda\xb8\xf8\x4a\x6d\x17\x76\xf1\x18\x97\x32\xeb\xf2\x56\x19\xd6\x77\xf7\xc1\x7b\xaa\x70\x70\xc0\xf5\x85\xed\xce\x89\xa6\x63\xe3\x52\x8e\x65\x73\x62\x54\x86\x75\xb9\xf1\x2a\xbb\x08\x76\x68\xc9\x37\x1a\x2f\x0c\xe4\x97\x93\xe5\x5f\x23\x8b\x63\xfb\x5a\x76\x87\x45\xfb\x89\x37\x20\x46\x2e\x27\xf8\x85\x20\x5b\x0c\x46\x97\xf2\xc5\x9a\x89\x0b\x99\xbc\x15\xbb\x8e\xfc\xa4\x25\xf4\xe1\xd9\x3d\x2d\x65\x5d\x85\x31\xef\xe2\xa6\x54\xa6\x53\xb0\x6c\xc3\x71\x04\x3e\x30\x4a\x1b\x36\x32\x4a\x78\x1e\xf0\x24\xb5\x45\x89\xcd\x42\x05\xc9\xcc\xa1\x31\x4c\xe0\x61\xde\xf8\xda\xcd\x3f\xec\x61\x11\x6f\x48\xc4\x1a\xc6\x33\x6d\x9a\x39\xcd\x64\x97\x32\x66\x48\xc7\x8d\x4b\xdc\xa1\x1c\xb3\x29\x9c\x4c\x9d\x05\xcd\x9b\x76\xb2\x0b\xc9\x18\x1f\x72\x7b\x20\x63\xfa\xc9\x6e\x06\x77\xec\xc9\x6d\x03\x6b\x1a\xca\x9a\x10\xb1\x9b\xca\xcb\x9c\xd9\xc3\x65\xae\x85\x72\x87\xc9\xcc\xd6\x1f\xb0\xfd\x04\xfd\xc0\x9f\x9a\x8e\x9f\x9c\xf2\xed\x9c\x17\xd5\xb2\x6c\xcd\x8f\x70\x23\x8a\xc9\x9c\xa8\xe6\x1a\x30\x67\xb2\x9a\x5b\xd6\x88\x09\xeb\xa8\x22\x33\x27\xad\x99\x1d\x98\x35\x71\x1d\x53\x56\x16\x4f\x72\x0a\x42\x47\xc5\xa3\x4b\xe2\x4d\x62\x73\x0b\x7a\x93\xd6\xf0\x26\xb3\x99\xa5\xb0\x26\xb4\x79\xae\xc4\x9c\xd4\x72\x93\x33\xdb\x69\x98\x76\xe2\x39\x4a\x0e\x38\x4e\xa7\x0c\x74\xdc\x21\xb8\xe0\x93\xd5\x1a\x27\x3e\x13\x19\x26\x3b\x8f\xe6\x0c\x82\xa3\xd4\x3e\xdc\x82\x75\x7c\x5d\xcb\x79\xbf\x8c\xd4\xda\x51\xa7\xe3\x01\xa3\xe3\x2b\x72\x4f\x7f\xf6\x64\x5e\xba\xf7\x37\xbf\x04\x4f\xee\x96\x04\x7e\xad\x24\x07\x15\x19\x74\xa0\xa8\x50\xef\x75\xce\xb6\x14\x36\xd4\x9f\x25\x26\x3a\xfc\x1d\xa6\x73\x89\x67\x72\x4f\x51\x30\x5c\x02\xe6\x6d\x4b\xc1\xa9\x3a\x59\x38\x38\x55\xe0\x4d\x12\x96\x7d\xbd\xa5\x00\x3d\xca\x6a\x7c\xfc\xdb\x90\xaf\x27\x01\x9e\x2a\x5d\x60\xc4\x19\x43\x9f\xac\x6a\x31\xa8\xc4\x3d\x8e\x7b\x19\x49\x89\xb3\x13\x2b\x92\x0a\x78\x2c\x9a\x00\x78\x2c\xb6\x7f\x51\xd0\xfa\x58\x02\x82\x00\x0f\xfb\xaa\x5a\xdb\xa8\x95\x22\x57\x22\x30\xd6\x1b\xe9\x04\x99\xda\x36\xc0\x14\x0f\xee\xc1\xce\xc5\x4b\x7c\xa9\xf0\x0d\xd5\xf3\x91\xb9\x78\x64\xee\x34\x50\xf1\x05\x2d\x3b\x8c\x4e\x4a\x99\x96\x97\x6f\x26\x5e\xec\x9e\x2a\x33\x1c\x6c\xf6\xce\xb1\xef\x64\xbc\x62\x5b\x56\x0f\x0d\x7b\x0f\x6e\xdf\x6d\x54\xa0\xa8\x68\x52\x92\xde\x9c\xd0\x1a\x9c\x34\x3e\x2e\x1e\x96\x89\xae\xb2\x0f\x76\xb8\xad\xa0\x96\x3e\x94\x1a\x3e\xc7\x5b\x6e\xe5\x52\x93\x70\xfd\x72\x57\x0e\x74\x58\xcb\x9c\x8f\x38\x17\x3f\xe2\x3c\x4d\xbc\x36\x74\x57\xf8\xac\xf4\x11\x15\x5d\x7d\x29\xeb\x7d\x8d\xac\x43\x11\x8f\xab\xf1\x0b\x22\x01\x43\xd7\x83\xd4\xd4\x71\x39\xba\x14\x95\xe1\xe2\x71\x96\x21\xf4\x7a\xbf\xda\x5d\xbe\xfd\x0e\x49\xaf\x05\x5e\x84\xc1\xa5\x7b\xa9\x93\x60\xf3\xfd\x6b\xf3\xfd\x6b\xf3\xfd\x6b\xf3\xfd\x6b\xf3\xfd\x6b\xdf\xec\xfd\x6b\xd0\x63\x5e\xb6\xdd\x79\x2d\x06\x94\x88\xc3\x19\x3a\x80\x81\x30\x21\xca\xab\xe8\x75\xe4\x6a\x32\x01\x56\x51\x41\x30\x5d\x3c\x25\x56\xb2\xd9\x11\x30\xe9\xf3\x79\x93\x16\xbc\x55\x20\x7b\xc0\xc7\x7c\x68\x6c\x1f\x4a\x90\xcc\xf6\xa1\xeb\x03\xf4\x9e\x15\x34\x38\xd6\x80\xbe\xb5\xda\xae\x55\x5d\xa2\x0d\x75\x14\x8b\xad\x83\x75\xa2\xf8\x19\xce\x13\x74\xd9\x15\xd2\xc3\x15\x16\x22\xaa\x67\x4a\x65\xc8\xed\xf5\x11\x8b\xe3\xaf\x64\xad\x74\x94\xf4\x9b\x06\x7e\x63\x7d\x98\x08\xfa\x74\xd5\xe3\x34\xf8\xf1\x7e\xc6\x89\x90\x9d\xb2\x6e\x3a\x9b\xf7\x46\x95\xb6\xb9\xb6\x6b\x70\x9b\x08\x0c\xb9\x17\xdf\x15\x07\xa1\xe4\xdd\x54\xb8\xe5\x3d\xfe\x79\x01\xa5\x95\xb6\x9e\xc1\x1f\xa6\xb3\xa2\x91\x7e\x53\xba\x90\xe8\x9d\x53\x60\x4e\x66\xf4\xa9\x30\xcb\x57\x38\x38\x59\x2b\xb3\x16\x27\xf1\xf2\xd2\x44\x39\x96\x70\xca\x10\x93\x34\x80\xe3\xf6\xc8\x54\xe0\x88\x5b\x94\x7b\x47\xd0\xe1\x1d\xff\x54\x86\x7e\x48\x38\xc5\x91\x3b\xdb\x4c\x81\x29\x54\xf3\x96\x43\xb6\x28\x3b\x68\x22\x5b\xb4\x2a\x74\x15\x49\xd1\x94\x83\xb5\x61\xe3\x6c\x08\x65\xa6\x67\x83\xde\xa0\xd8\xef\xc1\x11\xc3\xe9\x13\xac\x25\xc8\x6c\xe1\x09\x76\x47\x4e\xd9\x46\xf8\xd2\xf0\x8d\xb3\x9d\xd0\x76\xed\xcb\x79\xff\xbe\xde\x25\x27\x6f\x7b\xc4\xb8\xb9\x2d\x88\x38\x91\x2b\x6f\x86\x3b\xe9\x4c\xf4\xa8\x86\xb4\xdc\x95\x83\xc7\xf8\xe8\x64\x4d\x1f\xac\x09\x74\x1f\x4a\x70\xf2\x90\xf3\x2e\xfb\x1d\x83\xef\xa4\x11\xaa\x29\x4a\x93\x47\x98\x17\xdc\xf2\x18\xbc\xa3\x3a\xac\x5c\xfb\xa2\xad\xdf\xe3\xaa\x66\x32\xd0\x8b\xb5\x29\xe0\x65\xc9\x47\xce\x97\xb4\xd6\x76\x29\xf5\x6f\xc3\x32\xc9\x67\x5a\xbd\xd2\xc8\xb3\xed\x4f\xb6\xfa\x7c\x0d\xec\xbb\x95\x7d\xc6\x74\xfb\x9d\x0d\x9f\xe3\x3a\xbc\x0c\xf4\x6f\xeb\xb6\xda\xca\xe6\x37\xf3\x4b\xdb\xf6\x21\xfe\xea\x63\xdc\x96\xfd\x61\x23\xcd\x4b\x6b\x83\xe7\x32\x0a\x99\x95\x75\x35\x35\x7b\x93\x7f\x54\x3a\xbc\x78\x18\xfa\xd5\x56\xa7\x62\x65\x6d\x4d\xfd\xfa\xa8\x03\x89\xb4\x71\x48\xae\x4c\x6f\x7b\x2f\xe2\xc9\x59\x91\xbe\xb9\x2f\xd9\x0b\xf1\x2f\xae\x1a\x8d\xe5\xa0\x61\x9f\xc8\x51\x36\x21\x11\x19\xb0\x14\xff\x04\xb1\xa5\x20\x53\x5b\xd8\xa1\x76\x43\x55\x4b\x60\x3c\x9c\xa0\x15\x51\x0c\xa1\x50\x87\x9c\x40\x7d\x90\x2e\x94\x82\x35\x69\x21\x73\xac\x83\x59\x7d\x9b\xa8\xd4\x11\x2b\xb9\xcc\x09\xa1\xf9\xb8\xab\x54\x06\xeb\xc6\x23\x0d\xca\xe4\x42\x35\x64\x42\x5c\x53\x2b\xd1\xd6\xb8\x71\xc6\xf6\x61\x3f\x9d\x1c\x8d\xd6\x7b\xda\x9f\xa2\x1f\xce\x97\xfa\x20\xdb\x6e\xbc\xa7\x45\xd0\xc2\x8e\x96\xcc\x83\x0d\x35\x76\x54\x5c\x6c\x48\x34\x36\x08\x43\x3e\x50\x33\xde\x06\x07\xb8\x52\x5c\x42\xda\xdf\x77\x63\xda\x5f\xdb\x76\xd8\x4c\x9d\x1a\x30\x9d\x4d\xd8\x70\x7b\x52\xc9\xfb\xf8\xa7\x8e\x52\xee\x45\xfc\xa6\x95\xf7\xf1\x88\x31\xc9\xb6\x40\xf0\x3a\x38\xf6\x65\xd9\xea\x4e\x99\xc6\xde\x8d\x6d\x1c\xc0\xb4\x40\x75\xf8\xf9\xbe\xa6\x41\xd4\xda\x8f\x21\x9d\x96\x66\xdd\xcb\x35\x5d\x90\x15\x23\x4f\x96\xbb\x40\x05\x58\x12\xa1\x0a\x65\xcb\x96\xbc\x4f\xbc\xa8\x66\x8e\x0a\xb8\x03\xb6\x04\xf0\x61\xff\x69\x5c\x67\x4b\x2f\x71\x42\x88\x7b\x1f\x9b\x3a\x6e\x92\xd9\x48\x53\xd3\xa7\x1f\x47\xf1\x38\xaa\xda\x0d\x0b\x32\x17\x44\xe4\x25\x49\x47\x4e\x04\xbb\x25\x23\x56\x4a\xd3\x48\x53\x46\x19\xf3\x24\x0e\x62\x2c\xd6\x26\x43\x14\x30\x67\x63\x15\x07\x9b\xb9\xa1\x0a\xec\xc7\xd3\x07\x79\x3f\x92\x0d\xce\xdd\x40\x85\x0d\x71\xf2\x36\x4e\xa1\x9b\xa6\x92\x9e\x9b\xf1\x68\xd9\x6d\x10\xf0\x26\xc1\x99\xc3\x33\x87\x0b\x71\x18\x7a\xac\x96\xf5\x26\xee\x3c\x59\x39\xf2\x9b\xf1\x63\x8f\x27\x70\xe2\x56\x3a\x25\x53\xa7\x1f\x38\xc0\x5e\x7d\xa1\x52\x58\x21\xe8\x02\x50\x7b\x59\xf3\x9a\xdc\xab\x13\xca\x39\xd5\xcd\xa9\x6e\x4e\x75\x73\xaa\x9b\x53\xdd\x7b\xa7\xba\x7d\xac\x4e\x74\xf5\x1c\xaa\xe7\x50\x3d\x87\xea\x39\x54\xcf\xa1\xfa\x3d\x43\xb5\x75\x24\xe2\x42\xd9\x2d\x39\x7f\x6e\xe1\xf8\xed\x97\xca\xe2\x0b\xaa\xe4\x29\x12\xa8\x3c\x65\x4e\x5b\x31\xe3\x79\xee\xcd\xe5\x34\x52\x99\x61\xdf\xe5\x65\x55\x6a\xdb\x2f\xc9\x19\x0a\xe4\x45\xef\xce\x7a\x11\x54\xe8\x7e\xf5\x44\x34\xaa\xc0\xbb\x5b\xaf\x1f\x5e\x62\xd6\x1b\xa9\x80\xf9\x6e\xca\xad\x6f\xc9\xa9\xd5\x4e\x78\xaf\xc7\x62\x25\x1d\x6e\x4d\x56\x8d\x7a\x1d\xb8\x94\xf5\x36\xee\x46\xd0\x6a\xe9\xa4\xdb\x8d\x36\xe7\x50\x21\xf1\xc3\x70\x6f\xe0\x52\x7a\x2a\x04\x58\x18\x4e\x5b\xbb\xed\xd3\xf7\x93\x40\x88\xc0\xbd\x24\x80\xaf\xe5\x1c\xed\x83\xaa\x07\xd1\x08\x77\x64\xbf\x55\x9d\x88\x95\x35\x6b\x31\xdc\xb0\x9c\xbe\x36\xa4\x0c\xd1\x1d\x8d\xe2\xb9\x34\xcd\xc8\x1e\x42\x4a\x61\x4a\x3f\x03\xa5\x5e\xde\x38\xeb\x70\xfb\xc6\x44\xf8\x53\x0c\x84\x38\xa2\xbc\xa0\xab\xe0\x0e\x73\x6c\x56\x6a\x57\xd6\xcc\x88\x6f\x89\x11\x20\x28\x02\x07\x44\x1b\x80\x55\x38\x9f\x20\x26\x31\xfa\x18\x66\x0f\x8c\x89\x31\x26\xcd\x15\x8c\x25\x05\xbb\xd2\xba\x37\xeb\xc5\x39\x47\xcd\x39\x6a\xce\x51\x73\x8e\x7a\x9b\x1c\x95\xa6\x16\x40\x2a\x9c\x4e\x10\x91\x18\x5d\x0c\x93\x07\xc6\xc4\x08\x93\xa6\x0a\x46\x92\x62\x3d\x99\x04\xda\xcb\x02\xde\x2c\xf2\x7b\x30\x57\xfa\x1f\x99\x65\xf2\x24\xff\xa1\xce\x64\x49\xfd\x43\x88\xc8\x35\x00\x10\x50\x52\xda\x1f\x75\x28\x58\xb7\x0e\xaa\x56\x96\xc6\x22\x8c\x9c\xa7\x46\x86\x91\x87\x77\xbd\x34\x5c\xe5\xa2\x27\xcc\xf9\x9a\x89\x30\x30\x4b\x9e\x0c\xb7\x28\x53\x1b\x11\xae\x2e\x2e\x6f\x86\xd7\xf5\x61\x5b\xf5\xcd\xa2\xc8\x18\x09\x6e\x0c\x12\x9c\x33\x95\xd6\xf0\xd6\xf3\x15\xd5\xe0\xf6\x1d\xf0\x7c\x21\xbb\xa2\x91\x6d\x4c\xcc\xe0\x5a\x8f\x1b\x3f\x98\x16\xe4\xc4\x92\x2c\xe8\x0c\x75\x42\xae\x71\xb8\x8a\x84\x5c\xfc\x4c\x15\xc2\xac\x62\x32\x94\x07\x99\x1d\x32\x0b\xa7\xcd\xc2\x69\xff\x77\xc2\x69\x0c\x52\xc3\x36\x40\x89\xcc\x03\x44\x68\xc0\x42\x44\x08\x8b\x03\x16\xad\x1d\x42\x4c\x18\x0d\x20\x23\x4a\x43\x88\x80\x80\x54\x3d\x30\xac\xc0\x87\x14\xf0\x50\x96\x61\xb3\x8c\x29\x13\x0b\x3d\x77\x08\xc4\x89\x17\x9c\xa1\x0f\xa3\xea\x68\x06\x64\x43\x72\x64\xe7\x19\xe0\xcc\x71\x14\xc7\xc2\x6c\x99\x79\x46\xb5\x39\x63\x33\x4e\x9d\xe1\x29\x15\x3c\xf8\x67\x35\x0b\xcf\x4c\x59\xc3\x47\x8e\x25\x72\x86\x8d\x8c\x96\x1e\x30\x7d\x41\x3b\xf3\xa6\x59\xe3\x26\x5a\x3c\x5b\xf2\x23\x0e\xdb\x9e\xbc\xe8\x93\x09\x9f\x31\xe9\xca\x31\x14\x77\xe2\x95\x53\x46\xe6\xe4\x2b\xbb\xa8\x8c\x09\x58\x46\x07\xb1\x27\x61\xb9\x65\xb0\xfb\x9f\x5b\x00\x32\x62\x1c\x55\x02\x3e\x21\xcb\x29\x60\xd2\xda\xe3\x13\xb3\x0c\x74\x78\x72\xc6\x77\x05\xc6\x04\x8d\x93\x08\x59\xa4\x67\xd8\x03\x27\x3a\x17\x14\xa3\x07\x13\x15\x23\x34\x07\xb4\x78\x2d\x31\xe2\x32\x10\x21\xb2\xe2\x34\x05\x09\x8a\x50\x93\x2d\xb9\x8e\xd5\x32\x47\x6a\x1d\x45\xc6\x24\xd6\x79\x68\xa9\x24\x8e\xa0\x25\x7b\x25\x2d\xa7\x8e\x0c\x0d\xd3\x6a\xd8\xfc\x5d\xd1\x20\x95\x21\xde\x01\xb2\xe9\xc0\x50\x19\x1f\x24\xc3\x52\xe9\x0c\x7f\xc5\x24\xd2\x19\x80\xa5\xc3\x33\x2a\x89\x9e\xb3\x41\x9e\x59\x15\x90\x16\x9c\xe3\x66\xef\x17\xfc\xd1\x1d\x26\x49\xb9\x73\x40\xea\x1c\x90\x39\x2f\x16\xe8\x93\x40\xa0\xac\x39\xe2\x96\xb0\x9c\x39\xd4\x2b\xb0\x8c\xf9\x7c\xec\xe4\xab\x63\x27\xa7\xe4\xee\x0b\x58\x2e\x2d\x4f\x5e\x34\xae\x4f\xb4\xf0\x59\x3a\x12\x1f\xec\xf2\x0e\x31\xe8\x64\xa0\xd1\xdb\xe1\x0e\x8d\x78\x2b\x5e\xe2\x12\xe3\x80\xd1\xd0\xd0\x06\xc8\x8a\x23\xe4\x84\xe5\xc4\xb1\x01\x28\x28\x23\x8e\x81\x41\xf2\xe1\x73\x9c\x9c\x38\x4e\x62\xb2\xe0\x58\x8f\xe2\x72\xe0\x65\x3c\xe5\x9c\x04\x38\xe4\x1d\x49\xe9\x6f\xc8\x88\x0c\xc9\x6f\x80\x86\x50\x91\x18\x53\x52\x14\x79\xdb\xda\x5c\x9e\x7d\x40\x09\x6f\xa8\xc4\x94\x74\xf7\x7c\x6f\xcd\x7c\x6f\xcd\x7c\x6f\xcd\x7c\x6f\xcd\x7c\x6f\xcd\xbb\xdd\x5b\x93\x7c\xe4\xbc\x64\x36\x42\x28\x44\x2a\x1b\xea\xe0\x72\xea\x2b\x98\x34\x36\x86\x85\x48\x62\x43\xcd\x4b\x4a\x61\xf3\x07\xe3\x58\x0b\x00\x16\x00\x8f\x9c\x97\xbd\x46\x88\x02\xca\x5d\x03\xc6\x4c\x57\x36\x25\x71\x8d\xd4\x97\x27\x6d\x0d\x71\x80\x21\x69\x0d\xe1\x65\x48\x59\xb3\x70\x71\x09\x6b\x16\x2c\x26\x5d\xcd\x82\x3c\x5d\x5b\x55\x16\x17\x92\xaa\xe6\x21\xc2\x12\xd5\x2c\x58\x4c\x9a\x1a\x82\xc4\x24\xa9\x21\xa8\x67\x92\xcb\xa5\xf1\xca\x79\xe6\x73\xe0\x52\x2a\x10\xcf\x60\x51\xc9\x69\x0e\x78\x4a\xee\x97\x8b\x55\xdc\xa8\xa5\xb1\xca\x55\x30\x4b\x4a\x9a\x85\x8c\x4b\x48\xb3\x60\x51\xf7\x4c\x0d\x79\x51\xc9\x68\x56\xe5\x50\xa9\x68\x16\xe8\x43\x80\x2f\x86\x98\x90\x86\xe6\x62\x09\x35\x16\x2e\x39\xac\xc1\x64\xa0\x91\xb1\x4d\x91\xf0\x9e\xae\x6f\x42\xf2\x19\xa9\x29\x4f\xea\x19\x1b\x11\xb3\x25\x9e\x39\xb0\x90\xb4\x33\xe6\x9d\x90\xa4\x33\xd0\x51\x5c\x29\x67\x4e\x73\x19\x12\xce\x08\x6c\x9a\x53\x80\x6c\x33\xc2\xab\x43\x3e\xb9\xac\xf5\x62\x50\x9e\x19\x2a\x11\x95\x65\x7e\xdb\x16\x32\x64\x98\xa1\x52\x51\xf9\x65\x36\xd8\xc5\xd8\x2c\xec\x3a\xba\xa9\xec\x7f\xd9\xbb\xb6\xe6\xb6\x6d\xec\xff\xae\x4f\xa1\x87\x3e\xd8\xcd\xc8\xff\x4e\xfb\x9f\x9d\xad\x5e\x3a\x8e\xed\xa4\xd9\xd8\x89\xc6\x52\xd2\x07\x37\xcb\x81\xc9\x23\x09\x2b\x92\xe0\x00\xa0\x6c\x67\xf2\xe1\x77\x40\xdd\xdc\x4d\x04\x9c\x03\xc0\x8e\x9b\x20\xf4\x43\x12\x83\x3f\x02\xe7\x7e\x0e\x6e\xfb\x34\xc2\xaa\x30\xfb\x39\xd2\x1d\x3c\xb2\xbd\x59\x79\xd8\x23\x74\x7f\x5a\x8a\x9b\x13\x51\x4f\xf9\xec\x64\x0e\xf9\xe2\x94\x2b\x33\x43\xfa\x05\xe7\x67\x33\x72\x3b\x90\xb7\x4b\x90\x92\x17\x40\xec\x43\x0b\xb5\xbe\xfe\x92\x33\xb0\x2b\x3f\x9b\x4e\x79\xcd\xf5\x1e\x59\x71\x1b\x8e\x5a\x14\x70\x6c\xc5\xc0\xe1\xac\x57\xce\x4c\x41\x4a\x28\x4e\x5b\x43\xe6\x71\x3e\x87\xa2\x2d\x79\x3d\x7b\x35\xab\xc5\xf6\xbf\xcf\x6e\x21\x6f\x5d\x93\x76\x28\x35\xc6\xf6\xeb\x7e\xef\x5c\x6b\x42\x7d\x90\xcd\x53\x31\x9d\xcf\xcf\xb6\x0b\x24\x90\x6f\x21\x87\xe9\xdf\x2d\x72\x5d\xdc\x29\xa9\xb6\xc7\xf4\xcd\x75\xbb\x6a\xb4\x8f\x75\x15\x27\x12\x19\xc8\xd4\x0e\xea\x9f\xcd\x5a\xc5\xa9\xd4\xd3\xea\xf5\x9b\xd6\x1b\x26\xa1\x5f\x71\x86\x30\xe1\x23\xef\xb4\xa7\xbb\xe5\x3d\x29\x4e\x52\x9c\xa4\x38\x48\xc5\x21\x7d\xe0\x06\xf8\x6c\xbe\x37\xc5\xf8\x42\x32\x6d\x6e\x66\xfb\xe5\x67\x44\x7b\x5c\x7a\x45\x63\xd3\xe0\x9e\xbf\x76\x36\x5d\x0d\xad\x17\x89\x58\x38\x1e\x6c\x06\x12\x23\xd6\xc1\x1b\x27\x13\xae\x8d\x61\x35\xe7\x32\x01\x89\xd1\x49\xb4\xea\xa6\x90\x27\x85\x3c\x29\xe4\x49\x21\x4f\x0a\x79\x52\xc8\xf3\x6d\x84\x3c\x58\x68\x1c\x21\x07\x9f\x7b\xdf\x5e\x70\x57\x11\x8d\x1a\x51\x7c\x27\x15\x9a\xdd\x40\x4d\x6c\xe3\x7e\x81\x06\xbf\xdd\xc6\xb8\x61\x21\xee\x15\xfa\x47\x42\x82\x23\x4f\xcb\xe3\xd3\x45\x4f\x7b\x1f\x64\xe6\x7c\xed\x7e\xe0\x47\x7d\xec\xbf\x27\x27\x82\xfb\x8a\x37\x5b\x7e\x66\x2c\xcc\x27\x78\xfa\x05\xa4\xa9\x8b\x45\x8d\x4e\xfb\xce\x91\x5b\x96\x43\x56\x2c\x46\xe2\xbb\x07\x69\xc8\xaf\x6c\x17\x1c\x24\xf3\x97\xcc\x5f\x32\x7f\xc9\xfc\x7d\xa7\xe6\x0f\x3d\x24\xa2\xf4\x7b\x8c\x9d\xca\x5b\x2d\x1a\xb3\x0c\xfc\xee\x35\xde\x68\x11\x7b\x45\xd1\xa1\xc1\xfd\xfe\xf4\x22\xb3\xec\x6f\x57\xad\xfe\x6b\xee\xf2\xcd\x97\xac\x51\xca\x41\x71\xcb\xe4\xcc\x8c\xea\xf3\x7d\x43\x12\xa2\x19\xa0\x76\xcb\x33\x0c\xf1\xb0\x36\xfe\xe1\x87\xe7\xc7\x7c\xc2\x0e\x22\xb5\x83\xfa\x87\xd5\x9b\x2f\x6b\xd1\xb0\xf7\x30\x21\x86\x47\x78\x41\xb2\xac\x7e\x23\xf7\x08\x29\xc2\xc2\x09\x0f\x9e\x12\xc9\x40\x6a\xee\x95\x3d\x25\x13\x95\x4c\x54\x32\x51\xc9\x44\x3d\xb2\x89\x42\x0d\x81\x20\xc5\xc4\x71\x52\xf8\x46\xcc\x68\x08\x3d\xc1\xea\x00\x25\x8b\x41\xb3\x03\x43\x02\x04\x98\x99\xf9\xa9\x35\xdf\x24\x16\xc3\x5e\x98\x45\x4f\xb3\x3f\x69\xf6\x27\xcd\xfe\xa4\xd9\x9f\x34\xfb\x93\x66\x7f\xd2\xec\x4f\x9a\xfd\x49\xb3\x3f\x69\xf6\x27\xcd\xfe\xa4\xd9\x9f\x34\xfb\x93\x66\x7f\xd2\xec\x4f\x9a\xfd\x49\xb3\x3f\x69\xf6\x27\xcd\xfe\xa4\xd9\x9f\x34\xfb\x93\x66\x7f\xd2\xec\x4f\x9a\xfd\x49\xb3\x3f\x5f\x71\xf6\xc7\xd1\x60\x77\xc2\xdf\x1e\x36\x52\x84\xdb\x49\x58\x47\x67\xae\xdb\xe9\x14\xe4\x58\x0b\xb9\xf7\x88\x62\xb7\xd7\x51\xab\xd7\x8f\xae\x59\xbe\x28\xc5\xec\xa8\x82\xca\x75\x3e\x1a\x42\x20\x36\xa8\xb9\x39\xda\x47\xb5\x55\x14\xb0\x86\xe9\x79\x14\x20\x75\x57\xe7\x01\x40\x14\xb6\xbc\x17\x65\x5b\x79\x33\xa7\xbb\x10\xf8\x94\x5b\xdc\xb2\x1b\xc3\x3c\x15\x14\xdc\xc6\x04\xd4\xb0\xd7\x34\xe4\x1f\xe1\xdc\x75\x7e\x5e\xbf\xcf\xea\xbb\xb7\x8e\xc3\xe6\x07\x84\xaa\xc0\x00\xdb\xbd\xed\x65\x19\xc3\xfe\xbf\x0f\xfe\x7c\xf6\x69\x70\xf8\xdb\xc1\xc1\xd5\x4f\x83\x5f\x3f\x3c\x3b\xf8\xf3\xa8\xfb\xcb\x8f\x87\xbf\x1d\x7e\xda\xfc\xe3\xd9\xe1\xe1\xc1\xc1\xd5\xeb\x8b\x97\x93\xd1\xd9\x07\x7e\xf8\xe9\xaa\x6e\xab\xc5\xea\x5f\x9f\x0e\xae\xe0\xec\x03\x12\xe4\xf0\xf0\xb7\x1f\xac\xdd\xba\x1d\x2c\xda\x6b\x90\x35\x68\x50\x03\x5e\xeb\x81\x90\x83\xf5\x4d\xcf\x7d\x2d\xdb\xfd\xf7\xc3\x39\xa4\xcd\xfc\x98\x63\x37\x33\xbb\x76\xe0\xe4\xc4\x8e\x41\x90\x12\xf7\x75\x7d\x28\x20\xb7\x3f\x19\x18\x7e\xcf\x43\x89\x37\x4a\xb4\xf3\xa2\x5d\xb3\xcc\x43\xc9\xb6\x3a\x40\xd1\xd6\x02\x8b\x64\x9e\xbc\x64\xbc\x7a\xe3\x38\x22\x97\x40\xc4\x0d\x29\x59\xf1\xb6\x2e\x11\xd1\x12\xee\x40\x4e\x1c\x7b\xcc\x33\xd8\x8d\xc8\xda\x0e\xc1\x2b\xf3\xa3\x1a\xc8\x63\x91\x9a\xe5\x39\x28\x75\x21\x0a\x77\x53\x42\xe0\x4b\xe0\x0a\x2e\xe2\x5b\x5f\xf4\xca\x34\x1b\x23\x24\x8d\x46\x02\xf3\xb0\x86\xbf\x34\xe7\xab\x62\xda\x12\x87\xd7\xef\x2f\x78\xed\x10\x10\x4f\x60\xd7\x29\xd2\x9e\xc0\x38\xa1\x5e\xe7\xbe\xbc\x2e\x10\xcd\x9c\xd7\xab\x12\xc4\x7f\x7d\x13\x74\x27\x07\x2a\xb6\x20\x74\x41\x33\xaa\x65\x58\x0a\x8c\x88\xac\x3e\x8f\x9b\x70\x33\x2f\xff\xfb\x16\x5a\x9c\x9e\x70\xdc\x15\x21\x02\xf3\x94\xb4\x8d\x42\x80\x4a\x62\x91\xc4\xe2\x9e\x58\x10\x1a\x2b\x74\xe1\x9b\x66\xac\xfc\x8a\xde\x84\xf2\x15\xb5\x43\x5e\xe5\x6e\x0f\x71\xf4\x2b\x75\x7b\x7d\x88\x5e\xe6\x26\xd1\x37\xa0\x67\x94\xc8\x89\xee\xda\xe9\xc5\x6d\x72\x69\x9b\xa4\x6e\xf4\xf1\x92\x8b\xda\x21\x86\x9b\xcc\x41\xd2\xe0\x09\x8d\xd7\xd5\xb1\x93\x92\x29\x15\x3d\x85\x5a\x76\x75\x30\x93\x2d\x3c\x00\x6c\xe4\xde\xa2\x68\xe6\x6c\xe4\x68\x90\x0b\x29\xc6\x9a\xe5\x8b\x31\xff\xb8\xa7\xf3\xee\x45\x36\x2e\x2f\xbe\xba\x5f\x77\x75\x40\xfa\xb8\xbb\x05\x72\xd8\xf3\x20\x4d\xb1\x3a\x9b\xfd\xf5\xd6\x4f\xbe\xe0\xa5\xde\x77\x73\x88\x2b\x05\x5e\xdd\x84\xfd\xae\x51\x5a\x02\xab\x7c\x31\x96\xef\x99\xdc\xa3\x62\x56\x2b\x8a\xf1\x4a\x51\xae\xd8\x89\x73\x33\x1b\xea\x3e\x50\xac\xa7\xcd\x3b\x39\xb8\x60\x0d\xee\x2a\x50\x2c\x2c\xc1\x71\xa3\xc6\x8c\x65\x83\x17\x28\xe5\xca\x4f\x97\x14\xfa\xb8\x47\x8c\x5b\x74\x9a\x96\xcd\x33\x35\xe7\x58\x46\xe6\x24\x6b\xf8\x7b\x90\xca\xb9\x16\xcd\x83\xf6\x5d\x77\xed\xa5\x56\x2f\x64\x3c\xed\xb7\x3d\x88\xc5\x81\x4d\x35\xe1\xc5\x03\x70\x62\x7b\xe7\x17\xce\xbd\x91\x48\xd6\xef\x17\x7c\xc9\x15\x2e\x0a\x46\xa7\x96\xf4\xb4\x92\x9c\x52\x3e\xe9\x74\x32\x38\x95\xdc\x88\x53\x64\x6e\xe3\x15\x64\xd3\x81\x58\xfa\x41\xb9\x7a\x3a\xf9\x9b\xa7\xed\x6f\x10\xcd\x5c\xbd\xb2\x96\x73\x9d\xf8\xf6\x24\x0e\x6e\xb5\x64\xef\xd7\x69\x46\x5b\xeb\x87\x8a\x0e\x0b\x50\x9a\xd7\xcc\xb5\x5a\x7b\x67\xa8\xfe\xef\xe8\xd9\x0f\xbd\x40\x71\xc2\xcc\x40\xe1\xc4\xc7\x6d\x60\x62\xf6\xdc\x2d\x10\xf7\xc8\xb9\xb7\x8d\xd5\x26\x05\x8a\xcd\xb4\x4b\x65\x8e\x6f\xf6\x70\xdc\x2d\x11\x17\xa6\x58\xb0\xef\x97\x28\x22\xb1\x3c\x17\x6d\xad\xad\x37\x3f\x62\x98\xcb\x2a\x1e\x8e\xf1\x31\xec\x7d\xc8\x7f\xce\x78\xad\x34\xab\x73\x08\xee\xcc\x5f\xc0\xec\xd3\xe2\x18\x38\x33\xd3\x6f\x37\xf2\x18\x14\x5e\x15\x2a\x5b\xba\x42\x64\x04\xdb\x1b\xc9\x97\xe6\x56\x78\xde\x84\x75\x68\xd9\xe4\x81\x94\x76\xa8\xd0\x4a\x45\x76\xd9\xbf\xaf\xa6\x1c\xbb\x16\xcf\x21\x09\xf7\xbc\x5b\x60\x95\xed\x2f\x9c\x20\x71\x4e\xdf\x8c\xb3\x4b\xd0\x92\x43\x58\x7f\x0c\xce\x1f\x8c\xeb\x6c\xc2\x2b\x08\x43\x6a\xab\xea\x2e\xbb\x00\xcd\x82\x60\x5e\xff\x73\x3c\x38\x17\xb3\x19\xaf\x67\x47\x67\xab\x8b\xbd\xa3\xe1\x8d\x98\xb4\x5c\x1a\x8b\x83\x03\x68\xb2\x73\x31\x0b\x03\x69\xaf\x21\x3b\x39\xce\x5e\xf0\x12\xa2\x00\xd9\x13\x53\x2c\xd0\x84\xcd\xb2\x91\xe3\xca\x62\x34\x96\x58\x40\x1d\x69\x80\xef\x2e\xcf\xc3\x41\x2a\xd0\xcc\xdc\x6c\x5d\x0a\x56\x64\x39\xcb\xe7\x90\x15\x5c\x06\xe3\x96\xa0\xb3\x91\x90\x61\x2b\x4e\x5d\xe5\x7a\x04\x44\xb8\x27\xbf\x00\x39\x83\x60\xd1\xde\xa2\x64\xd6\x15\xda\x24\xa4\x89\xe4\x55\x04\xa8\x08\xda\x7f\x09\x33\xb8\x8d\x01\xf4\x4e\x41\xf6\x2f\xd1\x4a\x6b\x76\x85\xc4\x59\x8b\x61\x10\x8e\x2e\xd5\x51\x01\xd7\x6d\x18\xf3\x0d\xca\x12\x24\x9f\x86\x70\xde\xe5\xd0\xcb\x56\xcd\x1f\x6a\xce\x61\x2a\xe4\x0d\x93\xc5\xdb\xc6\xe2\xe8\xdd\xb1\xc2\xe5\x2a\x71\xc8\x58\xbe\xc8\x24\xa8\x46\xd4\x0a\x42\x42\x1c\x83\xa8\xe5\x5d\xe6\x58\xba\x8c\x60\xd0\x18\xea\x22\x13\xb6\xd1\x61\x7b\x34\x61\x61\xa2\x62\xc2\x8d\x8c\xa9\xec\xd5\x8a\x17\x0f\x18\x01\xce\xcc\x8d\xc6\x0f\x25\x30\xbc\x0a\xd8\xbe\xd0\xbd\x3c\x6a\xcb\x72\x35\xbd\x65\xe1\x88\x35\xf1\xc7\x7d\x0c\x5f\x27\x42\x70\x0f\x41\x76\x4c\xfe\x6a\x9e\xa6\x2d\xcb\x91\x28\x79\x1e\xe6\x2d\x24\x34\x42\x71\x2d\x64\x18\x8c\x0e\x12\x6b\x07\x49\x78\xdd\xb4\x7a\xc2\x78\xe9\x2b\x30\xeb\xe4\xe1\x64\xde\xd6\x8b\xf0\x14\x62\x8d\x76\xc1\x6e\x23\xa4\x23\xcf\x03\x5f\xcf\xc6\x61\xfb\x5a\xfa\xfd\x53\x91\x2f\xcc\x70\xac\xd3\xf4\x34\x9c\xec\xc5\x7e\x67\x83\x44\x5b\xa7\x30\xe1\x11\xfa\xea\xcc\x85\xec\x6d\x59\x04\x86\x1e\xe1\x71\x59\x95\x3d\x6f\xa7\x11\xbc\xd1\x45\x5b\x6a\x5e\xf2\x1a\xe2\xa0\x44\xe0\x56\x84\xc8\x6e\x05\x91\xbd\xe0\x52\xe9\xe0\xb1\xad\xc1\xde\x04\x78\x06\xc4\x57\x30\x66\x3a\x58\x7e\x0d\x40\x70\x4e\x70\x09\x53\x09\x6a\xde\x45\x0d\x72\x19\x18\x3b\x5f\x9a\x82\x0e\x74\xd5\x8f\x20\x9c\xf1\x82\x9b\x92\x40\x3d\xcb\xce\x79\x0d\x2a\x08\x2b\x38\xac\x62\xb3\xac\x4b\x52\xa2\xec\x35\xc4\x94\x2d\xfd\xdd\x61\x69\xc9\x7a\x29\x8b\xd2\x82\xfb\xc1\x97\x50\x83\x52\xa7\x30\x65\x6d\xa9\x4f\xcc\xb6\xcf\x61\xcf\x27\x16\xdd\x20\x8d\xa4\xb8\x06\x5f\x27\x0f\xb7\xb6\x7d\x2e\xee\xf7\xcd\x93\x8b\xaa\x62\xae\xed\x17\x4e\xd3\x81\x22\x2e\xde\x84\x38\x19\x61\x7e\xa6\x8c\x97\xad\x84\xc9\xdc\xe8\xb9\x28\x2d\x43\x70\x07\xef\x98\x00\xde\x3c\x73\xad\x9b\x97\xa0\x43\x89\x6e\x4a\xf4\xb6\xdf\xa3\x89\x69\xfa\xf3\x3b\xb0\x02\xf6\xad\x12\x23\x71\x10\xd7\xf9\x07\x9c\x46\x76\xae\x26\xf3\x40\x75\xcd\xcd\xa1\x26\x6d\x37\xcf\x60\x75\xaa\x87\xa3\x15\x42\x78\xf1\x9a\x10\x71\xcb\x65\x63\xad\x3e\x22\xd7\xc0\x50\x56\xbf\x10\xd6\xbd\x04\x2c\x2a\x51\xf9\x1c\x2a\x88\x40\x21\xb7\xa8\x98\xc3\xd8\xa4\xee\x05\x30\xde\x1c\xe3\xc6\x59\x79\x0a\x25\xbb\x1b\x43\x2e\xea\x42\x3d\x86\xe1\x6a\x40\x72\x51\x3c\xe2\x07\x55\xdb\x6d\x9c\x7c\x54\xeb\xac\xf3\x66\x6c\x52\xb2\xa7\x63\x9f\xbf\x49\x8d\x7b\x0c\x35\xd1\x20\xab\xf5\x7a\x89\x97\xa6\x2a\x37\x22\xcb\xef\x3f\xfe\x3f\x4c\x96\x78\x05\xa2\xd5\x8f\xa6\x31\x0e\x9a\x94\x62\x76\x0e\x4b\xd8\x93\xc6\x38\x78\x5e\x99\xd9\xdf\x7c\xcf\x20\xdc\x1a\xc1\x63\xa4\x50\x76\x27\x86\x01\xb0\xaa\x52\x2c\x36\x74\x11\x5c\x05\x7a\x0e\xad\x22\x4c\xe4\xdb\x8a\xce\x66\x79\xa0\x5c\xf2\x1c\x2e\x44\xcd\xad\x3b\xb2\xe8\x58\xab\x3d\x0f\xa1\xe6\x6e\x97\x42\x61\x76\x03\x51\x12\x2e\x02\x7f\x51\x6a\xb0\x79\xe6\xa2\x16\x12\xd3\x59\x0c\x49\x77\x2a\x72\x09\x5d\xa6\xc9\xeb\xd9\x63\x87\xd3\x2c\x77\x9f\x8b\x4a\x24\xa5\xf9\xa9\x44\xd1\x96\x2d\xe2\xfb\x58\xd3\x49\xd3\xa5\xdd\x1f\x69\xaf\x34\x78\x0e\x4f\x42\x53\xb2\x1c\x2a\xa8\x75\x74\x6c\x05\x0d\x43\xef\xa0\xa4\x21\x77\xeb\xfc\x30\xd2\x4b\x10\x36\x8f\x7e\x60\xf3\x8f\x75\x5b\x26\x67\xa0\xbb\x5e\x0f\x7b\x51\x7b\x82\xd4\x79\x6c\x77\x65\x52\xe2\xa4\xc4\x49\x89\xff\xce\x4a\x8c\x00\x5b\xc7\xe7\xc3\x9e\x77\xdf\x1d\x1f\xa9\xcc\x62\xe5\xfd\x73\x19\x0e\xf8\x1a\xf4\x8d\x90\x7b\x8a\xc3\x6e\x9b\x92\x8b\xba\x86\x5c\x4f\x5c\x83\x8c\x17\xf6\x2e\x00\x1a\x66\xaa\xd2\xc3\x5e\x48\x2c\xb5\x85\x79\x55\x94\xf0\x35\xba\x7f\xc1\x6e\x2f\x21\xbf\xcb\x4b\x78\xf8\xcf\x3a\x24\xa8\x16\x85\xe3\x48\x67\x4a\x28\x1d\x2a\xcf\xa2\xd5\x4d\xfb\x60\xdb\x45\x6c\xbf\xc3\x21\x98\xe7\xf8\x8f\x71\x76\xdc\xda\x12\x45\x24\x2d\x36\x8f\x01\xbc\x84\x19\xc2\x2b\xd3\x20\x45\x09\xd9\xf1\xe5\x9b\x68\xa0\xeb\x05\x16\xf6\xc5\x15\x44\xcc\x93\x52\xb4\x05\x8a\x9c\x58\xf6\x6c\xcd\xa2\x6b\xab\xb6\x0f\x30\x75\x1f\x9d\xef\x37\x08\xfb\xea\xc8\x24\xf7\x99\x20\x09\xfa\x08\x65\xdf\x1d\xc5\x8c\xd3\x4b\x7e\xf4\xfd\x78\x48\xbb\x15\xd4\xfc\x21\x26\x94\x90\xc7\x15\xf8\xca\x67\xd2\x81\xa4\x03\x11\x75\x00\x4d\xd2\x95\xbb\x78\x75\x3a\x8c\x05\xf8\x12\x6a\x73\xda\x11\xc4\xc4\xfc\x7d\x32\x19\x65\x23\xa6\xd4\x8d\x93\x0d\xc9\xa9\x25\xa7\x96\x9c\x5a\x72\x6a\xc9\xa9\x7d\xc7\x4e\xad\xf3\x17\xef\xac\x0b\x75\xa9\x88\xce\xa5\x01\x04\xb0\x57\xf5\x6a\xc9\xb7\xd9\x4e\x69\x5d\xf0\x4a\xc6\x2d\xe0\x36\x1a\xda\xb9\x98\x29\xcd\xd4\x3c\x3b\x65\x1a\x5e\xac\x4a\x36\xd1\xb1\x1f\x0a\xd7\xb5\x4b\x95\x88\x6b\x5f\xdc\x4c\x05\xe3\x0d\xd8\x17\x7e\x53\x01\x9d\xcb\x4d\x08\x60\x97\xab\xba\x7c\x76\x2a\xb4\x8a\x06\x3a\x6e\x9b\xee\x34\xd6\x6c\x72\xd7\x40\x86\x39\xf2\x09\x0d\x1d\x5b\x89\x4c\xf5\x32\x2e\xa0\x59\xc8\x92\x9d\x49\x29\x64\x34\x4c\x5d\xaa\x98\x58\x47\x39\xcb\xa6\xd6\x9d\xd8\x3e\x98\x52\xc7\x07\x5d\xc0\x5d\x7c\x50\xd7\x8e\x54\x12\x24\xca\x9f\xad\xb7\x91\x4e\xc4\xf1\x6c\x26\x61\xe6\x9a\xaa\xc2\xb9\x78\xb3\x2a\x78\xd8\x0b\x8f\xac\x22\xdb\xe4\xa8\xae\x33\xaa\xa9\x7b\x77\xf9\x2a\x1a\x56\x2e\xaa\xce\xc2\x45\x03\x9c\x63\xd6\x77\xfb\x2d\xcb\x21\xf4\x02\x29\xcf\x1b\xf1\x4b\x49\x7a\x4a\xd2\x53\x92\x9e\x92\xf4\x94\xa4\xa7\x24\xdd\x95\xa4\x77\xfe\x22\x6a\x92\xfe\x1f\x25\xea\xac\x30\xb5\xe7\xf5\x54\x7f\x7c\x60\x84\x10\xa7\x20\xfe\x3b\x09\xe2\x17\x6c\xba\xb0\x1c\x65\x86\xb7\x92\xcf\xa5\x58\x20\x42\x3d\x34\x39\x22\x07\xf0\x17\xa0\x14\x9b\xc5\x4d\x8a\xef\x61\x66\xdd\xf1\xd2\xd1\x90\x4d\xfe\xae\x34\xab\x9a\x2c\x32\x19\x76\xc0\x51\xab\x03\xa2\xe1\x79\x7c\xc4\x78\xc2\x24\x0b\xa7\x9c\x3f\xa5\x3c\x04\xe3\x34\x07\x1b\x8d\xeb\x05\x7e\xae\x14\x0b\x1e\xc3\x00\xb0\x56\x8b\x6c\xb7\x2d\x2b\xb3\x6d\x7c\xf7\x20\x9e\x7b\x5f\x1b\x05\xcc\xb8\xed\x26\xa5\x79\x29\xcd\x4b\x69\x5e\x4a\xf3\x52\x9a\x97\xd2\x3c\x44\x9a\xd7\xc6\x4c\xf3\x3a\xef\x98\x2d\xe0\x4e\xc5\x85\x8c\x08\xc7\xeb\xe8\x39\xa8\x7b\x4f\x39\x01\x4c\x42\x25\x96\x10\x97\x86\x1a\x6a\xe6\x38\xe7\x3d\x25\xc8\xdf\x4a\x82\xdc\xef\xb7\x92\x47\xc2\x42\x59\x9d\xca\x7e\x7c\x31\xf2\x63\x1d\x8a\xe3\xf8\x2b\x24\x94\xcb\x07\xed\x2e\x9a\xb8\x62\x83\x8f\x3f\x0d\x7e\xfd\x70\x70\x35\x58\xfd\x2d\xfb\xf0\xe3\xe6\xff\xec\x57\xef\xa0\x3a\xe2\x3c\xad\xd5\x27\x39\x43\x7d\x19\xc9\x3a\xf5\xcb\xb0\x17\x1e\x63\xb0\x1b\x65\xec\x15\xc2\xbe\xa4\xb4\x23\xa5\x1d\x29\xed\x48\x69\x47\x4a\x3b\xbe\xd5\xb4\x03\xd1\xd4\xb8\x0b\x05\x39\x66\xc2\x26\xf9\x8b\xe4\x2f\x92\xbf\x48\xfe\x22\xf9\x8b\xef\xd8\x5f\x5c\xb7\xf6\x13\x04\x89\x1c\xda\x2c\x07\x8c\xb9\x05\x1d\xea\xa2\x11\xbc\xd6\xd1\x00\x65\xdc\x2d\xf2\xd2\x6c\x8f\x67\x32\x1e\xa0\xfa\xc5\xb8\xef\xd8\x85\xb4\xf5\xd9\xd5\x59\x5e\xb2\x88\xcb\x35\x0d\xaa\xe3\xc2\x28\x22\xa2\x16\x9a\x95\x5d\x6d\x29\x53\x31\x0f\x08\x68\x9b\xee\x86\x2b\xe7\x49\x2a\x54\x58\x05\x59\xd3\xea\x6c\xa5\x6b\x91\x60\x31\x46\x67\xb0\xd6\xdd\x5e\x90\x15\xf8\x9a\x97\xbb\x36\x96\x4b\x05\x1c\x84\x6a\x44\x31\x92\x5c\x48\xae\xef\x4e\x8c\x3c\xef\xdf\x5a\xe1\x04\x52\xdc\x38\x92\xac\xb8\x1e\xf6\xfc\x9c\x2c\x54\x8d\xbe\x3b\xb5\x69\x00\xce\x51\x57\x50\xf0\xd6\x11\x5c\x38\x46\xb3\x79\x8c\xe2\x38\xae\xa1\xf8\xba\xa7\xb1\x3e\xd1\x1b\xbf\xbd\x0f\x89\x45\x68\x9a\x59\x8d\x90\xd9\xcf\x05\xc5\xc9\x89\x1d\x83\x20\x25\xf6\x5b\x0c\xd0\x40\x6e\x63\x35\xe8\x0e\xf5\x0e\x25\xde\x28\xd1\xce\x8b\x76\xcd\x32\xf8\xda\x04\xcc\x05\xf6\x38\x24\xf3\xe4\x25\xe3\x15\x66\x33\x1c\x92\x88\xf8\xdb\xb4\xa9\x69\x80\x9b\x3d\xe6\x19\xec\x46\x64\x6d\x87\xe0\x95\xf9\x51\x0d\xe4\xb1\x48\xcd\xba\x63\xc9\xcd\x95\x4d\xce\xa6\x84\x43\xfb\x08\x5c\x71\xc5\x00\xf7\xff\x14\x4c\xb3\x31\x42\xd2\x68\x24\x30\x0f\x6b\xf8\x4b\x29\xda\x06\xd3\x96\x38\xbc\x7e\x7f\xc1\x5d\x17\x8a\x78\x02\xe3\xf3\x68\x12\x30\x4e\xa8\xd7\xb9\x2c\xaf\x0b\x44\x33\xc4\x5d\x12\x68\xf1\x37\x3f\x12\x56\x16\x47\xc5\x16\x84\xd2\x04\x45\xa8\x96\xbe\x4b\x3b\xd1\x91\xd5\xe7\x71\x13\x26\xc6\xfa\xfc\x2d\xb4\x38\x3d\xe1\xb8\x2b\x42\x04\xe6\x29\x69\x1b\x85\x00\x95\xc4\x22\x89\xc5\x3d\xb1\x20\x34\x56\xd6\x53\x2f\xfd\x8d\x55\xb7\x6a\xe3\xec\x76\x53\x50\x43\x4a\x19\xda\x8b\x3f\xe5\x12\xb2\xe9\x15\xf6\x20\xe3\xa0\x0f\x75\x45\x7d\xc2\xe0\x89\xf4\x0d\xe8\x19\x25\x72\xa2\xbb\x76\x7a\xb1\x7a\xb0\x65\x0a\xf2\x05\x92\xba\xd1\xc7\xdb\x69\x07\xfe\x44\xea\x30\xc3\x4d\xe6\x20\x69\xf0\x84\xc6\xeb\x2a\xae\xa3\xe8\xe5\xd9\xf5\xa5\x28\xdb\x0a\xec\x17\xc5\x7a\xc3\x46\xee\x2d\x8a\x66\xce\x46\x8e\x06\x9b\xea\x60\x2a\x0e\xa6\xe2\x60\x2a\x0e\xa6\xe2\x60\x2a\x0e\xa6\xe2\x60\x2a\x0e\xa6\xe2\x60\x2a\x0e\xa6\xe2\x60\x2a\x0e\xa6\xe2\xe0\xd3\x28\x0e\xa6\xe2\x60\x2a\x0e\xa6\xe2\x60\x2a\x0e\xa6\xe2\x60\x2a\x0e\xa6\xe2\xe0\xa3\x15\x07\xcd\x82\x13\x5e\x83\x52\x23\x29\xae\xf7\xf4\xde\x6d\x42\xe1\xd6\x96\xd0\xe1\x4c\x70\x2e\xaa\x8a\xb9\xf2\x0c\x94\x69\x22\x52\xd8\xae\x8e\x4e\x02\xf7\xfb\x53\xc6\xcb\x56\xc2\xa3\xde\x9b\x6f\x4e\x66\x78\xf9\x84\x6e\xcd\x37\xfd\xf9\x1d\x73\xea\x2d\x8a\x83\xb8\xce\xd3\x16\x98\xa0\xa5\x62\xeb\x34\x23\xa3\x62\x5d\x17\x6a\x25\xca\x60\xe5\xd7\x1d\xad\x10\xc2\x8b\xd7\x84\x88\xb5\x45\xf7\x79\x18\x5f\xaf\x70\x1f\x10\x88\xab\x7c\x0e\x15\x44\xa0\x90\x5b\x54\x06\xdd\xe5\xfa\xbd\x00\xc6\xf3\x9a\x6b\xce\xca\x53\x28\xd9\xdd\x18\x72\x51\x17\xea\x31\x0c\x57\x03\x92\x8b\xe2\x11\x3f\xa8\xda\x6e\xf9\xe0\xa3\x5a\x67\x9d\x37\x63\x91\x2f\x9e\x90\x7d\xfe\x26\x35\xee\x31\xd4\x44\x83\xac\x78\xcd\x4c\x58\xfd\xd2\x5c\x06\x31\x22\xcb\xef\x3f\xfe\x3f\x4c\x96\x56\x7b\x7d\x1e\x4d\x63\x1c\x34\x71\xac\x6a\x74\x8b\xb5\xab\x40\x49\x4d\x62\x9c\x72\x8b\x97\x5a\xb4\xcc\x3e\xc9\x6a\x92\xb7\x1a\x21\xb4\xc0\xbd\xba\x30\xb1\xed\xc9\xb1\xcd\xd1\x40\x41\xde\x9a\xbd\x67\xbe\x8a\xdc\x74\x6e\xbc\x83\x38\x11\xb5\x86\x5b\x8b\x83\x71\xa3\x99\x67\xaa\x10\x73\x58\x38\xcb\xba\x1b\xbf\x5b\x7e\xd6\x9f\x3d\x99\xb3\x7a\x06\x23\x51\xf2\xdc\x51\xfe\x43\x8a\x9b\x6c\xeb\xe3\xaf\x33\xa2\xee\xcb\x6f\x44\x7d\x29\x84\xc3\xeb\x63\xb7\x91\x74\x88\xee\x03\xdf\xe3\x0f\x45\xc1\x39\xaf\xdb\xdb\xb7\xee\x83\xb2\xb0\x52\x66\x9e\x12\x96\x80\xd8\x75\x8f\x64\xf4\x66\x03\x75\x54\x40\xf7\xe6\x2c\x22\x20\xe6\x1c\x47\x02\xa0\xd3\xfc\x6c\xad\x8c\xd9\x4f\x3f\x92\xc2\x7d\x28\x1e\x81\x7f\x22\x67\xa5\x09\x8e\x51\xb8\xa4\x71\xad\x9b\xc6\x03\x74\x47\xa9\x3b\x27\x16\x85\xe2\x6d\xd3\x94\x50\x41\xad\x59\xd9\x59\x9f\x18\x15\x19\xbc\x62\xe3\x55\x1b\x5b\x76\x50\x77\x2a\xd7\x65\x8c\x51\xa4\xba\xd2\x93\xac\x2b\xdd\xf0\xba\x10\x37\x2a\xb2\x8d\x9f\x55\x8a\x9d\x48\x28\xa0\x36\x75\x8e\xb1\x73\x43\x22\x91\xec\x9f\xc3\x47\x9f\xad\xd8\x3a\xdd\x27\x39\xb3\xf0\x97\x08\x70\x15\x36\x9d\x48\x60\xda\xd2\x53\x4c\xb4\x61\x1c\xe9\x73\xa6\xa0\x38\xee\xea\x36\x26\xb4\x94\xa2\x8c\x81\xac\xe2\x46\xab\xac\x2c\xc5\xcd\x48\xf2\x25\x2f\x61\x06\x67\x2a\x67\x65\x57\x26\xb0\xbd\x83\xeb\xa8\x79\x72\xd6\xb0\x6b\x5e\x72\xb7\xa4\xe3\x35\x82\x15\x4e\x23\x81\x34\xa4\x24\x71\xc3\x1b\x02\xf3\x14\x52\x34\x4f\xbd\x97\x28\x05\x32\x9c\x59\x0b\x47\x11\x47\x26\x1a\x29\xf2\x0b\x73\xd7\xc2\xb0\x17\x61\xc8\x9b\xbd\xe6\x26\x49\x78\xc1\x4b\x50\x77\x4a\x43\x15\xa7\xa7\x29\xf5\x49\xa9\x4f\x4a\x7d\x52\xea\xf3\xc8\xa9\x4f\x0a\x24\xff\x9e\x81\xa4\x02\xb9\xe4\x39\x1c\xe7\xb9\xdd\xbb\x39\x3b\xe6\xf8\x16\xe6\x3b\x6e\xb9\x30\x37\x3e\x75\x67\xc9\x8e\xff\x02\x37\x11\x0b\xb0\x9c\x9f\x87\x71\x37\xbc\x62\x33\x18\xb5\x65\x39\xee\x4e\xd2\xb4\x48\xa6\x33\xfe\xc1\x8a\x37\x26\xcd\x45\xca\x03\x82\xd1\x98\x10\xab\x02\xcd\xcc\x29\x2a\xc3\x5e\xd8\xd8\x58\x5d\x0b\xcd\x10\x96\x80\x3a\x75\x41\x20\x09\x92\x28\xb8\x4b\x4c\xbe\x7e\x3f\x11\x8d\xd4\xa3\x49\x2e\x6b\xf8\x7b\x90\x51\x4f\xe9\x9c\x9a\xdb\x0c\xed\xbb\xf0\x88\x88\x98\xad\x33\x68\xb0\x88\xba\xba\x52\x7c\xd5\xb0\x3c\x1e\xe2\x66\x6a\x38\x36\x57\xda\x68\xd7\xd2\x44\xb1\x50\x0e\x10\xcd\xe4\x0c\xf4\xfe\x8b\xf3\x1d\x5d\x5d\xbd\xbe\xff\xaa\x7c\xf7\x34\xbf\x2b\xbd\xd8\x5b\x4a\x75\x2b\x1e\xd4\xec\xba\x84\x22\xcc\xc9\xad\x2c\x84\x3d\x12\x41\xf0\x53\xcd\x99\x84\xc2\x7a\xf9\xa6\x13\xc5\x1e\x9f\x0e\x36\xe3\xf5\x12\x03\x51\x82\xb4\x79\x1f\xab\x1d\xc4\xd8\x40\x98\x4e\x1d\x87\xb5\x22\xa8\xe8\xdc\xec\x80\xc2\xc0\x6c\x66\x40\x01\xed\xa8\xe6\x5c\xef\x82\xcf\xb8\x71\xf9\xb6\xb3\x34\x8f\x18\x80\x43\x26\x5c\xb6\xa5\x6d\x94\x96\xc0\xaa\x4b\x60\xc5\xdd\x1b\x73\xde\xdd\xfe\xb3\x00\xed\x9a\x66\xe9\xc8\xb4\x6c\xa1\xd6\x5f\x10\x79\xbb\xcc\xb1\xe9\xd4\xac\x18\xdc\xd3\x1b\xb7\xbc\xd6\xa2\x80\x63\x2b\x06\x0e\xc7\x3c\x8d\x84\x29\x48\x09\xc5\x69\x6b\x98\x31\xce\xe7\x50\xb4\x25\xaf\x67\xaf\x66\xb5\xd8\xfe\xf7\xd9\x2d\xe4\xad\xbb\x20\xeb\x8c\x47\xf0\xfd\xba\xdf\x3b\xa8\x31\x27\x00\xd2\x90\xfd\xb7\x4c\x21\x87\xe9\xdf\x2d\x94\x2d\xf1\xd2\x29\x5f\x7b\x13\xed\x63\xf4\x0d\x54\x64\x6a\x07\xf5\xcf\x65\x55\xfa\x7d\xba\xeb\x0b\xdd\x4a\xe5\xb1\x99\xca\x6a\xb4\x62\x8d\xbc\xd3\x9e\xee\xce\xf2\xa4\x38\x49\x71\x92\xe2\x20\x15\x87\xf4\x81\x1b\xe0\xb3\xb9\x35\x2e\xfd\x42\x00\x67\x59\xb2\x4c\x0d\xe4\x68\x6c\x1a\xdc\xf3\xd7\xce\xa6\xab\xa1\xf5\x22\x11\x0b\xc7\x83\xcd\x40\x62\xc4\x3a\x78\xe3\x64\xc2\xb5\xf1\x7a\xd7\xf7\x04\x24\x46\x27\xd1\xaa\x9b\x42\x9e\x14\xf2\xa4\x90\x27\x85\x3c\x29\xe4\x49\x21\xcf\xb7\x11\xf2\x60\xa1\x71\x84\x1c\x7c\xee\x7d\x7b\xc1\x5d\x45\x34\x6a\x44\xf1\x9d\x54\x68\x76\x03\x35\xb1\x8d\xfb\x05\x1a\xfc\x76\x3e\x6f\xc3\x42\xdc\x2b\xf4\x8f\x84\x04\x47\x9e\x96\xc7\xa7\x8b\x9e\xf6\x3e\xc8\xcc\xf9\xda\xfd\xc0\x8f\xfa\xd8\x7f\x4f\x4e\x04\xf7\x15\x6f\xb6\xfc\xcc\x58\x98\x4f\xf0\xf4\x0b\x48\x53\x17\x8b\x1a\xe4\x23\x7a\xfc\x67\xf2\xa3\xf0\xdd\x83\x34\xe4\x57\xb6\x13\xcb\xc9\xfc\x25\xf3\x97\xcc\x5f\x32\x7f\xdf\xa9\xf9\x43\x0f\x89\x28\xfd\x1e\x63\xa7\xf2\x56\x8b\x46\x94\x62\x76\x67\x5d\x5d\x11\xd4\x2b\x8a\x0e\x0d\xee\xf7\xa7\x17\x99\x65\x7f\xbb\x6a\xf5\x5f\x73\x97\x6f\xbe\x64\x8d\x52\x0e\x8a\x5b\x26\x67\x66\x54\x9f\xef\x1b\x92\x10\xcd\x00\xb5\x5b\x9e\x61\x88\x87\xb5\xf1\x0f\x3f\x3c\x3f\xe6\x13\x76\x10\xa9\x1d\xd4\x3f\xac\xde\x7c\x59\x8b\x86\xbd\x87\x09\x31\x3c\xc2\x0b\x92\x65\xf5\x1b\xb9\x47\x48\x11\x16\x4e\x78\xf0\x94\x48\x06\x52\x73\xaf\xec\x29\x99\xa8\x64\xa2\x92\x89\x4a\x26\xea\x91\x4d\x14\x6a\x08\x04\x29\x26\x8e\x93\xc2\x37\x62\x46\x43\xe8\x09\x56\x07\x28\x59\x0c\x9a\x1d\x18\x12\x20\xc0\xcc\xcc\x4f\xad\xf9\x26\xb1\x18\xf6\xc2\x2c\x7a\x9a\xfd\x49\xb3\x3f\x69\xf6\x27\xcd\xfe\xa4\xd9\x9f\x34\xfb\x93\x66\x7f\xd2\xec\x4f\x9a\xfd\x49\xb3\x3f\x69\xf6\x27\xcd\xfe\xa4\xd9\x9f\x34\xfb\x93\x66\x7f\xd2\xec\x4f\x9a\xfd\x49\xb3\x3f\x69\xf6\x27\xcd\xfe\xa4\xd9\x9f\x34\xfb\x93\x66\x7f\xd2\xec\x4f\x9a\xfd\xf9\x8a\xb3\x3f\x8e\x06\xce\x53\xf4\x28\xc2\xed\x24\xac\xa3\x33\xd7\xed\x74\x0a\x72\xbc\xba\x21\xf6\x7d\x77\xe9\xea\xb0\xe7\xe7\x7b\xa0\x6a\xf4\xdd\x29\xb7\xd8\x7f\x9c\xff\xaa\xa0\xe0\xad\x63\x12\x09\x29\x4f\x8a\x7f\x84\x73\x73\x3b\x96\x1d\xcd\x79\x73\xd2\xe6\x44\x55\x5c\xfa\x89\xbe\x3d\xe9\x89\xde\x9f\xf4\xb0\x17\x5f\x99\x83\x76\x33\xfb\xbd\x97\x38\x39\x89\x76\x77\xa6\xfb\x88\x5e\x14\x90\xdb\x70\x0d\x0c\xbf\xe7\xa1\xc4\x1b\x25\xda\x79\xd1\xae\x59\xe6\xa1\x64\x5b\x9d\xc6\x68\x6b\x81\x45\x32\x4f\x5e\x32\x5e\x45\x3e\x3f\x78\x77\x08\x3d\x16\xd4\x7e\xb4\x20\x96\x3d\xe6\x19\xec\x46\x64\x6d\x87\xe0\x95\xf9\x51\xce\x63\xa1\xf1\xa4\x66\xdd\xcd\x17\xe6\x96\x72\x67\x53\x42\x84\x45\xe0\x0a\x2e\xb4\x58\xdf\xd7\xc0\x34\x1b\x23\x24\x8d\x46\x82\xf5\x01\xaf\x88\x2b\x04\xbc\x86\x87\x3b\x98\xd5\x0b\x18\x73\x48\xab\x07\x30\x4e\xa8\xd7\x49\x16\xaf\x8b\x9e\xa5\x05\xfe\x3a\x20\xb4\xf8\x23\xae\x06\xf5\x17\x04\xd7\x95\xa1\xbe\xe1\xa8\x47\x64\xf5\x79\xdc\x84\x2b\xf1\xff\xef\x5b\x68\x71\x7a\xc2\x71\x57\x84\x08\xcc\x53\xd2\x70\x57\x92\x26\xb1\xf8\xee\xc4\x82\xd0\x58\xa1\x2b\xac\x34\x63\xe5\x57\x5d\x25\xd4\x49\xa8\x1d\xf2\xaa\xab\x7a\x88\xa3\x5f\x4d\xd5\xeb\x43\xf4\x7a\x2a\x89\xbe\x01\x3d\xa3\x44\x4e\x74\xd7\x4e\xaf\xa2\x92\x6b\xa8\x24\x75\xa3\x8f\x97\x5c\x3d\x0d\x31\xdc\x64\x0e\x92\x06\x4f\x68\xac\x56\x15\xab\x93\x92\x29\x15\x3d\x85\x5a\x76\x75\x30\x93\x2d\x3c\x00\x6c\xe4\xde\xa2\x68\xe6\x6c\xe4\x68\xb0\xaa\x12\xae\xca\x83\xc7\x72\xb6\x47\x5c\xac\x16\xc1\x39\x20\xbb\xd4\xdf\xef\xc1\x2b\x73\xa7\xcb\xb0\xe7\x67\xc7\xbf\xf3\x0b\x61\x56\x17\xc2\x34\x6d\x59\xba\xae\xe5\x46\xf4\x48\x42\x23\x14\xd7\x42\x86\xc1\x68\x36\x0b\x78\x9f\x20\xba\x17\xa0\x25\xcf\x95\xb7\xe8\xd4\x1a\xe4\x92\x95\x01\x9d\x75\x55\xde\x30\x00\x7b\x2f\xb2\xc0\x2f\xaa\xc2\x04\xd4\x8d\x14\x15\xe8\x39\xb4\xea\x18\x73\xed\x0f\xa6\x98\xb4\xbe\x2c\xea\x42\xd4\xdc\x1a\xd3\xd0\xb1\x4e\x44\x3d\xe5\x16\x29\xc2\xa9\xea\xce\x33\x62\xfc\xa9\x8f\x1f\x45\xf0\x17\x25\xd5\x9b\x67\x2e\x6a\x21\x31\x9d\xc5\x90\x74\x7d\x55\x94\xe4\xf9\x25\x74\x7b\xfa\x79\xbd\xcf\xd4\xa3\x6d\x24\x9e\xf8\x6b\xa2\xe6\xee\x25\x6c\x44\x52\x9a\x9f\x4a\x14\x6d\xd9\x2a\xe2\xb2\xc4\x7f\xfc\x3f\xba\x1f\xd8\xe4\x54\xc2\x0c\x6e\xa3\x0f\x4f\x42\x53\xb2\xbc\xbb\xa1\x3c\x3a\xb6\x82\x86\xa1\x73\x10\x1a\x72\x57\xe3\xc4\x48\x2f\x41\xd8\x3c\xfa\x81\xf1\x98\xbb\x3f\xab\xeb\x84\xba\x5e\x0f\x7b\x51\x7b\x82\xd4\x79\x6c\x77\x65\x52\xe2\xa4\xc4\x49\x89\xff\xce\x4a\x8c\x00\xd3\xbc\x02\xd1\x5a\x24\xc6\xd9\x77\xc7\x47\xf2\x2e\xb4\x3a\x99\x43\xbe\x70\x06\x82\x94\x90\x28\x62\xbf\x2e\xed\xd3\x24\x6e\xd3\xe5\x9a\x0e\xa1\x86\x7a\xce\xfa\x36\xbe\xa6\x8d\xae\x63\x3f\xc9\xda\xb5\x77\xbd\xda\xc1\x7c\xdc\x74\x45\x62\xdb\x93\x63\x9b\xa3\xc1\x4a\xa9\x2f\xa1\x14\xac\x00\x99\x0a\x3d\xa9\xd0\x83\x2e\xf4\xfc\x55\x74\x92\x4b\x48\x2e\x21\xb9\x84\x6f\xc1\x25\x14\x5c\x99\x2b\x72\x47\xfb\x56\xef\xb9\x2a\x5b\x50\x2f\xdf\x33\xb9\x87\xc9\x56\xd3\x8f\x31\xfb\x2e\x93\x8f\x62\x79\x8c\x8b\x52\xd7\x28\x2f\xa4\xb0\x2e\x9d\xc6\x8c\x69\x67\x4d\x2f\x58\xf3\x1a\xee\x2e\xc1\xaa\x01\x14\x58\xc2\xbc\x39\x6a\xcc\x58\x36\x78\x81\x8a\x66\x65\x29\xf0\xc0\xee\xfa\x2a\x65\x76\x1a\x33\x2b\xed\xd4\xae\xcd\xd3\x5d\xc4\x1e\x99\x93\xf8\xfb\xe2\xc9\xb4\x47\xdf\x1b\x4f\x44\xc6\xd3\x7e\xdb\x83\x58\x1c\xd8\x2c\xe6\x7b\xf1\x00\x9c\xc8\x45\xad\x19\xaf\x41\xe2\x66\x97\x49\x24\x33\x26\x78\xc9\x15\xae\x76\xe4\xf4\x97\xff\xeb\x0d\xdd\x7e\xf3\x7f\xdf\x40\x76\xfa\x89\xfa\xd1\x60\x7f\xfa\xbf\xe2\x14\x99\xdb\x78\x05\xd9\x74\x20\x96\x7e\xac\x6e\xb1\x4f\xfe\xe6\x5b\xf0\x37\x88\x66\xae\x5e\x59\x57\x53\x3b\xf1\xed\xa9\xf1\xea\xc6\xf6\x73\x31\x3b\x05\xa5\x79\xcd\xf6\x4f\x57\x38\x18\xb8\x02\x7a\xdb\xea\x73\x31\x93\x66\x92\x7c\x8f\x54\xb8\xe5\x75\x6f\xa5\x03\xd1\x0b\xf3\x03\xb5\x89\x92\x2d\x3c\xc6\x48\x4c\xf0\xe2\x04\xc5\x3f\x86\x0c\xc3\x2e\x13\x83\xcd\x20\x7b\x1e\x22\xb1\xbe\xa4\x7f\xb4\xcc\xc7\x7b\xf7\x95\xa4\x3d\x8e\x69\x8f\x63\xda\xe3\x98\xf6\x38\xa6\x3d\x8e\x69\x8f\x63\xda\xe3\x98\xf6\x38\xa6\x3d\x8e\x69\x8f\x63\xda\xe3\x98\xf6\x38\xa6\x3d\x8e\x69\x8f\x63\xda\xe3\x98\xf6\x38\xa6\x3d\x8e\x69\x8f\x63\xda\xe3\xf8\x8d\xee\x71\x9c\x0a\x79\xc3\x64\xf1\xaa\x6e\x5a\x6d\xdb\x4e\xe4\x36\xa3\xac\x28\x32\xcd\x66\x99\xb9\xb4\x87\x5b\xd6\x89\x23\xc6\x7f\x6d\xcd\x37\x10\x00\xf9\xbc\xad\x17\x99\x29\xcb\x66\xa5\xbd\x48\x48\x03\xbb\x61\xb2\x8e\x80\x58\x40\x7d\x97\x2d\x00\x1a\x56\xf2\x25\x84\x15\xaf\xcd\x89\xd8\x20\x33\xe4\x82\x68\x7b\xa4\x65\xdf\x64\x87\x18\x98\x99\x1c\x2b\x97\x90\x99\x62\x96\x3d\xb7\xc3\x0c\x4d\x41\xde\xca\x08\xd7\x48\xb1\xb2\x14\x37\x19\xab\x45\x7d\x57\x89\x56\x65\x98\x5a\x0f\xa6\x7f\xeb\xc8\x68\x8a\x18\x2e\x92\x7e\xe6\x47\xcd\x99\x84\x22\x73\xc6\x20\x48\xb8\x56\x81\xcc\x58\xab\xe7\x31\xc6\xeb\xf6\xbe\x83\xbf\x52\xc4\xd6\x6e\x3b\x4e\x7f\xe3\x66\xe8\x5f\x17\x3b\x55\xca\x1a\x96\x2f\xc0\x29\xc3\xf6\x61\xaa\x05\x6f\x32\x5e\x2f\x59\xc9\x8b\x0c\x96\x50\x87\xe2\x75\xe2\x96\xb1\xa2\x30\x47\x79\xd8\x19\x8b\x60\xea\x4a\x7a\x7f\x5f\x13\xf8\x75\x20\x5a\xd8\xe2\xd4\x7e\x5f\x4b\x56\x2b\xbb\xdd\xc0\x29\x69\xce\xb2\x1c\xa4\x6b\xfe\x00\xd9\xab\x35\x60\x54\x2c\xc9\x97\x4c\x83\x61\x5f\xd6\x30\xa5\x9a\xb9\x64\x0a\x1e\x06\x3d\x56\xaf\xe3\xd2\x93\x37\x73\xd8\xb7\xa2\x90\x8a\x55\x72\xa8\xf5\x8a\xe1\xb1\x6c\x53\xbf\xcf\xeb\xce\x63\x40\x1c\xb4\x07\x64\xf8\x83\x70\xbb\x91\x42\x8b\x5c\x94\x51\xc0\x96\x98\x05\x6e\x28\x2c\xa7\x0d\x77\x34\xe0\xdd\x55\x8f\x97\xd0\x00\xd3\x50\x9c\x8b\xd9\x2b\xeb\x79\x04\x8e\x3e\xad\xd0\xc6\xac\x82\x60\xa4\xb4\x4b\x23\xed\xd2\xc0\xee\xd2\xe0\x26\xbd\xda\xc3\x20\x2b\x87\x31\xdc\x9d\x6b\xdd\xc4\x90\x10\x93\xc0\x19\xac\x6c\x0e\x66\x2f\x89\xa3\x35\xde\x90\xae\x52\x43\x09\x95\xd0\xab\x28\x28\x1e\xb0\x3d\x51\x44\xf2\x6e\xf3\x5c\x8b\xe2\x0e\x95\x33\x12\x71\x73\x21\x55\xb6\xca\x41\x84\xe4\x33\xee\x2e\x73\x3a\x95\x9e\xd8\x03\x8c\x36\x6e\x2a\x9f\x9b\x48\xda\x99\x50\x12\xfb\xd0\x30\xe9\xf2\x9e\x78\x51\x35\x4f\x01\x1d\x9b\x00\x55\x41\x25\x90\xea\x1e\x72\xb6\x99\x35\x88\xfd\x05\xb3\xfe\xae\x32\x11\x40\xde\x4a\x69\x22\x21\x47\x9a\xe1\xa3\x1b\xe6\x81\x6d\x69\x3d\x3a\x91\xd6\x67\x0d\x3c\x0c\x6c\x36\xe5\x52\xe9\x92\xd7\x10\xfd\x03\x46\xc2\xbb\x6a\x89\x3b\xc3\xf6\xa1\x78\x77\xf8\x44\xf6\x70\xb2\x59\x8a\x9c\x95\x5d\xff\xe3\xf7\xbd\x6a\x4b\xcd\xb1\x44\x47\xda\x28\xf2\x00\xf1\xb6\xca\xcc\x6e\xd4\x6d\x59\x66\xdd\xf2\xc4\x6c\x3d\x59\x16\x9d\x2a\xdd\x27\xba\x5d\x46\x0f\x66\x0e\xd6\xb8\x2a\x32\xdd\x29\xd6\x34\xd4\x2e\xf9\x50\xd6\xc7\x46\x79\x50\x97\x6a\xaf\xbc\x3f\x41\x36\x2d\xfe\x44\xa3\x99\x01\xff\xef\x78\xe9\x57\xe0\xe7\xc8\xba\xe6\xcd\x30\x43\xbf\xec\x11\x04\xc3\x57\x26\xe8\x1f\x71\x2f\xda\x8c\xf0\x95\x8f\xa2\x7e\xe0\x8f\x3c\xf8\x28\xee\x1a\x50\x0f\xfa\x85\x56\x3b\x56\x38\x86\xa9\x0a\x2a\x05\xf7\x73\xa9\x44\xa5\x20\xd1\x86\xa2\x08\x74\x60\xac\xd8\x90\x91\xb1\x02\x4f\x03\x7e\x90\xde\x62\x05\x9b\x84\x8a\x14\x66\x8a\x18\xa3\x05\xd8\x3e\xb7\x80\x9f\xdb\x5c\x8f\x44\x41\xf6\xf3\x4f\xff\x9f\x49\x50\x8d\xa8\xdd\x09\x29\x76\x48\xa8\xe1\xb8\x4a\x70\x28\x9e\x08\x5d\x46\xa9\xf6\xcc\x64\xe3\x64\x2a\x25\x80\xc4\x94\x62\xd0\x83\xa4\x30\x9f\x26\x00\x48\x56\x61\xea\x6a\x89\x42\xd6\x9a\x29\x69\x30\x88\x4f\x7e\xcd\x9d\x93\xa5\x65\x11\x16\x65\xd1\x95\x93\x1a\x8e\x7e\x9a\xe5\x22\x35\x28\x75\x0a\x53\xd6\x96\xba\x3b\x06\x6e\xd8\xf3\x31\x5c\x1b\xa4\x91\x14\xd7\x7b\x8c\x92\x5b\xb6\xe1\xd6\xb6\x8f\x03\xa7\x1b\xb9\xa8\x2a\xe6\x52\x0c\x54\xd2\x8b\x14\x35\x17\xaf\x51\x8c\x30\x3f\x53\xc6\xcb\x56\xc2\x64\x2e\x41\xcd\x45\x69\x19\x42\xbc\xe3\xa9\x8d\x4d\x7a\x09\x3a\x94\xe8\x66\x79\x86\xed\xf7\x68\x62\x9a\xfe\xfc\x8e\x99\x2e\x40\x71\x10\xd7\x79\x9c\x33\x25\x0e\x04\x79\x24\x8d\x07\xaa\x7b\xcd\x0c\xc2\x7e\x6d\x9e\xc1\x6a\x39\xaf\xa3\x15\x42\x78\xf1\x9a\x10\x71\x4b\xa1\xdb\x59\x21\xb6\x0f\x50\x36\x0d\x10\xb6\x0a\x04\xac\xbf\x57\xf9\x1c\x2a\x88\x40\x21\xb7\xa8\x0c\x3a\x87\xdf\x0b\x60\x3c\xaf\xb9\xe6\xac\x3c\x85\x92\xdd\x8d\x21\x17\x75\xa1\x1e\xc3\x70\x35\x20\xb9\x28\x1e\xf1\x83\xaa\xed\x2e\x3f\x7c\x54\xeb\xac\xf3\x66\x2c\xf2\xc5\x13\xb2\xcf\xdf\xa4\xc6\x3d\x86\x9a\x68\x90\xd5\xfa\x88\x8c\x97\x92\xe5\x30\x22\xcb\xef\x3f\xfe\x3f\x4c\x96\x56\xf3\xaf\x8f\xa6\x31\x0e\x9a\x94\x62\x76\x0e\xcb\x7d\xe7\x46\x3b\x78\x5e\xa5\x1b\x48\xd2\x0d\x24\xe9\x06\x92\x74\x03\x49\xba\x81\x24\xdd\x40\x92\x6e\x20\x49\x37\x90\x3c\xdc\x0d\x24\xff\x65\xef\x6a\xb6\xe3\xb6\x91\xf5\x5e\x4f\xe1\x17\xf0\xe6\xae\xee\xd1\x2e\xd1\x4d\xee\x99\x93\x78\xe2\x89\xe3\xcc\x12\x07\x22\xab\xbb\x71\x04\x12\x1c\x00\x94\xd4\x79\xfa\x39\x20\xbb\x25\xdb\x49\xb3\xbe\x22\xd0\x52\x3b\x86\x3b\xbb\x50\x1f\x80\xc2\x57\x7f\xf8\xab\x5a\x81\xa4\x56\x20\xf9\x7b\x54\x20\xe9\x5d\x4b\x1f\x16\xef\xdd\x4b\x02\xa1\xdc\xde\x0c\xae\x7d\xef\x8d\x4b\xd7\xf1\x98\x9b\xb9\x4c\x4b\xa7\x33\x52\x3e\x93\xe1\xac\x85\x27\xdd\x9a\xba\xaa\x5d\x57\xb5\xeb\xaa\x76\x5d\xd5\xae\xab\xda\x75\x55\xbb\xae\x6a\xd7\x55\xed\xba\xaa\x5d\x57\xb5\x4f\xad\x6a\x33\xaf\xf0\xd5\x7a\x49\xb5\x5e\x52\xad\x97\xf4\x55\xd4\x4b\xf2\xce\xc5\x93\x2f\x94\x33\x12\x0d\x8d\xb6\x27\xaf\x36\xf0\x36\x40\x8f\xd1\x2d\x42\x60\x30\xe9\x77\x4b\x3b\x7d\x6f\xb8\xd5\x22\x0c\xeb\x38\x32\xfa\x3f\xf7\x00\x2c\xec\xe3\xa0\xe9\x37\xa4\x7b\xdb\xe0\xb7\x60\x9a\xb4\xae\x23\xa2\x38\x6c\xad\x9f\xf9\xf3\x3f\x54\x9b\xd7\x1d\xf9\x06\x79\x9b\x99\xed\xbd\x86\x0c\xf8\x20\xe7\xf3\x7f\x6f\x3f\x9f\x5a\xf8\xaf\x52\xdf\xe0\x8f\x91\xe4\x13\x34\x43\x6b\x93\xd1\xe3\xbf\x30\x2d\xf8\x71\xaf\x22\x64\x70\x24\x44\x7d\x6b\xac\xf9\x63\x8a\x01\xff\x6d\xfa\xd6\x3d\x88\xd4\x46\x4e\x16\x19\x4d\x04\xf2\x9d\x8c\xdb\xc7\xa1\x9a\xb6\x6a\xda\xaa\x69\xab\xa6\xed\xef\x63\xda\xc0\x0f\x3b\xfd\xf8\x2b\x0d\xd6\x34\x9a\x19\x20\x3e\x30\x74\x40\x8b\x07\xba\x44\xa6\x71\x55\xb9\xbf\x63\x99\xe3\xeb\xab\xf2\x36\xf7\xa9\x11\xec\x73\x31\x4b\xf1\x35\xfe\x55\xe0\xf3\x06\x2b\x0a\x2f\x15\x4e\xfa\xe9\x7b\x4a\xef\xf3\x7e\x8c\x4f\xba\x86\xff\xed\x1a\x25\x93\x31\xf3\x2f\xfb\xfa\xbb\xd4\x6f\xb0\xf9\xfc\xa9\xac\x5d\xda\xc1\xe7\xbf\x14\xcc\xf1\x05\xe7\xff\xc5\xd6\x03\xbe\x8e\xf8\xa2\xf2\xe4\xb2\x78\x22\x8f\xc1\x04\xd1\x14\xec\xbf\xe5\x7d\x79\xfb\xec\x76\xa0\xaf\x81\x3d\xd5\xc3\xf0\x26\x77\x70\x55\x78\x70\xf4\x98\x56\x1e\x4f\x3d\x9c\x98\xe7\x62\xe6\xd0\xe2\x9c\x0e\x4c\xe2\x7f\x57\x9b\x12\xbc\x62\x46\xde\x60\xd6\x57\xd1\x10\x06\x6a\xe5\xba\x7b\x7c\x2c\x07\xcc\x02\x8a\x4c\x47\x5e\xdd\x8d\x62\x8d\xcb\x6b\x71\x64\xcf\x52\x91\x7e\x4b\x73\xbd\xf5\x96\x70\x7d\xf5\x8e\x8c\x3a\x1e\x2b\xcd\x60\x19\x09\x89\xab\x7c\xac\xdf\xa6\x2a\xce\x8d\x95\xe2\x5a\xf1\x67\x52\x0e\xc1\x3e\x72\x55\x7f\x6a\x96\x55\xb3\xac\x9a\x65\xd5\x2c\xab\x66\x59\x5f\x57\x96\x35\x27\x17\xd0\xa7\xe7\xc9\x9c\x66\x1f\x73\x7d\x55\xde\x69\xb4\x14\x1a\x6f\x6e\xa9\xfd\x05\x6e\x62\xb5\x6f\x1a\xcc\xef\x48\x7d\x80\x02\xb6\x02\xaf\x3c\x9b\xd1\xc8\x0b\x24\x84\x72\x95\x81\xea\xd2\xbe\x44\xac\x53\x13\xf2\x9a\x90\xd7\x84\xbc\x26\xe4\x35\x21\xaf\x09\x79\x4d\xc8\x6b\x42\x5e\x13\xf2\x9a\x90\xd7\x84\xbc\x26\xe4\x45\x12\xf2\x2f\xb2\xd6\xaf\x23\x89\x1f\x1c\x76\xa4\xac\x6e\x7d\xd6\xad\xcf\xba\xf5\x59\xb7\x3e\xeb\xd6\x67\xdd\xfa\xac\x5b\x9f\x75\xeb\xb3\x6e\x7d\xd6\xad\xcf\xba\xf5\x59\xb7\x3e\xbf\xcd\xad\xcf\xe3\x5b\x0b\xd7\x57\xe5\xdd\x86\x24\xaf\x11\xb3\xbc\x7a\xbc\xea\xf1\xaa\xc7\xab\x1e\xaf\x7a\xbc\xaf\xcb\xe3\x81\x59\xd0\xb9\xfc\x1d\xaa\x5e\x02\x0e\xa2\xe3\x87\xe6\x00\x1e\x0c\x96\xdd\x77\xa6\x7f\xad\xdb\xa8\xf3\xfc\x7d\x3f\x6e\x36\xe4\xff\x35\xd2\x48\x3f\x53\xbf\xe5\x1e\xf7\x03\xec\x8c\xc4\xb2\x08\x6c\xc9\x85\x5a\x8f\x0c\x7b\xf1\xe9\x04\xfc\xee\xec\xd8\xd1\xc7\xa0\xb7\xa9\x66\x46\xc3\x3e\xaa\x5c\xe7\xa1\xd4\x3c\xf0\xd6\xe1\xed\xa7\x77\xc6\xaf\x32\x0c\x43\xeb\xb5\x59\x88\x51\xb1\x18\x98\x7a\x7d\x6b\x39\x63\x86\x94\xa4\x48\x3f\xd3\xe9\x2d\x63\x6e\xf1\xc8\x7c\x02\x7b\x3f\x5a\xfb\x81\x1a\x4f\x4b\x0f\x85\x89\x57\xa8\x2f\x28\xb3\x81\x1d\x00\xee\x04\xd2\x6f\x18\xad\x45\x5f\x79\x10\xf4\xd8\xd3\xe0\x42\x2a\x39\x52\x16\x16\x28\x61\x29\xc0\x83\x44\x0a\x7c\x34\xb8\xf6\x9d\xee\xf5\x76\x7a\x94\x9e\x13\x26\xd0\x3b\xcf\x7a\x66\xde\xc2\x32\xdd\x0e\xd4\x8c\xe9\x75\xf0\xeb\xab\x75\xb4\x1f\xa6\x47\x6e\x26\x88\x1b\xd7\x47\x7a\x5c\xf0\x1b\x98\x12\x6d\xc2\xff\x7b\x37\x0e\x70\x34\xc2\x54\x1d\x41\x7d\xd0\xa1\xd9\x9b\x9d\xee\xb7\x84\x28\x02\xc8\x2e\x3f\xf6\xdf\xbd\xce\x88\xa6\x96\xff\xe9\xfa\x5f\x9d\x8b\x65\xac\xf5\x84\xf8\x31\x90\x7f\xe9\xa1\x04\xfa\xd9\xf4\xe3\xe3\x2f\x43\xe4\x77\x8b\x31\x96\xa5\x9f\x3d\x5d\x19\x6b\xc5\x44\xa7\xff\xbc\xb3\x54\x14\x10\xcb\x46\x04\x80\x23\x3b\x7b\x22\x40\xc0\x28\x1e\xac\x4c\xe3\xba\xe1\xbd\x77\x1b\x63\xa9\xd8\xfc\xb9\x46\xdb\xf4\x3a\x31\x84\x2b\x1a\xd7\xe1\xd3\x72\x80\x58\x0e\x08\x64\x80\xa8\xc4\xc7\x61\xb0\x93\x1f\xd2\x76\xb2\x3e\x8c\x34\xa1\x48\x08\x57\x6c\x5c\xb5\xd1\x18\x25\xec\x43\x13\x6d\x89\x51\xe0\x04\xc3\xe3\x38\x01\xb1\x04\x8b\x6b\x02\x54\x8c\x5f\xe0\xfa\x0a\xf6\x00\x19\xc8\x44\x74\x82\x1f\xa6\xa7\xbf\x42\x61\x1b\xbf\xed\x82\xbe\xf1\xd4\x52\x9f\x1e\x9a\xff\x30\x2c\xd5\x08\x59\x21\xf6\x3f\xc3\x9f\xae\xb0\xb2\xb2\x89\x27\xa7\x5b\x18\x19\x9a\x3e\xe0\xa3\x4f\x22\xc0\x39\x6c\xba\xf1\xa4\xe3\x42\x4f\x91\x68\x23\x39\xd2\xef\x75\xa0\xf6\xbb\xa9\x1c\x6c\x0a\x2d\xbd\xb3\x25\x90\x43\xd9\x68\x55\x5b\xeb\x1e\xde\x7b\x73\x6f\x2c\x6d\xe9\x87\xf4\xb8\x25\xb0\x13\x84\x74\x34\xfd\x1a\x3d\x4c\x8f\xe3\xf1\x4c\xc7\x35\x42\xb7\xac\x91\x00\x0d\xa9\x88\x6e\xb8\x21\x48\xbf\xd6\xbb\xe1\xd2\x7b\x09\x29\x50\x9a\x99\x03\x39\xda\x32\x9c\x18\xbc\x6b\xde\xb9\x91\x5b\xa0\x03\x87\x9c\x6a\x2f\xfd\xd2\xdb\x7d\x4a\x12\x7e\x34\x96\xc2\x3e\x44\xea\xca\xf4\xb4\xa6\x3e\x35\xf5\xa9\xa9\x4f\x4d\x7d\x5e\x38\xf5\xa9\x81\xe4\xd7\x19\x48\x1e\x4a\x54\x7f\xd7\x34\xcb\xde\x8d\xed\x18\xd3\x16\xd2\x0e\xcf\x8b\x54\x3e\xa2\x4b\x00\x1f\x3e\x83\xfb\xcd\xdd\xd1\x42\xf0\x87\xb8\x1b\x7c\x1b\x83\x8d\x7f\x50\x7a\x23\x69\x2e\xc8\x07\x60\xa2\x91\x10\xab\xa3\xa8\x5b\x1d\xf5\xf5\x55\xde\xd8\x34\x52\x8c\x7d\xed\xc9\x74\x50\x24\xa0\x50\xde\xbc\xb1\xc0\x99\xfa\xd7\xef\x27\xf0\x51\x78\x31\xe6\xe2\x8f\x9a\xc0\x42\xd8\x18\xb2\xed\x7b\xb6\xcc\xa0\x00\x11\x79\x0e\x05\x06\x2b\xa8\xab\xb3\xe2\x87\x41\x37\xe5\x10\x8f\xe7\x45\x4b\xcf\xca\x68\x4a\x89\xb0\x88\x85\x62\x40\xc2\x4e\xfb\x53\xb7\x0d\x17\xb9\x8f\xf0\x9e\x63\x00\x24\x87\xa7\x99\x5f\x2e\x56\x2c\xd3\x46\xe9\xbd\x3e\xd6\x0c\xc8\x9a\x17\xde\xd3\x03\xf9\xb2\xee\x1e\x9e\x10\x5c\x72\xcf\x0e\x94\xda\xaa\x7e\xf0\xcc\x97\x87\xf6\xf8\xbd\x38\xc1\x3d\x38\x48\x8b\x65\x23\x12\xdc\x6b\x5b\xe3\x85\x45\x73\x01\x0e\x0f\xfa\xec\x49\xd5\x17\xbb\x08\xb0\x0a\xec\x3f\x2f\x6f\x8e\x37\x8b\xbb\x14\xec\x98\x97\xdb\x3f\xb9\x89\xc4\x5b\x19\xf6\xe8\x13\x12\xde\xcf\xb1\xd1\x72\x0e\x06\x08\x3a\x79\x18\x6a\x7f\xa2\x7d\x06\xca\xf2\x34\xbc\x3d\x8e\xf7\x6a\xc5\x2c\x44\x67\xc9\x2f\xc5\xdd\x8b\x74\x43\x0c\x3e\x6d\x36\xcc\x83\x79\x80\x14\x59\x6f\x01\x61\x20\x9e\x01\x02\x7a\x96\x1a\x50\x02\x07\x5d\x6b\xc4\x56\x1a\xd9\x4d\x49\x60\x00\x99\x9a\x79\x3f\x9d\x41\x7d\xe7\xda\x7f\x9c\x3e\x15\xc8\x33\xe3\x1b\x4f\x9f\xe7\xf4\x19\x39\xcd\x07\xf4\x08\x39\xbd\x07\xc0\x2c\x9e\xd6\x63\xff\x9e\x11\xc9\x91\x35\x63\x1f\x6f\x76\x9d\x3b\x61\xcc\x38\xcb\xfc\xe0\xfc\x1d\xf9\x13\xf3\xcc\x9f\x7d\x5f\xd6\xb1\x85\x11\x6c\xad\xbb\xd5\xf6\x47\x63\xe3\x5f\xb6\x7e\x92\xa2\x1c\x35\x1b\xd7\x37\xfa\xa4\x75\x44\x88\x9d\x4a\x5b\x98\x7e\x74\x63\x50\xd6\xf4\xa4\x3c\x6d\xe9\x71\x71\x27\x07\x60\xc2\x9b\x37\x1b\x3b\x86\x9d\x4a\x92\xf2\xf7\xcb\xa5\x28\x30\xbb\x75\x47\x34\xa8\x41\xfb\xb4\x2a\xab\x20\x63\xbe\xec\x9e\xbf\x40\xe4\xd7\xa1\xc0\x71\x97\xf0\x33\xdd\x68\xd3\x5d\xc3\x9e\x14\xf5\x6d\xa9\x09\x79\x06\x0d\x51\xfb\x58\x0a\xb6\x9f\x58\x13\x78\x1c\x6e\x82\x45\x73\xcb\x74\xea\x88\x55\xc2\xd9\xa5\x48\x6e\xd0\x65\xfc\x7e\x88\x9e\x74\xa7\xcc\xb4\xfc\x1f\xf7\x45\xc6\x7a\xa8\xd9\xae\xa6\x45\xc5\x6c\xb4\x31\x90\xda\x18\x1f\xa2\x4a\xb8\x21\xea\x6e\xc8\xd7\xb4\x04\x5a\x58\xd1\x18\x67\x91\x1e\x5c\x6e\x5d\x96\x5d\x6c\x49\xb5\x2e\xaa\x9e\x42\xa4\x96\xef\x2d\x27\x83\x03\x5c\x29\x2e\x21\xe3\x1f\x87\x9c\xf1\xa7\xdd\x4c\xed\x29\x71\x74\xe1\x2b\x20\xba\x82\xc6\x83\x85\x3b\xa9\x57\x63\x1f\x8b\xe8\x4d\xa7\x1f\xd5\xac\x8f\x05\x8c\xd7\x41\xb1\x2f\x4b\x56\xf3\x06\x69\xee\xe0\x00\xa6\x45\x6a\xe2\x0f\x8f\x0d\x2d\xef\xc4\x22\xa4\xb3\xba\xdf\x8e\x7a\x4b\x17\x24\xc5\xc4\x93\xdb\x7d\xa4\x90\x2b\xc8\x19\xaa\x90\xb7\xec\x28\x04\xe6\x4a\x95\x30\x2a\x90\x06\x6c\x0c\xb0\xa7\xce\xdd\x93\x8a\x7a\xab\x06\x4f\x1b\xf3\x98\x8d\x38\xeb\xd8\xb9\xed\x26\xf5\x3b\xdd\x37\xf4\xd3\xff\x66\xf1\x58\x0f\x46\x6d\xd9\xe3\xd8\x2f\x4b\xe4\x5b\xd2\x9e\xbc\x8a\x69\xe7\x5a\x71\x87\x49\xa0\x76\x1b\xcd\xe2\x20\xc2\x4a\xbf\x69\x8b\xfd\x47\xef\x98\x23\x69\x38\xe0\xf3\x12\xdc\x4f\xb4\xff\x95\x98\x3b\xac\x72\x6c\x20\xd6\x17\xcb\x53\xba\xd6\xb0\x1a\xdc\x4d\x96\x7a\x59\xcb\xe5\x21\x0e\xba\xe0\xb8\x66\xc7\x00\xd0\xdc\x15\x9f\xb2\xf9\x81\x48\xba\x13\x5a\xe5\x70\xe5\xf0\x0b\x72\x18\xfa\xac\xd1\xcd\x2e\x2d\xec\x6c\x3c\x85\x5d\x7e\xec\xf1\x19\x9c\xba\xd7\xde\xb0\x47\xe1\x25\xc0\xc1\xfc\x41\xa5\xb0\x62\xb4\x05\xa0\xac\xa1\x3e\xaa\x86\xfc\xc9\x84\xb2\xba\xba\xea\xea\xaa\xab\xab\xae\xae\xba\xba\xd7\x76\x75\xb3\xad\x66\xa6\xba\x9a\xea\x6a\xaa\xab\xa9\xae\xa6\xba\x9a\xea\xd7\x34\xd5\xce\x93\x4a\x0b\x65\xf7\xf3\x11\xe2\x0b\x5a\x2a\x4b\x1b\x54\x8a\xbb\x1e\x05\xb5\x67\x7a\xf5\x74\x2c\x4f\x0d\xcc\x61\xf3\x97\x1d\xa4\xe9\xd5\xe0\xda\x0b\xeb\xd4\xf3\xe3\x6a\x6a\xf4\x8b\x5a\x04\x35\x3a\xaf\x9e\xa8\xd6\x14\xd8\xbb\x0d\xf6\x69\x13\xb3\xd9\x2d\x3e\xb0\x86\xaa\xf5\x3d\x79\xb3\xd9\xab\x10\x6c\x2e\x16\xab\x70\x5b\x72\x26\x6b\x3b\xf0\x56\x37\x77\xe9\x34\x82\x35\xb7\x5e\xfb\x7d\xb6\x38\xa7\x0e\xa9\xff\x51\x49\xd5\x6e\x75\xc8\xd7\xb4\x19\xb0\x30\x9c\x75\xee\x6e\x1c\xd8\x3d\x50\x08\xd1\x53\xe3\x4e\x5e\x16\x80\x75\xed\xf5\xef\x05\x61\x8a\x1c\xee\xcc\xa0\x52\x67\xfb\xad\xea\x47\x6b\xd5\x3c\xfc\xf3\x13\xdd\x53\x16\xcf\x75\xdf\x66\xce\x10\xd2\xca\x61\xaf\xe9\xb1\xb1\x63\xbb\xc8\x55\xb8\xd5\xcb\x8b\xb3\x8e\x0f\x78\x9e\x07\xff\x1c\x81\xd0\xdb\x63\x9f\x81\x6f\x41\x55\xc1\x15\xe6\x38\x2c\xee\x54\x56\x65\xc4\xb7\xc4\x08\x10\x14\x81\x03\xac\x0d\xc0\x2a\x9c\x4f\x10\x93\x04\x73\x0c\xb3\x07\xc6\xc4\x18\xc3\x73\x05\x63\x49\xc1\xa9\x74\xfe\xc5\x66\x11\x60\x0d\xdc\x6a\xb5\x48\x7f\x03\x8b\x54\x7d\x54\xf5\x51\x67\xf3\x51\x3c\xb5\x00\x52\xe1\x74\x82\x88\x24\x98\x62\x98\x3c\x30\x26\x46\x18\x9e\x2a\x18\x49\x8a\xcd\x24\x0b\x34\x68\xbf\xf0\x4c\x13\x32\x83\xd4\x99\xa8\x4c\x7f\xaf\xad\x69\x0f\xc9\xa5\x8a\x4e\x91\xf7\xce\xe7\x66\x99\x6f\xde\xec\x74\xd8\xa9\x69\xe9\x5c\x4d\xef\x44\x5c\x5f\x65\x4e\xa6\xe9\x93\x2c\xd2\x4a\x42\xa9\xf3\x90\x09\x8a\x5b\xcb\x86\x80\xa6\xb9\xb8\xbe\xca\x57\xa8\x96\xac\xe9\x4c\xe4\x9f\xdf\x82\xba\xf5\x19\xa2\x02\x55\x0b\x46\xa6\x10\x4d\xa7\x23\xa9\x66\xf4\x3e\x6d\x19\xd2\x3d\x5b\x0c\x03\x25\x4f\xfa\xd1\xd3\xeb\x08\xc5\xba\x7c\xb8\x9e\x56\x16\x6e\xbe\xed\x91\x4e\x00\x17\xeb\xe7\x74\xb1\x2a\x5d\x1f\xe1\xb6\x61\x65\x12\x9d\x2e\xb8\xa8\xf2\x1c\x9b\xde\x58\x9b\xae\xbb\x94\xeb\xeb\xd3\xb1\xea\xeb\xab\x22\x31\x12\x3c\x18\xc4\x38\x1f\xb6\xc1\xd2\xa2\x1c\x75\x43\xdc\xab\x43\xfd\x92\x62\xa3\x9f\xd6\xfb\x66\xe3\x59\x5a\x6d\x0f\x78\xa1\x90\x5c\x51\xcb\x96\x63\x33\xa4\xd2\x93\xda\x0f\xa1\x04\x25\xb6\x64\x15\xb4\x48\xfd\xd7\x09\x07\x57\xd9\x75\xf8\x62\xdd\xc8\x68\x46\xa4\x27\xab\x26\x24\xc9\x49\x9d\x71\xc2\xd7\xcc\xb5\x0c\x1c\x7b\xa9\x72\x25\xfa\x1f\xae\x3f\x13\xf8\xd9\x7a\xbd\x1f\x28\x9c\x05\x79\x8c\x8d\x04\x17\xa5\x3a\x98\x57\x48\xdc\x97\x80\xd4\xb0\x0c\x50\x22\xcb\x00\x11\x1a\x88\x10\x11\xc2\xe2\x80\x45\x7b\x87\x10\x13\x46\x03\xc8\x88\xd2\x10\x22\xe0\x94\x07\x9d\x7a\x1b\x02\x0e\x2b\xf0\x90\x02\x0e\x65\x05\x32\x5b\x91\x32\x89\xd0\xd7\x86\x40\x12\x7b\x21\x09\x7d\x04\x5d\x47\x3d\xa0\x18\x12\x4f\xa1\x44\xe0\xc2\x38\x4a\x22\x61\x61\x2a\x25\xea\xb6\x24\x36\x93\xf4\x19\x4e\xa9\xe0\xe0\x5f\x34\x2c\xdc\x33\xad\x0a\x1f\x25\x92\x58\x13\x36\x0a\x46\x7a\xc0\x0c\x05\xe5\x2c\x4b\xb3\xf2\x12\x2d\x99\x2c\xe5\x16\x47\x2c\x4f\x99\xf5\x59\x09\xbf\x22\xe9\x5a\x23\x28\x69\xe2\xb5\xa6\x8d\x95\xc9\xd7\xea\xa6\x56\x24\x60\x2b\x26\x48\x9c\x84\xad\x6d\x43\x3c\xff\xd2\x06\x90\x88\x31\xab\x05\x3c\x21\x5b\xd3\xc0\x59\x7b\x8f\x27\x66\x2b\xd0\xe1\xe4\x4c\xae\x0a\x82\x04\x4d\xe2\x08\x45\xa4\x17\xc8\x03\x27\xba\x14\x14\xa3\x87\x10\x15\x23\xb4\x04\xb4\x78\x2f\x31\xe2\x0a\x10\x21\xb2\xe2\x34\x05\x09\x8a\x50\xf3\xf0\x32\xc9\x71\xfb\x0c\xdd\xdf\xe3\x7a\x99\x8a\xf8\xa6\x43\xfe\xc7\x2d\xc9\x40\xff\x19\xa9\x6f\xa8\x04\x72\x2a\x65\x41\x0a\x7b\x36\x0b\x45\xe3\x9c\x38\x82\xc6\xce\xca\xe0\x5d\x47\x71\x47\xe3\x49\x72\x21\xa1\x21\x5f\x2f\x41\x7e\x2a\x1a\xa4\x32\xc4\xbb\x8e\xa2\x37\xcd\x62\x83\x40\xa8\x8c\x07\xc9\xb7\x63\x73\xb7\xf8\xe2\xa9\x70\x90\xe9\xbf\x96\x42\x53\x14\xb0\xb4\x79\x46\x8a\x66\xac\x3d\x20\x2f\xec\x0a\x48\x0b\xc9\x75\xb3\xd7\x33\xfe\xe8\x09\x93\x44\x90\xab\xcc\x92\xa3\xe5\x8a\x3f\xf1\x86\x9e\x05\x3a\x9c\x19\xe9\x5c\x6b\x36\x86\x7c\x8e\x81\x6a\x76\xda\x2b\xea\x1b\xd7\x32\xe9\x0a\x34\x2b\x83\x4f\x8f\x04\x52\xa1\xd7\x2b\xbf\xad\x6b\x27\xcf\xce\x3d\x14\x90\xdc\xe4\xd1\x73\x45\x87\xdb\xf5\x33\x2d\x7c\x96\xb6\xc4\x07\xb9\xbc\x82\x0d\x7a\x16\x50\xf6\x71\xb8\xc3\x20\x5e\x8a\x97\x0f\x3b\x13\xc9\x9a\x10\x4b\x50\x13\x35\x6d\xd1\xeb\x3e\xa4\x85\xa7\x3c\xeb\x96\x4a\xa0\x4d\xb7\x61\x1b\x1d\x62\x6e\xc8\x78\xac\xae\xa0\xfc\x78\xbb\xcf\x07\x9b\xd6\xbd\xea\xf5\x3c\xf1\xf5\xbc\xb2\x76\xb2\xa7\x87\x42\xf7\xfb\x8e\x68\x48\x86\x5f\x46\x53\x5a\xdd\xc4\x2c\xed\x68\xb0\xc7\xaf\x18\x21\x36\x63\x88\xae\x3b\x2e\x43\xe6\xd2\x10\x6a\x12\x63\x0a\x47\x91\x97\xed\xcd\xe5\xc9\xe7\xe0\x48\x3a\x66\x83\x02\x6a\x31\x68\xbb\x08\x52\xdf\xad\xa9\xef\xd6\xd4\x77\x6b\xea\xbb\x35\xf5\xdd\x9a\x57\x7b\xb7\x86\xfd\x24\xe8\x6e\x38\xfd\x26\x32\x42\xa8\xe9\xee\x09\xc3\x24\x68\x82\xcb\x55\x5f\x19\xc8\x37\xd4\x47\xe8\xc5\x71\x0e\xcb\xa7\x5b\x17\x25\x86\x97\x80\x16\xf5\x52\x1e\x8c\x63\x23\x00\x58\x00\x7c\x12\x62\xeb\xc6\x98\x43\x14\x37\xc6\x61\x8c\xec\x9e\x15\x20\x4c\xbe\xb3\x63\xe7\xac\xdb\x9a\x26\xa7\xbf\x8d\xb3\x73\x0d\x52\x55\xec\xee\xd2\x33\x64\x99\xd5\xb2\xc3\x75\x67\x95\xaa\x22\x69\xd3\x93\x9f\xeb\xe4\x14\xc3\xdd\xe8\xc6\x58\x13\xf7\x85\x61\x77\x2e\xc4\xc2\x90\xcf\xcf\x56\x95\xc5\x4d\x6f\x4e\x15\x46\xf4\xc6\xf9\xf2\x32\x1d\x7b\x53\x4a\xa6\xd6\x6d\x81\x6d\x70\x08\x6a\xae\xba\xac\x1a\x1d\x69\xbb\x58\xaf\x6d\x1d\x5e\x39\xcd\xfc\x12\xb8\x54\x15\x88\x2f\x60\x0f\xe9\x97\x6a\x75\xd8\x95\x02\x4f\xda\x54\x12\xab\xb8\x50\x4b\x63\x95\xeb\x60\xf4\xba\x31\xfd\x56\x3d\xd7\xe3\x2f\x35\xf1\x47\xe4\x67\xcb\x5c\xb4\xc3\xa8\x7a\x72\x21\xef\x11\xaf\x08\x87\x8e\x60\xd3\xde\x67\x69\x41\x3e\x19\xf8\x62\x88\x83\x6b\x4b\x62\x29\x93\x0b\xc7\x86\x35\xa9\x3c\x4d\x9f\x66\xde\x9a\xcc\xeb\xe8\x45\xcc\x3b\xdf\xdf\x9d\x77\x31\xe6\xa5\x17\x53\x5d\x1a\x35\x9f\x21\x50\xd3\x09\x70\xbe\xd7\x5c\x44\xfc\x19\xe6\x40\xde\xb8\x56\x85\x52\xb0\xad\x77\x83\xb2\x6e\x1b\xf2\xb5\x73\xee\x67\x89\xe4\x63\x46\x4a\x87\x69\xa2\x4a\x89\x48\xb9\xe1\x3e\x68\xdf\x27\xfd\x6c\xc9\xea\x7d\x3e\x2c\xcf\x29\xaf\x1b\xba\x71\x7d\xa4\xc7\x98\xc3\xab\x83\x3f\xb9\xac\xf5\xe2\x30\xe8\x5e\x99\xb6\xc8\x94\x7f\x82\x75\x41\x23\x4c\xe6\x9c\xd4\xc6\xea\x6d\x28\x32\xca\x19\xcf\xb4\xc5\xc1\x2e\x46\x66\x8c\x46\x2c\xfe\xef\xd3\xe8\xc9\xe1\xff\xa6\x8d\xfd\x2b\x37\xb2\xac\x3e\xa9\x86\xd6\xfc\x97\x27\x24\xb0\x28\x1c\x44\x35\x6f\xc7\xcd\x86\xfc\xcd\x6e\xec\xef\x3e\x40\xd5\x55\x18\x29\xcf\x78\xef\xf4\xa3\x10\xed\xbf\xec\x5d\x5d\x8f\xdb\xb6\xd2\xbe\xf7\xaf\xf0\x45\x2f\xec\x2c\x14\x14\xef\x5b\x14\xa8\x6f\x16\x41\xb2\xd9\x14\x49\x1a\x23\xde\xd3\x1b\x67\x7b\xc0\x48\x63\x9b\x58\x49\x14\x48\xca\x5d\x17\xfb\xe3\x0f\xe8\xaf\xdd\x34\x1b\x8b\xe4\x8c\xac\x75\x32\x76\x2f\xd6\xa9\x44\x91\xc3\xf9\x78\x1e\xce\x88\xbc\xff\x1f\x0f\x3f\x99\x34\x0d\xe7\xed\xfb\xba\xf7\x26\x44\xb8\xcd\x1e\x8d\xfa\x7f\x4d\x45\xf2\xcf\xcf\xc9\x6f\xd7\x83\x69\xb2\xf9\xeb\xbf\xd7\xcf\x76\xff\x36\x3c\xff\x09\x3b\xa4\xa6\x4d\x95\xbd\x1a\xd1\x20\x32\x77\x9c\xd4\x1b\x10\x04\xb2\x71\x1b\xb2\xbe\x53\xe5\xfc\x9d\xdf\x01\x84\x87\x9b\x6b\x5a\xce\x3d\x58\x0b\xb6\xde\x8b\x68\x11\x67\x9c\x87\x2c\xd0\x7d\xcd\xca\x58\x28\xb2\x76\xcd\x89\x4e\x61\x0b\x71\x7b\x51\x5a\x7d\xf0\x69\x7e\x61\xfe\x7b\x53\xfe\xed\x44\x6e\x4e\x4c\x47\xb6\x86\xd2\x56\x94\x42\x1e\xb8\x39\x57\xf3\xb9\x2c\xe7\x8f\x26\xa2\x0e\x8c\xa9\x54\x19\xbc\x98\x43\xf9\x58\xf9\xef\x37\x15\xbb\x49\xa9\x9b\x0e\x65\xf6\x31\x8a\x7b\xc6\xdf\xca\x9a\xfc\xc1\x09\x6e\x10\x75\x48\x11\x71\x97\xfd\x6b\xbc\xe4\x90\x89\x37\x76\x63\xaf\x39\xaf\xf3\x1a\x4a\xfb\x59\x5a\xcc\x74\x6f\x20\xc0\xc4\x2a\xdd\x90\x14\xf2\x69\xcc\x7d\xcd\xa6\xa9\xe7\x6e\xa3\xf9\x5c\xcd\x9f\x17\x50\x34\xb3\x53\xaf\x81\xff\xfb\x09\xe9\x02\xd2\x1b\x53\x17\xe4\x0d\x37\x39\xbe\xa8\x46\xcd\xaa\xfc\x66\xd2\x25\xb0\x51\x0f\x15\xfc\xd7\xc4\xfe\xa9\xf2\xba\x20\x99\xde\xf5\x4e\x53\xaf\x0e\x9f\xc3\x10\xd2\x9e\xfb\x16\x90\xc9\xe6\x69\x0c\x10\xd0\x56\xf2\xf2\x1f\x78\xe7\xa3\x79\xee\x2b\xca\xd5\x07\xaf\x52\x82\xc4\x33\x8c\x7f\x7d\x87\x67\xb7\x1f\x46\xf8\xc1\xa7\xb3\xbb\x64\x78\x3e\x18\x4c\x5d\x4c\x3f\x1b\x7c\x7a\xbe\xfe\xe3\xd9\xf0\x7c\x78\xb7\xfb\x71\x36\x1c\x0e\x06\xd3\xb7\xef\x2f\xaf\xc6\x17\xd7\x72\x78\x37\x2d\xeb\xe2\x66\xf3\xeb\x6e\x30\x85\x8b\x6b\xcf\x46\x86\x87\x21\xc3\xee\x73\x9b\xdc\x9f\xed\x91\xc8\xd2\x26\x4a\x27\xdb\xf7\x7b\xfb\x56\xd7\xd0\x3b\x78\xb7\x9f\xee\xee\x78\x58\xe3\xa1\x26\xa1\x9a\xe6\xd3\x5e\xb0\x9e\xf9\xbd\xc8\x11\xd4\x68\x13\xd2\xf1\x82\xe0\x51\x22\x1f\xb3\xc4\x8f\x28\xf1\x6a\x99\x52\x0a\x7b\xb3\x74\xd7\x7c\x5d\x58\xab\xee\x9b\xe6\x42\x16\x7f\x34\x30\x93\x48\xd1\xef\x26\x40\x64\x1f\xca\xfc\xe0\x5a\x55\x38\x31\x0b\x9f\x60\xf7\x4d\xee\xc7\xeb\x71\xb5\xf7\x6c\xbb\xff\x4c\x05\x69\x1b\x13\x24\xd2\x14\x8c\x79\xaf\x32\xdf\x1b\x1a\xf8\x33\xc1\x8c\x1e\xa6\x54\x8f\x7d\x1c\x77\x99\x78\xeb\x70\x8c\x98\xb6\x87\xfa\x5f\xba\x55\x7f\xff\x3b\xa2\x06\xdf\xef\xdf\xc8\xc3\x87\xd0\x90\x3c\xa4\x69\xb9\x80\xe0\x21\x21\xa6\xb3\x2d\xfc\x93\x65\xe6\x7d\xf1\x01\xd2\x8e\x32\xb5\xed\x5b\xd2\x6b\x7d\x32\x6d\x2a\xd4\x9a\xde\x04\x5c\x1f\xc3\x4e\xa3\xf1\xea\xd7\x38\xd4\x1f\xb9\x7e\x7d\x6f\xa0\x72\x3e\x79\x34\x4b\x86\x6b\x51\x5a\xba\x33\x32\x30\x3e\x6f\x83\xb3\x1a\xb1\x1a\x7d\x43\x8d\x82\x6f\x31\xb0\xa9\xe4\x6c\xd3\x3d\x16\xc2\xa6\x8b\x8b\xfd\x2b\x9f\x41\x1a\x1a\x88\x51\x62\xbb\x18\x5c\xf5\x7f\xff\x8d\x56\x68\x57\xac\x0f\x5a\x04\x08\x9f\xe4\xa1\xeb\xfa\xda\x60\xd1\x44\xcd\x04\xba\xaf\xe1\x08\x32\x16\xb2\x84\xbd\xb1\x70\xff\x49\xf6\x93\x18\x74\x5b\x84\x69\xc7\x4a\x63\x6d\x7d\xef\x3c\x37\xdd\xa0\x0c\x2d\x91\xf3\x1e\x21\x9a\xe0\x5b\xb6\x6b\xb2\x2f\x73\x61\x4c\xab\xa4\x76\xb9\x5e\x77\x75\xac\xac\xe5\x47\xb4\x38\x8a\x00\xe9\x7a\x5e\xea\x75\xd9\xbe\x3e\xd4\x34\x2d\x49\x79\x8d\x27\x55\x5a\x4d\xac\x48\x1b\x0b\x27\xb6\x05\x80\x0e\xde\xfc\xff\xff\x1d\xb8\xce\x0f\x04\x6d\x5e\x07\x7e\xa9\xca\x99\x9c\x4f\xd6\x2f\xad\xa1\x47\x92\x09\x28\x54\x39\x39\xdc\x92\x6f\x10\x6c\xca\x18\x86\xb6\x17\x90\x41\xc4\x7b\x1a\x2f\x71\x05\xaa\x9d\x7f\x86\xf1\x29\xf7\xdf\xfb\x52\x9f\xe5\xa8\x90\xb9\x2f\x64\xf9\x11\x44\xb6\x9a\x40\xaa\xca\xc3\xbb\x30\x84\x1a\x5c\x98\xe1\xed\x3e\x1a\x96\xd2\x6d\x34\xf2\x46\x3a\xa7\xbf\xf2\xce\x07\xb5\xdb\xab\x10\xd8\x1d\x22\x7e\x0c\xdc\x0e\x04\x78\xa1\xdd\x8a\x84\xd8\x81\x36\x82\x83\xd6\x91\x0f\x8b\x81\xd4\x51\x70\x3a\xb2\x7f\x71\xc0\x31\x1c\x42\x87\xc1\xe7\x08\xe8\x1c\xe0\x01\x63\x47\x1e\x01\x97\xe3\x03\x40\xe4\x9c\x06\x8a\x21\xe8\x72\x0b\x45\x95\x0b\x0b\xad\x78\x26\x4f\xb0\x11\xdb\x7e\x04\xf8\xa0\x99\xc3\xc8\x79\x0c\x9e\x9c\x70\x70\x72\x8a\xe3\x0b\xbe\xc5\x37\xa7\x16\xad\x53\xa9\x95\x4b\x78\x05\x22\x73\x87\x43\x04\x40\x9b\x47\xc0\xc4\xaf\xbf\x04\xdc\x17\x06\x2a\x76\x1f\x31\x9b\xc9\x52\xda\xa0\x40\x1b\x23\x96\x7d\x31\x5a\xc4\xf3\x30\xcf\xdc\xee\xad\x38\x03\xad\x21\x7b\x55\x3b\x85\x9c\xa4\x0b\xc8\xea\x5c\x96\xf3\xdf\xe7\xa5\xda\xff\xf3\xc5\x2d\xa4\x75\xd3\xb6\x4e\xa4\x11\x1a\x3f\xb2\x87\xe3\x6b\xda\x77\xb8\xed\x5e\x60\xe0\x24\xb9\x50\xa9\x07\x86\x58\xf3\x25\xf3\x8e\x94\xe0\xf5\x08\x1d\x8b\x5d\x3b\x6e\x49\x0f\x5a\x18\x61\x38\x60\xa4\x04\xd0\x14\xb0\x9a\x0c\x6c\x13\x05\xe7\x76\xe5\xbc\xf6\x4e\xaf\xdd\xce\xef\xec\x98\xd8\x31\xb1\x63\x62\xc7\xf4\x24\x1c\x13\x41\x67\xfe\x06\x39\x5f\x78\x2d\x55\x36\x72\x0e\xaf\x05\x4c\x4a\x06\x42\xa7\x6c\xc9\x03\x24\x1c\xd9\xc0\x46\x90\xbd\x0e\xa6\x11\xa3\x49\x3b\xb1\xb5\xc7\x6f\xb0\xc1\xca\x51\xbf\xc9\x76\x1d\xfb\x0a\x74\xbc\xd7\x44\xba\x5c\xa6\x39\x4c\x73\x98\xe6\x30\xcd\x61\x9a\xc3\x34\x87\x69\x0e\xd3\x1c\xa6\x39\xa7\x44\x73\x70\xdd\xc0\x4c\x71\xf2\x35\x82\xed\x1d\x55\x04\xd1\xb7\x56\x2a\xe3\x9c\x4b\x0b\x39\x97\x7b\xb1\x3a\x3e\x13\xdb\x0c\x45\x57\xf6\x49\xde\x9d\x7a\x62\x1a\xa2\xea\x10\x3d\x4d\x22\x8d\x44\x74\x83\x24\x45\x26\x2d\x84\x48\x5a\x84\xd2\x4a\x07\xe9\x90\x0a\xa9\x8e\xb4\x34\x5a\x6c\x18\xa3\x0c\x6b\x6d\xa0\x17\x52\x04\x83\x0a\x7d\xed\xca\x3e\xf2\x5d\x89\xb6\x4a\x6e\x8e\xa0\xbd\x64\x13\x41\xd4\xd0\x7e\x33\x63\x0e\x7c\x1c\xf8\x38\xf0\x71\xe0\xe3\xc0\xc7\x81\xef\x87\x0a\x7c\x48\x51\x91\x78\x0c\x32\x49\xd3\x68\xa8\x55\x95\x3b\xb4\x68\xf5\x16\x1b\x98\x48\xc6\x85\xf7\x51\xc9\xc3\x11\xf5\x3a\x54\x3c\xae\x31\xd8\xd6\x18\x7c\xb9\xf2\xc3\x85\x06\xa4\x85\x06\x08\x97\x84\x87\xb6\x44\xab\x68\x34\x18\x9b\x96\x44\x90\xb8\x7a\x9a\x81\x91\x12\x07\xb2\xe8\x43\x4d\x18\x48\x3b\x46\x47\x14\x48\xf4\xa0\x85\x11\xe2\xfc\xd2\xe3\x5e\x6a\xd4\xeb\x9e\x14\x90\x11\x02\x82\x08\x4e\x29\x67\x32\x12\xd0\x06\x01\x20\xd3\x4c\x12\xa1\x13\x34\x42\xb8\xd2\xc5\xc1\x89\x83\x13\x07\x27\x0e\x4e\x1c\x9c\x38\x38\x11\x07\x27\x84\x68\xd0\x96\x4f\x22\x55\xbc\xf6\x91\xac\x3e\xa1\xc7\x82\xf3\x31\xf8\x15\x27\xa4\x52\xc5\x4f\x43\xf4\x83\x5d\x95\x54\x69\xe5\x6e\x61\x67\xd4\x3b\x1e\xf6\xe0\x4a\x29\xae\x94\xe2\x4a\x29\xae\x94\xe2\x4a\x29\xae\x94\xe2\x4a\x29\xae\x94\xe2\x4a\x29\xae\x94\xe2\x4a\x29\xae\x94\xe2\x4a\x29\xae\x94\xe2\x4a\x29\xae\x94\xe2\x4a\x29\xae\x94\xe2\x4a\x29\xae\x94\xe2\x4a\x29\xae\x94\xe2\x4a\x29\xae\x94\xe2\x4a\x29\xae\x94\xe2\x4a\x29\xae\x94\xe2\x4a\x29\xae\x94\xe2\x4a\x29\xae\x94\xe2\x4a\x29\xae\x94\xe2\x4a\x29\xae\x94\x3a\x4a\xa5\x54\xd4\x6d\xa2\xb6\xaa\x50\x75\x69\x27\xa0\x97\x32\x85\x17\x69\xea\x7e\x5d\xa9\x1b\x08\x5a\xf9\xd8\x3c\xfc\xb3\x52\x39\x88\xd2\xfb\xbe\xfb\xb3\x21\x47\xbd\x56\xcd\x2c\x1e\x56\x09\x3d\x0f\xbe\x07\xe1\x09\x50\x56\x13\xaf\x72\xa9\x2a\x0a\x51\x66\xdf\xff\x40\xa1\x5c\x1e\x71\x90\x58\x34\x5f\x06\x9c\x06\x4b\x2a\xe0\x3d\x22\xee\xf6\xe9\xaf\xb5\x8a\x2e\x4a\xc4\xca\x7e\xeb\x9f\x66\x72\xfe\x5e\x54\x6f\x61\xf5\x11\x02\xcf\xd7\xa7\xef\x0e\x11\xa9\x43\xcf\x0d\x85\x7a\x92\x77\x46\x55\x1b\x8c\x4b\xd3\xa1\xd0\x48\x46\x0d\x5f\x68\xe8\x51\x24\x92\xf8\xf2\x3b\x73\xdb\x15\x3f\x11\xed\x17\x95\xfc\x13\xb4\x89\x4c\xcb\xb4\xa2\x77\x6b\xf1\x34\x1d\x6d\x7d\xc4\x1e\xd1\xe8\xdd\x7e\x54\x5d\x6b\x9f\x06\xa3\x6a\x9d\xc2\xeb\x27\xa4\x85\x7b\xd8\x1a\x72\x5a\x7b\xcb\xd3\xde\xef\x67\x72\x29\x0d\x7e\x65\x4d\x94\xab\x0f\x28\x29\x6f\xd9\x17\x3a\xc5\xfd\x75\x6b\x04\x42\xea\xf7\x2b\x61\x2d\xe8\x72\xd4\xff\x6b\xf0\xe9\xec\x2e\x19\x9e\x0f\x06\xd3\x9f\x93\xdf\xae\xcf\x06\x9f\x9e\xaf\xff\x78\x36\x3c\x1f\xde\xed\x7e\x9c\x0d\x87\x83\xc1\xf4\xed\xfb\xcb\xab\xf1\xc5\xb5\x1c\xde\x4d\xcb\xba\xb8\xd9\xfc\xba\x1b\x4c\xe1\xe2\xda\xb3\x91\xe1\xf0\xfc\x27\x64\xc7\x6f\x93\x9b\xfa\x33\xe8\x12\x2c\x98\x44\x96\x36\x51\x3a\xd9\x48\x64\xd4\xb7\xba\x06\x54\xf3\x3b\x33\x7b\x22\xda\x4c\xe3\xc4\x76\x83\xea\xda\x87\x19\x48\x35\x58\xc6\x90\x8c\x21\x7f\x4c\x0c\x89\x6c\x02\x23\x85\x64\xbd\xb0\xdb\x3b\x72\x9f\x51\xeb\x13\x71\xa4\xb7\xb3\x35\x8a\x3d\x47\x46\x38\x37\x0a\xc7\x86\x75\x24\x24\x4e\x84\xc2\x81\x50\x38\x0f\xa4\xc5\xb9\x50\x03\x33\x79\x3b\xea\x75\x24\xcb\x4d\xc4\x64\x8d\xfa\x6e\x34\xaa\x23\x77\x2a\x0b\x31\x8f\x98\x43\xc4\xcc\xad\x9f\x38\xae\xf3\x7c\xac\x72\x99\xae\x8e\xfa\xec\x5c\xce\x20\x5d\xa5\x79\xc4\x88\x71\xf6\x52\x29\x63\x27\x56\xe8\xc8\xc2\x6a\xbc\xb1\xc2\x2d\xa4\xb1\xf7\x52\x3c\x1f\x95\x43\x21\x09\xe3\x44\x2a\x44\x65\x78\x44\x96\xbf\xf9\x6f\x61\x6d\x75\x09\xb6\xeb\x09\x5e\x28\x63\x47\x04\xf2\x44\x4f\x8c\x93\xc7\x1b\x10\x59\x60\xde\xb4\x25\x6d\xa3\x11\x2e\x4d\xc8\x25\x16\x34\x41\x22\xac\xa5\x5e\x51\x30\x43\x14\x3f\x7a\xf8\x4d\x36\xd9\x3a\x64\x2b\x04\x8e\x82\xce\x6b\xad\xd7\x09\x91\x4b\xfa\x44\xd3\x5d\xa9\xd8\xb8\x4a\xb8\xa4\x4b\xb9\xa0\x4b\xb8\x9c\xdb\xe2\x9a\xa8\x49\x17\x50\xc0\x13\xd0\x00\xbc\xa9\xbb\xd7\xbd\xb4\xed\x75\x68\x98\x36\xad\x26\x2a\xbd\xe1\x18\xbe\x8f\xe1\x6c\xd5\x1d\x58\xf5\xe9\x9b\x12\xb2\x81\x4a\xc3\xc4\xaa\x6a\xd4\xeb\xc6\x02\x99\xa6\x31\x4d\x63\x9a\xc6\x34\x8d\x69\x1a\xd3\x34\xa6\x69\x4c\xd3\x98\xa6\x31\x4d\x63\x9a\xc6\x34\x8d\x69\xda\x43\x9a\x86\xb8\x39\x97\x4b\x28\xc1\x98\xb1\x56\x9f\x23\x9c\x22\xce\x78\xe3\xd9\x1d\xde\x69\xa0\x59\x1d\x1a\x63\x93\x98\x15\x16\x13\xa1\xf4\xae\xdf\x9f\x09\x99\xd7\x1a\xae\x16\x1a\xcc\x42\xe5\x91\xe2\xc4\xef\x91\x85\x75\x77\x28\x26\x8a\x57\x46\x5c\xf4\x22\x50\x24\x12\xe6\x89\xb6\x08\xbc\x20\xe9\xd8\x26\x89\x75\x92\xb1\x4c\xa2\xde\xe0\xe3\x24\x01\xb3\xc4\xb3\x4a\xa4\xd3\xa2\xf1\x9c\x58\x26\x49\x30\xa5\x38\xac\x89\xc4\x99\x54\x18\x93\x08\x5f\xb6\x84\x2d\xb1\x6c\x11\x3d\x36\x9c\xc9\x22\x60\x2d\xd2\xc8\xdc\x4e\x91\x52\xe4\xaf\x20\x17\xab\x09\xa4\xaa\xcc\xcc\xa9\x82\x83\x0a\xb4\x54\xd9\x89\x0f\xc2\xd4\x69\x0a\xc6\x9c\x3c\x52\x43\xae\x38\x7c\x07\x58\x8d\xbd\x7e\xcb\x5e\xff\x54\x5d\xae\x05\x5d\xc8\x52\xb8\xf2\xef\x4b\x2d\x52\x18\x93\xfa\xad\x5f\x7f\xe9\xc6\xde\x65\x01\xaa\xb6\x27\xed\x7d\x11\xf3\x1a\x47\x65\x10\xb6\xe5\x94\x37\x42\xcc\xd1\xdc\x0f\xeb\x90\xf7\xaf\x56\x8f\x11\x6e\x11\xaf\x20\x58\x15\xd9\x45\x96\xdf\xc7\xb1\x63\x40\xfb\x53\xf7\xf8\xd3\x17\x22\x86\xfb\xa3\x45\x58\x69\x65\x55\xaa\xa2\xdf\xbd\xc9\x60\x26\xea\xdc\x8e\xfa\x57\x2f\xc7\xdd\x8c\x01\x13\xfb\x92\x2f\x8d\xb1\x77\xe4\x00\x88\x21\xf4\x5f\xe0\x88\x5c\x1a\x9b\x14\xa2\x4a\x6e\x60\x15\xe1\x95\xb0\x62\x48\xf6\x6a\x44\x30\x8c\x8d\x50\x0a\x51\x05\xb6\xa5\x41\x64\x92\x13\x03\x9c\x18\xe0\xc4\x00\x27\x06\x38\x31\xc0\x89\x01\x4e\x0c\x70\x62\x80\x13\x03\x9c\x18\xe0\xc4\x00\x27\x06\x38\x31\xc0\x89\x01\x4e\x0c\x70\x62\x80\x13\x03\xdd\x25\x06\x76\x5b\x15\x46\x8c\x1c\xe7\xea\x72\x59\xc8\x98\x0c\x01\xf5\xc1\x22\x28\x7f\x45\xe3\xad\x48\x7c\xd5\xc9\x6d\x2f\xda\x8a\x6b\x45\x7a\x38\xe7\x99\xc1\xb0\x5a\xb2\x5a\x3e\x21\xb5\x44\xdc\x6c\x20\xad\xb5\xb4\xab\x97\xaa\xb4\x70\x6b\x8f\xed\xe3\x45\x9e\xab\xbf\xc7\x5a\x2e\x65\x0e\x73\xb8\x30\xa9\xc8\x45\xec\xd1\xb6\xf8\xdd\xe4\x52\x51\x89\xcf\x32\x97\xf1\x66\x89\x93\xc6\xd6\x45\x74\xb8\xfe\x49\x60\xcf\x14\xeb\x5a\x99\x56\xd5\x8f\x2e\x05\x94\x47\x70\x9a\xb8\x35\xaa\xac\x1b\x5b\xaa\xb4\x4a\xdf\xbb\x03\x9b\x46\xbd\x0e\xa6\xc0\x65\x15\x3f\x94\xf9\xea\xa3\x52\xf6\xb5\xcc\xc1\xac\x8c\x85\xa2\x1b\x49\xe8\xba\x7c\x61\x2e\xb5\xaa\x2b\x34\x77\xe8\x88\x01\xad\x47\xf0\x87\x2a\x9d\x34\x3b\x94\xe1\x7f\x0c\xe8\x53\x15\xa1\x81\x77\xb2\xac\x6f\x3f\xac\x77\xe1\xed\x2c\xb8\xe4\xb0\x04\xc4\x7e\xad\x04\x7e\x51\x2b\xaf\x3d\x40\xdb\xeb\xc0\x7a\x0c\x5d\x76\xa0\x8e\xd6\x62\x92\x0e\x20\xc3\x8a\x81\x34\x55\x45\x35\xd6\x6a\x26\x73\xe8\x4c\x8f\x55\x2a\x72\xb7\x0c\x8a\xea\x07\x89\x3c\xb7\x4d\x9c\x6e\x7a\xc6\x75\xbf\x0b\x4d\xfa\x5b\x96\x99\xfa\xdb\x74\xec\x11\xe7\x85\x11\x2f\x35\x64\x50\xba\x5c\xd1\xa4\x82\xb4\xc3\xa9\x7c\xac\x3b\xb8\x83\x7c\x08\xba\xb4\x0f\xbd\x1d\xf7\x04\xa5\x6e\x88\x9b\x8d\xdb\x3d\xba\xae\xb8\x3c\x8d\xcb\xd3\xb8\x3c\x8d\xcb\xd3\xb8\x3c\x8d\xcb\xd3\xb8\x3c\x8d\xcb\xd3\xb8\x3c\x8d\xcb\xd3\xb8\x3c\x8d\xcb\xd3\xb8\x3c\x8d\xcb\xd3\xb8\x3c\x8d\xcb\xd3\xb8\x3c\xad\x9b\xf2\x34\x63\x33\x19\x51\x2e\x80\x49\x48\xad\x1f\xf9\xa1\x4c\xe1\xb8\x8f\x7d\xa0\xbd\xef\xc1\x18\x77\x98\x5b\x14\xbe\x46\xf8\x95\x47\xba\x70\xfc\xb3\xe4\xac\x5d\x1d\x57\xf0\x4b\x95\xd7\x05\xbc\x82\xa5\x8c\xaa\x82\x8c\x66\xfd\xd8\x50\x9c\xad\x7b\x1c\xa7\x24\xe8\x59\xc2\x2f\x34\x20\x1f\x8e\x09\x52\xc9\x03\xd9\xf5\x8e\xb8\x02\x80\x8a\x6f\xf1\x7c\x7d\xa3\xe0\xeb\x6a\x8d\x53\xd2\xef\xc2\x75\xb8\x53\xf5\xde\xf4\x40\xab\x4a\xcc\x11\x65\x6b\xa7\x6d\x67\xf7\xc5\x36\xb8\x0e\xc4\xf9\xe6\x6d\x44\xae\x3f\x77\xaa\x07\xdb\xe7\x5f\xdc\x56\xba\xa3\x3e\xe0\xbc\xdd\xde\x92\x7e\x00\x67\xf7\xb7\xd2\x37\xb2\x9c\xbf\x92\x11\x53\x15\x3d\x49\x71\xd3\x13\x21\xda\x48\xa1\xc6\x88\x33\x2b\xcd\xcb\xf5\x09\xeb\xa3\x5e\xfb\x3e\xdf\x09\xc2\x80\x5e\x46\xe4\x5f\x22\xe3\x13\xc2\x20\x63\x95\x53\xc5\xd5\x42\x44\x0e\x10\x17\x7e\xe3\x23\x0e\x42\xb0\xc8\x74\x11\xea\xc9\x08\x77\x15\xab\x0f\x06\x84\x4e\x17\x70\x2c\x85\x38\xba\xc6\x47\x89\x34\x2b\x4d\xf8\x01\xe6\x51\x43\x83\x52\x7c\xce\x61\x02\xda\xd1\xa8\x77\xb2\xbc\x09\x92\x69\x1c\xaa\x81\xca\xbd\xed\xaf\x45\xfe\x72\xb7\xb5\x53\xd0\x43\x23\x66\x3e\xde\x0d\x08\x3d\x0f\xbe\x27\x5a\x39\x23\xe7\x10\xa7\xa0\xa8\x3a\x98\x53\x1b\x28\x94\xcb\x23\x0e\x12\x17\x7d\x3a\x66\x3c\xa8\x92\x05\x9a\xa7\xbf\xd6\x2a\xf2\x7d\x06\xbc\xec\xb7\xdb\x61\xce\xe4\xfc\xbd\xa8\xde\xc2\xea\x23\x20\x12\x26\x34\xdd\x71\xdf\x1b\x88\xa6\xa0\x64\x73\x43\xa1\x9e\xe4\x9d\xd9\xe0\x4a\x91\xd3\x74\x08\x43\xd4\xf1\x64\x75\xf7\x49\xfa\x37\xb0\xea\x45\xdd\x1a\x8f\x3d\xfe\xfd\x99\x49\xc8\xb3\x27\xa2\xfd\xa2\x92\x7f\x82\x36\x88\x55\x28\x72\xbd\x5b\x8b\x07\xb3\x30\x43\xdc\x23\x1a\xbd\xdb\x8f\xaa\x6b\xed\xdb\xed\xcc\xf0\xfa\x09\x69\xe1\x7e\x47\x52\x5c\xb5\x38\xe9\xb4\xf7\xfb\x99\x5c\x4a\xa3\xa2\xd7\xe6\x88\x4a\x03\x76\x0a\x84\xcd\xf8\x3e\xd6\x1a\x81\x90\x4e\xf0\x55\xfc\x56\xab\x1c\xfe\x6d\x66\x4f\x44\x9b\x69\x9c\xd8\x6e\x50\x5d\xfb\x30\x03\xa9\x06\xcb\x18\x92\x31\xe4\x8f\x89\x21\x91\x4d\x60\xa4\x70\x6a\x89\x1b\x28\x97\x71\xa4\xb7\xb3\x35\x8a\x3d\x47\x46\x38\x37\x0a\xc7\x86\x75\x24\x24\x4e\x84\xc2\x81\x50\x38\x0f\xa4\xc5\xb9\x50\x03\x33\x79\x3b\xea\x75\x24\xcb\x4d\xc4\x64\x8d\xfa\x6e\x34\xaa\x23\x77\x2a\x0b\x31\x8f\x98\x43\xc4\xcc\xad\x9f\x38\xae\xf3\x3c\x3c\x7f\x83\x7e\x76\x2e\x67\x90\xae\xd2\x98\xad\x02\x70\xf6\x52\x29\x63\x27\xee\x15\xe2\x51\xaf\x1b\x63\x8d\x7f\x87\x98\xe6\xf9\x24\xef\x12\xa3\xc2\x38\x91\x0a\x51\x19\x1e\x91\xe5\x13\xbc\x97\x4b\x37\xc1\xb8\x77\x3e\x08\x27\x86\xe4\x3d\x5d\x32\x6d\xa3\x11\x2e\x4d\xc8\x25\x16\x34\x41\x22\xac\xa5\x5e\x51\x30\x43\x14\x3f\xa2\x7d\x97\x97\xc8\x51\xd0\x79\x2d\xec\x7b\xbd\x84\xd3\x8d\x7b\xd3\x8b\x68\x49\x97\x72\x41\x97\x70\x39\xb7\xc5\x35\x51\xec\x3b\xbf\x64\x1a\x80\x37\x75\xc4\xcb\x68\x44\x86\x89\x7c\x5f\xf3\x3b\x8c\xe1\x6c\xd5\x1d\x58\xf5\xe9\x9b\x12\xb2\x81\x4a\xc3\xc4\xc6\x6e\x3b\xcb\x34\x8d\x69\x1a\xd3\x34\xa6\x69\x4c\xd3\x98\xa6\x31\x4d\x63\x9a\xc6\x34\x8d\x69\x1a\xd3\x34\xa6\x69\x4c\xd3\x68\x69\x1a\xe2\xe6\x5c\x2e\x81\xcf\x99\xe7\x73\xe6\xf9\x9c\x79\x3e\x67\x9e\xcf\x99\xe7\x73\xe6\xf9\x9c\x79\x3e\x67\x9e\xcf\x99\xe7\x73\xe6\xf9\x9c\x79\x3e\x67\x9e\xcf\x99\xe7\x73\xe6\xf9\x9c\x79\x3e\x67\x9e\xcf\x99\xef\xee\x9c\xf9\x38\x2a\x83\xb0\x2d\xa7\xbc\x11\x62\x8e\xe6\x7e\x58\x87\xbc\x7f\xb5\x7a\x8c\x70\x8b\x78\x05\xc1\xaa\xc8\x2e\xb2\xfc\x3e\x8e\x1d\x03\xda\x9f\xba\xc7\x9f\xbe\x10\x31\xdc\x1f\x2d\xc2\x4a\x2b\xab\x52\x15\xfd\xee\x4d\x06\x33\x51\xe7\x76\xd4\xbf\x7a\x39\xee\x66\x0c\x98\xd8\x97\x7c\x69\x8c\xbd\x23\x07\xc0\x78\x42\xef\xb6\x6a\x95\xbc\x0a\xce\xab\xe0\xbc\x0a\xce\xab\xe0\xbc\x0a\xce\xab\xe0\xbc\x0a\xce\xab\xe0\xbc\x0a\xce\xab\xe0\xbc\x0a\xce\xab\xe0\xbc\x0a\xce\xab\xe0\xbc\x0a\xce\xab\xe0\xbc\x0a\xde\xdd\x2a\xf8\x6e\x5f\xbe\x88\x91\xe3\x5c\x5d\x2e\x0b\x19\xb3\x1c\xee\xbe\x22\xcb\xe4\x66\x2b\x9e\x31\xda\xdd\xa2\xfc\x15\x8d\xb7\x22\xf1\x55\x27\xb7\x97\x66\x2b\xae\x15\xe9\xe1\x9c\x67\x06\xc3\x6a\xc9\x6a\xf9\x84\xd4\x12\x71\xb3\x81\xb4\xd6\xd2\xae\xdc\xe1\x1e\x70\x6b\x8f\xed\xe3\x45\x9e\xab\xbf\xc7\x5a\x2e\x65\x0e\x73\xb8\x30\xa9\xc8\x11\xc7\xd6\x61\xb7\x4e\x4b\x45\x25\x3e\xcb\x5c\xc6\x9b\x25\x4e\x1a\x5b\x17\xd1\xe1\xfa\x27\x81\x3d\x53\xac\x6b\x65\x5a\x55\x3f\xba\x14\x50\x1e\xc1\x69\xe2\xd6\xa8\xb2\x6e\x6c\xa9\xd2\x2a\x5d\x9f\xdf\x39\xea\x75\x30\x05\xbb\x03\x20\x3f\x2a\x65\x5f\xcb\x1c\xcc\xca\x58\x28\xba\x91\x84\xae\xcb\x17\xe6\x52\xab\xba\x42\x73\x87\x8e\x18\xd0\x7a\x04\x7f\xa8\xd2\x49\xb3\x43\x19\xfe\xc7\x80\x3e\x55\x11\x1a\x78\x27\xcb\xfa\xf6\x43\xdc\x79\x7a\x54\xc1\x25\x87\x25\x20\x36\x27\x25\xf0\x8b\x5a\xc5\x6c\x78\x49\xd8\x81\x75\x13\x5d\x76\xa0\x8e\xd6\x62\x92\x0e\x20\xc3\x8a\x81\x34\x55\x45\x35\xd6\x6a\x26\x73\xe8\x4c\x8f\x55\x2a\x72\xb7\x0c\x8a\xea\x07\x89\x3c\xb7\x4d\x9c\x6e\x7a\xc6\x75\xbf\x0b\x4d\xfa\x5b\x96\x99\xfa\xdb\x74\xec\x11\xe7\x85\x11\x2f\x35\x64\x50\xba\x5c\xd1\xa4\x82\xb4\xc3\xa9\x7c\xac\x3b\xb8\x53\x6b\x08\xba\xb4\x0f\xbd\x1d\xf7\x04\xa5\x6e\x88\x9b\x8d\xdb\x2a\xb9\xae\xb8\x3c\x8d\xcb\xd3\xb8\x3c\x8d\xcb\xd3\xb8\x3c\x8d\xcb\xd3\xb8\x3c\x8d\xcb\xd3\xb8\x3c\x8d\xcb\xd3\xb8\x3c\x8d\xcb\xd3\xb8\x3c\x8d\xcb\xd3\xb8\x3c\x8d\xcb\xd3\xb8\x3c\xad\x9b\xf2\x34\x63\x33\x19\x51\x2e\x80\x49\x48\xad\x1f\xf9\xa1\x4c\xe1\xb8\x8f\xb5\x42\xcf\xc1\xbe\xdc\xbd\xe6\x19\xb7\x1c\x87\x70\x2a\x0f\xac\xe7\x3d\x18\xe3\x4e\x4e\x8b\xc2\xf7\xb4\x5d\x38\xfe\xc1\x6d\xd6\xae\x8e\x3b\xf1\x4b\x95\xd7\x05\xbc\x82\xa5\x8c\xaa\xc2\x8c\x5e\x75\xc0\x42\x81\x6c\xdd\x63\xcc\x69\xfb\x88\x59\xc2\x2f\x74\x20\x1f\x8e\x09\x92\xc9\x03\xd9\xf5\x8e\xb8\x02\x81\x8a\xaf\xf1\xeb\x05\x1b\x05\x5f\x57\x8b\x9c\x92\x7e\x17\xae\xc3\x9d\xaa\xf7\xa6\x07\x5a\x55\x62\x8e\x28\x9b\x3b\x6d\x3b\xbb\x2f\xf6\xc1\x75\x20\xce\x37\x6f\x11\x41\xfd\xb9\x53\x3d\xd8\x3e\xff\xe2\xb6\xd2\x1d\xf5\x01\xe7\xed\xf6\x96\xf4\x03\x38\xbb\xbf\x95\xbe\x91\xe5\xfc\x95\x8c\x98\xaa\xe8\x49\x8a\x9b\x9e\x08\xd1\x46\x0a\x35\x46\x9c\xae\xfe\xe3\x45\x2e\x85\x09\xf3\xdf\x11\xe1\x22\x3e\x50\xb8\x3e\x3a\x19\x1e\x33\xac\xa1\x2c\x39\x5e\xaf\x65\x75\x44\x7d\x3e\xb2\x9a\xfd\x3e\x7e\x39\xea\xb5\x1d\x50\x9c\xae\xfc\x01\xd6\xf9\x87\xe3\x3c\x6c\xfc\xfb\xab\xe3\x3c\x28\x14\x1d\x44\xa9\xc5\xfe\x2c\xef\xc9\xfa\x38\xfa\xa7\xeb\x13\xe2\xb0\xd2\x29\x98\x8a\x4b\x69\xec\xd7\x27\x9e\xee\x04\x08\x3d\x0f\xbe\x27\xaa\x8b\xc8\x99\x8b\x9d\x06\x64\xfd\xce\xa9\x0d\x14\xca\xe5\x11\x07\x19\xaf\x76\x4f\x80\x29\xa1\x4a\x2d\x68\x9e\xfe\x5a\xab\xc8\xf7\x30\xf0\xb2\xdf\xee\x59\x39\x93\xf3\xf7\xa2\x7a\x0b\xab\x8f\x80\x48\xf4\xd0\x74\xc7\x7d\x6f\x20\x9a\xba\x92\xcd\x0d\x85\x7a\x92\x77\x46\xad\x6b\x83\x45\x4e\xd3\xa1\x50\xe4\x42\xc5\xa2\xbe\xfc\x24\xfd\x1b\x58\xf5\xa2\x6e\xc5\x84\xf3\x2f\x3f\x33\x09\x79\xf6\x44\xb4\x5f\x54\xf2\x4f\xd0\x06\xb1\x7a\x45\xae\x77\x6b\xf1\x60\x16\x74\x88\x7b\x44\xa3\x77\xfb\x51\x75\xad\x7d\xbb\x1d\x25\x5e\x3f\x21\x2d\x4c\x71\x69\xb5\x56\xa6\xbd\xdf\xcf\xe4\x52\x1a\x15\xbd\xa6\x47\x54\xd2\xb0\x53\x20\x6c\xa6\xfa\xb1\xd6\x08\x84\x74\x82\x5b\x08\xb4\x5a\x9d\xf1\x6f\x33\x7b\x22\xda\x4c\xe3\xc4\x76\x83\xea\xda\x87\x99\xf5\x22\x03\x63\x48\xc6\x90\x3f\x26\x86\x44\x36\x81\x91\xc2\xa9\x25\x7c\xa0\x5c\xc6\x91\xde\xce\xd6\x28\xf6\x1c\x19\xe1\xdc\x28\x1c\x1b\xd6\x91\x90\x38\x11\x0a\x07\x42\xe1\x3c\x90\x16\xe7\x42\x0d\xcc\xe4\xed\xa8\xd7\x91\x2c\x37\x11\x93\x35\xea\xbb\xd1\xa8\x8e\xdc\xe9\x3a\xcf\x33\xea\x1d\x71\xe6\xf6\x99\xa5\x0e\x8a\x0d\x73\x39\x83\x74\x95\xc6\x6c\x71\x80\xb3\x97\x4a\x19\x3b\x71\xaf\x3e\x8f\x7a\xdd\x18\x6b\xfc\xbb\xcf\x34\xcf\x27\x79\x07\x1a\x15\xc6\x89\x54\x88\xca\xf0\x88\x2c\x9f\xe0\x7d\x62\xba\x09\xc6\xbd\xab\x42\x38\x31\x24\xef\x17\x93\x69\x1b\x8d\x70\x69\x42\x2e\xb1\xa0\x09\x12\x61\x2d\xf5\x8a\x82\x19\xa2\xf8\x11\xed\x3b\xc8\x44\x8e\x82\xce\x6b\x61\xdf\x47\x26\x9c\x6e\xdc\x1b\x6a\x44\x4b\xba\x94\x0b\xba\x84\xcb\xb9\x2d\xae\x89\x62\xdf\x55\x26\xd3\x00\xbc\xa9\x23\x5e\xa2\x23\x32\x4c\xe4\x7b\xa6\xdf\x61\x0c\x67\xab\xee\xc0\xaa\x4f\xdf\x94\x90\x0d\x54\x1a\x26\x36\x76\xbb\x5c\xa6\x69\x4c\xd3\x98\xa6\x31\x4d\x63\x9a\xc6\x34\x8d\x69\x1a\xd3\x34\xa6\x69\x4c\xd3\x98\xa6\x31\x4d\x63\x9a\x46\x4b\xd3\x10\x37\xe7\x72\x09\x7c\x3e\x3e\x9f\x8f\xcf\xe7\xe3\xf3\xf9\xf8\x7c\x3e\x3e\x9f\x8f\xcf\xe7\xe3\xf3\xf9\xf8\x7c\x3e\x3e\x9f\x8f\xcf\xe7\xe3\x1f\xf3\x7c\xfc\xff\xb1\x77\x05\xd9\x8d\xe3\x38\x74\xef\x53\xf4\x05\xbc\x99\xd9\x65\x97\x49\xaa\x7a\xf2\xa6\xaa\xda\x93\x54\xaa\xd7\x8c\x44\xdb\x7c\xa6\x44\x35\x49\xd9\x71\x9f\x7e\x1e\x65\xcb\x4e\xa6\x6b\xd1\xc2\x87\x48\x2b\x66\x9c\xad\x44\x12\xfc\xf8\x00\x01\x10\x8a\xb0\x88\xdc\x80\x38\x37\x20\xce\x0d\x88\x73\x03\xe2\xdc\x80\x78\x52\x0d\x88\x69\x89\x33\x40\xb7\x02\xbb\x10\xc4\x4c\x3e\xfb\xa1\x84\x7c\xba\x5a\xbd\x00\x68\x11\x07\x08\x0a\x91\xde\xb2\x3c\x2c\xa8\x6b\x80\xf9\x34\x0c\x3f\x7d\x21\x22\x99\x66\x58\x84\x8d\x35\xde\x14\x86\x7c\xf7\xa6\x94\x4b\xd1\x6a\x7f\xf3\xcb\xf7\xbb\x45\x9a\x35\x20\xb6\x6f\xfe\x5e\x19\x67\x91\x0d\x20\x72\xa0\x7f\xe7\x47\x68\xe5\xfc\xbc\x12\xcd\x7c\x23\xf7\x04\x56\x42\xc5\x30\x3f\xc1\x88\x61\x19\x07\xa1\x54\xa2\x19\xf8\xae\xd0\xf5\x56\xe5\xc4\x40\x4e\x0c\xe4\xc4\x40\x4e\x0c\xe4\xc4\x40\x4e\x0c\xe4\xc4\x40\x4e\x0c\xe4\xc4\x40\x4e\x0c\xe4\xc4\x40\x4e\x0c\xe4\xc4\x40\x4e\x0c\xe4\xc4\x40\x4e\x0c\xe4\xc4\x40\xba\xc4\x40\xdf\xaa\x90\xb0\x72\x8c\xea\xb4\xaa\x14\x25\x43\x10\x7e\xa2\x2c\x55\x48\x4f\x09\xbd\x80\xe9\x16\xe2\x2b\x1e\xb6\x62\xe1\xaa\xc9\xb5\x17\x1d\x85\x5a\x41\x86\x0b\xcc\x2c\x5d\x86\x65\x86\xe5\x05\xc1\x12\x78\xd8\xc9\xa2\xb5\xca\xef\xc3\xf7\x4e\xe4\xab\x8f\xcd\xf1\x42\x6b\xb3\x5b\x58\xb5\x55\x5a\xae\xe4\x27\x57\x08\x0d\x7c\x01\x10\xed\x26\x57\x88\x46\xbc\x28\xad\xe8\x6a\x89\x49\xe3\x48\x11\x09\xe3\x9f\x0c\xfa\xcc\x11\xd7\x2a\xad\x69\xae\x5d\x0a\x10\x23\x04\x24\x1e\x95\xaa\x4c\xa3\x4b\x8d\x35\x45\xf7\x29\xd4\x9b\x59\x82\x2d\xe8\xbf\xa5\xf9\x68\x8c\xff\xac\xb4\x74\x7b\xe7\x65\x95\x46\x12\xb6\xad\x6f\xdd\xaf\xd6\xb4\x0d\x7c\x76\x48\x74\x02\xea\x56\xf0\xcd\xd4\x41\x9a\x09\x65\xf8\xec\xa4\x9d\xaa\x08\x9d\xfc\xa2\xea\xf6\xf5\xb7\xae\x0b\x6f\x32\xe3\xa2\xe5\x56\x02\xfd\x5a\x19\x78\xd1\x1a\x4a\x0f\x50\xc6\x09\x74\xaf\x48\x39\x81\x96\x8c\x62\x96\x09\x80\x66\xc5\xc9\xa2\x30\x55\xb3\xb0\x66\xa9\xb4\x4c\x86\x63\x53\x08\x1d\xc2\xa0\xd0\x3c\x58\xe4\x79\x7c\xc5\x74\xd3\x33\x61\xfa\x29\x90\xb4\x53\x75\x69\x76\x2e\x31\x23\xae\x2a\x27\xee\xac\x2c\x65\x1d\x72\x45\x4f\x8d\x2c\x12\x6e\xe5\xcf\xa6\x83\x7d\xc8\x87\x61\x4a\x27\xd3\x9b\x78\x26\x10\xdc\x80\x87\x5d\xe8\x1e\xdd\x36\xb9\x3c\x2d\x97\xa7\xe5\xf2\xb4\x5c\x9e\x96\xcb\xd3\x72\x79\x5a\x2e\x4f\xcb\xe5\x69\xb9\x3c\x2d\x97\xa7\xe5\xf2\xb4\x5c\x9e\x96\xcb\xd3\x72\x79\x5a\x2e\x4f\xcb\xe5\x69\xb9\x3c\x2d\x4d\x79\x9a\xf3\xa5\x22\x94\x0b\x20\x09\xa9\x6e\xc8\xdf\xea\x42\xc6\x1d\xf6\x0d\x7a\xbf\x4a\xe7\xc2\xc7\xdc\x48\xfe\x35\xc0\x2b\x3f\x99\x42\xfc\x6f\xc9\x79\xbf\x8f\x2b\xf8\xad\xd1\x6d\x25\xef\xe5\x56\x91\xaa\x20\xc9\xa7\x7e\xd4\x14\x97\xdd\x8c\x69\x20\x81\x77\x09\x0f\x34\x80\x83\x23\x46\x6a\xfe\x46\x76\xb3\x88\x11\x00\xc8\xbe\xd1\xcf\xeb\x07\x80\x77\xd5\x1a\x53\xc2\x77\x15\x26\x9c\x14\xde\x87\x19\x58\xd3\x88\x15\x50\xb6\x36\x6d\x3d\x3b\x17\xdb\x60\x13\xa0\x71\xf3\xd1\x22\xb7\x2f\x49\x71\x70\x1c\xff\xd3\x6b\x63\x13\xcd\x01\x63\xbb\x93\x26\x5d\x01\xd9\xed\x8c\xdd\xa8\x7a\x75\xaf\x08\x5b\x45\xde\x24\xda\xf6\x10\x44\x4b\x14\x2a\x45\x9c\xb5\x29\xe5\xd0\x9c\x30\x49\x80\x61\xa0\x27\xa9\x65\xe1\xcd\xa0\x3d\xc3\xab\xed\x89\xfb\x4d\xda\x04\xb3\x95\x76\x2d\x45\x19\x77\x85\x84\x68\x07\x12\xe1\x00\xa2\x1a\x13\xb9\x27\xc0\x18\x70\x21\xc1\xa8\xb1\x52\x56\x5d\x4d\xcd\xf0\xcf\x7c\x93\xf6\xa6\xb1\xca\x84\x1b\x04\x37\xb3\xf1\xe3\x0d\x34\xe0\xf5\x33\xbc\xd3\xc2\xb9\x28\x8c\x75\x6a\x6a\xf4\xab\xf0\xc3\x34\x92\xe0\x4c\xd3\xdd\xe8\xc2\xd4\x07\x86\xfc\x4e\x2a\x61\x8b\x6e\x0d\xdf\xcd\x77\xd0\xb3\x24\x55\xa2\x99\x45\x2b\xbb\x8a\xa1\x48\xda\x67\xdb\x3a\x04\x00\xe3\x41\x3b\xb4\xa3\x28\x5b\x2d\x6d\x9c\xd1\xe8\xd7\x93\xa8\x6a\xb1\xa4\xd5\xea\x23\x81\x60\xba\x3d\x3d\x4e\xf6\x6e\x2d\x6a\x6a\x2c\x8e\xb4\x2f\xd8\xbd\x86\x34\xb2\x42\x6e\x31\xd0\x8f\xa8\xe4\x9b\x0b\x69\x84\x84\xdd\x53\xa0\x9b\x22\xe0\x6e\x02\x19\xc0\xc8\x7d\x04\x68\x50\x6a\xc5\x38\x34\x28\xf5\xde\x01\x30\x28\xd1\xea\xa2\xb7\x0c\x20\x14\x32\xdc\x2c\x98\x1a\x36\x68\xee\x58\x7f\x98\x8b\x86\x89\xb6\x69\xb4\xac\x64\xed\x85\xee\x8c\xde\xe0\xdd\x25\x06\xaa\x11\x22\x46\xa8\x98\xe6\x7e\x86\x9f\xdb\xbb\xc2\xeb\x58\xf2\x41\xd4\x0d\x89\x5c\x43\x6a\x06\x95\x9d\x42\x23\x53\xb5\x8d\x1c\x69\xa5\x96\x93\x92\x35\x95\x0e\x5c\xec\x4e\x10\x02\x44\x9e\x7b\x40\x10\x30\xf8\xee\xfe\x60\xf8\x44\xef\xfb\x00\xa3\x13\x21\x47\x7a\xcc\x49\x1b\xd2\xe3\xb7\x45\x11\x92\x1f\x43\x17\x4b\x5a\xa4\x93\xfe\xdf\xc6\xf9\xa0\xc5\xb7\xee\xf3\x7f\xef\xbf\xdd\xcc\xc6\x3e\x82\xb8\xb5\xb0\x72\x61\x4d\xe8\xbc\x17\xd6\xe8\x1a\x51\xc8\x08\xc3\xb6\x2f\xa5\xa9\x84\xaa\x47\x97\x29\x5b\x4d\x17\xd5\xcc\xd3\x0c\xbc\x37\x5a\x5a\x31\x98\xe6\x08\x26\x9a\xce\x8a\x72\xb9\x94\xc5\xe0\xa3\x32\x71\x1f\x0f\xff\x1b\xb9\x8f\x3a\x5e\x10\x8d\x18\x98\x56\x82\x07\x3d\xef\x3d\xb9\x68\x2f\x9d\x4f\x4a\x74\x9b\xc8\xe2\x82\x4c\xc2\x30\x0f\xc4\x9b\xc6\x68\xb3\xda\x3f\x35\x21\x7e\x7f\x67\x6a\xe7\xad\x50\xb5\xbf\x5c\x0d\xd5\xe2\x45\x6a\x4a\x6a\x14\x1d\x38\xfc\x2a\xe1\x8b\xae\xe4\x41\x3a\x47\x71\xd7\xc8\xf2\xe2\x5a\x00\x40\x39\x0c\xc0\xe6\xa1\x21\xe6\x89\x74\xda\x0d\x08\x13\xdc\x4f\xc6\x95\xd0\x28\x80\xeb\xa8\xd6\x9f\xbc\x36\x92\x3a\xf6\xfc\x04\x0a\xe2\x0b\x88\xc4\xc9\x25\xbf\x8e\x1d\xbe\x04\x7e\x22\xa2\x01\xaf\x6d\x60\x44\x14\x24\x4c\xe0\xe1\x4a\xbc\x3e\x6d\xe4\x0e\x72\x10\xfe\xf9\x8f\xa8\x0e\x42\x6f\x45\xff\x13\xd9\x95\xdb\xad\x65\xfd\x5c\x3b\xe1\x95\x5b\x2a\xf1\xa2\x65\xc4\xd1\x69\x44\x31\xef\xb7\x77\xe0\x53\x6f\x24\x3c\xf0\xc9\xbf\xc8\x68\x16\x01\xc7\x34\x1e\xe1\xf9\x50\x16\x55\x58\x98\xa8\x38\xbe\x8e\x75\xa8\xc9\x1e\xb4\x58\x82\xe9\xa5\x3b\x50\x62\xe7\x3e\x69\xe1\xbc\x2a\xfe\xa5\x4d\xb1\x79\xf2\xc6\x12\x34\x0e\x73\xe0\x96\x8e\x56\xb8\x02\xe9\xfa\x71\xe2\xc2\x7a\x45\x2f\xf9\xc6\xe8\x19\xa5\x68\xbc\x68\x9b\x16\x15\x3a\xff\x1d\xd0\xfd\x70\x9f\x60\xef\xe8\x4e\xdd\xfc\x34\xed\x98\x2e\x80\xf8\xb3\xb5\xf2\x5e\xb9\x4d\x6c\xed\x2a\x44\xb1\x56\xf5\xea\xab\x29\xd3\xa8\x58\xa9\xdc\x86\x1a\x83\x66\x19\xfc\xf9\xf1\x21\xc9\xd8\x09\x69\x6d\xa3\xea\x32\xc9\xc0\x29\xe9\x08\x61\x84\x1e\xa4\xc4\x47\x9f\x1f\x1f\xa2\x73\xc9\x67\xa5\xa3\x5b\xea\xb4\xd6\xc6\xc9\xc2\xca\xc1\x29\x1e\x36\x6c\x77\x39\x98\x44\xa3\x23\xd8\x3e\x8b\x8d\xf2\x70\xbf\xe8\x98\xf8\x2e\x64\xb3\x5e\xba\xd8\xe0\xae\x4c\xad\xbc\xa1\x36\x06\x83\x02\x66\x20\x32\xa9\x87\x33\xbc\xc1\xd3\x84\xcd\x45\xcf\x27\x34\x1e\x65\x58\xfc\x61\xf8\x47\x49\xec\x52\x82\x81\x1d\xbd\x6d\x0a\xaf\x1e\x22\x08\xa4\x10\x32\x21\x13\xf7\x0c\x33\x8b\x28\xaa\x42\xd5\xa5\xb4\xb1\xb9\x34\xa1\xef\x7b\x09\x9c\x92\x95\x9a\xa8\xd4\x39\xa0\xf0\xb7\x03\x0a\x85\xa9\x97\x6a\xf5\x55\x34\xb1\x75\xbb\x94\x4b\xd1\x6a\x4f\x0f\x28\xa4\x8f\x9a\x01\xce\x1a\xe4\xe7\x7d\x94\x44\x77\x45\xde\x7b\x2e\x04\xf0\xe0\x00\x75\x7f\xd9\x44\x4a\x27\x1f\x8e\x14\x75\x43\x6b\x19\x01\x12\x18\xc7\xc9\x85\x6e\xed\xc0\x4d\x33\x5d\x6d\xb3\xd0\x29\xbc\x0c\xc4\x68\x38\x75\x33\x8b\xcb\x5a\xa5\x55\x5b\x69\xaf\x2d\x02\x1b\x9a\x5d\x2c\xda\x17\xad\xdc\xfa\x29\xbb\x84\x88\x4b\x98\xd6\x9f\x3f\x38\xa4\xb7\xde\x5b\xf5\xd2\x0e\x6c\x02\xf0\x11\x8b\x60\x10\x2f\xf9\xc0\x04\xb3\x88\xf3\x2d\xcd\xae\xde\x09\x5b\xde\x2e\x1e\xa2\xd3\x5e\xf6\x92\x53\x7a\xc9\x4b\x25\x75\x49\xe6\x5c\xbe\x79\x84\x9f\x68\xd4\x0f\x69\x1d\xd0\x58\x8e\x4d\xf7\xfb\x5f\x27\x1e\xa4\xd7\x1a\xf3\x8c\x50\xff\x37\xfc\xe6\xe7\x55\x01\x6f\x81\xd8\x31\x9f\x8f\x46\x3a\x1f\x9d\xbf\x5f\xff\xf9\x82\x14\xbb\x30\xb5\x17\xaa\xa6\xdf\xb1\x63\x97\x52\xf8\x2f\xd5\x56\x39\xac\x08\x9d\xa1\x81\x7a\xaf\x93\x3c\x10\x7c\xff\x36\x06\x21\x4d\xf0\x83\xe5\x3f\x2d\x4b\xe4\xea\x05\xff\xff\x6a\x76\x21\x68\xe6\xb1\x0b\xfd\xa2\xd2\x9a\x05\x74\x2d\xd3\x8d\xd3\x00\x83\x87\x26\x78\x7b\x52\x93\x4f\x8c\xe4\x2b\x59\xaa\x16\xfa\xd2\x34\x19\xfb\x4e\xfd\x29\xbf\xa8\x4a\x11\xae\x6c\x82\xbc\xcd\xc1\xd6\x0c\x1c\x3d\x31\x66\x66\xe7\x63\x44\x5d\x9a\xb5\xac\xa4\x15\x3a\xb6\xbe\x1c\xe2\x24\x77\x5a\xa8\xea\xbb\xac\x1a\x2d\x3c\xd1\x84\x60\xd3\x08\xbf\x4a\x7a\x51\x0a\x2f\xa8\xcf\x43\x1b\xd0\xff\x1c\xf4\x09\x5a\x5c\x08\xe1\x27\xba\x2f\x21\x85\x08\x04\xf4\x1a\xf0\x34\xcf\xc4\x8a\x3c\x96\xe8\xfc\x17\x10\xf2\xc4\xe0\xea\xf0\x6c\xd5\x31\x4c\x40\x6a\xc3\x37\xa2\xa8\x91\x4a\xe2\x91\x26\x84\x05\xb3\xd9\x27\xc4\xe1\xa0\x06\xa3\x19\xe4\x0c\xbe\x82\xd4\x55\x88\x99\xf6\xde\x1e\x21\xdc\xa5\x28\x96\x0e\xce\x14\xfc\x16\xde\x28\x3e\x93\xc7\xf6\x73\xdf\x8b\xe7\xb4\xcd\xe4\xcd\x4d\xda\xb7\x1b\xd9\xd3\x1b\x49\x03\x7b\x62\x92\x2e\xc3\x3e\xc3\xfe\x8a\x60\xcf\xf4\x22\x47\x6e\xc5\x32\x8e\x01\xe3\x69\xd1\xc2\xee\xd1\xf3\x2e\x92\xa5\xba\xed\xed\x8f\x59\x85\x79\xda\xbb\x8c\x3a\x41\xbc\xed\xcb\x28\x18\x19\x69\xb5\x5c\x27\x41\x5e\x57\x1e\xaf\xc7\x63\x6d\x1f\x33\x02\x3b\x72\xcb\x1e\x6e\x31\x13\xc3\x59\x61\x45\x2f\xdb\x46\x30\xbd\xc8\x79\x63\xc5\x8a\xf6\xf5\x89\xd1\x44\xd5\x7f\xcd\xb0\xbc\xa4\xe9\x5c\x88\x74\xe0\x7d\xc7\xb8\x6e\xde\x85\x37\x67\xd1\x67\x0e\x3c\xbc\x24\x44\x63\x27\x7b\x05\x4a\xb7\xb9\x9f\x09\xa9\x9f\x89\x17\x76\x25\xfd\xef\xbf\x7f\x23\xda\x0c\xc8\x65\x82\x99\x01\x33\xc9\xbb\x9d\x2a\xaf\x6e\xd9\x08\xa3\x68\xf9\xfa\xa3\x33\x0a\xb1\x99\xe5\x3a\x2b\xea\x0d\xad\x9b\x3c\xbf\x5b\x08\xae\x03\x02\x5d\xbe\xe0\x3a\xe9\x0b\xae\xd3\xaa\x9e\x5f\x86\x8e\x70\xd2\x46\xa7\x37\xe1\x85\x4b\xd8\xe7\x25\x64\x86\x9d\xf4\xcf\xcf\x49\xae\x21\x03\xfb\xb5\xea\xda\xe6\x3b\xe5\xbc\xac\x7d\x8a\x56\x63\x09\xad\xc3\xe4\x1b\xf9\x35\x65\x32\xc0\xa7\x34\x28\x08\x23\x1e\x44\x16\x55\xc3\x94\x7f\x94\x8d\x89\xad\x57\xa5\xb2\x5d\x3e\x62\x9f\x08\x1e\x8d\x71\x2a\xe1\xf0\x5b\x45\xbf\x3b\x03\x0d\x8e\x80\xf3\x2c\xb5\xa8\x00\xd5\xad\xf3\xd2\xc6\x6f\x9e\x25\xeb\xb2\x31\x43\x3f\xb2\xc0\xb4\x4d\xc8\x0d\x95\x2b\xa5\xce\xd3\x76\xcd\x22\x55\xa1\x03\x98\xee\x3e\xe3\x48\xda\x60\x0c\xd2\xc9\x40\x45\xfd\x76\x64\x42\xb6\x8b\x8d\x09\xe5\x12\x74\x32\x28\xd6\xa2\xb9\x6d\xfd\xfa\x5e\xb9\xc2\x6c\xa5\x4d\xa2\xf5\xe7\x69\x3c\x1d\x3e\xd8\x92\x66\x12\x09\x5d\x7d\x55\x2b\xaf\x42\x4a\x34\x99\xc3\xac\xfe\x48\xe1\x8d\x1c\x51\xff\x50\x07\x03\x2f\x0a\x99\x64\x0a\x13\x4e\x2d\x34\xc6\x7a\xa1\xaf\x2e\xc4\x9d\x03\x86\x13\x0e\x18\xf6\x29\xa9\x45\x87\xdd\x9b\x59\xf4\x15\x20\x4e\x81\xfa\x63\x38\x5e\xe6\xbf\xe8\x96\xf2\xd4\x5b\x31\xcd\x22\x6e\x11\x0d\x5c\xc0\x96\xd4\x4b\x77\x35\x8e\x70\x6a\xe2\xb2\x69\xd2\x6c\xd1\x1d\xf1\xf9\x71\xad\x31\x15\xa7\x39\x45\xaa\x7f\x9c\xaf\x0e\xc6\x46\x76\x11\xee\x2b\xe6\xb8\xeb\xc0\xb8\xeb\x49\x6a\x51\xf1\xb2\x36\xde\xd4\xd7\x9b\xdf\x28\x27\xd7\x14\xb7\x29\xe3\x36\xc4\x0d\xfe\xfd\xce\xd8\x44\x45\x19\x09\xb1\x91\xd6\x4c\xe6\x96\xcd\x7f\xbb\x65\x73\x63\x4d\x78\x4a\x96\xb1\xc1\xf9\x01\x9a\xd1\x41\x77\x4a\xa1\xd3\x3b\x26\x7a\xb8\x59\x37\xef\x44\x60\x69\xb0\xbe\x84\x6f\x4d\xcc\xb7\x87\x40\x46\xe6\xee\x07\xc7\xa9\x88\xdc\x8a\xc9\x77\x5a\x1c\x75\x13\xe8\xb6\x62\x9c\xbb\x3d\xc4\xa3\x1a\xa3\x35\xe2\x0d\x32\xa2\x21\x91\x91\xb6\x1e\xeb\xd3\xcd\xe7\x25\xb1\xee\x17\xd4\xd6\x96\x9f\x7b\x3f\xae\x3d\xe1\xe8\x22\x3b\xd6\xdc\x78\x3b\xcb\x8e\xa0\x7a\x23\x74\x9b\x1d\x71\x96\x7c\xf6\x81\xaf\x17\x2d\x33\x6d\x64\x7f\xe4\x82\xfc\x11\xde\x8e\xb6\x63\x92\x0c\x6b\x97\xdb\xd1\xa4\xc9\xd8\xf9\x76\x84\x16\x25\x3d\x2b\x70\x83\xfb\xfd\x7b\x59\x85\x39\xf1\xa6\x25\x91\x5a\x97\xbc\x57\xe5\x0b\xd6\x0e\x6e\xfb\xd6\x2f\xf9\xf2\xcc\x1b\xdf\x4a\x3f\xea\x19\x8d\x65\x42\x87\xba\x8e\x9b\x59\x7a\x53\x95\xe3\x59\x39\x9e\x95\xe3\x59\x39\x9e\x75\x35\xf1\xac\xff\xb1\x77\x7d\xcd\x6d\xe3\x48\xfe\x5d\x9f\x22\x0f\xf7\x60\xef\x94\x72\x57\x93\xab\xab\x3b\xbf\x4c\x39\x4e\x3c\xe7\x8b\x9d\xa8\x24\x65\xf7\x21\x9b\x42\xc1\x64\x8b\xc2\x0a\x04\x78\x00\x28\x5b\xa9\x7c\xf8\x2b\x90\x94\x9d\xdc\x64\xcd\x6e\x10\xb0\xec\x09\xad\x7d\x98\x6c\x91\xbf\x06\x1a\x8d\xfe\x47\x74\xe3\xcf\x96\xcf\xf2\xa7\x4f\x44\x06\xa7\x59\xa6\x6b\xe5\x96\x7a\x03\xea\x29\xd8\x15\x5e\xe7\x02\xd4\x70\xaf\x2e\xda\xbe\x87\xdb\x4a\x18\xee\x13\x9a\x0b\xc8\xb4\x0a\x6d\xd7\xf0\x43\xed\xfb\x1f\xff\x3e\x79\x2a\x7a\x37\x86\xc6\x8d\xc4\xf3\x18\xbe\xe4\x60\x2f\x32\xc2\x1e\x1b\x0c\x31\xc4\xf1\x1c\x40\xfc\x7f\x6b\x7d\xbd\x0b\x69\x25\x3f\x4c\x07\x14\xe1\x7d\xb8\x9f\xf5\x59\x0e\x03\x85\xb0\xee\x40\x85\x9e\x0e\x14\x57\xee\x20\xa4\x6b\x7b\x90\x83\x9e\xfb\xc3\x33\x07\x20\x1d\xae\xd8\x7c\xe8\xdf\x4a\x49\xc0\xab\xed\x7c\x1f\x53\x83\x98\xeb\xfc\xb1\xb5\xc7\x01\x8f\x82\x89\x92\x17\x87\xa1\xbc\x81\x9d\x1f\xf3\x41\x68\x97\x5a\xf9\xfa\xf4\xa0\xc5\x1a\x98\x3e\x18\x38\xf2\x61\x86\xd5\x9f\x79\xd4\xf2\x20\x2c\x1f\x6b\x8a\x9e\x71\x4d\xd1\x81\xcc\xdd\x10\x9b\xd3\x28\xb6\x80\xf7\xf6\xaa\x61\xf2\x88\x2c\xb6\x19\x97\x70\xf1\xe1\x64\xf2\xb8\x72\x79\x40\xb3\x53\x70\x07\x37\xfc\x30\x6e\x63\x65\xb4\x83\xcc\x07\xc5\x6f\x74\xc9\x85\x3a\xc8\x20\x46\x75\xf8\x8c\xd5\xa1\xb5\xf2\xad\xe2\xd7\x32\x44\x33\x45\x59\xbe\xb6\xfd\x72\xf8\xe9\xec\x81\x0c\xec\xda\x3f\xcf\x0e\xe5\x4b\xd8\x9d\x75\x70\x98\xbb\x16\x87\x76\x75\x3e\x98\x3d\xec\x34\x6e\xc0\x9b\x77\xca\x22\xe4\xdd\x66\xa5\x26\x8f\xb8\x3f\x43\xbf\x32\xfe\xf4\xf5\x12\x03\xa2\x9a\x41\x01\xd1\x70\x63\x32\xf8\xb3\xe7\x40\x9d\x10\xe7\x13\xe7\x70\x09\x88\x23\x07\x71\x52\xea\x11\x58\x1a\xae\xed\x86\x1f\xbb\x1f\x94\x82\x1f\xe8\x5d\x0c\x0d\xf1\x87\x9d\x60\x8f\xe3\x5a\x1e\xc8\x40\x0e\xb1\x1b\xad\x4f\xa3\xed\x63\x9b\x8e\x03\x46\x61\x63\x10\xf2\x8c\x83\x90\x83\x7a\xa2\xdf\x92\xb7\x15\xcf\x9e\xd9\x66\xdf\xda\x6a\x0d\x06\x7e\xba\xc2\xef\xbb\xc0\x4d\x8a\x6c\x77\xf1\xe6\xf0\x63\x38\xb0\xf8\x86\x97\x98\x1c\x2c\x8e\xbb\x1f\xf8\xe3\x6d\x98\xb0\xf1\x06\x5c\x30\x1c\x38\x42\xba\xbf\x44\x24\x44\x7a\xbc\xae\x72\xee\x60\xe1\x0c\x77\x50\xa0\x6c\x2b\x55\x9f\x18\x2d\xa5\x50\xc5\xc7\x86\xd0\xc9\x24\x9d\xd2\x2a\xf9\xed\xa2\x36\xb4\x0f\x84\xe4\x12\x88\xf0\x22\x87\xe0\x32\x86\x68\xa7\xfc\x4b\x7e\xfb\x51\xf1\x2d\x17\xd2\xa7\x03\x47\x36\xfd\x90\x4d\xc4\xcd\xd6\xbd\x40\x02\x47\x4f\x8d\x30\x16\xe4\xa3\xa8\xc7\x72\x61\xbd\x80\xbc\xbb\x63\xe7\xb9\x90\xee\xe1\x6f\x6a\x38\xf7\x1a\x9a\x3c\xf4\xc7\xca\x3a\x03\xbc\x8c\x85\x97\x47\x00\xba\x75\x86\xff\xb5\xbb\x92\xaf\xee\xe9\xf3\x8d\x48\x20\xe1\xd5\x57\x0e\xd6\x09\xc5\x71\x77\x3b\xdc\x97\x2c\xfd\xeb\xcb\x5f\xfe\x65\x12\x51\xda\xf0\xc1\x15\x8e\xa1\x9d\xf7\x84\x2c\x1b\x4a\x35\x2f\x9c\x2b\x30\xfd\x76\x11\x7a\x9e\x44\x54\x05\x91\x76\xe2\xc3\x6e\xc0\xaa\xd9\x77\xa7\x37\x0f\x4a\x11\x56\xd6\xae\xfc\x45\xa4\x0f\x3f\x42\x60\x2d\x6f\x4f\x33\x33\x91\xe3\x20\x31\x02\xc3\x4b\x11\x17\xef\x4b\x3c\x2c\xc8\x7e\x65\x42\x59\xc7\x55\x06\x51\x07\xf9\x1d\x30\xc6\x96\xe0\xa1\x7d\x6b\x79\x4c\xda\x00\x8f\x28\xca\xdc\xb2\x2d\xae\x26\x1f\x2d\x4a\x95\x11\x5b\xee\x80\x89\x2a\xde\x40\xb7\x55\x16\x71\x95\x50\x5b\xba\xdd\xac\xf7\x46\x33\xc6\x9e\x3d\x55\x4a\x3b\x8e\xba\x19\x0e\xcd\xee\xd7\xf5\x6a\x05\x86\x2d\xc4\x17\x88\x86\xf9\xe6\xfd\x82\xcd\xc1\x19\x01\xf1\xc6\xe9\x31\xff\xc6\x85\x63\x4b\x51\x42\x3c\xd4\xba\x2c\x77\xec\x0a\x1c\x8f\x06\xf9\xee\x3f\x17\xd3\x4b\x5d\x14\x42\x15\x2f\xdf\xde\x66\xb2\xce\x21\x09\xf6\x8c\x1b\xc4\xc9\x26\x3c\x34\x40\xc5\x2e\x75\x11\x0f\xb0\xbe\x06\x76\x76\xca\xce\x85\x84\xe8\xa0\x98\xe4\x07\x0d\x74\xc9\x0b\x36\x33\xb0\x12\xb7\x91\x71\x7d\x81\x4f\x02\x26\x7c\x9c\x5f\xc6\x05\x2c\xc1\x71\x56\x19\x90\x9a\xe7\x2c\xe3\xd9\x1a\x58\x2e\x22\xca\x57\x7d\x0d\x12\x1c\xf3\x5d\xab\xa3\x81\xe2\x2e\x52\x47\xc3\xc5\xf5\x89\xae\xc0\x14\x10\x75\x4b\xdd\x21\xb2\x77\xb0\x4b\x80\xba\x34\xa2\x8c\x0c\x1b\x59\x4b\xcd\xa1\x80\xdb\xd8\xa0\x1f\x2d\xb0\xff\xd1\xb5\x41\x7c\x77\x24\x61\xfa\x7d\x2a\xc1\x45\xc3\x74\xd2\xbe\xcc\xe1\xba\x8e\x27\x50\x1e\x71\x0b\x46\xac\x62\x49\x13\xce\x35\x92\xb5\x7d\x70\x9b\x61\x8f\x13\xe0\x92\x4a\x2b\x6d\x7c\xff\xb0\x0f\xfd\x57\xea\x62\xfd\xb0\x79\x1b\x48\x32\x9e\x6d\x98\x01\x5b\x69\x65\x21\x96\x73\xe9\xd1\x9d\xd9\xb1\x4b\x51\x8a\x78\xa2\xb3\x00\x95\x33\xe4\xa5\xc2\xf8\x91\x2e\x79\x3c\x51\xf4\x6e\x1d\xe3\x96\xf9\x1b\x5f\x0a\x30\xb1\x06\x89\x12\xc8\xc2\xf4\x7c\x05\x8c\x2b\x90\x42\x55\xb5\x5b\x72\x21\x63\xc8\x62\xe7\xbf\x9f\xad\x6b\xb5\x89\xeb\xc5\x77\xc8\x57\xfc\x36\x72\x74\xf0\x3a\x22\x14\x5b\xec\x54\x16\x0f\xaf\xb9\x03\x98\x61\x8e\xc2\x85\x60\xb2\xf3\x3e\xcd\x47\x42\xee\xa2\x8b\xb8\xce\xf0\x45\xa1\xb4\x01\xf6\x41\xe6\x11\x2d\x6c\x5c\x77\xa5\x64\xaf\xeb\x55\x64\x05\x79\x55\x4b\x27\xa4\x50\x10\x1f\x31\xf2\xaa\x47\x76\x7e\x5a\x38\x76\x2e\x8c\x75\x51\xe7\xdf\x01\xbf\x3f\x99\x44\x39\x80\x89\xa6\x8b\xcb\xa5\xfa\x5f\xd4\x7d\xe3\xc1\xa2\xba\xe5\x73\x58\x19\xb0\xeb\xc6\x20\x9a\x6d\x44\xd7\x74\xee\x73\x48\xd0\x24\x53\xa2\x61\x2e\x36\xc2\x67\x0f\x54\xc1\x2e\x85\x02\x1b\x0d\x37\xaa\x87\xc1\x0b\xd6\xc4\x0f\xd1\x10\xbb\xe3\x20\x2f\xf1\x79\xda\x38\x0e\xb4\x14\x5b\x50\x60\xed\x9b\xb6\xd3\xfd\xd9\x1a\xb2\x07\xaf\x07\xc1\x39\x4b\x52\x17\x97\xb0\x85\x07\x05\x0d\x35\x8b\xd2\xa7\xfe\x32\x1b\xc3\xbb\x11\xb1\x85\x1f\x73\x36\x18\x0f\x86\xc8\x65\x60\x5d\x47\xbc\xfb\xd8\xf1\xaf\x04\xb7\x86\xda\x92\x73\xc2\xfd\x3e\xf3\x5d\x8f\x9a\xab\xb6\x9e\x30\x15\xee\x59\x73\x4f\x40\x1f\x3a\x56\x54\xfc\x8f\xe7\xb9\xf0\xac\xe0\x12\x97\x19\xfa\xfe\x9d\x19\x81\x12\x49\x4e\x08\x5b\x7b\xff\x5b\x6b\xa5\x0d\x7e\x12\xf8\x25\xb8\xdf\xa0\x73\x90\x1e\x5f\xa8\x02\x45\x02\x69\xa7\xa9\x4b\xd6\x2d\x42\x86\xfb\xe4\x1d\xc8\xfa\xae\xc6\xa1\x96\x35\x7a\x44\xa1\xfd\x82\xf0\x7b\xf8\xfe\xcf\x60\xac\xd2\xa0\xc9\x1b\xa8\x24\xcf\xa0\x04\xe5\x92\xd2\xb1\x50\x71\xc3\x11\x3a\x63\x18\x95\xe6\xb3\x3b\x7e\x77\x90\x05\x38\x78\x64\x78\xe7\xf3\xfe\xaf\xbd\xcd\xb1\x99\xcd\xc9\x24\xd9\xd8\x48\xda\x87\x36\x0d\x33\x2a\x92\x51\x91\x8c\x8a\x64\x54\x24\xc3\x14\x09\x1a\xd8\x89\x12\x74\xdd\x2b\x7f\xc8\x99\xa1\xc8\x76\x21\xcd\xa2\xed\xdd\x78\x32\x19\xae\xb2\xfc\xe7\xd6\x9c\xf7\x9f\x3b\xa0\xa8\x40\x8e\x8f\x05\x9e\xae\xe7\x2b\x9f\xb5\xe7\x8e\x7e\xd4\x56\x90\x45\x5d\x7b\x29\x75\xc6\x1d\x5c\x6a\x9e\xbf\xe6\xd2\x9f\x1f\x33\xef\x75\x0e\xfe\x7b\x7b\x82\x10\x22\x93\xb5\x75\x60\x2e\x66\x27\x93\xc8\xec\xbe\x43\x8e\xed\x4c\x04\x2d\x3a\x56\xff\x7e\x77\xdc\x5c\x0a\xeb\xa6\xdd\xfb\x4e\x97\x22\xeb\x05\x80\x5b\x07\xfe\xfb\xf3\xb3\x9a\xf5\x7e\xd0\xd8\x42\x28\xd2\x50\xf6\xe0\x4b\xc3\x57\x2b\x91\xb5\x25\x57\xd1\xa9\xac\x81\x4b\xb7\x6e\x12\x68\xfb\xcd\x72\x32\x21\x79\x74\xaf\x7e\x45\x8f\x09\xeb\xcb\x09\xf5\x18\x53\x17\xd5\x39\x2f\x85\x14\xf0\x8c\x24\x6e\xf0\x3e\xeb\x26\xbd\x4b\xc4\x53\xf9\x8d\xf2\x3d\x93\xdc\xda\xa4\x14\x2e\x66\x49\xe1\x17\x8d\x73\x3c\xe7\xaa\x78\x4e\x22\x52\x61\xed\x1d\x61\xb8\x14\x3b\xdc\x59\xe3\xaa\x9a\x19\xed\x74\x46\xe9\x32\x44\xe4\x0a\xbd\x0c\x3c\x84\x00\x41\x2b\x86\xe9\xc6\x10\x0d\x79\xbf\xd4\x4f\x72\x5c\xe4\x95\xef\x5a\xf0\x9c\xbc\x58\x9e\xcd\x52\xae\x66\x9b\x6a\xa2\xad\x27\xb1\xd8\x2f\xb4\xd4\x2f\xb0\xd0\x2f\x4a\x99\x1f\xad\x46\x78\xda\x7c\xf7\x41\x3d\x4a\x08\x1c\xa8\x7a\xee\x47\xa6\xb0\xe4\xd5\x74\x03\x3b\x94\x96\x42\xcf\x62\x7a\x27\xcf\x41\x63\x6a\xe7\x54\xf2\xaa\xf7\xed\xaa\xbe\x96\xc2\xae\xdf\x6b\x37\x07\x9e\xef\x4e\xf3\xdc\x80\xb5\x90\x20\x78\xb1\x20\x21\x43\xe6\x96\x9e\x66\x90\x6c\xc1\xfa\xa2\x9b\xd3\xd5\x4a\x28\xe1\xe2\x3b\x32\xff\x0f\x1f\xf7\xa5\x2e\xc4\x54\x66\x52\x80\x72\x38\x4f\x26\x04\xff\x9b\x7c\x51\xc0\x35\x12\x61\x36\xe3\x45\x90\x0a\x0c\xd2\x15\xc8\xc7\x9d\xae\xb4\xd4\xc5\xee\x1d\xec\x62\xfb\x46\x09\x5d\x39\xcc\xb1\x0a\xe2\x10\x90\x5c\x43\x3d\xa6\xc0\xdd\x68\xf3\xe0\xc1\x0b\xac\xb4\x66\x5a\x29\xc8\xdc\x12\x97\xd8\xa4\x48\x25\x5e\x12\x37\x00\x15\xf7\x27\x4b\x4e\x26\xb1\x74\xed\x1d\xe4\x45\x2e\xe1\x69\x4c\xee\x8a\xdf\xce\x21\xdb\x65\x12\x0e\x31\x10\x94\x5c\xe9\xda\x55\xf5\xc3\x31\x0c\x62\x7f\xe2\x15\x65\xff\x13\x14\x34\xff\x3b\xfd\xdb\x82\x9d\xd6\xfd\x07\x6d\xc8\xdb\x77\x0f\x3e\x87\x02\xfd\x95\x2f\x04\x5e\x4b\x60\xa7\xf3\xf7\x49\x08\x74\xc7\xaa\x31\x47\xaa\x83\xf0\xcf\xa4\xae\x73\x02\xfb\x69\x4b\xeb\x7f\xa5\x2f\xf5\x3e\x37\xba\xc4\xbe\x10\x42\xe4\xbe\xcb\xda\x3b\xd8\x91\x1b\xad\x85\xd1\x0b\x6e\xc7\x49\x5c\xa2\xf0\x08\x3e\x02\xc1\xf0\x16\x8b\x34\x27\x3b\x3c\xc0\x0a\x6f\x82\x89\xd2\xaf\x11\x5e\xda\x72\x59\x13\x56\x2d\x60\xb5\x1a\x0a\xe3\x1e\x1b\xf7\xd8\x4f\xba\xc7\x88\xcc\x6f\x8d\x1e\xb6\x2b\x20\x11\xfc\x77\x50\xe0\x1b\xa2\xa5\xc2\xff\xef\xe5\x72\xc6\x66\xdc\xda\x1b\xe4\xe2\x8d\x26\x7b\x34\xd9\xa3\xc9\x1e\x4d\xf6\x68\xb2\x47\x93\xfd\x6c\x4d\x76\x63\xf5\x3e\x22\x4a\x19\xc3\xd0\xb5\x75\x49\x80\x2f\x54\x5b\x74\xbb\xe4\x05\xa6\xdc\x2f\x90\x46\x0e\xb7\x49\x90\x2f\x75\x61\x1d\xb7\x6b\xf6\x86\x3b\x38\x6f\x53\x6d\x49\xe9\x3c\x06\x0d\x5c\xdb\x9e\x20\x1a\x98\x12\xd1\x30\x60\x51\x01\xa6\xdc\x36\x0c\x1c\xfd\x81\x97\x08\x3c\x6f\x4f\x7f\xb3\x37\xda\xd9\x24\x04\x16\x75\x55\xf9\xaf\x7e\xcc\xb7\x19\x67\xf8\x0e\xdb\x44\x32\x29\x37\xaf\xcf\x73\xa7\x03\xf7\x9d\x2a\xd8\x5b\x63\xb4\x49\x82\xef\xa4\x4d\x85\xfb\x32\xe3\x6c\x85\x68\x83\x15\x8e\x6f\x5c\x5a\x02\x1b\xd8\xa5\x25\x80\x6b\xc5\x13\x00\x4f\xb0\xe5\x5d\xb7\x9c\xa5\x3e\x2d\x0a\x03\x05\xae\x04\x83\xe2\x02\xad\x9d\x43\xdc\x36\x4e\xf3\x54\x13\x5a\x99\x64\x8e\x44\x32\x25\xfd\x71\x7e\x91\x04\x37\xd3\x65\xa3\x9b\x93\x80\xaf\x81\xe7\x80\xbd\xd4\x38\xfc\x4c\x06\x79\x5c\xa4\xbd\xb3\x17\xef\x31\x99\x33\x26\x73\xc6\x64\xce\x98\xcc\x19\x93\x39\x63\x32\xe7\xe7\x48\xe6\x34\x56\x2f\x59\x32\xe7\x1f\x56\x2b\xe6\xef\x8b\x61\xdd\xa1\x9c\xb4\x44\xd0\xdb\x63\x0c\xae\xc6\xe0\x8a\x14\x5c\x6d\xf8\x6a\xd3\x5b\xf1\x4b\xd5\xec\xaf\x8d\xde\xa0\x5d\x67\x22\xe3\x12\x06\x56\x57\x60\x2d\x2f\xd2\xa5\x4a\xbe\xc1\x67\xe7\x02\x64\x9e\x84\x8a\xcf\xf6\x58\xc7\xcb\x8a\x25\x64\xd5\x3d\x91\x64\x79\x25\x5d\x89\x2c\x2d\x7a\x1a\x01\x35\x39\x72\x4f\x3d\xed\xb8\x11\xef\x3c\x4c\xf7\xfb\x7d\x12\x71\x00\x52\x6f\x44\x6c\xb5\xc4\x6b\xa7\xd9\x7d\x35\x08\xc3\xd7\xf5\x93\x99\xbd\x4e\x95\xa3\x69\xdc\x9a\x6a\x0c\xe6\xc7\x60\x7e\x0c\xe6\xc7\x60\x7e\x0c\xe6\xc7\x60\xfe\xa7\x09\xe6\xeb\x54\xc1\x7c\xe3\x07\x30\x6c\x99\x6a\x18\x7c\x22\x68\xa1\x92\x66\x20\xf0\xf5\xec\x44\x60\x03\xa5\xde\x42\x3a\x9e\x3b\x50\x1c\x75\xf9\x5f\x18\xfa\x98\x36\xf9\x33\xa6\x4d\x5e\xbc\xa8\x8d\x48\x80\x4b\xd0\x8e\x25\xe6\xbe\x27\x12\xf9\x06\x11\xd5\x64\x9c\x04\x8b\xb3\xb9\xf7\xf7\xa6\x7e\xe2\xd3\x2f\xff\x36\xfd\xaf\xcf\x47\x9f\xa6\xed\x7f\xb1\xcf\x7f\xd9\xff\x7f\xc7\xbf\x45\xbd\x2a\x16\x79\xa9\x4c\x78\xf0\x4d\x18\x0b\x69\xf1\xed\xab\x93\x49\x5c\xaf\x8c\xdf\x58\xaf\x63\xd1\x7a\x70\x0c\x16\xc7\x60\x71\x0c\x16\xc7\x60\x71\x0c\x16\xc7\x60\xf1\xa9\x05\x8b\xe8\x17\xbc\xd1\xb3\x90\xe1\x3f\x9a\x8e\x56\x6f\xb4\x7a\xa3\xd5\x1b\xad\xde\x68\xf5\x46\xab\xf7\x6c\xad\xde\x75\x9d\x6d\xfa\x6f\xf6\x0d\x5a\xd7\xfd\x31\xeb\x54\x0d\x6e\x40\xe5\x95\x16\xca\x25\x01\x37\xe9\x1a\xf3\x18\xdf\x94\x87\x9b\x34\xe0\xf6\x95\x77\x60\x52\x26\x77\xbb\xbb\xe2\x58\x86\x6d\x0b\x1c\x44\x01\x75\x67\x7b\x10\xba\xd3\x8e\xcb\x26\x97\xc9\x6c\xaa\xd6\x45\x75\xe5\xdb\x1b\x33\xe4\xfd\x0e\x61\x24\x2c\xb0\xaa\x76\xac\xdd\xe9\x09\x48\xe0\xd5\xe2\xb4\xd3\x22\x93\x68\xba\x09\x47\x7b\xda\xd8\xa7\x49\x04\x8a\x98\x66\x7e\x95\xce\x67\x46\x68\xe3\xbb\x48\x7a\xd1\xef\xab\xe9\x43\x31\xbb\xd2\xb6\xb9\xe7\x2d\xbf\x3e\x99\x0c\x77\x18\xa0\xac\xdc\xee\x4d\xff\xbe\xa1\x38\x20\x25\xe4\xa2\x46\x39\x53\x24\xe9\xf2\x5b\x0f\x75\xf1\x2d\xa9\x73\x2f\xbd\x67\x2f\xb9\x5b\xef\x7d\x6e\xfa\xe8\xef\xbf\x7c\x9d\x1e\xff\x76\x74\xf4\xc9\x67\xa3\x7f\x39\xfa\xfb\xcb\xe6\x3f\xfe\x72\xfc\xdb\xf1\xd7\xfd\x3f\x7e\x39\x3e\x3e\x3a\xfa\xf4\xee\xea\xf7\xe5\xec\xed\x67\x71\xfc\xf5\x93\xaa\xcb\x4d\xfb\xaf\xaf\x47\x9f\xe0\xed\x67\x24\xc8\x31\x26\xd9\x3d\xb8\x89\x30\x7a\x7f\xfa\x73\x4a\x0c\x73\x03\x25\x45\xd2\x30\x78\x64\x39\x4b\xd0\x7a\x13\xab\x18\xa7\xcd\x8c\x62\xb2\x7c\x36\x72\xfc\x11\x39\x5e\x6d\xa3\xde\x6b\x63\x9b\x5b\x08\xfa\x9f\xa3\xa1\xfa\x5f\x26\xb9\x28\xf1\x25\xe6\x24\xd6\xef\x17\x80\xe7\x1f\x94\x44\x07\x9f\xd4\x60\x0c\xbb\xc0\xfe\x37\xbd\x9f\x2f\xe2\x69\xf4\x6a\x63\xaf\x32\x0a\x59\x20\x9e\x65\x60\xad\xbf\x38\x1f\xf9\x02\xa9\x71\x71\xd0\x8a\xe2\x7c\x9e\x6f\xff\xfc\x1d\x5f\x0b\xb4\x0c\x87\xb0\xc9\xff\x78\x25\x7e\x37\xba\xae\xf0\x6f\x04\x4d\xfe\xc5\x8b\x8d\x50\x28\x71\x1b\x44\x84\x9a\x3f\x09\x20\x42\xd9\x3a\x5d\xde\x42\xa8\x1c\xfd\x70\xaf\x87\x1d\xb8\xd5\xfc\xff\x0c\xb4\x3a\xd1\xa6\x14\x28\xe9\x9d\x4c\xc2\xf3\xc3\x0e\xd7\x13\xfd\xd5\x3f\xfa\xa1\x78\xcf\xf5\x8f\xef\x12\x85\xf3\xc9\x7b\xb3\xd1\xfc\xda\x41\x52\xba\xdf\x64\x60\x47\x31\x1a\xc5\x68\x80\x18\x91\x5f\xa1\xdc\xee\x11\xaa\x1e\x9b\x73\x5e\x6f\x6f\xf7\x89\x5a\x92\x84\x12\x7d\x94\xe7\xf5\xe9\xc3\x8f\x93\x76\x6d\x6f\x04\xa2\xcd\xc7\x2b\x32\x6b\x82\x56\x62\xf0\x58\xe9\x1e\x64\xa8\xcb\x12\xfa\xc1\x65\x7a\xb7\x88\xa4\xd7\x02\xb6\x76\x28\x37\x9a\xdd\x47\xbd\xb5\x39\x86\x69\x09\x5c\xf7\x00\xd6\x90\x5f\xe9\xbe\x2f\xa0\x72\xac\x83\xa6\xb4\xd5\xb2\x2e\xc1\x47\x65\x89\x49\x24\x9c\x05\x81\xbb\xc8\x47\x51\x8f\x59\xc8\x6a\xd3\x73\x5d\x13\x56\xd5\x57\x3a\x5f\x74\x70\x67\x5a\x39\xb8\xed\x4d\x09\x53\x8c\xc8\xca\xa2\xc3\x49\xea\xed\xf6\x34\x4f\xab\x1b\xc8\xd9\x9a\xab\x02\x12\xdd\xda\x69\x6a\x75\xfa\x54\xe6\xdb\x8c\xe5\xbd\x56\x73\xad\x51\x39\x7e\x5a\xca\xa8\x41\xc7\xb6\xe9\x48\x3b\x51\x0b\x97\x42\xd5\xb7\x1f\xb0\xc7\xc7\x69\xf2\xeb\x7f\x12\xb6\x20\x13\x29\x90\xf6\x73\x74\x32\x70\x6c\x32\x38\x08\x1c\x5f\xd9\x45\x06\x47\x2a\xcb\x3b\x6d\xe8\xcf\x3a\xcc\x8c\xc6\x16\xb0\x90\x25\x40\x67\x5c\x36\x5f\x00\xf0\x34\x02\x66\xdd\xbd\x90\x06\x9c\xe2\xf5\xb5\x19\x8d\xe8\xeb\x54\x57\x95\x84\x12\x94\xe3\xb2\xd1\x92\x28\xee\x13\x7c\x6b\xaa\xa2\xa1\xaa\x1a\x9a\x93\x69\x77\x36\x73\x32\xf6\x1c\xa9\xa2\x4b\x4d\x82\x86\x1e\xf9\x4b\x48\x81\x16\xaf\x10\x72\xa6\xd3\x76\xe8\xa8\x67\x49\x92\x4e\x13\x94\x1b\xa1\x72\x7d\x63\x13\x5a\xb0\xa2\xb4\xfc\xcc\x40\x0e\xca\x09\x2e\x17\xc8\xcf\x2c\x41\x8b\xf5\x47\x52\x49\x83\x88\x3b\x47\xe4\x59\x39\xf9\xdf\x79\xdd\xad\x33\x7a\x66\x80\xbb\xde\x19\xe0\xbd\x34\xef\x56\xbc\xe6\x16\xf2\xd3\xe6\xe3\x97\x77\xed\x8d\x96\xb1\xa9\xd8\x74\x91\x03\x97\x52\xdf\xcc\x8c\xd8\x0a\x09\x05\xbc\xb5\x19\x97\xdc\x21\xcf\x04\xe2\x27\xe0\x7f\x19\xaf\xf8\xb5\x90\x02\xbb\x9f\xa8\xbb\x8f\xe7\x48\xd5\x45\x32\x04\x01\x82\x4c\x55\x4c\xfe\x97\x1b\x5d\x3d\xd7\xd1\x13\x36\xae\x5f\xd5\x4e\xd4\xf2\xf8\x12\x56\x19\x9d\x5d\xf9\xfe\x3c\x27\x93\xc8\x6c\xd9\x9f\x0c\xf0\x61\xde\xb9\x90\x60\x77\xd6\x41\x19\x7f\x06\x63\x68\x3b\x86\xb6\x63\x68\x3b\x86\xb6\x63\x68\xfb\xc3\xd0\x76\x74\xe1\x7f\x3e\x17\xde\x82\xd9\x8a\x0c\x4e\xb3\x0c\x63\xd9\x91\x03\x46\x51\xc7\x53\xc6\x4a\x99\xef\xb1\xd8\x54\xc7\x2e\xbe\x83\x5e\xea\x0d\xf4\x3a\xdc\x78\xf3\x2a\x4a\x5e\xc0\xac\x96\x72\xd1\xd4\xee\xf5\x4a\x3e\xd2\x9f\xa4\x6d\x25\x7c\x3a\x84\x24\x63\x68\xb1\xc1\x3b\xb1\x25\x38\xee\x4f\xfd\x9d\x4c\xe2\xcd\x9f\x2b\xa5\x1d\x47\x6b\xa9\xf0\x2f\x9d\x24\xe6\x91\xd8\x47\x69\xcd\xf5\x14\xc7\x8f\x7e\xd4\x1e\x70\x97\xf0\x4a\xfc\x15\x4c\xb2\xda\xc1\x95\xef\xa3\x9c\xec\x4e\x34\xfc\xd9\x52\x22\x70\x22\xdd\xd1\x2a\x25\x5b\xf1\x2c\x0d\xfa\xfe\xa0\x67\xca\x15\xad\x93\x34\x6d\x8b\xae\x55\x51\x80\x8e\x9b\x02\x5c\xdf\x85\x4d\xa8\x89\xb4\x50\x7d\x57\x34\x7d\x13\x46\xbe\xfa\x75\x32\x34\x7c\xec\xf9\xe8\x80\x55\x04\xa0\xf8\xb5\x84\x3c\x9e\xf9\x6f\xf5\x19\xc6\xcb\x43\xcb\x88\x5d\x73\x03\x39\xa2\xdd\x38\x12\x11\x13\x49\x4c\xf7\x9c\x19\x2a\x66\x75\x65\x9d\x01\x5e\xce\x81\xe7\xbb\xf7\xbe\x3a\xa1\xaf\xc6\x03\xc7\xeb\x2d\x37\xfe\xda\xcc\x3e\xf5\x8a\x60\x49\xef\x34\xaa\x87\x83\xc6\x1e\x12\x0f\xc2\xff\xf3\xfd\x6c\x77\x56\xea\xe2\x42\x55\xf5\x8f\x8c\xe3\x3f\x35\x87\x7d\x72\xff\x90\x72\xef\xe5\x55\xe7\x92\x9f\x4c\xc2\xf7\x1c\xd2\x35\xa3\xbb\x34\xbd\x83\x47\xac\xc6\xfe\x07\xb7\x0e\x8c\xe2\x72\x69\xf8\x6a\x25\xb2\xfe\x83\x34\x28\xe2\xbe\x5a\xfb\x35\x97\x5c\x65\x60\x2e\x66\x51\xe1\xda\x92\x99\xb9\x3f\xf5\xf3\x20\x9b\x10\x5e\x14\x8a\xf6\x43\x82\x7b\xff\xa7\x74\x0e\x8f\x6b\x14\xaa\x47\xa5\xd6\x97\xec\x41\xb0\xb2\x57\x1c\x5b\x35\x30\x64\xc7\x5d\xf7\xf8\x89\xa8\x05\x5f\xf1\xcc\x7f\x3b\xda\xf5\x35\xae\xc2\x81\x19\x5e\x02\x8b\xc0\x3d\x1f\x3d\xb6\xd7\x96\x74\x0b\x1b\x0b\x4e\x82\x2a\xdc\x9a\xc9\xbe\xda\x71\x14\x68\xc5\x8d\x7d\x70\xa6\x98\x55\xf4\xbf\x1c\x9a\x01\xf5\x27\x6d\x51\xc3\xfa\x0e\x91\xed\x8b\x2b\x62\x21\x83\x75\xa2\xf4\xd7\x43\x65\xb5\x31\xa0\x1c\x83\x2d\x28\x17\xcf\xcd\x82\xbb\x6a\x82\x68\xcc\xe8\x97\xa1\x00\x38\xb6\x12\xc6\x3a\xcc\xdd\xd5\x68\xe0\x0d\x40\xd5\x74\xfd\xc0\x74\x91\xc3\x73\xb4\xc9\x37\xb0\xf8\x32\xd6\xe4\xde\x9b\xf1\xc6\x1b\x6b\x59\x4b\x27\x30\x4c\x45\x18\x3c\xd2\x64\x70\x86\xcf\x3b\xa8\xaa\x96\x92\x35\xbd\x32\x58\x57\xfd\x13\x6d\xf6\x0d\x74\x73\xa8\x27\xfa\xb6\xed\xf0\x6c\x24\xbe\x62\x35\xdb\x10\x9d\x41\xe5\x1e\x55\x7f\x10\x39\x48\xd1\x25\x41\xd0\xa4\xed\x1f\xc6\x1c\xfc\x96\x0d\xc3\x27\xef\x8d\x01\x64\x48\xfb\x24\x68\x41\x3c\x9f\x52\xf6\xc2\x0a\x59\x6b\x1a\x78\x9f\x2b\x36\x08\xfd\x8b\x56\x89\xc0\x93\x8d\x7a\x57\x81\x4d\x82\x5c\xbb\x2c\x85\xa8\xbb\x5d\x05\x27\x2f\xf4\xf5\x3f\xe0\xff\xd8\xbb\x9a\xde\xd6\x6d\x2c\xba\xcf\xaf\xc8\x1f\xe8\xa2\x9d\x4d\x91\x5d\xf1\xd0\x02\x45\x81\xe9\xe0\x15\x98\x59\x12\xb4\x44\xdb\x42\x64\xd1\x43\x52\xf9\x98\xc1\xfc\xf7\x01\x65\x39\x6e\x3e\x2c\x9e\x43\x5d\xc5\x46\x1f\x9b\xac\x5e\x95\x43\xf2\xf2\x5c\x5e\x7e\xde\x33\xb1\xa8\xe5\xc2\x17\x41\x6a\xd8\x06\x28\x91\x39\x40\x84\x06\x14\x22\x42\x58\x1c\x50\xb4\x76\x08\x31\x61\x34\x80\x8c\xe8\x88\x0b\xed\xaa\xa4\x16\xe7\xd8\xa2\xdb\x9b\x07\xe3\xa4\x56\xa2\x87\x13\x03\xa5\xeb\x3a\x2e\x26\x24\x21\xe3\x65\x93\xb8\xd7\x26\x82\x19\xf4\x66\x3e\x86\xcc\x7e\x79\xa5\xa1\x94\x5c\x50\x95\xe2\x6f\x65\x5c\x10\x46\x6c\x9b\xb8\xde\x1c\x80\x75\x1f\xb6\x52\x1c\x8f\x36\x6a\x1e\x46\xc5\x63\xd9\x2a\x3f\x60\x47\x56\x20\x1e\xe4\x8c\xc1\xe9\xce\x63\x1e\x39\x59\x60\xb2\xb0\xd0\xfa\x3f\x92\x47\x22\x89\x82\xa6\x0e\x2c\xce\xbe\x84\x98\xac\xd9\xf9\x30\xf8\x18\x5f\x4b\xff\xfd\x78\x52\xf9\x81\x37\x9c\x5d\xfa\x4c\x36\xe2\x5c\x81\x1f\x37\xed\xbb\xdb\xea\x70\x95\xfd\xa5\x22\x37\x40\xd3\x7c\xd0\xa1\x7f\x53\xb5\xf3\x7e\x5d\xd9\x6e\xdd\x6c\xbe\x6c\x4d\x75\xff\xd5\xf8\xbe\xfd\xe8\x98\x01\xdd\x86\x9f\xf6\xa1\x89\x9e\xd8\x3b\xbb\x6a\xcd\x6e\x71\x33\x9f\x4a\xfa\xf2\xf1\x6d\xa2\xf3\xc1\xe7\xc3\xda\xbf\xfb\xc7\x78\x34\x62\xea\x3f\x65\xf8\x18\x5f\x98\xdf\xdd\xae\x75\xeb\xc7\x7f\xea\x57\xef\xb2\x11\x8d\x7d\x76\xfb\xdf\xff\xdd\x1c\xb9\x7c\x77\xfb\xf0\xfd\xca\x04\xfd\xfd\xf0\x89\xaf\xb6\x66\xf7\x72\x3b\xc6\xee\x4d\xf7\xd3\x3f\x7e\xfd\xe7\xdf\xfe\x78\xf5\xcf\xe7\xfa\xf9\xdc\x05\x87\x33\x36\x7c\x7f\xa5\xe0\xcc\x87\x1f\x5f\xda\xf9\xd0\x52\x1f\x25\x3c\x3b\x4f\xca\xe1\x3d\xc4\x97\xb6\xf7\xc1\xb8\xaf\x47\x5b\x45\x2d\xca\x9f\xda\x93\x2f\xbc\xfb\xab\x69\x02\xbe\x75\xa5\x73\x7f\xfd\xae\x95\xf1\xb7\x36\x6b\xdd\xb7\xe1\x97\xd6\x3e\xbe\xff\xbb\xf3\xed\x88\x3f\xeb\xa6\x0d\x67\x95\xc6\xcf\xd2\x3b\x8d\xfb\xd2\xaa\x6a\x7a\x6e\x8e\xa0\x1c\xed\xd3\x74\xbd\xed\xbd\x8a\x5b\x6a\xca\x45\x41\xae\xe4\x0d\xf8\x09\x9b\xbd\xfe\x59\xb7\xbd\xdf\xaa\xe8\x59\xee\x21\x9d\x73\x1d\x9b\x04\xbe\xec\x83\xec\xb5\x8b\x37\x45\x53\xb3\xac\x14\x45\x26\x90\xd1\xfb\x69\xb0\x3d\xe0\xaa\x02\x58\x2f\xfb\xa0\xca\x74\xb5\x74\xc7\x9d\xc0\x7d\xd0\x2e\x48\xc3\x77\x03\xdb\x3c\x86\x87\x10\x82\xe6\x02\x50\xc9\x23\x26\xf4\xfa\x13\x46\xf5\x66\xaf\xa1\xbc\x3f\x38\xe2\x70\xc7\x42\x35\xc3\x75\xe6\xf4\xe2\x88\xc2\x1e\x13\x8c\xab\xe1\x80\x40\x0c\xb5\xf7\xe6\x70\x36\x32\xec\x65\x0e\x7a\xfd\x72\x1e\x1c\xc1\x17\x72\xe0\x33\x41\xee\xf5\x4f\x6d\x6a\x2b\x32\x36\xd7\x46\xd5\x36\xa8\xce\xf8\xf0\xf1\xf4\x37\xcf\x3e\x23\xac\x34\x0f\x51\xdb\xf4\x7b\x09\xdb\xc4\x37\x23\xda\x61\x42\xad\x93\xe1\x96\x6e\xe7\xf4\x4c\xf3\xf5\x7f\xc3\x15\x73\x51\x7f\xdc\xe9\xa7\xb8\x6d\x6e\xf4\x4e\x70\xf0\x1c\x07\x90\xeb\xb6\xe5\xe1\x01\x8a\x54\xa3\x41\xb6\x06\x53\x85\x9f\x9f\x2a\x93\x7e\xf5\x82\x12\xb7\xd5\xdd\xa6\xd7\x1b\x73\xc5\x96\x8e\x1c\x5b\x3d\x07\xe3\xa5\x8c\x1d\xd3\x90\x3d\x49\x47\xfc\xf1\xba\xc6\xdd\x8d\x90\x7d\x4e\x33\x9e\x9c\x49\x2b\x50\xc0\x28\x2d\x1d\xf4\x46\xed\x9d\x59\x37\x4f\x62\xc8\x07\xff\xfd\xcc\x71\xdc\x74\xdb\x78\xf7\xec\xb7\x1f\x45\x7c\x42\xef\x1b\xb5\x81\xd2\xa5\x5c\xce\x29\x56\x46\x3b\xe3\x54\x88\xef\x85\x20\x19\x69\xb8\x0e\xa0\xb0\x36\x6a\x4c\x5a\x06\x90\x01\xce\x95\x25\x63\xcb\xc8\xc8\xc7\x49\xf4\x39\x76\x23\x56\xac\x90\x1c\xc9\x31\x7c\x3a\x97\xda\x4e\x94\xc9\x77\x09\x8d\x0a\x99\x9f\xc3\xd9\x75\x28\xeb\x53\x32\x7d\xc5\x07\x8a\x0f\x5c\xd0\x07\xe0\x4f\x2b\x5d\x6d\x8d\x72\x66\xed\x8c\xdf\xca\xcd\xa5\x5e\xc1\xaa\x07\xed\x1a\x28\x11\x0d\x5b\x00\xa2\x86\xc6\x62\x86\xd0\x0a\x42\x9e\x8e\x0f\xef\x6e\xe4\xbc\xb9\x84\xe3\x12\x8e\x4b\x38\x2e\xe1\xb8\x84\xe3\xbf\x5a\x38\x3e\xc4\x0b\x80\x16\x25\x5c\x94\x70\x51\xc2\x45\x09\x17\x25\x5c\x7c\xcb\xe1\xc2\x3a\xa3\xe2\x66\xe7\x78\xe7\xef\x8a\xb7\x3b\xe3\xa1\xa9\xec\xed\xea\xa6\x53\x2f\x39\x40\xa0\x1b\x94\x97\x6b\x7c\xd3\xa9\xbd\xad\xaf\xbc\x92\x27\xa1\x29\xd5\xbb\xa4\x67\xc2\x15\x38\xec\x6c\x21\xda\xe8\x38\xa4\x6f\x5f\x0e\xe4\xab\xad\x6e\xc0\x7d\x05\x64\xe8\x88\xd7\xc1\xd7\xcf\xca\xfb\x56\x0a\x13\x72\xe6\x8d\xb1\x8d\xc8\x31\xf6\x4a\x57\xf7\xf1\xf6\x4e\xdb\xac\x9c\x76\xcf\x62\x26\x1f\x2a\xa8\x7e\x50\xd1\x8d\x57\xda\x1b\x61\xe0\x85\x60\x5b\x6b\xef\xfb\x3d\x74\xa6\x0f\x23\x3b\x53\x59\x57\x7b\x21\x3f\xe6\x53\x53\x90\xd5\x85\x29\xc8\x0d\x16\xfe\xbe\xd9\xc7\x97\x0e\x4d\xb7\x51\xc3\xcb\xb8\x83\x59\x3e\xd7\x69\x9c\x11\xf1\x19\xdd\xd5\x42\xbd\x89\x96\x38\x9e\x79\x3e\x55\x6d\x8f\x4a\x39\x81\x35\xb8\xee\xb9\x25\xf5\x70\x32\xbb\x9c\x25\x27\x7d\xdf\x1d\xdb\x00\x7e\x4f\xb8\x1e\xe7\x80\xc7\xa6\x22\xb7\x28\x0b\x83\x0a\x83\x3e\x66\x10\x01\x8e\xc2\x82\xa3\x1a\xc8\x46\x8e\x87\x30\x03\x49\x4e\x50\xac\xa3\xb0\x71\xa6\x61\x1c\xc3\xd9\xb5\x40\xd7\x5b\x77\x91\x5e\x07\x19\x47\xd5\xa0\x8c\x82\xdf\xd0\x28\x58\xe2\x68\x89\xa3\x57\x17\x47\x31\x4a\x82\x64\xe4\x68\x08\x13\x90\xa4\x04\x45\x3a\x0a\x1b\x27\x1a\x46\x31\x9c\x5c\xe2\x3d\x0f\x01\x0e\x59\xe0\x26\xa3\x2d\xda\xe3\x66\xd7\x04\xd5\x74\x0f\xba\x6d\xe2\x9b\xaf\xb8\x88\x57\xc1\x2a\xe3\x9c\x75\x52\xab\xf9\xdb\xdb\xad\xf6\x5b\x35\x1c\xc1\xa8\x21\x8f\xf5\xdd\x8d\x50\xe7\x37\x5d\xb4\x53\xdc\xe1\x91\xbe\x03\x1d\x21\x91\xb3\x10\x18\x30\x99\xb9\x8f\x75\x54\x38\xbb\x1a\x55\xcd\xac\x4c\x7e\x74\x09\xf9\xd9\xb9\x70\xd2\xf1\x99\xb9\xa8\x26\xe0\x49\x9a\x32\x60\xf1\x4c\x7f\x74\x01\x74\xca\x2f\xce\xe2\x64\xe6\x3f\xba\xfa\x5c\x3a\x31\xae\xee\x2f\x4f\x36\xee\x6e\x44\xe7\x81\x54\x03\xd1\x40\x31\x23\xfb\x19\x67\x95\xbc\xcc\x67\x54\x9b\x47\x5c\x2f\x6c\x77\x66\x34\x9d\x3b\x2e\xe5\x58\x36\x67\x8c\xca\xb0\x2e\x3b\x5e\x65\x17\x91\x91\x4d\x30\xd7\x68\x6c\x56\xc1\xdc\x72\x32\xb3\x0b\xce\x2a\x2e\x23\xcb\x60\x66\x87\xd1\xd9\x06\xe7\x94\x93\xc1\x09\xbe\x10\xe4\x8a\xc1\xec\x52\xf0\x2c\x84\xb9\x85\x2c\xde\x0a\x3c\x2b\x61\x66\x09\x70\x76\xc2\x3c\x57\x21\xd6\x5d\x6c\x48\x25\x9d\x82\xb2\x0d\xe3\x08\x3c\x30\x4a\x1b\x1a\x19\x25\x3c\x07\xbc\x48\x6d\x51\x62\x53\xa8\x20\x99\x19\x1a\xc3\x04\x1e\xd6\x8d\xe7\x32\xff\xd0\xd3\x22\x6e\x4a\x44\x4d\xe3\x49\x9b\x66\x2e\x33\xe9\x52\xe6\x4c\xe9\xd8\x71\x89\x9d\xca\x91\x4d\x61\x22\x75\x16\x34\xb7\xec\xa4\x0b\xc9\x98\x1f\xb2\x3d\x90\xb1\xfc\xa4\x9b\xc1\xce\x3d\xd9\x36\x50\xcb\x50\x6a\x41\x44\x37\x95\x8b\x9c\xd9\xd3\x65\xd6\x42\xb9\xd3\x64\xb2\xf5\x23\xb6\x5f\xa0\x1f\xf8\xa5\xe9\xfc\xc5\x29\x6f\xe7\xbc\x51\x2d\xcb\xd6\xfc\x08\x37\xa3\x98\xcc\x85\x6a\xae\x01\x73\x16\xab\xb9\x65\xcd\x58\xb0\xce\x2a\x32\x73\xd1\x9a\xd9\x81\x59\x0b\xd7\x39\x65\x65\xf1\x24\xa7\x20\x74\x56\x3c\xbb\x24\x6e\x11\x9b\x5b\xd0\xa7\xb4\x86\x5b\xcc\x66\x96\x42\x2d\x68\xf3\x5c\x89\x5c\xd4\xb2\xc1\x99\x76\x1a\xd2\x4e\x9c\xa3\xe4\x80\xe3\x74\xca\x40\xc7\x1d\x82\x05\x5f\xac\xd6\x38\xf1\x49\x64\x98\xec\x1c\xcd\x09\x82\xa3\xd4\x1e\xb3\x60\x1d\x8f\x6b\x99\xf3\x65\xa4\xd6\xce\xec\xdb\xf8\xc0\xe8\x78\x44\xee\xcd\xbf\x7b\xd3\x9d\x97\xad\xcc\x29\x61\x48\xf7\xac\xf0\xb4\x92\x0c\x2a\x32\xe9\x40\x51\xa1\xde\xdb\x3b\xbb\x33\x61\x6b\xde\xe6\x0e\xcf\x9b\xfe\x62\xba\xe5\x79\xaf\x28\x08\x97\x80\x79\xbb\x33\xc1\x35\x55\xb2\x70\x70\xa9\xc0\x2d\x12\x56\x7d\x75\x0f\x88\x9f\xd3\x8d\x8f\xbf\xb5\xf1\xd5\x22\xc0\x4b\x85\x0b\x5c\xf0\x3e\xff\x11\x4e\x46\xb5\x08\x2a\xb1\xcf\x71\xaf\x23\x28\x31\x37\xb1\x22\xa9\x80\xcf\xce\x48\x32\xbc\xfe\xf9\x6e\xa8\xe6\x8d\xa0\xf5\xb1\x00\x04\x01\x8e\xf7\xaa\x76\xb6\x6e\xd6\x8d\x71\x12\x03\x63\xb5\xd5\x4e\x99\xae\xb2\x35\xb0\xc4\x83\x7b\x70\xef\x62\x12\x5f\x23\x9c\xa1\xba\x3c\x99\x8b\x4f\xe6\x4e\x13\x15\x2f\x68\xd9\x61\x76\x22\x65\x5a\x2e\xde\x2c\xbc\xd9\xbd\x54\x64\x18\x6d\x76\xe1\xb1\xef\x64\x3c\xb1\x2b\xab\x63\xc3\x2e\xc1\xed\xc7\x6d\x13\x4c\xdb\xf8\x20\x49\x6f\x66\x68\x1d\x14\x88\xe2\xe6\xa1\xcc\xe8\xaa\xfb\x60\x87\x6c\x05\x95\xf6\x41\x6a\xfa\x1c\xb3\xdc\xea\x55\x6b\x94\xeb\x57\xcf\x72\xa0\xc3\x5e\x66\x79\xe2\x2c\xfe\xc4\x79\x99\xf1\xba\x33\x8f\xe3\x35\x6b\x39\x06\x1c\x50\xd1\xdd\x17\x59\xef\xab\x75\x15\x44\x3c\xae\xc2\x13\x44\x02\x86\xae\x7a\x1f\xec\xee\xb8\x1d\x2d\x45\x65\xb8\x78\x9c\x65\x08\xbd\x2e\x57\xbb\xeb\xb7\xdf\x18\xf4\x76\xc0\x41\x18\x5c\xba\xd7\x6d\x12\xac\xe4\x5f\x2b\xf9\xd7\x4a\xfe\xb5\x92\x7f\xad\xe4\x5f\xfb\x66\xf3\xaf\x41\x9f\x79\xbd\xdb\x4f\x6b\x31\xa0\x44\x1c\xde\xd0\x01\x0c\x84\x09\x21\xaf\xa2\xb7\x37\xae\x32\x5d\x80\x55\x54\x10\x4c\x37\x8a\xe4\x8a\x35\x3b\x02\x26\x7d\x3e\x6f\xd1\x82\xb7\x0a\x64\x0f\xf8\x99\x0f\xb5\xed\x83\x04\xc9\x6c\x1f\xf6\x7d\x80\xce\x59\x41\x83\x63\x0d\xe8\x77\xb6\xb5\x9b\xa6\x92\x68\x43\x65\xdb\xd6\x54\xc1\x3a\x25\xfe\x86\xf3\x04\x2d\xbb\x43\x3a\xa6\xb0\x50\x51\x3d\x53\x37\x9d\x71\x07\x7d\x44\x71\xfc\xb5\xae\x9a\x36\xea\x9d\x2f\x03\x1f\x75\xca\x17\x82\x3e\xa5\x7a\x5c\x06\x3f\xe6\x67\x5c\x08\xd9\x35\xd6\x2d\x67\xf3\xbe\x6b\xa4\x6d\xde\xda\x0d\x78\x4d\x04\x86\x1c\x85\xec\x2b\x1d\xcc\xc6\xba\xe7\xa5\x70\xe5\x3d\xfe\x6d\x01\xd2\x4a\x5b\x6f\xe0\xc7\xe5\xac\xaa\xb5\xdf\x4a\x17\x12\xbd\x73\x09\xcc\xc5\x8c\xbe\x14\xa6\x7c\x85\x83\xd3\x55\x4c\x85\xa8\xbb\xce\x86\x41\xea\x44\x9a\x28\xc7\x12\x4e\x11\x62\x91\x06\x30\x6e\x8f\x2c\x05\x8e\xb8\xa2\xdc\x3b\x82\x0e\x67\xfc\x4b\x19\xfa\x25\xe0\x88\x23\xef\x6d\xbd\x04\xa6\x6a\xea\xcf\x9c\xb2\x45\xd9\xc1\x2e\xb2\xa5\x6d\x84\x52\x91\x88\x86\x1c\xac\x0d\x5b\x67\x43\x90\x59\x9e\x0d\x7a\x83\xea\x70\x07\x47\x0d\xaf\x4f\xb0\x96\x20\xab\x85\x57\xd8\x7b\xe3\x1a\x5b\x2b\x2f\x0d\x5f\x3b\xbb\x57\xad\xdd\x78\x39\xef\x3f\xd4\x5b\x72\xf1\x76\x40\x8c\x97\xdb\x82\x8a\x0b\x39\x79\x33\x3c\x6a\xd7\x45\x8f\xaa\x4d\xab\x9f\xe5\xe0\x31\x3e\x3a\x5d\x99\x2f\xb6\x0b\xe6\x29\x48\x70\x72\x8c\x79\xd7\x7d\xc6\xe0\xf7\xba\x53\x4d\x2d\x4a\x93\x3f\x61\x5e\x71\xcb\xe3\xe0\x1d\xd5\x61\xf5\xc6\x8b\xb6\xfe\x80\xdb\xd4\x8b\x81\x5e\xad\x4d\x01\x2f\x4b\x7e\x32\x5d\xd2\xa6\xb5\x2b\xdd\xfe\x3e\x6c\x93\x7c\x35\xeb\x33\x8d\x9c\x6c\x7f\xb2\xd5\xd3\x35\xb0\x17\x2b\x7b\xc2\x74\x87\x9b\x0d\x5f\xe3\x3e\xbc\x0e\xe6\x5f\xd6\xdd\xb7\x56\xd7\xbf\x77\xbf\xee\x76\x7d\x88\xff\xeb\x97\x78\x2d\xfb\xcb\x56\x77\x1f\xed\x0d\x4e\x45\x14\xd3\xad\xad\xab\x4c\x7d\x30\xf9\x2f\x4d\x1b\x3e\x7c\x0c\x7d\xb6\xd5\xa9\xb1\xb2\xb2\x5d\x75\x7e\xd6\x81\x8c\xb4\x71\x4a\xde\x74\xbd\xed\xfd\x20\x49\xad\xd2\x99\xfb\x92\xbd\x10\x7f\x71\xd5\x68\x2c\x06\x0d\xf7\x44\x8e\xb2\x09\x89\x91\x01\x0b\xf1\xaf\x10\x77\x26\xe8\xd4\x15\x76\xa8\xdd\x50\xd5\x12\x18\x2f\x2f\x68\x55\x14\x43\x10\xea\x90\x13\xa8\x0f\xda\x05\x29\xd8\x2e\x2d\x64\x8e\x75\x30\xd5\xb7\x89\x4a\x1d\xb1\x92\xdb\x9c\x10\x9a\x8f\xb7\x4a\x75\xb0\x6e\x3e\xd2\xa0\x4c\xae\x9a\xda\x74\x21\xee\xa9\x49\xb4\x35\x5e\x9c\xb1\x7d\x38\x2c\x27\x67\xa3\xf5\xde\x1c\x5e\xd1\x0f\xef\x4b\x7d\xd0\xbb\xfd\x7c\x4f\x8b\xa0\xc2\x8e\x96\x8c\x83\xb5\xa9\xed\xac\x71\xb1\x36\xaa\xb6\x41\x75\xc6\x07\x53\xcf\xb7\xc1\x08\x27\xc5\x25\xa4\xfd\xfd\x7e\x4e\xfb\x2b\xbb\x1b\x2e\x53\xa7\x26\x4c\x93\x01\x1b\x6e\x4f\x2a\x78\x1f\xff\xab\xa2\x76\xac\x88\xdf\xec\xf4\x53\x7c\x62\x6c\xf4\x4e\x60\xf0\x1a\x1d\xfb\xba\x6c\xf5\xd8\x74\xb5\x7d\x9c\xdb\x38\x80\x69\xc1\x54\xe1\xe7\xa7\xca\x0c\xa2\xd6\x7e\x0e\xe9\x5a\xdd\x6d\x7a\xbd\x31\x57\x64\xc5\xc8\x93\xd5\x73\x30\x02\x2c\x89\x50\x42\xd1\x72\x67\xbc\x4f\x1c\x54\x93\xb3\x02\x76\xc2\x96\x00\x1e\xef\x9f\xc6\x7d\xb6\xf4\x16\x27\x84\x78\xf0\xb1\xa5\xc7\x4d\xd3\x6d\x75\x57\x99\xdf\x7e\x9c\xc5\xe3\xa8\x6a\x37\x6c\xc8\x5c\x11\x91\x57\x46\x3b\xe3\x54\xb0\xf7\xa6\x53\xeb\xa6\x35\x33\x4d\x19\x25\xc7\x93\x38\x88\xb1\xa8\x4b\x86\x28\x60\xce\xc5\x2a\x06\x9b\xbc\x50\x05\xf6\xe3\xe9\x07\x39\x1f\xc9\x06\x67\x2f\x50\x61\x53\x9c\xbc\x8b\x53\xe8\xa5\xa9\xa4\xe7\x66\x7c\x2a\x7b\x0d\x02\xbe\x24\x58\x38\x5c\x38\x2c\xc4\x61\xe8\xb3\x4a\x57\xdb\x78\xf3\x64\xed\x8c\xdf\xce\x9f\x7b\xbc\x82\x53\x0f\xda\x35\x3a\xf5\xfa\x81\x01\xf6\xcd\x7f\x8c\x14\x56\x08\xad\x00\xd4\x41\xd6\xbc\x32\xee\xec\x82\xb2\x84\xba\x12\xea\x4a\xa8\x2b\xa1\xae\x84\xba\x4b\x87\xba\xc3\x58\x9d\xe8\xea\x32\x54\x97\xa1\xba\x0c\xd5\x65\xa8\x2e\x43\xf5\x25\x87\x6a\xeb\x8c\x8a\x1b\x65\x0f\xc6\xf9\xa9\x8d\xe3\xcf\xdf\x2a\x8b\x07\x54\xc9\x57\x24\x50\x79\x4d\x77\xba\x8a\x19\xdf\x73\x6f\xaf\xa7\x91\x4d\x37\xdc\xbb\xbc\xae\x4a\xdd\xf7\x2b\xe3\x3a\x13\x8c\x57\xbd\x9b\xf4\x22\xa8\xd0\xc3\xee\x89\xaa\x1b\x81\xb3\x5b\xdf\xbe\x1c\x62\x56\x5b\xdd\x00\xeb\xdd\x94\x5b\x3f\x18\xd7\xac\x9f\x95\xf7\xed\x5c\xac\xa4\xc3\x6d\x8c\x6d\x66\x1d\x07\xae\x74\x75\x1f\x6f\x23\xb4\xcd\xca\x69\xf7\x3c\xdb\x9c\x43\x85\xd4\x0f\x43\xde\xc0\x95\xf6\x46\x08\x50\x18\xae\xb5\xf6\xbe\x4f\xe7\x27\x81\x10\x81\xbc\x24\x80\xaf\xe5\x3c\xed\x83\xaa\x07\xd1\x08\x77\x64\x7f\xdf\xec\x55\xac\x6c\xb7\x51\x43\x86\xe5\x74\xda\x10\x19\xa2\x3b\x33\x8b\xe7\xba\xab\x67\xf6\x10\x52\x0a\x29\xfd\x0c\x94\x7a\x7d\xf3\xac\x31\xfb\xc6\x42\xf8\x4b\x4c\x84\x18\x51\x5e\xd0\x55\x70\x87\x39\x36\x2b\x75\x2b\xab\x30\xe2\x5b\x62\x04\x08\x8a\xc0\x01\xa3\x0d\xc0\x2a\x9c\x4f\x10\x93\x88\x3e\x86\xd9\x03\x63\x62\x8c\x49\x73\x05\x63\x89\x60\x57\x5a\xf7\x69\xbd\x58\x62\x54\x89\x51\x25\x46\x95\x18\xf5\x39\x31\x2a\x4d\x2d\x80\x54\x38\x9d\x20\x22\x11\x5d\x0c\x93\x07\xc6\xc4\x08\x93\xa6\x0a\x46\x12\xb1\x9e\x4c\x02\x1d\x64\x01\xef\x6e\xf2\x7b\x30\x57\xfa\x1f\x59\x65\x72\x92\xff\x50\x67\x52\x52\xff\x10\x22\x92\x06\x00\x02\x4a\x4a\xfb\xa3\x0e\x05\xeb\xd6\x41\xd5\xca\xd2\x58\x84\x91\xf3\xd4\xc8\x30\xf2\x70\xe9\xa5\xe1\x2a\x8b\xbe\x30\xe7\x35\x13\x61\x60\x4a\x9e\x0c\xb7\x28\xa9\x8d\x08\x57\x17\x97\x37\xc3\xeb\xfa\x72\xad\xfa\xee\x46\x64\x8e\x04\x37\x06\x19\x9c\x33\x95\xd6\xf0\xd6\xf3\x8a\x6a\x70\xfb\x46\x3c\x2f\x64\x57\x74\x64\x9b\x33\x66\xb0\xd6\x63\xc7\x0f\xd2\x82\xcc\x58\x92\x05\x9d\xa1\x4e\xc8\x1a\x87\x55\x24\x64\xf1\x33\x55\x08\xb3\x8a\xc9\x50\x1e\x24\x3b\xa4\x08\xa7\x15\xe1\xb4\xbf\x9c\x70\x1a\x41\x6a\xd8\x06\x28\x91\x39\x40\x84\x06\x14\x22\x42\x58\x1c\x50\xb4\x76\x08\x31\x61\x34\x80\x8c\x28\x0d\x21\x02\x02\x52\xf5\xc0\xb4\x02\x9f\x52\xc0\x53\x59\xc2\x66\x19\x4b\x26\x0a\x3d\x77\x0a\xc4\x8c\x17\xcc\xd4\x87\xa8\x3a\x1a\x01\x69\x48\x46\x76\x9e\x00\x27\xe7\x51\x8c\x85\x69\x99\x79\xa2\xda\xcc\xdc\x8c\xa9\x33\xbc\xa4\x82\x27\xff\x54\xb3\xf0\xc8\x94\x35\x7d\x64\x2c\x91\x33\x6d\x24\x5a\x3a\x62\x7a\x41\x3b\x73\xcb\xac\x79\x0b\x2d\xce\x96\xfc\x88\x43\xdb\x93\x1b\x7d\x32\xe1\x33\x16\x5d\x39\x86\x62\x17\x5e\x39\x65\x64\x2e\xbe\xb2\x8b\xca\x58\x80\x65\x74\x10\xbd\x08\xcb\x2d\x83\xee\x7f\xb6\x00\x64\xc6\x38\xab\x04\x7c\x41\x96\x53\xc0\xa2\xb5\xc7\x17\x66\x19\xe8\xf0\xe2\x8c\x77\x05\x62\x81\xc6\x04\x42\x8a\xf4\x84\x3d\x70\xa2\xb3\xa0\x18\x3d\x48\x54\x8c\xd0\x0c\xa8\x78\x2d\x31\xe2\x12\x88\x10\x59\x71\x9a\x82\x04\x45\xa8\x49\x4b\xae\x63\xb5\xcc\x91\x5a\x47\x91\x31\x89\x75\x0e\x2d\x15\xc4\x11\xb4\x64\xaf\xa4\xe5\xd4\x91\xa9\x61\x5a\x0d\x9b\xbf\x15\x0d\x52\x19\xe2\x1d\x20\x9b\x0e\x4c\x95\xf1\x49\x32\x2c\x95\x4e\xf8\x2b\x26\x91\x4e\x00\x4a\x0f\xcf\xa8\x24\x7a\xce\x05\x79\xb2\x2a\x20\x2d\x98\xe7\x66\x97\x1b\xfc\xd1\x1b\x26\x49\xb9\x73\x40\xea\x1c\x90\x39\x17\x1b\xe8\x93\x40\xa0\xac\x39\xe2\x96\xb0\x9c\x39\xd4\x2b\xb0\x8c\x79\x79\x76\xf2\xee\xd9\xc9\x29\xb8\x7b\x01\xcb\xa5\xe5\xc9\x45\xc7\xf5\x85\x36\x3e\xa5\x47\xe2\xd1\x2e\x17\x18\x83\x4e\x06\x9a\x7d\x1d\x6e\x6c\xc4\x67\xf1\x12\x97\x18\x07\x8c\x86\x0e\x6d\x80\xac\x38\x42\x4e\x58\x4e\x1c\x9b\x80\x82\x32\xe2\x18\x18\x24\x1f\x5e\xc6\xc9\x85\xc7\x49\x4c\x16\x1c\xeb\x51\x5c\x0e\x5c\xc6\x53\xa6\x24\xc0\x21\xef\x48\x4a\x7f\x43\x46\x24\x24\xbf\x01\x1a\x42\x45\x62\x4c\x49\x51\xe4\x73\x6b\x73\x7d\xf6\x01\x25\xbc\xa1\x12\x53\xd2\xdd\x25\x6f\x4d\xc9\x5b\x53\xf2\xd6\x94\xbc\x35\x25\x6f\xcd\xc5\xf2\xd6\x24\x3f\x99\x96\xcc\x46\x08\x85\x48\x65\x43\x1d\x2c\xa7\xbe\x82\x49\x63\x63\x58\x88\x24\x36\xd4\xbc\xa4\x14\x36\x3f\x19\xc7\x5a\x00\xb0\x00\xf8\x64\x5a\xf6\x1a\x21\x0a\x28\x77\x0d\x18\x33\x5d\xd9\x94\xc4\x35\x52\x5f\x4e\xda\x1a\xe2\x00\x21\x69\x0d\xe1\x65\x48\x59\x53\xb8\xb8\x84\x35\x05\x8b\x49\x57\x53\x90\xa7\xb4\x55\xb2\xb8\x90\x54\x35\x87\x08\x4b\x54\x53\xb0\x98\x34\x35\x04\x89\x49\x52\x43\x50\x6f\x24\x97\xa5\xf1\xe4\x3c\xf3\x2d\xb0\x94\x0a\xc4\x1b\x58\x54\x72\x9a\x01\x4f\xc9\xfd\xb2\x58\xe2\x46\x95\xc6\x92\xab\x60\x96\x94\x34\x85\x8c\x4a\x48\xff\x9f\xbd\x6b\x6b\x8e\xe2\xc6\xfe\xef\xf3\x29\xe6\x21\x0f\x76\xa8\xe1\x9f\x4a\xfe\xb5\xb5\xf1\x4b\xca\xd8\x86\xb0\xd8\xe0\xf2\x18\xf2\x40\x58\x95\xdc\x7d\x66\x46\x3b\xdd\xad\x2e\x49\x3d\xb6\x29\x3e\xfc\x96\x7a\x6e\xce\xc2\x48\xe7\x48\xb2\x71\x40\xb4\x1f\x00\xab\x7f\x2d\x1d\x9d\xbb\x2e\x87\x08\x8b\x15\x4f\x9f\xcb\x8b\x2d\x19\x4d\xea\x1c\xb6\x54\x34\x09\x74\xa3\xe0\x93\x21\x7a\x4a\x43\x53\xb1\x98\x88\x85\xf3\xba\x35\xb8\x32\xd0\x18\xdf\x26\x89\x7a\xf7\xf7\xd7\x53\xf2\x19\xd3\x53\x5a\xa9\x67\x9c\x47\x4c\x2e\xf1\x4c\x81\x45\x95\x76\xc6\x49\x27\xaa\xa4\x33\x62\xa2\xa8\xa5\x9c\x29\xc3\x25\x94\x70\xc6\xc0\xfa\x79\x0a\x51\xb6\x19\xc3\x57\x2b\x7b\xf2\xb8\xf2\xc5\xc8\xf2\xcc\xa8\x2f\x62\xcb\x32\x3f\xec\x08\x09\x65\x98\x51\x5f\xc5\x96\x5f\x26\x83\x3d\x1a\x9a\x79\x24\xc2\xf9\xeb\xdd\xe8\xfd\xc5\x23\x9b\xca\xca\x07\x03\x42\xf7\x27\x95\xbc\x3e\x92\xcd\x44\x4c\x8f\x66\x50\xcc\x8f\x85\xb6\x2b\xa4\x5f\x30\x7e\x2e\x25\xb7\x05\x79\xb3\x00\xa5\x44\x09\xc4\x3e\x74\xd0\x98\xab\x2f\x19\x03\xb7\xf0\xf3\xc9\x44\x34\xc2\xec\xe0\x15\xbf\xe2\x68\x64\x09\x87\x4e\x0c\x1c\xce\x6a\xe7\xcc\x04\x94\x82\xf2\xb8\xb3\x64\x1e\x17\x33\x28\xbb\x4a\x34\xd3\x97\xd3\x46\x6e\xfe\xfb\xe4\x06\x8a\xce\xb7\x68\x87\x62\x49\x6c\xbf\xee\xf6\xce\xb7\x27\x34\x04\xd9\x3e\x35\x37\xc5\xec\x64\xb3\x41\x02\xf9\x16\x72\x98\xe1\xdd\x22\xe7\xc5\xbd\x9c\xea\x7a\x6c\xdf\x7c\xd5\x55\x93\x7d\xac\xcf\x38\x91\xc8\x40\xa6\x76\x54\xff\x5c\xda\x2a\x4d\xa6\x9e\x96\xaf\x5f\xb7\x5e\x4f\x12\xfa\x15\x8f\xc2\x4e\x31\xf2\x5e\x7a\xfa\x2a\xef\x59\x70\xb2\xe0\x64\xc1\x41\x0a\x0e\xe9\x03\xd7\x20\xa6\xb3\x9d\x21\xc6\x17\x82\x69\x5b\x99\xed\x97\x9f\x11\xed\x71\xe1\x15\x6d\x9a\x46\x77\xec\xb5\xb7\xe9\x72\x68\x83\x44\xc4\xc2\xcd\xc1\x7a\x20\x29\x7c\x1d\xbc\x72\xb2\xee\xda\x18\x96\x6b\x2e\x97\xa0\x30\x32\x89\x16\xdd\xec\xf2\x64\x97\x27\xbb\x3c\xd9\xe5\xc9\x2e\x4f\x76\x79\xbe\x0d\x97\x07\x0b\x8d\x23\xe4\xe8\x73\xeb\x3b\x88\xee\x2a\xa2\x51\x2b\xcb\xef\x24\x43\xb3\x1d\xa8\xf5\x6d\xfc\x2f\xd0\xe0\x37\xc7\x18\xd7\x53\x88\x7b\x85\xfe\x91\x18\xe7\x28\x50\xf3\x84\x74\x31\x50\xdf\x47\xa9\xb9\x50\xbd\x1f\xf9\xd1\x10\xfd\x1f\x38\x13\xd1\x7d\xc5\xab\xad\x30\x35\x16\x67\x13\x02\xed\x02\x52\xd5\xa5\xa2\x46\x2f\x7d\xa7\xc8\x23\xcb\x31\x3b\x16\x13\xcd\x7b\x00\x69\xc8\xaf\x6c\x36\x1c\x64\xf5\x97\xd5\x5f\x56\x7f\x59\xfd\x7d\xa7\xea\x0f\x3d\x24\x22\xf7\x07\x8c\x9d\x3a\xb7\x46\xb6\x76\x1b\xf8\xed\x2b\xbc\xd2\x22\xf6\x8a\x22\x43\xa3\xbb\xfd\x19\x24\x9e\xb2\xbf\x5d\xb6\xfa\xaf\xb1\xcb\x37\x9f\xb2\x46\x09\x07\xc5\x2c\x93\x23\x33\xaa\xcd\x0f\x75\x49\x88\x6a\x80\xda\xad\x40\x37\x24\x40\xdb\x84\xbb\x1f\x81\x1f\x0b\x71\x3b\x88\xd4\x8e\xea\x1f\x56\x6e\xbe\x2c\x45\x07\x83\xfb\x71\x31\x02\xdc\x0b\x92\x66\x0d\x1b\x79\x80\x4b\x11\xe7\x4e\x04\xcc\x29\x91\x0c\xa4\xe6\x41\xd1\x53\x56\x51\x59\x45\x65\x15\x95\x55\xd4\x03\xab\x28\xd4\x10\x08\x5c\x4c\x1c\x27\x65\xde\x88\x11\x0d\xa1\x27\x58\x19\xa0\x44\x31\xe8\xe9\xc0\x90\x00\x01\x66\x57\x7e\x1a\x23\xd6\x81\xc5\xc1\x20\x4e\xa3\xe7\xd5\x9f\xbc\xfa\x93\x57\x7f\xf2\xea\x4f\x5e\xfd\xc9\xab\x3f\x79\xf5\x27\xaf\xfe\xe4\xd5\x9f\xbc\xfa\x93\x57\x7f\xf2\xea\x4f\x5e\xfd\xc9\xab\x3f\x79\xf5\x27\xaf\xfe\xe4\xd5\x9f\xbc\xfa\x93\x57\x7f\xf2\xea\x4f\x5e\xfd\xc9\xab\x3f\x79\xf5\x27\xaf\xfe\xe4\xd5\x9f\xaf\xb8\xfa\xe3\x69\xb0\xbd\xe1\x6f\xc7\x34\x52\x98\xdb\x4b\x58\x4f\x67\xae\xba\xc9\x04\xd4\xd8\x48\xb5\xf3\x8a\x62\xbf\xd5\xd1\xcb\xd7\x9f\x5e\xf1\x62\x5e\xc9\xe9\xd3\x1a\x6a\xdf\xfd\x68\x08\x86\x58\xa3\x16\xf6\x6a\x1f\xdd\xd5\x49\xc0\x5a\x6e\x66\x49\x80\xf4\x6d\x53\x44\x00\x51\xa6\xe5\x9d\xac\xba\x3a\x78\x72\xfa\x82\xc0\xc7\xc2\x61\x96\xfd\x18\xf6\xa9\xa1\x14\xae\x49\x40\x0d\x7b\x45\x43\xf1\x11\x4e\x7d\xf7\xe7\x0d\x87\xbc\xb9\x7d\xe3\xb9\x6c\x7e\x44\xc8\x0a\x8c\xb0\xdd\xdb\x14\xcb\x38\x18\xfe\x7b\xef\xcf\x27\x9f\x46\xfb\xbf\xed\xed\xbd\xff\x69\xf4\xeb\x87\x27\x7b\x7f\x3e\xed\xff\xf2\xe3\xfe\x6f\xfb\x9f\xd6\xff\x78\xb2\xbf\xbf\xb7\xf7\xfe\xd5\xd9\x8b\xcb\xf3\x93\x0f\x62\xff\xd3\xfb\xa6\xab\xe7\xcb\x7f\x7d\xda\x7b\x0f\x27\x1f\x90\x20\xfb\xfb\xbf\xfd\xe0\xec\xd6\xcd\x68\xde\x5d\x81\x6a\xc0\x80\x1e\x89\xc6\x8c\xa4\x1a\xad\x2a\x3d\x0f\x8d\xea\x76\xd7\x87\xf3\x70\x9b\xfd\xb1\xd7\x6e\x32\xb7\x74\xe0\xf8\xc4\x8d\x41\xe0\x12\x7f\xb9\x3e\x14\x90\xdf\x9e\x8c\xec\x7c\xcf\x62\x89\x77\x9e\x69\x17\x44\xbb\x76\x51\xc4\x92\x6d\x79\x81\xa2\xab\x05\x16\xc9\x3e\x45\xc5\x45\xfd\xda\x73\xa7\x2f\x81\x88\x6b\x52\xf2\xf2\x4d\x53\x21\xbc\x25\xdc\x85\x9c\xb8\xe9\xb1\xcf\x68\x3b\x22\x67\x3b\xc4\x5c\xd9\x1f\xdd\x42\x91\x8a\xd4\xbc\x28\x40\xeb\x33\x59\xfa\x9b\x12\x1c\x5f\xc2\xac\xe0\x3c\xbe\x55\xa1\x57\x6e\xf8\x18\xc1\x69\x34\x12\xd8\x87\xb7\xe2\x85\xbd\x5f\x15\xd3\x96\x38\xbc\xe1\x70\x2e\x1a\x0f\x83\x04\x02\xfb\x6e\x91\x0e\x04\xc6\x31\xf5\x2a\xf6\x15\x4d\x89\x68\xe6\x2d\xaf\x4a\x60\xff\x55\x25\xe8\x9e\x0f\x74\x6a\x46\xe8\x9d\x66\x54\xcb\xb8\x10\x18\xe1\x59\x7d\xee\x37\xe1\x56\x5e\xfe\xf7\x2d\x34\x3b\x3d\x62\xbf\x2b\x81\x07\x16\xc8\x69\x6b\x81\x00\x9d\xd9\x22\xb3\xc5\x1d\xb6\x20\x34\xd6\xe8\xc4\x37\x4d\x59\x85\x25\xbd\x09\xe9\x2b\x6a\x87\x82\xd2\xdd\x01\xec\x18\x96\xea\x0e\xfa\x10\x3d\xcd\x4d\xa2\x6f\x44\xcf\x28\x9e\x13\xdd\xb4\xd3\x93\xdb\xe4\xd4\x36\x49\xdc\xe8\xe3\x25\x27\xb5\x63\x14\x37\x79\x06\x49\x83\x27\x34\x5e\x65\xc7\x8e\x2a\xae\x75\xf2\x10\x6a\xd1\xe7\xc1\x6c\xb4\x70\x0f\xb0\x89\x7b\x8b\xa2\x99\xb7\x91\xa7\x41\x21\x95\x1c\x1b\x5e\xcc\xc7\xe2\xe3\x8e\xce\xfb\x37\xd9\xf8\xac\xf8\xb2\xbe\xee\xf2\x82\xf4\x71\x5f\x05\xf2\x60\x10\x40\x9a\x72\x79\x37\xfb\xab\x8d\x9d\x7c\x2e\x2a\xb3\xab\x72\x88\x2f\x04\x5e\x56\xc2\x7e\xdb\x6a\xa3\x80\xd7\xa1\x18\x8b\x77\x5c\xed\x10\x31\xa7\x16\xc5\x58\xa5\x24\x25\x76\xd2\x54\x66\x43\xd5\x03\xc5\x5a\xda\xa2\xe7\x83\x33\xde\xe2\x4a\x81\x62\x61\x09\x86\x1b\x35\x66\xec\x34\x04\x81\x52\x4a\x7e\xfa\xb8\x30\xc4\x3c\x62\xcc\xa2\x57\xb5\xac\x9f\x89\xbd\xc7\x32\xf1\x4c\xf2\x56\xbc\x03\xa5\xbd\x7b\xd1\x02\x68\xdf\x77\xd7\x9d\x6a\x0d\x42\xc6\xd3\x7e\xd3\x83\x54\x33\xb0\xce\x26\x3c\xbf\x87\x99\xd8\xd4\xfc\xc2\x99\x37\x12\xc9\x86\xc3\x52\x2c\x84\xc6\x79\xc1\xe8\xd0\x92\x1e\x56\x92\x43\xca\x47\x1d\x4e\x46\x87\x92\x6b\x76\x4a\x3c\xdb\x78\x01\x59\x77\x20\x95\x7c\x50\x4a\x4f\x67\x7b\xf3\xb8\xed\x0d\xa2\x99\xaf\x57\xce\x74\xae\x17\xdf\x1d\xc4\xc1\x8d\x51\xfc\xdd\x2a\xcc\xe8\x1a\x73\x5f\xde\x61\x09\xda\x88\x86\xfb\x76\x6b\x6f\x15\xd5\xff\x3d\x7d\xf2\xc3\x20\x92\x9d\x30\x2b\x50\x38\xf6\xf1\x2b\x98\x94\x3d\xf7\x33\xc4\x1d\x72\xee\x6c\xe3\xd4\x49\x91\x6c\x33\xe9\x43\x99\xc3\xeb\x1d\x33\xee\xe7\x88\x33\x9b\x2c\xd8\xf5\x4b\x14\x91\x78\x51\xc8\xae\x31\xce\xca\x8f\x98\xc9\xe5\xb5\x88\xc7\xf8\x18\xf7\x3e\x14\x3f\x33\xd1\x68\xc3\x9b\x02\xa2\x3b\xf3\x17\x30\xf7\xb2\x38\x06\xce\xae\xf4\xbb\x95\x3c\x06\x45\xd4\xa5\x66\x0b\x9f\x8b\x8c\x98\xf6\x56\x89\x85\xad\x0a\x2f\xda\xb8\x0e\x2d\xda\x22\x92\xd2\x1e\x11\x5a\x8a\xc8\x36\xfa\x0f\x95\x94\x43\xdf\xe6\x39\x24\xe1\x9e\xf5\x1b\xac\xd8\xee\xc4\x09\x12\xe7\xf8\xf5\x98\x5d\x80\x51\x02\xe2\xfa\x63\x71\xfe\xe0\xc2\xb0\x4b\x51\x43\x1c\x52\x57\xd7\xb7\xec\x0c\x0c\x8f\x82\x79\xf5\xcf\xf1\xe8\x54\x4e\xa7\xa2\x99\x3e\x3d\x59\x16\xf6\x4e\x86\x77\xce\x95\xa3\x68\x2c\x0e\x0e\xa0\x65\xa7\x72\x1a\x07\xd2\x5d\x01\x3b\x3a\x64\xcf\x45\x05\x49\x80\xdc\x81\x29\x16\xe8\x92\x4f\xd9\xb9\xa7\x64\x31\x1a\x4b\xce\xa1\x49\x34\xc0\xb7\x17\xa7\xf1\x20\x35\x18\x6e\x2b\x5b\x57\x92\x97\xac\xe0\xc5\x0c\x58\x29\x54\x34\x6e\x05\x86\x9d\x4b\x15\xb7\xe3\xd4\x97\xae\x47\x40\xc4\x5b\xf2\x33\x50\x53\x88\x66\xed\x0d\x0a\x73\xee\xd0\x26\x21\x5d\x2a\x51\x27\x80\x4a\x20\xfd\x17\x30\x85\x9b\x14\x40\x6f\x35\xb0\x7f\xc9\x4e\x39\xa3\x2b\x24\xce\x8a\x0d\xa3\x70\x4c\xa5\x9f\x96\x70\xd5\xc5\x4d\xbe\x45\x59\x80\x12\x93\x98\x99\xf7\x19\xf4\xaa\xd3\xb3\xfb\x5a\x73\x98\x48\x75\xcd\x55\xf9\xa6\x75\x18\x7a\xbf\xaf\x70\xb1\x0c\x1c\x18\x2f\xe6\x4c\x81\x6e\x65\xa3\x21\xc6\xc5\xb1\x88\x46\xdd\x32\xcf\xd6\x65\xc4\x04\x8d\xa1\x29\x99\x74\x8d\x0e\xdb\xa3\x4b\x1e\xc7\x2a\xd6\xdd\x60\x5c\xb3\x97\xcb\xb9\xb8\x47\x0f\x70\x6a\x2b\x1a\xdf\x17\xc3\x88\x3a\xe2\xf8\x42\xff\xf2\x79\x57\x55\xcb\xe5\x2d\xc7\x8c\x38\x03\x7f\xdc\xc7\xf0\x79\x22\xc4\xec\x21\xc8\x8e\x89\x5f\xed\xd3\x76\x55\x75\x2e\x2b\x51\xc4\x59\x0b\x05\xad\xd4\xc2\x48\x15\x07\x63\xa2\xd8\xda\x43\x12\xd1\xb4\x9d\xb9\xe4\xa2\x0a\x65\x98\x55\xf0\x70\x34\xeb\x9a\x79\x7c\x08\xb1\x42\x3b\xe3\x37\x09\xc2\x91\x67\x91\xaf\xb3\x71\xdc\xb9\x96\xe1\xf0\x58\x16\x73\x3b\x1c\xe7\x32\x3d\x0d\x87\x3d\xdf\x6d\x6c\x90\x68\xab\x10\x26\xde\x43\x5f\xde\xb9\xc0\xde\x54\x65\xa4\xeb\x11\xef\x97\xd5\xec\x59\x37\x49\x60\x8d\xce\xba\xca\x88\x4a\x34\x90\x06\x25\xc1\x6c\x25\xf0\xec\x96\x10\xec\xb9\x50\xda\x44\x8f\x6d\x05\xf6\x3a\xc2\x32\x20\xbe\x82\x51\xd3\xd1\xfc\x6b\x01\xa2\x63\x82\x0b\x98\x28\xd0\xb3\xde\x6b\x50\x8b\x48\xdf\xf9\xc2\x26\x74\xa0\xcf\x7e\x44\xe1\x8c\xe7\xc2\xa6\x04\x9a\x29\x3b\x15\x0d\xe8\x28\xac\x68\xb7\x8a\x4f\x59\x1f\xa4\x24\x39\x6b\x88\x49\x5b\x86\x9b\xc3\xca\x11\xf5\x52\x36\xa5\x45\xf7\x43\x2c\xa0\x01\xad\x8f\x61\xc2\xbb\xca\x1c\xd9\x63\x9f\x07\x83\x10\x5f\x74\x8d\x74\xae\xe4\x15\x84\x1a\x79\xb8\x71\x9d\x73\xf1\xbf\x6f\x9f\x42\xd6\x35\xf7\x1d\xbf\xf0\xaa\x0e\x14\x71\xf1\x2a\xc4\x3b\x11\xf6\x67\xc2\x45\xd5\x29\xb8\x9c\x59\x39\x97\x95\x63\x08\x7e\xe7\x1d\xe3\xc0\xdb\x67\x66\x4c\xfb\x02\x4c\x2c\xd1\x6d\x8a\xde\xf5\x7b\x34\x31\x6d\x7f\x7e\x07\x5e\xc2\xae\x5d\x62\xa4\x19\xc4\x75\xfe\x1e\x97\x91\xbd\xbb\xc9\x02\x50\x7d\x6b\x73\xa8\x45\xdb\xf5\x33\x5a\xde\xea\xe1\x69\x85\x60\x5e\xbc\x24\x24\x3c\x72\xd9\x3a\xb3\x8f\xc8\x3d\x30\x94\xdd\x2f\x84\x7d\x2f\x11\x9b\x4a\x74\x31\x83\x1a\x12\x50\xc8\xcf\x2a\xf6\x32\x36\x65\x06\x11\x13\x6f\xaf\x71\x13\xbc\x3a\x86\x8a\xdf\x8e\xa1\x90\x4d\xa9\x1f\x42\x71\xb5\xa0\x84\x2c\x1f\xf0\x83\xba\xeb\x0f\x4e\x3e\xa8\x76\x36\x45\x3b\xb6\x21\xd9\xe3\xd1\xcf\xdf\xa4\xc4\x3d\x84\x98\x18\x50\xf5\x6a\xbf\xc4\x0b\x9b\x95\x3b\x27\xf3\xef\x3f\xfe\x3f\x8e\x97\x44\x0d\xb2\x33\x0f\x26\x31\x1e\x9a\x54\x72\x7a\x0a\x0b\xd8\x11\xc6\x78\xe6\xbc\xb6\xab\xbf\xc5\x8e\x41\xf8\x25\x42\xa4\x08\xa1\xdc\x46\x0c\x03\xe0\x14\xa5\x54\xd3\xd0\x7b\x70\x35\x98\x19\x74\x9a\xb0\x90\xef\x4a\x3a\xdb\xed\x81\x6a\x21\x0a\x38\x93\x8d\x70\x9e\xc8\xa2\x63\x2d\xcf\x3c\xc4\xaa\xbb\x6d\x08\x85\x39\x0d\x44\x09\xb8\x08\xf3\x8b\x12\x83\xf5\x33\x93\x8d\x54\x98\xce\x62\x48\xba\x15\x91\x0b\xe8\x23\x4d\xd1\x4c\x1f\xda\x9d\xe6\x85\xff\x5e\x54\x22\x29\xed\x4f\x2d\xcb\xae\xea\x10\xdf\xc7\xaa\x4e\x9a\x2c\x6d\xff\x28\x77\xa6\x21\x70\x78\x0a\xda\x8a\x17\x50\x43\x63\x92\x63\x6b\x68\x39\xfa\x04\x25\x0d\xb9\xdf\xe7\x87\xe1\x5e\x02\xb3\x05\xf4\x03\x1b\x7f\xac\xda\x72\x35\x05\xd3\xf7\xfa\x60\x90\xb4\x27\x48\x99\xc7\x76\x57\x65\x21\xce\x42\x9c\x85\xf8\xef\x2c\xc4\x08\xb0\x95\x7f\x7e\x30\x08\xee\xbb\xe7\x23\xb5\xdd\xac\xbc\x7b\x2d\xc3\x03\xdf\x80\xb9\x96\x6a\x47\x72\xd8\xaf\x53\x0a\xd9\x34\x50\x98\x4b\xdf\x20\xd3\xb9\xbd\x73\x80\x96\xdb\xac\xf4\xc1\x20\xc6\x97\xda\xc0\xbc\x2c\x2b\xf8\x1a\xdd\x3f\xe3\x37\x17\x50\xdc\x16\x15\xdc\xff\x67\x3d\x1c\xd4\xc8\xd2\x73\xa5\x33\xc5\x95\x8e\xe5\x67\xd9\x99\xb6\xbb\xb7\xe3\x22\xae\xdf\xe1\x10\xec\x73\xf8\xc7\x98\x1d\x76\xae\x40\x11\x49\x8b\xf5\x63\x01\x2f\x60\x8a\xb0\xca\x34\x48\x59\x01\x3b\xbc\x78\x9d\x0c\x74\xb5\xc1\xc2\xbd\xb9\x82\x88\x79\x54\xc9\xae\x44\x91\x13\x3b\x3d\x1b\xb5\xe8\x3b\xaa\x1d\x02\x4c\x3d\x47\x17\xfa\x0d\xc2\xb9\x3a\x32\xc9\x43\x16\x48\xa2\x3e\x42\x39\x77\x47\x51\xe3\xf4\x94\x1f\xfd\x3c\x1e\x52\x6f\x45\x35\xbf\x8f\x05\x25\xe4\x75\x05\xa1\xfc\x99\x65\x20\xcb\x40\x42\x19\x40\x93\x74\x69\x2e\x5e\x1e\x1f\xa4\x02\x7c\x01\x8d\xbd\xed\x08\x52\x62\xfe\x7e\x79\x79\xce\xce\xb9\xd6\xd7\xde\x69\xc8\x46\x2d\x1b\xb5\x6c\xd4\xb2\x51\xcb\x46\xed\x3b\x36\x6a\xbd\xbd\x78\xeb\xdc\xa8\x4b\x45\xf4\x6e\x0d\x20\x80\xbd\x6c\x96\x5b\xbe\xed\x71\x4a\xe7\x86\x57\x32\x6e\x09\x37\xc9\xd0\x4e\xe5\x54\x1b\xae\x67\xec\x98\x1b\x78\xbe\x4c\xd9\x24\xc7\xbe\x2f\x5c\xdf\x29\x55\x22\xae\x7b\x73\x33\x15\x4c\xb4\xe0\xde\xf8\x4d\x05\xf4\x6e\x37\x21\x80\x5d\x2c\xf3\xf2\xec\x58\x1a\x9d\x0c\x74\xdc\xb5\xfd\x6d\xac\xec\xf2\xb6\x05\x86\xb9\xf2\x09\x0d\x9d\x5a\x88\x6c\xf6\x32\x2d\xa0\xdd\xc8\xc2\x4e\x94\x92\x2a\x19\xa6\xa9\x74\x4a\xac\xa7\x05\x67\x13\xe7\x49\xec\x10\x4c\x65\xd2\x83\xce\xe1\x36\x3d\xa8\xef\x44\x2a\x09\x12\x65\xcf\x56\xc7\x48\x2f\xe5\xe1\x74\xaa\x60\xea\x5b\xaa\xc2\x99\x78\xbb\x2b\xf8\x60\x10\xef\x59\x25\xd6\xc9\x49\x4d\x67\x52\x55\xf7\xf6\xe2\x65\x32\xac\x42\xd6\xbd\x86\x4b\x06\x38\xc3\xec\xef\x0e\xdb\x96\x43\xe8\x05\x92\x9f\xd7\xec\x97\x83\xf4\x1c\xa4\xe7\x20\x3d\x07\xe9\x39\x48\xcf\x41\xba\x2f\x48\xef\xed\x45\xd2\x20\xfd\x3f\x5a\x36\xac\xb4\xb9\xe7\xd5\x52\x7f\x7a\x60\x04\x13\x67\x27\xfe\x3b\x71\xe2\xe7\x7c\x32\x77\x5c\x65\x86\xd7\x92\xcf\x94\x9c\x23\x5c\x3d\x34\x39\x12\x3b\xf0\x67\xa0\x35\x9f\xa6\x0d\x8a\xef\x60\xb2\xfe\x7a\xe9\x64\xc8\x36\x7e\xd7\x86\xd7\x2d\x4b\x4c\x86\x2d\x70\xd2\xec\x80\x6c\x45\x91\x1e\x31\x1d\x33\xa9\xd2\xcb\xe7\x8f\x29\x0e\xc1\x18\xcd\xd1\x5a\xe2\x06\x91\x9f\xab\xe4\x5c\xa4\x50\x00\xbc\x33\x92\x6d\x8f\x65\x31\xd7\xc1\xf7\x00\xe2\xf9\xcf\xb5\x51\xc0\xac\xd9\x6e\x73\x98\x97\xc3\xbc\x1c\xe6\xe5\x30\x2f\x87\x79\x39\xcc\x43\x84\x79\x5d\xca\x30\xaf\xb7\x8e\x6c\x0e\xb7\x3a\x2d\x64\x42\x38\xd1\x24\x8f\x41\xfd\x67\xca\x09\x60\x0a\x6a\xb9\x80\xb4\x34\x34\xd0\x70\xcf\x3d\xef\x39\x40\xfe\x56\x02\xe4\xe1\xb0\x53\x22\x11\x16\x4a\xeb\xd4\xee\xeb\x8b\x91\x1f\xeb\x51\x3c\xd7\x5f\x21\xa1\x7c\x36\x68\x5b\x68\xe2\x3d\x1f\x7d\xfc\x69\xf4\xeb\x87\xbd\xf7\xa3\xe5\xdf\xd8\x87\x1f\xd7\xff\xe7\x2e\xbd\x83\xea\x88\xf7\xb6\xd6\x90\xe0\x0c\xf5\x65\xe4\xd4\xe9\x5f\x0e\x06\xf1\x3e\x06\xbf\xd6\x56\x5f\x21\xf4\x4b\x0e\x3b\x72\xd8\x91\xc3\x8e\x1c\x76\xe4\xb0\xe3\x5b\x0d\x3b\x10\x4d\xad\xb9\xd0\x50\x60\x16\x6c\xb2\xbd\xc8\xf6\x22\xdb\x8b\x6c\x2f\xb2\xbd\xf8\x8e\xed\xc5\x55\xe7\xbe\x41\x90\x38\x43\xeb\xed\x80\x29\x8f\xa0\x43\x53\xb6\x52\x34\x26\x19\xa0\x4a\x7b\x44\x5e\xd9\xe3\xf1\x5c\xa5\x03\xd4\xbf\x58\xf3\x9d\x3a\x91\xb6\xba\xbb\x9a\x15\x15\x4f\xb8\x5d\xd3\xa2\x7a\x0a\x46\x11\x11\x8d\x34\xbc\xea\x73\x4b\x4c\xa7\xbc\x20\xa0\x6b\xfb\x0a\x57\xde\x9b\x54\xa8\xb0\x1a\x58\xdb\x19\xb6\x94\xb5\x44\xb0\x18\xa5\x33\x5a\xc9\xee\x20\x4a\x0b\x7c\xcd\xe2\xae\xad\xa3\xa8\x80\x87\x50\xad\x2c\xcf\x95\x90\x4a\x98\xdb\x23\xcb\xcf\xbb\x8f\x56\x78\x81\xb4\xb0\x86\x84\x95\x57\x07\x83\x30\x23\x0b\x75\x6b\x6e\x8f\x5d\x12\x80\x33\xd4\x35\x94\xa2\xf3\x38\x17\x9e\xd1\xac\x1f\x2b\x38\x9e\x32\x14\x5f\xf7\x36\xd6\x47\x5a\xf1\x3b\xf8\x92\x58\x84\xa4\xd9\xdd\x08\xcc\x7d\x2f\x28\x8e\x4f\xdc\x18\x04\x2e\x71\x57\x31\x40\x03\xf9\x95\xd5\xa8\xbf\xd4\x3b\x96\x78\xe7\x99\x76\x41\xb4\x6b\x17\xd1\x65\x13\x30\x05\xec\x71\x48\xf6\x29\x2a\x2e\x6a\xcc\x61\x38\x24\x11\xf1\xd5\xb4\xa9\x61\x80\x7f\x7a\xec\x33\xda\x8e\xc8\xd9\x0e\x31\x57\xf6\x47\xb7\x50\xa4\x22\x35\xef\xaf\x25\xb7\x25\x9b\xbc\x4d\x09\x97\xf6\x11\x66\xc5\xe7\x03\xdc\xfd\x53\x72\xc3\xc7\x08\x4e\xa3\x91\xc0\x3e\xbc\x15\x2f\x94\xec\x5a\x4c\x5b\xe2\xf0\x86\xc3\xb9\xf0\x15\x14\x09\x04\xc6\xc7\xd1\x24\x60\x1c\x53\xaf\x62\x59\xd1\x94\x88\x66\x88\x5a\x12\x68\xf6\xb7\x3f\x0a\x96\x1a\x47\xa7\x66\x84\xca\x3a\x45\xa8\x96\xa1\x5b\x3b\xd1\x9e\xd5\xe7\x7e\x13\xc6\xc7\xfa\xfc\x2d\x34\x3b\x3d\x62\xbf\x2b\x81\x07\x16\xc8\x69\x6b\x81\x00\x9d\xd9\x22\xb3\xc5\x1d\xb6\x20\x34\xd6\xce\x5b\x2f\xc3\x95\x55\xbf\x6b\xe3\xe4\x66\x9d\x50\x43\x72\x19\xda\x8a\x3f\xe6\x14\xb2\xed\x15\xf6\x22\xe3\xa8\x0f\xf5\x49\x7d\xc2\xe0\x89\xf4\x8d\xe8\x19\xc5\x73\xa2\x9b\x76\x7a\xb2\x7a\xb4\x99\x14\xe4\x0b\x24\x71\xa3\x8f\xb7\x97\x0e\xfc\x8d\xd4\x71\x8a\x9b\x3c\x83\xa4\xc1\x13\x1a\xaf\xb2\xb8\x9e\xa4\x57\x60\xd7\x17\xb2\xea\x6a\x70\x17\x8a\x0d\x86\x4d\xdc\x5b\x14\xcd\xbc\x8d\x3c\x0d\xd6\xd9\xc1\x9c\x1c\xcc\xc9\xc1\x9c\x1c\xcc\xc9\xc1\xaf\x92\x1c\xbc\x57\xda\xe5\xe4\x60\x4e\x0e\xe6\xe4\x60\x4e\x0e\xe6\xe4\x60\x4e\x0e\xe6\xe4\x60\x4e\x0e\xe6\xe4\x60\x4e\x0e\xe6\xe4\x60\x4e\x0e\xe6\xe4\x60\x4e\x0e\xe6\xe4\xa0\x33\x39\x68\x37\x9c\x88\x06\xb4\x3e\x57\xf2\x6a\x47\xef\xfd\x2a\x14\x6e\x5c\x01\x1d\x4e\x05\x17\xb2\xae\xb9\x2f\xce\x40\xa9\x26\x22\x85\xdd\xe2\xe8\x25\xf0\x70\x38\xe1\xa2\xea\x14\x3c\x68\xdd\x7c\x7b\x33\xc3\x8b\x47\x54\x35\xdf\xf6\xe7\x77\xcc\xad\xb7\xa8\x19\xc4\x75\x9e\x16\x43\xa2\xb9\x62\x63\x34\x13\xa3\x62\x4d\x17\x2a\xd8\x1c\x2d\xed\xba\xa7\x15\x82\x79\xf1\x92\x90\x30\xb7\xe8\xbf\x0f\x03\x11\x47\x50\xa2\x07\x42\xcc\x10\xe1\x88\xeb\x62\x06\x35\x24\xa0\x90\x9f\x55\x46\x7d\x71\xfd\x41\xc4\xc4\x8b\x46\x18\xc1\xab\x63\xa8\xf8\xed\x18\x0a\xd9\x94\xfa\x21\x14\x57\x0b\x4a\xc8\xf2\x01\x3f\xa8\xbb\x7e\xfb\xe0\x83\x6a\x67\x53\xb4\x63\x59\xcc\x1f\x91\x7e\xfe\x26\x25\xee\x21\xc4\xc4\x80\xaa\x45\xc3\xad\x5b\xfd\xc2\x16\x83\x38\x27\xf3\xef\x3f\xfe\x3f\x8e\x97\x96\x67\x7d\x1e\x4c\x62\x3c\x34\xf1\x24\x2e\xfd\x6c\xed\x4b\x50\x52\x83\x18\x2f\xdf\xe2\xb9\x16\xcd\xb3\x8f\x32\x9b\x14\x2c\x46\x08\x29\xf0\x27\x10\xf3\xb4\x3d\xba\x69\xf3\x34\xd0\x50\x74\xf6\xec\x59\xa8\x20\xb7\xbd\x19\xef\x21\x8e\x64\x63\xe0\xc6\x61\x60\xfc\x68\xf6\x99\x68\xc4\x1a\x16\x4e\xb3\x6e\xc7\xef\xe7\x9f\xd5\x67\x8f\x66\xbc\x99\xc2\xb9\xac\x44\xe1\x49\xff\x21\xd9\x4d\x75\xcd\xe1\xd7\x19\x51\xff\xe5\xd7\xb2\xb9\x90\xd2\x63\xf5\xb1\x2b\xc5\x3d\xa2\xff\xc2\xf7\xf4\x43\xd1\x70\x2a\x9a\xee\xe6\x8d\xff\xa2\x2c\x2c\x97\xd9\xa7\x82\x05\x20\x4e\xdd\x23\x27\x7a\x7d\x80\x3a\x29\xa0\xff\x70\x16\x11\x10\x73\x8f\x23\x01\xd0\xab\x7e\x36\x5a\xc6\x9e\xa7\x3f\x57\xd2\x7f\x29\x1e\x61\xfe\x64\xc1\x2b\xeb\x1c\xa3\x70\x49\xe3\x5a\x35\x4d\x07\xe8\xf7\x52\xb7\x46\x2c\x09\xc5\xbb\xb6\xad\xa0\x86\xc6\xf0\xaa\xd7\x3e\x29\x32\x32\x78\xc1\xc6\x8b\x36\x36\xed\xa0\x6f\x75\x61\xaa\x14\xa3\xc8\x79\xa5\x47\x99\x57\xba\x16\x4d\x29\xaf\x75\x62\x1d\x3f\xad\x35\x3f\x52\x50\x42\x63\xf3\x1c\x63\xef\x9e\x23\x22\xd9\x3f\x87\x4f\xbe\x5a\xb1\x31\xba\x8f\x72\x65\xe1\x2f\x1e\xe0\xd2\x6d\x3a\x52\xc0\x8d\xa3\xa7\x18\x6f\xc3\x1a\xd2\x67\x5c\x43\x79\xd8\xe7\x6d\xac\x6b\xa9\x64\x95\x02\x59\xa7\xf5\x56\x79\x55\xc9\xeb\x73\x25\x16\xa2\x82\x29\x9c\xe8\x82\x57\x7d\x9a\xc0\xf5\x0e\xae\xa3\xf6\x29\x78\xcb\xaf\x44\x25\xfc\x9c\x8e\x97\x08\x5e\x7a\x95\x04\x52\x91\x92\xd8\x0d\xaf\x08\xec\x53\x2a\xd9\x3e\xf6\x5e\xa2\x04\xc8\xce\xcc\x8a\x39\xca\x34\x3c\xd1\x2a\x59\x9c\xd9\x5a\x0b\x07\x83\x04\x43\x5e\x6f\x27\xb5\x41\xc2\x73\x51\x81\xbe\xd5\x06\xea\x34\x3d\xcd\xa1\x4f\x0e\x7d\x72\xe8\x93\x43\x9f\x07\x0e\x7d\xb2\x23\xf9\xf7\x74\x24\x35\xa8\x85\x28\xe0\xb0\x28\xdc\xd6\xcd\xdb\x31\xcf\xb7\x30\xdf\xf1\xf3\x85\xad\xf8\xd4\xdf\x25\x3b\xfe\x0b\xdc\xa5\x9c\x83\xe3\xfe\x3c\x8c\xb9\x11\x35\x9f\xc2\x79\x57\x55\xe3\xfe\x26\x4d\x07\x67\x7a\xfd\x1f\x2c\x7b\x63\xc2\x5c\x24\x3f\x20\x26\x1a\xe3\x62\xd5\x60\xb8\xbd\x45\xe5\x60\x10\x37\x36\xde\x34\xd2\x70\x84\x26\xa0\x2e\x5d\x10\x48\x82\x24\x0a\xae\x88\xc9\xd7\xef\x27\xa2\x91\x7e\x30\xce\xe5\xad\x78\x07\x2a\xe9\x2d\x9d\x13\x5b\xcd\xd0\x7d\x0a\x8f\x88\x88\x39\x3a\x83\x06\x4b\x28\xab\x4b\xc1\xd7\x2d\x2f\xd2\x21\xae\x97\x86\x53\xcf\x4a\x97\xac\x2c\x4d\x12\x0d\xe5\x01\x31\x5c\x4d\xc1\xec\x2e\x9c\xef\xe9\xea\xf2\xf5\xdd\xa5\xf2\xfd\xcb\xfc\xbe\xf0\x62\x67\x2a\xd5\x2f\x78\xd0\xf0\xab\x0a\xca\x38\x23\xb7\xd4\x10\x6e\x4f\x04\x31\x9f\x7a\xc6\x15\x94\xce\xe2\x9b\x5e\x14\xb7\x7f\x3a\x5a\x8f\x37\x88\x0d\x64\x05\xca\x65\x7d\x9c\x7a\x10\xa3\x03\x61\x32\xf1\x5c\xd6\x8a\xa0\xa2\xf7\xb0\x03\x0a\x03\x73\x98\x01\x05\xb4\xa5\x9a\x77\xbf\x0b\x3e\xe2\xc6\xc5\xdb\xde\xd4\x3c\x62\x00\x1e\x9e\xf0\xe9\x96\xae\xd5\x46\x01\xaf\x2f\x80\x97\xb7\xaf\xed\x7d\x77\xbb\x8f\xfb\xba\x25\xcd\xd1\x91\x49\xd5\x41\x63\xbe\xc0\xf2\x6e\x9e\xe3\x93\x89\xdd\x31\xb8\xa3\x37\x7e\x7e\x6d\x64\x09\x87\x4e\x0c\x1c\x8e\x7d\x5a\x05\x13\x50\x0a\xca\xe3\xce\x4e\xc6\xb8\x98\x41\xd9\x55\xa2\x99\xbe\x9c\x36\x72\xf3\xdf\x27\x37\x50\x74\xfe\x84\xac\xd7\x1f\xc1\xf7\xeb\x6e\xef\xa0\xc1\x1c\xf2\xa5\x21\x87\x1f\x99\x42\x0e\x33\xbc\x5b\x28\x5d\x12\x24\x53\xa1\xfa\x26\xd9\xc7\xe8\x07\xa8\xc8\xd4\x8e\xea\x9f\x4f\xab\x0c\x87\x74\xd3\x17\x7b\x94\x2a\xe0\x30\x95\x53\x69\xa5\x1a\x79\x2f\x3d\x7d\xcd\xf2\x2c\x38\x59\x70\xb2\xe0\x20\x05\x87\xf4\x81\x6b\x10\xd3\x99\xd3\x2f\xfd\x82\x03\xe7\xd8\xb2\x4c\x75\xe4\x68\xd3\x34\xba\x63\xaf\xbd\x4d\x97\x43\x1b\x24\x22\x16\x6e\x0e\xd6\x03\x49\xe1\xeb\xe0\x95\x93\x75\xd7\xc6\xab\x53\xdf\x97\xa0\x30\x32\x89\x16\xdd\xec\xf2\x64\x97\x27\xbb\x3c\xd9\xe5\xc9\x2e\x4f\x76\x79\xbe\x0d\x97\x07\x0b\x8d\x23\xe4\xe8\x73\xeb\x3b\x88\xee\x2a\xa2\x51\x2b\xcb\xef\x24\x43\xb3\x1d\xa8\xf5\x6d\xfc\x2f\xd0\xe0\x37\xeb\x79\xeb\x29\xc4\xbd\x42\xff\x48\x8c\x73\x14\xa8\x79\x42\xba\x18\xa8\xef\xa3\xd4\x5c\xa8\xde\x8f\xfc\x68\x88\xfe\x0f\x9c\x89\xe8\xbe\xe2\xd5\x56\x98\x1a\x8b\xb3\x09\x81\x76\x01\xa9\xea\x52\x51\x83\x7c\x45\x4f\xf8\x4a\x7e\x92\x79\x0f\x20\x0d\xf9\x95\xcd\xc2\x72\x56\x7f\x59\xfd\x65\xf5\x97\xd5\xdf\x77\xaa\xfe\xd0\x43\x22\x72\x7f\xc0\xd8\xa9\x73\x6b\x64\x2b\x2b\x39\xbd\x75\xee\xae\x88\xea\x15\x45\x86\x46\x77\xfb\x33\x48\x3c\x65\x7f\xbb\x6c\xf5\x5f\x63\x97\x6f\x3e\x65\x8d\x12\x0e\x8a\x59\x26\x47\x66\x54\x9b\x1f\xea\x92\x10\xd5\x00\xb5\x5b\x81\x6e\x48\x80\xb6\x09\x77\x3f\x02\x3f\x16\xe2\x76\x10\xa9\x1d\xd5\x3f\xac\xdc\x7c\x59\x8a\x0e\x06\xf7\xe3\x62\x04\xb8\x17\x24\xcd\x1a\x36\xf2\x00\x97\x22\xce\x9d\x08\x98\x53\x22\x19\x48\xcd\x83\xa2\xa7\xac\xa2\xb2\x8a\xca\x2a\x2a\xab\xa8\x07\x56\x51\xa8\x21\x10\xb8\x98\x38\x4e\xca\xbc\x11\x23\x1a\x42\x4f\xb0\x32\x40\x89\x62\xd0\xd3\x81\x21\x01\x02\xcc\xae\xfc\x34\x46\xac\x03\x8b\x83\x41\x9c\x46\xcf\xab\x3f\x79\xf5\x27\xaf\xfe\xe4\xd5\x9f\xbc\xfa\x93\x57\x7f\xf2\xea\x4f\x5e\xfd\xc9\xab\x3f\x79\xf5\x27\xaf\xfe\xe4\xd5\x9f\xbc\xfa\x93\x57\x7f\xf2\xea\x4f\x5e\xfd\xc9\xab\x3f\x79\xf5\x27\xaf\xfe\xe4\xd5\x9f\xbc\xfa\x93\x57\x7f\xf2\xea\x4f\x5e\xfd\xc9\xab\x3f\x5f\x71\xf5\xc7\xd3\xc0\x7b\x8b\x1e\x85\xb9\xbd\x84\xf5\x74\xe6\xaa\x9b\x4c\x40\x8d\x97\x15\x62\xdf\xf5\x45\x57\x0f\x06\x61\xb6\x07\xea\xd6\xdc\x1e\x0b\x87\xfe\xc7\xd9\xaf\x1a\x4a\xd1\x79\x16\x91\x90\xfc\xa4\xc5\x47\x38\xb5\xd5\xb1\xdc\x68\xde\xca\x49\xeb\x1b\x55\x71\xe1\x27\xba\x7a\xd2\x23\xad\x9f\x74\xbf\x85\xaf\xec\x45\xbb\xcc\x5d\xf7\x12\xc7\x27\xc9\x6a\x67\xfa\xaf\xe8\x45\x01\xf9\x15\xd7\xc8\xce\xf7\x2c\x96\x78\xe7\x99\x76\x41\xb4\x6b\x17\x45\x2c\xd9\x96\xb7\x31\xba\x5a\x60\x91\xec\x53\x54\x5c\xd4\x89\xef\x0f\xde\x5e\x42\x8f\x05\x75\x5f\x2d\x88\x9d\x1e\xfb\x8c\xb6\x23\x72\xb6\x43\xcc\x95\xfd\xd1\xde\x6b\xa1\xf1\xa4\xe6\x7d\xe5\x0b\x5b\xa5\xdc\xdb\x94\xe0\x61\x11\x66\x05\xe7\x5a\xac\xea\x35\x70\xc3\xc7\x08\x4e\xa3\x91\x60\x75\xc1\x2b\xa2\x84\x40\xd0\xf0\x70\x17\xb3\x06\x01\x63\x2e\x69\x0d\x00\xc6\x31\xf5\x2a\xc8\x12\x4d\x39\x70\xb4\xc0\x97\x03\x42\xb3\x3f\xa2\x34\x68\x38\x23\xf8\x4a\x86\x86\xba\xa3\x01\x9e\xd5\xe7\x7e\x13\x2e\xc5\xff\xbf\x6f\xa1\xd9\xe9\x11\xfb\x5d\x09\x3c\xb0\x40\x4e\xc3\x95\x24\xcd\x6c\xf1\xdd\xb1\x05\xa1\xb1\x46\x67\x58\x69\xca\x2a\x2c\xbb\x4a\xc8\x93\x50\x3b\x14\x94\x57\x0d\x60\xc7\xb0\x9c\x6a\xd0\x87\xe8\xf9\x54\x12\x7d\x23\x7a\x46\xf1\x9c\xe8\xa6\x9d\x9e\x45\x25\xe7\x50\x49\xe2\x46\x1f\x2f\x39\x7b\x1a\xa3\xb8\xc9\x33\x48\x1a\x3c\xa1\xb1\x5e\x66\xac\x8e\x2a\xae\x75\xf2\x10\x6a\xd1\xe7\xc1\x6c\xb4\x70\x0f\xb0\x89\x7b\x8b\xa2\x99\xb7\x91\xa7\xc1\x32\x4b\xb8\x4c\x0f\x1e\xaa\xe9\x0e\x76\x71\x6a\x04\xef\x80\xdc\x5c\x7f\xb7\x07\x2f\x6d\x4d\x97\x83\x41\x98\x1e\xff\xce\x0b\xc2\x2c\x0b\xc2\xb4\x5d\x55\xf9\xca\x72\x23\x7a\xa4\xa0\x95\x5a\x18\xa9\xe2\x60\x0c\x9f\x46\xbc\x4f\x60\xdd\x33\x30\x4a\x14\x3a\x98\x75\x1a\x03\x6a\xc1\xab\x88\xce\xfa\x32\x6f\x18\x80\x9d\x85\x2c\xf0\x9b\xaa\x30\x0e\x75\xab\x64\x0d\x66\x06\x9d\x3e\xc4\x94\xfd\xc1\x24\x93\x56\xc5\xa2\xce\x64\x23\x9c\x3e\x0d\x1d\xeb\x48\x36\x13\xe1\xe0\x22\x9c\xa8\x6e\x2d\x23\xc6\x9e\x86\xd8\x51\xc4\xfc\xa2\xb8\x7a\xfd\xcc\x64\x23\x15\xa6\xb3\x18\x92\xae\x4a\x45\x29\x51\x5c\x40\x7f\xa6\x5f\x34\xbb\x54\x3d\x5a\x47\xe2\x89\xbf\x22\x6a\xe1\xdf\xc2\x46\x24\xa5\xfd\xa9\x65\xd9\x55\x9d\x26\x6e\x4b\xfc\xc7\xff\xa3\xfb\x81\x0d\x4e\x15\x4c\xe1\x26\xf9\xf0\x14\xb4\x15\x2f\xfa\x0a\xe5\xc9\xb1\x35\xb4\x1c\x1d\x83\xd0\x90\xfb\x1c\x27\x86\x7b\x09\xcc\x16\xd0\x0f\x8c\xc5\xdc\xfe\x59\x96\x13\xea\x7b\x7d\x30\x48\xda\x13\xa4\xcc\x63\xbb\xab\xb2\x10\x67\x21\xce\x42\xfc\x77\x16\x62\x04\x98\x11\x35\xc8\xce\xc1\x31\xde\xbe\x7b\x3e\x52\xf4\xae\xd5\xd1\x0c\x8a\xb9\xd7\x11\xa4\xb8\x44\x09\xfb\x75\xe1\x5e\x26\xf1\xab\x2e\xdf\x72\x08\xd5\xd5\xf3\xe6\xb7\xf1\x39\x6d\x74\x1e\xfb\x51\xe6\xae\x83\xf3\xd5\x9e\xc9\xc7\x2d\x57\xe4\x69\x7b\x74\xd3\xe6\x69\xb0\x14\xea\x0b\xa8\x24\x2f\x41\xe5\x44\x4f\x4e\xf4\xa0\x13\x3d\x7f\x65\x9d\x6c\x12\xb2\x49\xc8\x26\xe1\x5b\x30\x09\xa5\xd0\xb6\x44\xee\xf9\xae\xdd\x7b\xbe\xcc\x16\x34\x8b\x77\x5c\xed\x98\x64\xa7\xea\xc7\xa8\x7d\x9f\xca\x47\x4d\x79\x8a\x42\xa9\x2b\x94\xe7\x4a\x3a\xb7\x4e\x63\xc6\xb4\xd5\xa6\x67\xbc\x7d\x05\xb7\x17\xe0\x94\x00\x0a\x2c\x61\xdd\x1c\x35\x66\xec\x34\x04\x81\xca\x76\xa9\x29\xf0\xc0\xfe\xfc\x2a\x65\x75\x1a\xb3\x2a\xed\x95\xae\xf5\xd3\x17\x62\x4f\x3c\x93\xf8\x7a\xf1\x64\xda\xa3\xeb\xc6\x13\x91\xf1\xb4\xdf\xf4\x20\xd5\x0c\xac\x37\xf3\x3d\xbf\x87\x99\x28\x64\x63\xb8\x68\x40\xe1\x56\x97\x49\x24\xb3\x2a\x78\x21\x34\x2e\x77\xe4\xb5\x97\xff\x6b\x0d\xfd\x76\xf3\x7f\xdf\x40\x76\xfa\x91\xda\xd1\x68\x7b\xfa\xbf\xec\x94\x78\xb6\xf1\x02\xb2\xee\x40\x2a\xf9\x58\x56\xb1\xcf\xf6\xe6\x5b\xb0\x37\x88\x66\xbe\x5e\x39\x77\x53\x7b\xf1\xdd\xa1\xf1\xb2\x62\xfb\xa9\x9c\x1e\x83\x36\xa2\xe1\xbb\x97\x2b\x3c\x13\xb8\x04\x7a\xd3\x99\x53\x39\x55\x76\x91\x7c\x07\x57\xf8\xf9\x75\x67\xa6\x03\xd1\x0b\xfb\x03\x8d\xf5\x92\x1d\x73\x8c\xe1\x98\xe8\xcd\x09\x5a\x7c\x8c\x19\x86\x9b\x27\x46\xeb\x41\x0e\x02\x58\x62\x55\xa4\xff\x7c\x51\x8c\x77\x9e\x2b\xc9\x67\x1c\xf3\x19\xc7\x7c\xc6\x31\x9f\x71\xcc\x67\x1c\xf3\x19\xc7\x7c\xc6\x31\x9f\x71\xcc\x67\x1c\xf3\x19\xc7\x7c\xc6\x31\x9f\x71\xcc\x67\x1c\xf3\x19\xc7\x7c\xc6\x31\x9f\x71\xcc\x67\x1c\xf3\x19\xc7\x7c\xc6\xf1\x1b\x3d\xe3\x38\x91\xea\x9a\xab\xf2\x65\xd3\x76\xc6\x75\x9c\xc8\xaf\x46\x79\x59\x32\xc3\xa7\xcc\x16\xed\x11\x8e\x7d\xe2\x88\xf1\x5f\x39\xe3\x0d\x04\x40\x31\xeb\x9a\x39\xb3\x69\x59\x56\xb9\x93\x84\x34\xb0\x6b\xae\x9a\x04\x88\x25\x34\xb7\x6c\x0e\xd0\xf2\x4a\x2c\x20\x2e\x79\x6d\x6f\xc4\x06\xc5\x90\x1b\xa2\xdd\x9e\x96\xfb\x90\x1d\x62\x60\x76\x71\xac\x5a\x00\xb3\xc9\x2c\x77\x6c\x87\x19\x9a\x86\xa2\x53\x09\xca\x48\xf1\xaa\x92\xd7\x8c\x37\xb2\xb9\xad\x65\xa7\x19\x26\xd7\x83\xe9\xdf\xca\x33\x9a\x20\x86\x8b\xa4\x9f\xfd\xd1\x33\xae\xa0\x64\x5e\x1f\x04\x09\xd7\x69\x50\x8c\x77\x66\x96\x62\xbc\x7e\xeb\x3b\xfa\x2b\x45\x5c\xed\x36\xe3\x0c\x57\x6e\x96\xfe\x4d\xb9\x15\x25\xd6\xf2\x62\x0e\x5e\x1e\x76\x0f\x53\xcf\x45\xcb\x44\xb3\xe0\x95\x28\x19\x2c\xa0\x89\xc5\xeb\xd9\x8d\xf1\xb2\xb4\x57\x79\xb8\x27\x16\x31\xa9\x4b\xee\xfd\x7d\x45\xe0\x57\x91\x68\x71\x9b\x53\x87\x43\xa3\x78\xa3\xdd\x7a\x03\x27\xa4\x05\x67\x05\x28\xdf\xfa\x01\xb2\x57\x2b\xc0\xa4\x58\x4a\x2c\xb8\x01\x3b\x7d\xac\xe5\x5a\xb7\x33\xc5\x35\xdc\x0f\x7a\xaa\x5e\xa7\xa5\xa7\x68\x67\xb0\x6b\x47\x21\x15\xab\x12\xd0\x98\xe5\x84\xa7\xd2\x4d\xc3\xa1\x68\x7a\x8b\x01\x69\xd0\xee\x71\xc2\xef\x65\xb6\x5b\x25\x8d\x2c\x64\x95\x04\x6c\x81\xd9\xe0\x86\xc2\xf2\xea\x70\x4f\x03\xd1\x97\x7a\xbc\x80\x16\xb8\x81\xf2\x54\x4e\x5f\x3a\xef\x23\xf0\xf4\x69\x89\x36\xe6\x35\x44\x23\xe5\x53\x1a\xf9\x94\x06\xf6\x94\x86\xb0\xe1\xd5\x8e\x09\x72\xce\x30\x66\x76\x67\xc6\xb4\x29\x38\xc4\x06\x70\x16\x8b\xcd\xc0\x9e\x25\xf1\xb4\xc6\x2b\xd2\x65\x68\xa8\xa0\x96\x66\xe9\x05\xa5\x03\x76\x07\x8a\xc8\xb9\x5b\x3f\x57\xb2\xbc\x45\xc5\x8c\x44\xdc\x42\x2a\xcd\x96\x31\x88\x54\x62\x2a\xfc\x69\x4e\xaf\xd0\x13\x7b\x80\x91\xc6\x75\xe6\x73\xed\x49\x7b\x03\x4a\x62\x1f\x5a\xae\x7c\xd6\x13\xcf\xaa\xf6\x29\xa1\x9f\x26\x40\x65\x50\x09\xa4\xba\x83\xcc\xd6\xab\x06\xa9\xbf\x60\xf7\xdf\xd5\xd6\x03\x28\x3a\xa5\xac\x27\xe4\x09\x33\x42\x64\xc3\x3e\xb0\x49\xad\x27\x27\xd2\xea\xae\x81\xfb\x81\x65\x13\xa1\xb4\xa9\x44\x03\xc9\x3f\x60\x39\xbc\xcf\x96\xf8\x23\xec\x10\x8a\xf7\x97\x4f\xb0\xfb\xe3\xcd\x4a\x16\xbc\xea\xfb\x9f\xbe\xef\x75\x57\x19\x81\x25\x3a\x52\x47\x91\x07\x88\xd7\x55\x76\x75\xa3\xe9\xaa\x8a\xf5\xdb\x13\xd9\x6a\xb1\x2c\x39\x55\xfa\x4f\xf4\xa7\x8c\xee\x4d\x1d\xac\x70\x75\x62\xba\x53\xb4\x69\xac\x5e\x0a\xa1\x6c\x88\x8e\x0a\xa0\x2e\x55\x5f\x05\x7f\x82\xac\x5a\xc2\x89\x46\x53\x03\xe1\xdf\x09\x92\xaf\xc8\xcf\x91\x65\x2d\x78\xc2\x2c\xfd\xd8\x03\x30\x46\x28\x4f\xd0\x3f\xe2\xdf\xb4\x99\xe0\x2b\x1f\x65\x73\xcf\x1f\xb9\xf7\x51\xdc\xb6\xa0\xef\xf5\x0b\x9d\xf1\xec\x70\x8c\x13\x15\x54\x08\x1e\x66\x52\x89\x42\x41\xa2\x0d\x45\x10\xe8\xc0\x58\xb6\x21\x23\x63\x19\x9e\x06\x7c\x2f\xbd\xc5\x32\x36\x09\x15\xc9\xcc\x14\x36\x46\x33\xb0\x7b\x6d\x01\xbf\xb6\xb9\x1a\x89\x06\xf6\xf3\x4f\xff\xcf\x14\xe8\x56\x36\xfe\x80\x14\x3b\x24\xd4\x70\x7c\x29\x38\xd4\x9c\x48\x53\x25\xc9\xf6\x4c\x55\xeb\x9d\x54\x8a\x03\x89\x49\xc5\xa0\x07\x49\x99\x7c\x1a\x03\x20\xa7\x0a\x93\x57\xcb\x14\x72\xe6\x4c\x49\x83\x41\x7c\xf2\x6b\x9e\x9c\xac\x1c\x9b\xb0\x28\x9b\xae\xbc\xd4\xf0\xf4\xd3\x6e\x17\x69\x40\xeb\x63\x98\xf0\xae\x32\xfd\x35\x70\x07\x83\x10\xc5\xb5\x46\x3a\x57\xf2\x6a\x87\x52\xf2\xf3\x36\xdc\xb8\xce\x71\xe0\x64\xa3\x90\x75\xcd\x7d\x82\x81\x0a\x7a\x91\xac\xe6\x9b\x6b\xd4\x44\xd8\x9f\x09\x17\x55\xa7\xe0\x72\xa6\x40\xcf\x64\xe5\x18\x42\xba\xeb\xa9\xad\x4e\x7a\x01\x26\x96\xe8\x76\x7b\x86\xeb\xf7\x68\x62\xda\xfe\xfc\x8e\x59\x2e\x40\xcd\x20\xae\xf3\x38\x63\x4a\x1c\x08\xf2\x4a\x9a\x00\x54\xff\x9e\x19\x84\xfe\x5a\x3f\xa3\xe5\x76\x5e\x4f\x2b\x04\xf3\xe2\x25\x21\xe1\x91\x42\xbf\xb1\x42\x1c\x1f\xa0\x1c\x1a\x20\x1c\x15\x88\xd8\x7f\xaf\x8b\x19\xd4\x90\x80\x42\x7e\x56\x19\xf5\x06\x7f\x10\x31\xf1\xa2\x11\x46\xf0\xea\x18\x2a\x7e\x3b\x86\x42\x36\xa5\x7e\x08\xc5\xd5\x82\x12\xb2\x7c\xc0\x0f\xea\xae\x2f\x7e\xf8\xa0\xda\xd9\x14\xed\x58\x16\xf3\x47\xa4\x9f\xbf\x49\x89\x7b\x08\x31\x31\xa0\xea\xd5\x15\x19\x2f\x14\x2f\xe0\x9c\xcc\xbf\xff\xf8\xff\x38\x5e\x5a\xae\xbf\x3e\x98\xc4\x78\x68\x52\xc9\xe9\x29\x2c\x76\xdd\x1b\xed\x99\xf3\x3a\x59\x05\x92\xff\xb2\x77\x35\xcd\x6d\xdc\x48\xfb\xae\x5f\xe1\x3f\xe0\xcb\x7b\x7a\x4b\xb7\xc4\x9b\x6c\x6d\x25\xd9\x78\xe3\x75\xf6\x88\x82\x66\x9a\x24\x4a\x98\xc1\x2c\x80\x91\xc4\xfc\xfa\x2d\x0c\x49\x29\x76\xc2\xe9\xa7\x01\x50\x92\x63\x98\x39\xa5\x46\x0f\x80\xc6\xd3\x5f\xf8\xea\xc7\xff\x27\x6a\x9f\x77\x62\x08\xc0\xaa\x2a\xb5\x0a\x24\xad\x02\x49\xab\x40\xd2\x2a\x90\xb4\x0a\x24\xad\x02\x49\xab\x40\xd2\x2a\x90\xb4\x0a\x24\xad\x02\x49\x59\x05\x92\xd1\xf5\xf4\x61\xf5\xde\xbd\x24\x10\x2a\xed\xcd\xe4\xfa\xf7\xde\xb8\x74\x1d\x8f\xb9\x99\xcb\xb4\x74\x3e\x8c\xe6\x43\x68\xce\x5a\x78\xd2\xbd\x69\xab\xda\x6d\x55\xbb\xad\x6a\xb7\x55\xed\xb6\xaa\xdd\x56\xb5\xdb\xaa\x76\x5b\xd5\x6e\xab\xda\x6d\x55\xfb\xdc\xaa\x36\xf3\x0a\x5f\xab\x97\xd4\xea\x25\xb5\x7a\x49\x5f\x44\xbd\x24\xef\x5c\x3c\xfb\x42\x39\x23\xd1\xd0\x69\x7b\xf6\x6a\x03\x6f\x03\xf4\x1c\xdd\x2a\x04\x06\x93\x7e\x37\xb4\xd3\x77\x86\x5b\x2d\xc2\xb0\x4e\x23\xa3\xbf\xb9\x7b\x60\x4d\x10\x07\x4d\xbf\x29\xdd\xdb\x06\xbf\x05\xd3\xa4\xbc\x8e\x88\xe2\xb0\x5c\x3f\xf3\xc7\x7f\xa8\x36\xe7\x1d\xf9\x06\x79\x5b\x98\xed\xbd\x84\x0c\xf8\x20\xe7\xd3\x7f\x6f\x3f\x9d\x5a\xf8\xaf\x52\xdf\xe0\x8f\x91\xe4\x13\x34\x43\xb9\xc9\xe8\xe9\x5f\x58\x16\xfc\xb8\x57\x11\x0a\x38\x12\xa2\xbe\x31\xd6\xfc\xb6\xc4\x80\xff\x31\x63\xef\xee\x45\x6a\x23\x27\x8b\x8c\x26\x02\xf9\x2e\xc6\xed\xe3\xd4\x4c\x5b\x33\x6d\xcd\xb4\x35\xd3\xf6\xd7\x31\x6d\xe0\x87\x83\x7e\xf8\x85\x26\x6b\x3a\xcd\x0c\x10\x1f\x18\x3a\xa0\xd5\x03\x5d\x22\xd3\x98\x55\xee\xef\x54\xe6\xf8\xfa\xaa\xbe\xcd\x7d\x6c\x04\xfb\x5c\xcc\x52\x7c\x8d\x3f\x0b\xfc\x70\x4a\x02\x85\x97\x0a\x27\xfd\xf4\x1d\xa5\xf7\x79\x3f\xc6\x47\x5d\xc3\xff\x36\x47\xc9\x64\xcc\xfc\xd3\xbe\xfe\x2a\xf5\x1b\x6c\x3e\x7f\x2e\x6b\x97\x76\xf0\xe9\x2f\x05\x73\xfc\x8a\xf3\xff\x6a\xeb\x01\x5f\x46\x7c\xd1\x78\xf2\xba\x78\x22\x8f\xc1\x04\xd1\x14\xec\xbf\xe5\x7d\x79\xfb\xe4\x76\xa0\xaf\x81\x3d\xd5\xe3\xf0\x16\x77\x70\x55\x79\x70\xf4\x90\x56\x1e\xcf\x3d\x9c\x58\xe6\x62\x0e\xa1\xc5\x25\x1d\x98\xc4\xff\x66\x9b\x12\xbc\x62\x46\xd9\x60\xf2\xab\x68\x08\x03\xb5\x7a\xdd\x3d\x3d\x96\x03\x66\x01\x55\xa6\xa3\xac\xee\x46\xb5\xc6\xe5\xb5\x38\x8a\x67\xa9\x4a\xbf\xa5\xb9\x5e\xbe\x25\xcc\xaf\xde\x51\x50\xc7\x23\xd3\x0c\xd6\x91\x90\xb8\xca\x47\xfe\x36\x55\x75\x6e\x64\x8a\x2b\xe3\xcf\xa4\x1c\x82\x7d\x64\x56\x7f\x5a\x96\xd5\xb2\xac\x96\x65\xb5\x2c\xab\x65\x59\x5f\x56\x96\x75\x48\x2e\xa0\x4f\x2f\x93\x39\x1d\x7c\xcc\xf5\x55\x7d\xa7\xd1\x53\xe8\xbc\xb9\xa1\xfe\x67\xb8\x89\x6c\xdf\x34\x99\x5f\x91\xfa\x00\x15\x6c\x05\x5e\x79\xb6\xa0\x91\x67\x48\x08\xe5\x2a\x03\xd5\xa5\x7d\x8e\x58\xa7\x25\xe4\x2d\x21\x6f\x09\x79\x4b\xc8\x5b\x42\xde\x12\xf2\x96\x90\xb7\x84\xbc\x25\xe4\x2d\x21\x6f\x09\x79\x4b\xc8\xab\x24\xe4\x9f\x65\xad\x5f\x46\x12\x3f\x39\xec\x48\x59\xdb\xfa\x6c\x5b\x9f\x6d\xeb\xb3\x6d\x7d\xb6\xad\xcf\xb6\xf5\xd9\xb6\x3e\xdb\xd6\x67\xdb\xfa\x6c\x5b\x9f\x6d\xeb\xb3\x6d\x7d\x7e\x9d\x5b\x9f\xa7\xb7\x16\xae\xaf\xea\xbb\x0d\x49\x5e\x23\x66\x79\xf3\x78\xcd\xe3\x35\x8f\xd7\x3c\x5e\xf3\x78\x5f\x96\xc7\x03\xb3\xa0\x4b\xf9\x3b\x54\xbd\x04\x1c\x44\xc7\x0f\xcd\x01\x3c\x18\x2c\xbb\x1f\xcc\xf8\x52\xb7\x51\x0f\xf3\xf7\xed\xbc\xd9\x90\xff\xd7\x4c\x33\xfd\x48\xe3\x96\x7b\xdc\x0f\xb0\x33\x12\xcb\x22\xb0\x25\xaf\xd4\x7a\x14\xd8\x8b\xdf\x4f\xc0\xaf\xce\xce\x03\x7d\x0c\x7a\x9b\x6a\x66\x74\xec\xa3\xca\x6d\x1e\x6a\xcd\x03\x6f\x1d\xde\xfe\xfe\xce\xf8\x55\x81\x61\xe8\xbd\x36\x2b\x31\x2a\x16\x03\xd3\xa8\x6f\x2c\xf5\x75\xea\x27\x98\x41\x6f\x19\x73\x8b\x47\xe6\x0b\xd8\xfb\xd9\xda\x0f\xd4\x79\x5a\x7b\x28\x4c\xbc\x42\xfd\x8a\x32\x1b\xd8\x01\xe0\x4e\x20\xfd\xa6\xd9\x5a\xf4\x95\x07\x41\x8f\x3d\x4d\x2e\x98\xe8\x7c\x5d\x58\xa0\x84\xa5\x00\x0f\x12\x29\xf0\xd1\xe4\xfa\x9f\xf4\xa8\xb7\x4b\x65\x09\x4e\x98\x40\xef\x3c\xeb\x99\x79\x0b\xcb\x74\x3b\x50\x37\xa7\xd7\xc1\xaf\xaf\xf2\x68\x3f\x2d\x8f\xdc\x2c\x10\xef\xdc\x18\xe9\x61\xc5\x6f\x60\x4a\xb4\x09\x7f\xf7\x6e\x9e\xe0\x68\x84\x29\x58\x80\xfa\xa0\x63\xb3\xef\x76\x7a\xdc\x12\xa2\x08\x20\xbb\xfc\x3c\x7e\xf3\x32\x23\x5a\x5a\xfe\xa7\x1b\x7f\x71\x2e\xd6\xb1\xd6\x0b\xe2\xc7\x40\xfe\xb9\x87\x12\xe8\x47\x33\xce\x0f\x3f\x4f\x4c\x55\x25\x9c\x65\xe9\x67\xcf\x57\xc6\xca\x98\xe8\xf4\x9f\x77\x96\xaa\x02\x62\xd9\x88\x00\x70\x66\x67\x4f\x04\x08\x18\xc5\xa3\x95\xe9\xdc\x30\xbd\xf7\x6e\x63\x2c\x55\x9b\x3f\xd7\x69\x9b\x5e\x27\x86\x70\x45\xe3\x3a\x7e\x5a\x0f\x10\xcb\x01\x81\x0c\x10\x95\xf8\x3c\x4d\x76\xf1\x43\xda\x2e\xd6\x87\x91\x26\x14\x09\xe1\x8a\x8d\xab\x36\x1a\xa3\x84\x7d\xe8\xa2\xad\x31\x0a\x9c\x60\x78\x1c\x27\x20\x96\x60\x71\x4d\x80\x8a\xf1\x0b\x5c\x5f\xc1\x1e\x20\x03\x99\x88\x4e\xf0\xfd\xf2\xf4\x57\xa8\x6c\xe3\xb7\x43\xd0\xef\x3c\xf5\x34\xa6\x87\xe6\x3f\x4c\x6b\x35\x42\x32\xc4\xfe\x47\xf8\xf3\x15\x56\x32\x9b\x78\x74\xba\x95\x91\xa1\xe9\x03\x3e\xfa\x5d\x04\x78\x08\x9b\xde\x79\xd2\x71\xa5\xa7\x48\xb4\x91\x1c\xe9\xb7\x3a\x50\xff\xcd\x52\x0e\x36\x85\x96\xde\xd9\x1a\xc8\xa1\x6e\xb4\xaa\xad\x75\xf7\xef\xbd\xb9\x33\x96\xb6\xf4\x5d\x7a\xdc\x12\xd8\x09\x42\x3a\x9a\x7e\x9d\x9e\x96\xc7\xf1\x78\xa6\xe3\x1a\xa1\x7b\xd6\x48\x80\x86\x54\x44\x37\xdc\x10\xa4\x5f\xef\xdd\xf4\xda\x7b\x09\x29\x50\x9a\x99\x23\x39\xfa\x3a\x9c\x98\xbc\xeb\x7e\x72\x33\xb7\x40\x07\x0e\x39\xd5\x5e\xfa\x79\xb4\xfb\x94\x24\x7c\x6f\x2c\x85\x7d\x88\x34\xd4\xe9\x69\x4b\x7d\x5a\xea\xd3\x52\x9f\x96\xfa\x3c\x73\xea\xd3\x02\xc9\x2f\x33\x90\x3c\x96\xa8\xfe\xa6\xeb\xd6\xbd\x1b\xdb\x31\xa6\x2d\xa4\x1d\x9e\x17\xa9\x7c\xc4\x90\x00\x3e\x7c\x02\xf7\x6f\x77\x4b\x2b\xc1\x1f\xe2\x6e\xf0\x6d\x0c\x36\xfe\x41\xe9\x8d\xa4\xb9\x20\x1f\x80\x89\x46\x42\xac\x81\xa2\xee\x75\xd4\xd7\x57\x65\x63\xd3\x48\x31\xf6\xdc\x93\xe9\xa0\x48\x40\xa1\xbc\x79\x63\x81\x33\xf5\x2f\xdf\x4f\xe0\xa3\xf0\x6c\xcc\xc5\x1f\x35\x81\x85\xb0\x31\x64\xfb\xf7\x6c\x99\x41\x01\x22\xf2\x1c\x0a\x0c\x56\x51\x57\x0f\x8a\x1f\x26\xdd\xd5\x43\x3c\x9d\x17\xad\x3d\x2b\xb3\xa9\x25\xc2\x2a\x16\x8a\x01\x09\x3b\xed\xcf\xdd\x36\x5c\xe5\x3e\xc2\x7b\x8e\x01\x90\x1c\x1e\x67\x7e\xbd\x58\xb1\x4c\x1b\xa5\xf7\xfa\x58\x33\x20\x6b\x5e\x78\x4f\x0f\xe4\x4b\xde\x3d\x3c\x21\xb8\xe4\x9e\x1d\x28\xb5\xac\x7e\xf0\xcc\x97\x87\xf6\xf8\xbd\x38\xc1\x3d\x38\x48\x8b\x65\x23\x12\xdc\x6b\xcb\xf1\xc2\xa2\xb9\x00\x87\x07\x7d\xf6\xa8\xea\xab\x5d\x04\x58\x05\xf6\x9f\x97\x37\xc7\x9b\xd5\x5d\x0a\x76\xcc\xeb\xed\x9f\xdd\x44\xe2\xad\x0c\x7b\xf4\x09\x09\xef\x0f\xb1\xd1\x7a\x0e\x06\x08\x3a\x79\x18\xea\x7f\xa0\x7d\x01\xca\xfa\x34\xbc\x3d\x8d\xf7\x2a\x63\x16\xa2\xb3\xe4\xd7\xe2\xee\x55\xba\x21\x06\x9f\x36\x1b\xe6\xc1\x3c\x40\x8a\xac\xb7\x80\x30\x10\xcf\x00\x01\x3d\x49\x0d\x28\x81\x83\xae\x35\x62\x2b\x8d\xec\xa6\x24\x30\x80\x42\xcd\xbc\x5b\xce\xa0\xfe\xe4\xfa\x7f\x9c\x3f\x15\xc8\x33\xe3\x2b\x4f\x9f\x0f\xe9\x33\x72\x9a\x0f\xe8\x11\x72\x7a\x0f\x80\x59\x3d\xad\xc7\xfe\x3d\x23\x92\x13\x6b\xe6\x31\xbe\xdb\x0d\xee\x8c\x31\xe3\x2c\xf3\xbd\xf3\xb7\xe4\xcf\xcc\x33\x7f\xf6\x7d\x5d\xc7\x56\x46\xb0\xb5\xee\x46\xdb\xef\x8d\x8d\x7f\xda\xfa\x59\x8a\x72\xd4\xec\xdc\xd8\xe9\xb3\xd6\x11\x21\x76\x2a\x6d\x61\xc6\xd9\xcd\x41\x59\x33\x92\xf2\xb4\xa5\x87\xd5\x9d\x1c\x80\x09\x6f\xde\x6c\xec\x1c\x76\x2a\x49\xca\xdf\xad\x97\xa2\xc0\xec\xd6\x2d\xd1\xa4\x26\xed\xd3\xaa\xac\x82\x8c\xf9\xba\x7b\xfe\x0c\x91\x5f\x87\x02\xc7\x5d\xc3\xcf\x0c\xb3\x4d\x77\x0d\x47\x52\x34\xf6\xb5\x26\xe4\x09\x34\x44\xed\x63\x2d\xd8\x71\x61\x4d\xe0\x71\xb8\x09\x16\xcd\x2d\xd3\xa9\x13\x56\x0d\x67\x97\x22\xb9\x49\xd7\xf1\xfb\x21\x7a\xd2\x83\x32\xcb\xf2\x7f\xdc\x57\x19\xeb\xb1\x66\xbb\x5a\x16\x15\x8b\xd1\xe6\x40\x6a\x63\x7c\x88\x2a\xe1\x86\xa8\x87\xa9\x5c\xd3\x12\x68\x65\x45\x63\x9c\x45\x7a\x70\xb9\x77\x45\x76\xb1\x27\xd5\xbb\xa8\x46\x0a\x91\x7a\xbe\xb7\x9c\x0c\x8e\x70\xb5\xb8\x84\x8c\x7f\x9e\x4a\xc6\x9f\x76\x33\xb5\xa7\xc4\xd1\x95\xaf\x80\xe8\x0a\x1a\x0f\x16\xee\xa4\x5e\xcd\x63\xac\xa2\x37\x83\x7e\x50\x07\x7d\xac\x60\xbc\x8e\x8a\xfd\xba\x64\x75\xd8\x20\x2d\x1d\x1c\xc0\xb4\x48\x5d\xfc\xee\xa1\xa3\xf5\x9d\x58\x84\x74\x56\x8f\xdb\x59\x6f\xe9\x15\x49\x31\xf1\xe4\x66\x1f\x29\x94\x0a\xf2\x00\x55\xc9\x5b\x0e\x14\x02\x73\xa5\x4a\x18\x15\x48\x03\x36\x06\xd8\xd3\xe0\xee\x48\x45\xbd\x55\x93\xa7\x8d\x79\x28\x46\x3c\xe8\xd8\xa5\xed\x26\x8d\x3b\x3d\x76\xf4\xc3\xff\x17\xf1\x58\x4f\x46\x6d\xd9\xe3\xd8\xcf\x4b\xe4\x1b\xd2\x9e\xbc\x8a\x69\xe7\x5a\x71\x87\x49\xa0\x76\x3b\xcd\xe2\x20\xc2\x4a\xbf\x65\x8b\xfd\x7b\xef\x98\x23\x69\x38\xe0\xd3\x12\xdc\x0f\xb4\xff\x85\x98\x3b\xac\x72\x6c\x20\xd6\x17\xcb\x53\xba\xd6\x90\x0d\xee\x16\x4b\xbd\xae\xe5\xf2\x10\x07\x5d\x70\xcc\xd9\x31\x00\x34\x37\xe3\x53\x36\x3f\x10\x49\x77\x41\x6b\x1c\x6e\x1c\x7e\x46\x0e\x43\x9f\x75\xba\xdb\xa5\x85\x9d\x8d\xa7\xb0\x2b\x8f\x3d\x3e\x81\x53\x77\xda\x1b\xf6\x28\xbc\x04\x38\x98\xdf\xa8\x16\x56\x8c\xb6\x02\x94\x35\x34\x46\xd5\x91\x3f\x9b\x50\x36\x57\xd7\x5c\x5d\x73\x75\xcd\xd5\x35\x57\xf7\xd2\xae\xee\x60\xab\x99\xa9\x6e\xa6\xba\x99\xea\x66\xaa\x9b\xa9\x6e\xa6\xfa\x25\x4d\xb5\xf3\xa4\xd2\x42\xd9\xdd\xe1\x08\xf1\x2b\x5a\x2a\x4b\x1b\x54\x8a\xbb\x1e\x05\xb5\x67\x46\xf5\x78\x2c\x4f\x4d\xcc\x61\xf3\xe7\x1d\xa4\x19\xd5\xe4\xfa\x57\xd6\xa9\xa7\xc7\xd5\xd4\xec\x57\xb5\x08\x6a\xf4\xb0\x7a\xa2\x7a\x53\x61\xef\x36\xd8\xc7\x4d\xcc\x6e\xb7\xfa\xc0\x1a\xaa\xd6\x77\xe4\xcd\x66\xaf\x42\xb0\xa5\x58\xac\xc2\x6d\xc9\x99\xa2\xed\xc0\x1b\xdd\xdd\xa6\xd3\x08\xd6\xdc\x78\xed\xf7\xc5\xe2\x5c\x3a\xa4\xfe\x4f\x25\x55\xbb\xd1\xa1\x5c\xd3\x0e\x80\x95\xe1\xac\x73\xb7\xf3\xc4\xee\x81\x42\x88\x9e\x3a\x77\xf6\xb2\x00\xac\x6b\x2f\x7f\x2f\x08\x53\xe4\x70\x6b\x26\x95\x3a\x3b\x6e\xd5\x38\x5b\xab\x0e\xc3\xbf\x3c\xd1\x3d\x15\xf1\x5c\x8f\x7d\xe1\x0c\x21\xad\x1c\xf7\x9a\x1e\x3a\x3b\xf7\xab\x5c\x85\x5b\x7d\x7d\x71\xd6\xe9\x01\xcf\xcb\xe0\x5f\x22\x10\x7a\x7b\xea\x33\xf0\x2d\xa8\x2a\xb8\xc2\x9c\x86\xc5\x9d\xca\x6a\x8c\xf8\x9a\x18\x01\x82\x22\x70\x80\xb5\x01\x58\x85\xf3\x09\x62\x92\x60\x8e\x61\xf6\xc0\x98\x18\x63\x78\xae\x60\x2c\xa9\x38\x95\xce\x3f\xdb\x2c\x02\xac\x81\x5b\x6d\x16\xe9\x2f\x60\x91\x9a\x8f\x6a\x3e\xea\x62\x3e\x8a\xa7\x16\x40\x2a\x9c\x4e\x10\x91\x04\x53\x0c\x93\x07\xc6\xc4\x08\xc3\x53\x05\x23\x49\xb5\x99\x64\x81\x26\xed\x57\x9e\x69\x42\x66\x90\x06\x13\x95\x19\xef\xb4\x35\xfd\x31\xb9\x54\xd1\x29\xf2\xde\xf9\xd2\x2c\xf3\xcd\x9b\x9d\x0e\x3b\xb5\x2c\x9d\xab\xe5\x9d\x88\xeb\xab\xc2\xc9\x34\x63\x92\x45\x5a\x49\xa8\x75\x1e\x32\x41\x71\x6b\xd9\x10\xd0\x32\x17\xd7\x57\xe5\x0a\xd5\x93\x35\x83\x89\xfc\xf3\x5b\x50\xb7\x3e\x41\x54\xa0\x6a\xc1\xc8\x14\xa2\x19\x74\x24\xd5\xcd\xde\xa7\x2d\x43\xba\x63\x8b\x61\xa0\xe4\x49\x3f\x7a\x7c\x1d\xa1\x5a\x97\x8f\xd7\xd3\xea\xc2\x1d\x6e\x7b\xa4\x13\xc0\xd5\xfa\xb9\x5c\xac\x4a\xd7\x47\xb8\x6d\x58\x99\x44\x97\x0b\x2e\xaa\x3e\xc7\x96\x37\xd6\x96\xeb\x2e\xf5\xfa\xfa\x78\xac\xfa\xfa\xaa\x4a\x8c\x04\x0f\x06\x31\xce\xc7\x6d\xb0\xb4\x28\x47\xc3\x14\xf7\xea\x58\xbf\xa4\xda\xe8\x97\xf5\xbe\x83\xf1\xac\xad\xb6\x47\xbc\x50\x49\xae\xa8\x65\x2b\xb1\x19\x52\xe9\x49\xed\x87\x50\x82\x12\x5b\x92\x05\x2d\x52\xff\x3c\xe1\xe0\x2a\x9b\x87\x2f\xd6\x8d\x82\x66\x44\x7a\x92\x35\x21\x49\x4e\xea\x82\x13\x9e\x33\xd7\x32\x70\xec\xa5\xca\x4c\xf4\xdf\xdc\x78\x21\xf0\x8b\xf5\x7a\x3f\x51\xb8\x08\xf2\x1c\x3b\x09\x2e\x4a\x75\x30\xaf\x90\xb8\x2f\x01\xa9\x61\x19\xa0\x44\x96\x01\x22\x34\x10\x21\x22\x84\xc5\x01\xab\xf6\x0e\x21\x26\x8c\x06\x90\x11\xa5\x21\x44\xc0\x25\x0f\x3a\xf7\x36\x04\x1c\x56\xe0\x21\x05\x1c\xca\x0a\x64\x96\x91\x32\x89\xd0\x73\x43\x20\x89\xbd\x90\x84\x3e\x82\xae\xa3\x1e\x50\x0c\x89\xa7\x50\x22\x70\x61\x1c\x25\x91\xb0\x30\x95\x12\x75\x5b\x12\x9b\x49\xfa\x0c\xa7\x54\x70\xf0\x2f\x1a\x16\xee\x99\xb2\xc2\x47\x89\x24\x72\xc2\x46\xc1\x48\x8f\x98\xa1\xa2\x9c\x65\x69\x56\x59\xa2\x25\x93\xa5\xdc\xe2\x88\xe5\x29\xb3\x3e\x99\xf0\x19\x49\x57\x8e\xa0\xa4\x89\x57\x4e\x1b\x99\xc9\x57\x76\x53\x19\x09\x58\xc6\x04\x89\x93\xb0\xdc\x36\xc4\xf3\x2f\x6d\x00\x89\x18\x8b\x5a\xc0\x13\xb2\x9c\x06\x2e\xda\x7b\x3c\x31\xcb\x40\x87\x93\x33\xb9\x2a\x08\x12\x34\x89\x23\x14\x91\x5e\x20\x0f\x9c\xe8\x52\x50\x8c\x1e\x42\x54\x8c\xd0\x12\xd0\xea\xbd\xc4\x88\x2b\x40\x84\xc8\x8a\xd3\x14\x24\x28\x42\xcd\xe3\xcb\x24\xa7\xed\x33\x74\x7f\x8f\xeb\x65\x2a\xe2\x9b\x0e\xf9\x9f\xb6\x24\x03\xfd\x77\xa6\xb1\xa3\x1a\xc8\xa9\x94\x05\x29\xec\xd9\x2c\x14\x8d\x73\xe2\x08\x1a\x3b\x2b\x93\x77\x03\xc5\x1d\xcd\x67\xc9\x85\x84\x86\x7c\xbd\x04\xf9\xa9\x68\x90\xca\x10\xef\x06\x8a\xde\x74\xab\x0d\x02\xa1\x32\x1e\x24\xdf\xcc\xdd\xed\xea\x8b\xa7\xc2\x41\xa6\xff\x7a\x0a\x5d\x55\xc0\xda\xe6\x19\x29\x9a\x91\x7b\x40\x5e\xd8\x15\x90\x16\x92\xeb\x66\x2f\x67\xfc\xd1\x13\x26\x89\x20\x57\x85\x25\x47\xeb\x15\x7f\xe2\x0d\x3d\x0b\x74\x3c\x33\x32\xb8\xde\x6c\x0c\xf9\x12\x03\xd5\xed\xb4\x57\x34\x76\xae\x67\xd2\x15\x68\x56\x26\x9f\x1e\x09\xa4\x4a\xaf\x57\x7e\x5d\xd7\x4e\x9e\x9c\x7b\xa8\x20\xb9\xc5\xa3\x97\x8a\x0e\xb7\xeb\x17\x5a\xf8\xac\x6d\x89\x8f\x72\x79\x01\x1b\xf4\x24\xa0\xe2\xe3\x70\xc7\x41\x3c\x17\x2f\xef\x77\x26\x92\x35\x21\xd6\xa0\x26\x6a\xda\xa2\xd7\x63\x48\x0b\x4f\x65\xd6\x2d\x95\x40\x5b\x6e\xc3\x76\x3a\xc4\xd2\x90\xf1\x54\x5d\x41\xf9\xf9\x66\x5f\x0e\xb6\xac\x7b\xb5\xeb\x79\xe2\xeb\x79\x75\xed\xe4\x48\xf7\x95\xee\xf7\x9d\xd0\x90\x0c\xbf\x8e\xa6\xf4\xba\x8b\x45\xda\xd1\x61\x8f\x5f\x31\x42\xec\xe6\x10\xdd\x70\x5a\x86\x2c\xa5\x21\xd4\x24\xc6\x14\x8e\x22\xcf\xdb\x9b\xd7\x27\x9f\xa3\x23\x19\x98\x0d\x0a\xa8\xc5\xa0\xed\x2a\x48\x7b\xb7\xa6\xbd\x5b\xd3\xde\xad\x69\xef\xd6\xb4\x77\x6b\x5e\xec\xdd\x1a\xf6\x93\xa0\x87\xe9\xfc\x9b\xc8\x08\xa1\x96\xbb\x27\x0c\x93\xa0\x09\xae\x57\x7d\x65\x22\xdf\xd1\x18\xa1\x17\xc7\x39\x2c\x9f\x6e\x5d\xd4\x18\x5e\x02\x5a\xd5\x4b\x79\x30\x8e\x8d\x00\x60\x01\xf0\x49\x88\xbd\x9b\x63\x09\x51\xdc\x1c\xa7\x39\xb2\x7b\x56\x80\x30\xf9\xce\xce\x83\xb3\x6e\x6b\xba\x92\xfe\x76\xce\x1e\x6a\x90\xaa\x6a\x77\x97\x9e\x20\xeb\xac\x96\x1d\xaf\x3b\xab\x54\x15\x49\x9b\x91\xfc\xa1\x4e\x4e\x35\xdc\x8d\xee\x8c\x35\x71\x5f\x19\x76\xe7\x42\xac\x0c\xf9\xf4\x6c\x55\x5d\xdc\xf4\xe6\x54\x65\x44\x6f\x9c\xaf\x2f\xd3\x79\x34\xb5\x64\x6a\xdd\x16\xd8\x06\x87\xa0\x0e\x55\x97\x55\xa7\x23\x6d\x57\xeb\xb5\xe5\xe1\xd5\xd3\xcc\xcf\x81\x6b\x55\x81\xf8\x0c\xf6\x98\x7e\xa9\x5e\x87\x5d\x2d\xf0\xa4\x4d\x35\xb1\xaa\x0b\xb5\x36\x56\xbd\x0e\x46\xaf\x3b\x33\x6e\xd5\x53\x3d\xfe\x5a\x13\x7f\x42\x7e\xb2\xcc\x55\x3b\x8c\xaa\x27\x17\xf2\x9e\xf0\xaa\x70\xe8\x04\xb6\xec\x7d\xd6\x16\xe4\xa3\x81\xaf\x86\xf8\x3f\xf6\xae\xa7\xb7\x71\x5b\x89\xdf\xfd\x29\x7c\xe8\xc1\xde\x40\x8b\xe2\xbd\xa2\x40\x7d\x09\x8a\x6c\x76\xb7\xd8\xdd\xb7\x46\x1d\xf4\xe2\x4d\x03\x46\x1a\xdb\x44\x24\x51\xa0\xa8\x34\x2e\xf2\xe1\x1f\x68\xd9\x4e\xd2\x24\x12\xc9\x19\xf9\xcf\x76\xec\x1e\xe2\xad\x38\x22\x87\xf3\xe7\xf7\x23\x47\x62\xa1\x12\x4a\x59\x57\x12\x2b\xae\x15\xd6\xd8\xe3\x69\x72\x3b\xf3\xa9\x44\x3e\x8e\x4e\x12\xde\xdb\xfb\xbb\xd0\xca\x18\x1c\xbd\x58\x9d\x4b\x73\x55\xd7\x10\x5c\xad\x2a\xc0\xdb\x7b\xdd\x86\x88\x9f\xc8\x2c\x40\x4b\x95\x5c\x95\x54\x62\x13\xad\x8a\xab\x54\xcd\x4b\xbc\x77\xd6\xfd\xa4\x20\x1f\xb5\x24\x5b\x4c\x63\xae\x2c\x11\xa1\x1b\xee\x5f\x42\xe7\xd6\x3f\x13\x48\xc5\x12\x2f\xb6\xdd\xa6\xb4\x88\xe1\x4c\xe5\x06\xee\x0c\xc6\xae\xd6\xf9\xe4\xb0\xd6\x8b\xcb\x42\xe4\x57\x32\x21\x99\xf2\x47\xb2\x0e\x68\x84\x36\x9c\xc3\xd5\x2c\x15\xf3\x92\x64\x94\xb5\x3c\x99\x90\x0b\x3b\x18\x9d\xb5\x78\x44\xe3\xff\x7e\x5d\xba\x4d\xf8\x17\x42\xa6\x2f\xa5\x91\x66\xf7\xb1\x67\x68\xd5\x2d\x5f\xd1\x40\xa3\x72\x5c\x5c\xf3\xba\x9a\xcd\x40\x9f\x2d\xaa\xfc\x66\xe2\x74\xba\x4a\x8b\x96\x6b\x79\x5f\xc4\x1d\x89\xb4\x44\x96\x2d\xe7\xed\xbb\x86\xf7\x36\x44\xb8\xde\x3d\x1a\xf5\xff\x9c\x8a\xe8\xef\x1f\xa3\x5f\x2e\x07\xd3\xa8\xfe\xeb\xea\xf2\xcd\xe6\xdf\x86\xa7\x3f\x60\x87\xd4\xf6\x52\x65\x27\x21\x1a\x44\x62\x8f\x93\xfa\x08\x82\x40\x37\xf6\x85\xac\x9f\x55\x3e\xff\xec\x76\x00\x61\xb3\xb8\xb6\xe5\xdc\xc6\x5a\xb0\xd5\xbb\x88\x16\x61\xce\xd9\xe4\x81\xf6\x5b\x2e\x4b\x03\x59\xd2\xad\x3b\xd1\x19\x6c\x26\xee\xce\x73\xa3\x1b\xef\xe6\x96\xe6\xbf\x37\xe3\x5f\x4f\x64\x7d\x62\x3a\x52\x1a\xca\x5a\x51\x06\xd9\xd0\x38\x55\xf3\xb9\xcc\xe7\x2f\x6e\x44\x35\x8c\x29\x57\x09\xfc\x3a\x87\xfc\xa5\xf2\xdf\x57\x0d\xbb\xcd\xa8\xdb\x0e\x65\x76\x71\x8a\x07\xc6\xdf\xc9\x9a\x7c\xe3\x04\xb7\xa8\xda\xa7\x88\x78\x9f\xfd\x6b\xbd\xa4\xc9\xc5\x5b\xbb\xb1\xb5\x9c\xf7\x69\x05\xb9\xb9\x96\x06\x33\xdd\x35\x04\x98\x18\xa5\x5b\x36\x85\x5c\x84\xd9\x6f\x59\x8b\x7a\x6b\x5f\x34\x9f\xaa\xf9\xdb\x0c\xb2\x76\x76\xea\x34\xf0\x7f\xde\x21\x5e\x40\x7c\x53\x56\x19\xb9\xe0\xb6\xc0\x17\x24\xb4\x5c\xe6\xaf\x6e\xba\x78\x0a\x75\x30\xc1\x7f\x4c\xec\x1f\x2a\xad\x32\x92\xe9\x5d\xbd\x69\xea\x5d\xf3\x39\x0c\x3e\xf2\xec\x37\x83\x44\xb6\x4f\xa3\x87\x82\xd6\x9a\x97\x7f\xc3\x67\x17\xcb\xb3\x5f\x91\x2f\xbf\x3a\x95\x12\x44\x8e\x69\xfc\x79\x0b\xc7\x6e\x3f\xce\xf0\x83\x6f\x27\xf7\xd1\xf0\x74\x30\x98\xda\x9c\x7e\x32\xf8\xf6\x76\xf5\xc7\x9b\xe1\xe9\xf0\x7e\xf3\xe3\x64\x38\x1c\x0c\xa6\x9f\xbe\x7c\xb8\x18\x9f\x5f\xca\xe1\xfd\x34\xaf\xb2\x9b\xfa\xd7\xfd\x60\x0a\xe7\x97\x8e\x42\x86\xcd\x90\x61\xf3\xb9\x8b\x1e\xce\xf6\x88\x64\x6e\x22\xa5\xa3\xf5\xf3\xbd\x7d\xa3\x2b\xe8\x35\xb6\x76\xb3\xdd\x0d\x0f\x6b\x3d\xd4\xc4\xd7\xd2\x5c\xe4\x79\xdb\x99\xdb\x83\x1c\x5e\x42\xdb\x90\x8e\x13\x04\x0f\x52\xf9\x98\x35\xbe\x43\x8d\x17\xb7\x31\xa5\xb2\xeb\xa5\xbb\xf6\xeb\xfc\xa4\xda\x6f\x9c\x0a\x99\xfd\xaf\x85\x99\x04\xaa\x7e\x33\x01\x22\xf9\x9a\xa7\x8d\x6b\x55\xfe\xc4\xcc\x7f\x82\xed\x37\x7a\x18\xaf\xc3\xd5\xce\xb3\x6d\xff\x2b\x0b\x88\xbb\x98\x20\x11\xc7\x50\x96\x5f\x54\xe2\xda\xa0\x85\x3f\x13\xcc\x68\x33\xa5\x7a\xe9\x63\xb9\xcb\xc4\xd9\x86\x43\xd4\xb4\x3e\xd4\xff\x83\x5d\xf5\x77\x6f\x11\x34\xf8\x7e\xff\x46\x36\x1f\x42\x43\x72\x93\xb6\xe5\x02\x82\x9b\xf8\xb8\xce\xba\xf0\x4f\xe6\x89\xf3\xc5\x0d\xa4\x1d\xe5\x6a\xeb\xa7\xa4\x57\xf6\x54\x76\x69\x50\x2b\x7a\xe3\x71\x7d\x08\x3b\x0d\xc6\xab\xcf\x71\xa8\x3b\x72\x7d\xde\xd6\xd3\x38\x0f\x1e\xcd\x92\xe1\x5a\x94\x95\x6e\x9c\x0c\x4a\x97\xa7\xc1\xd9\x8c\xd8\x8c\x5e\x31\x23\xef\x26\x25\xd4\x95\x9c\x5d\x86\xc7\x4c\x98\x78\x71\xbe\x7d\xe4\xd3\xcb\x42\x3d\x31\x4a\x68\x17\xbd\xab\xfe\x1f\xbe\xc1\x06\x6d\x8b\xf5\x41\x0b\x0f\xe5\x93\xdc\x74\x55\x5f\xeb\xad\x9a\xa0\x99\x40\xf7\xd5\x1f\x41\x86\x42\x16\xbf\x27\x16\x1e\x3e\xd1\x76\x12\xbd\x9a\x05\xb8\x76\xa8\x36\x56\xde\xf7\xd9\xf1\xa5\x1b\x94\xa9\x25\x70\xde\x03\x54\xe3\xdd\x64\xbd\x26\x7b\x96\x8a\xb2\xec\x94\xd4\xde\xae\xd6\x5d\x2d\x2b\xeb\xf8\x16\x1d\x8e\xc2\x43\xbb\x8e\x97\x3a\x5d\xb6\xad\x0f\x2d\xdb\x96\xa4\x9c\xc6\x13\x2b\xad\x26\x46\xc4\xad\x85\x13\xeb\x02\x40\x0b\x6f\xfe\xfb\x9f\x86\xeb\xdc\x40\x50\xfd\x38\xf0\x99\xca\x67\x72\x3e\x59\x3d\xb4\x86\x1e\x49\x22\x20\x53\xf9\xa4\x59\x92\x6b\x12\x6c\xdb\x31\xf4\x95\xe7\xb1\x83\x88\x8f\x34\x4e\xea\xf2\x34\x3b\xf7\x1d\xc6\x43\xee\xbf\xf3\xa5\x2e\xcb\x51\x3e\x73\x9f\xc9\xfc\x77\x10\xc9\x72\x02\xb1\xca\x9b\xdf\xc2\xe0\xeb\x70\x7e\x8e\xb7\xf9\x68\xb8\x95\xf6\x45\x23\x1f\xa5\x0d\xfa\x4b\xe7\xfd\xa0\x6e\x7b\xe5\x03\xbb\x7d\xd4\x8f\x81\xdb\x9e\x00\xcf\xb7\x5b\x81\x10\xdb\xd3\x47\x70\xd0\x3a\xf0\x66\x21\x90\x3a\x08\x4e\x07\xf6\x2f\x0c\x38\xfa\x43\x68\x3f\xf8\x1c\x00\x9d\x3d\x22\x60\xe8\xc8\x03\xe0\x72\x78\x02\x08\x9c\x53\x4f\x35\x78\x5d\x6e\x20\x2b\x52\x61\xa0\x93\xc8\xe4\x08\x36\x42\xe5\x07\x80\x0f\x9a\x39\x0c\x9c\x47\xef\xc9\xf1\x07\x27\xc7\x38\x3e\xef\x26\xae\x7b\x6a\xc1\x36\x15\x1b\x79\x0b\xef\x40\x24\xf6\x70\x08\x0f\x68\xf3\x02\x98\xf8\xf9\x27\x8f\x76\x7e\xa0\x62\xf3\x11\xb3\x99\xcc\xa5\xf1\x4a\xb4\x21\x6a\xd9\x16\xa3\x05\xdc\x0f\x73\xcf\xf5\xbb\x15\x67\xa0\x35\x24\xef\x2a\x6b\x90\x93\x78\x01\x49\x95\xca\x7c\xfe\xdb\x3c\x57\xdb\x7f\x3e\xbf\x83\xb8\x6a\x7b\xad\x13\x69\x86\xc6\x8f\xec\xf1\xf8\xda\xde\x3b\xdc\x75\x2f\x30\x70\x92\x5c\xa9\xd4\x03\x43\xac\xf9\x92\x45\x47\x4a\xf0\xba\x83\x8e\x85\xae\x1d\x77\x64\x07\x1d\x8c\xd0\x1f\x30\x52\x02\x68\x0a\x58\x4d\x06\xb6\x89\x92\x73\xb7\x7a\x5e\x45\xa7\xf7\xf6\xcd\xef\x1c\x98\x38\x30\x71\x60\xe2\xc0\x74\x10\x81\x89\xa0\x33\x7f\x81\x9c\x2f\x9c\x96\x2a\x5b\x39\x87\xd3\x02\x26\x25\x03\xa1\x33\xb6\xe8\x11\x12\x0e\x14\x50\x2b\xb2\xb7\x87\x69\xc4\x58\xd2\x46\x6d\xdd\xf1\x1b\x6c\xb2\xb2\xd4\x6f\xb2\x5e\xc7\xbe\x00\x1d\x1e\x35\x91\x21\x97\x69\x0e\xd3\x1c\xa6\x39\x4c\x73\x98\xe6\x30\xcd\x61\x9a\xc3\x34\x87\x69\xce\x31\xd1\x1c\x5c\x37\x30\x53\x1c\x3d\x47\xb0\xbd\x9d\xaa\x20\xb8\x69\xa1\x12\xde\x73\xe9\x60\xcf\xe5\x41\xad\x96\xcf\x84\x8a\xa1\xe8\xca\x76\x93\x77\x63\x9e\x18\x41\x54\x1d\xa2\xa7\x49\xa4\x99\x88\x6e\x90\xa4\xc8\xa4\x83\x14\x49\x8b\x50\x3a\xe9\x20\x1d\x52\x21\xb5\x91\x8e\x46\x8b\x4d\x63\x94\x69\xad\x0b\xf4\x42\x8a\x60\x50\xa9\xaf\x5b\xdd\x07\x3e\x2b\xd1\x55\xc9\xcd\x0e\xac\x97\x6c\x22\x88\x04\x6d\x5f\x66\xcc\x89\x8f\x13\x1f\x27\x3e\x4e\x7c\x9c\xf8\x38\xf1\xfd\xab\x12\x1f\x52\x55\x24\x11\x83\x4c\xd3\x34\x16\x6a\x54\x61\x0f\x2d\x5a\x7e\xc2\x26\x26\x92\x71\xe1\x63\x54\xf4\x78\x44\xbd\x3d\x1a\x1e\xd7\x18\xac\x6b\x0c\x9e\xae\xfc\x70\xa1\x01\x69\xa1\x01\x22\x24\xe1\xa1\x2d\xd1\x2a\x1a\x0d\xc6\xa6\x25\x11\x24\xa1\x9e\x66\x60\xa4\xc4\x81\x2c\xfb\x50\x13\x06\xd2\x8e\xd1\x11\x05\x12\x3b\xe8\x60\x84\xb8\xb8\xf4\x72\x94\x1a\xf5\xf6\x4f\x0a\xc8\x08\x01\x41\x06\xa7\xd4\x33\x19\x09\xe8\x82\x00\x90\x59\x26\x89\xd2\x09\x84\x10\xae\x74\x71\x72\xe2\xe4\xc4\xc9\x89\x93\x13\x27\x27\x4e\x4e\xc4\xc9\x09\xa1\x1a\xb4\xe7\x93\x68\x15\x6f\x7d\x24\xab\x4f\xe8\xb1\xe0\x62\x0c\x7e\xc5\x09\x69\x54\xe1\xd3\x10\x7c\x63\x5b\x25\x95\x1b\xb9\x59\xd8\x19\xf5\x76\x87\x3d\xb8\x52\x8a\x2b\xa5\xb8\x52\x8a\x2b\xa5\xb8\x52\x8a\x2b\xa5\xb8\x52\x8a\x2b\xa5\xb8\x52\x8a\x2b\xa5\xb8\x52\x8a\x2b\xa5\xb8\x52\x8a\x2b\xa5\xb8\x52\x8a\x2b\xa5\xb8\x52\x8a\x2b\xa5\xb8\x52\x8a\x2b\xa5\xb8\x52\x8a\x2b\xa5\xb8\x52\x8a\x2b\xa5\xb8\x52\x8a\x2b\xa5\xb8\x52\x8a\x2b\xa5\xb8\x52\x8a\x2b\xa5\xb8\x52\x8a\x2b\xa5\xb8\x52\x8a\x2b\xa5\xb8\x52\x8a\x2b\xa5\x76\x52\x29\x15\xd4\x4c\x54\x46\x65\xaa\xca\xcd\x04\xf4\xad\x8c\xe1\xd7\x38\xb6\xbf\x2e\xd4\x0d\x78\xad\x7c\xd4\x37\xbf\x56\x2a\x05\x91\x3b\xb7\x7b\x38\x1b\x72\xd4\xeb\xd4\xcd\xc2\x61\x95\xd0\x73\xef\x36\x88\x48\x80\xf2\x9a\x70\x93\x8b\x55\x96\x89\x3c\xf9\xfe\x07\x0a\xf9\xed\x0e\x07\x89\x45\xf3\xb9\xc7\x69\xb0\xa4\x0a\xde\x22\xe2\xfd\xde\xfd\xbd\x56\xc1\x45\x89\x58\xdd\xaf\xe3\xd3\x4c\xce\xbf\x88\xe2\x13\x2c\x7f\x07\xcf\xf3\xf5\xe9\xbb\x43\x44\xea\xd0\x73\x43\x61\x9e\xe4\x9d\x51\x45\x8d\x71\x69\x3a\xe4\x9b\xc9\xa8\xe1\x0b\x0d\x3d\x0a\x44\x12\x4f\xbf\x33\xfb\xba\xe2\x03\xb1\x7e\x51\xc8\x3f\x40\x97\x81\xdb\x32\x9d\xd8\xdd\x4a\x3d\x6d\x47\x5b\xef\xb0\x47\x34\x76\xb7\x1d\xd5\xbe\xad\x4f\x43\xa9\x2a\x1d\xc3\xfb\x03\xb2\xc2\x2d\x6c\xf5\x39\xad\xbd\xe3\x69\xef\xf7\x13\x79\x2b\x4b\xfc\xca\x9a\xc8\x97\x5f\x51\x5a\x5e\xb3\x2f\xf4\x16\xf7\x73\x69\x04\x4a\xea\xf7\x0b\x61\x0c\xe8\x7c\xd4\xff\x73\xf0\xed\xe4\x3e\x1a\x9e\x0e\x06\xd3\x1f\xa3\x5f\x2e\x4f\x06\xdf\xde\xae\xfe\x78\x33\x3c\x1d\xde\x6f\x7e\x9c\x0c\x87\x83\xc1\xf4\xd3\x97\x0f\x17\xe3\xf3\x4b\x39\xbc\x9f\xe6\x55\x76\x53\xff\xba\x1f\x4c\xe1\xfc\xd2\x51\xc8\x70\x78\xfa\x03\xb2\xe3\x77\xd1\x4d\x75\x0d\x3a\x07\x03\x65\x24\x73\x13\x29\x1d\xd5\x1a\x19\xf5\x8d\xae\x00\x25\x7e\xe3\x66\x07\x62\xcd\x34\x41\x6c\x33\xa8\x7d\xc7\xb0\x12\x62\x0d\x86\x31\x24\x63\xc8\x7f\x27\x86\x44\x8a\xc0\x68\x21\x5a\x2d\xec\xf6\x76\xdc\x67\xd4\xfa\x44\x18\xe9\xdd\xdb\x1a\xc5\x96\x23\x23\x82\x1b\x45\x60\xc3\x06\x12\x92\x20\x42\x11\x40\x28\x82\x07\xd2\xe3\x6c\xaa\x81\x99\xbc\x1b\xf5\xf6\xa4\xcb\x3a\x63\xb2\x45\x7d\x37\x16\xb5\xa7\x70\x2a\x33\x31\x0f\x98\x43\xc4\xcc\xad\xee\x38\xae\xd2\x74\xac\x52\x19\x2f\x77\x7a\xef\x54\xce\x20\x5e\xc6\x69\xc0\x88\x71\xfe\x52\xa8\xd2\x4c\x8c\xd0\x81\x85\xd5\x78\x67\x85\x3b\x88\x43\xdb\x52\xdc\x1f\xb5\x87\x42\x92\xc6\x89\x4c\x88\xca\xf1\x88\x3c\xbf\xfe\x6f\x61\x4c\xf1\x01\xcc\xbe\x27\x78\xa1\x4a\x33\x22\xd0\x27\x7a\x62\xac\x3e\x3e\x82\x48\x3c\xf7\x4d\x3b\xb2\x36\x1a\xe5\xd2\xa4\x5c\x62\x45\x13\x6c\x84\x75\xd4\x2b\x0a\x66\x88\xe2\x47\x8f\xbf\x51\xbd\x5b\x87\x94\x42\x10\x28\xe8\xa2\xd6\x6a\x9d\x10\xb9\xa4\x4f\x34\xdd\x85\x0a\xcd\xab\x84\x4b\xba\x94\x0b\xba\x84\xcb\xb9\x1d\xae\x89\x96\xf1\x02\x32\x38\x00\x0b\xc0\xbb\xba\x7d\xdc\x4b\x9b\xde\x1e\x1d\xd3\xc4\xc5\x44\xc5\x37\x9c\xc3\xb7\x39\x9c\xbd\x7a\x0f\x5e\x7d\xfc\xae\x84\x14\x50\x68\x98\x18\x55\x8c\x7a\xfb\xf1\x40\xa6\x69\x4c\xd3\x98\xa6\x31\x4d\x63\x9a\xc6\x34\x8d\x69\x1a\xd3\x34\xa6\x69\x4c\xd3\x98\xa6\x31\x4d\x63\x9a\xf6\x98\xa6\x21\x1a\xa7\xf2\x16\x72\x28\xcb\xb1\x56\xd7\x01\x41\x11\xe7\xbc\xe1\xec\x0e\x1f\x34\xd0\xac\x0e\x8d\xb1\x49\xdc\x0a\x8b\x89\x50\x76\xd7\xef\xcf\x84\x4c\x2b\x0d\x17\x0b\x0d\xe5\x42\xa5\x81\xea\xc4\xbf\x23\x0b\x1b\xee\x50\x4c\x14\x6f\x8c\xb8\xec\x45\x60\x48\x24\xcc\x13\xed\x11\x78\x45\xd2\xb1\x4d\x12\xef\x24\x63\x99\x44\xbd\xc1\xe7\x49\x02\x66\x89\x67\x95\xc8\xa0\x45\x13\x39\xb1\x4c\x92\x60\x4a\x71\x58\x13\x89\x33\xa9\x30\x26\x11\xbe\xec\x08\x5b\x62\xd9\x22\x7a\x6c\x38\x97\x45\xc0\x5a\xa4\x93\xd9\x37\x45\x4a\x91\xbe\x83\x54\x2c\x27\x10\xab\x3c\x29\x8f\x15\x1c\x14\xa0\xa5\x4a\x8e\x7c\x10\x65\x15\xc7\x50\x96\x47\x8f\xd4\x90\x2b\x0e\xdf\x01\x56\xe3\xa8\xdf\x71\xd4\x3f\xd6\x90\x6b\x40\x67\x32\x17\xb6\xfc\xfb\x83\x16\x31\x8c\x49\xe3\xd6\xcf\x3f\xed\xc7\xdf\x65\x06\xaa\x32\x47\x1d\x7d\x11\xf3\x1a\x46\x65\x10\xbe\x65\x8d\x37\x40\xcd\xc1\xdc\x0f\x1b\x90\xb7\x8f\x56\x8f\x11\x61\x11\x6f\x20\x58\x13\xd9\x64\x96\xdf\xc6\xa1\x63\x40\xc7\x53\x7b\xfb\xe3\x57\x22\x86\xfb\xa3\x55\x58\x68\x65\x54\xac\x82\x9f\xbd\x49\x60\x26\xaa\xd4\x8c\xfa\x17\x67\xe3\xfd\x8c\x01\x93\xfb\xa2\xa7\xce\xd8\xdb\x71\x02\xc4\x10\xfa\x27\x38\x22\x95\xa5\x89\x32\x51\x44\x37\xb0\x0c\x88\x4a\x58\x35\x44\x5b\x33\x22\x18\x46\xad\x94\x4c\x14\x9e\xb2\x34\x88\x44\xf2\xc6\x00\x6f\x0c\xf0\xc6\x00\x6f\x0c\xf0\xc6\x00\x6f\x0c\xf0\xc6\x00\x6f\x0c\xf0\xc6\x00\x6f\x0c\xf0\xc6\x00\x6f\x0c\xf0\xc6\x00\x6f\x0c\xf0\xc6\x00\x6f\x0c\xf0\xc6\xc0\xfe\x36\x06\x36\xaf\x2a\x0c\x18\x39\x2e\xd4\xa5\x32\x93\x21\x3b\x04\xd4\x07\x8b\xa0\xe2\x15\x4d\xb4\x22\x89\x55\x47\xf7\x7a\xd1\x4e\x42\x2b\x32\xc2\xd9\xc8\x0c\x25\x9b\x25\x9b\xe5\x01\x99\x25\xa2\x71\x09\x71\xa5\xa5\x59\x9e\xa9\xdc\xc0\x9d\xd9\x51\x8c\xff\x3f\x7b\xd7\x93\xdc\xb6\xaf\x83\xf7\x3e\x45\x2e\x90\x0b\x78\xd7\xc9\x9f\x4e\xa7\x4d\xe3\x89\x5f\xbb\x67\x24\xd8\x8f\x13\x5a\xd4\x90\x92\x1c\xdf\xfe\x37\x94\x6d\x35\xed\x64\x63\x7c\xb0\x68\x25\x70\x36\xd9\x48\x84\x80\x0f\x00\x01\x02\xc4\xf0\x9f\x71\xce\x6f\x17\xc1\x76\xd6\xd1\x9a\xee\x62\x61\x9c\xe1\x8e\xb6\xc5\x6f\x93\x2b\x4c\x6d\x9e\xad\xb3\x7c\xb5\xc4\x37\xf7\xa6\x2c\x33\xe6\x3f\x05\xf4\x59\x22\xaf\x55\x06\x5f\x7f\x76\x2e\x40\x16\x21\x21\xf1\xa0\x54\x65\x1e\x5d\xaa\x83\x2f\x1e\xd2\xc0\xa6\xf9\x2c\x83\x08\xd2\xa9\xe2\x63\xe5\x76\x4f\xde\x37\xf7\xd6\x51\xdc\xc5\x86\x36\x79\x38\x11\xda\xea\x4b\xfc\x1a\x7c\x5b\xc3\xb1\x43\xa6\x08\xa8\xff\x82\x9f\xbe\x4a\xdc\xcc\xc8\xc3\x5f\x91\xc2\x54\x59\x18\xe9\x87\xad\xda\xd7\xc7\xfe\x16\xde\x6c\xce\xc5\x51\x47\xc0\x7d\xad\x02\x76\x31\x78\xce\x1d\xa0\x82\x04\xf4\xaf\xc8\x49\x40\xcb\x46\xb1\x08\x01\xa0\x5b\x89\x54\x14\x7e\x53\x2f\x82\x5f\x59\x47\xd9\x70\xec\x0b\xe3\x52\x1a\x14\xa2\x43\x84\x9f\x87\x57\x4c\xf7\x78\x26\x91\x9f\x03\x49\x5b\x5b\x95\x7e\x1b\x33\x5b\xc4\xf5\x26\x9a\x9b\x40\x25\x55\xe9\xac\x68\x59\x53\x91\x51\x94\xef\x91\x83\x0d\xf2\x11\x20\x69\x70\xbd\x99\x29\x81\xe0\x06\x3c\x1c\xd3\xed\xd1\x6d\xad\xe5\x69\x5a\x9e\xa6\xe5\x69\x5a\x9e\xa6\xe5\x69\x5a\x9e\xa6\xe5\x69\x5a\x9e\xa6\xe5\x69\x5a\x9e\xa6\xe5\x69\x5a\x9e\xa6\xe5\x69\x5a\x9e\xa6\xe5\x69\x5a\x9e\xa6\xe5\x69\x79\xca\xd3\x62\x53\x5a\x46\xb9\x00\x72\x20\xd5\x2f\xf9\x58\x15\x34\xee\xb2\x6f\xd0\xfb\x40\x31\xa6\x61\x6e\xac\xfd\x35\x60\x57\xde\x21\x61\xfc\x59\x72\x4d\xb3\x1b\x97\xf1\x9d\x77\xed\x86\x6e\xa9\xb3\xac\x2a\x48\x76\xd4\x8f\xba\xe2\xb2\xa7\x98\x07\x12\x58\x4a\x78\xa2\x01\x5c\x1c\x71\x52\xd7\x6f\x78\x37\x1b\x31\x03\x00\xf9\x37\x7e\xbc\xbe\x07\x78\x5f\xad\x31\x25\x7c\x6f\x12\xc1\x59\xe1\xbd\xa7\x20\xf8\xda\xac\x81\xb2\xb5\x69\xeb\xd9\x9f\x62\x1b\x8c\x00\x9e\x6d\x3e\x78\xe4\xf6\x39\x2b\x0e\x0e\xeb\xdf\xbd\xd6\x21\x13\x0d\x98\xb5\x1b\x34\xe9\x13\x18\xbb\xad\x0f\x2f\xb6\x5a\xdf\x5a\x86\xa8\xd8\x42\xe2\x89\x87\xc1\x5a\x26\x53\x39\xec\x2c\xab\x78\xd3\x4f\x58\x9f\xcf\xce\x6f\xf3\x13\x23\x22\x85\x8e\x71\xfe\xc2\xf4\x4f\x80\x42\x72\xc1\xe9\x79\xb5\x10\xcc\x0f\xc4\xdc\x2f\xdf\xe3\x00\x8c\x05\x8f\x8b\xa0\x95\x01\x73\xc5\xc5\x43\x24\x13\x8a\xff\xd3\x58\x80\x18\x1d\xf1\x2c\x96\x96\x55\x3c\x7d\x80\x39\xeb\xd3\xa8\x32\xcf\x8e\x96\x14\x52\x18\xf5\xc3\x56\x2f\x27\xf1\x94\xb7\xab\xa1\x3a\x75\xfb\x07\xe3\x6e\x8e\x57\x3b\x9d\xb4\x28\x43\xf2\x7c\x33\x60\xc2\xfa\xe4\x67\xd8\xe0\x64\xca\x10\x03\x28\x54\x07\x33\xb5\x0f\xa5\xaa\x1b\xf1\x23\x31\xef\x93\x39\xe2\x81\x4a\x16\x64\x56\xbf\x0f\x9e\xd9\xcf\x80\xf3\xfe\x70\x1d\xe6\xca\xae\x1f\x4c\xfd\x9d\x76\x4f\x04\x1c\x98\xc8\x90\x93\xfe\x5e\x88\x1d\x82\x8a\xc9\x46\x02\x9e\xe2\xc4\xec\xf7\x95\xc6\xc9\x10\x84\x04\xea\x78\xb0\x7a\xfc\x5d\x5f\xbd\xd0\x6e\xc6\x7a\x94\xbf\xf7\xf8\xf7\xb7\xb2\xe4\xca\x0b\x41\xbf\xa9\xed\x6f\x0a\x11\xc8\x42\x89\xe3\xae\x67\x0f\x92\x98\x11\xa6\x48\x06\x77\xc3\x57\xe5\x46\xdf\xf1\x66\x86\xfb\x0b\x42\xe1\x70\x23\x29\x56\x2d\x2e\x2a\xf6\xab\xab\xd2\x76\x36\x7a\x76\x6e\x4e\xa8\x34\xe0\x08\x20\xf4\xc4\xf7\xbd\xb7\x09\x30\x69\x82\xad\xf8\x67\xad\x72\xf8\x57\xcd\x2e\x04\xcd\x32\x46\xec\xf8\x51\xb9\x6d\x58\xa4\x22\x50\xa3\x7b\x48\xdd\x43\x7e\xce\x3d\x24\xf8\x0a\x84\x0b\x53\x3b\xb8\xa1\xaa\xe3\x05\xbd\xd9\x72\x14\x43\x8c\x0c\x18\x37\x09\xc3\x86\x1a\x12\x11\x23\x22\x61\x40\x24\x8c\x07\xa8\x71\xc9\xd5\xd0\xca\xbe\xce\x67\x99\x78\xb9\xf7\x98\x8a\xa8\x0f\x83\xa8\x4c\xe6\xd4\x6e\xcc\x9a\x21\x43\x40\x72\xfd\x8a\x8b\xd6\xb9\xd3\xcf\x6f\xe0\xb5\x9d\x5d\x51\xb1\x2b\x38\x57\x05\x60\xfa\x52\xfb\xd8\x2c\x53\x0b\xf1\x7c\x96\x47\x59\xf9\x3d\xc4\x32\xeb\x8b\xf4\x12\x43\x6e\x5c\x08\x42\x52\x8a\x27\xa4\xf9\x02\x7d\xb9\x72\x02\xc6\x7a\x3e\x04\x05\x23\xd2\xa7\x2b\x86\x36\x19\xe6\xca\xb8\x5c\x61\x46\x0b\x1c\x84\x9d\x89\x2a\x89\xc8\x10\x8a\x8f\x64\x7b\x79\x85\x0c\x85\x9c\xd5\x42\xfb\x7a\x05\xc5\x8d\x75\x7a\x09\xa5\x74\x25\x13\xba\x82\xe9\xdc\x33\xe6\x44\xd1\x9e\x5f\x31\x04\xe0\xaa\x0e\x34\xa3\x09\x29\x26\xd8\xaf\xf9\x01\x7d\xb8\x6a\x75\x06\xad\x9e\xbe\x2a\x81\x2f\xa8\x03\x2d\x1b\xee\xb5\xb3\x1a\xa6\x69\x98\xa6\x61\x9a\x86\x69\x1a\xa6\x69\x98\xa6\x61\x9a\x86\x69\x1a\xa6\x69\x98\xa6\x61\x9a\x86\x69\x1a\xa6\xc9\x86\x69\xc0\xc3\xce\x76\xa4\x73\xe6\x75\xce\xbc\xce\x99\xd7\x39\xf3\x3a\x67\x5e\xe7\xcc\xeb\x9c\x79\x9d\x33\xaf\x73\xe6\x75\xce\xbc\xce\x99\xd7\x39\xf3\x3a\x67\x5e\xe7\xcc\xeb\x9c\x79\x9d\x33\xaf\x73\xe6\xf3\xcd\x99\xe7\x85\x32\x80\x6e\x25\xf0\x32\xd8\xcc\x8e\xfd\x50\x83\x3c\xb4\x56\x2f\x00\xb3\x88\x03\x04\x85\xc8\xd1\xb3\x7c\x5b\x70\xbf\x01\xb6\xa7\x69\xf9\xe9\x33\x11\x89\xfd\x61\x16\xd6\xc1\x37\xbe\xf0\xec\xde\x9b\x92\x56\xa6\x75\xcd\xfc\xea\x7f\x37\x8b\x3c\xdf\x80\xf8\xbe\xeb\xbf\x95\x71\x36\xb2\x03\xe4\x07\xf4\xe9\xaa\x56\xab\x59\x70\xcd\x82\x6b\x16\x5c\xb3\xe0\x9a\x05\xd7\x2c\xb8\x66\xc1\x35\x0b\xae\x59\x70\xcd\x82\x6b\x16\x5c\xb3\xe0\x9a\x05\xd7\x2c\xb8\x66\xc1\x35\x0b\xae\x59\xf0\x7c\x59\xf0\xe3\xbd\x7c\x8c\x2f\xc7\x4c\x9d\xb3\x1b\xcb\x49\x87\xa7\x3f\x53\x96\x76\x7f\x15\xcf\x02\x36\xb7\x90\xbd\x92\xb1\x56\x22\xb6\x6a\x72\x77\x69\x9e\xc5\xb4\x82\x16\x2e\x59\x66\x8a\x0a\x4b\x85\xe5\x05\xc1\x12\x78\x38\x52\xd1\x06\xdb\xec\xd2\x70\x0f\x7a\x6d\xc6\xb6\xf1\xc6\x39\xbf\x5d\x04\xdb\x59\x47\x6b\xba\x8b\x85\x71\xc0\xd8\x3a\xf4\xea\xb4\xc2\xd4\xe6\xd9\x3a\xcb\x57\x4b\x8c\x1b\x07\x13\x91\x31\xff\x29\xa0\xcf\x12\x79\xad\x32\xf8\xfa\xb3\x73\x01\xb2\x08\x09\x89\x07\xa5\x2a\xf3\xe8\x52\x1d\x7c\xd1\xcf\xef\x9c\xcf\x32\x88\xe0\x38\x00\xf2\xc9\xfb\xe6\xde\x3a\x8a\xbb\xd8\xd0\x26\x0f\x27\x42\x5b\x7d\x89\x5f\x83\x6f\x6b\x38\x76\xc8\x14\x01\xf5\x5f\xf0\xd3\x57\x89\x9b\x19\x79\xf8\x2b\x52\x98\x2a\x0b\x23\xfd\xb0\x55\xfb\xfa\xc8\x9b\xa7\x27\xe5\x5c\x1c\x75\x04\x5c\x4e\x2a\x60\x17\x83\xe7\x5c\x78\x29\x48\x40\xff\x8a\x9c\x04\xb4\x6c\x14\x8b\x10\x00\xba\x95\x48\x45\xe1\x37\xf5\x22\xf8\x95\x75\x94\x0d\xc7\xbe\x30\x2e\xa5\x41\x21\x3a\x44\xf8\x79\x78\xc5\x74\x8f\x67\x12\xf9\x39\x90\xb4\xb5\x55\xe9\xb7\x31\xb3\x45\x5c\x6f\xa2\xb9\x09\x54\x52\x95\xce\x8a\x96\x35\x15\x19\x45\xf9\x1e\x39\xd8\xd4\x1a\x01\x92\x06\xd7\x9b\x99\x12\x08\x6e\xc0\xc3\x31\x5d\x95\xdc\xd6\x5a\x9e\xa6\xe5\x69\x5a\x9e\xa6\xe5\x69\x5a\x9e\xa6\xe5\x69\x5a\x9e\xa6\xe5\x69\x5a\x9e\xa6\xe5\x69\x5a\x9e\xa6\xe5\x69\x5a\x9e\xa6\xe5\x69\x5a\x9e\xa6\xe5\x69\x5a\x9e\x96\xa7\x3c\x2d\x36\xa5\x65\x94\x0b\x20\x07\x52\xfd\x92\x8f\x55\x41\xe3\x2e\xdb\x98\xb0\xa6\xe6\xe6\xd8\xe6\xc9\x4b\xc7\x01\x46\xe5\x8d\xf6\x3c\x50\x8c\x69\x72\x1a\x6b\x7f\x2f\x4b\xc2\xf8\x83\xdb\x9a\x66\x37\xae\xe0\x3b\xef\xda\x0d\xdd\x52\x67\x59\x55\x98\xec\xac\x03\xba\x15\x28\x7b\x8a\x91\x69\xfb\x80\x94\xf0\x44\x07\xb8\x38\xe2\x24\xaf\xdf\xf0\x6e\x36\x62\x06\x02\xf2\xaf\xfc\x7c\xc1\x1e\xe0\x7d\xb5\xc8\x94\xf0\xbd\x49\x04\x67\x85\xf7\x9e\x82\xe0\x6b\xb3\x06\xca\xe6\xa6\xad\x67\x7f\x8a\x7d\x30\x02\x78\xb6\xf9\xb0\x23\x68\x9f\xb3\xe2\xe0\xb0\xfe\xdd\x6b\x1d\x32\xd1\x80\x59\xbb\x41\x93\x3e\x81\xb1\xdb\xfa\xf0\x62\xab\xf5\xad\x65\x88\x8a\x2d\x24\x9e\x78\x18\xac\x65\x32\x95\xc3\xce\x54\xff\xf1\xc5\x59\x13\x4f\xb3\xdf\x0c\x77\xc1\x77\x14\x89\xc6\xc4\xc3\x31\xdd\x1a\xa4\xc9\x7c\x5c\xdb\x7a\x44\x3c\x8f\x0c\xb3\x6f\x8b\x9b\xf9\xec\xdc\x0e\x25\x61\xe5\x27\x35\xc9\x3e\x8c\xb3\xd8\xe2\xdb\xed\x38\x0b\x9d\xba\x3b\x60\xc1\x62\x98\xe5\xbd\xec\xc7\xd1\x5f\xae\x4d\xe0\xed\x95\xa6\xa0\x2a\xe9\x48\x63\xc8\x4f\x5c\xae\x00\x4c\x58\x9f\xfc\x0c\x8b\x44\x50\x72\x5c\x31\x80\xf5\x3b\x53\xfb\x50\xaa\xba\x11\x3f\x92\x0f\xbb\x0b\x88\x94\xa0\x52\x0b\x99\xd5\xef\x83\x67\xf6\x61\xe0\xbc\x3f\xdc\x59\xb9\xb2\xeb\x07\x53\x7f\xa7\xdd\x13\x01\x07\x3d\x32\xe4\xa4\xbf\x17\x62\x87\xae\x62\xb2\x91\x80\xa7\x38\x31\xbe\xaf\x0d\x36\x4e\x86\xa0\x53\x77\x2e\x52\x51\xd4\xdf\xbf\xeb\xab\x17\xda\xcd\x58\x8f\x22\xee\xfc\xef\xdf\xca\x92\x2b\x2f\x04\xfd\xa6\xb6\xbf\x29\x44\x20\x7b\x25\x8e\xbb\x9e\x3d\x48\x42\x47\x98\x22\x19\xdc\x0d\x5f\x95\x1b\x7d\xc7\x1b\x25\xee\x2f\x08\x85\x05\x76\xac\x76\x16\xb1\x5f\x5d\x95\xb6\xb3\xd1\xb3\x73\x7a\x42\x25\x0d\x47\x00\xa1\x27\xd5\xef\xbd\x4d\x80\x49\x13\xbc\x42\xe0\xac\xd5\x19\xff\xaa\xd9\x85\xa0\x59\xc6\x88\x1d\x3f\x2a\xb7\x0d\x8b\x7d\x92\x41\xf7\x90\xba\x87\xfc\x9c\x7b\x48\xf0\x15\x08\x17\xa6\x76\xe0\x43\x55\xc7\x0b\x7a\xb3\xe5\x28\x86\x18\x19\x30\x6e\x12\x86\x0d\x35\x24\x22\x46\x44\xc2\x80\x48\x18\x0f\x50\xe3\x92\xab\xa1\x95\x7d\x9d\xcf\x32\xf1\x72\xef\x31\x15\x51\x1f\x06\x51\x99\xcc\x69\x7f\xce\x33\x9f\x8d\x28\xb9\xe1\x64\x29\x43\xb1\xa1\xb3\x2b\x2a\x76\x05\xe7\x8a\x03\x4c\x5f\x6a\x1f\x9b\x65\x6a\x7d\x9e\xcf\xf2\x28\x2b\xbf\xf7\x59\x66\x7d\x91\x1e\x68\xc8\x8d\x0b\x41\x48\x4a\xf1\x84\x34\x5f\xa0\x9f\x58\x4e\xc0\x58\xaf\x8a\xa0\x60\x44\xfa\x8b\xc5\xd0\x26\xc3\x5c\x19\x97\x2b\xcc\x68\x81\x83\xb0\x33\x51\x25\x11\x19\x42\xf1\x91\x6c\x0f\xb2\x90\xa1\x90\xb3\x5a\x68\x3f\xb2\xa0\xb8\xb1\x0e\x35\xa1\x94\xae\x64\x42\x57\x30\x9d\x7b\xc6\x9c\x28\xda\xab\x2c\x86\x00\x5c\xd5\x81\x26\x3a\x21\xc5\x04\xfb\x4c\x3f\xa0\x0f\x57\xad\xce\xa0\xd5\xd3\x57\x25\xf0\x05\x75\xa0\x65\xc3\xbd\x2e\x57\xc3\x34\x0d\xd3\x34\x4c\xd3\x30\x4d\xc3\x34\x0d\xd3\x34\x4c\xd3\x30\x4d\xc3\x34\x0d\xd3\x34\x4c\xd3\x30\x4d\xc3\x34\xd9\x30\x0d\x78\xd8\xd9\x8e\x74\x3e\xbe\xce\xc7\xd7\xf9\xf8\x67\x9c\x8f\xff\x1f\x7b\x57\xb0\x16\x39\x8e\x83\xef\xf5\x14\xf3\x02\x75\xd9\xbd\x71\x63\xa1\x7b\x96\x6f\xbb\x7b\xd8\xa6\xe9\x39\x9b\x44\x45\xf9\xc3\x89\x33\xb6\x43\xc1\x3c\xfd\x7e\x4e\x91\x2a\xd8\xe9\xc3\x44\xbf\x62\x93\x2e\x53\x5c\x13\xdb\xf2\xaf\x5f\xb2\x24\x2b\x33\x5b\x2f\x01\x7e\x16\x39\x79\xc2\x1a\x21\xe3\x0a\xc8\x9c\x36\xc5\x8c\x9e\xc8\x29\x53\x68\x36\xb8\x9d\x14\x38\x59\xe2\xa7\x4a\x90\xb4\x64\x98\x13\x3d\x49\x0a\x6c\x29\xe6\x6b\x82\x7e\xa6\x94\x8f\x29\xe4\x5f\xce\xe4\x5b\xa2\xa7\x45\x78\x6d\x98\xca\x02\x6e\x6d\x69\x40\x5c\x1a\x10\x97\x06\xc4\xa5\x01\x71\x69\x40\x5c\x1a\x10\x97\x06\xc4\xb9\x1a\x10\xf3\x8e\x32\x80\x6e\x45\xf0\x32\xc4\xcc\x3e\xfb\xa1\x84\x7c\xb8\x5a\x7d\x0d\xd0\x22\x0e\x10\x14\x22\xa3\x65\xb9\xba\xe6\xae\x01\xe6\xd3\x38\xfc\xf2\x85\x88\x9c\xfd\x61\x11\x76\xce\x06\x5b\x59\xf6\xdd\x9b\x9a\x36\xaa\x37\xe1\xec\x97\x6f\x17\xd7\x79\xd6\x80\xd8\xbe\xf5\x5b\x65\x5c\x25\x36\x80\xc8\x81\xfe\x8d\x1f\x61\xb4\x0f\xeb\x46\x75\xeb\x07\x7a\x66\xb0\x12\x2a\x86\xf5\x01\x46\x02\xcb\xd8\x0b\xa5\x51\xdd\xc4\x77\xc5\xae\xb7\xba\x24\x06\x4a\x62\xa0\x24\x06\x4a\x62\xa0\x24\x06\x4a\x62\xa0\x24\x06\x4a\x62\xa0\x24\x06\x4a\x62\xa0\x24\x06\x4a\x62\xa0\x24\x06\x4a\x62\xa0\x24\x06\x4a\x62\xa0\x24\x06\xf2\x25\x06\xc6\x56\x85\x8c\x95\x63\x54\x67\x74\xa3\x39\x19\x82\xf8\x53\x75\xad\xf7\xdd\x89\xae\x61\xba\x85\xf8\x4a\x86\xad\x44\xb8\x6a\x71\xed\x45\x67\xa1\x56\x90\xe1\x22\x33\x93\x2f\xb0\x2c\xb0\x7c\x47\xb0\x04\x1e\xf6\x54\xf5\x4e\x87\xe7\xf8\xbd\x13\x7a\x0a\xa9\x39\x5e\x19\x63\x77\xd7\x4e\x3f\x6a\x43\xf7\xf4\xc1\x57\xca\x00\x5f\x00\x44\xbb\xc9\x55\xaa\x53\x77\xda\x68\xbe\x5a\x62\xd2\x78\xa1\x88\x8c\xf1\x4f\x01\x7d\x96\x88\x6b\xd5\xce\x76\xa7\x2e\x05\x88\x11\x22\x12\x5f\x94\xaa\xce\xa3\x4b\x9d\xb3\xd5\xf0\x29\xd4\xb3\x55\x86\x2d\x18\xbf\xa5\xf9\xd5\xda\xf0\x51\x1b\xf2\xcf\x3e\x50\x93\x47\x12\xae\x6f\xcf\xfd\xaf\xce\xf6\x1d\x7c\x76\xc8\x74\x02\x1a\x56\xf0\xc5\xb6\x51\x9a\x19\x65\x78\xeb\xc9\x2d\x55\x84\x9e\x3e\xe9\xb6\x7f\xfa\x6d\xe8\xc2\x9b\xcd\xb8\x18\x7a\x24\xa0\x5f\xab\x00\x2f\x3a\xcb\xe9\x01\x2a\x38\x81\xe1\x15\x39\x27\xd0\xb3\x51\x2c\x32\x01\xd0\xac\x78\xaa\x2a\xdb\x74\xd7\xce\x6e\xb4\xa1\x6c\x38\xb6\x95\x32\x31\x0c\x0a\xcd\x43\x44\x9e\x2f\xaf\x58\x6e\x7a\x26\x4e\x3f\x07\x92\x76\xba\xad\xed\xce\x67\x66\xc4\xfb\xc6\xab\x0b\x47\x35\xb5\x31\x57\x74\xd3\x51\x95\x71\x2b\x7f\x34\x1d\xec\x43\x3e\x02\x53\x3a\x98\xde\xcc\x33\x81\xe0\x06\x3c\xec\x63\xf7\xe8\xbe\x2b\xe5\x69\xa5\x3c\xad\x94\xa7\x95\xf2\xb4\x52\x9e\x56\xca\xd3\x4a\x79\x5a\x29\x4f\x2b\xe5\x69\xa5\x3c\xad\x94\xa7\x95\xf2\xb4\x52\x9e\x56\xca\xd3\x4a\x79\x5a\x29\x4f\x2b\xe5\x69\x79\xca\xd3\x7c\xa8\x35\xa3\x5c\x00\x49\x48\x0d\x43\xfe\xd6\x56\x94\x76\xd8\x57\xe8\xfd\x4c\xde\xc7\x8f\xb9\xb1\xfc\x6b\x80\x57\x7e\x30\x85\xf4\xdf\x92\x0b\xe1\x39\xad\xe0\x1f\xad\xe9\x1b\xba\xa4\x47\xcd\xaa\x82\x64\x9f\xfa\x51\x53\x5c\x0f\x33\xe6\x81\x04\xde\x25\x3c\xd0\x00\x0e\x8e\x18\xa9\xf5\x2b\xd9\xad\x12\x46\x00\x20\xfb\xc6\x3f\xaf\xef\x01\x3e\x54\x6b\x2c\x09\xdf\x4d\x9c\x70\x56\x78\xef\x67\xe0\x6c\xa7\xee\x81\xb2\xb5\x65\xeb\xd9\xb1\xd8\x06\x9b\x00\x8f\x9b\x5f\x2c\x72\x7f\x97\x15\x07\x2f\xe3\x7f\x78\xea\x5c\xa6\x39\x60\x6c\x77\xd0\xa4\x13\x20\xbb\x9d\x75\x0f\xba\xbd\xbf\xd4\x8c\xad\x62\x6f\x12\x6f\x7b\x18\xa2\x65\x0a\x95\x23\xce\xd6\xd6\x34\x35\x27\xcc\x12\x60\x1c\xe8\x86\x0c\x55\xc1\x4e\xda\x33\xbc\xda\x9e\xb9\xdf\xac\x4d\xb0\x8f\xe4\xb6\xa4\xea\xb4\x2b\x64\x44\x3b\x90\x08\x07\x10\xd5\x58\xc8\x3d\x01\xc1\x80\x0b\x0b\x46\x9d\x23\x6a\x86\x9a\x9a\xe9\x9f\xf9\x66\xed\x4d\xe7\xb4\x8d\x37\x08\xce\x56\xf3\xc7\x1b\x78\xc0\x1b\x67\x78\x61\x94\xf7\x49\x18\xeb\xd0\xd4\xe8\x57\x15\xa6\x69\x24\xc3\x99\xe6\xbb\xd1\x95\x6d\xf7\x0c\xf9\x8d\x55\xc2\x96\xdc\x1a\xbe\x99\xef\xa4\x67\x59\xaa\xc4\x33\x8b\x8e\x86\x8a\xa1\x44\xda\xe7\xfa\x36\x06\x00\xd3\x41\x3b\xb6\xa3\xa8\x7b\x43\x2e\xcd\x68\xfc\xeb\x49\x5c\xb5\xd8\xf0\x6a\xf5\x91\x40\x30\xdf\x9e\xbe\x4c\xf6\x62\xab\x5a\x6e\x2c\x8e\xb5\x2f\xd8\xbd\x86\x3c\xb2\x42\x6e\x31\xf0\x8f\xa8\xec\x9b\x0b\x79\x84\x84\xdd\x53\xe0\x9b\x22\xe0\x6e\x02\x1b\xc0\xc8\x7d\x04\x68\x50\x6e\xc5\x38\x34\x28\xf7\xde\x01\x30\x28\xd3\xea\xa2\xb7\x0c\x20\x14\x0a\xdc\x2c\x58\x1a\x36\x78\xee\xd8\x78\x98\x4b\x86\x89\xbe\xeb\x0c\x35\xd4\x06\x65\x06\xa3\x37\x79\x77\x99\x81\x6a\x84\x88\x11\x2a\xe6\xb9\x9f\xf1\xe7\x9f\x7d\x15\x4c\x2a\xf9\x20\xea\x86\x44\xae\x21\x35\x83\xca\x4e\xa1\x91\xb9\xda\xc6\x8e\xb4\x72\xcb\x49\xd9\x9a\xca\x07\x2e\x76\x27\x08\x01\xa2\xcc\x3d\x20\x08\x18\x72\x77\x7f\x30\x7c\xa2\xf7\x7d\x80\xd1\x99\x90\x63\x3d\xe6\xc9\xc5\xf4\xf8\x79\x55\xc5\xe4\xc7\xd4\xc5\xb2\x16\xe9\x29\xfc\xdb\xfa\x10\xb5\xf8\xdc\x7f\xfc\xef\xe5\x97\xb3\xd5\xdc\x47\x10\xbf\x55\x8e\xae\x9d\x8d\x9d\xf7\xe2\x1a\x7d\xa7\x2a\x4a\x30\x6c\x7f\x57\xdb\x46\xe9\x76\x76\x99\x8a\xd5\x74\x71\xcd\x3c\xcf\xc0\x07\x6b\xc8\xa9\xc9\x34\xc7\x30\xd1\x7c\x56\xa4\xcd\x86\xaa\xc9\x47\x65\xe6\x3e\xee\xff\x1f\xe8\x39\xe9\x78\x51\x34\x6a\x62\x5a\x09\x1e\xf4\xb8\xf7\xec\xa2\xbd\x7c\x3e\x29\xd3\x6d\x62\x8b\x0b\x32\x09\xd3\x3c\x90\x60\x3b\x6b\xec\xfd\xf3\x4d\x17\xe3\xf7\x17\xb6\xf5\xc1\x29\xdd\x86\xf7\xab\xa1\x46\xdd\x91\xe1\xa4\x46\xd1\x81\xe3\xaf\x51\xa1\x1a\x4a\x1e\xc8\x7b\x8e\xbb\xc6\x96\x97\xd4\x02\x00\xca\x11\x00\xb6\x0c\x0d\x09\x4f\x64\xd0\x6e\x40\x98\xe0\x7e\x0a\xae\x84\x47\x01\x52\x47\xb5\xf1\xe4\xf5\x40\xdc\xb1\xd7\x07\x50\x30\x5f\xc0\x24\x4e\x29\xf9\x0d\xec\xf0\x29\xf2\x13\x13\x0d\x78\x6d\x83\x20\xa2\x20\x61\x02\x0f\x37\xea\xe9\xe6\x81\x76\x90\x83\xf0\xcf\x7f\x24\x75\x10\x46\x2b\xfa\x9f\xc4\xae\xdc\x6e\x4b\xed\x6d\xeb\x55\xd0\x7e\xa3\xd5\x9d\xa1\x84\xa3\xf3\x88\x62\x3d\x6e\xef\xc4\xa7\x5e\x49\x78\xe2\x93\x7f\x91\xd1\x2a\x01\x8e\x79\x3c\x22\xf3\xa1\x2c\xae\xb0\x30\x51\x49\x7c\x1d\x6b\x5f\x93\x3d\x69\xb1\x0c\xd3\xcb\x77\xa0\xd4\xce\x7f\x30\xca\x07\x5d\xfd\xcb\xd8\xea\xe1\x26\x58\xc7\xd0\x38\xcc\x81\xdb\x78\x5e\xe1\x0a\xa4\xeb\x2f\x13\x57\x2e\x68\x7e\xc9\x37\x46\xcf\x28\x45\xe3\x45\xdb\xbc\xa8\xd0\xf1\x6f\x8f\xee\xab\xcb\x0c\x7b\xc7\x77\xea\xd6\x87\x69\xa7\x74\x01\xd4\x9f\xbd\xa3\x4b\xed\x1f\x52\x6b\x57\xa5\xaa\xad\x6e\xef\x3f\xdb\x3a\x8f\x8a\xd5\xda\x3f\x70\x63\xd0\x22\x83\xdf\x7e\xbd\xca\x32\x76\x46\x5a\x7b\xd0\x6d\x9d\x65\xe0\x9c\x74\x84\x30\xc2\x08\x52\xe6\xa3\xb7\x5f\xaf\x92\x73\xc9\x47\x6d\x92\x5b\xea\xbc\xd6\xc6\x53\xe5\x68\x72\x8a\x47\x0c\xdb\x43\x0e\x26\xd3\xe8\x08\xb6\x8f\x62\xe3\x3c\x3c\x2e\x3a\x25\xbe\x2b\xea\xb6\x1b\x9f\x1a\xdc\x8d\x6d\x75\xb0\xdc\xc6\x60\x50\xc0\x0c\x44\x26\xf7\x70\x86\x37\x78\x5a\xb0\xb9\x18\xf9\x84\xc7\xa3\x02\x8b\xdf\x0f\xff\x95\x98\x5d\x4a\x30\xb0\xa3\xb7\x4d\xe1\xd5\x43\x04\x81\x14\x42\x66\x64\xe2\x91\x61\x56\x09\x45\x55\xe9\xb6\x26\x97\x9a\x4b\x33\xfa\xbe\xef\x81\x53\x8a\x52\x33\x95\xba\x04\x14\xfe\x76\x40\xa1\xb2\xed\x46\xdf\x7f\x56\x5d\x6a\xdd\xae\x69\xa3\x7a\x13\xf8\x01\x85\xfc\x51\x33\xc0\x59\x83\xfc\xbc\x9f\x25\xd1\xdd\xb0\xf7\x5e\x0a\x01\x32\x38\x40\xdd\x5f\x31\x91\xf2\xc9\x47\x22\x45\xdd\xf1\x5a\x46\x80\x04\x26\x71\x72\xe1\x5b\x3b\x70\xd3\xec\x50\xdb\xac\x4c\x0e\x2f\x03\x31\x1a\x5e\x9f\xad\xd2\xb2\x56\xed\xf4\x23\xb9\x53\x8b\xc0\xc6\x66\x17\xd7\xfd\x9d\xd1\x7e\x7b\x53\x5c\x42\xc4\x25\xcc\xeb\xcf\xef\x1d\xd2\xf3\x10\x9c\xbe\xeb\x27\x36\x01\xf8\x19\x8b\x60\x10\x2f\x79\xcf\x04\xab\x84\xf3\xad\xed\xae\xdd\x29\x57\x9f\x5f\x5f\x25\xa7\xbd\xe2\x25\xe7\xf4\x92\x37\x9a\x4c\xcd\xe6\x5c\xb9\x79\xc4\x9f\xea\xf4\x77\x72\x1e\x68\x2c\x27\xa6\xfb\xe3\x6f\x10\x0f\xd2\x6b\x4d\x78\x46\xa8\xff\x1b\x7f\xeb\xe3\xaa\x80\xb7\x40\xec\x58\xce\x47\x33\x9d\x8f\x8e\xdf\xaf\xff\xf8\x8e\x14\xbb\xb2\x6d\x50\xba\xe5\xdf\xb1\x13\x97\x52\xfc\xaf\xf5\xa3\xf6\x58\x11\xba\x40\x03\xf5\x51\x27\x65\x20\xf8\xf6\x6d\x02\x42\x5a\xe0\x07\xcb\x7f\x58\x96\x28\xd5\x0b\xfe\xff\xd5\xec\x9d\xa0\x59\xc6\x2e\x8c\x8b\xca\x6b\x16\xd0\xb5\x2c\x37\x4e\x03\x0c\x1e\x9b\xe0\x3d\xb3\x9a\x7c\x62\x24\xdf\x50\xad\x7b\xe8\x4b\xd3\x6c\xec\x7b\xfd\x27\x7d\xd2\x8d\x66\x5c\xd9\x04\x79\x5b\x82\xad\x05\x38\x7a\x61\xcc\x2c\xce\xc7\x88\xba\x74\x5b\x6a\xc8\x29\x93\x5a\x5f\xf6\x71\x92\x0b\xa3\x74\xf3\x8d\x9a\xce\xa8\xc0\x34\x21\xd8\x34\xe2\xaf\xa1\xa0\x6a\x15\x14\xf7\x79\x68\x03\xc6\x9f\x87\x3e\x41\x8b\x0b\x21\xfe\xd4\xf0\x25\xa4\x18\x81\x80\x5e\x03\x9e\xe6\x85\x58\x51\xc6\x12\x1d\xff\x22\x42\x6e\x04\x5c\x1d\x99\xad\x7a\x09\x13\xb0\xda\xf0\xcd\x28\x6a\xa4\x92\x78\xa6\x09\x61\xc1\x6c\xf1\x09\x49\x38\xa8\xd1\x68\x46\x39\x83\xaf\x60\x75\x15\x12\xa6\xbd\xd7\x47\x08\xff\x5e\x14\xcb\x44\x67\x0a\x7e\x8b\x6c\x14\x5f\xc8\x63\xfb\xb1\xef\x25\x73\xda\x16\xf2\xe6\x16\xed\xdb\xcd\xec\xe9\xcd\xa4\x81\x23\x31\x91\x2f\xb0\x2f\xb0\x3f\x21\xd8\x0b\xbd\xc8\xb3\x5b\xb1\xcc\x63\xc0\x64\x5a\xb4\x88\x7b\xf4\xb2\x8b\x14\xa9\x6e\x7b\xfd\x13\x56\x61\x99\xf6\x2e\xb3\x4e\x10\x6f\xfb\x32\x0b\x46\x66\x5a\xad\xd4\x49\x50\xd6\x95\xc7\xeb\xf1\x44\xdb\xc7\xcc\xc0\x8e\xd2\xb2\x87\x5b\xcc\xa4\x70\x56\x44\xd1\x2b\xb6\x11\x42\x2f\xf2\xc1\x3a\x75\xcf\xfb\xfa\xc4\x6c\xa2\x1a\xbf\x66\x58\xbf\xa7\xe9\xbc\x13\xe9\xc0\xfb\x8e\x71\xdd\x7a\x08\x6f\xae\x92\xcf\x1c\x78\x78\xc3\x88\xc6\x2e\xf6\x0a\x94\xe9\x4b\x3f\x13\x56\x3f\x93\xa0\xdc\x3d\x85\xdf\x7f\xff\xc2\xb4\x19\x90\xcb\x04\x33\x03\x66\x92\x77\x3b\x5d\x9f\xdc\xb2\x11\x46\x31\xf4\xf4\x7d\x30\x0a\xa9\x99\xe5\x34\x2b\xea\x2d\xaf\x9b\xbc\xbc\x5b\x08\xae\x03\x02\x5d\xb9\xe0\xba\xe8\x0b\xae\xcb\xaa\x9e\xdf\xc4\x8e\x70\xe4\x92\xd3\x9b\x0a\xca\x67\xec\xf3\x12\x33\xc3\x9e\xc2\xed\x6d\x96\x6b\xc8\xc0\x7e\xdd\x0f\x6d\xf3\xbd\xf6\x81\xda\x90\xa3\xd5\x58\x46\xeb\xb0\xf8\x46\x7e\x5d\x9d\x0d\xf0\x39\x0d\x0a\xc2\x88\x7b\x91\x25\xd5\x30\x1d\xbe\x52\x67\x53\xeb\x55\xad\xdd\x90\x8f\x78\xce\x04\x8f\xce\x7a\x9d\x71\xf8\x47\xcd\xbf\x3b\x03\x0d\x8e\x80\xf3\x28\xb5\xa4\x00\x35\xbd\x0f\xe4\xd2\x37\xcf\xa2\xb6\xee\xec\xd4\x8f\x2c\x08\x6d\x13\x72\x43\xe5\x44\xa9\xf3\xb0\x5d\xab\x44\x55\xe8\x00\xa6\x87\xcf\x38\xb2\x36\x18\x83\x74\x36\x50\x71\xbf\x1d\x99\x91\xed\x52\x63\x42\xfb\x0c\x9d\x0c\xaa\xad\xea\xce\xfb\xb0\xbd\xd4\xbe\xb2\x8f\xe4\xb2\x68\xfd\x71\x1a\x37\xfb\x0f\xb6\xe4\x99\x44\x46\x57\x5f\xb7\x3a\xe8\x98\x12\xcd\xe6\x30\xeb\x3f\x72\x78\x23\x2f\xa8\xbf\x6a\xa3\x81\x57\x15\x65\x99\xc2\x82\x53\x0b\x9d\x75\x41\x99\x93\x0b\x71\x97\x80\xe1\x82\x03\x86\x63\x4a\xea\x7a\xc0\xee\xd9\x2a\xf9\x0a\x10\xa7\x40\xff\x31\x1d\x2f\xeb\x5f\x4c\xcf\x79\xea\xb5\x98\x56\x09\xb7\x88\x07\x2e\x60\x4b\xda\x8d\x3f\x19\x47\x38\x37\x71\xb9\x3c\x69\xb6\xe4\x8e\xf8\xfa\x65\xad\x29\x15\xa7\x3b\x44\xaa\xbf\x1f\xaf\x0e\xa6\x46\x76\x15\xef\x2b\x96\xb8\xeb\xc4\xb8\xeb\x41\x6a\x49\xf1\xb2\xb5\xc1\xb6\xa7\x9b\xdf\xa8\x17\xd7\x14\xb7\xab\xd3\x36\xc4\x8d\xfe\xfd\xce\xba\x4c\x45\x19\x19\xb1\x91\xd7\x4c\x96\x96\xcd\x7f\xbb\x65\x73\xe7\x6c\x7c\x8a\xea\xd4\xe0\xfc\x09\x9a\xd1\x41\x77\x4a\xa1\xd3\x3b\x26\x7a\xb8\x59\xb7\xec\x44\x60\x69\x88\xbe\x44\x6e\x4d\xc2\xb7\x87\x40\x46\x96\xee\x07\x27\xa9\x88\xd2\x8a\x29\x77\x5a\x9c\x75\x13\xf8\xb6\x62\x9e\xbb\x3d\xcc\xa3\x9a\xa0\x35\x92\x0d\x32\xa2\x21\x91\x99\xb6\x1e\xeb\xd3\x2d\xe7\x25\x89\xee\x17\xd4\xd6\x56\x9e\x7b\x7f\x5e\x7b\x22\xd1\x45\x76\xae\xb9\xc9\x76\x96\x9d\x41\xf5\x66\xe8\x36\x3b\xe3\x2c\xe5\xec\x83\x5c\x2f\x5a\x61\xda\x28\xfe\xc8\x3b\xf2\x47\x64\x3b\xda\xce\x49\x32\xa2\x5d\x6e\x67\x93\xa6\x60\xe7\xdb\x19\x5a\x94\x8c\xac\x20\x0d\xee\xb7\xef\x15\x15\xe6\xc2\x9b\x96\x24\x6a\x5d\xf2\x56\x95\x65\xa0\xf2\x3f\xf6\xae\xaf\xb9\x6d\x5b\xd9\xbf\xeb\x53\xe4\xe1\x3e\xd8\xcd\x28\xf7\x4e\x73\xe7\xce\x3d\x7e\xe9\x38\x4e\xdc\xe3\x13\x3b\xd1\x48\x4a\xfb\x90\x66\x38\x30\xb9\xa2\x70\x04\x02\x3c\x00\x28\x4b\x9d\x7c\xf8\x33\x20\x29\x3b\x39\x4d\xc5\x5d\x10\xb0\xac\x86\x62\x1f\xe2\x0e\xf9\x5b\xfc\x59\xec\x3f\x60\x17\x47\xa1\xdf\x76\x5d\x7e\x7a\xea\x2d\x5c\x4f\xff\xaa\x3e\x5a\x90\x06\x35\xe7\x3a\xce\x46\x87\x57\x55\x43\x3c\x6b\x88\x67\x0d\xf1\xac\x21\x9e\x35\xc4\xb3\x8e\x34\x9e\xe5\x4e\x9f\xf0\x14\xce\xd3\x54\x55\xd2\xce\xd5\x0a\xe4\x53\xd0\x2b\xac\xca\x38\xc8\xf4\xe9\x4c\x3e\x6c\x4a\xae\x99\x63\x80\x19\xa4\x4a\xfa\x96\x6b\xf8\xa6\xf4\xfd\xbf\xff\x1d\x3d\x15\xb9\x1b\x42\xe2\x06\x1a\xf3\x10\x52\xb6\xb7\x64\x0c\xb0\xc6\x7a\x43\xf4\x11\xa6\x3d\x88\xff\xab\x52\xb7\x5b\x9f\x52\xf2\xfd\x64\x40\xee\x5f\x87\xfb\xa8\xcf\x72\x68\xc8\xb9\xb1\x07\x4a\xf4\xb4\x20\x99\xb4\x07\x21\x5d\x99\x83\x1c\xf4\xdc\x1d\x9e\x39\x00\x69\x7f\xc1\xe6\x52\x5b\x1b\x2e\xf1\xf8\xb4\xe9\xef\x63\x4a\x10\x7d\x9b\x3d\xb6\xf4\x38\xe0\x51\x30\x5e\xb0\xfc\x30\x94\x57\xb0\x75\x6d\x3e\x08\xed\x42\x49\x97\x9f\xee\x35\x59\x3d\x3d\xff\x9e\x2d\xef\xa7\x58\xdd\x99\x47\x25\x0e\x32\xe4\x43\x4e\xd1\x11\xe7\x14\x1d\x48\xdd\xf5\xd1\x39\xb5\x60\xf3\xf8\x6e\x27\x1a\x46\x8f\x38\xc4\x26\x65\x02\xae\xde\x9f\x8d\x1e\x97\x2f\x0f\xa8\x76\x72\x66\xe1\x8e\x1d\xc6\x6c\x2c\xb5\xb2\x90\x3a\xa7\xf8\xb5\x2a\x18\x97\x07\x69\xc4\x20\x0e\x8f\x58\x1c\x1a\x23\xde\x48\x76\x2b\x7c\x24\x53\x90\xe9\x6b\xca\x2f\xfb\x9f\xce\xee\x39\x80\x6d\xf9\xe7\xc9\xa1\x6c\x09\xb3\x35\x16\x0e\x73\xd7\x62\xdf\xaa\xce\x07\xd3\x87\xad\xc4\xf5\xf8\xf2\x5e\x58\xf8\x7c\x5b\xcf\xd4\xe8\x11\xd7\xa7\xef\x2e\xe3\x77\x9f\x2f\xd1\xc3\xab\xe9\xe5\x10\xf5\x57\x26\xbd\xb7\x3d\x7b\xca\x84\x30\x5b\x9c\xfd\x39\x20\x0c\x1f\x84\x09\xa9\x07\x18\x52\x7f\x69\xd7\x7f\x9b\xb2\x57\x08\xbe\xa7\x75\xd1\xd7\xc5\xef\xb7\xe3\x17\xc6\xb4\x3c\x90\x82\xec\xa3\x37\x1a\x9b\x46\x99\xc7\x56\x1d\x07\xf4\xc2\x06\x27\xe4\x88\x9d\x90\x83\x5a\xa2\x5f\x92\x37\x25\x4b\x8f\x6c\xb1\xaf\x4d\xb9\x04\x0d\xdf\x5d\xe2\xf7\xbd\xe3\x26\x78\xba\xbd\x7a\x7d\xf8\x36\x1c\x98\x7d\xfd\x53\x4c\x0e\xe6\xc7\x3d\x34\xfc\xf1\x16\x8c\x5f\x7b\x3d\x2e\x18\xf6\x6c\x21\xdd\x5e\x22\x12\x22\xbd\x5e\x95\x19\xb3\x30\xb3\x9a\x59\xc8\x51\xba\x95\x2a\x4f\xb4\x12\x82\xcb\xfc\x43\x4d\xe8\x6c\x14\x4f\x68\x15\x6c\x33\xab\x34\x6d\x83\x90\x9c\x02\xe1\x9f\xe4\xe0\x9d\xc6\x10\xec\x94\x7f\xc1\x36\x1f\x24\x5b\x33\x2e\x5c\x38\x70\x18\xa6\x6f\x0e\x13\x71\xb1\xb5\x1f\x90\xc0\xd1\x5d\x23\xb4\x05\xf9\x2a\xea\xb5\x8c\x1b\xc7\x20\x6f\xef\x87\xf3\x92\x0b\xbb\x7f\x4f\x0d\x67\x5e\x43\x1d\x87\xfe\x50\x1a\xab\x81\x15\xa1\xf0\xb2\x00\x40\x1b\xab\xd9\x2f\xed\x95\x7c\x55\x47\x9d\x6f\x44\x00\x09\x2f\xbe\x32\x30\x96\x4b\x86\xbb\xdb\xe1\x21\x65\xe9\xbf\x5f\x3c\xff\xaf\x51\x40\x6e\xc3\x3b\x57\xb8\x01\x6d\xad\x27\x64\xda\x50\xac\x7e\xe1\x4c\x81\xf1\x97\x93\xd0\xf1\x26\x22\x2b\x88\xb4\x12\xf7\x9b\x01\x8b\x7a\xdd\x9d\xdf\xed\xe5\x22\x2c\xaf\xdd\xb8\x8b\x48\xf7\xbf\x42\x18\x5a\xd6\x9c\x66\x4e\x78\x86\x83\xc4\x30\x0c\x2b\x78\x58\xbc\xdf\xc3\x61\x41\xfa\x63\xc2\xa5\xb1\x4c\xa6\x10\xb4\x91\x5f\x01\x63\x74\x09\x1e\xda\x95\x96\xc7\x84\x0d\xf0\x88\xbc\xc8\x4c\xb2\xc6\xe5\xe4\xa3\x59\xa9\xd4\x7c\xcd\x2c\x24\xbc\x0c\xd7\xd0\x75\x99\x06\x9c\x25\xd4\x92\x6e\x16\xeb\x83\xd2\x0c\xb1\x66\xcf\xa5\x54\x96\xa1\x6e\x86\x43\x0f\xf7\xab\x6a\xb1\x00\x9d\xcc\xf8\xef\x10\x0c\xf3\xf5\xbb\x59\x32\x05\xab\x39\x84\x6b\xa7\xc3\xfc\x95\x71\x9b\xcc\x79\x01\xe1\x50\xab\xa2\xd8\x26\x37\x60\x59\x30\xc8\xb7\xff\x3f\x1b\x5f\xab\x3c\xe7\x32\x7f\xf1\x66\x93\x8a\x2a\x83\x28\xd8\x13\xa6\x11\x27\x9b\xf0\xd0\x00\x65\x72\xad\xf2\x70\x80\xd5\x2d\x24\x17\xe7\xc9\x25\x17\x10\x1c\x14\x13\xfc\xa0\x81\xce\x59\x9e\x4c\x34\x2c\xf8\x26\x30\xae\x4b\xf0\x89\x30\x08\x1f\xa6\xd7\x61\x01\x0b\xb0\x2c\x29\x35\x08\xc5\xb2\x24\x65\xe9\x12\x92\x8c\x07\xe4\xaf\xea\x16\x04\xd8\xc4\x55\xad\x0e\x06\x8a\xbb\x48\x1d\x0d\x17\xd6\x26\xba\x01\x9d\x43\xd0\x25\x75\x8f\x98\xbc\x85\x6d\x04\xd4\xb9\xe6\x45\x60\xd8\xc0\x52\x6a\x0a\x39\x6c\x42\x83\x7e\x30\x90\xfc\x43\x55\x1a\xb1\xef\x48\xc2\x74\xeb\x54\x80\x0d\x86\x69\x85\x79\x91\xc1\x6d\x15\x8e\xa1\x1c\xe2\x1a\x34\x5f\x84\xe2\x26\x9c\x69\x24\x2a\xb3\x77\x99\x61\x8f\x13\xe0\x82\x4a\x0b\xa5\x5d\xfd\xb0\xf7\xdd\x57\xea\x62\xed\xb0\x69\xe3\x48\x26\x2c\x5d\x25\x1a\x4c\xa9\xa4\x81\x50\xc6\xa5\x43\xb7\x7a\x9b\x5c\xf3\x82\x87\x63\x9d\x19\xc8\x2c\x41\x5e\x2a\x8c\x6f\xe9\x9c\x85\x63\x45\x67\xd6\x25\xcc\x24\xee\xc6\x97\x1c\x74\xa8\x46\xa2\x18\x32\xd7\x1d\xbb\x80\x61\x19\x92\xcb\xb2\xb2\x73\xc6\x45\x08\x5e\x6c\xed\xf7\x8b\x65\x25\x57\x61\xad\xf8\x16\xf9\x86\x6d\x02\x7b\x07\xaf\x02\x42\x25\xb3\xad\x4c\xc3\xe1\xd5\x77\x00\x27\x98\xa3\x70\x3e\x98\xc9\x65\x97\xe4\x23\x21\xb7\xde\x45\x58\x63\xf8\x2a\x97\x4a\x43\xf2\x5e\x64\x01\x35\x6c\x58\x73\xa5\x48\x5e\x55\x8b\xc0\x02\xf2\xa6\x12\x96\x0b\x2e\x21\x3c\x62\xe0\x59\x0f\x6c\xfc\x34\x70\xc9\x25\xd7\xc6\x06\xed\x7f\x0b\xfc\xee\x6c\x14\xe4\x00\x26\x9a\x2e\x2e\x96\xea\x9e\xa0\xeb\xc6\x81\x05\x35\xcb\xa7\xb0\xd0\x60\x96\xb5\x42\xd4\xeb\x80\xa6\xe9\xd4\xc5\x90\xa0\x0e\xa6\x04\xc3\x9c\xad\xb8\x8b\x1e\xc8\x3c\xb9\xe6\x12\x4c\x30\xdc\xa0\x16\x06\xcb\x93\xda\x7f\x08\x86\xd8\x1e\x07\x79\x81\x8f\xd3\x86\x31\xa0\x05\x5f\x83\x04\x63\x5e\x37\x95\xee\x2f\x96\x90\xee\xbd\x1e\x04\x67\x2c\x09\x95\x5f\xc3\x1a\xf6\x32\x1a\xaa\x17\x85\x0b\xfd\xa5\x26\x84\x75\xc3\x43\x33\x3f\xe6\x6c\x30\x1e\x0c\x11\xcb\xc0\x9a\x8e\x78\xf3\xb1\x1d\xbf\x02\xec\x12\x2a\x43\x8e\x09\x77\xdb\xcc\xf7\x35\x6a\x6e\x9a\x7c\xc2\x58\xb8\x17\xf5\x3d\x01\x5d\xe8\x58\x56\x71\x0f\xcb\x32\xee\x86\x82\x09\x5c\x64\xe8\xeb\x6f\x26\x04\x4a\x24\x3e\x21\x2c\xed\xdd\xb3\x54\x52\x69\x7c\x27\xf0\x53\xf0\xb0\x40\xa7\x20\x1c\x3e\x97\x39\x8a\x04\x52\x4f\x53\xa7\xac\x9d\x84\x14\xb7\xe5\xed\x39\xf4\x6d\x8e\x43\x25\x2a\x74\x8b\x7c\xeb\x05\xe1\xd7\xf0\xc3\x4f\x63\xb4\x52\xaf\xce\x6b\x28\x05\x4b\xa1\x00\x69\xa3\xd2\x31\x50\x32\xcd\x10\x32\xa3\x1f\x95\x7a\xdb\x1d\xbf\x3a\xc8\x0c\xec\xdd\x32\xbc\xf1\xf9\xf0\x6b\x6e\x73\xac\x7b\x73\x36\x8a\xd6\x36\x92\xf4\xa1\x75\x43\x0f\x82\x64\x10\x24\x83\x20\x19\x04\x49\x3f\x41\x82\x06\xb6\xbc\x00\x55\x75\xf2\x1f\xb2\x67\x28\xb2\xad\x4b\x33\x6b\x6a\x37\x9e\x8d\xfa\x8b\x2c\xb7\xdd\x9a\xb1\xee\x73\x07\x14\x11\xc8\xf0\xbe\xc0\xd3\xb5\x7c\xc5\x51\x5b\xee\xe8\x57\x4d\x09\x69\xd0\xb9\x17\x42\xa5\xcc\xc2\xb5\x62\xd9\x2b\x26\xdc\xf9\x31\xfd\x4e\x65\xe0\xf6\xdb\x23\xb8\x10\xa9\xa8\x8c\x05\x7d\x35\x39\x1b\x05\x1e\xee\x7b\xe4\xd0\xc6\x84\xd7\xa4\x63\xe5\xef\x57\xc7\xcd\x05\x37\x76\xdc\x7e\x6f\x55\xc1\xd3\x4e\x00\xd8\x58\x70\xfb\xcf\x47\xd5\xeb\x5d\xa3\xb1\x89\x50\xa4\xa6\xec\xc0\xe7\x9a\x2d\x16\x3c\x6d\x52\xae\x82\x53\x59\x02\x13\x76\x59\x07\xd0\x76\x8b\xe5\x6c\x44\xb2\xe8\x5e\xfe\x88\x6e\x13\xd6\x96\xe3\xf2\x31\xba\xce\xcb\x4b\x56\x70\xc1\xe1\x88\x38\xae\xf7\x3a\x6b\x3b\xbd\x8d\x34\xa6\xe2\x0b\xe1\x7b\x21\x98\x31\x51\x29\x5c\x4d\xa2\xc2\xcf\x6a\xe3\x78\xca\x64\x7e\x4c\x2c\x52\x62\xf5\x1d\xa1\xb9\x14\x3d\xdc\x6a\xe3\xb2\x9c\x68\x65\x55\x4a\xa9\x32\x44\x1c\x15\x7a\x1a\xb8\x0f\x01\x82\x54\xf4\x93\x8d\x3e\x12\xf2\x61\xaa\x9f\x64\xbb\xc8\x33\xdf\x96\xe0\x39\x7b\x36\xbf\x98\xc4\x9c\xcd\x26\xd4\x44\x9b\x4f\x62\xb2\x9f\x6f\xaa\x9f\x67\xa2\x5f\x90\x34\x3f\x5a\x8e\xf0\xb8\xde\xf7\x41\xbd\x4a\x70\x1c\xa8\x72\xee\x5b\xaa\xb0\x60\xe5\x78\x05\x5b\x94\x94\x42\xf7\x62\x7c\xcf\xcf\x5e\x6d\x6a\xfa\x54\xb0\xb2\xf3\xeb\xb2\xba\x15\xdc\x2c\xdf\x29\x3b\x05\x96\x6d\xcf\xb3\x4c\x83\x31\x10\xc1\x79\x31\x20\x20\x45\xc6\x96\x9e\xa6\x93\x6c\xc0\xb8\xa4\x9b\xf3\xc5\x82\x4b\x6e\xc3\x1b\x32\xff\x81\x8f\xdb\xa9\xf3\x51\x95\xa9\xe0\x20\x2d\xce\x92\xf1\xc1\xff\x22\x5e\xe4\x71\x8d\x84\x9f\xce\x78\xe6\x25\x02\xbd\x64\x05\xf2\x75\xab\x4a\x25\x54\xbe\x7d\x0b\xdb\xd0\xb6\x51\x44\x53\x0e\x73\xac\x82\xd8\x04\xe4\xa8\xa1\x5e\x93\x60\xef\x94\xde\x7b\xf0\x02\xcb\xad\xa9\x92\x12\x52\x3b\xc7\x05\x36\x29\x5c\x89\xe7\xc4\x15\x40\xc9\xdc\xc9\x92\xb3\x51\x28\x59\x7b\x0f\x79\x95\x09\x78\x1a\x9d\xbb\x61\x9b\x29\xa4\xdb\x54\xc0\x21\x1a\x82\xe2\x2b\x55\xd9\xb2\xda\xef\xc3\x20\xd6\x27\x5e\x50\x76\xbf\x41\x41\x73\xcf\xf9\xaf\xb3\xe4\xbc\xea\x3e\x68\x43\x5e\xbe\x3b\xf0\x29\xe4\xe8\x5d\x3e\x1f\x78\x25\x20\x39\x9f\xbe\x8b\x42\xa0\x3d\x56\x8d\x39\x52\xed\x85\x7f\x21\x54\x95\x11\x86\x9f\x36\xb5\xee\x29\x5c\xaa\xf7\xa5\x56\x05\xf6\x03\x1f\x22\x0f\x55\xd6\xde\xc2\x96\x5c\x68\xcd\x8f\x9e\x77\x39\x4e\xe2\x14\xf9\x7b\xf0\x01\x08\xfa\x97\x58\xa4\x19\xd9\xfe\x0e\x96\x7f\x11\x4c\x94\x7c\x0d\xf0\xd1\x9a\x89\x8a\x30\x6b\x1e\xb3\x55\x53\x18\xd6\xd8\xb0\xc6\xbe\xd3\x35\x46\x1c\xfc\x46\xe9\x61\xab\x02\x12\xc1\x7f\x06\x09\xae\x20\x5a\x2c\xfc\xbf\xcf\xe7\x93\x64\xc2\x8c\xb9\x43\x4e\xde\xa0\xb2\x07\x95\x3d\xa8\xec\x41\x65\x0f\x2a\x7b\x50\xd9\x47\xab\xb2\x6b\xad\xf7\x01\x91\xca\xe8\x87\xae\x8c\x8d\x02\x7c\x25\x9b\xa4\xdb\x39\xcb\x31\xe9\x7e\x9e\x34\x32\xd8\x44\x41\xbe\x56\xb9\xb1\xcc\x2c\x93\xd7\xcc\xc2\x65\x13\x6a\x8b\x4a\xe7\x31\x68\xe0\xca\xf6\x78\xd1\xc0\xa4\x88\xfa\x01\xf3\x12\x30\xe9\xb6\x7e\xe0\xe8\x0d\x5e\x22\xf0\xb4\x39\xfd\x9d\xbc\x56\xd6\x44\x21\x30\xab\xca\xd2\xed\xfa\x25\xae\xcc\x78\x82\xaf\xb0\x4d\x24\x13\x73\xf1\xba\x38\x77\x3c\x70\x57\xa9\x22\x79\xa3\xb5\xd2\x51\xf0\xad\x30\xb1\x70\x5f\xa4\x2c\x59\x20\xca\x60\xf9\xe3\x6b\x1b\x97\xc0\x0a\xb6\x71\x09\xe0\x4a\xf1\x78\xc0\x13\x74\x79\x5b\x2d\x67\xae\xce\xf3\x5c\x43\x8e\x4b\xc1\xa0\x98\x40\x4b\x6b\x11\xb7\x8d\xd3\x2c\xd5\x88\x5a\x26\x9a\x21\x11\x4d\x48\x7f\x98\x5e\x45\xc1\x4d\x55\x51\xcb\xe6\x28\xe0\x4b\x60\x19\x60\x2f\x35\xf6\x3f\x93\x41\x6e\x17\x69\xed\xec\xd8\x7b\x08\xe6\x0c\xc1\x9c\x21\x98\x33\x04\x73\x86\x60\xce\x10\xcc\xf9\x3e\x82\x39\xb5\xd6\x8b\x16\xcc\xf9\xa7\x51\x32\x71\xf7\xc5\x24\xed\xa1\x9c\xb8\x44\xd0\xcb\x63\x70\xae\x06\xe7\x8a\xe4\x5c\xad\xd8\x62\xd5\x99\xf1\x4b\x95\xec\xaf\xb4\x5a\xa1\x4d\x67\xe2\xc0\x45\x74\xac\x6e\xc0\x18\x96\xc7\x0b\x95\x7c\x81\x9f\x5c\x72\x10\x59\x14\x2a\x2e\xda\x63\x2c\x2b\xca\x24\xe2\x50\x3d\x10\x89\x16\x57\x52\x25\x4f\xe3\xa2\xc7\x61\x50\x9d\x21\xd7\xd4\xd3\xf6\x1b\xf1\xc6\xc3\x78\xb7\xde\x47\x01\x1b\x20\xd4\x8a\x87\x16\x4b\xac\xb2\x2a\x79\xc8\x06\x49\xf0\x79\xfd\xe4\xc1\x5e\xc6\x8a\xd1\xd4\x66\x4d\x39\x38\xf3\x83\x33\x3f\x38\xf3\x83\x33\x3f\x38\xf3\x83\x33\xff\xdd\x38\xf3\x55\x2c\x67\xbe\xb6\x03\x12\x6c\x9a\xaa\x1f\x7c\x24\x68\x2e\xa3\x46\x20\xf0\xf9\xec\x44\x60\x0d\x85\x5a\x43\xbc\x31\xb7\x20\x19\xea\xf2\x3f\x3f\xf4\x21\x6c\xf2\x57\x0c\x9b\x3c\x7b\x56\x69\x1e\x01\x97\x20\x1d\x0b\xcc\x7d\x4f\x24\xf2\x35\x22\xaa\xc8\x38\x09\x16\xa7\x73\x1f\xee\x4d\xfd\xc8\xc6\xbf\xff\xcf\xf8\x6f\x9f\x4e\x3e\x8e\x9b\x7f\x25\x9f\x7e\xd8\xfd\xbf\xd3\x9f\x82\x5e\x15\x8b\xbc\x54\xc6\xdf\xf9\x26\xb4\x85\x34\xf9\xe6\xe5\xd9\x28\xac\x55\xc6\xee\x8c\x93\xb1\x68\x39\x38\x38\x8b\x83\xb3\x38\x38\x8b\x83\xb3\x38\x38\x8b\x83\xb3\xf8\xd4\x9c\x45\xf4\x07\x4e\xe9\x19\x48\xf1\x9b\xa6\x83\xd6\x1b\xb4\xde\xa0\xf5\x06\xad\x37\x68\xbd\x41\xeb\x1d\xad\xd6\xbb\xad\xd2\x55\xf7\xcd\xbe\x5e\xf3\xba\x3b\x66\x1d\xab\xc0\x0d\xc8\xac\x54\x5c\xda\x28\xe0\x3a\x5e\x61\x1e\xed\x8a\xf2\x30\x1d\x07\xdc\xbc\x74\x06\x4c\xcc\xe0\x6e\x7b\x57\x5c\x92\x62\xcb\x02\x7b\x51\x40\xdd\xd9\xee\x85\x6e\x95\x65\xa2\x8e\x65\x26\x26\x56\xe9\xa2\xaa\x74\xe5\x8d\x13\xe4\xfd\x0e\x7e\x24\x0c\x24\x65\x65\x93\x66\xa5\x47\x20\x81\x17\x8b\xe3\x56\x8a\x8c\x82\xc9\x26\x1c\xed\x71\xad\x9f\x46\x01\x28\x62\x8a\xf9\x95\x2a\x9b\x68\xae\xb4\xab\x22\xe9\x58\xbf\x2b\xa7\x0f\x35\xd8\xa5\x32\xf5\x3d\x6f\xd9\xed\xd9\xa8\xbf\xc1\x00\x45\x69\xb7\xaf\xbb\xd7\x0d\xc5\x00\x29\x20\xe3\x15\xca\x98\x22\x71\x97\x5b\x7a\xa8\x8b\x6f\x49\x95\x7b\xe9\x35\x7b\xc9\xd5\x7a\x1f\x62\xd3\x27\xbf\x3d\xff\x3c\x3e\xfd\xe9\xe4\xe4\xa3\x8b\x46\x3f\x3f\xf9\xed\x45\xfd\x8f\x1f\x4e\x7f\x3a\xfd\xbc\xfb\xe3\xf9\xe9\xe9\xc9\xc9\xc7\xb7\x37\x3f\xcf\x27\x6f\x3e\xf1\xd3\xcf\x1f\x65\x55\xac\x9a\xbf\x3e\x9f\x7c\x84\x37\x9f\x90\x20\xa7\x98\x60\x77\xef\x22\xc2\xe8\xf5\xe9\xce\x29\x25\x98\x1b\x28\x29\x9c\x86\xc1\x23\xf3\x59\x84\xd2\x9b\x58\xc1\x38\xae\x7b\x14\x72\xc8\x27\xc3\x88\x3f\xe2\x88\x97\xeb\xa0\xf7\xda\x98\xfa\x16\x82\xee\xf7\x68\xa8\xee\x49\x05\xe3\x05\x3e\xc5\x9c\x34\xf4\xbb\x09\x60\xd9\x7b\x29\xd0\xce\x27\xd5\x19\xc3\x4e\xb0\x7b\xc6\x0f\xfd\x45\xbc\x8d\x9e\x6d\xec\x55\x46\x3e\x13\xc4\xd2\x14\x8c\x71\x17\xe7\x23\x3f\x20\x15\x2e\xf6\x9a\x51\x9c\xcd\xf3\xe5\xcf\xdd\xf1\x35\x43\xf3\xb0\xcf\x30\xb9\x87\x95\xfc\x67\xad\xaa\x12\xff\x85\x57\xe7\x9f\x3d\x5b\x71\x89\x62\xb7\x5e\x44\xa8\xf1\x13\x0f\x22\x94\xa5\xd3\xc6\x2d\xb8\xcc\xd0\x2f\x77\x5a\xd8\x9e\x4b\xcd\xfd\xa7\xa1\x91\x89\x26\x26\x43\x09\x67\x64\x12\xde\xef\x77\xb8\x9e\x68\xaf\xfe\xd1\x0e\xc5\x5b\xae\x7f\xfc\x96\xc8\x9c\x4f\xde\x9a\x0d\x66\xd7\xf6\xe2\xd2\xdd\x22\x03\x33\xb0\xd1\xc0\x46\x3d\xd8\x88\xfc\x09\xe5\x76\x0f\x5f\xf1\x58\x9f\xf3\x7a\xb3\xd9\x05\x6a\x49\x1c\x4a\xb4\x51\x8e\x6b\xeb\xc3\xb5\x93\x76\x6d\x6f\x00\xa2\xf5\xe6\x15\x79\x68\xbc\x66\xa2\x77\x5b\xe9\x16\xa4\xaf\xc9\xe2\xbb\xe1\x32\xbe\x9f\x44\xd2\x67\x1e\x4b\xdb\x77\x34\xea\xd5\x47\xbd\xb5\x39\x84\x6a\xf1\x9c\x77\x8f\xa1\x21\x7f\xd2\xee\x2f\xa0\x62\xac\xbd\xba\xb4\x56\xa2\x2a\xc0\x79\x65\x91\x49\x44\xec\x05\x61\x74\x91\xaf\xa2\x5e\x33\x90\x56\xba\xe3\xba\x26\xac\xa8\x2f\x55\x36\x6b\xe1\x2e\x94\xb4\xb0\xe9\x0c\x09\x53\x94\xc8\xc2\xa0\xdd\x49\xea\xed\xf6\x34\x4b\xab\x6d\xc8\xc5\x92\xc9\x1c\x22\xdd\xda\xa9\x2b\x79\xfe\x54\xfa\x5b\xb7\xe5\x9d\x92\x53\xa5\x50\x31\x7e\x5a\xc8\xa8\x46\xc7\x96\xe9\x88\xdb\x51\x03\xd7\x5c\x56\x9b\xf7\xd8\xe3\xe3\x34\xfe\x75\x8f\x80\x35\x88\x48\x02\xa4\xd9\x8e\x8e\x06\x8e\x0d\x06\x7b\x81\xe3\x33\xbb\xc8\xe0\x48\x61\x79\x2f\x0d\xdd\x59\x87\x89\x56\xd8\x04\x16\x32\x07\xa8\x94\x89\x7a\x07\x00\x4f\xc3\xa3\xd7\xed\x07\x71\xc0\x29\x56\x5f\x13\xd1\x08\x3e\x4f\x55\x59\x0a\x28\x40\x5a\x26\x6a\x29\x89\x1a\x7d\x82\x6d\x4d\x15\x34\x54\x51\x43\x33\x32\xcd\xd6\xa4\x56\x84\xee\x23\x95\x75\xa9\x41\x50\xdf\x23\x7f\x11\x29\xd0\xfc\x15\x42\xcc\x74\xdc\x34\x1d\xf5\x2e\x89\xd3\x69\x8c\x72\xc7\x65\xa6\xee\x4c\x44\x0d\x96\x17\x86\x5d\x68\xc8\x40\x5a\xce\xc4\x0c\xb9\xcd\xe2\x35\x59\x7f\x24\x15\xd5\x89\xb8\x37\x44\x8e\xca\xc8\xff\xca\xea\x6e\x8c\xd1\x0b\x0d\xcc\x76\xf6\x00\x6f\xa5\x39\xb3\xe2\x15\x33\x90\x9d\xd7\x9b\x5f\xce\xb4\xd7\x4a\x84\xa6\x62\xe2\x79\x0e\x4c\x08\x75\x37\xd1\x7c\xcd\x05\xe4\xf0\xc6\xa4\x4c\x30\x8b\x3c\x13\x88\xef\x80\x7b\x52\x56\xb2\x5b\x2e\x38\x76\x3d\x51\x57\x1f\xcb\x90\xa2\x8b\xa4\x08\x3c\x18\x99\x2a\x98\xdc\x93\x69\x55\x1e\x6b\xeb\x09\x0b\xd7\xcd\x6a\xcb\x6a\x59\x78\x0e\x2b\xb5\x4a\x6f\x5c\x7d\x9e\xb3\x51\xe0\x61\xd9\x9d\x0c\x70\x6e\xde\x25\x17\x60\xb6\xc6\x42\x11\xbe\x07\x83\x6b\x3b\xb8\xb6\x83\x6b\x3b\xb8\xb6\x83\x6b\xfb\x4d\xd7\x76\x30\xe1\xbf\x3f\x13\xde\x80\x5e\xf3\x14\xce\xd3\x14\xa3\xd9\x91\x0d\x46\x51\xc7\x53\xc6\x72\x99\xab\xb1\x58\x67\xc7\xce\xbe\x82\x9e\xab\x15\x74\x1a\xdc\x78\xf5\xca\x0b\x96\xc3\xa4\x12\x62\x56\xe7\xee\x75\x72\x3e\xd2\x9e\xa4\x2d\x25\x7c\x38\x84\xc4\x63\x68\xb6\xc1\x1b\xb1\x05\x58\xe6\x4e\xfd\x9d\x8d\xc2\xf5\x9f\x49\xa9\x2c\x43\x4b\x29\xff\x9d\x4e\xd2\xe0\x91\x86\x8f\x52\x9a\xeb\x29\xb6\x1f\xfd\xaa\x39\xe0\x2a\x61\x25\xff\x05\x74\xb4\xdc\xc1\x85\xab\xa3\x1c\xed\x4e\x34\xfc\xd9\x52\x22\x70\x24\xd9\xd1\x08\x25\x53\xb2\x34\x0e\xfa\xee\xa0\x67\xcc\x19\xad\xa2\x14\x6d\x0b\x2e\x55\x51\x80\x96\xe9\x1c\x6c\xd7\x85\x4d\xa8\x8e\x34\x50\x5d\x57\x34\x7d\xe1\x46\xbe\xfc\x71\xd4\xd7\x7d\xec\xd8\x74\xc0\x0a\x02\x90\xec\x56\x40\x16\x4e\xfd\x37\xf2\x0c\x63\xe5\xa1\x79\xc4\x2c\x99\x86\x0c\x51\x6e\x1c\x89\x88\xf1\x24\xc6\xbb\x91\xe9\xcb\x66\x55\x69\xac\x06\x56\x4c\x81\x65\xdb\x77\x2e\x3b\xa1\x2b\xc7\x03\x37\xd6\x6b\xa6\xdd\xb5\x99\x5d\xe2\x15\x31\x24\x9d\xdd\x28\xf7\x3b\x8d\x1d\x24\xf6\xc2\xff\xf9\x7a\x36\x5b\x23\x54\x7e\x25\xcb\xea\x5b\xca\xf1\x4f\xd5\x61\x17\xdf\xef\x13\xee\x9d\x63\xd5\x9a\xe4\x67\x23\xff\x35\x87\x34\xcd\xe8\x26\x4d\x67\xe3\x11\xb3\xb1\x7b\x60\x63\x41\x4b\x26\xe6\x9a\x2d\x16\x3c\xed\x3e\x48\x83\x22\xee\xb2\xb5\x5f\x31\xc1\x64\x0a\xfa\x6a\x12\x14\xae\x49\x99\x99\xba\x53\x3f\x7b\x87\x09\x61\x45\xa1\x68\xef\x63\xdc\x87\x9f\x54\x19\x3c\xae\x52\x28\x1f\x95\x5a\x57\xb0\x07\x31\x94\x9d\xec\xd8\x88\x81\x3e\x2b\xee\xb6\xc3\x4e\x44\x4d\xf8\x82\xa5\x6e\xef\x68\xdb\x55\xb8\x0a\x07\xa6\x59\x01\x49\x80\xd1\x73\xde\x63\x73\x6d\x49\x3b\xb1\xa1\xe0\x04\xc8\xdc\x2e\x13\xd1\x95\x3b\x8e\x02\x2d\x99\x36\x7b\x7b\x8a\x99\x45\xf7\x64\x50\x37\xa8\x3b\x68\x8b\x6a\xd6\x57\x88\xc9\x2e\xb9\x22\x14\x32\x18\xcb\x0b\x77\x3d\x54\x5a\x69\x0d\xd2\x26\xb0\x06\x69\xc3\x99\x59\x70\x9f\x4d\x10\x6c\x30\xba\x79\xc8\x03\x2e\x59\x70\x6d\x2c\xe6\xee\x6a\x0c\xf0\xbf\x69\xbb\x9e\xdd\xf4\x61\x18\x7c\xef\x53\x54\xdc\x7f\x2f\xc0\x95\xfb\xef\xb0\x1d\x11\xaa\x42\x9a\x41\x45\x68\xab\x26\x61\xda\xa6\xbd\xfb\x94\x90\x01\x1a\xfd\xf3\xb9\x75\x24\x2e\xed\xe1\x8b\x63\x7f\x8e\xeb\x38\x31\x21\x38\x9e\x94\x6a\x43\xd7\x0f\xa4\x8b\x1c\xae\xd1\xb0\xdf\x50\xf0\x73\x2c\xec\xbd\x07\x79\xf9\x64\x3d\x3b\x6d\x2b\x44\xa9\x40\xc0\x23\x4d\x06\x0b\x7c\xfe\x03\xb5\x76\x5a\x17\xa1\x57\x46\x11\x6f\xff\xb0\xcd\x3e\x40\x87\x43\x3d\xec\x6e\x1b\xf1\x0c\x93\x5e\xd1\x95\x6d\xc9\x9a\x41\xd5\x1e\x75\xfd\x20\x6a\x90\xb2\x96\xcc\x82\x26\xb9\xff\x3c\xe5\xe0\x2e\x3b\x0f\x9f\xec\x1b\x0b\x86\x21\xf9\xc9\x2c\x83\x78\x3d\xa5\xec\x85\x35\xc7\xd6\x34\xf0\xa9\x4f\xb1\x45\xe8\x9f\x4d\x9d\x08\x3c\x99\xd4\x1f\xad\x32\x49\x90\x9d\x95\x29\xa8\x0e\x25\xb5\xb4\xf0\x45\x20\x35\xac\x03\x94\xc8\x34\x40\x84\x06\x24\x44\x84\xb0\x38\x20\xab\x74\x08\x31\x61\x34\x80\x8c\x28\x0d\x21\x02\x4e\x25\xe7\x58\xd2\x6d\xd4\x45\x75\x5c\x99\xe8\xb5\x62\x50\x88\xb2\xf4\xc9\x04\x27\xa4\x3f\x6c\xe2\xf7\xda\x58\x30\xad\x38\x2c\xc7\xe0\xd9\x2f\x97\x02\x6a\xc9\x05\x89\xe4\x7f\x52\x75\x96\x19\x51\x57\x3e\xdf\x0c\xc0\xc2\xd9\x23\x17\xc7\xbd\x8e\xaa\x4b\xfc\xc7\x63\x5e\x91\x2f\x58\xc9\x0a\xc4\x83\x9c\xd1\x76\xa2\x36\x98\x47\x8e\x0e\x38\x39\x98\xd5\xe6\x75\xb2\x24\x32\x31\xd0\x58\xc1\x62\xf0\x26\xc4\xa8\x64\xc3\x61\xf0\xdd\xdf\x96\xfe\xff\x5b\xa9\xec\xf1\x86\xc1\xd4\x67\x74\x12\x43\x03\xf6\x4f\xed\x5f\x2e\xaf\x47\xd9\x6f\x82\x64\xc0\xd4\x8c\x15\xd6\xfd\x11\x6d\xd8\xaf\x65\x53\xbf\x55\x87\xcd\x51\xc9\xd3\x8b\x32\x4e\xf7\x95\x19\xd0\x6d\xf8\x71\x1f\x1a\xb1\x44\xdb\x35\x7b\xad\xce\xc9\xd5\x7c\x1f\x69\xd3\x7f\x9a\x68\x38\xf8\xf4\x4a\xff\xf4\xd2\x97\x46\x54\xf9\xd0\xe1\x23\xde\x30\x7f\x7c\xe3\xf6\x4f\xcd\x88\xa2\xc9\xf2\xaf\xef\xec\x6e\x3d\xdf\xc9\xab\xb5\xaa\x0c\xc6\x5f\x67\xb7\x62\x7f\xbe\x5a\x85\x87\x56\xbb\x4e\xe8\xf8\x28\x9b\xfa\x6a\x23\xb3\xce\xb7\xbb\x2c\x36\xb6\x2d\x63\x15\xdc\xac\xf3\xed\x2e\xfb\x19\x00\xee\xdb\x61\x1e\x27\x12\x10\x00"),
 		},
 		"/logging.banzaicloud.io_outputs.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "logging.banzaicloud.io_outputs.yaml",
 			modTime:          time.Time{},
-			uncompressedSize: 351281,
+			uncompressedSize: 552701,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x5b\x8f\xec\x38\x72\xe6\xfb\xf9\x15\x82\xdf\x4f\xef\x0c\xda\x63\x0f\xce\x8b\x31\xe8\xf5\xc2\x03\x2f\x66\x1b\x9e\xc5\xbc\x2c\x16\x04\x4b\x8a\xcc\x64\x27\x93\xd4\x90\x54\x5d\x8e\xe1\xff\x6e\x50\x52\x66\x29\x2b\xc5\x8b\xc8\xaa\xd3\x89\x3e\x5f\xbc\x55\x25\xf5\xf1\xa2\x60\x28\x22\x18\x11\xfc\xf4\xf9\xf3\xe7\x4f\xbc\x17\x7f\x23\x63\x85\x56\x5f\x1a\xde\x0b\x7a\x76\xa4\xfc\x5f\xf6\x87\xe3\x1f\xed\x0f\x42\xff\x8f\xc7\xdf\x7f\x3a\x0a\xd5\x7d\x69\x7e\x1a\xac\xd3\xa7\xff\x20\xab\x07\xd3\xd2\xff\xa4\x9d\x50\xc2\x09\xad\x3e\x9d\xc8\xf1\x8e\x3b\xfe\xe5\x53\xd3\x70\xa5\xb4\xe3\xfe\xdf\xd6\xff\xd9\x34\xad\x56\xce\x68\x29\xc9\x7c\xde\x93\xfa\xe1\x38\x3c\xd0\xc3\x20\x64\x47\x66\x04\x3f\x77\xfd\xf8\xbb\x1f\xfe\xf0\xc3\xef\x3e\x35\x4d\x6b\x68\x7c\xfc\xff\x8a\x13\x59\xc7\x4f\xfd\x97\x46\x0d\x52\x7e\x6a\x1a\xc5\x4f\xf4\xa5\xd1\x83\xeb\x07\x67\x7f\x90\x7a\xbf\x17\x6a\xff\xc3\x03\x57\x5f\xb9\x68\xa5\x1e\xba\x1f\x84\xfe\x64\x7b\x6a\x7d\xc7\x7b\xa3\x87\xfe\x4b\x13\x68\x35\x81\x9d\x47\xc8\x1d\xed\xb5\x11\xe7\xbf\x3f\x9f\x9f\xfa\xcc\xc7\x7e\x9b\x66\x9a\xff\xff\x19\x7b\x1e\xff\x21\x85\x75\xff\xbe\xf8\xe7\xff\x16\x76\xfa\xa1\x97\x83\xe1\xf2\x32\xca\xf1\x7f\x56\xa8\xfd\x20\xb9\x39\xff\xf7\x53\xd3\xd8\x56\xf7\xf4\xa5\xf9\x8b\x1f\x44\xcf\x5b\xea\x3e\x35\xcd\xbc\x14\xe3\x20\x3e\x37\xbc\xeb\xc6\xc5\xe5\xf2\x67\x23\x94\x23\xf3\x93\x96\xc3\x49\x5d\x86\xd8\x91\x6d\x8d\xe8\xdd\xb8\x78\x7f\xb6\x8d\x3b\xd0\x0c\xdf\xf0\xd6\x89\x47\xfa\x97\xb1\x61\xd3\xfc\x62\xb5\xfa\x99\xbb\xc3\x97\xe6\x07\xeb\xb8\x1b\xec\x0f\xd3\xef\xf3\xcf\xd3\xa2\xfe\x69\xf9\x2f\xf7\xe2\xc7\xf6\xa0\xb5\x24\xae\xd6\xba\xfb\xcb\x70\x7a\x20\xd3\xe8\x5d\xd3\x1b\xfd\x20\xe9\x64\x83\x7d\x9d\x1b\xfc\xa4\x07\xe5\xae\xba\xfc\xf9\xfa\xd1\xa9\x53\x3f\xd3\x3d\x99\x4f\xaf\xcd\x1e\x7f\xcf\x65\x7f\xe0\xbf\x9f\x56\xb2\x3d\xd0\x89\x7f\x99\x1f\xd1\x3d\xa9\x3f\xfd\xfc\xe7\xbf\xfd\xf8\xd7\xab\x7f\x37\x7e\x54\x3d\x19\x77\x79\xa1\x13\x2d\x18\x7d\xf1\xdf\x73\xcf\xd6\x19\xa1\xf6\x8b\x1f\xc6\x77\x9e\xd3\x70\xc9\xfd\x6f\x1b\xeb\x87\x5f\xa8\x75\x8b\x1f\xce\xec\xf9\x4a\xeb\x83\x1d\x07\xfc\x64\xff\x55\x72\xeb\x44\x6b\x89\x9b\xf6\xf0\xf6\xf7\xd8\xb3\x9e\x1e\x86\xdd\x8e\xcc\xda\x2f\xa9\x27\x3d\xb5\x87\x41\x1d\xd9\x6e\x90\x92\xb9\x83\x21\x7b\xd0\xb2\x0b\xb5\x0d\xae\xcd\x2d\xa0\x14\x27\xe1\x98\xa1\x56\x9b\x2e\xd8\xf7\x1a\x3b\xc4\x01\xad\xf8\x4a\x75\xa3\xd3\xa7\xde\x90\x4d\x0e\x29\x0a\xd2\x91\xe4\x2f\xd4\xb1\x56\x9f\xfc\xa0\x9c\x38\x91\x1e\x5c\x1d\xa4\xb0\xfc\x41\x12\x9b\x26\xfb\xc0\xdb\xe3\xd0\xa7\x00\x97\x5b\xf7\x96\x76\x72\xb0\x07\xc6\x1d\xb3\x87\xc1\x75\xfa\x49\xbd\x07\xdc\x28\xa2\x1e\xb9\xac\x9a\xeb\x04\x75\xd2\x5d\xdd\xab\x9c\x60\x3c\xd3\xf2\x8e\x3d\x0c\xc6\xba\xf7\x1c\xde\x8c\xdb\x7a\x81\x56\xc7\xbf\x57\x78\xef\x32\x42\xfd\x48\x66\x27\xf5\x13\xf3\x22\xfe\xad\x9c\xdb\x88\xd5\x7b\x39\x5e\x03\xf0\xf7\x81\x06\x9a\xb7\xa7\x24\xb5\x4f\xc3\xc5\x97\x6b\xc4\xeb\xa6\x8d\x60\x37\x6c\xfb\x38\xaa\x21\x67\x5e\x18\x3d\xf7\x5a\x91\x72\x82\xcb\x71\x8f\xe9\xdd\x8e\x3d\x70\x5b\xc7\x87\x13\xf4\x4e\x1b\x7a\x0c\x89\xe1\x26\x73\x93\x4d\x50\x27\xfe\xfc\x3e\x7c\xf2\x0a\xe7\x45\x54\xa5\x18\x9e\xc0\x0c\x57\x9d\x3e\x65\xbc\x8e\x9c\x89\x5a\x6a\xb5\xea\xb8\x79\x79\xa7\x4f\xcf\x84\xfa\x1e\xe2\x78\x46\xf2\x0d\xeb\x61\x9e\xb8\xa8\x1b\x8d\xe3\xfb\xba\x0f\x96\x5f\x92\x23\xbd\xbc\x07\x06\x1b\x2c\xb1\xc1\xb5\x75\xef\xff\x0c\x56\xbf\x34\x33\xd0\x57\xad\xea\x5e\x95\xd3\x8e\xcb\xf7\xd2\x32\x2a\x19\x27\xa0\x54\x9e\x89\x54\xd7\x6b\x11\xfa\x2c\xa5\x55\x3e\xde\xb6\x64\x2d\xf3\xcb\x26\x22\x1b\x2e\x0d\xe4\xe9\xe4\x3f\x90\xff\xcb\xe8\x53\xac\x51\x2e\x98\x27\x4b\xad\x21\xf7\xef\xf4\xf2\x1f\xb4\x4b\xb5\xdd\x82\xeb\x29\xba\x07\x96\x94\xf1\x8e\x97\x34\xda\x30\x1f\x01\xac\xfb\xc9\x38\xdc\x02\x1e\xdf\x79\xaf\x64\xe8\xef\x83\x30\x14\xe1\x80\x33\x7d\xf6\x0b\x97\x6c\x95\xe0\xda\xcd\x0d\x1f\xb9\x1c\x12\xab\x9a\xb9\x9a\x23\x12\x78\x14\x3c\xfa\xce\x3c\x9a\xd1\x88\x5b\x3b\x9c\x88\x19\x2d\x89\x71\x13\x51\xd5\x21\x6d\xc1\xc9\x13\x41\xda\xbe\x21\xf0\xe8\x82\xbe\x5f\x1e\xdd\x28\x6d\x2d\x59\x2b\xb4\x62\xf1\x37\x01\xb1\x0b\x96\x9e\x08\x62\xf7\x0d\x81\x47\x17\xf4\xfd\xf2\xe8\x46\xb1\xfb\x44\x0f\x4c\x74\xa4\x9c\x70\x2f\xcc\xe9\x23\x29\xb6\x13\x12\x12\x78\x95\xc0\xdd\x4b\x82\x04\x7e\x43\xe0\xd1\x05\x7d\xbf\x3c\x9a\xd1\x88\x5a\xcb\x5a\xad\x1c\x17\x8a\x0c\x6b\x0d\x75\xd3\x29\x97\x65\x86\x24\x77\xe2\x91\xd8\x60\x04\x84\xf0\x1a\x81\xc1\x97\x04\x21\xfc\x86\xc0\xa3\x0b\xfa\x7e\x79\x34\xa3\x91\xa1\x7d\x6d\x34\xc6\xc4\x6c\xec\xf5\x84\x0e\x22\x7b\x8d\xb0\x1d\x96\x04\x91\xfd\x86\xc0\xa3\x0b\xfa\x7e\x79\x34\xa3\x91\x75\xf6\x8d\xb6\x1c\x17\xe1\x10\xba\x60\xe8\x89\x20\x74\xdf\x10\x78\x74\x41\xdf\x2f\x8f\x66\x34\x1a\x4c\x4d\x58\x6b\xa2\x83\x9c\x38\xf5\x44\x1f\x3b\x6d\x4e\xbc\x3c\xba\xae\xeb\x98\xa2\x27\x29\xd2\x01\x89\xf1\x37\x7e\x22\x6b\xf9\x9e\xe2\x56\x40\x75\x30\x22\xa9\x21\x22\x02\x3e\x37\x7a\x70\xa3\x03\x3f\xd2\xe4\x17\xab\xc3\x5c\xfb\xb9\x91\xce\x3e\x46\x7e\x6e\xa3\xbf\x9e\xec\xbe\xe7\xed\x31\xd2\xe2\xc0\xed\x21\xf2\xb3\x15\x6a\x2f\x89\x8d\xe2\xee\xc3\x98\x4e\xa8\x56\x0e\x1d\x31\xc7\xf7\xe1\xd7\x95\x7a\xe7\x52\xef\xad\xe3\xf6\xc0\x62\xfc\x97\x0d\xd2\x1b\xda\x89\xe7\x22\xfe\xcf\x98\x45\xe0\xd9\xc8\x32\xf1\xaf\x83\x21\xeb\xb4\xe1\xfb\x15\x6e\x8c\x6f\x2b\x3e\x38\xcd\xc6\x84\x41\x7a\xf5\x72\x96\xad\xcf\x38\x8c\x3c\x90\xe0\xfa\x4c\x18\xe2\xd4\x59\xc6\x7b\xc1\x1e\xd7\xb2\xcd\x36\x41\x4d\x0b\x36\x06\xe4\xa6\xdf\x7d\x02\x6b\x5e\xe2\xa4\x0b\x21\x2d\xc8\x32\xb4\xd8\xbc\xaf\x78\xbe\x66\x90\xaf\x15\x64\x69\x04\x1b\x3e\xda\x79\x9a\xc0\x06\xc0\x7c\x0d\x20\xff\xeb\x9f\xf7\xe5\x4f\x7f\xf5\xdf\xeb\x43\x9e\xd0\x46\x33\x56\x2b\x43\x0b\x05\x8f\x05\xe9\xb7\xcf\x63\x89\x06\x37\x22\x2f\x9c\xad\x07\x79\x77\x45\xe0\xc5\x82\x46\x90\x77\xe0\xb1\x78\x8f\xdf\x54\xde\x59\x6e\xa7\x08\x2b\x48\x3c\x70\xe3\x5a\x8f\x90\x78\xe0\xb1\x5b\xba\x2f\x1e\x4b\x34\x40\x71\x93\x2d\xa3\x43\x71\x93\x1a\x38\x14\x37\x59\x83\x43\x71\x13\x14\x37\x41\x71\x93\xed\x13\x45\x71\x93\xcc\xe3\x29\x14\x37\x41\x71\x93\x57\xaa\x38\x00\x09\x4b\xfb\xe8\x83\x61\x7d\xf8\xf3\xdb\xe3\xa2\x60\x8b\x37\x2e\xc8\x9b\x76\x91\x49\x8f\xc5\x2a\x9f\xb8\xdb\x5e\xf8\x6f\x79\x2c\x66\x9d\x21\x1e\xb0\xb1\x92\x67\x62\x4f\x96\x09\x65\x1d\x57\x2d\xb1\xde\xe8\x9d\xf0\xba\xda\x55\x54\x94\x33\x41\x2d\x3b\x25\x5e\x3d\x7a\xac\xd4\x0c\xdc\x14\x57\x04\x13\xb2\xa0\x11\xdc\x14\xe0\xb1\x78\x8f\x1f\xed\x98\x7d\xb2\x5e\xe1\xc3\x71\x3b\x38\x30\xd4\x23\xa4\x1c\x78\xec\x96\xee\x8b\xc7\x72\xa4\x9c\xb3\x89\x6a\x56\xa9\x30\xa5\x19\x24\x5d\x9f\x25\x03\xc8\xdb\x6b\xd6\x45\x35\xd3\xf0\x5b\x80\x6f\x79\xcb\xe8\xe0\x5b\xae\x81\x83\x6f\x79\x0d\x0e\xbe\x65\xf8\x96\xe1\x5b\xde\x3e\x51\xf8\x96\xe1\x5b\x86\x6f\x39\xd4\x20\xa8\xbf\xb6\x5a\xb5\x83\x31\xa4\xda\x68\x72\x43\x78\x3b\xc7\x2b\x6f\x27\x86\x87\xb4\xa2\x0b\x21\xad\x68\xd9\x20\xc8\xaf\x07\xe7\x7a\xd6\x1b\xfd\x5c\x92\x8b\xb3\xc8\x4a\x12\xa7\xc8\xeb\x4e\xf1\x8c\x7f\x0d\xec\xc0\x55\x27\x0b\xd3\x65\xa4\x6e\xb9\xf4\x63\x28\xeb\x5f\xea\x3d\x1b\x6f\xf9\x62\xde\xe0\x0b\x4b\xf4\xe4\x28\xde\xc2\x14\xe6\x38\x2d\xa1\x8a\x6d\xd7\x6b\x88\xaa\x91\x18\xf2\xdc\x43\x1d\x33\xf4\xf7\x81\x6c\x99\x6c\xf2\x40\xd3\x11\x52\xdd\x9c\x16\x18\xc5\x93\xf2\x6a\x17\x3d\x92\x72\x96\xf5\x64\xd8\xc3\xfa\xd1\x58\x93\x21\xae\x3d\xd2\x59\xdc\xc5\x34\xec\x24\xce\xab\xc8\x2c\x63\xbe\x7e\x70\xcc\x2f\xcf\x3c\xad\xb3\xcd\x3a\x69\x39\xe3\xa7\xb3\x6c\x6f\xbc\xc1\xcd\xc4\x0b\x4f\x74\x15\x2f\xac\x6a\x24\x66\x1d\x2b\xa6\x90\x7c\xf4\xa4\x1f\x89\xbd\xe3\xa6\xbd\x41\xac\xe2\xd1\x05\xda\x7b\xb0\xfc\x0c\x67\xc8\x79\x1b\x4b\x2b\x26\x14\xeb\x78\x21\xb3\x7d\x10\x4a\xf1\xe4\xbc\xde\xeb\xf8\x9e\x71\x3b\xad\x7c\x19\xab\x2f\x50\xca\xcf\xba\x63\x47\xfd\x13\xb7\xde\xfc\x10\xf9\x44\x77\xdc\xf1\x4e\xef\x37\x1f\xdb\xf7\x02\xe7\x44\xf0\xe1\x87\x7a\xc4\x39\x11\x78\xec\x96\xee\x8b\xc7\x12\x0d\x70\xb0\xb2\x65\x74\x38\x58\xa9\x81\xc3\xc1\xca\x1a\x1c\x0e\x56\x70\xb0\x82\x83\x95\xed\x13\xc5\xc1\x0a\x0e\x56\x70\xb0\x12\x6a\x10\x39\x58\x99\x74\x18\xa1\x15\x93\xf4\x48\x65\x75\xd7\xba\x8e\x1d\xb4\x75\xc5\x0e\xc8\xae\x63\x56\x0f\xa6\xad\x7c\xba\xe5\x8e\xf6\xda\x94\x39\x3b\xba\xae\xdc\x45\xed\x27\x5f\xe7\xe8\xaf\x29\x3f\xe6\x85\xf2\xfc\x05\x2a\x76\xdb\x66\x24\x0a\x04\x9f\x57\x9a\x59\x2b\xd9\x23\x97\xa2\xe3\x61\x25\x22\xe9\x12\xd5\xa6\x6c\x11\x2d\x99\x47\x51\xc8\x3b\x7e\xe0\xc5\x1d\x97\x97\x5b\x9b\x84\x8d\x75\xfc\xd4\x57\x79\xe7\x16\xdb\xb7\xdc\x39\x77\x70\xae\xc2\xb5\xf7\x8b\xad\xe9\xdb\xda\xa8\xc4\x29\x71\x07\xce\x5e\xba\x9b\x5f\x22\x92\x90\x24\xb7\x4e\xb4\x96\xb8\x29\x48\xe6\x81\x57\x10\x1e\x9b\x70\x8f\xf0\x0a\x82\xc7\x6e\xe9\xbe\x78\x2c\xd1\x80\xf7\xbd\x14\xed\xf8\x69\x2f\x3f\x64\x86\x6b\x71\xcb\xe8\xe0\x5a\xac\x81\x83\x6b\x71\x0d\x0e\xae\x45\xb8\x16\xe1\x5a\xdc\x3e\x51\xb8\x16\xe1\x5a\x84\x6b\x31\xd4\x20\x72\x96\x2c\x8f\x97\x20\xbe\x39\xc4\x31\xb5\x83\x12\x7d\xb6\x3c\x72\x25\x31\x4c\xed\x2b\x82\x19\x54\xd0\x08\xa6\x36\x78\x2c\xde\xe3\x07\x9b\xda\xad\x14\xa4\x1c\x6b\x29\xe4\x92\x86\x94\xbb\x22\x70\x60\x41\x23\x48\x39\xf0\x58\xbc\xc7\x6f\x23\xe5\x70\x6a\x02\x06\x0c\xf4\x08\x21\x07\x1e\xbb\xa5\xfb\xe2\xb1\x6c\x21\xc7\x7a\x1e\x72\xe5\x43\xd2\x5d\x11\xb8\xb0\xa0\x11\x24\x1d\x78\x2c\xde\xe3\x47\x4b\x3a\xad\x9c\x17\x75\x61\x7f\x62\xca\xaf\x37\x58\xa7\x4f\xec\x40\xbc\x23\x53\x16\x84\x36\x41\x88\xaf\xc4\x1c\x9d\x7a\xc9\x5d\x61\x2c\x21\x77\xfc\x9c\x18\x4a\x8a\x3f\x84\x9c\x8d\xa9\x37\xb9\xc4\x29\x0f\x8b\xa4\x1d\x1f\xa4\x63\x57\xe1\x49\x55\xb7\x56\x76\xb4\x93\xd4\x3a\x6d\x18\x97\x82\x97\xad\xf4\xb4\x2c\x4c\xc8\xc2\xa2\xc5\xf4\xdc\xd2\xb8\x63\xa2\xe7\xc7\x29\x94\x1d\x17\x92\x69\xc5\xfa\xc1\x39\xa1\xf6\x97\xb7\xce\xce\x47\x68\x2d\x85\x76\x4f\x12\x5a\x72\xe7\x48\xb1\x03\xb7\x87\x78\x48\x64\x2e\x06\xb3\xd4\x73\xc3\x9d\x2e\xab\xc3\x50\x1c\x5e\xea\x1f\x2c\x8c\x68\x75\xae\x1f\xdf\x0f\xa9\x32\x57\xbd\xe8\x8a\x03\x1b\xc5\x5e\x69\x43\xec\xc2\x27\x65\x33\x10\xf2\xc4\x7a\x2d\x45\xbc\x62\x4c\xc6\xe3\xc1\xfa\xd7\xf9\x08\xfa\x91\xcc\x93\x11\x71\x99\x14\x66\xa6\x73\x94\xb0\x50\x1d\x3d\x33\xa1\x58\xf0\x1e\xee\x5c\xa4\xaa\x78\xe3\x65\x75\x92\x31\x84\xb5\x14\xc6\xcf\xa6\xf3\x7b\xb6\xf7\x9b\xa5\xb0\x2a\xe5\x04\x53\x2c\x63\xa7\xc7\x2b\xee\x5c\x1e\xab\x2e\x58\xf6\x8f\xbf\xfb\x1d\x33\xc4\x8b\x83\x61\x2f\xd7\x3f\xfb\x05\xa9\x28\xa5\x7f\x37\x77\x51\xbf\xc1\xa8\x14\x81\xe7\x18\xf5\x17\xb6\x27\xe7\xd7\xbb\xe6\x2b\xf8\x0a\xf6\xf6\xeb\x51\x04\xe7\xad\xbb\x27\x6d\x50\x25\x1f\xda\xf7\x6a\x8f\xb0\xf0\xc0\x63\xb7\x74\x5f\x3c\x96\x68\x50\x78\x41\x4b\xd3\xf4\xa2\xa7\x70\xe5\xbb\xd4\xc3\x89\xc4\x9c\x48\x39\x24\x43\x3b\x32\x4c\xff\xc2\x2c\x19\xc1\xa5\xf8\x1a\x2f\x81\x16\x7e\x61\x86\x5a\xad\x14\xb5\xce\x1b\x1b\x64\x4c\xfc\xfb\x15\xc3\x91\x9a\x77\x8c\xef\x5c\x61\x31\xb6\x19\x60\x1e\x4d\x4a\x2d\x4e\x0e\x44\x2b\xe6\x4d\xa8\xc1\x14\xea\xa4\x73\xe1\xa3\x23\xbd\x58\x8f\x35\xf4\x5d\xe9\xe7\x73\x15\xa9\xa2\x20\xd3\x1c\x68\x14\x0b\xaf\x4b\x62\xd8\xc1\x18\xff\xce\x6b\x5e\xd7\x18\x9a\xc7\x57\x8a\x0c\xe5\x3c\xad\xa5\xf4\x46\xc3\xa4\xf2\x17\xbe\x21\x3d\x8c\xba\x4d\xe9\x4a\xda\xf6\x40\x85\x4a\xb5\x55\x62\xe7\xf7\x5f\x2b\xb9\xb5\xe5\xba\xb9\xb5\x72\x0c\x0b\xad\xd1\xf5\x46\x0c\xa1\xaa\x31\x1e\xc9\x88\x5d\xa1\xc1\x34\x3f\x5f\xde\xff\xd0\x8f\xd9\x00\xac\xd3\x2d\x7b\x32\xbc\xd0\xe0\xba\xc0\xf8\x86\xc9\xb7\x12\xc6\xa9\x4a\x7b\xe4\xc6\x2b\xf0\x93\xd5\x55\x09\x32\x4e\xa3\x18\xe3\xec\x2f\x42\x40\x21\xb4\xaa\x50\x8f\xd0\xdc\xc1\x63\xb7\x74\x5f\x3c\x96\x68\x70\x91\x73\xc5\x5f\xe1\x0b\x42\xa5\x17\xf3\x8c\x53\xe6\xce\xcd\x29\x85\x9c\x7c\x38\xc3\x29\x16\xc7\xe8\xb9\xb1\x34\x99\x01\xc5\xba\xdd\x04\x64\xa8\x15\xc5\x0a\x41\xd6\x07\x3c\x5c\x38\x40\x79\xa3\xe6\x91\xcc\x78\x8e\x33\x4f\xe6\xa5\x2f\x7c\x31\x83\x2d\xd4\x90\x07\xd7\xd6\xa8\xb7\x73\xb9\x09\x62\x73\x9c\x45\x86\x82\x15\x01\x1b\xb5\xbb\x85\x5f\x71\xcc\x48\x74\xdc\xb8\xd2\xf3\xa9\x27\xe1\x0e\xcc\x19\xae\xac\xb7\x62\xc9\x30\xb9\x56\x6f\x34\x0b\xc9\x6f\x39\xe6\x25\x72\xb2\xb8\x46\x60\xad\x23\x22\x62\x5d\xfb\x49\x14\x3c\xe8\x3a\xd6\x73\x77\x60\x76\xd8\x25\xbc\xc3\x91\xab\x4b\xfb\x3e\x71\xb4\x84\xeb\x9f\x90\x4a\xfc\x06\x10\xa9\xc4\x48\x25\x46\x2a\x71\x1e\x2a\x52\x89\x91\x4a\xbc\x1d\x29\x18\xc1\xb5\x09\x06\xa9\xc4\x41\x30\xa4\x12\x87\x1b\x04\x8d\x58\x5c\xc0\x74\x21\x5c\xc0\xb4\x6c\xf0\x01\x07\x96\x79\x86\x4d\xd8\x57\xff\x72\x92\x42\x1d\x59\x6a\x00\x25\x95\xed\x3c\xe6\x26\xe3\x4e\x9b\x27\xbe\x16\x97\x92\xb0\xef\xda\x23\x33\x64\x7b\xad\x2c\xe5\x9c\xa3\x85\xbf\xa2\x30\xd4\xb6\x8c\x0e\x86\x5a\x0d\x1c\x0c\xb5\x35\x38\x18\x6a\x30\xd4\x60\xa8\x6d\x9f\x28\x0c\x35\x18\x6a\x30\xd4\x42\x0d\x62\x99\x60\x63\xac\x5c\x95\xd6\xd8\x29\xcb\x8c\x1e\x54\xc7\x8c\x7e\x10\x85\x27\x3a\xf4\xdc\x0b\x43\xcc\x63\xb5\xbc\x3d\x44\x4f\xca\xc2\x43\x39\x70\xd3\xd5\x4d\xe6\x40\xdc\xb8\x07\xe2\x29\x0d\x20\x1f\x27\xfc\x06\x13\x6f\x6f\xce\xab\x51\xe4\x9e\xb4\x39\x4e\x07\x7f\xb6\xfa\xa0\xeb\x48\xd4\x73\x29\x1e\x0b\x4f\x85\x2f\x8f\xd7\x2d\x73\x7f\x10\xe7\x78\x46\xd6\x91\x1b\x73\xdc\xca\x06\xe4\x91\xb2\xaa\x94\x85\x07\x33\x1f\xaf\x26\xef\xdf\x8c\x21\x8c\x56\x20\x5b\x9a\x62\x65\xd3\xb1\xd4\x0e\x46\xb8\xe2\x42\x1b\x5c\x8e\xda\x9c\xd2\xea\xe5\xa4\x07\x1b\xbd\xa7\x21\x67\x3c\xd3\x98\xe4\x2e\x71\x61\x44\x93\x27\xcd\xec\x81\x1b\x8a\x64\x9a\x65\xc2\x0c\x96\x0c\xe3\x43\x5a\x3f\x8c\xcd\x2b\x1e\x16\xf2\xf9\x7a\xd6\xa1\x36\x97\xf9\x94\x48\x5f\x4b\xaa\x52\x5a\x59\x32\x8f\xc1\x0c\x5c\xe1\xe8\x14\xe4\x93\x9c\x98\xa1\x70\x1e\xe3\x72\x74\x89\xb8\x9e\x54\x88\x50\x16\x48\x3c\x65\x67\x6e\x93\x19\x05\x95\x11\x04\xb8\x0d\xb0\xd9\x14\xac\xb5\x1d\xbb\xc9\x0d\xdc\x9a\x68\x43\xb4\xd5\x44\x79\x41\x5c\x85\xe0\xf9\x01\x5d\xcb\x0e\x72\xc2\xba\x26\xca\x0b\xee\x9a\x28\x1d\xe2\xb5\x1c\x43\x22\xd0\x6b\x63\xd3\x44\x60\xe1\x12\x2d\x63\x75\x33\x82\x0c\x27\x02\x0f\xbf\x03\x38\x78\x38\xbb\x59\x38\x05\x68\x09\x12\x77\x0f\xe4\xa9\x09\x10\xf8\x67\xc2\x66\x09\x11\x04\x7e\x94\xc0\xc3\x01\x02\x0f\x67\x37\xb3\x8e\xab\xee\x21\xfa\x9e\xf3\x56\xc7\xdb\x74\xa9\x97\x0a\x89\x3f\x11\x76\x4b\x88\x20\xf1\xa3\x04\x1e\x0e\x10\x78\x38\xbb\xd9\x13\x89\xfd\xa1\x5a\xc9\x4f\x2d\xc8\xe7\xd1\xfb\x14\xf8\x31\x39\xce\xa9\x01\x37\x86\xaf\x2d\xa4\x93\x96\x4d\x7e\xd2\xd1\xc7\x67\xc5\x5e\x51\x17\xa9\xe1\x9e\x7a\xd9\x1e\xcf\x3f\xcd\xa4\xde\x8b\x96\x4b\x66\xdd\xe8\xb8\x2f\xce\xe0\x3a\xe3\x85\x4f\xd4\x91\xeb\x7a\x45\xc8\x43\x2c\x68\x84\x5c\x57\xf0\x58\xbc\xc7\x8f\xce\x75\x3d\xcb\x39\x77\x18\x4e\x0f\xbd\x11\xa1\xf8\xa8\x5c\x79\x39\x58\x62\xa4\x1c\x99\xde\x08\x4b\x93\x18\xae\x10\xe9\xa2\x3f\x94\x96\x37\x1d\x9f\x7f\xbd\x1b\x04\x92\x1c\xbb\x2c\xd8\x23\x24\x39\x78\xec\x96\xee\x8b\xc7\x72\x24\xf9\x24\xee\x7a\x23\x1e\xe7\x82\x50\x63\x1d\xfd\xfe\x60\x82\x61\x91\x90\x7d\x57\x04\xbe\x2c\x68\x04\xd9\x07\x1e\x8b\xf7\xf8\x6b\xc9\x3e\x68\x7c\xe0\xc8\xf5\x1e\x21\xf5\xc0\x63\xb7\x74\x5f\x3c\x96\x21\xf5\x84\x1a\xa3\x55\x29\x92\x80\x95\x63\x67\xcf\xc5\x7c\xe2\x01\xa6\x99\x40\xc5\x25\x9a\xe6\x41\xbc\x96\x6b\xad\x0c\xb4\x8e\xe5\x85\xce\x51\x9b\x37\xbf\x44\x96\x7c\xdf\xae\xec\x99\x54\x5a\x68\x34\x74\x3d\xb8\x12\x7c\x70\x9a\xb5\x86\xfc\x87\xec\x61\x68\x8f\x54\xe8\x15\x4f\x3f\x1b\x1c\x02\xf2\x50\xb7\x8c\x0e\x79\xa8\x35\x70\xc8\x43\x5d\x83\x43\x1e\x2a\xf2\x50\x91\x87\xba\x7d\xa2\xc8\x43\xcd\x83\x41\x1e\x2a\xf2\x50\x17\x34\xfb\x4f\x3c\x73\x05\x15\xbb\xd4\x8e\x9e\x31\xaa\xf2\xa9\x5a\x43\xdd\x24\x4c\x2d\xfb\x25\x78\x37\x14\x1c\x38\x57\x04\xe3\xba\xa0\x11\x1c\x38\xe0\xb1\x78\x8f\x1f\xec\xc0\x21\xd5\x9a\x97\xe9\x5e\xcf\xd2\x32\xdd\x28\xf3\x76\x21\x94\x79\x5b\x36\x08\x32\xdd\x81\x9e\x67\x7d\x3b\x6a\x58\xa5\x3e\xd3\x47\x7a\x09\x5f\x03\x92\x18\xe3\x34\xb6\xda\xea\xf2\x33\xca\x89\x1c\xef\xb8\xe3\xeb\x18\xd5\x09\xd8\x09\x29\x9a\x25\xee\x92\x51\xe9\x59\x4a\x7f\x2a\x58\x37\x2c\xd2\x3e\x37\x31\x96\xaa\x8c\xe4\xad\xbc\xe2\xa0\xbc\xea\xa0\xd1\x7e\xc4\x45\xcf\x5a\xa7\x8d\x17\x57\xe3\x65\x4b\xc5\x08\xc4\x0a\xaf\x83\x1e\x6b\xdc\xb7\xba\x13\xaa\xa8\xbe\x7d\xcc\xaf\x3d\x79\x7c\x57\x7e\x98\x97\xeb\xe6\x97\x98\xc3\x9b\xe4\x8a\x32\x12\xdf\x34\x70\x1b\x6f\x19\x1d\xdc\xc6\x35\x70\x70\x1b\xaf\xc1\xc1\x6d\x0c\xb7\x31\xdc\xc6\xdb\x27\x0a\xb7\x31\xdc\xc6\x70\x1b\x87\x1a\x84\xcd\xc9\x60\x81\xaa\x94\xfe\x5e\x71\x53\xb1\x76\xba\xd5\x65\xa1\x15\x4e\x16\x5e\x02\xec\xa4\x65\x93\xc7\x2a\x00\xc0\xbb\x4e\x4c\x0e\xad\x9f\x93\x8a\x6e\xdd\xaa\xc7\x0c\x80\xd5\x94\xcd\xcf\xe3\x6a\x6f\xd1\xfd\x0f\xce\xad\xa8\x7c\x89\x68\x97\x60\x35\xb8\xb4\xe6\x9f\xae\x2e\x96\xe7\xf8\xcc\x2c\x3b\x90\xef\x45\xdd\x96\xae\xbd\x2d\x59\x3b\x3b\x55\x7b\x83\x53\xb5\xd9\x94\xa6\xbd\x11\x78\x5b\x8a\x76\xbe\xd7\xb6\xd9\x94\x9e\x9d\x97\x9c\x9d\xe5\xc1\xdd\xd0\x30\xa3\xb4\x40\xe6\x6a\x66\x96\x15\x00\x8f\x16\x01\x7f\xbf\x3c\x9a\xd1\x28\x5d\xe1\x05\x62\x16\x2c\x3c\x11\xc4\xec\x1b\x02\x8f\x2e\xe8\xfb\xe5\xd1\x64\xa3\x54\x55\xe3\xb3\xae\x1b\xf8\xf9\x2c\xa3\x4b\xac\x31\xb8\xdf\xb7\x8c\x0e\xee\xf7\x1a\x38\xb8\xdf\xd7\xe0\xe0\x7e\x87\xfb\x1d\xee\xf7\xed\x13\x85\xfb\x1d\xee\x77\xb8\xdf\x43\x0d\x62\xb7\x07\xb9\x31\xe4\x3a\xd8\x4b\xa2\x07\x52\x5d\xaf\x4b\xab\x3e\x8d\xf7\xe1\xbc\x5e\x7c\xc9\x2d\x1b\xd4\x7c\x93\x8b\xd7\x60\xca\x1c\xed\x08\x69\xbc\x10\x42\x1a\x97\x0d\x22\x21\x8d\xbc\x0b\x56\x09\xfb\x76\xc7\x32\xd3\xa9\x09\x3b\x91\x3b\xe8\xe8\x35\x48\xc1\x0e\xfc\xab\x64\x63\xa0\x5d\xd9\xd6\xd1\x3d\xa9\xca\x4b\xa1\x8c\x7e\x2e\x8b\x44\x1e\x55\xda\xaa\xbe\xc7\x2f\xe4\x68\xf9\x5c\x24\x4a\xab\xbb\xd0\xdb\x8a\x46\x76\xa6\x95\x98\x78\x50\xa3\xb5\xb2\x6e\x2e\x63\x45\x12\x8e\xc2\x73\x9b\xfc\x4a\xc8\x30\x28\x68\x84\x2c\x16\xf0\x58\xbc\xc7\x6f\x51\x7c\x09\x75\x3a\xc1\xca\xab\x74\x5f\xac\x0c\x71\xd9\x80\xc7\x12\x3d\x7e\x03\x71\x89\x02\x9d\xd7\x04\x86\x8c\xf5\x08\xa1\x07\x1e\xbb\xa5\xfb\xe2\xb1\xcd\x42\x0f\x3a\x1e\x58\x71\xbd\x47\x88\x3b\xf0\xd8\x2d\xdd\x17\x8f\x65\x88\xbb\xb9\x9a\x65\xaa\x2e\x67\xd4\x2c\x2e\xaf\xa6\x19\x4b\x11\x38\x1f\xf9\xdc\xfc\x14\x99\xd5\x91\xef\x8e\x2b\x29\xf6\xa9\xf2\x97\xc7\x3a\x2f\xea\x83\xd1\xc7\x52\xb7\x02\x42\xa1\xb6\x8c\x0e\xa1\x50\x35\x70\x08\x85\x5a\x83\x43\x28\x14\x42\xa1\x10\x0a\xb5\x7d\xa2\x08\x85\x42\x28\x14\x42\xa1\x42\x0d\x52\x05\x2c\x45\x59\xe8\xc5\x59\x03\x12\x5a\x8d\x51\x07\x81\x37\x99\x40\xe9\x68\xc7\x07\xe9\x58\x32\x78\x28\x13\xa7\xe7\xc6\x89\xaa\x02\x71\x67\x24\xa7\x7b\x51\x38\x27\x61\x5b\x6e\x3a\x36\x9a\x00\xac\x23\x29\x1e\xc9\x4b\x76\x2e\x64\xc8\x1c\x4b\xf1\x3a\x3d\xb7\x72\xe8\x68\x9a\x5e\x7a\x72\x69\xa0\x71\x76\xe5\x30\x08\x39\xbb\x10\x42\xce\x96\x0d\x82\xc2\x66\x4f\x6e\xde\x10\xb3\xd8\x91\xba\xa8\x96\xd7\x3d\x05\xaf\x4d\x03\x61\x3b\xa3\x4f\xb3\x89\xfa\xeb\x0f\x4a\x74\x74\xea\xb5\xa3\x78\x80\x6a\x78\x75\xa7\x77\xc4\xf7\xfb\x51\xdf\x7b\x78\x71\xa5\xa5\x8d\xaf\x81\xe6\x9d\x5a\x88\xe5\x11\x2c\xa9\xae\xae\xd8\xf2\x42\x5a\x14\x7f\x1d\xea\xbf\x2f\x57\x08\x15\x28\xb1\xf2\x0f\x38\x13\xb8\x22\xf8\x6b\x0b\x1a\xe1\x4c\x00\x3c\x16\xef\xf1\x83\xcf\x04\xce\x83\x65\xbc\x3d\x16\x4a\x7c\xcb\xad\x64\xfa\x91\x0c\xb3\x36\x5a\xfd\x28\xbc\x78\xb6\x35\xfc\xc4\x4e\xd4\x1e\xb8\x12\x36\xc0\xca\x89\xd7\x6a\xad\x3c\xc7\x36\x43\x5e\x63\x2f\xad\xf5\x08\x79\x0d\x1e\xbb\xa5\xfb\xe2\xb1\x44\x83\x85\x94\x9b\x6d\x22\xfb\x62\x1d\x45\x45\x66\x44\xee\x7a\xb4\xd7\x20\x67\xc8\x4d\xf0\xf4\x5a\x8f\x90\x9b\xe0\xb1\x5b\xba\x2f\x1e\xcb\x91\x9b\x8b\x74\x8e\xf6\xc0\x05\x2e\x71\x02\x2f\xae\xf6\x08\x79\x07\x1e\xbb\xa5\xfb\xe2\xb1\x8d\xf2\x2e\xf8\xc6\x20\xed\xae\x08\x9c\x58\xd0\x08\xd2\x0e\x3c\x16\xef\xf1\x1b\x48\xbb\x39\xb2\xf9\xa0\xad\x0b\x2f\x70\x6a\xfe\x59\x27\xf6\xc1\x77\x32\x58\x62\xe7\xc8\x86\x9d\x36\x6c\x50\x47\xa5\x9f\x54\x3a\xca\x21\x3c\xa0\x78\x69\x5c\x08\xef\x2b\xc2\xc6\x2a\x68\x04\xe1\x0d\x1e\x8b\xf7\xf8\xa1\xc2\x3b\x7a\x6d\xdc\x94\x68\xb1\xf2\xcb\x14\x1a\x75\xf3\x43\xa4\xa7\xa3\x50\x64\x85\xfd\xab\x33\xc4\x57\x18\x35\x91\x32\x62\xed\x70\x22\x66\xb4\x24\xb6\xb8\x09\xba\x54\x28\x77\x83\xe1\x63\x70\xc2\x14\x53\x5b\x17\x2e\x4a\xcf\xce\x7f\x22\x64\x30\xc4\x30\x13\xa7\xd7\x52\xb4\x75\x11\x5e\xe3\xfa\x70\x53\x17\xe3\x3e\x82\xd8\x39\xe6\x31\xbe\xdb\x92\x68\xa9\x9a\xc7\xe7\x01\xc7\x7e\x5e\x0e\xa5\x44\x37\xe1\x4f\x96\x09\x7e\xaa\x8b\xaa\xf1\x20\x47\x7a\x09\xbe\x63\x28\x02\x57\x04\x21\x5d\xd0\x08\x8a\x00\x78\x2c\xde\xe3\x07\x5b\x71\x5e\xc8\x59\x8a\x98\x5f\x90\x72\x57\x04\x0e\x2c\x68\x04\x29\x07\x1e\x8b\xf7\xf8\x4d\xa4\x9c\x65\x4e\x1f\x09\x27\x90\xe0\xc1\xd5\x1e\x21\xe7\xc0\x63\xb7\x74\x5f\x3c\x96\x68\xf0\xc0\x5d\x7b\x60\x7e\xc8\x64\xdd\x98\x9e\x12\x49\xcf\x4f\xd9\xbf\xb7\x60\xe1\xb4\xcf\x24\x16\x4a\x70\x6c\x18\x1d\x4a\x70\xd4\xc0\xa1\x04\xc7\x1a\x1c\x4a\x70\xa0\x04\x07\x4a\x70\x6c\x9f\x28\x4a\x70\xe4\xc1\xa0\x04\x07\x4a\x70\x2c\x08\x75\x14\x2e\x84\x3a\x0a\xcb\x06\x41\x8e\x79\x87\x04\x74\xa3\x5b\xb2\xf6\x3d\x4e\x8b\x67\xa8\x0f\x3c\x06\x9d\x7b\x28\x59\x29\x43\xfb\xb2\x52\x90\xfe\x51\x4b\xee\xa2\x56\x88\x1d\xb3\x43\x1b\x9e\x68\x6a\x87\xcd\xc7\xab\x4c\x2b\x76\x65\x2c\x96\xd9\x88\x76\x0c\x13\x88\x1c\x3f\x47\xe7\x16\x8b\x69\x58\x20\xdf\xfc\x1a\x59\x6b\xa9\xf7\x9d\xda\x5e\xea\xb2\x17\xc5\x1c\xcc\xfb\x80\x41\x95\x78\x0e\xe6\xf5\x96\xd1\xc1\xbc\xae\x81\x83\x79\xbd\x06\x07\xf3\x1a\xe6\x35\xcc\xeb\xed\x13\x85\x79\x0d\xf3\x1a\xe6\x75\xa8\x41\xb8\x42\x5b\x46\xc6\x45\x10\x5d\xa8\x3d\x59\x47\x86\x75\xfa\x14\xcc\xc8\xcd\xc5\xa8\xba\x35\xf8\x7c\xb8\x94\x51\x1a\x3e\x88\x11\xde\x19\xc5\xf6\xc2\xac\xc2\xaf\xfc\x72\x5e\xf7\x8d\x66\xc4\x5e\xa8\xfd\xea\x41\x6c\x64\x88\x52\xef\xbf\x6e\xb5\x3d\x60\x07\x6c\x19\x1d\xec\x80\x1a\x38\xd8\x01\x6b\x70\xb0\x03\x60\x07\xc0\x0e\xd8\x3e\x51\xd8\x01\xb0\x03\x60\x07\x84\x1a\x04\xed\x80\xb8\xf6\x9d\x71\xce\xa1\x43\x15\xc8\x9a\x4c\x59\x10\x09\xa9\xcd\x1b\x42\x93\x17\x5a\x9b\x0f\xd6\x6c\x0a\x7f\xdc\x86\xdb\xe4\x86\x41\x36\x79\xdc\xb1\xa4\xbc\x70\xc8\x02\xe0\xfc\xb0\xc8\x26\x73\xcf\xbe\x52\x5e\x78\x64\x93\x15\x22\xd9\xa4\xf9\x7d\x73\xc3\x44\x48\x6e\x93\xbf\x9a\x19\xa1\xb9\x0d\x78\xb4\x14\xf8\xfb\xe5\xd1\x8c\x46\x83\xa9\x51\xfe\x12\x1d\xec\xbf\x8a\xe8\xa1\x63\xe4\xbe\x02\xe7\x7a\x26\x3a\x49\x75\x57\xfb\xe9\xc1\xf5\x83\x37\xd8\xe6\x0b\x3c\x12\xfe\x9c\xf0\x78\xde\x02\x89\xd2\x8a\x24\x93\xae\x56\x11\x3a\x3d\xeb\xb4\x92\x28\xba\xb4\x21\x80\xf7\xbe\xb2\x51\xea\xa3\x80\x2b\x29\x0c\x08\x57\xd2\x02\x10\xae\x24\xb8\x92\xe0\x4a\x82\x2b\x09\xae\xa4\x2b\x54\xb8\x92\x6e\x9b\xc0\x95\xf4\x1b\x72\x25\xe1\x1e\x8f\x7c\x8b\x15\x99\xab\x05\x8d\x90\x1d\x0d\x1e\x8b\xf7\xf8\xc1\xd9\xd1\x10\x6f\x60\xbd\x50\x8f\x10\x6f\xe0\xb1\x5b\xba\x2f\x1e\x4b\x89\x37\xad\x76\x62\x3f\x18\x62\xc7\xe1\x81\x8c\x22\x47\x96\x49\xfe\x40\xa1\x34\xa8\xd4\x3a\x74\x46\xf7\x6c\xce\xfd\xaa\xb8\x97\xd9\x19\x1e\x1d\xc6\xb7\xbc\x44\x76\x1c\x4d\xeb\xde\x6b\x85\x84\xb2\xd4\xfa\x15\x77\xa5\x08\xa8\xbb\x06\x71\x11\xe8\x11\x9f\x24\xf0\xd8\x2d\xdd\x17\x8f\x25\x1a\xdc\x8b\xd8\x97\x42\x11\x8b\xa5\xa4\x27\x3a\xc0\xa5\xd4\xd8\x46\xb1\x1e\x21\xaa\xc1\x63\xb7\x74\x5f\x3c\x96\x68\x60\xe8\xa4\x1f\x47\x3d\x3f\xf0\x32\x85\xa3\x53\xad\x80\xe6\xc6\xf0\xb5\xb9\x3a\x52\xbc\x30\x85\x28\x18\x1b\x93\x7a\x0e\x97\xbc\x60\xbb\x45\x7a\x84\x48\x07\x8f\xdd\xd2\x7d\xf1\x58\xb4\x41\xe4\x47\x45\x4f\x86\xe4\xda\xf5\x58\x15\xa5\x4d\x20\x32\xaf\x08\xec\x5c\xd0\x08\x22\x13\x3c\x16\xef\xf1\xc3\x0b\x28\x5b\x62\x83\x59\x89\x96\x6d\xd2\x2b\x29\x45\x4b\xca\x46\x7c\xe5\x10\x91\x57\x04\xf6\x2d\x68\x04\x11\x09\x1e\x8b\xf7\xf8\xab\x69\x95\x83\x94\xab\x11\x92\x91\x67\xf4\x5a\xcc\x7c\x42\x09\xe5\x63\xc5\xc2\xf1\x6a\xb6\x89\x03\x21\x6c\xb1\x11\xd6\x7a\x84\xb0\x05\x8f\xdd\xd2\x7d\xf1\x58\xa2\xc1\x42\xd6\xe1\x1a\x4a\xf0\xe0\x7a\x8f\x90\x73\xe0\xb1\x5b\xba\x2f\x1e\x4b\xc9\xb9\xc1\x69\xd6\x1a\xe2\x8e\xd8\xc3\xd0\x1e\x43\x4a\x5d\x6a\xfa\xe9\x67\x83\xef\x03\x09\xaf\x5b\x46\x87\x84\xd7\x1a\x38\x24\xbc\xae\xc1\x21\xe1\x15\x09\xaf\x48\x78\xdd\x3e\x51\x24\xbc\xe6\xc1\x20\xe1\x15\x09\xaf\x0b\x6a\x0f\xd4\x1e\xab\xb4\xcd\x09\x61\xea\xa0\x0c\xc1\x2b\x14\x52\xfb\x0f\xa4\x69\x19\x29\xaf\xbb\x94\x01\x55\x95\x61\xc6\x65\x4d\x17\xc2\x65\x4d\xcb\x06\xe1\xfa\xe3\xf4\x3c\x7f\xc3\xa2\xca\x4a\xea\x83\x28\x54\x47\xcf\x35\x81\xd9\x5e\x66\x55\x3c\xae\x7b\x52\x95\xc5\xab\xac\x65\xb6\x3b\x32\xa9\xf7\xac\x13\x01\xbd\x25\x35\x8a\x47\x32\x4f\x46\xb8\xc2\xad\x1f\x56\x3d\x13\xfd\x8e\x9a\x74\xd5\xec\xad\xd3\x86\x18\x2f\xa9\xbf\xde\x34\x43\xff\x2e\x92\xef\x89\x1b\xe5\x79\x80\x8d\xe6\x64\xc1\x48\xa2\x95\xe0\x6f\x0e\x9b\xd6\x1a\x2d\x9d\xb4\x2b\xbf\x4f\x9f\x98\x95\x1f\x4a\x6a\x79\x19\xea\xc4\xf6\x33\x33\xe9\xcd\x9c\x6e\xe8\xa5\x68\xb9\xab\xc8\xe6\x83\x93\x64\xcb\xe8\xe0\x24\xa9\x81\x83\x93\x64\x0d\x0e\x4e\x12\x38\x49\xe0\x24\xd9\x3e\x51\x38\x49\xe0\x24\x81\x93\x24\xd4\x20\x68\xe8\x75\x0f\x4c\x0d\xa7\x87\x90\xb0\x49\x6d\x66\x78\x16\x2e\x04\xcf\xc2\xb2\x41\xf4\x66\xb3\x22\x73\x52\x28\x4b\xc6\x8d\x56\x58\x6f\x68\x27\x9e\x8b\x50\x90\xe9\x8d\x38\x88\x58\x8f\x88\xb5\x01\x8f\xdd\xd2\x7d\xf1\x58\xa2\x41\xf8\xce\x97\xb4\xbb\xcf\xec\xbc\x12\x54\xe3\x72\x75\x2e\x9a\xaf\x1d\xea\x3c\x32\x27\xfb\xe3\x66\x77\x58\x5b\x96\x33\xce\xad\x1d\x4e\xc4\x8c\x96\xc4\x5a\x43\xdd\x64\xdd\x05\xb6\x44\x7a\xcb\x74\x83\xe1\x9e\x17\x67\xdb\xa4\x4e\xed\xa6\x67\x47\x46\x71\x19\x8c\x13\xcd\xc4\xe9\xb5\x14\x6d\x9d\x96\x34\xae\x0f\x37\x75\xbe\x82\x11\xc4\x92\xb5\x7e\x81\xe2\x42\x20\x89\x16\xdf\x9e\x9f\x2f\x03\x8e\xfd\xbc\x1c\x4a\xc9\xae\xdb\x16\xe1\x16\x66\xc1\x27\xcb\x04\x3f\x31\x6f\xed\x05\x59\x2b\x03\x03\x11\xc5\xf8\x02\x85\x7b\x84\x96\x03\x1e\xbb\xa5\xfb\xe2\xb1\x94\xbc\x7d\xb2\xfe\xbb\x8a\x64\x5c\x70\x60\xa8\x47\x48\x39\xf0\xd8\x2d\xdd\x17\x8f\x25\x1a\xe0\x3c\x7e\xcb\xe8\x70\x1e\x5f\x03\x87\xf3\xf8\x35\x38\x9c\xc7\xe3\x3c\x1e\xe7\xf1\xdb\x27\x8a\xf3\x78\x9c\xc7\xe3\x3c\x3e\xd4\x20\x91\xb4\xc0\x7b\xe1\x27\xae\x15\xb3\x8e\xc7\xbd\xf9\xc1\xae\x72\xd3\x1f\x12\x00\xe9\xec\x87\x30\x80\x1c\xac\x8b\x55\x61\x4d\x3d\xaf\x4f\xfd\xe0\xbc\xde\x44\xed\xd1\x0e\xa1\xea\xb4\x09\x94\x29\xf6\x98\x39\xc3\x95\xdd\x91\x61\xbc\x6d\x49\xd2\xe4\x99\x2f\x02\xdc\x69\xd3\x12\xf3\xdf\x56\x66\xdd\x4b\x3c\xac\x19\x29\x19\x0d\x02\x27\x26\xaa\x95\x0c\x7b\xc3\x95\x9b\x8c\xb9\x56\x2b\x67\x74\xd9\x19\xd7\x04\xe3\xf5\xd5\xca\xc7\x19\x6f\xa3\x97\x5f\x27\x20\xc6\x84\x88\x62\x8c\x4d\xf9\x29\x91\x90\x92\xca\xf4\x14\xa1\xac\xe3\xca\x4b\x03\xa3\x3d\x03\xbf\xc7\xa9\xe1\x78\xeb\x7a\x3a\x71\x25\x63\x74\x17\xb4\x74\x22\x48\x26\x9a\xe8\x19\xef\xba\x6a\x1b\x3b\x7c\x42\x9d\x09\x10\x3d\x1d\xcb\xc2\x48\x6c\x36\xad\x88\x5e\x72\xce\xc1\x23\xb7\xd5\xe7\x64\xfd\x44\xe2\x94\x0a\x79\xba\x37\xfa\xf9\xa5\xb8\xc4\xa5\xfd\xb1\x46\x63\xb0\x3f\xb2\xaa\x3c\x45\xfb\x23\x3b\x91\xe3\x1d\x77\xbc\xf4\xf9\xe9\x8d\xb2\xca\xbc\x31\xfb\x23\x33\xb4\x2f\x55\x10\xec\x81\x1b\x6f\xe5\xd6\xcb\x82\x6a\x03\xfe\x2c\x97\x2a\x0f\xd8\x13\xbb\xc5\x8a\xbd\xe2\x6e\x30\xc4\x1e\xc9\xd8\xe2\x65\xb3\xc4\xda\xc1\x3a\x7d\xf2\x5a\x9a\xdc\x6b\x23\xdc\x21\xa0\x37\x6c\x81\x4a\x24\x43\x65\x83\xb0\x53\xf7\x87\x52\xa0\xe3\x29\x7e\x24\x9f\x44\x90\x7e\x69\xc5\xee\x85\xf5\x14\x8f\xd5\x0d\x63\x38\x6d\xbc\xaa\xd7\x4a\x1e\x12\xdf\x19\x08\xe5\xd9\x80\x96\xd8\xc3\xa0\x3a\xe9\x77\x46\xf0\xa2\xce\x0c\x10\x4b\xe6\x91\x0c\xb3\xa2\x23\x46\xaa\x35\x2f\x7d\xb1\x26\xff\xa1\xa9\x85\x17\x51\x7a\xf3\x5b\x2c\xd6\xa9\x97\x83\x3a\xfe\x1b\x6d\x2e\xdd\x8e\x83\x82\x2d\xa3\xc3\x41\x41\x0d\x1c\x0e\x0a\xd6\xe0\x70\x50\x80\x83\x02\x1c\x14\x6c\x9f\x28\x0e\x0a\x70\x50\x80\x83\x82\x50\x83\xf0\x41\x01\x1f\x5d\xa6\xa5\x1a\x1f\x62\xdf\x6a\x00\x7f\xfb\x71\x49\x88\x7d\x6b\xc0\x63\x89\x1e\x3f\xfa\xbe\x7b\xce\xc2\x7a\x29\x24\xdc\x15\x81\xfb\x0a\x1a\x41\xc2\x81\xc7\xe2\x3d\x7e\xb4\x84\x93\x82\x94\x8b\xb8\x43\x21\xe5\xae\x08\x1c\x58\xd0\x08\x52\x0e\x3c\x16\xef\xf1\xdb\x48\x39\x24\x6a\x81\x01\x03\x3d\x42\xc8\x81\xc7\x6e\xe9\xbe\x78\x2c\x25\xe4\x34\x99\x96\x98\xd3\x6c\x70\xbb\x3f\xc6\x8e\x92\x23\xc5\xc2\xb9\xe3\x11\x77\x73\x2a\xa2\x54\x90\x0c\x9d\xb2\xf2\xae\x13\xd3\x0b\xfa\x39\xc9\x73\xb5\x8e\x49\x44\xb6\x5e\x08\x91\xad\xcb\x06\x91\x62\xe3\x2d\x2b\x2e\x0b\xe6\x1f\x2e\xaf\x78\xe3\x9f\x76\xfa\x48\x81\xe3\x53\xa8\x26\x57\x84\xcf\x46\x41\x23\xa8\x26\xe0\xb1\x78\x8f\x1f\xac\x9a\x94\x8b\x56\x6d\x23\x66\x5b\xe2\x61\xd1\x49\xaa\xbb\x7c\x60\x8c\xce\x2f\xeb\x7b\x8c\xeb\x2f\x1e\xb9\xb2\xd4\x0e\x86\x98\xb5\xd1\x14\x8b\x30\xa3\x1c\x89\x7a\xdf\x7d\x34\x52\x32\xfc\xf8\x89\x9c\x11\xed\x18\xb3\x5b\x3c\x89\x19\x63\x14\x1d\x95\x20\x96\xed\x8c\x3e\x31\x7a\xa4\x78\x58\x77\x78\x42\x4a\xab\x51\x2d\x66\x86\x7a\xc9\x5b\x3a\x91\x72\x6c\xea\xb5\x68\x5c\xf5\xd7\x7a\xf4\x46\x3b\xdd\x16\xa6\xd0\xbc\xc3\xbd\x1a\x7a\x30\x6d\x99\x9a\x3f\x3d\x5a\x1e\xd4\x3c\x3e\x5e\x6c\x64\xbc\x3e\x5e\x11\x56\x2d\x59\x2b\xfa\x03\x99\x92\x40\xe2\x58\xc4\xed\x59\x8f\x0c\xfc\x34\xea\x79\x37\xbf\x45\x84\xa7\x1d\x4e\x5a\xea\xbd\xd8\x1c\x8c\xeb\x8d\x98\x31\xd8\xca\xf1\x53\x34\xbf\x09\x17\x71\x20\x9e\xf7\x0d\x20\xe2\x79\x11\xcf\x8b\x78\x5e\xc4\xf3\x22\x9e\xf7\x0a\x15\xf1\xbc\xb7\x4d\x10\xcf\xfb\x5b\x8a\xe7\x8d\xea\x30\xa9\xe5\x3f\x3f\xcd\x48\xb5\xba\x2b\xb5\xab\xa6\x6c\x47\xd6\x89\x13\x29\x2b\xb4\x2a\xcb\xf4\x9b\x51\x62\xe7\x11\xc2\x51\xa8\x9a\x47\xf6\x4a\x72\x63\xf8\x9a\x77\xa5\xee\x14\xa5\xa3\x91\x5b\x0a\x73\x2c\x2f\x0a\x9f\xd6\xc7\x44\xf5\xe6\xd2\xab\x5e\xe1\x92\xbe\x22\xb8\x0b\x0b\x1a\xc1\x25\x0d\x1e\x8b\xf7\xf8\xc1\x2e\x69\xa9\xf7\x35\xe5\x19\xfc\xe3\x95\x8e\xd1\xf1\x2b\xf1\xab\xde\x2c\x5c\x59\xa8\x63\x72\x03\xb6\xdc\xd1\x5e\x9b\x1a\x5f\x60\xf9\xd1\xeb\xfc\x7c\x71\x7d\xaf\xc5\xf3\xe5\xde\xc4\xe1\xa4\xd9\x14\x61\x56\xf4\xfc\xc5\x4d\x57\x3c\x82\xb9\x2e\x43\xe1\x61\x41\xcc\x9f\x19\xbc\x3e\xf8\xf3\x72\xe9\x6e\x7e\x8d\x79\x34\x5f\xac\xd4\x2b\xba\x21\x6a\x0b\xc0\x17\xb9\x02\x08\x5f\x24\x7c\x91\xf0\x45\xc2\x17\x09\x5f\xe4\x15\x2a\x7c\x91\xb7\x4d\xe0\x8b\xfc\x0d\xf9\x22\x2b\x43\x78\xfb\x7e\xd2\xe9\x47\x0f\x60\xd5\x34\xbd\x69\xf2\x4e\x50\xa3\xc9\x59\x8d\x72\x8e\x2a\x16\xdd\x3b\x80\xf5\x46\xb7\xef\x83\x64\x76\xed\x3f\xfd\xe1\x8f\xff\xcc\xce\xc3\xcb\xe1\xaf\xf8\xfe\xb1\xce\x0c\xad\x1b\x0c\x75\xb3\xa9\x5c\x3d\xc6\x38\xcb\x22\x90\xfa\x1d\xf6\x6d\xd5\xdd\xca\xed\x60\xa2\x66\x7c\x98\x5f\xca\xc3\xaf\xc7\xa2\xdc\xa9\xc7\xc3\xb6\xbb\x19\xac\xf3\x9a\x1c\x0a\x05\xc0\x97\x19\xee\x11\xfe\x72\xf0\xd8\x2d\xdd\x17\x8f\x45\x1b\x44\x63\x1e\xd7\xe2\x1d\x83\x68\x81\x1f\xac\xe3\x6e\x78\xc3\x26\x61\xf6\xe1\xad\x13\x8f\x2b\x2f\x38\xb6\xf6\xbd\xd1\x0f\x72\xf5\xcc\x37\x78\x14\x1c\xe5\x8f\xf0\x11\xf0\xb9\xa7\x9f\xd6\xfd\x2a\xe1\x2f\xd2\xea\xda\xdc\xfc\x73\xac\x3e\xdb\x7d\xf1\xdf\x9e\xe9\x3b\x3e\x97\xd5\xfd\xd2\xec\xb8\xb4\xf3\xbf\x86\x07\x43\x93\x87\xf6\x32\xb3\x79\x89\x9b\xff\xfc\xaf\x4f\xfe\xb5\x2d\x13\xf2\x46\x4b\xfc\x27\x2d\x87\xd3\xf9\xc0\xfd\x73\xd3\x91\x6d\x8d\x98\x2a\xdb\x36\x7f\xb6\x8d\x3b\x90\x57\x81\xfa\xc1\xcd\xcb\xff\x2f\x33\xae\x57\x7a\x7e\xf6\x1f\xbf\xe6\x87\xa9\x8b\x1f\xa6\xdf\xe7\x9f\xc7\xad\xd8\xfc\x69\xf9\xaf\xdb\xd7\xf4\xa6\xbb\xbf\x0c\xa7\x07\x32\x8d\xde\x5d\xd6\x32\xd8\xd7\xd5\x62\x5f\x75\xf9\xf3\xf5\xa3\xb7\xcb\x3e\x35\x7b\xfc\xfd\x03\x39\xfe\xfb\x69\xd9\xda\x03\x9d\x2e\x85\xbf\x75\x4f\xea\x4f\x3f\xff\xf9\x6f\x3f\xfe\xf5\xea\xdf\x21\xc6\xe4\xbd\xf8\xdb\x5a\xed\xe9\x00\x17\x1d\x85\xea\xb2\x1a\xae\xd7\x23\x0f\xed\xa3\xfe\x6d\xe1\xde\xc8\x2e\x7a\xb2\xff\x2a\xb9\x75\xa2\xb5\xc4\x4d\xbb\xa2\xbd\xc0\x29\x0f\xa7\xfc\x0a\x20\x9c\xf2\x70\xca\xc3\x29\x0f\xa7\x3c\x9c\xf2\x57\xa8\x70\xca\xdf\x36\x81\x53\xfe\x37\xe4\x94\xaf\x0d\x0b\xe5\x6d\x4b\x36\x7e\x0b\x49\x1e\x50\x93\xe7\x33\xcb\x07\x6b\x36\xf9\x35\xb6\xe1\x36\xb9\xfe\x8d\x66\x9b\x4b\xa2\xc9\xf6\x73\x14\x00\xe7\xfb\x3b\x9a\x4d\x3e\x8f\x26\xdb\xef\xd1\x64\xf9\x3e\x9a\x5c\xff\xc7\x86\x86\x09\x5f\x5b\x93\xbf\x9a\x19\x3e\xb7\x06\x3c\x5a\x0a\xfc\xfd\xf2\x68\x46\x23\x6e\xed\x70\x22\x66\xb4\x24\xc6\x4d\x44\x55\x87\xb4\x05\x27\x4f\x04\x69\xfb\x86\xc0\xa3\x0b\xfa\x7e\x79\x74\xa3\xb4\xb5\x64\xad\xd0\x2a\x71\xe1\x21\xc4\x2e\x58\x7a\x22\x88\xdd\x37\x04\x1e\x5d\xd0\xf7\xcb\xa3\x1b\xc5\xee\x13\x3d\x30\x31\xde\x76\xeb\x5e\xa6\x42\x3b\x91\xdb\x89\x1a\x48\x60\x70\xf7\x85\x20\x81\xdf\x10\x78\x74\x41\xdf\x2f\x8f\x66\x34\xa2\xd6\xb2\x56\x2b\xc7\x85\x22\xb3\xbc\x6f\x9c\x19\x92\xdc\x89\x47\x0a\xe7\x97\x36\x10\xc2\x60\xf0\x0b\x41\x08\xbf\x21\xf0\xe8\x82\xbe\x5f\x1e\xcd\x68\x64\x68\x5f\x1b\x8d\x31\x31\x1b\x7b\x3d\xa1\x83\xc8\x5e\x23\x6c\x87\x25\x41\x64\xbf\x21\xf0\xe8\x82\xbe\x5f\x1e\xcd\x68\x64\x9d\x7d\xa3\x2d\xc7\x45\x38\x84\x2e\x18\x7a\x22\x08\xdd\x37\x04\x1e\x5d\xd0\xf7\xcb\xa3\x19\x8d\x06\x53\x13\xd6\x9a\xe8\x20\x27\x4e\x3d\xd1\x07\xee\xad\xba\x10\xd2\xad\x97\x0d\x82\x4c\x27\x54\x2b\x87\x8e\x98\xe3\xc9\x72\x74\xe1\x77\x2e\xf5\xde\x3a\x6e\x0f\x19\xc5\xe8\x32\x40\x7a\x43\x3b\x51\x76\x69\x4a\xc6\x2c\xa2\xc9\x78\xab\xcb\xc4\xbf\x0e\x86\xce\x29\x72\x37\x0f\x26\x6e\x51\x18\x9c\xf6\x6a\x1a\x77\xf4\xea\xe5\x2c\x5b\x9f\x71\x18\x79\x20\xc1\xf5\x99\x30\xc4\xa9\xb3\x8c\xf7\x82\x3d\xae\x65\x9b\x6d\x82\x9a\x16\x6c\x0c\xc8\xad\x28\x44\x38\x61\xcd\x4b\x9c\x74\x21\x20\x1b\xfe\x8a\x90\xa9\x5c\xd0\x08\xd9\xf0\xe0\xb1\x78\x8f\x1f\x5c\x3d\xf6\x46\xe4\x85\xb3\xf5\x20\xef\xae\x08\xbc\x58\xd0\x08\xf2\x0e\x3c\x16\xef\xf1\x9b\xca\x3b\xcb\x2d\xae\xac\x05\x37\x86\x7b\x84\xc4\x03\x8f\xdd\xd2\x7d\xf1\x58\xa2\x01\x8a\x9b\x6c\x19\x1d\x8a\x9b\xd4\xc0\xa1\xb8\xc9\x1a\x1c\x8a\x9b\xa0\xb8\x09\x8a\x9b\x6c\x9f\x28\x8a\x9b\x64\x1e\x4f\xa1\xb8\x09\x8a\x9b\xbc\x52\xc5\x01\x48\x58\xda\x47\x1f\x8c\x15\xd5\x7c\x73\x5c\x14\x6c\xf1\xc6\x05\x79\xd3\x2e\x32\xe9\x56\xea\xa1\x7b\xe2\x6e\x7b\xe1\xbf\xe5\xb1\x98\x75\x86\x78\xc0\xc6\x4a\x9e\x89\x3d\x59\x26\x94\x75\x5c\xb5\xc4\x7a\xa3\x77\xc2\xeb\x6a\x57\x51\x51\xce\x24\x2e\x31\x0c\x8b\x57\x8f\x1e\x2b\x35\x03\x37\xc5\x15\xc1\x84\x2c\x68\x04\x37\x05\x78\x2c\xde\xe3\x47\x3b\x66\x9f\xac\x57\xf8\x70\xdc\x0e\x0e\x0c\xf5\x08\x29\x07\x1e\xbb\xa5\xfb\xe2\xb1\x1c\x29\xe7\x6c\xa2\x9a\x55\x2a\x4c\x69\x06\x49\xd7\x67\xc9\x00\xf2\xf6\x9a\x75\x85\xd7\x6b\xc3\xb7\xbc\x65\x74\xf0\x2d\xd7\xc0\xc1\xb7\xbc\x06\x07\xdf\x32\x7c\xcb\xf0\x2d\x6f\x9f\x28\x7c\xcb\xf0\x2d\xc3\xb7\x1c\x6a\x10\xd4\x5f\x5b\xad\xda\xc1\x18\x52\x6d\x34\xb9\x21\xbc\x9d\xe3\x95\xb7\x13\xc3\x43\x5a\xd1\x85\x90\x56\xb4\x6c\x10\xbe\xc5\xd1\xb9\x9e\xf5\x46\x3f\x97\xe4\xe2\x2c\xb2\x92\xc4\x29\xf2\xba\x53\x3c\xe3\x5f\x03\x3b\x70\xd5\xc9\xc2\x74\x19\xa9\x5b\x2e\xfd\x18\xca\xfa\x97\x7a\xcf\xf6\x46\x0f\x3d\xf3\x06\x5f\x58\xa2\x27\x47\xf1\x16\xa6\x30\xc7\x69\x09\x55\x6c\xbb\x5e\x43\x54\x8d\xc4\x90\xe7\x1e\xea\x98\xa1\xbf\x0f\x54\x78\xed\xa7\x07\x9a\x8e\x90\xea\xe6\xb4\xc0\x28\x9e\x94\x57\xbb\xe8\x91\x94\xb3\xac\x27\xc3\x1e\xd6\x8f\xc6\x9a\x0c\x71\xed\x91\xce\xe2\x2e\xa6\x61\x27\x71\x5e\x45\x66\x19\xf3\xf5\x83\x63\x7e\x79\xe6\x69\x9d\x6d\xd6\x49\xcb\x19\x3f\x9d\x65\x7b\xe3\x0d\x6e\x26\x5e\x78\xa2\xab\x78\x61\x55\x23\x31\xeb\x58\x31\x85\xe4\xa3\x27\xfd\x48\xec\x1d\x37\xed\x0d\x62\x15\x8f\x2e\xd0\xde\x83\xe5\x67\x38\x43\xce\xdb\x58\x5a\x31\xa1\x58\xc7\x0b\x99\xed\x83\x50\x8a\x27\xe7\xf5\x5e\xc7\xf7\x8c\xdb\x69\xe5\xcb\x58\x7d\x81\x52\x7e\xd6\x1d\x3b\xea\x9f\xb8\xf5\xe6\x87\xc8\x27\xba\xe3\x8e\x77\x7a\xbf\xf9\xd8\xbe\x17\x38\x27\x82\x0f\x3f\xd4\x23\xce\x89\xc0\x63\xb7\x74\x5f\x3c\x96\x68\x80\x83\x95\x2d\xa3\xc3\xc1\x4a\x0d\x1c\x0e\x56\xd6\xe0\x70\xb0\x82\x83\x15\x1c\xac\x6c\x9f\x28\x0e\x56\x70\xb0\x82\x83\x95\x50\x83\xc8\xc1\xca\xa4\xc3\x08\xad\x98\xa4\x47\x2a\xab\xbb\xd6\x75\xec\xa0\xad\x2b\x76\x40\x76\x1d\xb3\x7a\x30\x6d\xe5\xd3\x2d\x77\xb4\xd7\xa6\xcc\xd9\xd1\x75\xe5\x2e\x6a\x3f\xf9\x3a\x47\x7f\x4d\xf9\x31\x2f\x94\xe7\x2f\x50\xb1\xdb\x36\x23\x51\x20\xf8\xbc\xd2\xcc\x5a\xc9\x1e\xb9\x14\x1d\x0f\x2b\x11\x49\x97\xa8\x36\x65\x8b\x68\xc9\x3c\x8a\x42\xde\xf1\x03\x2f\xee\xb8\xbc\xdc\xda\x24\x6c\xac\xe3\xa7\xbe\xca\x3b\xb7\xd8\xbe\xe5\xce\xb9\x83\x73\x15\xae\xbd\x5f\x6c\x4d\xdf\xd6\x46\x25\x4e\x89\x3b\x70\xf6\xd2\xdd\xfc\x12\x91\x84\x24\xb9\x75\xa2\xb5\xc4\x4d\x41\x32\x0f\xbc\x82\xf0\xd8\x84\x7b\x84\x57\x10\x3c\x76\x4b\xf7\xc5\x63\x89\x06\xbc\xef\xa5\x68\xc7\x4f\x7b\xf9\x21\x33\x5c\x8b\x5b\x46\x07\xd7\x62\x0d\x1c\x5c\x8b\x6b\x70\x70\x2d\xc2\xb5\x08\xd7\xe2\xf6\x89\xc2\xb5\x08\xd7\x22\x5c\x8b\xa1\x06\x91\xb3\x64\x79\xbc\x04\xf1\xcd\x21\x8e\xa9\x1d\x94\xe8\xb3\xe5\x91\x2b\x89\x61\x6a\x5f\x11\xcc\xa0\x82\x46\x30\xb5\xc1\x63\xf1\x1e\x3f\xd8\xd4\x6e\xa5\x20\xe5\x58\x4b\x21\x97\x34\xa4\xdc\x15\x81\x03\x0b\x1a\x41\xca\x81\xc7\xe2\x3d\x7e\x1b\x29\x87\x53\x13\x30\x60\xa0\x47\x08\x39\xf0\xd8\x2d\xdd\x17\x8f\x65\x0b\x39\xd6\xf3\x90\x2b\x1f\x92\xee\x8a\xc0\x85\x05\x8d\x20\xe9\xc0\x63\xf1\x1e\x3f\x5a\xd2\x69\xe5\xbc\xa8\x0b\xfb\x13\x53\x7e\xbd\xc1\x3a\x7d\x62\x07\xe2\x1d\x99\xb2\x20\xb4\x09\x42\x7c\x25\xe6\xe8\xd4\x4b\xee\x0a\x63\x09\xb9\xe3\xe7\xc4\x50\x52\xfc\x21\xe4\x6c\x4c\xbd\xc9\x25\x4e\x79\x58\x24\xed\xf8\x20\x1d\xbb\x0a\x4f\xaa\xba\xb5\xb2\xa3\x9d\xa4\xd6\x69\xc3\xb8\x14\xbc\x6c\xa5\xa7\x65\x61\x42\x16\x16\x2d\xa6\xe7\x96\xc6\x1d\x13\x3d\x3f\x4e\xa1\xec\xb8\x90\x4c\x2b\xd6\x0f\xce\x09\xb5\xbf\xbc\x75\x76\x3e\x42\x6b\x29\xb4\x7b\x92\xd0\x92\x3b\x47\x8a\x1d\xb8\x3d\xc4\x43\x22\x73\x31\x98\xa5\x9e\x1b\xee\x74\x59\x1d\x86\xe2\xf0\x52\xff\x60\x61\x44\xab\x73\xfd\xf8\x7e\x48\x95\xb9\xea\x45\x57\x1c\xd8\x28\xf6\x4a\x1b\x62\x17\x3e\x29\x9b\x81\x90\x27\xd6\x6b\x29\xe2\x15\x63\x32\x1e\x0f\xd6\xbf\xce\x47\xd0\x8f\x64\x9e\x8c\x88\xcb\xa4\x30\x33\x9d\xa3\x84\x85\xea\xe8\x99\x09\xc5\x82\xf7\x70\xe7\x22\x55\xc5\x1b\x2f\xab\x93\x8c\x21\xac\xa5\x30\x7e\x36\x9d\xdf\xb3\xbd\xdf\x2c\x85\x55\x29\x27\x98\x62\x19\x3b\x3d\x5e\x71\xe7\xf2\x58\x75\xc1\xb2\x7f\xfc\xdd\xef\x98\x21\x5e\x1c\x0c\x7b\xb9\xfe\xd9\x2f\x48\x45\x29\xfd\xbb\xb9\x8b\xfa\x0d\x46\xa5\x08\x3c\xc7\xa8\xbf\xb0\x3d\x39\xbf\xde\x35\x5f\xc1\x57\xb0\xb7\x5f\x8f\x22\x38\x6f\xdd\x3d\x69\x83\x2a\xf9\xd0\xbe\x57\x7b\x84\x85\x07\x1e\xbb\xa5\xfb\xe2\xb1\x44\x83\xc2\x0b\x5a\x9a\xa6\x17\x3d\x85\x2b\xdf\xa5\x1e\x4e\x24\xe6\x44\xca\x21\x19\xda\x91\x61\xfa\x17\x66\xc9\x08\x2e\xc5\xd7\x78\x09\xb4\xf0\x0b\x33\xd4\x6a\xa5\xa8\x75\xde\xd8\x20\x63\xe2\xdf\xaf\x18\x8e\xd4\xbc\x63\x7c\xe7\x0a\x8b\xb1\xcd\x00\xf3\x68\x52\x6a\x71\x72\x20\x5a\x31\x6f\x42\x0d\xa6\x50\x27\x9d\x0b\x1f\x1d\xe9\xc5\x7a\xac\xa1\xef\x4a\x3f\x9f\xab\x48\x15\x05\x99\xe6\x40\xa3\x58\x78\x5d\x12\xc3\x0e\xc6\xf8\x77\x5e\xf3\xba\xc6\xd0\x3c\xbe\x52\x64\x28\xe7\x69\x2d\xa5\x37\x1a\x26\x95\xbf\xf0\x0d\xe9\x61\xd4\x6d\x4a\x57\xd2\xb6\x07\x2a\x54\xaa\xad\x12\x3b\xbf\xff\x5a\xc9\xad\x2d\xd7\xcd\xad\x95\x63\x58\x68\x8d\xae\x37\x62\x08\x55\x8d\xf1\x48\x46\xec\x0a\x0d\xa6\xf9\xf9\xf2\xfe\x87\x7e\xcc\x06\x60\x9d\x6e\xd9\x93\xe1\x85\x06\xd7\x05\xc6\x37\x4c\xbe\x95\x30\x4e\x55\xda\x23\x37\x5e\x81\x9f\xac\xae\x4a\x90\x71\x1a\xc5\x18\x67\x7f\x11\x02\x0a\xa1\x55\x85\x7a\x84\xe6\x0e\x1e\xbb\xa5\xfb\xe2\xb1\x44\x83\x8b\x9c\x2b\xfe\x0a\x5f\x10\x2a\xbd\x98\x67\x9c\x32\x77\x6e\x4e\x29\xe4\xe4\xc3\x19\x4e\xb1\x38\x46\xcf\x8d\xa5\xc9\x0c\x28\xd6\xed\x26\x20\x43\xad\x28\x56\x08\xb2\x3e\xe0\xe1\xc2\x01\xca\x1b\x35\x8f\x64\xc6\x73\x9c\x79\x32\x2f\x7d\xe1\x8b\x19\x6c\xa1\x86\x3c\xb8\xb6\x46\xbd\x9d\xcb\x4d\x10\x9b\xe3\x2c\x32\x14\xac\x08\xd8\xa8\xdd\x2d\xfc\x8a\x63\x46\xa2\xe3\xc6\x95\x9e\x4f\x3d\x09\x77\x60\xce\x70\x65\xbd\x15\x4b\x86\xc9\xb5\x7a\xa3\x59\x48\x7e\xcb\x31\x2f\x91\x93\xc5\x35\x02\x6b\x1d\x11\x11\xeb\xda\x4f\xa2\xe0\x41\xd7\xb1\x9e\xbb\x03\xb3\xc3\x2e\xe1\x1d\x8e\x5c\x5d\xda\xf7\x89\xa3\x25\x5c\xff\x84\x54\xe2\x37\x80\x48\x25\x46\x2a\x31\x52\x89\xf3\x50\x91\x4a\x8c\x54\xe2\xed\x48\xc1\x08\xae\x4d\x30\x48\x25\x0e\x82\x21\x95\x38\xdc\x20\x68\xc4\xe2\x02\xa6\x0b\xe1\x02\xa6\x65\x83\x0f\x38\xb0\xcc\x33\x6c\xc2\xbe\xfa\x97\x93\x14\xea\xc8\x52\x03\x28\xa9\x6c\xe7\x31\x37\x19\x77\xda\x3c\xf1\xb5\xb8\x94\x84\x7d\xd7\x1e\x99\x21\xdb\x6b\x65\x29\xe7\x1c\x2d\xfc\x15\x85\xa1\xb6\x65\x74\x30\xd4\x6a\xe0\x60\xa8\xad\xc1\xc1\x50\x83\xa1\x06\x43\x6d\xfb\x44\x61\xa8\xc1\x50\x83\xa1\x16\x6a\x10\xcb\x04\x1b\x63\xe5\xaa\xb4\xc6\x4e\x59\x66\xf4\xa0\x3a\x66\xf4\x83\x28\x3c\xd1\xa1\xe7\x5e\x18\x62\x1e\xab\xe5\xed\x21\x7a\x52\x16\x1e\xca\x81\x9b\xae\x6e\x32\x07\xe2\xc6\x3d\x10\x4f\x69\x00\xf9\x38\xe1\x37\x98\x78\x7b\x73\x5e\x8d\x22\xf7\xa4\xcd\x71\x3a\xf8\xb3\xd5\x07\x5d\x47\xa2\x9e\x4b\xf1\x58\x78\x2a\x7c\x79\xbc\x6e\x99\xfb\x83\x38\xc7\x33\xb2\x8e\xdc\x98\xe3\x56\x36\x20\x8f\x94\x55\xa5\x2c\x3c\x98\xf9\x78\x35\x79\xff\x66\x0c\x61\xb4\x02\xd9\xd2\x14\x2b\x9b\x8e\xa5\x76\x30\xc2\x15\x17\xda\xe0\x72\xd4\xe6\x94\x56\x2f\x27\x3d\xd8\xe8\x3d\x0d\x39\xe3\x99\xc6\x24\x77\x89\x0b\x23\x9a\x3c\x69\x66\x0f\xdc\x50\x24\xd3\x2c\x13\x66\xb0\x64\x18\x1f\xd2\xfa\x61\x6c\x5e\xf1\xb0\x90\xcf\xd7\xb3\x0e\xb5\xb9\xcc\xa7\x44\xfa\x5a\x52\x95\xd2\xca\x92\x79\x0c\x66\xe0\x0a\x47\xa7\x20\x9f\xe4\xc4\x0c\x85\xf3\x18\x97\xa3\x4b\xc4\xf5\xa4\x42\x84\xb2\x40\xe2\x29\x3b\x73\x9b\xcc\x28\xa8\x8c\x20\xc0\x6d\x80\xcd\xa6\x60\xad\xed\xd8\x4d\x6e\xe0\xd6\x44\x1b\xa2\xad\x26\xca\x0b\xe2\x2a\x04\xcf\x0f\xe8\x5a\x76\x90\x13\xd6\x35\x51\x5e\x70\xd7\x44\xe9\x10\xaf\xe5\x18\x12\x81\x5e\x1b\x9b\x26\x02\x0b\x97\x68\x19\xab\x9b\x11\x64\x38\x11\x78\xf8\x1d\xc0\xc1\xc3\xd9\xcd\xc2\x29\x40\x4b\x90\xb8\x7b\x20\x4f\x4d\x80\xc0\x3f\x13\x36\x4b\x88\x20\xf0\xa3\x04\x1e\x0e\x10\x78\x38\xbb\x99\x75\x5c\x75\x0f\xd1\xf7\x9c\xb7\x3a\xde\xa6\x4b\xbd\x54\x48\xfc\x89\xb0\x5b\x42\x04\x89\x1f\x25\xf0\x70\x80\xc0\xc3\xd9\xcd\x9e\x48\xec\x0f\xd5\x4a\x7e\x6a\x41\x3e\x8f\xde\xa7\xc0\x8f\xc9\x71\x4e\x0d\xb8\x31\x7c\x6d\x21\x9d\xb4\x6c\xf2\x93\x8e\x3e\x3e\x2b\xf6\x8a\xba\x48\x0d\xf7\xd4\xcb\xf6\x78\xfe\x69\x26\xf5\x5e\xb4\x5c\x32\xeb\x46\xc7\x7d\x71\x06\xd7\x19\x2f\x7c\xa2\x8e\x5c\xd7\x2b\x42\x1e\x62\x41\x23\xe4\xba\x82\xc7\xe2\x3d\x7e\x74\xae\xeb\x59\xce\xb9\xc3\x70\x7a\xe8\x8d\x08\xc5\x47\xe5\xca\xcb\xc1\x12\x23\xe5\xc8\xf4\x46\x58\x9a\xc4\x70\x85\x48\x17\xfd\xa1\xb4\xbc\xe9\xf8\xfc\xeb\xdd\x20\x90\xe4\xd8\x65\xc1\x1e\x21\xc9\xc1\x63\xb7\x74\x5f\x3c\x96\x23\xc9\x27\x71\xd7\x1b\xf1\x38\x17\x84\x1a\xeb\xe8\xf7\x07\x13\x0c\x8b\x84\xec\xbb\x22\xf0\x65\x41\x23\xc8\x3e\xf0\x58\xbc\xc7\x5f\x4b\xf6\x41\xe3\x03\x47\xae\xf7\x08\xa9\x07\x1e\xbb\xa5\xfb\xe2\xb1\x0c\xa9\x27\xd4\x18\xad\x4a\x91\x04\xac\x1c\x3b\x7b\x2e\xe6\x13\x0f\x30\xcd\x04\x2a\x2e\xd1\x34\x0f\xe2\xb5\x5c\x6b\x65\xa0\x75\x2c\x2f\x74\x8e\xda\xbc\xf9\x25\xb2\xe4\xfb\x76\x65\xcf\xa4\xd2\x42\xa3\xa1\xeb\xc1\x95\xe0\x83\xd3\xac\x35\xe4\x3f\x64\x0f\x43\x7b\xa4\x42\xaf\x78\xfa\xd9\xe0\x10\x90\x87\xba\x65\x74\xc8\x43\xad\x81\x43\x1e\xea\x1a\x1c\xf2\x50\x91\x87\x8a\x3c\xd4\xed\x13\x45\x1e\x6a\x1e\x0c\xf2\x50\x91\x87\xba\xa0\xd9\x7f\xe2\x99\x2b\xa8\xd8\xa5\x76\xf4\x8c\x51\x95\x4f\xd5\x1a\xea\x26\x61\x6a\xd9\x2f\xc1\xbb\xa1\xe0\xc0\xb9\x22\x18\xd7\x05\x8d\xe0\xc0\x01\x8f\xc5\x7b\xfc\x60\x07\x0e\xa9\xd6\xbc\x4c\xf7\x7a\x96\x96\xe9\x46\x99\xb7\x0b\xa1\xcc\xdb\xb2\x41\x90\xe9\x0e\xf4\x3c\xeb\xdb\x51\xc3\x2a\xf5\x99\x3e\xd2\x4b\xf8\x1a\x90\xc4\x18\xa7\xb1\xd5\x56\x97\x9f\x51\x4e\xe4\x78\xc7\x1d\x5f\xc7\xa8\x4e\xc0\x4e\x48\xd1\x2c\x71\x97\x8c\x4a\xcf\x52\xfa\x53\xc1\xba\x61\x91\xf6\xb9\x89\xb1\x54\x65\x24\x6f\xe5\x15\x07\xe5\x55\x07\x8d\xf6\x23\x2e\x7a\xd6\x3a\x6d\xbc\xb8\x1a\x2f\x5b\x2a\x46\x20\x56\x78\x1d\xf4\x58\xe3\xbe\xd5\x9d\x50\x45\xf5\xed\x63\x7e\xed\xc9\xe3\xbb\xf2\xc3\xbc\x5c\x37\xbf\xc4\x1c\xde\x24\x57\x94\x91\xf8\xa6\x81\xdb\x78\xcb\xe8\xe0\x36\xae\x81\x83\xdb\x78\x0d\x0e\x6e\x63\xb8\x8d\xe1\x36\xde\x3e\x51\xb8\x8d\xe1\x36\x86\xdb\x38\xd4\x20\x6c\x4e\x06\x0b\x54\xa5\xf4\xf7\x8a\x9b\x8a\xb5\xd3\xad\x2e\x0b\xad\x70\xb2\xf0\x12\x60\x27\x2d\x9b\x3c\x56\x01\x00\xde\x75\x62\x72\x68\xfd\x9c\x54\x74\xeb\x56\x3d\x66\x00\xac\xa6\x6c\x7e\x1e\x57\x7b\x8b\xee\x7f\x70\x6e\x45\xe5\x4b\x44\xbb\x04\xab\xc1\xa5\x35\xff\x74\x75\xb1\x3c\xc7\x67\x66\xd9\x81\x7c\x2f\xea\xb6\x74\xed\x6d\xc9\xda\xd9\xa9\xda\x1b\x9c\xaa\xcd\xa6\x34\xed\x8d\xc0\xdb\x52\xb4\xf3\xbd\xb6\xcd\xa6\xf4\xec\xbc\xe4\xec\x2c\x0f\xee\x86\x86\x19\xa5\x05\x32\x57\x33\xb3\xac\x00\x78\xb4\x08\xf8\xfb\xe5\xd1\x8c\x46\xe9\x0a\x2f\x10\xb3\x60\xe1\x89\x20\x66\xdf\x10\x78\x74\x41\xdf\x2f\x8f\x26\x1b\xa5\xaa\x1a\x9f\x75\xdd\xc0\xcf\x67\x19\x5d\x62\x8d\xc1\xfd\xbe\x65\x74\x70\xbf\xd7\xc0\xc1\xfd\xbe\x06\x07\xf7\x3b\xdc\xef\x70\xbf\x6f\x9f\x28\xdc\xef\x70\xbf\xc3\xfd\x1e\x6a\x10\xbb\x3d\xc8\x8d\x21\xd7\xc1\x5e\x12\x3d\x90\xea\x7a\x5d\x5a\xf5\x69\xbc\x0f\xe7\xf5\xe2\x4b\x6e\xd9\xa0\xe6\x9b\x5c\xbc\x06\x53\xe6\x68\x47\x48\xe3\x85\x10\xd2\xb8\x6c\x10\x09\x69\xe4\x5d\xb0\x4a\xd8\xb7\x3b\x96\x99\x4e\x4d\xd8\x89\xdc\x41\x47\xaf\x41\x0a\x76\xe0\x5f\x25\x1b\x03\xed\xca\xb6\x8e\xee\x49\x55\x5e\x0a\x65\xf4\x73\x59\x24\xf2\xa8\xd2\x56\xf5\x3d\x7e\x21\x47\xcb\xe7\x22\x51\x5a\xdd\x85\xde\x56\x34\xb2\x33\xad\xc4\xc4\x83\x1a\xad\x95\x75\x73\x19\x2b\x92\x70\x14\x9e\xdb\xe4\x57\x42\x86\x41\x41\x23\x64\xb1\x80\xc7\xe2\x3d\x7e\x8b\xe2\x4b\xa8\xd3\x09\x56\x5e\xa5\xfb\x62\x65\x88\xcb\x06\x3c\x96\xe8\xf1\x1b\x88\x4b\x14\xe8\xbc\x26\x30\x64\xac\x47\x08\x3d\xf0\xd8\x2d\xdd\x17\x8f\x6d\x16\x7a\xd0\xf1\xc0\x8a\xeb\x3d\x42\xdc\x81\xc7\x6e\xe9\xbe\x78\x2c\x43\xdc\xcd\xd5\x2c\x53\x75\x39\xa3\x66\x71\x79\x35\xcd\x58\x8a\xc0\xf9\xc8\xe7\xe6\xa7\xc8\xac\x8e\x7c\x77\x5c\x49\xb1\x4f\x95\xbf\x3c\xd6\x79\x51\x1f\x8c\x3e\x96\xba\x15\x10\x0a\xb5\x65\x74\x08\x85\xaa\x81\x43\x28\xd4\x1a\x1c\x42\xa1\x10\x0a\x85\x50\xa8\xed\x13\x45\x28\x14\x42\xa1\x10\x0a\x15\x6a\x90\x2a\x60\x29\xca\x42\x2f\xce\x1a\x90\xd0\x6a\x8c\x3a\x08\xbc\xc9\x04\x4a\x47\x3b\x3e\x48\xc7\x92\xc1\x43\x99\x38\x3d\x37\x4e\x54\x15\x88\x3b\x23\x39\xdd\x8b\xc2\x39\x09\xdb\x72\xd3\xb1\xd1\x04\x60\x1d\x49\xf1\x48\x5e\xb2\x73\x21\x43\xe6\x58\x8a\xd7\xe9\xb9\x95\x43\x47\xd3\xf4\xd2\x93\x4b\x03\x8d\xb3\x2b\x87\x41\xc8\xd9\x85\x10\x72\xb6\x6c\x10\x14\x36\x7b\x72\xf3\x86\x98\xc5\x8e\xd4\x45\xb5\xbc\xee\x29\x78\x6d\x1a\x08\xdb\x19\x7d\x9a\x4d\xd4\x5f\x7f\x50\xa2\xa3\x53\xaf\x1d\xc5\x03\x54\xc3\xab\x3b\xbd\x23\xbe\xdf\x8f\xfa\xde\xc3\x8b\x2b\x2d\x6d\x7c\x0d\x34\xef\xd4\x42\x2c\x8f\x60\x49\x75\x75\xc5\x96\x17\xd2\xa2\xf8\xeb\x50\xff\x7d\xb9\x42\xa8\x40\x89\x95\x7f\xc0\x99\xc0\x15\xc1\x5f\x5b\xd0\x08\x67\x02\xe0\xb1\x78\x8f\x1f\x7c\x26\x70\x1e\x2c\xe3\xed\xb1\x50\xe2\x5b\x6e\x25\xd3\x8f\x64\x98\xb5\xd1\xea\x47\xe1\xc5\xb3\xad\xe1\x27\x76\xa2\xf6\xc0\x95\xb0\x01\x56\x4e\xbc\x56\x6b\xe5\x39\xb6\x19\xf2\x1a\x7b\x69\xad\x47\xc8\x6b\xf0\xd8\x2d\xdd\x17\x8f\x25\x1a\x2c\xa4\xdc\x6c\x13\xd9\x17\xeb\x28\x2a\x32\x23\x72\xd7\xa3\xbd\x06\x39\x43\x6e\x82\xa7\xd7\x7a\x84\xdc\x04\x8f\xdd\xd2\x7d\xf1\x58\x8e\xdc\x5c\xa4\x73\xb4\x07\x2e\x70\x89\x13\x78\x71\xb5\x47\xc8\x3b\xf0\xd8\x2d\xdd\x17\x8f\x6d\x94\x77\xc1\x37\x06\x69\x77\x45\xe0\xc4\x82\x46\x90\x76\xe0\xb1\x78\x8f\xdf\x40\xda\xcd\x91\xcd\x07\x6d\x5d\x78\x81\x53\xf3\xcf\x3a\xb1\x0f\xbe\x93\xc1\x12\x3b\x47\x36\xec\xb4\x61\x83\x3a\x2a\xfd\xa4\xd2\x51\x0e\xe1\x01\xc5\x4b\xe3\x42\x78\x5f\x11\x36\x56\x41\x23\x08\x6f\xf0\x58\xbc\xc7\x0f\x15\xde\xd1\x6b\xe3\xa6\x44\x8b\x95\x5f\xa6\xd0\xa8\x9b\x1f\x22\x3d\x1d\x85\x22\x2b\xec\x5f\x9d\x21\xbe\xc2\xa8\x89\x94\x11\x6b\x87\x13\x31\xa3\x25\xb1\xc5\x4d\xd0\xa5\x42\xb9\x1b\x0c\x1f\x83\x13\xa6\x98\xda\xba\x70\x51\x7a\x76\xfe\x13\x21\x83\x21\x86\x99\x38\xbd\x96\xa2\xad\x8b\xf0\x1a\xd7\x87\x9b\xba\x18\xf7\x11\xc4\xce\x31\x8f\xf1\xdd\x96\x44\x4b\xd5\x3c\x3e\x0f\x38\xf6\xf3\x72\x28\x25\xba\x09\x7f\xb2\x4c\xf0\x53\x5d\x54\x8d\x07\x39\xd2\x4b\xf0\x1d\x43\x11\xb8\x22\x08\xe9\x82\x46\x50\x04\xc0\x63\xf1\x1e\x3f\xd8\x8a\xf3\x42\xce\x52\xc4\xfc\x82\x94\xbb\x22\x70\x60\x41\x23\x48\x39\xf0\x58\xbc\xc7\x6f\x22\xe5\x2c\x73\xfa\x48\x38\x81\x04\x0f\xae\xf6\x08\x39\x07\x1e\xbb\xa5\xfb\xe2\xb1\x44\x83\x07\xee\xda\x03\xf3\x43\x26\xeb\xc6\xf4\x94\x48\x7a\x7e\xca\xfe\xbd\x05\x0b\xa7\x7d\x26\xb1\x50\x82\x63\xc3\xe8\x50\x82\xa3\x06\x0e\x25\x38\xd6\xe0\x50\x82\x03\x25\x38\x50\x82\x63\xfb\x44\x51\x82\x23\x0f\x06\x25\x38\x50\x82\x63\x41\xa8\xa3\x70\x21\xd4\x51\x58\x36\x08\x72\xcc\x3b\x24\xa0\x1b\xdd\x92\xb5\xef\x71\x5a\x3c\x43\x7d\xe0\x31\xe8\xdc\x43\xc9\x4a\x19\xda\x97\x95\x82\xf4\x8f\x5a\x72\x17\xb5\x42\xec\x98\x1d\xda\xf0\x44\x53\x3b\x6c\x3e\x5e\x65\x5a\xb1\x2b\x63\xb1\xcc\x46\xb4\x63\x98\x40\xe4\xf8\x39\x3a\xb7\x58\x4c\xc3\x02\xf9\xe6\xd7\xc8\x5a\x4b\xbd\xef\xd4\xf6\x52\x97\xbd\x28\xe6\x60\xde\x07\x0c\xaa\xc4\x73\x30\xaf\xb7\x8c\x0e\xe6\x75\x0d\x1c\xcc\xeb\x35\x38\x98\xd7\x30\xaf\x61\x5e\x6f\x9f\x28\xcc\x6b\x98\xd7\x30\xaf\x43\x0d\xc2\x15\xda\x32\x32\x2e\x82\xe8\x42\xed\xc9\x3a\x32\xac\xd3\xa7\x60\x46\x6e\x2e\x46\xd5\xad\xc1\xe7\xc3\xa5\x8c\xd2\xf0\x41\x8c\xf0\xce\x28\xb6\x17\x66\x15\x7e\xe5\x97\xf3\xba\x6f\x34\x23\xf6\x42\xed\x57\x0f\x62\x23\x43\x94\x7a\xff\x75\xab\xed\x01\x3b\x60\xcb\xe8\x60\x07\xd4\xc0\xc1\x0e\x58\x83\x83\x1d\x00\x3b\x00\x76\xc0\xf6\x89\xc2\x0e\x80\x1d\x00\x3b\x20\xd4\x20\x68\x07\xc4\xb5\xef\x8c\x73\x0e\x1d\xaa\x40\xd6\x64\xca\x82\x48\x48\x6d\xde\x10\x9a\xbc\xd0\xda\x7c\xb0\x66\x53\xf8\xe3\x36\xdc\x26\x37\x0c\xb2\xc9\xe3\x8e\x25\xe5\x85\x43\x16\x00\xe7\x87\x45\x36\x99\x7b\xf6\x95\xf2\xc2\x23\x9b\xac\x10\xc9\x26\xcd\xef\x9b\x1b\x26\x42\x72\x9b\xfc\xd5\xcc\x08\xcd\x6d\xc0\xa3\xa5\xc0\xdf\x2f\x8f\x66\x34\x1a\x4c\x8d\xf2\x97\xe8\x60\xff\x55\x44\x0f\x1d\x23\xf7\x15\x38\xd7\x33\xd1\x49\xaa\xbb\xda\x4f\x0f\xae\x1f\xbc\xc1\x36\x5f\xe0\x91\xf0\xe7\x84\xc7\xf3\x16\x48\x94\x56\x24\x99\x74\xb5\x8a\xd0\xe9\x59\xa7\x95\x44\xd1\xa5\x0d\x01\xbc\xf7\x95\x8d\x52\x1f\x05\x5c\x49\x61\x40\xb8\x92\x16\x80\x70\x25\xc1\x95\x04\x57\x12\x5c\x49\x70\x25\x5d\xa1\xc2\x95\x74\xdb\x04\xae\xa4\xdf\x90\x2b\x09\xf7\x78\xe4\x5b\xac\xc8\x5c\x2d\x68\x84\xec\x68\xf0\x58\xbc\xc7\x0f\xce\x8e\x86\x78\x03\xeb\x85\x7a\x84\x78\x03\x8f\xdd\xd2\x7d\xf1\x58\x4a\xbc\x69\xb5\x13\xfb\xc1\x10\x3b\x0e\x0f\x64\x14\x39\xb2\x4c\xf2\x07\x0a\xa5\x41\xa5\xd6\xa1\x33\xba\x67\x73\xee\x57\xc5\xbd\xcc\xce\xf0\xe8\x30\xbe\xe5\x25\xb2\xe3\x68\x5a\xf7\x5e\x2b\x24\x94\xa5\xd6\xaf\xb8\x2b\x45\x40\xdd\x35\x88\x8b\x40\x8f\xf8\x24\x81\xc7\x6e\xe9\xbe\x78\x2c\xd1\xe0\x5e\xc4\xbe\x14\x8a\x58\x2c\x25\x3d\xd1\x01\x2e\xa5\xc6\x36\x8a\xf5\x08\x51\x0d\x1e\xbb\xa5\xfb\xe2\xb1\x44\x03\x43\x27\xfd\x38\xea\xf9\x81\x97\x29\x1c\x9d\x6a\x05\x34\x37\x86\xaf\xcd\xd5\x91\xe2\x85\x29\x44\xc1\xd8\x98\xd4\x73\xb8\xe4\x05\xdb\x2d\xd2\x23\x44\x3a\x78\xec\x96\xee\x8b\xc7\xa2\x0d\x22\x3f\x2a\x7a\x32\x24\xd7\xae\xc7\xaa\x28\x6d\x02\x91\x79\x45\x60\xe7\x82\x46\x10\x99\xe0\xb1\x78\x8f\x1f\x5e\x40\xd9\x12\x1b\xcc\x4a\xb4\x6c\x93\x5e\x49\x29\x5a\x52\x36\xe2\x2b\x87\x88\xbc\x22\xb0\x6f\x41\x23\x88\x48\xf0\x58\xbc\xc7\x5f\x4d\xab\x1c\xa4\x5c\x8d\x90\x8c\x3c\xa3\xd7\x62\xe6\x13\x4a\x28\x1f\x2b\x16\x8e\x57\xb3\x4d\x1c\x08\x61\x8b\x8d\xb0\xd6\x23\x84\x2d\x78\xec\x96\xee\x8b\xc7\x12\x0d\x16\xb2\x0e\xd7\x50\x82\x07\xd7\x7b\x84\x9c\x03\x8f\xdd\xd2\x7d\xf1\x58\x4a\xce\x0d\x4e\xb3\xd6\x10\x77\xc4\x1e\x86\xf6\x18\x52\xea\x52\xd3\x4f\x3f\x1b\x7c\x1f\x48\x78\xdd\x32\x3a\x24\xbc\xd6\xc0\x21\xe1\x75\x0d\x0e\x09\xaf\x48\x78\x45\xc2\xeb\xf6\x89\x22\xe1\x35\x0f\x06\x09\xaf\x48\x78\x5d\x50\x7b\xa0\xf6\x58\xa5\x6d\x4e\x08\x53\x07\x65\x08\x5e\xa1\x90\xda\x7f\x20\x4d\xcb\x48\x79\xdd\xa5\x0c\xa8\xaa\x0c\x33\x2e\x6b\xba\x10\x2e\x6b\x5a\x36\x08\xd7\x1f\xa7\xe7\xf9\x1b\x16\x55\x56\x52\x1f\x44\xa1\x3a\x7a\xae\x09\xcc\xf6\x32\xab\xe2\x71\xdd\x93\xaa\x2c\x5e\x65\x2d\xb3\xdd\x91\x49\xbd\x67\x9d\x08\xe8\x2d\xa9\x51\x3c\x92\x79\x32\xc2\x15\x6e\xfd\xb0\xea\x99\xe8\x77\xd4\xa4\xab\x66\x6f\x9d\x36\xc4\x78\x49\xfd\xf5\xa6\x19\xfa\x77\x91\x7c\x4f\xdc\x28\xcf\x03\x6c\x34\x27\x0b\x46\x12\xad\x04\x7f\x73\xd8\xb4\xd6\x68\xe9\xa4\x5d\xf9\x7d\xfa\xc4\xac\xfc\x50\x52\xcb\xcb\x50\x27\xb6\x9f\x99\x49\x6f\xe6\x74\x43\x2f\x45\xcb\x5d\x45\x36\x1f\x9c\x24\x5b\x46\x07\x27\x49\x0d\x1c\x9c\x24\x6b\x70\x70\x92\xc0\x49\x02\x27\xc9\xf6\x89\xc2\x49\x02\x27\x09\x9c\x24\xa1\x06\x41\x43\xaf\x7b\x60\x6a\x38\x3d\x84\x84\x4d\x6a\x33\xc3\xb3\x70\x21\x78\x16\x96\x0d\xa2\x37\x9b\x15\x99\x93\x42\x59\x32\x6e\xb4\xc2\x7a\x43\x3b\xf1\x5c\x84\x82\x4c\x6f\xc4\x41\xc4\x7a\x44\xac\x0d\x78\xec\x96\xee\x8b\xc7\x12\x0d\xc2\x77\xbe\xa4\xdd\x7d\x66\xe7\x95\xa0\x1a\x97\xab\x73\xd1\x7c\xed\x50\xe7\x91\x39\xd9\x1f\x37\xbb\xc3\xda\xb2\x9c\x71\x6e\xed\x70\x22\x66\xb4\x24\xd6\x1a\xea\x26\xeb\x2e\xb0\x25\xd2\x5b\xa6\x1b\x0c\xf7\xbc\x38\xdb\x26\x75\x6a\x37\x3d\x3b\x32\x8a\xcb\x60\x9c\x68\x26\x4e\xaf\xa5\x68\xeb\xb4\xa4\x71\x7d\xb8\xa9\xf3\x15\x8c\x20\x96\xac\xf5\x0b\x14\x17\x02\x49\xb4\xf8\xf6\xfc\x7c\x19\x70\xec\xe7\xe5\x50\x4a\x76\xdd\xb6\x08\xb7\x30\x0b\x3e\x59\x26\xf8\x89\x79\x6b\x2f\xc8\x5a\x19\x18\x88\x28\xc6\x17\x28\xdc\x23\xb4\x1c\xf0\xd8\x2d\xdd\x17\x8f\xa5\xe4\xed\x93\xf5\xdf\x55\x24\xe3\x82\x03\x43\x3d\x42\xca\x81\xc7\x6e\xe9\xbe\x78\x2c\xd1\x00\xe7\xf1\x5b\x46\x87\xf3\xf8\x1a\x38\x9c\xc7\xaf\xc1\xe1\x3c\x1e\xe7\xf1\x38\x8f\xdf\x3e\x51\x9c\xc7\xe3\x3c\x1e\xe7\xf1\xa1\x06\x89\xa4\x05\xde\x0b\x3f\x71\xad\x98\x75\x3c\xee\xcd\x0f\x76\x95\x9b\xfe\x90\x00\x48\x67\x3f\x84\x01\xe4\x60\x5d\xac\x0a\x6b\xea\x79\x7d\xea\x07\xe7\xf5\x26\x6a\x8f\x76\x08\x55\xa7\x4d\xa0\x4c\xb1\xc7\xcc\x19\xae\xec\x8e\x0c\xe3\x6d\x4b\x92\x26\xcf\x7c\x11\xe0\x4e\x9b\x96\x98\xff\xb6\x32\xeb\x5e\xe2\x61\xcd\x48\xc9\x68\x10\x38\x31\x51\xad\x64\xd8\x1b\xae\xdc\x64\xcc\xb5\x5a\x39\xa3\xcb\xce\xb8\x26\x18\xaf\xaf\x56\x3e\xce\x78\x1b\xbd\xfc\x3a\x01\x31\x26\x44\x14\x63\x6c\xca\x4f\x89\x84\x94\x54\xa6\xa7\x08\x65\x1d\x57\x5e\x1a\x18\xed\x19\xf8\x3d\x4e\x0d\xc7\x5b\xd7\xd3\x89\x2b\x19\xa3\xbb\xa0\xa5\x13\x41\x32\xd1\x44\xcf\x78\xd7\x55\xdb\xd8\xe1\x13\xea\x4c\x80\xe8\xe9\x58\x16\x46\x62\xb3\x69\x45\xf4\x92\x73\x0e\x1e\xb9\xad\x3e\x27\xeb\x27\x12\xa7\x54\xc8\xd3\xbd\xd1\xcf\x2f\xc5\x25\x2e\xed\x8f\x35\x1a\x83\xfd\x91\x55\xe5\x29\xda\x1f\xd9\x89\x1c\xef\xb8\xe3\xa5\xcf\x4f\x6f\x94\x55\xe6\x8d\xd9\x1f\x99\xa1\x7d\xa9\x82\x60\x0f\xdc\x78\x2b\xb7\x5e\x16\x54\x1b\xf0\x67\xb9\x54\x79\xc0\x9e\xd8\x2d\x56\xec\x15\x77\x83\x21\xf6\x48\xc6\x16\x2f\x9b\x25\xd6\x0e\xd6\xe9\x93\xd7\xd2\xe4\x5e\x1b\xe1\x0e\x01\xbd\x61\x0b\x54\x22\x19\x2a\x1b\x84\x9d\xba\x3f\x94\x02\x1d\x4f\xf1\x23\xf9\x24\x82\xf4\x4b\x2b\x76\x2f\xac\xa7\x78\xac\x6e\x18\xc3\x69\xe3\x55\xbd\x56\xf2\x90\xf8\xce\x40\x28\xcf\x06\xb4\xc4\x1e\x06\xd5\x49\xbf\x33\x82\x17\x75\x66\x80\x58\x32\x8f\x64\x98\x15\x1d\x31\x52\xad\x79\xe9\x8b\x35\xf9\x0f\x4d\x2d\xbc\x88\xd2\x9b\xdf\x62\xb1\x4e\xbd\x1c\xd4\xf1\xdf\x68\x73\xe9\x76\x1c\x14\x6c\x19\x1d\x0e\x0a\x6a\xe0\x70\x50\xb0\x06\x87\x83\x02\x1c\x14\xe0\xa0\x60\xfb\x44\x71\x50\x80\x83\x02\x1c\x14\x84\x1a\x84\x0f\x0a\xf8\xe8\x32\x2d\xd5\xf8\x10\xfb\x56\x03\xf8\xdb\x8f\x4b\x42\xec\x5b\x03\x1e\x4b\xf4\xf8\xd1\xf7\xdd\x73\x16\xd6\x4b\x21\xe1\xae\x08\xdc\x57\xd0\x08\x12\x0e\x3c\x16\xef\xf1\xa3\x25\x9c\x14\xa4\x5c\xc4\x1d\x0a\x29\x77\x45\xe0\xc0\x82\x46\x90\x72\xe0\xb1\x78\x8f\xdf\x46\xca\x21\x51\x0b\x0c\x18\xe8\x11\x42\x0e\x3c\x76\x4b\xf7\xc5\x63\x29\x21\xa7\xc9\xb4\xc4\x9c\x66\x83\xdb\xfd\x31\x76\x94\x1c\x29\x16\xce\x1d\x8f\xb8\x9b\x53\x11\xa5\x82\x64\xe8\x94\x95\x77\x9d\x98\x5e\xd0\xcf\x49\x9e\xab\x75\x4c\x22\xb2\xf5\x42\x88\x6c\x5d\x36\x88\x14\x1b\x6f\x59\x71\x59\x30\xff\x70\x79\xc5\x1b\xff\xb4\xd3\x47\x0a\x1c\x9f\x42\x35\xb9\x22\x7c\x36\x0a\x1a\x41\x35\x01\x8f\xc5\x7b\xfc\x60\xd5\xa4\x5c\xb4\x6a\x1b\x31\xdb\x12\x0f\x8b\x4e\x52\xdd\xe5\x03\x63\x74\x7e\x59\xdf\x63\x5c\x7f\xf1\xc8\x95\xa5\x76\x30\xc4\xac\x8d\xa6\x58\x84\x19\xe5\x48\xd4\xfb\xee\xa3\x91\x92\xe1\xc7\x4f\xe4\x8c\x68\xc7\x98\xdd\xe2\x49\xcc\x18\xa3\xe8\xa8\x04\xb1\x6c\x67\xf4\x89\xd1\x23\xc5\xc3\xba\xc3\x13\x52\x5a\x8d\x6a\x31\x33\xd4\x4b\xde\xd2\x89\x94\x63\x53\xaf\x45\xe3\xaa\xbf\xd6\xa3\x37\xda\xe9\xb6\x30\x85\xe6\x1d\xee\xd5\xd0\x83\x69\xcb\xd4\xfc\xe9\xd1\xf2\xa0\xe6\xf1\xf1\x62\x23\xe3\xf5\xf1\x8a\xb0\x6a\xc9\x5a\xd1\x1f\xc8\x94\x04\x12\xc7\x22\x6e\xcf\x7a\x64\xe0\xa7\x51\xcf\xbb\xf9\x2d\x22\x3c\xed\x70\xd2\x52\xef\xc5\xe6\x60\x5c\x6f\xc4\x8c\xc1\x56\x8e\x9f\xa2\xf9\x4d\xb8\x88\x03\xf1\xbc\x6f\x00\x11\xcf\x8b\x78\x5e\xc4\xf3\x22\x9e\x17\xf1\xbc\x57\xa8\x88\xe7\xbd\x6d\x82\x78\xde\xdf\x52\x3c\x6f\x54\x87\x49\x2d\xff\xf9\x69\x46\xaa\xd5\x5d\xa9\x5d\x35\x65\x3b\xb2\x4e\x9c\x48\x59\xa1\x55\x59\xa6\xdf\x8c\x12\x3b\x8f\x10\x8e\x42\xd5\x3c\xb2\x57\x92\x1b\xc3\xd7\xbc\x2b\x75\xa7\x28\x1d\x8d\xdc\x52\x98\x63\x79\x51\xf8\xb4\x3e\x26\xaa\x37\x97\x5e\xf5\x0a\x97\xf4\x15\xc1\x5d\x58\xd0\x08\x2e\x69\xf0\x58\xbc\xc7\x0f\x76\x49\x4b\xbd\xaf\x29\xcf\xe0\x1f\xaf\x74\x8c\x8e\x5f\x89\x5f\xf5\x66\xe1\xca\x42\x1d\x93\x1b\xb0\xe5\x8e\xf6\xda\xd4\xf8\x02\xcb\x8f\x5e\xe7\xe7\x8b\xeb\x7b\x2d\x9e\x2f\xf7\x26\x0e\x27\xcd\xa6\x08\xb3\xa2\xe7\x2f\x6e\xba\xe2\x11\xcc\x75\x19\x0a\x0f\x0b\x62\xfe\xcc\xe0\xf5\xc1\x9f\x97\x4b\x77\xf3\x6b\xcc\xa3\xf9\x62\xa5\x5e\xd1\x0d\x51\x5b\x00\xbe\xc8\x15\x40\xf8\x22\xe1\x8b\x84\x2f\x12\xbe\x48\xf8\x22\xaf\x50\xe1\x8b\xbc\x6d\x02\x5f\xe4\x6f\xc8\x17\x59\x19\xc2\xdb\xf7\x93\x4e\x3f\x7a\x00\xab\xa6\xe9\x4d\x93\x77\x82\x1a\x4d\xce\x6a\x94\x73\x54\xb1\xe8\xde\x01\xac\x37\xba\x7d\x1f\x24\xb3\x6b\xff\xe9\x0f\x7f\xfc\x67\x76\x1e\x5e\x0e\x7f\xc5\xf7\x8f\x75\x66\x68\xdd\x60\xa8\x9b\x4d\xe5\xea\x31\xc6\x59\x16\x81\xd4\xef\xb0\x6f\xab\xee\x56\x6e\x07\x13\x35\xe3\xc3\xfc\x52\x1e\x7e\x3d\x16\xe5\x4e\x3d\x1e\xb6\xdd\xcd\x60\x9d\xd7\xe4\x50\x28\x00\xbe\xcc\x70\x8f\xf0\x97\x83\xc7\x6e\xe9\xbe\x78\x2c\xda\x20\x1a\xf3\xb8\x16\xef\x18\x44\x0b\xfc\x60\x1d\x77\xc3\x1b\x36\x09\xb3\x0f\x6f\x9d\x78\x5c\x79\xc1\xb1\xb5\xef\x8d\x7e\x90\xab\x67\xbe\xc1\xa3\xe0\x28\x7f\x84\x8f\x80\xcf\x3d\xfd\xb4\xee\x57\x09\x7f\x91\x56\xd7\xe6\xe6\x9f\x63\xf5\xd9\xee\x8b\xff\xf6\x4c\xdf\xf1\xb9\xac\xee\xf2\x3f\xc3\x83\xa1\xc9\x41\x7b\x99\xd8\xbc\xc2\xcd\x7f\xfe\xd7\xa7\xd7\xc5\xe6\x6d\x4b\xbd\xa3\xee\x2f\xfc\x62\x3b\x1f\x85\xea\xbe\x34\xff\xf0\x0f\xe3\x1f\xbd\x1c\x0c\x97\xf3\x9f\xde\x90\x1d\x73\xf8\xec\x97\xe6\xff\xfd\xff\x4f\x73\x35\xde\xee\x6f\x53\xc1\xe5\xe9\x9f\xff\x1d\x00\x00\xff\xff\x6e\x27\xa3\x24\x31\x5c\x05\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\x5f\x8f\xe3\xb6\x11\x7f\xf7\xa7\xd0\x17\xf0\xf6\x82\xb6\x40\xe0\x97\x22\x48\x53\x20\xb8\x20\x3d\xa4\x45\x5e\x89\x31\x35\x96\x79\x4b\x91\x0a\x49\xd9\xeb\xfb\xf4\xc5\x48\xf2\xda\xe7\xb3\x44\x89\x74\x80\xbb\xed\x9c\xee\x65\x2d\xf1\x27\x72\x38\xfc\x91\x9c\x3f\xd4\x6a\xbd\x5e\xaf\xa0\x51\xbf\xa3\xf3\xca\x9a\x4d\x01\x8d\xc2\x97\x80\x86\xfe\xf2\x4f\xcf\xdf\xfb\x27\x65\xff\x72\xf8\x6e\xf5\xac\x4c\xb9\x29\x7e\x6c\x7d\xb0\xf5\x6f\xe8\x6d\xeb\x24\xfe\x13\x77\xca\xa8\xa0\xac\x59\xd5\x18\xa0\x84\x00\x9b\x55\x51\x80\x31\x36\x00\xfd\xec\xe9\xcf\xa2\x90\xd6\x04\x67\xb5\x46\xb7\xae\xd0\x3c\x3d\xb7\x5b\xdc\xb6\x4a\x97\xe8\x3a\xf0\xf3\xab\x0f\xef\x9e\xfe\xfe\xf4\x6e\x55\x14\xd2\x61\x57\xfc\xbf\xaa\x46\x1f\xa0\x6e\x36\x85\x69\xb5\x5e\x15\x85\x81\x1a\x37\x85\x6d\x43\xd3\x06\xff\xa4\x6d\x55\x29\x53\x3d\x6d\xc1\x7c\x02\x25\xb5\x6d\xcb\x27\x65\x57\xbe\x41\x49\x2f\xae\x9c\x6d\x9b\x4d\x31\xf2\x54\x0f\x76\xae\x21\x04\xac\xac\x53\xe7\xbf\xd7\xe7\x52\x6b\xe8\xde\x5b\x14\x7d\xfb\xff\xdd\xbd\xb9\xfb\x41\x2b\x1f\xde\x5f\xfd\xf8\x8b\xf2\xfd\x8d\x46\xb7\x0e\xf4\x6b\x2d\xbb\xdf\xbc\x32\x55\xab\xc1\x9d\x7f\x5d\x15\x85\x97\xb6\xc1\x4d\xf1\x2b\xd4\xe8\x1b\x90\x58\xae\x8a\x62\x10\x45\x57\x89\x75\x01\x65\xd9\x09\x17\xf4\x07\xa7\x4c\x40\xf7\xa3\xd5\x6d\x7d\x16\xea\xba\x28\xd1\x4b\xa7\x1a\x7a\x64\x53\xfc\xec\x8b\xb0\xc7\x01\xbe\x00\x19\xd4\x01\xff\xd1\xbd\xbb\x28\x3e\x7a\x6b\x3e\x40\xd8\x6f\x8a\x27\x1f\x20\xb4\xfe\xa9\xbf\x3f\xdc\x26\x39\x6c\x8a\x1f\xae\x7f\x0a\x27\xaa\xdb\xd6\x5a\x8d\x60\xee\xbd\xee\xd7\xb6\xde\xa2\x2b\xec\xae\x68\x9c\xdd\x6a\xac\xfd\xe8\xbb\xce\x0f\xfc\x68\x5b\x13\x86\xa7\xfa\x57\x7e\xf8\xbc\x68\xff\x52\x6a\x69\x85\x6e\x75\x79\xec\xf0\x1d\xe8\x66\x0f\xdf\x75\x3f\x79\xb9\xc7\xba\x53\x33\xfa\xcb\x36\x68\x7e\xf8\xf0\xf3\xef\x7f\xfd\xcf\x67\x3f\x17\x54\xab\x06\x5d\x78\xed\xd0\xfe\xff\x95\xa2\x5f\xfd\x7a\x7e\xb3\x0f\x4e\x99\xea\xea\x46\xd7\xe7\x73\x1e\xbc\xd6\xfe\xcb\xbf\x1e\xd5\x6e\x3f\xa2\x3c\xb7\x9b\xae\xb3\x7a\x16\xc5\x74\x65\xe9\x82\xa3\xff\x49\x83\x0f\x4a\x7a\x04\x27\xf7\xb7\xf7\xa7\xca\xd2\xb5\x6d\x77\x3b\x74\xf7\xee\xc4\x4a\xd2\x25\xf7\xad\x79\x16\xbb\x56\x6b\x11\xf6\x0e\xfd\xde\xea\x1b\x79\xcc\x90\xcd\xf5\xd5\x03\x6a\x55\xab\x20\x1c\x4a\xeb\x4a\x1f\xc3\xbb\x56\x87\x69\x40\xaf\x3e\x61\x5e\xed\x6c\xdd\x38\xf4\x3e\x0b\xa4\x44\x0d\x27\x2c\x85\xb4\x35\x55\x2a\xa8\x1a\x6d\x1b\xf2\x20\x95\x87\xad\x46\xd1\x37\x76\x0b\xf2\xb9\x6d\x62\x80\xd7\x43\xf7\xcb\x7f\x3b\xdd\xfa\xbd\x80\x20\xfc\xbe\x0d\xa5\x3d\xde\x0c\x87\x34\x38\xea\x29\x77\x00\x9d\xd5\xd6\x1e\xaa\xb6\x65\x5e\x57\xf6\x30\xa4\xb4\x50\x8a\x6d\xeb\x7c\x78\x64\xf5\x06\x5c\x49\x84\x96\xa7\xbf\x9f\xe1\x3d\xa4\x86\xf6\x80\x6e\xa7\xed\x51\x10\xc5\xdf\xf2\xdc\x42\xac\x86\x78\x3c\x07\xe0\x8f\x16\x5b\x1c\x86\xa7\x46\x53\x85\x7d\x9e\xb8\x3a\xbc\xb2\x1f\x08\x7e\xc1\xb0\x9f\x46\x75\x18\xdc\x49\xe0\x4b\x63\x0d\x9a\xa0\x40\x77\x63\xcc\xee\x76\x62\x0b\x3e\x4f\x0f\x7b\xe8\x9d\x75\x78\x40\x17\x43\x9a\x1e\x64\x3d\x54\x0d\x2f\x8f\xd1\xe4\x0b\x1c\x51\x54\x26\x0d\xf7\x60\x0e\x4c\x69\xeb\x19\xdd\x31\xa7\xa1\x1e\xa5\x35\x25\xb8\xd3\x83\xa6\x9e\x1e\xf5\x11\x74\x3c\x20\xd1\x83\xf9\x30\x47\x50\x79\xb5\x09\x50\xe5\x4d\x58\x24\x92\x67\x3c\x3d\x02\x43\xb4\x1e\x45\x1b\x6e\x56\x37\x4b\xfb\xff\x0c\x96\x2f\x9a\x01\xe8\x93\x35\x79\x5d\x15\x6c\x00\xbd\x80\x6e\xa6\xc1\xf2\x14\x67\x64\x51\x79\xbe\xd0\x94\x8d\x55\x26\xa4\x2e\xf9\x40\x4a\xf4\x5e\x90\xfc\xd5\xc4\x80\x8b\x03\xd1\x55\xd3\x04\xf9\x2f\x67\xeb\xa9\x87\xe6\x82\xd1\xe5\x51\x3a\x0c\xef\xf1\xf4\x1b\xee\x62\xcf\x2e\xc1\xa5\x6b\x72\x0c\x2c\xea\xa2\xcf\xaf\x6e\xab\xf3\x67\x00\xdb\x6e\xe7\x37\x35\x0d\x2c\x1d\x79\x97\x7f\x0e\xff\x68\x95\xc3\x72\x13\x7d\x72\x5d\x3c\xe3\x29\xfa\x54\x44\x6b\x17\x3f\x78\x00\xdd\x46\xa4\x3a\x53\x9a\x1d\x12\xeb\x28\xeb\xe8\x83\x75\x74\xc6\x43\xe0\x7d\x5b\xa3\x70\x56\xa3\x00\x37\xb1\x54\x67\xb6\x65\xb6\x65\xb6\x65\xb6\x65\xb6\x7d\x10\xdb\x7a\xf4\x64\x04\x16\xd3\x3d\xc1\xb4\xcb\xb4\xcb\xb4\xcb\xb4\xcb\xb4\xfb\x20\xda\x3d\xe2\x56\xa8\x92\x6c\xac\xe1\x24\x82\x7d\x46\x23\x76\x4a\x4f\x74\x0a\x33\x30\x33\x30\x33\x30\x33\x30\x33\x70\x06\x03\xa3\xf4\x82\x22\x70\x40\x19\x74\x42\x3a\xec\x18\x18\xb4\x17\x0e\x35\x50\xd0\x87\x68\x9d\xda\xac\xf2\x74\x87\x49\x98\x49\x98\x49\x98\x49\x98\x49\xf8\x2e\x09\x3b\xac\x72\xa3\x31\x7a\xc7\x82\xb8\x78\xe8\x36\xab\x3c\x4d\x63\xca\x66\xca\x66\xca\x66\xca\x66\xca\xbe\x4b\xd9\x3e\xf8\x9b\xd5\xf2\x34\x85\x33\xe9\x32\xe9\x32\xe9\x32\xe9\x32\xe9\x66\x90\x6e\xeb\x26\xe4\x12\x15\x74\xe4\x05\x73\xe2\xd4\x23\xef\xd8\x59\x57\x43\x7a\x74\x5d\x59\x0a\x83\x47\xad\xe2\x01\x89\xd3\x3d\x5e\xa3\xf7\x50\xe1\xf4\x2e\x20\x2a\xae\xe1\x91\x51\x00\x34\xed\x04\x05\xac\x29\xd9\xa8\x33\xe0\x4f\x3c\x42\xa9\x47\x13\xb7\x75\xf0\x87\x89\xdb\x72\xf2\x6e\xed\xab\x06\xe4\xf3\xc4\x13\x7b\xf0\xfb\x89\xdb\x94\x95\xa5\x51\x74\x74\x97\x2e\xc5\x88\xd2\x29\x23\x75\x5b\xa2\x08\x50\x8d\x77\x57\xac\xcf\xb5\xad\x7c\x00\xbf\x17\x53\xfa\x37\x1b\xa4\x71\xb8\x53\x2f\x9b\x55\x42\x73\x67\xb4\x62\xa4\xec\x84\x98\xe0\x53\xeb\xf0\x17\x5b\xfd\x60\x40\x9f\x82\x92\x77\xc6\xcf\xf4\xd8\x82\xb2\xec\xe4\xbb\x53\xa8\xcb\x34\xe1\x74\x10\xaa\xc6\x2c\x0c\x6a\x87\x70\x43\x76\xe4\x68\x00\x6d\x44\xc4\x9c\xb3\xc5\x39\x5b\x9c\xb3\xc5\x39\x5b\x9c\xb3\xc5\x39\x5b\x9c\xb3\xc5\x39\x5b\x9c\xb3\xf5\xc6\x73\xb6\x64\x77\xa8\x06\xba\xd1\x05\x63\x7c\xd5\x37\xc3\xaa\x18\x07\x59\x66\xa9\x99\x87\x37\xdb\x42\x33\xa3\x17\x96\x59\x66\x16\x00\xce\xb7\xc8\xcc\x19\x0b\x4b\x2c\x31\x71\x2b\x4c\x44\x77\x66\x3f\x14\xb1\x0e\xce\x90\xd6\x0c\xab\x20\xeb\xd8\xff\xb1\x8e\x65\x65\xa6\x46\x24\x49\x47\x06\x39\x83\x01\x7d\xbf\x3f\x17\x35\x34\x8d\x32\xd5\x7d\xb0\xeb\x83\x73\x62\x0a\x14\xed\xc2\x48\xb3\xb4\x95\xa0\x69\xfa\xdb\xac\x52\x3a\x53\xdb\x6a\x62\x59\x12\xa9\x9c\xc3\xda\x1e\x50\x5c\x09\xe7\xfe\x41\x34\x73\x2b\xe3\xf7\xe0\xb0\x1c\xb7\xef\xf0\x3c\xc4\xf3\x10\xcf\x43\x3c\x0f\x7d\xd3\xf3\xd0\xab\x9d\x78\x22\xfb\x2b\x22\xcf\x8b\x9d\x38\x17\x23\x6a\xcd\x9f\x2e\x5f\xa1\x41\x07\x01\xcb\xb8\xd5\x7a\x04\x68\xbc\xeb\xd6\xd7\xfb\x92\x3b\x77\xcf\x33\xd7\x9d\x5b\x97\x79\x64\xb5\xa0\x73\x3a\x2f\x80\x0f\xd6\x41\x75\x47\xa4\xd3\x43\x10\xda\x60\x29\x58\x03\x02\x5e\x62\x9d\xa7\xc4\x31\xae\xd6\x5d\x35\xe6\x81\x8c\x76\x4e\x8f\xa1\xea\xd2\x0b\x68\x94\x18\x8e\xf5\xcb\x80\xea\xb5\x99\x24\x9a\xa3\x33\x7d\xb5\x06\x11\x47\x03\x09\x79\xb6\xe7\xd9\x9e\x67\x7b\x9e\xed\xbf\xe9\xd9\xfe\x0b\xca\x1b\xf7\xff\x30\xdf\x31\xdf\x31\xdf\x31\xdf\xbd\x21\xbe\xf3\xe0\xfb\x3c\xeb\xcd\x2a\xad\xe3\x99\xf1\x98\xf1\x98\xf1\x98\xf1\xbe\x62\xc6\xe3\x70\x39\x0e\x97\xe3\x70\x39\x0e\x97\xe3\x70\x39\x0e\x97\xe3\x70\x39\x0e\x97\xe3\x70\xb9\x37\x1e\x2e\x97\xe1\x00\x19\x67\xfb\xc9\x82\xe3\xeb\xe1\xf5\xad\xbb\x68\xf4\x89\x1b\x13\xe4\x6a\x41\xa3\xbb\x4f\x56\x1d\x21\xc8\x7d\x8e\x5b\xcc\x07\x87\x30\xb2\xc7\x8a\xe9\x2c\x1c\xbd\x50\xc6\x07\x30\x12\x45\xe3\x2c\x25\x7d\xdd\xe4\x46\x87\xcb\xc7\xb3\x96\xd2\x2b\x1c\xa7\x0f\x9c\x67\x33\x05\x9b\x29\xd8\x4c\xc1\x66\x8a\x6f\xda\x4c\x41\x24\xe7\x51\xb2\xbb\x9d\xdd\xed\xec\x6e\x67\x77\xfb\x5b\x75\xb7\x13\xcb\x05\x1f\xf9\xa6\x45\x44\xa2\x67\x90\xf8\x29\xed\x33\x80\xe8\x93\x54\x3e\x8c\xa8\x56\xac\x17\xd8\xb6\xcc\xb6\x65\xb6\x2d\xb3\x6d\x99\x6d\xcb\x6c\x5b\x66\xdb\x32\xdb\x96\xd9\xb6\xfc\xc6\x6d\xcb\xd2\x1a\xd9\x3a\x87\x46\x8e\xf4\x68\x6c\x38\x67\x65\x39\x4e\x59\xb6\xf9\x70\x31\x3e\x5c\xec\xcb\xc3\xc5\xf6\x21\x34\x64\x90\x7f\x99\x54\xd7\x51\xfc\xd7\xb3\xc9\x54\x3d\xd1\xdd\x31\x9d\xa1\x6e\x10\x7b\x30\xa5\x46\x97\x54\x8d\x07\xe4\xd0\x56\xce\xb6\x8d\xa0\x9d\xe3\x38\xa3\x47\x6b\x71\x0b\x13\x13\xc9\x0c\xa8\xe4\xbd\xeb\xe7\x10\x59\x35\x71\x48\x6c\x87\xa5\x20\x53\x02\xfa\x34\x6e\xa2\xfa\xf4\x2e\xa4\xf4\xfd\xf8\x0d\x46\x72\xa3\x68\xd9\x85\x07\x34\xc1\x8b\x06\x9d\xd8\xde\x77\x8d\xcd\xa1\x6b\x42\x3a\xd3\xdd\xd4\x0a\x3b\x8a\x73\xa1\xcc\x34\xe5\x6b\xda\x20\x48\x3c\x43\xb3\xce\x7b\xd6\x7e\xb1\xd4\x4d\x9d\x69\x63\xe3\x06\x77\x26\xde\x78\x43\xef\xe2\x8d\x2f\x35\x22\xad\x9e\x3a\x52\x39\x5a\xb4\xcb\x7d\x7f\xe0\xa0\xfd\x02\x31\x4b\x47\xaf\xd0\x1e\xa1\xf2\x03\x9c\xc3\x40\x7b\x2c\x6b\x84\x32\xa2\x84\x44\x65\xfb\x93\x50\x92\x1b\x47\x36\x3a\x4a\xcb\x05\xdf\x4b\x3e\x4d\xd5\xaf\x50\xd2\x7d\xdd\xe3\xd6\xd6\xf5\xa0\xad\xab\x05\x53\x34\x1d\xc5\x50\xda\x3b\xa7\x54\x4c\xaf\xe6\x28\x67\x74\x54\x96\xec\x27\x62\x3f\x11\xfb\x89\xd8\x4f\xf4\x4d\xfb\x89\xd8\xb1\xc2\x8e\x15\x76\xac\xb0\x63\x85\x1d\x2b\xec\x58\x61\xc7\x0a\x3b\x56\xd8\xb1\xf2\xe6\x1d\x2b\xfd\x1a\x86\xcc\x05\x1a\x0f\x38\x42\x12\x91\xd7\x94\xa5\xd8\x5b\x1f\xc6\xd7\xe3\xf1\xf2\xfd\xb7\x19\xf2\x4a\x4b\x08\x58\x59\x77\x4a\x45\x49\x36\x51\x53\xe3\xf3\x0c\xfd\x39\x1f\x21\x21\x52\x1e\x66\xa0\xa4\x4a\x50\xf9\x19\x89\x02\xa3\xe5\x8d\x15\xde\x6b\xfa\x5c\x8b\x2a\x61\x7c\x11\x11\x6b\x46\x63\x5d\x9a\x10\x3d\xba\x83\x4a\xd4\x1d\xaa\x78\xf2\x8b\xd3\x3f\xba\xd2\xb3\x96\x0f\x50\x37\xc9\x08\x64\x57\xbb\x1a\xbe\x69\x42\x27\x10\x72\x56\xa5\x97\xfe\xe8\x73\xde\xed\xbd\x4e\x29\x3c\xbe\xa9\x5e\x9f\xad\x74\xab\x05\x4c\x88\x1a\x3c\x7d\xdf\x06\xc1\xc9\x3d\x5b\x05\xd9\x2a\xc8\x56\x41\xb6\x0a\xb2\x55\xf0\x6c\x15\x84\xa6\xd1\x4a\x42\xc8\x0a\xfa\x66\xd3\x22\x9b\x16\xd9\xb4\xc8\xa6\x45\x36\x2d\xb2\x69\x91\x4d\x8b\x6c\x5a\x64\xd3\xe2\x1b\x37\x2d\x6e\x5b\xfd\xfc\x1a\xc4\x37\x84\x38\xc6\x46\x50\xe4\x9d\x12\xba\xd0\xe3\xd4\x45\x24\x6f\xb5\x79\xab\xcd\x5b\x6d\xde\x6a\x7f\xc5\x5b\x6d\xa9\x15\x9a\x20\x24\x8e\x99\xa4\x99\xe5\x98\xe5\x98\xe5\x98\xe5\xde\x02\xcb\x8d\x76\x14\x93\x1c\x93\x1c\x93\x1c\x93\xdc\x1b\x21\x39\xd1\xc0\x98\x29\x9f\x99\x8e\x99\x8e\x99\x8e\x99\xee\xdb\x66\x3a\x6b\x28\xe5\x70\xc2\x10\x1d\x91\x66\xff\x45\x4b\xb1\x47\x28\xd1\xf9\x0c\x08\xf5\x09\x45\xc0\xba\xd1\x10\xd2\x6a\x42\x99\x81\xe7\x5c\x68\x34\xb0\x1d\x33\x36\xc6\x7a\xf2\x1a\x47\xe9\x8c\xdc\xec\x5b\xa0\xc6\x6a\x25\x4f\x0f\x84\x12\xe4\xa6\x3b\x3a\x15\x1e\xd0\xd2\x87\xb4\xf2\xdc\x7f\x0f\x46\xa3\x68\x33\x65\x4a\x7c\x11\x0d\x84\x80\xce\xf8\xc4\xf6\xe2\x0e\x5a\x1d\xc4\x67\x31\x63\x59\x9f\x12\x2d\x71\xa7\x51\x06\xeb\x04\x68\x05\x69\xea\xdf\xeb\x2a\x75\x6b\x5a\xab\xf0\x45\x62\x17\xe6\x32\xe9\xd4\x8f\xa1\xec\x40\x69\x61\x8d\x68\xda\x10\x94\xa9\x2e\xc2\x3f\xfb\x35\x25\x62\x99\x08\xad\xa9\xd7\x8c\xa0\xd3\x3d\xd0\x3f\x02\x43\x78\x6c\xc0\x41\xb0\x2e\x49\xe2\xc9\x31\xbf\x54\x30\xad\x93\x29\x50\xb3\xeb\x1f\x34\x65\x12\x80\x9a\xf8\x88\x7c\xac\x68\x65\xac\x43\xf1\xaa\x27\x69\x2d\xc8\x64\xb0\x2b\xd6\x52\x65\x2e\x42\x26\xef\x9d\x43\xb7\x7b\x4e\x51\x46\xb4\x4e\xe7\x21\x65\x05\x81\xbf\x82\x9c\xe3\x8a\x53\x61\xa8\x35\x25\x8d\xd9\x81\x26\xd3\xc4\xdc\x09\x25\x99\xc0\x07\x9e\x76\xb8\x53\x2f\x49\x00\x74\xfc\x04\x7a\xf1\xb7\x77\xef\x84\x43\x48\x8e\x50\xd6\xb6\xf2\x01\xfc\xbe\x13\x48\xc6\xf7\x0d\x5e\x71\xe2\x18\x33\x2a\x93\x27\x97\x6b\x8c\x4c\x0a\x3c\x27\x0e\x9c\x44\x85\x41\xa0\xcf\x9a\x05\x2f\x60\xb7\xb3\x47\x12\x1c\x6d\xb9\x8f\xd6\x8d\xb0\x04\x6f\xbb\x79\xdb\xcd\xdb\x6e\xde\x76\x7f\xd3\xdb\xee\xf1\x98\xc8\x88\x14\x1b\xd5\xe0\xf8\x71\x84\xb1\xc2\x91\x6c\xa9\xf1\xf0\x3c\x9a\x73\xd0\x09\xfb\x51\x78\x74\x0a\xb4\xfa\x34\x16\x88\x18\xeb\x30\x87\xd2\x1a\x83\x32\xd0\x66\x03\x9d\xb3\xc9\x38\xda\x42\x29\x60\x17\xd0\x25\x09\x63\x00\x18\x6a\x13\x5b\x16\x47\x2b\x62\x8d\xa0\x2d\x54\xeb\x30\x15\xa6\x3b\x8d\xea\x19\x4f\x9e\x24\xd3\x36\x65\xea\xf4\x79\x17\x29\x79\xf3\xf0\x1a\xfd\x35\x15\xf3\x18\xc5\xf0\x74\x02\xa8\x0c\x59\xdd\x45\xeb\x8b\x00\x55\x5a\x69\xab\x35\x6d\x1a\x44\xb7\x3c\x4d\xec\x21\xdb\x76\x6b\x9b\x54\x49\x7a\xb9\xc7\x1a\xd3\x8a\x1a\x45\x19\x18\x42\x6a\xf0\x3e\xdd\xb8\x42\x19\x93\xb4\x56\xcb\x59\xeb\x75\x18\xca\x64\x63\x1c\xd0\xa9\xdd\x29\xad\x27\x86\xf2\xe9\xef\x6f\x9b\x2e\xf5\x52\x94\x56\x8a\xa3\x83\xc4\x0d\xd7\x2b\x0c\xbd\x2e\xda\x2b\xe3\x38\x59\xb9\xa8\xe0\x68\x01\xdf\xa9\x75\x2e\x08\x3d\x95\x8e\x71\xb6\x17\x71\x94\x27\x47\x79\x72\x94\x27\x47\x79\xbe\xd1\x28\xcf\x57\x9e\x1b\x17\xed\x5c\xa6\xcc\xb4\x62\x9e\x71\x7c\x5a\x2d\x66\x9c\x4f\x1d\x2d\x2c\x32\x0c\x6b\x94\xa0\x21\x1a\x70\x1e\xfb\x6d\x40\xf2\xda\xae\x07\x72\x28\x55\xf2\x82\x60\xd6\x04\x3e\x5a\xba\x35\xb4\xa9\x39\xa0\xeb\xfc\x38\x43\x63\x4e\x4d\x62\xc7\xb4\x3e\x71\x85\xdc\x06\x99\xb3\xbc\x1d\xce\x00\x41\x31\x04\xbf\xcc\x58\x60\x4d\x80\x75\xab\xbb\x2b\xbb\x62\x97\x26\x1a\xc0\x85\x54\xff\xd4\x51\x85\xbd\x08\x0e\x8c\xa7\x33\x3f\xd0\xd1\x31\xc0\x89\x48\xe4\x30\x15\xb4\x14\x89\x9e\x78\x32\x22\xeb\x09\x8a\xb8\xbf\xfa\x99\x9e\x00\xe8\x2b\x03\x64\x10\x10\xbe\xdd\x45\xac\xc3\xe3\xad\x82\xa6\x89\xb8\x96\xc6\xcb\x72\x7e\x37\xe7\x77\x73\x7e\x37\xe7\x77\x73\x7e\x37\xe7\x77\x73\x7e\x37\xe7\x77\x73\x7e\xf7\x1b\xcf\xef\x9e\xda\xb6\xf1\x57\xb1\xf8\xab\x58\x5f\x7e\x15\x2b\xdd\x61\x39\x6f\x63\x33\x5a\xde\x9f\x6a\xad\xcc\xb3\x88\x55\x60\x4c\xd3\xc6\x8d\x4b\xeb\xae\x6e\xab\x05\x82\xd8\x29\x1d\xee\x06\x39\xab\x80\xf5\xdd\xe1\x12\x1b\x4c\xd2\x1a\x39\x36\x10\xe7\x0c\xc5\x0e\x21\x28\xd3\xda\xd6\x0b\xf2\x0b\x0b\x87\x15\xbe\x4c\x2c\xfc\xa3\x02\x5f\xb6\x58\x9f\x37\xf3\xd3\x49\x71\xd8\x90\xb5\xa7\x5b\x38\x4d\x8e\xf7\x58\x87\xde\x45\xac\x31\x00\x85\x0e\x67\xb7\x7b\x56\xd5\x22\x18\x75\xab\x83\xea\x3a\x03\x4d\xf9\xa8\x0e\xb9\x80\x76\x66\x94\x47\xc1\x9a\x4e\x6b\x7c\x1c\x27\xd6\xc1\x8b\xfa\x36\x52\xa9\x33\x56\xc4\xd8\x3f\x13\x2d\x12\x28\xb7\x04\x69\x08\xc0\x2f\x69\x03\x10\x4e\x0f\x69\xeb\xb0\xd6\x14\x1a\xb6\xa8\xb3\xd1\x28\x52\x7e\xa7\x9c\x0f\xb1\x60\xd2\x25\x23\x8d\x40\x1f\x3c\xd0\x22\xb3\x0d\x19\x48\x4a\x9b\xc5\x8b\x25\x8a\xd2\x06\x61\xd0\x07\x2c\xf3\x65\x30\xc0\x3d\x4a\x97\xe6\xb4\xbf\x6d\x72\xda\x4f\xc7\x0b\x83\x9b\xfa\x7c\x5e\x64\xea\x5a\xd4\x9e\xcb\x63\xe0\x1c\x8c\xbb\x69\x3a\x63\xc8\x43\xc6\x0d\xed\x49\xfb\xac\x93\x07\x90\xd7\x30\xb0\xbf\x2e\x59\x1d\x95\x29\xed\x31\xb7\x71\x33\x34\x2d\xa0\x0c\x3f\x45\x92\x03\xe6\x29\x9d\x06\x53\xb5\x50\xe1\x57\x24\x45\xd2\x93\xed\x69\xd4\x4b\x36\x5f\x90\x3d\xd4\x83\x66\xcb\x61\xe7\xb3\x59\x65\xca\xe0\xb2\x2a\x58\xba\x60\x8b\x00\x0f\x41\x64\x14\x9a\x32\x15\xb3\xbe\x00\x71\xea\x03\x82\x8f\xe3\x4d\x34\x7b\x30\x12\xdf\x7f\x9f\xa5\xc7\x74\x2e\x78\xf7\xd9\xc4\xaf\x48\x91\xb7\x08\x0e\x9d\x08\xf6\x19\xcd\x44\x90\xcd\x82\xf7\x4e\x1e\xc9\x36\x5f\x58\x33\x03\x77\x96\x01\x2e\x0b\xb0\x58\x8e\x3d\x3b\xd8\x62\x81\x3c\xaf\xaf\x71\x67\xf2\x03\xc0\xe7\x07\x61\x2c\x59\xe2\xcc\xd9\x33\xa7\x84\x65\xcc\x1c\xb9\x09\x8f\x46\xf7\x07\x8b\xa4\x3b\x23\x30\x68\x8e\x9e\xfd\x8f\xbd\xab\x59\x8e\x5d\xc5\xc1\xfb\x3c\x45\xbf\x40\x36\xb3\x9a\xca\xf6\x6e\x67\x31\x6f\x40\x11\xac\x76\x33\x71\x83\x07\x70\x3a\xfd\xf6\xb7\xc0\xee\x24\xe7\x56\x8c\xc4\x4f\x4e\x52\x39\xaa\x73\x76\xed\x7c\x08\x21\x04\x12\xfa\x61\x19\x66\x19\x26\xcb\x30\xe9\x33\x25\xd5\x29\xe6\xe1\x1e\xe3\x0b\x47\xfb\xdd\xe3\x17\x38\xf1\x2c\x9d\xce\xc4\x41\x94\x03\xe7\x5d\xdf\x65\x58\x21\x4c\x1d\xa0\xb0\xba\x7c\x7c\xd4\xf1\x51\xc7\x47\x1d\x1f\x75\x7c\xd4\x7d\xfd\x51\x87\x54\x17\x64\x55\xcd\xaa\x9a\x55\x35\xab\x6a\x56\xd5\x5f\xaf\xaa\x63\x4d\x99\xe8\x28\xdb\x22\xbc\xbf\x91\xab\x2c\x3e\x50\x21\xa1\x57\xc4\xf1\xf4\xda\x35\xcb\xcf\x52\x41\x26\x04\xe1\x2b\x26\xa9\x8d\x98\xed\xf0\xcd\x88\x7a\x5a\x1e\xc1\x19\x08\xe0\xf7\x6b\xea\x14\x0c\xba\x7a\x4f\xc4\xa0\x3b\xbc\xdd\xfa\xe9\xf5\x11\x53\x9d\xa4\x26\xd8\xbb\xd8\xb6\x5e\x53\x58\xf7\xfb\x60\xd2\xb1\xd0\x0d\x37\x82\xd5\x4d\xcf\x81\x5b\xe9\x29\x31\xe9\x47\x27\xdd\x15\x27\x18\x61\x67\x22\x48\xfc\x2b\x56\xd7\x91\xf9\xf8\xd7\x22\xc0\xce\x70\x93\xb5\x4f\xcb\x8c\xbe\x81\x92\x10\xd1\x4c\x04\xd2\x5e\x93\xc3\xa0\xd7\x23\xe6\xbf\xe4\x33\x95\x44\x1e\x49\x8c\xe8\x1b\xd9\x3f\xe9\x59\x44\x62\xcd\x28\xcc\x32\x4d\x5b\x22\xc6\xe7\x0b\xba\x83\x26\x39\x97\x66\x68\x5c\x21\xca\x28\xdb\x5b\xd3\x4b\xaa\xdb\x85\x7d\x46\x1a\xf5\xfb\xdd\xb3\xb2\x35\xc4\x9a\xf1\x3f\xe3\x22\x74\x7f\xa3\x99\xf0\x2d\x71\xab\xd0\x37\xcc\x6d\x5a\x58\x54\x16\x4b\xc4\x9f\x24\x11\x44\x50\x0a\x1c\x41\xdb\x10\xa4\x8a\x2e\x4f\x24\x49\x2a\x58\x63\xb2\xf4\x90\x31\x69\x12\x83\xcb\x0a\x4d\x4a\x3a\x2e\xa5\x75\xbf\x6d\x15\x09\x52\x43\x1e\x95\x35\xd2\x0f\xd0\x48\x7c\x46\xf1\x19\xf5\x69\x67\x14\x2e\x5a\x04\xa1\xa2\x8b\x13\x49\x90\x0a\x96\x98\x2c\x3c\x64\x4c\x9a\xc0\xe0\xa2\x42\x13\x92\x6e\x2b\x89\x02\xa5\xba\x1d\xbb\xa7\x18\x65\x05\x21\xa6\xc9\x69\x93\xaa\x4e\x6c\xc6\xa5\x08\x36\x57\x11\x90\x6e\x65\x1e\x52\xca\x94\x48\xae\x73\x71\xd4\x30\x0d\x38\x22\xb2\x98\xda\x44\x5e\x44\x4f\x42\xaf\x78\xc8\x08\x85\xf9\xb2\x49\x40\x69\x2d\x1e\xee\xda\x37\xd4\x00\x29\x79\x31\x97\x82\x5c\x40\xd6\x2f\x88\xf9\x8a\xd4\x15\xc8\xe0\x83\x3e\xc7\x22\x3a\x2a\x56\x16\x34\x41\xc0\x33\x98\x40\x83\xc7\x84\x27\xfe\x83\x97\x54\xe4\x41\xdb\x7e\x24\xe7\x92\x2e\xab\xe1\xd6\x6c\x8f\x7c\x2a\x66\x21\x70\x4a\xac\xba\x55\xf8\xe9\xc7\xd1\x94\xe0\x22\xfa\xcb\xd8\x64\x95\x9c\x52\xba\x4b\x3f\x5a\x5f\xc3\xaa\x1f\xee\xba\xdc\x91\xc8\x93\xa1\x28\xe7\xed\x19\x2c\x3a\xe5\xe0\x3c\x87\x6b\x6c\x97\xa1\xcd\xf8\x90\xfd\xbc\x64\xf6\xc9\xdf\xb7\x2a\xcf\xde\xdb\x76\xc3\xf3\x9d\xf8\x4a\xd5\x6c\x2d\x3a\xa3\x94\x7b\xa5\xfa\xa3\x90\x83\x25\xba\xa4\x0a\xba\x68\xfb\xd7\x31\x87\xbe\x65\xeb\xf0\x8b\xf7\x46\xc3\x30\x45\xfb\xa4\x6a\x41\x22\x9f\xb2\x85\xd6\x7a\xe0\x17\xae\x75\x19\x38\xf6\x14\xdb\x84\x9e\xaf\xdb\xd0\x02\xfe\x69\x54\xef\xd7\x86\x6b\x44\xce\x56\xd5\xa8\x17\x75\xa2\x5d\x51\x72\x7c\x15\x08\x35\x99\x07\x54\x41\x2e\x03\xa4\x88\x41\x11\x22\x45\x60\xe9\x80\x5d\xa9\xa3\x08\x26\x19\x8d\x20\x8c\x54\x31\x24\x09\x60\xb2\x83\xf6\x5a\xd0\x91\xaf\x15\xf4\x2b\x05\xf9\x2a\x5b\xc0\xb3\x0a\x93\xa9\x08\xbd\xf6\x0a\x54\xa2\x2f\x4a\xae\x3e\x05\xa4\x53\x4f\xc0\x62\x48\xba\x09\x55\x04\x5e\x78\x8f\x2a\xe1\x70\xa1\x29\x55\x44\x76\xc9\xdd\xac\x84\x66\xb2\x49\x45\xbe\xfc\x17\x4d\x8b\x7e\x32\x55\x5d\x1f\x4b\x38\x51\x73\x6d\x2c\x98\xe9\x86\xe9\x3b\xf2\xb9\xcc\xcc\x6a\x33\xb4\xca\x78\x59\xae\x71\x8a\xf9\x59\xa6\x7d\x2a\xe1\x2b\x8c\xae\x1a\x46\x95\x1a\x5e\x35\x63\x54\x1a\x5f\xd5\x43\x55\x18\x60\x15\x0b\x54\x6c\x84\xd5\x8e\x51\xbc\xfe\xa5\x03\x50\x6e\x8c\x4d\x23\xd0\x0d\xb2\x9a\x01\x3e\x95\x7a\xba\x61\x56\x81\x4e\x36\xce\xca\xb7\x42\x81\x81\x56\x72\x10\x16\x09\x7d\x01\x3f\xe8\x82\x5e\x0a\x4a\x13\x8f\x42\x54\x9a\x40\x97\x80\x76\xa7\x92\x26\xb8\x05\x88\x24\x61\xa5\x8b\x29\x51\x40\x29\xa2\xf9\xd6\xde\x2a\x85\xe6\x53\xdf\xf7\x30\x2a\x1d\xcc\x53\x0c\xf2\xbf\x3d\x49\xfa\xd8\xf7\xca\x28\xe8\x81\xec\xc1\x3d\x83\xa0\x95\xcd\xa2\xa2\x61\x87\x38\x05\x0d\x5d\x95\xd9\xd9\x33\x84\x13\x2c\xbb\xc2\x45\xb9\x1a\x26\x73\x25\xf3\x7b\x4d\x54\x34\x51\x94\x49\x72\x77\x86\xe0\xb4\xca\x0e\x48\xb8\x2a\xd3\x2f\xc9\x8f\x8b\x7a\x82\xbd\x3e\xd2\x15\x93\x8c\xff\x07\xf0\xaa\x2b\x60\x6f\xf5\x8c\x0b\x41\xad\x28\x14\x93\x42\x14\x8b\x92\x74\xb3\xaf\x53\xfe\xd4\x08\x93\x28\x20\xc8\x27\x71\xaa\xc8\x27\x71\x9e\x77\x1d\x38\x8b\x2b\x7a\x14\x68\x8b\x19\x39\xdb\x41\x1f\x35\xb8\x16\x05\xa5\x4e\xd2\x09\x30\xca\x0e\x88\xb9\x42\x5a\x95\xd9\xc5\x22\x81\x5b\x41\x5e\x4e\x3b\x29\x4a\x3b\x79\x3b\xdc\x7d\x07\xce\xa5\x13\xbd\x95\x75\x74\xbd\xfe\x49\x8e\xcf\xde\x9a\x78\xe3\xcb\x17\xe8\xa0\x37\x06\x35\x87\xc3\x6d\x93\xf8\x5d\x72\x79\x39\xe9\x00\x93\xf6\xa1\x87\x68\x52\x55\x5b\xea\x76\x14\x1d\x4f\x6d\xda\x4d\x2e\xc1\xa6\x6c\x58\x25\x7d\x68\xbd\x32\xc6\x0a\x7b\xa9\xcd\x95\x5b\x1e\xaf\xed\x60\xc9\xef\xc5\xe9\x79\xc5\xe9\x79\x7d\xf5\xa4\x81\x4b\xa7\xfc\xbe\x1b\x1a\xc5\xc2\xef\xb3\x53\x06\xa9\x42\xd3\xee\x40\x1a\xe0\x90\xe8\x3c\x1c\xd4\xe2\x83\x3d\xdf\xdc\x90\xad\x62\x48\x1a\x92\x26\x29\x98\x88\xfc\x5e\x6a\xbe\x1f\x7f\xb6\x83\xe4\x8c\x3c\x50\x90\x46\xf4\x72\xca\x82\x70\xdd\x1a\xae\x5b\xc3\x75\x6b\xb8\x6e\x0d\xd7\xad\xf9\xb2\xba\x35\xe8\x27\x5e\x9e\xe7\xfd\x9a\xc8\x14\x81\x4a\xb9\x27\x88\x24\x91\x16\xb8\x5f\xf7\x95\x19\x9c\x02\x13\x48\x15\xc7\x31\x2c\x17\xb3\x2e\x7a\x4c\xcf\xed\xf7\x2e\xae\xbd\x8c\xd3\x66\x40\x90\x02\xc2\x27\x3e\x0c\x99\x1e\x71\x14\x41\xb1\x4b\x98\x97\x80\xbe\x59\x11\x98\x89\x13\xbb\x9c\xed\x64\x47\xad\x5a\xe8\x55\x76\x9a\x40\x05\xeb\x5e\x1f\x5f\x1a\xc9\x7e\x0f\xd9\xc7\x5b\xb6\xa5\x3b\x8b\xd8\x15\x49\x6a\x03\x6e\xed\x93\xd3\x0d\xf7\x28\x95\x9e\x74\xb8\x76\x86\x3d\x59\x1f\x3a\x43\xbe\x95\xad\xea\x8b\x1b\x6b\x4e\x75\x46\x74\xda\xba\xfe\x3c\x5d\x8c\xee\xc5\xd3\xc9\x8e\x84\x67\x70\x12\x94\xb7\x8b\x53\x20\x94\x0c\x30\x5a\x77\xed\x8d\xd7\x6f\x67\xfe\x13\xb8\x57\x17\x88\x7f\xc0\x6e\xe6\x97\x18\xa4\x3f\xf5\x02\x8f\xbb\xa9\x27\x56\x77\xa6\xf6\xc6\xea\x47\x60\x70\x52\x69\x33\x0a\x69\x8c\x0d\xa9\x2c\x78\xaf\x85\xbf\x21\xbf\x69\xe6\xae\x04\x53\xb7\x27\x76\xe5\xbd\xe1\x75\x91\xa1\x1b\x58\x7a\xfb\xec\xcd\xc8\x57\x05\xdf\x0d\x71\xb6\x43\x4f\x2c\xa1\x87\xcf\xbe\xd6\xc4\xf6\x34\x26\xae\xfc\xa4\x1b\xd3\xd1\xbb\xa8\x77\x9c\xde\x93\xb3\x21\xb4\x99\x17\xa9\x2f\x8d\x58\x63\x08\xd6\xe6\xd6\x38\xd5\xd8\x8d\xf8\x17\xcc\x19\x9c\xb6\x83\xf0\xbd\x60\x07\x67\x67\x31\xd9\x91\x00\x88\xed\xce\x95\xce\x1e\xc6\xc7\x8a\x14\x83\x69\x82\x88\x86\x48\xbf\xe9\x5e\xa4\x33\x71\x07\xa4\x16\xff\xed\xb0\xb8\x4c\x39\xa9\xe0\x2f\x6b\x02\xbc\x84\x16\xb9\xda\xce\x93\xef\xe5\x2f\xf6\xb3\x34\x42\x0f\x5d\x96\xfc\x1d\xd6\x37\x9a\x61\x54\x98\xb1\x7b\x97\x1c\x7d\x97\x59\xae\x78\x7a\xe8\x0e\xf6\x6d\x78\x86\xec\x88\xec\xcf\xfb\xe8\x47\xeb\x2e\xf2\xa3\x07\xb0\xfc\xde\x91\xea\x49\x38\xf0\xb3\x35\x1e\xf2\x7d\xe3\xb1\xcd\xfe\xb8\x1c\x8f\x7b\x67\x18\xbe\x7f\xd5\x69\x31\x4f\xe2\x18\x63\xef\xfb\x74\xc2\x5f\x01\xd3\xf9\xb2\xbd\x0d\x7a\x0c\x2f\xaf\xc8\xde\x03\xe6\x7b\xc4\x50\xa8\xb3\xe7\x14\x4a\xd0\x04\x92\x34\x34\x0c\x42\xd9\x73\x24\x2a\xbb\x7a\x54\x48\xed\xd3\xeb\xf8\x3a\xd9\x58\x74\x77\x99\x31\xc0\xfc\xa1\xb7\xf6\xf5\x93\x41\xf8\xd3\x12\x06\x7b\x31\x3d\xe0\x70\xcf\x22\x61\xae\x2b\x65\x67\x3b\xb4\x2d\xe5\x0a\x13\x85\x56\x0e\xe2\x71\x89\xbd\x6b\x3b\x92\xb7\xe1\xa6\xbe\xa3\x6d\xf2\xfb\x0b\x5e\x17\x0a\xed\x33\xb8\xe3\x64\x2f\x02\x7b\x83\x26\x60\xe5\xcb\x80\x13\x00\xfe\xbf\xc0\x02\xdb\xf6\x9c\xc0\x8c\xe1\xd4\xc6\xae\x84\x37\xac\x1b\xc1\x17\x6c\xfb\x3c\xaa\x83\xe0\xae\x02\x5e\x66\x6b\x62\x0f\x66\x39\xa5\x3d\x66\x8f\x47\x91\xaf\x1a\x4d\x60\xc0\x0a\x7d\xb4\x0e\x9e\xc1\x61\x48\xf9\x4d\xb6\x42\xc5\x76\xa1\x5d\xe4\xe4\x0d\x2e\xaa\xa8\x46\x35\xbc\x82\x39\x69\x06\x7b\x26\x2c\x07\x65\xa2\x1e\x94\x35\x83\x74\xd7\x4e\x47\xcf\x8a\xda\x43\x1d\x6f\x48\x59\x07\x3b\x19\xe6\x22\x75\x68\x82\x09\x72\xf4\x6d\x00\xfa\x0c\xd9\x6b\x1d\x1d\x43\xc4\xa6\xde\xd9\x24\x0f\xca\xfa\xdf\xc0\xda\x59\xb3\x01\xe5\x53\x6d\x28\x40\x36\xc8\xa9\x40\xdd\xe4\xc1\xda\x04\x07\xb9\xa8\x2a\x6b\x0c\x28\xe4\xde\x81\x6d\xe9\xc1\x78\xe1\xec\x62\x06\xe1\xec\xe3\x5e\x53\x03\x6c\x29\xe1\x65\xd6\x0e\x44\xc4\x4a\x7d\xf7\xea\x48\x39\x49\x37\xb4\x4d\xe6\x04\xd2\x85\x47\x90\xd8\x0d\x80\x8e\xb3\xbf\x82\xc8\xea\xe9\xd1\xc4\xfe\x26\x06\xc2\xc5\xba\xa7\xb5\x2c\xa1\x4f\xd7\xb0\x20\x5d\x58\xe6\x3a\x46\xc7\x70\x47\x39\xe9\x67\x68\xfc\xf3\x36\x36\xcf\x27\x2d\x8e\x52\x4f\x4b\x5c\xf3\xd4\xfd\xdb\xba\x3a\x82\x22\x12\xa2\xf3\x31\x62\xa2\x6d\xf1\x0c\x2e\xa3\x42\x70\x84\x14\x9b\x20\xde\x9b\x62\x75\xd3\xf1\xa0\x96\xf8\x12\x55\x6b\x84\xc9\x29\xdd\xe6\x8c\x35\xd7\xb3\x5d\xbc\x58\x5d\x2a\x7b\x5f\xe3\xf4\xc4\x7f\x1e\xa6\x63\xf2\x46\xe7\xdd\xe5\xa8\x32\x3a\x1c\xfc\x49\x3a\x40\xfc\x02\x04\x98\xc5\x83\x13\x72\x09\xa7\x96\x79\xe5\xe3\x49\xee\x7f\x9d\xf5\xde\x37\xaf\xf3\xa9\xd1\xbe\x1e\x4c\xa3\xb6\x4a\x79\x78\x7b\xf5\x66\xb2\x0e\x11\x5c\x92\x0e\x87\x2e\x0f\x10\x5d\x9e\x58\x66\xe9\xfd\x05\x89\x0d\xe6\xc8\x46\x8e\x6c\xe4\xc8\x46\x8e\x6c\xe4\xc8\xc6\x2f\x8b\x6c\x3c\x1c\x66\x9b\xef\x70\x8d\x1d\x68\xf4\x6b\x02\x2b\x7c\x56\xf8\xac\xf0\x59\xe1\xb3\xc2\xff\x52\x85\xef\x83\x34\x43\x8f\x34\xcc\x68\xd3\x61\x8b\xca\x1a\x9f\x35\x3e\x6b\x7c\xd6\xf8\xac\xf1\xbf\x50\xe3\x5f\x40\x8f\xa7\xe6\x4b\x3e\xc6\x90\xfb\xe4\x7d\xba\xab\xa4\x33\x1f\xc1\x13\x26\x2f\x56\x3f\x69\xf2\xf1\x79\x3d\x9a\x18\x82\x01\x7b\xa6\x0b\xb6\xd8\x11\x4f\x81\x0b\x31\xc2\x50\x2b\x39\x09\x1f\x92\xe3\x7e\x57\x60\x11\xf1\x7c\xc5\xdb\x7f\x51\xc7\x37\x26\xe1\x0c\xa4\xed\x6e\xba\xce\xa0\xe1\x91\xf5\x44\xc1\x26\xa6\xe9\x86\x02\x40\xba\x3e\xa0\x6b\x02\x9a\x0e\xc0\x77\x3f\x69\x97\x12\x3e\x42\xce\x2b\x02\xb7\x08\x67\x14\xcb\xd8\x1f\x2c\x63\xc8\x07\xaf\x7a\x2e\x9c\x96\xf3\xe3\xec\xf4\x5e\x7c\x14\x55\x5f\xc6\x80\x02\x88\xe1\x2e\xb3\xd3\x1e\x56\x35\xfc\x70\x57\xc3\xd1\x44\x9a\x9e\x4f\xe0\x7c\x3d\x49\x93\x06\x13\x58\x93\xb3\x26\x67\x4d\xce\x9a\xfc\xe7\x6b\xf2\x55\xdd\xcd\x4e\x3f\x6f\xe9\xf9\x22\x3e\xdb\xce\x27\xb7\x1b\x16\xc9\xba\x8f\x75\x1f\xeb\x3e\xd6\x7d\x3f\x53\xf7\xf1\x8d\x8f\x6f\x7c\x7c\xe3\xe3\x1b\xdf\x8f\xbd\xf1\x69\x93\xa2\x55\x21\x93\x80\x85\xcd\x3e\xda\xd9\xcf\xe0\xf4\xf1\x8a\x04\x98\x12\x81\xf6\x2b\x1f\x23\x8b\xbb\x11\xb1\x05\xc4\xc7\xa2\x13\x6d\x81\xd6\xfb\x8b\x19\x23\x4b\x53\xd4\xe6\x5d\x01\xcb\xc7\x8f\x7a\x03\xe4\xf7\x93\x54\x53\x15\x27\x52\xad\x5e\xe5\x20\x1e\x64\x6b\x3a\x7e\xcd\xfc\x0f\x07\xfc\x6f\x77\x49\xe0\x3c\x54\xce\x43\xe5\x3c\x54\xce\x43\xe5\x3c\x54\xce\x43\xe5\x3c\x54\xce\x43\xe5\x3c\xd4\x9f\x9e\x87\xba\xfa\x4f\xa2\x8c\xee\x5e\xec\xb0\x1d\xbd\x61\x64\xf7\x0a\x8a\xe1\x60\x58\x95\xa9\x17\xff\xf3\xd6\xd4\x5e\x41\xd9\x81\xc3\x0e\x1c\x76\xe0\xb0\x03\xe7\x1b\x3b\x70\xc0\x28\x77\x4d\x11\x28\xfb\x79\x36\x08\x3f\x73\x05\x0b\x71\x51\x91\xc3\x20\x0c\x5c\xf2\xfd\xd8\x29\xcc\x3f\x83\xf7\x72\x44\x0a\x82\x37\x1f\x71\x60\x96\xcc\x4e\xbb\x3f\xd8\x25\x88\xa3\x9e\xf6\x5b\xd3\xdd\x1f\xe2\x89\x92\xf9\x79\x0a\xfe\x39\xf3\xb3\xca\xfe\x7a\xf6\xe3\x2c\xd5\x53\xe6\x8b\x58\x13\x3e\xf3\xb3\xd7\x66\x9c\xb6\x8e\x71\xf5\x5c\x44\x84\xee\x04\x2f\xdb\x7d\x3b\x6b\x58\x61\xc7\xf4\x13\x5c\x23\xab\x1f\xee\x2a\x68\x5c\x69\x8b\xc2\x92\xad\xa7\x4c\x43\x39\x43\x90\xfb\x3d\x55\x9b\x13\xb0\xb3\x12\x8d\xd2\x48\x3a\x68\x48\x28\x98\x62\xcb\xa9\xb4\xfb\x43\x4e\xa4\x10\x79\xc1\x22\x79\xa3\xdb\xe0\xe2\x74\xc8\x8a\xc2\xbe\xf6\xd8\xf7\x14\x20\x4c\x99\x9d\x8d\x14\x57\xfd\x6d\x8c\x4a\x8b\xea\x4a\x4d\xd2\xfb\x6a\x04\x10\xb2\xee\x8f\x53\x47\xb7\x5c\x9b\xc9\x3c\xd3\xf6\x45\xe1\x7e\xf3\xf8\x7e\xf0\xc3\xc6\xae\xbb\x82\xd5\x1f\x61\xfa\xe0\x32\x92\xdf\x34\xec\x36\x66\xb7\x31\xbb\x8d\xd9\x6d\xcc\x6e\x63\x76\x1b\xb3\xdb\x98\xdd\xc6\xec\x36\xfe\xe1\x6e\xe3\xfd\x02\x55\x08\xf2\x7e\x99\x12\x4c\x01\xcc\xce\x06\xab\xec\x54\x35\x6c\x98\x76\xa4\x17\x93\x94\x18\xdd\xb2\x7a\xac\x76\x00\x4a\xfa\x94\x21\x44\x22\x5c\xcf\x19\x00\x1f\xa6\x6c\xde\xa7\xa2\x30\x77\x05\x83\x8c\xd6\x8e\x13\xfc\x35\xd9\x65\xf8\x8f\x1d\xc7\x0f\x2d\x15\xb6\x04\xd8\x12\x60\x4b\x80\x2d\x01\xb6\x04\xd8\x12\x60\x4b\x80\x2d\x01\xb6\x04\xfe\x68\x4b\xe0\x5d\xe0\x46\xed\xad\x8f\x63\x36\x38\x66\x83\x63\x36\x38\x66\xe3\x1b\xc7\x6c\xac\x95\xf2\x33\x91\x69\xd8\xbc\x9f\xfe\xed\x85\x9a\x16\x1f\xc0\x89\xc9\x2a\xb9\x7f\x55\x46\x96\xe5\x3d\xd0\xfe\x3a\x23\x20\x6b\x4f\xd2\xb3\x9c\xbf\xda\xa7\xb1\x91\xe2\xbf\x9e\x8e\x59\xba\x74\x13\xf7\x8b\x52\xbb\x26\x39\xb6\xca\xdb\x73\xeb\x6e\xf3\x53\x84\x48\x1f\xbb\x0f\xc5\x66\xd8\xbb\x0a\x81\x0a\xf0\x2d\x96\x36\x16\x85\x19\xdd\xac\xea\x78\x19\xff\xfa\x16\xe5\x21\x62\xe2\x96\x56\x50\x83\x94\x21\xf2\x14\xc2\x07\x6c\xca\xeb\xed\xfd\xe6\x07\xb8\xbe\xc7\x8b\xe9\xe3\x18\xc4\x0b\x13\x1d\xac\xec\x50\x2b\xc3\x25\x1f\x6e\x24\x71\xab\x39\xe4\x2a\x80\xe9\x87\x1d\x2e\x7c\x75\x87\x1e\xed\xe0\x43\x84\xbb\xea\x43\x34\x66\x89\xcc\x4d\xc2\x85\x8b\x65\x94\x65\xb4\x58\x46\x09\x1f\xe1\x05\x8d\x59\xcd\xb2\x9a\x65\x35\xcb\x6a\x96\xd5\x6c\xb5\x9a\xcd\x93\x7f\xff\x7a\xd7\xdd\xf9\xf9\xa6\xa3\xef\x2a\x06\xe7\x37\x66\x7e\x63\xe6\x37\x66\x7e\x63\xe6\x37\x66\x7e\x63\xe6\x37\x66\x7e\x63\xe6\x37\xe6\x9f\xfe\xc6\x6c\x4d\x88\x55\x0a\xf6\x47\x41\x46\x00\x33\xcc\xb6\xb6\xc8\x79\x6a\xff\xfc\xda\x5c\x58\x48\x2f\x16\xb3\x35\x2e\x96\x8f\xf9\x5c\xc8\x7d\x99\xe0\x0c\x5e\xce\xe0\x2d\xcd\xe0\x95\x03\xb8\x6f\xf0\x52\x16\x5f\x4d\xc4\x19\xc2\xc9\xee\x1c\x5e\xc8\x00\x71\x29\x45\xca\x2b\x7d\xb8\xab\x91\x5b\x3b\x83\xc9\x9f\x79\xd8\xe9\x3e\x3b\xfb\x72\xad\xa2\x3d\x5d\x69\x9b\xc6\x4e\x07\x6d\xd4\x1b\x6f\x1a\x45\xd9\x01\x7c\x45\x22\x33\x36\x14\x96\xc3\xeb\xfd\xd4\xc6\xc7\x18\x1c\xaf\x24\xf7\x59\xe0\x3e\x0b\xdc\x67\x81\xfb\x2c\xfc\xfc\x3e\x0b\xdc\x96\x86\xdb\xd2\x70\x5b\x1a\x6e\x4b\xc3\x6d\x69\x28\x6d\x69\xb8\x1f\x0d\xf7\xa3\xf9\xc3\xfa\xd1\xfc\xcd\xde\x19\x25\xb9\x8d\x02\x61\xf8\xdd\xa7\xf0\x05\xe6\x02\x3e\xc4\xd6\xd6\x66\xdf\x29\x2c\xb5\x65\xca\x48\xa8\x00\xcd\x64\x72\xfa\x2d\x64\x39\xf1\x64\x0d\x34\x68\x52\xb1\x9d\xbf\xfc\x28\xa9\x8d\x9a\xe6\x83\x86\x56\x37\xa0\x07\xe8\x5d\x43\x0f\x6b\x3c\xac\xf1\xb0\xc6\xc3\x1a\xef\x69\xd7\x78\x4b\xf1\x96\x78\x68\x43\x46\xa1\xeb\x8a\xc7\xc4\xd5\xf5\xf2\xfd\xc8\x67\x53\xf0\x56\x27\x79\x38\xdd\xc8\x28\x99\x36\x5a\xd9\x9c\xd6\xed\xa2\xee\xad\x39\xd5\x6e\x2b\x20\x14\x0a\xa1\x50\x08\x85\x42\x28\x14\x42\xa1\x10\x0a\x85\x50\x28\x84\x42\x21\x14\xea\xd9\x43\xa1\xce\x67\x48\x95\x1f\x18\x5f\x56\x40\xa1\xf6\x40\x88\x3a\x68\xaa\xa4\xb4\x74\x90\x93\xf6\x22\x1b\x3c\xc4\x94\x33\x7f\x7a\xbd\xaa\x1e\xc2\x45\x92\x37\xa3\xaa\x7c\x27\xe5\x1a\x69\x5b\x31\xbb\x00\xa2\x25\xad\x5e\xc9\xbe\x8b\x83\x54\x3a\xe6\x8e\xe5\x6c\x9d\xbe\x36\x7a\x6a\xe9\xfc\x7a\xf9\x97\xcb\x0b\x9a\xdf\xae\x5e\x0c\x42\xce\x10\x72\x56\x16\x72\xd6\x91\x5f\x06\xc4\x82\x1d\x6d\xaa\x52\xd7\xdf\x53\xf0\xda\xb9\x21\xe2\x60\x4d\xbf\xb8\xa8\xbf\xbf\x51\xaa\xa5\x7e\x34\x21\xc4\xb5\x4e\xbb\xe7\x3e\x92\x5d\x37\x2f\x1f\xf7\xef\x3e\xd6\xd4\xdc\x5a\xef\xa3\xa0\x65\xa4\x56\xca\x0a\x12\x1c\x0d\xed\xba\xda\x62\x57\xb4\xc8\x91\x2f\xaa\xff\xf5\xf3\xcb\x07\x09\x2b\xa4\xa4\xd2\x3f\xe0\x4c\x00\x67\x02\x38\x13\xc0\x99\xc0\x43\x9f\x09\x5c\x1a\x2b\x64\x73\xaa\x24\xbe\x93\x4e\x8b\xb0\xcb\x25\x9c\x8b\x28\x32\xa7\x3c\xd7\x1c\xa9\x97\xc2\x52\xa7\x9c\xb7\x11\x9b\xc9\x9b\x5d\x23\x45\xbc\xd8\x15\xdf\x72\x19\xd8\xe6\x0b\x2b\x1b\x5a\x65\x72\xd9\x43\xac\x70\x54\xf0\x87\x5a\x85\x60\xfe\x90\xe3\x58\xce\x6d\x6b\xce\xcb\xce\x0f\x3f\xc6\xe0\x29\xbe\x31\x83\xfb\x02\x6d\x32\xb0\x0f\x1b\x85\x8d\x16\xdb\x28\xe3\xa6\x46\x36\x47\x12\xde\x27\xb4\xc3\x50\xf7\x1c\x54\x0d\x5a\x83\xd6\xa0\x35\x68\x0d\x5a\xff\x32\x5a\xb7\xe4\x1a\xab\x46\x6f\xac\x70\x04\xe2\x82\xb8\x20\x2e\x88\x0b\xe2\xfe\x42\xe2\xd2\x90\xaa\x19\xcc\x39\xeb\x92\xaf\xd6\x24\x2e\xcf\x85\xd5\xf6\xd3\x61\xb3\xa2\x37\xc3\xb6\x38\xe6\x02\xcc\x05\x98\x0b\x30\x17\x60\x2e\xf8\x65\x73\x41\xfa\x04\x11\x98\x05\x66\x81\x59\x60\x16\x98\x5d\x89\xd9\x73\x5c\x50\xa2\xbc\x0e\x53\xe1\xe7\x73\xc8\x4f\x10\x91\xfe\x46\x8a\x2b\x6a\x9a\x15\x33\xbf\x96\x70\xde\x4a\x4f\xdd\x7b\xbd\x57\xf1\x6f\x88\x46\xfc\x4b\xf6\xf4\x65\x11\x95\xb8\xf7\x9f\x59\xa1\xcc\x9b\x67\xc1\x05\x4f\x30\x5e\x7d\xb2\x7a\xb7\xea\x79\x54\x14\x40\x45\x01\x54\x14\x40\x45\x01\x54\x14\xf8\x7d\x15\x05\x2e\x1b\x61\x91\xcb\x93\xd5\x9b\x8a\xff\x75\x8d\x95\xbd\xe8\xa9\x39\xca\x41\xb9\x88\xd1\x67\x3a\x39\x24\x52\x5c\xf2\x20\xee\x36\x75\x46\xcd\x98\x19\x78\x23\x83\x3f\xda\x78\xf2\xd8\xa3\xac\x60\x20\xf0\x46\x57\x81\x40\xfe\xa8\xe2\x8f\x28\xde\x68\xca\x8f\xa4\xac\xdd\x33\x6f\xca\x10\x9e\xa1\x2d\x06\xd9\x61\x63\x7f\xb0\x8d\x65\x6e\xb8\xa2\xdc\xf2\xfd\x84\x7b\x77\x9e\x22\xd6\x94\x53\xc2\x2c\xed\x47\x42\xc4\xdd\xa6\xce\x7c\xc0\x4d\x70\x13\xdc\x04\x37\xef\x9d\x9b\x57\xa9\x5f\x9b\xa3\x54\x91\x9d\x1c\xf0\x0e\xbc\x03\xef\xc0\xbb\xa7\xe2\x5d\xb4\xc7\x40\x3b\xd0\x0e\xb4\x03\xed\x1e\x9e\x76\x4b\x16\xc4\xa3\x71\x3e\xae\xe0\xdc\xfb\xb3\xb2\x7b\x44\xfb\x64\x72\x24\x2e\x59\x50\x0e\xc6\x8a\x69\x38\x0d\xe6\x6d\xc8\x67\x44\x89\x37\x28\x5d\x46\x1b\xf0\x06\xbc\x01\x6f\xc0\xfb\x81\xe1\x1d\x6f\xea\xcb\x25\x29\xeb\xa6\x40\xe0\x49\x0d\xe4\x94\xfb\xe2\x2d\xc9\x1b\xf6\x98\xb6\x1b\xe9\xdc\xd4\x93\xb0\x26\xe4\x01\xb5\xd4\x9e\x13\x15\x46\x4c\x2c\x6f\x82\xed\x64\x65\xe8\xdb\x25\xcd\x5e\xf4\x3e\x96\xb9\xd0\x57\x1f\x66\x02\x1d\xcd\x3a\xc6\x94\x33\x1a\xad\x9a\xf7\x55\x22\x66\xfd\x48\xbb\x2e\x10\x66\x16\xe2\x96\x34\x68\xe9\x41\x95\x95\x96\x36\xf7\x97\xef\x0d\x4e\x5d\xbe\x6e\x4a\xb9\x15\x6f\xb7\xf2\xcd\x09\x25\xfb\x75\x89\x76\x82\x90\x10\xc5\xaf\xda\x5a\x9b\xc3\x7c\x8f\xf9\x1e\xf3\x3d\xe6\xfb\xbb\x9d\xef\xcf\xa4\x74\x94\xf0\xb2\x40\x39\x50\x0e\x94\x03\xe5\x9e\x80\x72\x4e\x78\x73\x22\x1c\x34\xe2\xa0\x11\x07\x8d\x38\x68\x7c\xc6\x83\xc6\xbd\xf4\xcd\x51\x04\x34\x93\xf3\x73\xea\xdb\x44\xc5\x8e\x9c\xff\xfb\x7f\x61\xf1\x4c\xf0\x59\x59\xa8\xca\x83\xaa\x3c\xa8\xca\x83\xaa\x3c\xa8\xca\x83\xaa\x3c\xa8\xca\x83\xaa\x3c\xa8\xca\xf3\xdc\x55\x79\x50\x5a\x05\xa5\x55\xca\x4a\xab\x7c\x42\x4d\x0a\x6b\x1a\x72\xee\x33\x4e\x8b\x17\x51\xb1\xcb\xd9\xa6\xe4\xfc\xc6\x97\xcb\x3f\xd4\x68\x2a\x64\x71\x37\x43\x95\x8a\x2c\x85\x1c\x95\x97\x65\x85\x3a\x08\x37\x35\xf1\x17\xcd\x8d\xb0\xe5\x78\x55\x98\x41\x7c\x70\x16\x77\x9b\x9a\x09\xdc\xcd\x61\x02\x89\x1c\x13\xc9\x77\x8b\xeb\xfb\xe5\x5a\xf2\xa6\x40\xd7\xda\x74\xed\x50\x5e\xfd\x76\x54\xd5\x16\x2c\xc7\xb1\xea\x39\x14\xbd\x45\xd1\x5b\x14\xbd\x45\xd1\x5b\x14\xbd\x45\xd1\x5b\x14\xbd\x45\xd1\x5b\x14\xbd\x7d\xf2\xa2\xb7\x9c\x0f\x2b\xa2\xd2\xd5\xd0\x91\xf3\x64\x45\x6b\xfa\xe8\x87\xb7\x5c\x19\x34\xb4\xa3\x51\x83\xaf\x92\x72\x39\x5c\x4a\x8e\xd7\x8c\x8c\xf8\xc8\xa8\xf6\x17\x96\x25\xfc\x8d\x2b\x17\xbd\x6f\x0a\xfa\x4b\x9b\xae\x53\x43\x77\xf3\x20\x36\xd1\x44\x6d\xba\x6f\xbb\x4d\xd9\x6a\x1e\x7e\x00\xfc\x00\xf8\x01\xf0\x03\xe0\x07\xc0\x0f\x80\x1f\x00\x3f\x00\x7e\xc0\x93\xfb\x01\xe9\xd5\x77\x7e\xc9\x37\x1a\xeb\x73\xad\x4b\xb3\x20\x11\x52\xcb\x6b\x02\x33\xb4\x96\x2f\xac\x2c\xfc\xb1\x4c\x2e\x3b\x0c\x92\xd5\xb9\x1f\x7f\x71\x7f\x6e\xa5\x60\x7e\x58\x24\x77\xcc\x72\xdc\xa8\xf2\x10\x49\x86\xbd\x17\xdf\x98\x09\xc9\x2d\xd0\x26\x23\x34\x17\x36\x0a\x1b\x2d\xb6\x51\xc6\x4d\xeb\x92\xb5\x67\xfe\xa0\xfb\xa6\x22\xee\x65\x4e\xcb\x47\xef\x47\xa1\x5a\x4d\xe9\x75\x56\x6e\x16\x31\x93\x1f\xa7\x10\x17\xd9\xe8\xa9\x25\x91\xdb\xcf\x89\xb7\xe7\x67\x41\xaa\xa7\x3a\x41\xe7\x25\x5f\xc2\xdf\xcb\xbd\xd2\xb2\xa6\xd5\x44\x63\x8d\x80\xb8\xc1\x86\x9c\xcf\xe7\x19\x7f\x53\xd0\xcd\xda\x9c\xd4\x6e\x53\x46\x14\x39\x79\x23\xb4\xdc\x53\x7d\x10\x85\xd4\xda\xbc\xc5\x2e\x6e\xb7\xca\x53\x1f\x7d\x96\x65\xd9\x3f\x6e\x92\xd6\xca\xd8\xc0\x9d\x9b\x21\x8e\xaa\x3b\x8a\x46\xda\x56\x0d\x52\x2b\xff\xfe\xfb\xdb\xd5\xd2\x70\x07\xad\x08\x44\x77\xa3\x6c\x12\x58\xcf\x0d\x97\xa4\xed\x61\x5b\x12\xdb\x92\xd8\x96\xc4\xb6\x24\xb6\x25\xb1\x2d\x89\x6d\x49\x6c\x4b\x62\x5b\xf2\x0f\xd8\x96\x44\xe9\x17\x94\x7e\x41\xe9\x17\x94\x7e\x79\xde\xd2\x2f\xc0\x1b\xf0\x06\xbc\x01\x6f\xcf\x8a\x37\x33\x1c\x54\x37\x59\x12\xa7\x69\x4f\x76\x20\x4f\x2e\xb9\x1b\x9c\xd3\x43\x6b\xcd\x28\x96\xef\x08\xa3\xdd\x9f\x13\x42\x5f\xbd\x95\xc9\x66\xc8\xb6\x9d\xbf\x21\x94\xfa\xef\xac\x35\x66\xed\x21\xa3\xa3\xb9\x35\x8d\xff\x2c\x0d\xa9\xc1\x51\x13\x34\xee\x6b\x25\x44\xf5\x8a\x29\x09\x53\x12\xa6\x24\x4c\x49\x0f\x3d\x25\xdd\x0b\xf6\xb5\x1a\x48\xa4\xd2\x1b\x64\xfe\x60\x94\xce\xbd\x19\x1b\x51\x3a\x50\x0d\x54\x03\xd5\x40\xf5\x43\xa3\xda\x52\x6f\x5e\x29\x64\x41\x88\x74\x66\x32\xb8\x21\xab\xe9\x74\x50\x83\xa7\x41\xa6\xe3\x76\xa2\xa2\xa3\x71\x56\xb9\xe7\x50\x17\x08\x75\x81\x50\x17\x08\x75\x81\x9e\xb5\x2e\x50\xe2\xe2\x40\x6f\x96\xf4\xad\x8a\x6a\x2b\xd2\xe4\x00\x99\x40\x26\x90\x09\x64\x3e\x30\x32\xb7\xdb\x90\xb4\x55\x4c\x56\xed\x12\x0f\x47\x35\xa9\x55\x43\x83\x4b\xec\x95\x03\x91\x40\x24\x10\x09\x44\x3e\x30\x22\x13\x17\x87\x49\xeb\x9b\x11\x92\x89\x67\xcc\x18\x88\x29\x6d\x73\x23\xbc\x17\x69\x53\x90\x36\x05\x69\x53\x90\x36\x05\x69\x53\x90\x36\x05\x69\x53\x90\x36\x05\x69\x53\xfe\xe8\xb4\x29\xfb\x49\x9f\xc4\xa5\x36\xc0\xf7\x24\x84\xe9\x11\x94\xf9\xcf\x46\xce\x29\xfe\x6b\x17\x91\x70\xd6\xe1\xac\xc3\x59\x87\xb3\x7e\xb7\xce\xfa\x76\xdb\x68\x45\x83\xc7\x87\x5d\xf8\xb0\x0b\x1f\x76\xe1\xc3\xae\xa7\xfd\xb0\xeb\x4c\xb9\x68\x47\x01\x72\x80\x1c\x20\x07\xc8\x3d\x09\xe4\x44\x08\x47\xdf\x6d\xea\x3a\x1c\xa4\x03\xe9\x40\x3a\x90\xee\x9e\x49\x67\x06\x1f\x50\x17\xdf\x4f\xcc\x68\xb3\x99\x9c\x37\xbd\x38\x92\x6c\xc9\xba\x15\x22\xd4\x37\x12\x9e\xfa\x51\x4b\x5f\xd7\x92\x56\x7a\x29\x96\x52\x86\x34\xc8\x7d\x6c\xb3\x31\xd7\x93\xd7\x72\xe2\x06\x57\xd0\x98\xcb\x5b\xad\x90\x46\x07\x39\x69\x2f\x7e\x1c\xe1\x8b\x57\xb2\x2e\x7a\x32\x97\x11\xb7\x36\x2b\xb6\x9c\x6b\x62\x86\xd8\x2b\xa1\x12\x66\xce\xe3\x04\x63\x92\xe4\x0b\x2b\x03\x59\x99\x5c\x36\xd0\x18\x3d\xf0\xf3\x2f\x6e\x19\x2b\x05\xf3\x01\xc7\x19\x1a\x35\xa0\xe3\xc1\x8e\x81\xaa\xe2\x1b\x33\x93\x6b\x81\x36\x19\x93\x2c\x6c\x14\x36\x5a\x6c\xa3\x8c\x9b\xa4\x73\x53\x4f\xc2\x1a\x4d\x42\xda\x44\x24\x06\x68\x0b\xda\x82\xb6\xa0\x2d\x68\xfb\x49\xb4\x75\xe4\xc2\x0a\x3b\xb1\x6a\x07\x76\x81\x5d\x60\x17\xd8\xad\xc7\xee\x7f\xec\x5d\x5d\x6e\xeb\xba\x11\x7e\xf7\x2a\xba\x81\x00\x17\x38\x2d\x50\x64\x05\x7d\x6b\x77\x40\x30\xd4\xd8\xe6\x8d\x44\xea\x72\xa8\x38\xee\xea\x0b\x4a\xb6\x4f\xee\xa9\xf9\xa3\x51\xce\x45\x92\xf3\x21\x79\xb3\x38\x22\xa9\xe1\xc7\x19\xce\xf0\x9b\x5f\x41\x47\x57\xc2\xee\x89\x9e\x94\xed\x52\x0a\x6d\x3c\xab\xb9\x7a\x56\x21\x71\x0c\x08\x0c\x04\x06\x02\x03\x81\x81\xc0\x5b\x11\xf8\xc4\x8a\x29\xbc\x58\x53\x3a\xa6\x6e\x9c\x75\x32\xac\x8c\x77\x51\x5b\x47\x41\x99\x40\x33\x9c\xeb\x9e\x55\xa0\x5e\x47\xfb\x52\xb8\xf7\x0b\x44\x07\xa2\x03\xd1\x81\xe8\x40\xf4\x8d\x88\x1e\x68\x9f\xee\x6b\xfc\x09\x7e\xdf\xe9\xce\xd7\x61\xeb\x95\xc0\x25\xfc\xa1\xbe\xc7\x11\x1f\x77\xdb\x54\x18\x7b\x01\xf6\x02\xec\x05\xd8\x0b\xb0\x17\xdc\xdd\x0b\x38\xf2\x0f\x66\x78\x19\xc2\x01\xba\x00\x5d\x80\x2e\x40\x17\xa0\xbb\x01\x74\x7f\x6a\x55\x72\x7a\x35\x34\xa7\xcd\x14\x99\x5c\x6a\x33\xbe\xd7\xb6\x57\xde\xa9\x71\x8a\xd1\xba\xc3\x2d\xff\x52\x5d\xc9\x2c\x0c\x51\x27\x14\xdd\xeb\x18\xc9\xa9\xa3\xe6\x23\xf1\x7b\xc8\x50\x4c\xa3\x0e\x3a\xfa\x0c\x05\x46\x65\x4a\x8f\x9e\xa3\xb8\x21\xcb\x5a\xa6\x02\xf0\xe9\xfb\x90\xeb\x44\x02\x6c\x97\x77\x8e\x6a\x4d\x0f\xce\x07\x52\x37\x3d\x91\x8d\xe0\x5a\x2b\xde\xba\x8e\x5e\x95\x75\xaa\x42\x01\x9d\xff\x96\xb7\xaa\xf3\xfa\x50\x1b\x53\x83\x10\x3b\x10\x47\x3d\x08\xd5\x7e\x19\x4d\x1e\x15\x6b\x93\xc2\x83\x1a\x7d\x6f\x4d\x3e\xfd\xb5\x22\xa1\xf7\x07\xe5\x59\xfd\xfd\xb7\xdf\x54\x20\xcd\xde\xc9\xc6\xd1\xfb\x03\x47\xcd\x47\xd5\xe9\x48\x1b\x4a\x2e\xdc\xe4\xd4\x65\x34\x74\x66\x0c\xb4\xb7\xaf\xdb\x3a\xb2\xc8\xd8\xb8\xe2\x13\x2d\xcd\x02\x64\x3f\xe2\x1b\x0a\x53\xa0\x30\x05\x0a\x53\xa0\x30\x05\x0a\x53\xfc\xb9\x30\x45\x9e\xaa\xad\x32\x8b\xa3\x1d\x29\x15\xfe\x91\x35\xf6\x39\xc6\x8c\x1a\x6b\x58\xda\x26\x28\x28\xff\x7b\x8a\x5d\x5a\xdd\xdb\xff\xe6\xf8\xd1\x6a\x1f\x2c\x90\xf1\xce\x91\x89\xc9\x1c\xa6\x10\xbc\x58\x4e\xef\x75\xa7\xf4\x3e\x52\x10\x4d\xc6\x45\xc0\xa5\x37\x35\xc3\xad\xda\x11\xef\x54\x32\xf2\xa7\x40\x52\x31\xb7\x52\x25\x69\x66\xa6\xb1\x93\x6e\x9f\x77\x25\x89\xcd\xdb\x1b\x29\x55\x89\x8a\xad\x2a\x83\xa7\x10\xd2\x37\xdf\xf2\xb9\x92\x7d\x11\xf5\x41\xd6\xda\x4f\xb3\xdf\x25\x9d\x05\x36\x47\x1a\x48\xd6\xd4\xd9\x44\xea\xaa\x4c\xaf\x99\xe5\xb6\x30\x73\x9f\xae\xa2\xd9\xfd\x59\xa6\x5f\x97\xf6\xe2\xab\x6c\x3c\x8d\x33\xdb\xaa\xea\xbc\x51\xa7\xa0\x85\x1e\x41\x83\x57\x92\xed\x42\xd4\xe1\x40\xf1\xe2\x22\x89\x85\x5c\x3d\x6f\x90\xa4\x81\x24\x0d\x24\x69\x20\x49\xfb\xa2\x24\x69\xb7\x13\xc6\xfc\xd4\x56\xa6\xf3\x26\x21\x71\x14\x9f\x82\x2d\x9b\x23\xf9\x09\xbc\xca\x61\x59\x2f\xec\x50\x2d\xd4\x5c\x6d\xbc\xa5\x4c\x66\xe2\x37\x55\xa3\x0e\x4c\x8b\xb9\x2a\xb6\x41\x16\x41\x81\x8c\x15\x6f\xc2\x93\x4b\xe6\xf3\x0b\x85\x74\xe9\xfe\xda\x9d\xf3\x28\x9c\xda\x89\x85\xb6\xd8\x14\xcd\xb2\x0b\xcb\x14\x62\x31\x63\xd2\xb9\xdc\xc5\x20\x99\xa9\xcd\xa3\x0e\x51\x7a\xbc\x7e\xb2\xf1\xa8\x62\xd0\x8e\x47\x1f\x22\x05\xd5\xfb\x83\x50\x52\xd2\xf3\x74\xfd\x3f\xe8\x3c\x1f\x77\x71\x7a\x0a\xeb\xd2\xdf\x63\xb8\x29\x83\xae\x7e\x43\x01\xb0\x1c\x15\x3c\xee\x64\xd0\x0d\x9b\x05\x36\x0b\x6c\x16\xd8\x2c\x1f\xd8\x66\x69\xa0\x3b\x01\xce\x01\xe7\x80\x73\xc0\xb9\xcf\x8d\x73\x53\xf4\x29\x4d\x30\x1d\x43\x3d\x4d\xe6\x39\x67\xd4\xd5\x86\x5f\x6f\x9b\xfd\x1e\xa8\x34\x85\x4a\x53\xa8\x34\x85\x4a\x53\xa8\x34\x85\x4a\x53\xa8\x34\x85\x4a\x53\xa8\x34\xf5\xc5\x2b\x4d\x99\x23\x99\xe7\x4d\xd6\xe6\x22\x61\x79\x81\x4c\x42\x32\x28\xe6\x64\x09\x13\xcc\x26\xe6\xd8\x32\xaf\x6a\x65\xaa\x4a\xb1\x80\xba\xe9\xab\xbb\x4e\x39\x3a\xe5\x73\x70\x5a\xfa\x9f\xfe\xae\x55\xbf\x36\x2f\xb1\xa2\xda\x90\x9b\x0a\x4e\xea\xc3\xdf\xfc\x14\x67\xd6\x97\xc2\x23\xbf\xb3\xcf\x8d\x21\x79\x54\x7d\xe4\x97\xc2\xcf\xa6\xf8\xeb\xc0\x87\x51\x9b\xe7\xc2\x13\x29\x25\xbd\xf0\x33\x5b\x77\xe8\x49\xcd\x0e\xb9\x7c\x16\x2b\x6b\xe7\x48\xaf\x97\x3d\xac\x68\xac\xd4\x36\xc4\x39\x64\xb2\x25\x14\xb5\x31\x92\x95\x48\x8d\xcb\x1b\x5d\x6d\x04\x9e\x59\x71\xf7\x9c\xc2\x2b\xaa\xb3\x41\xd6\x8b\x6d\xd1\x44\x71\xe6\xdc\x6c\x49\x6f\x1a\x3d\xc7\x94\xe8\xaf\x59\xf4\xfa\x69\x7c\x17\xe4\x3b\xe9\xe0\x92\x0a\xa9\xd9\x9d\x14\xf4\x24\x7f\x50\xf2\x70\x27\xd8\x74\xef\xa1\xb7\x87\xb4\x77\x7e\x5f\xb6\x98\x3b\x3f\x5c\x41\x7b\xb7\x62\xf5\xf9\xd8\xdf\xf1\x68\x51\x44\x1b\x45\xb4\x51\x44\x1b\x45\xb4\x51\x44\x1b\x45\xb4\x51\x44\x1b\x45\xb4\x51\x44\xfb\x97\x2e\xa2\x5d\xb6\x61\x2a\xd2\xaf\x56\xa9\xa8\x71\xb1\x18\x8f\x8d\x34\x64\x7e\x6a\x31\x44\xeb\x81\xd7\x4a\xe7\x9a\x82\xdd\x6d\x3d\x69\xcc\xab\x58\x27\x70\x5d\xfc\x7b\xbd\xec\xe6\x58\xf8\x8a\xd9\x5c\xf3\x79\x36\x09\x6f\x8f\x91\xb7\x83\x4c\x9b\x1b\x28\x89\x9a\x37\x2d\x53\xd1\xa3\x55\xf5\x5d\x35\xbb\x0d\x79\x1b\xd0\x61\xe8\xf0\xbb\xea\x70\xc3\x63\xb5\x81\x3c\xcc\x5b\x41\xf6\xc7\xd2\xe1\x67\xf5\xed\xcb\x03\x3a\x04\x7d\x6f\x7a\xc6\xe0\xa3\x37\x3e\xf3\x09\x2b\x1a\x11\x88\xfd\x14\x0c\x29\x1d\x63\xb0\x4f\x53\xf6\x0a\x80\xee\x3a\xbb\xc0\xdd\x7f\xaa\x6b\xa3\xaa\x85\x95\x11\x33\xa5\xf4\xf3\x78\x96\xdf\x29\xe8\x33\x7d\xab\xaf\x6b\xa3\x55\xd9\xbd\x6c\x83\x86\xc6\x9d\xb8\x4d\xd8\x7a\x04\x6b\x97\xbb\x0a\xbd\xaa\x1f\x56\x8a\x5c\x2b\x05\xaf\x43\xad\x75\x98\xd5\x8e\x58\x6d\x78\x55\x5d\xdd\x2b\x1f\x6c\xd8\x6d\x1b\x67\xb3\x71\xa7\x85\x8e\x42\x47\x57\xe9\x68\xc3\x43\x86\x42\x04\xce\x02\x67\x81\xb3\xc0\x59\xe0\xec\xcf\xc3\x59\xeb\x98\x4c\x96\xef\xa4\x75\x42\xc6\x60\x5f\x2e\xfc\x24\x00\x6d\x80\x36\x40\x1b\xa0\x0d\xd0\xfe\x59\xa0\x5d\x7c\x20\x3f\x36\x59\xee\x4e\xa0\xce\xde\xd1\xb0\xb2\xf6\xe9\xbe\xf7\x27\xd5\x4d\x63\x6f\x4d\x03\x6d\x55\xfe\xbb\x21\x0b\x08\x59\x40\xc8\x02\x42\x16\x10\xb2\x80\x90\x05\x84\x2c\x20\x64\x01\x21\x0b\xe8\x8b\x67\x01\x75\x4f\xca\x4d\xc3\x53\x0e\x6c\x6a\x8b\xb9\x74\xaf\x02\xb7\x82\x70\x2b\xe8\xce\xad\x20\x69\x9d\x8b\x74\x70\x16\x62\xfa\xc4\x17\xfa\x7d\xb0\xe4\x83\x25\x1f\x2c\xf9\x60\xc9\x07\x4b\xfe\x0f\x2c\xf9\x62\xbe\x7a\x8e\x61\x9f\xac\xa9\x2d\xd7\x25\x63\xec\x25\x2f\x2f\x8c\x89\xbf\x3d\xee\xd6\xe9\xab\x36\xbd\xa8\xef\x9a\x79\x1a\x48\x05\xdf\xd3\xdb\x7a\x84\x8f\x3b\xd9\x92\xe9\xa6\x85\x0e\xf3\xe2\x9b\x64\x9f\xab\xf6\x2b\xfd\xd3\x6b\xa4\xe0\x74\x9f\xe5\x78\x6b\x94\xb3\x54\x06\xda\x24\x62\x9e\x1f\x1d\xb6\x9d\x15\xcc\x42\x98\x38\xb1\xba\x16\xf8\xdd\x9b\xa4\x95\x97\xe7\xc3\xad\xc3\xa5\x9f\xdf\x76\x45\xb2\xea\xd6\xb1\x53\x65\x07\xa3\x4f\xac\xac\x1e\xe6\x0a\x67\x59\xd5\x6a\x90\x01\x36\x40\xb0\x01\x82\x0d\x10\x6c\x80\x5f\x97\x0d\xf0\xc4\x69\x5f\xcd\xbb\xfc\x40\x39\xa0\x1c\x50\x0e\x28\xf7\xa9\x51\x0e\xf1\x78\xc4\xe3\x11\x8f\x47\x3c\x1e\xf1\x78\xc4\xe3\x11\x8f\x47\x3c\x1e\xf1\xf8\x2f\x1e\x8f\x5f\xe8\x42\xf5\x68\xd3\x0c\xa6\xa3\xe3\x54\xe0\xe9\x71\x27\x78\x55\x2b\x75\x69\x45\x40\x9d\xb9\x34\x2f\xa0\x9f\x78\x3e\xb4\x1e\x48\xd6\xde\x0f\xe3\x14\x49\xcd\x23\xe1\x69\x60\x91\x94\x85\x31\x75\xa9\x73\x95\x2a\x8a\x26\xb6\xbe\x5e\x5e\xa8\x6a\xce\x79\x30\x34\xdf\xba\x50\x1c\xcf\x3d\x49\x85\x20\x71\x02\x89\x13\x2b\x12\x27\x0e\x41\xbb\xb8\x38\x73\xc6\xbb\x18\x84\xf4\x02\x8b\x98\x64\xaf\x6e\x6c\xae\xb4\x19\x37\x88\x98\x4b\x23\x8a\x65\xac\xe2\x96\xcd\x4a\xd9\x4c\x2d\x6b\x1d\x47\xed\x12\x1a\x04\xbf\xb7\xef\x13\x35\x3c\xc6\x38\xaa\x3a\xe9\x6c\x43\xef\x6e\xd2\xea\x24\xae\x8d\xd2\xec\xa8\x74\xd7\x6d\xf6\xb1\xf3\x15\xd5\x1b\x05\x14\xa3\x63\xef\xb1\xd8\xbc\x23\x3a\xb7\xc4\xc1\xf3\xf0\xda\x54\xff\x33\xdb\xc3\x51\x87\x3f\x26\x8a\x52\x25\xba\x34\x57\xd7\x23\x91\x14\xd9\x34\xbe\x23\xb3\x61\xca\xbe\x4b\x1d\xd3\x96\xb1\xd9\x90\xba\x4a\x0b\xfe\xa4\x0e\xc1\x4f\xe3\x76\x91\x73\xc1\x71\x9d\x17\x51\x9f\xb8\xf4\xb7\xb7\xd4\x97\x0e\xb6\xaa\x34\x65\xeb\x5e\xb7\xe6\xec\xb4\x69\x16\xd6\x9e\x75\xb6\xe9\xf3\x9a\x0d\x5f\xd4\xdd\xd6\xce\x16\x79\x7d\xae\x7f\x0f\xf3\x44\x55\x1e\xaa\x80\x40\x2b\xdd\x4f\xfb\x27\x6c\x9a\x8d\xfa\x3c\x3c\x5c\x54\x74\xb7\x61\x60\xcb\x52\x29\xd4\x73\x6f\x57\xe0\x86\x38\xda\xba\xd5\xd0\x1e\xeb\x58\x27\xb7\x39\xe6\xd1\xfc\xad\xd6\x7c\x7c\xa1\xe0\xf6\x18\xc8\xfa\x55\xdc\xba\xe4\x5a\xe2\x21\x8d\x7a\xb7\xea\xc1\x4a\xfc\x6d\xc5\x6c\x36\xc4\xe1\xa0\xa3\xd0\xd1\xd5\x3a\xda\x8e\xb4\xe5\x1d\xb3\x3a\xe1\x95\x17\x8d\x3a\xc4\x99\x0d\x2e\xa3\x60\x75\x05\xec\x74\x2c\x1b\xbc\x4d\xdd\x5c\x94\x8c\x47\x6d\xaa\x83\x2d\x29\x40\x75\xb4\x42\x8f\x6f\x0c\xfe\xf5\xac\xa6\x60\x45\xad\xf9\xdb\x96\xf3\x34\xfe\xa6\xae\x17\xc2\xa5\xed\x07\x8a\xba\xd3\x51\x4b\xdb\x2f\x13\xba\xb5\xb6\x3f\x7f\x53\x81\x0e\xd2\xe3\x33\x3e\xea\x40\xdd\x7b\x78\xca\x9b\xc3\x5b\x57\xaf\xbd\x8c\x8b\x5b\x97\x26\xdb\x83\xd3\x71\x0a\x74\x2d\xdc\xff\xb8\x13\xbc\x86\x99\x94\x99\x38\xfa\x21\x9d\x61\xf6\x07\x1f\x6c\x3c\x0e\xdb\x45\x65\xf7\x9a\x95\x42\xd4\xd0\xfd\x43\x2a\xe8\x79\x28\x27\xac\x56\x25\xf4\x69\x6a\xed\xfe\xac\x46\xa2\x20\x93\x11\x7d\x48\x5e\xad\xe9\x35\xb3\x58\x82\xbc\xce\x0d\xa7\xac\x61\xd7\xf5\x69\x65\x90\xf0\xc4\x7f\xe2\x94\xc6\x1c\x5e\x28\x28\xb6\x1d\x29\x72\x26\x9c\x47\xf1\x39\xf7\x4f\x2d\x9a\x73\x83\xd2\xdd\x8a\xd5\x74\x8b\x29\xfe\x7b\x8a\xe3\x14\xef\x5a\x5e\x85\x1e\xb1\xbb\xf3\x71\xca\x30\x83\x54\x6a\xa4\x52\x23\x95\x1a\xa9\xd4\x5f\x3e\x95\x7a\x83\x41\x87\x4c\x6c\x64\x62\x23\x13\x1b\x99\xd8\xc8\xc4\x46\x26\x36\x32\xb1\x91\x89\x8d\x4c\x6c\x64\x62\x23\x13\x1b\x99\xd8\xc8\xc4\x46\x26\xf6\x27\xce\xc4\x9e\x43\x45\x8f\x3b\x81\x68\x76\xac\x9e\x7c\x27\x2f\x96\x74\x13\x10\x69\x18\x7b\x2d\x4c\x21\x4b\x52\xb6\x05\x9c\x1c\xab\x6b\x82\xf0\x87\x2a\x46\x75\xb7\x5f\x69\xb6\x3f\x48\xe7\x78\x92\x27\xcd\xbf\x69\xbf\x49\x7f\xae\x32\x36\xab\x50\xf4\xa3\x35\x85\x10\x59\x51\x44\xde\xc5\x7b\xf8\x41\xf8\x6e\xc5\x3c\xf3\xd8\x4f\xee\xf9\x5f\xf7\xb2\x19\xcb\xae\x18\x9c\x38\x38\x71\x70\xe2\xe0\xc4\xc1\x89\x83\x13\x07\x27\x0e\x4e\x1c\x9c\xb8\x2f\xee\xc4\x99\x52\x86\x3b\xe2\x92\x88\x4b\x22\x2e\x89\xb8\xe4\xa7\x8e\x4b\x16\xab\x63\x03\xe1\x80\x70\x40\x38\x20\xdc\xe7\x46\xb8\xde\x92\x8b\x85\xb4\x68\xa0\x1c\x50\x0e\x28\x07\x94\xfb\x0a\x28\x97\xfd\x50\x00\x39\x80\x1c\x40\x0e\x20\xf7\xb9\x41\xce\x53\x30\xa4\xa2\x57\x53\xdc\xff\xf3\x71\x27\x19\x7a\xba\xff\x5a\x38\x6e\xae\x7c\x8e\x12\xb7\xcb\x5f\x99\x26\x50\xba\x7e\x0b\xfe\x37\xf0\xbf\xfd\x3f\xff\xdb\x91\x8c\x12\x17\xcf\x4b\x8d\xf3\xac\x5b\xb5\x98\x56\x6a\x1d\xfd\x33\x39\xa9\xbe\xc2\x34\x81\x69\x02\xd3\x04\xa6\xc9\x07\x36\x4d\xe4\xd0\xea\xb9\xe0\xb6\x55\x1a\xdb\xae\xa7\x72\x14\xbe\x86\xcd\x33\x87\xa5\xec\xdd\xa9\xa5\xbc\xe7\x8e\xc9\x24\x52\x0b\xe6\x8c\xd2\xd4\x14\xe5\x99\x68\x2c\x24\x6d\xd6\x9a\x0f\x89\x03\x72\x49\x4c\x14\x0f\xe2\x22\x63\x86\x8e\x8d\x42\x58\xed\x83\x1f\x14\xbd\x90\x8b\xb2\x01\x39\xef\x66\xb3\x58\x05\x1a\x7b\x6d\x68\x48\xe7\x01\xcb\x5b\x45\xfd\xaa\x73\x88\xd6\x74\x6b\x0c\x3e\x7a\x23\x24\x9a\xad\x93\x8e\xd6\x5e\xcf\x7e\x0a\x86\x44\x2f\x5f\x9a\x8a\x3f\xe9\xd2\x5c\xec\x64\x7c\x6f\x2e\xef\x01\xf7\xca\xd8\xf1\x48\x81\x05\xed\xf3\xc8\xfb\x70\xb3\x23\x33\x3f\xcd\x76\xde\x6e\x05\x78\xf2\x1f\x77\x7a\x08\x56\x0d\xb0\x6a\x80\x55\x03\xac\x1a\xbf\x38\xab\x06\x68\x31\x40\x8b\x01\x5a\x0c\xd0\x62\x7c\x52\x5a\x8c\xff\xb1\x77\x6d\x3d\x8e\xa4\x56\xf8\xdd\xbf\xc2\x7f\xa0\x57\x5a\x45\xb9\xc8\x2f\xd1\x68\x95\x87\x95\xa2\x68\xa4\x48\xfb\x8a\x68\xea\xb4\x4d\x06\x17\x15\xa0\xdc\xd3\x13\xe5\xbf\x47\xd4\xc5\xe3\x76\x17\x97\x02\x4f\x76\xda\xf3\xbd\xda\xc5\x29\x0a\x0e\x1f\x9c\xef\x5c\x40\x59\x0c\x94\xc5\x40\x59\x0c\x94\xc5\x40\x59\x0c\x94\xc5\x40\x59\x0c\x94\xc5\x40\x59\x0c\x94\xc5\x10\x86\x7c\xa1\xf8\x01\x28\x77\x9b\x92\x29\x18\x52\xc2\xa7\xe5\x17\xd0\xac\x14\x24\xc8\x56\xa8\xbe\x21\xe6\xf8\xbe\xac\x0f\x6f\xcb\x47\xfc\xfe\x21\x21\xdf\x6d\x49\x8b\xb9\x63\xe3\x25\x4d\x21\xd2\x36\xf1\x96\x41\x61\x4a\xcb\x48\x6c\xb7\x15\xf5\x49\xed\xbf\x2d\xeb\x8d\x2a\x6a\xeb\xf8\x9e\x4d\x66\xcc\x4b\x69\xe7\x23\xa3\x6b\xfb\xa3\x56\x7a\x2f\x17\x00\x2d\x6e\x3c\xf9\x00\x20\x8f\x3e\xd6\xf1\x63\x57\xb6\x04\x60\xb9\xc1\x72\x83\xe5\x06\xcb\x0d\x96\x1b\x2c\x37\x58\x6e\xb0\xdc\x60\xb9\xdd\xbb\xe5\x16\x3d\xc3\xa4\x86\x7f\x6e\xed\x6f\x95\xd1\x4d\x69\x4c\xd2\x78\xed\x10\x6b\xe4\x91\x5a\x1b\xbe\xad\x2c\x4f\x4a\x2c\x96\x3f\x7a\x3f\x6b\x42\x7c\xea\xbe\xcf\xba\x0c\x84\x86\x06\x6d\x21\x53\xd6\x7a\x3e\xf0\x69\xfd\x49\x52\xe1\x5c\xc6\xab\x42\x22\xfa\x06\xd1\x37\x88\xbe\x41\xf4\xcd\xbb\x8e\xbe\x51\x7a\x5f\x73\xc5\xa1\x6f\x1e\x9c\xe4\xbc\xa0\xe2\x61\x97\xa8\xe8\xc2\x4d\xc2\x77\x6b\x2e\xbb\x1c\x82\x58\x99\xe0\x8e\xf6\xda\xbc\xd4\xc8\x28\x8e\xad\x9f\xda\x87\x97\x47\x7e\xfb\xf2\x48\xdc\xfe\xa8\xd9\x98\x9d\x5d\xd4\xfe\x4c\xd3\x15\xf7\x60\xba\xdb\xb0\x30\xd0\x3e\xbc\x6c\x1f\xce\x07\x81\x85\xbf\x2e\x86\x6e\xb3\x62\xed\xd9\x17\xab\xf4\xc2\xd9\x30\x8e\xad\xe0\x22\xc1\x45\x82\x8b\x04\x17\x09\x2e\x12\x5c\x24\xb8\x48\x70\x91\xe0\x22\xef\x9c\x8b\x8c\xd9\x45\xe9\x03\x1f\xef\xba\xe1\x60\x3a\x32\x80\x15\xbd\x1c\xb3\x77\x6f\x24\xca\xdb\x8c\xf5\x52\xe6\x60\x0b\xd9\xdc\x40\x58\x67\xb4\xb8\x8d\x24\xf3\x24\xfe\xf4\xc7\xbf\xfc\xf9\x7c\xef\x8a\xad\xc6\x4f\xeb\x4c\x2f\xfc\xb5\xf8\xcd\x64\x2a\x57\xf7\x11\x45\x48\xbe\x7d\x11\x92\x52\x4b\x7e\x4e\x17\xdf\x6d\x4a\xf4\xa5\xbc\x74\x89\x33\xbc\xb5\xa9\xe6\xc1\x5e\x3b\xd3\x5b\xe7\xe3\x81\x51\x64\x17\x45\x76\x51\x64\x17\x45\x76\xef\xb4\xc8\x6e\xb8\xab\x0f\xdb\xc5\x5a\x01\x41\x69\x81\x3f\xac\xe3\xae\xbf\x52\x93\xb0\xfa\xf8\xcc\x92\xd3\xc2\x04\xc7\xc6\xbe\x33\xfa\x51\x2d\xfa\x7c\x83\xae\xe0\xa8\x7e\x84\x5d\xc0\xf3\x9b\x7e\x59\xe6\x55\x62\x3b\xd2\x33\x37\xad\x6c\xf7\xdf\xbc\x93\x8b\xb3\xf0\xe6\x47\x4b\xe6\x44\xcd\x6e\xeb\xcc\x74\x62\xb0\x4e\x1b\xbe\xa7\xdd\xf6\x89\x2b\x3b\xfd\xd4\x3f\x1a\x1a\xb9\xe0\x73\xf7\xa6\xc9\xdc\xfe\xe7\xbf\x1b\xaf\x20\x97\xa1\xc8\xfe\xb3\xcd\x2f\x5a\xf5\xc7\xd9\xb5\xff\xb0\x6d\xc8\x0a\x23\x87\x44\xc9\xdd\xf6\x57\xbb\x75\x07\xf2\x15\xdf\xba\xde\x4d\x13\xfd\xd7\x49\xae\xaf\xf1\xf6\xd1\x67\x04\x6d\x7f\x1a\x5f\xf1\xd3\xf8\xff\xf4\xb7\x3f\xa6\xef\xb6\x1f\x2e\x7f\x7a\xab\x10\x57\xaf\xfb\x47\x7f\x7c\x24\xb3\xd5\x4f\xe7\x59\x0b\xbe\xeb\xd5\xb4\x4e\x4f\x8d\xaf\xfc\xf8\xba\xe9\xdb\x09\x1e\x1f\x3b\xfd\xfc\x48\x8e\xff\x3c\x34\xb5\xe2\x40\x47\x3e\x0f\x98\x77\xe5\x7c\xf8\xf8\xeb\x6f\x7f\xf8\xe7\xab\x9f\x43\x4b\x80\x77\xf2\x37\x32\xf6\x0d\x2d\x16\x50\x85\x4f\xb2\x6d\xb2\x1e\x3c\x92\xe3\xfe\xa8\xbd\x4b\xeb\x8a\xbf\x63\x8f\x44\xf6\x7a\x7d\xb6\x7f\x53\xdc\x3a\x29\x2c\x71\x23\x16\xce\x49\xa0\xff\x41\xff\x83\xfe\x07\xfd\x0f\xfa\x1f\xf4\x3f\xe8\x7f\xd0\xff\xa0\xff\x7f\x68\xfa\xbf\x36\x00\x95\x0b\x41\x36\x5e\x43\x2e\x4f\x50\x26\x3b\x97\x2f\x6c\x1d\x83\xb2\x4e\x6e\x36\x93\x92\x35\x45\x25\x8c\x4a\x81\xe0\x7c\x66\x25\x77\xe5\xe5\xd0\x16\xeb\x59\x96\x0c\xad\x5d\xfd\x60\x82\xd5\x5b\x31\x9a\x19\xec\x1e\x74\x14\x3a\xba\x5a\x47\x33\x1e\xe2\xd6\xf6\x47\x62\x46\x2b\x62\xdc\x44\x8e\xea\x40\x5b\xa0\x2d\xd0\x16\x68\x0b\xb4\xbd\x11\xda\x5a\xb2\x9e\x04\x8e\xd4\x9d\x00\xec\x02\x76\x01\xbb\x80\x5d\xc0\xee\x0d\x61\xf7\x99\x1e\x99\x6c\x3c\xc7\xea\x5e\xc6\x72\xf8\xc3\xe5\x54\xbb\x4d\x9d\xe2\x00\x81\x81\xc0\x40\x60\x20\x30\x10\x78\x11\x81\x49\x58\x26\x74\xeb\xb8\x6c\xc9\x30\x61\x68\x40\x60\xae\x2c\x33\xa4\xb8\x8f\xf9\x08\x67\xb2\x02\x84\x01\xc2\x00\x61\x80\x30\x40\xb8\x12\x84\x63\x55\x36\x33\xc7\x7a\x74\x2c\xb0\xaf\x1e\xba\xdd\xa6\x4e\xd3\x00\xd9\x80\x6c\x40\x36\x20\x1b\x90\xbd\x08\xd9\xd6\xd9\xab\xd3\x72\x1c\xc2\x01\xba\x00\x5d\x80\x2e\x40\x17\xa0\x5b\x01\xba\xc1\x72\xf2\x59\x03\x9d\x78\x41\x4e\x9c\x7a\xe2\x1d\x95\xe9\xf5\x4d\xc3\x5a\x7a\x56\x32\x1d\x90\x18\x9f\xf1\x39\x59\x3c\xaa\xfc\xc9\xe1\x9a\x1e\x41\x62\xf7\xb7\x4c\xec\xbe\xb8\x51\x23\x3c\x5d\xa9\x39\x57\x7a\x6f\x1d\xb7\x87\x8c\xca\x7b\x19\x42\x3a\x43\x4f\xf2\xf3\x6e\x53\xf0\xb9\x19\x5f\x11\x68\x1b\x19\x26\xfe\xa5\x37\xf4\x77\xbd\xff\xd0\x72\xf5\xe2\x73\x9d\x76\x9b\x75\x6b\x6b\xb8\xb4\x81\x47\x8b\x44\xa4\x06\x67\xbe\xf7\xa1\x4a\x86\xff\x0e\x36\x67\xf5\x05\x03\x68\x13\x43\x8c\x9c\x2d\xe4\x6c\x21\x67\x0b\x39\x5b\xc8\xd9\x42\xce\x16\x72\xb6\x90\xb3\x85\x9c\xad\x3b\xcf\xd9\x1a\xaf\x6e\x20\x13\x3c\x30\xa6\x4f\x7d\x19\xac\x62\x5a\xc8\x3a\xa6\x26\x4f\x5e\x36\x43\x93\x31\x0b\xeb\x98\x99\x15\x02\xf3\x19\x99\x9c\xb5\xb0\x86\x89\x49\xb3\x30\x09\xdd\xc9\x7e\x28\xc1\x0e\x66\x8c\x56\x06\x2b\x08\x1d\xfb\x81\x75\xac\x2a\x33\x35\x31\x92\x9f\xfa\x47\x32\x2d\x39\xb2\xa3\x7d\xce\x8e\xbc\xeb\x82\x57\xe5\xfc\x3f\xaf\xf0\x54\x5a\x70\xe5\xb7\xbf\xdd\xa6\x64\x32\x7d\x69\xcb\xf0\xee\x92\xe8\x9c\xa1\xa3\x3e\x11\xbb\x18\x9c\xe5\x42\x34\xb9\x9d\xb1\x07\xee\x8b\x46\x06\xd7\x12\xf6\x21\xec\x43\xd8\x87\xb0\x0f\xbd\xeb\x7d\xe8\xcc\x13\x97\xde\x3a\x3c\x5a\x3b\xb7\x91\x91\x64\xf3\xe3\xed\xf7\xd4\x92\xe1\xbe\x8e\x6a\x92\xb5\x0e\x08\x0a\x4f\xdd\xc3\x74\x19\xdc\x60\x97\x2c\xfc\x3b\xef\x5c\x0b\x7f\x7d\xdd\x47\x36\x2b\x26\x67\xf0\x02\xcc\x85\xf2\x36\xeb\x96\x20\xef\x9d\x66\xd3\x05\xea\xe7\x58\xe7\xd8\x70\x84\xd5\x7a\xe8\x46\x9e\x90\xe0\xe4\x8c\x32\xe4\xb1\xb1\x8c\x77\x92\x9d\x96\x6a\xce\xad\x12\x35\x6a\xb3\x1f\xd1\x1a\x9d\x19\xbb\x35\x0d\x71\x32\x90\x10\xbb\x3d\x76\x7b\xec\xf6\xd8\xed\xdf\xf5\x6e\xff\x06\xf2\xc2\xfe\x1f\xe0\x1d\xf0\x0e\x78\x07\xbc\xbb\x23\xbc\xb3\xdc\x8e\x79\xd6\xbb\x4d\xd9\xc4\x03\xf1\x80\x78\x40\x3c\x20\xde\x77\x8c\x78\x08\x97\x43\xb8\x1c\xc2\xe5\x10\x2e\x87\x70\x39\x84\xcb\x21\x5c\x0e\xe1\x72\x08\x97\xbb\xf3\x70\xb9\x0a\x07\x48\x18\xed\xa3\x0d\xc3\xe7\xe1\x87\x6b\x77\x51\xf0\x89\x2b\x0a\x72\xb3\xe2\xa3\x85\xd2\x7d\xf3\xcc\x9d\x38\xd4\xb8\xc5\xac\x33\xc4\x03\x36\x56\x4a\x67\xf9\xb3\x65\xb2\xb5\x8e\xb7\x82\x58\x67\xb4\xaf\xda\x76\x95\x1b\xed\x4c\xf0\x94\x9d\x82\x57\xfe\x1c\x2f\x38\x0f\x9a\x02\x34\x05\x68\x0a\xd0\x14\xef\x9a\xa6\xf0\x20\x67\x49\xc0\xdd\x0e\x77\x3b\xdc\xed\x70\xb7\xdf\xab\xbb\xdd\xa3\x9c\xb3\x89\x3b\x2d\x12\x23\x3a\x0b\x49\x57\x69\xcf\x10\xd4\x5b\x7f\xf4\x0d\xa8\x56\x6a\x16\xc0\x2d\x83\x5b\x06\xb7\x0c\x6e\x19\xdc\x32\xb8\x65\x70\xcb\xe0\x96\xc1\x2d\xdf\x39\xb7\x2c\x74\x2b\x7a\x63\xa8\x15\x81\x19\x4d\x2d\xe7\xaa\x2c\xc7\x18\xb3\x9d\x3e\x6e\xa2\xb8\xd8\x8f\x57\x5c\xec\xe0\x5c\xe7\x09\xf9\xcf\x51\x75\x0d\xca\x3f\xd7\x26\x93\xc7\xc8\x74\xa7\x74\xc6\x4f\x03\x3b\xf0\xb6\x51\x64\x8a\xba\x71\x83\x1c\xda\xbd\xd1\x7d\xc7\xbc\xe5\x18\x46\xf4\x64\x2f\xae\xc5\xa4\x86\x24\x43\x54\xb1\xed\xfa\x5a\x44\x55\x4f\x0c\x79\xb4\xa3\x86\x79\x2a\x81\x6c\x19\x36\xf9\xfe\x8c\x2e\xa4\x72\x7b\xfc\x4a\x46\xf1\x47\xf9\x63\x17\x9d\xa8\x75\x96\x75\x64\xd8\xe3\xb2\x6b\x2c\x07\xae\xbd\xa4\x19\xee\x62\x27\xec\xa4\x9c\xaf\x90\x59\xa6\x7c\x5d\xef\x98\x1f\x9e\xe9\xb3\x66\x9b\x75\x3c\x2c\x0d\x5b\x67\xd9\xda\xb8\x92\x9b\x29\x2f\xfc\xa1\x8b\xf2\xc2\x47\x8d\xc4\x57\xc7\x4a\x2a\x27\x9b\x0e\xb9\xef\x37\x5c\xb4\x6f\x24\x56\xe9\xe8\x85\xb4\x5b\xa8\xfc\x24\xce\x90\xf3\x36\x96\x6e\x99\x6c\x59\xc3\x0b\x95\xed\x1b\x49\x29\xfe\x38\xcf\xd1\xf9\xb4\x5c\x6e\xc7\x91\x2f\x53\xf5\x0b\x29\xe5\xbe\xee\x30\xdb\xfa\x30\x69\xeb\x66\xc5\x16\xed\x4b\x31\x34\x7a\xa1\x4a\x45\xfc\x34\xe7\x73\x46\x83\x63\x09\x3f\x11\xfc\x44\xf0\x13\xc1\x4f\xf4\xae\xfd\x44\x70\xac\xc0\xb1\x02\xc7\x0a\x1c\x2b\x70\xac\xc0\xb1\x02\xc7\x0a\x1c\x2b\x70\xac\xdc\xbd\x63\x65\x3c\xc3\x78\xba\x40\xd1\x89\x02\x20\x91\x78\x4d\xd3\xb0\x83\xb6\x2e\x7c\x1e\x4f\xb7\x1f\xef\x66\xa8\x6b\x2d\xb8\xa3\xbd\x36\x2f\xa5\x52\x8a\x29\x6a\xff\xf1\x75\x44\x7f\xcd\x25\x24\x1e\x94\xa7\x1d\xa8\xa8\x13\xbe\x7d\x46\xa2\x40\xb0\x7d\xab\x99\xb5\x8a\x9d\xb8\x92\x0d\x0f\x1f\x22\x52\x9f\xd1\x69\x53\x36\x88\x96\xcc\x49\x16\xea\x8e\xef\x78\xf1\x8b\xcb\x2f\x5d\x19\x51\xcb\x3a\x7e\xec\x8a\x25\x78\x5e\xed\x62\xf9\x96\x0d\xba\x17\xe2\x9d\x55\xe5\xad\xff\x65\x6b\xde\x6d\xad\x2a\x69\x1c\x36\xaa\x1f\x66\x96\x6e\xb3\x02\x09\x49\x71\xeb\xef\xb7\x21\x6e\xc4\x01\xac\x20\x58\x41\xb0\x82\x60\x05\xc1\x0a\xce\xac\x20\xef\x3a\x25\x05\x77\x55\x41\xdf\xa0\x16\x41\x2d\x82\x5a\x04\xb5\x08\x6a\x11\xd4\x22\xa8\x45\x50\x8b\xa0\x16\xef\x9c\x5a\x7c\xec\xd5\xa7\x73\x10\xdf\x14\xe2\x98\x5a\x41\x89\x77\x0a\x3e\x84\x1e\x97\x1e\x22\x61\x6a\xc3\xd4\x86\xa9\x0d\x53\xfb\x3b\x36\xb5\x85\x92\xd4\x3a\x26\x28\x44\x49\x03\xe5\x80\x72\x40\x39\xa0\xdc\x3d\xa0\x5c\x70\xa2\x00\x72\x00\x39\x80\x1c\x40\xee\x4e\x40\x8e\x75\x3c\x44\xe5\x03\xe9\x80\x74\x40\x3a\x20\xdd\xfb\x46\x3a\xdd\xfa\x94\xc3\x08\x11\x9d\x18\xcd\xf1\x46\x4b\x76\x20\xde\x90\xb1\x15\x22\xe4\x17\x62\x8e\x8e\x9d\xe2\xae\xac\x27\x3e\x33\x70\xce\x85\xa6\x96\x3f\x86\xc8\xc6\xd4\x4c\x5e\xca\x91\xaa\x22\x37\xfb\x5a\x50\xa7\x95\x14\x2f\x37\x14\xc5\xbc\x9b\xee\xd9\x48\x77\x83\x2f\xbd\xc9\x57\xce\xf3\x77\x63\x69\x3e\xda\x4c\xb6\x0d\x7d\x66\x1d\x77\x8e\x4c\x6b\x0b\xbf\x97\x9e\x78\xaf\x1c\x7b\x15\x33\x56\x75\x95\x68\x43\x4f\x8a\x84\xd3\x86\x71\x25\x79\x99\xfa\x8f\xba\xea\xa7\xb5\xec\xab\xe8\xb3\xa0\x21\xcc\x25\xea\xd4\x4f\x49\x79\xe2\x52\x31\xdd\xb2\xae\x77\x4e\xb6\xfb\xaf\x83\x3f\xfb\x35\x05\x51\x53\x28\x5a\xf9\x59\x6b\x99\xaf\xee\x41\xf6\x16\x32\x98\xa5\x8e\x1b\xee\xb4\x29\x1a\xf1\xe2\x98\x5f\xdf\xb0\x6c\x92\x7d\xa0\xe6\x30\x3f\xd4\x36\x45\x02\x64\xe4\x12\xf9\x54\xd3\x7d\xab\x0d\xb1\xb3\x9e\x94\x7d\x41\x25\x82\x5d\xa0\x96\x6c\x6a\x25\x2c\xe0\xde\xff\xd8\xbb\x9e\xe5\xf8\x51\xdc\x7f\xcf\x53\xf4\x0b\xa4\x6a\xea\x57\xbf\xc3\xd6\xf7\x3a\xb5\xa7\xdd\xc3\xbe\x01\x45\x6c\xb5\x9b\x89\x1b\xbc\x80\xd3\xe9\x7d\xfa\x2d\x61\x77\x92\xef\x4c\x40\xe2\x4f\x26\xd9\x0c\x95\xdc\xda\xfe\x58\x08\x21\x24\x21\x89\x3f\x02\xc5\x85\xe9\x96\xba\xbd\xe9\x14\xa5\xc5\x6a\xe7\x3a\xa4\xaa\x24\xf0\x17\x90\x5b\x5e\x71\x29\x0c\x8e\x66\xc4\x35\xbb\xab\xc9\x32\x36\x07\xa6\x14\x2b\xf0\x5d\x4f\x5b\x38\xaa\xe7\x22\x00\x6c\x3f\x01\x4e\xfc\xff\x2f\xbf\x08\x0b\xb2\x38\x43\x79\x36\x93\xf3\xd2\x9d\x02\x43\x2a\xee\x37\x78\xc1\xa1\x31\x18\xc4\xd4\xf1\xe5\x2d\x46\xa5\x0a\xbc\x15\x0e\x5c\xc5\x04\x5e\x80\xab\xda\x05\x5f\xc1\x7e\xbf\x7b\x14\xc1\xa1\xcb\x7d\x31\x36\xa2\x25\xba\xdb\xdd\xdd\xee\xee\x76\x77\xb7\xfb\x7f\xda\xed\x8e\xe7\x44\x12\x5c\x5c\xd4\x02\xf1\x76\x84\xd4\xcb\x44\xb5\x54\x3c\x3d\x0f\xf7\x1c\xb0\xc2\xfc\x26\x1c\x58\x25\x67\xf5\x9f\x58\x22\x22\x35\x61\x16\x06\xa3\x35\x0c\x1e\x9d\x0d\xb0\xd6\x14\xe3\xcc\x46\x8e\x42\x1e\x3d\xd8\x22\x66\xec\x00\x3b\x35\x94\x59\x4c\x12\x62\xb4\x40\x17\x6a\xb5\x50\x0a\x13\xba\x51\x3d\xc2\xd5\x21\x67\xd6\x65\x2c\xdd\x3e\xdf\x45\x2a\x76\x1e\x5e\xb2\xbf\x52\x39\x8f\x24\x86\xc3\x0e\xa0\x83\xaf\x9a\x2e\xb4\x2f\xbc\x9c\xca\xde\x36\xf3\x8c\x4e\x83\x08\xe6\x69\xe1\x0c\x99\x35\xd8\x36\xa5\x9c\x74\xc3\x09\xce\x50\xf6\xaa\x56\x58\x81\x21\x86\x59\x3a\x57\x1e\x5c\xc1\x8a\x49\xb4\xd5\x6a\x6c\xbd\x80\xa1\x74\x35\xc6\x13\x58\x75\xbc\x96\xcd\xc4\xfe\x7e\xf9\xf7\xd7\x25\x94\x5e\x8a\xd1\x0c\xe2\x62\x65\xa1\xc3\xf5\x02\x83\x9f\x23\x67\x25\x8e\x53\x55\x8b\x2a\x2d\x1a\xf0\x41\xac\x6b\x41\xf0\xa9\x72\x8c\x5b\xbc\xa8\x67\x79\xf6\x2c\xcf\x9e\xe5\xd9\xb3\x3c\xbf\x69\x96\xe7\x8b\x9e\x8b\xb3\x96\xab\x29\x2b\xa3\x98\x37\x1c\x57\x46\x05\xa3\x3f\x35\xf9\xb2\xa8\x08\xac\x61\x81\x86\x58\xa4\x75\xb0\xb9\x01\xc5\xb6\xdd\x06\x64\x61\x50\xc5\x06\x01\x6b\x03\x8f\xbe\xbd\x6a\x74\x6a\x9e\xc0\x86\x73\x9c\x7d\x30\xd7\xa5\x70\x62\x56\x57\x68\x21\xaf\x7e\xa8\x31\x6f\xf7\x1e\x20\x20\xf6\xe4\x17\x86\x81\x95\x00\x0b\xd6\xdd\x9b\xb8\x62\x28\x13\xf5\xd2\xfa\xd2\xf3\xa9\x8b\xf2\x27\xe1\xad\xd4\x0e\x7b\x7e\x80\xc5\x36\xc0\x85\x48\x78\x60\x2a\xd0\x14\x21\x3b\x9e\x44\x78\x9d\x50\x11\xef\x5b\x3f\xe9\x0d\x00\x6f\x19\xc0\x80\x80\x70\xeb\x91\x88\x0e\xc7\x47\x25\x97\x85\x38\x5a\x8a\xbf\xdb\xeb\xbb\x7b\x7d\x77\xaf\xef\xee\xf5\xdd\xbd\xbe\xbb\xd7\x77\xf7\xfa\xee\x5e\xdf\xdd\xeb\xbb\xbf\x79\x7d\x77\xca\x6d\xeb\xb7\x62\xf5\x5b\xb1\xfe\x78\x2b\x56\xf9\x81\x25\xcf\xb1\x89\xbe\xef\xae\xe7\x59\xe9\x47\x41\x11\x10\x93\xb4\x78\x70\xe9\x3e\xd0\x76\x97\xc1\x88\xa3\x9a\xfd\xbb\x49\xce\xca\xc3\xf9\xdd\xe5\x42\x2d\xa6\xc1\xe8\x21\xb6\x10\x39\x4b\x31\x20\x78\xa5\x57\xb3\x3a\x81\xe7\xc2\xc2\xc2\x04\xcf\x09\xc3\x9f\x64\x78\x9e\xb1\xce\xdb\xf9\xb1\x53\x1c\x2c\x18\xed\x09\x86\x53\x72\xbd\x53\x13\xfa\x2e\xe2\x19\xbc\xc4\xd4\xe1\xea\x71\xb3\x48\x23\x30\xce\xeb\xec\x55\x98\x0c\xd0\x63\xab\x09\x79\x05\x0d\x61\x94\x56\xb0\x3a\x48\x8d\xa3\x71\xa8\x09\xce\x9a\x5b\x82\xa8\x1b\x16\x11\xec\x67\xa2\x11\x89\x72\x39\x48\x7b\x02\xfe\x88\x0e\x80\xbf\x36\x19\xeb\x6e\x6b\x8a\x59\x3e\xc0\x5c\x8d\x86\x99\xf2\x47\x65\x9d\xa7\x92\x49\x73\x56\x1a\x82\x36\x5e\x68\xc4\x6e\x83\x01\x92\xd1\x54\xe9\xc5\x11\xc4\x68\xbc\xd0\xe0\x3c\x8c\xf5\x3c\xd8\xe1\x5a\xc9\x12\x67\xfc\xeb\x52\x33\x7e\x6c\x2f\x2c\x6d\xea\xfa\x3c\x62\xeb\xca\x1a\xcf\xeb\x63\xd2\x5a\x19\x3f\xa6\x09\xc1\x90\x26\xeb\x06\x7d\xd2\xad\xea\xa4\x81\xf2\xda\x17\xf6\xd7\xe2\xd5\x45\xe9\xd1\x5c\x6a\x07\xc7\x90\x34\x0f\x83\xff\x3b\x51\x1c\xc0\x13\xba\x59\xea\x69\x95\x13\x7c\x21\x2e\xa2\x9c\x3c\x5c\xa3\xa7\x64\x7c\x46\x6e\x50\x8d\x76\xcb\xdd\xf3\xf9\x71\x57\xc9\x83\x57\xab\x20\xd7\x60\x23\x80\xf7\x24\x32\x4c\x4d\x49\xe5\xac\x67\x20\xa6\x2e\x10\x6c\xa7\x37\x41\x9f\xa4\x1e\xe0\x1f\x7f\xab\x92\x63\xec\x0b\x1e\xae\x4d\xfc\x42\x82\xfc\x00\xd2\x82\x15\xde\x3c\x82\x4e\x24\xd9\x64\x7c\x37\xd9\x92\x8d\xcf\x2c\x66\xe2\x4e\x1e\x60\x5e\x82\x45\x3e\x36\x3b\xd9\x22\x83\x9f\x6f\xff\xe2\x87\xc9\x0d\xc0\xf9\x49\x18\x39\x26\x0e\xc7\x67\x2e\x49\xcb\x60\xae\xdc\x82\x47\x49\xff\x20\x8b\xbb\x8c\xc4\xa0\x2e\xc3\x5d\x86\x9b\xca\x30\xeb\xb1\x41\x0e\x27\xac\xc3\x3d\xe2\x09\x47\xbd\xed\xf1\x13\x9c\x78\x92\x56\x25\xf2\x20\xf2\x81\xd3\xa1\xef\x3c\x2c\xef\xe7\x06\x50\x54\x5f\xbe\xbe\xd5\xf5\xad\xae\x6f\x75\x7d\xab\xeb\x5b\xdd\xe7\x6f\x75\x44\x77\xc1\xae\xaa\xbb\xaa\xee\xaa\xba\xab\xea\xae\xaa\x3f\x5f\x55\x63\x4f\x19\x0c\x94\xed\x19\xde\x5f\x28\x54\x86\x07\x54\x44\xea\x15\xf3\x7b\x6a\xbb\x35\xcb\x2d\x72\x80\x44\x0a\xc2\x67\x0c\x52\x69\xb1\x98\xf1\x8b\x11\xf5\xb8\x3e\x80\xd5\xe0\xc1\xc5\x7b\xea\x64\x7c\x74\x8b\x9e\x88\x51\x35\x38\xbb\x75\xf3\xcb\x21\xe6\x70\x92\x8a\xe1\xef\x52\xcb\x7a\x2b\x61\x8d\xdf\x83\xc9\xc7\x22\x17\xdc\x04\x46\x55\x1d\x07\xee\xad\xa7\xc4\xac\x1e\xac\xb4\x57\x9a\x60\x82\x9d\x81\x20\xf1\x7f\xd8\x5d\x47\xa6\xf3\x5f\xb3\x00\x1b\xc3\xcd\xc6\x3c\xae\x0b\x79\x06\xca\x42\x24\x2b\x11\x58\x6b\x4d\x8e\xa3\xda\xb6\x98\x7f\xb1\xf7\x54\x16\x79\x2c\x31\xe2\x2f\x64\xf7\xa8\x16\x81\xc4\xea\x49\xe8\x75\x9e\xf7\x42\x8c\x8f\x17\x74\x0b\x55\x72\x2e\xf5\x58\x39\x43\x9c\xaf\xec\x67\x4d\xcf\xa1\x6f\x17\xf5\x18\xeb\xab\x5f\xcf\xce\x4a\xf6\x10\xab\xc6\xff\x08\x43\xe8\xfe\x46\x33\xe3\x59\xe6\x52\xe1\x2f\x98\xdb\xb0\xa8\xac\xac\x2e\x11\x7f\x25\x89\x60\x82\x72\xe0\x18\xda\x86\x21\x55\x7c\x79\x62\x49\x52\xc6\x1c\xb3\xa5\x87\x8d\xc9\x93\x18\x5a\x56\x78\x52\xd2\x70\x2a\x8d\xfd\xd3\x66\x91\x21\x35\xec\xaf\x76\x8d\xf4\x0d\x34\x52\xdf\xa3\xfa\x1e\xf5\x61\x7b\x14\x2d\x5a\x0c\xa1\xe2\x8b\x13\x4b\x90\x32\xa6\x98\x2d\x3c\x6c\x4c\x9e\xc0\xd0\xa2\xc2\x13\x92\x66\x33\x49\x02\x85\xbe\x1d\xd1\x5d\x8c\x33\x83\x80\x65\x72\x4a\x87\xae\x13\xbb\x73\x29\xbc\x49\x75\x04\xe4\x7b\x99\x87\x50\x32\x25\x42\xe8\x5c\x1c\x15\xcc\x23\x8d\x48\x4c\xa6\xd2\xc8\x0b\x8c\x24\xb4\xca\x87\x44\x28\x2a\x96\xcd\x02\x0a\x73\xf1\xe3\xae\x7e\x41\x8d\x10\x8a\x17\x53\x25\xc8\x19\x64\xfd\x84\x98\xee\x48\x5d\x80\x0c\xce\xab\x33\x36\xd1\x19\xb0\xb3\xa0\xf6\x02\x9e\x40\x7b\x1e\x3c\x25\x3c\xf8\x07\xcf\xa1\xc9\x83\x32\xed\x48\x4e\x15\x5d\x16\xc3\x6d\xd5\x1e\xe9\x52\xcc\x4c\xe0\x50\x58\x75\xeb\xf0\xd3\x8e\xa3\xa1\xc0\x45\xb4\x97\xb1\xd9\x0c\x72\x0e\xe5\x2e\xed\x68\x7d\x49\xab\xfe\x71\xd7\xc4\x46\x62\x0f\x86\xa3\x9c\xf7\x63\x30\x0c\xca\xc1\x79\xf1\x57\xbc\x2e\x43\xe9\xe9\x47\xf2\xf1\x9c\xd1\x87\x78\xdf\xa6\x3c\x5b\x2f\xdb\x1d\xcf\x35\xe2\x2b\x57\xb3\xd5\xe8\x8c\x5c\xee\xe5\xea\x8f\x4c\x0e\xe6\xe8\x92\x22\xe8\xac\xe5\x5f\xc6\x1c\xfe\x92\x2d\xc3\xcf\x5e\x1b\x15\x9f\xc9\x5a\x27\x45\x13\x82\x7c\x4a\x36\x5a\x6b\x81\x9f\x39\xd7\x79\xe0\xd4\x51\x6c\x15\x7a\xba\x6f\x43\x0d\xf8\x87\x51\x1d\xef\x0d\x57\x89\x9c\xec\xaa\x51\x2e\xea\x4c\xbf\x22\x67\xfb\xca\x10\x6a\x36\x0f\xb8\x82\x9c\x07\xc8\x11\x83\x2c\x44\x8e\xc0\xf2\x01\x9b\x52\xc7\x11\x4c\x36\x1a\x43\x18\xb9\x62\xc8\x12\xc0\xe0\x07\xc5\xae\xa0\x63\x9b\x15\x7c\x93\x82\x6d\xca\x66\xf0\xac\xc0\x65\xca\x42\x2f\x35\x81\x72\xf4\x45\x8e\xe9\x93\x41\x3a\x77\x07\xcc\x86\xe4\xbb\x50\x59\xe0\x99\x76\x54\x0e\x87\x33\x5d\xa9\x2c\xb2\x73\x6c\xb3\x1c\x9a\xd9\x2e\x15\xdb\xf8\xcf\x1a\x16\x7f\x67\x2a\x32\x1f\x73\x38\x51\x62\x36\x66\x8c\x74\xc7\x74\x0d\xf9\x9c\xe7\x66\xd5\x39\x5a\x79\xbc\xcc\xd7\x38\xd9\xfc\xcc\xd3\x3e\x85\xf0\x05\x4e\x57\x09\xa3\x72\x1d\xaf\x92\x6f\x14\x3a\x5f\xc5\x9f\x2a\x70\xc0\x0a\x26\x28\xdb\x09\x2b\xfd\x46\xf6\xfc\xe7\x7e\x80\x63\x31\x56\x7d\x81\xef\x90\x95\x7c\xe0\x43\xa9\xe7\x3b\x66\x05\xe8\x6c\xe7\x2c\x7f\x29\x64\x38\x68\x39\x1b\x61\x96\xd0\x67\xf0\x83\x2f\xe8\xb9\xa0\x3c\xf1\xc8\x44\xe5\x09\x74\x0e\x68\x73\x2a\x79\x82\x9b\x81\xc8\x12\x56\xbe\x98\x32\x05\x94\x23\x9a\xaf\xd7\x5b\x85\xd4\x7c\xee\xf9\x1e\x45\xa5\x85\x65\xc6\x24\xff\xdb\x91\xa4\xc3\x7b\xaf\xf4\x00\x2d\x90\x1d\xd8\x27\x10\xbc\xb6\x59\x5c\x34\x6a\x13\xe7\xa0\x91\xb3\xb2\x58\x73\x06\x7f\x82\x35\x2a\x5c\x1c\xd3\x30\xb8\x2b\x89\xdf\x4b\xb2\xa2\x99\xa2\xcc\x92\xbb\x33\x78\xab\x86\xe4\x07\x19\xa6\x32\xdf\x48\x7e\x58\x87\x47\x88\xdd\x23\x5d\x30\x48\xfc\x1f\xc1\x0d\x4d\x01\x5b\xab\x67\x5a\x08\x4a\x45\x21\x9b\x14\xa6\x58\xe4\x94\x9b\x7d\x9e\xf2\xe7\x66\x98\xa0\x80\x10\x8f\xe0\x50\x89\x47\x70\x9c\x77\x0d\x38\x4b\x2b\x7a\x12\x68\xcf\x19\x39\x9b\x51\x1d\x15\xd8\x1a\x05\x35\x9c\xa4\x15\xa0\x07\x33\x12\xee\x0a\x6b\x56\x16\x8b\x4d\x02\xf7\x86\xbc\xbd\xec\x24\xab\xec\xe4\x75\x73\x77\x0d\x38\x17\x76\xf4\x5a\xd6\xf1\xf5\xfa\x07\x05\x3e\x5b\x6b\xe2\x9d\x2f\x9f\xa0\x83\x5e\x19\x54\x9d\x0e\xb7\x0f\xe2\xcf\x92\xcb\xcb\x49\x79\x98\x95\xf3\x2d\x44\x93\xab\xda\xc2\x6d\x47\x18\x78\xaa\xd3\x6e\x72\xf5\x26\x54\xc3\x0e\xd2\xf9\x5a\x93\x11\x3b\xec\x85\x6b\xae\xec\xfa\x70\xad\x07\x0b\x71\xaf\x5e\x9e\x97\x5d\x9e\xd7\x56\x4f\x6a\xb8\x34\xaa\xef\xbb\xa1\x71\x3c\xfc\x36\x2b\x65\x94\x83\xaf\x5a\x1d\xc4\x05\x38\x2c\x3a\x0f\x87\x61\x75\xde\x9c\x6f\x61\xc8\x5a\x31\x64\x7d\x92\x27\x29\x94\x88\xfc\xb9\xd4\x7c\x3d\xfe\xec\x1b\xc9\x99\x38\xa0\x60\x7d\xd1\xc9\x39\x09\xd2\xfb\xd6\xf4\xbe\x35\xbd\x6f\x4d\xef\x5b\xd3\xfb\xd6\x7c\x5a\xdf\x1a\xf2\x11\x27\xcf\x4b\xbc\x27\x32\x47\xa0\x42\xed\x09\x21\x49\xac\x09\x6e\x77\xfb\xca\x02\x76\x00\xed\x59\x1d\xc7\x29\x2c\x8b\x55\x17\x2d\x86\x67\xe3\x77\x17\x97\x1a\xe3\xbc\x11\x30\xa4\x80\xf1\x88\xf3\x63\xe2\x8e\x38\x8e\xa0\x98\xd5\x2f\xab\x27\xcf\xac\x18\xcc\xa4\x89\x5d\xcf\x66\x36\x93\x1a\x6a\xe8\x1d\xcc\x3c\xc3\xe0\x8d\x7d\x39\x7c\xa9\x24\xfb\x2d\x64\x9b\x68\xd9\x5e\xee\x2c\xf0\x56\x24\xa9\x34\xd8\xed\x9e\x9c\x66\xb8\x47\x39\xa8\x59\xf9\x6b\x63\xd8\x93\x71\xbe\x31\xe4\x6b\xdb\xaa\xb6\xb8\xd8\x73\xaa\x31\xa2\x55\xc6\xb6\xe7\xe9\xaa\x55\x2b\x9e\xce\x66\x62\x1c\x83\xb3\xa0\x9c\x59\xed\x00\x62\x90\x1e\x26\x63\xaf\xad\xf1\xda\xad\xcc\xdf\x03\xb7\xba\x05\xe2\x77\xb0\xbb\xfb\x25\x46\xe9\x4e\xad\xc0\x71\x35\xb5\xc4\x6a\xce\xd4\xd6\x58\xed\x08\xf4\x56\x0e\x4a\x4f\x42\x6a\x6d\x7c\x68\x0b\xde\x6a\xe2\x6f\xc8\xaf\x9a\xb9\x29\xc1\xdc\xe5\x49\x99\xbc\x37\xbc\x26\x32\x74\x03\x0b\x67\x9f\xad\x19\xf9\xa2\xe0\x9b\x21\x2e\x66\x6c\x89\x25\xd4\xf8\xd1\x66\x0d\x5e\x4f\xa3\x71\xe6\x67\x55\x59\x8e\xde\x44\xbd\xd3\xf4\x9e\xac\xf1\xbe\xce\xbd\x08\xf7\xd2\x88\x2d\x87\x60\xbb\xdc\x9a\xa6\x9a\xb2\x88\x7f\xc2\x5c\xc0\x2a\x33\x0a\xd7\x0a\x76\xb4\x66\x11\xb3\x99\x18\x80\xd4\xea\xdc\xe8\x6c\xe1\x7c\x6c\x48\x98\x4c\xe3\x05\x3a\x22\xed\x86\x7b\x91\x56\xe3\x0a\x08\x57\xfc\xd7\xc3\xd2\x32\x65\xe5\x00\xbf\x1a\xed\xe1\xd9\xd7\xc8\xd5\xbe\x9f\x7c\xad\x78\xb1\x5b\xa4\x16\x6a\x6c\x32\xe5\x6f\xb0\xbe\xd0\x08\x51\x61\xe2\xed\x5d\x72\x72\x4d\x46\xb9\xe1\xa9\xb1\x39\xd8\x97\xe1\x19\xb1\x22\x92\x3f\xc7\xd1\x8f\xc6\x5e\xe4\x7b\x07\x60\xe9\xb5\x23\x87\x47\x61\xc1\x2d\x46\x3b\x48\xdf\x1b\x4f\x2d\xf6\x87\xf5\x78\x8c\xed\x61\xf4\xfa\x1d\x4e\xab\x7e\x14\x47\xcc\xbd\x6f\x73\x13\xfe\x06\x18\xf6\x97\xfd\x6c\xd0\x51\x78\x69\x45\xf6\x16\x30\x7d\x47\x0c\x87\x3a\x73\x0e\xa9\x04\x55\x20\x41\x43\xc3\x28\x06\x73\x46\xa2\x92\xb3\xc7\x85\x54\x2e\x9c\x8e\x6f\x83\xc5\xa6\xbb\xeb\x42\x01\xa6\x37\xbd\xed\x5e\x3f\xe9\x85\x3b\xad\x7e\x34\x17\xdd\x02\x8e\x8e\x2c\x32\xc6\xba\x51\x76\x36\x63\xdd\x54\x6e\x30\x28\xb4\x72\x14\x0f\x2b\xde\x5d\xdb\x90\xbc\x1d\x37\xdc\x3b\x5a\x27\xbf\x3f\xe1\x35\xa1\xd0\x3c\x81\x3d\xce\xe6\x22\xa8\x33\x68\x06\x56\xba\x0d\x38\x03\xe0\xdf\x2b\xac\xb0\x2f\xcf\x19\xf4\xe4\x4f\x75\xec\x0a\x78\xe3\xb6\x10\x5c\xc6\xb2\x4f\xa3\x5a\xf0\xf6\x2a\xe0\x79\x31\x1a\xef\x60\x96\x73\x58\x63\xe6\x78\x14\xe9\xae\xd1\x0c\x06\x6c\xd0\x47\x63\xe1\x09\x2c\x85\x94\x5e\x64\x1b\x14\x5e\x17\xda\x44\x4e\x5e\xe1\x50\x45\x55\xaa\xe1\x0d\xcc\x4a\x3d\x9a\x33\x63\x3a\x38\x03\x75\x30\x18\x3d\x4a\x7b\x6d\xb4\xf5\x6c\xa8\x2d\xd4\xf1\x8e\x94\x0c\xb0\xb3\x61\x2e\x52\xf9\x2a\x18\x2f\x27\x57\x07\xa0\xce\x90\x34\xeb\xf8\x18\x02\x2f\xf5\x4e\x16\x79\x70\xe6\xff\x06\x56\xcf\x9a\x1d\x28\x5d\x6a\xc3\x01\x32\x5e\xce\x19\xea\x26\x0d\x56\x27\x38\x84\xa1\x3a\x18\xad\x61\x20\xec\x0e\x6a\x49\x8f\xda\x09\x6b\x56\x3d\x0a\x6b\x1e\x62\x97\x1a\x50\x53\x09\xcf\x8b\xb2\x20\x10\x2b\xdc\xbb\x57\x46\xca\x49\xda\xb1\x6e\x30\x27\x90\xd6\x3f\x80\xa4\x2c\x00\x3e\x4e\x7c\x06\x89\xd9\x53\x93\xc6\xfb\x4d\x34\xf8\x8b\xb1\x8f\x5b\x5b\x42\x17\xcc\x30\x2f\xad\x5f\x97\x32\x46\x63\xba\xa3\x9c\xd5\x13\x54\xbe\x5e\xc7\xe6\xe5\xa4\xc4\x51\xaa\x79\xc5\x39\x0f\xb7\x7f\x1b\x5b\x46\x10\x22\x11\x3a\x9f\x22\x06\x7d\x8b\x27\xb0\x09\x15\x42\x23\x84\xdc\x04\xf1\xd6\x15\x2b\x1b\x8e\x83\x61\xc5\x93\xa8\x52\x27\x4c\xce\xc1\x9a\xd3\x46\x5f\xcf\x66\x75\x62\x0b\xa9\xc4\x9e\xa6\xe9\xc1\x3f\x07\xf3\x31\x44\xa3\xd3\xe1\x72\x52\x19\x1d\x0e\xee\x24\x2d\x10\x71\x01\x06\xcc\xea\xc0\x0a\xb9\xfa\x53\xcd\xb8\xd2\xf9\x24\xf7\x3f\x8f\x3a\xf6\xcc\xcb\x78\x4a\xb4\xaf\x03\x5d\xa9\xad\x42\x1d\x5e\xac\xdf\x4c\x32\x20\x42\x4b\xd2\xe1\xd0\xe4\x00\xa2\xc9\x11\xcb\x22\x9d\xbb\x10\xb9\xc1\x3d\xb3\xb1\x67\x36\xf6\xcc\xc6\x9e\xd9\xd8\x33\x1b\x3f\x2d\xb3\xf1\x70\x58\x4c\xfa\x86\x6b\x6a\x43\xe3\x9b\x09\x5d\xe1\x77\x85\xdf\x15\x7e\x57\xf8\x5d\xe1\x7f\xaa\xc2\x77\x5e\xea\xb1\x45\x19\x26\xfa\x74\xd4\xa4\x76\x8d\xdf\x35\x7e\xd7\xf8\x5d\xe3\x77\x8d\xff\x89\x1a\xff\x02\x6a\x3a\x55\x1b\xf9\x14\x43\xee\x43\xf4\xe9\xae\x90\xce\x74\x06\x8f\x9f\x9d\xd8\xe2\xa4\x21\xc6\xe7\xd4\xa4\x31\x05\x03\x62\xae\x0b\x35\xd9\x88\x37\x80\xf5\x98\x61\xa8\x06\x39\x0b\xe7\x43\xe0\x3e\x2a\xb0\x84\x78\xbe\xe0\xc5\x4f\xd4\xe9\x85\xc9\xd8\x03\x79\xab\x9b\xaf\x33\x78\x78\x6c\x3d\x91\xb1\x88\x79\xba\x21\x03\x90\xaf\x0f\xf8\x9a\x80\xa7\x03\xe8\xd5\xcf\x5a\xa5\x8c\x87\x88\xfd\x8a\xc1\x2d\xc6\x1e\xd5\x65\xec\x2f\x2c\x63\xc4\x03\x2f\x7a\xce\x9f\xd6\xf3\xc3\x62\x55\x2c\x3f\x8a\xab\x2f\x31\xa1\x00\x30\xdd\x65\xb1\xca\xc1\xa6\x86\x7f\xdc\x95\x70\x34\x90\xa6\x96\x13\x58\x57\x4e\xd2\xac\x40\xfb\xae\xc9\xbb\x26\xef\x9a\xbc\x6b\xf2\xef\xaf\xc9\x37\x75\xb7\x58\xf5\xb4\x97\xe7\x0b\x3c\xb6\x5d\x4e\x36\x9a\x16\xd9\x75\x5f\xd7\x7d\x5d\xf7\x75\xdd\xf7\x3d\x75\x5f\xb7\xf8\xba\xc5\xd7\x2d\xbe\x6e\xf1\x7d\x5b\x8b\x4f\xe9\x90\xad\x0a\x89\x02\x2c\x6a\xf4\xe8\x67\x3f\x81\x55\xc7\x2b\x91\x60\xca\x04\x8a\x77\x3e\x26\x26\x77\x27\x62\x4f\x88\xc7\xa6\x13\x75\x89\xd6\xf1\xc9\xc4\xcc\xd2\x90\xb5\x79\x97\xc1\xf2\xe9\xbd\xbb\x01\xd2\xeb\x49\x0e\x73\x11\x27\x42\xaf\xde\xc1\x02\x6e\x64\x5b\x39\x7e\xc9\xf8\x0f\x07\xfa\xdd\x28\x09\xbd\x0e\xb5\xd7\xa1\xf6\x3a\xd4\x5e\x87\xda\xeb\x50\x7b\x1d\x6a\xaf\x43\xed\x75\xa8\xbd\x0e\xf5\xbb\xd7\xa1\x6e\xf1\x13\x94\xd1\xa8\x61\x47\xad\xe8\x1d\x23\xb9\x56\x48\x0c\x0b\xe3\xa6\x4c\x9d\xf8\xcd\x19\x5d\x6a\x82\xf6\x00\x4e\x0f\xe0\xf4\x00\x4e\x0f\xe0\x7c\xe1\x00\x0e\xe8\xc1\x5e\x43\x06\x4a\xbc\xce\x86\xe0\x67\xaa\x61\x21\x2d\x2a\x72\x1c\x85\x86\x4b\xfa\x3e\x76\x0e\xf3\xcf\xe0\x9c\x9c\x88\x86\xe0\xd5\x5b\x1c\xe8\x35\xb1\xd2\xee\x0f\x66\xf5\xe2\xa8\xe6\xf8\xd5\x74\xf7\x07\xdc\x51\x12\x3f\xcf\xde\x3d\x25\x7e\x1e\x92\xbf\x9e\xdd\xb4\xc8\xe1\x31\xf1\x04\xf6\x84\x4f\xfc\xec\x94\x9e\xe6\xfd\xc6\xb8\x72\x2e\x12\x42\x77\x82\xe7\xdd\xde\x4e\x3a\x56\xd4\x36\xfd\x08\x57\x64\xf5\x8f\xbb\x02\x1a\x37\xda\x50\x58\x92\xfd\x94\x79\x28\x67\xf0\x32\x7e\xa7\x6a\x75\x01\x76\x52\xa2\x49\x1a\x59\x1b\x0d\x0b\x85\x52\x6c\x29\x95\x76\x7f\x48\x89\x14\x21\x2f\x54\x26\x2f\x86\x0d\x2e\x56\xf9\xa4\x28\xc4\xb5\x47\x3c\x52\x40\x30\x65\xb1\x06\x29\x2e\x7a\x17\xb3\xd2\x50\x5d\x0d\xb3\x74\xae\x18\x01\x84\x2c\x7b\x39\xdc\xe8\x96\xba\x66\x32\xcd\xb4\xb8\x28\xdc\xef\x11\xdf\x77\x7e\xd8\xd9\x75\x97\x31\xfb\x13\xcc\xef\x18\x23\xe9\x45\xd3\xc3\xc6\x3d\x6c\xdc\xc3\xc6\x3d\x6c\xdc\xc3\xc6\x3d\x6c\xdc\xc3\xc6\x3d\x6c\xdc\xc3\xc6\xdf\x3c\x6c\x1c\x6f\x50\x45\x20\xc7\xdb\x94\x50\x0a\x60\xb1\xc6\x9b\xc1\xcc\x45\x9f\xf5\x73\x44\x7a\x29\x49\xc1\xec\x96\x2d\x62\x15\x01\xc8\xb9\xa7\x8c\x20\x92\xe0\x7a\xca\x01\x78\xb7\x64\xf3\x3e\x34\x85\xb9\xcb\xf8\xc8\x64\xcc\x34\xc3\xaf\xb3\x59\xc7\x7f\x9a\x69\x7a\xd7\x53\xe9\x9e\x40\xf7\x04\xba\x27\xd0\x3d\x81\x2f\xe4\x09\xfc\x97\xbd\x2b\x5a\x8e\x5c\xc5\xa1\xef\xfd\x15\xf9\x81\xbc\x6f\xe5\x23\xb6\xb6\x76\xf6\x9d\x22\x58\x71\x53\xed\x36\x2e\xc0\x93\x64\xbe\x7e\x0b\xb7\x3b\x93\xdc\x1b\x90\xc0\x99\x9b\x4e\xe7\x54\x1e\xdb\x91\xb1\x10\x47\x08\x09\x1d\x44\x02\x88\x04\x10\x09\x20\x12\x40\x24\xf0\xcf\x47\x02\xaf\x0a\x37\x5a\x77\x7d\xa8\xd9\x40\xcd\x06\x6a\x36\x50\xb3\x71\xc1\x35\x1b\xa7\x4e\xf9\x85\xca\x34\xee\xbb\x0f\xff\x0a\xca\x0c\x73\x88\xe4\xd5\xe0\x8c\xce\x1f\x9a\x33\xd3\xf2\x5a\x50\x7e\x9e\x19\x21\x27\x4e\xd2\xa3\x9e\x3e\xfb\x4c\x63\x1d\x4a\xf8\xfc\x71\x4c\xda\x2f\x67\xf2\x61\x36\x26\x1b\x92\x73\xb3\xbc\xa6\x5b\xb3\xe4\xa7\xcc\x20\x43\xda\xb4\x27\x32\xec\x2c\x20\x48\x05\x5c\xc4\xd4\xa6\xcd\x59\xef\x27\xd3\xa6\xcb\xf4\xdf\xe7\x2a\x0f\x95\x2e\x6e\x59\x43\x2d\x92\x0a\x83\xdc\xc7\xf8\x8e\x9a\xca\xb8\x9d\x27\x3f\xe0\xf1\x9e\x6f\xa6\xcf\xcb\x10\x6e\x98\xe4\xc2\xea\x9c\x5a\x9d\x5c\xb1\x73\x13\x99\x5b\x8b\x93\x6b\x10\x2c\x77\x76\xbc\xf1\xb5\x39\x3d\x99\xe3\x63\x8c\xbb\xe9\x41\xb6\x66\x49\xac\x4d\xc1\x86\x0b\x36\x0a\x1b\xad\xb6\x51\xc1\x43\x7c\x43\x63\xc0\x2c\x60\x16\x30\x0b\x98\x05\xcc\x36\xc3\x6c\x79\xf8\xb7\x2f\x7b\xdd\xcc\xcf\x67\x8c\xde\x35\xbc\x1c\x39\x66\xe4\x98\x91\x63\x46\x8e\x19\x39\x66\xe4\x98\x91\x63\x46\x8e\x19\x39\xe6\x6b\xcf\x31\xbb\x31\xa6\x2e\x05\xf9\xb7\x30\x6f\xa0\xb1\x9b\x5c\x6b\x93\xf3\x85\xfe\xf9\x85\x5c\x58\xe9\xa0\xe6\x71\x25\x2e\xd6\xf7\xe5\xbb\x90\x79\x9b\xc0\x0d\x5e\xdc\xe0\xad\xbd\xc1\xab\x3b\xf2\x17\x90\x29\x4b\x59\x13\x75\xa4\xb8\x77\x19\xe7\xc5\xbc\x20\x4d\xa5\x5a\xee\x95\xde\xed\x5a\xec\xd6\x4d\x34\x96\x7d\x1e\xe7\xdd\x27\xef\x9e\x9e\x9b\xc6\xbe\x6c\x69\x37\xbd\x7b\x71\xb4\x09\x37\x7e\x23\x8a\x71\x1d\x85\x86\x8b\xcc\xdc\xab\xb8\x3b\xbc\x21\x0c\xdb\xf4\x98\x8a\xe3\x8d\x06\xcf\x02\x78\x16\xc0\xb3\x00\x9e\x85\xeb\xe7\x59\x00\x2d\x0d\x68\x69\x40\x4b\x03\x5a\x1a\xd0\xd2\x48\x68\x69\xc0\x47\x03\x3e\x1a\xf0\xd1\x80\x8f\xe6\x5b\xf1\xd1\x80\x88\x06\x44\x34\x20\xa2\x01\x11\xcd\x37\x21\xa2\x59\xc9\x5b\xf2\xa5\x0d\x8c\x42\xb7\x91\xc7\xe4\xd5\x75\xfb\x92\xf2\xd9\x55\x7c\xd5\x41\x3f\x1c\xde\xe9\x28\x59\x36\x5a\x6d\x0e\xdb\x4e\x51\xef\xbd\x3b\xb4\x1e\x2b\xa0\x14\x0a\xa5\x50\x28\x85\x42\x29\x14\x4a\xa1\x50\x0a\x85\x52\x28\x94\x42\xa1\x14\xea\xda\x4b\xa1\x4e\x39\xa4\xc6\x0b\xc6\xe7\x1d\x50\xe2\x1e\x48\x55\x07\xa6\x49\x4a\x47\x0f\x7a\x1e\xa2\x62\x8b\x87\x84\x72\x96\xab\xd7\x9b\xf8\x10\xce\x92\xa2\x9b\x6c\xe3\x37\xd9\x60\xb4\xef\xd4\x12\x02\xa8\x8e\x06\xfb\x93\x52\x23\x25\x6d\x87\x5c\x38\xc6\xd9\x3a\x3d\x99\x61\xee\xe8\xf4\x79\xfc\xc7\xf1\x82\x96\xaf\x6b\x17\x83\x92\x33\x94\x9c\xd5\x95\x9c\xf5\x14\xd7\x05\xb1\xc2\xce\xe0\x9a\x5a\xd7\x5f\x52\xf1\xda\x69\x20\xea\xc1\xbb\xe3\x1a\xa2\x7e\xfe\xa0\x6c\x47\xc7\xc9\xa5\x12\xd7\x36\xed\x9e\xe6\x48\xf7\xfd\xb2\x7d\xbc\x7f\x8e\xb9\xa1\x72\x7b\xbd\xb7\x82\xd6\x95\xda\x28\x2b\x49\x08\x34\x76\xdb\xb8\xc5\x5e\xa1\x05\x87\x7c\x59\xfd\x6f\xf7\x2f\x6f\x24\x6c\x90\x52\x6a\xff\x80\x9c\x00\x72\x02\xc8\x09\x20\x27\xf0\xa5\x73\x02\xe7\xc1\x2a\x6d\x0e\x8d\x88\x1f\x74\x18\x54\x3a\xe5\x52\x21\x64\x14\xc9\x29\x2f\x98\x3d\x1d\xb5\xf2\xd4\xdb\x10\x7d\xc6\x66\x78\xb3\x33\x5a\xe5\xc9\xae\xe4\x96\x2b\x80\x6d\xb9\xb0\xba\xa5\x55\x27\x57\xbc\xc4\x2a\x57\x85\x7c\xa9\x35\x08\x96\x2f\x39\x89\xe5\xbc\x6f\xcd\xbc\x6c\x7e\xf9\x09\x16\x4f\xf5\x83\x0c\xdc\x57\x68\x53\x00\xfb\xb0\x51\xd8\x68\xb5\x8d\x0a\x1e\x32\xda\xec\x49\xc5\x58\xd0\x8e\x40\xdd\x4b\x51\x35\xd0\x1a\x68\x0d\xb4\x06\x5a\x03\xad\xff\x18\x5a\x77\x14\x8c\xb7\x53\x74\x5e\x05\x02\xe2\x02\x71\x81\xb8\x40\x5c\x20\xee\x1f\x44\x5c\x1a\x4b\x9c\xc1\x92\x5c\x97\xfe\xe9\x5d\xe1\xe7\x85\x58\xed\x7e\x7e\xd8\x6d\x98\xcd\x74\x2c\x0e\x5f\x00\x5f\x00\x5f\x00\x5f\x00\x5f\xf0\xc7\x7c\x41\x39\x83\x08\x98\x05\xcc\x02\x66\x01\xb3\x80\xd9\x8d\x30\x7b\xaa\x0b\x2a\xd0\xeb\x08\x15\x7e\xca\x43\x7e\x80\x88\xf2\x1d\x29\xa9\xa8\x79\x51\xcc\xf2\x59\x2a\x44\xaf\x23\xf5\xcf\xed\x51\xc5\xff\x52\x35\xe2\xbf\xf5\x91\x7e\xac\xa2\x0a\xcf\xfe\x77\x51\xa8\xf0\xe1\x45\x70\xc5\x7f\x08\x3e\x7d\xf6\xc3\xdd\xa6\xff\x07\xa3\x00\x18\x05\xc0\x28\x00\x46\x01\x30\x0a\x7c\x1e\xa3\xc0\xf9\x20\x2c\xf3\xf3\xec\x87\x5d\xc3\x7b\x83\xf1\xfa\xa8\x8e\x64\xf6\x7a\xb4\x21\x63\xf4\xcc\x24\xa7\x46\x8a\x6b\x1f\xc4\xbb\x5d\x9b\x51\x0b\x3c\x83\x6c\x65\xc8\x57\x9b\x4c\x9e\x78\x95\x55\x2c\x04\xd9\xea\xaa\x10\x28\x5f\x55\xf2\x15\x25\x5b\x4d\xfc\x4a\x62\xed\x5e\xf8\x10\x83\xf0\x02\x6d\x09\x90\x1d\x36\xf6\x8d\x6d\x8c\x79\xe0\x15\xca\xad\xf7\x27\xc2\x73\x88\x94\xb1\x26\x4e\x09\x8b\xb4\xdf\x0d\x11\xef\x76\x6d\xe6\x03\xdc\x04\x6e\x02\x37\x81\x9b\x97\x8e\x9b\xaf\x5a\xbf\x9a\xbd\xb6\x99\x93\x1c\xe0\x1d\xf0\x0e\x78\x07\xbc\xbb\x2a\xbc\xcb\xce\x18\xd0\x0e\x68\x07\xb4\x03\xda\x7d\x79\xb4\x5b\xbb\x20\xee\x5d\x88\x79\x05\x73\xdf\x2f\xea\xee\x91\x9d\x93\x39\x90\x3a\x77\x41\x79\x70\x5e\xcd\xe3\x61\x74\x8f\x23\xdf\x11\x25\x3f\xa0\x32\x8d\x36\xc0\x1b\xe0\x0d\xf0\x06\x78\x7f\x61\xf0\xce\x0f\xf5\xf6\xdc\x94\x75\x57\x21\xf0\x60\x47\x0a\x36\xfc\x88\x9e\xf4\x3b\xf6\x58\xb6\x1b\x1d\xc2\x7c\x24\xe5\x5d\xea\x03\xea\xa9\x3b\x35\x2a\xcc\x98\x18\x6f\x82\xdd\xec\x75\x9a\xdb\xb5\xcd\x5e\xf6\x39\x91\xb9\xd0\x53\x4c\x9e\x60\xc8\x76\x1d\x13\xca\x99\xdc\x60\xcd\xf3\x26\x11\x8b\x7e\xb4\xdf\x56\x08\xb3\x08\x09\x6b\x1b\xb4\xf2\xa2\x62\xa5\x95\xcd\xfd\xf6\x65\xc0\xa5\x9f\x5f\x0f\xa5\xde\x8a\x6f\x6e\xf4\x63\x50\x56\x1f\xb7\x35\xda\x49\x42\x52\x15\xbf\xed\x5a\x6d\x0e\xfe\x1e\xfe\x1e\xfe\x1e\xfe\xfe\x62\xfd\xfd\x09\x29\x03\x15\xa2\x2c\xa0\x1c\x50\x0e\x28\x07\x94\xbb\x02\x94\x0b\x2a\xba\x03\x21\xd1\x88\x44\x23\x12\x8d\x48\x34\x5e\x63\xa2\xf1\x5e\x47\xb3\x57\x09\x9a\x29\xc4\xa5\xf5\x6d\x81\xb1\x83\x8b\x7f\xff\x2e\x2c\xdf\x09\x9e\x95\x05\x56\x1e\xb0\xf2\x80\x95\x07\xac\x3c\x60\xe5\x01\x2b\x0f\x58\x79\xc0\xca\x03\x56\x9e\xeb\x66\xe5\x01\xb5\x0a\xa8\x55\xea\xa8\x55\x3e\x80\x93\xc2\x3b\x43\x21\x7c\x44\xb6\x78\x15\x95\xfb\x99\x1d\x0a\x17\x37\xde\x9e\xdf\xd0\xa2\xa9\xd4\xc5\xdd\x8d\x4d\x2a\xf2\x94\x7a\x54\x9e\xb7\x15\xf6\x41\x85\xd9\xe4\x3f\x94\x5b\x61\x6b\x7a\x55\xb9\x51\xbd\x09\x16\xef\x76\x2d\x0e\x3c\x2c\x65\x02\x85\x1e\x13\xc5\x6f\xcb\xeb\xfb\xf6\xb5\xe4\x5d\x85\xae\x07\xd7\x77\x63\x3d\xfb\xed\x64\x9b\x2d\x58\x4f\x53\xd3\xff\x81\xf4\x16\xa4\xb7\x20\xbd\x05\xe9\x2d\x48\x6f\x41\x7a\x0b\xd2\x5b\x90\xde\x82\xf4\xf6\xca\x49\x6f\x25\x17\x2b\xb2\xd2\xed\xd8\x53\x88\xe4\x55\xe7\x8e\xd9\x8b\xb7\x52\x19\x34\x76\x93\xb3\x63\x6c\x92\x72\x4e\x2e\x15\xd7\x2b\x23\x23\xbf\x32\x9a\xe3\x85\x75\x0b\xff\xce\x2f\x67\xbd\xef\x2a\xe6\x6b\x70\x7d\x6f\xc7\xfe\xdd\x44\x6c\x61\x88\x83\xeb\x7f\xdd\xed\xea\x76\xf3\x88\x03\x10\x07\x20\x0e\x40\x1c\x80\x38\x00\x71\x00\xe2\x00\xc4\x01\x88\x03\xae\x3c\x0e\x28\xef\xbe\xf9\x2d\xdf\xe4\x7c\xe4\x46\x57\xc6\x82\x42\x49\xad\x6c\x08\xc2\xd2\x5a\xb9\xb0\xba\xf2\xc7\x3a\xb9\xe2\x32\x48\xd1\xe4\xbe\xfd\xcb\xc7\x73\x1b\x05\xcb\xcb\x22\xa5\x6b\x56\x12\x46\xd5\x97\x48\x0a\xec\xbd\xfa\x41\xa6\x24\xb7\x42\x9b\x82\xd2\x5c\xd8\x28\x6c\xb4\xda\x46\x05\x0f\x6d\x6b\xd6\xce\xbc\xa0\xff\x65\x33\xe1\x25\xa7\xe5\x7d\x8c\x93\xb2\xdd\x40\xe5\x7d\x16\xe7\x45\xdc\x1c\xa7\x39\xd5\x45\x9a\x61\xee\x48\x71\xe7\x39\xf9\xf1\xfc\x55\x90\x3d\x52\x9b\xa0\xd3\x96\xaf\x10\xef\x71\x9f\xb4\xee\x69\x07\xa2\xa9\x45\x40\xde\x60\x53\xcf\xe7\x93\xc7\xdf\x55\x4c\xf3\xe0\x0e\xf6\x6e\x57\x87\x28\x7a\x8e\x4e\x0d\xfa\x9e\xda\x8b\x28\xf4\x30\xb8\xc7\xdc\x8f\x37\x37\x36\xd2\x31\xfb\xbf\x22\xcb\xfe\xfd\x90\xf6\x5e\xe7\x16\xee\x32\x0c\xb5\xb7\xfd\x5e\x19\xed\x3b\x3b\xea\xc1\xc6\xe7\xcf\x1f\x57\x47\xe3\x05\x8c\x22\x21\x7a\x98\xb4\x29\xc0\x3a\xb7\x5c\x8a\xb6\x87\x63\x49\x1c\x4b\xe2\x58\x12\xc7\x92\x38\x96\xc4\xb1\x24\x8e\x25\x71\x2c\x89\x63\xc9\x6f\x70\x2c\x09\xea\x17\x50\xbf\x80\xfa\x05\xd4\x2f\xd7\x4b\xfd\x02\x78\x03\xbc\x01\xde\x00\x6f\xd7\x0a\x6f\x6e\x7c\xb0\xfd\xec\x49\x1d\xe6\x7b\xf2\x23\x45\x0a\xc5\xd3\x60\x4e\x0f\x9d\x77\x93\x5a\xef\x11\x66\xa7\x9f\x13\x42\x4f\xd1\xeb\xe2\x30\x74\xd7\x2d\x77\x08\xf5\xf0\x1f\xd6\x1a\x59\x7b\x60\x74\xb4\x8c\xc6\xc4\x8f\xd2\x90\x1d\x03\x99\xa4\xf1\xd8\x2a\x21\xab\x57\xb8\x24\xb8\x24\xb8\x24\xb8\xa4\x2f\xed\x92\x2e\x05\xf6\x07\x3b\x92\x2a\xb5\x37\x60\x5e\x30\xe9\x10\x1e\x9d\xcf\x28\x1d\x50\x0d\xa8\x06\x54\x03\xaa\xbf\x34\x54\x7b\x3a\xba\x9f\x94\xba\x20\x64\x26\xb3\x58\xdc\xc0\x6a\xba\x5c\xd4\x10\x69\xd4\xe5\xba\x9d\xac\xe8\x6c\x9d\x15\xf7\x7f\xe0\x05\x02\x2f\x10\x78\x81\xc0\x0b\x74\xad\xbc\x40\x85\x1f\x47\x7a\xf4\x34\xbc\xc7\xa8\xb6\xa1\x4d\x0e\x20\x13\x90\x09\xc8\x6c\x87\xcc\xff\xb3\x77\x75\xcb\x91\xab\x3a\xf7\xbe\x9f\x62\x5e\x20\x55\xbb\x6a\xbe\xef\x26\x2f\x71\xce\x1b\x50\x04\x2b\xdd\xec\xd8\xe0\x83\x70\x3a\xd9\x4f\x7f\x0a\xbb\xbb\x67\xf6\x54\xf3\x63\x91\x39\x35\x93\xbd\x2a\xb9\x6b\x5b\x06\x21\x16\x02\x89\x25\xd8\xd8\xff\xc2\xc6\x2a\x0f\x24\xd2\x56\xb5\x04\xfb\x58\x78\x39\xab\xc9\xd1\x1a\x72\x4c\x80\x48\x40\x24\x20\x12\x10\xf9\x29\x21\xb2\xf0\xa3\x5b\xc6\xf1\x6e\x86\x64\xe1\x1d\x3f\x27\xc4\xd4\xc1\xdc\x49\xef\x05\x6d\x0a\x68\x53\x40\x9b\x02\xda\x14\xd0\xa6\x80\x36\x05\xb4\x29\xa0\x4d\x01\x6d\xca\x3f\x9a\x36\xe5\x69\x19\x5f\xd4\xb5\x36\xc0\x8d\x84\xb0\x3c\x83\x2a\xdf\x34\x7a\xa5\xf8\x97\x3a\x91\xd8\xac\x63\xb3\x8e\xcd\x3a\x36\xeb\xbf\xec\x66\xfd\xcb\x17\x33\x5a\x72\x11\x17\xbb\x70\xb1\x0b\x17\xbb\x70\xb1\xeb\xd3\x5e\xec\xda\x50\x2e\x3b\x50\x00\x39\x80\x1c\x40\x0e\x20\xf7\x49\x40\x4e\xa5\x74\xf4\xc7\x83\x6c\xc0\x81\x74\x40\x3a\x20\x1d\x90\xee\x57\x46\x3a\xef\x62\x82\xba\xfc\x79\x62\x45\x9b\x66\xe1\xe8\x27\x75\x22\x3d\x50\xe0\x0e\x11\xf6\x2f\x52\x91\xa6\x79\xd4\x51\xd6\x92\x41\x47\xad\x2e\xa5\x0c\xc9\xe9\xa7\xdc\x61\x63\x6d\x24\xbf\x97\x93\x37\xb8\x1d\x8d\xb9\xf6\xaa\x43\x1a\x3d\xeb\x65\x8c\xea\x5b\x08\x5f\xbd\x52\xe0\x6c\x64\xae\x22\xae\x97\x15\x5b\xaf\x35\x31\x53\xee\x95\xb2\x05\x33\x6f\xc3\x89\x86\x45\xb2\x5d\xd8\x3e\x20\xdb\x27\xb7\x19\xd0\x1a\x46\xe0\xc7\xbf\xbc\x65\x74\x0a\x6e\x07\xb8\x96\xa9\x21\x01\xba\x36\xb0\x6b\x80\xaa\xdd\x0f\x56\x16\xd7\x1d\xda\x6c\x58\x64\x61\xa3\xb0\xd1\xdd\x36\xda\xf0\x90\x66\x5e\x26\x52\xc1\x8f\xa4\x74\x28\x64\x62\x00\x6d\x81\xb6\x40\x5b\xa0\x2d\xd0\xf6\x83\xd0\x96\x89\x93\x87\x5d\xf0\xda\x01\xbb\x80\x5d\xc0\x2e\x60\x17\xb0\xfb\x81\xb0\x7b\xa6\x27\x65\x87\x94\x42\x1b\xdf\xd5\x5a\x3d\xab\x90\x38\x06\x04\x06\x02\x03\x81\x81\xc0\x40\xe0\x5e\x04\x3e\xb3\x62\x0a\xaf\xd6\x94\x8e\xa9\x1b\xb5\x4e\x86\x95\xf1\x2e\x6a\xeb\x28\x28\x13\x68\x85\x73\x3d\xb2\x0a\x34\xea\x68\x5f\x0b\xf7\x7e\x81\xe8\x40\x74\x20\x3a\x10\x1d\x88\xde\x89\xe8\x81\x9e\xd3\x7d\x8d\xbf\xc1\xef\x07\xdd\xf9\x3a\xf6\x5e\x09\xdc\xc2\x1f\xea\x5b\x1c\xf1\xf1\xd0\x67\xc2\x58\x0b\xb0\x16\x60\x2d\xc0\x5a\x80\xb5\xe0\xee\x5a\xc0\x91\x7f\x70\xc3\xcb\x10\x0e\xd0\x05\xe8\x02\x74\x01\xba\x00\xdd\x0e\xd0\xfd\xa9\x55\xc9\xe9\xcd\xd0\x9a\x36\x53\x64\x72\xa9\x69\xfc\x59\xdb\x51\x79\xa7\xe6\x25\x46\xeb\x8e\xb7\xfc\x4b\x75\x25\xb3\x30\x44\x83\x50\xf4\xa8\x63\x24\xa7\x4e\x9a\x4f\xc4\x1f\x21\x43\x31\xcd\x3a\xe8\xe8\x33\x14\x18\x15\x95\x9e\x3c\x47\xf1\x8b\x2c\x7b\x33\x15\x80\x4f\xe3\x43\x6e\x10\x09\xb0\x43\x7e\x73\x54\x7b\xf5\xe8\x7c\x20\x75\xb3\x13\x59\x0f\xae\xb5\xe2\xad\x1b\xe8\x4d\x59\xa7\x2a\x14\xd0\xf9\xb1\xbc\x55\x9d\xd7\xc7\x5a\x9f\x1a\x84\xd8\x89\x38\xea\x49\x68\xf6\x5b\x6f\xf2\xa8\x58\x53\x0a\x4f\x6a\xf6\xa3\x35\xf9\xf4\xd7\x8a\x84\xd1\x1f\x95\x67\xf5\x7f\x7f\xfc\xa1\x02\x69\xf6\x4e\xd6\x8f\xd1\x1f\x39\x6a\x3e\xa9\x41\x47\xea\x28\xb9\x70\x93\x53\x97\xd1\xd0\x98\x39\xd0\xb3\x7d\xeb\x6b\xc8\x26\xa3\x73\xc6\x27\x5a\x9a\x0d\xc8\x7e\xc4\x37\x14\xa6\x40\x61\x0a\x14\xa6\x40\x61\x0a\x14\xa6\xf8\x7b\x61\x8a\x3c\x55\x5b\x45\x8b\xb3\x9d\x29\x15\xfe\x91\xbd\xec\x73\x8c\x19\x35\xd6\xb0\xb4\x4c\x50\x50\xfe\xcf\x14\xbb\xb4\x7a\xb4\x7f\xe5\xf8\xd1\x6a\x03\x16\xc8\x78\xe7\xc8\xc4\xe4\x0e\x53\x08\x5e\x2c\x67\xf4\x7a\x50\xfa\x39\x52\x10\x29\xe3\x22\xe0\xd2\x9a\x9a\xe3\x56\x6d\x88\x77\x2a\x39\xf9\x4b\x20\xa9\x98\x5b\xa9\x92\xa4\x99\x65\x1e\xa4\xcb\xe7\x5d\x49\x62\xf7\xf6\x46\x4a\x55\xa2\x62\xab\xca\xe0\x25\x84\x34\xe6\x3d\xc3\x95\xfc\x8b\xa8\x8f\xb2\xb7\xfd\xb2\xee\xbb\xa4\x5a\x60\x73\xa2\x89\x64\xaf\x3a\x9b\x48\x5d\x95\x19\x35\xb3\xdc\x17\x66\x1e\xd3\x55\x34\xfb\xfc\x2e\xb3\xaf\xcb\xfb\xe2\xab\x6c\xbc\xcc\x2b\xdb\xaa\x1a\xbc\x51\xe7\xa0\x85\x3b\x82\x86\x5d\x49\xb6\x09\x51\x87\x23\xc5\xcb\x16\x49\x2c\xe4\xba\xf3\x06\x49\x1a\x48\xd2\x40\x92\x06\x92\xb4\x4f\x4a\x92\x76\x3b\x61\xcc\xab\xb6\xa2\xce\x9b\x84\xc4\x51\x7c\x0e\xb6\xec\x8e\xe4\x15\x78\x95\xc3\xb2\x56\xd8\xa9\x5a\xa8\xb9\xfa\x72\x4f\x99\xcc\xc4\x6f\xaa\x66\x1d\x98\x36\x77\x55\xec\x83\x6c\x82\x02\x19\x2b\x5e\x84\x17\x97\xdc\xe7\x57\x0a\xe9\xd2\xfd\xb5\x39\xef\xb3\x50\xb5\x0b\x0b\x7d\xb1\x25\x9a\x6d\x15\x96\x19\xc4\xe6\xc6\xa4\x73\xb9\x8b\x43\xb2\x52\x9b\x47\x1d\xa2\xf4\x78\xfd\x6c\xe3\x49\xc5\xa0\x1d\xcf\x3e\x44\x0a\x6a\xf4\x47\xa1\xa4\x64\xe7\xe9\xfa\x7f\xd0\x79\x3e\xee\xa2\x7a\x0a\xf3\xd2\xdf\x63\xb8\x29\x83\xae\xfe\x8e\x02\x60\x3b\x2a\x78\x3c\xc8\xa0\x1b\x3e\x0b\x7c\x16\xf8\x2c\xf0\x59\x7e\x61\x9f\xa5\x81\xee\x04\x38\x07\x9c\x03\xce\x01\xe7\x7e\x6f\x9c\x5b\xa2\x4f\x69\x82\xe9\x18\xea\x69\x31\x2f\x39\xa7\xae\xd6\xfd\xfa\xbb\xd9\xf1\x40\xa5\x29\x54\x9a\x42\xa5\x29\x54\x9a\x42\xa5\x29\x54\x9a\x42\xa5\x29\x54\x9a\x42\xa5\xa9\x4f\x5e\x69\xca\x9c\xc8\xbc\x74\x79\x9b\x9b\x84\xed\x03\x32\x09\xc9\xa1\x58\x93\x25\x4c\x30\x5d\xcc\xb1\x65\x5e\xd5\x8a\xaa\x4a\xb1\x80\xba\xeb\xab\x87\x41\x39\x3a\xe7\x73\x70\x5a\xda\x9f\xfe\xae\x55\xbf\xba\xa7\x58\xd1\x6c\xc8\x2d\x85\x4d\xea\xc3\x17\xbf\xc4\x95\xf5\xa5\xf0\xc8\x9f\xec\x73\x7d\x48\x3b\xaa\x31\xf2\x6b\xe1\x67\x53\xfc\x75\xe2\xe3\xac\xcd\x4b\xe1\x89\x94\x92\x5e\xf8\x99\xad\x3b\x8e\xa4\xd6\x0d\xb9\x5c\x8b\x95\xb9\x73\xa2\xb7\xcb\x1a\x56\x74\x56\x6a\x0b\xe2\x1a\x32\xe9\x09\x45\x75\x46\xb2\x12\xa9\x71\x79\xa1\xab\xf5\xc0\x33\x2b\x1e\x5e\x52\x78\x45\x0d\x36\xc8\x5a\xd1\x17\x4d\x14\x67\xce\xad\x9e\x74\x57\xef\x39\xa6\x44\x7f\xcd\xa2\xcf\x2f\xf3\x87\x20\xdf\x59\x07\x97\x4c\x48\xad\xdb\x49\x41\x4b\xf2\x07\x25\x0f\x77\x82\x4d\xf7\x1e\xfa\xfe\x90\xf6\xce\xef\xdb\x12\x73\xe7\x87\x2b\x68\x1f\x76\xcc\x3e\x1f\xc7\x3b\x3b\x5a\x14\xd1\x46\x11\x6d\x14\xd1\x46\x11\x6d\x14\xd1\x46\x11\x6d\x14\xd1\x46\x11\x6d\x14\xd1\xfe\x47\x17\xd1\x2e\xfb\x30\x15\xe9\x57\xaf\x54\xf4\x72\xb1\x18\x8f\x8d\x34\x65\x7e\x6a\x71\x44\xeb\x81\xd7\x4a\xe3\x9a\x82\xdd\x6d\x2d\x69\xcc\xab\xd8\x27\x70\x5f\xfc\x7b\xbf\xec\xe6\x58\xf8\x0e\x6d\xee\x19\x9e\x2e\xe1\xed\x31\xf2\x76\x90\x69\xdb\x06\x4a\xa2\xe6\x4d\xd3\x54\xf4\x68\xd5\x7c\x77\x69\xb7\x21\x6f\x03\x36\x0c\x1b\xfe\x50\x1b\x6e\x78\xac\xd6\x91\x87\x75\x29\xc8\xfe\x58\x3a\xfc\xac\x7e\x7d\x7b\x40\x87\xa0\xef\xa9\x67\x0e\x3e\x7a\xe3\x33\x43\x58\xb1\x88\x40\xec\x97\x60\x48\xe9\x18\x83\x7d\x5a\xb2\x57\x00\xf4\x30\xd8\x0d\xee\xfe\x5d\x9d\x1b\x55\x2b\xac\xf4\x98\x29\xa5\x9f\xc7\x77\xf9\x9d\x82\x31\xd3\xb6\xfa\xbc\x36\x5a\x95\xb7\x97\x6d\xd0\xd0\xb8\x12\xb7\x09\xdb\x8f\x60\xed\x72\x77\xa1\x57\x75\x60\xa5\xc8\xb5\x53\xf0\x3e\xd4\xda\x87\x59\xed\x88\xd5\x86\x57\xd5\xd9\xbd\xf3\xc1\x86\xd5\xb6\x51\x9b\x8d\x2b\x2d\x6c\x14\x36\xba\xcb\x46\x1b\x1e\x32\x14\x22\x70\x16\x38\x0b\x9c\x05\xce\x02\x67\x7f\x1e\xce\x5a\xc7\x64\xb2\x7c\x27\xad\x0a\x99\x83\x7d\xbd\xf0\x93\x00\xb4\x01\xda\x00\x6d\x80\x36\x40\xfb\x67\x81\x76\xf1\x81\x7c\xdf\x64\xb9\x3b\x81\x06\x7b\xc7\xc2\xca\xd6\xa7\xc7\xd1\x9f\xd5\xb0\xcc\xa3\x35\x0d\xb4\x55\xf9\x71\x43\x16\x10\xb2\x80\x90\x05\x84\x2c\x20\x64\x01\x21\x0b\x08\x59\x40\xc8\x02\x42\x16\xd0\x27\xcf\x02\x1a\x9e\x94\x5b\xa6\xa7\x1c\xd8\xd4\x26\x73\xe9\x5e\x05\x6e\x05\xe1\x56\xd0\x9d\x5b\x41\xd2\x3a\x17\xe9\xe0\x2c\xc4\x34\xc4\x17\xfa\x7d\xb0\xe4\x83\x25\x1f\x2c\xf9\x60\xc9\x07\x4b\xfe\x0f\x2c\xf9\x62\xbe\x7a\x8e\xe1\x39\x79\x53\x3d\xd7\x25\x63\x1c\x25\x1f\x2f\xf4\x89\xbf\x3e\x1e\xf6\xd9\xab\x36\xa3\xa8\xed\x9a\x79\x99\x48\x05\x3f\xd2\xf7\xf5\x08\x1f\x0f\xb2\x29\x33\x2c\x1b\x1d\xe6\x65\x6f\x92\x7d\xae\xda\xae\xf4\x4f\x6f\x91\x82\xd3\x63\x96\xe3\xad\x51\xce\x56\x19\xa8\x4b\xc4\xaa\x1f\x1d\xfa\xce\x0a\x56\x21\x4c\x9c\x58\x5d\x0b\xfc\xee\x4d\xd2\xca\xd3\xf3\xe1\xd6\xe0\xd2\xcf\xdf\x37\x45\x32\xeb\xf6\xb1\x53\x65\x3b\xa3\xcf\xac\xac\x9e\xd6\x0a\x67\x59\xd3\x6a\x90\x01\x36\x40\xb0\x01\x82\x0d\x10\x6c\x80\x9f\x97\x0d\xf0\xcc\x69\x5d\xcd\x6f\xf9\x81\x72\x40\x39\xa0\x1c\x50\xee\xb7\x46\x39\xc4\xe3\x11\x8f\x47\x3c\x1e\xf1\x78\xc4\xe3\x11\x8f\x47\x3c\x1e\xf1\x78\xc4\xe3\x3f\x79\x3c\x7e\xa3\x0b\xd5\xb3\x4d\x1a\x4c\x47\xc7\xa9\xc0\xd3\xe3\x41\xf0\xa9\x56\xea\xd2\x8a\x80\x3a\x73\x69\x5e\xc0\xb8\xf0\x7a\x68\x3d\x91\xec\x7d\x3f\xcd\x4b\x24\xb5\xf6\x84\x97\x89\x45\x52\x36\xc6\xd4\xad\xce\x55\xaa\x28\x9a\xd8\xfa\x46\x79\xa1\xaa\x35\xe7\xc1\xd0\x7a\xeb\x42\x71\x7c\x1f\x49\x2a\x04\x89\x13\x48\x9c\xd8\x91\x38\x71\x0c\xda\xc5\x6d\x33\x67\xbc\x8b\x41\x48\x2f\xb0\x89\x49\xfe\x6a\xe7\xeb\x4a\x9b\xb9\x43\xc4\x5a\x1a\x51\x2c\x63\x17\xb7\x6c\x56\x4a\x37\xb5\xac\x75\x1c\xb5\x4b\x68\x10\xfc\xb3\xfd\x98\xa8\xe1\x29\xc6\x59\xd5\x49\x67\x1b\x5a\x77\x93\x56\x27\x71\x6d\x94\x66\x67\xa5\x87\xa1\x7b\x8f\x9d\xaf\xa8\xde\x28\xa0\x18\x1d\xfb\x88\xc9\xe6\x1d\xd1\x7b\x4b\x1c\x3c\x0f\xaf\x4d\xf5\x3f\xb3\x2d\x9c\x75\xf8\xcf\x42\x51\x6a\x44\x97\xd7\xd5\xf5\x48\x24\x45\x36\x8d\x1f\xc8\x74\xa8\xec\x9b\xd4\x39\x2d\x19\xdd\x8e\xd4\x55\x5a\xf0\x67\x75\x0c\x7e\x99\xfb\x45\xae\x05\xc7\x75\x5e\x44\x5d\x71\xe9\xef\xd9\xd2\x58\x3a\xd8\xaa\xd2\x94\xed\xfb\xdc\x9e\xb3\xd3\x26\x2d\xec\x3d\xeb\x6c\xb3\xe7\x3d\x0b\xbe\xa8\xb9\xad\x8d\x2d\xf2\xfa\x5c\xff\x1e\x56\x45\x55\x1e\xaa\x80\x40\x2b\xdd\x4f\xfb\x10\x36\x69\xa3\xae\x87\x87\x8b\x89\x1e\x3a\x3a\xb6\x4d\x95\x42\x3d\xf7\x76\x03\x6e\x88\xa3\xed\x9b\x0d\xed\xb1\x8e\x7d\x72\x9b\x63\x1e\xcd\x63\xb5\x67\xf0\x85\x82\xdb\x63\x20\xfb\x67\x71\xeb\x94\x6b\x89\x87\x34\xda\xdd\xae\x07\x2b\xf1\xb7\x1d\xda\x6c\x88\xc3\xc1\x46\x61\xa3\xbb\x6d\xb4\x1d\x69\xcb\x2b\x66\x55\xe1\x95\x0f\xcd\x3a\xc4\x95\x0d\x2e\x63\x60\x75\x03\x1c\x74\x2c\x3b\xbc\x4d\xcd\xdc\x8c\x8c\x67\x6d\xaa\x9d\x2d\x19\x40\xb5\xb7\xc2\x1d\xdf\x1c\xfc\xdb\xbb\x5a\x82\x15\xbd\xcd\x5f\x7b\xce\xd3\xf8\xab\xba\x5e\x08\x97\xbe\x3f\x51\xd4\x83\x8e\x5a\xfa\xfe\xa6\xd0\xde\xda\xfe\xfc\x55\x05\x3a\x4a\x8f\xcf\xf8\xa4\x03\x0d\x1f\xb1\x53\xee\x0e\x6f\x5d\x77\xed\x65\x5c\xec\x9d\x9a\x6c\x8f\x4e\xc7\x25\xd0\xb5\x70\xff\xe3\x41\xf0\x19\x66\x52\x66\xe1\xe8\xa7\x74\x86\x39\x1e\x7d\xb0\xf1\x34\xf5\x8b\xca\xae\x35\x3b\x85\xa8\x69\xf8\x7f\xa9\xa0\x97\xa9\x9c\xb0\x5a\x95\x30\x26\xd5\xda\xe7\x77\x35\x13\x05\x99\x8c\xe8\x43\xda\xd5\x9a\x51\x33\x8b\x25\xc8\xeb\xdc\x70\xca\x1a\x76\xc3\x98\x66\x06\x09\x4f\xfc\x17\x4e\x69\xcc\xe1\x95\x82\x62\x3b\x90\x22\x67\xc2\xfb\x2c\x3e\xe7\xfe\xa9\x45\x73\x6e\x50\x7a\xd8\x31\x9b\x6e\x31\xc5\x7f\x2d\x71\x5e\xe2\x5d\xcf\xab\xd0\x22\x76\x77\x06\xa7\x0c\x33\x48\xa5\x46\x2a\x35\x52\xa9\x91\x4a\xfd\xe9\x53\xa9\x3b\x1c\x3a\x64\x62\x23\x13\x1b\x99\xd8\xc8\xc4\x46\x26\x36\x32\xb1\x91\x89\x8d\x4c\x6c\x64\x62\x23\x13\x1b\x99\xd8\xc8\xc4\x46\x26\x36\x32\xb1\x7f\xe3\x4c\xec\x35\x54\xf4\x78\x10\x88\x66\xc7\xea\xc9\x0f\xf2\x62\x49\x37\x01\x91\xa6\x79\xd4\xc2\x14\xb2\x24\xa5\x2f\xe0\xe4\x58\x5d\x13\x84\x7f\xa9\x62\x54\x77\xdb\x95\xb4\xfd\x8b\x34\x8e\x17\x79\xd2\x3c\x3b\xfe\x2f\x7b\x57\xcf\xdb\x36\x0c\x44\x77\xfd\x0a\x21\xbb\xc7\xb6\x81\xd6\x2e\x9d\x3a\x76\x09\x02\x81\xa1\x2e\x8a\x60\x99\x54\x48\x2a\x45\x50\xf4\xbf\x17\xd4\x87\x13\x24\x22\x19\x9f\x9c\x36\x76\xdf\x98\x18\x47\x9d\x45\xea\xf9\xde\xdd\xe9\xdd\x6c\xbf\xea\xfc\xcc\x6b\xac\x3e\x42\x4e\x77\x8d\x8c\x94\xc8\xa2\x4b\x84\x29\xde\xe6\xc5\xe2\xd9\x01\xf7\xd9\x76\x6d\xaf\xb6\xdf\x96\xba\x19\xe3\x54\x0c\x24\x0e\x24\x0e\x24\x0e\x24\x0e\x24\x0e\x24\x0e\x24\x0e\x24\x0e\x24\xee\xcc\x49\x9c\x8c\x75\xb8\xa3\x2e\x89\xba\x24\xea\x92\xa8\x4b\x9e\x74\x5d\x32\x3a\x1d\x1b\x08\x07\x84\x03\xc2\x01\xe1\x4e\x1b\xe1\xda\x86\x94\x8b\xb4\x45\x03\xe5\x80\x72\x40\x39\xa0\xdc\x39\xa0\x5c\x70\xa3\x00\x72\x00\x39\x80\x1c\x40\xee\xb4\x41\x4e\x93\x91\x54\x3a\x5d\xf6\xee\xf6\xb2\xc8\x38\x5f\xdd\xbf\xff\x1a\x49\x37\x27\xb6\x23\xa6\xed\xf2\x37\xdb\x04\x62\xaf\xdf\x42\xff\x0d\xfa\x6f\xaf\xf5\xdf\xee\x48\x96\xec\xe1\x79\xde\x38\xac\xba\x95\xaa\x69\x79\x6b\xa7\xb7\xa4\xb8\xe7\x15\xa1\x09\x42\x13\x84\x26\x08\x4d\x3e\x70\x68\xc2\x87\x56\x6d\x23\xb4\x2d\x61\xdc\x54\x2d\xc5\xab\xf0\x29\x6c\x1e\x34\x2c\x79\xd7\xf6\x96\x7c\xcf\x95\x25\xe9\x45\x2d\xac\x0d\x1c\x9a\xd4\x41\xd9\x12\x75\x91\xa6\xcd\x94\xf9\xce\x6b\x40\x8e\x8d\x89\xec\x2f\x31\xad\x31\x40\xc7\xca\x45\x6c\x79\x6b\xf4\xae\xa4\x07\x52\x8e\xf7\x85\x94\x56\x43\x58\x5c\x1a\xea\x5a\x21\x69\xe7\xf3\x01\xe3\x55\x59\x7e\xa5\x35\x44\x53\x67\xab\x33\xda\x69\xc9\x14\x9a\x4d\x8b\x8e\xa6\x2e\x6f\x75\x6f\x24\xb1\x2e\x3e\x9a\xb2\xb7\x74\x34\x67\x93\x8c\x27\x73\xbe\x07\xb6\x2d\x65\xd3\xdd\x91\xb1\x0c\xfb\x30\xf2\x6e\xf6\x71\x64\xe0\xa3\x21\xce\xcb\x0e\x00\x4f\x7b\xbf\xe0\x21\x54\x35\xa0\xaa\x01\x55\x0d\xa8\x6a\xfc\xe7\xaa\x1a\x90\xc5\x80\x2c\x06\x64\x31\x20\x8b\x01\x59\x0c\xc8\x62\x40\x16\x03\xb2\x18\x90\xc5\x80\x2c\x06\x64\x31\x20\x8b\x01\x59\x0c\xc8\x62\x9c\xa8\x2c\x86\x34\xe4\x85\xe2\x07\xa0\x2c\x32\xce\x16\x0c\x01\xcc\xf4\xf8\x05\x4e\x56\x0a\x12\x1a\x25\xdb\xbe\xa2\xd2\x89\x9a\xe7\xc3\x6b\xf9\x88\x7f\xdf\x12\xf2\x61\x25\x2d\x66\xc7\xc6\x21\x4d\xa1\xa4\x6d\xe2\x2a\xc3\x81\xe1\xca\x48\xe4\xf9\x0a\x7d\x52\x7b\x6f\xcb\xde\xb4\x2c\x5b\x27\xea\x72\xa2\x31\x8f\x5c\xe7\x23\x77\xd7\xf6\x3b\xdd\xea\xba\x59\x00\xb4\x38\x79\xf2\x0d\x40\x1e\xc6\xac\x13\xbb\x8e\xf7\x08\x80\xb9\x81\xb9\x81\xb9\x81\xb9\x81\xb9\x81\xb9\x81\xb9\x81\xb9\x81\xb9\x9d\x3b\x73\x8b\xc6\x30\xa9\xdb\x3f\x5b\xfb\xa9\x32\xba\xe2\xf6\x24\x8d\x63\x87\xca\xaa\xd9\x91\xb2\xe1\x69\x65\x6f\x5b\x25\xd6\xcb\x1f\x9d\xcf\x9a\x58\x3e\x35\xef\x73\xdd\x1b\x08\x15\x0d\xa7\x85\x0c\xcf\x7a\x0e\xf8\xb4\xde\x36\xc4\xdc\xcb\xb8\x2a\x24\xea\xd2\xa8\x4b\xa3\x2e\x8d\xba\xf4\x49\xd7\xa5\x5b\x5d\xaf\x19\x71\xe8\xcd\x83\x9b\xfc\xb6\xa6\xe2\xe1\x57\x62\x85\x0b\x47\x69\xdf\x5d\x33\xec\x72\x68\x62\x2d\xa5\x70\x54\x6b\xf3\xb8\x66\x0d\x76\x6f\xfd\x64\x1f\x7e\x3c\xde\x6e\xcf\xde\x4e\x9f\xa3\x2b\xc7\xb7\xb3\x59\xf6\xfb\x34\x1d\xdb\x83\x69\xb6\x21\xb3\xd1\x3e\xfc\xd8\x6e\xf6\x81\xc0\xc2\x47\xcf\x6e\x5d\x76\xc0\xb3\x67\x1f\x6d\xab\x17\x62\xc3\x38\xb6\x22\x17\x89\x5c\x24\x72\x91\xc8\x45\x22\x17\x89\x5c\x24\x72\x91\xc8\x45\x22\x17\x79\xe6\xb9\xc8\x18\x2f\x4a\x07\x7c\xa2\xeb\x86\xc0\x74\xcc\x00\xae\xf0\x72\x7c\x7b\xf7\x48\x4b\x79\xce\xb8\x7e\x95\xb9\xd9\xa2\xa9\x8e\xb0\x58\x67\xb4\x3c\xce\x4a\xe6\x56\x7e\xfe\x74\xf9\x65\x3f\x77\xc5\xae\xc6\x4f\xeb\x4c\x2f\xfd\x58\xfc\x6a\xa2\xca\xab\x7d\x84\x08\xc9\xfb\x8b\x90\x70\x99\xfc\xfc\xba\x78\x91\x71\xce\x0b\x5f\xba\xc4\x19\xa1\x6c\xca\x3c\xe8\xb5\x33\xbd\x75\x7e\x24\x3f\x44\x76\x21\xb2\x0b\x91\x5d\x88\xec\x9e\xa9\xc8\x6e\xd8\xd5\x4d\xbe\xa8\x15\x10\x5c\x2d\xf0\x81\x75\xc2\xf5\x2f\x8e\x49\xf8\xf8\x08\xe9\x9a\x87\x85\x0d\x8e\xdd\xfb\xce\xe8\x9b\x76\xb1\xe6\x1b\x2c\x05\x47\xcf\x47\xb8\x04\x3c\x5f\xe9\xeb\x72\x5e\x25\xf6\x8b\xf4\x53\x18\xd5\xa8\xfa\xdd\x9d\x5c\xdc\x85\x57\xff\xb4\x64\x1e\xa8\x2a\x72\x67\xa6\x88\xc1\x3a\x6d\x44\x4d\xcf\xff\xd3\xdf\x18\x1a\x53\xc1\x7b\xef\xa6\xbd\xcc\x7f\xfd\xce\x9e\xb6\x55\x48\x49\x9d\xa3\xea\xbb\xd8\xb3\xf4\x6d\xa3\xaa\x22\xbf\xb8\x18\xfe\xe8\xda\xde\x88\x76\xfa\xd3\x53\xe6\xa1\x7b\xd9\x16\xf9\xd5\x75\xe6\x63\x41\x6d\xa8\xfa\x41\xc6\x36\x5a\xd9\x22\xbf\xba\xce\xfe\x0c\x00\xbb\x44\x9a\xe3\xfd\x6e\x08\x00"),
 		},
 	}
 	fs["/"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
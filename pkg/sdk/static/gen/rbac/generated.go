@@ -26,56 +26,56 @@ var Root = func() http.FileSystem {
 			modTime:          time.Time{},
 			uncompressedSize: 280,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\xce\xbd\x4a\xc5\x40\x10\xc5\xf1\x7e\x9f\x62\x48\x9f\x88\x9d\x6c\x6b\x61\x6f\x61\x23\xb7\x98\xec\x3d\xe0\x98\x64\x67\x99\x99\x8d\x1f\x4f\x2f\xb1\x12\x09\xb7\x3e\x9c\x1f\x7f\x6e\xf2\x02\x73\xd1\x9a\xc9\x66\x2e\x13\xf7\x78\x53\x93\x6f\x0e\xd1\x3a\x2d\x0f\x3e\x89\xde\xed\xf7\x69\x91\x7a\xcd\xf4\xb8\x76\x0f\xd8\xb3\xae\x48\x1b\x82\xaf\x1c\x9c\x13\x51\xe5\x0d\x99\x9a\xe9\xe7\xd7\x68\xc7\x68\x7d\x85\xe7\x34\x12\x37\x79\x32\xed\xcd\x33\xbd\x0e\x07\x8e\x1a\x52\xfe\xea\xc3\x25\x11\x19\x5c\xbb\x95\xe3\x43\x34\x52\xe8\x82\x6a\xd8\x05\x1f\x9e\x88\x76\xd8\xfc\x0b\x14\x03\x07\x86\xcb\x19\xfc\xbf\xfa\xcc\xf5\x3e\xbf\xa3\x04\x97\x02\xf7\x5b\xfe\x4f\x00\x00\x00\xff\xff\x4b\x41\x4e\x63\x18\x01\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\xce\xbd\x4a\xc5\x40\x10\xc5\xf1\x7e\x9f\x62\x48\x9f\x88\x9d\x6c\x6b\x61\x6f\x61\x23\xb7\x98\xec\x3d\xe0\x98\x64\x67\x99\x99\x8d\x1f\x4f\x2f\xb1\x12\x09\xb7\x3e\x9c\x1f\x7f\x6e\xf2\x02\x73\xd1\x9a\xc9\x66\x2e\x13\xf7\x78\x53\x93\x6f\x0e\xd1\x3a\x2d\x0f\x3e\x89\xde\xed\xf7\x69\x91\x7a\xcd\xf4\xb8\x76\x0f\xd8\xb3\xae\x48\x1b\x82\xaf\x1c\x9c\x13\x51\xe5\x0d\x99\x9a\xe9\xe7\xd7\x68\xc7\x68\x7d\x85\xe7\x34\x12\x37\x79\x32\xed\xcd\x33\xbd\x0e\x07\x8e\x1a\x52\xfe\xea\xc3\x25\x11\x19\x5c\xbb\x95\xe3\x43\x34\x52\xe8\x82\x6a\xd8\x05\x1f\x9e\x88\x76\xd8\xfc\x0b\x14\x03\x07\x86\xcb\x19\xfc\xbf\xfa\xcc\xf5\x3e\xbf\xa3\x04\x97\x02\xf7\x5b\xfe\xcf\x00\x4b\x41\x4e\x63\x18\x01\x00\x00"),
 		},
 		"/auth_proxy_role_binding.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "auth_proxy_role_binding.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 257,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x8d\x31\x4e\xc0\x30\x0c\x45\xf7\x9c\xc2\x17\x68\x11\x1b\xca\x06\x0c\xec\x45\x62\x77\x13\x17\x4c\xd3\x38\xb2\x9d\x8a\x72\x7a\x54\xa9\xb0\x80\xd8\x6c\xe9\xbd\xff\xb0\xf1\x0b\xa9\xb1\xd4\x08\x3a\x63\x1a\xb1\xfb\x9b\x28\x7f\xa2\xb3\xd4\x71\xbd\xb3\x91\xe5\x66\xbf\x0d\x2b\xd7\x1c\xe1\xb1\x74\x73\xd2\x49\x0a\x3d\x70\xcd\x5c\x5f\xc3\x46\x8e\x19\x1d\x63\x00\xa8\xb8\x51\x84\xa6\xf2\x71\x0c\x2a\x85\xe6\x8b\x39\xef\x89\x96\x13\xc1\xc6\x4f\x2a\xbd\xfd\x93\x0b\x00\xbf\x6a\x7f\x8c\x07\xeb\xf3\x3b\x25\xb7\x18\x86\x4b\x78\x26\xdd\x39\xd1\x7d\x4a\xd2\xab\xff\x38\x99\x16\xec\xe5\xfb\xb7\x86\x89\x22\xd8\x61\x4e\x5b\xf8\x0a\x00\x00\xff\xff\x38\xc6\x1a\x54\x01\x01\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x8d\x31\x4e\xc0\x30\x0c\x45\xf7\x9c\xc2\x17\x68\x11\x1b\xca\x06\x0c\xec\x45\x62\x77\x13\x17\x4c\xd3\x38\xb2\x9d\x8a\x72\x7a\x54\xa9\xb0\x80\xd8\x6c\xe9\xbd\xff\xb0\xf1\x0b\xa9\xb1\xd4\x08\x3a\x63\x1a\xb1\xfb\x9b\x28\x7f\xa2\xb3\xd4\x71\xbd\xb3\x91\xe5\x66\xbf\x0d\x2b\xd7\x1c\xe1\xb1\x74\x73\xd2\x49\x0a\x3d\x70\xcd\x5c\x5f\xc3\x46\x8e\x19\x1d\x63\x00\xa8\xb8\x51\x84\xa6\xf2\x71\x0c\x2a\x85\xe6\x8b\x39\xef\x89\x96\x13\xc1\xc6\x4f\x2a\xbd\xfd\x93\x0b\x00\xbf\x6a\x7f\x8c\x07\xeb\xf3\x3b\x25\xb7\x18\x86\x4b\x78\x26\xdd\x39\xd1\x7d\x4a\xd2\xab\xff\x38\x99\x16\xec\xe5\xfb\xb7\x86\x89\x22\xd8\x61\x4e\x5b\xf8\x1a\x00\x38\xc6\x1a\x54\x01\x01\x00\x00"),
 		},
 		"/auth_proxy_service.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "auth_proxy_service.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 379,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x8f\xb1\x6a\x04\x31\x0c\x44\x7b\x7f\x85\xd8\xde\x09\x21\x57\x04\x7f\x45\x20\x90\x5e\xf1\x0d\xb7\x26\xb6\x65\x24\xdd\x41\xfe\x3e\x78\xb3\x07\x29\xb6\xb8\x72\x34\x62\x66\x1e\x8f\xf2\x09\xb5\x22\x3d\xd1\xed\x25\x7c\x97\x7e\x4e\xf4\x01\xbd\x95\x8c\xd0\xe0\x7c\x66\xe7\x14\x88\xb8\x77\x71\xf6\x22\xdd\xa6\x24\x1a\x2a\x0d\xbe\xe2\x6a\x4f\x45\x9e\x87\xa8\x27\x5a\xde\x4e\xa7\xd7\xe5\xc0\xb6\xbc\xa2\x21\xd1\xea\x3e\xec\xd0\x57\x1e\x48\xb4\xb8\x5e\x31\x03\x2a\x7f\xa1\xee\x4d\x59\xba\xab\xd4\x38\x2a\x77\xa4\xbb\xac\xd0\xd8\xb8\xf3\x05\x1a\x88\x3a\xb7\x43\x2b\x36\xb8\x96\x6c\xd1\x76\xa6\xbf\x57\x1b\x9c\x91\xc8\x7e\xcc\xd1\x82\x0d\xe4\x59\x35\x21\xb6\xce\xb8\xe7\xfd\x9b\xbb\xf1\x4d\xbc\x4d\x3a\xeb\x05\xfe\xbe\x1d\xef\x4f\x86\x8a\xec\xa2\x8f\x8e\xfe\x0d\x00\x00\xff\xff\xe8\x4f\xf6\xaf\x7b\x01\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x8f\xb1\x6a\x04\x31\x0c\x44\x7b\x7f\x85\xd8\xde\x09\x21\x57\x04\x7f\x45\x20\x90\x5e\xf1\x0d\xb7\x26\xb6\x65\x24\xdd\x41\xfe\x3e\x78\xb3\x07\x29\xb6\xb8\x52\x9a\x61\x66\x1e\x8f\xf2\x09\xb5\x22\x3d\xd1\xed\x25\x7c\x97\x7e\x4e\xf4\x01\xbd\x95\x8c\xd0\xe0\x7c\x66\xe7\x14\x88\xb8\x77\x71\xf6\x22\xdd\xe6\x49\x34\x54\x1a\x7c\xc5\xd5\x9e\x8a\x3c\x0f\x51\x4f\xb4\xbc\x9d\x4e\xaf\xcb\x81\x6c\x79\x45\x43\xa2\xd5\x7d\xd8\xa1\xae\x3c\x90\x68\x71\xbd\x62\x06\x54\xfe\x42\xdd\x9b\xb2\x74\x57\xa9\x71\x54\xee\x48\xf7\xb3\x42\x63\xe3\xce\x17\x68\x20\xea\xdc\x0e\xa5\xd8\xe0\x5a\xb2\x45\xdb\x99\xfe\xac\x36\x38\x23\x91\xfd\x98\xa3\x05\x1b\xc8\xb3\x6a\x42\x6c\x9d\x71\xcf\xfb\x37\x77\xe3\x9b\x78\xdb\x24\x67\xbd\xc0\xdf\xb7\xe7\xdd\x64\xa8\xc8\x2e\xfa\xe8\xe8\xdf\x01\x00\xe8\x4f\xf6\xaf\x7b\x01\x00\x00"),
 		},
 		"/kustomization.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "kustomization.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 344,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x8f\xb1\x4e\xc5\x30\x0c\x45\xf7\x7e\x85\xa5\x2e\x30\xb4\x19\xd8\xde\xca\x87\x44\x49\xea\xd7\x58\x24\x71\xe5\x38\x94\xf2\xf5\xe8\x45\x80\x5a\xc4\x66\xf9\x9e\xab\xab\x23\x58\xb9\x49\xc0\x7a\x1b\x26\x10\x4e\x38\x1f\x2e\xa7\xef\xdb\x7a\x2a\x0b\x95\xf5\xe7\x97\xd0\x2d\x28\x16\x13\x06\x25\x2e\xf6\xcc\xff\x97\x5d\xfb\x23\xbc\x72\xce\x58\x14\x34\x22\xdc\x39\x25\xde\xa9\xac\xf0\x02\x89\x0a\x56\xa0\x3b\x1c\xdc\x60\x77\x0f\x82\x61\xa1\xea\x7c\xc2\x61\xec\xb8\x6b\x1a\x61\x13\xfe\x38\xe0\x29\xaa\x6e\xf5\x66\xcc\x4a\x1a\x9b\x9f\x03\x67\xe3\xc5\x95\xf0\x69\xde\x9a\xc7\x49\xbc\x0b\x53\x47\x9f\x87\x11\xf6\x48\xa1\x37\x15\x83\xd6\xc7\x82\x80\xc9\xa8\x42\xa1\x02\x96\x65\x63\x2a\x3a\x0f\x53\x5f\xb0\xbd\x66\x2b\xca\x3b\x85\x5f\xb5\x53\x72\x36\xfe\xf3\xbe\xca\x7e\x05\x00\x00\xff\xff\xac\x90\x8c\xf8\x58\x01\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x8f\xb1\x4e\xc5\x30\x0c\x45\xf7\x7e\x85\xa5\x2e\x30\xb4\x19\xd8\xde\xca\x87\x44\x49\xea\xd7\x58\x24\x71\xe5\x38\x94\xf2\xf5\xe8\x45\x80\x5a\xc4\x66\xf9\x9e\xab\xab\x23\x58\xb9\x49\xc0\x7a\x1b\x26\x10\x4e\x38\x1f\x2e\xa7\xef\xdb\x7a\x2a\x0b\x95\xf5\xe7\x97\xd0\x2d\x28\x16\x13\x06\x25\x2e\xf6\xcc\xff\x97\x5d\xfb\x23\xbc\x72\xce\x58\x14\x34\x22\xdc\x39\x25\xde\xa9\xac\xf0\x02\x89\x0a\x56\xa0\x3b\x1c\xdc\x60\x77\x0f\x82\x61\xa1\xea\x7c\xc2\x61\xec\xb8\x6b\x1a\x61\x13\xfe\x38\xe0\x29\xaa\x6e\xf5\x66\xcc\x4a\x1a\x9b\x9f\x03\x67\xe3\xc5\x95\xf0\x69\xde\x9a\xc7\x49\xbc\x0b\x53\x47\x9f\x87\x11\xf6\x48\xa1\x37\x15\x83\xd6\xc7\x82\x80\xc9\xa8\x42\xa1\x02\x96\x65\x63\x2a\x3a\x0f\x53\x5f\xb0\xbd\x66\x2b\xca\x3b\x85\x5f\xb5\x53\x72\x36\xfe\xf3\xbe\xca\x7e\x0d\x00\xac\x90\x8c\xf8\x58\x01\x00\x00"),
 		},
 		"/leader_election_role.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "leader_election_role.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 355,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x4e\xbd\x4e\xc3\x40\x0c\xde\xef\x29\xac\x32\x27\x15\x1b\xca\x0b\xb0\x33\xb0\xbb\xb9\x8f\xd6\xea\xe5\x7c\xb2\x7d\x45\xe2\xe9\x51\xd2\x94\x05\x31\x30\xf9\xd3\xf7\xeb\x27\x6a\xb0\x45\xdc\x45\xab\x53\x28\x65\xa5\x02\xce\x30\x42\xc1\x1c\xa2\x75\x4c\xdc\xe4\x1d\xb6\x5a\x26\xb2\x13\xcf\x23\xf7\xb8\xa8\xc9\x17\x6f\xfa\xf5\xc5\x47\xd1\xe3\xed\x39\x5d\xa5\xe6\x89\xde\xb4\x20\x2d\x08\xce\x1c\x3c\x25\xa2\xca\x0b\xa6\xbd\x76\x78\xd4\x0e\xb6\xda\xac\x17\xf8\x94\x06\xe2\x26\xaf\xa6\xbd\xf9\x1a\x18\xe8\x70\x48\x44\x06\xd7\x6e\x33\x76\x6e\xd6\xfa\x21\xe7\x85\x9b\x27\xa2\x1b\xec\xb4\xf3\x67\xc4\x76\x8b\xf8\x1d\x7c\x72\xcc\x97\x7b\xc4\xc0\x81\x0d\xf6\x96\x1f\xb0\xfd\xe8\x19\x05\x81\xff\xce\x1f\x3d\x38\xfa\x1f\x5f\xfc\xda\xf9\x0e\x00\x00\xff\xff\xcb\xa9\x3f\x0f\x63\x01\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x8e\xb1\x6e\xc3\x30\x0c\x44\x77\x7d\x05\x91\xce\x76\xd0\xad\xf0\x0f\x74\xef\xd0\x9d\x91\xae\x09\x11\xd9\x14\x48\x2a\x05\xfa\xf5\x85\x5d\xa7\x4b\xd1\x21\x93\x1e\xee\x4e\x77\x7c\xa2\x06\x9b\xc5\x5d\x74\x71\x0a\xa5\xa2\x54\xc1\x05\x46\xa8\xc8\x21\xba\x8c\x89\x9b\xbc\xc3\xd6\xc8\x44\x76\xe2\x3c\x72\x8f\x8b\x9a\x7c\xf1\xe6\x5f\x5f\x7c\x14\x3d\xde\x9e\xd3\x55\x96\x32\xd1\x9b\x56\xa4\x19\xc1\x85\x83\xa7\x44\xb4\xf0\x8c\x69\xaf\x1d\xee\xb5\x83\xad\x31\xeb\x15\x3e\xa5\x81\xb8\xc9\xab\x69\x6f\xbe\x7e\x18\xe8\x70\x48\x44\x06\xd7\x6e\x19\xbb\x96\x75\xf9\x90\xf3\xcc\xcd\x13\xd1\x0d\x76\xda\xf5\x33\x62\x7b\xab\xf8\x0f\x7c\x72\xe4\xcb\x46\xd9\xc0\x81\x0d\x7b\x2b\x77\x6c\xbf\x7e\x41\x45\xe0\xd1\xf9\xa3\x07\x47\xff\xe7\x8a\x3f\x3b\xdf\x03\x00\xcb\xa9\x3f\x0f\x63\x01\x00\x00"),
 		},
 		"/leader_election_role_binding.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "leader_election_role_binding.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 263,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x8d\x3d\x8a\xc3\x30\x10\x46\x7b\x9d\x62\x2e\x60\x2f\xdb\x2d\xea\x76\x9b\xed\x1d\x48\x3f\x96\x3e\x27\x13\xcb\x1a\xa1\x1f\x43\x72\xfa\x60\x70\x92\xce\xdd\x0c\xbc\xf7\x3d\x4e\x72\x46\x2e\xa2\xd1\x52\x1e\xd9\xf5\xdc\xea\x55\xb3\x3c\xb8\x8a\xc6\x7e\xfe\x29\xbd\xe8\xd7\xfa\x6d\x66\x89\xde\xd2\xa0\x01\x7f\x12\xbd\xc4\x8b\x59\x50\xd9\x73\x65\x6b\x88\x22\x2f\xb0\x14\xc0\x1e\xb9\x43\x80\xdb\xec\x2e\x6b\xc0\xb8\xd3\xdb\x3d\x60\xda\x60\x4e\xf2\x9f\xb5\xa5\x83\xa2\x21\xfa\x04\x0f\xf7\x4d\x69\xe3\x0d\xae\x16\x6b\xba\xdd\x39\x21\xaf\xe2\xf0\xeb\x9c\xb6\x58\xdf\xb6\xc7\xc4\x2d\xbc\xfe\x92\xd8\xc1\x52\xb9\x97\x8a\xc5\x3c\x03\x00\x00\xff\xff\xc0\xbe\x81\x75\x07\x01\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x8d\x3d\x8a\xc3\x30\x10\x46\x7b\x9d\x62\x2e\x60\x2f\xdb\x2d\xea\x76\x9b\xed\x1d\x48\x3f\x96\x3e\x27\x13\xcb\x1a\xa1\x1f\x43\x72\xfa\x60\x70\x92\xce\xdd\x0c\xbc\xf7\x3d\x4e\x72\x46\x2e\xa2\xd1\x52\x1e\xd9\xf5\xdc\xea\x55\xb3\x3c\xb8\x8a\xc6\x7e\xfe\x29\xbd\xe8\xd7\xfa\x6d\x66\x89\xde\xd2\xa0\x01\x7f\x12\xbd\xc4\x8b\x59\x50\xd9\x73\x65\x6b\x88\x22\x2f\xb0\x14\xc0\x1e\xb9\x43\x80\xdb\xec\x2e\x6b\xc0\xb8\xd3\xdb\x3d\x60\xda\x60\x4e\xf2\x9f\xb5\xa5\x83\xa2\x21\xfa\x04\x0f\xf7\x4d\x69\xe3\x0d\xae\x16\x6b\xba\xdd\x39\x21\xaf\xe2\xf0\xeb\x9c\xb6\x58\xdf\xb6\xc7\xc4\x2d\xbc\xfe\x92\xd8\xc1\x52\xb9\x97\x8a\xc5\x3c\x07\x00\xc0\xbe\x81\x75\x07\x01\x00\x00"),
 		},
 		"/role.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "role.yaml",
 			modTime:          time.Time{},
-			uncompressedSize: 2247,
+			uncompressedSize: 2397,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x55\xc1\x6e\xdb\x30\x0c\xbd\xfb\x2b\x84\x5e\x0a\x0c\x70\x8a\xdd\x06\x5f\x77\xd8\x7d\x18\x76\xa7\x25\xd6\xe5\x22\x89\x82\x28\x25\x4b\xbf\x7e\x90\x23\xb7\x9e\xed\x14\xdd\x90\xf4\x64\x8a\xa2\xc8\xf7\x9e\x29\xaa\x69\xdb\xb6\x81\x40\x3f\x31\x0a\xb1\xef\x54\xec\x41\xef\x20\xa7\x27\x8e\xf4\x0c\x89\xd8\xef\xf6\x5f\x64\x47\xfc\x70\xf8\xdc\xec\xc9\x9b\x4e\x7d\xb5\x59\x12\xc6\xef\x6c\xb1\x71\x98\xc0\x40\x82\xae\x51\x4a\x47\x1c\x0f\xfc\x20\x87\x92\xc0\x85\x4e\xf9\x6c\x6d\xa3\x94\x07\x87\x9d\x72\xe0\x61\xc0\xd8\xc6\x72\x30\x66\x8b\xd2\x35\xad\x82\x40\xdf\x22\xe7\x20\x25\x45\xab\xee\xee\x1a\xa5\x22\x0a\xe7\xa8\xb1\xfa\x34\xfb\x47\x1a\x1c\x04\x19\x97\x82\x3a\x62\x2a\xf6\x01\x63\x3f\xc5\x94\xe2\x38\x9a\x06\x2d\x56\x73\xc0\x34\x7e\x2d\xc9\xd9\x08\x90\xf4\xd3\x68\xe5\x60\xa6\x03\xc7\xd1\xf9\x2e\x28\xe8\x4d\x60\xf2\xe9\x8c\xa4\xf0\x92\x00\x1a\xeb\x92\x0d\x2e\x60\xad\x00\xfc\x43\xad\x50\xfe\x89\x24\xf4\xe9\xc0\x36\x3b\xd4\x16\xc8\x9d\x2b\x05\x36\x93\x16\xf1\x40\x1a\x41\x6b\xce\x13\xaa\xea\xfb\x20\x81\x5a\x85\x07\xf4\x49\x6a\x9b\x6c\x48\x36\x6e\x5f\x04\xf3\x5e\x85\x20\x8c\xbf\x7f\x91\xdc\x00\x3a\xf6\x82\x95\x7a\xc4\x60\x49\xc3\xcb\x5a\x12\x24\x7c\xcc\x56\x3e\xa2\x5f\x2a\xc2\x56\xe1\xef\x84\xbe\x5c\xa7\x2d\xc0\x18\x2c\x9f\xdc\x9b\x92\x5c\x0b\x50\x5f\x63\x17\x10\x7e\x71\x7f\xfb\xda\x9a\x39\x1a\xf2\xf3\x09\xb2\x46\x62\x11\x64\xd9\xa7\xf7\x9f\xee\xd7\xd9\xfe\x52\xb4\x55\x1e\xd3\x91\xe3\x9e\xfc\x70\x31\x35\xf9\x21\xa2\xac\xb2\xdf\x80\xe9\x02\x5b\x60\x4b\xfa\xb4\x71\x9b\xd9\x08\xea\x1c\x29\x9d\xc6\x10\xba\x26\xb4\x2c\x6f\x42\xb4\x3c\x0c\x45\xac\x1e\xfc\x33\x90\xb6\x9c\xcd\xa6\x68\xfa\x3c\xd9\x1f\x2d\x1f\x65\xee\xe0\x9c\x42\xae\x57\xea\x75\xb3\x66\x3d\x2f\x5e\x43\x6e\xab\xf6\xff\x50\x79\x28\x63\x20\x6f\x31\x9a\xef\xac\x42\x27\x7e\x73\xdf\xea\xdc\xc6\xac\x5f\xd1\x59\x91\x70\xec\x29\x71\x2c\x3c\x34\x47\x64\xd9\x69\x76\x6b\x0e\x75\x90\xd7\xe8\xdb\x6b\x7b\xf1\xed\xbf\x28\x6f\x79\xca\x7b\xf2\xe6\xa5\x0d\x66\xfe\x3a\x91\x97\x11\xd3\xd6\x55\xa9\xfc\x09\x00\x00\xff\xff\x57\xcd\x78\xd0\xc7\x08\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x56\x31\x6f\xdc\x3c\x0c\xdd\xfd\x2b\x84\x2c\x01\x3e\xc0\x17\x7c\x5b\xe1\xb5\x43\xf7\xa2\xe8\x4e\x4b\x8c\xc3\x46\x22\x05\x51\xba\x34\xf9\xf5\x85\x1c\x39\x71\xce\x77\x41\x5a\xdc\x65\x32\x45\x3d\x51\xef\x3d\x9b\x92\xbb\xbe\xef\x3b\x88\xf4\x13\x93\x92\xf0\x60\xd2\x08\x76\x07\x25\xdf\x49\xa2\x27\xc8\x24\xbc\xbb\xff\xa2\x3b\x92\x9b\xfd\xff\xdd\x3d\xb1\x1b\xcc\x57\x5f\x34\x63\xfa\x2e\x1e\xbb\x80\x19\x1c\x64\x18\x3a\x63\x6c\xc2\x79\xc1\x0f\x0a\xa8\x19\x42\x1c\x0c\x17\xef\x3b\x63\x18\x02\x0e\x26\x00\xc3\x84\xa9\x4f\x75\x61\x2a\x1e\x75\xe8\x7a\x03\x91\xbe\x25\x29\x51\x6b\x89\xde\x5c\x5d\x75\xc6\x24\x54\x29\xc9\x62\xcb\x59\xe1\x5b\x9a\x02\x44\x9d\x21\x8a\x36\x61\xae\xf1\x1e\xd3\xb8\x60\xea\xe6\x38\x87\x0e\x3d\xb6\x70\xc2\x3c\x3f\x3d\xe9\x73\x10\x21\xdb\xbb\x39\x2a\xd1\x2d\x0b\x1e\xe6\xe4\x87\xa8\x20\xbb\x28\xc4\xf3\xee\xfd\xac\x4b\x23\x58\x6c\x43\x71\x78\x40\x6b\x43\xe0\x2f\xf6\x8a\xf5\x9d\x68\x46\xce\x7b\xf1\x25\xa0\xf5\x40\xa1\xd6\xef\x4d\x14\xb7\x78\x91\xf6\x64\x11\xac\x95\xc2\xf9\x4d\xee\x93\x0c\xea\x0d\xee\x91\xb3\xb6\xcf\xe4\x88\x65\xf3\xf4\x49\x32\x1f\x75\x08\x62\xd4\x6d\x71\x07\x18\x84\x15\x9b\xf4\x84\xd1\x93\x85\x97\xb1\x66\xc8\x78\x5b\xbc\xe2\x3b\x0c\xce\x65\x47\x63\xd8\x1b\xfc\x9d\x91\x6b\x3b\x1d\x23\x8c\xd1\xcb\x63\x40\xfe\x0c\x42\x25\x8b\x5a\xf0\xc4\xd3\x96\xc8\xdc\xe0\xc2\x19\x7c\x14\xb7\x20\x31\x5d\x9e\xd5\xd8\xb0\x07\x7c\x7e\xc9\x78\xf9\xbd\xad\x48\x72\xc4\xeb\x73\x6d\xcb\xc4\x23\xe8\x61\x1f\x5f\xff\x77\xbd\xad\xf6\xe6\x3d\xf7\x86\x31\x3f\x48\xba\x27\x9e\x4e\x96\x26\x9e\x12\xaa\xe2\xe5\x95\x1e\x70\x8b\xe2\xc9\x3e\x6e\x09\xd5\x83\x04\x6d\x49\x94\x1f\x67\x08\x9d\x93\x5a\xd1\x77\x29\x7a\x99\xa6\x6a\xd6\x08\xfc\x04\x64\xbd\x14\x77\xd4\x34\xfb\x7c\xdf\xdc\x7a\x79\xd0\x75\x42\x4a\x8e\xa5\x35\xfa\xeb\x64\xab\xfa\x3c\x78\x85\x5c\xd6\xed\x7f\x91\x72\x53\x0f\xa7\x72\x4c\xd1\x7a\x66\x03\x5d\xf4\xad\x73\x9b\x75\x2b\xb5\x13\x9e\x90\xb3\x11\x11\x84\x29\x4b\xaa\x3a\xac\x24\x14\xdd\x59\x09\x5b\x0d\xed\x7a\x69\xe8\xcb\x7b\x7b\xf2\x8f\xe4\xa4\xbd\xf5\x07\x63\x24\x76\x2f\x9f\xc1\x2a\xdf\xee\x89\x43\xc4\x32\x75\x56\x29\x7f\x06\x00\x7d\x8b\x84\x02\x5d\x09\x00\x00"),
 		},
 		"/role_binding.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "role_binding.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 261,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x8d\xb1\x4e\x03\x31\x0c\x86\xf7\x3c\x85\x5f\xa0\x87\xd8\x50\x36\x60\x60\x2f\x12\xbb\x2f\x71\x8b\x69\x62\x47\xb6\x53\x09\x9e\x1e\x9d\x74\xb0\x80\xba\xd9\xd2\xf7\xfd\x1f\x0e\x7e\x23\x73\x56\xc9\x60\x2b\x96\x05\x67\xbc\xab\xf1\x17\x06\xab\x2c\x97\x07\x5f\x58\xef\xae\xf7\xe9\xc2\x52\x33\x3c\xb7\xe9\x41\x76\xd4\x46\x4f\x2c\x95\xe5\x9c\x3a\x05\x56\x0c\xcc\x09\x40\xb0\x53\x86\x8e\x82\x67\xb2\x83\x69\xa3\x75\xa7\xb6\xfb\x48\xa7\x0d\xc2\xc1\x2f\xa6\x73\xdc\x08\x26\x80\x3f\xbd\x7f\xe7\x93\xcf\xf5\x83\x4a\x78\x4e\x87\x5d\x79\x25\xbb\x72\xa1\xc7\x52\x74\x4a\xfc\x5a\x95\x4e\x38\xdb\xcf\xef\x03\x0b\x65\xf0\x4f\x0f\xea\xe9\x3b\x00\x00\xff\xff\x3d\x57\xd3\xb6\x05\x01\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x8d\xb1\x4e\x03\x31\x0c\x86\xf7\x3c\x85\x5f\xa0\x87\xd8\x50\x36\x60\x60\x2f\x12\xbb\x2f\x71\x8b\x69\x62\x47\xb6\x53\x09\x9e\x1e\x9d\x74\xb0\x80\xba\xd9\xd2\xf7\xfd\x1f\x0e\x7e\x23\x73\x56\xc9\x60\x2b\x96\x05\x67\xbc\xab\xf1\x17\x06\xab\x2c\x97\x07\x5f\x58\xef\xae\xf7\xe9\xc2\x52\x33\x3c\xb7\xe9\x41\x76\xd4\x46\x4f\x2c\x95\xe5\x9c\x3a\x05\x56\x0c\xcc\x09\x40\xb0\x53\x86\x8e\x82\x67\xb2\x83\x69\xa3\x75\xa7\xb6\xfb\x48\xa7\x0d\xc2\xc1\x2f\xa6\x73\xdc\x08\x26\x80\x3f\xbd\x7f\xe7\x93\xcf\xf5\x83\x4a\x78\x4e\x87\x5d\x79\x25\xbb\x72\xa1\xc7\x52\x74\x4a\xfc\x5a\x95\x4e\x38\xdb\xcf\xef\x03\x0b\x65\xf0\x4f\x0f\xea\xe9\x7b\x00\x3d\x57\xd3\xb6\x05\x01\x00\x00"),
 		},
 	}
 	fs["/"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
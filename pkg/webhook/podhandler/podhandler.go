@@ -0,0 +1,247 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package podhandler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"emperror.dev/errors"
+	util "github.com/banzaicloud/operator-tools/pkg/utils"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+const (
+	// TailAnnotation lists the files to tail in a comma separated list.
+	// An entry is either a plain path, referring to the first container of the pod, or a container:path pair.
+	TailAnnotation = "sidecar.logging.banzaicloud.io/tail"
+	// TailLabel opts the pod in to the webhook, the webhook configuration selects the pods having the label with any value
+	TailLabel = "sidecar.logging.banzaicloud.io/tail"
+	// WebhookPath is where the pod mutating webhook is served
+	WebhookPath = "/tailer-webhook"
+
+	DefaultTailerImage = "busybox:1.33"
+	// DefaultTailerUser is the user of the sidecars when neither the tailed container nor the pod sets one
+	DefaultTailerUser = 65534
+	tailerNamePrefix   = "file-tailer"
+)
+
+var tailerResources = corev1.ResourceRequirements{
+	Limits: corev1.ResourceList{
+		corev1.ResourceMemory: resource.MustParse("50M"),
+		corev1.ResourceCPU:    resource.MustParse("50m"),
+	},
+	Requests: corev1.ResourceList{
+		corev1.ResourceMemory: resource.MustParse("10M"),
+		corev1.ResourceCPU:    resource.MustParse("10m"),
+	},
+}
+
+// The webhook configuration selects the pods having the TailLabel by the object selector patched in config/webhook,
+// controller-gen has no marker for it.
+// +kubebuilder:webhook:path=/tailer-webhook,mutating=true,failurePolicy=ignore,sideEffects=None,groups="",resources=pods,verbs=create,versions=v1,name=tailer-webhook.logging.banzaicloud.io,admissionReviewVersions=v1
+
+// PodHandler injects file tailer sidecars into annotated pods
+type PodHandler struct {
+	Log   logr.Logger
+	Image string
+	// RunAsUser is the user of the sidecars when neither the tailed container nor the pod sets one
+	RunAsUser int64
+	decoder   *admission.Decoder
+}
+
+// SetupPodHandlerWithManager registers the file tailer webhook
+func SetupPodHandlerWithManager(mgr ctrl.Manager, image string, runAsUser int64) {
+	if image == "" {
+		image = DefaultTailerImage
+	}
+	mgr.GetWebhookServer().Register(WebhookPath, &webhook.Admission{
+		Handler: &PodHandler{
+			Log:       ctrl.Log.WithName("webhooks").WithName("PodHandler"),
+			Image:     image,
+			RunAsUser: runAsUser,
+		},
+	})
+}
+
+// Handle injects the sidecars.
+// The pod is admitted unchanged if the sidecars cannot be injected, the error is logged and returned as a warning.
+func (h *PodHandler) Handle(ctx context.Context, req admission.Request) admission.Response {
+	pod := &corev1.Pod{}
+	if err := h.decoder.Decode(req, pod); err != nil {
+		h.Log.Error(err, "failed to decode pod", "namespace", req.Namespace, "name", req.Name)
+		return allowedWithError(err)
+	}
+
+	if _, ok := pod.Annotations[TailAnnotation]; !ok {
+		return admission.Allowed("no tailer annotation")
+	}
+
+	if err := h.injectTailers(pod); err != nil {
+		h.Log.Error(err, "failed to inject file tailers", "namespace", req.Namespace, "pod", pod.GetName(), "generateName", pod.GetGenerateName())
+		return allowedWithError(err)
+	}
+
+	marshaledPod, err := json.Marshal(pod)
+	if err != nil {
+		h.Log.Error(err, "failed to marshal pod", "namespace", req.Namespace, "pod", pod.GetName(), "generateName", pod.GetGenerateName())
+		return allowedWithError(err)
+	}
+
+	return admission.PatchResponseFromRaw(req.Object.Raw, marshaledPod)
+}
+
+func allowedWithError(err error) admission.Response {
+	return admission.Allowed("file tailers not injected").WithWarnings(fmt.Sprintf("file tailers not injected: %s", err))
+}
+
+// InjectDecoder injects the decoder
+func (h *PodHandler) InjectDecoder(d *admission.Decoder) error {
+	h.decoder = d
+	return nil
+}
+
+func (h *PodHandler) injectTailers(pod *corev1.Pod) error {
+	if len(pod.Spec.Containers) == 0 {
+		return errors.New("pod has no containers")
+	}
+	for _, c := range pod.Spec.Containers {
+		if strings.HasPrefix(c.Name, tailerNamePrefix) {
+			// already injected
+			return nil
+		}
+	}
+
+	volumes := 0
+	for i, entry := range strings.Split(pod.Annotations[TailAnnotation], ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		containerName := pod.Spec.Containers[0].Name
+		path := entry
+		if parts := strings.SplitN(entry, ":", 2); len(parts) == 2 {
+			containerName, path = parts[0], parts[1]
+		}
+		if !filepath.IsAbs(path) {
+			return errors.Errorf("tailed file path %q must be absolute", path)
+		}
+
+		container := findContainer(pod, containerName)
+		if container == nil {
+			return errors.Errorf("container %q not found for tailed file %q", containerName, path)
+		}
+
+		// reuse the volume already holding the log directory, an emptyDir mounted over it would hide the
+		// files of the container
+		mount := findMount(container, path)
+		if mount == nil {
+			volumeName := fmt.Sprintf("%s-%d", tailerNamePrefix, volumes)
+			volumes++
+			pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+				Name: volumeName,
+				VolumeSource: corev1.VolumeSource{
+					EmptyDir: &corev1.EmptyDirVolumeSource{},
+				},
+			})
+			container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+				Name:      volumeName,
+				MountPath: filepath.Dir(path),
+			})
+			mount = &container.VolumeMounts[len(container.VolumeMounts)-1]
+		}
+
+		pod.Spec.Containers = append(pod.Spec.Containers, corev1.Container{
+			Name:            fmt.Sprintf("%s-%d", tailerNamePrefix, i),
+			Image:           h.Image,
+			ImagePullPolicy: corev1.PullIfNotPresent,
+			Command:         []string{"tail", "-n+1", "-F", path},
+			Resources:       tailerResources,
+			SecurityContext: h.tailerSecurityContext(pod, container),
+			VolumeMounts: []corev1.VolumeMount{
+				{
+					Name:      mount.Name,
+					MountPath: mount.MountPath,
+					SubPath:   mount.SubPath,
+					ReadOnly:  true,
+				},
+			},
+		})
+	}
+	return nil
+}
+
+// tailerSecurityContext runs the sidecar as the user and group of the tailed container, so that it can read the files the container writes
+func (h *PodHandler) tailerSecurityContext(pod *corev1.Pod, container *corev1.Container) *corev1.SecurityContext {
+	runAsUser := h.RunAsUser
+	var runAsGroup *int64
+	if podContext := pod.Spec.SecurityContext; podContext != nil {
+		if podContext.RunAsUser != nil {
+			runAsUser = *podContext.RunAsUser
+		}
+		runAsGroup = podContext.RunAsGroup
+	}
+	if containerContext := container.SecurityContext; containerContext != nil {
+		if containerContext.RunAsUser != nil {
+			runAsUser = *containerContext.RunAsUser
+		}
+		if containerContext.RunAsGroup != nil {
+			runAsGroup = containerContext.RunAsGroup
+		}
+	}
+	return &corev1.SecurityContext{
+		RunAsNonRoot:             util.BoolPointer(runAsUser != 0),
+		RunAsUser:                util.IntPointer64(runAsUser),
+		RunAsGroup:               runAsGroup,
+		ReadOnlyRootFilesystem:   util.BoolPointer(true),
+		AllowPrivilegeEscalation: util.BoolPointer(false),
+		Capabilities: &corev1.Capabilities{
+			Drop: []corev1.Capability{"ALL"},
+		},
+	}
+}
+
+// findMount returns the deepest mount of the container holding the path
+func findMount(container *corev1.Container, path string) *corev1.VolumeMount {
+	var found *corev1.VolumeMount
+	for i := range container.VolumeMounts {
+		mount := &container.VolumeMounts[i]
+		mountPath := filepath.Clean(mount.MountPath)
+		if mountPath != "/" && !strings.HasPrefix(path, mountPath+"/") {
+			continue
+		}
+		if found == nil || len(mountPath) > len(filepath.Clean(found.MountPath)) {
+			found = mount
+		}
+	}
+	return found
+}
+
+func findContainer(pod *corev1.Pod, name string) *corev1.Container {
+	for i := range pod.Spec.Containers {
+		if pod.Spec.Containers[i].Name == name {
+			return &pod.Spec.Containers[i]
+		}
+	}
+	return nil
+}
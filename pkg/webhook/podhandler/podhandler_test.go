@@ -0,0 +1,264 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package podhandler
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func testPod(annotation string, containers ...corev1.Container) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+		Spec:       corev1.PodSpec{Containers: containers},
+	}
+	if annotation != "" {
+		pod.Annotations = map[string]string{TailAnnotation: annotation}
+	}
+	return pod
+}
+
+func testHandler() *PodHandler {
+	return &PodHandler{Log: ctrl.Log, Image: DefaultTailerImage, RunAsUser: DefaultTailerUser}
+}
+
+func sidecarMounts(pod *corev1.Pod) map[string]corev1.VolumeMount {
+	mounts := make(map[string]corev1.VolumeMount)
+	for _, c := range pod.Spec.Containers {
+		if len(c.Command) == 4 {
+			mounts[c.Command[3]] = c.VolumeMounts[0]
+		}
+	}
+	return mounts
+}
+
+func TestInjectTailersPlainPaths(t *testing.T) {
+	pod := testPod("/var/log/app/a.log, /var/log/app/b.log,/tmp/c.log",
+		corev1.Container{Name: "app"}, corev1.Container{Name: "other"})
+	if err := testHandler().injectTailers(pod); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(pod.Spec.Containers) != 5 {
+		t.Fatalf("expected 3 sidecars, got %d containers", len(pod.Spec.Containers))
+	}
+	expectedVolumes := []string{"file-tailer-0", "file-tailer-1"}
+	var volumes []string
+	for _, v := range pod.Spec.Volumes {
+		if v.EmptyDir == nil {
+			t.Errorf("volume %s should be an emptyDir", v.Name)
+		}
+		volumes = append(volumes, v.Name)
+	}
+	if !reflect.DeepEqual(volumes, expectedVolumes) {
+		t.Errorf("expected volumes %v, got %v", expectedVolumes, volumes)
+	}
+	expectedAppMounts := []corev1.VolumeMount{
+		{Name: "file-tailer-0", MountPath: "/var/log/app"},
+		{Name: "file-tailer-1", MountPath: "/tmp"},
+	}
+	if !reflect.DeepEqual(pod.Spec.Containers[0].VolumeMounts, expectedAppMounts) {
+		t.Errorf("expected app mounts %v, got %v", expectedAppMounts, pod.Spec.Containers[0].VolumeMounts)
+	}
+	if len(pod.Spec.Containers[1].VolumeMounts) != 0 {
+		t.Errorf("unexpected mounts in other container: %v", pod.Spec.Containers[1].VolumeMounts)
+	}
+	expectedSidecarMounts := map[string]corev1.VolumeMount{
+		"/var/log/app/a.log": {Name: "file-tailer-0", MountPath: "/var/log/app", ReadOnly: true},
+		"/var/log/app/b.log": {Name: "file-tailer-0", MountPath: "/var/log/app", ReadOnly: true},
+		"/tmp/c.log":         {Name: "file-tailer-1", MountPath: "/tmp", ReadOnly: true},
+	}
+	if mounts := sidecarMounts(pod); !reflect.DeepEqual(mounts, expectedSidecarMounts) {
+		t.Errorf("expected sidecar mounts %v, got %v", expectedSidecarMounts, mounts)
+	}
+	for _, c := range pod.Spec.Containers[2:] {
+		if c.Resources.Limits.Memory().IsZero() || c.Resources.Requests.Cpu().IsZero() {
+			t.Errorf("sidecar %s has no resources set", c.Name)
+		}
+		if c.SecurityContext == nil || !*c.SecurityContext.ReadOnlyRootFilesystem || *c.SecurityContext.AllowPrivilegeEscalation {
+			t.Errorf("sidecar %s has no restricted security context", c.Name)
+		}
+	}
+}
+
+func TestInjectTailersContainerPaths(t *testing.T) {
+	pod := testPod("app:/var/log/a.log,other:/var/log/b.log",
+		corev1.Container{Name: "app"}, corev1.Container{Name: "other"})
+	if err := testHandler().injectTailers(pod); err != nil {
+		t.Fatal(err)
+	}
+
+	expectedAppMounts := []corev1.VolumeMount{{Name: "file-tailer-0", MountPath: "/var/log"}}
+	if !reflect.DeepEqual(pod.Spec.Containers[0].VolumeMounts, expectedAppMounts) {
+		t.Errorf("expected app mounts %v, got %v", expectedAppMounts, pod.Spec.Containers[0].VolumeMounts)
+	}
+	expectedOtherMounts := []corev1.VolumeMount{{Name: "file-tailer-1", MountPath: "/var/log"}}
+	if !reflect.DeepEqual(pod.Spec.Containers[1].VolumeMounts, expectedOtherMounts) {
+		t.Errorf("expected other mounts %v, got %v", expectedOtherMounts, pod.Spec.Containers[1].VolumeMounts)
+	}
+
+	for annotation, expected := range map[string]string{
+		"missing:/var/log/a.log": `container "missing" not found for tailed file "/var/log/a.log"`,
+		"app:var/log/a.log":      `tailed file path "var/log/a.log" must be absolute`,
+	} {
+		err := testHandler().injectTailers(testPod(annotation, corev1.Container{Name: "app"}))
+		if err == nil || err.Error() != expected {
+			t.Errorf("expected error %q, got %v", expected, err)
+		}
+	}
+}
+
+func TestInjectTailersExistingMount(t *testing.T) {
+	pod := testPod("/var/log/app/a.log",
+		corev1.Container{
+			Name: "app",
+			VolumeMounts: []corev1.VolumeMount{
+				{Name: "data", MountPath: "/var"},
+				{Name: "logs", MountPath: "/var/log/", SubPath: "app-logs"},
+			},
+		})
+	pod.Spec.Volumes = []corev1.Volume{{Name: "logs"}, {Name: "data"}}
+	if err := testHandler().injectTailers(pod); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(pod.Spec.Volumes) != 2 {
+		t.Errorf("no volume should be added, got %v", pod.Spec.Volumes)
+	}
+	if len(pod.Spec.Containers[0].VolumeMounts) != 2 {
+		t.Errorf("no mount should be added to the app container, got %v", pod.Spec.Containers[0].VolumeMounts)
+	}
+	expected := map[string]corev1.VolumeMount{
+		"/var/log/app/a.log": {Name: "logs", MountPath: "/var/log/", SubPath: "app-logs", ReadOnly: true},
+	}
+	if mounts := sidecarMounts(pod); !reflect.DeepEqual(mounts, expected) {
+		t.Errorf("expected sidecar mounts %v, got %v", expected, mounts)
+	}
+}
+
+func TestInjectTailersReinjection(t *testing.T) {
+	pod := testPod("/var/log/a.log", corev1.Container{Name: "app"})
+	h := testHandler()
+	if err := h.injectTailers(pod); err != nil {
+		t.Fatal(err)
+	}
+	injected := pod.DeepCopy()
+	if err := h.injectTailers(pod); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(pod, injected) {
+		t.Errorf("pod should not change on re-injection")
+	}
+}
+
+func TestHandle(t *testing.T) {
+	decoder, err := admission.NewDecoder(scheme.Scheme)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := testHandler()
+	if err := h.InjectDecoder(decoder); err != nil {
+		t.Fatal(err)
+	}
+
+	request := func(pod *corev1.Pod) admission.Request {
+		raw, err := json.Marshal(pod)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+			Namespace: pod.Namespace,
+			Object:    runtime.RawExtension{Raw: raw},
+		}}
+	}
+
+	resp := h.Handle(context.Background(), request(testPod("", corev1.Container{Name: "app"})))
+	if !resp.Allowed || len(resp.Patches) != 0 {
+		t.Errorf("pod without annotation should be allowed unchanged, got %+v", resp)
+	}
+
+	resp = h.Handle(context.Background(), request(testPod("/var/log/a.log", corev1.Container{Name: "app"})))
+	if !resp.Allowed || len(resp.Patches) == 0 {
+		t.Errorf("annotated pod should be patched, got %+v", resp)
+	}
+
+	resp = h.Handle(context.Background(), request(testPod("missing:/var/log/a.log", corev1.Container{Name: "app"})))
+	if !resp.Allowed || len(resp.Patches) != 0 || len(resp.Warnings) != 1 {
+		t.Errorf("pod with invalid annotation should be allowed unchanged with a warning, got %+v", resp)
+	}
+}
+
+func TestTailerSecurityContext(t *testing.T) {
+	user := func(uid int64) *int64 { return &uid }
+	tests := []struct {
+		name    string
+		pod     *corev1.PodSecurityContext
+		app     *corev1.SecurityContext
+		user    int64
+		group   *int64
+		nonRoot bool
+	}{
+		{
+			name:    "default user",
+			user:    DefaultTailerUser,
+			nonRoot: true,
+		},
+		{
+			name:    "user of the pod",
+			pod:     &corev1.PodSecurityContext{RunAsUser: user(1000), RunAsGroup: user(3000)},
+			user:    1000,
+			group:   user(3000),
+			nonRoot: true,
+		},
+		{
+			name:    "user of the container",
+			pod:     &corev1.PodSecurityContext{RunAsUser: user(1000), RunAsGroup: user(3000)},
+			app:     &corev1.SecurityContext{RunAsUser: user(2000)},
+			user:    2000,
+			group:   user(3000),
+			nonRoot: true,
+		},
+		{
+			name:    "root container",
+			app:     &corev1.SecurityContext{RunAsUser: user(0)},
+			user:    0,
+			nonRoot: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pod := testPod("/var/log/a.log", corev1.Container{Name: "app", SecurityContext: test.app})
+			pod.Spec.SecurityContext = test.pod
+			h := testHandler()
+			if err := h.injectTailers(pod); err != nil {
+				t.Fatal(err)
+			}
+			sc := pod.Spec.Containers[1].SecurityContext
+			if *sc.RunAsUser != test.user || *sc.RunAsNonRoot != test.nonRoot || !reflect.DeepEqual(sc.RunAsGroup, test.group) {
+				t.Errorf("expected user %d, group %v, non-root %t, got %+v", test.user, test.group, test.nonRoot, sc)
+			}
+		})
+	}
+}